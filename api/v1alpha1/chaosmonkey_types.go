@@ -0,0 +1,348 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KindChaosMonkey is the kind for chaos monkey
+const KindChaosMonkey = "ChaosMonkey"
+
+// LabelChaosMonkey is the label of the chaos generated by a monkey, its value is the name of monkey
+const LabelChaosMonkey = "chaos-mesh.org/monkey"
+
+// MonkeyAction represents a kind of experiment the monkey generates.
+// +kubebuilder:validation:Enum=pod-kill;pod-failure;network-delay;network-loss;cpu-stress
+type MonkeyAction string
+
+const (
+	// MonkeyPodKillAction kills the target pods
+	MonkeyPodKillAction MonkeyAction = "pod-kill"
+	// MonkeyPodFailureAction makes the target pods unavailable
+	MonkeyPodFailureAction MonkeyAction = "pod-failure"
+	// MonkeyNetworkDelayAction delays the packets of the target pods
+	MonkeyNetworkDelayAction MonkeyAction = "network-delay"
+	// MonkeyNetworkLossAction drops the packets of the target pods
+	MonkeyNetworkLossAction MonkeyAction = "network-loss"
+	// MonkeyCPUStressAction burns the CPU of the target pods
+	MonkeyCPUStressAction MonkeyAction = "cpu-stress"
+)
+
+const (
+	// DefaultMonkeyInterval is the default interval between the experiments of a monkey
+	DefaultMonkeyInterval = 10 * time.Minute
+	// DefaultMonkeyDuration is the default duration of the experiments of a monkey
+	DefaultMonkeyDuration = time.Minute
+	// DefaultMonkeyHistoryLimit is the default number of the experiments kept in the status of a monkey
+	DefaultMonkeyHistoryLimit = 10
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ChaosMonkey is the Schema for the chaosmonkeys API.
+// A ChaosMonkey continuously generates small randomized experiments on the selected pods.
+type ChaosMonkey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the scope and limits of the generated experiments
+	Spec ChaosMonkeySpec `json:"spec"`
+
+	// +optional
+	// Most recently observed status of the monkey
+	Status ChaosMonkeyStatus `json:"status"`
+}
+
+// ChaosMonkeySpec defines the experiments generated by the monkey
+type ChaosMonkeySpec struct {
+	// Selector is used to select the pods which the monkey is allowed to attack.
+	// The pods in the namespace of monkey are selected if no namespace is given.
+	Selector SelectorSpec `json:"selector"`
+
+	// Actions are the kinds of experiments the monkey picks from randomly.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Actions []MonkeyAction `json:"actions"`
+
+	// Interval is the interval between two experiments, such as "10m".
+	// default: 10m.
+	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	// Duration is how long an experiment lasts before it's recovered, such as "1m".
+	// default: 1m.
+	// +optional
+	Duration string `json:"duration,omitempty"`
+
+	// Intensity limits the blast radius and the strength of every experiment.
+	// +optional
+	Intensity *MonkeyIntensity `json:"intensity,omitempty"`
+
+	// MaxConcurrent is the max number of experiments running at the same time.
+	// default: 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConcurrent int `json:"maxConcurrent,omitempty"`
+
+	// ActiveHours is the window in which the monkey runs experiments,
+	// the monkey is always active if it's nil.
+	// +optional
+	ActiveHours *ActiveHours `json:"activeHours,omitempty"`
+
+	// Suspend stops the monkey from generating new experiments.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// HistoryLimit is the number of experiments kept in the status.
+	// default: 10.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HistoryLimit int `json:"historyLimit,omitempty"`
+}
+
+// MonkeyIntensity defines the upper limits of the randomized experiments
+type MonkeyIntensity struct {
+	// MaxPercent is the max percentage of the selected pods attacked by an experiment.
+	// default: 10.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxPercent int `json:"maxPercent,omitempty"`
+
+	// MaxLatency is the max latency of network-delay, such as "200ms".
+	// default: 100ms.
+	// +optional
+	MaxLatency string `json:"maxLatency,omitempty"`
+
+	// MaxLoss is the max percentage of dropped packets of network-loss.
+	// default: 10.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxLoss int `json:"maxLoss,omitempty"`
+
+	// MaxCPULoad is the max percentage of CPU load of cpu-stress.
+	// default: 50.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxCPULoad int `json:"maxCPULoad,omitempty"`
+}
+
+// ActiveHours defines a daily time window
+type ActiveHours struct {
+	// Start is the beginning of the window in the form of "15:04", such as "09:00".
+	Start string `json:"start"`
+
+	// End is the end of the window in the form of "15:04", such as "17:00".
+	// The window goes across midnight if End is before Start.
+	End string `json:"end"`
+
+	// Days are the days of week when the window is open, every day if it's empty.
+	// +optional
+	// +listType=atomic
+	Days []Weekday `json:"days,omitempty"`
+
+	// TimeZone is the IANA time zone of the window, such as "Asia/Shanghai".
+	// default: UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// Weekday is a day of week
+// +kubebuilder:validation:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+type Weekday string
+
+var weekdays = map[Weekday]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// ChaosMonkeyStatus represents the experiments generated by the monkey
+type ChaosMonkeyStatus struct {
+	// LastExperimentTime is the time when the last experiment was generated.
+	// +optional
+	LastExperimentTime *metav1.Time `json:"lastExperimentTime,omitempty"`
+
+	// Experiments are the latest experiments generated by the monkey.
+	// +optional
+	// +listType=atomic
+	Experiments []MonkeyExperiment `json:"experiments,omitempty"`
+}
+
+// MonkeyExperiment records an experiment generated by the monkey
+type MonkeyExperiment struct {
+	Kind   string       `json:"kind"`
+	Name   string       `json:"name"`
+	Action MonkeyAction `json:"action"`
+	// Value is the percentage of pods attacked by the experiment.
+	Value string `json:"value"`
+
+	StartTime metav1.Time `json:"startTime"`
+
+	// EndTime is the time when the experiment was recovered, it's nil if it's running.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosMonkeyList contains a list of ChaosMonkey
+type ChaosMonkeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosMonkey `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosMonkey{}, &ChaosMonkeyList{})
+}
+
+// GetInterval returns the interval between two experiments
+func (in *ChaosMonkeySpec) GetInterval() (time.Duration, error) {
+	return parseDurationOr(in.Interval, DefaultMonkeyInterval)
+}
+
+// GetDuration returns the duration of an experiment
+func (in *ChaosMonkeySpec) GetDuration() (time.Duration, error) {
+	return parseDurationOr(in.Duration, DefaultMonkeyDuration)
+}
+
+// GetMaxConcurrent returns the max number of running experiments
+func (in *ChaosMonkeySpec) GetMaxConcurrent() int {
+	if in.MaxConcurrent == 0 {
+		return 1
+	}
+	return in.MaxConcurrent
+}
+
+// GetHistoryLimit returns the number of experiments kept in the status
+func (in *ChaosMonkeySpec) GetHistoryLimit() int {
+	if in.HistoryLimit == 0 {
+		return DefaultMonkeyHistoryLimit
+	}
+	return in.HistoryLimit
+}
+
+// GetMaxPercent returns the max percentage of pods attacked by an experiment
+func (in *MonkeyIntensity) GetMaxPercent() int {
+	if in == nil || in.MaxPercent == 0 {
+		return 10
+	}
+	return in.MaxPercent
+}
+
+// GetMaxLatency returns the max latency of network-delay
+func (in *MonkeyIntensity) GetMaxLatency() (time.Duration, error) {
+	if in == nil {
+		return 100 * time.Millisecond, nil
+	}
+	return parseDurationOr(in.MaxLatency, 100*time.Millisecond)
+}
+
+// GetMaxLoss returns the max percentage of dropped packets of network-loss
+func (in *MonkeyIntensity) GetMaxLoss() int {
+	if in == nil || in.MaxLoss == 0 {
+		return 10
+	}
+	return in.MaxLoss
+}
+
+// GetMaxCPULoad returns the max percentage of CPU load of cpu-stress
+func (in *MonkeyIntensity) GetMaxCPULoad() int {
+	if in == nil || in.MaxCPULoad == 0 {
+		return 50
+	}
+	return in.MaxCPULoad
+}
+
+// IsActive returns whether the window is open at t
+func (in *ActiveHours) IsActive(t time.Time) (bool, error) {
+	start, end, loc, err := in.parse()
+	if err != nil {
+		return false, err
+	}
+
+	t = t.In(loc)
+	minute := t.Hour()*60 + t.Minute()
+	if start <= end {
+		return in.onDay(t.Weekday()) && minute >= start && minute < end, nil
+	}
+
+	// the window goes across midnight, the part after midnight belongs to the previous day
+	if minute >= start {
+		return in.onDay(t.Weekday()), nil
+	}
+	if minute < end {
+		return in.onDay(t.AddDate(0, 0, -1).Weekday()), nil
+	}
+	return false, nil
+}
+
+// NextStart returns the next time when the window opens after t
+func (in *ActiveHours) NextStart(t time.Time) (time.Time, error) {
+	start, _, loc, err := in.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	t = t.In(loc)
+	for i := 0; i <= 7; i++ {
+		day := t.AddDate(0, 0, i)
+		next := time.Date(day.Year(), day.Month(), day.Day(), start/60, start%60, 0, 0, loc)
+		if next.After(t) && in.onDay(next.Weekday()) {
+			return next, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("the window never opens")
+}
+
+func (in *ActiveHours) onDay(day time.Weekday) bool {
+	if len(in.Days) == 0 {
+		return true
+	}
+	for _, d := range in.Days {
+		if weekdays[d] == day {
+			return true
+		}
+	}
+	return false
+}
+
+// parse returns the minutes of start and end in a day, and the location of the window
+func (in *ActiveHours) parse() (int, int, *time.Location, error) {
+	loc, err := time.LoadLocation(in.TimeZone)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	start, err := time.Parse("15:04", in.Start)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	end, err := time.Parse("15:04", in.End)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	return start.Hour()*60 + start.Minute(), end.Hour()*60 + end.Minute(), loc, nil
+}
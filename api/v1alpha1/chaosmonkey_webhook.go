@@ -0,0 +1,167 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var chaosmonkeylog = logf.Log.WithName("chaosmonkey-resource")
+
+// SetupWebhookWithManager setup ChaosMonkey's webhook with manager
+func (in *ChaosMonkey) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-chaos-mesh-org-v1alpha1-chaosmonkey,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=chaosmonkeys,versions=v1alpha1,name=vchaosmonkey.kb.io
+
+var _ webhook.Validator = &ChaosMonkey{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *ChaosMonkey) ValidateCreate() error {
+	chaosmonkeylog.Info("validate create", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *ChaosMonkey) ValidateUpdate(old runtime.Object) error {
+	chaosmonkeylog.Info("validate update", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *ChaosMonkey) ValidateDelete() error {
+	chaosmonkeylog.Info("validate delete", "name", in.Name)
+
+	// Nothing to do?
+	return nil
+}
+
+// Validate validates the actions, durations, limits and active hours of the monkey
+func (in *ChaosMonkey) Validate() error {
+	specField := field.NewPath("spec")
+	allErrs := in.Spec.validateActions(specField.Child("actions"))
+
+	if _, err := in.Spec.GetInterval(); err != nil {
+		allErrs = append(allErrs, field.Invalid(specField.Child("interval"), in.Spec.Interval,
+			fmt.Sprintf("parse interval field error:%s", err)))
+	}
+	if duration, err := in.Spec.GetDuration(); err != nil {
+		allErrs = append(allErrs, field.Invalid(specField.Child("duration"), in.Spec.Duration,
+			fmt.Sprintf("parse duration field error:%s", err)))
+	} else if duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(specField.Child("duration"), in.Spec.Duration,
+			"duration must be positive"))
+	}
+	if in.Spec.MaxConcurrent < 0 {
+		allErrs = append(allErrs, field.Invalid(specField.Child("maxConcurrent"), in.Spec.MaxConcurrent,
+			"maxConcurrent can't be negative"))
+	}
+
+	if in.Spec.Intensity != nil {
+		allErrs = append(allErrs, in.Spec.Intensity.validate(specField.Child("intensity"))...)
+	}
+	if in.Spec.ActiveHours != nil {
+		allErrs = append(allErrs, in.Spec.ActiveHours.validate(specField.Child("activeHours"))...)
+	}
+
+	if len(allErrs) > 0 {
+		return fmt.Errorf(allErrs.ToAggregate().Error())
+	}
+	return nil
+}
+
+// validateActions validates the actions are supported
+func (in *ChaosMonkeySpec) validateActions(actionsField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(in.Actions) == 0 {
+		allErrs = append(allErrs, field.Required(actionsField, "at least one action is required"))
+	}
+
+	supported := []string{
+		string(MonkeyPodKillAction),
+		string(MonkeyPodFailureAction),
+		string(MonkeyNetworkDelayAction),
+		string(MonkeyNetworkLossAction),
+		string(MonkeyCPUStressAction),
+	}
+	for i, action := range in.Actions {
+		switch action {
+		case MonkeyPodKillAction, MonkeyPodFailureAction, MonkeyNetworkDelayAction,
+			MonkeyNetworkLossAction, MonkeyCPUStressAction:
+		default:
+			allErrs = append(allErrs, field.NotSupported(actionsField.Index(i), action, supported))
+		}
+	}
+	return allErrs
+}
+
+// validate validates the limits are in range
+func (in *MonkeyIntensity) validate(intensityField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	percents := map[string]int{
+		"maxPercent": in.MaxPercent,
+		"maxLoss":    in.MaxLoss,
+		"maxCPULoad": in.MaxCPULoad,
+	}
+	for _, name := range []string{"maxPercent", "maxLoss", "maxCPULoad"} {
+		if value := percents[name]; value < 0 || value > 100 {
+			allErrs = append(allErrs, field.Invalid(intensityField.Child(name), value,
+				"it must be between 0 and 100"))
+		}
+	}
+
+	if latency, err := in.GetMaxLatency(); err != nil || latency <= 0 {
+		allErrs = append(allErrs, field.Invalid(intensityField.Child("maxLatency"), in.MaxLatency,
+			"it must be a positive duration"))
+	}
+	return allErrs
+}
+
+// validate validates the window can be parsed and isn't empty
+func (in *ActiveHours) validate(activeHoursField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if _, err := time.Parse("15:04", in.Start); err != nil {
+		allErrs = append(allErrs, field.Invalid(activeHoursField.Child("start"), in.Start,
+			"it must be in the form of 15:04"))
+	}
+	if _, err := time.Parse("15:04", in.End); err != nil {
+		allErrs = append(allErrs, field.Invalid(activeHoursField.Child("end"), in.End,
+			"it must be in the form of 15:04"))
+	}
+	if in.Start == in.End {
+		allErrs = append(allErrs, field.Invalid(activeHoursField.Child("end"), in.End,
+			"the window is empty"))
+	}
+	if _, err := time.LoadLocation(in.TimeZone); err != nil {
+		allErrs = append(allErrs, field.Invalid(activeHoursField.Child("timeZone"), in.TimeZone, err.Error()))
+	}
+	for i, day := range in.Days {
+		if _, ok := weekdays[day]; !ok {
+			allErrs = append(allErrs, field.NotSupported(activeHoursField.Child("days").Index(i), day,
+				[]string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}))
+		}
+	}
+	return allErrs
+}
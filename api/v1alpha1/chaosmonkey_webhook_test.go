@@ -0,0 +1,149 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("chaosmonkey_webhook", func() {
+	Context("webhook.Validator of chaosmonkey", func() {
+		It("Validate", func() {
+			type TestCase struct {
+				name    string
+				monkey  ChaosMonkey
+				execute func(monkey *ChaosMonkey) error
+				expect  string
+			}
+			tcs := []TestCase{
+				{
+					name: "simple ValidateCreate",
+					monkey: ChaosMonkey{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo1"},
+						Spec:       ChaosMonkeySpec{Actions: []MonkeyAction{MonkeyPodKillAction}},
+					},
+					execute: func(monkey *ChaosMonkey) error {
+						return monkey.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "simple ValidateUpdate",
+					monkey: ChaosMonkey{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo2"},
+						Spec: ChaosMonkeySpec{
+							Actions:     []MonkeyAction{MonkeyNetworkDelayAction, MonkeyCPUStressAction},
+							Interval:    "1h",
+							Duration:    "5m",
+							Intensity:   &MonkeyIntensity{MaxPercent: 30, MaxLatency: "1s"},
+							ActiveHours: &ActiveHours{Start: "22:00", End: "06:00", Days: []Weekday{"Mon"}, TimeZone: "Asia/Shanghai"},
+						},
+					},
+					execute: func(monkey *ChaosMonkey) error {
+						return monkey.ValidateUpdate(monkey)
+					},
+					expect: "",
+				},
+				{
+					name: "validate the actions",
+					monkey: ChaosMonkey{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo3"},
+						Spec:       ChaosMonkeySpec{Actions: []MonkeyAction{"container-kill"}},
+					},
+					execute: func(monkey *ChaosMonkey) error {
+						return monkey.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the duration",
+					monkey: ChaosMonkey{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo4"},
+						Spec:       ChaosMonkeySpec{Actions: []MonkeyAction{MonkeyPodKillAction}, Duration: "-1m"},
+					},
+					execute: func(monkey *ChaosMonkey) error {
+						return monkey.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the intensity",
+					monkey: ChaosMonkey{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo5"},
+						Spec: ChaosMonkeySpec{
+							Actions:   []MonkeyAction{MonkeyPodKillAction},
+							Intensity: &MonkeyIntensity{MaxPercent: 101},
+						},
+					},
+					execute: func(monkey *ChaosMonkey) error {
+						return monkey.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the active hours",
+					monkey: ChaosMonkey{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo6"},
+						Spec: ChaosMonkeySpec{
+							Actions:     []MonkeyAction{MonkeyPodKillAction},
+							ActiveHours: &ActiveHours{Start: "9am", End: "09:00"},
+						},
+					},
+					execute: func(monkey *ChaosMonkey) error {
+						return monkey.ValidateCreate()
+					},
+					expect: "error",
+				},
+			}
+
+			for _, tc := range tcs {
+				err := tc.execute(&tc.monkey)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred(), tc.name)
+				} else {
+					Expect(err).NotTo(HaveOccurred(), tc.name)
+				}
+			}
+		})
+	})
+
+	Context("ActiveHours", func() {
+		It("IsActive", func() {
+			// 2020-06-01 is a Monday
+			window := &ActiveHours{Start: "22:00", End: "06:00", Days: []Weekday{"Mon"}}
+			for t, expected := range map[time.Time]bool{
+				time.Date(2020, 6, 1, 21, 59, 0, 0, time.UTC): false,
+				time.Date(2020, 6, 1, 22, 0, 0, 0, time.UTC):  true,
+				time.Date(2020, 6, 2, 5, 59, 0, 0, time.UTC):  true,
+				time.Date(2020, 6, 2, 6, 0, 0, 0, time.UTC):   false,
+				time.Date(2020, 6, 2, 22, 0, 0, 0, time.UTC):  false,
+			} {
+				active, err := window.IsActive(t)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(active).To(Equal(expected), t.String())
+			}
+		})
+
+		It("NextStart", func() {
+			window := &ActiveHours{Start: "09:00", End: "17:00", Days: []Weekday{"Mon", "Wed"}}
+			next, err := window.NextStart(time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(Equal(time.Date(2020, 6, 3, 9, 0, 0, 0, time.UTC)))
+		})
+	})
+})
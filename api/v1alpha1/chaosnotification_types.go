@@ -0,0 +1,167 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KindChaosNotification is the kind for chaos notification
+const KindChaosNotification = "ChaosNotification"
+
+// NotificationEvent is a lifecycle event of chaos
+type NotificationEvent string
+
+const (
+	// NotificationEventStarted means the chaos is injected into the targets
+	NotificationEventStarted NotificationEvent = "Started"
+	// NotificationEventFailed means the chaos failed to be injected
+	NotificationEventFailed NotificationEvent = "Failed"
+	// NotificationEventAborted means the running chaos is stopped before it finishes,
+	// e.g. it's paused when its hypothesis is broken
+	NotificationEventAborted NotificationEvent = "Aborted"
+	// NotificationEventFinished means the chaos is recovered after it finishes
+	NotificationEventFinished NotificationEvent = "Finished"
+	// NotificationEventRecoveryFailed means the recovery of chaos failed, so the faults are still
+	// left in the targets while the recovery is retried
+	NotificationEventRecoveryFailed NotificationEvent = "RecoveryFailed"
+	// NotificationEventRecovered means the chaos is recovered after its recovery failed
+	NotificationEventRecovered NotificationEvent = "Recovered"
+	// NotificationEventResidualFaults means the chaos was cleaned up without being recovered,
+	// so the faults may be left in the targets
+	NotificationEventResidualFaults NotificationEvent = "ResidualFaults"
+)
+
+// ReceiverType is the type of notification receiver
+type ReceiverType string
+
+const (
+	// SlackReceiver posts the messages to an incoming webhook of Slack
+	SlackReceiver ReceiverType = "slack"
+	// TeamsReceiver posts the messages to an incoming webhook of Microsoft Teams
+	TeamsReceiver ReceiverType = "teams"
+	// WebhookReceiver posts the messages with the events as JSON to a HTTP endpoint
+	WebhookReceiver ReceiverType = "webhook"
+	// PagerDutyReceiver opens the incidents of PagerDuty through the Events API v2
+	PagerDutyReceiver ReceiverType = "pagerduty"
+	// OpsgenieReceiver opens the alerts of Opsgenie through the Alert API
+	OpsgenieReceiver ReceiverType = "opsgenie"
+)
+
+// +kubebuilder:object:root=true
+
+// ChaosNotification is the Schema for the chaosnotifications API.
+// A ChaosNotification sends the messages to its receivers when the chaos in its namespace
+// starts, fails to be injected, is aborted or finishes, and opens incidents when its recovery fails.
+type ChaosNotification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the events and the receivers of the notification
+	Spec ChaosNotificationSpec `json:"spec"`
+}
+
+// ChaosNotificationSpec defines which events of chaos are sent to the receivers
+type ChaosNotificationSpec struct {
+	// Events are the lifecycle events which are sent, all events are sent if it's empty.
+	// +optional
+	// +listType=atomic
+	Events []NotificationEvent `json:"events,omitempty"`
+
+	// Kinds are the kinds of chaos whose events are sent, such as "PodChaos".
+	// The events of all kinds are sent if it's empty.
+	// +optional
+	// +listType=atomic
+	Kinds []string `json:"kinds,omitempty"`
+
+	// Template is the Go template of the messages, which is executed with the event, e.g.
+	// "{{ .Event }}: {{ .Kind }} {{ .Namespace }}/{{ .Name }}". The default template is used if it's empty.
+	// +optional
+	Template string `json:"template,omitempty"`
+
+	// Receivers are the receivers which the messages are sent to.
+	// +listType=atomic
+	Receivers []NotificationReceiver `json:"receivers"`
+}
+
+// NotificationReceiver defines where the messages are sent to.
+// The incident receivers, i.e. pagerduty and opsgenie, only open incidents on the RecoveryFailed
+// and ResidualFaults events and resolve them on the Recovered event, since the faults left in
+// the targets are incidents themselves.
+type NotificationReceiver struct {
+	// Type is the type of the receiver.
+	// +kubebuilder:validation:Enum=slack;teams;webhook;pagerduty;opsgenie
+	Type ReceiverType `json:"type"`
+
+	// URL is the url which the messages are posted to. It's optional for the incident
+	// receivers, whose default urls are the public endpoints of PagerDuty and Opsgenie.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// URLFrom reads the url from a secret in the namespace of the notification,
+	// since the incoming webhooks of Slack and Teams are credentials.
+	// +optional
+	// +mapType=atomic
+	URLFrom *corev1.SecretKeySelector `json:"urlFrom,omitempty"`
+
+	// KeyFrom reads the key of the incident receivers from a secret in the namespace of
+	// the notification, which is the integration key of PagerDuty or the API key of Opsgenie.
+	// +optional
+	// +mapType=atomic
+	KeyFrom *corev1.SecretKeySelector `json:"keyFrom,omitempty"`
+
+	// Template overrides the template of the notification for this receiver.
+	// +optional
+	Template string `json:"template,omitempty"`
+}
+
+// Matches returns true if the event of chaos with the kind is sent by the notification
+func (in *ChaosNotificationSpec) Matches(kind string, event NotificationEvent) bool {
+	if len(in.Kinds) > 0 {
+		matched := false
+		for _, k := range in.Kinds {
+			if k == kind {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if len(in.Events) == 0 {
+		return true
+	}
+	for _, e := range in.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosNotificationList contains a list of ChaosNotification
+type ChaosNotificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosNotification `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosNotification{}, &ChaosNotificationList{})
+}
@@ -0,0 +1,166 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net/url"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var chaosnotificationlog = logf.Log.WithName("chaosnotification-resource")
+
+// SetupWebhookWithManager setup ChaosNotification's webhook with manager
+func (in *ChaosNotification) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-chaos-mesh-org-v1alpha1-chaosnotification,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=chaosnotifications,versions=v1alpha1,name=vchaosnotification.kb.io
+
+var _ webhook.Validator = &ChaosNotification{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *ChaosNotification) ValidateCreate() error {
+	chaosnotificationlog.Info("validate create", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *ChaosNotification) ValidateUpdate(old runtime.Object) error {
+	chaosnotificationlog.Info("validate update", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *ChaosNotification) ValidateDelete() error {
+	chaosnotificationlog.Info("validate delete", "name", in.Name)
+
+	// Nothing to do?
+	return nil
+}
+
+// Validate validates the events, the kinds, the templates and the receivers of the notification
+func (in *ChaosNotification) Validate() error {
+	specField := field.NewPath("spec")
+	allErrs := field.ErrorList{}
+
+	supportedEvents := []string{
+		string(NotificationEventStarted),
+		string(NotificationEventFailed),
+		string(NotificationEventAborted),
+		string(NotificationEventFinished),
+		string(NotificationEventRecoveryFailed),
+		string(NotificationEventRecovered),
+		string(NotificationEventResidualFaults),
+	}
+	for i, event := range in.Spec.Events {
+		switch event {
+		case NotificationEventStarted, NotificationEventFailed, NotificationEventAborted, NotificationEventFinished,
+			NotificationEventRecoveryFailed, NotificationEventRecovered, NotificationEventResidualFaults:
+		default:
+			allErrs = append(allErrs, field.NotSupported(specField.Child("events").Index(i), event, supportedEvents))
+		}
+	}
+
+	kinds := AllKinds()
+	for i, kind := range in.Spec.Kinds {
+		if _, ok := kinds[kind]; !ok {
+			allErrs = append(allErrs, field.Invalid(specField.Child("kinds").Index(i), kind, "unknown kind of chaos"))
+		}
+	}
+
+	allErrs = append(allErrs, validateNotificationTemplate(specField.Child("template"), in.Spec.Template)...)
+
+	receiversField := specField.Child("receivers")
+	if len(in.Spec.Receivers) == 0 {
+		allErrs = append(allErrs, field.Required(receiversField, "at least one receiver is required"))
+	}
+	for i := range in.Spec.Receivers {
+		allErrs = append(allErrs, in.Spec.Receivers[i].validate(receiversField.Index(i))...)
+	}
+
+	if len(allErrs) > 0 {
+		return fmt.Errorf(allErrs.ToAggregate().Error())
+	}
+	return nil
+}
+
+// validate validates the type, the url and the template of the receiver
+func (in *NotificationReceiver) validate(receiverField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch in.Type {
+	case SlackReceiver, TeamsReceiver, WebhookReceiver:
+		if (in.URL == "") == (in.URLFrom == nil) {
+			allErrs = append(allErrs, field.Invalid(receiverField, in.URL, "exactly one of url and urlFrom is required"))
+		}
+		if in.KeyFrom != nil {
+			allErrs = append(allErrs, field.Invalid(receiverField.Child("keyFrom"), in.KeyFrom,
+				fmt.Sprintf("keyFrom isn't used by the %s receiver", in.Type)))
+		}
+	case PagerDutyReceiver, OpsgenieReceiver:
+		if in.URL != "" && in.URLFrom != nil {
+			allErrs = append(allErrs, field.Invalid(receiverField, in.URL, "at most one of url and urlFrom is allowed"))
+		}
+		if in.KeyFrom == nil {
+			allErrs = append(allErrs, field.Required(receiverField.Child("keyFrom"),
+				fmt.Sprintf("the key of the %s receiver is required", in.Type)))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(receiverField.Child("type"), in.Type,
+			[]string{string(SlackReceiver), string(TeamsReceiver), string(WebhookReceiver),
+				string(PagerDutyReceiver), string(OpsgenieReceiver)}))
+	}
+	if in.URL != "" {
+		if u, err := url.Parse(in.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(receiverField.Child("url"), in.URL, "the url must be an absolute http or https url"))
+		}
+	}
+	allErrs = append(allErrs, validateSecretKeySelector(receiverField.Child("urlFrom"), in.URLFrom)...)
+	allErrs = append(allErrs, validateSecretKeySelector(receiverField.Child("keyFrom"), in.KeyFrom)...)
+
+	allErrs = append(allErrs, validateNotificationTemplate(receiverField.Child("template"), in.Template)...)
+	return allErrs
+}
+
+// validateSecretKeySelector validates the name and the key of secret are given if it's referenced
+func validateSecretKeySelector(selectorField *field.Path, selector *corev1.SecretKeySelector) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if selector != nil && (selector.Name == "" || selector.Key == "") {
+		allErrs = append(allErrs, field.Invalid(selectorField, selector, "the name and the key of secret are required"))
+	}
+	return allErrs
+}
+
+// validateNotificationTemplate validates the template can be parsed
+func validateNotificationTemplate(templateField *field.Path, text string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if text == "" {
+		return allErrs
+	}
+	if _, err := template.New("notification").Parse(text); err != nil {
+		allErrs = append(allErrs, field.Invalid(templateField, text, err.Error()))
+	}
+	return allErrs
+}
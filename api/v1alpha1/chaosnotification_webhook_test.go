@@ -0,0 +1,219 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("chaosnotification_webhook", func() {
+	Context("webhook.Validator of chaosnotification", func() {
+		It("Validate", func() {
+			slack := NotificationReceiver{
+				Type: SlackReceiver,
+				URLFrom: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "slack"},
+					Key:                  "url",
+				},
+			}
+
+			type TestCase struct {
+				name         string
+				notification ChaosNotification
+				execute      func(notification *ChaosNotification) error
+				expect       string
+			}
+			tcs := []TestCase{
+				{
+					name: "simple ValidateCreate",
+					notification: ChaosNotification{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo1"},
+						Spec:       ChaosNotificationSpec{Receivers: []NotificationReceiver{slack}},
+					},
+					execute: func(notification *ChaosNotification) error {
+						return notification.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "simple ValidateUpdate",
+					notification: ChaosNotification{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo2"},
+						Spec: ChaosNotificationSpec{
+							Events:   []NotificationEvent{NotificationEventFailed, NotificationEventAborted},
+							Kinds:    []string{KindPodChaos},
+							Template: "{{ .Event }}: {{ .Kind }} {{ .Namespace }}/{{ .Name }}",
+							Receivers: []NotificationReceiver{
+								slack,
+								{Type: WebhookReceiver, URL: "https://example.com/chaos"},
+							},
+						},
+					},
+					execute: func(notification *ChaosNotification) error {
+						return notification.ValidateUpdate(notification)
+					},
+					expect: "",
+				},
+				{
+					name: "validate the events",
+					notification: ChaosNotification{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo3"},
+						Spec: ChaosNotificationSpec{
+							Events:    []NotificationEvent{"Paused"},
+							Receivers: []NotificationReceiver{slack},
+						},
+					},
+					execute: func(notification *ChaosNotification) error {
+						return notification.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the kinds",
+					notification: ChaosNotification{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo4"},
+						Spec: ChaosNotificationSpec{
+							Kinds:     []string{"ChaosMonkey"},
+							Receivers: []NotificationReceiver{slack},
+						},
+					},
+					execute: func(notification *ChaosNotification) error {
+						return notification.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the template",
+					notification: ChaosNotification{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo5"},
+						Spec: ChaosNotificationSpec{
+							Template:  "{{ .Event ",
+							Receivers: []NotificationReceiver{slack},
+						},
+					},
+					execute: func(notification *ChaosNotification) error {
+						return notification.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the receivers",
+					notification: ChaosNotification{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo6"},
+					},
+					execute: func(notification *ChaosNotification) error {
+						return notification.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the url of receiver",
+					notification: ChaosNotification{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo7"},
+						Spec: ChaosNotificationSpec{
+							Receivers: []NotificationReceiver{{Type: TeamsReceiver, URL: "example.com"}},
+						},
+					},
+					execute: func(notification *ChaosNotification) error {
+						return notification.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate both url and urlFrom of receiver",
+					notification: ChaosNotification{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo8"},
+						Spec: ChaosNotificationSpec{
+							Receivers: []NotificationReceiver{{Type: SlackReceiver, URL: "https://example.com", URLFrom: slack.URLFrom}},
+						},
+					},
+					execute: func(notification *ChaosNotification) error {
+						return notification.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "simple incident receivers",
+					notification: ChaosNotification{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo9"},
+						Spec: ChaosNotificationSpec{
+							Events: []NotificationEvent{NotificationEventRecoveryFailed, NotificationEventRecovered, NotificationEventResidualFaults},
+							Receivers: []NotificationReceiver{
+								{Type: PagerDutyReceiver, KeyFrom: slack.URLFrom},
+								{Type: OpsgenieReceiver, URL: "https://api.eu.opsgenie.com", KeyFrom: slack.URLFrom},
+							},
+						},
+					},
+					execute: func(notification *ChaosNotification) error {
+						return notification.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "validate the key of incident receiver",
+					notification: ChaosNotification{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo10"},
+						Spec: ChaosNotificationSpec{
+							Receivers: []NotificationReceiver{{Type: PagerDutyReceiver}},
+						},
+					},
+					execute: func(notification *ChaosNotification) error {
+						return notification.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the key of message receiver",
+					notification: ChaosNotification{
+						ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "foo11"},
+						Spec: ChaosNotificationSpec{
+							Receivers: []NotificationReceiver{{Type: WebhookReceiver, URL: "https://example.com", KeyFrom: slack.URLFrom}},
+						},
+					},
+					execute: func(notification *ChaosNotification) error {
+						return notification.ValidateCreate()
+					},
+					expect: "error",
+				},
+			}
+
+			for _, tc := range tcs {
+				err := tc.execute(&tc.notification)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred(), tc.name)
+				} else {
+					Expect(err).NotTo(HaveOccurred(), tc.name)
+				}
+			}
+		})
+	})
+
+	Context("ChaosNotificationSpec", func() {
+		It("Matches", func() {
+			spec := &ChaosNotificationSpec{}
+			Expect(spec.Matches(KindPodChaos, NotificationEventStarted)).To(BeTrue())
+
+			spec = &ChaosNotificationSpec{
+				Events: []NotificationEvent{NotificationEventFailed},
+				Kinds:  []string{KindPodChaos, KindNetworkChaos},
+			}
+			Expect(spec.Matches(KindNetworkChaos, NotificationEventFailed)).To(BeTrue())
+			Expect(spec.Matches(KindNetworkChaos, NotificationEventStarted)).To(BeFalse())
+			Expect(spec.Matches(KindTimeChaos, NotificationEventFailed)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,55 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KindChaosProtection is the kind for chaos protection
+const KindChaosProtection = "ChaosProtection"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// ChaosProtection is the Schema for the chaosprotections API.
+// The pods selected by a ChaosProtection are never targeted by any chaos.
+type ChaosProtection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the protected workloads
+	Spec ChaosProtectionSpec `json:"spec"`
+}
+
+// ChaosProtectionSpec defines the workloads which must never be targeted by chaos
+type ChaosProtectionSpec struct {
+	// Selectors is a set of selectors to select the protected pods.
+	// A pod is protected if it meets any of these selectors.
+	// +listType=atomic
+	Selectors []SelectorSpec `json:"selectors"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosProtectionList contains a list of ChaosProtection
+type ChaosProtectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosProtection `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosProtection{}, &ChaosProtectionList{})
+}
@@ -0,0 +1,273 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// KindChaosResult is the kind for chaos result
+	KindChaosResult = "ChaosResult"
+
+	// LabelResultKind is the label of the results with the kind of their experiments
+	LabelResultKind = "chaos-mesh.org/experiment-kind"
+	// LabelResultName is the label of the results with the name of their experiments
+	LabelResultName = "chaos-mesh.org/experiment-name"
+	// LabelResultUID is the label of the results with the uid of their experiments
+	LabelResultUID = LabelExperimentUID
+	// LabelResultVerdict is the label of the results with their verdicts
+	LabelResultVerdict = "chaos-mesh.org/verdict"
+)
+
+// ChaosVerdict is the verdict of a run of chaos
+type ChaosVerdict string
+
+const (
+	// VerdictRunning means the run isn't finished yet
+	VerdictRunning ChaosVerdict = "Running"
+	// VerdictPassed means the chaos finished, none of the probes failed and the SLO isn't violated
+	VerdictPassed ChaosVerdict = "Passed"
+	// VerdictFailed means the chaos failed to be injected, any of the probes failed or the SLO is violated
+	VerdictFailed ChaosVerdict = "Failed"
+	// VerdictAborted means the chaos was stopped before it finished, e.g. it's paused or deleted
+	VerdictAborted ChaosVerdict = "Aborted"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="kind",type=string,JSONPath=`.spec.experiment.kind`
+// +kubebuilder:printcolumn:name="experiment",type=string,JSONPath=`.spec.experiment.name`
+// +kubebuilder:printcolumn:name="verdict",type=string,JSONPath=`.status.verdict`
+// +kubebuilder:printcolumn:name="duration",type=string,JSONPath=`.status.duration`
+
+// ChaosResult is the Schema for the chaosresults API.
+// A ChaosResult is created for each run of chaos, and it isn't owned by the chaos, so the
+// histories of the experiments survive when they're updated, re-run or deleted.
+type ChaosResult struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the experiment and the run of the result
+	Spec ChaosResultSpec `json:"spec"`
+
+	// +optional
+	// Status defines the verdict and the measurements of the run
+	Status ChaosResultStatus `json:"status,omitempty"`
+}
+
+// ChaosResultSpec defines which run of the experiment the result belongs to
+type ChaosResultSpec struct {
+	// Experiment is the chaos which is run
+	Experiment ExperimentReference `json:"experiment"`
+
+	// StartTime is the time when the run started
+	StartTime metav1.Time `json:"startTime"`
+
+	// Snapshot is the spec of the chaos when the run started, which is exported to replay the run
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +mapType=atomic
+	// +optional
+	Snapshot *runtime.RawExtension `json:"snapshot,omitempty"`
+}
+
+// ExperimentReference refers to the chaos of a result
+type ExperimentReference struct {
+	Kind string    `json:"kind"`
+	Name string    `json:"name"`
+	UID  types.UID `json:"uid"`
+	// Generation is the generation of the chaos which is run
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+}
+
+// ChaosResultStatus defines the verdict, the targets and the measurements of a run
+type ChaosResultStatus struct {
+	// Verdict is the verdict of the run
+	// +kubebuilder:validation:Enum=Running;Passed;Failed;Aborted
+	Verdict ChaosVerdict `json:"verdict,omitempty"`
+
+	// Reason explains the verdict
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// EndTime is the time when the run finished, failed or was aborted
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// Duration is the duration of the run, e.g. "5m0s"
+	// +optional
+	Duration string `json:"duration,omitempty"`
+
+	// Targets are the pods which the chaos is injected into
+	// +optional
+	// +listType=atomic
+	Targets []ResultTarget `json:"targets,omitempty"`
+
+	// Probes are the measurements which are taken during the run, e.g. by the
+	// steady-state checks. The run fails if any of the measurements fails.
+	// +optional
+	// +listType=atomic
+	Probes []ProbeMeasurement `json:"probes,omitempty"`
+
+	// SLO is the evaluation of the service level objective of the chaos. The run fails if
+	// the indicator degrades more than the threshold.
+	// +optional
+	SLO *SLOResult `json:"slo,omitempty"`
+
+	// Load is the measurement of the load which is generated during the run
+	// +optional
+	Load *LoadResult `json:"load,omitempty"`
+}
+
+// ResultTarget is a pod which the chaos is injected into
+type ResultTarget struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// +optional
+	Action string `json:"action,omitempty"`
+}
+
+// ProbeMeasurement is a measurement of a probe during the run
+type ProbeMeasurement struct {
+	// Name is the name of the probe
+	Name string `json:"name"`
+	// Time is the time when the measurement is taken
+	Time metav1.Time `json:"time"`
+	// Value is the measured value
+	// +optional
+	Value string `json:"value,omitempty"`
+	// Passed is true if the measurement satisfies the probe
+	Passed bool `json:"passed"`
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// SLOResult is the evaluation of the service level objective of a run. The numbers are
+// formatted as decimal strings.
+type SLOResult struct {
+	// Query is the PromQL query of the indicator
+	Query string `json:"query"`
+	// Baseline is the mean of the indicator in the pre-window before the chaos
+	// +optional
+	Baseline string `json:"baseline,omitempty"`
+	// Value is the mean of the indicator in the chaos window
+	// +optional
+	Value string `json:"value,omitempty"`
+	// Degradation is the degradation of the indicator in percent, it's negative if the indicator is improved
+	// +optional
+	Degradation string `json:"degradation,omitempty"`
+	// Threshold is the max degradation of the indicator in percent
+	Threshold string `json:"threshold"`
+	// Passed is true if the degradation doesn't exceed the threshold
+	Passed bool `json:"passed"`
+	// Error is the error which failed the evaluation, the verdict isn't affected by it
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// LoadResult is the measurement of the load which is generated during a run. The latencies are
+// formatted as durations, e.g. "12.5ms".
+type LoadResult struct {
+	// Target is the target of the load
+	Target string `json:"target"`
+	// Requests is the number of the requests which are sent
+	Requests int64 `json:"requests"`
+	// Errors is the number of the requests which failed
+	Errors int64 `json:"errors"`
+	// ErrorRatio is the ratio of the failed requests in percent
+	// +optional
+	ErrorRatio string `json:"errorRatio,omitempty"`
+	// ErrorCodes are the numbers of the failed requests by their codes, e.g. "HTTP 503",
+	// "Unavailable" or "Timeout"
+	// +optional
+	ErrorCodes map[string]int64 `json:"errorCodes,omitempty"`
+	// P50 is the median latency of the requests
+	// +optional
+	P50 string `json:"p50,omitempty"`
+	// P90 is the 90th percentile latency of the requests
+	// +optional
+	P90 string `json:"p90,omitempty"`
+	// P99 is the 99th percentile latency of the requests
+	// +optional
+	P99 string `json:"p99,omitempty"`
+	// Max is the max latency of the requests
+	// +optional
+	Max string `json:"max,omitempty"`
+	// Latency is the histogram of the latencies of the requests
+	// +optional
+	// +listType=atomic
+	Latency []HistogramBucket `json:"latency,omitempty"`
+	// Error is the error which stopped the load, e.g. the target is invalid
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// HistogramBucket is a bucket of the latency histogram
+type HistogramBucket struct {
+	// LE is the upper bound of the bucket, e.g. "100ms" or "+Inf"
+	LE string `json:"le"`
+	// Count is the number of the requests whose latencies are in the bucket, it isn't cumulative
+	Count int64 `json:"count"`
+}
+
+// Finish records the end of the run with the verdict. A run which finished is failed
+// if any of its probes failed or its SLO is violated.
+func (in *ChaosResult) Finish(verdict ChaosVerdict, reason string, end metav1.Time) {
+	if slo := in.Status.SLO; verdict == VerdictPassed && slo != nil && slo.Error == "" && !slo.Passed {
+		verdict = VerdictFailed
+		reason = "the SLO is violated: the degradation " + slo.Degradation + "% exceeds " + slo.Threshold + "%"
+	}
+	if verdict == VerdictPassed {
+		for _, probe := range in.Status.Probes {
+			if !probe.Passed {
+				verdict = VerdictFailed
+				reason = "probe " + probe.Name + " failed"
+				if probe.Message != "" {
+					reason += ": " + probe.Message
+				}
+				break
+			}
+		}
+	}
+
+	in.Status.Verdict = verdict
+	in.Status.Reason = reason
+	in.Status.EndTime = &end
+	in.Status.Duration = end.Sub(in.Spec.StartTime.Time).String()
+	if in.Labels == nil {
+		in.Labels = make(map[string]string)
+	}
+	in.Labels[LabelResultVerdict] = string(verdict)
+}
+
+// IsFinished returns true if the run isn't running anymore
+func (in *ChaosResult) IsFinished() bool {
+	return in.Status.Verdict != "" && in.Status.Verdict != VerdictRunning
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosResultList contains a list of ChaosResult
+type ChaosResultList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosResult `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosResult{}, &ChaosResultList{})
+}
@@ -0,0 +1,229 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// KindChaosTemplate is the kind for chaos template
+const KindChaosTemplate = "ChaosTemplate"
+
+// LabelChaosTemplate is the label of the chaos instantiated from a template, its value is the name of template
+const LabelChaosTemplate = "chaos-mesh.org/template"
+
+// AnnotationExperiment is the annotation of workloads which attaches the chaos instantiated from a template to
+// the pods of the workload, its value is `<template>@<name>=<value>,...` and the parameters are optional
+const AnnotationExperiment = "chaos-mesh.org/experiment"
+
+// AnnotationExperimentTrigger is the annotation of workloads which decides when the experiment attached by
+// AnnotationExperiment runs, its value is one of ExperimentTriggerAlways and ExperimentTriggerRollout
+const AnnotationExperimentTrigger = "chaos-mesh.org/experiment-trigger"
+
+// AnnotationExperimentUpdater is the annotation of workloads which records the user who attached the experiment
+// last, i.e. changed AnnotationExperiment or AnnotationExperimentTrigger. It's recorded by the webhook and can't
+// be set by the users, the user becomes the creator of the attached experiment.
+const AnnotationExperimentUpdater = "chaos-mesh.org/experiment-updater"
+
+const (
+	// ExperimentTriggerAlways runs the attached experiment as long as the workload is annotated, it's the default trigger
+	ExperimentTriggerAlways = "always"
+	// ExperimentTriggerRollout runs the attached experiment only while the Deployment is rolling out
+	ExperimentTriggerRollout = "rollout"
+)
+
+// LabelWorkloadUID is the label of the chaos attached to a workload by AnnotationExperiment, its value is the uid of workload
+const LabelWorkloadUID = "chaos-mesh.org/workload-uid"
+
+// templatePlaceholderRegexp matches the `${name}` placeholders in the template
+var templatePlaceholderRegexp = regexp.MustCompile(`\$\{([a-zA-Z_][a-zA-Z0-9_]*)\}`)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// ChaosTemplate is the Schema for the chaostemplates API.
+// A ChaosTemplate is a vetted experiment, which is instantiated into a chaos with the parameters filled in.
+type ChaosTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the parameters and the chaos of the template
+	Spec ChaosTemplateSpec `json:"spec"`
+}
+
+// ChaosTemplateSpec defines the chaos instantiated from the template
+type ChaosTemplateSpec struct {
+	// Parameters are the parameters used by the `${name}` placeholders in the template.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Parameters []ChaosTemplateParameter `json:"parameters,omitempty"`
+
+	// Kind is the kind of the instantiated chaos, such as "PodChaos".
+	Kind string `json:"kind"`
+
+	// Template is the spec of the instantiated chaos, in which the `${name}` placeholders in
+	// the strings are substituted by the parameters, such as the namespaces of selector and duration.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +mapType=atomic
+	Template runtime.RawExtension `json:"template"`
+}
+
+// ChaosTemplateParameter defines a parameter of the template
+type ChaosTemplateParameter struct {
+	// Name is the name used by the `${name}` placeholders.
+	Name string `json:"name"`
+
+	// Description describes the usage of the parameter.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Default is used when the parameter isn't given on instantiation.
+	// +optional
+	Default string `json:"default,omitempty"`
+
+	// Required means the parameter must be given on instantiation.
+	// +optional
+	Required bool `json:"required,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosTemplateList contains a list of ChaosTemplate
+type ChaosTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosTemplate{}, &ChaosTemplateList{})
+}
+
+// Instantiate creates the chaos with the namespace and name from the template.
+// The values override the default values of the parameters.
+func (in *ChaosTemplate) Instantiate(namespace, name string, values map[string]string) (InnerObject, error) {
+	kind, ok := AllKinds()[in.Spec.Kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown kind %s", in.Spec.Kind)
+	}
+
+	params, err := in.Spec.resolveParameters(values)
+	if err != nil {
+		return nil, err
+	}
+
+	var template interface{}
+	if err := json.Unmarshal(in.Spec.Template.Raw, &template); err != nil {
+		return nil, fmt.Errorf("template must be a JSON object: %v", err)
+	}
+
+	// the placeholders are substituted in the decoded strings instead of the raw JSON,
+	// so the values of parameters can't break the structure of the chaos
+	data, err := json.Marshal(map[string]interface{}{
+		"spec": SubstituteTemplateParameters(template, params),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	obj := kind.Chaos.DeepCopyObject()
+	if err := json.Unmarshal(data, obj); err != nil {
+		return nil, fmt.Errorf("template isn't a valid %s: %v", in.Spec.Kind, err)
+	}
+
+	chaos, ok := obj.(InnerObject)
+	if !ok {
+		return nil, fmt.Errorf("%s isn't a chaos", in.Spec.Kind)
+	}
+	meta := obj.(metav1.Object)
+	meta.SetNamespace(namespace)
+	meta.SetName(name)
+	meta.SetLabels(map[string]string{LabelChaosTemplate: in.Name})
+	obj.GetObjectKind().SetGroupVersionKind(GroupVersion.WithKind(in.Spec.Kind))
+
+	return chaos, nil
+}
+
+// resolveParameters returns the values of all the parameters, the default values are used
+// for the parameters which aren't given
+func (in *ChaosTemplateSpec) resolveParameters(values map[string]string) (map[string]string, error) {
+	params := make(map[string]string, len(in.Parameters))
+	for _, param := range in.Parameters {
+		value, ok := values[param.Name]
+		if !ok {
+			if param.Required {
+				return nil, fmt.Errorf("parameter %s is required", param.Name)
+			}
+			value = param.Default
+		}
+		params[param.Name] = value
+	}
+
+	var unknown []string
+	for name := range values {
+		if _, ok := params[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown parameters %v", unknown)
+	}
+
+	return params, nil
+}
+
+// TemplatePlaceholders returns the names used by the `${name}` placeholders in the data
+func TemplatePlaceholders(data string) []string {
+	var names []string
+	for _, match := range templatePlaceholderRegexp.FindAllStringSubmatch(data, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// SubstituteTemplateParameters substitutes the parameters into the `${name}` placeholders
+// in the strings of the value decoded from JSON
+func SubstituteTemplateParameters(value interface{}, params map[string]string) interface{} {
+	replace := func(s string) string {
+		return templatePlaceholderRegexp.ReplaceAllStringFunc(s, func(placeholder string) string {
+			return params[templatePlaceholderRegexp.FindStringSubmatch(placeholder)[1]]
+		})
+	}
+
+	switch v := value.(type) {
+	case string:
+		return replace(v)
+	case []interface{}:
+		for i := range v {
+			v[i] = SubstituteTemplateParameters(v[i], params)
+		}
+		return v
+	case map[string]interface{}:
+		ret := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			ret[replace(key)] = SubstituteTemplateParameters(val, params)
+		}
+		return ret
+	default:
+		return v
+	}
+}
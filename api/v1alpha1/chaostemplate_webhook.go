@@ -0,0 +1,122 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var chaostemplatelog = logf.Log.WithName("chaostemplate-resource")
+
+// templateParameterRegexp matches the valid names of template parameters
+var templateParameterRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// SetupWebhookWithManager setup ChaosTemplate's webhook with manager
+func (in *ChaosTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-chaos-mesh-org-v1alpha1-chaostemplate,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=chaostemplates,versions=v1alpha1,name=vchaostemplate.kb.io
+
+var _ webhook.Validator = &ChaosTemplate{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *ChaosTemplate) ValidateCreate() error {
+	chaostemplatelog.Info("validate create", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *ChaosTemplate) ValidateUpdate(old runtime.Object) error {
+	chaostemplatelog.Info("validate update", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *ChaosTemplate) ValidateDelete() error {
+	chaostemplatelog.Info("validate delete", "name", in.Name)
+
+	// Nothing to do?
+	return nil
+}
+
+// Validate validates the template, the parameters must be unique and every placeholder
+// in the template must be declared as a parameter
+func (in *ChaosTemplate) Validate() error {
+	specField := field.NewPath("spec")
+	allErrs := in.Spec.validateKind(specField.Child("kind"))
+
+	declared := make(map[string]bool, len(in.Spec.Parameters))
+	values := make(map[string]string)
+	for i, param := range in.Spec.Parameters {
+		nameField := specField.Child("parameters").Index(i).Child("name")
+		if !templateParameterRegexp.MatchString(param.Name) {
+			allErrs = append(allErrs, field.Invalid(nameField, param.Name,
+				fmt.Sprintf("the name must match %s", templateParameterRegexp)))
+		}
+		if declared[param.Name] {
+			allErrs = append(allErrs, field.Duplicate(nameField, param.Name))
+		}
+		declared[param.Name] = true
+
+		if param.Required {
+			values[param.Name] = ""
+		}
+	}
+
+	templateField := specField.Child("template")
+	for _, name := range TemplatePlaceholders(string(in.Spec.Template.Raw)) {
+		if !declared[name] {
+			allErrs = append(allErrs, field.Invalid(templateField, "${"+name+"}",
+				fmt.Sprintf("parameter %s is used but not declared", name)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		if _, err := in.Instantiate(in.Namespace, in.Name, values); err != nil {
+			allErrs = append(allErrs, field.Invalid(templateField, string(in.Spec.Template.Raw), err.Error()))
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return fmt.Errorf(allErrs.ToAggregate().Error())
+	}
+	return nil
+}
+
+// validateKind validates the kind is one of the chaos kinds
+func (in *ChaosTemplateSpec) validateKind(kindField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	kinds := AllKinds()
+	if _, ok := kinds[in.Kind]; !ok {
+		supported := make([]string, 0, len(kinds))
+		for kind := range kinds {
+			supported = append(supported, kind)
+		}
+		sort.Strings(supported)
+		allErrs = append(allErrs, field.NotSupported(kindField, in.Kind, supported))
+	}
+	return allErrs
+}
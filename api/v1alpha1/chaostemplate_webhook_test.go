@@ -0,0 +1,118 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("chaostemplate_webhook", func() {
+	newTemplate := func(template string, params ...ChaosTemplateParameter) *ChaosTemplate {
+		return &ChaosTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-kill"},
+			Spec: ChaosTemplateSpec{
+				Kind:       KindPodChaos,
+				Parameters: params,
+				Template:   runtime.RawExtension{Raw: []byte(template)},
+			},
+		}
+	}
+	podKill := `{"action": "pod-kill", "mode": "${mode}", "selector": {"namespaces": ["${namespace}"]}, "duration": "${duration}"}`
+	params := []ChaosTemplateParameter{
+		{Name: "namespace", Required: true},
+		{Name: "mode", Default: "one"},
+		{Name: "duration", Default: "5m"},
+	}
+
+	Context("Instantiate", func() {
+		It("fill in the parameters", func() {
+			chaos, err := newTemplate(podKill, params...).Instantiate("shop", "kill-web",
+				map[string]string{"namespace": "shop", "duration": "10m"})
+			Expect(err).ToNot(HaveOccurred())
+
+			podChaos := chaos.(*PodChaos)
+			Expect(podChaos.Namespace).To(Equal("shop"))
+			Expect(podChaos.Name).To(Equal("kill-web"))
+			Expect(podChaos.Labels).To(HaveKeyWithValue(LabelChaosTemplate, "pod-kill"))
+			Expect(podChaos.Spec.Action).To(Equal(PodKillAction))
+			Expect(podChaos.Spec.Mode).To(Equal(OnePodMode))
+			Expect(podChaos.Spec.Selector.Namespaces).To(Equal([]string{"shop"}))
+			Expect(*podChaos.Spec.Duration).To(Equal("10m"))
+		})
+
+		It("reject the invalid parameters", func() {
+			tpl := newTemplate(podKill, params...)
+			_, err := tpl.Instantiate("shop", "kill-web", nil)
+			Expect(err).To(HaveOccurred())
+			_, err = tpl.Instantiate("shop", "kill-web", map[string]string{"namespace": "shop", "percent": "50"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Validator", func() {
+		It("Validate", func() {
+			type TestCase struct {
+				name   string
+				tpl    *ChaosTemplate
+				expect string
+			}
+			unknownKind := newTemplate(podKill, params...)
+			unknownKind.Spec.Kind = "DiskChaos"
+			tcs := []TestCase{
+				{
+					name:   "valid template",
+					tpl:    newTemplate(podKill, params...),
+					expect: "",
+				},
+				{
+					name:   "unknown kind",
+					tpl:    unknownKind,
+					expect: "error",
+				},
+				{
+					name:   "undeclared parameter",
+					tpl:    newTemplate(podKill, params[:2]...),
+					expect: "error",
+				},
+				{
+					name:   "duplicate parameter",
+					tpl:    newTemplate(podKill, append(params, ChaosTemplateParameter{Name: "mode"})...),
+					expect: "error",
+				},
+				{
+					name:   "invalid parameter name",
+					tpl:    newTemplate(podKill, append(params, ChaosTemplateParameter{Name: "pod-name"})...),
+					expect: "error",
+				},
+				{
+					name:   "invalid template",
+					tpl:    newTemplate(`{"action": "pod-kill", "selector": "${namespace}"}`, params...),
+					expect: "error",
+				},
+			}
+
+			for _, tc := range tcs {
+				err := tc.tpl.Validate()
+				if len(tc.expect) != 0 {
+					Expect(err).To(HaveOccurred(), tc.name)
+				} else {
+					Expect(err).ToNot(HaveOccurred(), tc.name)
+				}
+			}
+		})
+	})
+})
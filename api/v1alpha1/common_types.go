@@ -14,8 +14,12 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"math"
+	"strconv"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -24,6 +28,11 @@ import (
 const (
 	// PauseAnnotationKey defines the annotation used to pause a chaos
 	PauseAnnotationKey = "experiment.chaos-mesh.org/pause"
+	// CreatorAnnotationKey defines the annotation used to record the user who created a chaos
+	CreatorAnnotationKey = "experiment.chaos-mesh.org/creator"
+	// LabelExperimentUID is the label of the objects created for an experiment, such as its results,
+	// its value is the uid of the experiment
+	LabelExperimentUID = "chaos-mesh.org/experiment-uid"
 )
 
 // SelectorSpec defines the some selectors to select objects.
@@ -31,10 +40,12 @@ const (
 type SelectorSpec struct {
 	// Namespaces is a set of namespace to which objects belong.
 	// +optional
+	// +listType=atomic
 	Namespaces []string `json:"namespaces,omitempty"`
 
 	// Nodes is a set of node name and objects must belong to these nodes.
 	// +optional
+	// +listType=atomic
 	Nodes []string `json:"nodes,omitempty"`
 
 	// Pods is a map of string keys and a set values that used to select pods.
@@ -66,6 +77,7 @@ type SelectorSpec struct {
 
 	// PodPhaseSelectors is a set of condition of a pod at the current time.
 	// supported value: Pending / Running / Succeeded / Failed / Unknown
+	// +listType=atomic
 	PodPhaseSelectors []string `json:"podPhaseSelectors,omitempty"`
 }
 
@@ -80,6 +92,68 @@ type SchedulerSpec struct {
 	//
 	// More rule info: https://godoc.org/github.com/robfig/cron
 	Cron string `json:"cron"`
+
+	// ConcurrencyPolicy specifies how to treat a run which is triggered while the previous one
+	// is still running. Valid values are:
+	// - "Forbid": skips the new run;
+	// - "Allow": keeps the chaos injected and postpones the recovery to the end of the new run;
+	// - "Replace": recovers the running chaos and starts the new run.
+	// If it's omitted, the chaos is handled as "Forbid" and the duration must be shorter than
+	// the scheduling interval.
+	// +optional
+	// +kubebuilder:validation:Enum=Forbid;Allow;Replace
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// StartingDeadlineSeconds is the deadline in seconds for starting a run if it misses
+	// the scheduled time for any reason. Missed runs are recorded in the history and skipped.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// HistoryLimit is the number of the latest run results kept in the status.
+	// Defaults to 10.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	HistoryLimit *int32 `json:"historyLimit,omitempty"`
+}
+
+// ConcurrencyPolicy describes how the scheduled runs will be handled when they overlap.
+type ConcurrencyPolicy string
+
+const (
+	// AllowConcurrent keeps the chaos injected and postpones the recovery to the end of the new run.
+	AllowConcurrent ConcurrencyPolicy = "Allow"
+	// ForbidConcurrent skips the new run if the previous one hasn't finished yet.
+	ForbidConcurrent ConcurrencyPolicy = "Forbid"
+	// ReplaceConcurrent recovers the running chaos and starts the new run.
+	ReplaceConcurrent ConcurrencyPolicy = "Replace"
+)
+
+// DefaultScheduleHistoryLimit is the number of run results kept in the status if HistoryLimit is omitted.
+const DefaultScheduleHistoryLimit = 10
+
+// GetConcurrencyPolicy returns the concurrency policy, ForbidConcurrent if it's omitted.
+func (in *SchedulerSpec) GetConcurrencyPolicy() ConcurrencyPolicy {
+	if in.ConcurrencyPolicy == "" {
+		return ForbidConcurrent
+	}
+	return in.ConcurrencyPolicy
+}
+
+// GetHistoryLimit returns the number of run results should be kept in the status.
+func (in *SchedulerSpec) GetHistoryLimit() int {
+	if in.HistoryLimit == nil {
+		return DefaultScheduleHistoryLimit
+	}
+	return int(*in.HistoryLimit)
+}
+
+// MissedDeadline returns whether the run scheduled at scheduledTime can't be started at now anymore.
+func (in *SchedulerSpec) MissedDeadline(scheduledTime, now time.Time) bool {
+	if in.StartingDeadlineSeconds == nil || scheduledTime.IsZero() {
+		return false
+	}
+	return now.Sub(scheduledTime) > time.Duration(*in.StartingDeadlineSeconds)*time.Second
 }
 
 // PodMode represents the mode to run pod chaos action.
@@ -109,7 +183,14 @@ const (
 	ChaosPhaseAbnormal ChaosPhase = "Abnormal"
 )
 
+// ChaosStatus is the status of chaos, which is only written by the controllers through the status
+// subresource, and the spec of chaos is never written by the controllers.
 type ChaosStatus struct {
+	// ObservedGeneration is the generation of the spec which the status is observed from.
+	// The status is outdated if it's less than the generation of the chaos.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	// Phase is the chaos status.
 	Phase  ChaosPhase `json:"phase"`
 	Reason string     `json:"reason,omitempty"`
@@ -118,6 +199,103 @@ type ChaosStatus struct {
 
 	// Experiment records the last experiment state.
 	Experiment ExperimentStatus `json:"experiment"`
+
+	// Conditions represents the latest observations of the chaos.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []ChaosCondition `json:"conditions,omitempty"`
+}
+
+// ChaosConditionType is the type of a chaos condition.
+type ChaosConditionType string
+
+const (
+	// ConditionRecovering is true while the recovery of the chaos keeps failing.
+	ConditionRecovering ChaosConditionType = "Recovering"
+	// ConditionForceRecovered is true if the chaos was cleaned up forcibly because the recovery timed out.
+	ConditionForceRecovered ChaosConditionType = "ForceRecovered"
+	// ConditionSelected is false if the pods failed to be selected in the last attempt.
+	ConditionSelected ChaosConditionType = "Selected"
+)
+
+// The reasons of the Recovering and ForceRecovered conditions
+const (
+	// RecoveringReasonRecoverFailed means the last recovery failed
+	RecoveringReasonRecoverFailed = "RecoverFailed"
+	// RecoveringReasonRecovered means the chaos is recovered after its recovery failed
+	RecoveringReasonRecovered = "Recovered"
+	// RecoveringReasonRecoveryTimeout means the chaos was cleaned up forcibly because the recovery timed out
+	RecoveringReasonRecoveryTimeout = "RecoveryTimeout"
+)
+
+// The reasons of the Selected condition
+const (
+	// SelectedReasonSelected means the pods are selected
+	SelectedReasonSelected = "Selected"
+	// SelectedReasonNoPodSelected means no pod meets the selector
+	SelectedReasonNoPodSelected = "NoPodSelected"
+	// SelectedReasonNamespaceNotAllowed means all the pods meeting the selector are in the namespaces
+	// not allowed by the namespace policy
+	SelectedReasonNamespaceNotAllowed = "NamespaceNotAllowed"
+	// SelectedReasonInvalidMode means the mode or its value is invalid
+	SelectedReasonInvalidMode = "InvalidMode"
+	// SelectedReasonInvalidSelector means the selector can't be parsed
+	SelectedReasonInvalidSelector = "InvalidSelector"
+	// SelectedReasonAPIError means the pods failed to be listed from the API server
+	SelectedReasonAPIError = "APIError"
+	// SelectedReasonSafeguarded means all the selected pods are skipped by the safeguards of chaos
+	SelectedReasonSafeguarded = "Safeguarded"
+	// SelectedReasonVetoed means the targets are vetoed by the target provider
+	SelectedReasonVetoed = "Vetoed"
+	// SelectedReasonTargetGone means all the pods meeting the selector are being deleted along with
+	// their namespaces or workloads
+	SelectedReasonTargetGone = "TargetGone"
+)
+
+// ChaosCondition describes an observation of the chaos.
+type ChaosCondition struct {
+	Type   ChaosConditionType     `json:"type"`
+	Status corev1.ConditionStatus `json:"status"`
+
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the last time the status of the condition changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// GetCondition returns the condition of the type, nil if it doesn't exist.
+func (in *ChaosStatus) GetCondition(conditionType ChaosConditionType) *ChaosCondition {
+	for i := range in.Conditions {
+		if in.Conditions[i].Type == conditionType {
+			return &in.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetCondition adds or updates the condition of the same type. The last transition time
+// is kept if the status of the condition doesn't change.
+func (in *ChaosStatus) SetCondition(condition ChaosCondition) {
+	if condition.LastTransitionTime.IsZero() {
+		condition.LastTransitionTime = metav1.Now()
+	}
+
+	existing := in.GetCondition(condition.Type)
+	if existing == nil {
+		in.Conditions = append(in.Conditions, condition)
+		return
+	}
+
+	if existing.Status == condition.Status {
+		condition.LastTransitionTime = existing.LastTransitionTime
+	}
+	*existing = condition
 }
 
 // ScheduleStatus is the current status of chaos scheduler.
@@ -129,6 +307,76 @@ type ScheduleStatus struct {
 	// Next time when this action will be recovered
 	// +optional
 	NextRecover *metav1.Time `json:"nextRecover,omitempty"`
+
+	// History records the results of the latest scheduled runs, the oldest first.
+	// +optional
+	// +listType=atomic
+	History []ScheduleRecord `json:"history,omitempty"`
+}
+
+// ScheduleResult is the result of a scheduled run.
+type ScheduleResult string
+
+const (
+	// ScheduleResultRunning means the run has started and not finished yet.
+	ScheduleResultRunning ScheduleResult = "Running"
+	// ScheduleResultSucceeded means the chaos was injected and recovered as scheduled.
+	ScheduleResultSucceeded ScheduleResult = "Succeeded"
+	// ScheduleResultFailed means the chaos failed to be injected.
+	ScheduleResultFailed ScheduleResult = "Failed"
+	// ScheduleResultReplaced means the run was recovered early to start a new run.
+	ScheduleResultReplaced ScheduleResult = "Replaced"
+	// ScheduleResultInterrupted means the run was recovered early because the chaos was paused or deleted.
+	ScheduleResultInterrupted ScheduleResult = "Interrupted"
+	// ScheduleResultSkipped means the run wasn't started because the previous one was still running.
+	ScheduleResultSkipped ScheduleResult = "Skipped"
+	// ScheduleResultMissed means the run wasn't started before the starting deadline.
+	ScheduleResultMissed ScheduleResult = "Missed"
+)
+
+// ScheduleRecord is the record of a scheduled run.
+type ScheduleRecord struct {
+	// ScheduledTime is the time when the run was scheduled to start.
+	ScheduledTime metav1.Time `json:"scheduledTime"`
+
+	// StartTime is the time when the chaos was injected.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is the time when the run finished.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	Result ScheduleResult `json:"result"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// AddRecord appends the record to the history and drops the oldest ones beyond the limit.
+func (in *ScheduleStatus) AddRecord(record ScheduleRecord, limit int) {
+	in.History = append(in.History, record)
+	if limit < 0 {
+		limit = 0
+	}
+	if len(in.History) > limit {
+		in.History = append([]ScheduleRecord(nil), in.History[len(in.History)-limit:]...)
+	}
+	if len(in.History) == 0 {
+		in.History = nil
+	}
+}
+
+// FinishRunning marks all running records finished at the end time with the result.
+func (in *ScheduleStatus) FinishRunning(end time.Time, result ScheduleResult, message string) {
+	for i := range in.History {
+		if in.History[i].Result != ScheduleResultRunning {
+			continue
+		}
+		in.History[i].EndTime = &metav1.Time{Time: end}
+		in.History[i].Result = result
+		in.History[i].Message = message
+	}
 }
 
 // ExperimentPhase is the current status of chaos experiment.
@@ -154,7 +402,281 @@ type ExperimentStatus struct {
 	// +optional
 	Duration string `json:"duration,omitempty"`
 	// +optional
+	// +listType=atomic
 	PodRecords []PodStatus `json:"podRecords,omitempty"`
+	// FailedRecords are the pods which the chaos failed to be applied on in the last attempt.
+	// The pods in PodRecords are skipped when the failed attempt is retried.
+	// +optional
+	// +listType=atomic
+	FailedRecords []FailedPodStatus `json:"failedRecords,omitempty"`
+	// ControlGroup are the selected pods which are left untouched as the control group.
+	// +optional
+	// +listType=atomic
+	ControlGroup []ControlPodStatus `json:"controlGroup,omitempty"`
+	// Selection records the outcomes of selecting the pods in the last attempt.
+	// +optional
+	Selection *SelectionStatus `json:"selection,omitempty"`
+	// SelectionRetry records the retries of the selection while no pod meets the selector.
+	// +optional
+	SelectionRetry *SelectionRetryStatus `json:"selectionRetry,omitempty"`
+	// Impact is the impact of the last attempt estimated before the chaos is injected.
+	// +optional
+	Impact *ImpactStatus `json:"impact,omitempty"`
+	// Simulated is true if the last attempt was simulated, in which the pods were selected
+	// and recorded but the chaos wasn't injected into them.
+	// +optional
+	Simulated bool `json:"simulated,omitempty"`
+	// Stagger records the progress of the injection while the chaos is injected into the pods
+	// one by one by the stagger interval. It's removed once all the pods are injected.
+	// +optional
+	Stagger *StaggerStatus `json:"stagger,omitempty"`
+}
+
+// SLOSpec defines the service level objective which judges the runs of chaos. The indicator is
+// evaluated by Prometheus over the chaos window and the pre-window of the same length before it,
+// and the run fails if the indicator degrades more than the threshold.
+type SLOSpec struct {
+	// Address is the address of Prometheus, such as "http://prometheus.monitoring:9090".
+	// The address configured in the controller manager is used if it's empty.
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// Query is the PromQL query of the service level indicator, which must return a single
+	// value, such as the p99 latency or the error ratio of a service.
+	Query string `json:"query"`
+
+	// Threshold is the max degradation of the indicator in percent, such as "20" or "12.5".
+	Threshold string `json:"threshold"`
+
+	// HigherIsBetter means the degradation is the drop of the indicator, e.g. the success
+	// ratio or the throughput. By default, the degradation is the rise of the indicator.
+	// +optional
+	HigherIsBetter bool `json:"higherIsBetter,omitempty"`
+}
+
+// GetThreshold returns the max degradation of the indicator in percent
+func (in *SLOSpec) GetThreshold() (float64, error) {
+	threshold, err := strconv.ParseFloat(in.Threshold, 64)
+	if err != nil {
+		return 0, err
+	}
+	if threshold < 0 || math.IsNaN(threshold) || math.IsInf(threshold, 0) {
+		return 0, fmt.Errorf("threshold %s must be a non-negative number", in.Threshold)
+	}
+	return threshold, nil
+}
+
+// LoadProtocol is the protocol of the generated load
+type LoadProtocol string
+
+const (
+	// LoadProtocolHTTP sends HTTP requests to the url of the target
+	LoadProtocolHTTP LoadProtocol = "http"
+	// LoadProtocolGRPC calls the gRPC method of the target with an empty request
+	LoadProtocolGRPC LoadProtocol = "grpc"
+)
+
+const (
+	// DefaultLoadTimeout is the default time limit for each request of the load
+	DefaultLoadTimeout = 5 * time.Second
+	// MaxLoadQPS is the max number of requests per second of the load
+	MaxLoadQPS = 1000
+)
+
+// LoadSpec defines the load which is generated against a service during the runs of chaos,
+// so the impact of chaos is measured without depending on the existing traffic. The latency
+// and the errors of the requests are recorded in the ChaosResult of the run.
+type LoadSpec struct {
+	// Protocol is the protocol of the load, it's http by default.
+	// +kubebuilder:validation:Enum=http;grpc
+	// +optional
+	Protocol LoadProtocol `json:"protocol,omitempty"`
+
+	// Target is the url of the service for http, such as "http://checkout.shop:8080/healthz",
+	// or its address for grpc, such as "checkout.shop:9090".
+	Target string `json:"target"`
+
+	// Method is the HTTP method, which is GET by default, or the full name of the gRPC method,
+	// which is "/grpc.health.v1.Health/Check" by default. The other gRPC methods are called
+	// with an empty request.
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// Headers are the headers of the HTTP requests or the metadata of the gRPC calls.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body is the body of the HTTP requests.
+	// +optional
+	Body string `json:"body,omitempty"`
+
+	// QPS is the number of requests sent per second.
+	QPS int `json:"qps"`
+
+	// Duration is the max duration of the load, such as "5m".
+	// The load lasts until the run ends by default.
+	// +optional
+	Duration *string `json:"duration,omitempty"`
+
+	// Timeout is the time limit for each request, such as "1s". It's 5s by default.
+	// +optional
+	Timeout *string `json:"timeout,omitempty"`
+}
+
+// GetProtocol returns the protocol of the load
+func (in *LoadSpec) GetProtocol() LoadProtocol {
+	if in.Protocol == "" {
+		return LoadProtocolHTTP
+	}
+	return in.Protocol
+}
+
+// GetDuration returns the max duration of the load, it's nil if the load lasts until the run ends
+func (in *LoadSpec) GetDuration() (*time.Duration, error) {
+	return parseDurationPtr(in.Duration)
+}
+
+// GetTimeout returns the time limit for each request
+func (in *LoadSpec) GetTimeout() (time.Duration, error) {
+	timeout, err := parseDurationPtr(in.Timeout)
+	if err != nil || timeout == nil {
+		return DefaultLoadTimeout, err
+	}
+	return *timeout, nil
+}
+
+// SelectorRetryPolicy defines how to retry the selection when no pod meets the selector,
+// e.g. the deployment is still rolling out
+type SelectorRetryPolicy struct {
+	// Window is the time limit for retrying the selection, such as "5m".
+	// The experiment fails if no pod is selected within the window.
+	Window string `json:"window"`
+
+	// Backoff is the interval before the first retry, such as "5s", which is doubled after every retry.
+	// default: 5s.
+	// +optional
+	Backoff string `json:"backoff,omitempty"`
+
+	// MaxBackoff is the limit of the interval between the retries, such as "1m".
+	// default: 1m.
+	// +optional
+	MaxBackoff string `json:"maxBackoff,omitempty"`
+}
+
+const (
+	// DefaultSelectorRetryBackoff is the default interval before the first retry of the selection
+	DefaultSelectorRetryBackoff = 5 * time.Second
+	// DefaultSelectorRetryMaxBackoff is the default limit of the interval between the retries of the selection
+	DefaultSelectorRetryMaxBackoff = time.Minute
+)
+
+// GetWindow returns the time limit for retrying the selection
+func (in *SelectorRetryPolicy) GetWindow() (time.Duration, error) {
+	return ParseDuration(in.Window)
+}
+
+// GetBackoff returns the interval before the retry after the given number of retries
+func (in *SelectorRetryPolicy) GetBackoff(retries int) (time.Duration, error) {
+	backoff, err := parseDurationOr(in.Backoff, DefaultSelectorRetryBackoff)
+	if err != nil {
+		return 0, err
+	}
+	maxBackoff, err := parseDurationOr(in.MaxBackoff, DefaultSelectorRetryMaxBackoff)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := 0; i < retries && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff, nil
+}
+
+// SelectionRetryStatus records the retries of the selection when no pod meets the selector
+type SelectionRetryStatus struct {
+	// StartTime is the time of the first failed selection
+	StartTime metav1.Time `json:"startTime"`
+	// Retries is the number of the retries
+	Retries int `json:"retries"`
+}
+
+// StaggerStatus records the progress of the staggered injection
+type StaggerStatus struct {
+	// Pending is the number of the pods which the chaos is going to be injected into
+	Pending int `json:"pending"`
+	// NextTime is the time when the chaos is injected into the next pod
+	NextTime metav1.Time `json:"nextTime"`
+}
+
+// SelectionStatus records how many pods are matched, filtered and finally selected
+type SelectionStatus struct {
+	// Matched is the number of pods matching the selector
+	Matched int `json:"matched"`
+	// FilteredByNamespace is the number of matched pods filtered by the namespace policy
+	FilteredByNamespace int `json:"filteredByNamespace"`
+	// Protected is the number of matched pods protected by ChaosProtections
+	Protected int `json:"protected"`
+	// Selected is the number of pods finally chosen by the mode
+	Selected int `json:"selected"`
+	// Safeguarded is the number of chosen pods skipped by the safeguards of chaos,
+	// which aren't counted in Selected
+	// +optional
+	Safeguarded int `json:"safeguarded,omitempty"`
+	// Gone is the number of matched pods skipped because they're being deleted along with
+	// their namespaces or workloads
+	// +optional
+	Gone int `json:"gone,omitempty"`
+}
+
+// ImpactStatus is the impact of chaos on the selected pods, which is estimated before the chaos is injected
+type ImpactStatus struct {
+	// Pods is the number of the selected pods
+	Pods int `json:"pods"`
+	// Deployments are the deployments which the selected pods belong to
+	// +optional
+	// +listType=atomic
+	Deployments []DeploymentImpact `json:"deployments,omitempty"`
+	// ViolatedDisruptionBudgets are the PodDisruptionBudgets, in the form of "namespace/name",
+	// which would be violated by killing the selected pods. It's only estimated for pod-kill.
+	// +optional
+	// +listType=atomic
+	ViolatedDisruptionBudgets []string `json:"violatedDisruptionBudgets,omitempty"`
+	// SingleEndpointServices are the services, in the form of "namespace/name", whose only
+	// ready endpoint is one of the selected pods
+	// +optional
+	// +listType=atomic
+	SingleEndpointServices []string `json:"singleEndpointServices,omitempty"`
+}
+
+// DeploymentImpact is the part of a deployment which is affected by chaos
+type DeploymentImpact struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Affected is the number of the selected pods of the deployment
+	Affected int `json:"affected"`
+	// Replicas is the desired number of the pods of the deployment
+	Replicas int32 `json:"replicas"`
+	// Percent is the percentage of the replicas which are affected
+	Percent int `json:"percent"`
+}
+
+// FailedPodStatus represents a pod which the chaos failed to be applied on
+type FailedPodStatus struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Error is the reason why the chaos failed to be applied on the pod
+	Error string `json:"error"`
+}
+
+// ControlPodStatus represents a pod in the control group, which the chaos isn't applied on
+type ControlPodStatus struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	HostIP    string `json:"hostIP"`
+	PodIP     string `json:"podIP"`
 }
 
 const (
@@ -186,6 +708,7 @@ type InnerObject interface {
 	IsDeleted() bool
 	IsPaused() bool
 	GetChaos() *ChaosInstance
+	GetRecoveryTimeout() (*time.Duration, error)
 	StatefulObject
 }
 
@@ -211,6 +734,75 @@ type ChaosInstance struct {
 	Status    string
 }
 
+// FailurePolicy describes how to handle the chaos which failed to be applied on some of the pods.
+type FailurePolicy string
+
+const (
+	// AllOrNothingFailure rolls back the chaos from all the pods if it failed to be applied on any of them.
+	AllOrNothingFailure FailurePolicy = "AllOrNothing"
+	// BestEffortFailure keeps the chaos on the pods which it's applied on, and the experiment proceeds
+	// unless it failed to be applied on all the pods.
+	BestEffortFailure FailurePolicy = "BestEffort"
+)
+
+// +kubebuilder:object:generate=false
+
+// FailurePolicyObject defines a common interface for chaos objects which support the failure policy
+type FailurePolicyObject interface {
+	// GetFailurePolicy returns how to handle the chaos which failed to be applied on some of the pods
+	GetFailurePolicy() FailurePolicy
+}
+
+// +kubebuilder:object:generate=false
+
+// ControlGroupObject defines a common interface for chaos objects which support the control group
+type ControlGroupObject interface {
+	// GetControlGroupPercent returns the percentage of the selected pods which are left untouched
+	GetControlGroupPercent() int
+}
+
+// +kubebuilder:object:generate=false
+
+// StaggerObject defines a common interface for chaos objects which spread the injection across the pods
+type StaggerObject interface {
+	// GetStaggerInterval returns the interval between the injections into the selected pods
+	GetStaggerInterval() (*time.Duration, error)
+}
+
+// +kubebuilder:object:generate=false
+
+// SelectorObject defines a common interface for chaos objects which select pods by selectors
+type SelectorObject interface {
+	// GetSelectorSpecs returns all the selectors used to select pods
+	GetSelectorSpecs() []SelectorSpec
+	// GetSelectorRetryPolicy returns how to retry the selection when no pod meets the selectors
+	GetSelectorRetryPolicy() *SelectorRetryPolicy
+}
+
+// +kubebuilder:object:generate=false
+
+// SLOObject defines a common interface for chaos objects which are judged by the service level objective
+type SLOObject interface {
+	// GetSLO returns the service level objective which judges the runs of chaos
+	GetSLO() *SLOSpec
+}
+
+// +kubebuilder:object:generate=false
+
+// SimulationObject defines a common interface for chaos objects which can run without being injected
+type SimulationObject interface {
+	// IsSimulated returns true if the chaos runs without being injected
+	IsSimulated() bool
+}
+
+// +kubebuilder:object:generate=false
+
+// LoadObject defines a common interface for chaos objects which generate load during their runs
+type LoadObject interface {
+	// GetLoad returns the load which is generated against a service during the runs of chaos
+	GetLoad() *LoadSpec
+}
+
 // +kubebuilder:object:generate=false
 
 // ChaosList defines a common interface for chaos lists
@@ -15,7 +15,9 @@ package v1alpha1
 
 import (
 	"fmt"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	cronv3 "github.com/robfig/cron/v3"
@@ -32,6 +34,9 @@ const (
 
 	// ValidateValueParseError defines the error message for value parse error
 	ValidateValueParseError = "parse value field error:%s"
+
+	// ValidateSelectorAllPodsError defines the error message for the selector which selects all pods in the cluster
+	ValidateSelectorAllPodsError = "selector without any restriction is not allowed with mode:%s, at least one namespace or selector should be specified"
 )
 
 // ValidateScheduler validates the InnerSchedulerObject
@@ -48,6 +53,9 @@ func ValidateScheduler(schedulerObject InnerSchedulerObject, spec *field.Path) f
 	}
 
 	scheduler := schedulerObject.GetScheduler()
+	if scheduler != nil {
+		allErrs = append(allErrs, ValidateSchedulerPolicy(scheduler, schedulerField)...)
+	}
 
 	if duration != nil && scheduler != nil {
 		errs := validateSchedulerParams(duration, durationField, scheduler, schedulerField)
@@ -70,7 +78,8 @@ func validateSchedulerParams(duration *time.Duration, durationField *field.Path,
 			allErrs = append(allErrs, err...)
 		}
 
-		if scheduler != nil {
+		// Overlapping runs are handled by the concurrency policy if it's specified explicitly
+		if scheduler != nil && spec.ConcurrencyPolicy == "" {
 			tmpTime := time.Time{}
 			nextTime := scheduler.Next(tmpTime)
 			interval := nextTime.Sub(tmpTime)
@@ -83,6 +92,178 @@ func validateSchedulerParams(duration *time.Duration, durationField *field.Path,
 	return allErrs
 }
 
+// ValidateSchedulerPolicy validates the concurrency policy, starting deadline and history limit of the scheduler
+func ValidateSchedulerPolicy(spec *SchedulerSpec, schedulerField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch spec.ConcurrencyPolicy {
+	case AllowConcurrent, ForbidConcurrent, ReplaceConcurrent, "":
+	default:
+		allErrs = append(allErrs, field.NotSupported(schedulerField.Child("concurrencyPolicy"), spec.ConcurrencyPolicy,
+			[]string{string(AllowConcurrent), string(ForbidConcurrent), string(ReplaceConcurrent)}))
+	}
+
+	if spec.StartingDeadlineSeconds != nil && *spec.StartingDeadlineSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(schedulerField.Child("startingDeadlineSeconds"), *spec.StartingDeadlineSeconds,
+			"must be greater than or equal to 0"))
+	}
+
+	if spec.HistoryLimit != nil && *spec.HistoryLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(schedulerField.Child("historyLimit"), *spec.HistoryLimit,
+			"must be greater than or equal to 0"))
+	}
+	return allErrs
+}
+
+// ValidateSelectorRetryPolicy validates the selector retry policy of the chaos
+func ValidateSelectorRetryPolicy(policy *SelectorRetryPolicy, scheduled bool, spec *field.Path) field.ErrorList {
+	if policy == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	policyField := spec.Child("selectorRetryPolicy")
+	if scheduled {
+		allErrs = append(allErrs, field.Forbidden(policyField, "selectorRetryPolicy can't be used with scheduler"))
+	}
+
+	durations := []struct {
+		name  string
+		value string
+	}{
+		{"window", policy.Window},
+		{"backoff", policy.Backoff},
+		{"maxBackoff", policy.MaxBackoff},
+	}
+	for _, d := range durations {
+		if d.value == "" && d.name != "window" {
+			continue
+		}
+		duration, err := ParseDuration(d.value)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(policyField.Child(d.name), d.value,
+				fmt.Sprintf("parse %s field error:%s", d.name, err)))
+			continue
+		}
+		if duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(policyField.Child(d.name), d.value,
+				fmt.Sprintf("%s must be greater than 0", d.name)))
+		}
+	}
+	return allErrs
+}
+
+// ValidateRecoveryTimeout validates the recovery timeout of the chaos
+func ValidateRecoveryTimeout(chaos InnerObject, spec *field.Path) field.ErrorList {
+	timeout, err := chaos.GetRecoveryTimeout()
+	if err != nil {
+		return field.ErrorList{field.Invalid(spec.Child("recoveryTimeout"), nil,
+			fmt.Sprintf("parse recoveryTimeout field error:%s", err))}
+	}
+	if timeout != nil && *timeout <= 0 {
+		return field.ErrorList{field.Invalid(spec.Child("recoveryTimeout"), timeout.String(),
+			"recoveryTimeout must be greater than 0")}
+	}
+	return nil
+}
+
+// ValidateSLO validates the service level objective of the chaos
+func ValidateSLO(slo *SLOSpec, spec *field.Path) field.ErrorList {
+	if slo == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	sloField := spec.Child("slo")
+	if slo.Query == "" {
+		allErrs = append(allErrs, field.Required(sloField.Child("query"), "query is required"))
+	}
+	if _, err := slo.GetThreshold(); err != nil {
+		allErrs = append(allErrs, field.Invalid(sloField.Child("threshold"), slo.Threshold,
+			fmt.Sprintf("parse threshold field error:%s", err)))
+	}
+	if slo.Address != "" {
+		if u, err := url.Parse(slo.Address); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(sloField.Child("address"), slo.Address,
+				"address must be a http or https url"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateLoad validates the load which is generated during the runs of chaos
+func ValidateLoad(load *LoadSpec, spec *field.Path) field.ErrorList {
+	if load == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	loadField := spec.Child("load")
+	switch load.GetProtocol() {
+	case LoadProtocolHTTP:
+		if u, err := url.Parse(load.Target); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(loadField.Child("target"), load.Target,
+				"target must be a http or https url"))
+		}
+	case LoadProtocolGRPC:
+		if load.Target == "" {
+			allErrs = append(allErrs, field.Required(loadField.Child("target"), "target is required"))
+		}
+		if load.Method != "" && !strings.HasPrefix(load.Method, "/") {
+			allErrs = append(allErrs, field.Invalid(loadField.Child("method"), load.Method,
+				"method must be the full name of the gRPC method, such as /grpc.health.v1.Health/Check"))
+		}
+		if load.Body != "" {
+			allErrs = append(allErrs, field.Forbidden(loadField.Child("body"), "body can't be used with grpc"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(loadField.Child("protocol"), load.Protocol,
+			[]string{string(LoadProtocolHTTP), string(LoadProtocolGRPC)}))
+	}
+	if load.QPS <= 0 || load.QPS > MaxLoadQPS {
+		allErrs = append(allErrs, field.Invalid(loadField.Child("qps"), load.QPS,
+			fmt.Sprintf("qps must be greater than 0 and less than or equal to %d", MaxLoadQPS)))
+	}
+	if duration, err := load.GetDuration(); err != nil {
+		allErrs = append(allErrs, field.Invalid(loadField.Child("duration"), load.Duration,
+			fmt.Sprintf("parse duration field error:%s", err)))
+	} else if duration != nil && *duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(loadField.Child("duration"), duration.String(),
+			"duration must be greater than 0"))
+	}
+	if timeout, err := load.GetTimeout(); err != nil {
+		allErrs = append(allErrs, field.Invalid(loadField.Child("timeout"), load.Timeout,
+			fmt.Sprintf("parse timeout field error:%s", err)))
+	} else if timeout <= 0 {
+		allErrs = append(allErrs, field.Invalid(loadField.Child("timeout"), timeout.String(),
+			"timeout must be greater than 0"))
+	}
+	return allErrs
+}
+
+// ValidateControlGroupPercent validates the control group leaves at least one pod to be treated
+func ValidateControlGroupPercent(percent int, spec *field.Path) field.ErrorList {
+	if percent < 0 || percent >= 100 {
+		return field.ErrorList{field.Invalid(spec.Child("controlGroupPercent"), percent,
+			"controlGroupPercent must be greater than or equal to 0 and less than 100")}
+	}
+	return nil
+}
+
+// ValidateStaggerInterval validates the interval between the injections into the pods
+func ValidateStaggerInterval(chaos StaggerObject, spec *field.Path) field.ErrorList {
+	interval, err := chaos.GetStaggerInterval()
+	if err != nil {
+		return field.ErrorList{field.Invalid(spec.Child("staggerInterval"), nil,
+			fmt.Sprintf("parse staggerInterval field error:%s", err))}
+	}
+	if interval != nil && *interval <= 0 {
+		return field.ErrorList{field.Invalid(spec.Child("staggerInterval"), interval.String(),
+			"staggerInterval must be greater than 0")}
+	}
+	return nil
+}
+
 // ParseCron returns a new crontab schedule representing the given standardSpec (https://en.wikipedia.org/wiki/Cron)
 func ParseCron(standardSpec string, cronField *field.Path) (cronv3.Schedule, field.ErrorList) {
 	allErrs := field.ErrorList{}
@@ -122,6 +303,53 @@ func ValidatePodMode(value string, mode PodMode, valueField *field.Path) field.E
 				fmt.Sprintf("value of %d is invalid, Must be (0,100] with mode:%s",
 					percentage, mode)))
 		}
+
+	case OnePodMode, AllPodMode, "":
+		// value is ignored in these modes
+
+	default:
+		validate, ok := podModeValidators[mode]
+		if !ok {
+			allErrs = append(allErrs, field.Invalid(valueField, value,
+				fmt.Sprintf("mode:%s is not supported", mode)))
+			break
+		}
+		if err := validate(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(valueField, value,
+				fmt.Sprintf("value is invalid with mode:%s: %v", mode, err)))
+		}
+	}
+	return allErrs
+}
+
+// podModeValidators are the validators of the values of the custom modes
+var podModeValidators = map[PodMode]func(value string) error{}
+
+// RegisterPodMode registers the custom mode with the validator of its value, so the webhooks accept
+// the chaos in the mode. The pods are selected in the mode by the selection strategy of the same name.
+func RegisterPodMode(mode PodMode, validate func(value string) error) {
+	podModeValidators[mode] = validate
+}
+
+// ValidateSelector rejects the selector which would select all pods in the cluster with mode all
+func ValidateSelector(selector SelectorSpec, mode PodMode, selectorField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if mode == AllPodMode && selector.isEmpty() {
+		allErrs = append(allErrs, field.Required(selectorField,
+			fmt.Sprintf(ValidateSelectorAllPodsError, mode)))
 	}
 	return allErrs
 }
+
+// isEmpty checks whether the selector has neither namespace restriction nor any other selector
+func (in *SelectorSpec) isEmpty() bool {
+	return len(in.Namespaces) == 0 &&
+		len(in.Nodes) == 0 &&
+		len(in.Pods) == 0 &&
+		len(in.NodeSelectors) == 0 &&
+		len(in.FieldSelectors) == 0 &&
+		len(in.LabelSelectors) == 0 &&
+		len(in.AnnotationSelectors) == 0 &&
+		len(in.PodPhaseSelectors) == 0
+}
@@ -14,10 +14,16 @@
 package v1alpha1
 
 import (
+	"regexp"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
 
+// plainNumberRegexp matches a duration which has no unit
+var plainNumberRegexp = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
 // DefaultNamespace set the namespace of chaos object as the default namespace selector if namespaces not set
 func (in *SelectorSpec) DefaultNamespace(namespace string) {
 	if len(in.Namespaces) == 0 {
@@ -25,6 +31,28 @@ func (in *SelectorSpec) DefaultNamespace(namespace string) {
 	}
 }
 
+// DefaultTargetMode set OnePodMode as the default mode if mode not set
+func (in *Target) DefaultTargetMode() {
+	if in.TargetMode == "" {
+		in.TargetMode = OnePodMode
+	}
+}
+
+// DefaultSchedulerAndDuration normalizes the scheduler and duration of chaos.
+// The spaces around cron and duration are trimmed, and a duration without unit is treated as seconds.
+func DefaultSchedulerAndDuration(scheduler *SchedulerSpec, duration *string) {
+	if scheduler != nil {
+		scheduler.Cron = strings.TrimSpace(scheduler.Cron)
+	}
+
+	if duration != nil {
+		*duration = strings.TrimSpace(*duration)
+		if plainNumberRegexp.MatchString(*duration) {
+			*duration += "s"
+		}
+	}
+}
+
 // +kubebuilder:object:generate=false
 
 // ChaosValidator describes the interface should be implemented in chaos
@@ -36,4 +64,6 @@ type ChaosValidator interface {
 	ValidateScheduler(spec *field.Path) field.ErrorList
 	// ValidatePodMode validates the value with podmode
 	ValidatePodMode(spec *field.Path) field.ErrorList
+	// ValidateSelector validates the selector with podmode
+	ValidateSelector(spec *field.Path) field.ErrorList
 }
@@ -14,9 +14,12 @@
 package v1alpha1
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 var _ = Describe("common_webhook", func() {
@@ -26,5 +29,109 @@ var _ = Describe("common_webhook", func() {
 			selector.DefaultNamespace(metav1.NamespaceDefault)
 			Expect(selector.Namespaces[0]).To(Equal(metav1.NamespaceDefault))
 		})
+
+		It("normalize scheduler and duration", func() {
+			duration := " 30 "
+			scheduler := &SchedulerSpec{Cron: " @every 1m "}
+			DefaultSchedulerAndDuration(scheduler, &duration)
+			Expect(duration).To(Equal("30s"))
+			Expect(scheduler.Cron).To(Equal("@every 1m"))
+
+			duration = "1h"
+			DefaultSchedulerAndDuration(nil, &duration)
+			Expect(duration).To(Equal("1h"))
+		})
+	})
+
+	Context("ValidateSelector", func() {
+		It("reject empty selector with mode all", func() {
+			errs := ValidateSelector(SelectorSpec{}, AllPodMode, field.NewPath("spec").Child("selector"))
+			Expect(errs).To(HaveLen(1))
+		})
+
+		It("accept empty selector with other modes", func() {
+			errs := ValidateSelector(SelectorSpec{}, OnePodMode, field.NewPath("spec").Child("selector"))
+			Expect(errs).To(BeEmpty())
+		})
+
+		It("accept selector with namespace restriction", func() {
+			selector := SelectorSpec{Namespaces: []string{metav1.NamespaceDefault}}
+			errs := ValidateSelector(selector, AllPodMode, field.NewPath("spec").Child("selector"))
+			Expect(errs).To(BeEmpty())
+		})
+	})
+
+	Context("ValidatePodMode", func() {
+		It("reject unsupported mode", func() {
+			errs := ValidatePodMode("1", PodMode("unknown"), field.NewPath("spec").Child("value"))
+			Expect(errs).To(HaveLen(1))
+		})
+	})
+
+	Context("ValidateSchedulerPolicy", func() {
+		It("reject unsupported concurrency policy", func() {
+			scheduler := &SchedulerSpec{Cron: "@every 1m", ConcurrencyPolicy: ConcurrencyPolicy("unknown")}
+			errs := ValidateSchedulerPolicy(scheduler, field.NewPath("spec").Child("scheduler"))
+			Expect(errs).To(HaveLen(1))
+		})
+
+		It("reject negative starting deadline and history limit", func() {
+			deadline := int64(-1)
+			limit := int32(-1)
+			scheduler := &SchedulerSpec{Cron: "@every 1m", StartingDeadlineSeconds: &deadline, HistoryLimit: &limit}
+			errs := ValidateSchedulerPolicy(scheduler, field.NewPath("spec").Child("scheduler"))
+			Expect(errs).To(HaveLen(2))
+		})
+
+		It("allow overlapping runs with explicit concurrency policy", func() {
+			duration := time.Hour
+			scheduler := &SchedulerSpec{Cron: "@every 1m"}
+			errs := validateSchedulerParams(&duration, field.NewPath("spec").Child("duration"), scheduler, field.NewPath("spec").Child("scheduler"))
+			Expect(errs).To(HaveLen(1))
+
+			scheduler.ConcurrencyPolicy = ReplaceConcurrent
+			errs = validateSchedulerParams(&duration, field.NewPath("spec").Child("duration"), scheduler, field.NewPath("spec").Child("scheduler"))
+			Expect(errs).To(BeEmpty())
+		})
+	})
+
+	Context("ScheduleStatus", func() {
+		It("keep the latest records", func() {
+			status := &ScheduleStatus{}
+			for i := 0; i < 3; i++ {
+				status.AddRecord(ScheduleRecord{Result: ScheduleResultSkipped, Message: string(rune('a' + i))}, 2)
+			}
+			Expect(status.History).To(HaveLen(2))
+			Expect(status.History[0].Message).To(Equal("b"))
+			Expect(status.History[1].Message).To(Equal("c"))
+
+			status.AddRecord(ScheduleRecord{Result: ScheduleResultRunning}, 0)
+			Expect(status.History).To(BeEmpty())
+		})
+
+		It("finish running records", func() {
+			status := &ScheduleStatus{}
+			status.AddRecord(ScheduleRecord{Result: ScheduleResultMissed}, DefaultScheduleHistoryLimit)
+			status.AddRecord(ScheduleRecord{Result: ScheduleResultRunning}, DefaultScheduleHistoryLimit)
+
+			now := time.Now()
+			status.FinishRunning(now, ScheduleResultSucceeded, "")
+			Expect(status.History[0].Result).To(Equal(ScheduleResultMissed))
+			Expect(status.History[0].EndTime).To(BeNil())
+			Expect(status.History[1].Result).To(Equal(ScheduleResultSucceeded))
+			Expect(status.History[1].EndTime.Time).To(Equal(now))
+		})
+
+		It("check the starting deadline", func() {
+			deadline := int64(60)
+			scheduler := &SchedulerSpec{Cron: "@every 10m"}
+			now := time.Now()
+			Expect(scheduler.MissedDeadline(now.Add(-time.Hour), now)).To(BeFalse())
+
+			scheduler.StartingDeadlineSeconds = &deadline
+			Expect(scheduler.MissedDeadline(now.Add(-time.Hour), now)).To(BeTrue())
+			Expect(scheduler.MissedDeadline(now.Add(-time.Second), now)).To(BeFalse())
+			Expect(scheduler.MissedDeadline(time.Time{}, now)).To(BeFalse())
+		})
 	})
 })
@@ -0,0 +1,458 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KindDBChaos is the kind for database chaos
+const KindDBChaos = "DBChaos"
+
+func init() {
+	all.register(KindDBChaos, &ChaosKind{
+		Chaos:     &DBChaos{},
+		ChaosList: &DBChaosList{},
+	})
+}
+
+// DBChaosAction represents the chaos action about the queries to databases.
+type DBChaosAction string
+
+const (
+	// DBQueryDelayAction represents the chaos action of delaying the matched queries.
+	DBQueryDelayAction DBChaosAction = "query-delay"
+
+	// DBQueryErrorAction represents the chaos action of rejecting the matched queries with an error.
+	DBQueryErrorAction DBChaosAction = "query-error"
+
+	// DBConnectionKillAction represents the chaos action of killing the connections which send the matched queries.
+	DBConnectionKillAction DBChaosAction = "connection-kill"
+
+	// DBFailoverAction represents the chaos action of forcing the failover of the selected database pods.
+	DBFailoverAction DBChaosAction = "failover"
+
+	// DBPartialReadAction represents the chaos action of cutting the objects read by the matched requests of S3 in half.
+	DBPartialReadAction DBChaosAction = "partial-read"
+)
+
+// DBProtocol is the wire protocol of the database.
+type DBProtocol string
+
+const (
+	// DBProtocolMySQL is the protocol of MySQL and the compatible databases, such as MariaDB and TiDB.
+	DBProtocolMySQL DBProtocol = "mysql"
+
+	// DBProtocolPostgres is the protocol of PostgreSQL and the compatible databases.
+	DBProtocolPostgres DBProtocol = "postgres"
+
+	// DBProtocolRedis is the protocol of Redis (RESP) and the compatible databases.
+	DBProtocolRedis DBProtocol = "redis"
+
+	// DBProtocolS3 is the HTTP/1.1 API of S3 and the compatible object storages, such as MinIO and Ceph.
+	DBProtocolS3 DBProtocol = "s3"
+)
+
+// DBFailoverMode is the way the failover of Redis is forced.
+type DBFailoverMode string
+
+const (
+	// DBFailoverClusterMode forces the failover of a node of Redis Cluster by CLUSTER FAILOVER.
+	DBFailoverClusterMode DBFailoverMode = "cluster"
+
+	// DBFailoverSentinelMode forces the failover of a master monitored by Redis Sentinel by SENTINEL FAILOVER.
+	DBFailoverSentinelMode DBFailoverMode = "sentinel"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// DBChaos is the Schema for the dbchaos API
+type DBChaos struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the behavior of a database chaos experiment
+	Spec DBChaosSpec `json:"spec"`
+
+	// +optional
+	// Most recently observed status of the database chaos experiment
+	Status DBChaosStatus `json:"status"`
+}
+
+// DBChaosSpec defines the desired state of DBChaos.
+// The connections from the selected pods to the database are redirected to a proxy in the network namespace
+// of the pods, which parses the queries and injects the faults, so the database itself is untouched.
+// The failover action is the exception, which selects the pods of the database and fails them over once.
+type DBChaosSpec struct {
+	// Action defines the specific database chaos action.
+	// Supported action: query-delay / query-error / connection-kill / failover / partial-read
+	// +kubebuilder:validation:Enum=query-delay;query-error;connection-kill;failover;partial-read
+	Action DBChaosAction `json:"action"`
+
+	// Mode defines the mode to run chaos action.
+	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
+	Mode PodMode `json:"mode"`
+
+	// Value is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
+	// If `FixedPodMode`, provide an integer of pods to do chaos action.
+	// If `FixedPercentPodMod`, provide a number from 0-100 to specify the percent of pods the server can do chaos action.
+	// If `RandomMaxPercentPodMod`,  provide a number from 0-100 to specify the max percent of pods to do chaos action
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Selector is used to select the client pods of the database that are used to inject chaos action.
+	// The pods of the database are selected by the failover action instead.
+	Selector SelectorSpec `json:"selector"`
+
+	// Protocol is the wire protocol of the database.
+	// The connections encrypted by TLS or compressed are relayed without faults.
+	// The failover action only supports redis, and the partial-read action only supports s3.
+	// +kubebuilder:validation:Enum=mysql;postgres;redis;s3
+	Protocol DBProtocol `json:"protocol"`
+
+	// Target is the database which the selected pods connect to.
+	// In the failover action, the port is the one which Redis or Sentinel listens on in the selected pods.
+	Target DBTarget `json:"target"`
+
+	// Query is the regular expression of the affected queries, such as "^(?i)update orders".
+	// The prepared statements are matched by the statements which they're prepared from.
+	// The commands of redis are matched by their arguments joined by spaces, such as "^(?i)set session:".
+	// The requests of s3 are matched by their methods and resources in the path style, such as "^GET /backups/",
+	// and the buckets of the virtual-hosted style requests are moved into the paths.
+	// If it's omitted, all queries are affected.
+	// +optional
+	Query string `json:"query,omitempty"`
+
+	// Delay is the latency added to the matched queries before they're sent to the database, such as "500ms".
+	// It's required by the query-delay action.
+	// +optional
+	Delay string `json:"delay,omitempty"`
+
+	// Error is the error which the matched queries are rejected with in the query-error action.
+	// +optional
+	Error *DBErrorSpec `json:"error,omitempty"`
+
+	// Percent is the percentage of the matched queries which are affected.
+	// If it's omitted, all the matched queries are affected.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percent int32 `json:"percent,omitempty"`
+
+	// InTransaction only affects the queries sent in a transaction, so the retries of the transactions
+	// can be validated, e.g. the connection is killed in the middle of a transaction.
+	// The transactions of redis are the commands between MULTI and EXEC.
+	// +optional
+	InTransaction bool `json:"inTransaction,omitempty"`
+
+	// Failover is the failover forced by the failover action.
+	// +optional
+	Failover *DBFailoverSpec `json:"failover,omitempty"`
+
+	// Duration represents the duration of the chaos action
+	Duration *string `json:"duration,omitempty"`
+
+	// RecoveryTimeout is the time limit for recovering the chaos when it's deleted, such as "5m".
+	// If the recovery isn't completed in time, the chaos is cleaned up forcibly.
+	// +optional
+	RecoveryTimeout *string `json:"recoveryTimeout,omitempty"`
+
+	// Simulate runs the chaos without injecting it. The targets are selected, and the status, the events
+	// and the metrics are recorded as usual, but chaos-daemon isn't called and the targets are left untouched.
+	// +optional
+	Simulate bool `json:"simulate,omitempty"`
+
+	// SLO is the service level objective which judges the runs of the chaos by a Prometheus query.
+	// +optional
+	SLO *SLOSpec `json:"slo,omitempty"`
+
+	// Load is the load which is generated against a service during the runs of the chaos.
+	// +optional
+	Load *LoadSpec `json:"load,omitempty"`
+
+	// SelectorRetryPolicy defines how to retry the selection when no pod meets the selector.
+	// It only applies to the chaos without scheduler, since the scheduled chaos selects again in the next run.
+	// +optional
+	SelectorRetryPolicy *SelectorRetryPolicy `json:"selectorRetryPolicy,omitempty"`
+
+	// FailurePolicy specifies how to handle the chaos which failed to be applied on some of the pods.
+	// Valid values are:
+	// - "AllOrNothing": rolls back the chaos from all the pods, and the experiment fails;
+	// - "BestEffort": keeps the chaos on the pods which it's applied on, and the experiment proceeds
+	// unless it failed to be applied on all the pods.
+	// If it's omitted, the experiment fails and only the failed pods are applied when it's retried.
+	// The errors of the failed pods are recorded in the status.
+	// +optional
+	// +kubebuilder:validation:Enum=AllOrNothing;BestEffort;""
+	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// ControlGroupPercent is the percentage of the selected pods which are left untouched as the control group,
+	// so the treated pods can be compared with the untreated ones.
+	// The control group is recorded in the status, and at least one pod is treated.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=99
+	ControlGroupPercent int `json:"controlGroupPercent,omitempty"`
+
+	// StaggerInterval spreads the injection across the selected pods over time, e.g. "30s" injects
+	// the chaos into one pod every 30 seconds instead of all the pods at once. The duration starts
+	// when the first pod is injected, and the pods not injected yet are skipped once it's recovered.
+	// +optional
+	StaggerInterval *string `json:"staggerInterval,omitempty"`
+
+	// Scheduler defines some schedule rules to control the running time of the chaos experiment about database.
+	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
+}
+
+// DBTarget is the database which the pods connect to
+type DBTarget struct {
+	// Addresses are the IPv4 addresses or cidrs of the database.
+	// +optional
+	// +listType=atomic
+	Addresses []string `json:"addresses,omitempty"`
+
+	// Service is the Service of the database, which is resolved into its cluster IP and the addresses of its endpoints.
+	// If neither the addresses nor the service is specified, the connections to the port on all addresses are affected.
+	// +optional
+	Service *ServiceReference `json:"service,omitempty"`
+
+	// Port is the port which the pods connect to the database on.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
+
+// DBErrorSpec is the error replied to the rejected queries
+type DBErrorSpec struct {
+	// Code is the error code of MySQL, which defaults to 1105 (ER_UNKNOWN_ERROR).
+	// It's the HTTP status of s3, which defaults to 503, and it's ignored by the postgres and redis protocols.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Code int32 `json:"code,omitempty"`
+
+	// SQLState is the 5-character SQLSTATE of the error, such as "40001" of the serialization failure.
+	// It defaults to "HY000" for mysql and "XX000" for postgres, and it's ignored by redis and s3.
+	// +optional
+	SQLState string `json:"sqlState,omitempty"`
+
+	// Message is the message of the error.
+	// The message of redis is the whole error line starting with the error prefix, such as
+	// "OOM command not allowed when used memory > 'maxmemory'." or "LOADING Redis is loading the dataset in memory".
+	// The message of s3 is the error code of S3, which defaults to "SlowDown".
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// DBFailoverSpec is the failover forced on the selected pods of Redis
+type DBFailoverSpec struct {
+	// Mode is the way the failover is forced.
+	// In the cluster mode, a selected replica is promoted, or a replica of a selected master is promoted.
+	// In the sentinel mode, the selected sentinels fail over the master.
+	// +kubebuilder:validation:Enum=cluster;sentinel
+	Mode DBFailoverMode `json:"mode"`
+
+	// MasterName is the name of the master monitored by the sentinels, it's required by the sentinel mode.
+	// +optional
+	MasterName string `json:"masterName,omitempty"`
+
+	// Force fails over the master of Redis Cluster without the agreement of the master,
+	// e.g. the master is unreachable.
+	// +optional
+	Force bool `json:"force,omitempty"`
+
+	// PasswordSecret is the key of the secret in the namespace of the chaos which holds the password of Redis.
+	// +optional
+	PasswordSecret *corev1.SecretKeySelector `json:"passwordSecret,omitempty"`
+}
+
+// GetSelector is a getter for Selector (for implementing SelectSpec)
+func (in *DBChaosSpec) GetSelector() SelectorSpec {
+	return in.Selector
+}
+
+// GetMode is a getter for Mode (for implementing SelectSpec)
+func (in *DBChaosSpec) GetMode() PodMode {
+	return in.Mode
+}
+
+// GetValue is a getter for Value (for implementing SelectSpec)
+func (in *DBChaosSpec) GetValue() string {
+	return in.Value
+}
+
+// DBChaosStatus defines the observed state of DBChaos
+type DBChaosStatus struct {
+	ChaosStatus `json:",inline"`
+}
+
+// GetDuration gets the duration of DBChaos
+func (in *DBChaos) GetDuration() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.Duration)
+}
+
+// GetRecoveryTimeout would return the recovery timeout for chaos
+func (in *DBChaos) GetRecoveryTimeout() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.RecoveryTimeout)
+}
+
+// GetSLO returns the service level objective which judges the runs of chaos
+func (in *DBChaos) GetSLO() *SLOSpec {
+	return in.Spec.SLO
+}
+
+// IsSimulated returns true if the chaos runs without being injected
+func (in *DBChaos) IsSimulated() bool {
+	return in.Spec.Simulate
+}
+
+// GetLoad returns the load which is generated against a service during the runs of chaos
+func (in *DBChaos) GetLoad() *LoadSpec {
+	return in.Spec.Load
+}
+
+// GetNextStart gets NextStart field of DBChaos
+func (in *DBChaos) GetNextStart() time.Time {
+	if in.Status.Scheduler.NextStart == nil {
+		return time.Time{}
+	}
+	return in.Status.Scheduler.NextStart.Time
+}
+
+// SetNextStart sets NextStart field of DBChaos
+func (in *DBChaos) SetNextStart(t time.Time) {
+	if t.IsZero() {
+		in.Status.Scheduler.NextStart = nil
+		return
+	}
+
+	if in.Status.Scheduler.NextStart == nil {
+		in.Status.Scheduler.NextStart = &metav1.Time{}
+	}
+	in.Status.Scheduler.NextStart.Time = t
+}
+
+// GetNextRecover get NextRecover field of DBChaos
+func (in *DBChaos) GetNextRecover() time.Time {
+	if in.Status.Scheduler.NextRecover == nil {
+		return time.Time{}
+	}
+	return in.Status.Scheduler.NextRecover.Time
+}
+
+// SetNextRecover sets NextRecover field of DBChaos
+func (in *DBChaos) SetNextRecover(t time.Time) {
+	if t.IsZero() {
+		in.Status.Scheduler.NextRecover = nil
+		return
+	}
+
+	if in.Status.Scheduler.NextRecover == nil {
+		in.Status.Scheduler.NextRecover = &metav1.Time{}
+	}
+	in.Status.Scheduler.NextRecover.Time = t
+}
+
+// GetScheduler returns the scheduler of DBChaos
+func (in *DBChaos) GetScheduler() *SchedulerSpec {
+	return in.Spec.Scheduler
+}
+
+// GetStatus returns the status of DBChaos
+func (in *DBChaos) GetStatus() *ChaosStatus {
+	return &in.Status.ChaosStatus
+}
+
+// IsDeleted returns whether this resource has been deleted
+func (in *DBChaos) IsDeleted() bool {
+	return !in.DeletionTimestamp.IsZero()
+}
+
+// IsPaused returns whether this resource has been paused
+func (in *DBChaos) IsPaused() bool {
+	if in.Annotations == nil || in.Annotations[PauseAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
+// GetSelectorSpecs returns the selectors of chaos
+func (in *DBChaos) GetSelectorSpecs() []SelectorSpec {
+	return []SelectorSpec{in.Spec.Selector}
+}
+
+// GetSelectorRetryPolicy returns how to retry the selection when no pod meets the selectors
+func (in *DBChaos) GetSelectorRetryPolicy() *SelectorRetryPolicy {
+	return in.Spec.SelectorRetryPolicy
+}
+
+// GetFailurePolicy returns how to handle the chaos which failed to be applied on some of the pods
+func (in *DBChaos) GetFailurePolicy() FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetControlGroupPercent returns the percentage of the selected pods which are left untouched
+func (in *DBChaos) GetControlGroupPercent() int {
+	return in.Spec.ControlGroupPercent
+}
+
+// GetStaggerInterval returns the interval between the injections into the selected pods
+func (in *DBChaos) GetStaggerInterval() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.StaggerInterval)
+}
+
+// GetChaos returns a chaos instance
+func (in *DBChaos) GetChaos() *ChaosInstance {
+	instance := &ChaosInstance{
+		Name:      in.Name,
+		Namespace: in.Namespace,
+		Kind:      KindDBChaos,
+		StartTime: in.CreationTimestamp.Time,
+		Action:    string(in.Spec.Action),
+		Status:    string(in.GetStatus().Experiment.Phase),
+	}
+	if in.Spec.Duration != nil {
+		instance.Duration = *in.Spec.Duration
+	}
+	if in.DeletionTimestamp != nil {
+		instance.EndTime = in.DeletionTimestamp.Time
+	}
+	return instance
+}
+
+// +kubebuilder:object:root=true
+
+// DBChaosList contains a list of DBChaos
+type DBChaosList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBChaos `json:"items"`
+}
+
+// ListChaos returns a list of database chaos
+func (in *DBChaosList) ListChaos() []*ChaosInstance {
+	res := make([]*ChaosInstance, 0, len(in.Items))
+	for _, item := range in.Items {
+		res = append(res, item.GetChaos())
+	}
+	return res
+}
+
+func init() {
+	SchemeBuilder.Register(&DBChaos{}, &DBChaosList{})
+}
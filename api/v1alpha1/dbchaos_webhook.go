@@ -0,0 +1,256 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var dbchaoslog = logf.Log.WithName("dbchaos-resource")
+
+// SetupWebhookWithManager setup DBChaos's webhook with manager
+func (in *DBChaos) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-chaos-mesh-org-v1alpha1-dbchaos,mutating=true,failurePolicy=fail,groups=chaos-mesh.org,resources=dbchaos,verbs=create;update,versions=v1alpha1,name=mdbchaos.kb.io
+
+var _ webhook.Defaulter = &DBChaos{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (in *DBChaos) Default() {
+	dbchaoslog.Info("default", "name", in.Name)
+
+	in.Spec.Selector.DefaultNamespace(in.GetNamespace())
+	DefaultSchedulerAndDuration(in.Spec.Scheduler, in.Spec.Duration)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-chaos-mesh-org-v1alpha1-dbchaos,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=dbchaos,versions=v1alpha1,name=vdbchaos.kb.io
+
+var _ ChaosValidator = &DBChaos{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *DBChaos) ValidateCreate() error {
+	dbchaoslog.Info("validate create", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *DBChaos) ValidateUpdate(old runtime.Object) error {
+	dbchaoslog.Info("validate update", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *DBChaos) ValidateDelete() error {
+	dbchaoslog.Info("validate delete", "name", in.Name)
+
+	// Nothing to do?
+	return nil
+}
+
+// Validate validates chaos object
+func (in *DBChaos) Validate() error {
+	specField := field.NewPath("spec")
+	allErrs := in.ValidateScheduler(specField)
+	allErrs = append(allErrs, ValidateRecoveryTimeout(in, specField)...)
+	allErrs = append(allErrs, ValidateSLO(in.Spec.SLO, specField)...)
+	allErrs = append(allErrs, ValidateLoad(in.Spec.Load, specField)...)
+	allErrs = append(allErrs, ValidateSelectorRetryPolicy(in.Spec.SelectorRetryPolicy, in.Spec.Scheduler != nil, specField)...)
+	allErrs = append(allErrs, in.ValidatePodMode(specField)...)
+	allErrs = append(allErrs, in.ValidateSelector(specField)...)
+	allErrs = append(allErrs, in.Spec.validateFault(specField)...)
+	allErrs = append(allErrs, in.Spec.Target.validateTarget(specField.Child("target"))...)
+	allErrs = append(allErrs, ValidateControlGroupPercent(in.Spec.ControlGroupPercent, specField)...)
+	allErrs = append(allErrs, ValidateStaggerInterval(in, specField)...)
+
+	if len(allErrs) > 0 {
+		return fmt.Errorf(allErrs.ToAggregate().Error())
+	}
+	return nil
+}
+
+// ValidateScheduler validates the scheduler and duration
+func (in *DBChaos) ValidateScheduler(spec *field.Path) field.ErrorList {
+	return ValidateScheduler(in, spec)
+}
+
+// ValidatePodMode validates the value with podmode
+func (in *DBChaos) ValidatePodMode(spec *field.Path) field.ErrorList {
+	return ValidatePodMode(in.Spec.Value, in.Spec.Mode, spec.Child("value"))
+}
+
+// ValidateSelector validates the selector with podmode
+func (in *DBChaos) ValidateSelector(spec *field.Path) field.ErrorList {
+	return ValidateSelector(in.Spec.Selector, in.Spec.Mode, spec.Child("selector"))
+}
+
+// validateFault validates the fields of the fault with the action
+func (in *DBChaosSpec) validateFault(spec *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if in.Query != "" {
+		if _, err := regexp.Compile(in.Query); err != nil {
+			allErrs = append(allErrs, field.Invalid(spec.Child("query"), in.Query,
+				fmt.Sprintf("parse query field error:%s", err)))
+		}
+	}
+
+	if in.Action == DBQueryDelayAction {
+		delay, err := time.ParseDuration(in.Delay)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(spec.Child("delay"), in.Delay,
+				fmt.Sprintf("parse delay field error:%s", err)))
+		} else if delay <= 0 {
+			allErrs = append(allErrs, field.Invalid(spec.Child("delay"), in.Delay,
+				"delay should be positive"))
+		}
+	} else if in.Delay != "" {
+		allErrs = append(allErrs, field.Invalid(spec.Child("delay"), in.Delay,
+			fmt.Sprintf("delay can only be used with action:%s", DBQueryDelayAction)))
+	}
+
+	if in.Error != nil {
+		if in.Action != DBQueryErrorAction {
+			allErrs = append(allErrs, field.Invalid(spec.Child("error"), in.Error,
+				fmt.Sprintf("error can only be used with action:%s", DBQueryErrorAction)))
+		}
+		if in.Protocol == DBProtocolS3 {
+			if in.Error.Code != 0 && (in.Error.Code < 400 || in.Error.Code > 599) {
+				allErrs = append(allErrs, field.Invalid(spec.Child("error", "code"), in.Error.Code,
+					"the code of s3 must be an HTTP status between 400 and 599"))
+			}
+		} else if in.Error.Code < 0 || in.Error.Code > 65535 {
+			allErrs = append(allErrs, field.Invalid(spec.Child("error", "code"), in.Error.Code,
+				"the code must be between 0 and 65535"))
+		}
+		if in.Error.SQLState != "" && len(in.Error.SQLState) != 5 {
+			allErrs = append(allErrs, field.Invalid(spec.Child("error", "sqlState"), in.Error.SQLState,
+				"the sqlState must have 5 characters"))
+		}
+		if in.Protocol == DBProtocolRedis && strings.ContainsAny(in.Error.Message, "\r\n") {
+			allErrs = append(allErrs, field.Invalid(spec.Child("error", "message"), in.Error.Message,
+				"the message of redis must be a single line"))
+		}
+		if in.Protocol == DBProtocolS3 && in.Error.SQLState != "" {
+			allErrs = append(allErrs, field.Invalid(spec.Child("error", "sqlState"), in.Error.SQLState,
+				"the sqlState can't be used with protocol:s3"))
+		}
+	}
+
+	if in.Percent < 0 || in.Percent > 100 {
+		allErrs = append(allErrs, field.Invalid(spec.Child("percent"), in.Percent,
+			"the percent must be between 0 and 100"))
+	}
+
+	if in.Action == DBPartialReadAction && in.Protocol != DBProtocolS3 {
+		allErrs = append(allErrs, field.Invalid(spec.Child("protocol"), in.Protocol,
+			fmt.Sprintf("action:%s only supports protocol:%s", DBPartialReadAction, DBProtocolS3)))
+	}
+	// the requests of s3 aren't in transactions
+	if in.Protocol == DBProtocolS3 && in.InTransaction {
+		allErrs = append(allErrs, field.Invalid(spec.Child("inTransaction"), in.InTransaction,
+			"inTransaction can't be used with protocol:s3"))
+	}
+
+	allErrs = append(allErrs, in.validateFailover(spec)...)
+
+	return allErrs
+}
+
+// validateFailover validates the failover, which is forced on the pods of the database instead of
+// the queries of the clients
+func (in *DBChaosSpec) validateFailover(spec *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if in.Action != DBFailoverAction {
+		if in.Failover != nil {
+			allErrs = append(allErrs, field.Invalid(spec.Child("failover"), in.Failover,
+				fmt.Sprintf("failover can only be used with action:%s", DBFailoverAction)))
+		}
+		return allErrs
+	}
+
+	if in.Protocol != DBProtocolRedis {
+		allErrs = append(allErrs, field.Invalid(spec.Child("protocol"), in.Protocol,
+			fmt.Sprintf("action:%s only supports protocol:%s", DBFailoverAction, DBProtocolRedis)))
+	}
+	if in.Query != "" || in.Percent != 0 || in.InTransaction {
+		allErrs = append(allErrs, field.Invalid(spec.Child("query"), in.Query,
+			fmt.Sprintf("query, percent and inTransaction can't be used with action:%s", DBFailoverAction)))
+	}
+	if len(in.Target.Addresses) > 0 || in.Target.Service != nil {
+		allErrs = append(allErrs, field.Invalid(spec.Child("target"), in.Target,
+			fmt.Sprintf("only the port of target can be used with action:%s", DBFailoverAction)))
+	}
+
+	failoverField := spec.Child("failover")
+	if in.Failover == nil {
+		return append(allErrs, field.Required(failoverField,
+			fmt.Sprintf("failover is required by action:%s", DBFailoverAction)))
+	}
+	switch in.Failover.Mode {
+	case DBFailoverClusterMode:
+	case DBFailoverSentinelMode:
+		if in.Failover.MasterName == "" {
+			allErrs = append(allErrs, field.Required(failoverField.Child("masterName"),
+				fmt.Sprintf("masterName is required by mode:%s", DBFailoverSentinelMode)))
+		}
+	default:
+		allErrs = append(allErrs, field.Invalid(failoverField.Child("mode"), in.Failover.Mode,
+			"the mode must be cluster or sentinel"))
+	}
+	allErrs = append(allErrs, validateSecretKeySelector(failoverField.Child("passwordSecret"), in.Failover.PasswordSecret)...)
+
+	return allErrs
+}
+
+// validateTarget validates the database which the pods connect to
+func (in *DBTarget) validateTarget(target *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if in.Port <= 0 || in.Port > 65535 {
+		allErrs = append(allErrs, field.Invalid(target.Child("port"), in.Port,
+			"the port must be between 1 and 65535"))
+	}
+	for i, address := range in.Addresses {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			ip, _, _ = net.ParseCIDR(address)
+		}
+		if ip == nil || ip.To4() == nil {
+			allErrs = append(allErrs, field.Invalid(target.Child("addresses").Index(i), address,
+				"the address must be an IPv4 address or cidr"))
+		}
+	}
+	if in.Service != nil && in.Service.Name == "" {
+		allErrs = append(allErrs, field.Invalid(target.Child("service", "name"), in.Service.Name,
+			"the name of the service is required"))
+	}
+
+	return allErrs
+}
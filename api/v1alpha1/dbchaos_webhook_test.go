@@ -0,0 +1,396 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("dbchaos_webhook", func() {
+	Context("Defaulter", func() {
+		It("set default namespace selector", func() {
+			dbchaos := &DBChaos{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault},
+			}
+			dbchaos.Default()
+			Expect(dbchaos.Spec.Selector.Namespaces[0]).To(Equal(metav1.NamespaceDefault))
+		})
+	})
+	Context("ChaosValidator of dbchaos", func() {
+		It("Validate", func() {
+
+			type TestCase struct {
+				name    string
+				chaos   DBChaos
+				execute func(chaos *DBChaos) error
+				expect  string
+			}
+			tcs := []TestCase{
+				{
+					name: "simple ValidateCreate",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo1",
+						},
+						Spec: DBChaosSpec{
+							Action: DBQueryDelayAction,
+							Target: DBTarget{Port: 3306},
+							Delay:  "500ms",
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "simple ValidateUpdate",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo2",
+						},
+						Spec: DBChaosSpec{
+							Action: DBConnectionKillAction,
+							Target: DBTarget{Port: 5432, Addresses: []string{"10.0.0.1", "10.1.0.0/16"}},
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateUpdate(chaos)
+					},
+					expect: "",
+				},
+				{
+					name: "simple ValidateDelete",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo3",
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateDelete()
+					},
+					expect: "",
+				},
+				{
+					name: "validate the delay of query-delay",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo4",
+						},
+						Spec: DBChaosSpec{
+							Action: DBQueryDelayAction,
+							Target: DBTarget{Port: 3306},
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the query",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo5",
+						},
+						Spec: DBChaosSpec{
+							Action: DBQueryErrorAction,
+							Target: DBTarget{Port: 3306},
+							Query:  "^(select",
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the sqlState",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo6",
+						},
+						Spec: DBChaosSpec{
+							Action: DBQueryErrorAction,
+							Target: DBTarget{Port: 5432},
+							Error:  &DBErrorSpec{SQLState: "4001"},
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the error with the action",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: DBChaosSpec{
+							Action: DBConnectionKillAction,
+							Target: DBTarget{Port: 5432},
+							Error:  &DBErrorSpec{SQLState: "40001"},
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the port",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: DBChaosSpec{
+							Action: DBConnectionKillAction,
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the addresses",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: DBChaosSpec{
+							Action: DBConnectionKillAction,
+							Target: DBTarget{Port: 3306, Addresses: []string{"mysql.default"}},
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the error of redis",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo10",
+						},
+						Spec: DBChaosSpec{
+							Action:   DBQueryErrorAction,
+							Protocol: DBProtocolRedis,
+							Target:   DBTarget{Port: 6379},
+							Error:    &DBErrorSpec{Message: "OOM command not allowed\r\n+OK"},
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "simple failover",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo11",
+						},
+						Spec: DBChaosSpec{
+							Action:   DBFailoverAction,
+							Protocol: DBProtocolRedis,
+							Target:   DBTarget{Port: 26379},
+							Failover: &DBFailoverSpec{Mode: DBFailoverSentinelMode, MasterName: "mymaster"},
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "validate the master name of sentinel",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo12",
+						},
+						Spec: DBChaosSpec{
+							Action:   DBFailoverAction,
+							Protocol: DBProtocolRedis,
+							Target:   DBTarget{Port: 26379},
+							Failover: &DBFailoverSpec{Mode: DBFailoverSentinelMode},
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the protocol of failover",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: DBChaosSpec{
+							Action:   DBFailoverAction,
+							Protocol: DBProtocolMySQL,
+							Target:   DBTarget{Port: 3306},
+							Failover: &DBFailoverSpec{Mode: DBFailoverClusterMode},
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the failover with the action",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo14",
+						},
+						Spec: DBChaosSpec{
+							Action:   DBConnectionKillAction,
+							Protocol: DBProtocolRedis,
+							Target:   DBTarget{Port: 6379},
+							Failover: &DBFailoverSpec{Mode: DBFailoverClusterMode},
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "simple s3 slowdown",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo15",
+						},
+						Spec: DBChaosSpec{
+							Action:   DBQueryErrorAction,
+							Protocol: DBProtocolS3,
+							Target:   DBTarget{Port: 9000},
+							Query:    "^PUT /backups/",
+							Error:    &DBErrorSpec{Code: 503, Message: "SlowDown"},
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "validate the http status of s3",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo16",
+						},
+						Spec: DBChaosSpec{
+							Action:   DBQueryErrorAction,
+							Protocol: DBProtocolS3,
+							Target:   DBTarget{Port: 9000},
+							Error:    &DBErrorSpec{Code: 1105},
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "simple partial read",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo17",
+						},
+						Spec: DBChaosSpec{
+							Action:   DBPartialReadAction,
+							Protocol: DBProtocolS3,
+							Target:   DBTarget{Port: 9000},
+							Query:    "^GET /backups/",
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "validate the protocol of partial read",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo18",
+						},
+						Spec: DBChaosSpec{
+							Action:   DBPartialReadAction,
+							Protocol: DBProtocolMySQL,
+							Target:   DBTarget{Port: 3306},
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the transaction of s3",
+					chaos: DBChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo19",
+						},
+						Spec: DBChaosSpec{
+							Action:        DBConnectionKillAction,
+							Protocol:      DBProtocolS3,
+							Target:        DBTarget{Port: 9000},
+							InTransaction: true,
+						},
+					},
+					execute: func(chaos *DBChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+			}
+
+			for _, tc := range tcs {
+				err := tc.execute(&tc.chaos)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred())
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+	})
+})
@@ -0,0 +1,36 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import "fmt"
+
+// DeprecatedUsage is the usage of a deprecated field or action in a chaos
+type DeprecatedUsage struct {
+	// Field is the path of the deprecated field, the deprecated action is reported as
+	// the action field with its value, e.g. spec.action=<action>
+	Field string
+	// Message tells what to use instead
+	Message string
+}
+
+// Warning formats the usage as an admission warning of the kind
+func (u DeprecatedUsage) Warning(kind string) string {
+	return fmt.Sprintf("%s %s is deprecated, %s", kind, u.Field, u.Message)
+}
+
+// Deprecator is implemented by the chaos which has deprecated fields or actions
+type Deprecator interface {
+	// DeprecatedUsages returns the deprecated fields and actions used by the chaos
+	DeprecatedUsages() []DeprecatedUsage
+}
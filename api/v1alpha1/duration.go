@@ -0,0 +1,111 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// longUnits are the duration units which are not supported by time.ParseDuration
+var longUnits = map[string]time.Duration{
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// ParseDuration parses a duration string like time.ParseDuration, and additionally accepts
+// the units "d" (24h) and "w" (7d), which can be combined with the others such as "1w2d" or "1d12h30m".
+func ParseDuration(s string) (time.Duration, error) {
+	orig := s
+
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	if s == "" {
+		return 0, fmt.Errorf("invalid duration %q", orig)
+	}
+
+	var long float64
+	var rest strings.Builder
+	for s != "" {
+		i := 0
+		for i < len(s) && (s[i] == '.' || ('0' <= s[i] && s[i] <= '9')) {
+			i++
+		}
+		number := s[:i]
+		s = s[i:]
+
+		i = 0
+		for i < len(s) && s[i] != '.' && (s[i] < '0' || s[i] > '9') {
+			i++
+		}
+		unit := s[:i]
+		s = s[i:]
+
+		if scale, ok := longUnits[unit]; ok {
+			value, err := strconv.ParseFloat(number, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid duration %q", orig)
+			}
+			long += value * float64(scale)
+			continue
+		}
+		rest.WriteString(number)
+		rest.WriteString(unit)
+	}
+
+	var duration time.Duration
+	if rest.Len() > 0 {
+		var err error
+		duration, err = time.ParseDuration(rest.String())
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %s", orig, err)
+		}
+	}
+
+	if long > float64(math.MaxInt64-duration) {
+		return 0, fmt.Errorf("invalid duration %q: out of range", orig)
+	}
+	duration += time.Duration(long)
+
+	if neg {
+		duration = -duration
+	}
+	return duration, nil
+}
+
+// parseDurationPtr parses the optional duration of a chaos
+func parseDurationPtr(s *string) (*time.Duration, error) {
+	if s == nil {
+		return nil, nil
+	}
+	duration, err := ParseDuration(*s)
+	if err != nil {
+		return nil, err
+	}
+	return &duration, nil
+}
+
+// parseDurationOr parses the duration, and returns the default value if it's empty
+func parseDurationOr(s string, defaultValue time.Duration) (time.Duration, error) {
+	if s == "" {
+		return defaultValue, nil
+	}
+	return ParseDuration(s)
+}
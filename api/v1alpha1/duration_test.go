@@ -0,0 +1,55 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("duration", func() {
+	Context("ParseDuration", func() {
+		It("parse durations", func() {
+			type TestCase struct {
+				value  string
+				expect time.Duration
+			}
+			tcs := []TestCase{
+				{value: "30s", expect: 30 * time.Second},
+				{value: "1h30m", expect: 90 * time.Minute},
+				{value: "2d", expect: 48 * time.Hour},
+				{value: "1w", expect: 7 * 24 * time.Hour},
+				{value: "1w2d3h4m", expect: 9*24*time.Hour + 3*time.Hour + 4*time.Minute},
+				{value: "1.5d", expect: 36 * time.Hour},
+				{value: "-1d", expect: -24 * time.Hour},
+				{value: "0", expect: 0},
+			}
+
+			for _, tc := range tcs {
+				duration, err := ParseDuration(tc.value)
+				Expect(err).ToNot(HaveOccurred(), tc.value)
+				Expect(duration).To(Equal(tc.expect), tc.value)
+			}
+		})
+
+		It("reject invalid durations", func() {
+			for _, value := range []string{"", "-", "30", "d", "1x", "1.2.3d", "1d30", "100000000w"} {
+				_, err := ParseDuration(value)
+				Expect(err).To(HaveOccurred(), value)
+			}
+		})
+	})
+})
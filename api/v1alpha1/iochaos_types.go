@@ -50,8 +50,23 @@ const (
 	DeviceLayer     = "device"
 )
 
+// IOPercentScope represents the scope which the percent of I/O chaos applies on.
+type IOPercentScope string
+
+const (
+	// OperationScope decides whether to inject the fault on every operation
+	OperationScope IOPercentScope = "operation"
+	// FileScope decides once for every file, all operations on an unlucky file fail
+	FileScope IOPercentScope = "file"
+	// FdScope decides once for every opening of a file, all operations on an unlucky fd fail
+	FdScope IOPercentScope = "fd"
+)
+
 const (
 	DefaultChaosfsAddr = ":65534"
+
+	// DefaultPercent defines default value for percent
+	DefaultPercent = "100"
 )
 
 // IoChaosSpec defines the desired state of IoChaos
@@ -71,7 +86,6 @@ type IoChaosSpec struct {
 
 	// Mode defines the mode to run chaos action.
 	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
-	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent
 	Mode PodMode `json:"mode"`
 
 	// Value is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
@@ -79,17 +93,40 @@ type IoChaosSpec struct {
 	// If `FixedPercentPodMod`, provide a number from 0-100 to specify the percent of pods the server can do chaos action.
 	// IF `RandomMaxPercentPodMod`,  provide a number from 0-100 to specify the max percent of pods to do chaos action
 	// +optional
-	Value string `json:"value"`
+	Value string `json:"value,omitempty"`
 
 	// Duration represents the duration of the chaos action.
 	// It is required when the action is `PodFailureAction`.
 	// A duration string is a possibly signed sequence of
 	// decimal numbers, each with optional fraction and a unit suffix,
-	// such as "300ms", "-1.5h" or "2h45m".
-	// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	// such as "300ms", "-1.5h", "2h45m" or "1d12h".
+	// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h", "d", "w".
 	// +optional
 	Duration *string `json:"duration,omitempty"`
 
+	// RecoveryTimeout is the time limit for recovering the chaos when it's deleted, such as "5m".
+	// If the recovery isn't completed in time, the chaos is cleaned up forcibly.
+	// +optional
+	RecoveryTimeout *string `json:"recoveryTimeout,omitempty"`
+
+	// Simulate runs the chaos without injecting it. The targets are selected, and the status, the events
+	// and the metrics are recorded as usual, but chaos-daemon isn't called and the targets are left untouched.
+	// +optional
+	Simulate bool `json:"simulate,omitempty"`
+
+	// SLO is the service level objective which judges the runs of the chaos by a Prometheus query.
+	// +optional
+	SLO *SLOSpec `json:"slo,omitempty"`
+
+	// Load is the load which is generated against a service during the runs of the chaos.
+	// +optional
+	Load *LoadSpec `json:"load,omitempty"`
+
+	// SelectorRetryPolicy defines how to retry the selection when no pod meets the selector.
+	// It only applies to the chaos without scheduler, since the scheduled chaos selects again in the next run.
+	// +optional
+	SelectorRetryPolicy *SelectorRetryPolicy `json:"selectorRetryPolicy,omitempty"`
+
 	// Layer represents the layer of the I/O action.
 	// Supported value: fs.
 	// Default layer: fs
@@ -118,13 +155,24 @@ type IoChaosSpec struct {
 	// +optional
 	Percent string `json:"percent,omitempty"`
 
+	// PercentScope defines what the percent applies on.
+	// Supported scope: operation / file / fd
+	// default: operation.
+	// +kubebuilder:validation:Enum=operation;file;fd;""
+	// +optional
+	PercentScope IOPercentScope `json:"percentScope,omitempty"`
+
 	// Path defines the path of files for injecting I/O chaos action.
 	// +optional
 	Path string `json:"path,omitempty"`
 
 	// Methods defines the I/O methods for injecting I/O chaos action.
 	// default: all I/O methods.
+	// The volumes in block mode can't be injected, and allocate, getxattr, listxattr,
+	// removexattr and setxattr aren't supported on NFS volumes, including the ones
+	// provisioned by NFS based CSI drivers.
 	// +optional
+	// +listType=atomic
 	Methods []string `json:"methods,omitempty"`
 
 	// Addr defines the address for sidecar container.
@@ -150,6 +198,7 @@ type IoChaosStatus struct {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 
 // IoChaos is the Schema for the iochaos API
 type IoChaos struct {
@@ -179,14 +228,27 @@ func (in *IoChaos) IsPaused() bool {
 
 // GetDuration would return the duration for chaos
 func (in *IoChaos) GetDuration() (*time.Duration, error) {
-	if in.Spec.Duration == nil {
-		return nil, nil
-	}
-	duration, err := time.ParseDuration(*in.Spec.Duration)
-	if err != nil {
-		return nil, err
-	}
-	return &duration, nil
+	return parseDurationPtr(in.Spec.Duration)
+}
+
+// GetRecoveryTimeout would return the recovery timeout for chaos
+func (in *IoChaos) GetRecoveryTimeout() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.RecoveryTimeout)
+}
+
+// GetSLO returns the service level objective which judges the runs of chaos
+func (in *IoChaos) GetSLO() *SLOSpec {
+	return in.Spec.SLO
+}
+
+// IsSimulated returns true if the chaos runs without being injected
+func (in *IoChaos) IsSimulated() bool {
+	return in.Spec.Simulate
+}
+
+// GetLoad returns the load which is generated against a service during the runs of chaos
+func (in *IoChaos) GetLoad() *LoadSpec {
+	return in.Spec.Load
 }
 
 func (in *IoChaos) GetNextStart() time.Time {
@@ -232,6 +294,16 @@ func (in *IoChaos) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetSelectorSpecs returns the selectors of chaos
+func (in *IoChaos) GetSelectorSpecs() []SelectorSpec {
+	return []SelectorSpec{in.Spec.Selector}
+}
+
+// GetSelectorRetryPolicy returns how to retry the selection when no pod meets the selectors
+func (in *IoChaos) GetSelectorRetryPolicy() *SelectorRetryPolicy {
+	return in.Spec.SelectorRetryPolicy
+}
+
 // GetChaos returns a chaos instance
 func (in *IoChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
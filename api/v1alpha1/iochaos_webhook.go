@@ -44,6 +44,21 @@ func (in *IoChaos) Default() {
 	iochaoslog.Info("default", "name", in.Name)
 
 	in.Spec.Selector.DefaultNamespace(in.GetNamespace())
+	DefaultSchedulerAndDuration(in.Spec.Scheduler, in.Spec.Duration)
+	in.Spec.DefaultFields()
+}
+
+// DefaultFields set the default value of layer, percent and addr if they are not set
+func (in *IoChaosSpec) DefaultFields() {
+	if in.Layer == "" {
+		in.Layer = FileSystemLayer
+	}
+	if in.Percent == "" {
+		in.Percent = DefaultPercent
+	}
+	if in.Addr == "" {
+		in.Addr = DefaultChaosfsAddr
+	}
 }
 
 // +kubebuilder:webhook:verbs=create;update,path=/validate-chaos-mesh-org-v1alpha1-iochaos,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=iochaos,versions=v1alpha1,name=viochaos.kb.io
@@ -74,7 +89,12 @@ func (in *IoChaos) ValidateDelete() error {
 func (in *IoChaos) Validate() error {
 	specField := field.NewPath("spec")
 	allErrs := in.ValidateScheduler(specField)
+	allErrs = append(allErrs, ValidateRecoveryTimeout(in, specField)...)
+	allErrs = append(allErrs, ValidateSLO(in.Spec.SLO, specField)...)
+	allErrs = append(allErrs, ValidateLoad(in.Spec.Load, specField)...)
+	allErrs = append(allErrs, ValidateSelectorRetryPolicy(in.Spec.SelectorRetryPolicy, in.Spec.Scheduler != nil, specField)...)
 	allErrs = append(allErrs, in.ValidatePodMode(specField)...)
+	allErrs = append(allErrs, in.ValidateSelector(specField)...)
 	allErrs = append(allErrs, in.Spec.validateDelay(specField.Child("delay"))...)
 	allErrs = append(allErrs, in.Spec.validateErrno(specField.Child("errno"))...)
 	allErrs = append(allErrs, in.Spec.validatePercent(specField.Child("percent"))...)
@@ -95,6 +115,11 @@ func (in *IoChaos) ValidatePodMode(spec *field.Path) field.ErrorList {
 	return ValidatePodMode(in.Spec.Value, in.Spec.Mode, spec.Child("value"))
 }
 
+// ValidateSelector validates the selector with podmode
+func (in *IoChaos) ValidateSelector(spec *field.Path) field.ErrorList {
+	return ValidateSelector(in.Spec.Selector, in.Spec.Mode, spec.Child("selector"))
+}
+
 func (in *IoChaosSpec) validateDelay(delay *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if in.Action == IODelayAction || in.Action == IOMixedAction {
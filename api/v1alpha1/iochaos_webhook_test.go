@@ -28,6 +28,16 @@ var _ = Describe("iochaos_webhook", func() {
 			iochaos.Default()
 			Expect(iochaos.Spec.Selector.Namespaces[0]).To(Equal(metav1.NamespaceDefault))
 		})
+
+		It("set default layer, percent and addr", func() {
+			iochaos := &IoChaos{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault},
+			}
+			iochaos.Default()
+			Expect(iochaos.Spec.Layer).To(Equal(IOLayer(FileSystemLayer)))
+			Expect(iochaos.Spec.Percent).To(Equal(DefaultPercent))
+			Expect(iochaos.Spec.Addr).To(Equal(DefaultChaosfsAddr))
+		})
 	})
 	Context("ChaosValidator of iochaos", func() {
 		It("Validate", func() {
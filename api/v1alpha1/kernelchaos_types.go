@@ -30,6 +30,7 @@ func init() {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 
 // KernelChaos is the Schema for the kernelchaos API
 type KernelChaos struct {
@@ -48,7 +49,6 @@ type KernelChaos struct {
 type KernelChaosSpec struct {
 	// Mode defines the mode to run chaos action.
 	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
-	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent
 	Mode PodMode `json:"mode"`
 
 	// Value is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
@@ -56,7 +56,7 @@ type KernelChaosSpec struct {
 	// If `FixedPercentPodMod`, provide a number from 0-100 to specify the percent of pods the server can do chaos action.
 	// If `RandomMaxPercentPodMod`,  provide a number from 0-100 to specify the max percent of pods to do chaos action
 	// +optional
-	Value string `json:"value"`
+	Value string `json:"value,omitempty"`
 
 	// Selector is used to select pods that are used to inject chaos action.
 	Selector SelectorSpec `json:"selector"`
@@ -67,6 +67,29 @@ type KernelChaosSpec struct {
 	// Duration represents the duration of the chaos action
 	Duration *string `json:"duration,omitempty"`
 
+	// RecoveryTimeout is the time limit for recovering the chaos when it's deleted, such as "5m".
+	// If the recovery isn't completed in time, the chaos is cleaned up forcibly.
+	// +optional
+	RecoveryTimeout *string `json:"recoveryTimeout,omitempty"`
+
+	// Simulate runs the chaos without injecting it. The targets are selected, and the status, the events
+	// and the metrics are recorded as usual, but chaos-daemon isn't called and the targets are left untouched.
+	// +optional
+	Simulate bool `json:"simulate,omitempty"`
+
+	// SLO is the service level objective which judges the runs of the chaos by a Prometheus query.
+	// +optional
+	SLO *SLOSpec `json:"slo,omitempty"`
+
+	// Load is the load which is generated against a service during the runs of the chaos.
+	// +optional
+	Load *LoadSpec `json:"load,omitempty"`
+
+	// SelectorRetryPolicy defines how to retry the selection when no pod meets the selector.
+	// It only applies to the chaos without scheduler, since the scheduled chaos selects again in the next run.
+	// +optional
+	SelectorRetryPolicy *SelectorRetryPolicy `json:"selectorRetryPolicy,omitempty"`
+
 	// Scheduler defines some schedule rules to control the running time of the chaos experiment about time.
 	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
 }
@@ -102,6 +125,7 @@ type FailKernRequest struct {
 
 	// Headers indicates the appropriate kernel headers you need.
 	// Eg: "linux/mmzone.h", "linux/blkdev.h" and so on
+	// +listType=atomic
 	Headers []string `json:"headers,omitempty"`
 
 	// Callchain indicate a special call chain, such as:
@@ -115,6 +139,7 @@ type FailKernRequest struct {
 	// to learn more.
 	// If no special call chain, just keep Callchain empty, which means it will fail at any call chain
 	// with slab alloc (eg: kmalloc).
+	// +listType=atomic
 	Callchain []Frame `json:"callchain,omitempty"`
 
 	// Probability indicates the fails with probability.
@@ -152,17 +177,30 @@ type KernelChaosStatus struct {
 
 // GetDuration gets the duration of KernelChaos
 func (in *KernelChaos) GetDuration() (*time.Duration, error) {
-	if in.Spec.Duration == nil {
-		return nil, nil
-	}
-	duration, err := time.ParseDuration(*in.Spec.Duration)
-	if err != nil {
-		return nil, err
-	}
-	return &duration, nil
+	return parseDurationPtr(in.Spec.Duration)
 }
 
 // GetNextStart gets NextStart field of KernelChaos
+// GetRecoveryTimeout would return the recovery timeout for chaos
+func (in *KernelChaos) GetRecoveryTimeout() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.RecoveryTimeout)
+}
+
+// GetSLO returns the service level objective which judges the runs of chaos
+func (in *KernelChaos) GetSLO() *SLOSpec {
+	return in.Spec.SLO
+}
+
+// IsSimulated returns true if the chaos runs without being injected
+func (in *KernelChaos) IsSimulated() bool {
+	return in.Spec.Simulate
+}
+
+// GetLoad returns the load which is generated against a service during the runs of chaos
+func (in *KernelChaos) GetLoad() *LoadSpec {
+	return in.Spec.Load
+}
+
 func (in *KernelChaos) GetNextStart() time.Time {
 	if in.Status.Scheduler.NextStart == nil {
 		return time.Time{}
@@ -227,6 +265,16 @@ func (in *KernelChaos) IsPaused() bool {
 	return true
 }
 
+// GetSelectorSpecs returns the selectors of chaos
+func (in *KernelChaos) GetSelectorSpecs() []SelectorSpec {
+	return []SelectorSpec{in.Spec.Selector}
+}
+
+// GetSelectorRetryPolicy returns how to retry the selection when no pod meets the selectors
+func (in *KernelChaos) GetSelectorRetryPolicy() *SelectorRetryPolicy {
+	return in.Spec.SelectorRetryPolicy
+}
+
 // GetChaos returns a chaos instance
 func (in *KernelChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -42,6 +42,7 @@ func (in *KernelChaos) Default() {
 	kernelchaoslog.Info("default", "name", in.Name)
 
 	in.Spec.Selector.DefaultNamespace(in.GetNamespace())
+	DefaultSchedulerAndDuration(in.Spec.Scheduler, in.Spec.Duration)
 }
 
 // +kubebuilder:webhook:verbs=create;update,path=/validate-chaos-mesh-org-v1alpha1-kernelchaos,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=kernelchaos,versions=v1alpha1,name=vkernelchaos.kb.io
@@ -72,7 +73,12 @@ func (in *KernelChaos) ValidateDelete() error {
 func (in *KernelChaos) Validate() error {
 	specField := field.NewPath("spec")
 	allErrs := in.ValidateScheduler(specField)
+	allErrs = append(allErrs, ValidateRecoveryTimeout(in, specField)...)
+	allErrs = append(allErrs, ValidateSLO(in.Spec.SLO, specField)...)
+	allErrs = append(allErrs, ValidateLoad(in.Spec.Load, specField)...)
+	allErrs = append(allErrs, ValidateSelectorRetryPolicy(in.Spec.SelectorRetryPolicy, in.Spec.Scheduler != nil, specField)...)
 	allErrs = append(allErrs, in.ValidatePodMode(specField)...)
+	allErrs = append(allErrs, in.ValidateSelector(specField)...)
 
 	if len(allErrs) > 0 {
 		return fmt.Errorf(allErrs.ToAggregate().Error())
@@ -89,3 +95,8 @@ func (in *KernelChaos) ValidateScheduler(spec *field.Path) field.ErrorList {
 func (in *KernelChaos) ValidatePodMode(spec *field.Path) field.ErrorList {
 	return ValidatePodMode(in.Spec.Value, in.Spec.Mode, spec.Child("value"))
 }
+
+// ValidateSelector validates the selector with podmode
+func (in *KernelChaos) ValidateSelector(spec *field.Path) field.ErrorList {
+	return ValidateSelector(in.Spec.Selector, in.Spec.Mode, spec.Child("selector"))
+}
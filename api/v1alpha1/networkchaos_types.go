@@ -62,6 +62,18 @@ const (
 
 	// BandwidthAction represents the chaos action of network bandwidth of pods.
 	BandwidthAction NetworkChaosAction = "bandwidth"
+
+	// ConntrackAction represents the chaos action of exhausting the conntrack table of the nodes of pods.
+	ConntrackAction NetworkChaosAction = "conntrack"
+
+	// MTUAction represents the chaos action of changing the mtu of the network interfaces of pods.
+	MTUAction NetworkChaosAction = "mtu"
+
+	// NodePortAction represents the chaos action of blocking the node ports on the nodes of pods.
+	NodePortAction NetworkChaosAction = "nodeport"
+
+	// MetadataAction represents the chaos action of blocking or delaying the instance metadata service of pods.
+	MetadataAction NetworkChaosAction = "metadata"
 )
 
 // Direction represents traffic direction from source to target,
@@ -80,13 +92,64 @@ const (
 	Both Direction = "both"
 )
 
+// NetemProfileSpec refers to a profile of the network in a ConfigMap
+type NetemProfileSpec struct {
+	// ConfigMap is the name of the ConfigMap in the namespace of the chaos
+	ConfigMap string `json:"configMap"`
+
+	// Key is the key of the profile in the ConfigMap, defaults to "profile".
+	// The profile is in csv format with a header of the columns "time", "delay", "jitter" and "loss",
+	// e.g. "time,delay,loss\n0s,120ms,0\n10s,180ms,0.5". The time column is optional, the samples
+	// without it are applied one by one every interval, like a measured latency distribution.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Interval is how long every sample lasts if the profile doesn't have the time column,
+	// and how long the last sample lasts. Defaults to "1s".
+	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	// Loop replays the profile from the beginning after the last sample.
+	// +optional
+	Loop bool `json:"loop,omitempty"`
+}
+
+// DefaultNetemProfileKey is the key of the profile in the ConfigMap if it isn't specified
+const DefaultNetemProfileKey = "profile"
+
+// GetKey returns the key of the profile in the ConfigMap
+func (in *NetemProfileSpec) GetKey() string {
+	if in.Key == "" {
+		return DefaultNetemProfileKey
+	}
+	return in.Key
+}
+
+// GetInterval returns how long every sample lasts
+func (in *NetemProfileSpec) GetInterval() (time.Duration, error) {
+	if in.Interval == "" {
+		return time.Second, nil
+	}
+	return ParseDuration(in.Interval)
+}
+
+// ServiceMeshMode represents how the chaos works with the service mesh sidecars
+type ServiceMeshMode string
+
+const (
+	// NoServiceMesh applies the chaos without regard to the service mesh sidecars
+	NoServiceMesh ServiceMeshMode = "none"
+
+	// AutoServiceMesh detects the service mesh sidecars and applies the chaos in front of them
+	AutoServiceMesh ServiceMeshMode = "auto"
+)
+
 // Target represents network partition and netem action target.
 type Target struct {
 	// TargetSelector defines the target selector
 	TargetSelector SelectorSpec `json:"selector"`
 
 	// TargetMode defines the target selector mode
-	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent;""
 	TargetMode PodMode `json:"mode"`
 
 	// TargetValue is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
@@ -94,7 +157,7 @@ type Target struct {
 	// If `FixedPercentPodMod`, provide a number from 0-100 to specify the percent of pods the server can do chaos action.
 	// If `RandomMaxPercentPodMod`,  provide a number from 0-100 to specify the max percent of pods to do chaos action
 	// +optional
-	TargetValue string `json:"value"`
+	TargetValue string `json:"value,omitempty"`
 }
 
 // GetSelector is a getter for Selector (for implementing SelectSpec)
@@ -117,12 +180,11 @@ type NetworkChaosSpec struct {
 	// Action defines the specific network chaos action.
 	// Supported action: partition, netem, delay, loss, duplicate, corrupt
 	// Default action: delay
-	// +kubebuilder:validation:Enum=netem;delay;loss;duplicate;corrupt;partition;bandwidth
+	// +kubebuilder:validation:Enum=netem;delay;loss;duplicate;corrupt;partition;bandwidth;conntrack;mtu;nodeport;metadata
 	Action NetworkChaosAction `json:"action"`
 
 	// Mode defines the mode to run chaos action.
 	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
-	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent
 	Mode PodMode `json:"mode"`
 
 	// Value is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
@@ -130,7 +192,7 @@ type NetworkChaosSpec struct {
 	// If `FixedPercentPodMod`, provide a number from 0-100 to specify the percent of pods the server can do chaos action.
 	// If `RandomMaxPercentPodMod`,  provide a number from 0-100 to specify the max percent of pods to do chaos action
 	// +optional
-	Value string `json:"value"`
+	Value string `json:"value,omitempty"`
 
 	// Selector is used to select pods that are used to inject chaos action.
 	Selector SelectorSpec `json:"selector"`
@@ -138,9 +200,51 @@ type NetworkChaosSpec struct {
 	// Duration represents the duration of the chaos action
 	Duration *string `json:"duration,omitempty"`
 
+	// RecoveryTimeout is the time limit for recovering the chaos when it's deleted, such as "5m".
+	// If the recovery isn't completed in time, the chaos is cleaned up forcibly.
+	// +optional
+	RecoveryTimeout *string `json:"recoveryTimeout,omitempty"`
+
+	// Simulate runs the chaos without injecting it. The targets are selected, and the status, the events
+	// and the metrics are recorded as usual, but chaos-daemon isn't called and the targets are left untouched.
+	// +optional
+	Simulate bool `json:"simulate,omitempty"`
+
+	// SLO is the service level objective which judges the runs of the chaos by a Prometheus query.
+	// +optional
+	SLO *SLOSpec `json:"slo,omitempty"`
+
+	// Load is the load which is generated against a service during the runs of the chaos.
+	// +optional
+	Load *LoadSpec `json:"load,omitempty"`
+
+	// SelectorRetryPolicy defines how to retry the selection when no pod meets the selector.
+	// It only applies to the chaos without scheduler, since the scheduled chaos selects again in the next run.
+	// +optional
+	SelectorRetryPolicy *SelectorRetryPolicy `json:"selectorRetryPolicy,omitempty"`
+
 	// Scheduler defines some schedule rules to control the running time of the chaos experiment about network.
 	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
 
+	// Device is the glob pattern of the network interfaces of the pods which the tc rules
+	// are applied on, e.g. "net1" or "eth*" for the pods with multiple interfaces.
+	// Defaults to "eth0".
+	// +optional
+	Device string `json:"device,omitempty"`
+
+	// ServiceMesh defines how the iptables rules of partition work with the service mesh sidecars.
+	// "auto" detects the sidecars of Istio and Linkerd, keeps the traffic to the targets from being
+	// redirected to the sidecars and inserts the rules in front of theirs.
+	// Defaults to "none".
+	// +kubebuilder:validation:Enum=none;auto;""
+	// +optional
+	ServiceMesh ServiceMeshMode `json:"serviceMesh,omitempty"`
+
+	// Profile refers to a ConfigMap containing the samples of the network measured in production,
+	// which are replayed by updating the netem periodically. It's only available with the netem action.
+	// +optional
+	Profile *NetemProfileSpec `json:"profile,omitempty"`
+
 	// Delay represents the detail about delay action
 	// +optional
 	Delay *DelaySpec `json:"delay,omitempty"`
@@ -158,6 +262,26 @@ type NetworkChaosSpec struct {
 	// +optional
 	Bandwidth *BandwidthSpec `json:"bandwidth,omitempty"`
 
+	// Conntrack represents the detail about conntrack exhaustion action. The conntrack table
+	// belongs to the node, so the other pods on the nodes of the selected pods are affected too.
+	// +optional
+	Conntrack *ConntrackSpec `json:"conntrack,omitempty"`
+
+	// MTU represents the detail about mtu action, the mtu is changed on the network
+	// interfaces matching the device.
+	// +optional
+	MTU *MTUSpec `json:"mtu,omitempty"`
+
+	// NodePort represents the detail about nodeport action, the node ports are blocked
+	// on the nodes of the selected pods.
+	// +optional
+	NodePort *NodePortSpec `json:"nodePort,omitempty"`
+
+	// Metadata represents the detail about metadata action, the access to the instance metadata
+	// service from the selected pods is blocked or delayed.
+	// +optional
+	Metadata *MetadataSpec `json:"metadata,omitempty"`
+
 	// Direction represents the direction, this applies on netem and network partition action
 	// +optional
 	// +kubebuilder:validation:Enum=to;from;both;""
@@ -169,7 +293,24 @@ type NetworkChaosSpec struct {
 
 	// ExternalTargets represents network targets outside k8s
 	// +optional
+	// +listType=atomic
 	ExternalTargets []string `json:"externalTargets,omitempty"`
+
+	// TargetServices represents the Services which are the network targets. The cluster IPs
+	// and the endpoints of the Services are resolved, and kept in sync while the chaos is running.
+	// +optional
+	// +listType=atomic
+	TargetServices []ServiceReference `json:"targetServices,omitempty"`
+}
+
+// ServiceReference refers to a Service
+type ServiceReference struct {
+	// Namespace of the Service, defaults to the namespace of the chaos
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the Service
+	Name string `json:"name"`
 }
 
 // GetSelector is a getter for Selector (for implementing SelectSpec)
@@ -187,12 +328,115 @@ func (in *NetworkChaosSpec) GetValue() string {
 	return in.Value
 }
 
+// DefaultNetworkDevice is the network interface which the tc rules are applied on if the device isn't specified
+const DefaultNetworkDevice = "eth0"
+
+// GetDevice returns the glob pattern of the network interfaces which the tc rules are applied on
+func (in *NetworkChaosSpec) GetDevice() string {
+	if in.Device == "" {
+		return DefaultNetworkDevice
+	}
+	return in.Device
+}
+
 // NetworkChaosStatus defines the observed state of NetworkChaos
 type NetworkChaosStatus struct {
 	ChaosStatus `json:",inline"`
+
+	// Rules summarizes the rules applied on the pods, so the injected chaos
+	// can be audited without accessing the nodes.
+	// +optional
+	// +listType=atomic
+	Rules []NetworkRules `json:"rules,omitempty"`
+
+	// TargetIPSet is the ipset of the targets which is kept in sync with the endpoints of the target services.
+	// +optional
+	TargetIPSet *TargetIPSet `json:"targetIPSet,omitempty"`
+
+	// TargetSelection records the outcomes of selecting the target pods in the last attempt.
+	// +optional
+	TargetSelection *SelectionStatus `json:"targetSelection,omitempty"`
+}
+
+// TargetIPSet records the ipset containing the target services and the pods it's flushed on
+type TargetIPSet struct {
+	Name string `json:"name"`
+
+	// Cidrs are the cidrs of the target pods and the external targets.
+	// +optional
+	// +listType=atomic
+	Cidrs []string `json:"cidrs,omitempty"`
+
+	// ServiceCidrs are the cidrs resolved from the cluster IPs and the endpoints of the target services.
+	// +optional
+	// +listType=atomic
+	ServiceCidrs []string `json:"serviceCidrs,omitempty"`
+
+	// Pods are the pods which the ipset is flushed on, in the form of "namespace/name".
+	// +optional
+	// +listType=atomic
+	Pods []string `json:"pods,omitempty"`
+}
+
+// NetworkRules summarizes the tc and iptables rules applied on a pod
+type NetworkRules struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+
+	// Device is the network interface which the tc rules are applied on.
+	// +optional
+	Device string `json:"device,omitempty"`
+
+	// Qdiscs are the tc qdiscs, e.g. "parent 1:4 handle 40: netem delay 10ms".
+	// +optional
+	// +listType=atomic
+	Qdiscs []string `json:"qdiscs,omitempty"`
+
+	// Filters are the tc filters, e.g. "parent 1: basic match 'ipset(name dst)' classid 1:4".
+	// +optional
+	// +listType=atomic
+	Filters []string `json:"filters,omitempty"`
+
+	// Iptables are the iptables rules with the chains, e.g. "OUTPUT -m set --match-set name dst -j DROP".
+	// +optional
+	// +listType=atomic
+	Iptables []string `json:"iptables,omitempty"`
+
+	// IPSets are the ipsets referred by the rules.
+	// +optional
+	// +listType=atomic
+	IPSets []IPSetSummary `json:"ipsets,omitempty"`
+
+	// Programs are the tc-bpf programs of the bpf backend, e.g. "egress delay 10ms loss 25% to 2 cidrs".
+	// +optional
+	// +listType=atomic
+	Programs []string `json:"programs,omitempty"`
+}
+
+// IPSetSummary summarizes the contents of an ipset
+type IPSetSummary struct {
+	Name string `json:"name"`
+
+	// Entries is the number of the cidrs in the ipset.
+	Entries int `json:"entries"`
+
+	// Hash is the sha1 of the sorted cidrs in the ipset.
+	Hash string `json:"hash"`
+}
+
+// RulesOf returns the rules applied on the pod, a new one is added if it doesn't exist
+func (in *NetworkChaosStatus) RulesOf(namespace, name string) *NetworkRules {
+	for i := range in.Rules {
+		if in.Rules[i].Namespace == namespace && in.Rules[i].Name == name {
+			return &in.Rules[i]
+		}
+	}
+	in.Rules = append(in.Rules, NetworkRules{Namespace: namespace, Name: name})
+	return &in.Rules[len(in.Rules)-1]
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 
 // NetworkChaos is the Schema for the networkchaos API
 type NetworkChaos struct {
@@ -226,14 +470,27 @@ func (in *NetworkChaos) IsPaused() bool {
 
 // GetDuration would return the duration for chaos
 func (in *NetworkChaos) GetDuration() (*time.Duration, error) {
-	if in.Spec.Duration == nil {
-		return nil, nil
-	}
-	duration, err := time.ParseDuration(*in.Spec.Duration)
-	if err != nil {
-		return nil, err
-	}
-	return &duration, nil
+	return parseDurationPtr(in.Spec.Duration)
+}
+
+// GetRecoveryTimeout would return the recovery timeout for chaos
+func (in *NetworkChaos) GetRecoveryTimeout() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.RecoveryTimeout)
+}
+
+// GetSLO returns the service level objective which judges the runs of chaos
+func (in *NetworkChaos) GetSLO() *SLOSpec {
+	return in.Spec.SLO
+}
+
+// IsSimulated returns true if the chaos runs without being injected
+func (in *NetworkChaos) IsSimulated() bool {
+	return in.Spec.Simulate
+}
+
+// GetLoad returns the load which is generated against a service during the runs of chaos
+func (in *NetworkChaos) GetLoad() *LoadSpec {
+	return in.Spec.Load
 }
 
 func (in *NetworkChaos) GetNextStart() time.Time {
@@ -279,6 +536,20 @@ func (in *NetworkChaos) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetSelectorSpecs returns the selectors of sources and targets
+func (in *NetworkChaos) GetSelectorSpecs() []SelectorSpec {
+	selectors := []SelectorSpec{in.Spec.Selector}
+	if in.Spec.Target != nil {
+		selectors = append(selectors, in.Spec.Target.TargetSelector)
+	}
+	return selectors
+}
+
+// GetSelectorRetryPolicy returns how to retry the selection when no pod meets the selectors
+func (in *NetworkChaos) GetSelectorRetryPolicy() *SelectorRetryPolicy {
+	return in.Spec.SelectorRetryPolicy
+}
+
 // GetChaos returns a chaos instance
 func (in *NetworkChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -445,6 +716,80 @@ type BandwidthSpec struct {
 	Minburst *uint32 `json:"minburst,omitempty"`
 }
 
+// ConntrackSpec defines detail of conntrack table exhaustion.
+type ConntrackSpec struct {
+	// Headroom is the number of the new connections which can still be tracked. The max of the
+	// conntrack table is lowered to the number of the tracked connections plus the headroom,
+	// but never below 1024, so the connections beyond it are dropped until the chaos is recovered.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Headroom int32 `json:"headroom,omitempty"`
+}
+
+// MTUSpec defines detail of mtu change.
+type MTUSpec struct {
+	// MTU is the mtu of the network interfaces during the chaos. A lower mtu than the path
+	// reveals the bugs of path mtu discovery and fragmentation.
+	// +kubebuilder:validation:Minimum=68
+	// +kubebuilder:validation:Maximum=65535
+	MTU int32 `json:"mtu"`
+}
+
+// NodePortSpec defines detail of the node ports to block.
+type NodePortSpec struct {
+	// Ports are the node ports to block, both tcp and udp are blocked.
+	// +optional
+	// +listType=atomic
+	Ports []int32 `json:"ports,omitempty"`
+
+	// Services are the NodePort or LoadBalancer Services whose node ports are blocked.
+	// +optional
+	// +listType=atomic
+	Services []ServiceReference `json:"services,omitempty"`
+
+	// HealthCheck blocks the health check node ports of the LoadBalancer Services instead of
+	// their node ports, so the load balancers take the nodes as unhealthy and fail over.
+	// It only applies to the Services whose externalTrafficPolicy is Local.
+	// +optional
+	HealthCheck bool `json:"healthCheck,omitempty"`
+}
+
+// MetadataMode is the way the access to the instance metadata service is disrupted.
+type MetadataMode string
+
+const (
+	// MetadataBlockMode drops the packets to the metadata service, so the requests time out.
+	MetadataBlockMode MetadataMode = "block"
+
+	// MetadataDelayMode delays the packets to the metadata service by the delay of the spec.
+	MetadataDelayMode MetadataMode = "delay"
+)
+
+// DefaultMetadataAddress is the link-local address of the instance metadata service of AWS, GCP, Azure and OpenStack.
+const DefaultMetadataAddress = "169.254.169.254"
+
+// MetadataSpec defines detail of disrupting the instance metadata service.
+type MetadataSpec struct {
+	// Mode is the way the access to the metadata service is disrupted.
+	// "block" drops the packets to it, "delay" delays the packets to it by the delay of the spec.
+	// +kubebuilder:validation:Enum=block;delay
+	Mode MetadataMode `json:"mode"`
+
+	// Addresses are the IPv4 addresses of the metadata service, such as "100.100.100.200" of Alibaba Cloud.
+	// Defaults to 169.254.169.254.
+	// +optional
+	// +listType=atomic
+	Addresses []string `json:"addresses,omitempty"`
+}
+
+// GetAddresses returns the addresses of the metadata service
+func (in *MetadataSpec) GetAddresses() []string {
+	if len(in.Addresses) == 0 {
+		return []string{DefaultMetadataAddress}
+	}
+	return in.Addresses
+}
+
 // ToTbf converts BandwidthSpec to *chaosdaemonpb.Tbf
 // Bandwidth action use TBF under the hood.
 // TBF stands for Token Bucket Filter, is a classful queueing discipline available
@@ -15,6 +15,8 @@ package v1alpha1
 
 import (
 	"fmt"
+	"net"
+	"path"
 	"strconv"
 	"time"
 
@@ -52,10 +54,12 @@ func (in *NetworkChaos) Default() {
 	networkchaoslog.Info("default", "name", in.Name)
 
 	in.Spec.Selector.DefaultNamespace(in.GetNamespace())
-	// the target's namespace selector
+	// the target's namespace selector and mode
 	if in.Spec.Target != nil {
 		in.Spec.Target.TargetSelector.DefaultNamespace(in.GetNamespace())
+		in.Spec.Target.DefaultTargetMode()
 	}
+	DefaultSchedulerAndDuration(in.Spec.Scheduler, in.Spec.Duration)
 
 	// set default direction
 	if in.Spec.Direction == "" {
@@ -63,6 +67,23 @@ func (in *NetworkChaos) Default() {
 	}
 
 	in.Spec.DefaultDelay()
+	in.Spec.DefaultCorrelation()
+}
+
+// DefaultCorrelation set the default value if Correlation of loss, duplicate, corrupt or reorder is not set
+func (in *NetworkChaosSpec) DefaultCorrelation() {
+	if in.Loss != nil && in.Loss.Correlation == "" {
+		in.Loss.Correlation = DefaultCorrelation
+	}
+	if in.Duplicate != nil && in.Duplicate.Correlation == "" {
+		in.Duplicate.Correlation = DefaultCorrelation
+	}
+	if in.Corrupt != nil && in.Corrupt.Correlation == "" {
+		in.Corrupt.Correlation = DefaultCorrelation
+	}
+	if in.Delay != nil && in.Delay.Reorder != nil && in.Delay.Reorder.Correlation == "" {
+		in.Delay.Reorder.Correlation = DefaultCorrelation
+	}
 }
 
 // DefaultDelay set the default value if Jitter or Correlation is not set
@@ -105,8 +126,15 @@ func (in *NetworkChaos) ValidateDelete() error {
 func (in *NetworkChaos) Validate() error {
 	specField := field.NewPath("spec")
 	allErrs := in.ValidateScheduler(specField)
+	allErrs = append(allErrs, ValidateRecoveryTimeout(in, specField)...)
+	allErrs = append(allErrs, ValidateSLO(in.Spec.SLO, specField)...)
+	allErrs = append(allErrs, ValidateLoad(in.Spec.Load, specField)...)
+	allErrs = append(allErrs, ValidateSelectorRetryPolicy(in.Spec.SelectorRetryPolicy, in.Spec.Scheduler != nil, specField)...)
 	allErrs = append(allErrs, in.ValidatePodMode(specField)...)
+	allErrs = append(allErrs, in.ValidateSelector(specField)...)
 	allErrs = append(allErrs, in.ValidateExternalTargets(specField)...)
+	allErrs = append(allErrs, in.ValidateActionFields(specField)...)
+	allErrs = append(allErrs, in.ValidateDevice(specField)...)
 
 	if in.Spec.Delay != nil {
 		allErrs = append(allErrs, in.Spec.Delay.validateDelay(specField.Child("delay"))...)
@@ -144,11 +172,30 @@ func (in *NetworkChaos) ValidatePodMode(spec *field.Path) field.ErrorList {
 	return ValidatePodMode(in.Spec.Value, in.Spec.Mode, spec.Child("value"))
 }
 
-// ValidateExternalTargets validates externalTargets must be with `to` direction
+// ValidateSelector validates the selector with podmode
+func (in *NetworkChaos) ValidateSelector(spec *field.Path) field.ErrorList {
+	return ValidateSelector(in.Spec.Selector, in.Spec.Mode, spec.Child("selector"))
+}
+
+// ValidateDevice validates the device is a valid glob pattern
+func (in *NetworkChaos) ValidateDevice(spec *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if _, err := path.Match(in.Spec.Device, ""); err != nil {
+		allErrs = append(allErrs,
+			field.Invalid(spec.Child("device"), in.Spec.Device,
+				fmt.Sprintf("parse device pattern error: %v", err)))
+	}
+
+	return allErrs
+}
+
+// ValidateExternalTargets validates externalTargets and targetServices must be with `to` direction
 func (in *NetworkChaos) ValidateExternalTargets(target *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if in.Spec.ExternalTargets != nil && in.Spec.Direction == From && in.Spec.Action != PartitionAction {
+	if (in.Spec.ExternalTargets != nil || in.Spec.TargetServices != nil) &&
+		in.Spec.Direction == From && in.Spec.Action != PartitionAction {
 		allErrs = append(allErrs,
 			field.Invalid(target.Child("direction"), in.Spec.Direction,
 				fmt.Sprintf("external targets cannot be used with `from` direction in netem action yet")))
@@ -156,6 +203,174 @@ func (in *NetworkChaos) ValidateExternalTargets(target *field.Path) field.ErrorL
 
 	// TODO: validate externalTargets are in ip or domain form
 
+	for i, service := range in.Spec.TargetServices {
+		if service.Name == "" {
+			allErrs = append(allErrs,
+				field.Invalid(target.Child("targetServices").Index(i).Child("name"), service.Name,
+					"the name of the target service is required"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateActionFields validates the fields required by the action are defined
+func (in *NetworkChaos) ValidateActionFields(spec *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	missing := func(child string) {
+		allErrs = append(allErrs, field.Invalid(spec.Child(child), nil,
+			fmt.Sprintf("%s must be defined with action:%s", child, in.Spec.Action)))
+	}
+
+	switch in.Spec.Action {
+	case NetemAction:
+		if in.Spec.Delay == nil && in.Spec.Loss == nil && in.Spec.Duplicate == nil && in.Spec.Corrupt == nil &&
+			in.Spec.Profile == nil {
+			allErrs = append(allErrs, field.Invalid(spec.Child("action"), in.Spec.Action,
+				"at least one of delay, loss, duplicate, corrupt, profile must be defined with action:netem"))
+		}
+	case DelayAction:
+		if in.Spec.Delay == nil {
+			missing("delay")
+		}
+	case LossAction:
+		if in.Spec.Loss == nil {
+			missing("loss")
+		}
+	case DuplicateAction:
+		if in.Spec.Duplicate == nil {
+			missing("duplicate")
+		}
+	case CorruptAction:
+		if in.Spec.Corrupt == nil {
+			missing("corrupt")
+		}
+	case BandwidthAction:
+		if in.Spec.Bandwidth == nil {
+			missing("bandwidth")
+		}
+	case ConntrackAction:
+		if in.Spec.Conntrack == nil {
+			missing("conntrack")
+		} else if in.Spec.Conntrack.Headroom < 0 {
+			allErrs = append(allErrs, field.Invalid(spec.Child("conntrack", "headroom"), in.Spec.Conntrack.Headroom,
+				"headroom must not be negative"))
+		}
+	case MTUAction:
+		if in.Spec.MTU == nil {
+			missing("mtu")
+		} else if in.Spec.MTU.MTU < 68 || in.Spec.MTU.MTU > 65535 {
+			allErrs = append(allErrs, field.Invalid(spec.Child("mtu", "mtu"), in.Spec.MTU.MTU,
+				"mtu must be between 68 and 65535"))
+		}
+	case NodePortAction:
+		if in.Spec.NodePort == nil {
+			missing("nodePort")
+		} else {
+			allErrs = append(allErrs, in.Spec.NodePort.validateNodePort(spec.Child("nodePort"))...)
+		}
+	case MetadataAction:
+		if in.Spec.Metadata == nil {
+			missing("metadata")
+		} else {
+			allErrs = append(allErrs, in.validateMetadata(spec)...)
+		}
+	}
+
+	if in.Spec.Profile != nil {
+		allErrs = append(allErrs, in.Spec.Profile.validateProfile(in.Spec.Action, spec.Child("profile"))...)
+	}
+
+	if in.Spec.Action == PartitionAction && in.Spec.Target == nil &&
+		len(in.Spec.ExternalTargets) == 0 && len(in.Spec.TargetServices) == 0 {
+		allErrs = append(allErrs, field.Invalid(spec.Child("target"), nil,
+			"target, externalTargets or targetServices must be defined with action:partition"))
+	}
+
+	return allErrs
+}
+
+// validateMetadata validates the disruption of the metadata service, whose addresses are the only targets
+func (in *NetworkChaos) validateMetadata(spec *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	metadata := spec.Child("metadata")
+
+	switch in.Spec.Metadata.Mode {
+	case MetadataBlockMode:
+	case MetadataDelayMode:
+		if in.Spec.Delay == nil {
+			allErrs = append(allErrs, field.Invalid(spec.Child("delay"), nil,
+				fmt.Sprintf("delay must be defined with mode:%s", MetadataDelayMode)))
+		}
+	default:
+		allErrs = append(allErrs, field.Invalid(metadata.Child("mode"), in.Spec.Metadata.Mode,
+			"the mode must be block or delay"))
+	}
+	for i, address := range in.Spec.Metadata.Addresses {
+		if ip := net.ParseIP(address); ip == nil || ip.To4() == nil {
+			allErrs = append(allErrs, field.Invalid(metadata.Child("addresses").Index(i), address,
+				"the address must be an IPv4 address"))
+		}
+	}
+	if in.Spec.Target != nil || len(in.Spec.ExternalTargets) > 0 || len(in.Spec.TargetServices) > 0 {
+		allErrs = append(allErrs, field.Invalid(spec.Child("target"), nil,
+			fmt.Sprintf("target, externalTargets and targetServices can't be used with action:%s", MetadataAction)))
+	}
+	if in.Spec.Direction != "" && in.Spec.Direction != To {
+		allErrs = append(allErrs, field.Invalid(spec.Child("direction"), in.Spec.Direction,
+			fmt.Sprintf("only the `to` direction can be used with action:%s", MetadataAction)))
+	}
+	return allErrs
+}
+
+// validateNodePort validates the node ports to block
+func (in *NodePortSpec) validateNodePort(nodePort *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(in.Ports) == 0 && len(in.Services) == 0 {
+		allErrs = append(allErrs, field.Invalid(nodePort, in,
+			"at least one of ports, services must be defined with action:nodeport"))
+	}
+	if in.HealthCheck && len(in.Services) == 0 {
+		allErrs = append(allErrs, field.Invalid(nodePort.Child("healthCheck"), in.HealthCheck,
+			"healthCheck can only be used with services"))
+	}
+	for i, port := range in.Ports {
+		if port <= 0 || port > 65535 {
+			allErrs = append(allErrs, field.Invalid(nodePort.Child("ports").Index(i), port,
+				"the port must be between 1 and 65535"))
+		}
+	}
+	for i, service := range in.Services {
+		if service.Name == "" {
+			allErrs = append(allErrs, field.Invalid(nodePort.Child("services").Index(i).Child("name"), service.Name,
+				"the name of the service is required"))
+		}
+	}
+	return allErrs
+}
+
+// validateProfile validates the profile
+func (in *NetemProfileSpec) validateProfile(action NetworkChaosAction, profile *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if action != NetemAction {
+		allErrs = append(allErrs,
+			field.Invalid(profile, in.ConfigMap,
+				fmt.Sprintf("profile can only be used with action:%s", NetemAction)))
+	}
+	if in.ConfigMap == "" {
+		allErrs = append(allErrs,
+			field.Invalid(profile.Child("configMap"), in.ConfigMap, "the name of the ConfigMap is required"))
+	}
+	interval, err := in.GetInterval()
+	if err != nil {
+		allErrs = append(allErrs,
+			field.Invalid(profile.Child("interval"), in.Interval,
+				fmt.Sprintf("parse interval field error:%s", err)))
+	} else if interval <= 0 {
+		allErrs = append(allErrs,
+			field.Invalid(profile.Child("interval"), in.Interval, "interval should be positive"))
+	}
 	return allErrs
 }
 
@@ -281,5 +496,7 @@ func (in *BandwidthSpec) validateBandwidth(bandwidth *field.Path) field.ErrorLis
 
 // validateTarget validates the target
 func (in *Target) validateTarget(target *field.Path) field.ErrorList {
-	return ValidatePodMode(in.TargetValue, in.TargetMode, target.Child("value"))
+	allErrs := ValidatePodMode(in.TargetValue, in.TargetMode, target.Child("value"))
+	allErrs = append(allErrs, ValidateSelector(in.TargetSelector, in.TargetMode, target.Child("selector"))...)
+	return allErrs
 }
@@ -42,6 +42,22 @@ var _ = Describe("networkchaos_webhook", func() {
 			Expect(networkchaos.Spec.Delay.Correlation).To(Equal(DefaultCorrelation))
 			Expect(networkchaos.Spec.Delay.Jitter).To(Equal(DefaultJitter))
 		})
+
+		It("set default target mode and correlation", func() {
+			networkchaos := &NetworkChaos{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault},
+				Spec: NetworkChaosSpec{
+					Loss: &LossSpec{
+						Loss: "50",
+					},
+					Target: &Target{},
+				},
+			}
+			networkchaos.Default()
+			Expect(networkchaos.Spec.Loss.Correlation).To(Equal(DefaultCorrelation))
+			Expect(networkchaos.Spec.Target.TargetMode).To(Equal(OnePodMode))
+			Expect(networkchaos.Spec.Target.TargetSelector.Namespaces[0]).To(Equal(metav1.NamespaceDefault))
+		})
 	})
 	Context("ChaosValidator of networkchaos", func() {
 		It("Validate", func() {
@@ -279,14 +295,216 @@ var _ = Describe("networkchaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate the action without the required spec",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: NetworkChaosSpec{
+							Action: DelayAction,
+							Loss: &LossSpec{
+								Loss:        "50",
+								Correlation: "0",
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the negative headroom of conntrack",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: NetworkChaosSpec{
+							Action: ConntrackAction,
+							Conntrack: &ConntrackSpec{
+								Headroom: -1,
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the mtu out of range",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: NetworkChaosSpec{
+							Action: MTUAction,
+							MTU: &MTUSpec{
+								MTU: 20,
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the health check without services",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: NetworkChaosSpec{
+							Action: NodePortAction,
+							NodePort: &NodePortSpec{
+								Ports:       []int32{30080},
+								HealthCheck: true,
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "simple metadata delay",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo18",
+						},
+						Spec: NetworkChaosSpec{
+							Action:   MetadataAction,
+							Metadata: &MetadataSpec{Mode: MetadataDelayMode},
+							Delay:    &DelaySpec{Latency: "2s", Jitter: "1s", Correlation: "25"},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "validate the delay of metadata",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo19",
+						},
+						Spec: NetworkChaosSpec{
+							Action:   MetadataAction,
+							Metadata: &MetadataSpec{Mode: MetadataDelayMode},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the targets of metadata",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo20",
+						},
+						Spec: NetworkChaosSpec{
+							Action:          MetadataAction,
+							Metadata:        &MetadataSpec{Mode: MetadataBlockMode, Addresses: []string{"100.100.100.200"}},
+							ExternalTargets: []string{"8.8.8.8"},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the target selector selects all pods",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo14",
+						},
+						Spec: NetworkChaosSpec{
+							Action: PartitionAction,
+							Target: &Target{
+								TargetMode: AllPodMode,
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the name of target services",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo16",
+						},
+						Spec: NetworkChaosSpec{
+							Action:         PartitionAction,
+							TargetServices: []ServiceReference{{Namespace: "shop"}},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the profile with partition action",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo17",
+						},
+						Spec: NetworkChaosSpec{
+							Action:          PartitionAction,
+							ExternalTargets: []string{"8.8.8.8"},
+							Profile:         &NetemProfileSpec{ConfigMap: "wan"},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the device pattern",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo15",
+						},
+						Spec: NetworkChaosSpec{
+							Action: PartitionAction,
+							Device: "eth[",
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
 				err := tc.execute(&tc.chaos)
 				if tc.expect == "error" {
-					Expect(err).To(HaveOccurred())
+					Expect(err).To(HaveOccurred(), tc.name)
 				} else {
-					Expect(err).NotTo(HaveOccurred())
+					Expect(err).NotTo(HaveOccurred(), tc.name)
 				}
 			}
 		})
@@ -0,0 +1,324 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KindPhysicalMachineChaos is the kind for physical machine chaos
+const KindPhysicalMachineChaos = "PhysicalMachineChaos"
+
+func init() {
+	all.register(KindPhysicalMachineChaos, &ChaosKind{
+		Chaos:     &PhysicalMachineChaos{},
+		ChaosList: &PhysicalMachineChaosList{},
+	})
+}
+
+// PhysicalMachineChaosAction represents the chaos action about physical machines.
+type PhysicalMachineChaosAction string
+
+const (
+	// PMNetworkDelayAction delays the packets on a network device of machine
+	PMNetworkDelayAction PhysicalMachineChaosAction = "network-delay"
+
+	// PMNetworkLossAction drops the packets on a network device of machine
+	PMNetworkLossAction PhysicalMachineChaosAction = "network-loss"
+
+	// PMNetworkCorruptAction corrupts the packets on a network device of machine
+	PMNetworkCorruptAction PhysicalMachineChaosAction = "network-corrupt"
+
+	// PMNetworkDuplicateAction duplicates the packets on a network device of machine
+	PMNetworkDuplicateAction PhysicalMachineChaosAction = "network-duplicate"
+
+	// PMStressCPUAction burns the cpu of machine
+	PMStressCPUAction PhysicalMachineChaosAction = "stress-cpu"
+
+	// PMStressMemAction occupies the memory of machine
+	PMStressMemAction PhysicalMachineChaosAction = "stress-mem"
+
+	// PMDiskFillAction fills the disk of machine with a file
+	PMDiskFillAction PhysicalMachineChaosAction = "disk-fill"
+
+	// PMProcessKillAction kills the processes on machine
+	PMProcessKillAction PhysicalMachineChaosAction = "process-kill"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// PhysicalMachineChaos is the Schema for the physicalmachinechaos API.
+// The faults are injected by the chaosd agents which run on the physical machines or virtual machines.
+type PhysicalMachineChaos struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the behavior of a physical machine chaos experiment
+	Spec PhysicalMachineChaosSpec `json:"spec"`
+
+	// +optional
+	// Most recently observed status of the physical machine chaos experiment
+	Status PhysicalMachineChaosStatus `json:"status"`
+}
+
+// PhysicalMachineChaosSpec defines the desired state of PhysicalMachineChaos
+type PhysicalMachineChaosSpec struct {
+	// Action defines the specific physical machine chaos action.
+	// Supported action: network-delay / network-loss / network-corrupt / network-duplicate /
+	// stress-cpu / stress-mem / disk-fill / process-kill
+	// +kubebuilder:validation:Enum=network-delay;network-loss;network-corrupt;network-duplicate;stress-cpu;stress-mem;disk-fill;process-kill
+	Action PhysicalMachineChaosAction `json:"action"`
+
+	// Addresses is the addresses of the chaosd agents to inject faults, e.g. `http://172.16.0.10:31768`
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Addresses []string `json:"addresses"`
+
+	// Network defines the detail of network actions
+	// +optional
+	Network *PhysicalNetworkSpec `json:"network,omitempty"`
+
+	// Stress defines the detail of stress actions
+	// +optional
+	Stress *PhysicalStressSpec `json:"stress,omitempty"`
+
+	// Disk defines the detail of disk actions
+	// +optional
+	Disk *PhysicalDiskSpec `json:"disk,omitempty"`
+
+	// Process defines the detail of process actions
+	// +optional
+	Process *PhysicalProcessSpec `json:"process,omitempty"`
+
+	// Duration represents the duration of the chaos action
+	// +optional
+	Duration *string `json:"duration,omitempty"`
+
+	// RecoveryTimeout is the time limit for recovering the chaos when it's deleted, such as "5m".
+	// If the recovery isn't completed in time, the chaos is cleaned up forcibly.
+	// +optional
+	RecoveryTimeout *string `json:"recoveryTimeout,omitempty"`
+
+	// Simulate runs the chaos without injecting it. The targets are selected, and the status, the events
+	// and the metrics are recorded as usual, but chaos-daemon isn't called and the targets are left untouched.
+	// +optional
+	Simulate bool `json:"simulate,omitempty"`
+
+	// SLO is the service level objective which judges the runs of the chaos by a Prometheus query.
+	// +optional
+	SLO *SLOSpec `json:"slo,omitempty"`
+
+	// Load is the load which is generated against a service during the runs of the chaos.
+	// +optional
+	Load *LoadSpec `json:"load,omitempty"`
+
+	// Scheduler defines some schedule rules to control the running time of the chaos experiment about physical machines.
+	// +optional
+	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
+}
+
+// PhysicalNetworkSpec defines the detail of network actions on physical machines
+type PhysicalNetworkSpec struct {
+	// Device is the network device to inject faults, e.g. `eth0`
+	Device string `json:"device"`
+
+	// Latency is the latency of delay, e.g. `100ms`
+	// +optional
+	Latency string `json:"latency,omitempty"`
+
+	// Jitter is the jitter of delay, e.g. `10ms`
+	// +optional
+	Jitter string `json:"jitter,omitempty"`
+
+	// Percent is the percentage of packets to loss, corrupt or duplicate, e.g. `25`
+	// +optional
+	Percent string `json:"percent,omitempty"`
+
+	// Correlation is the correlation with the previous packet, e.g. `25`
+	// +optional
+	Correlation string `json:"correlation,omitempty"`
+}
+
+// PhysicalStressSpec defines the detail of stress actions on physical machines
+type PhysicalStressSpec struct {
+	// Workers specifies the number of stressors
+	// +kubebuilder:validation:Minimum=1
+	Workers int `json:"workers"`
+
+	// Load specifies the percentage of cpu load of each cpu stressor, 100 by default
+	// +optional
+	Load *int `json:"load,omitempty"`
+
+	// Size specifies the memory size to be occupied by each memory stressor, e.g. `256MB`
+	// +optional
+	Size string `json:"size,omitempty"`
+}
+
+// PhysicalDiskSpec defines the detail of disk actions on physical machines
+type PhysicalDiskSpec struct {
+	// Path is the path of the file to fill the disk, the file is removed when the chaos is recovered
+	Path string `json:"path"`
+
+	// Size is the size of the file, e.g. `10G`
+	Size string `json:"size"`
+}
+
+// PhysicalProcessSpec defines the detail of process actions on physical machines
+type PhysicalProcessSpec struct {
+	// Process is the name or the pid of the processes to kill
+	Process string `json:"process"`
+
+	// Signal is the signal number sent to the processes, 9 by default
+	// +optional
+	Signal int `json:"signal,omitempty"`
+}
+
+// PhysicalMachineChaosStatus defines the observed state of PhysicalMachineChaos
+type PhysicalMachineChaosStatus struct {
+	ChaosStatus `json:",inline"`
+}
+
+// GetDuration gets the duration of PhysicalMachineChaos
+func (in *PhysicalMachineChaos) GetDuration() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.Duration)
+}
+
+// GetNextStart gets NextStart field of PhysicalMachineChaos
+// GetRecoveryTimeout would return the recovery timeout for chaos
+func (in *PhysicalMachineChaos) GetRecoveryTimeout() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.RecoveryTimeout)
+}
+
+// GetSLO returns the service level objective which judges the runs of chaos
+func (in *PhysicalMachineChaos) GetSLO() *SLOSpec {
+	return in.Spec.SLO
+}
+
+// IsSimulated returns true if the chaos runs without being injected
+func (in *PhysicalMachineChaos) IsSimulated() bool {
+	return in.Spec.Simulate
+}
+
+// GetLoad returns the load which is generated against a service during the runs of chaos
+func (in *PhysicalMachineChaos) GetLoad() *LoadSpec {
+	return in.Spec.Load
+}
+
+func (in *PhysicalMachineChaos) GetNextStart() time.Time {
+	if in.Status.Scheduler.NextStart == nil {
+		return time.Time{}
+	}
+	return in.Status.Scheduler.NextStart.Time
+}
+
+// SetNextStart sets NextStart field of PhysicalMachineChaos
+func (in *PhysicalMachineChaos) SetNextStart(t time.Time) {
+	if t.IsZero() {
+		in.Status.Scheduler.NextStart = nil
+		return
+	}
+
+	if in.Status.Scheduler.NextStart == nil {
+		in.Status.Scheduler.NextStart = &metav1.Time{}
+	}
+	in.Status.Scheduler.NextStart.Time = t
+}
+
+// GetNextRecover get NextRecover field of PhysicalMachineChaos
+func (in *PhysicalMachineChaos) GetNextRecover() time.Time {
+	if in.Status.Scheduler.NextRecover == nil {
+		return time.Time{}
+	}
+	return in.Status.Scheduler.NextRecover.Time
+}
+
+// SetNextRecover sets NextRecover field of PhysicalMachineChaos
+func (in *PhysicalMachineChaos) SetNextRecover(t time.Time) {
+	if t.IsZero() {
+		in.Status.Scheduler.NextRecover = nil
+		return
+	}
+
+	if in.Status.Scheduler.NextRecover == nil {
+		in.Status.Scheduler.NextRecover = &metav1.Time{}
+	}
+	in.Status.Scheduler.NextRecover.Time = t
+}
+
+// GetScheduler returns the scheduler of PhysicalMachineChaos
+func (in *PhysicalMachineChaos) GetScheduler() *SchedulerSpec {
+	return in.Spec.Scheduler
+}
+
+// GetStatus returns the status of PhysicalMachineChaos
+func (in *PhysicalMachineChaos) GetStatus() *ChaosStatus {
+	return &in.Status.ChaosStatus
+}
+
+// IsDeleted returns whether this resource has been deleted
+func (in *PhysicalMachineChaos) IsDeleted() bool {
+	return !in.DeletionTimestamp.IsZero()
+}
+
+// IsPaused returns whether this resource has been paused
+func (in *PhysicalMachineChaos) IsPaused() bool {
+	if in.Annotations == nil || in.Annotations[PauseAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
+// GetChaos returns a chaos instance
+func (in *PhysicalMachineChaos) GetChaos() *ChaosInstance {
+	instance := &ChaosInstance{
+		Name:      in.Name,
+		Namespace: in.Namespace,
+		Kind:      KindPhysicalMachineChaos,
+		StartTime: in.CreationTimestamp.Time,
+		Action:    string(in.Spec.Action),
+		Status:    string(in.GetStatus().Experiment.Phase),
+	}
+	if in.Spec.Duration != nil {
+		instance.Duration = *in.Spec.Duration
+	}
+	if in.DeletionTimestamp != nil {
+		instance.EndTime = in.DeletionTimestamp.Time
+	}
+	return instance
+}
+
+// +kubebuilder:object:root=true
+
+// PhysicalMachineChaosList contains a list of PhysicalMachineChaos
+type PhysicalMachineChaosList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PhysicalMachineChaos `json:"items"`
+}
+
+// ListChaos returns a list of physical machine chaos
+func (in *PhysicalMachineChaosList) ListChaos() []*ChaosInstance {
+	res := make([]*ChaosInstance, 0, len(in.Items))
+	for _, item := range in.Items {
+		res = append(res, item.GetChaos())
+	}
+	return res
+}
+
+func init() {
+	SchemeBuilder.Register(&PhysicalMachineChaos{}, &PhysicalMachineChaosList{})
+}
@@ -0,0 +1,236 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var physicalmachinechaoslog = logf.Log.WithName("physicalmachinechaos-resource")
+
+// SetupWebhookWithManager setup PhysicalMachineChaos's webhook with manager
+func (in *PhysicalMachineChaos) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-chaos-mesh-org-v1alpha1-physicalmachinechaos,mutating=true,failurePolicy=fail,groups=chaos-mesh.org,resources=physicalmachinechaos,verbs=create;update,versions=v1alpha1,name=mphysicalmachinechaos.kb.io
+
+var _ webhook.Defaulter = &PhysicalMachineChaos{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (in *PhysicalMachineChaos) Default() {
+	physicalmachinechaoslog.Info("default", "name", in.Name)
+
+	DefaultSchedulerAndDuration(in.Spec.Scheduler, in.Spec.Duration)
+
+	if in.Spec.Process != nil && in.Spec.Process.Signal == 0 {
+		in.Spec.Process.Signal = 9
+	}
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-chaos-mesh-org-v1alpha1-physicalmachinechaos,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=physicalmachinechaos,versions=v1alpha1,name=vphysicalmachinechaos.kb.io
+
+var _ webhook.Validator = &PhysicalMachineChaos{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *PhysicalMachineChaos) ValidateCreate() error {
+	physicalmachinechaoslog.Info("validate create", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *PhysicalMachineChaos) ValidateUpdate(old runtime.Object) error {
+	physicalmachinechaoslog.Info("validate update", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *PhysicalMachineChaos) ValidateDelete() error {
+	physicalmachinechaoslog.Info("validate delete", "name", in.Name)
+
+	// Nothing to do?
+	return nil
+}
+
+// Validate validates chaos object
+func (in *PhysicalMachineChaos) Validate() error {
+	specField := field.NewPath("spec")
+	allErrs := in.ValidateScheduler(specField)
+	allErrs = append(allErrs, ValidateRecoveryTimeout(in, specField)...)
+	allErrs = append(allErrs, ValidateSLO(in.Spec.SLO, specField)...)
+	allErrs = append(allErrs, ValidateLoad(in.Spec.Load, specField)...)
+	allErrs = append(allErrs, in.Spec.validateAddresses(specField.Child("addresses"))...)
+	allErrs = append(allErrs, in.Spec.validateAction(specField)...)
+
+	if len(allErrs) > 0 {
+		return fmt.Errorf(allErrs.ToAggregate().Error())
+	}
+	return nil
+}
+
+// ValidateScheduler validates the scheduler and duration
+func (in *PhysicalMachineChaos) ValidateScheduler(spec *field.Path) field.ErrorList {
+	return ValidateScheduler(in, spec)
+}
+
+// validateAddresses validates the addresses of chaosd agents
+func (in *PhysicalMachineChaosSpec) validateAddresses(addresses *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(in.Addresses) == 0 {
+		allErrs = append(allErrs, field.Required(addresses, "at least one address of chaosd is required"))
+	}
+
+	for i, address := range in.Addresses {
+		u, err := url.Parse(address)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(addresses.Index(i), address,
+				"address should be an url such as http://172.16.0.10:31768"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateAction validates the detail of the chaos action
+func (in *PhysicalMachineChaosSpec) validateAction(spec *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch in.Action {
+	case PMNetworkDelayAction, PMNetworkLossAction, PMNetworkCorruptAction, PMNetworkDuplicateAction:
+		network := spec.Child("network")
+		if in.Network == nil {
+			return append(allErrs, field.Required(network, fmt.Sprintf("network is required by action %s", in.Action)))
+		}
+		if in.Network.Device == "" {
+			allErrs = append(allErrs, field.Required(network.Child("device"), "device is required"))
+		}
+		if in.Action == PMNetworkDelayAction {
+			allErrs = append(allErrs, validatePhysicalDuration(network.Child("latency"), in.Network.Latency, true)...)
+			allErrs = append(allErrs, validatePhysicalDuration(network.Child("jitter"), in.Network.Jitter, false)...)
+		} else {
+			allErrs = append(allErrs, validatePhysicalPercent(network.Child("percent"), in.Network.Percent, true)...)
+		}
+		allErrs = append(allErrs, validatePhysicalPercent(network.Child("correlation"), in.Network.Correlation, false)...)
+	case PMStressCPUAction, PMStressMemAction:
+		stress := spec.Child("stress")
+		if in.Stress == nil {
+			return append(allErrs, field.Required(stress, fmt.Sprintf("stress is required by action %s", in.Action)))
+		}
+		if in.Stress.Workers <= 0 {
+			allErrs = append(allErrs, field.Invalid(stress.Child("workers"), in.Stress.Workers, "workers should be greater than 0"))
+		}
+		if in.Stress.Load != nil && (*in.Stress.Load < 0 || *in.Stress.Load > 100) {
+			allErrs = append(allErrs, field.Invalid(stress.Child("load"), *in.Stress.Load, "load should be between 0 and 100"))
+		}
+		if in.Action == PMStressMemAction {
+			allErrs = append(allErrs, validatePhysicalSize(stress.Child("size"), in.Stress.Size)...)
+		}
+	case PMDiskFillAction:
+		disk := spec.Child("disk")
+		if in.Disk == nil {
+			return append(allErrs, field.Required(disk, fmt.Sprintf("disk is required by action %s", in.Action)))
+		}
+		if in.Disk.Path == "" {
+			allErrs = append(allErrs, field.Required(disk.Child("path"), "path is required"))
+		}
+		allErrs = append(allErrs, validatePhysicalSize(disk.Child("size"), in.Disk.Size)...)
+	case PMProcessKillAction:
+		process := spec.Child("process")
+		if in.Process == nil {
+			return append(allErrs, field.Required(process, fmt.Sprintf("process is required by action %s", in.Action)))
+		}
+		if in.Process.Process == "" {
+			allErrs = append(allErrs, field.Required(process.Child("process"), "process is required"))
+		}
+		if in.Process.Signal < 0 || in.Process.Signal > 64 {
+			allErrs = append(allErrs, field.Invalid(process.Child("signal"), in.Process.Signal, "signal should be between 1 and 64"))
+		}
+	default:
+		allErrs = append(allErrs, field.Invalid(spec.Child("action"), in.Action, "unknown action"))
+	}
+
+	return allErrs
+}
+
+func validatePhysicalDuration(path *field.Path, value string, required bool) field.ErrorList {
+	if value == "" {
+		if required {
+			return field.ErrorList{field.Required(path, "")}
+		}
+		return nil
+	}
+
+	if _, err := time.ParseDuration(value); err != nil {
+		return field.ErrorList{field.Invalid(path, value, fmt.Sprintf("parse duration error:%s", err))}
+	}
+	return nil
+}
+
+func validatePhysicalPercent(path *field.Path, value string, required bool) field.ErrorList {
+	if value == "" {
+		if required {
+			return field.ErrorList{field.Required(path, "")}
+		}
+		return nil
+	}
+
+	percent, err := strconv.ParseFloat(value, 64)
+	if err != nil || percent < 0 || percent > 100 {
+		return field.ErrorList{field.Invalid(path, value, "percent should be a number between 0 and 100")}
+	}
+	return nil
+}
+
+func validatePhysicalSize(path *field.Path, value string) field.ErrorList {
+	if value == "" {
+		return field.ErrorList{field.Required(path, "")}
+	}
+
+	if _, err := ParsePhysicalSize(value); err != nil {
+		return field.ErrorList{field.Invalid(path, value, err.Error())}
+	}
+	return nil
+}
+
+// ParsePhysicalSize parses the size such as `256MB`, `10G` and `1Gi` into bytes
+func ParsePhysicalSize(value string) (int64, error) {
+	// accept the units of stress-ng and fallocate such as `MB` and `GB` besides the quantity of kubernetes
+	normalized := value
+	if n := len(value); n > 1 && (value[n-1] == 'B' || value[n-1] == 'b') {
+		normalized = value[:n-1]
+	}
+
+	quantity, err := resource.ParseQuantity(normalized)
+	if err != nil {
+		return 0, fmt.Errorf("parse size %s error:%s", value, err)
+	}
+	if quantity.Sign() <= 0 {
+		return 0, fmt.Errorf("size %s should be greater than 0", value)
+	}
+	return quantity.Value(), nil
+}
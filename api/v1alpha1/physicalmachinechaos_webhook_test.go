@@ -0,0 +1,147 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("physicalmachinechaos_webhook", func() {
+	Context("Defaulter", func() {
+		It("set default signal", func() {
+			chaos := &PhysicalMachineChaos{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault},
+				Spec: PhysicalMachineChaosSpec{
+					Action:  PMProcessKillAction,
+					Process: &PhysicalProcessSpec{Process: "nginx"},
+				},
+			}
+			chaos.Default()
+			Expect(chaos.Spec.Process.Signal).To(Equal(9))
+		})
+	})
+	Context("Validator of physicalmachinechaos", func() {
+		It("Validate", func() {
+
+			type TestCase struct {
+				name   string
+				spec   PhysicalMachineChaosSpec
+				expect string
+			}
+			duration := "400s"
+			load := 120
+			addresses := []string{"http://172.16.0.10:31768"}
+			tcs := []TestCase{
+				{
+					name: "network delay",
+					spec: PhysicalMachineChaosSpec{
+						Action:    PMNetworkDelayAction,
+						Addresses: addresses,
+						Network:   &PhysicalNetworkSpec{Device: "eth0", Latency: "100ms", Jitter: "10ms", Correlation: "25"},
+					},
+					expect: "",
+				},
+				{
+					name: "network loss without percent",
+					spec: PhysicalMachineChaosSpec{
+						Action:    PMNetworkLossAction,
+						Addresses: addresses,
+						Network:   &PhysicalNetworkSpec{Device: "eth0"},
+					},
+					expect: "error",
+				},
+				{
+					name: "network action without network",
+					spec: PhysicalMachineChaosSpec{
+						Action:    PMNetworkCorruptAction,
+						Addresses: addresses,
+					},
+					expect: "error",
+				},
+				{
+					name: "no addresses",
+					spec: PhysicalMachineChaosSpec{
+						Action:  PMProcessKillAction,
+						Process: &PhysicalProcessSpec{Process: "nginx"},
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid address",
+					spec: PhysicalMachineChaosSpec{
+						Action:    PMProcessKillAction,
+						Addresses: []string{"172.16.0.10:31768"},
+						Process:   &PhysicalProcessSpec{Process: "nginx"},
+					},
+					expect: "error",
+				},
+				{
+					name: "stress memory",
+					spec: PhysicalMachineChaosSpec{
+						Action:    PMStressMemAction,
+						Addresses: addresses,
+						Stress:    &PhysicalStressSpec{Workers: 1, Size: "256MB"},
+					},
+					expect: "",
+				},
+				{
+					name: "stress cpu with invalid load",
+					spec: PhysicalMachineChaosSpec{
+						Action:    PMStressCPUAction,
+						Addresses: addresses,
+						Stress:    &PhysicalStressSpec{Workers: 1, Load: &load},
+					},
+					expect: "error",
+				},
+				{
+					name: "disk fill with invalid size",
+					spec: PhysicalMachineChaosSpec{
+						Action:    PMDiskFillAction,
+						Addresses: addresses,
+						Disk:      &PhysicalDiskSpec{Path: "/tmp/fill", Size: "ten"},
+					},
+					expect: "error",
+				},
+				{
+					name: "only define the Duration",
+					spec: PhysicalMachineChaosSpec{
+						Action:    PMDiskFillAction,
+						Addresses: addresses,
+						Disk:      &PhysicalDiskSpec{Path: "/tmp/fill", Size: "1Gi"},
+						Duration:  &duration,
+					},
+					expect: "error",
+				},
+			}
+
+			for _, tc := range tcs {
+				chaos := &PhysicalMachineChaos{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: metav1.NamespaceDefault,
+						Name:      "foo",
+					},
+					Spec: tc.spec,
+				}
+				err := chaos.ValidateCreate()
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred(), tc.name)
+				} else {
+					Expect(err).NotTo(HaveOccurred(), tc.name)
+				}
+			}
+		})
+	})
+})
@@ -17,6 +17,7 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // KindPodChaos is the kind for pod chaos
@@ -40,9 +41,18 @@ const (
 	PodFailureAction PodChaosAction = "pod-failure"
 	// ContainerKillAction represents the chaos action of killing the container
 	ContainerKillAction PodChaosAction = "container-kill"
+	// PodReIPAction represents the chaos action of recreating the sandboxes of pods,
+	// which changes the ip of the pods without deleting them.
+	PodReIPAction PodChaosAction = "pod-reip"
+	// ContainerSignalAction represents the chaos action of sending signals to the processes of the container
+	ContainerSignalAction PodChaosAction = "container-signal"
+	// ConfigCorruptAction represents the chaos action of replacing a file of the ConfigMap or Secret volume
+	// in the container, which is restored on recovery.
+	ConfigCorruptAction PodChaosAction = "config-corrupt"
 )
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 
 // PodChaos is the control script`s spec.
 type PodChaos struct {
@@ -76,14 +86,27 @@ func (in *PodChaos) IsPaused() bool {
 
 // GetDuration would return the duration for chaos
 func (in *PodChaos) GetDuration() (*time.Duration, error) {
-	if in.Spec.Duration == nil {
-		return nil, nil
-	}
-	duration, err := time.ParseDuration(*in.Spec.Duration)
-	if err != nil {
-		return nil, err
-	}
-	return &duration, nil
+	return parseDurationPtr(in.Spec.Duration)
+}
+
+// GetRecoveryTimeout would return the recovery timeout for chaos
+func (in *PodChaos) GetRecoveryTimeout() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.RecoveryTimeout)
+}
+
+// GetSLO returns the service level objective which judges the runs of chaos
+func (in *PodChaos) GetSLO() *SLOSpec {
+	return in.Spec.SLO
+}
+
+// IsSimulated returns true if the chaos runs without being injected
+func (in *PodChaos) IsSimulated() bool {
+	return in.Spec.Simulate
+}
+
+// GetLoad returns the load which is generated against a service during the runs of chaos
+func (in *PodChaos) GetLoad() *LoadSpec {
+	return in.Spec.Load
 }
 
 func (in *PodChaos) GetNextStart() time.Time {
@@ -129,6 +152,31 @@ func (in *PodChaos) GetScheduler() *SchedulerSpec {
 	return in.Spec.Scheduler
 }
 
+// GetSelectorSpecs returns the selectors of chaos
+func (in *PodChaos) GetSelectorSpecs() []SelectorSpec {
+	return []SelectorSpec{in.Spec.Selector}
+}
+
+// GetSelectorRetryPolicy returns how to retry the selection when no pod meets the selectors
+func (in *PodChaos) GetSelectorRetryPolicy() *SelectorRetryPolicy {
+	return in.Spec.SelectorRetryPolicy
+}
+
+// GetFailurePolicy returns how to handle the chaos which failed to be applied on some of the pods
+func (in *PodChaos) GetFailurePolicy() FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetControlGroupPercent returns the percentage of the selected pods which are left untouched
+func (in *PodChaos) GetControlGroupPercent() int {
+	return in.Spec.ControlGroupPercent
+}
+
+// GetStaggerInterval returns the interval between the injections into the selected pods
+func (in *PodChaos) GetStaggerInterval() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.StaggerInterval)
+}
+
 // GetChaos returns a chaos instance
 func (in *PodChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -158,14 +206,13 @@ type PodChaosSpec struct {
 	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
 
 	// Action defines the specific pod chaos action.
-	// Supported action: pod-kill / pod-failure / container-kill
+	// Supported action: pod-kill / pod-failure / container-kill / pod-reip / container-signal / config-corrupt
 	// Default action: pod-kill
-	// +kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill
+	// +kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill;pod-reip;container-signal;config-corrupt
 	Action PodChaosAction `json:"action"`
 
 	// Mode defines the mode to run chaos action.
 	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
-	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent
 	Mode PodMode `json:"mode"`
 
 	// Value is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
@@ -173,27 +220,145 @@ type PodChaosSpec struct {
 	// If `FixedPercentPodMod`, provide a number from 0-100 to specify the percent of pods the server can do chaos action.
 	// IF `RandomMaxPercentPodMod`,  provide a number from 0-100 to specify the max percent of pods to do chaos action
 	// +optional
-	Value string `json:"value"`
+	Value string `json:"value,omitempty"`
 
 	// Duration represents the duration of the chaos action.
 	// It is required when the action is `PodFailureAction`.
 	// A duration string is a possibly signed sequence of
 	// decimal numbers, each with optional fraction and a unit suffix,
-	// such as "300ms", "-1.5h" or "2h45m".
-	// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	// such as "300ms", "-1.5h", "2h45m" or "1d12h".
+	// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h", "d", "w".
 	// +optional
 	Duration *string `json:"duration,omitempty"`
 
+	// RecoveryTimeout is the time limit for recovering the chaos when it's deleted, such as "5m".
+	// If the recovery isn't completed in time, the chaos is cleaned up forcibly.
+	// +optional
+	RecoveryTimeout *string `json:"recoveryTimeout,omitempty"`
+
+	// Simulate runs the chaos without injecting it. The targets are selected, and the status, the events
+	// and the metrics are recorded as usual, but chaos-daemon isn't called and the targets are left untouched.
+	// +optional
+	Simulate bool `json:"simulate,omitempty"`
+
+	// SLO is the service level objective which judges the runs of the chaos by a Prometheus query.
+	// +optional
+	SLO *SLOSpec `json:"slo,omitempty"`
+
+	// Load is the load which is generated against a service during the runs of the chaos.
+	// +optional
+	Load *LoadSpec `json:"load,omitempty"`
+
+	// SelectorRetryPolicy defines how to retry the selection when no pod meets the selector.
+	// It only applies to the chaos without scheduler, since the scheduled chaos selects again in the next run.
+	// +optional
+	SelectorRetryPolicy *SelectorRetryPolicy `json:"selectorRetryPolicy,omitempty"`
+
+	// FailurePolicy specifies how to handle the chaos which failed to be applied on some of the pods.
+	// Valid values are:
+	// - "AllOrNothing": rolls back the chaos from all the pods, and the experiment fails;
+	// - "BestEffort": keeps the chaos on the pods which it's applied on, and the experiment proceeds
+	// unless it failed to be applied on all the pods.
+	// If it's omitted, the experiment fails and only the failed pods are applied when it's retried.
+	// The errors of the failed pods are recorded in the status.
+	// +optional
+	// +kubebuilder:validation:Enum=AllOrNothing;BestEffort;""
+	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// ControlGroupPercent is the percentage of the selected pods which are left untouched as the control group,
+	// so the treated pods can be compared with the untreated ones.
+	// The control group is recorded in the status, and at least one pod is treated.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=99
+	ControlGroupPercent int `json:"controlGroupPercent,omitempty"`
+
+	// StaggerInterval spreads the injection across the selected pods over time, e.g. "30s" injects
+	// the chaos into one pod every 30 seconds instead of all the pods at once. The duration starts
+	// when the first pod is injected, and the pods not injected yet are skipped once it's recovered.
+	// +optional
+	StaggerInterval *string `json:"staggerInterval,omitempty"`
+
 	// ContainerName indicates the name of the container.
-	// Needed in container-kill.
+	// Deprecated: use ContainerNames instead.
+	// +optional
+	ContainerName string `json:"containerName,omitempty"`
+
+	// ContainerNames indicates the names of the containers which are killed or signaled.
+	// Needed in container-kill and container-signal.
 	// +optional
-	ContainerName string `json:"containerName"`
+	// +listType=atomic
+	ContainerNames []string `json:"containerNames,omitempty"`
 
 	// GracePeriod is used in pod-kill action. It represents the duration in seconds before the pod should be deleted.
 	// Value must be non-negative integer. The default value is zero that indicates delete immediately.
 	// +optional
 	// +kubebuilder:validation:Minimum=0
-	GracePeriod int64 `json:"gracePeriod"`
+	GracePeriod int64 `json:"gracePeriod,omitempty"`
+
+	// Signal is the signal which is sent to the processes of the containers in container-signal.
+	// +optional
+	Signal *SignalSpec `json:"signal,omitempty"`
+
+	// ConfigCorruption is the file of the ConfigMap or Secret volume which is replaced in config-corrupt.
+	// +optional
+	ConfigCorruption *ConfigCorruptionSpec `json:"configCorruption,omitempty"`
+
+	// Safeguards keeps the workloads of the selected pods available in pod-kill, pod-failure and pod-reip.
+	// +optional
+	Safeguards *Safeguards `json:"safeguards,omitempty"`
+}
+
+// Safeguards defines the guards which skip the selected pods if the chaos on them would make
+// their workloads unavailable
+type Safeguards struct {
+	// MinAvailable is the number or the percentage of the replicas of each workload, i.e. the
+	// ReplicaSet or StatefulSet owning the pods, which must be kept available, such as 1 or "50%".
+	// The selected pods which would reduce a workload below it are skipped.
+	MinAvailable intstr.IntOrString `json:"minAvailable"`
+
+	// Override disables the guards, so the chaos is injected into all the selected pods
+	// even if their workloads become unavailable.
+	// +optional
+	Override bool `json:"override,omitempty"`
+}
+
+// SignalSpec defines the signal which is sent to the processes of the containers in every run of the chaos
+type SignalSpec struct {
+	// Name is the name or the number of the signal, such as "SIGHUP", "USR1" or "9".
+	Name string `json:"name"`
+
+	// Child is the index of the direct child of the main process of the container which receives the signal.
+	// The children are ordered by their start time and counted from 1. The main process receives the signal
+	// if it's zero.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Child int `json:"child,omitempty"`
+}
+
+// ConfigCorruptionSpec defines the file of the ConfigMap or Secret volume which is replaced in the containers
+// until the chaos is recovered
+type ConfigCorruptionSpec struct {
+	// Volume is the name of the ConfigMap, Secret or projected volume in the pod.
+	// The volume mounted with subPath isn't supported.
+	Volume string `json:"volume"`
+
+	// Key is the path of the file in the volume, such as "app.yaml" or "conf/app.yaml".
+	Key string `json:"key"`
+
+	// Content is the content which replaces the file.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// Corruption corrupts the original content of the file if the content isn't provided.
+	// Valid values are:
+	// - "truncate": keeps the first half of the content;
+	// - "random": replaces the content with the random bytes of the same length;
+	// - "empty": empties the file.
+	// Default corruption is truncate.
+	// +optional
+	// +kubebuilder:validation:Enum=truncate;random;empty;""
+	Corruption string `json:"corruption,omitempty"`
 }
 
 func (in *PodChaosSpec) GetSelector() SelectorSpec {
@@ -208,6 +373,20 @@ func (in *PodChaosSpec) GetValue() string {
 	return in.Value
 }
 
+// GetContainerNames returns the names of the containers which are killed or signaled,
+// including the deprecated ContainerName
+func (in *PodChaosSpec) GetContainerNames() []string {
+	if in.ContainerName == "" {
+		return in.ContainerNames
+	}
+	for _, name := range in.ContainerNames {
+		if name == in.ContainerName {
+			return in.ContainerNames
+		}
+	}
+	return append([]string{in.ContainerName}, in.ContainerNames...)
+}
+
 // +kubebuilder:object:root=true
 
 // PodChaosList is PodChaos list.
@@ -235,6 +414,57 @@ type PodStatus struct {
 	// e.g. "delete this pod" or "pause this pod duration 5m"
 	// +optional
 	Message string `json:"message"`
+
+	// Progress is the progress of the chaos on the pod reported by chaos-daemon
+	// +optional
+	Progress *PodProgress `json:"progress,omitempty"`
+
+	// State is the state of the pod observed by the controller while the chaos is applied,
+	// it's empty if nothing happened to the pod
+	// +optional
+	State TargetState `json:"state,omitempty"`
+	// Reason is the reason of the state, e.g. the namespace of the pod is being deleted
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// TargetState is the state of a pod which the chaos is applied on
+type TargetState string
+
+const (
+	// TargetGone means the pod is being deleted along with its namespace or workload, so the chaos
+	// isn't recovered from it but is dropped
+	TargetGone TargetState = "TargetGone"
+)
+
+// PodProgress is the progress of chaos on a pod, which is pushed by chaos-daemon while the chaos is applied
+type PodProgress struct {
+	// Phase is the phase of the last event, one of Applied, Failed, Recovered and Exited
+	Phase string `json:"phase"`
+	// Method is the method of chaos-daemon which the last event is caused by, e.g. SetNetem
+	// +optional
+	Method string `json:"method,omitempty"`
+	// Message is the error of the last event if it's Failed or Exited
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Containers is the number of faults applied to each container of the pod
+	// +optional
+	// +listType=atomic
+	Containers []ContainerProgress `json:"containers,omitempty"`
+	// Pids are the processes running for the chaos in the pod, e.g. the stressors
+	// +optional
+	// +listType=atomic
+	Pids []int64 `json:"pids,omitempty"`
+	// LastUpdateTime is the time of the last event
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// ContainerProgress is the progress of chaos on a container
+type ContainerProgress struct {
+	// ID is the id of the container, e.g. docker://xxx
+	ID string `json:"id"`
+	// Applied is the number of faults applied to the container and not recovered yet
+	Applied int `json:"applied"`
 }
 
 // ListChaos returns a list of pod chaos
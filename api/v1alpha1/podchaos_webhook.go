@@ -15,8 +15,12 @@ package v1alpha1
 
 import (
 	"fmt"
+	"path"
+	"strconv"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
@@ -42,6 +46,7 @@ func (in *PodChaos) Default() {
 	podchaoslog.Info("default", "name", in.Name)
 
 	in.Spec.Selector.DefaultNamespace(in.GetNamespace())
+	DefaultSchedulerAndDuration(in.Spec.Scheduler, in.Spec.Duration)
 }
 
 // +kubebuilder:webhook:verbs=create;update,path=/validate-chaos-mesh-org-v1alpha1-podchaos,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=podchaos,versions=v1alpha1,name=vpodchaos.kb.io
@@ -68,12 +73,37 @@ func (in *PodChaos) ValidateDelete() error {
 	return nil
 }
 
+var _ Deprecator = &PodChaos{}
+
+// DeprecatedUsages implements Deprecator
+func (in *PodChaos) DeprecatedUsages() []DeprecatedUsage {
+	var usages []DeprecatedUsage
+	if in.Spec.ContainerName != "" {
+		usages = append(usages, DeprecatedUsage{
+			Field:   "spec.containerName",
+			Message: "use spec.containerNames instead",
+		})
+	}
+	return usages
+}
+
 // Validate validates chaos object
 func (in *PodChaos) Validate() error {
 	specField := field.NewPath("spec")
 	allErrs := in.ValidateScheduler(specField)
+	allErrs = append(allErrs, ValidateRecoveryTimeout(in, specField)...)
+	allErrs = append(allErrs, ValidateSLO(in.Spec.SLO, specField)...)
+	allErrs = append(allErrs, ValidateLoad(in.Spec.Load, specField)...)
+	allErrs = append(allErrs, ValidateSelectorRetryPolicy(in.Spec.SelectorRetryPolicy, in.Spec.Scheduler != nil, specField)...)
 	allErrs = append(allErrs, in.ValidatePodMode(specField)...)
-	allErrs = append(allErrs, in.Spec.validateContainerName(specField.Child("containerName"))...)
+	allErrs = append(allErrs, in.ValidateSelector(specField)...)
+	allErrs = append(allErrs, in.Spec.validateContainerNames(specField.Child("containerNames"))...)
+	allErrs = append(allErrs, in.Spec.validateFailurePolicy(specField.Child("failurePolicy"))...)
+	allErrs = append(allErrs, ValidateControlGroupPercent(in.Spec.ControlGroupPercent, specField)...)
+	allErrs = append(allErrs, ValidateStaggerInterval(in, specField)...)
+	allErrs = append(allErrs, in.Spec.validateSafeguards(specField.Child("safeguards"))...)
+	allErrs = append(allErrs, in.Spec.validateSignal(specField.Child("signal"))...)
+	allErrs = append(allErrs, in.Spec.validateConfigCorruption(specField.Child("configCorruption"))...)
 
 	if len(allErrs) > 0 {
 		return fmt.Errorf(allErrs.ToAggregate().Error())
@@ -87,25 +117,27 @@ func (in *PodChaos) ValidateScheduler(spec *field.Path) field.ErrorList {
 	schedulerField := spec.Child("scheduler")
 
 	switch in.Spec.Action {
-	case PodFailureAction:
+	case PodFailureAction, ConfigCorruptAction:
 		allErrs = append(allErrs, ValidateScheduler(in, spec)...)
 		break
-	case PodKillAction:
+	case PodKillAction, PodReIPAction:
 		// We choose to ignore the Duration property even user define it
 		if in.Spec.Scheduler == nil {
 			allErrs = append(allErrs, field.Invalid(schedulerField, in.Spec.Scheduler, ValidatePodchaosSchedulerError))
 		} else {
 			_, err := ParseCron(in.Spec.Scheduler.Cron, schedulerField.Child("cron"))
 			allErrs = append(allErrs, err...)
+			allErrs = append(allErrs, ValidateSchedulerPolicy(in.Spec.Scheduler, schedulerField)...)
 		}
 		break
-	case ContainerKillAction:
+	case ContainerKillAction, ContainerSignalAction:
 		// We choose to ignore the Duration property even user define it
 		if in.Spec.Scheduler == nil {
 			allErrs = append(allErrs, field.Invalid(schedulerField, in.Spec.Scheduler, ValidatePodchaosSchedulerError))
 		} else {
 			_, err := ParseCron(in.Spec.Scheduler.Cron, schedulerField.Child("cron"))
 			allErrs = append(allErrs, err...)
+			allErrs = append(allErrs, ValidateSchedulerPolicy(in.Spec.Scheduler, schedulerField)...)
 		}
 		break
 	default:
@@ -124,14 +156,133 @@ func (in *PodChaos) ValidatePodMode(spec *field.Path) field.ErrorList {
 	return ValidatePodMode(in.Spec.Value, in.Spec.Mode, spec.Child("value"))
 }
 
-// validateContainerName validates the ContainerName
-func (in *PodChaosSpec) validateContainerName(containerField *field.Path) field.ErrorList {
+// ValidateSelector validates the selector with podmode
+func (in *PodChaos) ValidateSelector(spec *field.Path) field.ErrorList {
+	return ValidateSelector(in.Spec.Selector, in.Spec.Mode, spec.Child("selector"))
+}
+
+// validateContainerNames validates the ContainerNames and the deprecated ContainerName
+func (in *PodChaosSpec) validateContainerNames(containerField *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
-	if in.Action == ContainerKillAction {
-		if in.ContainerName == "" {
-			err := fmt.Errorf("the name of container should not be empty on %s action", in.Action)
-			allErrs = append(allErrs, field.Invalid(containerField, in.ContainerName, err.Error()))
+	if in.Action == ContainerKillAction || in.Action == ContainerSignalAction {
+		if len(in.GetContainerNames()) == 0 {
+			err := fmt.Errorf("the names of containers should not be empty on %s action", in.Action)
+			allErrs = append(allErrs, field.Invalid(containerField, in.ContainerNames, err.Error()))
 		}
 	}
 	return allErrs
 }
+
+// validateFailurePolicy validates the FailurePolicy, the killed pods or containers can't be rolled back
+func (in *PodChaosSpec) validateFailurePolicy(policyField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if in.FailurePolicy == AllOrNothingFailure && in.Action != PodFailureAction && in.Action != ConfigCorruptAction {
+		err := fmt.Errorf("the chaos can't be rolled back on %s action", in.Action)
+		allErrs = append(allErrs, field.Invalid(policyField, in.FailurePolicy, err.Error()))
+	}
+	return allErrs
+}
+
+// validateSafeguards validates the Safeguards, which only keep the workloads available in pod-kill, pod-failure and pod-reip
+func (in *PodChaosSpec) validateSafeguards(safeguardsField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if in.Safeguards == nil {
+		return allErrs
+	}
+
+	if in.Action == ContainerKillAction || in.Action == ContainerSignalAction || in.Action == ConfigCorruptAction {
+		err := fmt.Errorf("the safeguards aren't supported on %s action", in.Action)
+		allErrs = append(allErrs, field.Invalid(safeguardsField, in.Safeguards, err.Error()))
+	}
+
+	minAvailable := in.Safeguards.MinAvailable
+	minAvailableField := safeguardsField.Child("minAvailable")
+	if minAvailable.Type == intstr.Int {
+		if minAvailable.IntVal < 0 {
+			allErrs = append(allErrs, field.Invalid(minAvailableField, minAvailable.IntVal, "the min available replicas must be non-negative"))
+		}
+	} else {
+		percent, err := strconv.Atoi(strings.TrimSuffix(minAvailable.StrVal, "%"))
+		if !strings.HasSuffix(minAvailable.StrVal, "%") || err != nil || percent < 0 || percent > 100 {
+			allErrs = append(allErrs, field.Invalid(minAvailableField, minAvailable.StrVal, "the min available replicas must be a percentage from 0% to 100%"))
+		}
+	}
+	return allErrs
+}
+
+// maxSignal is the max number of the signals on linux, including the real-time signals
+const maxSignal = 64
+
+// signalNames are the names of the standard signals on linux
+var signalNames = map[string]struct{}{
+	"HUP": {}, "INT": {}, "QUIT": {}, "ILL": {}, "TRAP": {}, "ABRT": {}, "BUS": {}, "FPE": {},
+	"KILL": {}, "USR1": {}, "SEGV": {}, "USR2": {}, "PIPE": {}, "ALRM": {}, "TERM": {}, "STKFLT": {},
+	"CHLD": {}, "CONT": {}, "STOP": {}, "TSTP": {}, "TTIN": {}, "TTOU": {}, "URG": {}, "XCPU": {},
+	"XFSZ": {}, "VTALRM": {}, "PROF": {}, "WINCH": {}, "IO": {}, "PWR": {}, "SYS": {},
+}
+
+// validateSignal validates the Signal, which is required in container-signal
+func (in *PodChaosSpec) validateSignal(signalField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if in.Action != ContainerSignalAction {
+		return allErrs
+	}
+	if in.Signal == nil {
+		err := fmt.Errorf("the signal should not be empty on %s action", in.Action)
+		allErrs = append(allErrs, field.Invalid(signalField, in.Signal, err.Error()))
+		return allErrs
+	}
+
+	nameField := signalField.Child("name")
+	if num, err := strconv.Atoi(in.Signal.Name); err == nil {
+		if num <= 0 || num > maxSignal {
+			allErrs = append(allErrs, field.Invalid(nameField, in.Signal.Name,
+				fmt.Sprintf("the number of signal must be from 1 to %d", maxSignal)))
+		}
+	} else if _, ok := signalNames[strings.TrimPrefix(strings.ToUpper(in.Signal.Name), "SIG")]; !ok {
+		allErrs = append(allErrs, field.Invalid(nameField, in.Signal.Name, "unknown signal"))
+	}
+
+	if in.Signal.Child < 0 {
+		allErrs = append(allErrs, field.Invalid(signalField.Child("child"), in.Signal.Child,
+			"the index of child must be non-negative"))
+	}
+	return allErrs
+}
+
+// validateConfigCorruption validates the ConfigCorruption, which is required in config-corrupt
+func (in *PodChaosSpec) validateConfigCorruption(corruptionField *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if in.Action != ConfigCorruptAction {
+		return allErrs
+	}
+	corruption := in.ConfigCorruption
+	if corruption == nil {
+		err := fmt.Errorf("the config corruption should not be empty on %s action", in.Action)
+		allErrs = append(allErrs, field.Invalid(corruptionField, corruption, err.Error()))
+		return allErrs
+	}
+
+	if corruption.Volume == "" {
+		allErrs = append(allErrs, field.Invalid(corruptionField.Child("volume"), corruption.Volume,
+			"the name of volume should not be empty"))
+	}
+	// the key is a relative path in the volume, which is the same as the path of items in ConfigMap volumes
+	key := corruption.Key
+	if key == "" || path.IsAbs(key) || path.Clean(key) != key || strings.HasPrefix(key, "..") {
+		allErrs = append(allErrs, field.Invalid(corruptionField.Child("key"), key,
+			"the key must be a clean relative path in the volume, which doesn't start with '..'"))
+	}
+
+	switch corruption.Corruption {
+	case "", "truncate", "random", "empty":
+	default:
+		allErrs = append(allErrs, field.Invalid(corruptionField.Child("corruption"), corruption.Corruption,
+			"the corruption must be one of truncate, random and empty"))
+	}
+	if corruption.Content != nil && corruption.Corruption != "" {
+		allErrs = append(allErrs, field.Invalid(corruptionField.Child("corruption"), corruption.Corruption,
+			"the corruption can't be set with the content"))
+	}
+	return allErrs
+}
@@ -17,6 +17,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 var _ = Describe("podchaos_webhook", func() {
@@ -39,6 +40,9 @@ var _ = Describe("podchaos_webhook", func() {
 				expect  string
 			}
 			duration := "400s"
+			content := "level: ???"
+			timeout := "1s"
+			negativeInterval := "-30s"
 			tcs := []TestCase{
 				{
 					name: "simple ValidateCreate for ContainerKillAction",
@@ -56,6 +60,58 @@ var _ = Describe("podchaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate the FailurePolicy",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: PodChaosSpec{
+							Action:        PodKillAction,
+							Scheduler:     &SchedulerSpec{Cron: "@every 1m"},
+							FailurePolicy: AllOrNothingFailure,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the ControlGroupPercent",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: PodChaosSpec{
+							Action:              PodFailureAction,
+							ControlGroupPercent: 100,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the StaggerInterval",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: PodChaosSpec{
+							Action:          PodFailureAction,
+							StaggerInterval: &negativeInterval,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
 				{
 					name: "simple ValidateUpdate for PodKillAction",
 					chaos: PodChaos{
@@ -72,6 +128,182 @@ var _ = Describe("podchaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "ValidateCreate for PodReIPAction without Scheduler",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo20",
+						},
+						Spec: PodChaosSpec{
+							Action: PodReIPAction,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "ValidateCreate for PodReIPAction",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo21",
+						},
+						Spec: PodChaosSpec{
+							Action:    PodReIPAction,
+							Scheduler: &SchedulerSpec{Cron: "@every 10m"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "ValidateCreate for ContainerSignalAction",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo22",
+						},
+						Spec: PodChaosSpec{
+							Action:         ContainerSignalAction,
+							Scheduler:      &SchedulerSpec{Cron: "@every 10m"},
+							ContainerNames: []string{"nginx"},
+							Signal:         &SignalSpec{Name: "SIGHUP", Child: 1},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "ValidateCreate for ContainerSignalAction without Signal",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo23",
+						},
+						Spec: PodChaosSpec{
+							Action:         ContainerSignalAction,
+							Scheduler:      &SchedulerSpec{Cron: "@every 10m"},
+							ContainerNames: []string{"nginx"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "ValidateCreate for ContainerSignalAction with unknown Signal",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo24",
+						},
+						Spec: PodChaosSpec{
+							Action:         ContainerSignalAction,
+							Scheduler:      &SchedulerSpec{Cron: "@every 10m"},
+							ContainerNames: []string{"nginx"},
+							Signal:         &SignalSpec{Name: "SIGFOO"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "ValidateCreate for ContainerSignalAction with out of range Signal",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo25",
+						},
+						Spec: PodChaosSpec{
+							Action:         ContainerSignalAction,
+							Scheduler:      &SchedulerSpec{Cron: "@every 10m"},
+							ContainerNames: []string{"nginx"},
+							Signal:         &SignalSpec{Name: "65"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "ValidateCreate for ConfigCorruptAction",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo26",
+						},
+						Spec: PodChaosSpec{
+							Action:           ConfigCorruptAction,
+							FailurePolicy:    AllOrNothingFailure,
+							ConfigCorruption: &ConfigCorruptionSpec{Volume: "config", Key: "conf/app.yaml", Corruption: "random"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "ValidateCreate for ConfigCorruptAction without ConfigCorruption",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo27",
+						},
+						Spec: PodChaosSpec{
+							Action: ConfigCorruptAction,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "ValidateCreate for ConfigCorruptAction with the key out of the volume",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo28",
+						},
+						Spec: PodChaosSpec{
+							Action:           ConfigCorruptAction,
+							ConfigCorruption: &ConfigCorruptionSpec{Volume: "config", Key: "../app.yaml"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "ValidateCreate for ConfigCorruptAction with both Content and Corruption",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo29",
+						},
+						Spec: PodChaosSpec{
+							Action:           ConfigCorruptAction,
+							ConfigCorruption: &ConfigCorruptionSpec{Volume: "config", Key: "app.yaml", Content: &content, Corruption: "empty"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
 				{
 					name: "simple ValidateDelete",
 					chaos: PodChaos{
@@ -150,16 +382,217 @@ var _ = Describe("podchaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "simple ContainerNames",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo19",
+						},
+						Spec: PodChaosSpec{
+							Action:         ContainerKillAction,
+							ContainerNames: []string{"nginx", "sidecar"},
+							Scheduler:      &SchedulerSpec{Cron: "@every 1m"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "simple Safeguards",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo10",
+						},
+						Spec: PodChaosSpec{
+							Action:     PodKillAction,
+							Scheduler:  &SchedulerSpec{Cron: "@every 1m"},
+							Safeguards: &Safeguards{MinAvailable: intstr.FromString("50%")},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "validate the MinAvailable of Safeguards",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo11",
+						},
+						Spec: PodChaosSpec{
+							Action:     PodKillAction,
+							Scheduler:  &SchedulerSpec{Cron: "@every 1m"},
+							Safeguards: &Safeguards{MinAvailable: intstr.FromString("half")},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the Safeguards on ContainerKillAction",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo12",
+						},
+						Spec: PodChaosSpec{
+							Action:        ContainerKillAction,
+							ContainerName: "nginx",
+							Scheduler:     &SchedulerSpec{Cron: "@every 1m"},
+							Safeguards:    &Safeguards{MinAvailable: intstr.FromInt(1)},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "simple SLO",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: PodChaosSpec{
+							Action:    PodKillAction,
+							Scheduler: &SchedulerSpec{Cron: "@every 1m"},
+							SLO:       &SLOSpec{Query: "sum(rate(http_requests_total{code=~\"5..\"}[1m]))", Threshold: "20", Address: "http://prometheus:9090"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "validate the threshold of SLO",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo14",
+						},
+						Spec: PodChaosSpec{
+							Action:    PodKillAction,
+							Scheduler: &SchedulerSpec{Cron: "@every 1m"},
+							SLO:       &SLOSpec{Query: "up", Threshold: "-1"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the query and address of SLO",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo15",
+						},
+						Spec: PodChaosSpec{
+							Action:    PodKillAction,
+							Scheduler: &SchedulerSpec{Cron: "@every 1m"},
+							SLO:       &SLOSpec{Threshold: "10", Address: "prometheus:9090"},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the load",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo16",
+						},
+						Spec: PodChaosSpec{
+							Action:    PodKillAction,
+							Scheduler: &SchedulerSpec{Cron: "@every 1m"},
+							Load:      &LoadSpec{Target: "http://checkout.shop:8080/healthz", QPS: 50, Timeout: &timeout},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "validate the qps of the load",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo17",
+						},
+						Spec: PodChaosSpec{
+							Action:    PodKillAction,
+							Scheduler: &SchedulerSpec{Cron: "@every 1m"},
+							Load:      &LoadSpec{Target: "http://checkout.shop:8080/healthz", QPS: 5000},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the method of the grpc load",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo18",
+						},
+						Spec: PodChaosSpec{
+							Action:    PodKillAction,
+							Scheduler: &SchedulerSpec{Cron: "@every 1m"},
+							Load:      &LoadSpec{Protocol: LoadProtocolGRPC, Target: "checkout.shop:9090", Method: "Check", QPS: 10},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
 				err := tc.execute(&tc.chaos)
 				if tc.expect == "error" {
-					Expect(err).To(HaveOccurred())
+					Expect(err).To(HaveOccurred(), tc.name)
 				} else {
-					Expect(err).NotTo(HaveOccurred())
+					Expect(err).NotTo(HaveOccurred(), tc.name)
 				}
 			}
 		})
 	})
+
+	Context("DeprecatedUsages", func() {
+		It("should report the deprecated containerName", func() {
+			chaos := PodChaos{
+				Spec: PodChaosSpec{
+					Action:         ContainerKillAction,
+					ContainerName:  "nginx",
+					ContainerNames: []string{"sidecar"},
+				},
+			}
+			Expect(chaos.Spec.GetContainerNames()).To(Equal([]string{"nginx", "sidecar"}))
+
+			usages := chaos.DeprecatedUsages()
+			Expect(usages).To(HaveLen(1))
+			Expect(usages[0].Warning(KindPodChaos)).To(Equal("PodChaos spec.containerName is deprecated, use spec.containerNames instead"))
+
+			chaos.Spec.ContainerName = ""
+			Expect(chaos.DeprecatedUsages()).To(BeEmpty())
+		})
+	})
 })
@@ -34,6 +34,7 @@ func init() {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 
 // StressChaos is the Schema for the stresschaos API
 type StressChaos struct {
@@ -59,7 +60,7 @@ type StressChaosSpec struct {
 	// If `FixedPercentPodMod`, provide a number from 0-100 to specify the max % of pods the server can do chaos action.
 	// If `RandomMaxPercentPodMod`,  provide a number from 0-100 to specify the % of pods to do chaos action
 	// +optional
-	Value string `json:"value"`
+	Value string `json:"value,omitempty"`
 
 	// Selector is used to select pods that are used to inject chaos action.
 	Selector SelectorSpec `json:"selector"`
@@ -83,6 +84,54 @@ type StressChaosSpec struct {
 	// +optional
 	Duration *string `json:"duration,omitempty"`
 
+	// RecoveryTimeout is the time limit for recovering the chaos when it's deleted, such as "5m".
+	// If the recovery isn't completed in time, the chaos is cleaned up forcibly.
+	// +optional
+	RecoveryTimeout *string `json:"recoveryTimeout,omitempty"`
+
+	// Simulate runs the chaos without injecting it. The targets are selected, and the status, the events
+	// and the metrics are recorded as usual, but chaos-daemon isn't called and the targets are left untouched.
+	// +optional
+	Simulate bool `json:"simulate,omitempty"`
+
+	// SLO is the service level objective which judges the runs of the chaos by a Prometheus query.
+	// +optional
+	SLO *SLOSpec `json:"slo,omitempty"`
+
+	// Load is the load which is generated against a service during the runs of the chaos.
+	// +optional
+	Load *LoadSpec `json:"load,omitempty"`
+
+	// SelectorRetryPolicy defines how to retry the selection when no pod meets the selector.
+	// It only applies to the chaos without scheduler, since the scheduled chaos selects again in the next run.
+	// +optional
+	SelectorRetryPolicy *SelectorRetryPolicy `json:"selectorRetryPolicy,omitempty"`
+
+	// FailurePolicy specifies how to handle the chaos which failed to be applied on some of the pods.
+	// Valid values are:
+	// - "AllOrNothing": rolls back the chaos from all the pods, and the experiment fails;
+	// - "BestEffort": keeps the chaos on the pods which it's applied on, and the experiment proceeds
+	// unless it failed to be applied on all the pods.
+	// If it's omitted, the experiment fails and only the failed pods are applied when it's retried.
+	// The errors of the failed pods are recorded in the status.
+	// +optional
+	// +kubebuilder:validation:Enum=AllOrNothing;BestEffort;""
+	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// ControlGroupPercent is the percentage of the selected pods which are left untouched as the control group,
+	// so the treated pods can be compared with the untreated ones.
+	// The control group is recorded in the status, and at least one pod is treated.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=99
+	ControlGroupPercent int `json:"controlGroupPercent,omitempty"`
+
+	// StaggerInterval spreads the injection across the selected pods over time, e.g. "30s" injects
+	// the chaos into one pod every 30 seconds instead of all the pods at once. The duration starts
+	// when the first pod is injected, and the pods not injected yet are skipped once it's recovered.
+	// +optional
+	StaggerInterval *string `json:"staggerInterval,omitempty"`
+
 	// Scheduler defines some schedule rules to control the running time of the chaos experiment about time.
 	// +optional
 	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
@@ -123,17 +172,30 @@ type StressInstance struct {
 
 // GetDuration gets the duration of StressChaos
 func (in *StressChaos) GetDuration() (*time.Duration, error) {
-	if in.Spec.Duration == nil {
-		return nil, nil
-	}
-	duration, err := time.ParseDuration(*in.Spec.Duration)
-	if err != nil {
-		return nil, err
-	}
-	return &duration, nil
+	return parseDurationPtr(in.Spec.Duration)
 }
 
 // GetNextStart gets NextStart field of StressChaos
+// GetRecoveryTimeout would return the recovery timeout for chaos
+func (in *StressChaos) GetRecoveryTimeout() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.RecoveryTimeout)
+}
+
+// GetSLO returns the service level objective which judges the runs of chaos
+func (in *StressChaos) GetSLO() *SLOSpec {
+	return in.Spec.SLO
+}
+
+// IsSimulated returns true if the chaos runs without being injected
+func (in *StressChaos) IsSimulated() bool {
+	return in.Spec.Simulate
+}
+
+// GetLoad returns the load which is generated against a service during the runs of chaos
+func (in *StressChaos) GetLoad() *LoadSpec {
+	return in.Spec.Load
+}
+
 func (in *StressChaos) GetNextStart() time.Time {
 	if in.Status.Scheduler.NextStart == nil {
 		return time.Time{}
@@ -198,6 +260,31 @@ func (in *StressChaos) IsPaused() bool {
 	return true
 }
 
+// GetSelectorSpecs returns the selectors of chaos
+func (in *StressChaos) GetSelectorSpecs() []SelectorSpec {
+	return []SelectorSpec{in.Spec.Selector}
+}
+
+// GetSelectorRetryPolicy returns how to retry the selection when no pod meets the selectors
+func (in *StressChaos) GetSelectorRetryPolicy() *SelectorRetryPolicy {
+	return in.Spec.SelectorRetryPolicy
+}
+
+// GetFailurePolicy returns how to handle the chaos which failed to be applied on some of the pods
+func (in *StressChaos) GetFailurePolicy() FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetControlGroupPercent returns the percentage of the selected pods which are left untouched
+func (in *StressChaos) GetControlGroupPercent() int {
+	return in.Spec.ControlGroupPercent
+}
+
+// GetStaggerInterval returns the interval between the injections into the selected pods
+func (in *StressChaos) GetStaggerInterval() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.StaggerInterval)
+}
+
 // GetChaos returns a chaos instance
 func (in *StressChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -226,9 +313,22 @@ type Stressors struct {
 	// CPUStressor stresses CPU out
 	// +optional
 	CPUStressor *CPUStressor `json:"cpu,omitempty"`
+	// MemoryBandwidthStressor saturates the memory bandwidth or thrashes the last level cache
+	// +optional
+	MemoryBandwidthStressor *MemoryBandwidthStressor `json:"memoryBandwidth,omitempty"`
+	// FileDescriptorStressor exhausts the file descriptors of the target process
+	// +optional
+	FileDescriptorStressor *FileDescriptorStressor `json:"fileDescriptor,omitempty"`
+	// ThreadStressor exhausts the pids of the target pod, which limit its threads
+	// +optional
+	ThreadStressor *ThreadStressor `json:"thread,omitempty"`
+	// EphemeralStorageStressor fills the writable layer or the emptyDir volume of the target container
+	// +optional
+	EphemeralStorageStressor *EphemeralStorageStressor `json:"ephemeralStorage,omitempty"`
 }
 
-// Normalize the stressors to comply with stress-ng
+// Normalize the stressors to comply with stress-ng. The fileDescriptor, thread and ephemeralStorage
+// stressors aren't run by stress-ng, so they're left out.
 func (in *Stressors) Normalize() (string, error) {
 	stressors := ""
 	if in.MemoryStressor != nil {
@@ -258,6 +358,14 @@ func (in *Stressors) Normalize() (string, error) {
 			stressors += fmt.Sprintf(" --cpu-load %d",
 				*in.CPUStressor.Load)
 		}
+		// the cores of the target are only known by chaos-daemon, which pins the workers to them
+		if len(in.CPUStressor.CPUAffinity) != 0 && in.CPUStressor.CPUAffinity != CPUAffinityTarget {
+			stressors += fmt.Sprintf(" --taskset %s", in.CPUStressor.CPUAffinity)
+		}
+		if in.CPUStressor.RealtimePriority != nil {
+			stressors += fmt.Sprintf(" --sched fifo --sched-prio %d",
+				*in.CPUStressor.RealtimePriority)
+		}
 
 		if in.CPUStressor.Options != nil {
 			for _, v := range in.CPUStressor.Options {
@@ -265,6 +373,30 @@ func (in *Stressors) Normalize() (string, error) {
 			}
 		}
 	}
+	if in.MemoryBandwidthStressor != nil {
+		switch in.MemoryBandwidthStressor.Type {
+		case CacheMemoryBandwidthStressor:
+			stressors += fmt.Sprintf(" --cache %d", in.MemoryBandwidthStressor.Workers)
+			if in.MemoryBandwidthStressor.CacheWays != nil {
+				stressors += fmt.Sprintf(" --cache-ways %d", *in.MemoryBandwidthStressor.CacheWays)
+			}
+		default:
+			stressors += fmt.Sprintf(" --stream %d", in.MemoryBandwidthStressor.Workers)
+			if len(in.MemoryBandwidthStressor.Size) != 0 {
+				size, err := units.FromHumanSize(in.MemoryBandwidthStressor.Size)
+				if err != nil {
+					return "", err
+				}
+				stressors += fmt.Sprintf(" --stream-l3-size %d", size)
+			}
+		}
+
+		if in.MemoryBandwidthStressor.Options != nil {
+			for _, v := range in.MemoryBandwidthStressor.Options {
+				stressors += fmt.Sprintf(" %v ", v)
+			}
+		}
+	}
 	return stressors, nil
 }
 
@@ -286,9 +418,13 @@ type MemoryStressor struct {
 
 	// extend stress-ng options
 	// +optional
+	// +listType=atomic
 	Options []string `json:"options,omitempty"`
 }
 
+// CPUAffinityTarget pins the CPU workers to the same cores as the target process
+const CPUAffinityTarget = "target"
+
 // CPUStressor defines how to stress CPU out
 type CPUStressor struct {
 	Stressor `json:",inline"`
@@ -297,11 +433,110 @@ type CPUStressor struct {
 	// +optional
 	Load *int `json:"load,omitempty"`
 
+	// CPUAffinity pins the workers to the cores, which is either a list of cores such as "0-3,6"
+	// or "target" to use the same cores as the target process. The memory workers are pinned
+	// as well, since they're run by the same stress-ng process.
+	// +optional
+	CPUAffinity string `json:"cpuAffinity,omitempty"`
+
+	// Nice specifies the nice value of the workers, from -20 to 19.
+	// +optional
+	Nice *int `json:"nice,omitempty"`
+
+	// RealtimePriority runs the workers by the FIFO real-time scheduler with the priority,
+	// from 1 to 99. It can't be set with Nice, which doesn't apply to the real-time scheduler.
+	// +optional
+	RealtimePriority *int `json:"realtimePriority,omitempty"`
+
+	// extend stress-ng options
+	// +optional
+	// +listType=atomic
+	Options []string `json:"options,omitempty"`
+}
+
+// MemoryBandwidthStressorType is the way to stress the memory bandwidth
+type MemoryBandwidthStressorType string
+
+const (
+	// StreamMemoryBandwidthStressor saturates the memory bandwidth by copying the arrays which are
+	// larger than the last level cache
+	StreamMemoryBandwidthStressor MemoryBandwidthStressorType = "stream"
+	// CacheMemoryBandwidthStressor thrashes the last level cache by reading and writing it randomly
+	CacheMemoryBandwidthStressor MemoryBandwidthStressorType = "cache"
+)
+
+// MemoryBandwidthStressor defines how to contend for the memory bandwidth and the last level cache,
+// which hurts the latency-sensitive workloads without pressure on their memory
+type MemoryBandwidthStressor struct {
+	Stressor `json:",inline"`
+
+	// Type specifies the way to stress, stream saturates the memory bandwidth and cache thrashes
+	// the last level cache. Default is stream.
+	// +kubebuilder:validation:Enum=stream;cache
+	// +optional
+	Type MemoryBandwidthStressorType `json:"type,omitempty"`
+
+	// Size specifies the size of the arrays copied by each stream worker in units of B, KB/KiB,
+	// MB/MiB, GB/GiB, the larger size takes more bandwidth. Default is 4 times the size of the
+	// last level cache. It's only used by stream.
+	// +optional
+	Size string `json:"size,omitempty"`
+
+	// CacheWays specifies the number of the ways of the last level cache which are thrashed,
+	// the fewer ways thrash a smaller part of the cache. Default is all the ways. It's only used by cache.
+	// +optional
+	CacheWays *int `json:"cacheWays,omitempty"`
+
 	// extend stress-ng options
 	// +optional
+	// +listType=atomic
 	Options []string `json:"options,omitempty"`
 }
 
+// FileDescriptorStressor defines how to exhaust the file descriptors of the target process.
+// The file descriptors are opened in the target process, so that it fails with EMFILE on
+// opening files or accepting connections.
+type FileDescriptorStressor struct {
+	// Percent specifies the percent of the soft limit of open files of the target process
+	// which is used, including the file descriptors already opened by the process.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Percent int `json:"percent"`
+}
+
+// ThreadStressor defines how to exhaust the pids of the target pod. The pids are held by the
+// processes spawned in the pids cgroup of the pod, so that the pod fails with EAGAIN on
+// creating threads or processes.
+type ThreadStressor struct {
+	// Percent specifies the percent of the max pids of the pod which is used, including the
+	// threads and processes already run in the pod.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Percent int `json:"percent"`
+}
+
+// EphemeralStorageStressor defines how to fill the ephemeral storage of the target container.
+// A file is created in the directory, so that the container fails with ENOSPC on writing it or
+// is evicted by kubelet for exceeding the limit. The file is removed on recovery.
+type EphemeralStorageStressor struct {
+	// Percent specifies the percent of the limit which is used, including the storage already used
+	// in the directory. The limit is exceeded if it's more than 100, which gets the pod evicted.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=200
+	Percent int `json:"percent"`
+
+	// Volume is the name of the emptyDir volume mounted in the target container, which is filled
+	// at its mount path. The limit is the size limit of the volume, or the ephemeral storage limit of
+	// the pod if the size isn't limited.
+	// +optional
+	Volume string `json:"volume,omitempty"`
+
+	// Path is the directory in the writable layer of the target container which is filled, the
+	// limit is the ephemeral storage limit of the container. Default is /tmp. It can't be set with Volume.
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 
 // StressChaosList contains a list of StressChaos
@@ -16,7 +16,9 @@ package v1alpha1
 import (
 	"errors"
 	"fmt"
+	"path"
 	"strconv"
+	"strings"
 
 	"github.com/docker/go-units"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -52,6 +54,7 @@ var _ webhook.Defaulter = &StressChaos{}
 func (in *StressChaos) Default() {
 	stressChaosLog.Info("default", "name", in.Name)
 	in.Spec.Selector.DefaultNamespace(in.GetNamespace())
+	DefaultSchedulerAndDuration(in.Spec.Scheduler, in.Spec.Duration)
 }
 
 // +kubebuilder:webhook:verbs=create;update,path=/validate-chaos-mesh-org-v1alpha1-stresschaos,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=stresschaos,versions=v1alpha1,name=vstresschaos.kb.io
@@ -83,7 +86,14 @@ func (in *StressChaos) Validate() error {
 	root := field.NewPath("stresschaos")
 	errs := in.Spec.Validate(root)
 	errs = append(errs, in.ValidatePodMode(root)...)
+	errs = append(errs, in.ValidateSelector(root.Child("spec"))...)
 	errs = append(errs, in.ValidateScheduler(root.Child("spec"))...)
+	errs = append(errs, ValidateRecoveryTimeout(in, root.Child("spec"))...)
+	errs = append(errs, ValidateSLO(in.Spec.SLO, root.Child("spec"))...)
+	errs = append(errs, ValidateLoad(in.Spec.Load, root.Child("spec"))...)
+	errs = append(errs, ValidateSelectorRetryPolicy(in.Spec.SelectorRetryPolicy, in.Spec.Scheduler != nil, root.Child("spec"))...)
+	errs = append(errs, ValidateControlGroupPercent(in.Spec.ControlGroupPercent, root.Child("spec"))...)
+	errs = append(errs, ValidateStaggerInterval(in, root.Child("spec"))...)
 	if len(errs) > 0 {
 		return fmt.Errorf(errs.ToAggregate().Error())
 	}
@@ -95,6 +105,11 @@ func (in *StressChaos) ValidatePodMode(spec *field.Path) field.ErrorList {
 	return ValidatePodMode(in.Spec.Value, in.Spec.Mode, spec.Child("value"))
 }
 
+// ValidateSelector validates the selector with podmode
+func (in *StressChaos) ValidateSelector(spec *field.Path) field.ErrorList {
+	return ValidateSelector(in.Spec.Selector, in.Spec.Mode, spec.Child("selector"))
+}
+
 // ValidateScheduler validates whether scheduler is well defined
 func (in *StressChaos) ValidateScheduler(spec *field.Path) field.ErrorList {
 	return ValidateScheduler(in, spec)
@@ -125,6 +140,22 @@ func (in *Stressors) Validate(parent *field.Path) field.ErrorList {
 		errs = append(errs, in.CPUStressor.Validate(current)...)
 		once = true
 	}
+	if in.MemoryBandwidthStressor != nil {
+		errs = append(errs, in.MemoryBandwidthStressor.Validate(current)...)
+		once = true
+	}
+	if in.FileDescriptorStressor != nil {
+		errs = append(errs, in.FileDescriptorStressor.Validate(current)...)
+		once = true
+	}
+	if in.ThreadStressor != nil {
+		errs = append(errs, in.ThreadStressor.Validate(current)...)
+		once = true
+	}
+	if in.EphemeralStorageStressor != nil {
+		errs = append(errs, in.EphemeralStorageStressor.Validate(current)...)
+		once = true
+	}
 	if !once {
 		errs = append(errs, field.Invalid(current, in, "missing stressors"))
 	}
@@ -183,5 +214,110 @@ func (in *CPUStressor) Validate(parent *field.Path) field.ErrorList {
 	if in.Load != nil && (*in.Load < 0 || *in.Load > 100) {
 		errs = append(errs, field.Invalid(current, in, "illegal proportion"))
 	}
+	if len(in.CPUAffinity) != 0 && in.CPUAffinity != CPUAffinityTarget {
+		if err := validateCPUList(in.CPUAffinity); err != nil {
+			errs = append(errs, field.Invalid(current.Child("cpuAffinity"), in.CPUAffinity,
+				fmt.Sprintf("incorrect cpu list: %s", err)))
+		}
+	}
+	if in.Nice != nil && (*in.Nice < -20 || *in.Nice > 19) {
+		errs = append(errs, field.Invalid(current.Child("nice"), *in.Nice, "nice should be from -20 to 19"))
+	}
+	if in.RealtimePriority != nil {
+		if *in.RealtimePriority < 1 || *in.RealtimePriority > 99 {
+			errs = append(errs, field.Invalid(current.Child("realtimePriority"), *in.RealtimePriority,
+				"real-time priority should be from 1 to 99"))
+		}
+		if in.Nice != nil {
+			errs = append(errs, field.Invalid(current.Child("nice"), *in.Nice,
+				"nice can't be set with the real-time priority"))
+		}
+	}
+	return errs
+}
+
+// Validate validates whether the MemoryBandwidthStressor is well defined
+func (in *MemoryBandwidthStressor) Validate(parent *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	current := parent.Child("memoryBandwidth")
+	errs = append(errs, in.Stressor.Validate(current)...)
+	switch in.Type {
+	case "", StreamMemoryBandwidthStressor:
+		if in.CacheWays != nil {
+			errs = append(errs, field.Invalid(current.Child("cacheWays"), *in.CacheWays,
+				fmt.Sprintf("cache ways are only used by the %s stressor", CacheMemoryBandwidthStressor)))
+		}
+		if len(in.Size) != 0 {
+			if size, err := units.FromHumanSize(in.Size); err != nil || size <= 0 {
+				errs = append(errs, field.Invalid(current.Child("size"), in.Size, "incorrect bytes format"))
+			}
+		}
+	case CacheMemoryBandwidthStressor:
+		if len(in.Size) != 0 {
+			errs = append(errs, field.Invalid(current.Child("size"), in.Size,
+				fmt.Sprintf("size is only used by the %s stressor", StreamMemoryBandwidthStressor)))
+		}
+		if in.CacheWays != nil && *in.CacheWays <= 0 {
+			errs = append(errs, field.Invalid(current.Child("cacheWays"), *in.CacheWays, "cache ways should always be positive"))
+		}
+	default:
+		errs = append(errs, field.Invalid(current.Child("type"), in.Type, "unknown type of memory bandwidth stressor"))
+	}
 	return errs
 }
+
+// Validate validates whether the FileDescriptorStressor is well defined
+func (in *FileDescriptorStressor) Validate(parent *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	current := parent.Child("fileDescriptor")
+	if in.Percent <= 0 || in.Percent > 100 {
+		errs = append(errs, field.Invalid(current.Child("percent"), in.Percent, "percent should be from 1 to 100"))
+	}
+	return errs
+}
+
+// Validate validates whether the ThreadStressor is well defined
+func (in *ThreadStressor) Validate(parent *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	current := parent.Child("thread")
+	if in.Percent <= 0 || in.Percent > 100 {
+		errs = append(errs, field.Invalid(current.Child("percent"), in.Percent, "percent should be from 1 to 100"))
+	}
+	return errs
+}
+
+// Validate validates whether the EphemeralStorageStressor is well defined
+func (in *EphemeralStorageStressor) Validate(parent *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	current := parent.Child("ephemeralStorage")
+	if in.Percent <= 0 || in.Percent > 200 {
+		errs = append(errs, field.Invalid(current.Child("percent"), in.Percent, "percent should be from 1 to 200"))
+	}
+	if len(in.Path) != 0 {
+		if len(in.Volume) != 0 {
+			errs = append(errs, field.Invalid(current.Child("path"), in.Path, "path can't be set with volume"))
+		} else if !path.IsAbs(in.Path) {
+			errs = append(errs, field.Invalid(current.Child("path"), in.Path, "path should be absolute"))
+		}
+	}
+	return errs
+}
+
+// validateCPUList validates the list of cores in the format of taskset, such as 0-3,6
+func validateCPUList(list string) error {
+	for _, part := range strings.Split(list, ",") {
+		bounds := strings.SplitN(part, "-", 2)
+		first, err := strconv.Atoi(bounds[0])
+		if err != nil || first < 0 {
+			return fmt.Errorf("invalid core %q", bounds[0])
+		}
+		if len(bounds) == 1 {
+			continue
+		}
+		last, err := strconv.Atoi(bounds[1])
+		if err != nil || last < first {
+			return fmt.Errorf("invalid range of cores %q", part)
+		}
+	}
+	return nil
+}
@@ -181,6 +181,106 @@ var _ = Describe("stresschaos_webhook", func() {
 					},
 					errs: 0,
 				},
+				{
+					name: "default MemoryBandwidthStressor",
+					stressor: &MemoryBandwidthStressor{
+						Stressor: Stressor{Workers: 1},
+						Size:     "64MiB",
+					},
+					errs: 0,
+				},
+				{
+					name: "MemoryBandwidthStressor thrashing the cache",
+					stressor: &MemoryBandwidthStressor{
+						Stressor:  Stressor{Workers: 1},
+						Type:      CacheMemoryBandwidthStressor,
+						CacheWays: &[]int{4}[0],
+					},
+					errs: 0,
+				},
+				{
+					name: "MemoryBandwidthStressor with the options of the other type",
+					stressor: &MemoryBandwidthStressor{
+						Stressor:  Stressor{Workers: 1},
+						Type:      StreamMemoryBandwidthStressor,
+						CacheWays: &[]int{4}[0],
+					},
+					errs: 1,
+				},
+				{
+					name: "CPUStressor pinned to the cores of the target",
+					stressor: &CPUStressor{
+						Stressor:    Stressor{Workers: 1},
+						CPUAffinity: CPUAffinityTarget,
+						Nice:        &[]int{-5}[0],
+					},
+					errs: 0,
+				},
+				{
+					name: "CPUStressor pinned to the listed cores",
+					stressor: &CPUStressor{
+						Stressor:         Stressor{Workers: 1},
+						CPUAffinity:      "0-3,6",
+						RealtimePriority: &[]int{10}[0],
+					},
+					errs: 0,
+				},
+				{
+					name: "CPUStressor with invalid cores",
+					stressor: &CPUStressor{
+						Stressor:    Stressor{Workers: 1},
+						CPUAffinity: "3-1,x",
+					},
+					errs: 1,
+				},
+				{
+					name: "CPUStressor with both nice and real-time priority",
+					stressor: &CPUStressor{
+						Stressor:         Stressor{Workers: 1},
+						Nice:             &[]int{20}[0],
+						RealtimePriority: &[]int{100}[0],
+					},
+					errs: 3,
+				},
+				{
+					name: "FileDescriptorStressor",
+					stressor: &FileDescriptorStressor{
+						Percent: 90,
+					},
+					errs: 0,
+				},
+				{
+					name: "ThreadStressor with invalid percent",
+					stressor: &ThreadStressor{
+						Percent: 120,
+					},
+					errs: 1,
+				},
+				{
+					name: "EphemeralStorageStressor exceeding the limit of the volume",
+					stressor: &EphemeralStorageStressor{
+						Percent: 150,
+						Volume:  "cache",
+					},
+					errs: 0,
+				},
+				{
+					name: "EphemeralStorageStressor with both volume and path",
+					stressor: &EphemeralStorageStressor{
+						Percent: 90,
+						Volume:  "cache",
+						Path:    "/var/cache",
+					},
+					errs: 1,
+				},
+				{
+					name: "EphemeralStorageStressor with relative path",
+					stressor: &EphemeralStorageStressor{
+						Percent: 300,
+						Path:    "tmp",
+					},
+					errs: 2,
+				},
 			}
 			parent := field.NewPath("parent")
 			for _, tc := range tcs {
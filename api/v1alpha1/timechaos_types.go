@@ -30,6 +30,7 @@ func init() {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 
 // TimeChaos is the Schema for the timechaos API
 type TimeChaos struct {
@@ -48,7 +49,6 @@ type TimeChaos struct {
 type TimeChaosSpec struct {
 	// Mode defines the mode to run chaos action.
 	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
-	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent
 	Mode PodMode `json:"mode"`
 
 	// Value is required when the mode is set to `FixedPodMode` / `FixedPercentPodMod` / `RandomMaxPercentPodMod`.
@@ -56,30 +56,89 @@ type TimeChaosSpec struct {
 	// If `FixedPercentPodMod`, provide a number from 0-100 to specify the percent of pods the server can do chaos action.
 	// If `RandomMaxPercentPodMod`,  provide a number from 0-100 to specify the max percent of pods to do chaos action
 	// +optional
-	Value string `json:"value"`
+	Value string `json:"value,omitempty"`
 
 	// Selector is used to select pods that are used to inject chaos action.
 	Selector SelectorSpec `json:"selector"`
 
 	// TimeOffset defines the delta time of injected program. It's a possibly signed sequence of decimal numbers, such as
 	// "300ms", "-1.5h" or "2h45m". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
-	TimeOffset string `json:"timeOffset"`
+	// At least one of TimeOffset and TimerSlack should be specified.
+	// +optional
+	TimeOffset string `json:"timeOffset,omitempty"`
+
+	// TimerSlack delays the timers of the injected program by up to the slack, such as "10ms", which breaks
+	// the precision of nanosleep, the timeouts of poll, epoll_wait and select, and the futex waits.
+	// The slack is set on all the threads of the program and reset to the default on recovery.
+	// It doesn't apply to timerfd and the threads scheduled by a real-time policy.
+	// +optional
+	TimerSlack string `json:"timerSlack,omitempty"`
 
 	// ClockIds defines all affected clock id
 	// All available options are ["CLOCK_REALTIME","CLOCK_MONOTONIC","CLOCK_PROCESS_CPUTIME_ID","CLOCK_THREAD_CPUTIME_ID",
 	// "CLOCK_MONOTONIC_RAW","CLOCK_REALTIME_COARSE","CLOCK_MONOTONIC_COARSE","CLOCK_BOOTTIME","CLOCK_REALTIME_ALARM",
 	// "CLOCK_BOOTTIME_ALARM"]
 	// Default value is ["CLOCK_REALTIME"]
+	// +listType=atomic
 	ClockIds []string `json:"clockIds,omitempty"`
 
 	// ContainerName indicates the name of affected container.
 	// If not set, all containers will be injected
 	// +optional
+	// +listType=atomic
 	ContainerNames []string `json:"containerNames,omitempty"`
 
 	// Duration represents the duration of the chaos action
 	Duration *string `json:"duration,omitempty"`
 
+	// RecoveryTimeout is the time limit for recovering the chaos when it's deleted, such as "5m".
+	// If the recovery isn't completed in time, the chaos is cleaned up forcibly.
+	// +optional
+	RecoveryTimeout *string `json:"recoveryTimeout,omitempty"`
+
+	// Simulate runs the chaos without injecting it. The targets are selected, and the status, the events
+	// and the metrics are recorded as usual, but chaos-daemon isn't called and the targets are left untouched.
+	// +optional
+	Simulate bool `json:"simulate,omitempty"`
+
+	// SLO is the service level objective which judges the runs of the chaos by a Prometheus query.
+	// +optional
+	SLO *SLOSpec `json:"slo,omitempty"`
+
+	// Load is the load which is generated against a service during the runs of the chaos.
+	// +optional
+	Load *LoadSpec `json:"load,omitempty"`
+
+	// SelectorRetryPolicy defines how to retry the selection when no pod meets the selector.
+	// It only applies to the chaos without scheduler, since the scheduled chaos selects again in the next run.
+	// +optional
+	SelectorRetryPolicy *SelectorRetryPolicy `json:"selectorRetryPolicy,omitempty"`
+
+	// FailurePolicy specifies how to handle the chaos which failed to be applied on some of the pods.
+	// Valid values are:
+	// - "AllOrNothing": rolls back the chaos from all the pods, and the experiment fails;
+	// - "BestEffort": keeps the chaos on the pods which it's applied on, and the experiment proceeds
+	// unless it failed to be applied on all the pods.
+	// If it's omitted, the experiment fails and only the failed pods are applied when it's retried.
+	// The errors of the failed pods are recorded in the status.
+	// +optional
+	// +kubebuilder:validation:Enum=AllOrNothing;BestEffort;""
+	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// ControlGroupPercent is the percentage of the selected pods which are left untouched as the control group,
+	// so the treated pods can be compared with the untreated ones.
+	// The control group is recorded in the status, and at least one pod is treated.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=99
+	ControlGroupPercent int `json:"controlGroupPercent,omitempty"`
+
+	// StaggerInterval spreads the injection across the selected pods over time, e.g. "30s" injects
+	// the chaos into one pod every 30 seconds instead of all the pods at once. The duration starts
+	// when the first pod is injected, and the pods not injected yet are skipped once it's recovered.
+	// +optional
+	StaggerInterval *string `json:"staggerInterval,omitempty"`
+
 	// Scheduler defines some schedule rules to control the running time of the chaos experiment about time.
 	Scheduler *SchedulerSpec `json:"scheduler,omitempty"`
 }
@@ -118,17 +177,30 @@ type TimeChaosStatus struct {
 
 // GetDuration gets the duration of TimeChaos
 func (in *TimeChaos) GetDuration() (*time.Duration, error) {
-	if in.Spec.Duration == nil {
-		return nil, nil
-	}
-	duration, err := time.ParseDuration(*in.Spec.Duration)
-	if err != nil {
-		return nil, err
-	}
-	return &duration, nil
+	return parseDurationPtr(in.Spec.Duration)
 }
 
 // GetNextStart gets NextStart field of TimeChaos
+// GetRecoveryTimeout would return the recovery timeout for chaos
+func (in *TimeChaos) GetRecoveryTimeout() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.RecoveryTimeout)
+}
+
+// GetSLO returns the service level objective which judges the runs of chaos
+func (in *TimeChaos) GetSLO() *SLOSpec {
+	return in.Spec.SLO
+}
+
+// IsSimulated returns true if the chaos runs without being injected
+func (in *TimeChaos) IsSimulated() bool {
+	return in.Spec.Simulate
+}
+
+// GetLoad returns the load which is generated against a service during the runs of chaos
+func (in *TimeChaos) GetLoad() *LoadSpec {
+	return in.Spec.Load
+}
+
 func (in *TimeChaos) GetNextStart() time.Time {
 	if in.Status.Scheduler.NextStart == nil {
 		return time.Time{}
@@ -193,6 +265,31 @@ func (in *TimeChaos) IsPaused() bool {
 	return true
 }
 
+// GetSelectorSpecs returns the selectors of chaos
+func (in *TimeChaos) GetSelectorSpecs() []SelectorSpec {
+	return []SelectorSpec{in.Spec.Selector}
+}
+
+// GetSelectorRetryPolicy returns how to retry the selection when no pod meets the selectors
+func (in *TimeChaos) GetSelectorRetryPolicy() *SelectorRetryPolicy {
+	return in.Spec.SelectorRetryPolicy
+}
+
+// GetFailurePolicy returns how to handle the chaos which failed to be applied on some of the pods
+func (in *TimeChaos) GetFailurePolicy() FailurePolicy {
+	return in.Spec.FailurePolicy
+}
+
+// GetControlGroupPercent returns the percentage of the selected pods which are left untouched
+func (in *TimeChaos) GetControlGroupPercent() int {
+	return in.Spec.ControlGroupPercent
+}
+
+// GetStaggerInterval returns the interval between the injections into the selected pods
+func (in *TimeChaos) GetStaggerInterval() (*time.Duration, error) {
+	return parseDurationPtr(in.Spec.StaggerInterval)
+}
+
 // GetChaos returns a chaos instance
 func (in *TimeChaos) GetChaos() *ChaosInstance {
 	instance := &ChaosInstance{
@@ -43,6 +43,7 @@ func (in *TimeChaos) Default() {
 	timechaoslog.Info("default", "name", in.Name)
 
 	in.Spec.Selector.DefaultNamespace(in.GetNamespace())
+	DefaultSchedulerAndDuration(in.Spec.Scheduler, in.Spec.Duration)
 	in.Spec.DefaultClockIds()
 }
 
@@ -74,8 +75,16 @@ func (in *TimeChaos) ValidateDelete() error {
 func (in *TimeChaos) Validate() error {
 	specField := field.NewPath("spec")
 	allErrs := in.ValidateScheduler(specField)
+	allErrs = append(allErrs, ValidateRecoveryTimeout(in, specField)...)
+	allErrs = append(allErrs, ValidateSLO(in.Spec.SLO, specField)...)
+	allErrs = append(allErrs, ValidateLoad(in.Spec.Load, specField)...)
+	allErrs = append(allErrs, ValidateSelectorRetryPolicy(in.Spec.SelectorRetryPolicy, in.Spec.Scheduler != nil, specField)...)
 	allErrs = append(allErrs, in.ValidatePodMode(specField)...)
+	allErrs = append(allErrs, in.ValidateSelector(specField)...)
 	allErrs = append(allErrs, in.Spec.validateTimeOffset(specField.Child("timeOffset"))...)
+	allErrs = append(allErrs, in.Spec.validateTimerSlack(specField.Child("timerSlack"))...)
+	allErrs = append(allErrs, ValidateControlGroupPercent(in.Spec.ControlGroupPercent, specField)...)
+	allErrs = append(allErrs, ValidateStaggerInterval(in, specField)...)
 
 	if len(allErrs) > 0 {
 		return fmt.Errorf(allErrs.ToAggregate().Error())
@@ -93,10 +102,18 @@ func (in *TimeChaos) ValidatePodMode(spec *field.Path) field.ErrorList {
 	return ValidatePodMode(in.Spec.Value, in.Spec.Mode, spec.Child("value"))
 }
 
-// validateTimeOffset validates the timeOffset
+// ValidateSelector validates the selector with podmode
+func (in *TimeChaos) ValidateSelector(spec *field.Path) field.ErrorList {
+	return ValidateSelector(in.Spec.Selector, in.Spec.Mode, spec.Child("selector"))
+}
+
+// validateTimeOffset validates the timeOffset, which can be omitted if the timerSlack is set
 func (in *TimeChaosSpec) validateTimeOffset(timeOffset *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	if len(in.TimeOffset) == 0 && len(in.TimerSlack) != 0 {
+		return allErrs
+	}
 	_, err := time.ParseDuration(in.TimeOffset)
 	if err != nil {
 		allErrs = append(allErrs, field.Invalid(timeOffset,
@@ -106,3 +123,24 @@ func (in *TimeChaosSpec) validateTimeOffset(timeOffset *field.Path) field.ErrorL
 
 	return allErrs
 }
+
+// validateTimerSlack validates the timerSlack
+func (in *TimeChaosSpec) validateTimerSlack(timerSlack *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(in.TimerSlack) == 0 {
+		return allErrs
+	}
+	slack, err := time.ParseDuration(in.TimerSlack)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(timerSlack,
+			in.TimerSlack,
+			fmt.Sprintf("parse timerSlack field error:%s", err)))
+	} else if slack <= 0 {
+		allErrs = append(allErrs, field.Invalid(timerSlack,
+			in.TimerSlack,
+			"timerSlack should be positive"))
+	}
+
+	return allErrs
+}
@@ -135,6 +135,38 @@ var _ = Describe("timechaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate the timerSlack without timeOffset",
+					chaos: TimeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: TimeChaosSpec{
+							TimerSlack: "10ms",
+						},
+					},
+					execute: func(chaos *TimeChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "validate the negative timerSlack",
+					chaos: TimeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: TimeChaosSpec{
+							TimerSlack: "-10ms",
+						},
+					},
+					execute: func(chaos *TimeChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
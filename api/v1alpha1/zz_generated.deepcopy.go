@@ -1,3 +1,4 @@
+//go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
 // Copyright PingCAP, Inc.
@@ -18,9 +19,30 @@
 package v1alpha1
 
 import (
+	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveHours) DeepCopyInto(out *ActiveHours) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveHours.
+func (in *ActiveHours) DeepCopy() *ActiveHours {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveHours)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BandwidthSpec) DeepCopyInto(out *BandwidthSpec) {
 	*out = *in
@@ -55,6 +77,16 @@ func (in *CPUStressor) DeepCopyInto(out *CPUStressor) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Nice != nil {
+		in, out := &in.Nice, &out.Nice
+		*out = new(int)
+		**out = **in
+	}
+	if in.RealtimePriority != nil {
+		in, out := &in.RealtimePriority, &out.RealtimePriority
+		*out = new(int)
+		**out = **in
+	}
 	if in.Options != nil {
 		in, out := &in.Options, &out.Options
 		*out = make([]string, len(*in))
@@ -73,161 +105,157 @@ func (in *CPUStressor) DeepCopy() *CPUStressor {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChaosStatus) DeepCopyInto(out *ChaosStatus) {
+func (in *ChaosCondition) DeepCopyInto(out *ChaosCondition) {
 	*out = *in
-	in.Scheduler.DeepCopyInto(&out.Scheduler)
-	in.Experiment.DeepCopyInto(&out.Experiment)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosStatus.
-func (in *ChaosStatus) DeepCopy() *ChaosStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosCondition.
+func (in *ChaosCondition) DeepCopy() *ChaosCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(ChaosStatus)
+	out := new(ChaosCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CorruptSpec) DeepCopyInto(out *CorruptSpec) {
+func (in *ChaosMonkey) DeepCopyInto(out *ChaosMonkey) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CorruptSpec.
-func (in *CorruptSpec) DeepCopy() *CorruptSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosMonkey.
+func (in *ChaosMonkey) DeepCopy() *ChaosMonkey {
 	if in == nil {
 		return nil
 	}
-	out := new(CorruptSpec)
+	out := new(ChaosMonkey)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DelaySpec) DeepCopyInto(out *DelaySpec) {
-	*out = *in
-	if in.Reorder != nil {
-		in, out := &in.Reorder, &out.Reorder
-		*out = new(ReorderSpec)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelaySpec.
-func (in *DelaySpec) DeepCopy() *DelaySpec {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosMonkey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(DelaySpec)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DuplicateSpec) DeepCopyInto(out *DuplicateSpec) {
+func (in *ChaosMonkeyList) DeepCopyInto(out *ChaosMonkeyList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosMonkey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DuplicateSpec.
-func (in *DuplicateSpec) DeepCopy() *DuplicateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosMonkeyList.
+func (in *ChaosMonkeyList) DeepCopy() *ChaosMonkeyList {
 	if in == nil {
 		return nil
 	}
-	out := new(DuplicateSpec)
+	out := new(ChaosMonkeyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
-	*out = *in
-	if in.StartTime != nil {
-		in, out := &in.StartTime, &out.StartTime
-		*out = (*in).DeepCopy()
-	}
-	if in.EndTime != nil {
-		in, out := &in.EndTime, &out.EndTime
-		*out = (*in).DeepCopy()
-	}
-	if in.PodRecords != nil {
-		in, out := &in.PodRecords, &out.PodRecords
-		*out = make([]PodStatus, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentStatus.
-func (in *ExperimentStatus) DeepCopy() *ExperimentStatus {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosMonkeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(ExperimentStatus)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FailKernRequest) DeepCopyInto(out *FailKernRequest) {
+func (in *ChaosMonkeySpec) DeepCopyInto(out *ChaosMonkeySpec) {
 	*out = *in
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
-		*out = make([]string, len(*in))
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]MonkeyAction, len(*in))
 		copy(*out, *in)
 	}
-	if in.Callchain != nil {
-		in, out := &in.Callchain, &out.Callchain
-		*out = make([]Frame, len(*in))
-		copy(*out, *in)
+	if in.Intensity != nil {
+		in, out := &in.Intensity, &out.Intensity
+		*out = new(MonkeyIntensity)
+		**out = **in
+	}
+	if in.ActiveHours != nil {
+		in, out := &in.ActiveHours, &out.ActiveHours
+		*out = new(ActiveHours)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailKernRequest.
-func (in *FailKernRequest) DeepCopy() *FailKernRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosMonkeySpec.
+func (in *ChaosMonkeySpec) DeepCopy() *ChaosMonkeySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FailKernRequest)
+	out := new(ChaosMonkeySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Frame) DeepCopyInto(out *Frame) {
+func (in *ChaosMonkeyStatus) DeepCopyInto(out *ChaosMonkeyStatus) {
 	*out = *in
+	if in.LastExperimentTime != nil {
+		in, out := &in.LastExperimentTime, &out.LastExperimentTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Experiments != nil {
+		in, out := &in.Experiments, &out.Experiments
+		*out = make([]MonkeyExperiment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Frame.
-func (in *Frame) DeepCopy() *Frame {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosMonkeyStatus.
+func (in *ChaosMonkeyStatus) DeepCopy() *ChaosMonkeyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Frame)
+	out := new(ChaosMonkeyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IoChaos) DeepCopyInto(out *IoChaos) {
+func (in *ChaosNotification) DeepCopyInto(out *ChaosNotification) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IoChaos.
-func (in *IoChaos) DeepCopy() *IoChaos {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosNotification.
+func (in *ChaosNotification) DeepCopy() *ChaosNotification {
 	if in == nil {
 		return nil
 	}
-	out := new(IoChaos)
+	out := new(ChaosNotification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IoChaos) DeepCopyObject() runtime.Object {
+func (in *ChaosNotification) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -235,31 +263,31 @@ func (in *IoChaos) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IoChaosList) DeepCopyInto(out *IoChaosList) {
+func (in *ChaosNotificationList) DeepCopyInto(out *ChaosNotificationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IoChaos, len(*in))
+		*out = make([]ChaosNotification, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IoChaosList.
-func (in *IoChaosList) DeepCopy() *IoChaosList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosNotificationList.
+func (in *ChaosNotificationList) DeepCopy() *ChaosNotificationList {
 	if in == nil {
 		return nil
 	}
-	out := new(IoChaosList)
+	out := new(ChaosNotificationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IoChaosList) DeepCopyObject() runtime.Object {
+func (in *ChaosNotificationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -267,73 +295,57 @@ func (in *IoChaosList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IoChaosSpec) DeepCopyInto(out *IoChaosSpec) {
+func (in *ChaosNotificationSpec) DeepCopyInto(out *ChaosNotificationSpec) {
 	*out = *in
-	in.Selector.DeepCopyInto(&out.Selector)
-	if in.Scheduler != nil {
-		in, out := &in.Scheduler, &out.Scheduler
-		*out = new(SchedulerSpec)
-		**out = **in
-	}
-	if in.Duration != nil {
-		in, out := &in.Duration, &out.Duration
-		*out = new(string)
-		**out = **in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEvent, len(*in))
+		copy(*out, *in)
 	}
-	if in.Methods != nil {
-		in, out := &in.Methods, &out.Methods
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IoChaosSpec.
-func (in *IoChaosSpec) DeepCopy() *IoChaosSpec {
-	if in == nil {
-		return nil
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]NotificationReceiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(IoChaosSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IoChaosStatus) DeepCopyInto(out *IoChaosStatus) {
-	*out = *in
-	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IoChaosStatus.
-func (in *IoChaosStatus) DeepCopy() *IoChaosStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosNotificationSpec.
+func (in *ChaosNotificationSpec) DeepCopy() *ChaosNotificationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IoChaosStatus)
+	out := new(ChaosNotificationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KernelChaos) DeepCopyInto(out *KernelChaos) {
+func (in *ChaosProtection) DeepCopyInto(out *ChaosProtection) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelChaos.
-func (in *KernelChaos) DeepCopy() *KernelChaos {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosProtection.
+func (in *ChaosProtection) DeepCopy() *ChaosProtection {
 	if in == nil {
 		return nil
 	}
-	out := new(KernelChaos)
+	out := new(ChaosProtection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KernelChaos) DeepCopyObject() runtime.Object {
+func (in *ChaosProtection) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -341,31 +353,31 @@ func (in *KernelChaos) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KernelChaosList) DeepCopyInto(out *KernelChaosList) {
+func (in *ChaosProtectionList) DeepCopyInto(out *ChaosProtectionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KernelChaos, len(*in))
+		*out = make([]ChaosProtection, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelChaosList.
-func (in *KernelChaosList) DeepCopy() *KernelChaosList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosProtectionList.
+func (in *ChaosProtectionList) DeepCopy() *ChaosProtectionList {
 	if in == nil {
 		return nil
 	}
-	out := new(KernelChaosList)
+	out := new(ChaosProtectionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KernelChaosList) DeepCopyObject() runtime.Object {
+func (in *ChaosProtectionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -373,104 +385,1288 @@ func (in *KernelChaosList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KernelChaosSpec) DeepCopyInto(out *KernelChaosSpec) {
+func (in *ChaosProtectionSpec) DeepCopyInto(out *ChaosProtectionSpec) {
 	*out = *in
-	in.Selector.DeepCopyInto(&out.Selector)
-	in.FailKernRequest.DeepCopyInto(&out.FailKernRequest)
-	if in.Duration != nil {
-		in, out := &in.Duration, &out.Duration
-		*out = new(string)
-		**out = **in
-	}
-	if in.Scheduler != nil {
-		in, out := &in.Scheduler, &out.Scheduler
-		*out = new(SchedulerSpec)
-		**out = **in
+	if in.Selectors != nil {
+		in, out := &in.Selectors, &out.Selectors
+		*out = make([]SelectorSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelChaosSpec.
-func (in *KernelChaosSpec) DeepCopy() *KernelChaosSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosProtectionSpec.
+func (in *ChaosProtectionSpec) DeepCopy() *ChaosProtectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KernelChaosSpec)
+	out := new(ChaosProtectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KernelChaosStatus) DeepCopyInto(out *KernelChaosStatus) {
+func (in *ChaosResult) DeepCopyInto(out *ChaosResult) {
 	*out = *in
-	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelChaosStatus.
-func (in *KernelChaosStatus) DeepCopy() *KernelChaosStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosResult.
+func (in *ChaosResult) DeepCopy() *ChaosResult {
 	if in == nil {
 		return nil
 	}
-	out := new(KernelChaosStatus)
+	out := new(ChaosResult)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LossSpec) DeepCopyInto(out *LossSpec) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LossSpec.
-func (in *LossSpec) DeepCopy() *LossSpec {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosResult) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(LossSpec)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemoryStressor) DeepCopyInto(out *MemoryStressor) {
+func (in *ChaosResultList) DeepCopyInto(out *ChaosResultList) {
 	*out = *in
-	out.Stressor = in.Stressor
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStressor.
-func (in *MemoryStressor) DeepCopy() *MemoryStressor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosResultList.
+func (in *ChaosResultList) DeepCopy() *ChaosResultList {
 	if in == nil {
 		return nil
 	}
-	out := new(MemoryStressor)
+	out := new(ChaosResultList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosResultList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkChaos) DeepCopyInto(out *NetworkChaos) {
+func (in *ChaosResultSpec) DeepCopyInto(out *ChaosResultSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Experiment = in.Experiment
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaos.
-func (in *NetworkChaos) DeepCopy() *NetworkChaos {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosResultSpec.
+func (in *ChaosResultSpec) DeepCopy() *ChaosResultSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkChaos)
+	out := new(ChaosResultSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosResultStatus) DeepCopyInto(out *ChaosResultStatus) {
+	*out = *in
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]ResultTarget, len(*in))
+		copy(*out, *in)
+	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = make([]ProbeMeasurement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(SLOResult)
+		**out = **in
+	}
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(LoadResult)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosResultStatus.
+func (in *ChaosResultStatus) DeepCopy() *ChaosResultStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosResultStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosStatus) DeepCopyInto(out *ChaosStatus) {
+	*out = *in
+	in.Scheduler.DeepCopyInto(&out.Scheduler)
+	in.Experiment.DeepCopyInto(&out.Experiment)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ChaosCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosStatus.
+func (in *ChaosStatus) DeepCopy() *ChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosTemplate) DeepCopyInto(out *ChaosTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosTemplate.
+func (in *ChaosTemplate) DeepCopy() *ChaosTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosTemplateList) DeepCopyInto(out *ChaosTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosTemplateList.
+func (in *ChaosTemplateList) DeepCopy() *ChaosTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosTemplateParameter) DeepCopyInto(out *ChaosTemplateParameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosTemplateParameter.
+func (in *ChaosTemplateParameter) DeepCopy() *ChaosTemplateParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosTemplateParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosTemplateSpec) DeepCopyInto(out *ChaosTemplateSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ChaosTemplateParameter, len(*in))
+		copy(*out, *in)
+	}
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosTemplateSpec.
+func (in *ChaosTemplateSpec) DeepCopy() *ChaosTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigCorruptionSpec) DeepCopyInto(out *ConfigCorruptionSpec) {
+	*out = *in
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigCorruptionSpec.
+func (in *ConfigCorruptionSpec) DeepCopy() *ConfigCorruptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigCorruptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerProgress) DeepCopyInto(out *ContainerProgress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerProgress.
+func (in *ContainerProgress) DeepCopy() *ContainerProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPodStatus) DeepCopyInto(out *ControlPodStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPodStatus.
+func (in *ControlPodStatus) DeepCopy() *ControlPodStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPodStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConntrackSpec) DeepCopyInto(out *ConntrackSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConntrackSpec.
+func (in *ConntrackSpec) DeepCopy() *ConntrackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConntrackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CorruptSpec) DeepCopyInto(out *CorruptSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CorruptSpec.
+func (in *CorruptSpec) DeepCopy() *CorruptSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CorruptSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBChaos) DeepCopyInto(out *DBChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBChaos.
+func (in *DBChaos) DeepCopy() *DBChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(DBChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBChaosList) DeepCopyInto(out *DBChaosList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBChaosList.
+func (in *DBChaosList) DeepCopy() *DBChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBChaosSpec) DeepCopyInto(out *DBChaosSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	in.Target.DeepCopyInto(&out.Target)
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(DBErrorSpec)
+		**out = **in
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(DBFailoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.RecoveryTimeout != nil {
+		in, out := &in.RecoveryTimeout, &out.RecoveryTimeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(SLOSpec)
+		**out = **in
+	}
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(LoadSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SelectorRetryPolicy != nil {
+		in, out := &in.SelectorRetryPolicy, &out.SelectorRetryPolicy
+		*out = new(SelectorRetryPolicy)
+		**out = **in
+	}
+	if in.StaggerInterval != nil {
+		in, out := &in.StaggerInterval, &out.StaggerInterval
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(SchedulerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBChaosSpec.
+func (in *DBChaosSpec) DeepCopy() *DBChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBChaosStatus) DeepCopyInto(out *DBChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBChaosStatus.
+func (in *DBChaosStatus) DeepCopy() *DBChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBErrorSpec) DeepCopyInto(out *DBErrorSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBErrorSpec.
+func (in *DBErrorSpec) DeepCopy() *DBErrorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBErrorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBFailoverSpec) DeepCopyInto(out *DBFailoverSpec) {
+	*out = *in
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBFailoverSpec.
+func (in *DBFailoverSpec) DeepCopy() *DBFailoverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBFailoverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBTarget) DeepCopyInto(out *DBTarget) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ServiceReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBTarget.
+func (in *DBTarget) DeepCopy() *DBTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DBTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DelaySpec) DeepCopyInto(out *DelaySpec) {
+	*out = *in
+	if in.Reorder != nil {
+		in, out := &in.Reorder, &out.Reorder
+		*out = new(ReorderSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelaySpec.
+func (in *DelaySpec) DeepCopy() *DelaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DelaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentImpact) DeepCopyInto(out *DeploymentImpact) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentImpact.
+func (in *DeploymentImpact) DeepCopy() *DeploymentImpact {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentImpact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DuplicateSpec) DeepCopyInto(out *DuplicateSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DuplicateSpec.
+func (in *DuplicateSpec) DeepCopy() *DuplicateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DuplicateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralStorageStressor) DeepCopyInto(out *EphemeralStorageStressor) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralStorageStressor.
+func (in *EphemeralStorageStressor) DeepCopy() *EphemeralStorageStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralStorageStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentReference) DeepCopyInto(out *ExperimentReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentReference.
+func (in *ExperimentReference) DeepCopy() *ExperimentReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PodRecords != nil {
+		in, out := &in.PodRecords, &out.PodRecords
+		*out = make([]PodStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailedRecords != nil {
+		in, out := &in.FailedRecords, &out.FailedRecords
+		*out = make([]FailedPodStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ControlGroup != nil {
+		in, out := &in.ControlGroup, &out.ControlGroup
+		*out = make([]ControlPodStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selection != nil {
+		in, out := &in.Selection, &out.Selection
+		*out = new(SelectionStatus)
+		**out = **in
+	}
+	if in.SelectionRetry != nil {
+		in, out := &in.SelectionRetry, &out.SelectionRetry
+		*out = new(SelectionRetryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Impact != nil {
+		in, out := &in.Impact, &out.Impact
+		*out = new(ImpactStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stagger != nil {
+		in, out := &in.Stagger, &out.Stagger
+		*out = new(StaggerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentStatus.
+func (in *ExperimentStatus) DeepCopy() *ExperimentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailKernRequest) DeepCopyInto(out *FailKernRequest) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Callchain != nil {
+		in, out := &in.Callchain, &out.Callchain
+		*out = make([]Frame, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailKernRequest.
+func (in *FailKernRequest) DeepCopy() *FailKernRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(FailKernRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedPodStatus) DeepCopyInto(out *FailedPodStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedPodStatus.
+func (in *FailedPodStatus) DeepCopy() *FailedPodStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedPodStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileDescriptorStressor) DeepCopyInto(out *FileDescriptorStressor) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileDescriptorStressor.
+func (in *FileDescriptorStressor) DeepCopy() *FileDescriptorStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(FileDescriptorStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Frame) DeepCopyInto(out *Frame) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Frame.
+func (in *Frame) DeepCopy() *Frame {
+	if in == nil {
+		return nil
+	}
+	out := new(Frame)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HistogramBucket) DeepCopyInto(out *HistogramBucket) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HistogramBucket.
+func (in *HistogramBucket) DeepCopy() *HistogramBucket {
+	if in == nil {
+		return nil
+	}
+	out := new(HistogramBucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPSetSummary) DeepCopyInto(out *IPSetSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPSetSummary.
+func (in *IPSetSummary) DeepCopy() *IPSetSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(IPSetSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImpactStatus) DeepCopyInto(out *ImpactStatus) {
+	*out = *in
+	if in.Deployments != nil {
+		in, out := &in.Deployments, &out.Deployments
+		*out = make([]DeploymentImpact, len(*in))
+		copy(*out, *in)
+	}
+	if in.ViolatedDisruptionBudgets != nil {
+		in, out := &in.ViolatedDisruptionBudgets, &out.ViolatedDisruptionBudgets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SingleEndpointServices != nil {
+		in, out := &in.SingleEndpointServices, &out.SingleEndpointServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImpactStatus.
+func (in *ImpactStatus) DeepCopy() *ImpactStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImpactStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IoChaos) DeepCopyInto(out *IoChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IoChaos.
+func (in *IoChaos) DeepCopy() *IoChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(IoChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IoChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IoChaosList) DeepCopyInto(out *IoChaosList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IoChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IoChaosList.
+func (in *IoChaosList) DeepCopy() *IoChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(IoChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IoChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IoChaosSpec) DeepCopyInto(out *IoChaosSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(SchedulerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.RecoveryTimeout != nil {
+		in, out := &in.RecoveryTimeout, &out.RecoveryTimeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(SLOSpec)
+		**out = **in
+	}
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(LoadSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SelectorRetryPolicy != nil {
+		in, out := &in.SelectorRetryPolicy, &out.SelectorRetryPolicy
+		*out = new(SelectorRetryPolicy)
+		**out = **in
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IoChaosSpec.
+func (in *IoChaosSpec) DeepCopy() *IoChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IoChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IoChaosStatus) DeepCopyInto(out *IoChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IoChaosStatus.
+func (in *IoChaosStatus) DeepCopy() *IoChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IoChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelChaos) DeepCopyInto(out *KernelChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelChaos.
+func (in *KernelChaos) DeepCopy() *KernelChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KernelChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelChaosList) DeepCopyInto(out *KernelChaosList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KernelChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelChaosList.
+func (in *KernelChaosList) DeepCopy() *KernelChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KernelChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelChaosSpec) DeepCopyInto(out *KernelChaosSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	in.FailKernRequest.DeepCopyInto(&out.FailKernRequest)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.RecoveryTimeout != nil {
+		in, out := &in.RecoveryTimeout, &out.RecoveryTimeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(SLOSpec)
+		**out = **in
+	}
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(LoadSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SelectorRetryPolicy != nil {
+		in, out := &in.SelectorRetryPolicy, &out.SelectorRetryPolicy
+		*out = new(SelectorRetryPolicy)
+		**out = **in
+	}
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(SchedulerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelChaosSpec.
+func (in *KernelChaosSpec) DeepCopy() *KernelChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelChaosStatus) DeepCopyInto(out *KernelChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelChaosStatus.
+func (in *KernelChaosStatus) DeepCopy() *KernelChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadResult) DeepCopyInto(out *LoadResult) {
+	*out = *in
+	if in.ErrorCodes != nil {
+		in, out := &in.ErrorCodes, &out.ErrorCodes
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Latency != nil {
+		in, out := &in.Latency, &out.Latency
+		*out = make([]HistogramBucket, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadResult.
+func (in *LoadResult) DeepCopy() *LoadResult {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadSpec) DeepCopyInto(out *LoadSpec) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadSpec.
+func (in *LoadSpec) DeepCopy() *LoadSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LossSpec) DeepCopyInto(out *LossSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LossSpec.
+func (in *LossSpec) DeepCopy() *LossSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LossSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MTUSpec) DeepCopyInto(out *MTUSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MTUSpec.
+func (in *MTUSpec) DeepCopy() *MTUSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MTUSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryBandwidthStressor) DeepCopyInto(out *MemoryBandwidthStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+	if in.CacheWays != nil {
+		in, out := &in.CacheWays, &out.CacheWays
+		*out = new(int)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryBandwidthStressor.
+func (in *MemoryBandwidthStressor) DeepCopy() *MemoryBandwidthStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryBandwidthStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryStressor) DeepCopyInto(out *MemoryStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStressor.
+func (in *MemoryStressor) DeepCopy() *MemoryStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataSpec) DeepCopyInto(out *MetadataSpec) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataSpec.
+func (in *MetadataSpec) DeepCopy() *MetadataSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonkeyExperiment) DeepCopyInto(out *MonkeyExperiment) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonkeyExperiment.
+func (in *MonkeyExperiment) DeepCopy() *MonkeyExperiment {
+	if in == nil {
+		return nil
+	}
+	out := new(MonkeyExperiment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonkeyIntensity) DeepCopyInto(out *MonkeyIntensity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonkeyIntensity.
+func (in *MonkeyIntensity) DeepCopy() *MonkeyIntensity {
+	if in == nil {
+		return nil
+	}
+	out := new(MonkeyIntensity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetemProfileSpec) DeepCopyInto(out *NetemProfileSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetemProfileSpec.
+func (in *NetemProfileSpec) DeepCopy() *NetemProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetemProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkChaos) DeepCopyInto(out *NetworkChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaos.
+func (in *NetworkChaos) DeepCopy() *NetworkChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
 func (in *NetworkChaos) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
@@ -479,31 +1675,339 @@ func (in *NetworkChaos) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkChaosList) DeepCopyInto(out *NetworkChaosList) {
+func (in *NetworkChaosList) DeepCopyInto(out *NetworkChaosList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosList.
+func (in *NetworkChaosList) DeepCopy() *NetworkChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkChaosSpec) DeepCopyInto(out *NetworkChaosSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.RecoveryTimeout != nil {
+		in, out := &in.RecoveryTimeout, &out.RecoveryTimeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(SLOSpec)
+		**out = **in
+	}
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(LoadSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SelectorRetryPolicy != nil {
+		in, out := &in.SelectorRetryPolicy, &out.SelectorRetryPolicy
+		*out = new(SelectorRetryPolicy)
+		**out = **in
+	}
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(SchedulerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(NetemProfileSpec)
+		**out = **in
+	}
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(DelaySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Loss != nil {
+		in, out := &in.Loss, &out.Loss
+		*out = new(LossSpec)
+		**out = **in
+	}
+	if in.Duplicate != nil {
+		in, out := &in.Duplicate, &out.Duplicate
+		*out = new(DuplicateSpec)
+		**out = **in
+	}
+	if in.Corrupt != nil {
+		in, out := &in.Corrupt, &out.Corrupt
+		*out = new(CorruptSpec)
+		**out = **in
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(BandwidthSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conntrack != nil {
+		in, out := &in.Conntrack, &out.Conntrack
+		*out = new(ConntrackSpec)
+		**out = **in
+	}
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(MTUSpec)
+		**out = **in
+	}
+	if in.NodePort != nil {
+		in, out := &in.NodePort, &out.NodePort
+		*out = new(NodePortSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(MetadataSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(Target)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalTargets != nil {
+		in, out := &in.ExternalTargets, &out.ExternalTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetServices != nil {
+		in, out := &in.TargetServices, &out.TargetServices
+		*out = make([]ServiceReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosSpec.
+func (in *NetworkChaosSpec) DeepCopy() *NetworkChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkChaosStatus) DeepCopyInto(out *NetworkChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]NetworkRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetIPSet != nil {
+		in, out := &in.TargetIPSet, &out.TargetIPSet
+		*out = new(TargetIPSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetSelection != nil {
+		in, out := &in.TargetSelection, &out.TargetSelection
+		*out = new(SelectionStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosStatus.
+func (in *NetworkChaosStatus) DeepCopy() *NetworkChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkRules) DeepCopyInto(out *NetworkRules) {
+	*out = *in
+	if in.Qdiscs != nil {
+		in, out := &in.Qdiscs, &out.Qdiscs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Iptables != nil {
+		in, out := &in.Iptables, &out.Iptables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPSets != nil {
+		in, out := &in.IPSets, &out.IPSets
+		*out = make([]IPSetSummary, len(*in))
+		copy(*out, *in)
+	}
+	if in.Programs != nil {
+		in, out := &in.Programs, &out.Programs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkRules.
+func (in *NetworkRules) DeepCopy() *NetworkRules {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePortSpec) DeepCopyInto(out *NodePortSpec) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]ServiceReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePortSpec.
+func (in *NodePortSpec) DeepCopy() *NodePortSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePortSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationReceiver) DeepCopyInto(out *NotificationReceiver) {
+	*out = *in
+	if in.URLFrom != nil {
+		in, out := &in.URLFrom, &out.URLFrom
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyFrom != nil {
+		in, out := &in.KeyFrom, &out.KeyFrom
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationReceiver.
+func (in *NotificationReceiver) DeepCopy() *NotificationReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalDiskSpec) DeepCopyInto(out *PhysicalDiskSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalDiskSpec.
+func (in *PhysicalDiskSpec) DeepCopy() *PhysicalDiskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalDiskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalMachineChaos) DeepCopyInto(out *PhysicalMachineChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineChaos.
+func (in *PhysicalMachineChaos) DeepCopy() *PhysicalMachineChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalMachineChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PhysicalMachineChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalMachineChaosList) DeepCopyInto(out *PhysicalMachineChaosList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NetworkChaos, len(*in))
+		*out = make([]PhysicalMachineChaos, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosList.
-func (in *NetworkChaosList) DeepCopy() *NetworkChaosList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineChaosList.
+func (in *PhysicalMachineChaosList) DeepCopy() *PhysicalMachineChaosList {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkChaosList)
+	out := new(PhysicalMachineChaosList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NetworkChaosList) DeepCopyObject() runtime.Object {
+func (in *PhysicalMachineChaosList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -511,209 +2015,440 @@ func (in *NetworkChaosList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkChaosSpec) DeepCopyInto(out *NetworkChaosSpec) {
+func (in *PhysicalMachineChaosSpec) DeepCopyInto(out *PhysicalMachineChaosSpec) {
 	*out = *in
-	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(PhysicalNetworkSpec)
+		**out = **in
+	}
+	if in.Stress != nil {
+		in, out := &in.Stress, &out.Stress
+		*out = new(PhysicalStressSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Disk != nil {
+		in, out := &in.Disk, &out.Disk
+		*out = new(PhysicalDiskSpec)
+		**out = **in
+	}
+	if in.Process != nil {
+		in, out := &in.Process, &out.Process
+		*out = new(PhysicalProcessSpec)
+		**out = **in
+	}
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
 		*out = new(string)
 		**out = **in
 	}
+	if in.RecoveryTimeout != nil {
+		in, out := &in.RecoveryTimeout, &out.RecoveryTimeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(SLOSpec)
+		**out = **in
+	}
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(LoadSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Scheduler != nil {
 		in, out := &in.Scheduler, &out.Scheduler
 		*out = new(SchedulerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineChaosSpec.
+func (in *PhysicalMachineChaosSpec) DeepCopy() *PhysicalMachineChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalMachineChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalMachineChaosStatus) DeepCopyInto(out *PhysicalMachineChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineChaosStatus.
+func (in *PhysicalMachineChaosStatus) DeepCopy() *PhysicalMachineChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalMachineChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalNetworkSpec) DeepCopyInto(out *PhysicalNetworkSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalNetworkSpec.
+func (in *PhysicalNetworkSpec) DeepCopy() *PhysicalNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalProcessSpec) DeepCopyInto(out *PhysicalProcessSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalProcessSpec.
+func (in *PhysicalProcessSpec) DeepCopy() *PhysicalProcessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalProcessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalStressSpec) DeepCopyInto(out *PhysicalStressSpec) {
+	*out = *in
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(int)
 		**out = **in
 	}
-	if in.Delay != nil {
-		in, out := &in.Delay, &out.Delay
-		*out = new(DelaySpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalStressSpec.
+func (in *PhysicalStressSpec) DeepCopy() *PhysicalStressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalStressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodChaos) DeepCopyInto(out *PodChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodChaos.
+func (in *PodChaos) DeepCopy() *PodChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(PodChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodChaosList) DeepCopyInto(out *PodChaosList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PodChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodChaosList.
+func (in *PodChaosList) DeepCopy() *PodChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(PodChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodChaosSpec) DeepCopyInto(out *PodChaosSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(SchedulerSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Loss != nil {
-		in, out := &in.Loss, &out.Loss
-		*out = new(LossSpec)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
 		**out = **in
 	}
-	if in.Duplicate != nil {
-		in, out := &in.Duplicate, &out.Duplicate
-		*out = new(DuplicateSpec)
+	if in.RecoveryTimeout != nil {
+		in, out := &in.RecoveryTimeout, &out.RecoveryTimeout
+		*out = new(string)
 		**out = **in
 	}
-	if in.Corrupt != nil {
-		in, out := &in.Corrupt, &out.Corrupt
-		*out = new(CorruptSpec)
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(SLOSpec)
 		**out = **in
 	}
-	if in.Bandwidth != nil {
-		in, out := &in.Bandwidth, &out.Bandwidth
-		*out = new(BandwidthSpec)
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(LoadSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Target != nil {
-		in, out := &in.Target, &out.Target
-		*out = new(Target)
-		(*in).DeepCopyInto(*out)
+	if in.SelectorRetryPolicy != nil {
+		in, out := &in.SelectorRetryPolicy, &out.SelectorRetryPolicy
+		*out = new(SelectorRetryPolicy)
+		**out = **in
 	}
-	if in.ExternalTargets != nil {
-		in, out := &in.ExternalTargets, &out.ExternalTargets
+	if in.StaggerInterval != nil {
+		in, out := &in.StaggerInterval, &out.StaggerInterval
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContainerNames != nil {
+		in, out := &in.ContainerNames, &out.ContainerNames
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Signal != nil {
+		in, out := &in.Signal, &out.Signal
+		*out = new(SignalSpec)
+		**out = **in
+	}
+	if in.ConfigCorruption != nil {
+		in, out := &in.ConfigCorruption, &out.ConfigCorruption
+		*out = new(ConfigCorruptionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Safeguards != nil {
+		in, out := &in.Safeguards, &out.Safeguards
+		*out = new(Safeguards)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodChaosSpec.
+func (in *PodChaosSpec) DeepCopy() *PodChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodChaosStatus) DeepCopyInto(out *PodChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosSpec.
-func (in *NetworkChaosSpec) DeepCopy() *NetworkChaosSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodChaosStatus.
+func (in *PodChaosStatus) DeepCopy() *PodChaosStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkChaosSpec)
+	out := new(PodChaosStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkChaosStatus) DeepCopyInto(out *NetworkChaosStatus) {
+func (in *PodProgress) DeepCopyInto(out *PodProgress) {
 	*out = *in
-	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]ContainerProgress, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pids != nil {
+		in, out := &in.Pids, &out.Pids
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosStatus.
-func (in *NetworkChaosStatus) DeepCopy() *NetworkChaosStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodProgress.
+func (in *PodProgress) DeepCopy() *PodProgress {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkChaosStatus)
+	out := new(PodProgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodChaos) DeepCopyInto(out *PodChaos) {
+func (in *PodStatus) DeepCopyInto(out *PodStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(PodProgress)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodChaos.
-func (in *PodChaos) DeepCopy() *PodChaos {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodStatus.
+func (in *PodStatus) DeepCopy() *PodStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PodChaos)
+	out := new(PodStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PodChaos) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeMeasurement) DeepCopyInto(out *ProbeMeasurement) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeMeasurement.
+func (in *ProbeMeasurement) DeepCopy() *ProbeMeasurement {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(ProbeMeasurement)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodChaosList) DeepCopyInto(out *PodChaosList) {
+func (in *ReorderSpec) DeepCopyInto(out *ReorderSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]PodChaos, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodChaosList.
-func (in *PodChaosList) DeepCopy() *PodChaosList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReorderSpec.
+func (in *ReorderSpec) DeepCopy() *ReorderSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PodChaosList)
+	out := new(ReorderSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PodChaosList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultTarget) DeepCopyInto(out *ResultTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultTarget.
+func (in *ResultTarget) DeepCopy() *ResultTarget {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(ResultTarget)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodChaosSpec) DeepCopyInto(out *PodChaosSpec) {
+func (in *SLOResult) DeepCopyInto(out *SLOResult) {
 	*out = *in
-	in.Selector.DeepCopyInto(&out.Selector)
-	if in.Scheduler != nil {
-		in, out := &in.Scheduler, &out.Scheduler
-		*out = new(SchedulerSpec)
-		**out = **in
-	}
-	if in.Duration != nil {
-		in, out := &in.Duration, &out.Duration
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodChaosSpec.
-func (in *PodChaosSpec) DeepCopy() *PodChaosSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOResult.
+func (in *SLOResult) DeepCopy() *SLOResult {
 	if in == nil {
 		return nil
 	}
-	out := new(PodChaosSpec)
+	out := new(SLOResult)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodChaosStatus) DeepCopyInto(out *PodChaosStatus) {
+func (in *SLOSpec) DeepCopyInto(out *SLOSpec) {
 	*out = *in
-	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodChaosStatus.
-func (in *PodChaosStatus) DeepCopy() *PodChaosStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOSpec.
+func (in *SLOSpec) DeepCopy() *SLOSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PodChaosStatus)
+	out := new(SLOSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodStatus) DeepCopyInto(out *PodStatus) {
+func (in *Safeguards) DeepCopyInto(out *Safeguards) {
 	*out = *in
+	out.MinAvailable = in.MinAvailable
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodStatus.
-func (in *PodStatus) DeepCopy() *PodStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Safeguards.
+func (in *Safeguards) DeepCopy() *Safeguards {
 	if in == nil {
 		return nil
 	}
-	out := new(PodStatus)
+	out := new(Safeguards)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReorderSpec) DeepCopyInto(out *ReorderSpec) {
+func (in *ScheduleRecord) DeepCopyInto(out *ScheduleRecord) {
 	*out = *in
+	in.ScheduledTime.DeepCopyInto(&out.ScheduledTime)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReorderSpec.
-func (in *ReorderSpec) DeepCopy() *ReorderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleRecord.
+func (in *ScheduleRecord) DeepCopy() *ScheduleRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(ReorderSpec)
+	out := new(ScheduleRecord)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -729,6 +2464,13 @@ func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 		in, out := &in.NextRecover, &out.NextRecover
 		*out = (*in).DeepCopy()
 	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]ScheduleRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleStatus.
@@ -744,6 +2486,16 @@ func (in *ScheduleStatus) DeepCopy() *ScheduleStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulerSpec) DeepCopyInto(out *SchedulerSpec) {
 	*out = *in
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerSpec.
@@ -756,6 +2508,52 @@ func (in *SchedulerSpec) DeepCopy() *SchedulerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectionRetryStatus) DeepCopyInto(out *SelectionRetryStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectionRetryStatus.
+func (in *SelectionRetryStatus) DeepCopy() *SelectionRetryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectionRetryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectionStatus) DeepCopyInto(out *SelectionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectionStatus.
+func (in *SelectionStatus) DeepCopy() *SelectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectorRetryPolicy) DeepCopyInto(out *SelectorRetryPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorRetryPolicy.
+func (in *SelectorRetryPolicy) DeepCopy() *SelectorRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectorRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SelectorSpec) DeepCopyInto(out *SelectorSpec) {
 	*out = *in
@@ -829,6 +2627,52 @@ func (in *SelectorSpec) DeepCopy() *SelectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceReference.
+func (in *ServiceReference) DeepCopy() *ServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignalSpec) DeepCopyInto(out *SignalSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignalSpec.
+func (in *SignalSpec) DeepCopy() *SignalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SignalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaggerStatus) DeepCopyInto(out *StaggerStatus) {
+	*out = *in
+	in.NextTime.DeepCopyInto(&out.NextTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaggerStatus.
+func (in *StaggerStatus) DeepCopy() *StaggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StaggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StressChaos) DeepCopyInto(out *StressChaos) {
 	*out = *in
@@ -902,10 +2746,35 @@ func (in *StressChaosSpec) DeepCopyInto(out *StressChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RecoveryTimeout != nil {
+		in, out := &in.RecoveryTimeout, &out.RecoveryTimeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(SLOSpec)
+		**out = **in
+	}
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(LoadSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SelectorRetryPolicy != nil {
+		in, out := &in.SelectorRetryPolicy, &out.SelectorRetryPolicy
+		*out = new(SelectorRetryPolicy)
+		**out = **in
+	}
+	if in.StaggerInterval != nil {
+		in, out := &in.StaggerInterval, &out.StaggerInterval
+		*out = new(string)
+		**out = **in
+	}
 	if in.Scheduler != nil {
 		in, out := &in.Scheduler, &out.Scheduler
 		*out = new(SchedulerSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -989,6 +2858,26 @@ func (in *Stressors) DeepCopyInto(out *Stressors) {
 		*out = new(CPUStressor)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MemoryBandwidthStressor != nil {
+		in, out := &in.MemoryBandwidthStressor, &out.MemoryBandwidthStressor
+		*out = new(MemoryBandwidthStressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FileDescriptorStressor != nil {
+		in, out := &in.FileDescriptorStressor, &out.FileDescriptorStressor
+		*out = new(FileDescriptorStressor)
+		**out = **in
+	}
+	if in.ThreadStressor != nil {
+		in, out := &in.ThreadStressor, &out.ThreadStressor
+		*out = new(ThreadStressor)
+		**out = **in
+	}
+	if in.EphemeralStorageStressor != nil {
+		in, out := &in.EphemeralStorageStressor, &out.EphemeralStorageStressor
+		*out = new(EphemeralStorageStressor)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stressors.
@@ -1017,6 +2906,51 @@ func (in *Target) DeepCopy() *Target {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetIPSet) DeepCopyInto(out *TargetIPSet) {
+	*out = *in
+	if in.Cidrs != nil {
+		in, out := &in.Cidrs, &out.Cidrs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceCidrs != nil {
+		in, out := &in.ServiceCidrs, &out.ServiceCidrs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetIPSet.
+func (in *TargetIPSet) DeepCopy() *TargetIPSet {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetIPSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThreadStressor) DeepCopyInto(out *ThreadStressor) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThreadStressor.
+func (in *ThreadStressor) DeepCopy() *ThreadStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(ThreadStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeChaos) DeepCopyInto(out *TimeChaos) {
 	*out = *in
@@ -1095,10 +3029,35 @@ func (in *TimeChaosSpec) DeepCopyInto(out *TimeChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RecoveryTimeout != nil {
+		in, out := &in.RecoveryTimeout, &out.RecoveryTimeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(SLOSpec)
+		**out = **in
+	}
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(LoadSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SelectorRetryPolicy != nil {
+		in, out := &in.SelectorRetryPolicy, &out.SelectorRetryPolicy
+		*out = new(SelectorRetryPolicy)
+		**out = **in
+	}
+	if in.StaggerInterval != nil {
+		in, out := &in.StaggerInterval, &out.StaggerInterval
+		*out = new(string)
+		**out = **in
+	}
 	if in.Scheduler != nil {
 		in, out := &in.Scheduler, &out.Scheduler
 		*out = new(SchedulerSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
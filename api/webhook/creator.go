@@ -0,0 +1,108 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/mutate-chaos-mesh-org-v1alpha1-creator,mutating=true,failurePolicy=fail,groups=chaos-mesh.org,resources=podchaos;networkchaos;iochaos;timechaos;kernelchaos;stresschaos;physicalmachinechaos;dbchaos;chaosmonkeys,verbs=create;update,versions=v1alpha1,name=mcreator.kb.io
+
+// CreatorRecorder records the user who created the chaos into the annotation of chaos.
+// The creator is only recorded on creation, the chaos whose creator is unknown, e.g. the one
+// created before the recorder is registered, stays unknown, since the updaters such as the
+// controller itself aren't the ones who created it.
+type CreatorRecorder struct {
+	// Namespace is the namespace of Chaos Mesh. Its service accounts are the components which
+	// create the chaos on behalf of the users, such as the chaos generated by ChaosMonkey. They're
+	// never recorded as the creator, the creator propagated by them is kept instead.
+	Namespace string
+
+	decoder *admission.Decoder
+}
+
+func (v *CreatorRecorder) Handle(ctx context.Context, req admission.Request) admission.Response {
+	obj := &unstructured.Unstructured{}
+
+	err := v.decoder.Decode(req, obj)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	var creator string
+	switch req.Operation {
+	case v1beta1.Create:
+		if v.isComponent(req.UserInfo.Username) {
+			creator = obj.GetAnnotations()[v1alpha1.CreatorAnnotationKey]
+			break
+		}
+		data, err := json.Marshal(req.UserInfo)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		creator = string(data)
+	case v1beta1.Update:
+		// the creator can't be modified after the chaos is created
+		old := &unstructured.Unstructured{}
+		if err := v.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		creator = old.GetAnnotations()[v1alpha1.CreatorAnnotationKey]
+	default:
+		return admission.Allowed("")
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations[v1alpha1.CreatorAnnotationKey] == creator {
+		return admission.Allowed("")
+	}
+
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	if creator == "" {
+		delete(annotations, v1alpha1.CreatorAnnotationKey)
+	} else {
+		annotations[v1alpha1.CreatorAnnotationKey] = creator
+	}
+	obj.SetAnnotations(annotations)
+
+	log.Info("Record creator of chaos", "namespace", obj.GetNamespace(), "name", obj.GetName(), "creator", creator)
+
+	marshaled, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// isComponent returns true if the user is a service account of Chaos Mesh
+func (v *CreatorRecorder) isComponent(username string) bool {
+	return v.Namespace != "" && strings.HasPrefix(username, "system:serviceaccount:"+v.Namespace+":")
+}
+
+func (v *CreatorRecorder) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
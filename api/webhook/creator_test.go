@@ -0,0 +1,181 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	. "github.com/onsi/gomega"
+	"k8s.io/api/admission/v1beta1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func newChaosObject(g *GomegaWithT, creator string) runtime.RawExtension {
+	chaos := &v1alpha1.PodChaos{}
+	chaos.APIVersion = v1alpha1.GroupVersion.String()
+	chaos.Kind = v1alpha1.KindPodChaos
+	chaos.Namespace = "chaos"
+	chaos.Name = "pod-kill"
+	if creator != "" {
+		chaos.Annotations = map[string]string{v1alpha1.CreatorAnnotationKey: creator}
+	}
+
+	raw, err := json.Marshal(chaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	return runtime.RawExtension{Raw: raw}
+}
+
+// recordedCreator returns the creator annotation of the chaos mutated by the recorder
+func recordedCreator(g *GomegaWithT, req admission.Request) string {
+	decoder, err := admission.NewDecoder(scheme.Scheme)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	recorder := &CreatorRecorder{Namespace: "chaos-mesh"}
+	g.Expect(recorder.InjectDecoder(decoder)).To(Succeed())
+
+	resp := recorder.Handle(context.TODO(), req)
+	g.Expect(resp.Allowed).To(BeTrue())
+
+	raw := req.Object.Raw
+	if len(resp.Patches) > 0 {
+		patch, err := json.Marshal(resp.Patches)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		decoded, err := jsonpatch.DecodePatch(patch)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		raw, err = decoded.Apply(raw)
+		g.Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	chaos := &v1alpha1.PodChaos{}
+	g.Expect(json.Unmarshal(raw, chaos)).To(Succeed())
+	return chaos.Annotations[v1alpha1.CreatorAnnotationKey]
+}
+
+func TestCreatorRecorder(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	alice := authenticationv1.UserInfo{Username: "alice", Groups: []string{"dev"}}
+	bob := authenticationv1.UserInfo{Username: "bob"}
+	controller := authenticationv1.UserInfo{Username: "system:serviceaccount:chaos-mesh:chaos-controller-manager"}
+	other := authenticationv1.UserInfo{Username: "system:serviceaccount:app:chaos-controller-manager"}
+	aliceData := `{"username":"alice","groups":["dev"]}`
+	otherData := `{"username":"system:serviceaccount:app:chaos-controller-manager"}`
+
+	type TestCase struct {
+		name    string
+		req     v1beta1.AdmissionRequest
+		creator string
+	}
+
+	tcs := []TestCase{
+		{
+			name:    "the user creating the chaos is recorded",
+			req:     v1beta1.AdmissionRequest{Operation: v1beta1.Create, UserInfo: alice, Object: newChaosObject(g, "")},
+			creator: aliceData,
+		},
+		{
+			name: "the forged creator is overwritten",
+			req: v1beta1.AdmissionRequest{Operation: v1beta1.Create, UserInfo: alice,
+				Object: newChaosObject(g, `{"username":"admin"}`)},
+			creator: aliceData,
+		},
+		{
+			name: "the creator propagated by the component of Chaos Mesh is kept",
+			req: v1beta1.AdmissionRequest{Operation: v1beta1.Create, UserInfo: controller,
+				Object: newChaosObject(g, aliceData)},
+			creator: aliceData,
+		},
+		{
+			name:    "the component of Chaos Mesh isn't recorded as the creator",
+			req:     v1beta1.AdmissionRequest{Operation: v1beta1.Create, UserInfo: controller, Object: newChaosObject(g, "")},
+			creator: "",
+		},
+		{
+			name: "the service account in other namespaces is recorded",
+			req: v1beta1.AdmissionRequest{Operation: v1beta1.Create, UserInfo: other,
+				Object: newChaosObject(g, aliceData)},
+			creator: otherData,
+		},
+		{
+			name: "the creator is kept when the chaos is updated by others",
+			req: v1beta1.AdmissionRequest{Operation: v1beta1.Update, UserInfo: bob,
+				Object: newChaosObject(g, `{"username":"admin"}`), OldObject: newChaosObject(g, aliceData)},
+			creator: aliceData,
+		},
+		{
+			name: "the removed creator is restored",
+			req: v1beta1.AdmissionRequest{Operation: v1beta1.Update, UserInfo: bob,
+				Object: newChaosObject(g, ""), OldObject: newChaosObject(g, aliceData)},
+			creator: aliceData,
+		},
+		{
+			name: "the user updating the chaos whose creator is unknown isn't recorded",
+			req: v1beta1.AdmissionRequest{Operation: v1beta1.Update, UserInfo: bob,
+				Object: newChaosObject(g, ""), OldObject: newChaosObject(g, "")},
+			creator: "",
+		},
+		{
+			name: "the creator can't be forged on the chaos whose creator is unknown",
+			req: v1beta1.AdmissionRequest{Operation: v1beta1.Update, UserInfo: bob,
+				Object: newChaosObject(g, aliceData), OldObject: newChaosObject(g, "")},
+			creator: "",
+		},
+		{
+			name:    "the deletion isn't mutated",
+			req:     v1beta1.AdmissionRequest{Operation: v1beta1.Delete, UserInfo: bob, Object: newChaosObject(g, aliceData)},
+			creator: aliceData,
+		},
+	}
+
+	for _, tc := range tcs {
+		creator := recordedCreator(g, admission.Request{AdmissionRequest: tc.req})
+		g.Expect(creator).To(Equal(tc.creator), tc.name)
+	}
+}
+
+// The controller updates the finalizers of the chaos created before the creator is recorded,
+// which mustn't make the controller its creator and bypass the security mode.
+func TestCreatorRecorderUpdatingFinalizers(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	controller := authenticationv1.UserInfo{Username: "system:serviceaccount:chaos-mesh:chaos-controller-manager"}
+
+	old := &v1alpha1.PodChaos{}
+	old.APIVersion = v1alpha1.GroupVersion.String()
+	old.Kind = v1alpha1.KindPodChaos
+	old.Namespace = "chaos"
+	old.Name = "pod-kill"
+	updated := old.DeepCopy()
+	updated.Finalizers = []string{"app/p1"}
+
+	oldRaw, err := json.Marshal(old)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	updatedRaw, err := json.Marshal(updated)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	creator := recordedCreator(g, admission.Request{AdmissionRequest: v1beta1.AdmissionRequest{
+		Operation: v1beta1.Update,
+		UserInfo:  controller,
+		Object:    runtime.RawExtension{Raw: updatedRaw},
+		OldObject: runtime.RawExtension{Raw: oldRaw},
+	}})
+	g.Expect(creator).To(BeEmpty())
+}
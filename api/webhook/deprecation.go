@@ -0,0 +1,102 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/metrics"
+)
+
+var deprecationLog = ctrl.Log.WithName("deprecation-webhook")
+
+// +kubebuilder:webhook:path=/validate-chaos-mesh-org-v1alpha1-deprecation,mutating=false,failurePolicy=ignore,groups=chaos-mesh.org,resources=podchaos;networkchaos;iochaos;timechaos;kernelchaos;stresschaos,verbs=create;update,versions=v1alpha1,name=vdeprecation.kb.io
+
+// DeprecationWarner allows all the chaos, and warns about the deprecated fields and actions they use
+// in the admission response. It's served as a plain http handler, because the admission response of
+// the api in use doesn't have the warnings, which are shown by kubectl since kubernetes 1.19.
+type DeprecationWarner struct {
+	Metrics *metrics.ChaosCollector
+}
+
+// warningResponse is the admission response with the warnings
+type warningResponse struct {
+	v1beta1.AdmissionResponse `json:",inline"`
+	Warnings                  []string `json:"warnings,omitempty"`
+}
+
+type warningReview struct {
+	metav1.TypeMeta `json:",inline"`
+	Response        *warningResponse `json:"response,omitempty"`
+}
+
+func (w *DeprecationWarner) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	review := v1beta1.AdmissionReview{}
+	if err := json.NewDecoder(r.Body).Decode(&review); err != nil || review.Request == nil {
+		deprecationLog.Error(err, "unable to decode the admission review")
+		http.Error(rw, "unable to decode the admission review", http.StatusBadRequest)
+		return
+	}
+	req := review.Request
+
+	resp := warningReview{
+		TypeMeta: review.TypeMeta,
+		Response: &warningResponse{
+			AdmissionResponse: v1beta1.AdmissionResponse{
+				UID:     req.UID,
+				Allowed: true,
+			},
+		},
+	}
+	resp.Response.Warnings = w.warnings(req)
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(resp); err != nil {
+		deprecationLog.Error(err, "unable to encode the admission response")
+	}
+}
+
+// warnings returns the warnings of the deprecated usages in the chaos of the request
+func (w *DeprecationWarner) warnings(req *v1beta1.AdmissionRequest) []string {
+	kind, ok := v1alpha1.AllKinds()[req.Kind.Kind]
+	if !ok {
+		return nil
+	}
+
+	obj := kind.Chaos.DeepCopyObject()
+	if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+		deprecationLog.Error(err, "unable to decode the chaos", "kind", req.Kind.Kind)
+		return nil
+	}
+
+	deprecator, ok := obj.(v1alpha1.Deprecator)
+	if !ok {
+		return nil
+	}
+
+	var warnings []string
+	for _, usage := range deprecator.DeprecatedUsages() {
+		warnings = append(warnings, usage.Warning(req.Kind.Kind))
+		if w.Metrics != nil {
+			w.Metrics.DeprecatedUsages.WithLabelValues(req.Namespace, req.Kind.Kind, usage.Field).Inc()
+		}
+	}
+	return warnings
+}
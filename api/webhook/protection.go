@@ -0,0 +1,72 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// +kubebuilder:webhook:path=/validate-chaos-mesh-org-v1alpha1-protection,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=podchaos;networkchaos;iochaos;timechaos;kernelchaos;stresschaos;dbchaos,verbs=create;update,versions=v1alpha1,name=vprotection.kb.io
+
+// ProtectionValidator rejects the chaos which only targets the pods protected by ChaosProtection
+type ProtectionValidator struct {
+	client  client.Client
+	decoder *admission.Decoder
+}
+
+func (v *ProtectionValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	kind, ok := v1alpha1.AllKinds()[req.Kind.Kind]
+	if !ok {
+		return admission.Allowed("")
+	}
+
+	obj := kind.Chaos.DeepCopyObject()
+	if err := v.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	selectorObject, ok := obj.(v1alpha1.SelectorObject)
+	if !ok {
+		return admission.Allowed("")
+	}
+
+	protected, err := utils.IsExclusivelyProtected(ctx, v.client, selectorObject.GetSelectorSpecs())
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	if protected {
+		return admission.Denied(fmt.Sprintf("%s only targets the pods protected by ChaosProtection", req.Kind.Kind))
+	}
+
+	return admission.Allowed("")
+}
+
+func (v *ProtectionValidator) InjectClient(c client.Client) error {
+	v.client = c
+	return nil
+}
+
+func (v *ProtectionValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/mutate-apps-v1-experiment-updater,mutating=true,failurePolicy=fail,groups=apps,resources=deployments;statefulsets;daemonsets,verbs=create;update,versions=v1,name=mupdater.kb.io
+
+// ExperimentUpdaterRecorder records the user who attached the experiment to the workload into the
+// annotation chaos-mesh.org/experiment-updater, who becomes the creator of the attached experiment.
+// The user is only recorded when the experiment annotations are changed, so the updates of the
+// other controllers, such as the revision updated by kube-controller-manager, don't replace it.
+type ExperimentUpdaterRecorder struct {
+	decoder *admission.Decoder
+}
+
+func (v *ExperimentUpdaterRecorder) Handle(ctx context.Context, req admission.Request) admission.Response {
+	obj := &unstructured.Unstructured{}
+
+	err := v.decoder.Decode(req, obj)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if req.Operation != v1beta1.Create && req.Operation != v1beta1.Update {
+		return admission.Allowed("")
+	}
+
+	// the updater is removed along with the experiment
+	annotations := obj.GetAnnotations()
+	var updater string
+	if _, attached := annotations[v1alpha1.AnnotationExperiment]; attached {
+		old := &unstructured.Unstructured{}
+		if req.Operation == v1beta1.Update {
+			if err := v.decoder.DecodeRaw(req.OldObject, old); err != nil {
+				return admission.Errored(http.StatusBadRequest, err)
+			}
+		}
+
+		if req.Operation == v1beta1.Update && !experimentChanged(old.GetAnnotations(), annotations) {
+			updater = old.GetAnnotations()[v1alpha1.AnnotationExperimentUpdater]
+		} else {
+			data, err := json.Marshal(req.UserInfo)
+			if err != nil {
+				return admission.Errored(http.StatusInternalServerError, err)
+			}
+			updater = string(data)
+		}
+	}
+
+	if annotations[v1alpha1.AnnotationExperimentUpdater] == updater {
+		return admission.Allowed("")
+	}
+
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	if updater == "" {
+		delete(annotations, v1alpha1.AnnotationExperimentUpdater)
+	} else {
+		annotations[v1alpha1.AnnotationExperimentUpdater] = updater
+	}
+	obj.SetAnnotations(annotations)
+
+	log.Info("Record updater of experiment", "kind", obj.GetKind(), "namespace", obj.GetNamespace(),
+		"name", obj.GetName(), "updater", updater)
+
+	marshaled, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// experimentChanged returns true if the annotations which attach the experiment are changed
+func experimentChanged(old, new map[string]string) bool {
+	for _, key := range []string{v1alpha1.AnnotationExperiment, v1alpha1.AnnotationExperimentTrigger} {
+		oldValue, oldOK := old[key]
+		newValue, newOK := new[key]
+		if oldOK != newOK || oldValue != newValue {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *ExperimentUpdaterRecorder) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	. "github.com/onsi/gomega"
+	"k8s.io/api/admission/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func newDeploymentObject(g *GomegaWithT, annotations map[string]string) runtime.RawExtension {
+	deployment := &appsv1.Deployment{}
+	deployment.APIVersion = appsv1.SchemeGroupVersion.String()
+	deployment.Kind = "Deployment"
+	deployment.Namespace = "shop"
+	deployment.Name = "web"
+	deployment.Annotations = annotations
+
+	raw, err := json.Marshal(deployment)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	return runtime.RawExtension{Raw: raw}
+}
+
+// recordedUpdater returns the updater annotation of the workload mutated by the recorder
+func recordedUpdater(g *GomegaWithT, req admission.Request) string {
+	decoder, err := admission.NewDecoder(scheme.Scheme)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	recorder := &ExperimentUpdaterRecorder{}
+	g.Expect(recorder.InjectDecoder(decoder)).To(Succeed())
+
+	resp := recorder.Handle(context.TODO(), req)
+	g.Expect(resp.Allowed).To(BeTrue())
+
+	raw := req.Object.Raw
+	if len(resp.Patches) > 0 {
+		patch, err := json.Marshal(resp.Patches)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		decoded, err := jsonpatch.DecodePatch(patch)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		raw, err = decoded.Apply(raw)
+		g.Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	deployment := &appsv1.Deployment{}
+	g.Expect(json.Unmarshal(raw, deployment)).To(Succeed())
+	return deployment.Annotations[v1alpha1.AnnotationExperimentUpdater]
+}
+
+func TestExperimentUpdaterRecorder(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	alice := authenticationv1.UserInfo{Username: "alice"}
+	controller := authenticationv1.UserInfo{Username: "system:serviceaccount:kube-system:deployment-controller"}
+	aliceData := `{"username":"alice"}`
+	forged := `{"username":"admin"}`
+
+	type TestCase struct {
+		name    string
+		req     v1beta1.AdmissionRequest
+		updater string
+	}
+
+	tcs := []TestCase{
+		{
+			name: "the user attaching the experiment is recorded",
+			req: v1beta1.AdmissionRequest{Operation: v1beta1.Create, UserInfo: alice,
+				Object: newDeploymentObject(g, map[string]string{v1alpha1.AnnotationExperiment: "pod-failure"})},
+			updater: aliceData,
+		},
+		{
+			name: "the forged updater is overwritten",
+			req: v1beta1.AdmissionRequest{Operation: v1beta1.Create, UserInfo: alice,
+				Object: newDeploymentObject(g, map[string]string{
+					v1alpha1.AnnotationExperiment:        "pod-failure",
+					v1alpha1.AnnotationExperimentUpdater: forged,
+				})},
+			updater: aliceData,
+		},
+		{
+			name: "the workload without experiment isn't recorded",
+			req: v1beta1.AdmissionRequest{Operation: v1beta1.Create, UserInfo: alice,
+				Object: newDeploymentObject(g, map[string]string{v1alpha1.AnnotationExperimentUpdater: forged})},
+			updater: "",
+		},
+		{
+			name: "the updater is kept when the experiment isn't changed",
+			req: v1beta1.AdmissionRequest{Operation: v1beta1.Update, UserInfo: controller,
+				Object: newDeploymentObject(g, map[string]string{
+					v1alpha1.AnnotationExperiment:        "pod-failure",
+					v1alpha1.AnnotationExperimentUpdater: forged,
+				}),
+				OldObject: newDeploymentObject(g, map[string]string{
+					v1alpha1.AnnotationExperiment:        "pod-failure",
+					v1alpha1.AnnotationExperimentUpdater: aliceData,
+				})},
+			updater: aliceData,
+		},
+		{
+			name: "the user changing the trigger is recorded",
+			req: v1beta1.AdmissionRequest{Operation: v1beta1.Update, UserInfo: alice,
+				Object: newDeploymentObject(g, map[string]string{
+					v1alpha1.AnnotationExperiment:        "pod-failure",
+					v1alpha1.AnnotationExperimentTrigger: v1alpha1.ExperimentTriggerRollout,
+				}),
+				OldObject: newDeploymentObject(g, map[string]string{
+					v1alpha1.AnnotationExperiment:        "pod-failure",
+					v1alpha1.AnnotationExperimentUpdater: forged,
+				})},
+			updater: aliceData,
+		},
+		{
+			name: "the updater is removed along with the experiment",
+			req: v1beta1.AdmissionRequest{Operation: v1beta1.Update, UserInfo: alice,
+				Object: newDeploymentObject(g, map[string]string{v1alpha1.AnnotationExperimentUpdater: aliceData}),
+				OldObject: newDeploymentObject(g, map[string]string{
+					v1alpha1.AnnotationExperiment:        "pod-failure",
+					v1alpha1.AnnotationExperimentUpdater: aliceData,
+				})},
+			updater: "",
+		},
+	}
+
+	for _, tc := range tcs {
+		updater := recordedUpdater(g, admission.Request{AdmissionRequest: tc.req})
+		g.Expect(updater).To(Equal(tc.updater), tc.name)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/argoplugin"
+	"github.com/chaos-mesh/chaos-mesh/pkg/sdk"
+	"github.com/chaos-mesh/chaos-mesh/pkg/version"
+)
+
+var (
+	log  = ctrl.Log.WithName("argo-plugin")
+	conf = &argoplugin.Config{}
+
+	tokenFile    string
+	printVersion bool
+)
+
+func init() {
+	flag.BoolVar(&printVersion, "version", false, "print version information and exit")
+	flag.StringVar(&conf.Host, "host", "127.0.0.1", "the host which http server listens on")
+	flag.IntVar(&conf.Port, "port", argoplugin.DefaultPort, "the port which http server listens on")
+	flag.StringVar(&tokenFile, "token-file", argoplugin.DefaultTokenFile, "the file of the token which the requests must have, the requests aren't authenticated if it doesn't exist")
+
+	flag.Parse()
+}
+
+func main() {
+	version.PrintVersionInfo("Chaos Mesh Argo Workflows Plugin")
+
+	if printVersion {
+		os.Exit(0)
+	}
+
+	ctrl.SetLogger(zap.Logger(true))
+
+	token, err := ioutil.ReadFile(tokenFile)
+	if err != nil && !os.IsNotExist(err) {
+		log.Error(err, "failed to read token", "file", tokenFile)
+		os.Exit(1)
+	}
+	if os.IsNotExist(err) {
+		log.Info("Token file doesn't exist, the requests aren't authenticated", "file", tokenFile)
+	}
+
+	c, err := sdk.NewClient(ctrl.GetConfigOrDie())
+	if err != nil {
+		log.Error(err, "failed to create client")
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		cancel()
+	}()
+
+	// the chaos of the cancelled workflows are aborted before the plugin exits
+	server := argoplugin.NewServer(c, strings.TrimSpace(string(token)))
+	if err := argoplugin.StartServer(ctx, conf, server); err != nil {
+		log.Error(err, "failed to run argo workflows plugin")
+		os.Exit(1)
+	}
+}
@@ -14,12 +14,15 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
+	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon"
+	"github.com/chaos-mesh/chaos-mesh/pkg/tracing"
 	"github.com/chaos-mesh/chaos-mesh/pkg/version"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -27,23 +30,47 @@ import (
 )
 
 var (
-	log  = ctrl.Log.WithName("chaos-daemon")
-	conf = &chaosdaemon.Config{Host: "0.0.0.0"}
+	log        = ctrl.Log.WithName("chaos-daemon")
+	conf       = &chaosdaemon.Config{Host: "0.0.0.0"}
+	tracingCfg = tracing.Config{ServiceName: "chaos-daemon"}
 
-	printVersion bool
+	printVersion   bool
+	allowedClients string
 )
 
 func init() {
 	flag.BoolVar(&printVersion, "version", false, "print version information and exit")
 	flag.IntVar(&conf.GRPCPort, "grpc-port", 31767, "the port which grpc server listens on")
 	flag.IntVar(&conf.HTTPPort, "http-port", 31766, "the port which http server listens on")
-	flag.StringVar(&conf.Runtime, "runtime", "docker", "current container runtime")
+	flag.StringVar(&conf.Runtime, "runtime", "auto", "current container runtime, one of docker, containerd, crio and auto which detects the runtimes by their sockets")
 	flag.BoolVar(&conf.Profiling, "pprof", false, "enable pprof")
+	flag.StringVar(&conf.TLS.CACert, "ca-cert", "", "the CA cert to verify the client certs, grpc is served with mutual TLS if the CA cert, the cert and the key are all set")
+	flag.StringVar(&conf.TLS.Cert, "cert", "", "the serving cert of grpc")
+	flag.StringVar(&conf.TLS.Key, "key", "", "the key of the serving cert")
+	flag.StringVar(&allowedClients, "allowed-clients", "chaos-mesh-controller-manager", "a comma-separated list of the names of client certs which are allowed to call chaos-daemon")
+	flag.StringVar(&conf.JournalPath, "journal-path", "", "the file which the journal of injections is persisted to, so the injected faults are tracked across restarts")
+	flag.StringVar(&conf.Mode, "mode", "privileged", "the mode which chaos-daemon runs in, one of privileged and least-privilege which only supports the actions in the namespaces of the targets with a few linux capabilities")
+	flag.StringVar(&conf.NodeName, "node-name", "", "the node which chaos-daemon runs on, the containers requested are verified to belong to the pods on the node if it's set")
+	flag.StringVar(&tracingCfg.Endpoint, "tracing-endpoint", "", "the url of the collector which accepts spans in zipkin v2 format, tracing is disabled if it is empty")
+	flag.Float64Var(&tracingCfg.SampleRatio, "tracing-sample-ratio", 1, "the ratio of the traces which are sampled")
 
 	flag.Parse()
+
+	if allowedClients != "" {
+		conf.AllowedClients = strings.Split(allowedClients, ",")
+	}
 }
 
 func main() {
+	// chaos-daemon runs itself as the worker of stressors on windows
+	if flag.Arg(0) == chaosdaemon.StressWorkerCommand {
+		if err := chaosdaemon.RunStressWorker(flag.Args()[1:]); err != nil {
+			log.Error(err, "failed to run stress worker")
+			os.Exit(1)
+		}
+		return
+	}
+
 	version.PrintVersionInfo("Chaos-daemon")
 
 	if printVersion {
@@ -52,6 +79,13 @@ func main() {
 
 	ctrl.SetLogger(zap.Logger(true))
 
+	shutdownTracing, err := tracing.Setup(tracingCfg)
+	if err != nil {
+		log.Error(err, "failed to set up tracing")
+		os.Exit(1)
+	}
+	defer shutdownTracing(context.Background())
+
 	reg := prometheus.NewRegistry()
 	reg.MustRegister(
 		prometheus.NewGoCollector(),
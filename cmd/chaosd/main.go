@@ -0,0 +1,67 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosd"
+	"github.com/chaos-mesh/chaos-mesh/pkg/version"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+var (
+	log  = ctrl.Log.WithName("chaosd")
+	conf = &chaosd.Config{}
+
+	printVersion bool
+)
+
+func init() {
+	flag.BoolVar(&printVersion, "version", false, "print version information and exit")
+	flag.StringVar(&conf.Host, "host", "0.0.0.0", "the host which http server listens on")
+	flag.IntVar(&conf.Port, "port", chaosd.DefaultPort, "the port which http server listens on")
+
+	flag.Parse()
+}
+
+func main() {
+	version.PrintVersionInfo("Chaosd")
+
+	if printVersion {
+		os.Exit(0)
+	}
+
+	ctrl.SetLogger(zap.Logger(true))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		cancel()
+	}()
+
+	// all the running attacks are recovered before chaosd exits
+	if err := chaosd.StartServer(ctx, conf, chaosd.NewServer()); err != nil {
+		log.Error(err, "failed to run chaosd")
+		os.Exit(1)
+	}
+}
@@ -14,23 +14,31 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
+	"reflect"
 	"time"
 
 	chaosmeshv1alpha1 "github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	apiWebhook "github.com/chaos-mesh/chaos-mesh/api/webhook"
 	"github.com/chaos-mesh/chaos-mesh/controllers"
+	"github.com/chaos-mesh/chaos-mesh/controllers/annotationtrigger"
 	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	"github.com/chaos-mesh/chaos-mesh/controllers/metrics"
+	"github.com/chaos-mesh/chaos-mesh/pkg/daemonstatus"
+	"github.com/chaos-mesh/chaos-mesh/pkg/experimentlog"
+	"github.com/chaos-mesh/chaos-mesh/pkg/tracing"
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 	"github.com/chaos-mesh/chaos-mesh/pkg/version"
 	"github.com/chaos-mesh/chaos-mesh/pkg/webhook/config"
 	"github.com/chaos-mesh/chaos-mesh/pkg/webhook/config/watcher"
 
+	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 
@@ -74,14 +82,29 @@ func main() {
 
 	// set RPCTimeout config
 	utils.RPCTimeout = common.ControllerCfg.RPCTimeout
+	utils.ChaosDaemonTLS = utils.TLSFiles{
+		CACert: common.ControllerCfg.ChaosDaemonCACert,
+		Cert:   common.ControllerCfg.ChaosDaemonClientCert,
+		Key:    common.ControllerCfg.ChaosDaemonClientKey,
+	}
 
 	ctrl.SetLogger(zap.Logger(true))
 
+	if err := common.SetupShard(); err != nil {
+		setupLog.Error(err, "unable to set up shard")
+		os.Exit(1)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
-		Scheme:             scheme,
-		MetricsBindAddress: common.ControllerCfg.MetricsAddr,
-		LeaderElection:     common.ControllerCfg.EnableLeaderElection,
-		Port:               9443,
+		Scheme:                  scheme,
+		MetricsBindAddress:      common.ControllerCfg.MetricsAddr,
+		LeaderElection:          common.ControllerCfg.EnableLeaderElection,
+		LeaderElectionID:        common.ControllerCfg.LeaderElectionID,
+		LeaderElectionNamespace: common.ControllerCfg.LeaderElectionNamespace,
+		LeaseDuration:           &common.ControllerCfg.LeaderElectionLeaseDuration,
+		RenewDeadline:           &common.ControllerCfg.LeaderElectionRenewDeadline,
+		RetryPeriod:             &common.ControllerCfg.LeaderElectionRetryPeriod,
+		Port:                    9443,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -166,6 +189,127 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = (&controllers.PhysicalMachineChaosReconciler{
+		Client:        mgr.GetClient(),
+		EventRecorder: mgr.GetEventRecorderFor("physicalmachinechaos-controller"),
+		Log:           ctrl.Log.WithName("controllers").WithName("PhysicalMachineChaos"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PhysicalMachineChaos")
+		os.Exit(1)
+	}
+	if err = (&chaosmeshv1alpha1.PhysicalMachineChaos{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "PhysicalMachineChaos")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.DBChaosReconciler{
+		Client:        mgr.GetClient(),
+		EventRecorder: mgr.GetEventRecorderFor("dbchaos-controller"),
+		Log:           ctrl.Log.WithName("controllers").WithName("DBChaos"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DBChaos")
+		os.Exit(1)
+	}
+	if err = (&chaosmeshv1alpha1.DBChaos{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "DBChaos")
+		os.Exit(1)
+	}
+	if err = (&chaosmeshv1alpha1.ChaosTemplate{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ChaosTemplate")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.ChaosMonkeyReconciler{
+		Client:        mgr.GetClient(),
+		EventRecorder: mgr.GetEventRecorderFor("chaosmonkey-controller"),
+		Log:           ctrl.Log.WithName("controllers").WithName("ChaosMonkey"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ChaosMonkey")
+		os.Exit(1)
+	}
+	if err = (&chaosmeshv1alpha1.ChaosMonkey{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ChaosMonkey")
+		os.Exit(1)
+	}
+
+	if err = common.SetupNotifier(mgr); err != nil {
+		setupLog.Error(err, "unable to set up notifier")
+		os.Exit(1)
+	}
+	if err = (&chaosmeshv1alpha1.ChaosNotification{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ChaosNotification")
+		os.Exit(1)
+	}
+	if common.ControllerCfg.AnnotationTrigger {
+		for _, workload := range []annotationtrigger.Workload{&appsv1.Deployment{}, &appsv1.StatefulSet{}, &appsv1.DaemonSet{}} {
+			kind := reflect.TypeOf(workload).Elem().Name()
+			if err = (&controllers.WorkloadReconciler{
+				Client:        mgr.GetClient(),
+				EventRecorder: mgr.GetEventRecorderFor("workload-controller"),
+				Log:           ctrl.Log.WithName("controllers").WithName(kind),
+				Object:        workload,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", kind)
+				os.Exit(1)
+			}
+		}
+	}
+	if err = (&controllers.TargetGoneReconciler{
+		Client:        mgr.GetClient(),
+		EventRecorder: mgr.GetEventRecorderFor("target-gone-controller"),
+		Log:           ctrl.Log.WithName("controllers").WithName("TargetGone"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TargetGone")
+		os.Exit(1)
+	}
+	if common.ControllerCfg.RecordResults {
+		common.SetupResultRecorder(mgr)
+	}
+	if common.ControllerCfg.DaemonStatus {
+		err = common.SetupDaemonStatus(mgr, func(ctx context.Context, node string) (daemonstatus.Client, error) {
+			return utils.NewChaosDaemonClientOnNode(ctx, mgr.GetClient(), node, common.ControllerCfg.ChaosDaemonPort)
+		})
+		if err != nil {
+			setupLog.Error(err, "unable to set up daemon status watcher")
+			os.Exit(1)
+		}
+	}
+
+	shutdownTracing, err := tracing.Setup(tracing.Config{
+		ServiceName: "chaos-controller-manager",
+		Endpoint:    common.ControllerCfg.TracingEndpoint,
+		SampleRatio: common.ControllerCfg.TracingSampleRatio,
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+	defer shutdownTracing(context.Background())
+
+	if err = common.SetupAuditor(); err != nil {
+		setupLog.Error(err, "unable to set up auditor")
+		os.Exit(1)
+	}
+	defer common.Auditor.Close()
+
+	if err = common.SetupTargetProvider(); err != nil {
+		setupLog.Error(err, "unable to set up target provider")
+		os.Exit(1)
+	}
+
+	if common.ControllerCfg.ControllerConfigMap != "" {
+		clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+		if err != nil {
+			setupLog.Error(err, "unable to create kubernetes client")
+			os.Exit(1)
+		}
+		reloader := utils.NewNamespacePolicyReloader(clientset, common.ControllerCfg.Namespace, common.ControllerCfg.ControllerConfigMap)
+		if err = mgr.Add(reloader); err != nil {
+			setupLog.Error(err, "unable to set up namespace policy reloader")
+			os.Exit(1)
+		}
+	}
+
 	// Init metrics collector
 	metricsCollector := metrics.NewChaosCollector(mgr.GetCache(), controllermetrics.Registry)
 
@@ -176,6 +320,19 @@ func main() {
 	conf := config.NewConfigWatcherConf()
 	stopCh := ctrl.SetupSignalHandler()
 
+	common.SetupExperimentLogs()
+	if common.ControllerCfg.ExperimentLogAddr != "0" {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/experiments/logs", experimentlog.Handler(common.ExperimentLogs))
+			mux.Handle("/namespaces/policy", utils.NamespacePolicyHandler(mgr.GetClient()))
+			if err := http.ListenAndServe(common.ControllerCfg.ExperimentLogAddr, mux); err != nil {
+				setupLog.Error(err, "unable to start experiment log server")
+				os.Exit(1)
+			}
+		}()
+	}
+
 	if common.ControllerCfg.PprofAddr != "0" {
 		go func() {
 			if err := http.ListenAndServe(common.ControllerCfg.PprofAddr, nil); err != nil {
@@ -202,6 +359,18 @@ func main() {
 			Metrics: metricsCollector,
 		}},
 	)
+	hookServer.Register("/mutate-chaos-mesh-org-v1alpha1-creator", &webhook.Admission{
+		Handler: &apiWebhook.CreatorRecorder{Namespace: common.ControllerCfg.Namespace},
+	})
+	hookServer.Register("/mutate-apps-v1-experiment-updater", &webhook.Admission{
+		Handler: &apiWebhook.ExperimentUpdaterRecorder{},
+	})
+	hookServer.Register("/validate-chaos-mesh-org-v1alpha1-protection", &webhook.Admission{
+		Handler: &apiWebhook.ProtectionValidator{},
+	})
+	hookServer.Register("/validate-chaos-mesh-org-v1alpha1-deprecation", &apiWebhook.DeprecationWarner{
+		Metrics: metricsCollector,
+	})
 
 	// +kubebuilder:scaffold:builder
 
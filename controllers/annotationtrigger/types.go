@@ -0,0 +1,413 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package annotationtrigger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+const (
+	// templateRetryInterval is the interval to retry when the template doesn't exist yet
+	templateRetryInterval = time.Minute
+	// deletionRetryInterval is the interval to wait for the replaced experiment to be recovered
+	deletionRetryInterval = 5 * time.Second
+
+	// deploymentRevisionAnnotation is the revision of deployments and their ReplicaSets set by kube-controller-manager
+	deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+	// deploymentNewReplicaSetAvailable and deploymentProgressDeadlineExceeded are the reasons of
+	// the Progressing condition of deployments once the rollout completes or fails
+	deploymentNewReplicaSetAvailable   = "NewReplicaSetAvailable"
+	deploymentProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+)
+
+// Workload is a workload which the experiments are attached to
+type Workload interface {
+	metav1.Object
+	runtime.Object
+}
+
+// Reconciler instantiates the experiment attached to a workload by the annotation chaos-mesh.org/experiment,
+// the experiment is scoped to the pods of the workload, and deleted once the annotation is removed or changed
+type Reconciler struct {
+	client.Client
+	record.EventRecorder
+	Log logr.Logger
+}
+
+// Reconcile reconciles a workload
+func (r *Reconciler) Reconcile(req ctrl.Request, workload Workload) (ctrl.Result, error) {
+	return r.reconcile(context.Background(), workload)
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, workload Workload) (ctrl.Result, error) {
+	value, attached := workload.GetAnnotations()[v1alpha1.AnnotationExperiment]
+	attached = attached && workload.GetDeletionTimestamp().IsZero()
+
+	var template string
+	var values map[string]string
+	if attached {
+		var err error
+		if template, values, err = ParseAnnotation(value); err != nil {
+			r.Event(workload, v1.EventTypeWarning, utils.EventWorkloadExperimentFailed, err.Error())
+			// the experiment attached before is kept until the annotation is fixed
+			return ctrl.Result{}, nil
+		}
+	}
+	name := experimentName(workload, template)
+
+	// the attached experiment only runs while its trigger is active
+	running := attached
+	if attached {
+		var err error
+		switch trigger := workload.GetAnnotations()[v1alpha1.AnnotationExperimentTrigger]; trigger {
+		case "", v1alpha1.ExperimentTriggerAlways:
+		case v1alpha1.ExperimentTriggerRollout:
+			deployment, ok := workload.(*appsv1.Deployment)
+			if !ok {
+				r.Event(workload, v1.EventTypeWarning, utils.EventWorkloadExperimentFailed,
+					fmt.Sprintf("trigger %s only supports Deployments", trigger))
+				return ctrl.Result{}, nil
+			}
+			if running, err = r.rollingOut(ctx, deployment); err != nil {
+				return ctrl.Result{}, err
+			}
+		default:
+			r.Event(workload, v1.EventTypeWarning, utils.EventWorkloadExperimentFailed,
+				fmt.Sprintf("unknown trigger %s in annotation %s", trigger, v1alpha1.AnnotationExperimentTrigger))
+			return ctrl.Result{}, nil
+		}
+	}
+
+	experiments, err := r.listExperiments(ctx, workload)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// the experiments which don't match the annotation are deleted, and the new one is created once
+	// they are recovered, so that the attached experiments never overlap
+	exists, pending := false, false
+	for _, obj := range experiments {
+		chaos := obj.(metav1.Object)
+		if !chaos.GetDeletionTimestamp().IsZero() {
+			pending = true
+			continue
+		}
+		if running && chaos.GetName() == name && chaos.GetAnnotations()[v1alpha1.AnnotationExperiment] == value {
+			exists = true
+			continue
+		}
+
+		if err := r.Delete(ctx, obj); err != nil && !k8serror.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		pending = true
+		message := fmt.Sprintf("%s %s is deleted", obj.GetObjectKind().GroupVersionKind().Kind, chaos.GetName())
+		if attached && !running {
+			message += " as the rollout finished"
+		}
+		r.Event(workload, v1.EventTypeNormal, utils.EventWorkloadExperimentDeleted, message)
+	}
+
+	if !running || exists {
+		return ctrl.Result{}, nil
+	}
+	if pending {
+		return ctrl.Result{RequeueAfter: deletionRetryInterval}, nil
+	}
+
+	tpl := &v1alpha1.ChaosTemplate{}
+	if err := r.Get(ctx, types.NamespacedName{Name: template}, tpl); err != nil {
+		if k8serror.IsNotFound(err) {
+			r.Event(workload, v1.EventTypeWarning, utils.EventWorkloadExperimentFailed,
+				fmt.Sprintf("template %s is not found", template))
+			return ctrl.Result{RequeueAfter: templateRetryInterval}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	chaos, err := r.newExperiment(workload, tpl, name, values)
+	if err != nil {
+		r.Event(workload, v1.EventTypeWarning, utils.EventWorkloadExperimentFailed, err.Error())
+		return ctrl.Result{}, nil
+	}
+	if common.ControllerCfg.SecurityMode {
+		if err := common.CheckCreatorPermission(ctx, r.Client, chaos.(v1alpha1.InnerObject)); err != nil {
+			r.Event(workload, v1.EventTypeWarning, utils.EventWorkloadExperimentFailed, err.Error())
+			return ctrl.Result{}, err
+		}
+	}
+	if err := r.Create(ctx, chaos); err != nil {
+		r.Event(workload, v1.EventTypeWarning, utils.EventWorkloadExperimentFailed, err.Error())
+		return ctrl.Result{}, err
+	}
+	r.Event(workload, v1.EventTypeNormal, utils.EventWorkloadExperimentCreated,
+		fmt.Sprintf("%s %s is created from template %s", tpl.Spec.Kind, name, template))
+
+	return ctrl.Result{}, nil
+}
+
+// rollingOut returns whether the deployment is rolling out, which is observed by its ReplicaSets. The rollout
+// begins once the ReplicaSet of a new revision is created, and finishes once the new ReplicaSet is available
+// and the old ones are scaled down, or the rollout exceeds its progress deadline. Scaling isn't a rollout.
+func (r *Reconciler) rollingOut(ctx context.Context, deployment *appsv1.Deployment) (bool, error) {
+	var progressing *appsv1.DeploymentCondition
+	for i := range deployment.Status.Conditions {
+		if deployment.Status.Conditions[i].Type == appsv1.DeploymentProgressing {
+			progressing = &deployment.Status.Conditions[i]
+		}
+	}
+	if progressing != nil && progressing.Reason == deploymentProgressDeadlineExceeded {
+		return false, nil
+	}
+
+	var replicaSets appsv1.ReplicaSetList
+	if err := r.List(ctx, &replicaSets, client.InNamespace(deployment.Namespace)); err != nil {
+		return false, err
+	}
+
+	revision := deployment.Annotations[deploymentRevisionAnnotation]
+	var current *appsv1.ReplicaSet
+	for i := range replicaSets.Items {
+		rs := &replicaSets.Items[i]
+		if !metav1.IsControlledBy(rs, deployment) {
+			continue
+		}
+		if rs.Annotations[deploymentRevisionAnnotation] == revision {
+			current = rs
+			continue
+		}
+		// the pods of the old revisions are still being replaced
+		if (rs.Spec.Replicas != nil && *rs.Spec.Replicas > 0) || rs.Status.Replicas > 0 {
+			return true, nil
+		}
+	}
+	if current == nil {
+		return false, nil
+	}
+
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	if current.Status.AvailableReplicas >= desired {
+		return false, nil
+	}
+	// the pods of the current revision are unavailable, which is a rollout unless the deployment
+	// has finished the rollout of the revision before, e.g. it's scaled up
+	return progressing == nil || progressing.Reason != deploymentNewReplicaSetAvailable, nil
+}
+
+// listExperiments lists the experiments of all kinds attached to the workload
+func (r *Reconciler) listExperiments(ctx context.Context, workload Workload) ([]runtime.Object, error) {
+	var experiments []runtime.Object
+	for kindName, kind := range v1alpha1.AllKinds() {
+		list := kind.ChaosList.DeepCopyObject()
+		if err := r.List(ctx, list, client.InNamespace(workload.GetNamespace()),
+			client.MatchingLabels{v1alpha1.LabelWorkloadUID: string(workload.GetUID())}); err != nil {
+			return nil, err
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			item.GetObjectKind().SetGroupVersionKind(v1alpha1.GroupVersion.WithKind(kindName))
+			experiments = append(experiments, item)
+		}
+	}
+	return experiments, nil
+}
+
+// newExperiment instantiates the template into the experiment attached to the workload
+func (r *Reconciler) newExperiment(workload Workload, tpl *v1alpha1.ChaosTemplate, name string, values map[string]string) (runtime.Object, error) {
+	instance, err := tpl.Instantiate(workload.GetNamespace(), name, values)
+	if err != nil {
+		return nil, fmt.Errorf("unable to instantiate template %s: %v", tpl.Name, err)
+	}
+
+	selector, err := podSelector(workload)
+	if err != nil {
+		return nil, err
+	}
+	chaos, err := scopeToWorkload(instance, tpl.Spec.Kind, workload.GetNamespace(), selector)
+	if err != nil {
+		return nil, err
+	}
+
+	gvk, err := workloadKind(workload)
+	if err != nil {
+		return nil, err
+	}
+	obj := chaos.(metav1.Object)
+	labels := obj.GetLabels()
+	labels[v1alpha1.LabelWorkloadUID] = string(workload.GetUID())
+	obj.SetLabels(labels)
+	annotations := map[string]string{v1alpha1.AnnotationExperiment: workload.GetAnnotations()[v1alpha1.AnnotationExperiment]}
+	// the experiment is created on behalf of the user who attached it, whom the webhook records on the workload
+	if updater := workload.GetAnnotations()[v1alpha1.AnnotationExperimentUpdater]; updater != "" {
+		annotations[v1alpha1.CreatorAnnotationKey] = updater
+	}
+	obj.SetAnnotations(annotations)
+	obj.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(workload, gvk)})
+
+	return chaos, nil
+}
+
+// ParseAnnotation parses the value of the annotation chaos-mesh.org/experiment into the name
+// of the template and the values of the parameters
+func ParseAnnotation(value string) (string, map[string]string, error) {
+	value = strings.TrimSpace(value)
+	template, params := value, ""
+	if i := strings.Index(value, "@"); i >= 0 {
+		template, params = value[:i], value[i+1:]
+	}
+	if template == "" {
+		return "", nil, fmt.Errorf("the template of annotation %s %q is empty", v1alpha1.AnnotationExperiment, value)
+	}
+
+	values := make(map[string]string)
+	for _, param := range strings.Split(params, ",") {
+		if strings.TrimSpace(param) == "" {
+			continue
+		}
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return "", nil, fmt.Errorf("invalid parameter %q in annotation %s, it should be name=value",
+				param, v1alpha1.AnnotationExperiment)
+		}
+		values[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+
+	return template, values, nil
+}
+
+// experimentName is the name of the experiment instantiated from the template for the workload
+func experimentName(workload Workload, template string) string {
+	return fmt.Sprintf("%s-%s", workload.GetName(), template)
+}
+
+// scopeToWorkload restricts the selector of the chaos to the pods of the workload, the other filters
+// of the selector in the template, such as the annotations and the phases of the pods, are kept
+func scopeToWorkload(chaos runtime.Object, kind, namespace string, selector map[string]string) (runtime.Object, error) {
+	if _, ok := chaos.(v1alpha1.SelectorObject); !ok {
+		return nil, fmt.Errorf("%s doesn't select pods, so it can't be attached to workloads", kind)
+	}
+
+	data, err := json.Marshal(chaos)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+
+	spec, _ := obj["spec"].(map[string]interface{})
+	if spec == nil {
+		spec = make(map[string]interface{})
+		obj["spec"] = spec
+	}
+	specSelector, _ := spec["selector"].(map[string]interface{})
+	if specSelector == nil {
+		specSelector = make(map[string]interface{})
+		spec["selector"] = specSelector
+	}
+	labels, _ := specSelector["labelSelectors"].(map[string]interface{})
+	if labels == nil {
+		labels = make(map[string]interface{})
+		specSelector["labelSelectors"] = labels
+	}
+	for key, value := range selector {
+		labels[key] = value
+	}
+	specSelector["namespaces"] = []string{namespace}
+	delete(specSelector, "pods")
+
+	if data, err = json.Marshal(obj); err != nil {
+		return nil, err
+	}
+	scoped := v1alpha1.AllKinds()[kind].Chaos.DeepCopyObject()
+	if err := json.Unmarshal(data, scoped); err != nil {
+		return nil, err
+	}
+
+	// the other selectors, such as the target of NetworkChaos, mustn't reach beyond the namespace of workload.
+	// They're checked as defaulted by the webhook, which selects the namespace of chaos if it's not set.
+	defaulted := scoped.DeepCopyObject()
+	if defaulter, ok := defaulted.(interface{ Default() }); ok {
+		defaulter.Default()
+	}
+	namespaces := common.SelectorNamespaces(defaulted.(v1alpha1.SelectorObject).GetSelectorSpecs()...)
+	if len(namespaces) != 1 || namespaces[0] != namespace {
+		return nil, fmt.Errorf("%s selects pods beyond namespace %s, so it can't be attached to workloads", kind, namespace)
+	}
+	return scoped, nil
+}
+
+// podSelector returns the labels which select the pods of the workload
+func podSelector(workload Workload) (map[string]string, error) {
+	var selector *metav1.LabelSelector
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		selector = w.Spec.Selector
+	case *appsv1.StatefulSet:
+		selector = w.Spec.Selector
+	case *appsv1.DaemonSet:
+		selector = w.Spec.Selector
+	default:
+		return nil, fmt.Errorf("unsupported workload %T", workload)
+	}
+
+	if selector == nil || len(selector.MatchLabels) == 0 {
+		return nil, fmt.Errorf("workload %s doesn't select its pods by matchLabels", workload.GetName())
+	}
+	if len(selector.MatchExpressions) > 0 {
+		return nil, fmt.Errorf("workload %s selects its pods by matchExpressions, which is unsupported", workload.GetName())
+	}
+	return selector.MatchLabels, nil
+}
+
+func workloadKind(workload Workload) (schema.GroupVersionKind, error) {
+	switch workload.(type) {
+	case *appsv1.Deployment:
+		return appsv1.SchemeGroupVersion.WithKind("Deployment"), nil
+	case *appsv1.StatefulSet:
+		return appsv1.SchemeGroupVersion.WithKind("StatefulSet"), nil
+	case *appsv1.DaemonSet:
+		return appsv1.SchemeGroupVersion.WithKind("DaemonSet"), nil
+	}
+	return schema.GroupVersionKind{}, fmt.Errorf("unsupported workload %T", workload)
+}
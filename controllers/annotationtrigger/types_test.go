@@ -0,0 +1,337 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package annotationtrigger
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+)
+
+func newReconciler(objs ...runtime.Object) *Reconciler {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+
+	return &Reconciler{
+		Client:        fake.NewFakeClientWithScheme(scheme, objs...),
+		EventRecorder: record.NewFakeRecorder(100),
+		Log:           ctrl.Log.WithName("annotationtrigger"),
+	}
+}
+
+func newDeployment(annotation string) *appsv1.Deployment {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web", UID: "web-uid"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+	}
+	if annotation != "" {
+		deployment.Annotations = map[string]string{v1alpha1.AnnotationExperiment: annotation}
+	}
+	return deployment
+}
+
+func TestParseAnnotation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	template, values, err := ParseAnnotation("network-delay")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(template).To(Equal("network-delay"))
+	g.Expect(values).To(BeEmpty())
+
+	template, values, err = ParseAnnotation("network-delay@latency=100ms, mode=all")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(template).To(Equal("network-delay"))
+	g.Expect(values).To(Equal(map[string]string{"latency": "100ms", "mode": "all"}))
+
+	_, _, err = ParseAnnotation("@latency=100ms")
+	g.Expect(err).To(HaveOccurred())
+	_, _, err = ParseAnnotation("network-delay@latency")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestReconcile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tpl := &v1alpha1.ChaosTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-failure"},
+		Spec: v1alpha1.ChaosTemplateSpec{
+			Kind:       v1alpha1.KindPodChaos,
+			Parameters: []v1alpha1.ChaosTemplateParameter{{Name: "mode", Default: "one"}},
+			Template: runtime.RawExtension{Raw: []byte(`{"action": "pod-failure", "mode": "${mode}", ` +
+				`"selector": {"namespaces": ["other"], "labelSelectors": {"tier": "frontend"}}}`)},
+		},
+	}
+	deployment := newDeployment("pod-failure@mode=all")
+	r := newReconciler(tpl, deployment)
+	ctx := context.TODO()
+	key := types.NamespacedName{Namespace: "shop", Name: "web-pod-failure"}
+
+	_, err := r.reconcile(ctx, deployment)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	chaos := &v1alpha1.PodChaos{}
+	g.Expect(r.Get(ctx, key, chaos)).To(Succeed())
+	g.Expect(chaos.Spec.Mode).To(Equal(v1alpha1.AllPodMode))
+	g.Expect(chaos.Spec.Selector.Namespaces).To(Equal([]string{"shop"}))
+	g.Expect(chaos.Spec.Selector.LabelSelectors).To(Equal(map[string]string{"app": "web", "tier": "frontend"}))
+	g.Expect(chaos.Labels).To(HaveKeyWithValue(v1alpha1.LabelWorkloadUID, "web-uid"))
+	g.Expect(chaos.Labels).To(HaveKeyWithValue(v1alpha1.LabelChaosTemplate, "pod-failure"))
+	g.Expect(chaos.OwnerReferences).To(HaveLen(1))
+	g.Expect(chaos.OwnerReferences[0].Kind).To(Equal("Deployment"))
+
+	// reconciling again keeps the experiment
+	_, err = r.reconcile(ctx, deployment)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r.Get(ctx, key, &v1alpha1.PodChaos{})).To(Succeed())
+
+	// the experiment is replaced once the annotation is changed
+	deployment.Annotations[v1alpha1.AnnotationExperiment] = "pod-failure@mode=one"
+	result, err := r.reconcile(ctx, deployment)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(deletionRetryInterval))
+	g.Expect(r.Get(ctx, key, &v1alpha1.PodChaos{})).ToNot(Succeed())
+
+	_, err = r.reconcile(ctx, deployment)
+	g.Expect(err).ToNot(HaveOccurred())
+	chaos = &v1alpha1.PodChaos{}
+	g.Expect(r.Get(ctx, key, chaos)).To(Succeed())
+	g.Expect(chaos.Spec.Mode).To(Equal(v1alpha1.OnePodMode))
+
+	// the experiment is deleted once the annotation is removed
+	delete(deployment.Annotations, v1alpha1.AnnotationExperiment)
+	_, err = r.reconcile(ctx, deployment)
+	g.Expect(err).ToNot(HaveOccurred())
+	var list v1alpha1.PodChaosList
+	g.Expect(r.List(ctx, &list, client.InNamespace("shop"))).To(Succeed())
+	g.Expect(list.Items).To(BeEmpty())
+}
+
+func TestReconcileWithoutTemplate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	r := newReconciler()
+	result, err := r.reconcile(context.TODO(), newDeployment("missing"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(templateRetryInterval))
+	g.Expect(r.EventRecorder.(*record.FakeRecorder).Events).To(Receive(ContainSubstring("template missing is not found")))
+}
+
+func newReplicaSet(deployment *appsv1.Deployment, revision string, replicas, available int32) *appsv1.ReplicaSet {
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   deployment.Namespace,
+			Name:        deployment.Name + "-" + revision,
+			Annotations: map[string]string{deploymentRevisionAnnotation: revision},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(deployment, appsv1.SchemeGroupVersion.WithKind("Deployment")),
+			},
+		},
+		Spec:   appsv1.ReplicaSetSpec{Replicas: &replicas},
+		Status: appsv1.ReplicaSetStatus{Replicas: replicas, AvailableReplicas: available},
+	}
+}
+
+func TestReconcileRolloutTrigger(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tpl := &v1alpha1.ChaosTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-failure"},
+		Spec: v1alpha1.ChaosTemplateSpec{
+			Kind:     v1alpha1.KindPodChaos,
+			Template: runtime.RawExtension{Raw: []byte(`{"action": "pod-failure", "mode": "one"}`)},
+		},
+	}
+	replicas := int32(3)
+	deployment := newDeployment("pod-failure")
+	deployment.Annotations[v1alpha1.AnnotationExperimentTrigger] = v1alpha1.ExperimentTriggerRollout
+	deployment.Annotations[deploymentRevisionAnnotation] = "2"
+	deployment.Spec.Replicas = &replicas
+	deployment.Status.Conditions = []appsv1.DeploymentCondition{
+		{Type: appsv1.DeploymentProgressing, Reason: "ReplicaSetUpdated"},
+	}
+	oldRS := newReplicaSet(deployment, "1", 2, 2)
+	newRS := newReplicaSet(deployment, "2", 2, 1)
+	r := newReconciler(tpl, deployment, oldRS, newRS)
+	ctx := context.TODO()
+	key := types.NamespacedName{Namespace: "shop", Name: "web-pod-failure"}
+
+	// the experiment runs while the old pods are replaced
+	_, err := r.reconcile(ctx, deployment)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r.Get(ctx, key, &v1alpha1.PodChaos{})).To(Succeed())
+
+	// the experiment keeps running until the new pods are available
+	g.Expect(r.Update(ctx, newReplicaSet(deployment, "1", 0, 0))).To(Succeed())
+	_, err = r.reconcile(ctx, deployment)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r.Get(ctx, key, &v1alpha1.PodChaos{})).To(Succeed())
+
+	// the experiment is stopped once the rollout finishes
+	g.Expect(r.Update(ctx, newReplicaSet(deployment, "2", 3, 3))).To(Succeed())
+	deployment.Status.Conditions[0].Reason = deploymentNewReplicaSetAvailable
+	_, err = r.reconcile(ctx, deployment)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r.Get(ctx, key, &v1alpha1.PodChaos{})).ToNot(Succeed())
+
+	// scaling isn't a rollout
+	replicas = 5
+	_, err = r.reconcile(ctx, deployment)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r.Get(ctx, key, &v1alpha1.PodChaos{})).ToNot(Succeed())
+}
+
+func TestReconcileRolloutTriggerOfStatefulSet(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "shop",
+			Name:      "db",
+			Annotations: map[string]string{
+				v1alpha1.AnnotationExperiment:        "pod-failure",
+				v1alpha1.AnnotationExperimentTrigger: v1alpha1.ExperimentTriggerRollout,
+			},
+		},
+	}
+	r := newReconciler()
+	_, err := r.reconcile(context.TODO(), statefulSet)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r.EventRecorder.(*record.FakeRecorder).Events).To(Receive(ContainSubstring("only supports Deployments")))
+}
+
+func TestReconcileTargetBeyondWorkload(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type TestCase struct {
+		name    string
+		target  string
+		created bool
+	}
+
+	tcs := []TestCase{
+		{name: "target in the namespace of workload", target: `{"namespaces": ["shop"]}`, created: true},
+		{name: "target defaulted to the namespace of workload", target: `{"labelSelectors": {"app": "db"}}`, created: true},
+		{name: "target in other namespaces", target: `{"namespaces": ["kube-system"]}`},
+		{name: "target in all namespaces", target: `{"namespaces": ["!kube-system"]}`},
+		{name: "target pods in other namespaces", target: `{"pods": {"kube-system": ["etcd"]}}`},
+	}
+
+	for _, tc := range tcs {
+		tpl := &v1alpha1.ChaosTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "partition"},
+			Spec: v1alpha1.ChaosTemplateSpec{
+				Kind: v1alpha1.KindNetworkChaos,
+				Template: runtime.RawExtension{Raw: []byte(`{"action": "partition", "mode": "all", ` +
+					`"target": {"mode": "all", "selector": ` + tc.target + `}}`)},
+			},
+		}
+		r := newReconciler(tpl)
+		ctx := context.TODO()
+
+		_, err := r.reconcile(ctx, newDeployment("partition"))
+		g.Expect(err).ToNot(HaveOccurred(), tc.name)
+		err = r.Get(ctx, types.NamespacedName{Namespace: "shop", Name: "web-partition"}, &v1alpha1.NetworkChaos{})
+		if tc.created {
+			g.Expect(err).ToNot(HaveOccurred(), tc.name)
+			continue
+		}
+		g.Expect(err).To(HaveOccurred(), tc.name)
+		g.Expect(r.EventRecorder.(*record.FakeRecorder).Events).To(Receive(ContainSubstring("beyond namespace shop")), tc.name)
+	}
+}
+
+// sarClient allows the users to create the chaos in the namespaces of allowed
+type sarClient struct {
+	client.Client
+
+	allowed map[string][]string
+}
+
+func (c *sarClient) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOption) error {
+	sar, ok := obj.(*authorizationv1.SubjectAccessReview)
+	if !ok {
+		return c.Client.Create(ctx, obj, opts...)
+	}
+	for _, ns := range c.allowed[sar.Spec.User] {
+		sar.Status.Allowed = sar.Status.Allowed || ns == sar.Spec.ResourceAttributes.Namespace
+	}
+	return nil
+}
+
+func TestReconcileOnBehalfOfUpdater(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	common.ControllerCfg.SecurityMode = true
+	defer func() { common.ControllerCfg.SecurityMode = false }()
+
+	type TestCase struct {
+		name    string
+		updater string
+		created bool
+	}
+
+	tcs := []TestCase{
+		{name: "allowed", updater: `{"username":"alice"}`, created: true},
+		{name: "not allowed in the namespace of workload", updater: `{"username":"bob"}`},
+		{name: "updater is unknown"},
+	}
+
+	for _, tc := range tcs {
+		tpl := &v1alpha1.ChaosTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-failure"},
+			Spec: v1alpha1.ChaosTemplateSpec{
+				Kind:     v1alpha1.KindPodChaos,
+				Template: runtime.RawExtension{Raw: []byte(`{"action": "pod-failure", "mode": "one"}`)},
+			},
+		}
+		deployment := newDeployment("pod-failure")
+		if tc.updater != "" {
+			deployment.Annotations[v1alpha1.AnnotationExperimentUpdater] = tc.updater
+		}
+		r := newReconciler(tpl, deployment)
+		r.Client = &sarClient{Client: r.Client, allowed: map[string][]string{"alice": {"shop"}, "bob": {"other"}}}
+		ctx := context.TODO()
+
+		_, err := r.reconcile(ctx, deployment)
+		chaos := &v1alpha1.PodChaos{}
+		getErr := r.Get(ctx, types.NamespacedName{Namespace: "shop", Name: "web-pod-failure"}, chaos)
+		if !tc.created {
+			g.Expect(err).To(HaveOccurred(), tc.name)
+			g.Expect(getErr).To(HaveOccurred(), tc.name)
+			continue
+		}
+
+		// the updater of workload is the creator of the experiment
+		g.Expect(err).ToNot(HaveOccurred(), tc.name)
+		g.Expect(getErr).ToNot(HaveOccurred(), tc.name)
+		g.Expect(chaos.Annotations).To(HaveKeyWithValue(v1alpha1.CreatorAnnotationKey, tc.updater), tc.name)
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosmonkey
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// Reconciler generates and recovers the experiments of a ChaosMonkey
+type Reconciler struct {
+	client.Client
+	record.EventRecorder
+	Log logr.Logger
+}
+
+// Reconcile reconciles a ChaosMonkey resource
+func (r *Reconciler) Reconcile(req ctrl.Request, monkey *v1alpha1.ChaosMonkey) (ctrl.Result, error) {
+	return r.reconcile(context.Background(), monkey, time.Now())
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, monkey *v1alpha1.ChaosMonkey, now time.Time) (ctrl.Result, error) {
+	interval, err := monkey.Spec.GetInterval()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	duration, err := monkey.Spec.GetDuration()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	active := true
+	if monkey.Spec.ActiveHours != nil {
+		if active, err = monkey.Spec.ActiveHours.IsActive(now); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	requeueAfter := interval
+	requeueBy := func(d time.Duration) {
+		if d < requeueAfter {
+			requeueAfter = d
+		}
+	}
+
+	// the experiments are recovered once they last long enough, or the active hours are over
+	running := 0
+	for i := range monkey.Status.Experiments {
+		exp := &monkey.Status.Experiments[i]
+		if exp.EndTime != nil {
+			continue
+		}
+
+		if end := exp.StartTime.Add(duration); active && now.Before(end) {
+			running++
+			requeueBy(end.Sub(now))
+			continue
+		}
+
+		if err := r.recoverExperiment(ctx, monkey, exp); err != nil {
+			r.Event(monkey, v1.EventTypeWarning, utils.EventMonkeyExperimentFailed, err.Error())
+			return ctrl.Result{}, err
+		}
+		exp.EndTime = &metav1.Time{Time: now}
+		r.Event(monkey, v1.EventTypeNormal, utils.EventMonkeyExperimentRecovered,
+			fmt.Sprintf("%s %s is recovered", exp.Kind, exp.Name))
+	}
+
+	var created runtime.Object
+	if !monkey.Spec.Suspend && active && running < monkey.Spec.GetMaxConcurrent() {
+		next := now
+		if last := monkey.Status.LastExperimentTime; last != nil {
+			next = last.Add(interval)
+		}
+
+		if !now.Before(next) {
+			chaos, exp, err := r.newExperiment(monkey, now)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			// the experiments are created by the controller, so the creator of monkey is checked before
+			if common.ControllerCfg.SecurityMode {
+				err = common.CheckCreatorPermission(ctx, r.Client, chaos.(v1alpha1.InnerObject))
+			}
+			if err == nil {
+				err = r.Create(ctx, chaos)
+			}
+			if err != nil {
+				r.Event(monkey, v1.EventTypeWarning, utils.EventMonkeyExperimentFailed, err.Error())
+				return ctrl.Result{}, err
+			}
+			r.Event(monkey, v1.EventTypeNormal, utils.EventMonkeyExperimentCreated,
+				fmt.Sprintf("%s %s is created with %s%% of the pods", exp.Kind, exp.Name, exp.Value))
+
+			created = chaos
+			monkey.Status.Experiments = append(monkey.Status.Experiments, *exp)
+			monkey.Status.LastExperimentTime = &metav1.Time{Time: now}
+			next = now.Add(interval)
+			requeueBy(duration)
+		}
+		requeueBy(next.Sub(now))
+	}
+
+	if !active {
+		start, err := monkey.Spec.ActiveHours.NextStart(now)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		requeueBy(start.Sub(now))
+	}
+
+	monkey.Status.Experiments = trimHistory(monkey.Status.Experiments, monkey.Spec.GetHistoryLimit())
+	if err := r.Status().Update(ctx, monkey); err != nil {
+		r.Log.Error(err, "unable to update the status of monkey")
+		if created != nil {
+			// the experiment isn't recorded, so it would never be recovered
+			if err := r.Delete(ctx, created); err != nil && !k8serror.IsNotFound(err) {
+				r.Log.Error(err, "unable to delete the unrecorded experiment")
+			}
+		}
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// recoverExperiment deletes the chaos of the experiment, the chaos deleted by others is ignored
+func (r *Reconciler) recoverExperiment(ctx context.Context, monkey *v1alpha1.ChaosMonkey, exp *v1alpha1.MonkeyExperiment) error {
+	kind, ok := v1alpha1.AllKinds()[exp.Kind]
+	if !ok {
+		return fmt.Errorf("unknown kind %s", exp.Kind)
+	}
+
+	chaos := kind.Chaos.DeepCopyObject()
+	meta := chaos.(metav1.Object)
+	meta.SetNamespace(monkey.Namespace)
+	meta.SetName(exp.Name)
+	if err := r.Delete(ctx, chaos); err != nil && !k8serror.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// newExperiment generates a chaos of a random action with random intensities within the limits
+func (r *Reconciler) newExperiment(monkey *v1alpha1.ChaosMonkey, now time.Time) (runtime.Object, *v1alpha1.MonkeyExperiment, error) {
+	spec := &monkey.Spec
+	action := spec.Actions[rand.Intn(len(spec.Actions))]
+	value := strconv.Itoa(1 + rand.Intn(spec.Intensity.GetMaxPercent()))
+
+	selector := *spec.Selector.DeepCopy()
+	if len(selector.Pods) == 0 {
+		selector.DefaultNamespace(monkey.Namespace)
+	}
+
+	meta := metav1.ObjectMeta{
+		Namespace:   monkey.Namespace,
+		Name:        fmt.Sprintf("%s-%s-%s", monkey.Name, action, utilrand.String(5)),
+		Labels:      map[string]string{v1alpha1.LabelChaosMonkey: monkey.Name},
+		Annotations: map[string]string{},
+		OwnerReferences: []metav1.OwnerReference{
+			*metav1.NewControllerRef(monkey, v1alpha1.GroupVersion.WithKind(v1alpha1.KindChaosMonkey)),
+		},
+	}
+
+	// the experiments are created on behalf of the creator of monkey, the webhook keeps the creator
+	// since the controller is a component of Chaos Mesh
+	if creator, ok := monkey.Annotations[v1alpha1.CreatorAnnotationKey]; ok {
+		meta.Annotations[v1alpha1.CreatorAnnotationKey] = creator
+	}
+
+	var chaos runtime.Object
+	var kind string
+	switch action {
+	case v1alpha1.MonkeyPodKillAction:
+		interval, err := spec.GetInterval()
+		if err != nil {
+			return nil, nil, err
+		}
+		// pod-kill must be scheduled, the experiment is recovered before the next kill
+		kind = v1alpha1.KindPodChaos
+		chaos = &v1alpha1.PodChaos{
+			ObjectMeta: meta,
+			Spec: v1alpha1.PodChaosSpec{
+				Action:    v1alpha1.PodKillAction,
+				Mode:      v1alpha1.FixedPercentPodMode,
+				Value:     value,
+				Selector:  selector,
+				Scheduler: &v1alpha1.SchedulerSpec{Cron: "@every " + interval.String()},
+			},
+		}
+	case v1alpha1.MonkeyPodFailureAction:
+		kind = v1alpha1.KindPodChaos
+		chaos = &v1alpha1.PodChaos{
+			ObjectMeta: meta,
+			Spec: v1alpha1.PodChaosSpec{
+				Action:   v1alpha1.PodFailureAction,
+				Mode:     v1alpha1.FixedPercentPodMode,
+				Value:    value,
+				Selector: selector,
+			},
+		}
+	case v1alpha1.MonkeyNetworkDelayAction:
+		maxLatency, err := spec.Intensity.GetMaxLatency()
+		if err != nil {
+			return nil, nil, err
+		}
+		maxMillis := int64(maxLatency / time.Millisecond)
+		if maxMillis < 1 {
+			maxMillis = 1
+		}
+		latency := time.Duration(1+rand.Int63n(maxMillis)) * time.Millisecond
+		kind = v1alpha1.KindNetworkChaos
+		chaos = &v1alpha1.NetworkChaos{
+			ObjectMeta: meta,
+			Spec: v1alpha1.NetworkChaosSpec{
+				Action:   v1alpha1.DelayAction,
+				Mode:     v1alpha1.FixedPercentPodMode,
+				Value:    value,
+				Selector: selector,
+				Delay:    &v1alpha1.DelaySpec{Latency: latency.String()},
+			},
+		}
+	case v1alpha1.MonkeyNetworkLossAction:
+		loss := 1 + rand.Intn(spec.Intensity.GetMaxLoss())
+		kind = v1alpha1.KindNetworkChaos
+		chaos = &v1alpha1.NetworkChaos{
+			ObjectMeta: meta,
+			Spec: v1alpha1.NetworkChaosSpec{
+				Action:   v1alpha1.LossAction,
+				Mode:     v1alpha1.FixedPercentPodMode,
+				Value:    value,
+				Selector: selector,
+				Loss:     &v1alpha1.LossSpec{Loss: strconv.Itoa(loss), Correlation: "0"},
+			},
+		}
+	case v1alpha1.MonkeyCPUStressAction:
+		load := 1 + rand.Intn(spec.Intensity.GetMaxCPULoad())
+		kind = v1alpha1.KindStressChaos
+		chaos = &v1alpha1.StressChaos{
+			ObjectMeta: meta,
+			Spec: v1alpha1.StressChaosSpec{
+				Mode:     v1alpha1.FixedPercentPodMode,
+				Value:    value,
+				Selector: selector,
+				Stressors: &v1alpha1.Stressors{
+					CPUStressor: &v1alpha1.CPUStressor{Stressor: v1alpha1.Stressor{Workers: 1}, Load: &load},
+				},
+			},
+		}
+	default:
+		return nil, nil, fmt.Errorf("unknown action %s", action)
+	}
+
+	return chaos, &v1alpha1.MonkeyExperiment{
+		Kind:      kind,
+		Name:      meta.Name,
+		Action:    action,
+		Value:     value,
+		StartTime: metav1.Time{Time: now},
+	}, nil
+}
+
+// trimHistory drops the oldest recovered experiments until there are no more than limit experiments,
+// the running experiments are always kept
+func trimHistory(experiments []v1alpha1.MonkeyExperiment, limit int) []v1alpha1.MonkeyExperiment {
+	drop := len(experiments) - limit
+	if drop <= 0 {
+		return experiments
+	}
+
+	kept := make([]v1alpha1.MonkeyExperiment, 0, limit)
+	for _, exp := range experiments {
+		if drop > 0 && exp.EndTime != nil {
+			drop--
+			continue
+		}
+		kept = append(kept, exp)
+	}
+	return kept
+}
@@ -0,0 +1,232 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosmonkey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+)
+
+func newReconciler(objs ...runtime.Object) *Reconciler {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+
+	return &Reconciler{
+		Client:        fake.NewFakeClientWithScheme(scheme, objs...),
+		EventRecorder: record.NewFakeRecorder(100),
+		Log:           ctrl.Log.WithName("chaosmonkey"),
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	monkey := &v1alpha1.ChaosMonkey{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "monkey"},
+		Spec: v1alpha1.ChaosMonkeySpec{
+			Selector:  v1alpha1.SelectorSpec{LabelSelectors: map[string]string{"app": "web"}},
+			Actions:   []v1alpha1.MonkeyAction{v1alpha1.MonkeyPodFailureAction},
+			Interval:  "10m",
+			Duration:  "1m",
+			Intensity: &v1alpha1.MonkeyIntensity{MaxPercent: 20},
+		},
+	}
+	r := newReconciler(monkey)
+	ctx := context.TODO()
+	now := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	// the first experiment is created at once
+	result, err := r.reconcile(ctx, monkey, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(time.Minute))
+	g.Expect(monkey.Status.Experiments).To(HaveLen(1))
+
+	exp := monkey.Status.Experiments[0]
+	g.Expect(exp.Kind).To(Equal(v1alpha1.KindPodChaos))
+	g.Expect(exp.EndTime).To(BeNil())
+
+	chaos := &v1alpha1.PodChaos{}
+	g.Expect(r.Get(ctx, client.ObjectKey{Namespace: "shop", Name: exp.Name}, chaos)).To(Succeed())
+	g.Expect(chaos.Labels).To(HaveKeyWithValue(v1alpha1.LabelChaosMonkey, "monkey"))
+	g.Expect(chaos.Spec.Action).To(Equal(v1alpha1.PodFailureAction))
+	g.Expect(chaos.Spec.Mode).To(Equal(v1alpha1.FixedPercentPodMode))
+	g.Expect(chaos.Spec.Selector.Namespaces).To(Equal([]string{"shop"}))
+	g.Expect(chaos.OwnerReferences).To(HaveLen(1))
+
+	// nothing is created before the interval passes
+	result, err = r.reconcile(ctx, monkey, now.Add(30*time.Second))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+	g.Expect(monkey.Status.Experiments).To(HaveLen(1))
+
+	// the experiment is recovered once it lasts long enough
+	result, err = r.reconcile(ctx, monkey, now.Add(time.Minute))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(9 * time.Minute))
+	g.Expect(monkey.Status.Experiments[0].EndTime).ToNot(BeNil())
+	err = r.Get(ctx, client.ObjectKey{Namespace: "shop", Name: exp.Name}, &v1alpha1.PodChaos{})
+	g.Expect(err).To(HaveOccurred())
+
+	// the suspended monkey doesn't create new experiments
+	monkey.Spec.Suspend = true
+	_, err = r.reconcile(ctx, monkey, now.Add(10*time.Minute))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(monkey.Status.Experiments).To(HaveLen(1))
+
+	monkey.Spec.Suspend = false
+	_, err = r.reconcile(ctx, monkey, now.Add(10*time.Minute))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(monkey.Status.Experiments).To(HaveLen(2))
+}
+
+func TestReconcileOutsideActiveHours(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	monkey := &v1alpha1.ChaosMonkey{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "monkey"},
+		Spec: v1alpha1.ChaosMonkeySpec{
+			Selector:    v1alpha1.SelectorSpec{Namespaces: []string{"shop"}},
+			Actions:     []v1alpha1.MonkeyAction{v1alpha1.MonkeyNetworkDelayAction},
+			Duration:    "30m",
+			ActiveHours: &v1alpha1.ActiveHours{Start: "09:00", End: "17:00"},
+		},
+	}
+	r := newReconciler(monkey)
+	ctx := context.TODO()
+
+	_, err := r.reconcile(ctx, monkey, time.Date(2020, 6, 1, 16, 50, 0, 0, time.UTC))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(monkey.Status.Experiments).To(HaveLen(1))
+
+	// the running experiment is recovered when the window closes, and waits for the next window
+	result, err := r.reconcile(ctx, monkey, time.Date(2020, 6, 1, 17, 0, 0, 0, time.UTC))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(monkey.Status.Experiments).To(HaveLen(1))
+	g.Expect(monkey.Status.Experiments[0].EndTime).ToNot(BeNil())
+	g.Expect(result.RequeueAfter).To(Equal(10 * time.Minute))
+
+	result, err = r.reconcile(ctx, monkey, time.Date(2020, 6, 1, 20, 0, 0, 0, time.UTC))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(monkey.Status.Experiments).To(HaveLen(1))
+	g.Expect(result.RequeueAfter).To(Equal(10 * time.Minute))
+}
+
+// sarClient allows the creators to create the chaos in the namespaces of allowed
+type sarClient struct {
+	client.Client
+
+	allowed map[string][]string
+}
+
+func (c *sarClient) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOption) error {
+	sar, ok := obj.(*authorizationv1.SubjectAccessReview)
+	if !ok {
+		return c.Client.Create(ctx, obj, opts...)
+	}
+	for _, ns := range c.allowed[sar.Spec.User] {
+		sar.Status.Allowed = sar.Status.Allowed || ns == sar.Spec.ResourceAttributes.Namespace
+	}
+	return nil
+}
+
+func TestReconcileOnBehalfOfCreator(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	common.ControllerCfg.SecurityMode = true
+	defer func() { common.ControllerCfg.SecurityMode = false }()
+
+	type TestCase struct {
+		name      string
+		creator   string
+		namespace string
+		created   bool
+	}
+
+	tcs := []TestCase{
+		{name: "allowed", creator: `{"username":"alice"}`, namespace: "shop", created: true},
+		{name: "not allowed in the target namespace", creator: `{"username":"alice"}`, namespace: "kube-system"},
+		{name: "creator is unknown", namespace: "shop"},
+	}
+
+	for _, tc := range tcs {
+		monkey := &v1alpha1.ChaosMonkey{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "monkey"},
+			Spec: v1alpha1.ChaosMonkeySpec{
+				Selector: v1alpha1.SelectorSpec{Namespaces: []string{tc.namespace}},
+				Actions:  []v1alpha1.MonkeyAction{v1alpha1.MonkeyPodFailureAction},
+				Duration: "1m",
+			},
+		}
+		if tc.creator != "" {
+			monkey.Annotations = map[string]string{v1alpha1.CreatorAnnotationKey: tc.creator}
+		}
+		r := newReconciler(monkey)
+		r.Client = &sarClient{Client: r.Client, allowed: map[string][]string{"alice": {"shop"}}}
+		ctx := context.TODO()
+
+		_, err := r.reconcile(ctx, monkey, time.Now())
+		var list v1alpha1.PodChaosList
+		g.Expect(r.List(ctx, &list)).To(Succeed())
+		if !tc.created {
+			g.Expect(err).To(HaveOccurred(), tc.name)
+			g.Expect(list.Items).To(BeEmpty(), tc.name)
+			continue
+		}
+
+		// the creator of monkey is propagated to the experiments
+		g.Expect(err).ToNot(HaveOccurred(), tc.name)
+		g.Expect(list.Items).To(HaveLen(1), tc.name)
+		g.Expect(list.Items[0].Annotations).To(HaveKeyWithValue(v1alpha1.CreatorAnnotationKey, tc.creator), tc.name)
+	}
+}
+
+func TestTrimHistory(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	end := metav1.Now()
+	experiments := []v1alpha1.MonkeyExperiment{
+		{Name: "a", EndTime: &end},
+		{Name: "b"},
+		{Name: "c", EndTime: &end},
+		{Name: "d"},
+	}
+
+	g.Expect(trimHistory(experiments, 10)).To(HaveLen(4))
+
+	names := func(experiments []v1alpha1.MonkeyExperiment) []string {
+		var names []string
+		for _, exp := range experiments {
+			names = append(names, exp.Name)
+		}
+		return names
+	}
+	g.Expect(names(trimHistory(experiments, 3))).To(Equal([]string{"b", "c", "d"}))
+	// the running experiments are always kept
+	g.Expect(names(trimHistory(experiments, 1))).To(Equal([]string{"b", "d"}))
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosmonkey"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+)
+
+// ChaosMonkeyReconciler reconciles a ChaosMonkey object
+type ChaosMonkeyReconciler struct {
+	client.Client
+	record.EventRecorder
+	Log logr.Logger
+}
+
+// +kubebuilder:rbac:groups=chaos-mesh.org,resources=chaosmonkeys,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=chaos-mesh.org,resources=chaosmonkeys/status,verbs=get;update;patch
+
+// Reconcile reconciles a ChaosMonkey resource
+func (r *ChaosMonkeyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	monkey := &v1alpha1.ChaosMonkey{}
+	if err := r.Get(context.Background(), req.NamespacedName, monkey); err != nil {
+		if !k8serror.IsNotFound(err) {
+			r.Log.Error(err, "unable to get chaos monkey")
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// the experiments are garbage collected along with the deleted monkey
+	if !monkey.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	reconciler := chaosmonkey.Reconciler{
+		Client:        r.Client,
+		EventRecorder: r.EventRecorder,
+		Log:           r.Log.WithValues("namespace", req.Namespace, "name", req.Name),
+	}
+	return reconciler.Reconcile(req, monkey)
+}
+
+// SetupWithManager setups a chaos monkey reconciler on controller-manager
+func (r *ChaosMonkeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ChaosMonkey{}).
+		WithEventFilter(common.ShardPredicate()).
+		Complete(r)
+}
@@ -0,0 +1,131 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+const (
+	// AnnotationInjectKey is set on the pods which are under chaos, the value
+	// describes the injected chaos, e.g. `network-delay`.
+	AnnotationInjectKey = `chaos-mesh.org/inject`
+	// AnnotationExperimentKey is set on the pods which are under chaos, the value
+	// is the `namespace/name` of the chaos experiment.
+	AnnotationExperimentKey = `chaos-mesh.org/experiment`
+)
+
+// InjectAnnotationValue returns the value of AnnotationInjectKey for the chaos kind and action
+func InjectAnnotationValue(kind, action string) string {
+	// actions of PodChaos are self-described, such as `pod-failure` and `container-kill`
+	if kind == v1alpha1.KindPodChaos && action != "" {
+		return action
+	}
+
+	prefix := strings.ToLower(strings.TrimSuffix(kind, "Chaos"))
+	if action == "" {
+		return prefix
+	}
+
+	return fmt.Sprintf("%s-%s", prefix, action)
+}
+
+func experimentAnnotationValue(chaos *v1alpha1.ChaosInstance) string {
+	return fmt.Sprintf("%s/%s", chaos.Namespace, chaos.Name)
+}
+
+// AnnotatePods annotates the pods recorded in the status of chaos to mark them as under chaos.
+// The pods of the simulated chaos are left untouched.
+func AnnotatePods(ctx context.Context, c client.Client, chaos v1alpha1.InnerObject) error {
+	if chaos.GetStatus().Experiment.Simulated {
+		return nil
+	}
+
+	instance := chaosInstance(chaos)
+	experiment := experimentAnnotationValue(instance)
+
+	return updatePodRecords(ctx, c, chaos, func(pod *v1.Pod, record v1alpha1.PodStatus) bool {
+		inject := InjectAnnotationValue(instance.Kind, record.Action)
+		if pod.Annotations[AnnotationInjectKey] == inject &&
+			pod.Annotations[AnnotationExperimentKey] == experiment {
+			return false
+		}
+
+		if pod.Annotations == nil {
+			pod.Annotations = make(map[string]string)
+		}
+		pod.Annotations[AnnotationInjectKey] = inject
+		pod.Annotations[AnnotationExperimentKey] = experiment
+		return true
+	})
+}
+
+// CleanPodAnnotations removes the annotations added by AnnotatePods.
+// The annotations are kept if the pod has been annotated by another chaos.
+func CleanPodAnnotations(ctx context.Context, c client.Client, chaos v1alpha1.InnerObject) error {
+	experiment := experimentAnnotationValue(chaosInstance(chaos))
+
+	return updatePodRecords(ctx, c, chaos, func(pod *v1.Pod, _ v1alpha1.PodStatus) bool {
+		if pod.Annotations[AnnotationExperimentKey] != experiment {
+			return false
+		}
+
+		delete(pod.Annotations, AnnotationInjectKey)
+		delete(pod.Annotations, AnnotationExperimentKey)
+		return true
+	})
+}
+
+// updatePodRecords applies mutate on every pod recorded in the status of chaos,
+// the pod is updated only when mutate returns true. Pods which are not found are ignored.
+func updatePodRecords(ctx context.Context, c client.Client, chaos v1alpha1.InnerObject,
+	mutate func(pod *v1.Pod, record v1alpha1.PodStatus) bool) error {
+	var result error
+
+	for _, record := range chaos.GetStatus().Experiment.PodRecords {
+		key := types.NamespacedName{
+			Namespace: record.Namespace,
+			Name:      record.Name,
+		}
+
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			var pod v1.Pod
+			if err := c.Get(ctx, key, &pod); err != nil {
+				return err
+			}
+
+			if !mutate(&pod, record) {
+				return nil
+			}
+
+			return c.Update(ctx, &pod)
+		})
+		if err != nil && !k8serror.IsNotFound(err) {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestInjectAnnotationValue(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(InjectAnnotationValue(v1alpha1.KindPodChaos, "pod-failure")).To(Equal("pod-failure"))
+	g.Expect(InjectAnnotationValue(v1alpha1.KindNetworkChaos, "delay")).To(Equal("network-delay"))
+	g.Expect(InjectAnnotationValue(v1alpha1.KindTimeChaos, "")).To(Equal("time"))
+}
+
+func TestAnnotatePods(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	p1, p2, gone := newPod("p1"), newPod("p2"), newPod("gone")
+	// p2 is annotated by another chaos after this one
+	p2.Annotations = map[string]string{AnnotationInjectKey: "time", AnnotationExperimentKey: "default/other"}
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, &p1, &p2)
+
+	chaos := &v1alpha1.TimeChaos{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "skew"}}
+	chaos.Status.Experiment.PodRecords = []v1alpha1.PodStatus{recordPod(&p1), recordPod(&gone)}
+
+	g.Expect(AnnotatePods(context.TODO(), c, chaos)).To(Succeed())
+	annotations := podAnnotations(g, c, "p1")
+	g.Expect(annotations).To(HaveKeyWithValue(AnnotationInjectKey, "time"))
+	g.Expect(annotations).To(HaveKeyWithValue(AnnotationExperimentKey, "default/skew"))
+
+	// the annotations of another chaos are kept
+	chaos.Status.Experiment.PodRecords = append(chaos.Status.Experiment.PodRecords, recordPod(&p2))
+	g.Expect(CleanPodAnnotations(context.TODO(), c, chaos)).To(Succeed())
+	g.Expect(podAnnotations(g, c, "p1")).ToNot(HaveKey(AnnotationExperimentKey))
+	g.Expect(podAnnotations(g, c, "p1")).ToNot(HaveKey(AnnotationInjectKey))
+	g.Expect(podAnnotations(g, c, "p2")).To(HaveKeyWithValue(AnnotationExperimentKey, "default/other"))
+
+	// the pods of the simulated chaos are left untouched
+	chaos.Status.Experiment.Simulated = true
+	g.Expect(AnnotatePods(context.TODO(), c, chaos)).To(Succeed())
+	g.Expect(podAnnotations(g, c, "p1")).ToNot(HaveKey(AnnotationExperimentKey))
+}
+
+func podAnnotations(g *WithT, c client.Client, name string) map[string]string {
+	var pod v1.Pod
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: name}, &pod)).To(Succeed())
+	return pod.Annotations
+}
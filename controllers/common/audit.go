@@ -0,0 +1,117 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/metrics"
+	"github.com/chaos-mesh/chaos-mesh/pkg/audit"
+	"github.com/chaos-mesh/chaos-mesh/pkg/simulation"
+)
+
+// Auditor writes the audit records of all injections and recoveries, it is
+// set up by SetupAuditor. No record is written if it is nil.
+var Auditor *audit.Auditor
+
+// SetupAuditor creates the Auditor with the audit sinks in ControllerCfg
+func SetupAuditor() error {
+	sinks, err := audit.NewSinks(audit.Config{
+		Sinks:              ControllerCfg.AuditSinks,
+		FilePath:           ControllerCfg.AuditFilePath,
+		WebhookURL:         ControllerCfg.AuditWebhookURL,
+		KafkaRESTProxy:     ControllerCfg.AuditKafkaRESTProxy,
+		KafkaTopic:         ControllerCfg.AuditKafkaTopic,
+		GrafanaURL:         ControllerCfg.AuditGrafanaURL,
+		GrafanaAPIKey:      ControllerCfg.AuditGrafanaAPIKey,
+		GrafanaDashboardID: ControllerCfg.AuditGrafanaDashboardID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(sinks) > 0 {
+		Auditor = audit.NewAuditor(ControllerCfg.AuditQueueSize, func(record *audit.Record) {
+			metrics.AuditRecordsDropped.WithLabelValues(record.Kind, string(record.Operation)).Inc()
+		}, sinks...)
+	}
+	return nil
+}
+
+// AuditOperation writes the audit record of an injection or a recovery of chaos,
+// which starts at startTime and results in err
+func AuditOperation(ctx context.Context, chaos v1alpha1.InnerObject, operation audit.Operation, startTime time.Time, err error) {
+	if Auditor == nil {
+		return
+	}
+
+	instance := chaosInstance(chaos)
+	record := &audit.Record{
+		Operation: operation,
+		Kind:      instance.Kind,
+		Namespace: instance.Namespace,
+		Name:      instance.Name,
+		Targets:   auditTargets(chaos),
+		StartTime: startTime,
+		EndTime:   time.Now(),
+		Outcome:   audit.OutcomeSuccess,
+		Simulated: simulation.IsSimulated(ctx),
+	}
+
+	if creator, creatorErr := getCreator(chaos); creatorErr == nil {
+		record.Creator = creator.Username
+	}
+
+	if err != nil {
+		record.Outcome = audit.OutcomeFailure
+		record.Error = err.Error()
+	}
+
+	Auditor.Audit(ctx, record)
+}
+
+func auditTargets(chaos v1alpha1.InnerObject) []audit.Target {
+	var containers []string
+	switch chaos := chaos.(type) {
+	case *v1alpha1.PodChaos:
+		containers = chaos.Spec.GetContainerNames()
+	case *v1alpha1.TimeChaos:
+		containers = chaos.Spec.ContainerNames
+	case *v1alpha1.PhysicalMachineChaos:
+		// the targets are the machines which chaosd runs on instead of pods
+		targets := make([]audit.Target, 0, len(chaos.Spec.Addresses))
+		for _, address := range chaos.Spec.Addresses {
+			targets = append(targets, audit.Target{
+				Name:   address,
+				Action: string(chaos.Spec.Action),
+			})
+		}
+		return targets
+	}
+
+	records := chaos.GetStatus().Experiment.PodRecords
+	targets := make([]audit.Target, 0, len(records))
+	for _, record := range records {
+		targets = append(targets, audit.Target{
+			Namespace:  record.Namespace,
+			Name:       record.Name,
+			Action:     record.Action,
+			Containers: containers,
+		})
+	}
+
+	return targets
+}
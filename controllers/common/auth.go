@@ -0,0 +1,150 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// CheckCreatorPermission checks whether the creator of chaos is allowed to create
+// the chaos in all the namespaces which the chaos targets. The chaos whose creator is unknown,
+// e.g. the one created before the creator is recorded, is never allowed, it must be recreated.
+func CheckCreatorPermission(ctx context.Context, c client.Client, chaos v1alpha1.InnerObject) error {
+	instance := chaosInstance(chaos)
+
+	creator, err := getCreator(chaos)
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range targetNamespaces(chaos) {
+		sar := &authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: namespace,
+					Verb:      "create",
+					Group:     v1alpha1.GroupVersion.Group,
+					Resource:  strings.ToLower(instance.Kind),
+				},
+				User:   creator.Username,
+				UID:    creator.UID,
+				Groups: creator.Groups,
+				Extra:  convertExtra(creator.Extra),
+			},
+		}
+
+		if err := c.Create(ctx, sar); err != nil {
+			return err
+		}
+
+		if !sar.Status.Allowed {
+			scope := fmt.Sprintf("namespace %s", namespace)
+			if namespace == "" {
+				scope = "all namespaces"
+			}
+			return fmt.Errorf("creator %s is not allowed to create %s in %s", creator.Username, instance.Kind, scope)
+		}
+	}
+
+	return nil
+}
+
+func getCreator(chaos v1alpha1.InnerObject) (*authenticationv1.UserInfo, error) {
+	instance := chaosInstance(chaos)
+
+	meta, ok := chaos.(interface{ GetAnnotations() map[string]string })
+	if !ok {
+		return nil, fmt.Errorf("%s %s/%s has no annotations", instance.Kind, instance.Namespace, instance.Name)
+	}
+
+	data, ok := meta.GetAnnotations()[v1alpha1.CreatorAnnotationKey]
+	if !ok {
+		return nil, fmt.Errorf("creator of %s %s/%s is unknown, recreate it to record the creator",
+			instance.Kind, instance.Namespace, instance.Name)
+	}
+
+	creator := &authenticationv1.UserInfo{}
+	if err := json.Unmarshal([]byte(data), creator); err != nil {
+		return nil, err
+	}
+
+	return creator, nil
+}
+
+// targetNamespaces returns the namespaces which the selectors of chaos could select pods from.
+// An empty string is returned when the selectors are not restricted to specific namespaces,
+// which means that the permission is checked for all namespaces.
+func targetNamespaces(chaos v1alpha1.InnerObject) []string {
+	selectorObject, ok := chaos.(v1alpha1.SelectorObject)
+	if !ok {
+		return []string{""}
+	}
+	return SelectorNamespaces(selectorObject.GetSelectorSpecs()...)
+}
+
+// SelectorNamespaces returns the namespaces which the selectors could select pods from. An empty
+// string is returned when the selectors are not restricted to specific namespaces.
+func SelectorNamespaces(selectors ...v1alpha1.SelectorSpec) []string {
+	set := make(map[string]struct{})
+	for _, selector := range selectors {
+		if len(selector.Pods) > 0 {
+			for ns := range selector.Pods {
+				set[ns] = struct{}{}
+			}
+			continue
+		}
+
+		if len(selector.Namespaces) == 0 {
+			return []string{""}
+		}
+
+		for _, ns := range selector.Namespaces {
+			// the namespace selector such as `!kube-system` is not restricted to specific namespaces
+			if len(validation.IsDNS1123Label(ns)) > 0 {
+				return []string{""}
+			}
+			set[ns] = struct{}{}
+		}
+	}
+
+	namespaces := make([]string, 0, len(set))
+	for ns := range set {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+func convertExtra(extra map[string]authenticationv1.ExtraValue) map[string]authorizationv1.ExtraValue {
+	if extra == nil {
+		return nil
+	}
+
+	ret := make(map[string]authorizationv1.ExtraValue, len(extra))
+	for k, v := range extra {
+		ret[k] = authorizationv1.ExtraValue(v)
+	}
+	return ret
+}
@@ -0,0 +1,130 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// sarClient allows the users to create the chaos in the namespaces of allowed, "" stands for all namespaces
+type sarClient struct {
+	client.Client
+
+	allowed map[string][]string
+	reviews []authorizationv1.SubjectAccessReviewSpec
+}
+
+func (c *sarClient) Create(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+	sar := obj.(*authorizationv1.SubjectAccessReview)
+	c.reviews = append(c.reviews, sar.Spec)
+	for _, ns := range c.allowed[sar.Spec.User] {
+		if ns == sar.Spec.ResourceAttributes.Namespace && sar.Spec.ResourceAttributes.Verb == "create" {
+			sar.Status.Allowed = true
+		}
+	}
+	return nil
+}
+
+func newCreatedPodChaos(creator string, selector v1alpha1.SelectorSpec) *v1alpha1.PodChaos {
+	chaos := &v1alpha1.PodChaos{}
+	chaos.Namespace = "chaos"
+	chaos.Name = "pod-kill"
+	chaos.Spec.Selector = selector
+	if creator != "" {
+		chaos.Annotations = map[string]string{v1alpha1.CreatorAnnotationKey: creator}
+	}
+	return chaos
+}
+
+func TestTargetNamespaces(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type TestCase struct {
+		name       string
+		selector   v1alpha1.SelectorSpec
+		namespaces []string
+	}
+
+	tcs := []TestCase{
+		{
+			name:       "selector without namespaces",
+			selector:   v1alpha1.SelectorSpec{LabelSelectors: map[string]string{"app": "tikv"}},
+			namespaces: []string{""},
+		},
+		{
+			name:       "selector with namespaces",
+			selector:   v1alpha1.SelectorSpec{Namespaces: []string{"ns1", "ns2", "ns1"}},
+			namespaces: []string{"ns1", "ns2"},
+		},
+		{
+			name:       "selector excluding namespaces",
+			selector:   v1alpha1.SelectorSpec{Namespaces: []string{"ns1", "!kube-system"}},
+			namespaces: []string{""},
+		},
+		{
+			name: "selector with pods",
+			selector: v1alpha1.SelectorSpec{
+				Namespaces: []string{"ignored"},
+				Pods:       map[string][]string{"ns1": {"p1"}, "ns2": {"p2"}},
+			},
+			namespaces: []string{"ns1", "ns2"},
+		},
+	}
+
+	for _, tc := range tcs {
+		chaos := newCreatedPodChaos("", tc.selector)
+		g.Expect(targetNamespaces(chaos)).To(ConsistOf(tc.namespaces), tc.name)
+	}
+}
+
+func TestCheckCreatorPermission(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	alice := `{"username":"alice","groups":["dev"]}`
+	cli := &sarClient{allowed: map[string][]string{"alice": {"ns1", "ns2"}}}
+
+	chaos := newCreatedPodChaos(alice, v1alpha1.SelectorSpec{Namespaces: []string{"ns1", "ns2"}})
+	g.Expect(CheckCreatorPermission(context.TODO(), cli, chaos)).To(Succeed())
+	g.Expect(cli.reviews).To(HaveLen(2))
+	g.Expect(cli.reviews[0].User).To(Equal("alice"))
+	g.Expect(cli.reviews[0].Groups).To(Equal([]string{"dev"}))
+	g.Expect(cli.reviews[0].ResourceAttributes.Resource).To(Equal("podchaos"))
+
+	// the creator must be allowed in all the target namespaces
+	chaos = newCreatedPodChaos(alice, v1alpha1.SelectorSpec{Namespaces: []string{"ns1", "ns3"}})
+	err := CheckCreatorPermission(context.TODO(), cli, chaos)
+	g.Expect(err).To(MatchError("creator alice is not allowed to create PodChaos in namespace ns3"))
+
+	chaos = newCreatedPodChaos(alice, v1alpha1.SelectorSpec{})
+	err = CheckCreatorPermission(context.TODO(), cli, chaos)
+	g.Expect(err).To(MatchError("creator alice is not allowed to create PodChaos in all namespaces"))
+
+	cli.allowed["alice"] = append(cli.allowed["alice"], "")
+	g.Expect(CheckCreatorPermission(context.TODO(), cli, chaos)).To(Succeed())
+
+	// the chaos whose creator is unknown is never allowed
+	cli.reviews = nil
+	chaos = newCreatedPodChaos("", v1alpha1.SelectorSpec{Namespaces: []string{"ns1"}})
+	err = CheckCreatorPermission(context.TODO(), cli, chaos)
+	g.Expect(err).To(MatchError(ContainSubstring("creator of PodChaos chaos/pod-kill is unknown")))
+	g.Expect(cli.reviews).To(BeEmpty())
+}
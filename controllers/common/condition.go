@@ -0,0 +1,57 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// reasoner is implemented by the errors with machine-readable reasons, e.g. the selection errors
+type reasoner interface {
+	Reason() string
+}
+
+// SetSelectedCondition sets the Selected condition by the result of applying the chaos.
+// The condition is false with the reason of the error if the pods failed to be selected,
+// and it's kept if the chaos failed for other errors.
+func SetSelectedCondition(status *v1alpha1.ChaosStatus, err error) {
+	if err == nil {
+		condition := v1alpha1.ChaosCondition{
+			Type:   v1alpha1.ConditionSelected,
+			Status: corev1.ConditionTrue,
+			Reason: v1alpha1.SelectedReasonSelected,
+		}
+		if selection := status.Experiment.Selection; selection != nil {
+			condition.Message = fmt.Sprintf("%d pods are selected", selection.Selected)
+		}
+		status.SetCondition(condition)
+		return
+	}
+
+	var r reasoner
+	if !errors.As(err, &r) {
+		return
+	}
+	status.SetCondition(v1alpha1.ChaosCondition{
+		Type:    v1alpha1.ConditionSelected,
+		Status:  corev1.ConditionFalse,
+		Reason:  r.Reason(),
+		Message: err.Error(),
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+type reasonError struct {
+	reason string
+}
+
+func (e *reasonError) Error() string  { return "no pod is selected" }
+func (e *reasonError) Reason() string { return e.reason }
+
+func TestSetSelectedCondition(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	status := &v1alpha1.ChaosStatus{}
+	SetSelectedCondition(status, errors.New("permission denied"))
+	g.Expect(status.GetCondition(v1alpha1.ConditionSelected)).Should(BeNil())
+
+	SetSelectedCondition(status, &reasonError{reason: v1alpha1.SelectedReasonNoPodSelected})
+	condition := status.GetCondition(v1alpha1.ConditionSelected)
+	g.Expect(condition).ShouldNot(BeNil())
+	g.Expect(condition.Status).Should(Equal(v1.ConditionFalse))
+	g.Expect(condition.Reason).Should(Equal(v1alpha1.SelectedReasonNoPodSelected))
+	g.Expect(condition.Message).Should(Equal("no pod is selected"))
+
+	status.Experiment.Selection = &v1alpha1.SelectionStatus{Matched: 3, Selected: 2}
+	SetSelectedCondition(status, nil)
+	condition = status.GetCondition(v1alpha1.ConditionSelected)
+	g.Expect(condition.Status).Should(Equal(v1.ConditionTrue))
+	g.Expect(condition.Reason).Should(Equal(v1alpha1.SelectedReasonSelected))
+	g.Expect(condition.Message).Should(Equal("2 pods are selected"))
+}
@@ -0,0 +1,203 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/daemonstatus"
+	"github.com/chaos-mesh/chaos-mesh/pkg/simulation"
+)
+
+// eventChaosTargetFailed is the reason of the event recorded when a target of the running chaos fails,
+// e.g. its stressors exit
+const eventChaosTargetFailed = "ChaosTargetFailed"
+
+// DaemonStatus watches the progress of chaos pushed by chaos-daemon, it's nil if it isn't set up
+var DaemonStatus *daemonstatus.Watcher
+
+// SetupDaemonStatus watches the progress pushed by chaos-daemon through the clients created by dial,
+// and writes the progress into the records of the targets in the status of chaos as it arrives
+func SetupDaemonStatus(mgr ctrl.Manager, dial daemonstatus.Dialer) error {
+	c := mgr.GetClient()
+	recorder := mgr.GetEventRecorderFor("chaos-daemon-status")
+
+	DaemonStatus = daemonstatus.NewWatcher(dial, func(ctx context.Context, event *pb.StatusEvent) {
+		handleDaemonEvent(ctx, c, recorder, event)
+	}, func(ctx context.Context) ([]string, error) {
+		return runningChaosNodes(ctx, c)
+	})
+	return mgr.Add(DaemonStatus)
+}
+
+// watchDaemons watches chaos-daemon on the nodes of the pods before the chaos is applied on them
+func watchDaemons(ctx context.Context, pods []*v1.Pod) {
+	if DaemonStatus == nil || simulation.IsSimulated(ctx) {
+		return
+	}
+
+	watched := make(map[string]bool)
+	for _, pod := range pods {
+		if node := pod.Spec.NodeName; !watched[node] {
+			watched[node] = true
+			DaemonStatus.Watch(ctx, node)
+		}
+	}
+}
+
+// daemonProgress returns the progress of chaos on the pod received from chaos-daemon, it's nil if there's none
+func daemonProgress(chaos v1alpha1.InnerObject, pod *v1.Pod) *v1alpha1.PodProgress {
+	accessor, err := meta.Accessor(chaos)
+	if DaemonStatus == nil || err != nil {
+		return nil
+	}
+	return DaemonStatus.Progress.Pod(string(accessor.GetUID()), containerIDs(pod))
+}
+
+// handleDaemonEvent writes the progress of the event into the record of the pod whose containers
+// are in the event. The event is only recorded in the progress if the pod isn't recorded yet,
+// it's written when the pod is recorded.
+func handleDaemonEvent(ctx context.Context, c client.Client, recorder record.EventRecorder, event *pb.StatusEvent) {
+	parts := strings.Split(event.Experiment, "/")
+	if len(parts) != 3 || len(event.ContainerIds) == 0 {
+		return
+	}
+	kind, ok := v1alpha1.AllKinds()[parts[0]]
+	if !ok {
+		return
+	}
+	key := types.NamespacedName{Namespace: parts[1], Name: parts[2]}
+
+	var (
+		chaos   runtime.Object
+		updated *v1alpha1.PodStatus
+		// gone is true if the chaos of the event has been deleted and created again
+		gone bool
+	)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		chaos = kind.Chaos.DeepCopyObject()
+		if err := c.Get(ctx, key, chaos); err != nil {
+			return err
+		}
+		if string(chaos.(metav1.Object).GetUID()) != event.ExperimentUid {
+			gone = true
+			return nil
+		}
+
+		records := chaos.(v1alpha1.InnerObject).GetStatus().Experiment.PodRecords
+		for i := range records {
+			var pod v1.Pod
+			if err := c.Get(ctx, types.NamespacedName{Namespace: records[i].Namespace, Name: records[i].Name}, &pod); err != nil {
+				continue
+			}
+			containers := containerIDs(&pod)
+			if !overlaps(containers, event.ContainerIds) {
+				continue
+			}
+
+			records[i].Progress = DaemonStatus.Progress.Pod(event.ExperimentUid, containers)
+			updated = &records[i]
+			return c.Status().Update(ctx, chaos)
+		}
+		return nil
+	})
+	if k8serror.IsNotFound(err) || gone {
+		// the chaos is gone, so is its progress
+		DaemonStatus.Progress.Forget(event.ExperimentUid)
+		return
+	}
+	if err != nil {
+		log.Error(err, "failed to update the progress of chaos", "chaos", key, "kind", parts[0])
+		return
+	}
+
+	if updated != nil && (event.Phase == pb.StatusFailed || event.Phase == pb.StatusExited) {
+		recorder.Event(chaos, v1.EventTypeWarning, eventChaosTargetFailed, fmt.Sprintf("%s on pod %s/%s %s: %s",
+			event.Method, updated.Namespace, updated.Name, strings.ToLower(event.Phase), event.Message))
+	}
+}
+
+// runningChaosNodes returns the nodes of the pods which the running chaos in the shard is applied on
+func runningChaosNodes(ctx context.Context, c client.Client) ([]string, error) {
+	seen := make(map[string]bool)
+	var nodes []string
+	for _, kind := range v1alpha1.AllKinds() {
+		list := kind.ChaosList.DeepCopyObject()
+		if err := c.List(ctx, list); err != nil {
+			return nodes, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nodes, err
+		}
+
+		for _, item := range items {
+			chaos, ok := item.(v1alpha1.InnerObject)
+			if !ok || !InShard(chaos.GetChaos().Namespace) {
+				continue
+			}
+			status := chaos.GetStatus().Experiment
+			if status.Phase != v1alpha1.ExperimentPhaseRunning {
+				continue
+			}
+			for _, r := range status.PodRecords {
+				var pod v1.Pod
+				if err := c.Get(ctx, types.NamespacedName{Namespace: r.Namespace, Name: r.Name}, &pod); err != nil {
+					continue
+				}
+				if node := pod.Spec.NodeName; node != "" && !seen[node] {
+					seen[node] = true
+					nodes = append(nodes, node)
+				}
+			}
+		}
+	}
+	return nodes, nil
+}
+
+func containerIDs(pod *v1.Pod) []string {
+	ids := make([]string, 0, len(pod.Status.ContainerStatuses))
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.ContainerID != "" {
+			ids = append(ids, status.ContainerID)
+		}
+	}
+	return ids
+}
+
+func overlaps(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
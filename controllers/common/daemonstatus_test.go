@@ -0,0 +1,107 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/daemonstatus"
+)
+
+func TestHandleDaemonEvent(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	previous := DaemonStatus
+	DaemonStatus = daemonstatus.NewWatcher(nil, nil, nil)
+	defer func() { DaemonStatus = previous }()
+
+	chaos := &v1alpha1.StressChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "stress", UID: "uid1"},
+	}
+	chaos.Status.Experiment.PodRecords = []v1alpha1.PodStatus{{Namespace: "default", Name: "p1"}}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"},
+		Status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{
+			{Name: "app", ContainerID: "docker://c1"},
+		}},
+	}
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+	c := fake.NewFakeClientWithScheme(scheme, chaos, pod)
+	recorder := record.NewFakeRecorder(10)
+
+	handle := func(event *pb.StatusEvent) *v1alpha1.PodProgress {
+		DaemonStatus.Progress.Record(event)
+		handleDaemonEvent(context.TODO(), c, recorder, event)
+
+		var stored v1alpha1.StressChaos
+		g.Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "stress"}, &stored)).To(Succeed())
+		return stored.Status.Experiment.PodRecords[0].Progress
+	}
+
+	progress := handle(&pb.StatusEvent{ExperimentUid: "uid1", Experiment: "StressChaos/default/stress",
+		Method: "ExecStressors", ContainerIds: []string{"docker://c1"}, Phase: pb.StatusApplied, Pids: []int64{100}})
+	g.Expect(progress.Phase).To(Equal(pb.StatusApplied))
+	g.Expect(progress.Pids).To(Equal([]int64{100}))
+	g.Expect(recorder.Events).To(BeEmpty())
+
+	progress = handle(&pb.StatusEvent{ExperimentUid: "uid1", Experiment: "StressChaos/default/stress",
+		Method: "ExecStressors", ContainerIds: []string{"docker://c1"}, Phase: pb.StatusExited, Pids: []int64{100},
+		Message: "exit status 1"})
+	g.Expect(progress.Phase).To(Equal(pb.StatusExited))
+	g.Expect(progress.Pids).To(BeEmpty())
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("ExecStressors on pod default/p1 exited: exit status 1")))
+
+	// the progress of the chaos which is created again is forgotten
+	handle(&pb.StatusEvent{ExperimentUid: "uid0", Experiment: "StressChaos/default/stress",
+		ContainerIds: []string{"docker://c1"}, Phase: pb.StatusApplied})
+	g.Expect(DaemonStatus.Progress.Pod("uid0", []string{"docker://c1"})).To(BeNil())
+}
+
+func TestApplyPodsWithDaemonProgress(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	previous := DaemonStatus
+	DaemonStatus = daemonstatus.NewWatcher(nil, nil, nil)
+	defer func() { DaemonStatus = previous }()
+
+	chaos := &v1alpha1.TimeChaos{ObjectMeta: metav1.ObjectMeta{UID: "uid1"}}
+	pod := newPod("p1")
+	pod.Status.ContainerStatuses = []v1.ContainerStatus{{Name: "app", ContainerID: "docker://c1"}}
+
+	// the progress pushed while the pod is applied is recorded along with the pod
+	apply := func(ctx context.Context, pod *v1.Pod) error {
+		DaemonStatus.Progress.Record(&pb.StatusEvent{ExperimentUid: "uid1", Method: "SetTimeOffset",
+			ContainerIds: []string{"docker://c1"}, Phase: pb.StatusApplied})
+		return nil
+	}
+	g.Expect(ApplyPods(context.TODO(), chaos, []v1.Pod{pod}, recordPod, apply, nil)).To(Succeed())
+	g.Expect(chaos.Status.Experiment.PodRecords).To(HaveLen(1))
+	g.Expect(chaos.Status.Experiment.PodRecords[0].Progress.Containers).To(Equal([]v1alpha1.ContainerProgress{
+		{ID: "docker://c1", Applied: 1},
+	}))
+}
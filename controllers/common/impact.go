@@ -0,0 +1,39 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/impact"
+)
+
+// RecordImpact estimates the impact of chaos on the selected pods before it's injected, and records
+// it in the status. The estimation is advisory, so its failure is only logged and never stops the chaos.
+func RecordImpact(ctx context.Context, c client.Reader, chaos v1alpha1.InnerObject, pods []v1.Pod, podKill bool) {
+	status := chaos.GetStatus()
+	estimated, err := impact.Estimate(ctx, c, pods, podKill)
+	if err != nil {
+		instance := chaosInstance(chaos)
+		log.Error(err, "failed to estimate the impact of chaos", "kind", instance.Kind,
+			"namespace", instance.Namespace, "name", instance.Name)
+		status.Experiment.Impact = nil
+		return
+	}
+	status.Experiment.Impact = estimated
+}
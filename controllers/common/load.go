@@ -0,0 +1,69 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/loadgen"
+)
+
+// loadGenerators are the generators of the load of the running runs by the keys of their results
+var loadGenerators = struct {
+	sync.Mutex
+	generators map[types.NamespacedName]*loadgen.Generator
+}{
+	generators: make(map[types.NamespacedName]*loadgen.Generator),
+}
+
+// startLoad starts to generate the load of the chaos during the run which is recorded by the result.
+// The load lasts until the run ends or the duration of the load elapses.
+func startLoad(chaos v1alpha1.InnerObject, result *v1alpha1.ChaosResult) {
+	obj, ok := chaos.(v1alpha1.LoadObject)
+	if !ok || obj.GetLoad() == nil || result.IsFinished() {
+		return
+	}
+
+	key := types.NamespacedName{Namespace: result.Namespace, Name: result.Name}
+	loadGenerators.Lock()
+	defer loadGenerators.Unlock()
+	if _, ok := loadGenerators.generators[key]; ok {
+		return
+	}
+	loadGenerators.generators[key] = loadgen.Start(obj.GetLoad().DeepCopy())
+}
+
+// stopLoad stops the load of the run which is recorded by the result of the key, and returns its
+// measurement. It returns nil if the chaos generates no load.
+func stopLoad(chaos v1alpha1.InnerObject, key types.NamespacedName) *v1alpha1.LoadResult {
+	loadGenerators.Lock()
+	generator, ok := loadGenerators.generators[key]
+	delete(loadGenerators.generators, key)
+	loadGenerators.Unlock()
+	if ok {
+		return generator.Stop()
+	}
+
+	obj, isLoad := chaos.(v1alpha1.LoadObject)
+	if !isLoad || obj.GetLoad() == nil {
+		return nil
+	}
+	return &v1alpha1.LoadResult{
+		Target: obj.GetLoad().Target,
+		Error:  "the load generator of the run is lost, e.g. the controller manager restarted during the run",
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/experimentlog"
+)
+
+// ExperimentLogs keeps the latest log lines of the latest experiments, it is set up
+// by SetupExperimentLogs. No log line is kept if it is nil.
+var ExperimentLogs *experimentlog.Store
+
+// SetupExperimentLogs creates ExperimentLogs with the limits in ControllerCfg
+func SetupExperimentLogs() {
+	ExperimentLogs = experimentlog.NewStore(ControllerCfg.ExperimentLogMaxExperiments, ControllerCfg.ExperimentLogLines)
+}
+
+// ExperimentLogger returns a logger which attaches the kind, namespace, name and uid
+// of the chaos to every log line, and keeps the log lines in ExperimentLogs
+func ExperimentLogger(base logr.Logger, chaos v1alpha1.InnerObject) logr.Logger {
+	instance := chaosInstance(chaos)
+	experiment := experimentlog.Experiment{
+		Kind:      instance.Kind,
+		Namespace: instance.Namespace,
+		Name:      instance.Name,
+	}
+	if meta, ok := chaos.(metav1.Object); ok {
+		experiment.UID = string(meta.GetUID())
+	}
+
+	return experimentlog.NewLogger(base, ExperimentLogs, experiment)
+}
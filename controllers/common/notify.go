@@ -0,0 +1,144 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/notify"
+)
+
+// Notifier sends the lifecycle events of chaos to the ChaosNotifications, it is
+// set up by SetupNotifier. No event is sent if it is nil.
+var Notifier *notify.Notifier
+
+// +kubebuilder:rbac:groups=chaos-mesh.org,resources=chaosnotifications,verbs=get;list;watch
+
+// SetupNotifier creates the Notifier and runs it with the manager
+func SetupNotifier(mgr ctrl.Manager) error {
+	// the notifications and the secrets are read at sending, which is rare, so they aren't cached
+	Notifier = notify.NewNotifier(mgr.GetAPIReader())
+	return mgr.Add(Notifier)
+}
+
+// lifecycleEvent returns the event of the phase transition of chaos, it returns
+// an empty event if the transition isn't notified
+func lifecycleEvent(previous, current v1alpha1.ExperimentPhase) v1alpha1.NotificationEvent {
+	if previous == current {
+		return ""
+	}
+
+	switch current {
+	case v1alpha1.ExperimentPhaseRunning:
+		return v1alpha1.NotificationEventStarted
+	case v1alpha1.ExperimentPhaseFailed:
+		return v1alpha1.NotificationEventFailed
+	case v1alpha1.ExperimentPhasePaused:
+		if previous == v1alpha1.ExperimentPhaseRunning {
+			return v1alpha1.NotificationEventAborted
+		}
+	case v1alpha1.ExperimentPhaseWaiting:
+		// the scheduled chaos waits for the next run after it's recovered
+		if previous == v1alpha1.ExperimentPhaseRunning {
+			return v1alpha1.NotificationEventFinished
+		}
+	case v1alpha1.ExperimentPhaseFinished:
+		return v1alpha1.NotificationEventFinished
+	}
+	return ""
+}
+
+// recoveryEvents returns the events of the transitions of the Recovering and ForceRecovered conditions
+func recoveryEvents(previous, current *v1alpha1.ChaosStatus) []v1alpha1.NotificationEvent {
+	isTrue := func(status *v1alpha1.ChaosStatus, conditionType v1alpha1.ChaosConditionType) bool {
+		condition := status.GetCondition(conditionType)
+		return condition != nil && condition.Status == corev1.ConditionTrue
+	}
+
+	var events []v1alpha1.NotificationEvent
+	wasRecovering, recovering := isTrue(previous, v1alpha1.ConditionRecovering), isTrue(current, v1alpha1.ConditionRecovering)
+	if !wasRecovering && recovering {
+		events = append(events, v1alpha1.NotificationEventRecoveryFailed)
+	}
+	if wasRecovering && !recovering {
+		if condition := current.GetCondition(v1alpha1.ConditionRecovering); condition != nil &&
+			condition.Reason == v1alpha1.RecoveringReasonRecovered {
+			events = append(events, v1alpha1.NotificationEventRecovered)
+		}
+	}
+	if !isTrue(previous, v1alpha1.ConditionForceRecovered) && isTrue(current, v1alpha1.ConditionForceRecovered) {
+		events = append(events, v1alpha1.NotificationEventResidualFaults)
+	}
+	return events
+}
+
+// notifyTransition sends the events of the transition of chaos from the previous status, which is written already
+func notifyTransition(chaos v1alpha1.InnerObject, previous *v1alpha1.ChaosStatus) {
+	if Notifier == nil {
+		return
+	}
+
+	status := chaos.GetStatus()
+	events := recoveryEvents(previous, status)
+	if event := lifecycleEvent(previous.Experiment.Phase, status.Experiment.Phase); event != "" {
+		events = append(events, event)
+	}
+
+	instance := chaosInstance(chaos)
+	for _, event := range events {
+		Notifier.Notify(&notify.Event{
+			Event:     event,
+			Kind:      instance.Kind,
+			Namespace: instance.Namespace,
+			Name:      instance.Name,
+			Phase:     status.Experiment.Phase,
+			Reason:    eventReason(status, event),
+			Targets:   len(status.Experiment.PodRecords),
+			Time:      time.Now(),
+		})
+	}
+}
+
+// eventReason returns the reason of the event. The failed event is explained by the failed
+// selection or the first failed pod, and the recovery events are explained by the conditions.
+func eventReason(status *v1alpha1.ChaosStatus, event v1alpha1.NotificationEvent) string {
+	switch event {
+	case v1alpha1.NotificationEventFailed:
+		if status.Experiment.Reason != "" {
+			return status.Experiment.Reason
+		}
+		if condition := status.GetCondition(v1alpha1.ConditionSelected); condition != nil &&
+			condition.Status == corev1.ConditionFalse {
+			return condition.Message
+		}
+		if len(status.Experiment.FailedRecords) > 0 {
+			record := status.Experiment.FailedRecords[0]
+			return fmt.Sprintf("failed on pod %s/%s: %s", record.Namespace, record.Name, record.Error)
+		}
+	case v1alpha1.NotificationEventRecoveryFailed:
+		if condition := status.GetCondition(v1alpha1.ConditionRecovering); condition != nil {
+			return condition.Message
+		}
+	case v1alpha1.NotificationEventResidualFaults:
+		if condition := status.GetCondition(v1alpha1.ConditionForceRecovered); condition != nil {
+			return fmt.Sprintf("the chaos was cleaned up without being recovered: %s", condition.Message)
+		}
+	}
+	return status.Experiment.Reason
+}
@@ -0,0 +1,146 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/notify"
+)
+
+func TestLifecycleEvent(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	for _, tc := range []struct {
+		previous v1alpha1.ExperimentPhase
+		current  v1alpha1.ExperimentPhase
+		expected v1alpha1.NotificationEvent
+	}{
+		{"", v1alpha1.ExperimentPhaseRunning, v1alpha1.NotificationEventStarted},
+		{v1alpha1.ExperimentPhasePaused, v1alpha1.ExperimentPhaseRunning, v1alpha1.NotificationEventStarted},
+		{v1alpha1.ExperimentPhaseRunning, v1alpha1.ExperimentPhaseRunning, ""},
+		{"", v1alpha1.ExperimentPhaseFailed, v1alpha1.NotificationEventFailed},
+		{v1alpha1.ExperimentPhaseFailed, v1alpha1.ExperimentPhaseFailed, ""},
+		{v1alpha1.ExperimentPhaseRunning, v1alpha1.ExperimentPhasePaused, v1alpha1.NotificationEventAborted},
+		{"", v1alpha1.ExperimentPhasePaused, ""},
+		{v1alpha1.ExperimentPhaseRunning, v1alpha1.ExperimentPhaseWaiting, v1alpha1.NotificationEventFinished},
+		{v1alpha1.ExperimentPhasePaused, v1alpha1.ExperimentPhaseWaiting, ""},
+		{v1alpha1.ExperimentPhaseRunning, v1alpha1.ExperimentPhaseFinished, v1alpha1.NotificationEventFinished},
+	} {
+		g.Expect(lifecycleEvent(tc.previous, tc.current)).To(Equal(tc.expected), "%s -> %s", tc.previous, tc.current)
+	}
+}
+
+func TestRecoveryEvents(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	healthy := &v1alpha1.ChaosStatus{}
+	failed := &v1alpha1.ChaosStatus{}
+	SetRecoveringCondition(failed, errors.New("recovery failed"))
+	recovered := failed.DeepCopy()
+	SetRecoveringCondition(recovered, nil)
+	forced := failed.DeepCopy()
+	forced.SetCondition(v1alpha1.ChaosCondition{
+		Type:   v1alpha1.ConditionRecovering,
+		Status: corev1.ConditionFalse,
+		Reason: v1alpha1.RecoveringReasonRecoveryTimeout,
+	})
+	forced.SetCondition(v1alpha1.ChaosCondition{
+		Type:    v1alpha1.ConditionForceRecovered,
+		Status:  corev1.ConditionTrue,
+		Reason:  v1alpha1.RecoveringReasonRecoveryTimeout,
+		Message: "recovery failed",
+	})
+
+	g.Expect(recoveryEvents(healthy, healthy)).To(BeEmpty())
+	g.Expect(recoveryEvents(healthy, failed)).To(Equal([]v1alpha1.NotificationEvent{v1alpha1.NotificationEventRecoveryFailed}))
+	g.Expect(recoveryEvents(failed, failed)).To(BeEmpty())
+	g.Expect(recoveryEvents(failed, recovered)).To(Equal([]v1alpha1.NotificationEvent{v1alpha1.NotificationEventRecovered}))
+	g.Expect(recoveryEvents(recovered, recovered)).To(BeEmpty())
+	// the chaos which is cleaned up after the timeout isn't recovered
+	g.Expect(recoveryEvents(failed, forced)).To(Equal([]v1alpha1.NotificationEvent{v1alpha1.NotificationEventResidualFaults}))
+	g.Expect(recoveryEvents(healthy, forced)).To(Equal([]v1alpha1.NotificationEvent{v1alpha1.NotificationEventResidualFaults}))
+
+	g.Expect(eventReason(failed, v1alpha1.NotificationEventRecoveryFailed)).To(Equal("recovery failed"))
+	g.Expect(eventReason(forced, v1alpha1.NotificationEventResidualFaults)).
+		To(Equal("the chaos was cleaned up without being recovered: recovery failed"))
+}
+
+func TestEventReason(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	status := &v1alpha1.ChaosStatus{}
+	status.Experiment.FailedRecords = []v1alpha1.FailedPodStatus{{Namespace: "default", Name: "p1", Error: "timeout"}}
+	g.Expect(eventReason(status, v1alpha1.NotificationEventStarted)).To(BeEmpty())
+	g.Expect(eventReason(status, v1alpha1.NotificationEventFailed)).To(Equal("failed on pod default/p1: timeout"))
+
+	SetSelectedCondition(status, &reasonError{reason: v1alpha1.SelectedReasonNoPodSelected})
+	g.Expect(eventReason(status, v1alpha1.NotificationEventFailed)).To(Equal("no pod is selected"))
+}
+
+func TestUpdateChaosNotifies(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	events := make(chan map[string]interface{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		payload := make(map[string]interface{})
+		_ = json.Unmarshal(body, &payload)
+		events <- payload
+	}))
+	defer server.Close()
+
+	chaos := &v1alpha1.TimeChaos{
+		TypeMeta:   metav1.TypeMeta{Kind: v1alpha1.KindTimeChaos},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "chaos"},
+		Spec:       v1alpha1.TimeChaosSpec{Mode: v1alpha1.OnePodMode, TimeOffset: "-1h"},
+	}
+	notification := &v1alpha1.ChaosNotification{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "notification"},
+		Spec: v1alpha1.ChaosNotificationSpec{
+			Receivers: []v1alpha1.NotificationReceiver{{Type: v1alpha1.WebhookReceiver, URL: server.URL}},
+		},
+	}
+	c := newGuardedClient(chaos.DeepCopy(), notification)
+
+	Notifier = notify.NewNotifier(c)
+	defer func() { Notifier = nil }()
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		_ = Notifier.Start(stop)
+	}()
+
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+	g.Eventually(events).Should(Receive(HaveKeyWithValue("event", "Started")))
+
+	// the phase which is written already isn't notified again
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhasePaused
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+	g.Eventually(events).Should(Receive(HaveKeyWithValue("event", "Aborted")))
+	g.Consistently(events).ShouldNot(Receive())
+}
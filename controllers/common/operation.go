@@ -0,0 +1,181 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/metrics"
+	"github.com/chaos-mesh/chaos-mesh/pkg/audit"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/simulation"
+	"github.com/chaos-mesh/chaos-mesh/pkg/targetprovider"
+	"github.com/chaos-mesh/chaos-mesh/pkg/tracing"
+)
+
+// AnnotationTraceParentKey records the W3C `traceparent` of the current run of chaos,
+// so the injection and the recovery of a run are in the same trace
+const AnnotationTraceParentKey = `experiment.chaos-mesh.org/traceparent`
+
+// Operation is an injection or a recovery of chaos
+type Operation struct {
+	ctx       context.Context
+	chaos     v1alpha1.InnerObject
+	operation audit.Operation
+	startTime time.Time
+	span      trace.Span
+}
+
+// StartOperation starts an injection or a recovery of chaos, the returned context
+// carries the span of the operation and should be used to perform the operation.
+// Every injection starts a new trace, and the following recovery joins it.
+// The injection is simulated if the chaos or the controller is in simulation mode, and the
+// following recovery is simulated if the injection was.
+func StartOperation(ctx context.Context, chaos v1alpha1.InnerObject, operation audit.Operation) (context.Context, *Operation) {
+	instance := chaosInstance(chaos)
+	accessor, err := meta.Accessor(chaos)
+	if err != nil {
+		log.Error(err, "failed to access the metadata of chaos")
+	}
+
+	status := &chaos.GetStatus().Experiment
+	if operation == audit.OperationInject {
+		status.Simulated = IsSimulated(chaos)
+	}
+	if status.Simulated {
+		ctx = simulation.WithSimulation(ctx)
+	}
+
+	opts := []trace.SpanStartOption{
+		trace.WithAttributes(
+			attribute.String("chaos.kind", instance.Kind),
+			attribute.String("chaos.namespace", instance.Namespace),
+			attribute.String("chaos.name", instance.Name),
+			attribute.Bool("chaos.simulated", status.Simulated),
+		),
+	}
+	// the operation is linked with the reconcile which performs it
+	if reconcile := trace.SpanContextFromContext(ctx); reconcile.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: reconcile}))
+	}
+	if operation == audit.OperationInject {
+		opts = append(opts, trace.WithNewRoot())
+	} else if accessor != nil {
+		ctx = tracing.Extract(ctx, accessor.GetAnnotations()[AnnotationTraceParentKey])
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, fmt.Sprintf("%s %s", operation, instance.Kind), opts...)
+
+	experiment := targetprovider.Experiment{Kind: instance.Kind, Namespace: instance.Namespace, Name: instance.Name}
+	if accessor != nil {
+		experiment.UID = string(accessor.GetUID())
+	}
+	ctx = targetprovider.WithExperiment(ctx, experiment)
+
+	// chaos-daemon records the injections by the UID of chaos, so the retried injections are skipped,
+	// and it reports the progress of chaos with the kind, the namespace and the name
+	if accessor != nil && accessor.GetUID() != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, pb.ExperimentUIDMetadataKey, string(accessor.GetUID()),
+			pb.ExperimentMetadataKey, fmt.Sprintf("%s/%s/%s", instance.Kind, instance.Namespace, instance.Name))
+	}
+
+	if operation == audit.OperationInject && accessor != nil {
+		if traceparent := tracing.Inject(ctx); traceparent != "" {
+			annotations := accessor.GetAnnotations()
+			if annotations == nil {
+				annotations = make(map[string]string)
+			}
+			annotations[AnnotationTraceParentKey] = traceparent
+			accessor.SetAnnotations(annotations)
+		}
+	}
+
+	return ctx, &Operation{
+		ctx:       ctx,
+		chaos:     chaos,
+		operation: operation,
+		startTime: time.Now(),
+		span:      span,
+	}
+}
+
+// IsSimulated returns true if the chaos runs without being injected, either by its spec or by
+// the simulation mode of the controller
+func IsSimulated(chaos v1alpha1.InnerObject) bool {
+	if ControllerCfg != nil && ControllerCfg.Simulate {
+		return true
+	}
+	obj, ok := chaos.(v1alpha1.SimulationObject)
+	return ok && obj.IsSimulated()
+}
+
+// chaosInstance returns the instance of chaos. The chaos which doesn't describe itself, e.g. the fake
+// chaos of tests, is described by its type and object meta instead, so the instance is never nil.
+func chaosInstance(chaos v1alpha1.InnerObject) *v1alpha1.ChaosInstance {
+	if instance := chaos.GetChaos(); instance != nil {
+		return instance
+	}
+
+	instance := &v1alpha1.ChaosInstance{}
+	if kind := chaos.GetObjectKind(); kind != nil {
+		instance.Kind = kind.GroupVersionKind().Kind
+	}
+	if accessor, err := meta.Accessor(chaos); err == nil {
+		instance.Namespace = accessor.GetNamespace()
+		instance.Name = accessor.GetName()
+	}
+	return instance
+}
+
+// StartReconcileSpan starts the span of a reconcile of chaos
+func StartReconcileSpan(ctx context.Context, req ctrl.Request) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, "Reconcile", trace.WithAttributes(
+		attribute.String("chaos.namespace", req.Namespace),
+		attribute.String("chaos.name", req.Name),
+	))
+}
+
+// Finish records the metrics, the audit record and the span of the operation which results in err
+func (o *Operation) Finish(err error) {
+	kind := chaosInstance(o.chaos).Kind
+
+	outcome := audit.OutcomeSuccess
+	if err != nil {
+		outcome = audit.OutcomeFailure
+		o.span.RecordError(err)
+		o.span.SetStatus(codes.Error, err.Error())
+	}
+	metrics.OperationDuration.WithLabelValues(kind, string(o.operation), string(outcome)).
+		Observe(time.Since(o.startTime).Seconds())
+
+	if o.operation == audit.OperationInject && err == nil {
+		selected := len(o.chaos.GetStatus().Experiment.PodRecords)
+		metrics.SelectedPods.WithLabelValues(kind).Observe(float64(selected))
+		o.span.SetAttributes(attribute.Int("chaos.selected_pods", selected))
+	}
+
+	AuditOperation(o.ctx, o.chaos, o.operation, o.startTime, err)
+
+	o.span.End()
+}
@@ -0,0 +1,93 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/audit"
+	"github.com/chaos-mesh/chaos-mesh/pkg/simulation"
+)
+
+func TestSimulatedOperation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &v1alpha1.TimeChaos{}
+	chaos.Spec.Simulate = true
+	pods := []v1.Pod{newPod("p1"), newPod("p2")}
+
+	ctx, op := StartOperation(context.TODO(), chaos, audit.OperationInject)
+	g.Expect(simulation.IsSimulated(ctx)).To(BeTrue())
+	g.Expect(chaos.Status.Experiment.Simulated).To(BeTrue())
+
+	// the pods are recorded, but the chaos isn't applied on them
+	applied := false
+	err := ApplyPods(ctx, chaos, pods, recordPod, func(ctx context.Context, pod *v1.Pod) error {
+		applied = true
+		return nil
+	}, nil)
+	op.Finish(err)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(applied).To(BeFalse())
+	g.Expect(chaos.Status.Experiment.PodRecords).To(ConsistOf(recordPod(&pods[0]), recordPod(&pods[1])))
+
+	// the recovery follows the injection even if the spec is changed
+	chaos.Spec.Simulate = false
+	ctx, op = StartOperation(context.TODO(), chaos, audit.OperationRecover)
+	op.Finish(nil)
+	g.Expect(simulation.IsSimulated(ctx)).To(BeTrue())
+
+	ctx, op = StartOperation(context.TODO(), chaos, audit.OperationInject)
+	op.Finish(nil)
+	g.Expect(simulation.IsSimulated(ctx)).To(BeFalse())
+	g.Expect(chaos.Status.Experiment.Simulated).To(BeFalse())
+
+	// all the chaos are simulated in the simulation mode of the controller
+	ControllerCfg.Simulate = true
+	defer func() { ControllerCfg.Simulate = false }()
+	ctx, op = StartOperation(context.TODO(), chaos, audit.OperationInject)
+	op.Finish(nil)
+	g.Expect(simulation.IsSimulated(ctx)).To(BeTrue())
+}
+
+// undescribedChaos is a chaos which doesn't describe itself by GetChaos
+type undescribedChaos struct {
+	v1alpha1.TimeChaos
+}
+
+func (in *undescribedChaos) GetChaos() *v1alpha1.ChaosInstance {
+	return nil
+}
+
+func TestOperationOfUndescribedChaos(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &undescribedChaos{}
+	chaos.Kind = v1alpha1.KindTimeChaos
+	chaos.Namespace, chaos.Name = "default", "skew"
+
+	instance := chaosInstance(chaos)
+	g.Expect(instance.Kind).To(Equal(v1alpha1.KindTimeChaos))
+	g.Expect(instance.Namespace).To(Equal("default"))
+	g.Expect(instance.Name).To(Equal("skew"))
+
+	_, op := StartOperation(context.TODO(), chaos, audit.OperationRecover)
+	op.Finish(nil)
+	g.Expect(experimentAnnotationValue(chaosInstance(chaos))).To(Equal("default/skew"))
+}
@@ -0,0 +1,396 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"golang.org/x/time/rate"
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/simulation"
+)
+
+// PodFunc applies or recovers the chaos on a pod, it's called concurrently
+type PodFunc func(ctx context.Context, pod *v1.Pod) error
+
+// nodeLimiters limit the operations on the pods of each node, they're shared by all the chaos
+var nodeLimiters = struct {
+	sync.Mutex
+	limiters map[string]*rate.Limiter
+}{
+	limiters: make(map[string]*rate.Limiter),
+}
+
+func nodeLimiter(node string) *rate.Limiter {
+	nodeLimiters.Lock()
+	defer nodeLimiters.Unlock()
+
+	limiter, ok := nodeLimiters.limiters[node]
+	if !ok {
+		limit, burst := rate.Inf, 1
+		if ControllerCfg.NodeRateLimit > 0 {
+			limit = rate.Limit(ControllerCfg.NodeRateLimit)
+			burst = int(math.Ceil(ControllerCfg.NodeRateLimit))
+		}
+		limiter = rate.NewLimiter(limit, burst)
+		nodeLimiters.limiters[node] = limiter
+	}
+	return limiter
+}
+
+// RunOnPods calls fn on every pod by a pool of ControllerCfg.PodWorkers workers, and the calls
+// on the pods of the same node are limited to ControllerCfg.NodeRateLimit per second.
+// The error of each pod is returned by the index of pods.
+// fn isn't called if the operation is simulated, and all the pods succeed.
+func RunOnPods(ctx context.Context, pods []*v1.Pod, fn PodFunc) []error {
+	errs := make([]error, len(pods))
+	if simulation.IsSimulated(ctx) {
+		for _, pod := range pods {
+			log.Info("Skip the simulated operation on pod", "namespace", pod.Namespace, "name", pod.Name)
+		}
+		return errs
+	}
+
+	workers := ControllerCfg.PodWorkers
+	if workers <= 0 || workers > len(pods) {
+		workers = len(pods)
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := nodeLimiter(pods[i].Spec.NodeName).Wait(ctx); err != nil {
+					errs[i] = err
+					continue
+				}
+				errs[i] = fn(ctx, pods[i])
+			}
+		}()
+	}
+	for i := range pods {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return errs
+}
+
+// staggerInterval returns the interval between the operations on the pods of chaos,
+// it's 0 if the chaos isn't staggered
+func staggerInterval(chaos v1alpha1.InnerObject) time.Duration {
+	obj, ok := chaos.(v1alpha1.StaggerObject)
+	if !ok {
+		return 0
+	}
+	interval, err := obj.GetStaggerInterval()
+	if err != nil || interval == nil {
+		return 0
+	}
+	return *interval
+}
+
+// PodRecorder builds the record of the pod in the status of chaos after the chaos is applied on it
+type PodRecorder func(pod *v1.Pod) v1alpha1.PodStatus
+
+// ApplyPods applies the chaos on pods by apply with RunOnPods. The pods which the chaos is applied on
+// are recorded in PodRecords of the status, and the others are recorded in FailedRecords.
+// If the last attempt failed, the pods in PodRecords have been injected, so they are kept and
+// only the rest pods are applied, instead of starting from scratch.
+// Part of the pods may be left untouched as the control group by the control group percent of chaos,
+// they're recorded in ControlGroup of the status, and kept when the failed attempt is retried.
+// The failure on some of the pods is handled by the failure policy of chaos: the chaos is rolled
+// back from the applied pods by rollback with AllOrNothingFailure, and it's treated as applied
+// with BestEffortFailure if any pod is applied. The rollback may be nil if the chaos can't be
+// rolled back.
+// If the chaos has a stagger interval, it's applied on one of the pods each time, and the number of the
+// rest pods and the time to apply the next one are recorded in Stagger of the status. The next pod is
+// applied by calling ApplyPods again after the interval, which keeps the pods applied before.
+func ApplyPods(ctx context.Context, chaos v1alpha1.InnerObject, pods []v1.Pod, record PodRecorder, apply, rollback PodFunc) error {
+	status := &chaos.GetStatus().Experiment
+
+	applied := make(map[string]bool)
+	var (
+		records []v1alpha1.PodStatus
+		control []v1alpha1.ControlPodStatus
+		failed  []v1alpha1.FailedPodStatus
+	)
+	if status.Phase == v1alpha1.ExperimentPhaseFailed || status.Stagger != nil {
+		for _, r := range status.PodRecords {
+			applied[fmt.Sprintf("%s/%s", r.Namespace, r.Name)] = true
+			records = append(records, r)
+		}
+		// the control group of the failed attempt or the previous steps is kept untouched
+		control = status.ControlGroup
+	} else {
+		control = controlGroup(chaos, pods)
+	}
+	if status.Stagger != nil {
+		// the pods failed in the previous steps aren't applied again
+		failed = status.FailedRecords
+		for _, f := range failed {
+			applied[fmt.Sprintf("%s/%s", f.Namespace, f.Name)] = true
+		}
+	}
+	for _, c := range control {
+		applied[fmt.Sprintf("%s/%s", c.Namespace, c.Name)] = true
+	}
+
+	// the pods may be selected randomly, the number of injected pods is kept as selected
+	var targets []*v1.Pod
+	for i := range pods {
+		if len(records)+len(failed)+len(targets)+len(control) >= len(pods) {
+			break
+		}
+		if applied[fmt.Sprintf("%s/%s", pods[i].Namespace, pods[i].Name)] {
+			continue
+		}
+		targets = append(targets, &pods[i])
+	}
+
+	status.Stagger = nil
+	if interval := staggerInterval(chaos); interval > 0 && len(targets) > 1 {
+		status.Stagger = &v1alpha1.StaggerStatus{
+			Pending:  len(targets) - 1,
+			NextTime: metav1.NewTime(time.Now().Add(interval)),
+		}
+		targets = targets[:1]
+		log.Info("Apply the chaos on the staggered pod", "chaos", chaos.GetChaos(),
+			"namespace", targets[0].Namespace, "name", targets[0].Name, "pending", status.Stagger.Pending)
+	}
+
+	var result error
+	// the progress of the pods is pushed by chaos-daemon while they're applied
+	watchDaemons(ctx, targets)
+
+	for i, err := range RunOnPods(ctx, targets, apply) {
+		pod := targets[i]
+		if err != nil {
+			failed = append(failed, v1alpha1.FailedPodStatus{
+				Namespace: pod.Namespace,
+				Name:      pod.Name,
+				Error:     err.Error(),
+			})
+			result = multierror.Append(result, fmt.Errorf("%s/%s: %v", pod.Namespace, pod.Name, err))
+			continue
+		}
+		r := record(pod)
+		r.Progress = daemonProgress(chaos, pod)
+		records = append(records, r)
+	}
+
+	status.PodRecords = records
+	status.FailedRecords = failed
+	status.ControlGroup = control
+	if result == nil {
+		return nil
+	}
+
+	var policy v1alpha1.FailurePolicy
+	if obj, ok := chaos.(v1alpha1.FailurePolicyObject); ok {
+		policy = obj.GetFailurePolicy()
+	}
+	switch policy {
+	case v1alpha1.BestEffortFailure:
+		if len(records) > 0 {
+			log.Info("Chaos is applied on part of the pods", "chaos", chaos.GetChaos(),
+				"applied", len(records), "failed", len(failed), "error", result.Error())
+			return nil
+		}
+	case v1alpha1.AllOrNothingFailure:
+		if rollback != nil {
+			if err := rollbackPods(ctx, chaos, pods, rollback); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+	// the failed attempt is retried from the pods applied before
+	status.Stagger = nil
+	return result
+}
+
+// controlGroup picks the pods left untouched randomly by the control group percent of chaos,
+// at least one of the pods is treated.
+func controlGroup(chaos v1alpha1.InnerObject, pods []v1.Pod) []v1alpha1.ControlPodStatus {
+	obj, ok := chaos.(v1alpha1.ControlGroupObject)
+	if !ok {
+		return nil
+	}
+	n := len(pods) * obj.GetControlGroupPercent() / 100
+	if n >= len(pods) {
+		n = len(pods) - 1
+	}
+	if n <= 0 {
+		return nil
+	}
+
+	control := make([]v1alpha1.ControlPodStatus, 0, n)
+	for _, i := range rand.Perm(len(pods))[:n] {
+		control = append(control, v1alpha1.ControlPodStatus{
+			Namespace: pods[i].Namespace,
+			Name:      pods[i].Name,
+			HostIP:    pods[i].Status.HostIP,
+			PodIP:     pods[i].Status.PodIP,
+		})
+	}
+	return control
+}
+
+// rollbackPods recovers the chaos from the pods in PodRecords of the status by rollback with RunOnPods.
+// The pods which are rolled back are removed from PodRecords and the finalizers of chaos.
+func rollbackPods(ctx context.Context, chaos v1alpha1.InnerObject, pods []v1.Pod, rollback PodFunc) error {
+	status := &chaos.GetStatus().Experiment
+
+	selected := make(map[string]*v1.Pod, len(pods))
+	for i := range pods {
+		selected[fmt.Sprintf("%s/%s", pods[i].Namespace, pods[i].Name)] = &pods[i]
+	}
+
+	var (
+		targets []*v1.Pod
+		keys    []string
+	)
+	for _, r := range status.PodRecords {
+		key := fmt.Sprintf("%s/%s", r.Namespace, r.Name)
+		// the pods which aren't selected in this attempt are recovered with the chaos later
+		if pod, ok := selected[key]; ok {
+			targets = append(targets, pod)
+			keys = append(keys, key)
+		}
+	}
+
+	var result error
+	rolledBack := make(map[string]bool, len(targets))
+	for i, err := range RunOnPods(ctx, targets, rollback) {
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("rollback %s: %v", keys[i], err))
+			continue
+		}
+		rolledBack[keys[i]] = true
+	}
+
+	var kept []v1alpha1.PodStatus
+	for _, r := range status.PodRecords {
+		if !rolledBack[fmt.Sprintf("%s/%s", r.Namespace, r.Name)] {
+			kept = append(kept, r)
+		}
+	}
+	status.PodRecords = kept
+
+	if meta, ok := chaos.(metav1.Object); ok {
+		finalizers := make([]string, 0, len(meta.GetFinalizers()))
+		for _, key := range meta.GetFinalizers() {
+			if !rolledBack[key] {
+				finalizers = append(finalizers, key)
+			}
+		}
+		meta.SetFinalizers(finalizers)
+	}
+	return result
+}
+
+// RecoverPods recovers the chaos from the pods recorded in the finalizers of chaos by recover
+// with RunOnPods. The finalizer of a pod is removed once the pod is recovered or not found.
+// The pods which are gone along with their namespaces or workloads are skipped and marked in the records.
+// All the finalizers are removed if the chaos is annotated to clean finalizers forcibly.
+func RecoverPods(ctx context.Context, c client.Client, chaos v1alpha1.InnerObject, recover PodFunc) error {
+	meta, ok := chaos.(metav1.Object)
+	if !ok {
+		return fmt.Errorf("chaos %s has no object meta", chaos.GetChaos().Name)
+	}
+
+	var (
+		result error
+		keys   []string
+		pods   []*v1.Pod
+	)
+	finalizers := meta.GetFinalizers()
+	remaining := make(map[string]bool, len(finalizers))
+	for _, key := range finalizers {
+		remaining[key] = true
+
+		ns, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		var pod v1.Pod
+		err = c.Get(ctx, types.NamespacedName{
+			Namespace: ns,
+			Name:      name,
+		}, &pod)
+		if err != nil {
+			if !k8serror.IsNotFound(err) {
+				result = multierror.Append(result, err)
+				continue
+			}
+
+			log.Info("Pod not found", "namespace", ns, "name", name)
+			remaining[key] = false
+			continue
+		}
+		if reason := targetGoneReason(chaos.GetStatus(), &pod); reason != "" {
+			// the terminating pods are going away with the chaos in them, so they aren't fought
+			log.Info("Skip recovering the pod which is gone", "namespace", ns, "name", name, "reason", reason)
+			MarkTargetGone(chaos.GetStatus(), key, reason)
+			remaining[key] = false
+			continue
+		}
+
+		keys = append(keys, key)
+		pods = append(pods, &pod)
+	}
+
+	for i, err := range RunOnPods(ctx, pods, recover) {
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("%s: %v", keys[i], err))
+			continue
+		}
+		remaining[keys[i]] = false
+	}
+
+	kept := make([]string, 0, len(finalizers))
+	for _, key := range finalizers {
+		if remaining[key] {
+			kept = append(kept, key)
+		}
+	}
+	meta.SetFinalizers(kept)
+
+	if meta.GetAnnotations()[AnnotationCleanFinalizer] == AnnotationCleanFinalizerForced {
+		log.Info("Force cleanup all finalizers", "chaos", chaos.GetChaos())
+		meta.SetFinalizers(kept[:0])
+		return nil
+	}
+
+	return result
+}
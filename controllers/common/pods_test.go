@@ -0,0 +1,243 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func newPod(name string) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       v1.PodSpec{NodeName: "node"},
+	}
+}
+
+func recordPod(pod *v1.Pod) v1alpha1.PodStatus {
+	return v1alpha1.PodStatus{Namespace: pod.Namespace, Name: pod.Name}
+}
+
+func TestApplyPods(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &v1alpha1.TimeChaos{}
+	pods := []v1.Pod{newPod("p1"), newPod("p2"), newPod("p3")}
+
+	var lock sync.Mutex
+	var applied []string
+	apply := func(fail string) PodFunc {
+		return func(ctx context.Context, pod *v1.Pod) error {
+			lock.Lock()
+			defer lock.Unlock()
+			applied = append(applied, pod.Name)
+			if pod.Name == fail {
+				return errors.New("injection failed")
+			}
+			return nil
+		}
+	}
+
+	err := ApplyPods(context.TODO(), chaos, pods, recordPod, apply("p2"), nil)
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(applied).To(ConsistOf("p1", "p2", "p3"))
+	g.Expect(chaos.Status.Experiment.PodRecords).To(ConsistOf(recordPod(&pods[0]), recordPod(&pods[2])))
+	g.Expect(chaos.Status.Experiment.FailedRecords).To(Equal([]v1alpha1.FailedPodStatus{
+		{Namespace: "default", Name: "p2", Error: "injection failed"},
+	}))
+
+	// only the failed pod is applied when the failed attempt is retried
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFailed
+	applied = nil
+	err = ApplyPods(context.TODO(), chaos, pods, recordPod, apply(""), nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(applied).To(Equal([]string{"p2"}))
+	g.Expect(chaos.Status.Experiment.PodRecords).To(HaveLen(3))
+	g.Expect(chaos.Status.Experiment.FailedRecords).To(BeEmpty())
+
+	// all the pods are applied in a new attempt
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseWaiting
+	applied = nil
+	err = ApplyPods(context.TODO(), chaos, pods, recordPod, apply(""), nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(applied).To(ConsistOf("p1", "p2", "p3"))
+}
+
+func TestApplyPodsWithFailurePolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	pods := []v1.Pod{newPod("p1"), newPod("p2")}
+	apply := func(ctx context.Context, pod *v1.Pod) error {
+		if pod.Name == "p2" {
+			return errors.New("injection failed")
+		}
+		return nil
+	}
+
+	// the chaos is treated as applied if any pod is applied
+	chaos := &v1alpha1.TimeChaos{}
+	chaos.Spec.FailurePolicy = v1alpha1.BestEffortFailure
+	err := ApplyPods(context.TODO(), chaos, pods, recordPod, apply, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(chaos.Status.Experiment.PodRecords).To(ConsistOf(recordPod(&pods[0])))
+	g.Expect(chaos.Status.Experiment.FailedRecords).To(HaveLen(1))
+
+	// the applied pods are rolled back
+	var lock sync.Mutex
+	var rolledBack []string
+	chaos = &v1alpha1.TimeChaos{}
+	chaos.Finalizers = []string{"default/p1", "default/p2"}
+	chaos.Spec.FailurePolicy = v1alpha1.AllOrNothingFailure
+	err = ApplyPods(context.TODO(), chaos, pods, recordPod, apply, func(ctx context.Context, pod *v1.Pod) error {
+		lock.Lock()
+		defer lock.Unlock()
+		rolledBack = append(rolledBack, pod.Name)
+		return nil
+	})
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(rolledBack).To(Equal([]string{"p1"}))
+	g.Expect(chaos.Status.Experiment.PodRecords).To(BeEmpty())
+	g.Expect(chaos.Status.Experiment.FailedRecords).To(HaveLen(1))
+	g.Expect(chaos.Finalizers).To(Equal([]string{"default/p2"}))
+}
+
+func TestApplyPodsWithControlGroup(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	pods := []v1.Pod{newPod("p1"), newPod("p2"), newPod("p3"), newPod("p4")}
+	var lock sync.Mutex
+	var applied []string
+	apply := func(ctx context.Context, pod *v1.Pod) error {
+		lock.Lock()
+		defer lock.Unlock()
+		applied = append(applied, pod.Name)
+		return nil
+	}
+
+	chaos := &v1alpha1.TimeChaos{}
+	chaos.Spec.ControlGroupPercent = 50
+	err := ApplyPods(context.TODO(), chaos, pods, recordPod, apply, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(applied).To(HaveLen(2))
+	g.Expect(chaos.Status.Experiment.PodRecords).To(HaveLen(2))
+
+	control := chaos.Status.Experiment.ControlGroup
+	g.Expect(control).To(HaveLen(2))
+	for _, c := range control {
+		g.Expect(applied).ToNot(ContainElement(c.Name))
+	}
+
+	// the control group is kept untouched when the failed attempt is retried
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFailed
+	chaos.Status.Experiment.PodRecords = chaos.Status.Experiment.PodRecords[:1]
+	applied = nil
+	err = ApplyPods(context.TODO(), chaos, pods, recordPod, apply, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(applied).To(HaveLen(1))
+	g.Expect(chaos.Status.Experiment.PodRecords).To(HaveLen(2))
+	g.Expect(chaos.Status.Experiment.ControlGroup).To(Equal(control))
+
+	// at least one pod is treated
+	chaos = &v1alpha1.TimeChaos{}
+	chaos.Spec.ControlGroupPercent = 99
+	err = ApplyPods(context.TODO(), chaos, pods, recordPod, apply, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(chaos.Status.Experiment.PodRecords).To(HaveLen(1))
+	g.Expect(chaos.Status.Experiment.ControlGroup).To(HaveLen(3))
+}
+
+func TestApplyPodsWithStaggerInterval(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	interval := "30s"
+	chaos := &v1alpha1.TimeChaos{}
+	chaos.Spec.StaggerInterval = &interval
+	pods := []v1.Pod{newPod("p1"), newPod("p2"), newPod("p3")}
+
+	var applied []string
+	apply := func(ctx context.Context, pod *v1.Pod) error {
+		applied = append(applied, pod.Name)
+		return nil
+	}
+
+	// the chaos is applied on one pod each time without waiting for the interval
+	start := time.Now()
+	g.Expect(ApplyPods(context.TODO(), chaos, pods, recordPod, apply, nil)).To(Succeed())
+	g.Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+	g.Expect(applied).To(Equal([]string{"p1"}))
+	stagger := chaos.Status.Experiment.Stagger
+	g.Expect(stagger).ToNot(BeNil())
+	g.Expect(stagger.Pending).To(Equal(2))
+	g.Expect(stagger.NextTime.Sub(start)).To(BeNumerically(">=", 30*time.Second))
+
+	// the pods applied in the previous steps are kept, even if the pods are selected in another order
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	reselected := []v1.Pod{pods[2], pods[0], pods[1]}
+	g.Expect(ApplyPods(context.TODO(), chaos, reselected, recordPod, apply, nil)).To(Succeed())
+	g.Expect(applied).To(Equal([]string{"p1", "p3"}))
+	g.Expect(chaos.Status.Experiment.Stagger.Pending).To(Equal(1))
+
+	g.Expect(ApplyPods(context.TODO(), chaos, pods, recordPod, apply, nil)).To(Succeed())
+	g.Expect(applied).To(Equal([]string{"p1", "p3", "p2"}))
+	g.Expect(chaos.Status.Experiment.Stagger).To(BeNil())
+	g.Expect(chaos.Status.Experiment.PodRecords).To(HaveLen(3))
+
+	// the failed step is retried from the pods applied before
+	chaos.Status = v1alpha1.TimeChaosStatus{}
+	g.Expect(ApplyPods(context.TODO(), chaos, pods, recordPod, apply, nil)).To(Succeed())
+	err := ApplyPods(context.TODO(), chaos, pods, recordPod, func(ctx context.Context, pod *v1.Pod) error {
+		return errors.New("injection failed")
+	}, nil)
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(chaos.Status.Experiment.Stagger).To(BeNil())
+	g.Expect(chaos.Status.Experiment.PodRecords).To(HaveLen(1))
+	g.Expect(chaos.Status.Experiment.FailedRecords).To(HaveLen(1))
+}
+
+func TestRecoverPods(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	p1, p2 := newPod("p1"), newPod("p2")
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, &p1, &p2)
+
+	chaos := &v1alpha1.TimeChaos{}
+	chaos.Finalizers = []string{"default/p1", "default/p2", "default/gone"}
+
+	err := RecoverPods(context.TODO(), c, chaos, func(ctx context.Context, pod *v1.Pod) error {
+		if pod.Name == "p2" {
+			return errors.New("recovery failed")
+		}
+		return nil
+	})
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(chaos.Finalizers).To(Equal([]string{"default/p2"}))
+
+	chaos.Annotations = map[string]string{AnnotationCleanFinalizer: AnnotationCleanFinalizerForced}
+	err = RecoverPods(context.TODO(), c, chaos, func(ctx context.Context, pod *v1.Pod) error {
+		return errors.New("recovery failed")
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(chaos.Finalizers).To(BeEmpty())
+}
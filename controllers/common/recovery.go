@@ -0,0 +1,124 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/reconciler"
+	"github.com/chaos-mesh/chaos-mesh/pkg/audit"
+)
+
+// RecoverDeleted recovers the deleted chaos with r. The Recovering condition of the chaos is set
+// while the recovery keeps failing, and once the recovery hasn't completed within the recovery timeout
+// of the chaos, all its finalizers are cleaned up forcibly with the ForceRecovered condition set,
+// so the chaos won't block its deletion forever.
+func RecoverDeleted(ctx context.Context, r reconciler.InnerReconciler, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	status := chaos.GetStatus()
+
+	timeout, err := chaos.GetRecoveryTimeout()
+	if err != nil {
+		log.Error(err, "failed to get recovery timeout, recover without timeout")
+		timeout = nil
+	}
+
+	var deadline time.Time
+	recoverCtx := ctx
+	if timeout != nil {
+		deadline = time.Now().Add(*timeout)
+		if recovering := status.GetCondition(v1alpha1.ConditionRecovering); recovering != nil &&
+			recovering.Status == corev1.ConditionTrue {
+			deadline = recovering.LastTransitionTime.Add(*timeout)
+		}
+
+		var cancel context.CancelFunc
+		recoverCtx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
+
+	opCtx, op := StartOperation(recoverCtx, chaos, audit.OperationRecover)
+	err = r.Recover(opCtx, req, chaos)
+	op.Finish(err)
+	SetRecoveringCondition(status, err)
+	if err == nil {
+		return nil
+	}
+	if timeout == nil || time.Now().Before(deadline) {
+		return err
+	}
+
+	accessor, accessErr := meta.Accessor(chaos)
+	if accessErr != nil {
+		log.Error(accessErr, "failed to access the metadata of chaos")
+		return err
+	}
+
+	log.Info("Recovery timed out, force cleanup all finalizers", "chaos", chaos.GetChaos(), "timeout", timeout.String())
+	accessor.SetFinalizers(nil)
+	status.SetCondition(v1alpha1.ChaosCondition{
+		Type:   v1alpha1.ConditionRecovering,
+		Status: corev1.ConditionFalse,
+		Reason: v1alpha1.RecoveringReasonRecoveryTimeout,
+	})
+	status.SetCondition(v1alpha1.ChaosCondition{
+		Type:    v1alpha1.ConditionForceRecovered,
+		Status:  corev1.ConditionTrue,
+		Reason:  v1alpha1.RecoveringReasonRecoveryTimeout,
+		Message: err.Error(),
+	})
+	return nil
+}
+
+// SetRecoveringCondition sets the Recovering condition by the result of the last recovery. The condition
+// is true while the recovery keeps failing, and it's set false once the failed recovery succeeds.
+func SetRecoveringCondition(status *v1alpha1.ChaosStatus, err error) {
+	if err == nil {
+		if status.GetCondition(v1alpha1.ConditionRecovering) != nil {
+			status.SetCondition(v1alpha1.ChaosCondition{
+				Type:   v1alpha1.ConditionRecovering,
+				Status: corev1.ConditionFalse,
+				Reason: v1alpha1.RecoveringReasonRecovered,
+			})
+		}
+		return
+	}
+
+	status.SetCondition(v1alpha1.ChaosCondition{
+		Type:    v1alpha1.ConditionRecovering,
+		Status:  corev1.ConditionTrue,
+		Reason:  v1alpha1.RecoveringReasonRecoverFailed,
+		Message: err.Error(),
+	})
+}
+
+// RecordRecoveryFailure writes the failed recovery of chaos into its Recovering condition,
+// so the faults left in the targets are visible and notified while the recovery is retried
+func RecordRecoveryFailure(ctx context.Context, c client.Client, chaos v1alpha1.InnerObject, err error) {
+	SetRecoveringCondition(chaos.GetStatus(), err)
+
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		return UpdateChaos(ctx, c, chaos)
+	})
+	if updateError != nil {
+		log.Error(updateError, "unable to update chaos status")
+	}
+}
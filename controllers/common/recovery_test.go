@@ -0,0 +1,102 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+type failedRecoverer struct {
+	recovered int
+}
+
+func (r *failedRecoverer) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	return nil
+}
+
+func (r *failedRecoverer) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	r.recovered++
+	return errors.New("recovery failed")
+}
+
+func (r *failedRecoverer) Object() v1alpha1.InnerObject {
+	return &v1alpha1.TimeChaos{}
+}
+
+func TestRecoverDeleted(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	r := &failedRecoverer{}
+	chaos := &v1alpha1.TimeChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "chaos", Finalizers: []string{"default/p1"}},
+	}
+
+	// recovery without timeout keeps failing
+	err := RecoverDeleted(context.TODO(), r, ctrl.Request{}, chaos)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(chaos.Finalizers).To(HaveLen(1))
+	recovering := chaos.Status.GetCondition(v1alpha1.ConditionRecovering)
+	g.Expect(recovering).ToNot(BeNil())
+	g.Expect(recovering.Status).To(Equal(v1.ConditionTrue))
+
+	// the recovery hasn't timed out yet
+	timeout := "1h"
+	chaos.Spec.RecoveryTimeout = &timeout
+	err = RecoverDeleted(context.TODO(), r, ctrl.Request{}, chaos)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(chaos.Finalizers).To(HaveLen(1))
+	g.Expect(chaos.Status.GetCondition(v1alpha1.ConditionForceRecovered)).To(BeNil())
+
+	// the recovery has been failing for longer than the timeout
+	recovering.LastTransitionTime = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	err = RecoverDeleted(context.TODO(), r, ctrl.Request{}, chaos)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(chaos.Finalizers).To(BeEmpty())
+	g.Expect(chaos.Status.GetCondition(v1alpha1.ConditionRecovering).Status).To(Equal(v1.ConditionFalse))
+	forced := chaos.Status.GetCondition(v1alpha1.ConditionForceRecovered)
+	g.Expect(forced).ToNot(BeNil())
+	g.Expect(forced.Status).To(Equal(v1.ConditionTrue))
+	g.Expect(forced.Message).To(ContainSubstring("recovery failed"))
+	g.Expect(r.recovered).To(Equal(3))
+}
+
+func TestSetRecoveringCondition(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// the successful recovery doesn't add the condition
+	status := &v1alpha1.ChaosStatus{}
+	SetRecoveringCondition(status, nil)
+	g.Expect(status.GetCondition(v1alpha1.ConditionRecovering)).To(BeNil())
+
+	SetRecoveringCondition(status, errors.New("recovery failed"))
+	recovering := status.GetCondition(v1alpha1.ConditionRecovering)
+	g.Expect(recovering.Status).To(Equal(v1.ConditionTrue))
+	g.Expect(recovering.Reason).To(Equal(v1alpha1.RecoveringReasonRecoverFailed))
+	g.Expect(recovering.Message).To(Equal("recovery failed"))
+
+	SetRecoveringCondition(status, nil)
+	recovering = status.GetCondition(v1alpha1.ConditionRecovering)
+	g.Expect(recovering.Status).To(Equal(v1.ConditionFalse))
+	g.Expect(recovering.Reason).To(Equal(v1alpha1.RecoveringReasonRecovered))
+}
@@ -0,0 +1,257 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/slo"
+)
+
+// +kubebuilder:rbac:groups=chaos-mesh.org,resources=chaosresults,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=chaos-mesh.org,resources=chaosresults/status,verbs=get;update;patch
+
+// sloTimeout is the time limit for evaluating the SLO of a run
+const sloTimeout = 10 * time.Second
+
+var (
+	// resultClient writes the ChaosResults, it is set up by SetupResultRecorder.
+	// No result is recorded if it is nil.
+	resultClient client.Client
+	// sloEvaluator evaluates the SLOs of the finished runs
+	sloEvaluator *slo.Evaluator
+)
+
+// SetupResultRecorder records the runs of chaos in ChaosResults with the client of the manager
+func SetupResultRecorder(mgr ctrl.Manager) {
+	resultClient = mgr.GetClient()
+	sloEvaluator = &slo.Evaluator{
+		Address: ControllerCfg.PrometheusAddress,
+		Client:  &http.Client{Timeout: sloTimeout},
+	}
+}
+
+// maxResultPrefixLength is the max length of the chaos name in the names of results, which
+// leaves the room for the suffix of the start time
+const maxResultPrefixLength = 242
+
+// ResultName returns the name of the result of the run of chaos which started at start
+func ResultName(name string, start time.Time) string {
+	if len(name) > maxResultPrefixLength {
+		name = name[:maxResultPrefixLength]
+	}
+	return fmt.Sprintf("%s-%d", name, start.Unix())
+}
+
+// recordResult records the run of chaos in its ChaosResult on the transition from the previous status,
+// which is written already. A result is created when the chaos starts, and it's finished with the
+// verdict once the chaos finishes, fails or is aborted, and the finished run is also judged by the SLO
+// of the chaos. The load of the chaos is generated while the run is running, and its measurement is
+// recorded in the result. The results aren't owned by the chaos, so they're kept after it's deleted.
+// Failing to record the result doesn't fail the chaos.
+func recordResult(ctx context.Context, chaos v1alpha1.InnerObject, previous *v1alpha1.ChaosStatus, deleted bool) {
+	c := resultClient
+	if c == nil {
+		return
+	}
+
+	status := chaos.GetStatus()
+	running := previous.Experiment.Phase == v1alpha1.ExperimentPhaseRunning
+	var err error
+	switch event := lifecycleEvent(previous.Experiment.Phase, status.Experiment.Phase); {
+	case deleted:
+		if running {
+			err = finishResult(ctx, c, chaos, previous, v1alpha1.VerdictAborted, "the chaos is deleted")
+		}
+	case event == v1alpha1.NotificationEventStarted:
+		var result *v1alpha1.ChaosResult
+		if result, err = createResult(ctx, c, chaos, status.Experiment.StartTime); err == nil {
+			startLoad(chaos, result)
+		}
+	case event == v1alpha1.NotificationEventFinished:
+		err = finishResult(ctx, c, chaos, previous, v1alpha1.VerdictPassed, "")
+	case event == v1alpha1.NotificationEventAborted:
+		err = finishResult(ctx, c, chaos, previous, v1alpha1.VerdictAborted, "the chaos is paused")
+	case event == v1alpha1.NotificationEventFailed:
+		reason := eventReason(status, event)
+		if running {
+			err = finishResult(ctx, c, chaos, previous, v1alpha1.VerdictFailed, reason)
+			break
+		}
+		// the chaos failed before it's injected, so the failed run is recorded by a new result
+		var result *v1alpha1.ChaosResult
+		now := metav1.Now()
+		if result, err = createResult(ctx, c, chaos, &now); err == nil {
+			err = writeFinishedResult(ctx, c, chaos, result, v1alpha1.VerdictFailed, reason)
+		}
+	}
+	if err != nil {
+		instance := chaosInstance(chaos)
+		log.Error(err, "failed to record the result of chaos",
+			"kind", instance.Kind, "namespace", instance.Namespace, "name", instance.Name)
+	}
+}
+
+// createResult creates the result of the run which started at start. The existing result is
+// returned if it's created already.
+func createResult(ctx context.Context, c client.Client, chaos v1alpha1.InnerObject, start *metav1.Time) (*v1alpha1.ChaosResult, error) {
+	if start == nil {
+		now := metav1.Now()
+		start = &now
+	}
+	meta := chaos.(metav1.Object)
+	instance := chaosInstance(chaos)
+	result := &v1alpha1.ChaosResult{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: meta.GetNamespace(),
+			Name:      ResultName(meta.GetName(), start.Time),
+			Labels: map[string]string{
+				v1alpha1.LabelResultKind:    instance.Kind,
+				v1alpha1.LabelResultName:    meta.GetName(),
+				v1alpha1.LabelResultUID:     string(meta.GetUID()),
+				v1alpha1.LabelResultVerdict: string(v1alpha1.VerdictRunning),
+			},
+		},
+		Spec: v1alpha1.ChaosResultSpec{
+			Experiment: v1alpha1.ExperimentReference{
+				Kind:       instance.Kind,
+				Name:       meta.GetName(),
+				UID:        meta.GetUID(),
+				Generation: meta.GetGeneration(),
+			},
+			StartTime: *start,
+		},
+	}
+
+	snapshot, err := specSnapshot(chaos)
+	if err != nil {
+		return nil, err
+	}
+	result.Spec.Snapshot = snapshot
+
+	if err := c.Create(ctx, result); err != nil {
+		if !k8serror.IsAlreadyExists(err) {
+			return nil, err
+		}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: result.Namespace, Name: result.Name}, result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	result.Status.Verdict = v1alpha1.VerdictRunning
+	result.Status.Targets = resultTargets(chaos.GetStatus())
+	if err := c.Status().Update(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// finishResult finishes the result of the run which started at the previous start time of chaos.
+// The result is created if it's missing, e.g. it's recorded after the chaos started.
+func finishResult(ctx context.Context, c client.Client, chaos v1alpha1.InnerObject, previous *v1alpha1.ChaosStatus, verdict v1alpha1.ChaosVerdict, reason string) error {
+	start := previous.Experiment.StartTime
+	if start == nil {
+		start = chaos.GetStatus().Experiment.StartTime
+	}
+	if start == nil {
+		return fmt.Errorf("the start time of the run is unknown")
+	}
+
+	meta := chaos.(metav1.Object)
+	result := &v1alpha1.ChaosResult{}
+	key := types.NamespacedName{Namespace: meta.GetNamespace(), Name: ResultName(meta.GetName(), start.Time)}
+	// the load is stopped even if the result fails to be written, so it doesn't last forever
+	load := stopLoad(chaos, key)
+	if err := c.Get(ctx, key, result); err != nil {
+		if !k8serror.IsNotFound(err) {
+			return err
+		}
+		if result, err = createResult(ctx, c, chaos, start); err != nil {
+			return err
+		}
+	}
+	if result.IsFinished() {
+		return nil
+	}
+	if load != nil {
+		result.Status.Load = load
+	}
+
+	// the SLO is only evaluated for the runs which were injected
+	if obj, ok := chaos.(v1alpha1.SLOObject); ok && obj.GetSLO() != nil && sloEvaluator != nil {
+		sloCtx, cancel := context.WithTimeout(ctx, sloTimeout)
+		result.Status.SLO = sloEvaluator.Evaluate(sloCtx, obj.GetSLO(), result.Spec.StartTime.Time, time.Now())
+		cancel()
+	}
+	return writeFinishedResult(ctx, c, chaos, result, verdict, reason)
+}
+
+// writeFinishedResult writes the verdict of the result and its label
+func writeFinishedResult(ctx context.Context, c client.Client, chaos v1alpha1.InnerObject, result *v1alpha1.ChaosResult, verdict v1alpha1.ChaosVerdict, reason string) error {
+	result.Finish(verdict, reason, metav1.Now())
+	if targets := resultTargets(chaos.GetStatus()); len(targets) > 0 {
+		result.Status.Targets = targets
+	}
+
+	// the status is written by the status subresource, so it's kept from the update of the labels
+	status := result.Status.DeepCopy()
+	if err := c.Update(ctx, result); err != nil {
+		return err
+	}
+	result.Status = *status
+	return c.Status().Update(ctx, result)
+}
+
+// specSnapshot returns the spec of the chaos, which is recorded in the result to replay the run
+func specSnapshot(chaos v1alpha1.InnerObject) (*runtime.RawExtension, error) {
+	data, err := json.Marshal(chaos)
+	if err != nil {
+		return nil, err
+	}
+	var obj struct {
+		Spec json.RawMessage `json:"spec"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: obj.Spec}, nil
+}
+
+func resultTargets(status *v1alpha1.ChaosStatus) []v1alpha1.ResultTarget {
+	if len(status.Experiment.PodRecords) == 0 {
+		return nil
+	}
+	targets := make([]v1alpha1.ResultTarget, 0, len(status.Experiment.PodRecords))
+	for _, record := range status.Experiment.PodRecords {
+		targets = append(targets, v1alpha1.ResultTarget{
+			Namespace: record.Namespace,
+			Name:      record.Name,
+			Action:    record.Action,
+		})
+	}
+	return targets
+}
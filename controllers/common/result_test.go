@@ -0,0 +1,235 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/slo"
+)
+
+func TestRecordResult(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &v1alpha1.TimeChaos{
+		TypeMeta:   metav1.TypeMeta{Kind: v1alpha1.KindTimeChaos},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "chaos", UID: "uid-1", Generation: 1},
+		Spec:       v1alpha1.TimeChaosSpec{Mode: v1alpha1.OnePodMode, TimeOffset: "-1h"},
+	}
+	c := newGuardedClient(chaos.DeepCopy())
+	resultClient = c.Client
+	defer func() { resultClient = nil }()
+
+	list := func() []v1alpha1.ChaosResult {
+		var results v1alpha1.ChaosResultList
+		g.Expect(c.List(context.TODO(), &results, client.InNamespace("default"),
+			client.MatchingLabels{v1alpha1.LabelResultUID: "uid-1"})).To(Succeed())
+		return results.Items
+	}
+
+	// the first run starts and finishes
+	start := metav1.NewTime(time.Now().Add(-time.Minute))
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	chaos.Status.Experiment.StartTime = &start
+	chaos.Status.Experiment.PodRecords = []v1alpha1.PodStatus{{Namespace: "default", Name: "p1", Action: "time-offset"}}
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+
+	results := list()
+	g.Expect(results).To(HaveLen(1))
+	g.Expect(results[0].Name).To(Equal(ResultName("chaos", start.Time)))
+	g.Expect(results[0].Spec.Experiment).To(Equal(v1alpha1.ExperimentReference{
+		Kind: v1alpha1.KindTimeChaos, Name: "chaos", UID: "uid-1", Generation: 1,
+	}))
+	g.Expect(results[0].Status.Verdict).To(Equal(v1alpha1.VerdictRunning))
+	g.Expect(results[0].Status.Targets).To(Equal([]v1alpha1.ResultTarget{{Namespace: "default", Name: "p1", Action: "time-offset"}}))
+	g.Expect(results[0].Spec.Snapshot).ToNot(BeNil())
+	g.Expect(string(results[0].Spec.Snapshot.Raw)).To(MatchJSON(`{"mode": "one", "timeOffset": "-1h", "selector": {}}`))
+
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+	results = list()
+	g.Expect(results).To(HaveLen(1))
+	g.Expect(results[0].Status.Verdict).To(Equal(v1alpha1.VerdictPassed))
+	g.Expect(results[0].Labels[v1alpha1.LabelResultVerdict]).To(Equal(string(v1alpha1.VerdictPassed)))
+	g.Expect(results[0].Status.EndTime).ToNot(BeNil())
+	g.Expect(results[0].Status.Duration).ToNot(BeEmpty())
+
+	// the chaos is re-run, and the second run is paused
+	second := metav1.NewTime(start.Add(time.Hour))
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	chaos.Status.Experiment.StartTime = &second
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhasePaused
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+
+	aborted := &v1alpha1.ChaosResult{}
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: ResultName("chaos", second.Time)}, aborted)).To(Succeed())
+	g.Expect(aborted.Status.Verdict).To(Equal(v1alpha1.VerdictAborted))
+	g.Expect(list()).To(HaveLen(2))
+
+	// the chaos which fails before it's injected is recorded by a failed result
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFailed
+	chaos.Status.Experiment.Reason = "no pod is selected"
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+
+	var failed []v1alpha1.ChaosResult
+	for _, result := range list() {
+		if result.Status.Verdict == v1alpha1.VerdictFailed {
+			failed = append(failed, result)
+		}
+	}
+	g.Expect(failed).To(HaveLen(1))
+	g.Expect(failed[0].Status.Reason).To(Equal("no pod is selected"))
+}
+
+func TestRecordResultWithSLO(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	start := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+	// the error ratio rises from 1% in the pre-window to 3% in the chaos window
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value := "0.03"
+		if r.URL.Query().Get("time") == fmt.Sprint(start.Unix()) {
+			value = "0.01"
+		}
+		fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[{"value":[0,"%s"]}]}}`, value)
+	}))
+	defer server.Close()
+
+	chaos := &v1alpha1.TimeChaos{
+		TypeMeta:   metav1.TypeMeta{Kind: v1alpha1.KindTimeChaos},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "chaos", UID: "uid-1"},
+		Spec: v1alpha1.TimeChaosSpec{
+			Mode:       v1alpha1.OnePodMode,
+			TimeOffset: "-1h",
+			SLO:        &v1alpha1.SLOSpec{Address: server.URL, Query: "error_ratio", Threshold: "50"},
+		},
+	}
+	c := newGuardedClient(chaos.DeepCopy())
+	resultClient = c.Client
+	sloEvaluator = &slo.Evaluator{}
+	defer func() {
+		resultClient = nil
+		sloEvaluator = nil
+	}()
+
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	chaos.Status.Experiment.StartTime = &start
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+
+	result := &v1alpha1.ChaosResult{}
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: ResultName("chaos", start.Time)}, result)).To(Succeed())
+	g.Expect(result.Status.Verdict).To(Equal(v1alpha1.VerdictFailed))
+	g.Expect(result.Status.Reason).To(Equal("the SLO is violated: the degradation 200.00% exceeds 50%"))
+	g.Expect(result.Status.SLO).To(Equal(&v1alpha1.SLOResult{
+		Query:       "error_ratio",
+		Baseline:    "0.01",
+		Value:       "0.03",
+		Degradation: "200.00",
+		Threshold:   "50",
+	}))
+}
+
+func TestRecordResultWithLoad(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	chaos := &v1alpha1.TimeChaos{
+		TypeMeta:   metav1.TypeMeta{Kind: v1alpha1.KindTimeChaos},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "chaos", UID: "uid-1"},
+		Spec: v1alpha1.TimeChaosSpec{
+			Mode:       v1alpha1.OnePodMode,
+			TimeOffset: "-1h",
+			Load:       &v1alpha1.LoadSpec{Target: server.URL, QPS: 100},
+		},
+	}
+	c := newGuardedClient(chaos.DeepCopy())
+	resultClient = c.Client
+	defer func() { resultClient = nil }()
+
+	// the load is generated while the run is running
+	start := metav1.NewTime(time.Now().Truncate(time.Second))
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	chaos.Status.Experiment.StartTime = &start
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+	time.Sleep(200 * time.Millisecond)
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+
+	result := &v1alpha1.ChaosResult{}
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: ResultName("chaos", start.Time)}, result)).To(Succeed())
+	g.Expect(result.Status.Verdict).To(Equal(v1alpha1.VerdictPassed))
+	g.Expect(result.Status.Load).ToNot(BeNil())
+	g.Expect(result.Status.Load.Target).To(Equal(server.URL))
+	g.Expect(result.Status.Load.Requests).To(BeNumerically(">", 0))
+	g.Expect(result.Status.Load.Errors).To(BeZero())
+	g.Expect(loadGenerators.generators).To(BeEmpty())
+
+	// the run whose generator is lost records the error
+	second := metav1.NewTime(start.Add(time.Hour))
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	chaos.Status.Experiment.StartTime = &second
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+	key := types.NamespacedName{Namespace: "default", Name: ResultName("chaos", second.Time)}
+	loadGenerators.Lock()
+	generator := loadGenerators.generators[key]
+	delete(loadGenerators.generators, key)
+	loadGenerators.Unlock()
+	generator.Stop()
+
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+	g.Expect(c.Get(context.TODO(), key, result)).To(Succeed())
+	g.Expect(result.Status.Load.Error).To(ContainSubstring("the load generator of the run is lost"))
+}
+
+func TestChaosResultFinish(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	start := metav1.NewTime(time.Now().Add(-time.Minute))
+	result := &v1alpha1.ChaosResult{
+		Spec: v1alpha1.ChaosResultSpec{StartTime: start},
+		Status: v1alpha1.ChaosResultStatus{
+			Verdict: v1alpha1.VerdictRunning,
+			Probes: []v1alpha1.ProbeMeasurement{
+				{Name: "latency", Value: "120ms", Passed: true},
+				{Name: "availability", Value: "97%", Passed: false, Message: "below 99%"},
+			},
+		},
+	}
+	g.Expect(result.IsFinished()).To(BeFalse())
+
+	// the failed probe fails the finished run
+	result.Finish(v1alpha1.VerdictPassed, "", metav1.NewTime(start.Add(time.Minute)))
+	g.Expect(result.IsFinished()).To(BeTrue())
+	g.Expect(result.Status.Verdict).To(Equal(v1alpha1.VerdictFailed))
+	g.Expect(result.Status.Reason).To(Equal("probe availability failed: below 99%"))
+	g.Expect(result.Status.Duration).To(Equal("1m0s"))
+	g.Expect(result.Labels[v1alpha1.LabelResultVerdict]).To(Equal(string(v1alpha1.VerdictFailed)))
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// SelectionRetry is the decision on retrying the chaos which failed to be applied
+type SelectionRetry int
+
+const (
+	// NoSelectionRetry means the failure isn't handled by the selector retry policy
+	NoSelectionRetry SelectionRetry = iota
+	// RetrySelectionLater means the selection should be retried later
+	RetrySelectionLater
+	// SelectionRetryExhausted means no pod is selected within the window of the selector retry policy
+	SelectionRetryExhausted
+)
+
+// DecideSelectionRetry decides whether to retry applying the chaos which failed with the error,
+// by the selector retry policy of the chaos, and returns the interval before the retry.
+// Only the failures that no pod meets the selectors are retried. The retries are recorded
+// in the status, and they're kept after the window passes so that the chaos isn't retried
+// again, until it's applied or fails for other reasons.
+func DecideSelectionRetry(chaos v1alpha1.InnerObject, err error, now time.Time) (SelectionRetry, time.Duration) {
+	status := chaos.GetStatus()
+
+	var r reasoner
+	if !errors.As(err, &r) || r.Reason() != v1alpha1.SelectedReasonNoPodSelected {
+		status.Experiment.SelectionRetry = nil
+		return NoSelectionRetry, 0
+	}
+
+	selectorObject, ok := chaos.(v1alpha1.SelectorObject)
+	if !ok || selectorObject.GetSelectorRetryPolicy() == nil {
+		return NoSelectionRetry, 0
+	}
+	policy := selectorObject.GetSelectorRetryPolicy()
+
+	window, err := policy.GetWindow()
+	if err != nil {
+		log.Error(err, "failed to parse the window of selector retry policy")
+		return NoSelectionRetry, 0
+	}
+
+	retry := status.Experiment.SelectionRetry
+	if retry == nil {
+		retry = &v1alpha1.SelectionRetryStatus{StartTime: metav1.NewTime(now)}
+		status.Experiment.SelectionRetry = retry
+	}
+
+	remaining := window - now.Sub(retry.StartTime.Time)
+	if remaining <= 0 {
+		return SelectionRetryExhausted, 0
+	}
+
+	backoff, err := policy.GetBackoff(retry.Retries)
+	if err != nil {
+		log.Error(err, "failed to parse the backoff of selector retry policy")
+		return NoSelectionRetry, 0
+	}
+	if backoff > remaining {
+		backoff = remaining
+	}
+	retry.Retries++
+
+	return RetrySelectionLater, backoff
+}
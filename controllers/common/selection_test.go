@@ -0,0 +1,71 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestDecideSelectionRetry(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	noPod := &reasonError{reason: v1alpha1.SelectedReasonNoPodSelected}
+	now := time.Now()
+
+	// the chaos without selector retry policy fails immediately
+	chaos := &v1alpha1.PodChaos{}
+	decision, _ := DecideSelectionRetry(chaos, noPod, now)
+	g.Expect(decision).To(Equal(NoSelectionRetry))
+
+	chaos.Spec.SelectorRetryPolicy = &v1alpha1.SelectorRetryPolicy{
+		Window:     "1m",
+		Backoff:    "10s",
+		MaxBackoff: "15s",
+	}
+
+	// the failures of other reasons aren't retried
+	decision, _ = DecideSelectionRetry(chaos, errors.New("permission denied"), now)
+	g.Expect(decision).To(Equal(NoSelectionRetry))
+	g.Expect(chaos.Status.Experiment.SelectionRetry).To(BeNil())
+
+	decision, backoff := DecideSelectionRetry(chaos, noPod, now)
+	g.Expect(decision).To(Equal(RetrySelectionLater))
+	g.Expect(backoff).To(Equal(10 * time.Second))
+
+	// the backoff is doubled and limited by the max backoff
+	decision, backoff = DecideSelectionRetry(chaos, noPod, now.Add(10*time.Second))
+	g.Expect(decision).To(Equal(RetrySelectionLater))
+	g.Expect(backoff).To(Equal(15 * time.Second))
+	g.Expect(chaos.Status.Experiment.SelectionRetry.Retries).To(Equal(2))
+
+	// the backoff is limited by the remaining window
+	decision, backoff = DecideSelectionRetry(chaos, noPod, now.Add(55*time.Second))
+	g.Expect(decision).To(Equal(RetrySelectionLater))
+	g.Expect(backoff).To(Equal(5 * time.Second))
+
+	decision, _ = DecideSelectionRetry(chaos, noPod, now.Add(time.Minute))
+	g.Expect(decision).To(Equal(SelectionRetryExhausted))
+	g.Expect(chaos.Status.Experiment.SelectionRetry).ToNot(BeNil())
+
+	// the retries are reset once the chaos is applied
+	decision, _ = DecideSelectionRetry(chaos, nil, now.Add(2*time.Minute))
+	g.Expect(decision).To(Equal(NoSelectionRetry))
+	g.Expect(chaos.Status.Experiment.SelectionRetry).To(BeNil())
+}
@@ -0,0 +1,105 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// SetupShard resolves the index of the shard which this controller manager reconciles
+func SetupShard() error {
+	if ControllerCfg.Shards <= 1 {
+		ControllerCfg.Shards = 1
+		ControllerCfg.ShardIndex = 0
+		return nil
+	}
+
+	if ControllerCfg.ShardIndex < 0 {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return err
+		}
+		index, err := parseOrdinal(hostname)
+		if err != nil {
+			return err
+		}
+		ControllerCfg.ShardIndex = index
+	}
+
+	if ControllerCfg.ShardIndex >= ControllerCfg.Shards {
+		return fmt.Errorf("shard index %d is out of range, there are only %d shards",
+			ControllerCfg.ShardIndex, ControllerCfg.Shards)
+	}
+
+	// the replicas of different shards shouldn't compete for the same lock
+	ControllerCfg.LeaderElectionID = fmt.Sprintf("%s-shard-%d", ControllerCfg.LeaderElectionID, ControllerCfg.ShardIndex)
+
+	log.Info("Reconcile chaos in shard", "index", ControllerCfg.ShardIndex, "shards", ControllerCfg.Shards)
+	return nil
+}
+
+// parseOrdinal parses the ordinal of the pod in a StatefulSet from its name, e.g. `chaos-controller-manager-2`
+func parseOrdinal(name string) (int, error) {
+	i := strings.LastIndex(name, "-")
+	if i < 0 {
+		return 0, fmt.Errorf("failed to parse shard index from %s", name)
+	}
+
+	index, err := strconv.Atoi(name[i+1:])
+	if err != nil || index < 0 {
+		return 0, fmt.Errorf("failed to parse shard index from %s", name)
+	}
+	return index, nil
+}
+
+// shardOf returns the shard which the chaos in the namespace belongs to
+func shardOf(namespace string, shards int) int {
+	if shards <= 1 {
+		return 0
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(namespace))
+	return int(h.Sum32() % uint32(shards))
+}
+
+// InShard returns whether the chaos in the namespace is reconciled by this controller manager
+func InShard(namespace string) bool {
+	return shardOf(namespace, ControllerCfg.Shards) == ControllerCfg.ShardIndex
+}
+
+// ShardPredicate filters out the events of the chaos which don't belong to this shard
+func ShardPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return e.Meta != nil && InShard(e.Meta.GetNamespace())
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return e.Meta != nil && InShard(e.Meta.GetNamespace())
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.MetaNew != nil && InShard(e.MetaNew.GetNamespace())
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return e.Meta != nil && InShard(e.Meta.GetNamespace())
+		},
+	}
+}
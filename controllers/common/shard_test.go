@@ -0,0 +1,53 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseOrdinal(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	index, err := parseOrdinal("chaos-controller-manager-2")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(index).To(Equal(2))
+
+	_, err = parseOrdinal("chaos-controller-manager-6d4f9b7c5-x2x9z")
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = parseOrdinal("localhost")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestShardOf(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(shardOf("default", 1)).To(Equal(0))
+	g.Expect(shardOf("default", 0)).To(Equal(0))
+
+	counts := make([]int, 4)
+	for i := 0; i < 1000; i++ {
+		namespace := fmt.Sprintf("namespace-%d", i)
+		shard := shardOf(namespace, 4)
+		g.Expect(shard).To(Equal(shardOf(namespace, 4)))
+		counts[shard]++
+	}
+	for _, count := range counts {
+		g.Expect(count).To(BeNumerically(">", 0))
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/reconciler"
+	"github.com/chaos-mesh/chaos-mesh/pkg/audit"
+)
+
+// NextStaggerStep returns the time before the next pod of the running chaos is injected, if the chaos
+// is being injected into the pods one by one. ok is false if no pod is pending.
+func NextStaggerStep(status *v1alpha1.ChaosStatus, now time.Time) (wait time.Duration, ok bool) {
+	stagger := status.Experiment.Stagger
+	if status.Experiment.Phase != v1alpha1.ExperimentPhaseRunning || stagger == nil {
+		return 0, false
+	}
+	return stagger.NextTime.Sub(now), true
+}
+
+// ApplyStaggerStep injects the running chaos into the next pending pod by r, which applies the chaos
+// on the next pod by ApplyPods. The reconcilers requeue the chaos for the steps instead of waiting
+// for the stagger interval, so the workers aren't blocked while the chaos is staggered.
+func ApplyStaggerStep(ctx context.Context, r reconciler.InnerReconciler, c client.Client, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	var err error
+	opCtx, op := StartOperation(ctx, chaos, audit.OperationInject)
+	if ControllerCfg.SecurityMode {
+		err = CheckCreatorPermission(opCtx, c, chaos)
+	}
+	if err == nil {
+		err = r.Apply(opCtx, req, chaos)
+	}
+	op.Finish(err)
+	if err != nil {
+		return err
+	}
+
+	if err := AnnotatePods(ctx, c, chaos); err != nil {
+		log.Error(err, "failed to annotate pods")
+	}
+	return nil
+}
+
+// StaggerResult requeues the chaos for the next step if it's being injected into the pods one by one
+func StaggerResult(status *v1alpha1.ChaosStatus) ctrl.Result {
+	wait, ok := NextStaggerStep(status, time.Now())
+	if !ok {
+		return ctrl.Result{}
+	}
+	if wait <= 0 {
+		return ctrl.Result{Requeue: true}
+	}
+	return ctrl.Result{RequeueAfter: wait}
+}
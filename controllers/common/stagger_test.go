@@ -0,0 +1,95 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// staggeredReconciler applies the chaos on the pods by ApplyPods
+type staggeredReconciler struct {
+	pods    []v1.Pod
+	applied []string
+}
+
+func (r *staggeredReconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	return ApplyPods(ctx, chaos, r.pods, recordPod, func(ctx context.Context, pod *v1.Pod) error {
+		r.applied = append(r.applied, pod.Name)
+		return nil
+	}, nil)
+}
+
+func (r *staggeredReconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	return nil
+}
+
+func (r *staggeredReconciler) Object() v1alpha1.InnerObject {
+	return &v1alpha1.TimeChaos{}
+}
+
+func TestReconcileStaggeredChaos(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	interval := "1h"
+	key := types.NamespacedName{Namespace: "default", Name: "chaos"}
+	chaos := &v1alpha1.TimeChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "chaos"},
+		Spec:       v1alpha1.TimeChaosSpec{Mode: v1alpha1.AllPodMode, TimeOffset: "-1h", StaggerInterval: &interval},
+	}
+	c := newGuardedClient(chaos)
+	inner := &staggeredReconciler{pods: []v1.Pod{newPod("p1"), newPod("p2")}}
+	r := NewReconciler(inner, c, ctrl.Log.WithName("test"))
+
+	// the chaos is running once the first pod is applied, and requeued for the next one
+	result, err := r.Reconcile(ctrl.Request{NamespacedName: key})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(inner.applied).To(Equal([]string{"p1"}))
+	g.Expect(result.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+
+	stored := &v1alpha1.TimeChaos{}
+	g.Expect(c.Get(context.TODO(), key, stored)).To(Succeed())
+	g.Expect(stored.Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseRunning))
+	g.Expect(stored.Status.Experiment.Stagger).ToNot(BeNil())
+	g.Expect(stored.Status.Experiment.Stagger.Pending).To(Equal(1))
+
+	// nothing is applied before the interval passes
+	result, err = r.Reconcile(ctrl.Request{NamespacedName: key})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(inner.applied).To(HaveLen(1))
+	g.Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+	// the next pod is applied after the interval
+	stored.Status.Experiment.Stagger.NextTime = metav1.NewTime(time.Now().Add(-time.Second))
+	g.Expect(c.Client.Status().Update(context.TODO(), stored)).To(Succeed())
+	result, err = r.Reconcile(ctrl.Request{NamespacedName: key})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(inner.applied).To(Equal([]string{"p1", "p2"}))
+	g.Expect(result).To(Equal(ctrl.Result{}))
+
+	stored = &v1alpha1.TimeChaos{}
+	g.Expect(c.Get(context.TODO(), key, stored)).To(Succeed())
+	g.Expect(stored.Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseRunning))
+	g.Expect(stored.Status.Experiment.Stagger).To(BeNil())
+	g.Expect(stored.Status.Experiment.PodRecords).To(HaveLen(2))
+}
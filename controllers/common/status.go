@@ -0,0 +1,211 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// originalKey is the key of the context value which holds the chaos as read by the reconciler
+type originalKey struct{}
+
+// original holds the chaos as read by the reconciler, it's replaced once the chaos is written
+type original struct {
+	chaos runtime.Object
+}
+
+// WithOriginal returns a context which carries the chaos as read by the reconciler. UpdateChaos merges
+// the changes of the reconciler, i.e. the difference between the original chaos and the written one,
+// into the latest chaos if the chaos has been written by the others since it's read.
+func WithOriginal(ctx context.Context, chaos v1alpha1.InnerObject) context.Context {
+	return context.WithValue(ctx, originalKey{}, &original{chaos: chaos.(runtime.Object).DeepCopyObject()})
+}
+
+// UpdateChaos writes the finalizers and the status of chaos. The spec is never written by the
+// controllers, so it's only owned by the users and the GitOps tools, and any normalization of
+// the spec must be done by the mutating webhook instead.
+// The finalizers are written only if they're changed, and the status is written through the
+// status subresource with the observed generation. The chaos is kept up to date with the stored one.
+// The chaos is written with its own resourceVersion. If it has been written by the others since it's
+// read, e.g. the progress pushed by chaos-daemon or the targets marked as gone, the changes of the
+// reconciler are merged into the latest chaos when the original chaos is carried by ctx, otherwise
+// the conflict is returned.
+// The transitions of the experiment phase and the recovery conditions are notified once they're written,
+// and the runs of chaos are recorded in their ChaosResults.
+func UpdateChaos(ctx context.Context, c client.Client, chaos v1alpha1.InnerObject) error {
+	meta, ok := chaos.(metav1.Object)
+	if !ok {
+		return fmt.Errorf("%T has no object meta", chaos)
+	}
+	chaos.GetStatus().ObservedGeneration = meta.GetGeneration()
+
+	orig, _ := ctx.Value(originalKey{}).(*original)
+	backoff := retry.DefaultRetry
+	if orig == nil {
+		backoff.Steps = 1
+	}
+
+	var (
+		desired  runtime.Object
+		previous *v1alpha1.ChaosStatus
+		deleted  bool
+	)
+	err := retry.RetryOnConflict(backoff, func() error {
+		// the stored chaos is read into a new object, since the omitted fields aren't cleared by decoding
+		stored := reflect.New(reflect.TypeOf(chaos).Elem()).Interface().(runtime.Object)
+		key := types.NamespacedName{Namespace: meta.GetNamespace(), Name: meta.GetName()}
+		if err := c.Get(ctx, key, stored); err != nil {
+			return err
+		}
+		storedMeta := stored.(metav1.Object)
+		previous = stored.(v1alpha1.InnerObject).GetStatus().DeepCopy()
+
+		desired = chaos.(runtime.Object).DeepCopyObject()
+		if storedMeta.GetResourceVersion() != meta.GetResourceVersion() {
+			if orig == nil {
+				resource := schema.GroupResource{Group: v1alpha1.GroupVersion.Group, Resource: strings.ToLower(chaosInstance(chaos).Kind)}
+				return apierrors.NewConflict(resource, meta.GetName(), fmt.Errorf("the chaos has been modified since it's read"))
+			}
+			mergeChanges(orig.chaos, desired, stored)
+		}
+		desiredMeta := desired.(metav1.Object)
+
+		if !equalFinalizers(storedMeta.GetFinalizers(), desiredMeta.GetFinalizers()) {
+			// only the finalizers are changed on the stored chaos, so its spec is written as it is
+			storedMeta.SetFinalizers(desiredMeta.GetFinalizers())
+			if err := c.Update(ctx, stored); err != nil {
+				return err
+			}
+			// the deleted chaos is gone with its last finalizer
+			if storedMeta.GetDeletionTimestamp() != nil && len(storedMeta.GetFinalizers()) == 0 {
+				deleted = true
+				return nil
+			}
+		}
+
+		// the desired status is based on the stored chaos, which is checked by its resourceVersion
+		desiredMeta.SetResourceVersion(storedMeta.GetResourceVersion())
+		return c.Status().Update(ctx, desired)
+	})
+	if err != nil {
+		return err
+	}
+
+	reflect.ValueOf(chaos).Elem().Set(reflect.ValueOf(desired).Elem())
+	if orig != nil {
+		orig.chaos = desired.DeepCopyObject()
+	}
+	notifyTransition(chaos, previous)
+	recordResult(ctx, chaos, previous, deleted)
+	return nil
+}
+
+// mergeChanges merges the changes from the original chaos to the desired one into the latest chaos, and
+// writes the result into desired. The status is owned by the reconciler except the fields of the target
+// records written by the others, i.e. the progress pushed by chaos-daemon and the targets marked as gone,
+// which are kept unless the reconciler changes them as well.
+func mergeChanges(original, desired, latest runtime.Object) {
+	originalMeta, desiredMeta, latestMeta := original.(metav1.Object), desired.(metav1.Object), latest.(metav1.Object)
+	desiredMeta.SetFinalizers(mergeFinalizers(originalMeta.GetFinalizers(), desiredMeta.GetFinalizers(), latestMeta.GetFinalizers()))
+
+	originalRecords := recordsByKey(original.(v1alpha1.InnerObject).GetStatus())
+	latestRecords := recordsByKey(latest.(v1alpha1.InnerObject).GetStatus())
+	records := desired.(v1alpha1.InnerObject).GetStatus().Experiment.PodRecords
+	for i := range records {
+		record := &records[i]
+		key := record.Namespace + "/" + record.Name
+		latestRecord, ok := latestRecords[key]
+		if !ok {
+			continue
+		}
+		originalRecord := originalRecords[key]
+		if originalRecord == nil {
+			originalRecord = &v1alpha1.PodStatus{}
+		}
+
+		if reflect.DeepEqual(record.Progress, originalRecord.Progress) {
+			record.Progress = latestRecord.Progress
+		}
+		if record.State == originalRecord.State && record.Reason == originalRecord.Reason {
+			record.State, record.Reason = latestRecord.State, latestRecord.Reason
+		}
+	}
+}
+
+// mergeFinalizers applies the finalizers added and removed from original to desired on latest
+func mergeFinalizers(original, desired, latest []string) []string {
+	removed := make(map[string]bool)
+	for _, f := range original {
+		removed[f] = true
+	}
+	for _, f := range desired {
+		delete(removed, f)
+	}
+
+	merged := make([]string, 0, len(latest)+len(desired))
+	for _, f := range latest {
+		if !removed[f] {
+			merged = append(merged, f)
+		}
+	}
+	for _, f := range desired {
+		if !containsString(merged, f) {
+			merged = append(merged, f)
+		}
+	}
+	return merged
+}
+
+func recordsByKey(status *v1alpha1.ChaosStatus) map[string]*v1alpha1.PodStatus {
+	records := make(map[string]*v1alpha1.PodStatus, len(status.Experiment.PodRecords))
+	for i := range status.Experiment.PodRecords {
+		record := &status.Experiment.PodRecords[i]
+		records[record.Namespace+"/"+record.Name] = record
+	}
+	return records
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func equalFinalizers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
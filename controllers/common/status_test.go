@@ -0,0 +1,310 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// specGuard fails every write of chaos which changes the stored spec
+type specGuard struct {
+	client.Client
+	writes int
+}
+
+func (g *specGuard) check(ctx context.Context, obj runtime.Object) error {
+	g.writes++
+	meta := obj.(metav1.Object)
+	stored := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(runtime.Object)
+	if err := g.Client.Get(ctx, types.NamespacedName{Namespace: meta.GetNamespace(), Name: meta.GetName()}, stored); err != nil {
+		return err
+	}
+
+	spec := func(obj runtime.Object) interface{} {
+		data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			panic(err)
+		}
+		return data["spec"]
+	}
+	if !reflect.DeepEqual(spec(stored), spec(obj)) {
+		return fmt.Errorf("the spec of %s/%s is written", meta.GetNamespace(), meta.GetName())
+	}
+	return nil
+}
+
+func (g *specGuard) Update(ctx context.Context, obj runtime.Object, opts ...client.UpdateOption) error {
+	if err := g.check(ctx, obj); err != nil {
+		return err
+	}
+	return g.Client.Update(ctx, obj, opts...)
+}
+
+func (g *specGuard) Patch(ctx context.Context, obj runtime.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return fmt.Errorf("chaos mustn't be patched by the controllers")
+}
+
+func (g *specGuard) Status() client.StatusWriter {
+	return &specGuardStatusWriter{g}
+}
+
+type specGuardStatusWriter struct {
+	guard *specGuard
+}
+
+func (w *specGuardStatusWriter) Update(ctx context.Context, obj runtime.Object, opts ...client.UpdateOption) error {
+	if err := w.guard.check(ctx, obj); err != nil {
+		return err
+	}
+	return w.guard.Client.Status().Update(ctx, obj, opts...)
+}
+
+func (w *specGuardStatusWriter) Patch(ctx context.Context, obj runtime.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return fmt.Errorf("chaos mustn't be patched by the controllers")
+}
+
+// finalizingReconciler adds the finalizer on apply and removes it on recovery, like the reconcilers of chaos.
+// The status of chaos is written by others while it's recovered if concurrent is set.
+type finalizingReconciler struct {
+	concurrent func(ctx context.Context)
+}
+
+func (r *finalizingReconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	meta := chaos.(metav1.Object)
+	meta.SetFinalizers(append(meta.GetFinalizers(), "default/p1"))
+	chaos.GetStatus().Experiment.PodRecords = []v1alpha1.PodStatus{{Namespace: "default", Name: "p1"}}
+	return nil
+}
+
+func (r *finalizingReconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	if r.concurrent != nil {
+		r.concurrent(ctx)
+	}
+	chaos.(metav1.Object).SetFinalizers(nil)
+	return nil
+}
+
+func (r *finalizingReconciler) Object() v1alpha1.InnerObject {
+	return &v1alpha1.TimeChaos{}
+}
+
+func newGuardedClient(objs ...runtime.Object) *specGuard {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+	return &specGuard{Client: fake.NewFakeClientWithScheme(scheme, objs...)}
+}
+
+func TestUpdateChaos(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &v1alpha1.TimeChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "chaos", Generation: 2},
+		Spec:       v1alpha1.TimeChaosSpec{Mode: v1alpha1.OnePodMode, TimeOffset: "-1h"},
+	}
+	c := newGuardedClient(chaos.DeepCopy())
+
+	chaos.Finalizers = []string{"default/p1"}
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+	g.Expect(c.writes).To(Equal(2))
+
+	stored := &v1alpha1.TimeChaos{}
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "chaos"}, stored)).To(Succeed())
+	g.Expect(stored.Finalizers).To(Equal([]string{"default/p1"}))
+	g.Expect(stored.Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseRunning))
+	g.Expect(stored.Status.ObservedGeneration).To(Equal(int64(2)))
+	g.Expect(chaos.ResourceVersion).To(Equal(stored.ResourceVersion))
+
+	// only the status is written if the finalizers aren't changed
+	c.writes = 0
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).To(Succeed())
+	g.Expect(c.writes).To(Equal(1))
+
+	// the spec changed by the controller is never written
+	chaos.Spec.TimeOffset = "-2h"
+	g.Expect(UpdateChaos(context.TODO(), c, chaos)).ToNot(Succeed())
+}
+
+// TestReconcilerNeverWritesSpec is the conformance test that the reconciler only writes the finalizers
+// and the status of chaos through its lifecycle.
+func TestReconcilerNeverWritesSpec(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &v1alpha1.TimeChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "chaos", Generation: 1},
+		Spec:       v1alpha1.TimeChaosSpec{Mode: v1alpha1.OnePodMode, TimeOffset: "-1h"},
+	}
+	c := newGuardedClient(chaos)
+	r := NewReconciler(&finalizingReconciler{}, c, ctrl.Log.WithName("test"))
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "chaos"}}
+	get := func() *v1alpha1.TimeChaos {
+		stored := &v1alpha1.TimeChaos{}
+		g.Expect(c.Get(context.TODO(), req.NamespacedName, stored)).To(Succeed())
+		return stored
+	}
+
+	// inject
+	_, err := r.Reconcile(req)
+	g.Expect(err).ToNot(HaveOccurred())
+	stored := get()
+	g.Expect(stored.Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseRunning))
+	g.Expect(stored.Status.ObservedGeneration).To(Equal(int64(1)))
+	g.Expect(stored.Finalizers).To(HaveLen(1))
+
+	// pause
+	stored.Annotations = map[string]string{v1alpha1.PauseAnnotationKey: "true"}
+	stored.Generation = 2
+	g.Expect(c.Client.Update(context.TODO(), stored)).To(Succeed())
+	_, err = r.Reconcile(req)
+	g.Expect(err).ToNot(HaveOccurred())
+	stored = get()
+	g.Expect(stored.Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhasePaused))
+	g.Expect(stored.Status.ObservedGeneration).To(Equal(int64(2)))
+	g.Expect(stored.Finalizers).To(BeEmpty())
+
+	// delete
+	now := metav1.Now()
+	stored.DeletionTimestamp = &now
+	stored.Finalizers = []string{"default/p1"}
+	g.Expect(c.Client.Update(context.TODO(), stored)).To(Succeed())
+	_, err = r.Reconcile(req)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(get().Finalizers).To(BeEmpty())
+	g.Expect(c.writes).ToNot(BeZero())
+}
+
+// writeConcurrently writes the progress pushed by chaos-daemon and the target marked as gone into the stored chaos,
+// like the writers of status other than the reconciler
+func writeConcurrently(ctx context.Context, c client.Client, key types.NamespacedName) error {
+	stored := &v1alpha1.TimeChaos{}
+	if err := c.Get(ctx, key, stored); err != nil {
+		return err
+	}
+	records := stored.Status.Experiment.PodRecords
+	for i := range records {
+		switch records[i].Name {
+		case "p1":
+			records[i].Progress = &v1alpha1.PodProgress{Phase: "Applied", Method: "SetTimeOffset"}
+		case "p2":
+			MarkTargetGone(&stored.Status.ChaosStatus, records[i].Namespace+"/"+records[i].Name, "namespace default is being deleted")
+		}
+	}
+	return c.Status().Update(ctx, stored)
+}
+
+func TestUpdateChaosConcurrently(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	key := types.NamespacedName{Namespace: "default", Name: "chaos"}
+	chaos := &v1alpha1.TimeChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "chaos", Generation: 1,
+			Finalizers: []string{"default/p1", "default/p2"}},
+		Spec: v1alpha1.TimeChaosSpec{Mode: v1alpha1.AllPodMode, TimeOffset: "-1h"},
+	}
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	chaos.Status.Experiment.PodRecords = []v1alpha1.PodStatus{
+		{Namespace: "default", Name: "p1"},
+		{Namespace: "default", Name: "p2"},
+	}
+	c := newGuardedClient(chaos.DeepCopy())
+	ctx := context.TODO()
+	g.Expect(c.Get(ctx, key, chaos)).To(Succeed())
+
+	// the chaos is written by the others after the reconciler reads it
+	ctx = WithOriginal(ctx, chaos)
+	g.Expect(writeConcurrently(ctx, c.Client, key)).To(Succeed())
+	stored := &v1alpha1.TimeChaos{}
+	g.Expect(c.Get(ctx, key, stored)).To(Succeed())
+	stored.Finalizers = append(stored.Finalizers, "foregroundDeletion")
+	g.Expect(c.Client.Update(ctx, stored)).To(Succeed())
+
+	// the reconciler recovers the chaos from p1
+	chaos.Finalizers = []string{"default/p2"}
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
+	chaos.Status.Experiment.PodRecords[0].Message = "recovered"
+	g.Expect(UpdateChaos(ctx, c, chaos)).To(Succeed())
+
+	// the changes of both are kept
+	stored = &v1alpha1.TimeChaos{}
+	g.Expect(c.Get(ctx, key, stored)).To(Succeed())
+	g.Expect(stored.Finalizers).To(Equal([]string{"default/p2", "foregroundDeletion"}))
+	g.Expect(stored.Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseFinished))
+	records := stored.Status.Experiment.PodRecords
+	g.Expect(records).To(HaveLen(2))
+	g.Expect(records[0].Message).To(Equal("recovered"))
+	g.Expect(records[0].Progress).ToNot(BeNil())
+	g.Expect(records[0].Progress.Method).To(Equal("SetTimeOffset"))
+	g.Expect(records[1].State).To(Equal(v1alpha1.TargetGone))
+	g.Expect(chaos.ResourceVersion).To(Equal(stored.ResourceVersion))
+
+	// the chaos written by the others is never overwritten without the original one
+	g.Expect(writeConcurrently(ctx, c.Client, key)).To(Succeed())
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	err := UpdateChaos(context.TODO(), c, chaos)
+	g.Expect(apierrors.IsConflict(err)).To(BeTrue())
+	g.Expect(c.Get(ctx, key, stored)).To(Succeed())
+	g.Expect(stored.Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseFinished))
+}
+
+// TestReconcilerWithConcurrentWriters is the conformance test that the reconciler keeps the status written by
+// the others, i.e. the progress pushed by chaos-daemon and the targets marked as gone, while it reconciles.
+func TestReconcilerWithConcurrentWriters(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	key := types.NamespacedName{Namespace: "default", Name: "chaos"}
+	chaos := &v1alpha1.TimeChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "chaos", Generation: 1,
+			Annotations: map[string]string{v1alpha1.PauseAnnotationKey: "true"}},
+		Spec: v1alpha1.TimeChaosSpec{Mode: v1alpha1.AllPodMode, TimeOffset: "-1h"},
+	}
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	chaos.Status.Experiment.PodRecords = []v1alpha1.PodStatus{
+		{Namespace: "default", Name: "p1"},
+		{Namespace: "default", Name: "p2"},
+	}
+	c := newGuardedClient(chaos)
+	inner := &finalizingReconciler{concurrent: func(ctx context.Context) {
+		g.Expect(writeConcurrently(ctx, c.Client, key)).To(Succeed())
+	}}
+	r := NewReconciler(inner, c, ctrl.Log.WithName("test"))
+
+	// pause, while the status is written by the others
+	_, err := r.Reconcile(ctrl.Request{NamespacedName: key})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	stored := &v1alpha1.TimeChaos{}
+	g.Expect(c.Get(context.TODO(), key, stored)).To(Succeed())
+	g.Expect(stored.Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhasePaused))
+	records := stored.Status.Experiment.PodRecords
+	g.Expect(records).To(HaveLen(2))
+	g.Expect(records[0].Progress).ToNot(BeNil())
+	g.Expect(records[1].State).To(Equal(v1alpha1.TargetGone))
+}
@@ -0,0 +1,65 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// MarkTargetGone marks the record of the pod keyed by its namespace/name as gone with the reason,
+// and returns whether the record is found and marked
+func MarkTargetGone(status *v1alpha1.ChaosStatus, namespacedName string, reason string) bool {
+	records := status.Experiment.PodRecords
+	for i := range records {
+		if records[i].Namespace+"/"+records[i].Name != namespacedName || records[i].State == v1alpha1.TargetGone {
+			continue
+		}
+		records[i].State = v1alpha1.TargetGone
+		records[i].Reason = reason
+		return true
+	}
+	return false
+}
+
+// AllTargetsGone returns true if the chaos has been applied on some pods and all of them are gone
+func AllTargetsGone(status *v1alpha1.ChaosStatus) bool {
+	records := status.Experiment.PodRecords
+	if len(records) == 0 {
+		return false
+	}
+	for _, r := range records {
+		if r.State != v1alpha1.TargetGone {
+			return false
+		}
+	}
+	return true
+}
+
+// targetGoneReason returns the reason why the pod is gone, which is empty if it isn't. The pod is gone
+// if its record in the status of chaos is marked as gone or it's being deleted.
+func targetGoneReason(status *v1alpha1.ChaosStatus, pod *v1.Pod) string {
+	for _, r := range status.Experiment.PodRecords {
+		if r.Namespace == pod.Namespace && r.Name == pod.Name && r.State == v1alpha1.TargetGone {
+			return r.Reason
+		}
+	}
+	if pod.DeletionTimestamp != nil {
+		return fmt.Sprintf("pod %s/%s is being deleted", pod.Namespace, pod.Name)
+	}
+	return ""
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestAllTargetsGone(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	p1, p2 := newPod("p1"), newPod("p2")
+	status := &v1alpha1.ChaosStatus{}
+	g.Expect(AllTargetsGone(status)).To(BeFalse())
+
+	status.Experiment.PodRecords = []v1alpha1.PodStatus{recordPod(&p1), recordPod(&p2)}
+	g.Expect(MarkTargetGone(status, "default/p1", "namespace default is being deleted")).To(BeTrue())
+	g.Expect(MarkTargetGone(status, "default/p1", "namespace default is being deleted")).To(BeFalse())
+	g.Expect(status.Experiment.PodRecords[0].State).To(Equal(v1alpha1.TargetGone))
+	g.Expect(AllTargetsGone(status)).To(BeFalse())
+
+	g.Expect(MarkTargetGone(status, "default/p2", "ReplicaSet default/web has been deleted")).To(BeTrue())
+	g.Expect(AllTargetsGone(status)).To(BeTrue())
+}
+
+func TestRecoverPodsSkipsGoneTargets(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := metav1.Now()
+	p1, p2 := newPod("p1"), newPod("p2")
+	p2.DeletionTimestamp = &now
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, &p1, &p2)
+
+	chaos := &v1alpha1.TimeChaos{}
+	chaos.Finalizers = []string{"default/p1", "default/p2"}
+	chaos.Status.Experiment.PodRecords = []v1alpha1.PodStatus{recordPod(&p1), recordPod(&p2)}
+
+	var recovered []string
+	err := RecoverPods(context.TODO(), c, chaos, func(ctx context.Context, pod *v1.Pod) error {
+		recovered = append(recovered, pod.Name)
+		return nil
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(recovered).To(Equal([]string{"p1"}))
+	g.Expect(chaos.Finalizers).To(BeEmpty())
+	g.Expect(chaos.Status.Experiment.PodRecords[0].State).To(BeEmpty())
+	g.Expect(chaos.Status.Experiment.PodRecords[1].State).To(Equal(v1alpha1.TargetGone))
+	g.Expect(chaos.Status.Experiment.PodRecords[1].Reason).To(Equal("pod default/p2 is being deleted"))
+}
@@ -0,0 +1,34 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"github.com/chaos-mesh/chaos-mesh/pkg/targetprovider"
+)
+
+// SetupTargetProvider enables the target provider configured in ControllerCfg,
+// which vetoes or supplies the targets of chaos. No provider is consulted if it's disabled.
+func SetupTargetProvider() error {
+	provider, err := targetprovider.New(targetprovider.Config{
+		Name:           ControllerCfg.TargetProvider,
+		URL:            ControllerCfg.TargetProviderURL,
+		IgnoreFailures: ControllerCfg.TargetProviderIgnoreFailures,
+	})
+	if err != nil {
+		return err
+	}
+
+	targetprovider.SetDefault(provider)
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/reconciler"
+	"github.com/chaos-mesh/chaos-mesh/pkg/audit"
 	"github.com/chaos-mesh/chaos-mesh/pkg/config"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -75,33 +76,56 @@ func NewReconciler(reconcile reconciler.InnerReconciler, c client.Client, log lo
 func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	var err error
 
-	r.Log.Info("Reconciling a common chaos", "name", req.Name, "namespace", req.Namespace)
-	ctx := context.Background()
+	r.Log.Info("Reconciling a common chaos")
+	ctx, span := StartReconcileSpan(context.Background(), req)
+	defer span.End()
 
 	chaos := r.Object()
 	if err = r.Get(ctx, req.NamespacedName, chaos); err != nil {
 		r.Log.Error(err, "unable to get chaos")
 		return ctrl.Result{}, err
 	}
+	ctx = WithOriginal(ctx, chaos)
 
 	status := chaos.GetStatus()
 
 	if chaos.IsDeleted() {
 		// This chaos was deleted
 		r.Log.Info("Removing self")
-		if err = r.Recover(ctx, req, chaos); err != nil {
+		err = RecoverDeleted(ctx, r.InnerReconciler, req, chaos)
+		if err != nil {
 			r.Log.Error(err, "failed to recover chaos")
+
+			updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				return UpdateChaos(ctx, r.Client, chaos)
+			})
+			if updateError != nil {
+				r.Log.Error(updateError, "unable to update chaos status")
+			}
+
 			return ctrl.Result{Requeue: true}, err
 		}
+		if err = CleanPodAnnotations(ctx, r.Client, chaos); err != nil {
+			r.Log.Error(err, "failed to clean pod annotations")
+		}
 		status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
 	} else if chaos.IsPaused() {
 		if status.Experiment.Phase == v1alpha1.ExperimentPhaseRunning {
 			r.Log.Info("Pausing")
 
-			if err = r.Recover(ctx, req, chaos); err != nil {
+			opCtx, op := StartOperation(ctx, chaos, audit.OperationRecover)
+			err = r.Recover(opCtx, req, chaos)
+			op.Finish(err)
+			if err != nil {
 				r.Log.Error(err, "failed to pause chaos")
+				RecordRecoveryFailure(ctx, r.Client, chaos, err)
 				return ctrl.Result{Requeue: true}, err
 			}
+			SetRecoveringCondition(status, nil)
+			status.Experiment.Stagger = nil
+			if err = CleanPodAnnotations(ctx, r.Client, chaos); err != nil {
+				r.Log.Error(err, "failed to clean pod annotations")
+			}
 			now := time.Now()
 			status.Experiment.EndTime = &metav1.Time{
 				Time: now,
@@ -111,37 +135,107 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 			}
 		}
 		status.Experiment.Phase = v1alpha1.ExperimentPhasePaused
+	} else if status.Experiment.Phase == v1alpha1.ExperimentPhaseRunning && AllTargetsGone(status) {
+		r.Log.Info("Halting as all the targets are gone")
+
+		opCtx, op := StartOperation(ctx, chaos, audit.OperationRecover)
+		err = r.Recover(opCtx, req, chaos)
+		op.Finish(err)
+		if err != nil {
+			r.Log.Error(err, "failed to recover chaos")
+			RecordRecoveryFailure(ctx, r.Client, chaos, err)
+			return ctrl.Result{Requeue: true}, err
+		}
+		SetRecoveringCondition(status, nil)
+		status.Experiment.Stagger = nil
+		if err = CleanPodAnnotations(ctx, r.Client, chaos); err != nil {
+			r.Log.Error(err, "failed to clean pod annotations")
+		}
+		now := time.Now()
+		status.Experiment.EndTime = &metav1.Time{
+			Time: now,
+		}
+		if status.Experiment.StartTime != nil {
+			status.Experiment.Duration = now.Sub(status.Experiment.StartTime.Time).String()
+		}
+		status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
+	} else if wait, ok := NextStaggerStep(status, time.Now()); ok {
+		if wait > 0 {
+			return ctrl.Result{RequeueAfter: wait}, nil
+		}
+
+		r.Log.Info("Injecting the next staggered pod")
+		if err = ApplyStaggerStep(ctx, r.InnerReconciler, r.Client, req, chaos); err != nil {
+			r.Log.Error(err, "failed to apply chaos action")
+
+			status.Experiment.Phase = v1alpha1.ExperimentPhaseFailed
+
+			updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				return UpdateChaos(ctx, r.Client, chaos)
+			})
+			if updateError != nil {
+				r.Log.Error(updateError, "unable to update chaos finalizers")
+			}
+			return ctrl.Result{Requeue: true}, err
+		}
 	} else if status.Experiment.Phase == v1alpha1.ExperimentPhaseRunning {
-		r.Log.Info("The common chaos is already running", "name", req.Name, "namespace", req.Namespace)
+		r.Log.Info("The common chaos is already running")
 		return ctrl.Result{}, nil
 	} else {
 		// Start chaos action
 		r.Log.Info("Performing Action")
 
-		if err = r.Apply(ctx, req, chaos); err != nil {
+		opCtx, op := StartOperation(ctx, chaos, audit.OperationInject)
+		if ControllerCfg.SecurityMode {
+			err = CheckCreatorPermission(opCtx, r.Client, chaos)
+		}
+		if err == nil {
+			err = r.Apply(opCtx, req, chaos)
+		}
+		op.Finish(err)
+		SetSelectedCondition(status, err)
+		decision, backoff := DecideSelectionRetry(chaos, err, time.Now())
+		if decision == RetrySelectionLater {
+			r.Log.Info("No pod is selected, retrying the selection later", "after", backoff)
+
+			if err := UpdateChaos(ctx, r.Client, chaos); err != nil {
+				r.Log.Error(err, "unable to update chaos status")
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: backoff}, nil
+		}
+		if err != nil {
 			r.Log.Error(err, "failed to apply chaos action")
 
 			status.Experiment.Phase = v1alpha1.ExperimentPhaseFailed
 
 			updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-				return r.Update(ctx, chaos)
+				return UpdateChaos(ctx, r.Client, chaos)
 			})
 			if updateError != nil {
 				r.Log.Error(updateError, "unable to update chaos finalizers")
 			}
 
+			if decision == SelectionRetryExhausted {
+				// The selection has been retried within the whole window, so don't requeue it
+				r.Log.Info("No pod is selected within the window of selector retry policy")
+				return ctrl.Result{}, nil
+			}
 			return ctrl.Result{Requeue: true}, err
 		}
+		if err = AnnotatePods(ctx, r.Client, chaos); err != nil {
+			r.Log.Error(err, "failed to annotate pods")
+		}
 		status.Experiment.StartTime = &metav1.Time{
 			Time: time.Now(),
 		}
 		status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
 	}
 
-	if err := r.Update(ctx, chaos); err != nil {
+	if err := UpdateChaos(ctx, r.Client, chaos); err != nil {
 		r.Log.Error(err, "unable to update chaos status")
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return StaggerResult(status), nil
 }
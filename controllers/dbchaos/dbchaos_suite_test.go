@@ -0,0 +1,184 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbchaos_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"k8s.io/client-go/kubernetes/scheme"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	. "github.com/chaos-mesh/chaos-mesh/controllers/dbchaos"
+	. "github.com/chaos-mesh/chaos-mesh/controllers/test"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+func TestDBChaos(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecsWithDefaultAndCustomReporters(t,
+		"DBChaos Suite",
+		[]Reporter{envtest.NewlineReporter{}})
+}
+
+var _ = BeforeSuite(func(done Done) {
+	logf.SetLogger(zap.LoggerTo(GinkgoWriter, true))
+
+	Expect(v1.AddToScheme(scheme.Scheme)).To(Succeed())
+
+	close(done)
+}, 60)
+
+var _ = AfterSuite(func() {
+})
+
+var _ = Describe("DBChaos", func() {
+	Context("DBChaos", func() {
+		podObjects, pods := GenerateNPods(
+			"p",
+			1,
+			v1.PodRunning,
+			metav1.NamespaceDefault,
+			nil,
+			map[string]string{"l1": "l1"},
+			v1.ContainerStatus{ContainerID: "fake-container-id"},
+		)
+
+		dbchaos := v1alpha1.DBChaos{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DBChaos",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: v1alpha1.DBChaosSpec{
+				Action:   v1alpha1.DBQueryDelayAction,
+				Mode:     v1alpha1.AllPodMode,
+				Selector: v1alpha1.SelectorSpec{Namespaces: []string{metav1.NamespaceDefault}},
+				Protocol: v1alpha1.DBProtocolMySQL,
+				Target:   v1alpha1.DBTarget{Port: 3306},
+				Delay:    "500ms",
+			},
+		}
+
+		r := Reconciler{
+			Client:        fake.NewFakeClientWithScheme(scheme.Scheme, podObjects...),
+			EventRecorder: &record.FakeRecorder{},
+			Log:           ctrl.Log.WithName("controllers").WithName("DBChaos"),
+		}
+
+		It("DBChaos Apply", func() {
+			defer mock.With("MockSelectAndFilterPods", func() []v1.Pod {
+				return pods
+			})()
+			defer mock.With("MockChaosDaemonClient", &MockChaosDaemonClient{})()
+
+			err := r.Apply(context.TODO(), ctrl.Request{}, &dbchaos)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dbchaos.Status.Experiment.PodRecords).To(HaveLen(1))
+		})
+
+		It("DBChaos Apply Error", func() {
+			defer mock.With("MockSelectAndFilterPods", func() []v1.Pod {
+				return pods
+			})()
+			defer mock.With("MockChaosDaemonClient", &MockChaosDaemonClient{})()
+			defer mock.With("MockSetDBFaultError", errors.New("SetDBFaultError"))()
+
+			err := r.Apply(context.TODO(), ctrl.Request{}, &dbchaos)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("SetDBFaultError"))
+		})
+
+		It("DBChaos Apply with a missing Service", func() {
+			chaos := dbchaos.DeepCopy()
+			chaos.Spec.Target.Service = &v1alpha1.ServiceReference{Name: "mysql"}
+
+			err := r.Apply(context.TODO(), ctrl.Request{}, chaos)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("DBChaos Failover", func() {
+			defer mock.With("MockSelectAndFilterPods", func() []v1.Pod {
+				return pods
+			})()
+			defer mock.With("MockChaosDaemonClient", &MockChaosDaemonClient{})()
+
+			chaos := dbchaos.DeepCopy()
+			chaos.Finalizers = nil
+			chaos.Spec.Action = v1alpha1.DBFailoverAction
+			chaos.Spec.Protocol = v1alpha1.DBProtocolRedis
+			chaos.Spec.Target = v1alpha1.DBTarget{Port: 6379}
+			chaos.Spec.Delay = ""
+			chaos.Spec.Failover = &v1alpha1.DBFailoverSpec{Mode: v1alpha1.DBFailoverClusterMode}
+
+			err := r.Apply(context.TODO(), ctrl.Request{}, chaos)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(chaos.Status.Experiment.PodRecords).To(HaveLen(1))
+			// the failover can't be recovered
+			Expect(chaos.Finalizers).To(BeEmpty())
+
+			chaos.Spec.Failover.PasswordSecret = &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "redis"},
+				Key:                  "password",
+			}
+			err = r.Apply(context.TODO(), ctrl.Request{}, chaos)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("DBChaos Recover", func() {
+			defer mock.With("MockSelectAndFilterPods", func() []v1.Pod {
+				return pods
+			})()
+			defer mock.With("MockChaosDaemonClient", &MockChaosDaemonClient{})()
+
+			err := r.Recover(context.TODO(), ctrl.Request{}, &dbchaos)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("DBChaos Recover Error", func() {
+			defer mock.With("MockSelectAndFilterPods", func() []v1.Pod {
+				return pods
+			})()
+			defer mock.With("MockChaosDaemonClient", &MockChaosDaemonClient{})()
+			defer mock.With("MockDeleteDBFaultError", errors.New("DeleteDBFaultError"))()
+
+			err := r.Apply(context.TODO(), ctrl.Request{}, &dbchaos)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = r.Recover(context.TODO(), ctrl.Request{}, &dbchaos)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("DeleteDBFaultError"))
+		})
+	})
+})
@@ -0,0 +1,357 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbchaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/netutils"
+	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
+	chaosdaemon "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+const (
+	dbChaosMsg         = "%s on the %s connections to port %d"
+	dbChaosFailoverMsg = "%s failover of the %s on port %d"
+)
+
+// Reconciler is db-chaos reconciler
+type Reconciler struct {
+	client.Client
+	record.EventRecorder
+	Log logr.Logger
+}
+
+// Reconcile reconciles a DBChaos resource
+func (r *Reconciler) Reconcile(req ctrl.Request, chaos *v1alpha1.DBChaos) (ctrl.Result, error) {
+	r.Log.Info("Reconciling dbchaos")
+	scheduler := chaos.GetScheduler()
+	duration, err := chaos.GetDuration()
+	if err != nil {
+		r.Log.Error(err, "unable to get the duration of chaos")
+		return ctrl.Result{}, err
+	}
+	if scheduler == nil && duration == nil {
+		return r.commonDBChaos(chaos, req)
+	} else if scheduler != nil && duration != nil {
+		return r.scheduleDBChaos(chaos, req)
+	}
+
+	// This should be ensured by admission webhook in the future
+	r.Log.Error(errors.New("spec invalid"), "scheduler and duration should be omitted or defined at the same time")
+	return ctrl.Result{}, fmt.Errorf("invalid scheduler and duration")
+}
+
+func (r *Reconciler) commonDBChaos(dbchaos *v1alpha1.DBChaos, req ctrl.Request) (ctrl.Result, error) {
+	cr := common.NewReconciler(r, r.Client, r.Log)
+	return cr.Reconcile(req)
+}
+
+func (r *Reconciler) scheduleDBChaos(dbchaos *v1alpha1.DBChaos, req ctrl.Request) (ctrl.Result, error) {
+	sr := twophase.NewReconciler(r, r.Client, r.Log)
+	return sr.Reconcile(req)
+}
+
+// Apply applies db-chaos
+func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	dbchaos, ok := chaos.(*v1alpha1.DBChaos)
+	if !ok {
+		err := errors.New("chaos is not dbchaos")
+		r.Log.Error(err, "chaos is not DBChaos", "chaos", chaos)
+		return err
+	}
+
+	// the failover is forced on the pods of the database, which aren't connected through the proxy
+	failover := dbchaos.Spec.Action == v1alpha1.DBFailoverAction
+	var addresses []string
+	if !failover {
+		var err error
+		addresses, err = r.resolveTarget(ctx, dbchaos)
+		if err != nil {
+			r.Log.Error(err, "failed to resolve the target of database")
+			return err
+		}
+	}
+
+	dbchaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	pods, err := utils.SelectAndRecordPods(ctx, r.Client, &dbchaos.Spec, dbchaos.Status.Experiment.Selection)
+	if err != nil {
+		r.Log.Error(err, "failed to select and filter pods")
+		return err
+	}
+	common.RecordImpact(ctx, r.Client, dbchaos, pods, false)
+
+	if failover {
+		err = r.failoverAllPods(ctx, pods, dbchaos)
+	} else {
+		err = r.applyAllPods(ctx, pods, addresses, dbchaos)
+	}
+	if err != nil {
+		r.Log.Error(err, "failed to apply chaos on all pods")
+		return err
+	}
+
+	r.Event(dbchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
+	return nil
+}
+
+// Recover means the reconciler recovers the chaos action
+func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	dbchaos, ok := chaos.(*v1alpha1.DBChaos)
+	if !ok {
+		err := errors.New("chaos is not DBChaos")
+		r.Log.Error(err, "chaos is not DBChaos", "chaos", chaos)
+		return err
+	}
+
+	if err := r.cleanFinalizersAndRecover(ctx, dbchaos); err != nil {
+		return err
+	}
+	r.Event(dbchaos, v1.EventTypeNormal, utils.EventChaosRecovered, "")
+
+	return nil
+}
+
+// Object would return the instance of chaos
+func (r *Reconciler) Object() v1alpha1.InnerObject {
+	return &v1alpha1.DBChaos{}
+}
+
+// resolveTarget resolves the addresses of the database, an empty result means all the addresses
+func (r *Reconciler) resolveTarget(ctx context.Context, chaos *v1alpha1.DBChaos) ([]string, error) {
+	addresses := append([]string{}, chaos.Spec.Target.Addresses...)
+	if chaos.Spec.Target.Service == nil {
+		return addresses, nil
+	}
+
+	cidrs, err := netutils.ResolveServices(ctx, r.Client, chaos.Namespace,
+		[]v1alpha1.ServiceReference{*chaos.Spec.Target.Service})
+	if err != nil {
+		return nil, err
+	}
+	// the connections to all the addresses would be affected if nothing is resolved
+	if len(cidrs) == 0 {
+		return nil, fmt.Errorf("service %s has neither cluster IP nor endpoints", chaos.Spec.Target.Service.Name)
+	}
+	return append(addresses, cidrs...), nil
+}
+
+func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, chaos *v1alpha1.DBChaos) error {
+	return common.RecoverPods(ctx, r.Client, chaos, func(ctx context.Context, pod *v1.Pod) error {
+		return r.recoverPod(ctx, pod, chaos)
+	})
+}
+
+func (r *Reconciler) recoverPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.DBChaos) error {
+	r.Log.Info("Try to recover pod", "namespace", pod.Namespace, "name", pod.Name)
+
+	if len(pod.Status.ContainerStatuses) == 0 {
+		return fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
+	}
+
+	pbClient, err := utils.NewChaosDaemonClient(ctx, r.Client, pod, common.ControllerCfg.ChaosDaemonPort)
+	if err != nil {
+		return err
+	}
+	defer pbClient.Close()
+
+	// the containers of a pod share the network namespace, so the proxy is started for the first one
+	_, err = pbClient.DeleteDBFault(ctx, &chaosdaemon.DBFaultRequest{
+		ContainerId: pod.Status.ContainerStatuses[0].ContainerID,
+		Protocol:    string(chaos.Spec.Protocol),
+		Port:        uint32(chaos.Spec.Target.Port),
+	})
+	if err != nil {
+		r.Log.Error(err, "recover pod error", "namespace", pod.Namespace, "name", pod.Name)
+		return err
+	}
+
+	r.Log.Info("Recover pod finished", "namespace", pod.Namespace, "name", pod.Name)
+	return nil
+}
+
+func (r *Reconciler) applyAllPods(ctx context.Context, pods []v1.Pod, addresses []string, chaos *v1alpha1.DBChaos) error {
+	for index := range pods {
+		key, err := cache.MetaNamespaceKeyFunc(&pods[index])
+		if err != nil {
+			return err
+		}
+		chaos.Finalizers = utils.InsertFinalizer(chaos.Finalizers, key)
+	}
+
+	return common.ApplyPods(ctx, chaos, pods, func(pod *v1.Pod) v1alpha1.PodStatus {
+		return v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Action:    string(chaos.Spec.Action),
+			Message:   fmt.Sprintf(dbChaosMsg, chaos.Spec.Action, chaos.Spec.Protocol, chaos.Spec.Target.Port),
+		}
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		return r.applyPod(ctx, pod, addresses, chaos)
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		return r.recoverPod(ctx, pod, chaos)
+	})
+}
+
+func (r *Reconciler) applyPod(ctx context.Context, pod *v1.Pod, addresses []string, chaos *v1alpha1.DBChaos) error {
+	r.Log.Info("Try to inject database fault on pod", "namespace", pod.Namespace, "name", pod.Name)
+
+	if len(pod.Status.ContainerStatuses) == 0 {
+		return fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
+	}
+
+	pbClient, err := utils.NewChaosDaemonClient(ctx, r.Client, pod, common.ControllerCfg.ChaosDaemonPort)
+	if err != nil {
+		return err
+	}
+	defer pbClient.Close()
+
+	if err := utils.CheckCapabilities(ctx, pbClient, pod.Spec.NodeName, utils.Requirement{
+		Action:            string(chaos.Spec.Action),
+		LinuxCapabilities: utils.NetworkCapabilities,
+		Methods:           []string{"SetDBFault", "DeleteDBFault"},
+	}); err != nil {
+		return err
+	}
+
+	fault, err := dbFault(chaos)
+	if err != nil {
+		return err
+	}
+	_, err = pbClient.SetDBFault(ctx, &chaosdaemon.DBFaultRequest{
+		ContainerId: pod.Status.ContainerStatuses[0].ContainerID,
+		Protocol:    string(chaos.Spec.Protocol),
+		Addresses:   addresses,
+		Port:        uint32(chaos.Spec.Target.Port),
+		Fault:       fault,
+	})
+	return err
+}
+
+// dbFault converts the spec of chaos into the fault injected by chaos-daemon
+func dbFault(chaos *v1alpha1.DBChaos) (*chaosdaemon.DBFault, error) {
+	fault := &chaosdaemon.DBFault{
+		Action:        string(chaos.Spec.Action),
+		Query:         chaos.Spec.Query,
+		Percent:       uint32(chaos.Spec.Percent),
+		InTransaction: chaos.Spec.InTransaction,
+	}
+	if chaos.Spec.Delay != "" {
+		delay, err := time.ParseDuration(chaos.Spec.Delay)
+		if err != nil {
+			return nil, err
+		}
+		fault.DelayNs = uint64(delay.Nanoseconds())
+	}
+	if chaos.Spec.Error != nil {
+		fault.ErrorCode = uint32(chaos.Spec.Error.Code)
+		fault.SqlState = chaos.Spec.Error.SQLState
+		fault.ErrorMessage = chaos.Spec.Error.Message
+	}
+	return fault, nil
+}
+
+// failoverAllPods forces the failover on the pods once, there is nothing to recover and
+// the pods aren't recorded in the finalizers
+func (r *Reconciler) failoverAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.DBChaos) error {
+	password, err := r.failoverPassword(ctx, chaos)
+	if err != nil {
+		return err
+	}
+
+	return common.ApplyPods(ctx, chaos, pods, func(pod *v1.Pod) v1alpha1.PodStatus {
+		return v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Action:    string(chaos.Spec.Action),
+			Message:   fmt.Sprintf(dbChaosFailoverMsg, chaos.Spec.Failover.Mode, chaos.Spec.Protocol, chaos.Spec.Target.Port),
+		}
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		return r.failoverPod(ctx, pod, password, chaos)
+	}, nil)
+}
+
+func (r *Reconciler) failoverPod(ctx context.Context, pod *v1.Pod, password string, chaos *v1alpha1.DBChaos) error {
+	r.Log.Info("Try to failover database on pod", "namespace", pod.Namespace, "name", pod.Name)
+
+	if len(pod.Status.ContainerStatuses) == 0 {
+		return fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
+	}
+
+	pbClient, err := utils.NewChaosDaemonClient(ctx, r.Client, pod, common.ControllerCfg.ChaosDaemonPort)
+	if err != nil {
+		return err
+	}
+	defer pbClient.Close()
+
+	if err := utils.CheckCapabilities(ctx, pbClient, pod.Spec.NodeName, utils.Requirement{
+		Action:            string(chaos.Spec.Action),
+		LinuxCapabilities: []string{utils.CapSysAdmin, utils.CapSysPtrace},
+		Methods:           []string{"FailoverDB"},
+	}); err != nil {
+		return err
+	}
+
+	_, err = pbClient.FailoverDB(ctx, &chaosdaemon.DBFailoverRequest{
+		ContainerId: pod.Status.ContainerStatuses[0].ContainerID,
+		Protocol:    string(chaos.Spec.Protocol),
+		Port:        uint32(chaos.Spec.Target.Port),
+		Mode:        string(chaos.Spec.Failover.Mode),
+		MasterName:  chaos.Spec.Failover.MasterName,
+		Password:    password,
+		Force:       chaos.Spec.Failover.Force,
+	})
+	return err
+}
+
+// failoverPassword reads the password of the database from the secret in the namespace of chaos
+func (r *Reconciler) failoverPassword(ctx context.Context, chaos *v1alpha1.DBChaos) (string, error) {
+	if chaos.Spec.Failover == nil {
+		return "", fmt.Errorf("failover is required by action %s", chaos.Spec.Action)
+	}
+	selector := chaos.Spec.Failover.PasswordSecret
+	if selector == nil {
+		return "", nil
+	}
+
+	var secret v1.Secret
+	key := types.NamespacedName{Namespace: chaos.Namespace, Name: selector.Name}
+	if err := r.Get(ctx, key, &secret); err != nil {
+		return "", err
+	}
+	data, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", fmt.Errorf("key %s is not found in secret %s/%s", selector.Key, chaos.Namespace, selector.Name)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
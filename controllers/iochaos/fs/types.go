@@ -35,7 +35,9 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
 	fscli "github.com/chaos-mesh/chaos-mesh/pkg/chaosfs/client"
+	"github.com/chaos-mesh/chaos-mesh/pkg/simulation"
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/webhook/inject"
 )
 
 type Reconciler struct {
@@ -82,11 +84,19 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 		return err
 	}
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, &iochaos.Spec)
+	iochaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	pods, err := utils.SelectAndRecordPods(ctx, r.Client, &iochaos.Spec, iochaos.Status.Experiment.Selection)
 	if err != nil {
 		r.Log.Error(err, "failed to select and filter pods")
 		return err
 	}
+	common.RecordImpact(ctx, r.Client, iochaos, pods, false)
+
+	// fail fast instead of retrying against the missing chaosfs sidecar
+	if err := inject.CheckInjected(pods); err != nil {
+		r.Log.Error(err, "the chaosfs sidecar isn't injected")
+		return err
+	}
 
 	if err := r.injectAllPods(ctx, pods, iochaos); err != nil {
 		return err
@@ -220,9 +230,19 @@ func (r *Reconciler) injectAllPods(ctx context.Context, pods []v1.Pod, iochaos *
 func (r *Reconciler) injectPod(ctx context.Context, pod *v1.Pod, iochaos *v1alpha1.IoChaos) error {
 	r.Log.Info("Inject I/O chaos action", "namespace", pod.Namespace, "name", pod.Name)
 
+	volumes, err := interceptedVolumes(ctx, r.Client, pod)
+	if err != nil {
+		r.Log.Error(err, "failed to get intercepted volumes", "namespace", pod.Namespace, "name", pod.Name)
+		return err
+	}
+	if err := checkVolumeCapabilities(volumes, iochaos.Spec.Methods); err != nil {
+		r.Log.Error(err, "volumes don't support the I/O chaos", "namespace", pod.Namespace, "name", pod.Name)
+		return err
+	}
+
 	cctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
 	defer cancel()
-	err := wait.PollUntil(2*time.Second, func() (bool, error) {
+	err = wait.PollUntil(2*time.Second, func() (bool, error) {
 		if err := r.injectAction(ctx, pod, iochaos); err != nil {
 			if utils.IsCaredNetError(err) {
 				r.Log.Info("Inject I/O chaos action, network is not ok, retrying...",
@@ -248,13 +268,11 @@ func (r *Reconciler) injectPod(ctx context.Context, pod *v1.Pod, iochaos *v1alph
 }
 
 func (r *Reconciler) injectAction(ctx context.Context, pod *v1.Pod, iochaos *v1alpha1.IoChaos) error {
-	// TODO: move to api repo
-	addr := iochaos.Spec.Addr
-	if addr == "" {
-		addr = v1alpha1.DefaultChaosfsAddr
+	if simulation.IsSimulated(ctx) {
+		return nil
 	}
 
-	addr = fmt.Sprintf("%s%s", pod.Status.PodIP, addr)
+	addr := chaosfsAddr(pod, iochaos)
 
 	cli, err := fscli.NewClient(addr)
 	if err != nil {
@@ -277,12 +295,11 @@ func (r *Reconciler) injectAction(ctx context.Context, pod *v1.Pod, iochaos *v1a
 }
 
 func (r *Reconciler) recoverInjectAction(ctx context.Context, pod *v1.Pod, iochaos *v1alpha1.IoChaos) error {
-	addr := iochaos.Spec.Addr
-	if addr == "" {
-		addr = v1alpha1.DefaultChaosfsAddr
+	if simulation.IsSimulated(ctx) {
+		return nil
 	}
 
-	addr = fmt.Sprintf("%s%s", pod.Status.PodIP, addr)
+	addr := chaosfsAddr(pod, iochaos)
 
 	cli, err := fscli.NewClient(addr)
 	if err != nil {
@@ -292,3 +309,13 @@ func (r *Reconciler) recoverInjectAction(ctx context.Context, pod *v1.Pod, iocha
 	_, err = cli.RecoverAll(ctx, &empty.Empty{})
 	return err
 }
+
+// chaosfsAddr returns the address of chaosfs in the pod. The addr of the chaos created before
+// the defaulting webhook sets it may be empty, so the default one is used.
+func chaosfsAddr(pod *v1.Pod, iochaos *v1alpha1.IoChaos) string {
+	addr := iochaos.Spec.Addr
+	if addr == "" {
+		addr = v1alpha1.DefaultChaosfsAddr
+	}
+	return fmt.Sprintf("%s%s", pod.Status.PodIP, addr)
+}
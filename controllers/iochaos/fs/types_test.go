@@ -0,0 +1,45 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestChaosfsAddr(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	pod := &v1.Pod{Status: v1.PodStatus{PodIP: "10.0.0.1"}}
+
+	type TestCase struct {
+		name string
+		addr string
+		want string
+	}
+
+	tcs := []TestCase{
+		{name: "addr set", addr: ":65533", want: "10.0.0.1:65533"},
+		{name: "addr of the chaos created before defaulting", addr: "", want: "10.0.0.1" + v1alpha1.DefaultChaosfsAddr},
+	}
+
+	for _, tc := range tcs {
+		iochaos := &v1alpha1.IoChaos{Spec: v1alpha1.IoChaosSpec{Addr: tc.addr}}
+		g.Expect(chaosfsAddr(pod, iochaos)).To(Equal(tc.want), tc.name)
+	}
+}
@@ -43,8 +43,9 @@ func genMessage(iochaos *v1alpha1.IoChaos) string {
 
 func genChaosfsRequest(iochaos *v1alpha1.IoChaos) (*fspb.Request, error) {
 	req := &fspb.Request{
-		Pct:  100,
-		Path: iochaos.Spec.Path,
+		Pct:   100,
+		Path:  iochaos.Spec.Path,
+		Scope: string(iochaos.Spec.PercentScope),
 	}
 
 	if iochaos.Spec.Percent != "" {
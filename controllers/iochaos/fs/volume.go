@@ -0,0 +1,146 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// volumeKind is the kind of the storage which backs a volume
+type volumeKind string
+
+const (
+	localVolume volumeKind = "local"
+	nfsVolume   volumeKind = "nfs"
+	csiVolume   volumeKind = "csi"
+	blockVolume volumeKind = "block"
+)
+
+// nfsCSIDrivers are the CSI drivers provisioning network file systems, which have the same limitations as NFS
+var nfsCSIDrivers = map[string]bool{
+	"nfs.csi.k8s.io":               true,
+	"efs.csi.aws.com":              true,
+	"filestore.csi.storage.gke.io": true,
+	"file.csi.azure.com":           true,
+	"cephfs.csi.ceph.com":          true,
+	"csi.trident.netapp.io":        true,
+	"fsx.openzfs.csi.aws.com":      true,
+	"nfs.manila.csi.openstack.org": true,
+	"csi-nfsplugin.k8s.io":         true,
+}
+
+// unsupportedMethods are the I/O methods which can't be injected on the kinds of volumes.
+// Extended attributes and fallocate aren't supported by NFSv3 and the NFSv4 before 4.2,
+// so the faults on them would never be triggered.
+var unsupportedMethods = map[volumeKind][]string{
+	nfsVolume: {"allocate", "getxattr", "listxattr", "removexattr", "setxattr"},
+}
+
+// interceptedVolume is a volume which is intercepted by the chaosfs sidecar
+type interceptedVolume struct {
+	name   string
+	kind   volumeKind
+	driver string
+}
+
+// interceptedVolumes returns the volumes mounted with bidirectional propagation, which are
+// mounted into the chaosfs sidecar to be intercepted by FUSE
+func interceptedVolumes(ctx context.Context, c client.Reader, pod *v1.Pod) ([]interceptedVolume, error) {
+	names := make(map[string]bool)
+	for _, container := range pod.Spec.Containers {
+		for _, mount := range container.VolumeMounts {
+			if mount.MountPropagation != nil && *mount.MountPropagation == v1.MountPropagationBidirectional {
+				names[mount.Name] = true
+			}
+		}
+	}
+
+	var volumes []interceptedVolume
+	for _, volume := range pod.Spec.Volumes {
+		if !names[volume.Name] {
+			continue
+		}
+
+		intercepted := interceptedVolume{name: volume.Name, kind: localVolume}
+		switch {
+		case volume.NFS != nil:
+			intercepted.kind = nfsVolume
+		case volume.CSI != nil:
+			intercepted.kind, intercepted.driver = csiKind(volume.CSI.Driver), volume.CSI.Driver
+		case volume.PersistentVolumeClaim != nil:
+			pv, err := boundVolume(ctx, c, pod.Namespace, volume.PersistentVolumeClaim.ClaimName)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case pv.Spec.VolumeMode != nil && *pv.Spec.VolumeMode == v1.PersistentVolumeBlock:
+				intercepted.kind = blockVolume
+			case pv.Spec.NFS != nil:
+				intercepted.kind = nfsVolume
+			case pv.Spec.CSI != nil:
+				intercepted.kind, intercepted.driver = csiKind(pv.Spec.CSI.Driver), pv.Spec.CSI.Driver
+			}
+		}
+		volumes = append(volumes, intercepted)
+	}
+
+	return volumes, nil
+}
+
+func csiKind(driver string) volumeKind {
+	if nfsCSIDrivers[driver] {
+		return nfsVolume
+	}
+	return csiVolume
+}
+
+// boundVolume returns the persistent volume bound to the claim
+func boundVolume(ctx context.Context, c client.Reader, namespace, claim string) (*v1.PersistentVolume, error) {
+	var pvc v1.PersistentVolumeClaim
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: claim}, &pvc); err != nil {
+		return nil, err
+	}
+	if pvc.Spec.VolumeName == "" {
+		return nil, fmt.Errorf("persistent volume claim %s/%s isn't bound", namespace, claim)
+	}
+
+	var pv v1.PersistentVolume
+	if err := c.Get(ctx, types.NamespacedName{Name: pvc.Spec.VolumeName}, &pv); err != nil {
+		return nil, err
+	}
+	return &pv, nil
+}
+
+// checkVolumeCapabilities checks the I/O methods can be injected on the intercepted volumes
+func checkVolumeCapabilities(volumes []interceptedVolume, methods []string) error {
+	for _, volume := range volumes {
+		if volume.kind == blockVolume {
+			return fmt.Errorf("volume %s is in block mode, which can't be intercepted in the fs layer", volume.name)
+		}
+
+		for _, unsupported := range unsupportedMethods[volume.kind] {
+			for _, method := range methods {
+				if method == unsupported {
+					return fmt.Errorf("method %s isn't supported on %s volume %s", method, volume.kind, volume.name)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestInterceptedVolumes(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	bidirectional := v1.MountPropagationBidirectional
+	block := v1.PersistentVolumeBlock
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "chaosfs",
+				VolumeMounts: []v1.VolumeMount{
+					{Name: "data", MountPropagation: &bidirectional},
+					{Name: "shared", MountPropagation: &bidirectional},
+					{Name: "raw", MountPropagation: &bidirectional},
+					{Name: "inline", MountPropagation: &bidirectional},
+					{Name: "config"},
+				},
+			}},
+			Volumes: []v1.Volume{
+				{Name: "data", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+				{Name: "shared", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "shared"}}},
+				{Name: "raw", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "raw"}}},
+				{Name: "inline", VolumeSource: v1.VolumeSource{CSI: &v1.CSIVolumeSource{Driver: "pd.csi.storage.gke.io"}}},
+				{Name: "config", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{}}},
+			},
+		},
+	}
+	objs := []runtime.Object{
+		&v1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "shared"},
+			Spec:       v1.PersistentVolumeClaimSpec{VolumeName: "pv-shared"},
+		},
+		&v1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-shared"},
+			Spec: v1.PersistentVolumeSpec{PersistentVolumeSource: v1.PersistentVolumeSource{
+				CSI: &v1.CSIPersistentVolumeSource{Driver: "efs.csi.aws.com"},
+			}},
+		},
+		&v1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "raw"},
+			Spec:       v1.PersistentVolumeClaimSpec{VolumeName: "pv-raw"},
+		},
+		&v1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-raw"},
+			Spec:       v1.PersistentVolumeSpec{VolumeMode: &block},
+		},
+	}
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, objs...)
+
+	volumes, err := interceptedVolumes(context.TODO(), c, pod)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(volumes).To(Equal([]interceptedVolume{
+		{name: "data", kind: localVolume},
+		{name: "shared", kind: nfsVolume, driver: "efs.csi.aws.com"},
+		{name: "raw", kind: blockVolume},
+		{name: "inline", kind: csiVolume, driver: "pd.csi.storage.gke.io"},
+	}))
+
+	g.Expect(checkVolumeCapabilities(volumes[:2], []string{"read", "write"})).ShouldNot(HaveOccurred())
+	g.Expect(checkVolumeCapabilities(volumes[:2], []string{"setxattr"})).To(MatchError("method setxattr isn't supported on nfs volume shared"))
+	g.Expect(checkVolumeCapabilities(volumes, nil)).To(MatchError("volume raw is in block mode, which can't be intercepted in the fs layer"))
+	g.Expect(checkVolumeCapabilities(volumes[3:], []string{"setxattr"})).ShouldNot(HaveOccurred())
+
+	pod.Spec.Volumes[1].PersistentVolumeClaim.ClaimName = "missing"
+	_, err = interceptedVolumes(context.TODO(), c, pod)
+	g.Expect(err).Should(HaveOccurred())
+}
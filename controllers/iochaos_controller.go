@@ -21,8 +21,10 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	"github.com/chaos-mesh/chaos-mesh/controllers/iochaos"
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 )
@@ -39,18 +41,18 @@ type IoChaosReconciler struct {
 
 // Reconcile reconciles an IOChaos resource
 func (r *IoChaosReconciler) Reconcile(req ctrl.Request) (result ctrl.Result, err error) {
-	logger := r.Log.WithValues("iochaos", req.NamespacedName)
+	chaos := &v1alpha1.IoChaos{}
+	if err := r.Get(context.Background(), req.NamespacedName, chaos); err != nil {
+		r.Log.Error(err, "unable to get iochaos")
+		return ctrl.Result{}, nil
+	}
 
+	logger := common.ExperimentLogger(r.Log, chaos).WithValues("reconciler", "iochaos")
 	reconciler := iochaos.Reconciler{
 		Client:        r.Client,
 		EventRecorder: r.EventRecorder,
 		Log:           logger,
 	}
-	chaos := &v1alpha1.IoChaos{}
-	if err := r.Get(context.Background(), req.NamespacedName, chaos); err != nil {
-		r.Log.Error(err, "unable to get iochaos")
-		return ctrl.Result{}, nil
-	}
 
 	result, err = reconciler.Reconcile(req, chaos)
 	if err != nil {
@@ -66,5 +68,9 @@ func (r *IoChaosReconciler) Reconcile(req ctrl.Request) (result ctrl.Result, err
 func (r *IoChaosReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.IoChaos{}).
+		WithEventFilter(common.ShardPredicate()).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: common.ControllerCfg.ConcurrentReconcilesOf(v1alpha1.KindIOChaos),
+		}).
 		Complete(r)
 }
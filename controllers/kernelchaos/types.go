@@ -58,11 +58,12 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		r.Log.Error(err, "unable to get kernelChaos")
 		return ctrl.Result{}, nil
 	}
+	r.Log = common.ExperimentLogger(r.Log, &kernelChaos)
 
 	scheduler := kernelChaos.GetScheduler()
 	duration, err := kernelChaos.GetDuration()
 	if err != nil {
-		r.Log.Error(err, fmt.Sprintf("unable to get kernelChaos[%s/%s]'s duration", kernelChaos.Namespace, kernelChaos.Name))
+		r.Log.Error(err, "unable to get the duration of chaos")
 		return ctrl.Result{}, nil
 	}
 	if scheduler == nil && duration == nil {
@@ -72,7 +73,7 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	}
 
 	// This should be ensured by admission webhook in the future
-	r.Log.Error(fmt.Errorf("kernelChaos[%s/%s] spec invalid", kernelChaos.Namespace, kernelChaos.Name), "scheduler and duration should be omitted or defined at the same time")
+	r.Log.Error(errors.New("spec invalid"), "scheduler and duration should be omitted or defined at the same time")
 	return ctrl.Result{}, nil
 }
 
@@ -95,11 +96,13 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 		return err
 	}
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, &kernelChaos.Spec)
+	kernelChaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	pods, err := utils.SelectAndRecordPods(ctx, r.Client, &kernelChaos.Spec, kernelChaos.Status.Experiment.Selection)
 	if err != nil {
 		r.Log.Error(err, "failed to select and filter pods")
 		return err
 	}
+	common.RecordImpact(ctx, r.Client, kernelChaos, pods, false)
 
 	if err = r.applyAllPods(ctx, pods, kernelChaos); err != nil {
 		r.Log.Error(err, "failed to apply chaos on all pods")
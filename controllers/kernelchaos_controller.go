@@ -17,11 +17,13 @@ import (
 	"github.com/go-logr/logr"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	"github.com/chaos-mesh/chaos-mesh/controllers/kernelchaos"
 
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 )
 
 // KernelChaosReconciler reconciles a KernelChaos object
@@ -51,5 +53,9 @@ func (r *KernelChaosReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 func (r *KernelChaosReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.KernelChaos{}).
+		WithEventFilter(common.ShardPredicate()).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: common.ControllerCfg.ConcurrentReconcilesOf(v1alpha1.KindKernelChaos),
+		}).
 		Complete(r)
 }
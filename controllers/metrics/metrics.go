@@ -17,6 +17,8 @@ import (
 	"context"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 
@@ -29,6 +31,9 @@ var log = ctrl.Log.WithName("metrics-collector")
 type ChaosCollector struct {
 	store               cache.Cache
 	experimentStatus    *prometheus.GaugeVec
+	injectedTargets     *prometheus.GaugeVec
+	selectedPods        *prometheus.GaugeVec
+	deprecatedChaos     *prometheus.GaugeVec
 	SidecarTemplates    prometheus.Gauge
 	ConfigTemplates     *prometheus.GaugeVec
 	InjectionConfigs    *prometheus.GaugeVec
@@ -37,6 +42,7 @@ type ChaosCollector struct {
 	ConfigNameDuplicate *prometheus.CounterVec
 	InjectRequired      *prometheus.CounterVec
 	Injections          *prometheus.CounterVec
+	DeprecatedUsages    *prometheus.CounterVec
 }
 
 // NewChaosCollector initializes metrics and collector
@@ -47,6 +53,18 @@ func NewChaosCollector(store cache.Cache, registerer prometheus.Registerer) *Cha
 			Name: "chaos_mesh_experiments",
 			Help: "Total number of chaos experiments and their phases",
 		}, []string{"namespace", "kind", "phase"}),
+		injectedTargets: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "chaos_mesh_injected_targets",
+			Help: "Total number of pods which are currently injected by running chaos experiments",
+		}, []string{"namespace", "kind"}),
+		selectedPods: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "chaos_mesh_experiment_selected_pods",
+			Help: "Number of pods matched, filtered by namespace policy, protected and selected by the selector of each unfinished experiment in the last attempt",
+		}, []string{"namespace", "kind", "name", "selector", "outcome"}),
+		deprecatedChaos: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "chaos_mesh_deprecated_chaos",
+			Help: "Number of existing chaos experiments which use the deprecated fields or actions",
+		}, []string{"namespace", "kind", "field"}),
 		SidecarTemplates: prometheus.NewGauge(prometheus.GaugeOpts{
 			Name: "chaos_mesh_templates",
 			Help: "Total number of injection templates",
@@ -79,6 +97,10 @@ func NewChaosCollector(store cache.Cache, registerer prometheus.Registerer) *Cha
 			Name: "chaos_mesh_injections_total",
 			Help: "Total number of sidecar injections performed on the webhook",
 		}, []string{"namespace", "config"}),
+		DeprecatedUsages: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "chaos_mesh_deprecated_usages_total",
+			Help: "Total number of deprecated fields or actions used in the chaos admitted by the webhook",
+		}, []string{"namespace", "kind", "field"}),
 	}
 	registerer.MustRegister(c)
 	return c
@@ -87,6 +109,8 @@ func NewChaosCollector(store cache.Cache, registerer prometheus.Registerer) *Cha
 // Describe implements the prometheus.Collector interface.
 func (c *ChaosCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.experimentStatus.Describe(ch)
+	c.injectedTargets.Describe(ch)
+	c.selectedPods.Describe(ch)
 	c.SidecarTemplates.Describe(ch)
 	c.ConfigTemplates.Describe(ch)
 	c.InjectionConfigs.Describe(ch)
@@ -95,6 +119,8 @@ func (c *ChaosCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.TemplateLoadError.Describe(ch)
 	c.InjectRequired.Describe(ch)
 	c.Injections.Describe(ch)
+	c.DeprecatedUsages.Describe(ch)
+	c.deprecatedChaos.Describe(ch)
 }
 
 // Collect implements the prometheus.Collector interface.
@@ -108,13 +134,20 @@ func (c *ChaosCollector) Collect(ch chan<- prometheus.Metric) {
 	c.TemplateLoadError.Collect(ch)
 	c.InjectRequired.Collect(ch)
 	c.Injections.Collect(ch)
+	c.DeprecatedUsages.Collect(ch)
 	c.experimentStatus.Collect(ch)
+	c.injectedTargets.Collect(ch)
+	c.selectedPods.Collect(ch)
+	c.deprecatedChaos.Collect(ch)
 }
 
 func (c *ChaosCollector) collect() {
 	// TODO(yeya24) if there is an error in List
 	// the experiment status will be lost
 	c.experimentStatus.Reset()
+	c.injectedTargets.Reset()
+	c.selectedPods.Reset()
+	c.deprecatedChaos.Reset()
 
 	for kind, obj := range v1alpha1.AllKinds() {
 		expCache := map[string]map[string]int{}
@@ -135,5 +168,57 @@ func (c *ChaosCollector) collect() {
 				c.experimentStatus.WithLabelValues(ns, kind, phase).Set(float64(count))
 			}
 		}
+
+		items, err := meta.ExtractList(obj.ChaosList)
+		if err != nil {
+			log.Error(err, "failed to extract chaos list", "kind", kind)
+			return
+		}
+		targetCache := map[string]int{}
+		for _, item := range items {
+			if deprecator, ok := item.(v1alpha1.Deprecator); ok {
+				namespace := item.(metav1.Object).GetNamespace()
+				for _, usage := range deprecator.DeprecatedUsages() {
+					c.deprecatedChaos.WithLabelValues(namespace, kind, usage.Field).Inc()
+				}
+			}
+
+			chaos, ok := item.(v1alpha1.InnerObject)
+			if !ok {
+				continue
+			}
+			experiment := chaos.GetStatus().Experiment
+			if experiment.Phase != v1alpha1.ExperimentPhaseFinished {
+				instance := chaos.GetChaos()
+				c.setSelection(instance, "default", experiment.Selection)
+				if networkchaos, ok := item.(*v1alpha1.NetworkChaos); ok {
+					c.setSelection(instance, "target", networkchaos.Status.TargetSelection)
+				}
+			}
+			if experiment.Phase != v1alpha1.ExperimentPhaseRunning {
+				continue
+			}
+			targetCache[chaos.GetChaos().Namespace] += len(experiment.PodRecords)
+		}
+
+		for ns, count := range targetCache {
+			c.injectedTargets.WithLabelValues(ns, kind).Set(float64(count))
+		}
+	}
+}
+
+func (c *ChaosCollector) setSelection(chaos *v1alpha1.ChaosInstance, selector string, selection *v1alpha1.SelectionStatus) {
+	if selection == nil {
+		return
+	}
+
+	outcomes := map[string]int{
+		"matched":               selection.Matched,
+		"filtered_by_namespace": selection.FilteredByNamespace,
+		"protected":             selection.Protected,
+		"selected":              selection.Selected,
+	}
+	for outcome, count := range outcomes {
+		c.selectedPods.WithLabelValues(chaos.Namespace, chaos.Kind, chaos.Name, selector, outcome).Set(float64(count))
 	}
 }
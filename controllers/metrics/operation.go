@@ -0,0 +1,45 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	controllermetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// OperationDuration observes the latency of injections and recoveries of chaos
+	OperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "chaos_mesh_operation_duration_seconds",
+		Help:    "Latency of chaos injections and recoveries",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 3, 6, 10, 30, 60},
+	}, []string{"kind", "operation", "outcome"})
+
+	// SelectedPods observes the number of pods selected by every injection of chaos
+	SelectedPods = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "chaos_mesh_selected_pods",
+		Help:    "Number of pods selected by chaos injections",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+	}, []string{"kind"})
+
+	// AuditRecordsDropped counts the audit records dropped as the queue of the auditor is full
+	AuditRecordsDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "chaos_mesh_audit_records_dropped_total",
+		Help: "Number of audit records dropped as the audit queue is full",
+	}, []string{"kind", "operation"})
+)
+
+func init() {
+	controllermetrics.Registry.MustRegister(OperationDuration, SelectedPods, AuditRecordsDropped)
+}
@@ -0,0 +1,183 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bpf
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+const (
+	// NodeLabel is the label of the nodes which choose the backend of NetworkChaos
+	NodeLabel = "chaos-mesh.org/network-backend"
+	// Backend is the value of NodeLabel which chooses the tc-bpf backend
+	Backend = "bpf"
+
+	// FinalizerPrefix is the prefix of the finalizers of the pods injected by the tc-bpf backend
+	FinalizerPrefix = "tcbpf-"
+)
+
+var log = ctrl.Log.WithName("networkchaos-bpf")
+
+// Requirement returns what the node must support to inject the faults by the tc-bpf backend,
+// the delay is respected by the fq qdisc
+func Requirement(action string, delay bool) utils.Requirement {
+	requirement := utils.Requirement{
+		Action:            action,
+		Modules:           []string{"cls_bpf"},
+		BPF:               true,
+		KernelFeatures:    []string{utils.KernelFeatureBPFSyscall, utils.KernelFeatureNetClsAct, utils.KernelFeatureNetSchIngress},
+		LinuxCapabilities: utils.NetworkCapabilities,
+		Methods:           []string{"SetBpfFault"},
+	}
+	if delay {
+		requirement.Modules = append(requirement.Modules, "sch_fq")
+	}
+	return requirement
+}
+
+// Split splits the pods into the ones on the nodes which choose the tc-bpf backend and the others.
+// All pods are the others unless the backend is enabled by the feature gate. The pods on the nodes
+// which choose the backend but can't meet the requirement, such as the arm64 nodes whose kernels are
+// built without cls_bpf, are the others too, so they're injected by tc and iptables.
+func Split(ctx context.Context, c client.Client, pods []v1.Pod, requirement utils.Requirement) (bpfPods []v1.Pod, others []v1.Pod, err error) {
+	if !common.ControllerCfg.BPFNetworkBackend {
+		return nil, pods, nil
+	}
+
+	chosen := make(map[string]bool)
+	for index := range pods {
+		pod := pods[index]
+		nodeName := pod.Spec.NodeName
+		if _, ok := chosen[nodeName]; !ok {
+			var node v1.Node
+			if err := c.Get(ctx, types.NamespacedName{Name: nodeName}, &node); err != nil {
+				return nil, nil, err
+			}
+			chosen[nodeName] = node.Labels[NodeLabel] == Backend && capable(ctx, c, &pods[index], requirement)
+		}
+
+		if chosen[nodeName] {
+			bpfPods = append(bpfPods, pod)
+		} else {
+			others = append(others, pod)
+		}
+	}
+	return bpfPods, others, nil
+}
+
+// capable returns false if the chaos-daemon on the node of the pod reports that the node can't
+// meet the requirement. It returns true if the capabilities are unknown, then the errors of injection
+// are reported as usual.
+func capable(ctx context.Context, c client.Client, pod *v1.Pod, requirement utils.Requirement) bool {
+	daemon, err := utils.NewChaosDaemonClient(ctx, c, pod, common.ControllerCfg.ChaosDaemonPort)
+	if err != nil {
+		return true
+	}
+	defer daemon.Close()
+
+	reason, err := utils.UnsupportedReason(ctx, daemon, pod.Spec.NodeName, requirement)
+	if err != nil || reason == "" {
+		return true
+	}
+	log.Info("node can't support the bpf backend, fall back to tc", "node", pod.Spec.NodeName, "reason", reason)
+	return false
+}
+
+// Supported returns true if the netem can be injected by the tc-bpf backend,
+// which only supports delay with jitter and loss without correlations
+func Supported(netem *pb.Netem) bool {
+	if netem.Time == 0 && netem.Loss == 0 {
+		return false
+	}
+	return netem.Jitter <= netem.Time && netem.DelayCorr == 0 && netem.LossCorr == 0 &&
+		netem.Duplicate == 0 && netem.Reorder == 0 && netem.Corrupt == 0
+}
+
+// FromNetem converts the netem to the fault of the tc-bpf backend, which is applied on
+// the egress packets to the cidrs, or all egress packets if there are no cidrs
+func FromNetem(netem *pb.Netem, device string, cidrs []string) *pb.BpfFaultRequest {
+	return &pb.BpfFaultRequest{
+		Device:      device,
+		Delay:       netem.Time,
+		Jitter:      netem.Jitter,
+		Loss:        netem.Loss,
+		EgressCidrs: cidrs,
+	}
+}
+
+// BatchSet injects the faults by tc-bpf programs on the pods, the pods on the same node
+// are handled in one connection. The fault of each pod is returned by faultOf.
+func BatchSet(ctx context.Context, c client.Client, pods []*v1.Pod, action string, faultOf func(pod *v1.Pod) *pb.BpfFaultRequest) []error {
+	faults := make(map[string]*pb.BpfFaultRequest, len(pods))
+	for _, pod := range pods {
+		if len(pod.Status.ContainerStatuses) > 0 {
+			faults[pod.Status.ContainerStatuses[0].ContainerID] = faultOf(pod)
+		}
+	}
+
+	return utils.BatchByNode(ctx, c, pods, common.ControllerCfg.ChaosDaemonPort,
+		func(ctx context.Context, pbClient utils.ChaosDaemonClientInterface, node string, containerIDs []string) (*pb.BatchResponse, error) {
+			delay := false
+			for _, containerID := range containerIDs {
+				if faults[containerID].Delay > 0 {
+					delay = true
+					break
+				}
+			}
+			requirement := Requirement(action, delay)
+			if err := utils.CheckCapabilities(ctx, pbClient, node, requirement); err != nil {
+				return nil, err
+			}
+
+			resp := &pb.BatchResponse{Errors: make([]string, len(containerIDs))}
+			for i, containerID := range containerIDs {
+				fault := *faults[containerID]
+				fault.ContainerId = containerID
+				if _, err := pbClient.SetBpfFault(ctx, &fault); err != nil {
+					resp.Errors[i] = err.Error()
+				}
+			}
+			return resp, nil
+		})
+}
+
+// BatchDelete removes the faults of tc-bpf programs from the pods
+func BatchDelete(ctx context.Context, c client.Client, pods []*v1.Pod, device string) []error {
+	return utils.BatchByNode(ctx, c, pods, common.ControllerCfg.ChaosDaemonPort,
+		func(ctx context.Context, pbClient utils.ChaosDaemonClientInterface, _ string, containerIDs []string) (*pb.BatchResponse, error) {
+			resp := &pb.BatchResponse{Errors: make([]string, len(containerIDs))}
+			for i, containerID := range containerIDs {
+				_, err := pbClient.DeleteBpfFault(ctx, &pb.BpfFaultRequest{
+					ContainerId: containerID,
+					Device:      device,
+				})
+				// the chaos-daemon which doesn't support the backend has injected nothing
+				if err != nil && status.Code(err) != codes.Unimplemented {
+					resp.Errors[i] = err.Error()
+				}
+			}
+			return resp, nil
+		})
+}
@@ -0,0 +1,116 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bpf
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// capabilityServer is the chaos-daemon which only reports the capabilities
+type capabilityServer struct {
+	pb.ChaosDaemonServer
+	caps *pb.CapabilitiesResponse
+}
+
+func (s *capabilityServer) GetCapabilities(context.Context, *empty.Empty) (*pb.CapabilitiesResponse, error) {
+	return s.caps, nil
+}
+
+func TestSplit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	daemon := &capabilityServer{caps: &pb.CapabilitiesResponse{
+		KernelVersion: "5.4.0",
+		Arch:          "amd64",
+		Modules:       []string{"sch_netem", "sch_fq", "cls_bpf"},
+		Bpf:           true,
+	}}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ToNot(HaveOccurred())
+	server := grpc.NewServer()
+	pb.RegisterChaosDaemonServer(server, daemon)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	port := common.ControllerCfg.ChaosDaemonPort
+	common.ControllerCfg.ChaosDaemonPort = listener.Addr().(*net.TCPAddr).Port
+	defer func() { common.ControllerCfg.ChaosDaemonPort = port }()
+
+	local := v1.NodeStatus{Addresses: []v1.NodeAddress{{Type: v1.NodeInternalIP, Address: "127.0.0.1"}}}
+	c := fake.NewFakeClientWithScheme(scheme.Scheme,
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1", Labels: map[string]string{NodeLabel: Backend}}, Status: local},
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n2"}, Status: local},
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n3", Labels: map[string]string{NodeLabel: Backend}}, Status: local},
+	)
+	pods := []v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "p1"}, Spec: v1.PodSpec{NodeName: "n1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "p2"}, Spec: v1.PodSpec{NodeName: "n2"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "p3"}, Spec: v1.PodSpec{NodeName: "n1"}},
+	}
+
+	requirement := Requirement("delay", true)
+
+	bpfPods, others, err := Split(context.TODO(), c, pods, requirement)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bpfPods).To(BeEmpty())
+	g.Expect(others).To(HaveLen(3))
+
+	common.ControllerCfg.BPFNetworkBackend = true
+	defer func() { common.ControllerCfg.BPFNetworkBackend = false }()
+
+	bpfPods, others, err = Split(context.TODO(), c, pods, requirement)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bpfPods).To(HaveLen(2))
+	g.Expect(bpfPods[1].Name).To(Equal("p3"))
+	g.Expect(others).To(HaveLen(1))
+	g.Expect(others[0].Name).To(Equal("p2"))
+
+	// the pods on the node whose kernel can't support the backend fall back to tc
+	daemon.caps = &pb.CapabilitiesResponse{
+		KernelVersion: "5.4.0",
+		Arch:          "arm64",
+		Modules:       []string{"sch_netem", "sch_fq"},
+		Bpf:           true,
+	}
+	bpfPods, others, err = Split(context.TODO(), c, []v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "p4"}, Spec: v1.PodSpec{NodeName: "n3"}},
+	}, requirement)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bpfPods).To(BeEmpty())
+	g.Expect(others).To(HaveLen(1))
+}
+
+func TestSupported(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(Supported(&pb.Netem{Time: 10000, Jitter: 1000, Loss: 10})).To(BeTrue())
+	g.Expect(Supported(&pb.Netem{Loss: 10, Limit: 1000})).To(BeTrue())
+	g.Expect(Supported(&pb.Netem{Time: 10000, DelayCorr: 25})).To(BeFalse())
+	g.Expect(Supported(&pb.Netem{Time: 1000, Jitter: 10000})).To(BeFalse())
+	g.Expect(Supported(&pb.Netem{Duplicate: 10})).To(BeFalse())
+	g.Expect(Supported(&pb.Netem{})).To(BeFalse())
+}
@@ -17,8 +17,10 @@ import (
 	"context"
 	"crypto/sha1"
 	"fmt"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
@@ -49,12 +51,43 @@ func BuildIPSet(pods []v1.Pod, externalCidrs []string, networkchaos *v1alpha1.Ne
 	}
 }
 
-// GenerateIPSetName generates name for ipset
+// NewTargetIPSet records the ipset of the targets and the pods it's flushed on, so that it can be
+// flushed again when the endpoints of the target services change
+func NewTargetIPSet(set *pb.IpSet, serviceCidrs []string, pods []*v1.Pod) (*v1alpha1.TargetIPSet, error) {
+	target := &v1alpha1.TargetIPSet{
+		Name:         set.Name,
+		Cidrs:        append([]string(nil), set.Cidrs...),
+		ServiceCidrs: append([]string(nil), serviceCidrs...),
+	}
+	for _, pod := range pods {
+		key, err := cache.MetaNamespaceKeyFunc(pod)
+		if err != nil {
+			return nil, err
+		}
+		target.Pods = append(target.Pods, key)
+	}
+	return target, nil
+}
+
+// GenerateIPSetName generates name for ipset. The uid of chaos is encoded in the name,
+// so the ipsets left on the nodes can be traced back to the experiment
 func GenerateIPSetName(networkchaos *v1alpha1.NetworkChaos, namePostFix string) string {
 	originalName := networkchaos.Name
+	uid := strings.ReplaceAll(string(networkchaos.UID), "-", "")
 
 	var ipsetName string
-	if len(originalName) < 6 {
+	if uid != "" {
+		namePrefix := originalName
+		if len(namePrefix) > 5 {
+			namePrefix = namePrefix[0:5]
+		}
+		uidLen := ipsetLen - 7 - len(namePostFix)
+		if uidLen > len(uid) {
+			uidLen = len(uid)
+		}
+
+		ipsetName = namePrefix + "_" + uid[0:uidLen] + "_" + namePostFix
+	} else if len(originalName) < 6 {
 		ipsetName = originalName + "_" + namePostFix
 	} else {
 		namePrefix := originalName[0:5]
@@ -71,6 +104,23 @@ func GenerateIPSetName(networkchaos *v1alpha1.NetworkChaos, namePostFix string)
 	return ipsetName
 }
 
+// NameOf returns the name of the ipset with the postfix which is referred by the rules on the pod.
+// The name recorded in the status is preferred, because the ipsets injected by the earlier versions
+// aren't named after the uid of chaos.
+func NameOf(networkchaos *v1alpha1.NetworkChaos, namespace, name, namePostFix string) string {
+	for _, rules := range networkchaos.Status.Rules {
+		if rules.Namespace != namespace || rules.Name != name {
+			continue
+		}
+		for _, set := range rules.IPSets {
+			if strings.HasSuffix(set.Name, "_"+namePostFix) {
+				return set.Name
+			}
+		}
+	}
+	return GenerateIPSetName(networkchaos, namePostFix)
+}
+
 // FlushIpSet makes grpc calls to chaosdaemon to save ipset
 func FlushIpSet(ctx context.Context, c client.Client, pod *v1.Pod, ipset *pb.IpSet) error {
 	pbClient, err := utils.NewChaosDaemonClient(ctx, c, pod, common.ControllerCfg.ChaosDaemonPort)
@@ -91,3 +141,28 @@ func FlushIpSet(ctx context.Context, c client.Client, pod *v1.Pod, ipset *pb.IpS
 	})
 	return err
 }
+
+// BatchFlushIpSet flushes ipset on pods, the pods on the same node are handled in one batch call
+func BatchFlushIpSet(ctx context.Context, c client.Client, pods []*v1.Pod, ipset *pb.IpSet) error {
+	errs := utils.BatchByNode(ctx, c, pods, common.ControllerCfg.ChaosDaemonPort,
+		func(ctx context.Context, pbClient utils.ChaosDaemonClientInterface, node string, containerIDs []string) (*pb.BatchResponse, error) {
+			if err := utils.CheckCapabilities(ctx, pbClient, node, utils.Requirement{
+				Action:            "partition",
+				LinuxCapabilities: utils.NetworkCapabilities,
+				Methods:           []string{"BatchFlushIpSet"},
+			}); err != nil {
+				return nil, err
+			}
+
+			req := &pb.BatchIpSetRequest{}
+			for _, containerID := range containerIDs {
+				req.Requests = append(req.Requests, &pb.IpSetRequest{
+					Ipset:       ipset,
+					ContainerId: containerID,
+				})
+			}
+			return pbClient.BatchFlushIpSet(ctx, req)
+		})
+
+	return utils.MergeErrors(errs)
+}
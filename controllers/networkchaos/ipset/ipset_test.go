@@ -52,3 +52,39 @@ func Test_generateIpSetName(t *testing.T) {
 		g.Expect(len(name)).Should(Equal(27))
 	})
 }
+
+func Test_generateIpSetNameWithUID(t *testing.T) {
+	g := NewWithT(t)
+
+	networkChaos := &cmv1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-metav1object",
+			UID:  "6f1c2a3b-4d5e-4f60-8a9b-0c1d2e3f4a5b",
+		},
+	}
+
+	name := GenerateIPSetName(networkChaos, "tgt")
+	g.Expect(name).Should(Equal("test-_6f1c2a3b4d5e4f608_tgt"))
+	g.Expect(len(name)).Should(Equal(27))
+
+	networkChaos.Name = "test"
+	g.Expect(GenerateIPSetName(networkChaos, "src")).Should(Equal("test_6f1c2a3b4d5e4f608_src"))
+}
+
+func TestNameOf(t *testing.T) {
+	g := NewWithT(t)
+
+	networkChaos := &cmv1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+			UID:  "6f1c2a3b-4d5e-4f60-8a9b-0c1d2e3f4a5b",
+		},
+	}
+	rules := networkChaos.Status.RulesOf("default", "app")
+	rules.IPSets = []cmv1alpha1.IPSetSummary{{Name: "test_tgt"}}
+
+	// the ipset injected before the uid is encoded in the name
+	g.Expect(NameOf(networkChaos, "default", "app", "tgt")).Should(Equal("test_tgt"))
+	g.Expect(NameOf(networkChaos, "default", "app", "src")).Should(Equal("test_6f1c2a3b4d5e4f608_src"))
+	g.Expect(NameOf(networkChaos, "default", "other", "tgt")).Should(Equal("test_6f1c2a3b4d5e4f608_tgt"))
+}
@@ -46,6 +46,31 @@ func FlushIptables(ctx context.Context, c client.Client, pod *v1.Pod, rule *pb.R
 	return err
 }
 
+// BatchFlushIptables flushes iptables on pods, the pods on the same node are handled in one batch call
+func BatchFlushIptables(ctx context.Context, c client.Client, pods []*v1.Pod, rule *pb.Rule) error {
+	errs := utils.BatchByNode(ctx, c, pods, common.ControllerCfg.ChaosDaemonPort,
+		func(ctx context.Context, pbClient utils.ChaosDaemonClientInterface, node string, containerIDs []string) (*pb.BatchResponse, error) {
+			if err := utils.CheckCapabilities(ctx, pbClient, node, utils.Requirement{
+				Action:            "partition",
+				LinuxCapabilities: utils.NetworkCapabilities,
+				Methods:           []string{"BatchFlushIptables"},
+			}); err != nil {
+				return nil, err
+			}
+
+			req := &pb.BatchIpTablesRequest{}
+			for _, containerID := range containerIDs {
+				req.Requests = append(req.Requests, &pb.IpTablesRequest{
+					Rule:        rule,
+					ContainerId: containerID,
+				})
+			}
+			return pbClient.BatchFlushIptables(ctx, req)
+		})
+
+	return utils.MergeErrors(errs)
+}
+
 // GenerateIPTables generates iptables protobuf rule
 func GenerateIPTables(action pb.Rule_Action, direction pb.Rule_Direction, set string) pb.Rule {
 	return pb.Rule{
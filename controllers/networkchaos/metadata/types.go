@@ -0,0 +1,150 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/netem"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/partition"
+	"github.com/chaos-mesh/chaos-mesh/controllers/reconciler"
+	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
+)
+
+const (
+	networkMetadataActionMsg = "%s instance metadata service %v"
+)
+
+// Reconciler blocks or delays the instance metadata service by the partition or the delay to its addresses,
+// which are the external targets in the `to` direction
+type Reconciler struct {
+	client.Client
+	record.EventRecorder
+	Log logr.Logger
+}
+
+// Object implements the reconciler.InnerReconciler.Object
+func (r *Reconciler) Object() v1alpha1.InnerObject {
+	return &v1alpha1.NetworkChaos{}
+}
+
+func newReconciler(c client.Client, log logr.Logger, req ctrl.Request, recorder record.EventRecorder) twophase.Reconciler {
+	return twophase.Reconciler{
+		InnerReconciler: &Reconciler{
+			Client:        c,
+			EventRecorder: recorder,
+			Log:           log,
+		},
+		Client: c,
+		Log:    log,
+	}
+}
+
+// NewTwoPhaseReconciler would create Reconciler for twophase package
+func NewTwoPhaseReconciler(c client.Client, log logr.Logger, req ctrl.Request, recorder record.EventRecorder) *twophase.Reconciler {
+	r := newReconciler(c, log, req, recorder)
+	return twophase.NewReconciler(r, r.Client, r.Log)
+}
+
+// NewCommonReconciler would create Reconciler for common package
+func NewCommonReconciler(c client.Client, log logr.Logger, req ctrl.Request, recorder record.EventRecorder) *common.Reconciler {
+	r := newReconciler(c, log, req, recorder)
+	return common.NewReconciler(r, r.Client, r.Log)
+}
+
+// Apply implements the reconciler.InnerReconciler.Apply
+func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	networkchaos, ok := chaos.(*v1alpha1.NetworkChaos)
+	if !ok {
+		err := errors.New("chaos is not NetworkChaos")
+		r.Log.Error(err, "chaos is not NetworkChaos", "chaos", chaos)
+		return err
+	}
+	if networkchaos.Spec.Metadata == nil {
+		return fmt.Errorf("metadata is required by action %s", networkchaos.Spec.Action)
+	}
+
+	expanded := Expand(networkchaos)
+	err := r.inner(expanded).Apply(ctx, req, expanded)
+	restore(networkchaos, expanded)
+	if err != nil {
+		return err
+	}
+
+	for i := range networkchaos.Status.Experiment.PodRecords {
+		record := &networkchaos.Status.Experiment.PodRecords[i]
+		record.Action = string(networkchaos.Spec.Action)
+		record.Message = fmt.Sprintf(networkMetadataActionMsg, networkchaos.Spec.Metadata.Mode,
+			networkchaos.Spec.Metadata.GetAddresses())
+	}
+	return nil
+}
+
+// Recover implements the reconciler.InnerReconciler.Recover
+func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	networkchaos, ok := chaos.(*v1alpha1.NetworkChaos)
+	if !ok {
+		err := errors.New("chaos is not NetworkChaos")
+		r.Log.Error(err, "chaos is not NetworkChaos", "chaos", chaos)
+		return err
+	}
+	if networkchaos.Spec.Metadata == nil {
+		return fmt.Errorf("metadata is required by action %s", networkchaos.Spec.Action)
+	}
+
+	expanded := Expand(networkchaos)
+	err := r.inner(expanded).Recover(ctx, req, expanded)
+	restore(networkchaos, expanded)
+	return err
+}
+
+// inner returns the reconciler of the action which the chaos is expanded into
+func (r *Reconciler) inner(expanded *v1alpha1.NetworkChaos) reconciler.InnerReconciler {
+	if expanded.Spec.Action == v1alpha1.PartitionAction {
+		return &partition.Reconciler{Client: r.Client, EventRecorder: r.EventRecorder, Log: r.Log}
+	}
+	return &netem.Reconciler{Client: r.Client, EventRecorder: r.EventRecorder, Log: r.Log}
+}
+
+// Expand returns a copy of the chaos whose action is the partition or the delay to the addresses of
+// the metadata service, the spec of the chaos itself is kept as it is
+func Expand(networkchaos *v1alpha1.NetworkChaos) *v1alpha1.NetworkChaos {
+	expanded := networkchaos.DeepCopy()
+	spec := &expanded.Spec
+
+	spec.Action = v1alpha1.PartitionAction
+	if spec.Metadata.Mode == v1alpha1.MetadataDelayMode {
+		spec.Action = v1alpha1.DelayAction
+	}
+	spec.Direction = v1alpha1.To
+	spec.Target = nil
+	spec.TargetServices = nil
+	spec.ExternalTargets = append([]string(nil), spec.Metadata.GetAddresses()...)
+	return expanded
+}
+
+// restore copies the status and the finalizers recorded on the expanded chaos back to the chaos
+func restore(networkchaos, expanded *v1alpha1.NetworkChaos) {
+	networkchaos.Status = expanded.Status
+	networkchaos.Finalizers = expanded.Finalizers
+}
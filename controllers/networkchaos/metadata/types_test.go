@@ -0,0 +1,69 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestExpand(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &v1alpha1.NetworkChaos{
+		Spec: v1alpha1.NetworkChaosSpec{
+			Action:   v1alpha1.MetadataAction,
+			Metadata: &v1alpha1.MetadataSpec{Mode: v1alpha1.MetadataBlockMode},
+		},
+	}
+	expanded := Expand(chaos)
+	g.Expect(expanded.Spec.Action).To(Equal(v1alpha1.PartitionAction))
+	g.Expect(expanded.Spec.Direction).To(Equal(v1alpha1.To))
+	g.Expect(expanded.Spec.ExternalTargets).To(Equal([]string{v1alpha1.DefaultMetadataAddress}))
+	// the chaos itself isn't changed
+	g.Expect(chaos.Spec.Action).To(Equal(v1alpha1.MetadataAction))
+	g.Expect(chaos.Spec.ExternalTargets).To(BeNil())
+
+	chaos.Spec.Metadata = &v1alpha1.MetadataSpec{
+		Mode:      v1alpha1.MetadataDelayMode,
+		Addresses: []string{"100.100.100.200"},
+	}
+	chaos.Spec.Delay = &v1alpha1.DelaySpec{Latency: "2s"}
+	expanded = Expand(chaos)
+	g.Expect(expanded.Spec.Action).To(Equal(v1alpha1.DelayAction))
+	g.Expect(expanded.Spec.ExternalTargets).To(Equal([]string{"100.100.100.200"}))
+	g.Expect(expanded.Spec.Delay.Latency).To(Equal("2s"))
+}
+
+func TestRestore(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &v1alpha1.NetworkChaos{
+		Spec: v1alpha1.NetworkChaosSpec{
+			Action:   v1alpha1.MetadataAction,
+			Metadata: &v1alpha1.MetadataSpec{Mode: v1alpha1.MetadataBlockMode},
+		},
+	}
+	expanded := Expand(chaos)
+	expanded.Finalizers = []string{"outputdefault/app"}
+	expanded.Status.RulesOf("default", "app").Iptables = []string{"OUTPUT -m set --match-set tgt dst -j DROP"}
+
+	restore(chaos, expanded)
+	g.Expect(chaos.Finalizers).To(Equal([]string{"outputdefault/app"}))
+	g.Expect(chaos.Status.Rules).To(HaveLen(1))
+	g.Expect(chaos.Spec.Action).To(Equal(v1alpha1.MetadataAction))
+}
@@ -0,0 +1,116 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mtu
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// mtuServer is the chaos-daemon which only keeps the mtus of the containers
+type mtuServer struct {
+	pb.ChaosDaemonServer
+
+	sync.Mutex
+	mtus map[string]int32
+}
+
+func (s *mtuServer) GetCapabilities(context.Context, *empty.Empty) (*pb.CapabilitiesResponse, error) {
+	return &pb.CapabilitiesResponse{
+		Os:      "linux",
+		Methods: []string{"SetMtu", "DeleteMtu"},
+	}, nil
+}
+
+func (s *mtuServer) SetMtu(ctx context.Context, in *pb.MtuRequest) (*empty.Empty, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.mtus[in.ContainerId] = in.Mtu
+	return &empty.Empty{}, nil
+}
+
+func (s *mtuServer) DeleteMtu(ctx context.Context, in *pb.MtuRequest) (*empty.Empty, error) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.mtus, in.ContainerId)
+	return &empty.Empty{}, nil
+}
+
+func TestApplyAndRecover(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	daemon := &mtuServer{mtus: make(map[string]int32)}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ToNot(HaveOccurred())
+	server := grpc.NewServer()
+	pb.RegisterChaosDaemonServer(server, daemon)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	port := common.ControllerCfg.ChaosDaemonPort
+	common.ControllerCfg.ChaosDaemonPort = listener.Addr().(*net.TCPAddr).Port
+	defer func() { common.ControllerCfg.ChaosDaemonPort = port }()
+
+	pod := func(name, containerID string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name},
+			Spec:       v1.PodSpec{NodeName: "n1"},
+			Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{{ContainerID: containerID}},
+			},
+		}
+	}
+	p1, p2 := pod("p1", "containerd://c1"), pod("p2", "containerd://c2")
+	c := fake.NewFakeClientWithScheme(scheme.Scheme,
+		&v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+			Status:     v1.NodeStatus{Addresses: []v1.NodeAddress{{Type: v1.NodeInternalIP, Address: "127.0.0.1"}}},
+		},
+		p1, p2,
+	)
+	r := &Reconciler{Client: c, EventRecorder: record.NewFakeRecorder(10), Log: ctrl.Log}
+
+	chaos := &v1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "mtu"},
+		Spec: v1alpha1.NetworkChaosSpec{
+			Action: v1alpha1.MTUAction,
+			MTU:    &v1alpha1.MTUSpec{MTU: 1200},
+		},
+	}
+	err = r.applyAllPods(context.TODO(), []v1.Pod{*p1, *p2}, chaos)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(daemon.mtus).To(Equal(map[string]int32{"containerd://c1": 1200, "containerd://c2": 1200}))
+	g.Expect(chaos.Finalizers).To(ConsistOf("default/p1", "default/p2"))
+
+	err = r.cleanFinalizersAndRecover(context.TODO(), chaos)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(daemon.mtus).To(BeEmpty())
+	g.Expect(chaos.Finalizers).To(BeEmpty())
+}
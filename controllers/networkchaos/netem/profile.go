@@ -0,0 +1,140 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netem
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// loadProfile reads the profile of the chaos from the ConfigMap, it returns nil if the profile isn't defined
+func loadProfile(ctx context.Context, c client.Reader, networkchaos *v1alpha1.NetworkChaos) (*pb.NetemProfile, error) {
+	spec := networkchaos.Spec.Profile
+	if spec == nil {
+		return nil, nil
+	}
+
+	interval, err := spec.GetInterval()
+	if err != nil {
+		return nil, err
+	}
+
+	var cm v1.ConfigMap
+	if err := c.Get(ctx, types.NamespacedName{Namespace: networkchaos.Namespace, Name: spec.ConfigMap}, &cm); err != nil {
+		return nil, err
+	}
+	data, ok := cm.Data[spec.GetKey()]
+	if !ok {
+		return nil, fmt.Errorf("key %s is not found in ConfigMap %s", spec.GetKey(), spec.ConfigMap)
+	}
+
+	profile, err := parseProfile(data, interval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile in ConfigMap %s: %v", spec.ConfigMap, err)
+	}
+	profile.Loop = spec.Loop
+	return profile, nil
+}
+
+// parseProfile parses the samples from the profile in csv format, e.g.
+//
+//	time,delay,jitter,loss
+//	0s,120ms,10ms,0
+//	10s,180ms,20ms,0.5
+//
+// The samples are applied every interval if there is no time column.
+func parseProfile(data string, interval time.Duration) (*pb.NetemProfile, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %v", err)
+	}
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+		switch header[i] {
+		case "time", "delay", "jitter", "loss":
+		default:
+			return nil, fmt.Errorf("unknown column %s", header[i])
+		}
+	}
+
+	profile := &pb.NetemProfile{}
+	var offset time.Duration
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		sample := &pb.NetemSample{Netem: &pb.Netem{}}
+		timed := false
+		for i, column := range header {
+			value := strings.TrimSpace(record[i])
+			if column == "loss" {
+				loss, err := strconv.ParseFloat(value, 32)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid loss %s", line, value)
+				}
+				sample.Netem.Loss = float32(loss)
+				continue
+			}
+
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid %s %s", line, column, value)
+			}
+			switch column {
+			case "time":
+				if d < offset {
+					return nil, fmt.Errorf("line %d: time %s is earlier than the previous sample", line, value)
+				}
+				offset, timed = d, true
+			case "delay":
+				sample.Netem.Time = uint32(d.Microseconds())
+			case "jitter":
+				sample.Netem.Jitter = uint32(d.Microseconds())
+			}
+		}
+
+		if !timed && len(profile.Samples) > 0 {
+			offset += interval
+		}
+		sample.OffsetMs = offset.Milliseconds()
+		profile.Samples = append(profile.Samples, sample)
+	}
+
+	if len(profile.Samples) == 0 {
+		return nil, fmt.Errorf("no sample is found")
+	}
+	profile.PeriodMs = (offset + interval).Milliseconds()
+	return profile, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netem
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestParseProfile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("timed samples", func(t *testing.T) {
+		profile, err := parseProfile(`# measured between us-east and eu-west
+time,delay,jitter,loss
+0s,120ms,10ms,0
+10s, 180ms, 20ms, 0.5
+`, time.Second)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(profile.Samples).To(HaveLen(2))
+		g.Expect(profile.Samples[1].OffsetMs).To(Equal(int64(10000)))
+		g.Expect(profile.Samples[1].Netem.Time).To(Equal(uint32(180000)))
+		g.Expect(profile.Samples[1].Netem.Jitter).To(Equal(uint32(20000)))
+		g.Expect(profile.Samples[1].Netem.Loss).To(Equal(float32(0.5)))
+		g.Expect(profile.PeriodMs).To(Equal(int64(11000)))
+	})
+
+	t.Run("latency distribution", func(t *testing.T) {
+		profile, err := parseProfile("delay\n100ms\n150ms\n90ms\n", 500*time.Millisecond)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(profile.Samples).To(HaveLen(3))
+		g.Expect(profile.Samples[2].OffsetMs).To(Equal(int64(1000)))
+		g.Expect(profile.PeriodMs).To(Equal(int64(1500)))
+	})
+
+	t.Run("invalid profiles", func(t *testing.T) {
+		for _, data := range []string{
+			"",
+			"time,bandwidth\n0s,1mbps\n",
+			"delay\n",
+			"delay\nslow\n",
+			"time,loss\n10s,1\n5s,2\n",
+		} {
+			_, err := parseProfile(data, time.Second)
+			g.Expect(err).To(HaveOccurred(), data)
+		}
+	})
+}
+
+func TestLoadProfile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cm := v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "wan"},
+		Data:       map[string]string{"profile": "delay\n100ms\n"},
+	}
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, &cm)
+	chaos := &v1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "wan"},
+		Spec: v1alpha1.NetworkChaosSpec{
+			Action:  v1alpha1.NetemAction,
+			Profile: &v1alpha1.NetemProfileSpec{ConfigMap: "wan", Loop: true},
+		},
+	}
+
+	profile, err := loadProfile(context.TODO(), c, chaos)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(profile.Loop).To(BeTrue())
+	g.Expect(profile.Samples[0].Netem.Time).To(Equal(uint32(100000)))
+
+	chaos.Spec.Profile.Key = "missing"
+	_, err = loadProfile(context.TODO(), c, chaos)
+	g.Expect(err).To(HaveOccurred())
+}
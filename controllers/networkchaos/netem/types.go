@@ -19,8 +19,10 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/go-logr/logr"
+	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-multierror"
 	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
@@ -33,8 +35,10 @@ import (
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/bpf"
 	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/ipset"
 	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/netutils"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/summary"
 	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/tc"
 	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
@@ -102,17 +106,21 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 		return err
 	}
 
-	sources, err := utils.SelectAndFilterPods(ctx, r.Client, &networkchaos.Spec)
+	networkchaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	sources, err := utils.SelectAndRecordPods(ctx, r.Client, &networkchaos.Spec, networkchaos.Status.Experiment.Selection)
 	if err != nil {
 		r.Log.Error(err, "failed to select and filter pods")
 		return err
 	}
+	common.RecordImpact(ctx, r.Client, networkchaos, sources, false)
 
 	var targets []v1.Pod
 
+	networkchaos.Status.TargetSelection = nil
 	// We should only apply filter when we specify targets
 	if networkchaos.Spec.Target != nil {
-		targets, err = utils.SelectAndFilterPods(ctx, r.Client, networkchaos.Spec.Target)
+		networkchaos.Status.TargetSelection = &v1alpha1.SelectionStatus{}
+		targets, err = utils.SelectAndRecordPods(ctx, r.Client, networkchaos.Spec.Target, networkchaos.Status.TargetSelection)
 		if err != nil {
 			r.Log.Error(err, "failed to select and filter pods")
 			return err
@@ -120,6 +128,8 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 	}
 
 	pods := append(sources, targets...)
+	networkchaos.Status.Rules = nil
+	networkchaos.Status.TargetIPSet = nil
 
 	externalCidrs, err := netutils.ResolveCidrs(networkchaos.Spec.ExternalTargets)
 	if err != nil {
@@ -127,21 +137,27 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 		return err
 	}
 
+	serviceCidrs, err := netutils.ResolveServices(ctx, r.Client, networkchaos.Namespace, networkchaos.Spec.TargetServices)
+	if err != nil {
+		r.Log.Error(err, "failed to resolve target services")
+		return err
+	}
+
 	switch networkchaos.Spec.Direction {
 	case v1alpha1.To:
-		err = r.applyNetem(ctx, sources, targets, externalCidrs, networkchaos)
+		err = r.applyNetem(ctx, sources, targets, externalCidrs, serviceCidrs, networkchaos)
 		if err != nil {
 			r.Log.Error(err, "failed to apply netem", "sources", sources, "targets", targets)
 			return err
 		}
 	case v1alpha1.From:
-		err = r.applyNetem(ctx, targets, sources, []string{}, networkchaos)
+		err = r.applyNetem(ctx, targets, sources, []string{}, []string{}, networkchaos)
 		if err != nil {
 			r.Log.Error(err, "failed to apply netem", "sources", targets, "targets", sources)
 			return err
 		}
 	case v1alpha1.Both:
-		err = r.applyNetem(ctx, pods, pods, externalCidrs, networkchaos)
+		err = r.applyNetem(ctx, pods, pods, externalCidrs, serviceCidrs, networkchaos)
 		if err != nil {
 			r.Log.Error(err, "failed to apply netem", "sources", pods, "targets", pods)
 			return err
@@ -180,6 +196,8 @@ func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alph
 	if err := r.cleanFinalizersAndRecover(ctx, networkchaos); err != nil {
 		return err
 	}
+	networkchaos.Status.Rules = nil
+	networkchaos.Status.TargetIPSet = nil
 	r.Event(networkchaos, v1.EventTypeNormal, utils.EventChaosRecovered, "")
 	return nil
 }
@@ -187,8 +205,15 @@ func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alph
 func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, networkchaos *v1alpha1.NetworkChaos) error {
 	var result error
 
+	var (
+		keys    []string
+		pods    []*v1.Pod
+		bpfKeys []string
+		bpfPods []*v1.Pod
+	)
 	for _, key := range networkchaos.Finalizers {
-		ns, name, err := cache.SplitMetaNamespaceKey(key)
+		podKey := strings.TrimPrefix(key, bpf.FinalizerPrefix)
+		ns, name, err := cache.SplitMetaNamespaceKey(podKey)
 		if err != nil {
 			result = multierror.Append(result, err)
 			continue
@@ -211,13 +236,48 @@ func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, networkchaos
 			continue
 		}
 
-		err = r.recoverPod(ctx, &pod, networkchaos)
+		r.Log.Info("Try to recover pod", "namespace", pod.Namespace, "name", pod.Name)
+		if podKey != key {
+			bpfKeys = append(bpfKeys, key)
+			bpfPods = append(bpfPods, &pod)
+			continue
+		}
+		keys = append(keys, key)
+		pods = append(pods, &pod)
+	}
+
+	errs := utils.BatchByNode(ctx, r.Client, pods, common.ControllerCfg.ChaosDaemonPort,
+		func(ctx context.Context, pbClient utils.ChaosDaemonClientInterface, _ string, containerIDs []string) (*pb.BatchResponse, error) {
+			req := &pb.BatchNetemRequest{}
+			for _, containerID := range containerIDs {
+				req.Requests = append(req.Requests, &pb.NetemRequest{
+					ContainerId: containerID,
+					Netem:       nil,
+					Device:      networkchaos.Spec.Device,
+				})
+			}
+			return pbClient.BatchDeleteNetem(ctx, req)
+		})
+	for i, err := range errs {
+		if err != nil {
+			r.Log.Error(err, "recover pod error", "namespace", pods[i].Namespace, "name", pods[i].Name)
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		r.Log.Info("Recover pod finished", "namespace", pods[i].Namespace, "name", pods[i].Name)
+		networkchaos.Finalizers = utils.RemoveFromFinalizer(networkchaos.Finalizers, keys[i])
+	}
+
+	for i, err := range bpf.BatchDelete(ctx, r.Client, bpfPods, networkchaos.Spec.Device) {
 		if err != nil {
+			r.Log.Error(err, "recover pod error", "namespace", bpfPods[i].Namespace, "name", bpfPods[i].Name)
 			result = multierror.Append(result, err)
 			continue
 		}
 
-		networkchaos.Finalizers = utils.RemoveFromFinalizer(networkchaos.Finalizers, key)
+		r.Log.Info("Recover pod finished", "namespace", bpfPods[i].Namespace, "name", bpfPods[i].Name)
+		networkchaos.Finalizers = utils.RemoveFromFinalizer(networkchaos.Finalizers, bpfKeys[i])
 	}
 
 	if networkchaos.Annotations[common.AnnotationCleanFinalizer] == common.AnnotationCleanFinalizerForced {
@@ -229,65 +289,89 @@ func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, networkchaos
 	return result
 }
 
-func (r *Reconciler) recoverPod(ctx context.Context, pod *v1.Pod, _ *v1alpha1.NetworkChaos) error {
-	r.Log.Info("Try to recover pod", "namespace", pod.Namespace, "name", pod.Name)
-
-	pbClient, err := utils.NewChaosDaemonClient(ctx, r.Client, pod, common.ControllerCfg.ChaosDaemonPort)
+// applyAllPods applies netem on all egress traffic of pods, the pods on the same node
+// are handled in one batch call
+func (r *Reconciler) applyAllPods(ctx context.Context, pods []v1.Pod, networkchaos *v1alpha1.NetworkChaos) error {
+	netem, profile, err := r.netemOf(ctx, networkchaos)
 	if err != nil {
 		return err
 	}
-	defer pbClient.Close()
+	netem.Parent = &pb.TcHandle{
+		Major: 1,
+		Minor: 0,
+	}
+	netem.Handle = &pb.TcHandle{
+		Major: 1,
+		Minor: 0,
+	}
 
-	if len(pod.Status.ContainerStatuses) == 0 {
-		return fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
+	targets := make([]*v1.Pod, 0, len(pods))
+	for index := range pods {
+		r.Log.Info("Try to apply netem on pod", "namespace", pods[index].Namespace, "name", pods[index].Name)
+		targets = append(targets, &pods[index])
 	}
 
-	containerID := pod.Status.ContainerStatuses[0].ContainerID
+	errs := utils.BatchByNode(ctx, r.Client, targets, common.ControllerCfg.ChaosDaemonPort,
+		func(ctx context.Context, pbClient utils.ChaosDaemonClientInterface, node string, containerIDs []string) (*pb.BatchResponse, error) {
+			if err := utils.CheckCapabilities(ctx, pbClient, node, utils.Requirement{
+				Action:            string(networkchaos.Spec.Action),
+				Modules:           []string{"sch_netem"},
+				LinuxCapabilities: utils.NetworkCapabilities,
+				Methods:           []string{"BatchSetNetem"},
+			}); err != nil {
+				return nil, err
+			}
 
-	_, err = pbClient.DeleteNetem(ctx, &pb.NetemRequest{
-		ContainerId: containerID,
-		Netem:       nil,
-	})
+			req := &pb.BatchNetemRequest{}
+			for _, containerID := range containerIDs {
+				req.Requests = append(req.Requests, &pb.NetemRequest{
+					ContainerId: containerID,
+					Netem:       netem,
+					Device:      networkchaos.Spec.Device,
+					Profile:     profile,
+				})
+			}
+			return pbClient.BatchSetNetem(ctx, req)
+		})
 
-	if err != nil {
-		r.Log.Error(err, "recover pod error", "namespace", pod.Namespace, "name", pod.Name)
-	} else {
-		r.Log.Info("Recover pod finished", "namespace", pod.Namespace, "name", pod.Name)
+	for i, err := range errs {
+		if err != nil {
+			continue
+		}
+		rules := networkchaos.Status.RulesOf(targets[i].Namespace, targets[i].Name)
+		rules.Device = networkchaos.Spec.GetDevice()
+		rules.Qdiscs = append(rules.Qdiscs, summary.Netem(netem))
 	}
 
-	return err
+	return utils.MergeErrors(errs)
 }
 
-func (r *Reconciler) applyPod(ctx context.Context, pod *v1.Pod, networkchaos *v1alpha1.NetworkChaos, parent, handle *pb.TcHandle) error {
+// applyPod applies netem on the pod and returns the applied netem
+func (r *Reconciler) applyPod(ctx context.Context, pod *v1.Pod, networkchaos *v1alpha1.NetworkChaos, parent, handle *pb.TcHandle) (*pb.Netem, error) {
 	r.Log.Info("Try to apply netem on pod", "namespace", pod.Namespace, "name", pod.Name)
 
-	var (
-		netem *pb.Netem
-		err   error
-	)
-	switch networkchaos.Spec.Action {
-	case v1alpha1.NetemAction:
-		netem, err = mergeNetem(networkchaos.Spec)
-	default:
-		action := strings.Title(string(networkchaos.Spec.Action))
-		spec, ok := reflect.Indirect(reflect.ValueOf(networkchaos.Spec)).FieldByName(action).Interface().(NetemSpec)
-		if !ok {
-			return fmt.Errorf("spec %s is not a NetemSpec", action)
-		}
-		netem, err = spec.ToNetem()
-	}
+	netem, profile, err := r.netemOf(ctx, networkchaos)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	pbClient, err := utils.NewChaosDaemonClient(ctx, r.Client, pod, common.ControllerCfg.ChaosDaemonPort)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer pbClient.Close()
 
+	if err := utils.CheckCapabilities(ctx, pbClient, pod.Spec.NodeName, utils.Requirement{
+		Action:            string(networkchaos.Spec.Action),
+		Modules:           []string{"sch_netem"},
+		LinuxCapabilities: utils.NetworkCapabilities,
+		Methods:           []string{"SetNetem"},
+	}); err != nil {
+		return nil, err
+	}
+
 	if len(pod.Status.ContainerStatuses) == 0 {
-		return fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
+		return nil, fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
 	}
 
 	containerID := pod.Status.ContainerStatuses[0].ContainerID
@@ -298,9 +382,41 @@ func (r *Reconciler) applyPod(ctx context.Context, pod *v1.Pod, networkchaos *v1
 	_, err = pbClient.SetNetem(ctx, &pb.NetemRequest{
 		ContainerId: containerID,
 		Netem:       netem,
+		Device:      networkchaos.Spec.Device,
+		Profile:     profile,
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return netem, nil
+}
+
+// netemOf returns the netem of the chaos, which is the first sample if the profile is defined
+func (r *Reconciler) netemOf(ctx context.Context, networkchaos *v1alpha1.NetworkChaos) (*pb.Netem, *pb.NetemProfile, error) {
+	profile, err := loadProfile(ctx, r.Client, networkchaos)
+	if err != nil {
+		return nil, nil, err
+	}
+	if profile == nil {
+		netem, err := toNetem(networkchaos)
+		return netem, nil, err
+	}
+	return proto.Clone(profile.Samples[0].Netem).(*pb.Netem), profile, nil
+}
+
+// toNetem converts the spec of the action of networkchaos to netem
+func toNetem(networkchaos *v1alpha1.NetworkChaos) (*pb.Netem, error) {
+	if networkchaos.Spec.Action == v1alpha1.NetemAction {
+		return mergeNetem(networkchaos.Spec)
+	}
+
+	action := strings.Title(string(networkchaos.Spec.Action))
+	spec, ok := reflect.Indirect(reflect.ValueOf(networkchaos.Spec)).FieldByName(action).Interface().(NetemSpec)
+	if !ok {
+		return nil, fmt.Errorf("spec %s is not a NetemSpec", action)
+	}
+	return spec.ToNetem()
 }
 
 // mergeNetem calls ToNetem on all non nil network emulation specs and merges them into one request.
@@ -340,9 +456,22 @@ func mergeNetem(spec v1alpha1.NetworkChaosSpec) (*pb.Netem, error) {
 	return merged, nil
 }
 
-func (r *Reconciler) applyNetem(ctx context.Context, sources, targets []v1.Pod, externalTargets []string, networkchaos *v1alpha1.NetworkChaos) error {
+func (r *Reconciler) applyNetem(ctx context.Context, sources, targets []v1.Pod, externalTargets, serviceCidrs []string, networkchaos *v1alpha1.NetworkChaos) error {
 
 	g := errgroup.Group{}
+	var rulesLock sync.Mutex
+
+	var cidrs []string
+	if len(targets)+len(externalTargets)+len(serviceCidrs) > 0 {
+		cidrs = append(ipset.BuildIPSet(targets, externalTargets, networkchaos, ipsetPostFix).Cidrs, serviceCidrs...)
+	}
+	sources, err := r.applyBpf(ctx, sources, cidrs, networkchaos)
+	if err != nil {
+		return err
+	}
+	if len(sources) == 0 {
+		return nil
+	}
 
 	for index := range sources {
 		pod := &sources[index]
@@ -356,29 +485,25 @@ func (r *Reconciler) applyNetem(ctx context.Context, sources, targets []v1.Pod,
 	}
 
 	// if we don't specify targets, then sources pods apply netem on all egress traffic
-	if len(targets)+len(externalTargets) == 0 {
+	if len(targets)+len(externalTargets)+len(serviceCidrs) == 0 {
 		r.Log.Info("apply netem", "sources", sources)
-		for index := range sources {
-			pod := &sources[index]
-
-			g.Go(func() error {
-				parent := &pb.TcHandle{
-					Major: 1,
-					Minor: 0,
-				}
-
-				handle := &pb.TcHandle{
-					Major: 1,
-					Minor: 0,
-				}
-				return r.applyPod(ctx, pod, networkchaos, parent, handle)
-			})
-		}
-		return g.Wait()
+		return r.applyAllPods(ctx, sources, networkchaos)
 	}
 
 	// create ipset contains all target ips
 	dstIpset := ipset.BuildIPSet(targets, externalTargets, networkchaos, ipsetPostFix)
+	if len(networkchaos.Spec.TargetServices) > 0 {
+		related := make([]*v1.Pod, 0, len(sources))
+		for index := range sources {
+			related = append(related, &sources[index])
+		}
+		targetIPSet, err := ipset.NewTargetIPSet(&dstIpset, serviceCidrs, related)
+		if err != nil {
+			return err
+		}
+		networkchaos.Status.TargetIPSet = targetIPSet
+		dstIpset.Cidrs = append(dstIpset.Cidrs, serviceCidrs...)
+	}
 	r.Log.Info("apply netem with filter", "sources", sources, "ipset", dstIpset.String())
 
 	for index := range sources {
@@ -415,71 +540,63 @@ func (r *Reconciler) applyNetem(ctx context.Context, sources, targets []v1.Pod,
 				return err
 			}
 
-			err = tc.AddQdisc(ctx, r.Client, pod, &pb.Qdisc{
-				Parent: &pb.TcHandle{
-					Major: 1,
-					Minor: 0,
+			qdiscs := []*pb.Qdisc{
+				{
+					Parent: &pb.TcHandle{
+						Major: 1,
+						Minor: 0,
+					},
+					Handle: &pb.TcHandle{
+						Major: 1,
+						Minor: 0,
+					},
+					Type: "prio",
+					// NOTE: priomap is the same as pfifo_fast qdisc,
+					// so that it keeps the same behavior when handling non-classified traffic.
+					// http://tldp.org/HOWTO/Adv-Routing-HOWTO/lartc.qdisc.classless.html
+					// bands 4 = 3 + 1:
+					// 3 is for default bands setting, similar with priomap,
+					// 1 is for holding netem qdisc.
+					Args: []string{"bands", "4", "priomap", "1", "2", "2", "2", "1", "2", "0", "0", "1", "1", "1", "1", "1", "1", "1", "1"},
 				},
-				Handle: &pb.TcHandle{
-					Major: 1,
-					Minor: 0,
+				{
+					Parent: &pb.TcHandle{
+						Major: 1,
+						Minor: 1,
+					},
+					Handle: &pb.TcHandle{
+						Major: 10,
+						Minor: 0,
+					},
+					Type: "sfq",
 				},
-				Type: "prio",
-				// NOTE: priomap is the same as pfifo_fast qdisc,
-				// so that it keeps the same behavior when handling non-classified traffic.
-				// http://tldp.org/HOWTO/Adv-Routing-HOWTO/lartc.qdisc.classless.html
-				// bands 4 = 3 + 1:
-				// 3 is for default bands setting, similar with priomap,
-				// 1 is for holding netem qdisc.
-				Args: []string{"bands", "4", "priomap", "1", "2", "2", "2", "1", "2", "0", "0", "1", "1", "1", "1", "1", "1", "1", "1"},
-			})
-			if err != nil {
-				return err
-			}
-
-			err = tc.AddQdisc(ctx, r.Client, pod, &pb.Qdisc{
-				Parent: &pb.TcHandle{
-					Major: 1,
-					Minor: 1,
+				{
+					Parent: &pb.TcHandle{
+						Major: 1,
+						Minor: 2,
+					},
+					Handle: &pb.TcHandle{
+						Major: 20,
+						Minor: 0,
+					},
+					Type: "sfq",
 				},
-				Handle: &pb.TcHandle{
-					Major: 10,
-					Minor: 0,
+				{
+					Parent: &pb.TcHandle{
+						Major: 1,
+						Minor: 3,
+					},
+					Handle: &pb.TcHandle{
+						Major: 30,
+						Minor: 0,
+					},
+					Type: "sfq",
 				},
-				Type: "sfq",
-			})
-			if err != nil {
-				return err
 			}
-
-			err = tc.AddQdisc(ctx, r.Client, pod, &pb.Qdisc{
-				Parent: &pb.TcHandle{
-					Major: 1,
-					Minor: 2,
-				},
-				Handle: &pb.TcHandle{
-					Major: 20,
-					Minor: 0,
-				},
-				Type: "sfq",
-			})
-			if err != nil {
-				return err
-			}
-
-			err = tc.AddQdisc(ctx, r.Client, pod, &pb.Qdisc{
-				Parent: &pb.TcHandle{
-					Major: 1,
-					Minor: 3,
-				},
-				Handle: &pb.TcHandle{
-					Major: 30,
-					Minor: 0,
-				},
-				Type: "sfq",
-			})
-			if err != nil {
-				return err
+			for _, qdisc := range qdiscs {
+				if err := tc.AddQdisc(ctx, r.Client, pod, networkchaos.Spec.Device, qdisc); err != nil {
+					return err
+				}
 			}
 
 			parent := &pb.TcHandle{
@@ -490,12 +607,12 @@ func (r *Reconciler) applyNetem(ctx context.Context, sources, targets []v1.Pod,
 				Major: 40,
 				Minor: 0,
 			}
-			err = r.applyPod(ctx, pod, networkchaos, parent, handle)
+			netem, err := r.applyPod(ctx, pod, networkchaos, parent, handle)
 			if err != nil {
 				return err
 			}
 
-			return tc.AddEmatchFilter(ctx, r.Client, pod, &pb.EmatchFilter{
+			filter := &pb.EmatchFilter{
 				Match: fmt.Sprintf("ipset(%s dst)", dstIpset.Name),
 				Parent: &pb.TcHandle{
 					Major: 1,
@@ -505,9 +622,78 @@ func (r *Reconciler) applyNetem(ctx context.Context, sources, targets []v1.Pod,
 					Major: 1,
 					Minor: 4,
 				},
-			})
+			}
+			if err := tc.AddEmatchFilter(ctx, r.Client, pod, networkchaos.Spec.Device, filter); err != nil {
+				return err
+			}
+
+			rulesLock.Lock()
+			defer rulesLock.Unlock()
+			rules := networkchaos.Status.RulesOf(pod.Namespace, pod.Name)
+			rules.Device = networkchaos.Spec.GetDevice()
+			for _, qdisc := range qdiscs {
+				rules.Qdiscs = append(rules.Qdiscs, summary.Qdisc(qdisc))
+			}
+			rules.Qdiscs = append(rules.Qdiscs, summary.Netem(netem))
+			rules.Filters = append(rules.Filters, summary.Filter(filter))
+			summary.AddIPSet(rules, &dstIpset)
+			return nil
 		})
 	}
 
 	return g.Wait()
 }
+
+// applyBpf applies netem by the tc-bpf backend on the sources on the nodes which choose it, and returns
+// the other sources. The sources are all returned if the netem isn't supported by the backend,
+// so that it's applied by tc.
+func (r *Reconciler) applyBpf(ctx context.Context, sources []v1.Pod, cidrs []string, networkchaos *v1alpha1.NetworkChaos) ([]v1.Pod, error) {
+	if !common.ControllerCfg.BPFNetworkBackend {
+		return sources, nil
+	}
+
+	netem, profile, err := r.netemOf(ctx, networkchaos)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil || !bpf.Supported(netem) {
+		r.Log.Info("netem isn't supported by the bpf backend, apply it by tc", "netem", netem)
+		return sources, nil
+	}
+
+	bpfSources, others, err := bpf.Split(ctx, r.Client, sources, bpf.Requirement(string(networkchaos.Spec.Action), netem.Time > 0))
+	if err != nil {
+		return nil, err
+	}
+	if len(bpfSources) == 0 {
+		return sources, nil
+	}
+
+	fault := bpf.FromNetem(netem, networkchaos.Spec.Device, cidrs)
+	pods := make([]*v1.Pod, 0, len(bpfSources))
+	for index := range bpfSources {
+		pod := &bpfSources[index]
+
+		key, err := cache.MetaNamespaceKeyFunc(pod)
+		if err != nil {
+			return nil, err
+		}
+		networkchaos.Finalizers = utils.InsertFinalizer(networkchaos.Finalizers, bpf.FinalizerPrefix+key)
+		pods = append(pods, pod)
+	}
+	r.Log.Info("apply netem by bpf", "sources", bpfSources, "cidrs", cidrs)
+
+	errs := bpf.BatchSet(ctx, r.Client, pods, string(networkchaos.Spec.Action), func(*v1.Pod) *pb.BpfFaultRequest {
+		return fault
+	})
+	for i, err := range errs {
+		if err != nil {
+			continue
+		}
+		rules := networkchaos.Status.RulesOf(pods[i].Namespace, pods[i].Name)
+		rules.Device = networkchaos.Spec.GetDevice()
+		rules.Programs = append(rules.Programs, summary.Programs(fault)...)
+	}
+
+	return others, utils.MergeErrors(errs)
+}
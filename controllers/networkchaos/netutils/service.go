@@ -0,0 +1,66 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netutils
+
+import (
+	"context"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// ResolveServices converts the cluster IPs and the endpoints of the services into sorted cidrs.
+// The services without endpoints are resolved into their cluster IPs only.
+func ResolveServices(ctx context.Context, c client.Reader, namespace string, services []v1alpha1.ServiceReference) ([]string, error) {
+	set := make(map[string]bool)
+	for _, ref := range services {
+		key := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+		if key.Namespace == "" {
+			key.Namespace = namespace
+		}
+
+		var service v1.Service
+		if err := c.Get(ctx, key, &service); err != nil {
+			return nil, err
+		}
+		if service.Spec.ClusterIP != "" && service.Spec.ClusterIP != v1.ClusterIPNone {
+			set[IPToCidr(service.Spec.ClusterIP)] = true
+		}
+
+		var endpoints v1.Endpoints
+		if err := c.Get(ctx, key, &endpoints); err != nil {
+			if k8serror.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, subset := range endpoints.Subsets {
+			for _, address := range subset.Addresses {
+				set[IPToCidr(address.IP)] = true
+			}
+		}
+	}
+
+	cidrs := make([]string, 0, len(set))
+	for cidr := range set {
+		cidrs = append(cidrs, cidr)
+	}
+	sort.Strings(cidrs)
+	return cidrs, nil
+}
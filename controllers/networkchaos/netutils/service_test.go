@@ -0,0 +1,57 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netutils
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestResolveServices(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	payments := v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "payments"},
+		Spec:       v1.ServiceSpec{ClusterIP: "10.96.0.10"},
+	}
+	endpoints := v1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "payments"},
+		Subsets: []v1.EndpointSubset{{
+			Addresses: []v1.EndpointAddress{{IP: "10.244.1.3"}, {IP: "10.244.0.2"}},
+		}},
+	}
+	headless := v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "db"},
+		Spec:       v1.ServiceSpec{ClusterIP: v1.ClusterIPNone},
+	}
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, &payments, &endpoints, &headless)
+
+	cidrs, err := ResolveServices(context.TODO(), c, "default", []v1alpha1.ServiceReference{
+		{Namespace: "shop", Name: "payments"},
+		{Name: "db"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cidrs).To(Equal([]string{"10.244.0.2/32", "10.244.1.3/32", "10.96.0.10/32"}))
+
+	_, err = ResolveServices(context.TODO(), c, "default", []v1alpha1.ServiceReference{{Name: "payments"}})
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,317 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodeport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+const (
+	networkNodePortActionMsg = "node ports %s are blocked on node %s"
+)
+
+type Reconciler struct {
+	client.Client
+	record.EventRecorder
+	Log logr.Logger
+}
+
+// Object implements the reconciler.InnerReconciler.Object
+func (r *Reconciler) Object() v1alpha1.InnerObject {
+	return &v1alpha1.NetworkChaos{}
+}
+
+func newReconciler(c client.Client, log logr.Logger, req ctrl.Request, recorder record.EventRecorder) twophase.Reconciler {
+	return twophase.Reconciler{
+		InnerReconciler: &Reconciler{
+			Client:        c,
+			EventRecorder: recorder,
+			Log:           log,
+		},
+		Client: c,
+		Log:    log,
+	}
+}
+
+// NewTwoPhaseReconciler would create Reconciler for twophase package
+func NewTwoPhaseReconciler(c client.Client, log logr.Logger, req ctrl.Request, recorder record.EventRecorder) *twophase.Reconciler {
+	r := newReconciler(c, log, req, recorder)
+	return twophase.NewReconciler(r, r.Client, r.Log)
+}
+
+// NewCommonReconciler would create Reconciler for common package
+func NewCommonReconciler(c client.Client, log logr.Logger, req ctrl.Request, recorder record.EventRecorder) *common.Reconciler {
+	r := newReconciler(c, log, req, recorder)
+	return common.NewReconciler(r, r.Client, r.Log)
+}
+
+// Apply implements the reconciler.InnerReconciler.Apply
+func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	networkchaos, ok := chaos.(*v1alpha1.NetworkChaos)
+	if !ok {
+		err := errors.New("chaos is not NetworkChaos")
+		r.Log.Error(err, "chaos is not NetworkChaos", "chaos", chaos)
+		return err
+	}
+
+	networkchaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	pods, err := utils.SelectAndRecordPods(ctx, r.Client, &networkchaos.Spec, networkchaos.Status.Experiment.Selection)
+
+	if err != nil {
+		r.Log.Error(err, "failed to select and filter pods")
+		return err
+	}
+	common.RecordImpact(ctx, r.Client, networkchaos, pods, false)
+
+	ports, err := ResolveNodePorts(ctx, r.Client, networkchaos)
+	if err != nil {
+		r.Log.Error(err, "failed to resolve node ports")
+		return err
+	}
+
+	err = r.applyAllPods(ctx, pods, networkchaos, ports)
+	if err != nil {
+		return err
+	}
+
+	networkchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
+	for _, pod := range pods {
+		ps := v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Action:    string(networkchaos.Spec.Action),
+			Message:   fmt.Sprintf(networkNodePortActionMsg, formatNodePorts(ports), pod.Spec.NodeName),
+		}
+		networkchaos.Status.Experiment.PodRecords = append(networkchaos.Status.Experiment.PodRecords, ps)
+	}
+	r.Event(networkchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
+	return nil
+}
+
+func (r *Reconciler) applyAllPods(ctx context.Context, pods []v1.Pod, networkchaos *v1alpha1.NetworkChaos, ports []*pb.NodePort) error {
+	targets := make([]*v1.Pod, 0, len(pods))
+	for index := range pods {
+		pod := &pods[index]
+
+		key, err := cache.MetaNamespaceKeyFunc(pod)
+		if err != nil {
+			return err
+		}
+		networkchaos.Finalizers = utils.InsertFinalizer(networkchaos.Finalizers, key)
+
+		r.Log.Info("Try to block node ports on the node of pod", "namespace", pod.Namespace, "name", pod.Name)
+		targets = append(targets, pod)
+	}
+
+	errs := utils.BatchByNode(ctx, r.Client, targets, common.ControllerCfg.ChaosDaemonPort,
+		func(ctx context.Context, pbClient utils.ChaosDaemonClientInterface, node string, containerIDs []string) (*pb.BatchResponse, error) {
+			if err := utils.CheckCapabilities(ctx, pbClient, node, utils.Requirement{
+				Action:            string(networkchaos.Spec.Action),
+				LinuxCapabilities: utils.NetworkCapabilities,
+				Methods:           []string{"BlockNodePorts"},
+			}); err != nil {
+				return nil, err
+			}
+
+			resp := &pb.BatchResponse{Errors: make([]string, len(containerIDs))}
+			for i, containerID := range containerIDs {
+				_, err := pbClient.BlockNodePorts(ctx, &pb.NodePortsRequest{
+					ContainerId: containerID,
+					Ports:       ports,
+				})
+				if err != nil {
+					resp.Errors[i] = err.Error()
+				}
+			}
+			return resp, nil
+		})
+
+	return utils.MergeErrors(errs)
+}
+
+// Recover implements the reconciler.InnerReconciler.Recover
+func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	networkchaos, ok := chaos.(*v1alpha1.NetworkChaos)
+	if !ok {
+		err := errors.New("chaos is not NetworkChaos")
+		r.Log.Error(err, "chaos is not NetworkChaos", "chaos", chaos)
+		return err
+	}
+
+	if err := r.cleanFinalizersAndRecover(ctx, networkchaos); err != nil {
+		return err
+	}
+	r.Event(networkchaos, v1.EventTypeNormal, utils.EventChaosRecovered, "")
+	return nil
+}
+
+func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, networkchaos *v1alpha1.NetworkChaos) error {
+	var result error
+
+	var (
+		keys []string
+		pods []*v1.Pod
+	)
+	for _, key := range networkchaos.Finalizers {
+		ns, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		var pod v1.Pod
+		err = r.Get(ctx, types.NamespacedName{
+			Namespace: ns,
+			Name:      name,
+		}, &pod)
+
+		if err != nil {
+			if !k8serror.IsNotFound(err) {
+				result = multierror.Append(result, err)
+				continue
+			}
+
+			r.Log.Info("Pod not found", "namespace", ns, "name", name)
+			networkchaos.Finalizers = utils.RemoveFromFinalizer(networkchaos.Finalizers, key)
+			continue
+		}
+
+		r.Log.Info("Try to recover pod", "namespace", pod.Namespace, "name", pod.Name)
+		keys = append(keys, key)
+		pods = append(pods, &pod)
+	}
+
+	errs := utils.BatchByNode(ctx, r.Client, pods, common.ControllerCfg.ChaosDaemonPort,
+		func(ctx context.Context, pbClient utils.ChaosDaemonClientInterface, _ string, containerIDs []string) (*pb.BatchResponse, error) {
+			resp := &pb.BatchResponse{Errors: make([]string, len(containerIDs))}
+			for i, containerID := range containerIDs {
+				_, err := pbClient.UnblockNodePorts(ctx, &pb.NodePortsRequest{
+					ContainerId: containerID,
+				})
+				// the chaos-daemon which doesn't support the action has blocked nothing
+				if err != nil && status.Code(err) != codes.Unimplemented {
+					resp.Errors[i] = err.Error()
+				}
+			}
+			return resp, nil
+		})
+	for i, err := range errs {
+		if err != nil {
+			r.Log.Error(err, "recover pod error", "namespace", pods[i].Namespace, "name", pods[i].Name)
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		r.Log.Info("Recover pod finished", "namespace", pods[i].Namespace, "name", pods[i].Name)
+		networkchaos.Finalizers = utils.RemoveFromFinalizer(networkchaos.Finalizers, keys[i])
+	}
+
+	if networkchaos.Annotations[common.AnnotationCleanFinalizer] == common.AnnotationCleanFinalizerForced {
+		r.Log.Info("Force cleanup all finalizers", "chaos", networkchaos)
+		networkchaos.Finalizers = networkchaos.Finalizers[:0]
+		return nil
+	}
+
+	return result
+}
+
+// ResolveNodePorts returns the node ports to block, the node ports of the Services are resolved
+func ResolveNodePorts(ctx context.Context, c client.Reader, networkchaos *v1alpha1.NetworkChaos) ([]*pb.NodePort, error) {
+	spec := networkchaos.Spec.NodePort
+	if spec == nil {
+		return nil, errors.New("nodePort is required by action:nodeport")
+	}
+
+	var ports []*pb.NodePort
+	seen := make(map[string]bool)
+	add := func(protocol string, port int32) {
+		key := fmt.Sprintf("%s/%d", protocol, port)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		ports = append(ports, &pb.NodePort{Protocol: protocol, Port: port})
+	}
+
+	for _, port := range spec.Ports {
+		add("tcp", port)
+		add("udp", port)
+	}
+
+	for _, ref := range spec.Services {
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = networkchaos.Namespace
+		}
+		var service v1.Service
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, &service); err != nil {
+			return nil, err
+		}
+
+		if spec.HealthCheck {
+			// the health check node port is only allocated to the LoadBalancer Services whose
+			// externalTrafficPolicy is Local, and it's served by kube-proxy over http
+			if service.Spec.HealthCheckNodePort == 0 {
+				return nil, fmt.Errorf("service %s/%s has no health check node port", namespace, ref.Name)
+			}
+			add("tcp", service.Spec.HealthCheckNodePort)
+			continue
+		}
+
+		found := false
+		for _, port := range service.Spec.Ports {
+			if port.NodePort == 0 {
+				continue
+			}
+			found = true
+			add(strings.ToLower(string(port.Protocol)), port.NodePort)
+		}
+		if !found {
+			return nil, fmt.Errorf("service %s/%s has no node ports", namespace, ref.Name)
+		}
+	}
+
+	return ports, nil
+}
+
+func formatNodePorts(ports []*pb.NodePort) string {
+	formatted := make([]string, 0, len(ports))
+	for _, port := range ports {
+		formatted = append(formatted, fmt.Sprintf("%s/%d", port.Protocol, port.Port))
+	}
+	return strings.Join(formatted, ",")
+}
@@ -0,0 +1,168 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodeport
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// nodePortServer is the chaos-daemon which only keeps the blocked node ports of the containers
+type nodePortServer struct {
+	pb.ChaosDaemonServer
+
+	sync.Mutex
+	ports map[string][]*pb.NodePort
+}
+
+func (s *nodePortServer) GetCapabilities(context.Context, *empty.Empty) (*pb.CapabilitiesResponse, error) {
+	return &pb.CapabilitiesResponse{
+		Os:      "linux",
+		Methods: []string{"BlockNodePorts", "UnblockNodePorts"},
+	}, nil
+}
+
+func (s *nodePortServer) BlockNodePorts(ctx context.Context, in *pb.NodePortsRequest) (*empty.Empty, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.ports[in.ContainerId] = in.Ports
+	return &empty.Empty{}, nil
+}
+
+func (s *nodePortServer) UnblockNodePorts(ctx context.Context, in *pb.NodePortsRequest) (*empty.Empty, error) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.ports, in.ContainerId)
+	return &empty.Empty{}, nil
+}
+
+func TestResolveNodePorts(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := fake.NewFakeClientWithScheme(scheme.Scheme,
+		&v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web"},
+			Spec: v1.ServiceSpec{
+				Type: v1.ServiceTypeLoadBalancer,
+				Ports: []v1.ServicePort{
+					{Protocol: v1.ProtocolTCP, Port: 80, NodePort: 30080},
+					{Protocol: v1.ProtocolUDP, Port: 53, NodePort: 30053},
+				},
+				HealthCheckNodePort: 32000,
+			},
+		},
+		&v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "internal"},
+			Spec: v1.ServiceSpec{
+				Type:  v1.ServiceTypeClusterIP,
+				Ports: []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: 80}},
+			},
+		},
+	)
+	chaos := func(spec *v1alpha1.NodePortSpec) *v1alpha1.NetworkChaos {
+		return &v1alpha1.NetworkChaos{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "nodeport"},
+			Spec:       v1alpha1.NetworkChaosSpec{Action: v1alpha1.NodePortAction, NodePort: spec},
+		}
+	}
+
+	ports, err := ResolveNodePorts(context.TODO(), c, chaos(&v1alpha1.NodePortSpec{
+		Ports:    []int32{30080},
+		Services: []v1alpha1.ServiceReference{{Namespace: "shop", Name: "web"}},
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(formatNodePorts(ports)).To(Equal("tcp/30080,udp/30080,udp/30053"))
+
+	ports, err = ResolveNodePorts(context.TODO(), c, chaos(&v1alpha1.NodePortSpec{
+		Services:    []v1alpha1.ServiceReference{{Namespace: "shop", Name: "web"}},
+		HealthCheck: true,
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(formatNodePorts(ports)).To(Equal("tcp/32000"))
+
+	_, err = ResolveNodePorts(context.TODO(), c, chaos(&v1alpha1.NodePortSpec{
+		Services: []v1alpha1.ServiceReference{{Name: "internal"}},
+	}))
+	g.Expect(err).To(MatchError("service default/internal has no node ports"))
+}
+
+func TestApplyAndRecover(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	daemon := &nodePortServer{ports: make(map[string][]*pb.NodePort)}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ToNot(HaveOccurred())
+	server := grpc.NewServer()
+	pb.RegisterChaosDaemonServer(server, daemon)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	port := common.ControllerCfg.ChaosDaemonPort
+	common.ControllerCfg.ChaosDaemonPort = listener.Addr().(*net.TCPAddr).Port
+	defer func() { common.ControllerCfg.ChaosDaemonPort = port }()
+
+	pod := func(name, containerID string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name},
+			Spec:       v1.PodSpec{NodeName: "n1"},
+			Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{{ContainerID: containerID}},
+			},
+		}
+	}
+	p1, p2 := pod("p1", "containerd://c1"), pod("p2", "containerd://c2")
+	c := fake.NewFakeClientWithScheme(scheme.Scheme,
+		&v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+			Status:     v1.NodeStatus{Addresses: []v1.NodeAddress{{Type: v1.NodeInternalIP, Address: "127.0.0.1"}}},
+		},
+		p1, p2,
+	)
+	r := &Reconciler{Client: c, EventRecorder: record.NewFakeRecorder(10), Log: ctrl.Log}
+
+	chaos := &v1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "nodeport"},
+		Spec: v1alpha1.NetworkChaosSpec{
+			Action:   v1alpha1.NodePortAction,
+			NodePort: &v1alpha1.NodePortSpec{Ports: []int32{30080}},
+		},
+	}
+	ports := []*pb.NodePort{{Protocol: "tcp", Port: 30080}}
+	err = r.applyAllPods(context.TODO(), []v1.Pod{*p1, *p2}, chaos, ports)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(daemon.ports).To(HaveLen(2))
+	g.Expect(daemon.ports["containerd://c1"][0].Port).To(Equal(int32(30080)))
+	g.Expect(chaos.Finalizers).To(ConsistOf("default/p1", "default/p2"))
+
+	err = r.cleanFinalizersAndRecover(context.TODO(), chaos)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(daemon.ports).To(BeEmpty())
+	g.Expect(chaos.Finalizers).To(BeEmpty())
+}
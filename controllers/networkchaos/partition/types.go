@@ -20,7 +20,6 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
-	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
 	k8sError "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -31,9 +30,11 @@ import (
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/bpf"
 	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/ipset"
 	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/iptable"
 	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/netutils"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/summary"
 	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
@@ -96,17 +97,21 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 		return err
 	}
 
-	sources, err := utils.SelectAndFilterPods(ctx, r.Client, &networkchaos.Spec)
+	networkchaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	sources, err := utils.SelectAndRecordPods(ctx, r.Client, &networkchaos.Spec, networkchaos.Status.Experiment.Selection)
 
 	if err != nil {
 		r.Log.Error(err, "failed to select and filter pods")
 		return err
 	}
+	common.RecordImpact(ctx, r.Client, networkchaos, sources, false)
 
 	var targets []v1.Pod
 
+	networkchaos.Status.TargetSelection = nil
 	if networkchaos.Spec.Target != nil {
-		targets, err = utils.SelectAndFilterPods(ctx, r.Client, networkchaos.Spec.Target)
+		networkchaos.Status.TargetSelection = &v1alpha1.SelectionStatus{}
+		targets, err = utils.SelectAndRecordPods(ctx, r.Client, networkchaos.Spec.Target, networkchaos.Status.TargetSelection)
 		if err != nil {
 			r.Log.Error(err, "failed to select and filter pods")
 			return err
@@ -119,29 +124,48 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 		r.Log.Error(err, "failed to resolve external targets")
 		return err
 	}
+	serviceCidrs, err := netutils.ResolveServices(ctx, r.Client, networkchaos.Namespace, networkchaos.Spec.TargetServices)
+	if err != nil {
+		r.Log.Error(err, "failed to resolve target services")
+		return err
+	}
 	targetSet := ipset.BuildIPSet(targets, externalCidrs, networkchaos, targetIpSetPostFix)
 
 	allPods := append(sources, targets...)
 
+	networkchaos.Status.Rules = nil
+	networkchaos.Status.TargetIPSet = nil
+
+	targetCidrs := append(append([]string(nil), targetSet.Cidrs...), serviceCidrs...)
+	sources, targets, err = r.applyBpf(ctx, sources, targets, sourceSet.Cidrs, targetCidrs, networkchaos)
+	if err != nil {
+		r.Log.Error(err, "failed to block by bpf")
+		return err
+	}
+
 	// Set up ipset in every related pods
-	g := errgroup.Group{}
-	for index := range allPods {
-		pod := allPods[index]
+	others := append(append([]v1.Pod(nil), sources...), targets...)
+	related := make([]*v1.Pod, 0, len(others))
+	for index := range others {
+		pod := &others[index]
 		r.Log.Info("PODS", "name", pod.Name, "namespace", pod.Namespace)
-		g.Go(func() error {
-			err = ipset.FlushIpSet(ctx, r.Client, &pod, &sourceSet)
-			if err != nil {
-				return err
-			}
+		related = append(related, pod)
+	}
 
-			r.Log.Info("Flush ipset on pod", "name", pod.Name, "namespace", pod.Namespace)
-			return ipset.FlushIpSet(ctx, r.Client, &pod, &targetSet)
-		})
+	if len(networkchaos.Spec.TargetServices) > 0 {
+		targetIPSet, err := ipset.NewTargetIPSet(&targetSet, serviceCidrs, related)
+		if err != nil {
+			return err
+		}
+		networkchaos.Status.TargetIPSet = targetIPSet
+		targetSet.Cidrs = append(targetSet.Cidrs, serviceCidrs...)
 	}
 
-	if err = g.Wait(); err != nil {
-		r.Log.Error(err, "flush pod ipset error")
-		return err
+	for _, set := range []*pb.IpSet{&sourceSet, &targetSet} {
+		if err = ipset.BatchFlushIpSet(ctx, r.Client, related, set); err != nil {
+			r.Log.Error(err, "flush pod ipset error")
+			return err
+		}
 	}
 
 	if networkchaos.Spec.Direction == v1alpha1.To || networkchaos.Spec.Direction == v1alpha1.Both {
@@ -191,9 +215,10 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 
 // BlockSet blocks ipset for pods
 func (r *Reconciler) BlockSet(ctx context.Context, pods []v1.Pod, set *pb.IpSet, direction pb.Rule_Direction, networkchaos *v1alpha1.NetworkChaos) error {
-	g := errgroup.Group{}
 	sourceRule := iptable.GenerateIPTables(pb.Rule_ADD, direction, set.Name)
+	sourceRule.Mesh = networkchaos.Spec.ServiceMesh == v1alpha1.AutoServiceMesh
 
+	targets := make([]*v1.Pod, 0, len(pods))
 	for index := range pods {
 		pod := &pods[index]
 
@@ -209,11 +234,121 @@ func (r *Reconciler) BlockSet(ctx context.Context, pods []v1.Pod, set *pb.IpSet,
 			networkchaos.Finalizers = utils.InsertFinalizer(networkchaos.Finalizers, "output"+key)
 		}
 
-		g.Go(func() error {
-			return iptable.FlushIptables(ctx, r.Client, pod, &sourceRule)
-		})
+		targets = append(targets, pod)
 	}
-	return g.Wait()
+	if err := iptable.BatchFlushIptables(ctx, r.Client, targets, &sourceRule); err != nil {
+		return err
+	}
+
+	for _, pod := range targets {
+		rules := networkchaos.Status.RulesOf(pod.Namespace, pod.Name)
+		rules.Iptables = append(rules.Iptables, summary.Iptables(&sourceRule))
+		summary.AddIPSet(rules, set)
+	}
+	return nil
+}
+
+// applyBpf blocks the packets by the tc-bpf backend on the sources and targets on the nodes which choose it,
+// and returns the other sources and targets, which are blocked by iptables
+func (r *Reconciler) applyBpf(ctx context.Context, sources, targets []v1.Pod, sourceCidrs, targetCidrs []string,
+	networkchaos *v1alpha1.NetworkChaos) ([]v1.Pod, []v1.Pod, error) {
+	requirement := bpf.Requirement(string(networkchaos.Spec.Action), false)
+	bpfSources, otherSources, err := bpf.Split(ctx, r.Client, sources, requirement)
+	if err != nil {
+		return nil, nil, err
+	}
+	bpfTargets, otherTargets, err := bpf.Split(ctx, r.Client, targets, requirement)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(bpfSources)+len(bpfTargets) == 0 {
+		return sources, targets, nil
+	}
+
+	to := networkchaos.Spec.Direction == v1alpha1.To || networkchaos.Spec.Direction == v1alpha1.Both
+	from := networkchaos.Spec.Direction == v1alpha1.From || networkchaos.Spec.Direction == v1alpha1.Both
+
+	// the pod which is both a source and a target blocks the cidrs of both
+	faults := make(map[string]*pb.BpfFaultRequest)
+	var pods []*v1.Pod
+	block := func(pod *v1.Pod, egress, ingress []string) error {
+		if len(egress)+len(ingress) == 0 {
+			return nil
+		}
+		key, err := cache.MetaNamespaceKeyFunc(pod)
+		if err != nil {
+			return err
+		}
+		fault, ok := faults[key]
+		if !ok {
+			fault = &pb.BpfFaultRequest{Device: networkchaos.Spec.Device, Partition: true}
+			faults[key] = fault
+			pods = append(pods, pod)
+			networkchaos.Finalizers = utils.InsertFinalizer(networkchaos.Finalizers, bpf.FinalizerPrefix+key)
+		}
+		fault.EgressCidrs = appendUnique(fault.EgressCidrs, egress...)
+		fault.IngressCidrs = appendUnique(fault.IngressCidrs, ingress...)
+		return nil
+	}
+	for index := range bpfSources {
+		var egress, ingress []string
+		if to {
+			egress = targetCidrs
+		}
+		if from {
+			ingress = targetCidrs
+		}
+		if err := block(&bpfSources[index], egress, ingress); err != nil {
+			return nil, nil, err
+		}
+	}
+	for index := range bpfTargets {
+		var egress, ingress []string
+		if to {
+			ingress = sourceCidrs
+		}
+		if from {
+			egress = sourceCidrs
+		}
+		if err := block(&bpfTargets[index], egress, ingress); err != nil {
+			return nil, nil, err
+		}
+	}
+	r.Log.Info("block by bpf", "pods", len(pods))
+
+	keyOf := func(pod *v1.Pod) string {
+		key, _ := cache.MetaNamespaceKeyFunc(pod)
+		return key
+	}
+	errs := bpf.BatchSet(ctx, r.Client, pods, string(networkchaos.Spec.Action), func(pod *v1.Pod) *pb.BpfFaultRequest {
+		return faults[keyOf(pod)]
+	})
+	for i, err := range errs {
+		if err != nil {
+			continue
+		}
+		rules := networkchaos.Status.RulesOf(pods[i].Namespace, pods[i].Name)
+		rules.Device = networkchaos.Spec.GetDevice()
+		rules.Programs = append(rules.Programs, summary.Programs(faults[keyOf(pods[i])])...)
+	}
+
+	return otherSources, otherTargets, utils.MergeErrors(errs)
+}
+
+func appendUnique(cidrs []string, added ...string) []string {
+	for _, cidr := range added {
+		found := false
+		for _, c := range cidrs {
+			if c == cidr {
+				found = true
+				break
+			}
+		}
+		if !found {
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	return cidrs
 }
 
 // Recover implements the reconciler.InnerReconciler.Recover
@@ -230,6 +365,8 @@ func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alph
 		r.Log.Error(err, "cleanFinalizersAndRecover failed")
 		return err
 	}
+	networkchaos.Status.Rules = nil
+	networkchaos.Status.TargetIPSet = nil
 	r.Event(networkchaos, v1.EventTypeNormal, utils.EventChaosRecovered, "")
 
 	return nil
@@ -238,6 +375,10 @@ func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alph
 func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, networkchaos *v1alpha1.NetworkChaos) error {
 	var result error
 
+	var (
+		bpfKeys []string
+		bpfPods []*v1.Pod
+	)
 	for _, key := range networkchaos.Finalizers {
 		direction := key[0:6]
 
@@ -265,18 +406,25 @@ func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, networkchaos
 			continue
 		}
 
+		if direction == bpf.FinalizerPrefix {
+			bpfKeys = append(bpfKeys, key)
+			bpfPods = append(bpfPods, &pod)
+			continue
+		}
+
 		var rule pb.Rule
 
 		if networkchaos.Spec.Direction != v1alpha1.From {
 			switch direction {
 			case "output":
-				set := ipset.GenerateIPSetName(networkchaos, targetIpSetPostFix)
+				set := ipset.NameOf(networkchaos, ns, name, targetIpSetPostFix)
 				rule = iptable.GenerateIPTables(pb.Rule_DELETE, pb.Rule_OUTPUT, set)
 			case "input-":
-				set := ipset.GenerateIPSetName(networkchaos, sourceIpSetPostFix)
+				set := ipset.NameOf(networkchaos, ns, name, sourceIpSetPostFix)
 				rule = iptable.GenerateIPTables(pb.Rule_DELETE, pb.Rule_INPUT, set)
 			}
 
+			rule.Mesh = networkchaos.Spec.ServiceMesh == v1alpha1.AutoServiceMesh
 			err = iptable.FlushIptables(ctx, r.Client, &pod, &rule)
 			if err != nil {
 				r.Log.Error(err, "error while deleting iptables rules")
@@ -288,13 +436,14 @@ func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, networkchaos
 		if networkchaos.Spec.Direction != v1alpha1.To {
 			switch direction {
 			case "output":
-				set := ipset.GenerateIPSetName(networkchaos, sourceIpSetPostFix)
+				set := ipset.NameOf(networkchaos, ns, name, sourceIpSetPostFix)
 				rule = iptable.GenerateIPTables(pb.Rule_DELETE, pb.Rule_OUTPUT, set)
 			case "input-":
-				set := ipset.GenerateIPSetName(networkchaos, targetIpSetPostFix)
+				set := ipset.NameOf(networkchaos, ns, name, targetIpSetPostFix)
 				rule = iptable.GenerateIPTables(pb.Rule_DELETE, pb.Rule_INPUT, set)
 			}
 
+			rule.Mesh = networkchaos.Spec.ServiceMesh == v1alpha1.AutoServiceMesh
 			err = iptable.FlushIptables(ctx, r.Client, &pod, &rule)
 			if err != nil {
 				r.Log.Error(err, "error while deleting iptables rules")
@@ -305,6 +454,15 @@ func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, networkchaos
 
 		networkchaos.Finalizers = utils.RemoveFromFinalizer(networkchaos.Finalizers, key)
 	}
+
+	for i, err := range bpf.BatchDelete(ctx, r.Client, bpfPods, networkchaos.Spec.Device) {
+		if err != nil {
+			r.Log.Error(err, "error while deleting bpf programs")
+			result = multierror.Append(result, err)
+			continue
+		}
+		networkchaos.Finalizers = utils.RemoveFromFinalizer(networkchaos.Finalizers, bpfKeys[i])
+	}
 	r.Log.Info("After recovering", "finalizers", networkchaos.Finalizers)
 
 	if networkchaos.Annotations[common.AnnotationCleanFinalizer] == common.AnnotationCleanFinalizerForced {
@@ -0,0 +1,110 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkchaos
+
+import (
+	"context"
+	"reflect"
+
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/ipset"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/netutils"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/summary"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// syncTargetServices flushes the ipset of the targets again if the endpoints of the target services
+// have changed since the chaos was applied
+func (r *Reconciler) syncTargetServices(ctx context.Context, chaos *v1alpha1.NetworkChaos) error {
+	target := chaos.Status.TargetIPSet
+	if target == nil || chaos.IsDeleted() || chaos.IsPaused() ||
+		chaos.Status.Experiment.Phase != v1alpha1.ExperimentPhaseRunning {
+		return nil
+	}
+
+	serviceCidrs, err := netutils.ResolveServices(ctx, r.Client, chaos.Namespace, chaos.Spec.TargetServices)
+	if err != nil {
+		return err
+	}
+	if len(serviceCidrs) == len(target.ServiceCidrs) &&
+		(len(serviceCidrs) == 0 || reflect.DeepEqual(serviceCidrs, target.ServiceCidrs)) {
+		return nil
+	}
+	r.Log.Info("Endpoints of target services changed", "old", target.ServiceCidrs, "new", serviceCidrs)
+
+	var pods []*v1.Pod
+	for _, key := range target.Pods {
+		ns, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return err
+		}
+
+		var pod v1.Pod
+		if err := r.Get(ctx, types.NamespacedName{Namespace: ns, Name: name}, &pod); err != nil {
+			if k8serror.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		pods = append(pods, &pod)
+	}
+
+	set := &pb.IpSet{
+		Name:  target.Name,
+		Cidrs: append(append([]string(nil), target.Cidrs...), serviceCidrs...),
+	}
+	if err := ipset.BatchFlushIpSet(ctx, r.Client, pods, set); err != nil {
+		return err
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var latest v1alpha1.NetworkChaos
+		if err := r.Get(ctx, types.NamespacedName{Namespace: chaos.Namespace, Name: chaos.Name}, &latest); err != nil {
+			return err
+		}
+		if latest.Status.TargetIPSet == nil || latest.Status.TargetIPSet.Name != set.Name {
+			return nil
+		}
+
+		latest.Status.TargetIPSet.ServiceCidrs = serviceCidrs
+		for i := range latest.Status.Rules {
+			for j := range latest.Status.Rules[i].IPSets {
+				if latest.Status.Rules[i].IPSets[j].Name == set.Name {
+					latest.Status.Rules[i].IPSets[j] = summary.IPSet(set)
+				}
+			}
+		}
+		return r.Status().Update(ctx, &latest)
+	})
+}
+
+// TargetsService returns whether the chaos targets the service
+func TargetsService(chaos *v1alpha1.NetworkChaos, namespace, name string) bool {
+	for _, ref := range chaos.Spec.TargetServices {
+		ns := ref.Namespace
+		if ns == "" {
+			ns = chaos.Namespace
+		}
+		if ns == namespace && ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package summary
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// Handle describes the tc handle like `tc`, e.g. "1:4" or "40:"
+func Handle(handle *pb.TcHandle) string {
+	if handle == nil {
+		return "root"
+	}
+	if handle.Minor == 0 {
+		return fmt.Sprintf("%d:", handle.Major)
+	}
+	return fmt.Sprintf("%d:%d", handle.Major, handle.Minor)
+}
+
+func location(parent, handle *pb.TcHandle) string {
+	loc := "root"
+	if parent != nil && !(parent.Major == 1 && parent.Minor == 0) {
+		loc = "parent " + Handle(parent)
+	}
+	if handle != nil {
+		loc += " handle " + Handle(handle)
+	}
+	return loc
+}
+
+// Qdisc describes the qdisc, e.g. "root handle 1: prio bands 4"
+func Qdisc(qdisc *pb.Qdisc) string {
+	parts := []string{location(qdisc.Parent, qdisc.Handle), qdisc.Type}
+	parts = append(parts, qdisc.Args...)
+	return strings.Join(parts, " ")
+}
+
+func percent(name string, value, corr float32) string {
+	if corr == 0 {
+		return fmt.Sprintf(" %s %g%%", name, value)
+	}
+	return fmt.Sprintf(" %s %g%% %g%%", name, value, corr)
+}
+
+// Netem describes the netem qdisc, e.g. "root handle 1: netem delay 10ms 1ms 25%"
+func Netem(netem *pb.Netem) string {
+	var b strings.Builder
+	b.WriteString(location(netem.Parent, netem.Handle))
+	b.WriteString(" netem")
+	if netem.Time > 0 {
+		b.WriteString(" delay ")
+		b.WriteString((time.Duration(netem.Time) * time.Microsecond).String())
+		if netem.Jitter > 0 {
+			b.WriteString(" ")
+			b.WriteString((time.Duration(netem.Jitter) * time.Microsecond).String())
+			if netem.DelayCorr > 0 {
+				fmt.Fprintf(&b, " %g%%", netem.DelayCorr)
+			}
+		}
+	}
+	if netem.Limit > 0 {
+		fmt.Fprintf(&b, " limit %d", netem.Limit)
+	}
+	if netem.Loss > 0 {
+		b.WriteString(percent("loss", netem.Loss, netem.LossCorr))
+	}
+	if netem.Duplicate > 0 {
+		b.WriteString(percent("duplicate", netem.Duplicate, netem.DuplicateCorr))
+	}
+	if netem.Reorder > 0 {
+		b.WriteString(percent("reorder", netem.Reorder, netem.ReorderCorr))
+		if netem.Gap > 0 {
+			fmt.Fprintf(&b, " gap %d", netem.Gap)
+		}
+	}
+	if netem.Corrupt > 0 {
+		b.WriteString(percent("corrupt", netem.Corrupt, netem.CorruptCorr))
+	}
+	return b.String()
+}
+
+// Tbf describes the tbf qdisc, e.g. "root handle 1: tbf rate 1000bps limit 100 buffer 10"
+func Tbf(tbf *pb.Tbf) string {
+	desc := fmt.Sprintf("root handle 1: tbf rate %dbps limit %d buffer %d", tbf.Rate, tbf.Limit, tbf.Buffer)
+	if tbf.PeakRate > 0 {
+		desc += fmt.Sprintf(" peakrate %dbps minburst %d", tbf.PeakRate, tbf.MinBurst)
+	}
+	return desc
+}
+
+// Filter describes the ematch filter, e.g. "parent 1: basic match 'ipset(name dst)' classid 1:4"
+func Filter(filter *pb.EmatchFilter) string {
+	return fmt.Sprintf("parent %s basic match '%s' classid %s",
+		Handle(filter.Parent), filter.Match, Handle(filter.Classid))
+}
+
+// Programs describes the tc-bpf programs of the bpf fault on each direction,
+// e.g. "egress delay 10ms 1ms loss 25% to 2 cidrs" or "ingress drop from 1 cidrs"
+func Programs(fault *pb.BpfFaultRequest) []string {
+	if fault.Partition {
+		var programs []string
+		if len(fault.EgressCidrs) > 0 {
+			programs = append(programs, fmt.Sprintf("egress drop to %d cidrs", len(fault.EgressCidrs)))
+		}
+		if len(fault.IngressCidrs) > 0 {
+			programs = append(programs, fmt.Sprintf("ingress drop from %d cidrs", len(fault.IngressCidrs)))
+		}
+		return programs
+	}
+
+	var b strings.Builder
+	b.WriteString("egress")
+	if fault.Delay > 0 {
+		b.WriteString(" delay ")
+		b.WriteString((time.Duration(fault.Delay) * time.Microsecond).String())
+		if fault.Jitter > 0 {
+			b.WriteString(" ")
+			b.WriteString((time.Duration(fault.Jitter) * time.Microsecond).String())
+		}
+	}
+	if fault.Loss > 0 {
+		fmt.Fprintf(&b, " loss %g%%", fault.Loss)
+	}
+	if len(fault.EgressCidrs) > 0 {
+		fmt.Fprintf(&b, " to %d cidrs", len(fault.EgressCidrs))
+	}
+	return []string{b.String()}
+}
+
+// Iptables describes the iptables rule with its chain like chaos-daemon applies it,
+// e.g. "OUTPUT -m set --match-set name dst -j DROP"
+func Iptables(rule *pb.Rule) string {
+	switch rule.Direction {
+	case pb.Rule_INPUT:
+		return fmt.Sprintf("INPUT -m set --match-set %s src -j DROP", rule.Set)
+	case pb.Rule_OUTPUT:
+		return fmt.Sprintf("OUTPUT -m set --match-set %s dst -j DROP", rule.Set)
+	}
+	return fmt.Sprintf("%s -m set --match-set %s -j DROP", rule.Direction, rule.Set)
+}
+
+// IPSet summarizes the ipset by the number and the hash of its cidrs
+func IPSet(set *pb.IpSet) v1alpha1.IPSetSummary {
+	cidrs := append([]string(nil), set.Cidrs...)
+	sort.Strings(cidrs)
+
+	hasher := sha1.New()
+	for _, cidr := range cidrs {
+		hasher.Write([]byte(cidr))
+		hasher.Write([]byte{'\n'})
+	}
+
+	return v1alpha1.IPSetSummary{
+		Name:    set.Name,
+		Entries: len(cidrs),
+		Hash:    fmt.Sprintf("%x", hasher.Sum(nil)),
+	}
+}
+
+// AddIPSet adds the summary of the ipset to the rules, the existing one with the same name is replaced
+func AddIPSet(rules *v1alpha1.NetworkRules, set *pb.IpSet) {
+	summary := IPSet(set)
+	for i := range rules.IPSets {
+		if rules.IPSets[i].Name == summary.Name {
+			rules.IPSets[i] = summary
+			return
+		}
+	}
+	rules.IPSets = append(rules.IPSets, summary)
+}
@@ -0,0 +1,115 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package summary
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func TestQdisc(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(Qdisc(&pb.Qdisc{
+		Parent: &pb.TcHandle{Major: 1, Minor: 0},
+		Handle: &pb.TcHandle{Major: 1, Minor: 0},
+		Type:   "prio",
+		Args:   []string{"bands", "4"},
+	})).To(Equal("root handle 1: prio bands 4"))
+
+	g.Expect(Qdisc(&pb.Qdisc{
+		Parent: &pb.TcHandle{Major: 1, Minor: 1},
+		Handle: &pb.TcHandle{Major: 10, Minor: 0},
+		Type:   "sfq",
+	})).To(Equal("parent 1:1 handle 10: sfq"))
+}
+
+func TestNetem(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(Netem(&pb.Netem{
+		Time:      10000,
+		Jitter:    1000,
+		DelayCorr: 25,
+		Loss:      10,
+		Parent:    &pb.TcHandle{Major: 1, Minor: 4},
+		Handle:    &pb.TcHandle{Major: 40, Minor: 0},
+	})).To(Equal("parent 1:4 handle 40: netem delay 10ms 1ms 25% loss 10%"))
+
+	g.Expect(Netem(&pb.Netem{
+		Duplicate:     5,
+		DuplicateCorr: 50,
+		Corrupt:       1.5,
+	})).To(Equal("root netem duplicate 5% 50% corrupt 1.5%"))
+}
+
+func TestTbfAndFilter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(Tbf(&pb.Tbf{Rate: 1000, Limit: 100, Buffer: 10})).
+		To(Equal("root handle 1: tbf rate 1000bps limit 100 buffer 10"))
+
+	g.Expect(Filter(&pb.EmatchFilter{
+		Match:   "ipset(set dst)",
+		Parent:  &pb.TcHandle{Major: 1, Minor: 0},
+		Classid: &pb.TcHandle{Major: 1, Minor: 4},
+	})).To(Equal("parent 1: basic match 'ipset(set dst)' classid 1:4"))
+}
+
+func TestIptables(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(Iptables(&pb.Rule{Direction: pb.Rule_INPUT, Set: "src_set"})).
+		To(Equal("INPUT -m set --match-set src_set src -j DROP"))
+	g.Expect(Iptables(&pb.Rule{Direction: pb.Rule_OUTPUT, Set: "dst_set"})).
+		To(Equal("OUTPUT -m set --match-set dst_set dst -j DROP"))
+}
+
+func TestIPSet(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	a := IPSet(&pb.IpSet{Name: "set", Cidrs: []string{"10.0.0.1/32", "10.0.0.2/32"}})
+	b := IPSet(&pb.IpSet{Name: "set", Cidrs: []string{"10.0.0.2/32", "10.0.0.1/32"}})
+	c := IPSet(&pb.IpSet{Name: "set", Cidrs: []string{"10.0.0.3/32"}})
+	g.Expect(a.Entries).To(Equal(2))
+	g.Expect(a.Hash).To(Equal(b.Hash))
+	g.Expect(a.Hash).ToNot(Equal(c.Hash))
+
+	rules := &v1alpha1.NetworkRules{}
+	AddIPSet(rules, &pb.IpSet{Name: "set", Cidrs: []string{"10.0.0.1/32"}})
+	AddIPSet(rules, &pb.IpSet{Name: "set", Cidrs: []string{"10.0.0.1/32", "10.0.0.2/32"}})
+	g.Expect(rules.IPSets).To(HaveLen(1))
+	g.Expect(rules.IPSets[0].Entries).To(Equal(2))
+}
+
+func TestPrograms(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(Programs(&pb.BpfFaultRequest{
+		Delay:       10000,
+		Jitter:      1000,
+		Loss:        25,
+		EgressCidrs: []string{"10.0.0.1/32", "10.0.0.2/32"},
+	})).To(Equal([]string{"egress delay 10ms 1ms loss 25% to 2 cidrs"}))
+
+	g.Expect(Programs(&pb.BpfFaultRequest{
+		Partition:    true,
+		EgressCidrs:  []string{"10.0.0.1/32"},
+		IngressCidrs: []string{"10.0.0.1/32", "10.0.0.2/32"},
+	})).To(Equal([]string{"egress drop to 1 cidrs", "ingress drop from 2 cidrs"}))
+}
@@ -17,11 +17,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
-	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
 	k8serror "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -32,6 +30,7 @@ import (
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/summary"
 	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
@@ -85,13 +84,16 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 		return err
 	}
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, &networkchaos.Spec)
+	networkchaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	pods, err := utils.SelectAndRecordPods(ctx, r.Client, &networkchaos.Spec, networkchaos.Status.Experiment.Selection)
 
 	if err != nil {
 		r.Log.Error(err, "failed to select and filter pods")
 		return err
 	}
+	common.RecordImpact(ctx, r.Client, networkchaos, pods, false)
 
+	networkchaos.Status.Rules = nil
 	err = r.applyAllPods(ctx, pods, networkchaos)
 	if err != nil {
 		return err
@@ -118,7 +120,12 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 }
 
 func (r *Reconciler) applyAllPods(ctx context.Context, pods []v1.Pod, networkchaos *v1alpha1.NetworkChaos) error {
-	g := errgroup.Group{}
+	tbf, err := networkchaos.Spec.Bandwidth.ToTbf()
+	if err != nil {
+		return err
+	}
+
+	targets := make([]*v1.Pod, 0, len(pods))
 	for index := range pods {
 		pod := &pods[index]
 
@@ -128,45 +135,42 @@ func (r *Reconciler) applyAllPods(ctx context.Context, pods []v1.Pod, networkcha
 		}
 		networkchaos.Finalizers = utils.InsertFinalizer(networkchaos.Finalizers, key)
 
-		g.Go(func() error {
-			return r.applyPod(ctx, pod, networkchaos)
-		})
+		r.Log.Info("Try to apply tbf on pod", "namespace", pod.Namespace, "name", pod.Name)
+		targets = append(targets, pod)
 	}
 
-	return g.Wait()
-}
-
-func (r *Reconciler) applyPod(ctx context.Context, pod *v1.Pod, networkchaos *v1alpha1.NetworkChaos) error {
-	r.Log.Info("Try to apply tbf on pod", "namespace", pod.Namespace, "name", pod.Name)
-
-	action := string(networkchaos.Spec.Action)
-	action = strings.Title(action)
-
-	spec := networkchaos.Spec.Bandwidth
-
-	pbClient, err := utils.NewChaosDaemonClient(ctx, r.Client, pod, common.ControllerCfg.ChaosDaemonPort)
-	if err != nil {
-		return err
-	}
-	defer pbClient.Close()
-
-	if len(pod.Status.ContainerStatuses) == 0 {
-		return fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
-	}
+	errs := utils.BatchByNode(ctx, r.Client, targets, common.ControllerCfg.ChaosDaemonPort,
+		func(ctx context.Context, pbClient utils.ChaosDaemonClientInterface, node string, containerIDs []string) (*pb.BatchResponse, error) {
+			if err := utils.CheckCapabilities(ctx, pbClient, node, utils.Requirement{
+				Action:            string(networkchaos.Spec.Action),
+				Modules:           []string{"sch_tbf"},
+				LinuxCapabilities: utils.NetworkCapabilities,
+				Methods:           []string{"BatchSetTbf"},
+			}); err != nil {
+				return nil, err
+			}
 
-	containerID := pod.Status.ContainerStatuses[0].ContainerID
+			req := &pb.BatchTbfRequest{}
+			for _, containerID := range containerIDs {
+				req.Requests = append(req.Requests, &pb.TbfRequest{
+					Tbf:         tbf,
+					ContainerId: containerID,
+					Device:      networkchaos.Spec.Device,
+				})
+			}
+			return pbClient.BatchSetTbf(ctx, req)
+		})
 
-	tbf, err := spec.ToTbf()
-	if err != nil {
-		return err
+	for i, err := range errs {
+		if err != nil {
+			continue
+		}
+		rules := networkchaos.Status.RulesOf(targets[i].Namespace, targets[i].Name)
+		rules.Device = networkchaos.Spec.GetDevice()
+		rules.Qdiscs = append(rules.Qdiscs, summary.Tbf(tbf))
 	}
 
-	_, err = pbClient.SetTbf(ctx, &pb.TbfRequest{
-		Tbf:         tbf,
-		ContainerId: containerID,
-	})
-
-	return err
+	return utils.MergeErrors(errs)
 }
 
 // Recover implements the reconciler.InnerReconciler.Recover
@@ -181,6 +185,7 @@ func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alph
 	if err := r.cleanFinalizersAndRecover(ctx, networkchaos); err != nil {
 		return err
 	}
+	networkchaos.Status.Rules = nil
 	r.Event(networkchaos, v1.EventTypeNormal, utils.EventChaosRecovered, "")
 	return nil
 }
@@ -188,6 +193,10 @@ func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alph
 func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, networkchaos *v1alpha1.NetworkChaos) error {
 	var result error
 
+	var (
+		keys []string
+		pods []*v1.Pod
+	)
 	for _, key := range networkchaos.Finalizers {
 		ns, name, err := cache.SplitMetaNamespaceKey(key)
 		if err != nil {
@@ -212,13 +221,32 @@ func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, networkchaos
 			continue
 		}
 
-		err = r.recoverPod(ctx, &pod, networkchaos)
+		r.Log.Info("Try to recover pod", "namespace", pod.Namespace, "name", pod.Name)
+		keys = append(keys, key)
+		pods = append(pods, &pod)
+	}
+
+	errs := utils.BatchByNode(ctx, r.Client, pods, common.ControllerCfg.ChaosDaemonPort,
+		func(ctx context.Context, pbClient utils.ChaosDaemonClientInterface, _ string, containerIDs []string) (*pb.BatchResponse, error) {
+			req := &pb.BatchTbfRequest{}
+			for _, containerID := range containerIDs {
+				req.Requests = append(req.Requests, &pb.TbfRequest{
+					Tbf:         nil,
+					ContainerId: containerID,
+					Device:      networkchaos.Spec.Device,
+				})
+			}
+			return pbClient.BatchDeleteTbf(ctx, req)
+		})
+	for i, err := range errs {
 		if err != nil {
+			r.Log.Error(err, "recover pod error", "namespace", pods[i].Namespace, "name", pods[i].Name)
 			result = multierror.Append(result, err)
 			continue
 		}
 
-		networkchaos.Finalizers = utils.RemoveFromFinalizer(networkchaos.Finalizers, key)
+		r.Log.Info("Recover pod finished", "namespace", pods[i].Namespace, "name", pods[i].Name)
+		networkchaos.Finalizers = utils.RemoveFromFinalizer(networkchaos.Finalizers, keys[i])
 	}
 
 	if networkchaos.Annotations[common.AnnotationCleanFinalizer] == common.AnnotationCleanFinalizerForced {
@@ -229,32 +257,3 @@ func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, networkchaos
 
 	return result
 }
-
-func (r *Reconciler) recoverPod(ctx context.Context, pod *v1.Pod, _ *v1alpha1.NetworkChaos) error {
-	r.Log.Info("Try to recover pod", "namespace", pod.Namespace, "name", pod.Name)
-
-	pbClient, err := utils.NewChaosDaemonClient(ctx, r.Client, pod, common.ControllerCfg.ChaosDaemonPort)
-	if err != nil {
-		return err
-	}
-	defer pbClient.Close()
-
-	if len(pod.Status.ContainerStatuses) == 0 {
-		return fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
-	}
-
-	containerID := pod.Status.ContainerStatuses[0].ContainerID
-
-	_, err = pbClient.DeleteTbf(ctx, &pb.TbfRequest{
-		Tbf:         nil,
-		ContainerId: containerID,
-	})
-
-	if err != nil {
-		r.Log.Error(err, "recover pod error", "namespace", pod.Namespace, "name", pod.Name)
-	} else {
-		r.Log.Info("Recover pod finished", "namespace", pod.Namespace, "name", pod.Name)
-	}
-
-	return err
-}
@@ -26,7 +26,7 @@ import (
 )
 
 // AddQdisc makes grpc call to chaosdaemon to add qdisc
-func AddQdisc(ctx context.Context, c client.Client, pod *v1.Pod, qdisc *pb.Qdisc) error {
+func AddQdisc(ctx context.Context, c client.Client, pod *v1.Pod, device string, qdisc *pb.Qdisc) error {
 	pbClient, err := utils.NewChaosDaemonClient(ctx, c, pod, common.ControllerCfg.ChaosDaemonPort)
 	if err != nil {
 		return err
@@ -41,13 +41,14 @@ func AddQdisc(ctx context.Context, c client.Client, pod *v1.Pod, qdisc *pb.Qdisc
 	_, err = pbClient.AddQdisc(ctx, &pb.QdiscRequest{
 		Qdisc:       qdisc,
 		ContainerId: containerID,
+		Device:      device,
 	})
 
 	return err
 }
 
 // AddEmatchFilter makes grpc call to chaosdaemon to add ematch filter
-func AddEmatchFilter(ctx context.Context, c client.Client, pod *v1.Pod, filter *pb.EmatchFilter) error {
+func AddEmatchFilter(ctx context.Context, c client.Client, pod *v1.Pod, device string, filter *pb.EmatchFilter) error {
 	pbClient, err := utils.NewChaosDaemonClient(ctx, c, pod, common.ControllerCfg.ChaosDaemonPort)
 	if err != nil {
 		return err
@@ -62,13 +63,14 @@ func AddEmatchFilter(ctx context.Context, c client.Client, pod *v1.Pod, filter *
 	_, err = pbClient.AddEmatchFilter(ctx, &pb.EmatchFilterRequest{
 		Filter:      filter,
 		ContainerId: containerID,
+		Device:      device,
 	})
 
 	return err
 }
 
 // DelQdisc makes grpc to chaosdaemon to delete tc filter
-func DelQdisc(ctx context.Context, c client.Client, pod *v1.Pod, filter *pb.TcFilter) error {
+func DelQdisc(ctx context.Context, c client.Client, pod *v1.Pod, device string, filter *pb.TcFilter) error {
 	pbClient, err := utils.NewChaosDaemonClient(ctx, c, pod, common.ControllerCfg.ChaosDaemonPort)
 	if err != nil {
 		return err
@@ -83,6 +85,7 @@ func DelQdisc(ctx context.Context, c client.Client, pod *v1.Pod, filter *pb.TcFi
 	_, err = pbClient.DelTcFilter(ctx, &pb.TcFilterRequest{
 		Filter:      filter,
 		ContainerId: containerID,
+		Device:      device,
 	})
 
 	return err
@@ -14,6 +14,7 @@
 package networkchaos
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-logr/logr"
@@ -23,7 +24,11 @@ import (
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/conntrack"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/metadata"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/mtu"
 	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/netem"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/nodeport"
 	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/partition"
 	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos/tbf"
 	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
@@ -39,10 +44,15 @@ type Reconciler struct {
 func (r *Reconciler) Reconcile(req ctrl.Request, chaos *v1alpha1.NetworkChaos) (ctrl.Result, error) {
 	r.Log.Info("Reconciling networkchaos")
 
+	// the failure of syncing shouldn't block the chaos from being recovered
+	if err := r.syncTargetServices(context.Background(), chaos); err != nil {
+		r.Log.Error(err, "failed to sync the endpoints of target services")
+	}
+
 	scheduler := chaos.GetScheduler()
 	duration, err := chaos.GetDuration()
 	if err != nil {
-		r.Log.Error(err, fmt.Sprintf("unable to get networkchaos[%s/%s]'s duration", chaos.Namespace, chaos.Name))
+		r.Log.Error(err, "unable to get the duration of chaos")
 		return ctrl.Result{}, err
 	}
 	if scheduler == nil && duration == nil {
@@ -68,6 +78,14 @@ func (r *Reconciler) commonNetworkChaos(networkchaos *v1alpha1.NetworkChaos, req
 			req, r.EventRecorder)
 	case v1alpha1.BandwidthAction:
 		cr = tbf.NewCommonReconciler(r.Client, r.Log.WithValues("action", "bandwidth"), req, r.EventRecorder)
+	case v1alpha1.ConntrackAction:
+		cr = conntrack.NewCommonReconciler(r.Client, r.Log.WithValues("action", "conntrack"), req, r.EventRecorder)
+	case v1alpha1.MTUAction:
+		cr = mtu.NewCommonReconciler(r.Client, r.Log.WithValues("action", "mtu"), req, r.EventRecorder)
+	case v1alpha1.NodePortAction:
+		cr = nodeport.NewCommonReconciler(r.Client, r.Log.WithValues("action", "nodeport"), req, r.EventRecorder)
+	case v1alpha1.MetadataAction:
+		cr = metadata.NewCommonReconciler(r.Client, r.Log.WithValues("action", "metadata"), req, r.EventRecorder)
 	default:
 		return r.invalidActionResponse(networkchaos)
 	}
@@ -85,6 +103,14 @@ func (r *Reconciler) scheduleNetworkChaos(networkchaos *v1alpha1.NetworkChaos, r
 			req, r.EventRecorder)
 	case v1alpha1.BandwidthAction:
 		sr = tbf.NewTwoPhaseReconciler(r.Client, r.Log.WithValues("action", "bandwidth"), req, r.EventRecorder)
+	case v1alpha1.ConntrackAction:
+		sr = conntrack.NewTwoPhaseReconciler(r.Client, r.Log.WithValues("action", "conntrack"), req, r.EventRecorder)
+	case v1alpha1.MTUAction:
+		sr = mtu.NewTwoPhaseReconciler(r.Client, r.Log.WithValues("action", "mtu"), req, r.EventRecorder)
+	case v1alpha1.NodePortAction:
+		sr = nodeport.NewTwoPhaseReconciler(r.Client, r.Log.WithValues("action", "nodeport"), req, r.EventRecorder)
+	case v1alpha1.MetadataAction:
+		sr = metadata.NewTwoPhaseReconciler(r.Client, r.Log.WithValues("action", "metadata"), req, r.EventRecorder)
 	default:
 		return r.invalidActionResponse(networkchaos)
 	}
@@ -18,11 +18,17 @@ import (
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	"github.com/chaos-mesh/chaos-mesh/controllers/networkchaos"
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 )
@@ -39,20 +45,19 @@ type NetworkChaosReconciler struct {
 
 // Reconcile reconciles a NetworkChaos resource
 func (r *NetworkChaosReconciler) Reconcile(req ctrl.Request) (result ctrl.Result, err error) {
-	logger := r.Log.WithValues("reconciler", "networkchaos")
+	chaos := &v1alpha1.NetworkChaos{}
+	if err := r.Get(context.Background(), req.NamespacedName, chaos); err != nil {
+		r.Log.Error(err, "unable to get network chaos")
+		return ctrl.Result{}, nil
+	}
 
+	logger := common.ExperimentLogger(r.Log, chaos).WithValues("reconciler", "networkchaos")
 	reconciler := networkchaos.Reconciler{
 		Client:        r.Client,
 		EventRecorder: r.EventRecorder,
 		Log:           logger,
 	}
 
-	chaos := &v1alpha1.NetworkChaos{}
-	if err := r.Get(context.Background(), req.NamespacedName, chaos); err != nil {
-		r.Log.Error(err, "unable to get network chaos")
-		return ctrl.Result{}, nil
-	}
-
 	result, err = reconciler.Reconcile(req, chaos)
 	if err != nil {
 		if chaos.IsDeleted() || chaos.IsPaused() {
@@ -67,7 +72,42 @@ func (r *NetworkChaosReconciler) Reconcile(req ctrl.Request) (result ctrl.Result
 }
 
 func (r *NetworkChaosReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	c, err := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.NetworkChaos{}).
-		Complete(r)
+		WithEventFilter(common.ShardPredicate()).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: common.ControllerCfg.ConcurrentReconcilesOf(v1alpha1.KindNetworkChaos),
+		}).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	// the target services may be in the namespaces out of the shard, so the endpoints are watched
+	// without the shard predicate and the chaos are filtered by the shard in the mapper instead
+	return c.Watch(&source.Kind{Type: &v1.Endpoints{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(r.chaosOfEndpoints),
+	})
+}
+
+// chaosOfEndpoints returns the running chaos which target the service of the endpoints
+func (r *NetworkChaosReconciler) chaosOfEndpoints(obj handler.MapObject) []reconcile.Request {
+	var list v1alpha1.NetworkChaosList
+	if err := r.List(context.Background(), &list); err != nil {
+		r.Log.Error(err, "unable to list network chaos")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range list.Items {
+		chaos := &list.Items[i]
+		if chaos.Status.TargetIPSet == nil || !common.InShard(chaos.Namespace) ||
+			!networkchaos.TargetsService(chaos, obj.Meta.GetNamespace(), obj.Meta.GetName()) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: chaos.Namespace, Name: chaos.Name},
+		})
+	}
+	return requests
 }
@@ -0,0 +1,154 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package physicalmachinechaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/hashicorp/go-multierror"
+	"golang.org/x/sync/errgroup"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosd"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// finalizer is set on the chaos until the attacks on all the machines are recovered
+const finalizer = "chaos-mesh.org/physical-machine-chaos"
+
+// Reconciler is physical-machine-chaos reconciler
+type Reconciler struct {
+	client.Client
+	record.EventRecorder
+	Log logr.Logger
+}
+
+// Reconcile reconciles a PhysicalMachineChaos resource
+func (r *Reconciler) Reconcile(req ctrl.Request, chaos *v1alpha1.PhysicalMachineChaos) (ctrl.Result, error) {
+	r.Log.Info("Reconciling physicalmachinechaos")
+	scheduler := chaos.GetScheduler()
+	duration, err := chaos.GetDuration()
+	if err != nil {
+		r.Log.Error(err, "unable to get the duration of chaos")
+		return ctrl.Result{}, err
+	}
+	if scheduler == nil && duration == nil {
+		return common.NewReconciler(r, r.Client, r.Log).Reconcile(req)
+	} else if scheduler != nil && duration != nil {
+		return twophase.NewReconciler(r, r.Client, r.Log).Reconcile(req)
+	}
+
+	// This should be ensured by admission webhook in the future
+	r.Log.Error(errors.New("spec invalid"), "scheduler and duration should be omitted or defined at the same time")
+	return ctrl.Result{}, fmt.Errorf("invalid scheduler and duration")
+}
+
+// Apply applies physical-machine-chaos
+func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	pmchaos, ok := chaos.(*v1alpha1.PhysicalMachineChaos)
+	if !ok {
+		err := errors.New("chaos is not PhysicalMachineChaos")
+		r.Log.Error(err, "chaos is not PhysicalMachineChaos", "chaos", chaos)
+		return err
+	}
+
+	pmchaos.Finalizers = utils.InsertFinalizer(pmchaos.Finalizers, finalizer)
+
+	attack := attackRequest(pmchaos)
+	g := errgroup.Group{}
+	for _, address := range pmchaos.Spec.Addresses {
+		address := address
+		g.Go(func() error {
+			r.Log.Info("Try to attack physical machine", "address", address, "action", attack.Action)
+			if _, err := chaosd.NewClient(address).Attack(ctx, attack); err != nil {
+				return fmt.Errorf("failed to attack %s: %v", address, err)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		r.Log.Error(err, "failed to apply chaos on all machines")
+		return err
+	}
+
+	r.Event(pmchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
+	return nil
+}
+
+// Recover means the reconciler recovers the chaos action
+func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	pmchaos, ok := chaos.(*v1alpha1.PhysicalMachineChaos)
+	if !ok {
+		err := errors.New("chaos is not PhysicalMachineChaos")
+		r.Log.Error(err, "chaos is not PhysicalMachineChaos", "chaos", chaos)
+		return err
+	}
+
+	if err := r.cleanFinalizersAndRecover(ctx, pmchaos); err != nil {
+		return err
+	}
+	r.Event(pmchaos, v1.EventTypeNormal, utils.EventChaosRecovered, "")
+
+	return nil
+}
+
+func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, chaos *v1alpha1.PhysicalMachineChaos) error {
+	var result error
+
+	uid := string(chaos.UID)
+	for _, address := range chaos.Spec.Addresses {
+		r.Log.Info("Try to recover physical machine", "address", address)
+		if err := chaosd.NewClient(address).Recover(ctx, uid); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to recover %s: %v", address, err))
+		}
+	}
+
+	if result == nil {
+		chaos.Finalizers = utils.RemoveFromFinalizer(chaos.Finalizers, finalizer)
+	}
+
+	if chaos.Annotations[common.AnnotationCleanFinalizer] == common.AnnotationCleanFinalizerForced {
+		r.Log.Info("Force cleanup all finalizers", "chaos", chaos)
+		chaos.Finalizers = chaos.Finalizers[:0]
+		return nil
+	}
+
+	return result
+}
+
+// Object would return the instance of chaos
+func (r *Reconciler) Object() v1alpha1.InnerObject {
+	return &v1alpha1.PhysicalMachineChaos{}
+}
+
+// attackRequest builds the request to chaosd, the uid of chaos identifies the attacks
+func attackRequest(chaos *v1alpha1.PhysicalMachineChaos) *chaosd.AttackRequest {
+	return &chaosd.AttackRequest{
+		UID:     string(chaos.UID),
+		Action:  chaos.Spec.Action,
+		Network: chaos.Spec.Network,
+		Stress:  chaos.Spec.Stress,
+		Disk:    chaos.Spec.Disk,
+		Process: chaos.Spec.Process,
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/physicalmachinechaos"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// PhysicalMachineChaosReconciler reconciles a PhysicalMachineChaos object
+type PhysicalMachineChaosReconciler struct {
+	client.Client
+	record.EventRecorder
+	Log logr.Logger
+}
+
+// +kubebuilder:rbac:groups=chaos-mesh.org,resources=physicalmachinechaos,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=chaos-mesh.org,resources=physicalmachinechaos/status,verbs=get;update;patch
+
+// Reconcile reconciles a PhysicalMachineChaos resource
+func (r *PhysicalMachineChaosReconciler) Reconcile(req ctrl.Request) (result ctrl.Result, err error) {
+	chaos := &v1alpha1.PhysicalMachineChaos{}
+	if err := r.Get(context.Background(), req.NamespacedName, chaos); err != nil {
+		r.Log.Error(err, "unable to get physical machine chaos")
+		return ctrl.Result{}, nil
+	}
+
+	logger := common.ExperimentLogger(r.Log, chaos).WithValues("reconciler", "physicalmachinechaos")
+	reconciler := physicalmachinechaos.Reconciler{
+		Client:        r.Client,
+		EventRecorder: r.EventRecorder,
+		Log:           logger,
+	}
+
+	result, err = reconciler.Reconcile(req, chaos)
+	if err != nil {
+		if chaos.IsDeleted() || chaos.IsPaused() {
+			r.Event(chaos, v1.EventTypeWarning, utils.EventChaosRecoverFailed, err.Error())
+		} else {
+			r.Event(chaos, v1.EventTypeWarning, utils.EventChaosInjectFailed, err.Error())
+		}
+	}
+
+	return result, nil
+}
+
+// SetupWithManager setups a physical machine chaos reconciler on controller-manager
+func (r *PhysicalMachineChaosReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.PhysicalMachineChaos{}).
+		WithEventFilter(common.ShardPredicate()).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: common.ControllerCfg.ConcurrentReconcilesOf(v1alpha1.KindPhysicalMachineChaos),
+		}).
+		Complete(r)
+}
@@ -0,0 +1,142 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configcorrupt_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/podchaos/configcorrupt"
+	. "github.com/chaos-mesh/chaos-mesh/controllers/test"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+func TestConfigCorrupt(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecsWithDefaultAndCustomReporters(t,
+		"ConfigCorrupt Suite",
+		[]Reporter{envtest.NewlineReporter{}})
+}
+
+var _ = BeforeSuite(func(done Done) {
+	logf.SetLogger(zap.LoggerTo(GinkgoWriter, true))
+
+	Expect(v1.AddToScheme(scheme.Scheme)).To(Succeed())
+	Expect(v1alpha1.AddToScheme(scheme.Scheme)).To(Succeed())
+
+	close(done)
+}, 60)
+
+var _ = AfterSuite(func() {
+})
+
+var _ = Describe("PodChaos", func() {
+	Context("ConfigCorrupt", func() {
+		objs, _ := GenerateNPods("p", 1, v1.PodRunning, metav1.NamespaceDefault, nil, nil, v1.ContainerStatus{
+			ContainerID: "fake-container-id",
+			Name:        "fake-name",
+			State:       v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+		})
+		pod := objs[0].(*v1.Pod)
+		pod.Spec.Volumes = []v1.Volume{{
+			Name: "config",
+			VolumeSource: v1.VolumeSource{
+				ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "app"}},
+			},
+		}, {
+			Name:         "data",
+			VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+		}}
+		pod.Spec.Containers[0].VolumeMounts = []v1.VolumeMount{{Name: "config", MountPath: "/etc/app"}}
+
+		podChaos := v1alpha1.PodChaos{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "PodChaos",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "podchaos-name",
+			},
+			Spec: v1alpha1.PodChaosSpec{
+				Selector:         v1alpha1.SelectorSpec{},
+				Action:           v1alpha1.ConfigCorruptAction,
+				Mode:             v1alpha1.OnePodMode,
+				ConfigCorruption: &v1alpha1.ConfigCorruptionSpec{Volume: "config", Key: "app.yaml"},
+			},
+		}
+
+		r := configcorrupt.Reconciler{
+			Client:        fake.NewFakeClientWithScheme(scheme.Scheme, objs...),
+			EventRecorder: &record.FakeRecorder{},
+			Log:           ctrl.Log.WithName("controllers").WithName("PodChaos"),
+		}
+
+		It("ConfigCorrupt Apply", func() {
+			defer mock.With("MockChaosDaemonClient", &MockChaosDaemonClient{})()
+
+			chaos := podChaos.DeepCopy()
+			err := r.Apply(context.TODO(), ctrl.Request{}, chaos)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(chaos.Status.Experiment.PodRecords).To(HaveLen(1))
+			Expect(chaos.Status.Experiment.PodRecords[0].Message).To(Equal("corrupt app.yaml of volume config by truncate"))
+
+			err = r.Recover(context.TODO(), ctrl.Request{}, chaos)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(chaos.Finalizers).To(BeEmpty())
+		})
+
+		It("ConfigCorrupt Apply Error", func() {
+			defer mock.With("MockChaosDaemonClient", &MockChaosDaemonClient{})()
+			defer mock.With("MockCorruptConfigError", errors.New("CorruptConfigError"))()
+
+			err := r.Apply(context.TODO(), ctrl.Request{}, podChaos.DeepCopy())
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("CorruptConfigError"))
+		})
+
+		It("ConfigCorrupt requires the volume of ConfigMap or Secret", func() {
+			chaos := podChaos.DeepCopy()
+			chaos.Spec.ConfigCorruption.Volume = "data"
+
+			err := r.Apply(context.TODO(), ctrl.Request{}, chaos)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("isn't a ConfigMap, Secret or projected volume"))
+
+			chaos.Spec.ConfigCorruption.Volume = "missing"
+			err = r.Apply(context.TODO(), ctrl.Request{}, chaos)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("doesn't have volume missing"))
+		})
+	})
+})
@@ -0,0 +1,272 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configcorrupt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+const (
+	configContentActionMsg = "replace %s of volume %s"
+	configCorruptActionMsg = "corrupt %s of volume %s by %s"
+
+	// defaultCorruption is used if neither the content nor the corruption is provided
+	defaultCorruption = "truncate"
+)
+
+// NewTwoPhaseReconciler would create Reconciler for twophase package
+func NewTwoPhaseReconciler(c client.Client, log logr.Logger, recorder record.EventRecorder) *twophase.Reconciler {
+	r := newReconciler(c, log, recorder)
+	return twophase.NewReconciler(r, r.Client, r.Log)
+}
+
+// NewCommonReconciler would create Reconciler for common package
+func NewCommonReconciler(c client.Client, log logr.Logger, recorder record.EventRecorder) *common.Reconciler {
+	r := newReconciler(c, log, recorder)
+	return common.NewReconciler(r, r.Client, r.Log)
+}
+
+func newReconciler(c client.Client, log logr.Logger, recorder record.EventRecorder) *Reconciler {
+	return &Reconciler{
+		Client:        c,
+		EventRecorder: recorder,
+		Log:           log,
+	}
+}
+
+type Reconciler struct {
+	client.Client
+	record.EventRecorder
+	Log logr.Logger
+}
+
+// Object implements the reconciler.InnerReconciler.Object
+func (r *Reconciler) Object() v1alpha1.InnerObject {
+	return &v1alpha1.PodChaos{}
+}
+
+// Apply implements the reconciler.InnerReconciler.Apply
+func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, obj v1alpha1.InnerObject) error {
+	podchaos, ok := obj.(*v1alpha1.PodChaos)
+	if !ok {
+		err := errors.New("chaos is not PodChaos")
+		r.Log.Error(err, "chaos is not PodChaos", "chaos", obj)
+		return err
+	}
+
+	corruption := podchaos.Spec.ConfigCorruption
+	if corruption == nil {
+		r.Log.Error(nil, "the config corruption is empty", "name", req.Name, "namespace", req.Namespace)
+		return fmt.Errorf("podchaos[%s/%s] the config corruption is empty", podchaos.Namespace, podchaos.Name)
+	}
+
+	podchaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	pods, err := utils.SelectAndRecordPods(ctx, r.Client, &podchaos.Spec, podchaos.Status.Experiment.Selection)
+	if err != nil {
+		r.Log.Error(err, "failed to select and filter pods")
+		return err
+	}
+	common.RecordImpact(ctx, r.Client, podchaos, pods, false)
+
+	for index := range pods {
+		key, err := cache.MetaNamespaceKeyFunc(&pods[index])
+		if err != nil {
+			return err
+		}
+		podchaos.Finalizers = utils.InsertFinalizer(podchaos.Finalizers, key)
+	}
+
+	err = common.ApplyPods(ctx, podchaos, pods, func(pod *v1.Pod) v1alpha1.PodStatus {
+		return v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Action:    string(podchaos.Spec.Action),
+			Message:   corruptionMessage(corruption),
+		}
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		return r.corruptPod(ctx, pod, corruption)
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		return r.recoverPod(ctx, pod, corruption)
+	})
+	if err != nil {
+		return err
+	}
+
+	r.Event(podchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
+	return nil
+}
+
+// Recover implements the reconciler.InnerReconciler.Recover
+func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, obj v1alpha1.InnerObject) error {
+	podchaos, ok := obj.(*v1alpha1.PodChaos)
+	if !ok {
+		err := errors.New("chaos is not PodChaos")
+		r.Log.Error(err, "chaos is not PodChaos", "chaos", obj)
+		return err
+	}
+
+	corruption := podchaos.Spec.ConfigCorruption
+	if corruption == nil {
+		return fmt.Errorf("podchaos[%s/%s] the config corruption is empty", podchaos.Namespace, podchaos.Name)
+	}
+	if err := common.RecoverPods(ctx, r.Client, podchaos, func(ctx context.Context, pod *v1.Pod) error {
+		return r.recoverPod(ctx, pod, corruption)
+	}); err != nil {
+		return err
+	}
+
+	r.Event(podchaos, v1.EventTypeNormal, utils.EventChaosRecovered, "")
+	return nil
+}
+
+func (r *Reconciler) corruptPod(ctx context.Context, pod *v1.Pod, corruption *v1alpha1.ConfigCorruptionSpec) error {
+	r.Log.Info("Try to corrupt config", "namespace", pod.Namespace, "name", pod.Name,
+		"volume", corruption.Volume, "key", corruption.Key)
+
+	containerID, mountPath, err := volumeMount(pod, corruption.Volume)
+	if err != nil {
+		return err
+	}
+
+	pbClient, err := utils.NewChaosDaemonClient(ctx, r.Client, pod, common.ControllerCfg.ChaosDaemonPort)
+	if err != nil {
+		return err
+	}
+	defer pbClient.Close()
+
+	// the files of the volume are written through the mounts of the host
+	if err := utils.CheckCapabilities(ctx, pbClient, pod.Spec.NodeName, utils.Requirement{
+		Action:     "config-corrupt",
+		Privileged: true,
+		Methods:    []string{"CorruptConfig", "RecoverConfig"},
+	}); err != nil {
+		return err
+	}
+
+	req := &pb.ConfigRequest{
+		ContainerId: containerID,
+		MountPath:   mountPath,
+		Key:         corruption.Key,
+	}
+	if corruption.Content != nil {
+		req.Content = []byte(*corruption.Content)
+	} else {
+		req.Corruption = corruptionOf(corruption)
+	}
+	if _, err := pbClient.CorruptConfig(ctx, req); err != nil {
+		r.Log.Error(err, "corrupt config error", "namespace", pod.Namespace, "name", pod.Name, "containerID", containerID)
+		return err
+	}
+	return nil
+}
+
+func (r *Reconciler) recoverPod(ctx context.Context, pod *v1.Pod, corruption *v1alpha1.ConfigCorruptionSpec) error {
+	r.Log.Info("Try to recover config", "namespace", pod.Namespace, "name", pod.Name,
+		"volume", corruption.Volume, "key", corruption.Key)
+
+	containerID, mountPath, err := volumeMount(pod, corruption.Volume)
+	if err != nil {
+		return err
+	}
+
+	pbClient, err := utils.NewChaosDaemonClient(ctx, r.Client, pod, common.ControllerCfg.ChaosDaemonPort)
+	if err != nil {
+		return err
+	}
+	defer pbClient.Close()
+
+	if _, err := pbClient.RecoverConfig(ctx, &pb.ConfigRequest{
+		ContainerId: containerID,
+		MountPath:   mountPath,
+		Key:         corruption.Key,
+	}); err != nil {
+		r.Log.Error(err, "recover config error", "namespace", pod.Namespace, "name", pod.Name, "containerID", containerID)
+		return err
+	}
+	return nil
+}
+
+// volumeMount returns the id of the first running container which mounts the volume, and the path
+// where it's mounted. All the containers mounting the volume share the same files, so they are
+// corrupted together through any one of them.
+func volumeMount(pod *v1.Pod, volume string) (string, string, error) {
+	found := false
+	for _, v := range pod.Spec.Volumes {
+		if v.Name != volume {
+			continue
+		}
+		if v.ConfigMap == nil && v.Secret == nil && v.Projected == nil {
+			return "", "", fmt.Errorf("volume %s of pod %s/%s isn't a ConfigMap, Secret or projected volume",
+				volume, pod.Namespace, pod.Name)
+		}
+		found = true
+	}
+	if !found {
+		return "", "", fmt.Errorf("pod %s/%s doesn't have volume %s", pod.Namespace, pod.Name, volume)
+	}
+
+	containerIDs := make(map[string]string, len(pod.Status.ContainerStatuses))
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Running != nil && status.ContainerID != "" {
+			containerIDs[status.Name] = status.ContainerID
+		}
+	}
+	for _, container := range pod.Spec.Containers {
+		containerID, ok := containerIDs[container.Name]
+		if !ok {
+			continue
+		}
+		for _, mount := range container.VolumeMounts {
+			// the file mounted with subPath is a bind mount of the file, which can't be relinked
+			if mount.Name == volume && mount.SubPath == "" && mount.SubPathExpr == "" {
+				return containerID, mount.MountPath, nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("no running container of pod %s/%s mounts volume %s without subPath",
+		pod.Namespace, pod.Name, volume)
+}
+
+// corruptionOf returns the corruption of the original content, which is truncate by default
+func corruptionOf(corruption *v1alpha1.ConfigCorruptionSpec) string {
+	if corruption.Corruption == "" {
+		return defaultCorruption
+	}
+	return corruption.Corruption
+}
+
+// corruptionMessage describes how the file of the volume is replaced
+func corruptionMessage(corruption *v1alpha1.ConfigCorruptionSpec) string {
+	if corruption.Content != nil {
+		return fmt.Sprintf(configContentActionMsg, corruption.Key, corruption.Volume)
+	}
+	return fmt.Sprintf(configCorruptActionMsg, corruption.Key, corruption.Volume, corruptionOf(corruption))
+}
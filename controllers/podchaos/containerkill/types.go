@@ -17,9 +17,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-logr/logr"
-	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -67,60 +67,56 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, obj v1alpha1.I
 		return err
 	}
 
-	if podchaos.Spec.ContainerName == "" {
-		r.Log.Error(nil, "the name of container is empty", "name", req.Name, "namespace", req.Namespace)
-		return fmt.Errorf("podchaos[%s/%s] the name of container is empty", podchaos.Namespace, podchaos.Name)
+	containerNames := podchaos.Spec.GetContainerNames()
+	if len(containerNames) == 0 {
+		r.Log.Error(nil, "the names of containers are empty", "name", req.Name, "namespace", req.Namespace)
+		return fmt.Errorf("podchaos[%s/%s] the names of containers are empty", podchaos.Namespace, podchaos.Name)
 	}
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, &podchaos.Spec)
+	podchaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	pods, err := utils.SelectAndRecordPods(ctx, r.Client, &podchaos.Spec, podchaos.Status.Experiment.Selection)
 	if err != nil {
 		r.Log.Error(err, "fail to select and filter pods")
 		return err
 	}
+	common.RecordImpact(ctx, r.Client, podchaos, pods, false)
 
-	g := errgroup.Group{}
-	for podIndex := range pods {
-		pod := &pods[podIndex]
-		haveContainer := false
+	err = common.ApplyPods(ctx, podchaos, pods, func(pod *v1.Pod) v1alpha1.PodStatus {
+		return v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Action:    string(podchaos.Spec.Action),
+			Message:   fmt.Sprintf(containerKillActionMsg, strings.Join(containerNames, ",")),
+		}
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		for _, name := range containerNames {
+			haveContainer := false
 
-		for containerIndex := range pod.Status.ContainerStatuses {
-			containerName := pod.Status.ContainerStatuses[containerIndex].Name
-			containerID := pod.Status.ContainerStatuses[containerIndex].ContainerID
+			for containerIndex := range pod.Status.ContainerStatuses {
+				containerName := pod.Status.ContainerStatuses[containerIndex].Name
+				containerID := pod.Status.ContainerStatuses[containerIndex].ContainerID
 
-			if containerName == podchaos.Spec.ContainerName {
-				haveContainer = true
-				g.Go(func() error {
-					err = r.KillContainer(ctx, pod, containerID)
-					if err != nil {
+				if containerName == name {
+					haveContainer = true
+					if err := r.KillContainer(ctx, pod, containerID); err != nil {
 						r.Log.Error(err, "failed to kill container")
+						return err
 					}
-					return err
-				})
+				}
 			}
-		}
 
-		if haveContainer == false {
-			r.Log.Error(nil, fmt.Sprintf("the pod %s doesn't have container %s", pod.Name, podchaos.Spec.ContainerName))
+			if !haveContainer {
+				r.Log.Error(nil, fmt.Sprintf("the pod %s doesn't have container %s", pod.Name, name))
+			}
 		}
-	}
-
-	if err := g.Wait(); err != nil {
+		return nil
+	}, nil)
+	if err != nil {
 		return err
 	}
 
-	podchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
-	for _, pod := range pods {
-		ps := v1alpha1.PodStatus{
-			Namespace: pod.Namespace,
-			Name:      pod.Name,
-			HostIP:    pod.Status.HostIP,
-			PodIP:     pod.Status.PodIP,
-			Action:    string(podchaos.Spec.Action),
-			Message:   fmt.Sprintf(containerKillActionMsg, podchaos.Spec.ContainerName),
-		}
-
-		podchaos.Status.Experiment.PodRecords = append(podchaos.Status.Experiment.PodRecords, ps)
-	}
 	r.Event(obj, v1.EventTypeNormal, utils.EventChaosInjected, "")
 	return nil
 }
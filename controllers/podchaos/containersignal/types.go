@@ -0,0 +1,170 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containersignal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+const (
+	containerSignalActionMsg      = "send signal %s to container %s"
+	containerChildSignalActionMsg = "send signal %s to the child %d of container %s"
+)
+
+func newReconciler(c client.Client, log logr.Logger, recorder record.EventRecorder) *Reconciler {
+	return &Reconciler{
+		Client:        c,
+		EventRecorder: recorder,
+		Log:           log,
+	}
+}
+
+type Reconciler struct {
+	client.Client
+	record.EventRecorder
+	Log logr.Logger
+}
+
+// NewTwoPhaseReconciler would create Reconciler for twophase package
+func NewTwoPhaseReconciler(c client.Client, log logr.Logger, recorder record.EventRecorder) *twophase.Reconciler {
+	r := newReconciler(c, log, recorder)
+	return twophase.NewReconciler(r, r.Client, r.Log)
+}
+
+// Apply implements the reconciler.InnerReconciler.Apply
+func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, obj v1alpha1.InnerObject) error {
+	var err error
+
+	podchaos, ok := obj.(*v1alpha1.PodChaos)
+	if !ok {
+		err = errors.New("chaos is not PodChaos")
+		r.Log.Error(err, "chaos is not PodChaos", "chaos", obj)
+		return err
+	}
+
+	containerNames := podchaos.Spec.GetContainerNames()
+	if len(containerNames) == 0 {
+		r.Log.Error(nil, "the names of containers are empty", "name", req.Name, "namespace", req.Namespace)
+		return fmt.Errorf("podchaos[%s/%s] the names of containers are empty", podchaos.Namespace, podchaos.Name)
+	}
+	signal := podchaos.Spec.Signal
+	if signal == nil {
+		r.Log.Error(nil, "the signal is empty", "name", req.Name, "namespace", req.Namespace)
+		return fmt.Errorf("podchaos[%s/%s] the signal is empty", podchaos.Namespace, podchaos.Name)
+	}
+
+	podchaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	pods, err := utils.SelectAndRecordPods(ctx, r.Client, &podchaos.Spec, podchaos.Status.Experiment.Selection)
+	if err != nil {
+		r.Log.Error(err, "fail to select and filter pods")
+		return err
+	}
+	common.RecordImpact(ctx, r.Client, podchaos, pods, false)
+
+	err = common.ApplyPods(ctx, podchaos, pods, func(pod *v1.Pod) v1alpha1.PodStatus {
+		return v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Action:    string(podchaos.Spec.Action),
+			Message:   signalMessage(signal, containerNames),
+		}
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		for _, name := range containerNames {
+			haveContainer := false
+
+			for containerIndex := range pod.Status.ContainerStatuses {
+				containerName := pod.Status.ContainerStatuses[containerIndex].Name
+				containerID := pod.Status.ContainerStatuses[containerIndex].ContainerID
+
+				if containerName == name {
+					haveContainer = true
+					if err := r.SignalContainer(ctx, pod, containerID, signal); err != nil {
+						r.Log.Error(err, "failed to send signal to container")
+						return err
+					}
+				}
+			}
+
+			if !haveContainer {
+				r.Log.Error(nil, fmt.Sprintf("the pod %s doesn't have container %s", pod.Name, name))
+			}
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	r.Event(obj, v1.EventTypeNormal, utils.EventChaosInjected, "")
+	return nil
+}
+
+// Recover implements the reconciler.InnerReconciler.Recover
+func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, obj v1alpha1.InnerObject) error {
+	return nil
+}
+
+// Object implements the reconciler.InnerReconciler.Object
+func (r *Reconciler) Object() v1alpha1.InnerObject {
+	return &v1alpha1.PodChaos{}
+}
+
+// SignalContainer sends the signal to the main process of the container, or to the child of it
+// Use client in chaos-daemon
+func (r *Reconciler) SignalContainer(ctx context.Context, pod *v1.Pod, containerID string, signal *v1alpha1.SignalSpec) error {
+	r.Log.Info("Try to send signal to container", "namespace", pod.Namespace, "podName", pod.Name,
+		"containerID", containerID, "signal", signal.Name, "child", signal.Child)
+
+	pbClient, err := utils.NewChaosDaemonClient(ctx, r.Client, pod, common.ControllerCfg.ChaosDaemonPort)
+	if err != nil {
+		return err
+	}
+	defer pbClient.Close()
+
+	if _, err = pbClient.ContainerSignal(ctx, &pb.SignalRequest{
+		ContainerId: containerID,
+		Signal:      signal.Name,
+		Child:       uint32(signal.Child),
+	}); err != nil {
+		r.Log.Error(err, "send signal error", "namespace", pod.Namespace, "podName", pod.Name, "containerID", containerID)
+		return err
+	}
+
+	return nil
+}
+
+// signalMessage describes the signal which is sent to the containers
+func signalMessage(signal *v1alpha1.SignalSpec, containerNames []string) string {
+	if signal.Child > 0 {
+		return fmt.Sprintf(containerChildSignalActionMsg, signal.Name, signal.Child, strings.Join(containerNames, ","))
+	}
+	return fmt.Sprintf(containerSignalActionMsg, signal.Name, strings.Join(containerNames, ","))
+}
@@ -20,7 +20,6 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
-	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
 	k8serror "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -32,6 +31,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
+	"github.com/chaos-mesh/chaos-mesh/pkg/simulation"
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 )
 
@@ -84,30 +84,23 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 		return err
 	}
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, &podchaos.Spec)
+	podchaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	pods, err := utils.SelectAndRecordPods(ctx, r.Client, &podchaos.Spec, podchaos.Status.Experiment.Selection)
 	if err != nil {
 		r.Log.Error(err, "failed to select and filter pods")
 		return err
 	}
+	pods, err = utils.ApplySafeguards(ctx, r.Client, podchaos.Spec.Safeguards, pods, podchaos.Status.Experiment.Selection)
+	if err != nil {
+		r.Log.Error(err, "failed to apply the safeguards")
+		return err
+	}
+	common.RecordImpact(ctx, r.Client, podchaos, pods, false)
 	err = r.failAllPods(ctx, pods, podchaos)
 	if err != nil {
 		return err
 	}
 
-	podchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
-	for _, pod := range pods {
-		ps := v1alpha1.PodStatus{
-			Namespace: pod.Namespace,
-			Name:      pod.Name,
-			HostIP:    pod.Status.HostIP,
-			PodIP:     pod.Status.PodIP,
-			Action:    string(podchaos.Spec.Action),
-		}
-		if podchaos.Spec.Duration != nil {
-			ps.Message = fmt.Sprintf(podFailureActionMsg, *podchaos.Spec.Duration)
-		}
-		podchaos.Status.Experiment.PodRecords = append(podchaos.Status.Experiment.PodRecords, ps)
-	}
 	r.Event(podchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
 	return nil
 }
@@ -176,22 +169,31 @@ func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, podchaos *v1
 }
 
 func (r *Reconciler) failAllPods(ctx context.Context, pods []v1.Pod, podchaos *v1alpha1.PodChaos) error {
-	g := errgroup.Group{}
 	for index := range pods {
-		pod := &pods[index]
-
-		key, err := cache.MetaNamespaceKeyFunc(pod)
+		key, err := cache.MetaNamespaceKeyFunc(&pods[index])
 		if err != nil {
 			return err
 		}
 		podchaos.Finalizers = utils.InsertFinalizer(podchaos.Finalizers, key)
-
-		g.Go(func() error {
-			return r.failPod(ctx, pod, podchaos)
-		})
 	}
 
-	return g.Wait()
+	return common.ApplyPods(ctx, podchaos, pods, func(pod *v1.Pod) v1alpha1.PodStatus {
+		ps := v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Action:    string(podchaos.Spec.Action),
+		}
+		if podchaos.Spec.Duration != nil {
+			ps.Message = fmt.Sprintf(podFailureActionMsg, *podchaos.Spec.Duration)
+		}
+		return ps
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		return r.failPod(ctx, pod, podchaos)
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		return r.recoverPod(ctx, pod, podchaos)
+	})
 }
 
 func (r *Reconciler) failPod(ctx context.Context, pod *v1.Pod, podchaos *v1alpha1.PodChaos) error {
@@ -237,24 +239,15 @@ func (r *Reconciler) failPod(ctx context.Context, pod *v1.Pod, podchaos *v1alpha
 		return err
 	}
 
-	ps := v1alpha1.PodStatus{
-		Namespace: pod.Namespace,
-		Name:      pod.Name,
-		HostIP:    pod.Status.HostIP,
-		PodIP:     pod.Status.PodIP,
-		Action:    string(podchaos.Spec.Action),
-	}
-	if podchaos.Spec.Duration != nil {
-		ps.Message = fmt.Sprintf(podFailureActionMsg, *podchaos.Spec.Duration)
-	}
-
-	podchaos.Status.Experiment.PodRecords = append(podchaos.Status.Experiment.PodRecords, ps)
-
 	return nil
 }
 
 func (r *Reconciler) recoverPod(ctx context.Context, pod *v1.Pod, podchaos *v1alpha1.PodChaos) error {
 	r.Log.Info("Recovering", "namespace", pod.Namespace, "name", pod.Name)
+	// the pod isn't failed by the simulated chaos, so it's left untouched
+	if simulation.IsSimulated(ctx) {
+		return nil
+	}
 
 	for index := range pod.Spec.Containers {
 		name := pod.Spec.Containers[index].Name
@@ -18,13 +18,13 @@ import (
 	"errors"
 
 	"github.com/go-logr/logr"
-	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 )
@@ -61,34 +61,21 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 		r.Log.Error(err, "chaos is not PodChaos", "chaos", chaos)
 		return err
 	}
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, &podchaos.Spec)
+	podchaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	pods, err := utils.SelectAndRecordPods(ctx, r.Client, &podchaos.Spec, podchaos.Status.Experiment.Selection)
 	if err != nil {
 		r.Log.Error(err, "fail to select and generate pods")
 		return err
 	}
-
-	g := errgroup.Group{}
-	for index := range pods {
-		pod := &pods[index]
-		g.Go(func() error {
-			r.Log.Info("Deleting", "namespace", pod.Namespace, "name", pod.Name)
-
-			if err := r.Delete(ctx, pod, &client.DeleteOptions{
-				GracePeriodSeconds: &podchaos.Spec.GracePeriod, // PeriodSeconds has to be set specifically
-			}); err != nil {
-				r.Log.Error(err, "unable to delete pod")
-				return err
-			}
-			return nil
-		})
-	}
-
-	if err := g.Wait(); err != nil {
+	pods, err = utils.ApplySafeguards(ctx, r.Client, podchaos.Spec.Safeguards, pods, podchaos.Status.Experiment.Selection)
+	if err != nil {
+		r.Log.Error(err, "failed to apply the safeguards")
 		return err
 	}
-	podchaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
-	for _, pod := range pods {
-		ps := v1alpha1.PodStatus{
+	common.RecordImpact(ctx, r.Client, podchaos, pods, true)
+
+	err = common.ApplyPods(ctx, podchaos, pods, func(pod *v1.Pod) v1alpha1.PodStatus {
+		return v1alpha1.PodStatus{
 			Namespace: pod.Namespace,
 			Name:      pod.Name,
 			HostIP:    pod.Status.HostIP,
@@ -96,8 +83,19 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 			Action:    string(podchaos.Spec.Action),
 			Message:   podKillActionMsg,
 		}
-
-		podchaos.Status.Experiment.PodRecords = append(podchaos.Status.Experiment.PodRecords, ps)
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		r.Log.Info("Deleting", "namespace", pod.Namespace, "name", pod.Name)
+
+		if err := r.Delete(ctx, pod, &client.DeleteOptions{
+			GracePeriodSeconds: &podchaos.Spec.GracePeriod, // PeriodSeconds has to be set specifically
+		}); err != nil {
+			r.Log.Error(err, "unable to delete pod")
+			return err
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		return err
 	}
 
 	r.Event(podchaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
@@ -0,0 +1,149 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podreip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+const (
+	podReIPActionMsg = "recreate the sandbox of pod to change ip %s"
+)
+
+func newReconciler(c client.Client, log logr.Logger, recorder record.EventRecorder) *Reconciler {
+	return &Reconciler{
+		Client:        c,
+		EventRecorder: recorder,
+		Log:           log,
+	}
+}
+
+type Reconciler struct {
+	client.Client
+	record.EventRecorder
+	Log logr.Logger
+}
+
+// NewTwoPhaseReconciler would create Reconciler for twophase package
+func NewTwoPhaseReconciler(c client.Client, log logr.Logger, recorder record.EventRecorder) *twophase.Reconciler {
+	r := newReconciler(c, log, recorder)
+	return twophase.NewReconciler(r, r.Client, r.Log)
+}
+
+// Apply implements the reconciler.InnerReconciler.Apply
+func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, obj v1alpha1.InnerObject) error {
+	podchaos, ok := obj.(*v1alpha1.PodChaos)
+	if !ok {
+		err := errors.New("chaos is not PodChaos")
+		r.Log.Error(err, "chaos is not PodChaos", "chaos", obj)
+		return err
+	}
+
+	podchaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	pods, err := utils.SelectAndRecordPods(ctx, r.Client, &podchaos.Spec, podchaos.Status.Experiment.Selection)
+	if err != nil {
+		r.Log.Error(err, "fail to select and filter pods")
+		return err
+	}
+	pods, err = utils.ApplySafeguards(ctx, r.Client, podchaos.Spec.Safeguards, pods, podchaos.Status.Experiment.Selection)
+	if err != nil {
+		r.Log.Error(err, "failed to apply the safeguards")
+		return err
+	}
+	common.RecordImpact(ctx, r.Client, podchaos, pods, false)
+
+	err = common.ApplyPods(ctx, podchaos, pods, func(pod *v1.Pod) v1alpha1.PodStatus {
+		return v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Action:    string(podchaos.Spec.Action),
+			Message:   fmt.Sprintf(podReIPActionMsg, pod.Status.PodIP),
+		}
+	}, r.KillSandbox, nil)
+	if err != nil {
+		return err
+	}
+
+	r.Event(obj, v1.EventTypeNormal, utils.EventChaosInjected, "")
+	return nil
+}
+
+// Recover implements the reconciler.InnerReconciler.Recover
+func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, obj v1alpha1.InnerObject) error {
+	return nil
+}
+
+// Object implements the reconciler.InnerReconciler.Object
+func (r *Reconciler) Object() v1alpha1.InnerObject {
+	return &v1alpha1.PodChaos{}
+}
+
+// KillSandbox kills the sandbox of the pod, kubelet creates the sandbox again and the pod gets a new ip.
+// Use client in chaos-daemon
+func (r *Reconciler) KillSandbox(ctx context.Context, pod *v1.Pod) error {
+	if pod.Spec.HostNetwork {
+		return fmt.Errorf("%s %s uses the network of the host, its ip can't be changed", pod.Namespace, pod.Name)
+	}
+	// kubelet doesn't create the sandbox again for the pods which are never restarted, they would fail
+	if pod.Spec.RestartPolicy == v1.RestartPolicyNever {
+		return fmt.Errorf("%s %s is never restarted, its sandbox can't be recreated", pod.Namespace, pod.Name)
+	}
+
+	containerID := ""
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.ContainerID != "" {
+			containerID = status.ContainerID
+			break
+		}
+	}
+	if containerID == "" {
+		return fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
+	}
+
+	r.Log.Info("Try to kill sandbox", "namespace", pod.Namespace, "podName", pod.Name, "containerID", containerID)
+
+	pbClient, err := utils.NewChaosDaemonClient(ctx, r.Client, pod, common.ControllerCfg.ChaosDaemonPort)
+	if err != nil {
+		return err
+	}
+	defer pbClient.Close()
+
+	if _, err = pbClient.SandboxKill(ctx, &pb.ContainerRequest{
+		Action: &pb.ContainerAction{
+			Action: pb.ContainerAction_KILL,
+		},
+		ContainerId: containerID,
+	}); err != nil {
+		r.Log.Error(err, "kill sandbox error", "namespace", pod.Namespace, "podName", pod.Name, "containerID", containerID)
+		return err
+	}
+
+	return nil
+}
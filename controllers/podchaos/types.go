@@ -14,6 +14,7 @@
 package podchaos
 
 import (
+	"errors"
 	"fmt"
 
 	"k8s.io/client-go/tools/record"
@@ -24,9 +25,12 @@ import (
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/controllers/podchaos/configcorrupt"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podchaos/containerkill"
+	"github.com/chaos-mesh/chaos-mesh/controllers/podchaos/containersignal"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podchaos/podfailure"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podchaos/podkill"
+	"github.com/chaos-mesh/chaos-mesh/controllers/podchaos/podreip"
 	"github.com/chaos-mesh/chaos-mesh/controllers/twophase"
 )
 
@@ -42,7 +46,7 @@ func (r *Reconciler) Reconcile(req ctrl.Request, chaos *v1alpha1.PodChaos) (ctrl
 	scheduler := chaos.GetScheduler()
 	duration, err := chaos.GetDuration()
 	if err != nil {
-		r.Log.Error(err, fmt.Sprintf("unable to get podchaos[%s/%s]'s duration", chaos.Namespace, chaos.Name))
+		r.Log.Error(err, "unable to get the duration of chaos")
 		return ctrl.Result{}, err
 	}
 	if scheduler == nil && duration == nil {
@@ -52,7 +56,7 @@ func (r *Reconciler) Reconcile(req ctrl.Request, chaos *v1alpha1.PodChaos) (ctrl
 	}
 
 	// This should be ensured by admission webhook in the future
-	r.Log.Error(fmt.Errorf("podchaos[%s/%s] spec invalid", chaos.Namespace, chaos.Name), "scheduler and duration should be omitted or defined at the same time")
+	r.Log.Error(errors.New("spec invalid"), "scheduler and duration should be omitted or defined at the same time")
 	return ctrl.Result{}, fmt.Errorf("invalid scheduler and duration")
 }
 
@@ -63,9 +67,16 @@ func (r *Reconciler) commonPodChaos(podchaos *v1alpha1.PodChaos, req ctrl.Reques
 		return r.notSupportedResponse(podchaos)
 	case v1alpha1.ContainerKillAction:
 		return r.notSupportedResponse(podchaos)
+	case v1alpha1.PodReIPAction:
+		return r.notSupportedResponse(podchaos)
+	case v1alpha1.ContainerSignalAction:
+		return r.notSupportedResponse(podchaos)
 	case v1alpha1.PodFailureAction:
 		pr = podfailure.NewCommonReconciler(r.Client, r.Log.WithValues("action",
 			"pod-failure"), r.EventRecorder)
+	case v1alpha1.ConfigCorruptAction:
+		pr = configcorrupt.NewCommonReconciler(r.Client, r.Log.WithValues("action",
+			"config-corrupt"), r.EventRecorder)
 	default:
 		return r.invalidActionResponse(podchaos)
 	}
@@ -84,6 +95,15 @@ func (r *Reconciler) schedulePodChaos(podchaos *v1alpha1.PodChaos, req ctrl.Requ
 	case v1alpha1.ContainerKillAction:
 		tr = containerkill.NewTwoPhaseReconciler(r.Client, r.Log.WithValues("action",
 			"container-kill"), r.EventRecorder)
+	case v1alpha1.PodReIPAction:
+		tr = podreip.NewTwoPhaseReconciler(r.Client, r.Log.WithValues("action",
+			"pod-reip"), r.EventRecorder)
+	case v1alpha1.ContainerSignalAction:
+		tr = containersignal.NewTwoPhaseReconciler(r.Client, r.Log.WithValues("action",
+			"container-signal"), r.EventRecorder)
+	case v1alpha1.ConfigCorruptAction:
+		tr = configcorrupt.NewTwoPhaseReconciler(r.Client, r.Log.WithValues("action",
+			"config-corrupt"), r.EventRecorder)
 	default:
 		return r.invalidActionResponse(podchaos)
 	}
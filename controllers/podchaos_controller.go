@@ -21,8 +21,10 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podchaos"
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 )
@@ -39,20 +41,19 @@ type PodChaosReconciler struct {
 
 // Reconcile reconciles a PodChaos resource
 func (r *PodChaosReconciler) Reconcile(req ctrl.Request) (result ctrl.Result, err error) {
-	logger := r.Log.WithValues("reconciler", "podchaos")
+	chaos := &v1alpha1.PodChaos{}
+	if err := r.Get(context.Background(), req.NamespacedName, chaos); err != nil {
+		r.Log.Error(err, "unable to get pod chaos")
+		return ctrl.Result{}, nil
+	}
 
+	logger := common.ExperimentLogger(r.Log, chaos).WithValues("reconciler", "podchaos")
 	reconciler := podchaos.Reconciler{
 		Client:        r.Client,
 		EventRecorder: r.EventRecorder,
 		Log:           logger,
 	}
 
-	chaos := &v1alpha1.PodChaos{}
-	if err := r.Get(context.Background(), req.NamespacedName, chaos); err != nil {
-		r.Log.Error(err, "unable to get pod chaos")
-		return ctrl.Result{}, nil
-	}
-
 	result, err = reconciler.Reconcile(req, chaos)
 	if err != nil {
 		if chaos.IsDeleted() || chaos.IsPaused() {
@@ -68,5 +69,9 @@ func (r *PodChaosReconciler) Reconcile(req ctrl.Request) (result ctrl.Result, er
 func (r *PodChaosReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.PodChaos{}).
+		WithEventFilter(common.ShardPredicate()).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: common.ControllerCfg.ConcurrentReconcilesOf(v1alpha1.KindPodChaos),
+		}).
 		Complete(r)
 }
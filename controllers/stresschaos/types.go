@@ -17,15 +17,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
-	"github.com/hashicorp/go-multierror"
-	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
-	k8serror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -40,11 +38,18 @@ import (
 
 const stressChaosMsg = "stress out pod"
 
+// defaultStoragePath is the directory in the writable layer which the ephemeralStorage stressor fills by default
+const defaultStoragePath = "/tmp"
+
 // Reconciler is stresschaos reconciler
 type Reconciler struct {
 	client.Client
 	record.EventRecorder
 	Log logr.Logger
+
+	// instancesLock guards the instances in the status of chaos, which are
+	// updated while the pods are applied or recovered concurrently
+	instancesLock sync.Mutex
 }
 
 // Reconcile reconciles a StressChaos resource
@@ -53,7 +58,7 @@ func (r *Reconciler) Reconcile(req ctrl.Request, chaos *v1alpha1.StressChaos) (c
 	scheduler := chaos.GetScheduler()
 	duration, err := chaos.GetDuration()
 	if err != nil {
-		r.Log.Error(err, fmt.Sprintf("unable to get stresschaos[%s/%s]'s duration", chaos.Namespace, chaos.Name))
+		r.Log.Error(err, "unable to get the duration of chaos")
 		return ctrl.Result{}, err
 	}
 	if scheduler == nil && duration == nil {
@@ -63,7 +68,7 @@ func (r *Reconciler) Reconcile(req ctrl.Request, chaos *v1alpha1.StressChaos) (c
 	}
 
 	// This should be ensured by admission webhook in the future
-	r.Log.Error(fmt.Errorf("stresschaos[%s/%s] spec invalid", chaos.Namespace, chaos.Name), "scheduler and duration should be omitted or defined at the same time")
+	r.Log.Error(errors.New("spec invalid"), "scheduler and duration should be omitted or defined at the same time")
 	return ctrl.Result{}, fmt.Errorf("invalid scheduler and duration")
 }
 
@@ -86,30 +91,23 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 		return err
 	}
 
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, &stresschaos.Spec)
+	stresschaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	pods, err := utils.SelectAndRecordPods(ctx, r.Client, &stresschaos.Spec, stresschaos.Status.Experiment.Selection)
 	if err != nil {
 		r.Log.Error(err, "failed to select and generate pods")
 		return err
 	}
+	common.RecordImpact(ctx, r.Client, stresschaos, pods, false)
 
-	stresschaos.Status.Instances = make(map[string]v1alpha1.StressInstance, len(pods))
+	// the instances of the pods which have been applied are kept when the failed attempt is retried
+	if stresschaos.Status.Experiment.Phase != v1alpha1.ExperimentPhaseFailed || stresschaos.Status.Instances == nil {
+		stresschaos.Status.Instances = make(map[string]v1alpha1.StressInstance, len(pods))
+	}
 	if err = r.applyAllPods(ctx, pods, stresschaos); err != nil {
 		r.Log.Error(err, "failed to apply chaos on all pods")
 		return err
 	}
 
-	stresschaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
-	for _, pod := range pods {
-		ps := v1alpha1.PodStatus{
-			Namespace: pod.Namespace,
-			Name:      pod.Name,
-			HostIP:    pod.Status.HostIP,
-			PodIP:     pod.Status.PodIP,
-			Message:   stressChaosMsg,
-		}
-
-		stresschaos.Status.Experiment.PodRecords = append(stresschaos.Status.Experiment.PodRecords, ps)
-	}
 	r.Event(stresschaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
 	return nil
 }
@@ -132,48 +130,9 @@ func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alph
 }
 
 func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, chaos *v1alpha1.StressChaos) error {
-	var result error
-
-	for _, key := range chaos.Finalizers {
-		ns, name, err := cache.SplitMetaNamespaceKey(key)
-		if err != nil {
-			result = multierror.Append(result, err)
-			continue
-		}
-
-		var pod v1.Pod
-		err = r.Get(ctx, types.NamespacedName{
-			Namespace: ns,
-			Name:      name,
-		}, &pod)
-
-		if err != nil {
-			if !k8serror.IsNotFound(err) {
-				result = multierror.Append(result, err)
-				continue
-			}
-
-			r.Log.Info("Pod not found", "namespace", ns, "name", name)
-			chaos.Finalizers = utils.RemoveFromFinalizer(chaos.Finalizers, key)
-			continue
-		}
-
-		err = r.recoverPod(ctx, &pod, chaos)
-		if err != nil {
-			result = multierror.Append(result, err)
-			continue
-		}
-
-		chaos.Finalizers = utils.RemoveFromFinalizer(chaos.Finalizers, key)
-	}
-
-	if chaos.Annotations[common.AnnotationCleanFinalizer] == common.AnnotationCleanFinalizerForced {
-		r.Log.Info("Force cleanup all finalizers", "chaos", chaos)
-		chaos.Finalizers = chaos.Finalizers[:0]
-		return nil
-	}
-
-	return result
+	return common.RecoverPods(ctx, r.Client, chaos, func(ctx context.Context, pod *v1.Pod) error {
+		return r.recoverPod(ctx, pod, chaos)
+	})
 }
 
 func (r *Reconciler) recoverPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.StressChaos) error {
@@ -187,18 +146,37 @@ func (r *Reconciler) recoverPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha
 	if len(pod.Status.ContainerStatuses) == 0 {
 		return fmt.Errorf("%s/%s can't get the state of container", pod.Namespace, pod.Name)
 	}
+	r.instancesLock.Lock()
 	instance, ok := chaos.Status.Instances[fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)]
+	r.instancesLock.Unlock()
 	if !ok {
 		r.Log.Info("Pod seems already recovered", "pod", pod.UID)
 		return nil
 	}
-	if _, err = daemonClient.CancelStressors(ctx, &pb.CancelStressRequest{
-		Instance:  instance.UID,
-		StartTime: instance.StartTime.UnixNano() / int64(time.Millisecond),
-	}); err != nil {
-		return err
+	// the instance has no UID if only the resources are exhausted
+	if len(instance.UID) != 0 {
+		if _, err = daemonClient.CancelStressors(ctx, &pb.CancelStressRequest{
+			Instance:  instance.UID,
+			StartTime: instance.StartTime.UnixNano() / int64(time.Millisecond),
+		}); err != nil {
+			return err
+		}
+	}
+	req, err := exhaustRequest(chaos, pod)
+	if err != nil {
+		// the storage isn't filled if the request fails to be made, the other resources are
+		// released by the container
+		r.Log.Error(err, "failed to make the request to recover resources", "namespace", pod.Namespace, "name", pod.Name)
+		req = &pb.ExhaustRequest{ContainerId: pod.Status.ContainerStatuses[0].ContainerID}
 	}
+	if req != nil {
+		if _, err = daemonClient.RecoverResources(ctx, req); err != nil {
+			return err
+		}
+	}
+	r.instancesLock.Lock()
 	delete(chaos.Status.Instances, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+	r.instancesLock.Unlock()
 	return nil
 }
 
@@ -208,21 +186,27 @@ func (r *Reconciler) Object() v1alpha1.InnerObject {
 }
 
 func (r *Reconciler) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.StressChaos) error {
-	g := errgroup.Group{}
 	for index := range pods {
-		pod := &pods[index]
-
-		key, err := cache.MetaNamespaceKeyFunc(pod)
+		key, err := cache.MetaNamespaceKeyFunc(&pods[index])
 		if err != nil {
 			return err
 		}
 		chaos.Finalizers = utils.InsertFinalizer(chaos.Finalizers, key)
-
-		g.Go(func() error {
-			return r.applyPod(ctx, pod, chaos)
-		})
 	}
-	return g.Wait()
+
+	return common.ApplyPods(ctx, chaos, pods, func(pod *v1.Pod) v1alpha1.PodStatus {
+		return v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Message:   stressChaosMsg,
+		}
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		return r.applyPod(ctx, pod, chaos)
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		return r.recoverPod(ctx, pod, chaos)
+	})
 }
 
 func (r *Reconciler) applyPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.StressChaos) error {
@@ -234,30 +218,177 @@ func (r *Reconciler) applyPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.
 		return err
 	}
 	defer daemonClient.Close()
+	// stressors are limited by the cgroup v1 hierarchy of the target, which is written
+	// through the cgroups of the host
+	if err := utils.CheckCapabilities(ctx, daemonClient, pod.Spec.NodeName, utils.Requirement{
+		Action:        "stress",
+		CgroupVersion: 1,
+		Privileged:    true,
+		Methods:       stressMethods(chaos),
+	}); err != nil {
+		return err
+	}
 	if len(pod.Status.ContainerStatuses) == 0 {
 		return fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
 	}
+	// the request is made before anything is injected, so that the invalid request fails early
+	exhaust, err := exhaustRequest(chaos, pod)
+	if err != nil {
+		return err
+	}
 	target := pod.Status.ContainerStatuses[0].ContainerID
-	stressors := chaos.Spec.StressngStressors
-	if len(stressors) == 0 {
-		stressors, err = chaos.Spec.Stressors.Normalize()
+	req := &pb.ExecStressRequest{
+		Scope:     pb.ExecStressRequest_POD,
+		Target:    target,
+		Stressors: chaos.Spec.StressngStressors,
+	}
+	if len(req.Stressors) == 0 {
+		req.Stressors, err = chaos.Spec.Stressors.Normalize()
 		if err != nil {
 			return err
 		}
+		if cpu := chaos.Spec.Stressors.CPUStressor; cpu != nil {
+			req.TargetCpuAffinity = cpu.CPUAffinity == v1alpha1.CPUAffinityTarget
+			if cpu.Nice != nil {
+				req.Nice = int32(*cpu.Nice)
+			}
+		}
 	}
-	res, err := daemonClient.ExecStressors(ctx, &pb.ExecStressRequest{
-		Scope:     pb.ExecStressRequest_POD,
-		Target:    target,
-		Stressors: stressors,
-	})
-	if err != nil {
-		return err
+	instance := v1alpha1.StressInstance{
+		StartTime: &metav1.Time{Time: time.Now()},
+	}
+	// stress-ng isn't run if there are only the fileDescriptor and thread stressors
+	if len(strings.TrimSpace(req.Stressors)) != 0 {
+		res, err := daemonClient.ExecStressors(ctx, req)
+		if err != nil {
+			return err
+		}
+		instance = v1alpha1.StressInstance{
+			UID: res.Instance,
+			StartTime: &metav1.Time{
+				Time: time.Unix(res.StartTime/1000, (res.StartTime%1000)*int64(time.Millisecond)),
+			},
+		}
 	}
-	chaos.Status.Instances[fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)] = v1alpha1.StressInstance{
-		UID: res.Instance,
-		StartTime: &metav1.Time{
-			Time: time.Unix(res.StartTime/1000, (res.StartTime%1000)*int64(time.Millisecond)),
-		},
+	// the instance is recorded before the resources are exhausted, so that the stressors are
+	// cancelled on recovery if the exhaustion fails
+	r.instancesLock.Lock()
+	chaos.Status.Instances[fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)] = instance
+	r.instancesLock.Unlock()
+
+	if exhaust != nil {
+		if _, err := daemonClient.ExhaustResources(ctx, exhaust); err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+// stressMethods returns the methods of chaos-daemon which the stressors need
+func stressMethods(chaos *v1alpha1.StressChaos) []string {
+	methods := []string{"ExecStressors"}
+	if exhausting(chaos.Spec.Stressors) {
+		methods = append(methods, "ExhaustResources")
+	}
+	return methods
+}
+
+// exhausting tells whether the stressors exhaust the resources by chaos-daemon instead of stress-ng
+func exhausting(s *v1alpha1.Stressors) bool {
+	return s != nil && (s.FileDescriptorStressor != nil || s.ThreadStressor != nil || s.EphemeralStorageStressor != nil)
+}
+
+// exhaustRequest returns the request to exhaust the resources of the pod,
+// or nil if none of the fileDescriptor, thread and ephemeralStorage stressors is set
+func exhaustRequest(chaos *v1alpha1.StressChaos, pod *v1.Pod) (*pb.ExhaustRequest, error) {
+	stressors := chaos.Spec.Stressors
+	if !exhausting(stressors) || len(pod.Status.ContainerStatuses) == 0 {
+		return nil, nil
+	}
+
+	req := &pb.ExhaustRequest{
+		ContainerId: pod.Status.ContainerStatuses[0].ContainerID,
+	}
+	if stressors.FileDescriptorStressor != nil {
+		req.FdPercent = int32(stressors.FileDescriptorStressor.Percent)
+	}
+	if stressors.ThreadStressor != nil {
+		req.PidPercent = int32(stressors.ThreadStressor.Percent)
+	}
+	if storage := stressors.EphemeralStorageStressor; storage != nil {
+		path, limit, err := storageLimit(pod, storage)
+		if err != nil {
+			return nil, err
+		}
+		req.StoragePath = path
+		req.StorageBytes = limit * int64(storage.Percent) / 100
+	}
+	return req, nil
+}
+
+// storageLimit returns the directory in the target container which the ephemeralStorage stressor fills,
+// and the limit of the storage of the directory
+func storageLimit(pod *v1.Pod, storage *v1alpha1.EphemeralStorageStressor) (string, int64, error) {
+	var container *v1.Container
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == pod.Status.ContainerStatuses[0].Name {
+			container = &pod.Spec.Containers[i]
+			break
+		}
+	}
+	if container == nil {
+		return "", 0, fmt.Errorf("container %s isn't found in pod %s/%s",
+			pod.Status.ContainerStatuses[0].Name, pod.Namespace, pod.Name)
+	}
+
+	if len(storage.Volume) == 0 {
+		path := storage.Path
+		if len(path) == 0 {
+			path = defaultStoragePath
+		}
+		limit, ok := container.Resources.Limits[v1.ResourceEphemeralStorage]
+		if !ok || limit.IsZero() {
+			return "", 0, fmt.Errorf("the ephemeral storage of container %s isn't limited", container.Name)
+		}
+		return path, limit.Value(), nil
+	}
+
+	var mount *v1.VolumeMount
+	for i := range container.VolumeMounts {
+		if container.VolumeMounts[i].Name == storage.Volume {
+			mount = &container.VolumeMounts[i]
+			break
+		}
+	}
+	if mount == nil {
+		return "", 0, fmt.Errorf("volume %s isn't mounted in container %s", storage.Volume, container.Name)
+	}
+	if mount.ReadOnly {
+		return "", 0, fmt.Errorf("volume %s is mounted read-only in container %s", storage.Volume, container.Name)
+	}
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name != storage.Volume {
+			continue
+		}
+		if volume.EmptyDir == nil {
+			return "", 0, fmt.Errorf("volume %s isn't an emptyDir", storage.Volume)
+		}
+		if volume.EmptyDir.SizeLimit != nil && !volume.EmptyDir.SizeLimit.IsZero() {
+			return mount.MountPath, volume.EmptyDir.SizeLimit.Value(), nil
+		}
+		// the emptyDir without the size limit is limited by the ephemeral storage limit of the pod,
+		// which is the sum of the limits of its containers
+		var limit int64
+		for _, c := range pod.Spec.Containers {
+			if l, ok := c.Resources.Limits[v1.ResourceEphemeralStorage]; ok {
+				limit += l.Value()
+			}
+		}
+		if limit == 0 {
+			return "", 0, fmt.Errorf("the size of volume %s isn't limited", storage.Volume)
+		}
+		return mount.MountPath, limit, nil
+	}
+	return "", 0, fmt.Errorf("volume %s isn't found in pod %s/%s", storage.Volume, pod.Namespace, pod.Name)
+}
@@ -19,6 +19,7 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	"github.com/chaos-mesh/chaos-mesh/controllers/stresschaos"
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 
@@ -26,6 +27,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 )
 
 // StressChaosReconciler reconciles a StressChaos object
@@ -40,20 +42,19 @@ type StressChaosReconciler struct {
 
 // Reconcile reconciles a StressChaos resource
 func (r *StressChaosReconciler) Reconcile(req ctrl.Request) (result ctrl.Result, err error) {
-	logger := r.Log.WithValues("reconciler", "stresschaos")
+	chaos := &v1alpha1.StressChaos{}
+	if err := r.Get(context.Background(), req.NamespacedName, chaos); err != nil {
+		r.Log.Error(err, "unable to get stress chaos")
+		return ctrl.Result{}, nil
+	}
 
+	logger := common.ExperimentLogger(r.Log, chaos).WithValues("reconciler", "stresschaos")
 	reconciler := stresschaos.Reconciler{
 		Client:        r.Client,
 		EventRecorder: r.EventRecorder,
 		Log:           logger,
 	}
 
-	chaos := &v1alpha1.StressChaos{}
-	if err := r.Get(context.Background(), req.NamespacedName, chaos); err != nil {
-		r.Log.Error(err, "unable to get stress chaos")
-		return ctrl.Result{}, nil
-	}
-
 	result, err = reconciler.Reconcile(req, chaos)
 	if err != nil {
 		if !chaos.IsDeleted() {
@@ -70,5 +71,9 @@ func (r *StressChaosReconciler) Reconcile(req ctrl.Request) (result ctrl.Result,
 func (r *StressChaosReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.StressChaos{}).
+		WithEventFilter(common.ShardPredicate()).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: common.ControllerCfg.ConcurrentReconcilesOf(v1alpha1.KindStressChaos),
+		}).
 		Complete(r)
 }
@@ -0,0 +1,195 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// eventTargetGone is the reason of the event recorded when a target of the running chaos is gone
+// along with its namespace or workload
+const eventTargetGone = "TargetGone"
+
+// TargetGoneReconciler marks the targets of the running chaos in the shard which are gone once the
+// deletion of their namespaces or workloads is observed, so that the chaos stops fighting the
+// terminating pods and is halted once all its targets are gone
+type TargetGoneReconciler struct {
+	client.Client
+	record.EventRecorder
+	Log logr.Logger
+}
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// Reconcile marks the targets in the namespace of the request which are gone
+func (r *TargetGoneReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	namespace := req.Name
+	checker := utils.NewTargetGoneChecker(r.Client)
+
+	for name, kind := range v1alpha1.AllKinds() {
+		list := kind.ChaosList.DeepCopyObject()
+		if err := r.List(ctx, list); err != nil {
+			r.Log.Error(err, "unable to list chaos", "kind", name)
+			return ctrl.Result{}, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		for _, item := range items {
+			chaos, ok := item.(v1alpha1.InnerObject)
+			if !ok || !common.InShard(chaos.GetChaos().Namespace) ||
+				chaos.GetStatus().Experiment.Phase != v1alpha1.ExperimentPhaseRunning {
+				continue
+			}
+
+			gone, err := r.goneTargets(ctx, checker, chaos.GetStatus(), namespace)
+			if err != nil {
+				r.Log.Error(err, "unable to check the targets", "kind", name, "chaos", chaos.GetChaos().Name)
+				return ctrl.Result{}, err
+			}
+			if len(gone) == 0 {
+				continue
+			}
+			if err := r.markGone(ctx, kind, chaos, gone); err != nil {
+				r.Log.Error(err, "unable to mark the targets which are gone", "kind", name, "chaos", chaos.GetChaos().Name)
+				return ctrl.Result{}, err
+			}
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+// goneTargets returns the reasons of the targets in the namespace which are gone and not marked yet,
+// keyed by the namespace/name of the pods. The pods which have been deleted are left to the chaos.
+func (r *TargetGoneReconciler) goneTargets(ctx context.Context, checker *utils.TargetGoneChecker, status *v1alpha1.ChaosStatus, namespace string) (map[string]string, error) {
+	gone := make(map[string]string)
+	for _, record := range status.Experiment.PodRecords {
+		if record.Namespace != namespace || record.State == v1alpha1.TargetGone {
+			continue
+		}
+
+		var pod v1.Pod
+		if err := r.Get(ctx, types.NamespacedName{Namespace: record.Namespace, Name: record.Name}, &pod); err != nil {
+			if k8serror.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		reason, err := checker.Gone(ctx, &pod)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			gone[record.Namespace+"/"+record.Name] = reason
+		}
+	}
+	return gone, nil
+}
+
+// markGone marks the targets in the latest status of the chaos, and records an event for each of them
+func (r *TargetGoneReconciler) markGone(ctx context.Context, kind *v1alpha1.ChaosKind, chaos v1alpha1.InnerObject, gone map[string]string) error {
+	key := types.NamespacedName{Namespace: chaos.GetChaos().Namespace, Name: chaos.GetChaos().Name}
+
+	var (
+		latest runtime.Object
+		marked []string
+	)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest = kind.Chaos.DeepCopyObject()
+		if err := r.Get(ctx, key, latest); err != nil {
+			return err
+		}
+		status := latest.(v1alpha1.InnerObject).GetStatus()
+		marked = nil
+		for namespacedName, reason := range gone {
+			if common.MarkTargetGone(status, namespacedName, reason) {
+				marked = append(marked, namespacedName)
+			}
+		}
+		if len(marked) == 0 {
+			return nil
+		}
+		return r.Status().Update(ctx, latest)
+	})
+	if k8serror.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, namespacedName := range marked {
+		r.Log.Info("Target is gone", "chaos", key, "pod", namespacedName, "reason", gone[namespacedName])
+		r.Event(latest, v1.EventTypeWarning, eventTargetGone,
+			fmt.Sprintf("pod %s is gone and the chaos isn't recovered from it: %s", namespacedName, gone[namespacedName]))
+	}
+	return nil
+}
+
+// SetupWithManager setups a target gone reconciler on controller-manager. The namespaces being
+// deleted are reconciled, and so are the namespaces of the workloads being deleted.
+func (r *TargetGoneReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	byNamespace := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(obj handler.MapObject) []reconcile.Request {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: obj.Meta.GetNamespace()}}}
+		}),
+	}
+
+	builder := ctrl.NewControllerManagedBy(mgr).
+		Named("target-gone").
+		For(&v1.Namespace{}).
+		WithEventFilter(predicate.Funcs{
+			CreateFunc: func(e event.CreateEvent) bool {
+				return false
+			},
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return e.MetaNew != nil && e.MetaNew.GetDeletionTimestamp() != nil
+			},
+			DeleteFunc: func(e event.DeleteEvent) bool {
+				return true
+			},
+			GenericFunc: func(e event.GenericEvent) bool {
+				return false
+			},
+		})
+	for _, workload := range []runtime.Object{&appsv1.Deployment{}, &appsv1.StatefulSet{}, &appsv1.DaemonSet{}, &appsv1.ReplicaSet{}} {
+		builder = builder.Watches(&source.Kind{Type: workload}, byNamespace)
+	}
+	return builder.Complete(r)
+}
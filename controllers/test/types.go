@@ -44,6 +44,149 @@ func (c *MockChaosDaemonClient) CancelStressors(ctx context.Context, in *chaosda
 	return nil, mockError("CancelStressors")
 }
 
+// Cleanup mocks cleaning up residual faults on chaos-daemon
+func (c *MockChaosDaemonClient) Cleanup(ctx context.Context, in *chaosdaemon.CleanupRequest, opts ...grpc.CallOption) (*chaosdaemon.CleanupResponse, error) {
+	return nil, mockError("Cleanup")
+}
+
+// GetCapabilities mocks reporting the capabilities of the node on chaos-daemon
+func (c *MockChaosDaemonClient) GetCapabilities(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*chaosdaemon.CapabilitiesResponse, error) {
+	if resp := mock.On("MockCapabilitiesResponse"); resp != nil {
+		return resp.(*chaosdaemon.CapabilitiesResponse), nil
+	}
+	return nil, mockError("GetCapabilities")
+}
+
+// BatchSetNetem mocks setting netem of containers in batch on chaos-daemon
+func (c *MockChaosDaemonClient) BatchSetNetem(ctx context.Context, in *chaosdaemon.BatchNetemRequest, opts ...grpc.CallOption) (*chaosdaemon.BatchResponse, error) {
+	return nil, mockError("BatchSetNetem")
+}
+
+// BatchDeleteNetem mocks deleting netem of containers in batch on chaos-daemon
+func (c *MockChaosDaemonClient) BatchDeleteNetem(ctx context.Context, in *chaosdaemon.BatchNetemRequest, opts ...grpc.CallOption) (*chaosdaemon.BatchResponse, error) {
+	return nil, mockError("BatchDeleteNetem")
+}
+
+// BatchSetTbf mocks setting tbf of containers in batch on chaos-daemon
+func (c *MockChaosDaemonClient) BatchSetTbf(ctx context.Context, in *chaosdaemon.BatchTbfRequest, opts ...grpc.CallOption) (*chaosdaemon.BatchResponse, error) {
+	return nil, mockError("BatchSetTbf")
+}
+
+// BatchDeleteTbf mocks deleting tbf of containers in batch on chaos-daemon
+func (c *MockChaosDaemonClient) BatchDeleteTbf(ctx context.Context, in *chaosdaemon.BatchTbfRequest, opts ...grpc.CallOption) (*chaosdaemon.BatchResponse, error) {
+	return nil, mockError("BatchDeleteTbf")
+}
+
+// BatchFlushIpSet mocks flushing ipset of containers in batch on chaos-daemon
+func (c *MockChaosDaemonClient) BatchFlushIpSet(ctx context.Context, in *chaosdaemon.BatchIpSetRequest, opts ...grpc.CallOption) (*chaosdaemon.BatchResponse, error) {
+	return nil, mockError("BatchFlushIpSet")
+}
+
+// BatchFlushIptables mocks flushing iptables of containers in batch on chaos-daemon
+func (c *MockChaosDaemonClient) BatchFlushIptables(ctx context.Context, in *chaosdaemon.BatchIpTablesRequest, opts ...grpc.CallOption) (*chaosdaemon.BatchResponse, error) {
+	return nil, mockError("BatchFlushIptables")
+}
+
+// SetBpfFault mocks injecting the faults by tc-bpf programs on chaos-daemon
+func (c *MockChaosDaemonClient) SetBpfFault(ctx context.Context, in *chaosdaemon.BpfFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("SetBpfFault")
+}
+
+// DeleteBpfFault mocks removing the faults of tc-bpf programs on chaos-daemon
+func (c *MockChaosDaemonClient) DeleteBpfFault(ctx context.Context, in *chaosdaemon.BpfFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("DeleteBpfFault")
+}
+
+// SetConntrackLimit mocks lowering the max of the conntrack table on chaos-daemon
+func (c *MockChaosDaemonClient) SetConntrackLimit(ctx context.Context, in *chaosdaemon.ConntrackRequest, opts ...grpc.CallOption) (*chaosdaemon.ConntrackResponse, error) {
+	return &chaosdaemon.ConntrackResponse{}, mockError("SetConntrackLimit")
+}
+
+// DeleteConntrackLimit mocks restoring the max of the conntrack table on chaos-daemon
+func (c *MockChaosDaemonClient) DeleteConntrackLimit(ctx context.Context, in *chaosdaemon.ConntrackRequest, opts ...grpc.CallOption) (*chaosdaemon.ConntrackResponse, error) {
+	return &chaosdaemon.ConntrackResponse{}, mockError("DeleteConntrackLimit")
+}
+
+// SetMtu mocks changing the mtu of the network interfaces on chaos-daemon
+func (c *MockChaosDaemonClient) SetMtu(ctx context.Context, in *chaosdaemon.MtuRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("SetMtu")
+}
+
+// DeleteMtu mocks restoring the mtu of the network interfaces on chaos-daemon
+func (c *MockChaosDaemonClient) DeleteMtu(ctx context.Context, in *chaosdaemon.MtuRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("DeleteMtu")
+}
+
+// BlockNodePorts mocks blocking the node ports on chaos-daemon
+func (c *MockChaosDaemonClient) BlockNodePorts(ctx context.Context, in *chaosdaemon.NodePortsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("BlockNodePorts")
+}
+
+// UnblockNodePorts mocks unblocking the node ports on chaos-daemon
+func (c *MockChaosDaemonClient) UnblockNodePorts(ctx context.Context, in *chaosdaemon.NodePortsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("UnblockNodePorts")
+}
+
+// SandboxKill mocks killing the sandbox of pods on chaos-daemon
+func (c *MockChaosDaemonClient) SandboxKill(ctx context.Context, in *chaosdaemon.ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("SandboxKill")
+}
+
+// ExhaustResources mocks exhausting the resources of containers on chaos-daemon
+func (c *MockChaosDaemonClient) ExhaustResources(ctx context.Context, in *chaosdaemon.ExhaustRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("ExhaustResources")
+}
+
+// RecoverResources mocks releasing the exhausted resources of containers on chaos-daemon
+func (c *MockChaosDaemonClient) RecoverResources(ctx context.Context, in *chaosdaemon.ExhaustRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("RecoverResources")
+}
+
+// SetTimerSlack mocks setting the timer slack of containers on chaos-daemon
+func (c *MockChaosDaemonClient) SetTimerSlack(ctx context.Context, in *chaosdaemon.TimerSlackRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("SetTimerSlack")
+}
+
+// ResetTimerSlack mocks resetting the timer slack of containers on chaos-daemon
+func (c *MockChaosDaemonClient) ResetTimerSlack(ctx context.Context, in *chaosdaemon.TimerSlackRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("ResetTimerSlack")
+}
+
+// ContainerSignal mocks sending signals to the processes of containers on chaos-daemon
+func (c *MockChaosDaemonClient) ContainerSignal(ctx context.Context, in *chaosdaemon.SignalRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("ContainerSignal")
+}
+
+// CorruptConfig mocks corrupting the files of ConfigMap or Secret volumes on chaos-daemon
+func (c *MockChaosDaemonClient) CorruptConfig(ctx context.Context, in *chaosdaemon.ConfigRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("CorruptConfig")
+}
+
+// RecoverConfig mocks recovering the files of ConfigMap or Secret volumes on chaos-daemon
+func (c *MockChaosDaemonClient) RecoverConfig(ctx context.Context, in *chaosdaemon.ConfigRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("RecoverConfig")
+}
+
+// SetDBFault mocks injecting the faults of database protocols on chaos-daemon
+func (c *MockChaosDaemonClient) SetDBFault(ctx context.Context, in *chaosdaemon.DBFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("SetDBFault")
+}
+
+// DeleteDBFault mocks recovering the faults of database protocols on chaos-daemon
+func (c *MockChaosDaemonClient) DeleteDBFault(ctx context.Context, in *chaosdaemon.DBFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("DeleteDBFault")
+}
+
+// FailoverDB mocks forcing the failover of databases on chaos-daemon
+func (c *MockChaosDaemonClient) FailoverDB(ctx context.Context, in *chaosdaemon.DBFailoverRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("FailoverDB")
+}
+
+// WatchStatus mocks watching the progress of experiments on chaos-daemon
+func (c *MockChaosDaemonClient) WatchStatus(ctx context.Context, in *chaosdaemon.WatchStatusRequest, opts ...grpc.CallOption) (chaosdaemon.ChaosDaemon_WatchStatusClient, error) {
+	return nil, mockError("WatchStatus")
+}
+
 func (c *MockChaosDaemonClient) ContainerGetPid(ctx context.Context, in *chaosdaemon.ContainerRequest, opts ...grpc.CallOption) (*chaosdaemon.ContainerResponse, error) {
 	if resp := mock.On("MockContainerGetPidResponse"); resp != nil {
 		return resp.(*chaosdaemon.ContainerResponse), nil
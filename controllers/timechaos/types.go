@@ -17,14 +17,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
-	"github.com/hashicorp/go-multierror"
 	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
-	k8serror "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -37,7 +35,10 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 )
 
-const timeChaosMsg = "time is shifted with %v"
+const (
+	timeChaosMsg  = "time is shifted with %v"
+	timerSlackMsg = "timers are delayed by up to %v"
+)
 
 // Reconciler is time-chaos reconciler
 type Reconciler struct {
@@ -52,7 +53,7 @@ func (r *Reconciler) Reconcile(req ctrl.Request, chaos *v1alpha1.TimeChaos) (ctr
 	scheduler := chaos.GetScheduler()
 	duration, err := chaos.GetDuration()
 	if err != nil {
-		r.Log.Error(err, fmt.Sprintf("unable to get timechaos[%s/%s]'s duration", chaos.Namespace, chaos.Name))
+		r.Log.Error(err, "unable to get the duration of chaos")
 		return ctrl.Result{}, err
 	}
 	if scheduler == nil && duration == nil {
@@ -62,7 +63,7 @@ func (r *Reconciler) Reconcile(req ctrl.Request, chaos *v1alpha1.TimeChaos) (ctr
 	}
 
 	// This should be ensured by admission webhook in the future
-	r.Log.Error(fmt.Errorf("timechaos[%s/%s] spec invalid", chaos.Namespace, chaos.Name), "scheduler and duration should be omitted or defined at the same time")
+	r.Log.Error(errors.New("spec invalid"), "scheduler and duration should be omitted or defined at the same time")
 	return ctrl.Result{}, fmt.Errorf("invalid scheduler and duration")
 }
 
@@ -85,32 +86,20 @@ func (r *Reconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1
 		return err
 	}
 
-	timechaos.SetDefaultValue()
-
-	pods, err := utils.SelectAndFilterPods(ctx, r.Client, &timechaos.Spec)
+	timechaos.Status.Experiment.Selection = &v1alpha1.SelectionStatus{}
+	pods, err := utils.SelectAndRecordPods(ctx, r.Client, &timechaos.Spec, timechaos.Status.Experiment.Selection)
 
 	if err != nil {
 		r.Log.Error(err, "failed to select and filter pods")
 		return err
 	}
+	common.RecordImpact(ctx, r.Client, timechaos, pods, false)
 
 	if err = r.applyAllPods(ctx, pods, timechaos); err != nil {
 		r.Log.Error(err, "failed to apply chaos on all pods")
 		return err
 	}
 
-	timechaos.Status.Experiment.PodRecords = make([]v1alpha1.PodStatus, 0, len(pods))
-	for _, pod := range pods {
-		ps := v1alpha1.PodStatus{
-			Namespace: pod.Namespace,
-			Name:      pod.Name,
-			HostIP:    pod.Status.HostIP,
-			PodIP:     pod.Status.PodIP,
-			Message:   fmt.Sprintf(timeChaosMsg, timechaos.Spec.TimeOffset),
-		}
-
-		timechaos.Status.Experiment.PodRecords = append(timechaos.Status.Experiment.PodRecords, ps)
-	}
 	r.Event(timechaos, v1.EventTypeNormal, utils.EventChaosInjected, "")
 	return nil
 }
@@ -133,48 +122,9 @@ func (r *Reconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alph
 }
 
 func (r *Reconciler) cleanFinalizersAndRecover(ctx context.Context, chaos *v1alpha1.TimeChaos) error {
-	var result error
-
-	for _, key := range chaos.Finalizers {
-		ns, name, err := cache.SplitMetaNamespaceKey(key)
-		if err != nil {
-			result = multierror.Append(result, err)
-			continue
-		}
-
-		var pod v1.Pod
-		err = r.Get(ctx, types.NamespacedName{
-			Namespace: ns,
-			Name:      name,
-		}, &pod)
-
-		if err != nil {
-			if !k8serror.IsNotFound(err) {
-				result = multierror.Append(result, err)
-				continue
-			}
-
-			r.Log.Info("Pod not found", "namespace", ns, "name", name)
-			chaos.Finalizers = utils.RemoveFromFinalizer(chaos.Finalizers, key)
-			continue
-		}
-
-		err = r.recoverPod(ctx, &pod, chaos)
-		if err != nil {
-			result = multierror.Append(result, err)
-			continue
-		}
-
-		chaos.Finalizers = utils.RemoveFromFinalizer(chaos.Finalizers, key)
-	}
-
-	if chaos.Annotations[common.AnnotationCleanFinalizer] == common.AnnotationCleanFinalizerForced {
-		r.Log.Info("Force cleanup all finalizers", "chaos", chaos)
-		chaos.Finalizers = chaos.Finalizers[:0]
-		return nil
-	}
-
-	return result
+	return common.RecoverPods(ctx, r.Client, chaos, func(ctx context.Context, pod *v1.Pod) error {
+		return r.recoverPod(ctx, pod, chaos)
+	})
 }
 
 func (r *Reconciler) recoverPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.TimeChaos) error {
@@ -200,7 +150,7 @@ func (r *Reconciler) recoverPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha
 
 		if len(expectedNames) == 0 || expectedNames[container.Name] {
 			g.Go(func() error {
-				err := r.recoverContainer(ctx, pbClient, container.ContainerID)
+				err := r.recoverContainer(ctx, pbClient, container.ContainerID, chaos)
 
 				if err != nil {
 					r.Log.Error(err, "recover pod error", "namespace", pod.Namespace, "name", pod.Name)
@@ -216,9 +166,20 @@ func (r *Reconciler) recoverPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha
 	return g.Wait()
 }
 
-func (r *Reconciler) recoverContainer(ctx context.Context, client chaosdaemon.ChaosDaemonClient, containerID string) error {
+func (r *Reconciler) recoverContainer(ctx context.Context, client chaosdaemon.ChaosDaemonClient, containerID string, chaos *v1alpha1.TimeChaos) error {
 	r.Log.Info("Try to recover time on container", "id", containerID)
 
+	if len(chaos.Spec.TimerSlack) != 0 {
+		if _, err := client.ResetTimerSlack(ctx, &chaosdaemon.TimerSlackRequest{
+			ContainerId: containerID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(chaos.Spec.TimeOffset) == 0 {
+		return nil
+	}
 	_, err := client.RecoverTimeOffset(ctx, &chaosdaemon.TimeRequest{
 		ContainerId: containerID,
 	})
@@ -232,22 +193,27 @@ func (r *Reconciler) Object() v1alpha1.InnerObject {
 }
 
 func (r *Reconciler) applyAllPods(ctx context.Context, pods []v1.Pod, chaos *v1alpha1.TimeChaos) error {
-	g := errgroup.Group{}
 	for index := range pods {
-		pod := &pods[index]
-
-		key, err := cache.MetaNamespaceKeyFunc(pod)
+		key, err := cache.MetaNamespaceKeyFunc(&pods[index])
 		if err != nil {
 			return err
 		}
 		chaos.Finalizers = utils.InsertFinalizer(chaos.Finalizers, key)
-
-		g.Go(func() error {
-			return r.applyPod(ctx, pod, chaos)
-		})
 	}
 
-	return g.Wait()
+	return common.ApplyPods(ctx, chaos, pods, func(pod *v1.Pod) v1alpha1.PodStatus {
+		return v1alpha1.PodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			HostIP:    pod.Status.HostIP,
+			PodIP:     pod.Status.PodIP,
+			Message:   timeChaosMessage(chaos),
+		}
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		return r.applyPod(ctx, pod, chaos)
+	}, func(ctx context.Context, pod *v1.Pod) error {
+		return r.recoverPod(ctx, pod, chaos)
+	})
 }
 
 func (r *Reconciler) applyPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.TimeChaos) error {
@@ -259,6 +225,23 @@ func (r *Reconciler) applyPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.
 	}
 	defer pbClient.Close()
 
+	requirement := utils.Requirement{
+		Action:            "time",
+		LinuxCapabilities: utils.TimeCapabilities,
+	}
+	if len(chaos.Spec.TimeOffset) != 0 {
+		requirement.Methods = append(requirement.Methods, "SetTimeOffset")
+	}
+	// the timer slack of other processes can only be written with CAP_SYS_NICE, which chaos-daemon
+	// only has in the privileged mode
+	if len(chaos.Spec.TimerSlack) != 0 {
+		requirement.Privileged = true
+		requirement.Methods = append(requirement.Methods, "SetTimerSlack")
+	}
+	if err := utils.CheckCapabilities(ctx, pbClient, pod.Spec.NodeName, requirement); err != nil {
+		return err
+	}
+
 	if len(pod.Status.ContainerStatuses) == 0 {
 		return fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
 	}
@@ -284,7 +267,29 @@ func (r *Reconciler) applyPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.
 func (r *Reconciler) applyContainer(ctx context.Context, client chaosdaemon.ChaosDaemonClient, containerID string, chaos *v1alpha1.TimeChaos) error {
 	r.Log.Info("Try to shift time on container", "id", containerID)
 
-	mask, err := utils.EncodeClkIds(chaos.Spec.ClockIds)
+	if len(chaos.Spec.TimerSlack) != 0 {
+		slack, err := time.ParseDuration(chaos.Spec.TimerSlack)
+		if err != nil {
+			return err
+		}
+
+		r.Log.Info("setting timer slack", "slack", slack)
+		if _, err := client.SetTimerSlack(ctx, &chaosdaemon.TimerSlackRequest{
+			ContainerId: containerID,
+			SlackNs:     uint64(slack.Nanoseconds()),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(chaos.Spec.TimeOffset) == 0 {
+		return nil
+	}
+	// the chaos created before the defaulting webhook sets the clock ids may have none,
+	// so it's defaulted on a copy, which keeps the stored spec untouched
+	spec := chaos.Spec.DeepCopy()
+	spec.DefaultClockIds()
+	mask, err := utils.EncodeClkIds(spec.ClockIds)
 	if err != nil {
 		return err
 	}
@@ -307,6 +312,18 @@ func (r *Reconciler) applyContainer(ctx context.Context, client chaosdaemon.Chao
 	return err
 }
 
+// timeChaosMessage describes the time chaos applied on the pods
+func timeChaosMessage(chaos *v1alpha1.TimeChaos) string {
+	var messages []string
+	if len(chaos.Spec.TimeOffset) != 0 {
+		messages = append(messages, fmt.Sprintf(timeChaosMsg, chaos.Spec.TimeOffset))
+	}
+	if len(chaos.Spec.TimerSlack) != 0 {
+		messages = append(messages, fmt.Sprintf(timerSlackMsg, chaos.Spec.TimerSlack))
+	}
+	return strings.Join(messages, ", ")
+}
+
 func secAndNSecFromDuration(duration time.Duration) (sec int64, nsec int64) {
 	sec = duration.Nanoseconds() / 1e9
 	nsec = duration.Nanoseconds() - (sec * 1e9)
@@ -18,6 +18,8 @@ import (
 	"time"
 
 	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 )
 
 type SecAndNSecFromDurationTestCase struct {
@@ -43,3 +45,19 @@ func TestSecAndNSecFromDuration(t *testing.T) {
 		g.Expect(nsec).Should(Equal(c.NSec))
 	}
 }
+
+func TestTimeChaosMessage(t *testing.T) {
+	g := NewGomegaWithT(t)
+	cases := []struct {
+		Spec    v1alpha1.TimeChaosSpec
+		Message string
+	}{
+		{v1alpha1.TimeChaosSpec{TimeOffset: "-1h"}, "time is shifted with -1h"},
+		{v1alpha1.TimeChaosSpec{TimerSlack: "10ms"}, "timers are delayed by up to 10ms"},
+		{v1alpha1.TimeChaosSpec{TimeOffset: "5s", TimerSlack: "1ms"}, "time is shifted with 5s, timers are delayed by up to 1ms"},
+	}
+
+	for _, c := range cases {
+		g.Expect(timeChaosMessage(&v1alpha1.TimeChaos{Spec: c.Spec})).Should(Equal(c.Message))
+	}
+}
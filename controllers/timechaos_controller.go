@@ -21,8 +21,10 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	"github.com/chaos-mesh/chaos-mesh/controllers/timechaos"
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 )
@@ -39,20 +41,19 @@ type TimeChaosReconciler struct {
 
 // Reconcile reconciles a TimeChaos resource
 func (r *TimeChaosReconciler) Reconcile(req ctrl.Request) (result ctrl.Result, err error) {
-	logger := r.Log.WithValues("reconciler", "timechaos")
+	chaos := &v1alpha1.TimeChaos{}
+	if err := r.Get(context.Background(), req.NamespacedName, chaos); err != nil {
+		r.Log.Error(err, "unable to get time chaos")
+		return ctrl.Result{}, nil
+	}
 
+	logger := common.ExperimentLogger(r.Log, chaos).WithValues("reconciler", "timechaos")
 	reconciler := timechaos.Reconciler{
 		Client:        r.Client,
 		EventRecorder: r.EventRecorder,
 		Log:           logger,
 	}
 
-	chaos := &v1alpha1.TimeChaos{}
-	if err := r.Get(context.Background(), req.NamespacedName, chaos); err != nil {
-		r.Log.Error(err, "unable to get time chaos")
-		return ctrl.Result{}, nil
-	}
-
 	result, err = reconciler.Reconcile(req, chaos)
 	if err != nil {
 		if chaos.IsDeleted() || chaos.IsPaused() {
@@ -69,5 +70,9 @@ func (r *TimeChaosReconciler) Reconcile(req ctrl.Request) (result ctrl.Result, e
 func (r *TimeChaosReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.TimeChaos{}).
+		WithEventFilter(common.ShardPredicate()).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: common.ControllerCfg.ConcurrentReconcilesOf(v1alpha1.KindTimeChaos),
+		}).
 		Complete(r)
 }
@@ -60,9 +60,15 @@ var _ = AfterSuite(func() {
 
 var _ reconciler.InnerReconciler = (*fakeReconciler)(nil)
 
-type fakeReconciler struct{}
+type fakeReconciler struct {
+	// calls records the operations performed by the reconciler if it's not nil
+	calls *[]string
+}
 
 func (r fakeReconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	if r.calls != nil {
+		*r.calls = append(*r.calls, "Apply")
+	}
 	if err := mock.On("MockApplyError"); err != nil {
 		return err.(error)
 	}
@@ -70,6 +76,9 @@ func (r fakeReconciler) Apply(ctx context.Context, req ctrl.Request, chaos v1alp
 }
 
 func (r fakeReconciler) Recover(ctx context.Context, req ctrl.Request, chaos v1alpha1.InnerObject) error {
+	if r.calls != nil {
+		*r.calls = append(*r.calls, "Recover")
+	}
 	if err := mock.On("MockRecoverError"); err != nil {
 		return err.(error)
 	}
@@ -132,6 +141,10 @@ func (in *fakeTwoPhaseChaos) GetDuration() (*time.Duration, error) {
 	return &duration, nil
 }
 
+func (in *fakeTwoPhaseChaos) GetRecoveryTimeout() (*time.Duration, error) {
+	return nil, nil
+}
+
 func (in *fakeTwoPhaseChaos) GetNextStart() time.Time {
 	if in.NextStart == nil {
 		return time.Time{}
@@ -417,5 +430,110 @@ var _ = Describe("TwoPhase", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("ApplyError"))
 		})
+
+		It("TwoPhase Forbid Concurrent", func() {
+			chaos := fakeTwoPhaseChaos{
+				TypeMeta:   typeMeta,
+				ObjectMeta: objectMeta,
+				Scheduler:  &v1alpha1.SchedulerSpec{Cron: "@hourly"},
+			}
+
+			chaos.SetNextRecover(futureTime)
+			chaos.SetNextStart(pastTime)
+			chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+
+			c := fake.NewFakeClientWithScheme(scheme.Scheme, &chaos)
+
+			var calls []string
+			r := twophase.Reconciler{
+				InnerReconciler: fakeReconciler{calls: &calls},
+				Client:          c,
+				Log:             ctrl.Log.WithName("controllers").WithName("TwoPhase"),
+			}
+
+			_, err = r.Reconcile(req)
+
+			Expect(err).ToNot(HaveOccurred())
+			_chaos := r.Object()
+			err = r.Get(context.TODO(), req.NamespacedName, _chaos)
+			Expect(err).ToNot(HaveOccurred())
+			history := _chaos.(v1alpha1.InnerSchedulerObject).GetStatus().Scheduler.History
+			Expect(history).To(HaveLen(1))
+			Expect(history[0].Result).To(Equal(v1alpha1.ScheduleResultSkipped))
+			// the running chaos is left as is
+			Expect(calls).To(BeEmpty())
+			Expect(_chaos.(v1alpha1.InnerSchedulerObject).GetNextStart().After(time.Now())).To(BeTrue())
+		})
+
+		It("TwoPhase Replace Concurrent", func() {
+			chaos := fakeTwoPhaseChaos{
+				TypeMeta:   typeMeta,
+				ObjectMeta: objectMeta,
+				Scheduler:  &v1alpha1.SchedulerSpec{Cron: "@hourly", ConcurrencyPolicy: v1alpha1.ReplaceConcurrent},
+			}
+
+			chaos.SetNextRecover(futureTime)
+			chaos.SetNextStart(pastTime)
+			chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+			chaos.Status.Scheduler.AddRecord(v1alpha1.ScheduleRecord{Result: v1alpha1.ScheduleResultRunning}, v1alpha1.DefaultScheduleHistoryLimit)
+
+			c := fake.NewFakeClientWithScheme(scheme.Scheme, &chaos)
+
+			var calls []string
+			r := twophase.Reconciler{
+				InnerReconciler: fakeReconciler{calls: &calls},
+				Client:          c,
+				Log:             ctrl.Log.WithName("controllers").WithName("TwoPhase"),
+			}
+
+			_, err = r.Reconcile(req)
+
+			Expect(err).ToNot(HaveOccurred())
+			_chaos := r.Object()
+			err = r.Get(context.TODO(), req.NamespacedName, _chaos)
+			Expect(err).ToNot(HaveOccurred())
+			history := _chaos.(v1alpha1.InnerSchedulerObject).GetStatus().Scheduler.History
+			Expect(history).To(HaveLen(2))
+			Expect(history[0].Result).To(Equal(v1alpha1.ScheduleResultReplaced))
+			Expect(history[0].Message).To(Equal("replaced by a new run"))
+			Expect(history[0].EndTime).ToNot(BeNil())
+			Expect(history[1].Result).To(Equal(v1alpha1.ScheduleResultRunning))
+			Expect(history[1].StartTime).ToNot(BeNil())
+
+			// the running chaos is recovered before the new run is applied
+			Expect(calls).To(Equal([]string{"Recover", "Apply"}))
+			Expect(_chaos.(v1alpha1.InnerSchedulerObject).GetStatus().Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseRunning))
+			Expect(_chaos.(v1alpha1.InnerSchedulerObject).GetNextStart().After(time.Now())).To(BeTrue())
+		})
+
+		It("TwoPhase Missed Starting Deadline", func() {
+			deadline := int64(60)
+			chaos := fakeTwoPhaseChaos{
+				TypeMeta:   typeMeta,
+				ObjectMeta: objectMeta,
+				Scheduler:  &v1alpha1.SchedulerSpec{Cron: "@hourly", StartingDeadlineSeconds: &deadline},
+			}
+
+			chaos.SetNextStart(pastTime)
+
+			c := fake.NewFakeClientWithScheme(scheme.Scheme, &chaos)
+
+			r := twophase.Reconciler{
+				InnerReconciler: fakeReconciler{},
+				Client:          c,
+				Log:             ctrl.Log.WithName("controllers").WithName("TwoPhase"),
+			}
+
+			_, err = r.Reconcile(req)
+
+			Expect(err).ToNot(HaveOccurred())
+			_chaos := r.Object()
+			err = r.Get(context.TODO(), req.NamespacedName, _chaos)
+			Expect(err).ToNot(HaveOccurred())
+			status := _chaos.(v1alpha1.InnerSchedulerObject).GetStatus()
+			Expect(status.Scheduler.History).To(HaveLen(1))
+			Expect(status.Scheduler.History[0].Result).To(Equal(v1alpha1.ScheduleResultMissed))
+			Expect(status.Experiment.Phase).ToNot(Equal(v1alpha1.ExperimentPhaseRunning))
+		})
 	})
 })
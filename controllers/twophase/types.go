@@ -22,7 +22,9 @@ import (
 	"k8s.io/client-go/util/retry"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	"github.com/chaos-mesh/chaos-mesh/controllers/reconciler"
+	"github.com/chaos-mesh/chaos-mesh/pkg/audit"
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -50,9 +52,11 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	var err error
 	now := time.Now()
 
-	r.Log.Info("Reconciling a two phase chaos", "name", req.Name, "namespace", req.Namespace)
+	r.Log.Info("Reconciling a two phase chaos")
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	ctx, span := common.StartReconcileSpan(ctx, req)
+	defer span.End()
 
 	_chaos := r.Object()
 	if err = r.Get(ctx, req.NamespacedName, _chaos); err != nil {
@@ -60,6 +64,7 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, err
 	}
 	chaos := _chaos.(v1alpha1.InnerSchedulerObject)
+	ctx = common.WithOriginal(ctx, chaos)
 
 	duration, err := chaos.GetDuration()
 	if err != nil {
@@ -83,22 +88,38 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	if chaos.IsDeleted() {
 		// This chaos was deleted
 		r.Log.Info("Removing self")
-		err = r.Recover(ctx, req, chaos)
+		err = common.RecoverDeleted(ctx, r.InnerReconciler, req, chaos)
 		if err != nil {
 			r.Log.Error(err, "failed to recover chaos")
+
+			updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				return common.UpdateChaos(ctx, r.Client, chaos)
+			})
+			if updateError != nil {
+				r.Log.Error(updateError, "unable to update chaos status")
+			}
+
 			return ctrl.Result{Requeue: true}, err
 		}
+		cleanPodAnnotations(ctx, r, chaos)
 
+		status.Scheduler.FinishRunning(now, v1alpha1.ScheduleResultInterrupted, "chaos is deleted")
 		status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
 	} else if chaos.IsPaused() {
 		if status.Experiment.Phase == v1alpha1.ExperimentPhaseRunning {
 			r.Log.Info("Pausing")
 
-			err = r.Recover(ctx, req, chaos)
+			opCtx, op := common.StartOperation(ctx, chaos, audit.OperationRecover)
+			err = r.Recover(opCtx, req, chaos)
+			op.Finish(err)
 			if err != nil {
 				r.Log.Error(err, "failed to pause chaos")
+				common.RecordRecoveryFailure(ctx, r.Client, chaos, err)
 				return ctrl.Result{Requeue: true}, err
 			}
+			common.SetRecoveringCondition(status, nil)
+			status.Experiment.Stagger = nil
+			cleanPodAnnotations(ctx, r, chaos)
 
 			now := time.Now()
 			status.Experiment.EndTime = &metav1.Time{
@@ -107,18 +128,33 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 			if status.Experiment.StartTime != nil {
 				status.Experiment.Duration = now.Sub(status.Experiment.StartTime.Time).String()
 			}
+			status.Scheduler.FinishRunning(now, v1alpha1.ScheduleResultInterrupted, "chaos is paused")
 		}
 		status.Experiment.Phase = v1alpha1.ExperimentPhasePaused
-	} else if !chaos.GetNextRecover().IsZero() && chaos.GetNextRecover().Before(now) {
-		// Start recover
+	} else if (!chaos.GetNextRecover().IsZero() && chaos.GetNextRecover().Before(now)) ||
+		(status.Experiment.Phase == v1alpha1.ExperimentPhaseRunning && common.AllTargetsGone(status)) {
+		// Start recover, the run is halted early if all the targets are gone
 		r.Log.Info("Recovering")
 
+		result, message := v1alpha1.ScheduleResultSucceeded, ""
+		if chaos.GetNextRecover().After(now) {
+			r.Log.Info("Halting as all the targets are gone")
+			result, message = v1alpha1.ScheduleResultInterrupted, "all the targets are gone"
+		}
+
 		// Don't need to recover again if chaos was paused before
 		if status.Experiment.Phase != v1alpha1.ExperimentPhasePaused {
-			if err = r.Recover(ctx, req, chaos); err != nil {
+			opCtx, op := common.StartOperation(ctx, chaos, audit.OperationRecover)
+			err = r.Recover(opCtx, req, chaos)
+			op.Finish(err)
+			if err != nil {
 				r.Log.Error(err, "failed to recover chaos")
+				common.RecordRecoveryFailure(ctx, r.Client, chaos, err)
 				return ctrl.Result{Requeue: true}, err
 			}
+			common.SetRecoveringCondition(status, nil)
+			status.Experiment.Stagger = nil
+			cleanPodAnnotations(ctx, r, chaos)
 		}
 
 		chaos.SetNextRecover(time.Time{})
@@ -127,6 +163,7 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 			Time: time.Now(),
 		}
 		status.Experiment.Phase = v1alpha1.ExperimentPhaseWaiting
+		status.Scheduler.FinishRunning(status.Experiment.EndTime.Time, result, message)
 	} else if status.Experiment.Phase == v1alpha1.ExperimentPhasePaused &&
 		!chaos.GetNextRecover().IsZero() && chaos.GetNextRecover().After(now) {
 		// Only resume chaos in the case when current round is not finished,
@@ -140,45 +177,116 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 			return ctrl.Result{Requeue: true}, err
 		}
 
+	} else if wait, ok := common.NextStaggerStep(status, now); ok && wait <= 0 {
+		r.Log.Info("Injecting the next staggered pod")
+		if err = common.ApplyStaggerStep(ctx, r.InnerReconciler, r.Client, req, chaos); err != nil {
+			r.Log.Error(err, "failed to apply chaos action")
+
+			status.Experiment.Phase = v1alpha1.ExperimentPhaseFailed
+			status.Scheduler.FinishRunning(time.Now(), v1alpha1.ScheduleResultFailed, err.Error())
+
+			updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				return common.UpdateChaos(ctx, r.Client, chaos)
+			})
+			if updateError != nil {
+				r.Log.Error(updateError, "unable to update chaos finalizers")
+			}
+			return ctrl.Result{Requeue: true}, err
+		}
 	} else if chaos.GetNextStart().Before(now) {
-		nextStart, err := utils.NextTime(*chaos.GetScheduler(), now)
+		nextStart, err := utils.NextTime(*scheduler, now)
 		if err != nil {
 			r.Log.Error(err, "failed to get next start time")
 			return ctrl.Result{}, err
 		}
 
-		nextRecover := now.Add(*duration)
-		if nextStart.Before(nextRecover) {
-			err := fmt.Errorf("nextRecover shouldn't be later than nextStart")
-			r.Log.Error(err, "nextRecover is later than nextStart. Then recover can never be reached",
-				"nextRecover", nextRecover, "nextStart", nextStart)
-			return ctrl.Result{}, err
+		// The first run of a chaos has no scheduled time, so it's treated as scheduled now
+		scheduledTime := chaos.GetNextStart()
+		if scheduledTime.IsZero() {
+			scheduledTime = now
+		}
+		record := v1alpha1.ScheduleRecord{
+			ScheduledTime: metav1.Time{Time: scheduledTime},
 		}
+		historyLimit := scheduler.GetHistoryLimit()
+		nextRecover := now.Add(*duration)
 
-		if err := applyAction(ctx, r, req, *duration, chaos); err != nil {
-			return ctrl.Result{Requeue: true}, err
+		running := status.Experiment.Phase == v1alpha1.ExperimentPhaseRunning &&
+			!chaos.GetNextRecover().IsZero() && chaos.GetNextRecover().After(now)
+		policy := scheduler.GetConcurrencyPolicy()
+
+		if scheduler.MissedDeadline(chaos.GetNextStart(), now) {
+			r.Log.Info("Missed the starting deadline", "scheduledTime", scheduledTime)
+
+			record.Result = v1alpha1.ScheduleResultMissed
+			record.Message = "missed the starting deadline"
+			status.Scheduler.AddRecord(record, historyLimit)
+		} else if running && policy == v1alpha1.ForbidConcurrent {
+			r.Log.Info("Skipping the run as the previous one is still running", "scheduledTime", scheduledTime)
+
+			record.Result = v1alpha1.ScheduleResultSkipped
+			record.Message = "the previous run is still running"
+			status.Scheduler.AddRecord(record, historyLimit)
+		} else if running && policy == v1alpha1.AllowConcurrent {
+			r.Log.Info("Postponing the recovery of the running chaos", "nextRecover", nextRecover)
+
+			record.StartTime = &metav1.Time{Time: now}
+			record.Result = v1alpha1.ScheduleResultRunning
+			status.Scheduler.AddRecord(record, historyLimit)
+
+			chaos.SetNextRecover(nextRecover)
+		} else {
+			if running {
+				r.Log.Info("Replacing the running chaos")
+
+				opCtx, op := common.StartOperation(ctx, chaos, audit.OperationRecover)
+				err = r.Recover(opCtx, req, chaos)
+				op.Finish(err)
+				if err != nil {
+					r.Log.Error(err, "failed to recover chaos")
+					common.RecordRecoveryFailure(ctx, r.Client, chaos, err)
+					return ctrl.Result{Requeue: true}, err
+				}
+				common.SetRecoveringCondition(status, nil)
+				status.Experiment.Stagger = nil
+				cleanPodAnnotations(ctx, r, chaos)
+
+				status.Scheduler.FinishRunning(now, v1alpha1.ScheduleResultReplaced, "replaced by a new run")
+			}
+
+			record.StartTime = &metav1.Time{Time: now}
+			record.Result = v1alpha1.ScheduleResultRunning
+			status.Scheduler.AddRecord(record, historyLimit)
+
+			if err := applyAction(ctx, r, req, *duration, chaos); err != nil {
+				return ctrl.Result{Requeue: true}, err
+			}
+
+			chaos.SetNextRecover(nextRecover)
 		}
 
 		chaos.SetNextStart(*nextStart)
-		chaos.SetNextRecover(nextRecover)
 	} else {
 		nextTime := chaos.GetNextStart()
 
 		if !chaos.GetNextRecover().IsZero() && chaos.GetNextRecover().Before(nextTime) {
 			nextTime = chaos.GetNextRecover()
 		}
+		if stagger := status.Experiment.Stagger; stagger != nil && stagger.NextTime.Time.Before(nextTime) {
+			nextTime = stagger.NextTime.Time
+		}
 		duration := nextTime.Sub(now)
 		r.Log.Info("Requeue request", "after", duration)
 
 		return ctrl.Result{RequeueAfter: duration}, nil
 	}
 
-	if err := r.Update(ctx, chaos); err != nil {
+	if err := common.UpdateChaos(ctx, r.Client, chaos); err != nil {
 		r.Log.Error(err, "unable to update chaos status")
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return common.StaggerResult(status), nil
 }
 
 func applyAction(
@@ -194,13 +302,24 @@ func applyAction(
 	// Start to apply action
 	r.Log.Info("Performing Action")
 
-	if err := r.Apply(ctx, req, chaos); err != nil {
+	var err error
+	opCtx, op := common.StartOperation(ctx, chaos, audit.OperationInject)
+	if common.ControllerCfg.SecurityMode {
+		err = common.CheckCreatorPermission(opCtx, r.Client, chaos)
+	}
+	if err == nil {
+		err = r.Apply(opCtx, req, chaos)
+	}
+	op.Finish(err)
+	common.SetSelectedCondition(status, err)
+	if err != nil {
 		r.Log.Error(err, "failed to apply chaos action")
 
 		status.Experiment.Phase = v1alpha1.ExperimentPhaseFailed
+		status.Scheduler.FinishRunning(time.Now(), v1alpha1.ScheduleResultFailed, err.Error())
 
 		updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-			return r.Update(ctx, chaos)
+			return common.UpdateChaos(ctx, r.Client, chaos)
 		})
 		if updateError != nil {
 			r.Log.Error(updateError, "unable to update chaos finalizers")
@@ -209,8 +328,18 @@ func applyAction(
 		return err
 	}
 
+	if err := common.AnnotatePods(ctx, r.Client, chaos); err != nil {
+		r.Log.Error(err, "failed to annotate pods")
+	}
+
 	status.Experiment.StartTime = &metav1.Time{Time: time.Now()}
 	status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
 	status.Experiment.Duration = duration.String()
 	return nil
 }
+
+func cleanPodAnnotations(ctx context.Context, r *Reconciler, chaos v1alpha1.InnerSchedulerObject) {
+	if err := common.CleanPodAnnotations(ctx, r.Client, chaos); err != nil {
+		r.Log.Error(err, "failed to clean pod annotations")
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/annotationtrigger"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+)
+
+// WorkloadReconciler attaches the experiments to a kind of workloads by the annotation chaos-mesh.org/experiment
+type WorkloadReconciler struct {
+	client.Client
+	record.EventRecorder
+	Log logr.Logger
+	// Object is an empty object of the workloads, such as &appsv1.Deployment{}
+	Object annotationtrigger.Workload
+}
+
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets;daemonsets;replicasets,verbs=get;list;watch
+
+// Reconcile reconciles a workload
+func (r *WorkloadReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	workload := r.Object.DeepCopyObject().(annotationtrigger.Workload)
+	if err := r.Get(context.Background(), req.NamespacedName, workload); err != nil {
+		if !k8serror.IsNotFound(err) {
+			r.Log.Error(err, "unable to get workload")
+		}
+		// the experiments are garbage collected along with the deleted workload
+		return ctrl.Result{}, nil
+	}
+
+	reconciler := annotationtrigger.Reconciler{
+		Client:        r.Client,
+		EventRecorder: r.EventRecorder,
+		Log:           r.Log.WithValues("namespace", req.Namespace, "name", req.Name),
+	}
+	return reconciler.Reconcile(req, workload)
+}
+
+// SetupWithManager setups a workload reconciler on controller-manager
+func (r *WorkloadReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(r.Object).
+		WithEventFilter(common.ShardPredicate()).
+		WithEventFilter(predicate.Funcs{
+			CreateFunc: func(e event.CreateEvent) bool {
+				return isRelevant(e.Meta, e.Object)
+			},
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return isRelevant(e.MetaOld, e.ObjectOld) || isRelevant(e.MetaNew, e.ObjectNew)
+			},
+			DeleteFunc: func(e event.DeleteEvent) bool {
+				return isRelevant(e.Meta, e.Object)
+			},
+		})
+
+	for _, kind := range v1alpha1.AllKinds() {
+		builder = builder.Owns(kind.Chaos.DeepCopyObject())
+	}
+	// the rollouts of deployments are observed by their ReplicaSets
+	if _, ok := r.Object.(*appsv1.Deployment); ok {
+		builder = builder.Owns(&appsv1.ReplicaSet{})
+	}
+	return builder.Complete(r)
+}
+
+// isRelevant returns whether the event is reconciled. Only the workloads which have experiments attached
+// or had them, the attached experiments which carry the annotation as well, and the ReplicaSets are reconciled.
+func isRelevant(meta metav1.Object, obj runtime.Object) bool {
+	if _, ok := obj.(*appsv1.ReplicaSet); ok {
+		return true
+	}
+	_, ok := meta.GetAnnotations()[v1alpha1.AnnotationExperiment]
+	return ok
+}
@@ -37,6 +37,8 @@ files=($(find . -type f -not \( \
         -o -path '*/Dockerfile' \
         -o -path './images/*' \
         -o -path './pkg/uiserver/embedded_assets_handler.go' \
+        -o -path './pkg/chaosctl/install/zz_generated.manifests.go' \
+        -o -path './pkg/openapi/zz_generated.crds.go' \
         -o -path '*/pb/*' \
         -o -path '*/*.deepcopy.go' \
     \) | grep -v -F "$ignored_files"
@@ -52,6 +52,20 @@ Define the secret's name of certs
 {{- printf "chaos-mesh-webhook-certs" -}}
 {{- end -}}
 
+{{/*
+Define the secret name of the serving certs of chaos-daemon
+*/}}
+{{- define "chaos-daemon.certs" -}}
+{{- printf "chaos-mesh-daemon-certs" -}}
+{{- end -}}
+
+{{/*
+Define the secret name of the client certs used to call chaos-daemon
+*/}}
+{{- define "chaos-daemon.client-certs" -}}
+{{- printf "chaos-mesh-daemon-client-certs" -}}
+{{- end -}}
+
 {{/*
 Define the MutatingWebhookConfiguration's name
 */}}
@@ -72,3 +86,14 @@ Define the webhook's name
 {{- define "chaos-mesh.webhook" -}}
 {{- printf "admission-webhook.chaos-mesh.org" -}}
 {{- end -}}
+
+{{/*
+Define the per-kind concurrent reconciles of controller-manager, e.g. `NetworkChaos:8,PodChaos:4`
+*/}}
+{{- define "chaos-mesh.concurrentReconciles" -}}
+{{- $items := list -}}
+{{- range $kind, $n := .Values.controllerManager.concurrentReconciles -}}
+{{- $items = append $items (printf "%s:%v" $kind $n) -}}
+{{- end -}}
+{{- join "," $items -}}
+{{- end -}}
@@ -44,7 +44,7 @@ OPTIONS:
                              If this value is not set and the Kubernetes is not installed, this script will exit with 1.
     -n, --name               Name of Kubernetes cluster, default value: kind
     -c  --crd                The URL of the crd files, default value: https://raw.githubusercontent.com/chaos-mesh/chaos-mesh/master/manifests/crd.yaml
-    -r  --runtime            Runtime specifies which container runtime to use. Currently we only supports docker and containerd. default value: docker
+    -r  --runtime            Runtime specifies which container runtime to use. Currently we supports docker, containerd, crio and auto. default value: auto
     -f  --chaosfs-sidecar    The URL of the chaosfs sidecar configmap files, default value: https://raw.githubusercontent.com/chaos-mesh/chaos-mesh/master/manifests/chaosfs-sidecar.yaml
         --kind-version       Version of the Kind tool, default value: v0.7.0
         --node-num           The count of the cluster nodes,default value: 3
@@ -74,7 +74,7 @@ main() {
     local local_registry=false
     local crd="https://raw.githubusercontent.com/chaos-mesh/chaos-mesh/master/manifests/crd.yaml"
     local chaosfs="https://raw.githubusercontent.com/chaos-mesh/chaos-mesh/master/manifests/chaosfs-sidecar.yaml"
-    local runtime="docker"
+    local runtime="auto"
     local template=false
     local sidecar_template=true
     local install_dependency_only=false
@@ -204,8 +204,8 @@ main() {
         esac
     done
 
-    if [ "${runtime}" != "docker" ] && [ "${runtime}" != "containerd" ]; then
-        printf "container runtime %s is not supported\n" "${local_kube}"
+    if [ "${runtime}" != "docker" ] && [ "${runtime}" != "containerd" ] && [ "${runtime}" != "crio" ] && [ "${runtime}" != "auto" ]; then
+        printf "container runtime %s is not supported\n" "${runtime}"
         exit 1
     fi
 
@@ -826,6 +826,12 @@ gen_chaos_mesh_manifests() {
     if [ "${runtime}" == "containerd" ]; then
         socketPath="/run/containerd/containerd.sock"
         mountPath="/run/containerd/containerd.sock"
+    elif [ "${runtime}" == "crio" ]; then
+        socketPath="/var/run/crio/crio.sock"
+        mountPath="/var/run/crio/crio.sock"
+    elif [ "${runtime}" == "auto" ]; then
+        socketPath="/run"
+        mountPath="/run"
     fi
 
     need_cmd mktemp
@@ -965,6 +971,7 @@ rules:
     - timechaos
     - kernelchaos
     - stresschaos
+    - physicalmachinechaos
   verbs: ["*"]
 ---
 # Source: chaos-mesh/templates/controller-manager-rbac.yaml
@@ -1057,6 +1064,8 @@ spec:
     spec:
       hostIPC: true
       hostPID: true
+      nodeSelector:
+        kubernetes.io/os: linux
       containers:
         - name: chaos-daemon
           image: pingcap/chaos-daemon:latest
@@ -1079,6 +1088,12 @@ spec:
               mountPath: ${mountPath}
             - name: sys-path
               mountPath: /sys
+            - name: modules-path
+              mountPath: /lib/modules
+              readOnly: true
+            - name: boot-path
+              mountPath: /boot
+              readOnly: true
           ports:
             - name: grpc
               containerPort: 31767
@@ -1092,6 +1107,12 @@ spec:
         - name: sys-path
           hostPath:
             path: /sys
+        - name: modules-path
+          hostPath:
+            path: /lib/modules
+        - name: boot-path
+          hostPath:
+            path: /boot
 ---
 # Source: chaos-mesh/templates/chaos-dashboard-deployment.yaml
 apiVersion: apps/v1
@@ -1348,6 +1369,24 @@ webhooks:
           - UPDATE
         resources:
           - stresschaos
+  - clientConfig:
+      caBundle: "${CA_BUNDLE}"
+      service:
+        name: chaos-mesh-controller-manager
+        namespace: chaos-testing
+        path: /mutate-chaos-mesh-org-v1alpha1-physicalmachinechaos
+    failurePolicy: Fail
+    name: mphysicalmachinechaos.kb.io
+    rules:
+      - apiGroups:
+          - chaos-mesh.org
+        apiVersions:
+          - v1alpha1
+        operations:
+          - CREATE
+          - UPDATE
+        resources:
+          - physicalmachinechaos
 ---
 # Source: chaos-mesh/templates/webhook-configuration.yaml
 apiVersion: admissionregistration.k8s.io/v1beta1
@@ -1467,6 +1506,24 @@ webhooks:
           - UPDATE
         resources:
           - stresschaos
+  - clientConfig:
+      caBundle: "${CA_BUNDLE}"
+      service:
+        name: chaos-mesh-controller-manager
+        namespace: chaos-testing
+        path: /validate-chaos-mesh-org-v1alpha1-physicalmachinechaos
+    failurePolicy: Fail
+    name: vphysicalmachinechaos.kb.io
+    rules:
+      - apiGroups:
+          - chaos-mesh.org
+        apiVersions:
+          - v1alpha1
+        operations:
+          - CREATE
+          - UPDATE
+        resources:
+          - physicalmachinechaos
 EOF
     # chaos-mesh.yaml end
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/jinzhu/gorm"
 
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/auth"
 	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
 	"github.com/chaos-mesh/chaos-mesh/pkg/config"
 	"github.com/chaos-mesh/chaos-mesh/pkg/core"
@@ -66,7 +67,7 @@ func Register(r *gin.RouterGroup, s *Service) {
 // @Produce json
 // @Param namespace query string false "namespace"
 // @Param name query string false "name"
-// @Param kind query string false "kind" Enums(PodChaos, IoChaos, NetworkChaos, TimeChaos, KernelChaos, StressChaos)
+// @Param kind query string false "kind" Enums(PodChaos, IoChaos, NetworkChaos, TimeChaos, KernelChaos, StressChaos, PhysicalMachineChaos, DBChaos)
 // @Success 200 {array} core.ArchiveExperimentMeta
 // @Router /api/archives [get]
 // @Failure 500 {object} utils.APIError
@@ -75,13 +76,26 @@ func (s *Service) listExperiments(c *gin.Context) {
 	name := c.Query("name")
 	ns := c.Query("namespace")
 
-	data, err := s.archive.ListMeta(context.TODO(), kind, ns, name)
+	metas, err := s.archive.ListMeta(context.TODO(), kind, ns, name)
 	if err != nil {
 		c.Status(http.StatusInternalServerError)
 		_ = c.Error(utils.ErrInternalServer.NewWithNoMessage())
 		return
 	}
 
+	data := make([]*core.ArchiveExperimentMeta, 0, len(metas))
+	for _, meta := range metas {
+		allowed, err := auth.IsAllowed(c, "list", meta.Kind, meta.Namespace)
+		if err != nil {
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+			return
+		}
+		if allowed {
+			data = append(data, meta)
+		}
+	}
+
 	c.JSON(http.StatusOK, data)
 }
 
@@ -91,7 +105,7 @@ func (s *Service) listExperiments(c *gin.Context) {
 // @Produce json
 // @Param namespace query string false "namespace"
 // @Param name query string false "name"
-// @Param kind query string false "kind" Enums(PodChaos, IoChaos, NetworkChaos, TimeChaos, KernelChaos, StressChaos)
+// @Param kind query string false "kind" Enums(PodChaos, IoChaos, NetworkChaos, TimeChaos, KernelChaos, StressChaos, PhysicalMachineChaos, DBChaos)
 // @Param uid query string false "uid"
 // @Success 200 {array} core.ArchiveExperiment
 // @Router /api/archives/detail/search [get]
@@ -102,7 +116,7 @@ func (s *Service) experimentDetailSearch(c *gin.Context) {
 	ns := c.Query("namespace")
 	uid := c.Query("uid")
 
-	data, err := s.archive.DetailList(context.TODO(), kind, ns, name, uid)
+	archives, err := s.archive.DetailList(context.TODO(), kind, ns, name, uid)
 	if err != nil {
 		if !gorm.IsRecordNotFoundError(err) {
 			c.Status(http.StatusInternalServerError)
@@ -114,6 +128,19 @@ func (s *Service) experimentDetailSearch(c *gin.Context) {
 		return
 	}
 
+	data := make([]*core.ArchiveExperiment, 0, len(archives))
+	for _, archive := range archives {
+		allowed, err := auth.IsAllowed(c, "get", archive.Kind, archive.Namespace)
+		if err != nil {
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+			return
+		}
+		if allowed {
+			data = append(data, archive)
+		}
+	}
+
 	c.JSON(http.StatusOK, data)
 }
 
@@ -147,5 +174,9 @@ func (s *Service) experimentDetail(c *gin.Context) {
 		return
 	}
 
+	if !auth.Authorize(c, "get", data.Kind, data.Namespace) {
+		return
+	}
+
 	c.JSON(http.StatusOK, data)
 }
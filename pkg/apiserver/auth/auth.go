@@ -0,0 +1,345 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/coreos/go-oidc"
+	"github.com/gin-gonic/gin"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/config"
+)
+
+var (
+	log    = ctrl.Log.WithName("auth")
+	scheme = runtime.NewScheme()
+)
+
+func init() {
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+}
+
+const (
+	userKey       = "chaos-mesh.org/user"
+	authorizerKey = "chaos-mesh.org/authorizer"
+	decisionsKey  = "chaos-mesh.org/decisions"
+)
+
+// Authenticator authenticates the requests of API by bearer token, and authorizes
+// the users by the RBAC of Kubernetes.
+type Authenticator struct {
+	conf     config.AuthConfig
+	kubeCli  client.Client
+	verifier *oidc.IDTokenVerifier
+
+	// newClient returns the client which acts as the user
+	newClient func(user *authenticationv1.UserInfo) (client.Client, error)
+}
+
+// NewAuthenticator returns an Authenticator, it returns nil if authentication is disabled.
+func NewAuthenticator(conf *config.ChaosDashboardConfig, cli client.Client) (*Authenticator, error) {
+	if !conf.Auth.Enabled {
+		return nil, nil
+	}
+
+	a := &Authenticator{
+		conf:    conf.Auth,
+		kubeCli: cli,
+	}
+
+	if conf.Auth.OIDCIssuerURL != "" {
+		provider, err := oidc.NewProvider(context.Background(), conf.Auth.OIDCIssuerURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to discover OIDC provider %s: %v", conf.Auth.OIDCIssuerURL, err)
+		}
+		a.verifier = provider.Verifier(&oidc.Config{ClientID: conf.Auth.OIDCClientID})
+	}
+
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	mapper, err := apiutil.NewDynamicRESTMapper(cfg)
+	if err != nil {
+		return nil, err
+	}
+	a.newClient = func(user *authenticationv1.UserInfo) (client.Client, error) {
+		impersonated := rest.CopyConfig(cfg)
+		// the extra of user isn't impersonated, which may carry any keys that need their own permission
+		impersonated.Impersonate = rest.ImpersonationConfig{
+			UserName: user.Username,
+			Groups:   user.Groups,
+		}
+		return client.New(impersonated, client.Options{Scheme: scheme, Mapper: mapper})
+	}
+
+	return a, nil
+}
+
+// Register mounts the authentication middleware on the mux, it must be
+// registered before the handlers.
+func Register(r *gin.RouterGroup, a *Authenticator) {
+	if a == nil {
+		return
+	}
+
+	r.Use(a.middleware)
+}
+
+func (a *Authenticator) middleware(c *gin.Context) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if token == "" || token == c.GetHeader("Authorization") {
+		c.Status(http.StatusUnauthorized)
+		_ = c.Error(utils.ErrUnauthorized.New("bearer token is required"))
+		c.Abort()
+		return
+	}
+
+	user, err := a.Authenticate(c.Request.Context(), token)
+	if err != nil {
+		log.Info("failed to authenticate", "error", err.Error())
+		c.Status(http.StatusUnauthorized)
+		_ = c.Error(utils.ErrUnauthorized.WrapWithNoMessage(err))
+		c.Abort()
+		return
+	}
+
+	c.Set(userKey, user)
+	c.Set(authorizerKey, a)
+	c.Set(decisionsKey, &decisions{allowed: make(map[string]bool)})
+	c.Next()
+}
+
+// decisions caches the decisions of authorization in a request, it's safe for the handlers
+// which authorize in several goroutines.
+type decisions struct {
+	sync.Mutex
+	allowed map[string]bool
+}
+
+func (d *decisions) get(key string) (bool, bool) {
+	d.Lock()
+	defer d.Unlock()
+	allowed, ok := d.allowed[key]
+	return allowed, ok
+}
+
+func (d *decisions) set(key string, allowed bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.allowed[key] = allowed
+}
+
+// Authenticate returns the user of the token. The token is verified as an OIDC ID token
+// if OIDC is configured, otherwise it is reviewed by Kubernetes.
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (*authenticationv1.UserInfo, error) {
+	if a.verifier != nil {
+		if user, err := a.authenticateOIDC(ctx, token); err == nil {
+			return user, nil
+		}
+	}
+
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token: token,
+		},
+	}
+	if err := a.kubeCli.Create(ctx, review); err != nil {
+		return nil, err
+	}
+	if !review.Status.Authenticated {
+		return nil, fmt.Errorf("token is not authenticated: %s", review.Status.Error)
+	}
+
+	return &review.Status.User, nil
+}
+
+// authenticateOIDC maps the claims of ID token to the user in the same way as kube-apiserver
+func (a *Authenticator) authenticateOIDC(ctx context.Context, token string) (*authenticationv1.UserInfo, error) {
+	idToken, err := a.verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+
+	return a.userFromClaims(claims)
+}
+
+// userFromClaims maps the claims of ID token to the user
+func (a *Authenticator) userFromClaims(claims map[string]interface{}) (*authenticationv1.UserInfo, error) {
+	username, ok := claims[a.conf.OIDCUsernameClaim].(string)
+	if !ok || username == "" {
+		return nil, fmt.Errorf("claim %s is not found in ID token", a.conf.OIDCUsernameClaim)
+	}
+
+	prefix := a.conf.OIDCUsernamePrefix
+	if prefix == "" && a.conf.OIDCUsernameClaim != "email" {
+		prefix = a.conf.OIDCIssuerURL + "#"
+	}
+	if prefix != "-" {
+		username = prefix + username
+	}
+
+	user := &authenticationv1.UserInfo{Username: username}
+	if a.conf.OIDCGroupsClaim != "" {
+		switch groups := claims[a.conf.OIDCGroupsClaim].(type) {
+		case string:
+			user.Groups = append(user.Groups, a.conf.OIDCGroupsPrefix+groups)
+		case []interface{}:
+			for _, group := range groups {
+				if g, ok := group.(string); ok {
+					user.Groups = append(user.Groups, a.conf.OIDCGroupsPrefix+g)
+				}
+			}
+		}
+	}
+
+	return user, nil
+}
+
+// UserFromContext returns the authenticated user of request, it returns nil if authentication is disabled.
+func UserFromContext(c *gin.Context) *authenticationv1.UserInfo {
+	user, ok := c.Get(userKey)
+	if !ok {
+		return nil
+	}
+	return user.(*authenticationv1.UserInfo)
+}
+
+// IsAllowed checks whether the user of request can do verb on the chaos of kind in namespace.
+// It always returns true if authentication is disabled. The decisions are cached in the request,
+// and it can be called concurrently for the same request.
+func IsAllowed(c *gin.Context, verb, kind, namespace string) (bool, error) {
+	value, ok := c.Get(authorizerKey)
+	if !ok {
+		return true, nil
+	}
+	a := value.(*Authenticator)
+	user := UserFromContext(c)
+
+	resource := strings.ToLower(kind)
+	key := fmt.Sprintf("%s/%s/%s", verb, resource, namespace)
+
+	cache := c.MustGet(decisionsKey).(*decisions)
+	if allowed, ok := cache.get(key); ok {
+		return allowed, nil
+	}
+
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      verb,
+				Group:     v1alpha1.GroupVersion.Group,
+				Resource:  resource,
+			},
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+		},
+	}
+	if err := a.kubeCli.Create(c.Request.Context(), sar); err != nil {
+		return false, err
+	}
+
+	cache.set(key, sar.Status.Allowed)
+	return sar.Status.Allowed, nil
+}
+
+// ClientFor returns the client which acts as the user of request by impersonation, so the writes are
+// authorized by Kubernetes and the user is recorded as the creator of chaos. It returns cli if
+// authentication is disabled.
+func ClientFor(c *gin.Context, cli client.Client) (client.Client, error) {
+	value, ok := c.Get(authorizerKey)
+	if !ok {
+		return cli, nil
+	}
+	return value.(*Authenticator).newClient(UserFromContext(c))
+}
+
+// AuthorizeTargets checks whether the user of request can create the chaos of kind in all the namespaces
+// which the selectors target, like the security mode of controller. The error is attached to c if not.
+func AuthorizeTargets(c *gin.Context, kind string, selectors ...v1alpha1.SelectorSpec) bool {
+	for _, namespace := range common.SelectorNamespaces(selectors...) {
+		if !Authorize(c, "create", kind, namespace) {
+			return false
+		}
+	}
+	return true
+}
+
+// AuthorizeChaosTargets is like AuthorizeTargets, but the selectors are the ones of chaos defaulted by the webhook,
+// e.g. the selector without namespaces selects the namespace of chaos.
+func AuthorizeChaosTargets(c *gin.Context, kind string, chaos runtime.Object) bool {
+	defaulted := chaos.DeepCopyObject()
+	if defaulter, ok := defaulted.(interface{ Default() }); ok {
+		defaulter.Default()
+	}
+	selectorObject, ok := defaulted.(v1alpha1.SelectorObject)
+	if !ok {
+		return true
+	}
+	return AuthorizeTargets(c, kind, selectorObject.GetSelectorSpecs()...)
+}
+
+// Authorize is like IsAllowed, but the error is attached to c if the user is not allowed.
+// The empty namespace stands for all namespaces.
+func Authorize(c *gin.Context, verb, kind, namespace string) bool {
+	allowed, err := IsAllowed(c, verb, kind, namespace)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return false
+	}
+
+	if !allowed {
+		scope := "namespace " + namespace
+		if namespace == "" {
+			scope = "all namespaces"
+		}
+		c.Status(http.StatusForbidden)
+		_ = c.Error(utils.ErrForbidden.New("%s is not allowed to %s %s in %s",
+			UserFromContext(c).Username, verb, kind, scope))
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,335 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/config"
+)
+
+// reviewClient reviews the tokens and the access of users like kube-apiserver. Only the token
+// "valid" is authenticated as alice, and alice is only allowed to list and create PodChaos in namespace "allowed".
+type reviewClient struct {
+	client.Client
+
+	mu   sync.Mutex
+	sars []authorizationv1.SubjectAccessReviewSpec
+}
+
+func (c *reviewClient) Create(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+	switch review := obj.(type) {
+	case *authenticationv1.TokenReview:
+		if review.Spec.Token == "valid" {
+			review.Status.Authenticated = true
+			review.Status.User = authenticationv1.UserInfo{Username: "alice", Groups: []string{"dev"}}
+		}
+	case *authorizationv1.SubjectAccessReview:
+		c.mu.Lock()
+		c.sars = append(c.sars, review.Spec)
+		c.mu.Unlock()
+		attrs := review.Spec.ResourceAttributes
+		review.Status.Allowed = review.Spec.User == "alice" && (attrs.Verb == "list" || attrs.Verb == "create") &&
+			attrs.Resource == "podchaos" && attrs.Namespace == "allowed"
+	}
+	return nil
+}
+
+func newTestRouter(a *Authenticator, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	Register(&r.RouterGroup, a)
+	r.GET("/", handler)
+	return r
+}
+
+func serve(r *gin.Engine, authorization string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestMiddleware(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	a := &Authenticator{kubeCli: &reviewClient{}}
+	var user *authenticationv1.UserInfo
+	r := newTestRouter(a, func(c *gin.Context) {
+		user = UserFromContext(c)
+		c.Status(http.StatusOK)
+	})
+
+	for _, authorization := range []string{"", "valid", "Bearer ", "Bearer invalid"} {
+		user = nil
+		w := serve(r, authorization)
+		g.Expect(w.Code).To(Equal(http.StatusUnauthorized), authorization)
+		g.Expect(user).To(BeNil(), authorization)
+	}
+
+	w := serve(r, "Bearer valid")
+	g.Expect(w.Code).To(Equal(http.StatusOK))
+	g.Expect(user).To(Equal(&authenticationv1.UserInfo{Username: "alice", Groups: []string{"dev"}}))
+}
+
+func TestUserFromClaims(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type TestCase struct {
+		name   string
+		conf   config.AuthConfig
+		claims map[string]interface{}
+		user   *authenticationv1.UserInfo
+		err    bool
+	}
+
+	issuer := "https://issuer.example.com"
+	tcs := []TestCase{
+		{
+			name:   "issuer is the default prefix of username",
+			conf:   config.AuthConfig{OIDCIssuerURL: issuer, OIDCUsernameClaim: "sub"},
+			claims: map[string]interface{}{"sub": "alice"},
+			user:   &authenticationv1.UserInfo{Username: issuer + "#alice"},
+		},
+		{
+			name:   "email isn't prefixed by default",
+			conf:   config.AuthConfig{OIDCIssuerURL: issuer, OIDCUsernameClaim: "email"},
+			claims: map[string]interface{}{"email": "alice@example.com"},
+			user:   &authenticationv1.UserInfo{Username: "alice@example.com"},
+		},
+		{
+			name:   "username without prefix",
+			conf:   config.AuthConfig{OIDCIssuerURL: issuer, OIDCUsernameClaim: "sub", OIDCUsernamePrefix: "-"},
+			claims: map[string]interface{}{"sub": "alice"},
+			user:   &authenticationv1.UserInfo{Username: "alice"},
+		},
+		{
+			name: "prefixed username and groups",
+			conf: config.AuthConfig{OIDCIssuerURL: issuer, OIDCUsernameClaim: "sub", OIDCUsernamePrefix: "oidc:",
+				OIDCGroupsClaim: "groups", OIDCGroupsPrefix: "oidc:"},
+			claims: map[string]interface{}{"sub": "alice", "groups": []interface{}{"dev", 1, "ops"}},
+			user:   &authenticationv1.UserInfo{Username: "oidc:alice", Groups: []string{"oidc:dev", "oidc:ops"}},
+		},
+		{
+			name: "single group",
+			conf: config.AuthConfig{OIDCIssuerURL: issuer, OIDCUsernameClaim: "sub", OIDCUsernamePrefix: "-",
+				OIDCGroupsClaim: "groups"},
+			claims: map[string]interface{}{"sub": "alice", "groups": "dev"},
+			user:   &authenticationv1.UserInfo{Username: "alice", Groups: []string{"dev"}},
+		},
+		{
+			name:   "username claim is missing",
+			conf:   config.AuthConfig{OIDCIssuerURL: issuer, OIDCUsernameClaim: "email"},
+			claims: map[string]interface{}{"sub": "alice"},
+			err:    true,
+		},
+	}
+
+	for _, tc := range tcs {
+		a := &Authenticator{conf: tc.conf}
+		user, err := a.userFromClaims(tc.claims)
+		if tc.err {
+			g.Expect(err).To(HaveOccurred(), tc.name)
+			continue
+		}
+		g.Expect(err).NotTo(HaveOccurred(), tc.name)
+		g.Expect(user).To(Equal(tc.user), tc.name)
+	}
+}
+
+func TestIsAllowed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cli := &reviewClient{}
+	a := &Authenticator{kubeCli: cli}
+	decisions := make(map[string]bool)
+	r := newTestRouter(a, func(c *gin.Context) {
+		for _, namespace := range []string{"allowed", "denied", "allowed"} {
+			allowed, err := IsAllowed(c, "list", "PodChaos", namespace)
+			g.Expect(err).NotTo(HaveOccurred())
+			decisions[namespace] = allowed
+		}
+		if Authorize(c, "delete", "PodChaos", "allowed") {
+			c.Status(http.StatusOK)
+		}
+	})
+
+	w := serve(r, "Bearer valid")
+	g.Expect(w.Code).To(Equal(http.StatusForbidden))
+	g.Expect(decisions).To(Equal(map[string]bool{"allowed": true, "denied": false}))
+
+	// the decisions are cached in the request
+	g.Expect(cli.sars).To(HaveLen(3))
+	g.Expect(cli.sars[0].User).To(Equal("alice"))
+	g.Expect(cli.sars[0].Groups).To(Equal([]string{"dev"}))
+	g.Expect(*cli.sars[0].ResourceAttributes).To(Equal(authorizationv1.ResourceAttributes{
+		Namespace: "allowed",
+		Verb:      "list",
+		Group:     "chaos-mesh.org",
+		Resource:  "podchaos",
+	}))
+}
+
+func TestIsAllowedConcurrently(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	a := &Authenticator{kubeCli: &reviewClient{}}
+	r := newTestRouter(a, func(c *gin.Context) {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				allowed, err := IsAllowed(c, "list", "PodChaos", "allowed")
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(allowed).To(BeTrue())
+			}()
+		}
+		wg.Wait()
+		c.Status(http.StatusOK)
+	})
+
+	g.Expect(serve(r, "Bearer valid").Code).To(Equal(http.StatusOK))
+}
+
+func TestIsAllowedWithoutAuthentication(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	r := newTestRouter(nil, func(c *gin.Context) {
+		allowed, err := IsAllowed(c, "delete", "PodChaos", "denied")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(allowed).To(BeTrue())
+		c.Status(http.StatusOK)
+	})
+
+	g.Expect(serve(r, "").Code).To(Equal(http.StatusOK))
+}
+
+func TestAuthorizeTargets(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type TestCase struct {
+		name      string
+		selectors []v1alpha1.SelectorSpec
+		code      int
+	}
+
+	tcs := []TestCase{
+		{
+			name:      "namespaces allowed",
+			selectors: []v1alpha1.SelectorSpec{{Namespaces: []string{"allowed"}}},
+			code:      http.StatusOK,
+		},
+		{
+			name: "pods in the namespace denied",
+			selectors: []v1alpha1.SelectorSpec{
+				{Namespaces: []string{"allowed"}},
+				{Pods: map[string][]string{"denied": {"p1"}}},
+			},
+			code: http.StatusForbidden,
+		},
+		{
+			name:      "all namespaces",
+			selectors: []v1alpha1.SelectorSpec{{Namespaces: []string{"allowed"}}, {}},
+			code:      http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tcs {
+		a := &Authenticator{kubeCli: &reviewClient{}}
+		r := newTestRouter(a, func(c *gin.Context) {
+			if AuthorizeTargets(c, "PodChaos", tc.selectors...) {
+				c.Status(http.StatusOK)
+			}
+		})
+		g.Expect(serve(r, "Bearer valid").Code).To(Equal(tc.code), tc.name)
+	}
+}
+
+func TestAuthorizeChaosTargets(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// the selector without namespaces selects the namespace of chaos
+	pod := &v1alpha1.PodChaos{}
+	pod.Namespace = "allowed"
+	// the target of NetworkChaos is authorized as well
+	network := &v1alpha1.NetworkChaos{}
+	network.Namespace = "allowed"
+	network.Spec.Target = &v1alpha1.Target{TargetSelector: v1alpha1.SelectorSpec{Namespaces: []string{"denied"}}}
+
+	codes := make(map[string]int)
+	for kind, chaos := range map[string]runtime.Object{"PodChaos": pod, "NetworkChaos": network} {
+		a := &Authenticator{kubeCli: &reviewClient{}}
+		r := newTestRouter(a, func(c *gin.Context) {
+			// both are authorized as PodChaos, which is the only kind alice can create
+			if AuthorizeChaosTargets(c, "PodChaos", chaos) {
+				c.Status(http.StatusOK)
+			}
+		})
+		codes[kind] = serve(r, "Bearer valid").Code
+	}
+	g.Expect(codes).To(Equal(map[string]int{"PodChaos": http.StatusOK, "NetworkChaos": http.StatusForbidden}))
+	g.Expect(pod.Spec.Selector.Namespaces).To(BeEmpty())
+}
+
+func TestClientFor(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	kubeCli := &reviewClient{}
+	userCli := &reviewClient{}
+	var impersonated *authenticationv1.UserInfo
+	a := &Authenticator{
+		kubeCli: kubeCli,
+		newClient: func(user *authenticationv1.UserInfo) (client.Client, error) {
+			impersonated = user
+			return userCli, nil
+		},
+	}
+
+	var cli client.Client
+	r := newTestRouter(a, func(c *gin.Context) {
+		var err error
+		cli, err = ClientFor(c, kubeCli)
+		g.Expect(err).NotTo(HaveOccurred())
+		c.Status(http.StatusOK)
+	})
+	g.Expect(serve(r, "Bearer valid").Code).To(Equal(http.StatusOK))
+	g.Expect(cli).To(BeIdenticalTo(userCli))
+	g.Expect(impersonated).To(Equal(&authenticationv1.UserInfo{Username: "alice", Groups: []string{"dev"}}))
+
+	// the client of apiserver is used without authentication
+	r = newTestRouter(nil, func(c *gin.Context) {
+		var err error
+		cli, err = ClientFor(c, kubeCli)
+		g.Expect(err).NotTo(HaveOccurred())
+		c.Status(http.StatusOK)
+	})
+	g.Expect(serve(r, "").Code).To(Equal(http.StatusOK))
+	g.Expect(cli).To(BeIdenticalTo(kubeCli))
+}
@@ -17,9 +17,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/auth"
 	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
 	"github.com/chaos-mesh/chaos-mesh/pkg/config"
 	"github.com/chaos-mesh/chaos-mesh/pkg/core"
@@ -57,6 +60,8 @@ func Register(r *gin.RouterGroup, s *Service) {
 	// TODO: add more api handlers
 	endpoint.GET("", s.listEvents)
 	endpoint.GET("/dry", s.listDryEvents)
+	endpoint.GET("/pod", s.listEventsByPodAndTime)
+	endpoint.GET("/phases", s.listPhaseRecords)
 }
 
 // @Summary Get the list of events from db.
@@ -70,7 +75,7 @@ func Register(r *gin.RouterGroup, s *Service) {
 // @Param experimentName query string false "The name of the experiment"
 // @Param experimentNamespace query string false "The namespace of the experiment"
 // @Param uid query string false "The UID of the experiment"
-// @Param kind query string false "kind" Enums(PodChaos, IoChaos, NetworkChaos, TimeChaos, KernelChaos, StressChaos)
+// @Param kind query string false "kind" Enums(PodChaos, IoChaos, NetworkChaos, TimeChaos, KernelChaos, StressChaos, PhysicalMachineChaos, DBChaos)
 // @Success 200 {array} core.Event
 // @Router /api/events [get]
 // @Failure 500 {object} utils.APIError
@@ -93,6 +98,9 @@ func (s *Service) listEvents(c *gin.Context) {
 	}
 
 	eventList, err := s.event.ListByFilter(context.Background(), filter)
+	if err == nil {
+		eventList, err = filterAllowedEvents(c, eventList)
+	}
 	if err != nil {
 		c.Status(http.StatusInternalServerError)
 		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
@@ -110,7 +118,7 @@ func (s *Service) listEvents(c *gin.Context) {
 // @Param endTime query string false "The end time of events"
 // @Param experimentName query string false "The name of the experiment"
 // @Param experimentNamespace query string false "The namespace of the experiment"
-// @Param kind query string false "kind" Enums(PodChaos, IoChaos, NetworkChaos, TimeChaos, KernelChaos, StressChaos)
+// @Param kind query string false "kind" Enums(PodChaos, IoChaos, NetworkChaos, TimeChaos, KernelChaos, StressChaos, PhysicalMachineChaos, DBChaos)
 // @Success 200 {array} core.Event
 // @Router /api/events/dry [get]
 // @Failure 500 {object} utils.APIError
@@ -124,6 +132,55 @@ func (s *Service) listDryEvents(c *gin.Context) {
 	}
 
 	eventList, err := s.event.DryListByFilter(context.Background(), filter)
+	if err == nil {
+		eventList, err = filterAllowedEvents(c, eventList)
+	}
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, eventList)
+}
+
+// @Summary Get the events which injected chaos into the pod between startTime and finishTime.
+// @Description Get the events which injected chaos into the pod and overlapped with the time range between startTime and finishTime.
+// @Tags events
+// @Produce json
+// @Param podName query string true "The pod's name"
+// @Param podNamespace query string true "The pod's namespace"
+// @Param startTime query string false "The start of the time range, in RFC3339 format"
+// @Param finishTime query string false "The end of the time range, in RFC3339 format"
+// @Success 200 {array} core.Event
+// @Router /api/events/pod [get]
+// @Failure 400 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+func (s *Service) listEventsByPodAndTime(c *gin.Context) {
+	podName, podNamespace := c.Query("podName"), c.Query("podNamespace")
+	if podName == "" || podNamespace == "" {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.New("podName and podNamespace cannot be empty"))
+		return
+	}
+
+	startTime, err := parseTime(c.Query("startTime"))
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.New("the format of the startTime is wrong"))
+		return
+	}
+	finishTime, err := parseTime(c.Query("finishTime"))
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.New("the format of the finishTime is wrong"))
+		return
+	}
+
+	eventList, err := s.event.ListByPodAndTime(context.Background(), podNamespace, podName, startTime, finishTime)
+	if err == nil {
+		eventList, err = filterAllowedEvents(c, eventList)
+	}
 	if err != nil {
 		c.Status(http.StatusInternalServerError)
 		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
@@ -132,3 +189,63 @@ func (s *Service) listDryEvents(c *gin.Context) {
 
 	c.JSON(http.StatusOK, eventList)
 }
+
+// @Summary Get the lifecycle events of an experiment.
+// @Description Get the phase transitions of an experiment ordered by time.
+// @Tags events
+// @Produce json
+// @Param uid query string true "The UID of the experiment"
+// @Success 200 {array} core.PhaseRecord
+// @Router /api/events/phases [get]
+// @Failure 400 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+func (s *Service) listPhaseRecords(c *gin.Context) {
+	uid := c.Query("uid")
+	if uid == "" {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.New("uid cannot be empty"))
+		return
+	}
+
+	records, err := s.event.ListPhaseRecords(context.Background(), uid)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	if len(records) > 0 && !auth.Authorize(c, "get", records[0].Kind, records[0].Namespace) {
+		return
+	}
+
+	c.JSON(http.StatusOK, records)
+}
+
+// filterAllowedEvents returns the events of the experiments which the user of request can get
+func filterAllowedEvents(c *gin.Context, events []*core.Event) ([]*core.Event, error) {
+	allowedEvents := make([]*core.Event, 0, len(events))
+	for _, event := range events {
+		allowed, err := auth.IsAllowed(c, "get", event.Kind, event.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if allowed {
+			allowedEvents = append(allowedEvents, event)
+		}
+	}
+	return allowedEvents, nil
+}
+
+// parseTime parses the time in RFC3339 format from the query, the `+` of the time zone
+// may be decoded as a space in the query. It returns nil if str is empty.
+func parseTime(str string) (*time.Time, error) {
+	if str == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, strings.Replace(str, " ", "+", -1))
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
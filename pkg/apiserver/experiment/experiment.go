@@ -16,23 +16,32 @@ package experiment
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
-	"sync"
 	"time"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"github.com/joomcode/errorx"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/auth"
 	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
 	"github.com/chaos-mesh/chaos-mesh/pkg/config"
 	"github.com/chaos-mesh/chaos-mesh/pkg/core"
+	"github.com/chaos-mesh/chaos-mesh/pkg/impact"
+	"github.com/chaos-mesh/chaos-mesh/pkg/openapi"
+	pkgutils "github.com/chaos-mesh/chaos-mesh/pkg/utils"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -88,11 +97,14 @@ func Register(r *gin.RouterGroup, s *Service) {
 	endpoint.GET("", s.listExperiments)
 	endpoint.POST("/new", s.createExperiment)
 	endpoint.GET("/detail/:kind/:namespace/:name", s.getExperimentDetail)
+	endpoint.GET("/artifacts/:kind/:namespace/:name", s.getExperimentArtifacts)
 	endpoint.DELETE("/:kind/:namespace/:name", s.deleteExperiment)
 	endpoint.PUT("/update", s.updateExperiment)
+	endpoint.PATCH("/:kind/:namespace/:name", s.patchExperimentSpec)
 	endpoint.PUT("/pause/:kind/:namespace/:name", s.pauseExperiment)
 	endpoint.PUT("/start/:kind/:namespace/:name", s.startExperiment)
 	endpoint.GET("/state", s.state)
+	endpoint.POST("/preview", s.previewExperiment)
 }
 
 // ExperimentInfo defines a form data of Experiment from API.
@@ -113,6 +125,21 @@ type ScopeInfo struct {
 	Value string `json:"value" binding:"ValueValid"`
 }
 
+// GetSelector implements utils.SelectSpec
+func (s *ScopeInfo) GetSelector() v1alpha1.SelectorSpec {
+	return s.ParseSelector()
+}
+
+// GetMode implements utils.SelectSpec
+func (s *ScopeInfo) GetMode() v1alpha1.PodMode {
+	return v1alpha1.PodMode(s.Mode)
+}
+
+// GetValue implements utils.SelectSpec
+func (s *ScopeInfo) GetValue() string {
+	return s.Value
+}
+
 // TODO: consider moving this to a common package
 // SelectorInfo defines the selector options of the Experiment.
 type SelectorInfo struct {
@@ -181,18 +208,32 @@ type SchedulerInfo struct {
 
 // PodChaosInfo defines the basic information of pod chaos for creating a new PodChaos.
 type PodChaosInfo struct {
-	Action        string `json:"action" binding:"oneof='' 'pod-kill' 'pod-failure' 'container-kill'"`
-	ContainerName string `json:"container_name"`
+	Action string `json:"action" binding:"oneof='' 'pod-kill' 'pod-failure' 'container-kill' 'pod-reip' 'container-signal' 'config-corrupt'"`
+	// ContainerName is deprecated, it's merged into ContainerNames when the chaos is created
+	ContainerName    string                         `json:"container_name"`
+	ContainerNames   []string                       `json:"container_names"`
+	Signal           *v1alpha1.SignalSpec           `json:"signal"`
+	ConfigCorruption *v1alpha1.ConfigCorruptionSpec `json:"config_corruption"`
+}
+
+// containerNames returns the names of the containers, including the deprecated ContainerName
+func (info *PodChaosInfo) containerNames() []string {
+	spec := v1alpha1.PodChaosSpec{ContainerName: info.ContainerName, ContainerNames: info.ContainerNames}
+	return spec.GetContainerNames()
 }
 
 // PodChaosInfo defines the basic information of network chaos for creating a new NetworkChaos.
 type NetworkChaosInfo struct {
-	Action      string                  `json:"action" binding:"oneof='' 'netem' 'delay' 'loss' 'duplicate' 'corrupt' 'partition' 'bandwidth'"`
+	Action      string                  `json:"action" binding:"oneof='' 'netem' 'delay' 'loss' 'duplicate' 'corrupt' 'partition' 'bandwidth' 'conntrack' 'mtu' 'nodeport' 'metadata'"`
 	Delay       *v1alpha1.DelaySpec     `json:"delay"`
 	Loss        *v1alpha1.LossSpec      `json:"loss"`
 	Duplicate   *v1alpha1.DuplicateSpec `json:"duplicate"`
 	Corrupt     *v1alpha1.CorruptSpec   `json:"corrupt"`
 	Bandwidth   *v1alpha1.BandwidthSpec `json:"bandwidth"`
+	Conntrack   *v1alpha1.ConntrackSpec `json:"conntrack"`
+	MTU         *v1alpha1.MTUSpec       `json:"mtu"`
+	NodePort    *v1alpha1.NodePortSpec  `json:"node_port"`
+	Metadata    *v1alpha1.MetadataSpec  `json:"metadata"`
 	Direction   string                  `json:"direction" binding:"oneof='' 'to' 'from' 'both'"`
 	TargetScope *ScopeInfo              `json:"target_scope"`
 }
@@ -216,6 +257,7 @@ type KernelChaosInfo struct {
 // TimeChaosInfo defines the basic information of time chaos for creating a new TimeChaos.
 type TimeChaosInfo struct {
 	TimeOffset     string   `json:"offset"`
+	TimerSlack     string   `json:"timer_slack"`
 	ClockIDs       []string `json:"clock_ids"`
 	ContainerNames []string `json:"container_names"`
 }
@@ -226,7 +268,20 @@ type StressChaosInfo struct {
 	StressngStressors string              `json:"stressng_stressors,omitempty"`
 }
 
-type actionFunc func(info *ExperimentInfo) error
+// Selectors returns the selectors of the experiment, the namespaces of which are defaulted like the webhook
+func (exp *ExperimentInfo) Selectors() []v1alpha1.SelectorSpec {
+	selectors := []v1alpha1.SelectorSpec{exp.Scope.ParseSelector()}
+	if exp.Target.Kind == v1alpha1.KindNetworkChaos && exp.Target.NetworkChaos != nil &&
+		exp.Target.NetworkChaos.TargetScope != nil {
+		selectors = append(selectors, exp.Target.NetworkChaos.TargetScope.ParseSelector())
+	}
+	for i := range selectors {
+		selectors[i].DefaultNamespace(exp.Namespace)
+	}
+	return selectors
+}
+
+type actionFunc func(cli client.Client, info *ExperimentInfo) error
 
 // @Summary Create a new chaos experiment.
 // @Description Create a new chaos experiment.
@@ -245,6 +300,33 @@ func (s *Service) createExperiment(c *gin.Context) {
 		return
 	}
 
+	if !auth.Authorize(c, "create", exp.Target.Kind, exp.Namespace) ||
+		!auth.AuthorizeTargets(c, exp.Target.Kind, exp.Selectors()...) {
+		return
+	}
+
+	if err := s.CreateExperiment(c, exp); err != nil {
+		if errorx.IsOfType(err, utils.ErrInvalidRequest) {
+			c.Status(http.StatusBadRequest)
+			_ = c.Error(err)
+			return
+		}
+		if apierrors.IsForbidden(err) {
+			c.Status(http.StatusForbidden)
+			_ = c.Error(utils.ErrForbidden.WrapWithNoMessage(err))
+			return
+		}
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, nil)
+}
+
+// CreateExperiment creates the chaos experiment described by exp as the user of request c.
+// An ErrInvalidRequest error is returned if the kind of exp is not supported.
+func (s *Service) CreateExperiment(c *gin.Context, exp *ExperimentInfo) error {
 	createFuncs := map[string]actionFunc{
 		v1alpha1.KindPodChaos:     s.createPodChaos,
 		v1alpha1.KindNetworkChaos: s.createNetworkChaos,
@@ -256,21 +338,17 @@ func (s *Service) createExperiment(c *gin.Context) {
 
 	f, ok := createFuncs[exp.Target.Kind]
 	if !ok {
-		c.Status(http.StatusBadRequest)
-		_ = c.Error(utils.ErrInvalidRequest.New(exp.Target.Kind + " is not supported"))
-		return
+		return utils.ErrInvalidRequest.New(exp.Target.Kind + " is not supported")
 	}
 
-	if err := f(exp); err != nil {
-		c.Status(http.StatusInternalServerError)
-		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
-		return
+	cli, err := auth.ClientFor(c, s.kubeCli)
+	if err != nil {
+		return err
 	}
-
-	c.JSON(http.StatusOK, nil)
+	return f(cli, exp)
 }
 
-func (s *Service) createPodChaos(exp *ExperimentInfo) error {
+func (s *Service) createPodChaos(cli client.Client, exp *ExperimentInfo) error {
 	chaos := &v1alpha1.PodChaos{
 		ObjectMeta: v1.ObjectMeta{
 			Name:        exp.Name,
@@ -279,11 +357,13 @@ func (s *Service) createPodChaos(exp *ExperimentInfo) error {
 			Annotations: exp.Annotations,
 		},
 		Spec: v1alpha1.PodChaosSpec{
-			Selector:      exp.Scope.ParseSelector(),
-			Action:        v1alpha1.PodChaosAction(exp.Target.PodChaos.Action),
-			Mode:          v1alpha1.PodMode(exp.Scope.Mode),
-			Value:         exp.Scope.Value,
-			ContainerName: exp.Target.PodChaos.ContainerName,
+			Selector:         exp.Scope.ParseSelector(),
+			Action:           v1alpha1.PodChaosAction(exp.Target.PodChaos.Action),
+			Mode:             v1alpha1.PodMode(exp.Scope.Mode),
+			Value:            exp.Scope.Value,
+			ContainerNames:   exp.Target.PodChaos.containerNames(),
+			Signal:           exp.Target.PodChaos.Signal,
+			ConfigCorruption: exp.Target.PodChaos.ConfigCorruption,
 		},
 	}
 
@@ -295,10 +375,10 @@ func (s *Service) createPodChaos(exp *ExperimentInfo) error {
 		chaos.Spec.Duration = &exp.Scheduler.Duration
 	}
 
-	return s.kubeCli.Create(context.Background(), chaos)
+	return cli.Create(context.Background(), chaos)
 }
 
-func (s *Service) createNetworkChaos(exp *ExperimentInfo) error {
+func (s *Service) createNetworkChaos(cli client.Client, exp *ExperimentInfo) error {
 	chaos := &v1alpha1.NetworkChaos{
 		ObjectMeta: v1.ObjectMeta{
 			Name:        exp.Name,
@@ -315,6 +395,10 @@ func (s *Service) createNetworkChaos(exp *ExperimentInfo) error {
 			Loss:      exp.Target.NetworkChaos.Loss,
 			Duplicate: exp.Target.NetworkChaos.Duplicate,
 			Corrupt:   exp.Target.NetworkChaos.Corrupt,
+			Conntrack: exp.Target.NetworkChaos.Conntrack,
+			MTU:       exp.Target.NetworkChaos.MTU,
+			NodePort:  exp.Target.NetworkChaos.NodePort,
+			Metadata:  exp.Target.NetworkChaos.Metadata,
 		},
 	}
 
@@ -339,10 +423,10 @@ func (s *Service) createNetworkChaos(exp *ExperimentInfo) error {
 		}
 	}
 
-	return s.kubeCli.Create(context.Background(), chaos)
+	return cli.Create(context.Background(), chaos)
 }
 
-func (s *Service) createIOChaos(exp *ExperimentInfo) error {
+func (s *Service) createIOChaos(cli client.Client, exp *ExperimentInfo) error {
 	chaos := &v1alpha1.IoChaos{
 		ObjectMeta: v1.ObjectMeta{
 			Name:        exp.Name,
@@ -374,10 +458,10 @@ func (s *Service) createIOChaos(exp *ExperimentInfo) error {
 		chaos.Spec.Duration = &exp.Scheduler.Duration
 	}
 
-	return s.kubeCli.Create(context.Background(), chaos)
+	return cli.Create(context.Background(), chaos)
 }
 
-func (s *Service) createTimeChaos(exp *ExperimentInfo) error {
+func (s *Service) createTimeChaos(cli client.Client, exp *ExperimentInfo) error {
 	chaos := &v1alpha1.TimeChaos{
 		ObjectMeta: v1.ObjectMeta{
 			Name:        exp.Name,
@@ -390,6 +474,7 @@ func (s *Service) createTimeChaos(exp *ExperimentInfo) error {
 			Mode:           v1alpha1.PodMode(exp.Scope.Mode),
 			Value:          exp.Scope.Value,
 			TimeOffset:     exp.Target.TimeChaos.TimeOffset,
+			TimerSlack:     exp.Target.TimeChaos.TimerSlack,
 			ClockIds:       exp.Target.TimeChaos.ClockIDs,
 			ContainerNames: exp.Target.TimeChaos.ContainerNames,
 		},
@@ -403,10 +488,10 @@ func (s *Service) createTimeChaos(exp *ExperimentInfo) error {
 		chaos.Spec.Duration = &exp.Scheduler.Duration
 	}
 
-	return s.kubeCli.Create(context.Background(), chaos)
+	return cli.Create(context.Background(), chaos)
 }
 
-func (s *Service) createKernelChaos(exp *ExperimentInfo) error {
+func (s *Service) createKernelChaos(cli client.Client, exp *ExperimentInfo) error {
 	chaos := &v1alpha1.KernelChaos{
 		ObjectMeta: v1.ObjectMeta{
 			Name:        exp.Name,
@@ -430,10 +515,10 @@ func (s *Service) createKernelChaos(exp *ExperimentInfo) error {
 		chaos.Spec.Duration = &exp.Scheduler.Duration
 	}
 
-	return s.kubeCli.Create(context.Background(), chaos)
+	return cli.Create(context.Background(), chaos)
 }
 
-func (s *Service) createStressChaos(exp *ExperimentInfo) error {
+func (s *Service) createStressChaos(cli client.Client, exp *ExperimentInfo) error {
 	chaos := &v1alpha1.StressChaos{
 		ObjectMeta: v1.ObjectMeta{
 			Name:        exp.Name,
@@ -458,7 +543,7 @@ func (s *Service) createStressChaos(exp *ExperimentInfo) error {
 		chaos.Spec.Duration = &exp.Scheduler.Duration
 	}
 
-	return s.kubeCli.Create(context.Background(), chaos)
+	return cli.Create(context.Background(), chaos)
 }
 
 func (s *Service) getPodChaosDetail(namespace string, name string) (ExperimentInfo, error) {
@@ -490,8 +575,10 @@ func (s *Service) getPodChaosDetail(namespace string, name string) (ExperimentIn
 		Target: TargetInfo{
 			Kind: v1alpha1.KindPodChaos,
 			PodChaos: &PodChaosInfo{
-				Action:        string(chaos.Spec.Action),
-				ContainerName: chaos.Spec.ContainerName,
+				Action:           string(chaos.Spec.Action),
+				ContainerNames:   chaos.Spec.GetContainerNames(),
+				Signal:           chaos.Spec.Signal,
+				ConfigCorruption: chaos.Spec.ConfigCorruption,
 			},
 		},
 	}
@@ -591,6 +678,10 @@ func (s *Service) getNetworkChaosDetail(namespace string, name string) (Experime
 				Duplicate: chaos.Spec.Duplicate,
 				Corrupt:   chaos.Spec.Corrupt,
 				Bandwidth: chaos.Spec.Bandwidth,
+				Conntrack: chaos.Spec.Conntrack,
+				MTU:       chaos.Spec.MTU,
+				NodePort:  chaos.Spec.NodePort,
+				Metadata:  chaos.Spec.Metadata,
 				Direction: string(chaos.Spec.Direction),
 				TargetScope: &ScopeInfo{
 					SelectorInfo: SelectorInfo{
@@ -651,6 +742,7 @@ func (s *Service) getTimeChaosDetail(namespace string, name string) (ExperimentI
 			Kind: v1alpha1.KindTimeChaos,
 			TimeChaos: &TimeChaosInfo{
 				TimeOffset:     chaos.Spec.TimeOffset,
+				TimerSlack:     chaos.Spec.TimerSlack,
 				ClockIDs:       chaos.Spec.ClockIds,
 				ContainerNames: chaos.Spec.ContainerNames,
 			},
@@ -762,7 +854,7 @@ func (s *Service) getStressChaosDetail(namespace string, name string) (Experimen
 // @Produce json
 // @Param namespace query string false "namespace"
 // @Param name query string false "name"
-// @Param kind query string false "kind" Enums(PodChaos, IoChaos, NetworkChaos, TimeChaos, KernelChaos, StressChaos)
+// @Param kind query string false "kind" Enums(PodChaos, IoChaos, NetworkChaos, TimeChaos, KernelChaos, StressChaos, PhysicalMachineChaos, DBChaos)
 // @Param status query string false "status" Enums(Running, Paused, Failed, Finished)
 // @Success 200 {array} Experiment
 // @Router /api/experiments [get]
@@ -790,6 +882,15 @@ func (s *Service) listExperiments(c *gin.Context) {
 			if status != "" && chaos.Status != status {
 				continue
 			}
+			allowed, err := auth.IsAllowed(c, "list", key, chaos.Namespace)
+			if err != nil {
+				c.Status(http.StatusInternalServerError)
+				_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+				return
+			}
+			if !allowed {
+				continue
+			}
 			data = append(data, &Experiment{
 				ExperimentBase: ExperimentBase{
 					Name:      chaos.Name,
@@ -831,6 +932,9 @@ func (s *Service) getExperimentDetail(c *gin.Context) {
 		_ = c.Error(utils.ErrInvalidRequest.New(kind + " is not supported"))
 		return
 	}
+	if !auth.Authorize(c, "get", kind, ns) {
+		return
+	}
 	switch kind {
 	case v1alpha1.KindPodChaos:
 		info, err = s.getPodChaosDetail(ns, name)
@@ -853,13 +957,143 @@ func (s *Service) getExperimentDetail(c *gin.Context) {
 	c.JSON(http.StatusOK, info)
 }
 
+// Artifacts defines the objects and the faults created for an experiment, which are found by the uid of experiment.
+type Artifacts struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+	// Results are the names of the ChaosResults labeled with the uid.
+	Results []string `json:"results"`
+	// Events are the ids of the events recorded for the experiment.
+	Events []uint `json:"events"`
+	// Pods are the pods which the chaos is injected into, in the format of namespace/name.
+	Pods []string `json:"pods"`
+	// Finalizers are the injections which haven't been recovered yet.
+	Finalizers []string `json:"finalizers"`
+	// IPSets are the ipsets created in the pods by NetworkChaos, the uid is encoded in their names.
+	IPSets []string `json:"ipsets"`
+	// Iptables are the iptables rules added in the pods by NetworkChaos.
+	Iptables []string `json:"iptables"`
+	// Journal is the path of the http endpoint of chaos-daemon, which lists the faults injected by the
+	// experiment on the node.
+	Journal string `json:"journal"`
+}
+
+// @Summary Get the artifacts of the specified chaos experiment.
+// @Description Get the results, events, pods, finalizers and network rules created for the experiment, which are found by its uid.
+// @Tags experiments
+// @Produce json
+// @Param namespace path string true "namespace"
+// @Param name path string true "name"
+// @Param kind path string true "kind" Enums(PodChaos, IoChaos, NetworkChaos, TimeChaos, KernelChaos, StressChaos, PhysicalMachineChaos, DBChaos)
+// @Success 200 {object} Artifacts
+// @Failure 400 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/experiments/artifacts/{kind}/{namespace}/{name} [get]
+func (s *Service) getExperimentArtifacts(c *gin.Context) {
+	kind := c.Param("kind")
+	ns := c.Param("namespace")
+	name := c.Param("name")
+
+	ctx := context.TODO()
+	chaosKind, ok := v1alpha1.AllKinds()[kind]
+	if !ok {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.New(kind + " is not supported"))
+		return
+	}
+	if !auth.Authorize(c, "get", kind, ns) {
+		return
+	}
+	if err := s.kubeCli.Get(ctx, types.NamespacedName{Namespace: ns, Name: name}, chaosKind.Chaos); err != nil {
+		if apierrors.IsNotFound(err) {
+			c.Status(http.StatusNotFound)
+			_ = c.Error(utils.ErrNotFound.NewWithNoMessage())
+		} else {
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		}
+		return
+	}
+
+	chaos, ok := chaosKind.Chaos.(v1alpha1.InnerObject)
+	if !ok {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.New("failed to get chaos status"))
+		return
+	}
+	artifacts := artifactsOf(kind, chaos)
+
+	var results v1alpha1.ChaosResultList
+	if err := s.kubeCli.List(ctx, &results, client.InNamespace(ns),
+		client.MatchingLabels{v1alpha1.LabelExperimentUID: artifacts.UID}); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+	for _, result := range results.Items {
+		artifacts.Results = append(artifacts.Results, result.Name)
+	}
+
+	events, err := s.event.ListByFilter(ctx, core.Filter{UID: artifacts.UID})
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+	for _, event := range events {
+		artifacts.Events = append(artifacts.Events, event.ID)
+	}
+
+	c.JSON(http.StatusOK, artifacts)
+}
+
+// artifactsOf returns the artifacts recorded in the chaos
+func artifactsOf(kind string, chaos v1alpha1.InnerObject) *Artifacts {
+	meta := chaos.(metav1.Object)
+	artifacts := &Artifacts{
+		Kind:       kind,
+		Namespace:  meta.GetNamespace(),
+		Name:       meta.GetName(),
+		UID:        string(meta.GetUID()),
+		Results:    []string{},
+		Events:     []uint{},
+		Pods:       []string{},
+		Finalizers: append([]string{}, meta.GetFinalizers()...),
+		IPSets:     []string{},
+		Iptables:   []string{},
+		Journal:    "/journal?uid=" + string(meta.GetUID()),
+	}
+	for _, record := range chaos.GetStatus().Experiment.PodRecords {
+		artifacts.Pods = append(artifacts.Pods, record.Namespace+"/"+record.Name)
+	}
+
+	if networkchaos, ok := chaos.(*v1alpha1.NetworkChaos); ok {
+		seen := make(map[string]bool)
+		for _, rules := range networkchaos.Status.Rules {
+			for _, set := range rules.IPSets {
+				if !seen[set.Name] {
+					seen[set.Name] = true
+					artifacts.IPSets = append(artifacts.IPSets, set.Name)
+				}
+			}
+			for _, rule := range rules.Iptables {
+				artifacts.Iptables = append(artifacts.Iptables, rules.Namespace+"/"+rules.Name+": "+rule)
+			}
+		}
+	}
+	return artifacts
+}
+
 // @Summary Delete the specified chaos experiment.
 // @Description Delete the specified chaos experiment.
 // @Tags experiments
 // @Produce json
 // @Param namespace path string true "namespace"
 // @Param name path string true "name"
-// @Param kind path string true "kind" Enums(PodChaos, IoChaos, NetworkChaos, TimeChaos, KernelChaos, StressChaos)
+// @Param kind path string true "kind" Enums(PodChaos, IoChaos, NetworkChaos, TimeChaos, KernelChaos, StressChaos, PhysicalMachineChaos, DBChaos)
 // @Param force query string true "force" Enums(true, false)
 // @Success 200 "delete ok"
 // @Failure 400 {object} utils.APIError
@@ -885,6 +1119,9 @@ func (s *Service) deleteExperiment(c *gin.Context) {
 		_ = c.Error(utils.ErrInvalidRequest.New(kind + " is not supported"))
 		return
 	}
+	if !auth.Authorize(c, "delete", kind, ns) {
+		return
+	}
 	if err := s.kubeCli.Get(ctx, chaosKey, chaosKind.Chaos); err != nil {
 		if apierrors.IsNotFound(err) {
 			c.Status(http.StatusNotFound)
@@ -941,32 +1178,11 @@ func (s *Service) state(c *gin.Context) {
 	data := new(ChaosState)
 
 	g, ctx := errgroup.WithContext(context.Background())
-	m := &sync.Mutex{}
 	kinds := v1alpha1.AllKinds()
 	for index := range kinds {
 		list := kinds[index]
 		g.Go(func() error {
-			if err := s.kubeCli.List(ctx, list.ChaosList); err != nil {
-				return err
-			}
-			m.Lock()
-			for _, chaos := range list.ListChaos() {
-				switch chaos.Status {
-				case string(v1alpha1.ExperimentPhaseRunning):
-					data.Running++
-				case string(v1alpha1.ExperimentPhaseWaiting):
-					data.Waiting++
-				case string(v1alpha1.ExperimentPhasePaused):
-					data.Paused++
-				case string(v1alpha1.ExperimentPhaseFailed):
-					data.Failed++
-				case string(v1alpha1.ExperimentPhaseFinished):
-					data.Finished++
-				}
-				data.Total++
-			}
-			m.Unlock()
-			return nil
+			return s.kubeCli.List(ctx, list.ChaosList)
 		})
 	}
 	if err := g.Wait(); err != nil {
@@ -975,6 +1191,34 @@ func (s *Service) state(c *gin.Context) {
 		return
 	}
 
+	// the chaos is authorized after listing, so the request isn't shared by the goroutines
+	for _, list := range kinds {
+		for _, chaos := range list.ListChaos() {
+			allowed, err := auth.IsAllowed(c, "list", chaos.Kind, chaos.Namespace)
+			if err != nil {
+				c.Status(http.StatusInternalServerError)
+				_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+				return
+			}
+			if !allowed {
+				continue
+			}
+			switch chaos.Status {
+			case string(v1alpha1.ExperimentPhaseRunning):
+				data.Running++
+			case string(v1alpha1.ExperimentPhaseWaiting):
+				data.Waiting++
+			case string(v1alpha1.ExperimentPhasePaused):
+				data.Paused++
+			case string(v1alpha1.ExperimentPhaseFailed):
+				data.Failed++
+			case string(v1alpha1.ExperimentPhaseFinished):
+				data.Finished++
+			}
+			data.Total++
+		}
+	}
+
 	c.JSON(http.StatusOK, data)
 }
 
@@ -1005,6 +1249,10 @@ func (s *Service) pauseExperiment(c *gin.Context) {
 		return
 	}
 
+	if !auth.Authorize(c, "patch", exp.Kind, exp.Namespace) {
+		return
+	}
+
 	annotations := map[string]string{
 		v1alpha1.PauseAnnotationKey: "true",
 	}
@@ -1042,6 +1290,10 @@ func (s *Service) startExperiment(c *gin.Context) {
 		return
 	}
 
+	if !auth.Authorize(c, "patch", exp.Kind, exp.Namespace) {
+		return
+	}
+
 	annotations := map[string]string{
 		v1alpha1.PauseAnnotationKey: "false",
 	}
@@ -1086,6 +1338,131 @@ func (s *Service) patchExperiment(exp *ExperimentBase, annotations map[string]st
 		client.ConstantPatch(types.MergePatchType, mergePatch))
 }
 
+// @Summary Patch the chaos experiment by API
+// @Description Patch the chaos experiment with a JSON merge patch, the patched experiment is validated against the OpenAPI schema of its kind
+// @Tags experiments
+// @Accept json
+// @Produce json
+// @Param kind path string true "kind"
+// @Param namespace path string true "namespace"
+// @Param name path string true "name"
+// @Param request body object true "JSON merge patch"
+// @Success 200 "patch ok"
+// @Failure 400 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/experiments/{kind}/{namespace}/{name} [patch]
+func (s *Service) patchExperimentSpec(c *gin.Context) {
+	exp := &ExperimentBase{}
+	if err := c.ShouldBindUri(exp); err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	if !auth.Authorize(c, "patch", exp.Kind, exp.Namespace) {
+		return
+	}
+
+	patch, err := c.GetRawData()
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	chaosKind := v1alpha1.AllKinds()[exp.Kind]
+	key := types.NamespacedName{Namespace: exp.Namespace, Name: exp.Name}
+	if err := s.kubeCli.Get(context.Background(), key, chaosKind.Chaos); err != nil {
+		if apierrors.IsNotFound(err) {
+			c.Status(http.StatusNotFound)
+			_ = c.Error(utils.ErrNotFound.WrapWithNoMessage(err))
+			return
+		}
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	patched, err := validatePatch(exp, chaosKind.Chaos, patch)
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	if !auth.AuthorizeChaosTargets(c, exp.Kind, patched) {
+		return
+	}
+
+	cli, err := auth.ClientFor(c, s.kubeCli)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	if err := cli.Patch(context.Background(), chaosKind.Chaos,
+		client.ConstantPatch(types.MergePatchType, patch)); err != nil {
+		if apierrors.IsInvalid(err) {
+			c.Status(http.StatusBadRequest)
+			_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+			return
+		}
+		if apierrors.IsForbidden(err) {
+			c.Status(http.StatusForbidden)
+			_ = c.Error(utils.ErrForbidden.WrapWithNoMessage(err))
+			return
+		}
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, nil)
+}
+
+// validatePatch applies the JSON merge patch to the chaos, and validates the patched chaos
+// against the OpenAPI schema of its kind. The identity of the chaos can't be patched.
+// The patched chaos is returned.
+func validatePatch(exp *ExperimentBase, chaos runtime.Object, patch []byte) (runtime.Object, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(chaos)
+	if err != nil {
+		return nil, err
+	}
+	// the type meta may be dropped when the chaos is decoded
+	content["apiVersion"] = v1alpha1.GroupVersion.String()
+	content["kind"] = exp.Kind
+
+	original, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := jsonpatch.MergePatch(original, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(patched); err != nil {
+		return nil, err
+	}
+	if obj.GetKind() != exp.Kind || obj.GetNamespace() != exp.Namespace || obj.GetName() != exp.Name {
+		return nil, fmt.Errorf("the kind, namespace and name of the experiment can't be patched")
+	}
+
+	if err := openapi.Validate(exp.Kind, obj.Object); err != nil {
+		return nil, err
+	}
+
+	result := chaos.DeepCopyObject()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // @Summary Update the chaos experiment by API
 // @Description Update the chaos experiment by API
 // @Tags experiments
@@ -1103,6 +1480,18 @@ func (s *Service) updateExperiment(c *gin.Context) {
 		return
 	}
 
+	if !auth.Authorize(c, "update", exp.Target.Kind, exp.Namespace) ||
+		!auth.AuthorizeTargets(c, exp.Target.Kind, exp.Selectors()...) {
+		return
+	}
+
+	cli, err := auth.ClientFor(c, s.kubeCli)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
 	updateFuncs := map[string]actionFunc{
 		v1alpha1.KindPodChaos:     s.updatePodChaos,
 		v1alpha1.KindNetworkChaos: s.updateNetworkChaos,
@@ -1119,10 +1508,13 @@ func (s *Service) updateExperiment(c *gin.Context) {
 		return
 	}
 
-	if err := f(exp); err != nil {
+	if err := f(cli, exp); err != nil {
 		if apierrors.IsNotFound(err) {
 			c.Status(http.StatusNotFound)
 			_ = c.Error(utils.ErrNotFound.WrapWithNoMessage(err))
+		} else if apierrors.IsForbidden(err) {
+			c.Status(http.StatusForbidden)
+			_ = c.Error(utils.ErrForbidden.WrapWithNoMessage(err))
 		} else {
 			c.Status(http.StatusInternalServerError)
 			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
@@ -1133,22 +1525,24 @@ func (s *Service) updateExperiment(c *gin.Context) {
 	c.JSON(http.StatusOK, nil)
 }
 
-func (s *Service) updatePodChaos(exp *ExperimentInfo) error {
+func (s *Service) updatePodChaos(cli client.Client, exp *ExperimentInfo) error {
 	chaos := &v1alpha1.PodChaos{}
 	key := types.NamespacedName{Namespace: exp.Namespace, Name: exp.Name}
 
-	if err := s.kubeCli.Get(context.Background(), key, chaos); err != nil {
+	if err := cli.Get(context.Background(), key, chaos); err != nil {
 		return err
 	}
 
 	chaos.SetLabels(exp.Labels)
 	chaos.SetAnnotations(exp.Annotations)
 	chaos.Spec = v1alpha1.PodChaosSpec{
-		Selector:      exp.Scope.ParseSelector(),
-		Action:        v1alpha1.PodChaosAction(exp.Target.PodChaos.Action),
-		Mode:          v1alpha1.PodMode(exp.Scope.Mode),
-		Value:         exp.Scope.Value,
-		ContainerName: exp.Target.PodChaos.ContainerName,
+		Selector:         exp.Scope.ParseSelector(),
+		Action:           v1alpha1.PodChaosAction(exp.Target.PodChaos.Action),
+		Mode:             v1alpha1.PodMode(exp.Scope.Mode),
+		Value:            exp.Scope.Value,
+		ContainerNames:   exp.Target.PodChaos.containerNames(),
+		Signal:           exp.Target.PodChaos.Signal,
+		ConfigCorruption: exp.Target.PodChaos.ConfigCorruption,
 	}
 
 	if exp.Scheduler.Cron != "" {
@@ -1159,14 +1553,14 @@ func (s *Service) updatePodChaos(exp *ExperimentInfo) error {
 		chaos.Spec.Duration = &exp.Scheduler.Duration
 	}
 
-	return s.kubeCli.Update(context.Background(), chaos)
+	return cli.Update(context.Background(), chaos)
 }
 
-func (s *Service) updateNetworkChaos(exp *ExperimentInfo) error {
+func (s *Service) updateNetworkChaos(cli client.Client, exp *ExperimentInfo) error {
 	chaos := &v1alpha1.NetworkChaos{}
 	key := types.NamespacedName{Namespace: exp.Namespace, Name: exp.Name}
 
-	if err := s.kubeCli.Get(context.Background(), key, chaos); err != nil {
+	if err := cli.Get(context.Background(), key, chaos); err != nil {
 		return err
 	}
 
@@ -1182,6 +1576,10 @@ func (s *Service) updateNetworkChaos(exp *ExperimentInfo) error {
 		Duplicate: exp.Target.NetworkChaos.Duplicate,
 		Corrupt:   exp.Target.NetworkChaos.Corrupt,
 		Bandwidth: exp.Target.NetworkChaos.Bandwidth,
+		Conntrack: exp.Target.NetworkChaos.Conntrack,
+		MTU:       exp.Target.NetworkChaos.MTU,
+		NodePort:  exp.Target.NetworkChaos.NodePort,
+		Metadata:  exp.Target.NetworkChaos.Metadata,
 		Direction: v1alpha1.Direction(exp.Target.NetworkChaos.Direction),
 	}
 
@@ -1201,14 +1599,14 @@ func (s *Service) updateNetworkChaos(exp *ExperimentInfo) error {
 		}
 	}
 
-	return s.kubeCli.Update(context.Background(), chaos)
+	return cli.Update(context.Background(), chaos)
 }
 
-func (s *Service) updateIOChaos(exp *ExperimentInfo) error {
+func (s *Service) updateIOChaos(cli client.Client, exp *ExperimentInfo) error {
 	chaos := &v1alpha1.IoChaos{}
 	key := types.NamespacedName{Namespace: exp.Namespace, Name: exp.Name}
 
-	if err := s.kubeCli.Get(context.Background(), key, chaos); err != nil {
+	if err := cli.Get(context.Background(), key, chaos); err != nil {
 		return err
 	}
 
@@ -1237,14 +1635,14 @@ func (s *Service) updateIOChaos(exp *ExperimentInfo) error {
 		chaos.Spec.Duration = &exp.Scheduler.Duration
 	}
 
-	return s.kubeCli.Update(context.Background(), chaos)
+	return cli.Update(context.Background(), chaos)
 }
 
-func (s *Service) updateKernelChaos(exp *ExperimentInfo) error {
+func (s *Service) updateKernelChaos(cli client.Client, exp *ExperimentInfo) error {
 	chaos := &v1alpha1.KernelChaos{}
 	key := types.NamespacedName{Namespace: exp.Namespace, Name: exp.Name}
 
-	if err := s.kubeCli.Get(context.Background(), key, chaos); err != nil {
+	if err := cli.Get(context.Background(), key, chaos); err != nil {
 		return err
 	}
 
@@ -1265,14 +1663,14 @@ func (s *Service) updateKernelChaos(exp *ExperimentInfo) error {
 		chaos.Spec.Duration = &exp.Scheduler.Duration
 	}
 
-	return s.kubeCli.Update(context.Background(), chaos)
+	return cli.Update(context.Background(), chaos)
 }
 
-func (s *Service) updateTimeChaos(exp *ExperimentInfo) error {
+func (s *Service) updateTimeChaos(cli client.Client, exp *ExperimentInfo) error {
 	chaos := &v1alpha1.TimeChaos{}
 	key := types.NamespacedName{Namespace: exp.Namespace, Name: exp.Name}
 
-	if err := s.kubeCli.Get(context.Background(), key, chaos); err != nil {
+	if err := cli.Get(context.Background(), key, chaos); err != nil {
 		return err
 	}
 
@@ -1283,6 +1681,7 @@ func (s *Service) updateTimeChaos(exp *ExperimentInfo) error {
 		Mode:           v1alpha1.PodMode(exp.Scope.Mode),
 		Value:          exp.Scope.Value,
 		TimeOffset:     exp.Target.TimeChaos.TimeOffset,
+		TimerSlack:     exp.Target.TimeChaos.TimerSlack,
 		ClockIds:       exp.Target.TimeChaos.ClockIDs,
 		ContainerNames: exp.Target.TimeChaos.ContainerNames,
 	}
@@ -1295,14 +1694,14 @@ func (s *Service) updateTimeChaos(exp *ExperimentInfo) error {
 		chaos.Spec.Duration = &exp.Scheduler.Duration
 	}
 
-	return s.kubeCli.Update(context.Background(), chaos)
+	return cli.Update(context.Background(), chaos)
 }
 
-func (s *Service) updateStressChaos(exp *ExperimentInfo) error {
+func (s *Service) updateStressChaos(cli client.Client, exp *ExperimentInfo) error {
 	chaos := &v1alpha1.StressChaos{}
 	key := types.NamespacedName{Namespace: exp.Namespace, Name: exp.Name}
 
-	if err := s.kubeCli.Get(context.Background(), key, chaos); err != nil {
+	if err := cli.Get(context.Background(), key, chaos); err != nil {
 		return err
 	}
 
@@ -1324,5 +1723,82 @@ func (s *Service) updateStressChaos(exp *ExperimentInfo) error {
 		chaos.Spec.Duration = &exp.Scheduler.Duration
 	}
 
-	return s.kubeCli.Create(context.Background(), chaos)
+	return cli.Update(context.Background(), chaos)
+}
+
+// PodPreview defines the information of a pod which would be selected by an experiment.
+type PodPreview struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Node      string `json:"node"`
+	IP        string `json:"ip"`
+}
+
+// PreviewResult defines the pods which would be selected by an experiment, grouped by namespace and node,
+// and the estimated impact of the experiment on them.
+type PreviewResult struct {
+	Total       int                     `json:"total"`
+	ByNamespace map[string][]PodPreview `json:"by_namespace"`
+	ByNode      map[string][]PodPreview `json:"by_node"`
+	Impact      *v1alpha1.ImpactStatus  `json:"impact"`
+}
+
+// @Summary Preview the pods which a chaos experiment would select.
+// @Description Preview the pods which a chaos experiment would select right now, using the same selection as the controller.
+// @Description The result of the random modes such as `one` and `fixed` is only one of the possible selections.
+// @Description The impact on the selected pods is estimated as well, e.g. the PodDisruptionBudgets which pod-kill would violate.
+// @Tags experiments
+// @Produce json
+// @Param request body ExperimentInfo true "Request body"
+// @Success 200 {object} PreviewResult
+// @Failure 400 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/experiments/preview [post]
+func (s *Service) previewExperiment(c *gin.Context) {
+	exp := &ExperimentInfo{}
+	if err := c.ShouldBindJSON(exp); err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	if !auth.Authorize(c, "create", exp.Target.Kind, exp.Namespace) ||
+		!auth.AuthorizeTargets(c, exp.Target.Kind, exp.Selectors()...) {
+		return
+	}
+
+	result := PreviewResult{
+		ByNamespace: make(map[string][]PodPreview),
+		ByNode:      make(map[string][]PodPreview),
+	}
+
+	pods, err := pkgutils.SelectAndFilterPods(context.Background(), s.kubeCli, &exp.Scope)
+	if err != nil && !errors.Is(err, pkgutils.ErrNoPodSelected) {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	for _, pod := range pods {
+		preview := PodPreview{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+			Node:      pod.Spec.NodeName,
+			IP:        pod.Status.PodIP,
+		}
+		result.ByNamespace[pod.Namespace] = append(result.ByNamespace[pod.Namespace], preview)
+		result.ByNode[pod.Spec.NodeName] = append(result.ByNode[pod.Spec.NodeName], preview)
+	}
+	result.Total = len(pods)
+
+	podKill := exp.Target.Kind == v1alpha1.KindPodChaos && exp.Target.PodChaos != nil &&
+		exp.Target.PodChaos.Action == string(v1alpha1.PodKillAction)
+	result.Impact, err = impact.Estimate(context.Background(), s.kubeCli, pods, podKill)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
 }
@@ -17,22 +17,47 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/archive"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/auth"
 	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/common"
 	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/event"
 	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/experiment"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/report"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/result"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/rollouts"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/scenario"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/schema"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/template"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/workflow"
 )
 
 var handlerModule = fx.Options(
 	fx.Provide(
+		auth.NewAuthenticator,
 		common.NewService,
 		experiment.NewService,
 		event.NewService,
 		archive.NewService,
+		template.NewService,
+		report.NewService,
+		schema.NewService,
+		scenario.NewService,
+		rollouts.NewService,
+		result.NewService,
+		workflow.NewService,
 	),
 	fx.Invoke(
+		// the authentication middleware must be registered before the handlers
+		auth.Register,
 		common.Register,
 		experiment.Register,
 		event.Register,
 		archive.Register,
+		template.Register,
+		report.Register,
+		schema.Register,
+		scenario.Register,
+		rollouts.Register,
+		result.Register,
+		workflow.Register,
 	),
 )
@@ -0,0 +1,324 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/auth"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/config"
+	"github.com/chaos-mesh/chaos-mesh/pkg/core"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var log = ctrl.Log.WithName("report api")
+
+const (
+	// TimelineInject is the type of the timeline entries when chaos is injected
+	TimelineInject = "inject"
+	// TimelineRecover is the type of the timeline entries when chaos is recovered
+	TimelineRecover = "recover"
+	// TimelinePhase is the type of the timeline entries when the phase of experiment changes
+	TimelinePhase = "phase"
+)
+
+// Service defines a handler service for experiment reports.
+type Service struct {
+	conf    *config.ChaosDashboardConfig
+	archive core.ExperimentStore
+	event   core.EventStore
+}
+
+// NewService returns a report service instance.
+func NewService(
+	conf *config.ChaosDashboardConfig,
+	archive core.ExperimentStore,
+	event core.EventStore,
+) *Service {
+	return &Service{
+		conf:    conf,
+		archive: archive,
+		event:   event,
+	}
+}
+
+// Register mounts our HTTP handler on the mux.
+func Register(r *gin.RouterGroup, s *Service) {
+	endpoint := r.Group("/reports")
+
+	endpoint.GET("/:uid", s.getReport)
+}
+
+// Report defines the report of an experiment.
+type Report struct {
+	UID        string     `json:"uid"`
+	Kind       string     `json:"kind"`
+	Namespace  string     `json:"namespace"`
+	Name       string     `json:"name"`
+	Action     string     `json:"action"`
+	StartTime  time.Time  `json:"start_time"`
+	FinishTime *time.Time `json:"finish_time,omitempty"`
+	Archived   bool       `json:"archived"`
+
+	Targets  []Target        `json:"targets"`
+	Timeline []TimelineEntry `json:"timeline"`
+	Metrics  []MetricsLink   `json:"metrics"`
+}
+
+// Target defines a pod which is injected by the experiment.
+type Target struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	IP        string `json:"ip"`
+	Action    string `json:"action"`
+	// Injections is the number of times the pod has been injected
+	Injections int `json:"injections"`
+}
+
+// TimelineEntry defines an entry in the timeline of the experiment.
+type TimelineEntry struct {
+	Time    time.Time `json:"time"`
+	Type    string    `json:"type"`
+	Message string    `json:"message"`
+}
+
+// MetricsLink defines a link to the metrics during the experiment.
+type MetricsLink struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// @Summary Get the report of an experiment.
+// @Description Get the report of an experiment, including its targets, the timeline of injections and recoveries, and the links to metrics.
+// @Tags reports
+// @Produce json,html
+// @Param uid path string true "The UID of the experiment"
+// @Param format query string false "The format of the report" Enums(json, html)
+// @Success 200 {object} Report
+// @Failure 400 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/reports/{uid} [get]
+func (s *Service) getReport(c *gin.Context) {
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "html" {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.New("format %s is not supported", format))
+		return
+	}
+
+	report, err := s.buildReport(context.Background(), c.Param("uid"))
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			c.Status(http.StatusNotFound)
+			_ = c.Error(utils.ErrNotFound.New("the experiment is not found"))
+		} else {
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		}
+		return
+	}
+
+	if !auth.Authorize(c, "get", report.Kind, report.Namespace) {
+		return
+	}
+
+	if format == "json" {
+		c.JSON(http.StatusOK, report)
+		return
+	}
+
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	c.Status(http.StatusOK)
+	if err := reportTemplate.Execute(c.Writer, report); err != nil {
+		log.Error(err, "failed to render report", "uid", report.UID)
+	}
+}
+
+func (s *Service) buildReport(ctx context.Context, uid string) (*Report, error) {
+	exp, err := s.archive.FindByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		UID:       exp.UID,
+		Kind:      exp.Kind,
+		Namespace: exp.Namespace,
+		Name:      exp.Name,
+		Action:    exp.Action,
+		StartTime: exp.StartTime,
+		Archived:  exp.Archived,
+		Targets:   make([]Target, 0),
+		Timeline:  make([]TimelineEntry, 0),
+		Metrics:   make([]MetricsLink, 0),
+	}
+	if !exp.FinishTime.IsZero() {
+		report.FinishTime = &exp.FinishTime
+	}
+
+	events, err := s.event.ListByFilter(ctx, core.Filter{UID: uid})
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make(map[string]*Target)
+	var keys []string
+	for _, event := range events {
+		if event.StartTime != nil {
+			report.Timeline = append(report.Timeline, TimelineEntry{
+				Time:    *event.StartTime,
+				Type:    TimelineInject,
+				Message: fmt.Sprintf("Chaos is injected into %d pods", len(event.Pods)),
+			})
+		}
+		if event.FinishTime != nil {
+			report.Timeline = append(report.Timeline, TimelineEntry{
+				Time:    *event.FinishTime,
+				Type:    TimelineRecover,
+				Message: fmt.Sprintf("Chaos is recovered from %d pods", len(event.Pods)),
+			})
+		}
+
+		for _, pod := range event.Pods {
+			key := fmt.Sprintf("%s/%s", pod.Namespace, pod.PodName)
+			target, ok := targets[key]
+			if !ok {
+				target = &Target{
+					Namespace: pod.Namespace,
+					Name:      pod.PodName,
+					IP:        pod.PodIP,
+					Action:    pod.Action,
+				}
+				targets[key] = target
+				keys = append(keys, key)
+			}
+			target.Injections++
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		report.Targets = append(report.Targets, *targets[key])
+	}
+
+	phases, err := s.event.ListPhaseRecords(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	for _, phase := range phases {
+		message := fmt.Sprintf("Experiment is %s", phase.Phase)
+		if phase.Reason != "" {
+			message = fmt.Sprintf("%s: %s", message, phase.Reason)
+		}
+		report.Timeline = append(report.Timeline, TimelineEntry{
+			Time:    phase.Time,
+			Type:    TimelinePhase,
+			Message: message,
+		})
+	}
+	sort.SliceStable(report.Timeline, func(i, j int) bool {
+		return report.Timeline[i].Time.Before(report.Timeline[j].Time)
+	})
+
+	if s.conf.ReportGrafanaURL != "" {
+		link, err := grafanaLink(s.conf.ReportGrafanaURL, report.StartTime, report.FinishTime)
+		if err != nil {
+			return nil, err
+		}
+		report.Metrics = append(report.Metrics, MetricsLink{Name: "Grafana", URL: link})
+	}
+
+	return report, nil
+}
+
+// grafanaLink appends the time range of the experiment to the url of Grafana dashboard,
+// the range is extended to the current time if the experiment is not finished
+func grafanaLink(dashboard string, start time.Time, finish *time.Time) (string, error) {
+	u, err := url.Parse(dashboard)
+	if err != nil {
+		return "", err
+	}
+
+	to := "now"
+	if finish != nil {
+		to = fmt.Sprint(finish.UnixNano() / int64(time.Millisecond))
+	}
+
+	query := u.Query()
+	query.Set("from", fmt.Sprint(start.UnixNano()/int64(time.Millisecond)))
+	query.Set("to", to)
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"formatTime": func(t time.Time) string { return t.Format(time.RFC3339) },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Report of {{ .Kind }} {{ .Namespace }}/{{ .Name }}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+@media print { a { color: inherit; } }
+</style>
+</head>
+<body>
+<h1>{{ .Kind }} {{ .Namespace }}/{{ .Name }}</h1>
+<table>
+<tr><th>UID</th><td>{{ .UID }}</td></tr>
+<tr><th>Action</th><td>{{ .Action }}</td></tr>
+<tr><th>Start Time</th><td>{{ formatTime .StartTime }}</td></tr>
+<tr><th>Finish Time</th><td>{{ if .FinishTime }}{{ formatTime .FinishTime }}{{ else }}-{{ end }}</td></tr>
+<tr><th>Archived</th><td>{{ .Archived }}</td></tr>
+</table>
+<h2>Targets</h2>
+<table>
+<tr><th>Namespace</th><th>Name</th><th>IP</th><th>Action</th><th>Injections</th></tr>
+{{- range .Targets }}
+<tr><td>{{ .Namespace }}</td><td>{{ .Name }}</td><td>{{ .IP }}</td><td>{{ .Action }}</td><td>{{ .Injections }}</td></tr>
+{{- end }}
+</table>
+<h2>Timeline</h2>
+<table>
+<tr><th>Time</th><th>Type</th><th>Message</th></tr>
+{{- range .Timeline }}
+<tr><td>{{ formatTime .Time }}</td><td>{{ .Type }}</td><td>{{ .Message }}</td></tr>
+{{- end }}
+</table>
+{{- if .Metrics }}
+<h2>Metrics</h2>
+<ul>
+{{- range .Metrics }}
+<li><a href="{{ .URL }}">{{ .Name }}</a></li>
+{{- end }}
+</ul>
+{{- end }}
+</body>
+</html>
+`))
@@ -0,0 +1,365 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/auth"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/cireport"
+	"github.com/chaos-mesh/chaos-mesh/pkg/replay"
+)
+
+// resultResource is the resource of ChaosResult which is authorized, the authorizer
+// takes the lowercase kind as the resource
+const resultResource = "ChaosResults"
+
+// Service defines a handler service for the results of chaos runs.
+type Service struct {
+	kubeCli client.Client
+}
+
+// NewService returns a result service instance.
+func NewService(cli client.Client) *Service {
+	return &Service{
+		kubeCli: cli,
+	}
+}
+
+// Register mounts our HTTP handler on the mux.
+func Register(r *gin.RouterGroup, s *Service) {
+	endpoint := r.Group("/results")
+
+	endpoint.GET("", s.listResults)
+	endpoint.GET("/summary", s.summarizeResults)
+	endpoint.GET("/export", s.exportResults)
+	endpoint.POST("/:namespace/:name/probes", s.addProbe)
+	endpoint.GET("/:namespace/:name/bundle", s.exportBundle)
+}
+
+// Summary defines the aggregated results of the runs of an experiment.
+type Summary struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Runs      int    `json:"runs"`
+	Running   int    `json:"running"`
+	Passed    int    `json:"passed"`
+	Failed    int    `json:"failed"`
+	Aborted   int    `json:"aborted"`
+	// PassRate is the ratio of the passed runs to the finished ones.
+	PassRate float64 `json:"pass_rate"`
+	// MeanDuration is the mean duration of the finished runs.
+	MeanDuration string                `json:"mean_duration"`
+	LastVerdict  v1alpha1.ChaosVerdict `json:"last_verdict"`
+	LastRun      time.Time             `json:"last_run"`
+}
+
+// ProbeRequest defines a measurement of a probe which is added to the result.
+type ProbeRequest struct {
+	Name    string `json:"name" binding:"required"`
+	Value   string `json:"value"`
+	Passed  *bool  `json:"passed" binding:"required"`
+	Message string `json:"message"`
+}
+
+// @Summary Get the results of chaos runs.
+// @Description Get the results of chaos runs, the latest runs come first.
+// @Tags results
+// @Produce json
+// @Param namespace query string false "namespace"
+// @Param kind query string false "the kind of experiment"
+// @Param name query string false "the name of experiment"
+// @Param verdict query string false "verdict" Enums(Running, Passed, Failed, Aborted)
+// @Success 200 {array} v1alpha1.ChaosResult
+// @Failure 403 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/results [get]
+func (s *Service) listResults(c *gin.Context) {
+	results, ok := s.queryResults(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, results)
+}
+
+// @Summary Get the aggregated results of chaos runs.
+// @Description Get the numbers of runs by verdict, the pass rates and the mean durations of the experiments.
+// @Tags results
+// @Produce json
+// @Param namespace query string false "namespace"
+// @Param kind query string false "the kind of experiment"
+// @Param name query string false "the name of experiment"
+// @Success 200 {array} Summary
+// @Failure 403 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/results/summary [get]
+func (s *Service) summarizeResults(c *gin.Context) {
+	results, ok := s.queryResults(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, summarize(results))
+}
+
+// @Summary Export the results of chaos runs for CI.
+// @Description Export the steps and the verdicts of the runs as JUnit XML or a JSON summary, so the runs can gate CI pipelines.
+// @Tags results
+// @Produce json,xml
+// @Param namespace query string false "namespace"
+// @Param kind query string false "the kind of experiment"
+// @Param name query string false "the name of experiment"
+// @Param format query string false "the format of the export" Enums(json, junit)
+// @Param latest query string false "only export the latest run of each experiment" Enums(true, false)
+// @Success 200 {object} cireport.Summary
+// @Failure 400 {object} utils.APIError
+// @Failure 403 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/results/export [get]
+func (s *Service) exportResults(c *gin.Context) {
+	format := c.DefaultQuery("format", cireport.FormatJSON)
+	if format != cireport.FormatJSON && format != cireport.FormatJUnit {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.New("format %s is not supported", format))
+		return
+	}
+
+	results, ok := s.queryResults(c)
+	if !ok {
+		return
+	}
+	if c.Query("latest") == "true" {
+		results = cireport.LatestRuns(results)
+	}
+	summary := cireport.Summarize(results)
+
+	if format == cireport.FormatJSON {
+		c.JSON(http.StatusOK, summary)
+		return
+	}
+
+	c.Header("Content-Type", "application/xml; charset=utf-8")
+	c.Status(http.StatusOK)
+	if err := cireport.WriteJUnit(c.Writer, summary); err != nil {
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+	}
+}
+
+// @Summary Add a probe measurement to the result of a chaos run.
+// @Description Add a probe measurement to the result, the run fails if the measurement doesn't pass.
+// @Tags results
+// @Produce json
+// @Param namespace path string true "namespace"
+// @Param name path string true "the name of result"
+// @Param request body ProbeRequest true "Request body"
+// @Success 200 {object} v1alpha1.ChaosResult
+// @Failure 400 {object} utils.APIError
+// @Failure 403 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/results/{namespace}/{name}/probes [post]
+func (s *Service) addProbe(c *gin.Context) {
+	req := &ProbeRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	ns := c.Param("namespace")
+	if !auth.Authorize(c, "update", resultResource, ns) {
+		return
+	}
+
+	ctx := context.Background()
+	result := &v1alpha1.ChaosResult{}
+	if err := s.kubeCli.Get(ctx, types.NamespacedName{Namespace: ns, Name: c.Param("name")}, result); err != nil {
+		if apierrors.IsNotFound(err) {
+			c.Status(http.StatusNotFound)
+			_ = c.Error(utils.ErrNotFound.New("the result is not found"))
+		} else {
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		}
+		return
+	}
+
+	result.Status.Probes = append(result.Status.Probes, v1alpha1.ProbeMeasurement{
+		Name:    req.Name,
+		Time:    metav1.Now(),
+		Value:   req.Value,
+		Passed:  *req.Passed,
+		Message: req.Message,
+	})
+	// the passed run is judged again with the late measurement
+	if result.Status.Verdict == v1alpha1.VerdictPassed && result.Status.EndTime != nil {
+		result.Finish(v1alpha1.VerdictPassed, result.Status.Reason, *result.Status.EndTime)
+	}
+
+	status := result.Status.DeepCopy()
+	if err := s.kubeCli.Update(ctx, result); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+	result.Status = *status
+	if err := s.kubeCli.Status().Update(ctx, result); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary Export the finished run of chaos as a bundle.
+// @Description Export the spec of the chaos when the run started, its targets and its timeline as a bundle, which is replayed by chaosctl.
+// @Tags results
+// @Produce json
+// @Param namespace path string true "namespace"
+// @Param name path string true "the name of result"
+// @Success 200 {object} replay.Bundle
+// @Failure 400 {object} utils.APIError
+// @Failure 403 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/results/{namespace}/{name}/bundle [get]
+func (s *Service) exportBundle(c *gin.Context) {
+	ns := c.Param("namespace")
+	if !auth.Authorize(c, "get", resultResource, ns) {
+		return
+	}
+
+	result := &v1alpha1.ChaosResult{}
+	if err := s.kubeCli.Get(context.Background(), types.NamespacedName{Namespace: ns, Name: c.Param("name")}, result); err != nil {
+		if apierrors.IsNotFound(err) {
+			c.Status(http.StatusNotFound)
+			_ = c.Error(utils.ErrNotFound.New("the result is not found"))
+		} else {
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		}
+		return
+	}
+
+	bundle, err := replay.Export(result)
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, bundle)
+}
+
+// queryResults lists the results which match the query and are allowed to the user, the error
+// is attached to c if it returns false
+func (s *Service) queryResults(c *gin.Context) ([]v1alpha1.ChaosResult, bool) {
+	labels := client.MatchingLabels{}
+	for query, label := range map[string]string{
+		"kind":    v1alpha1.LabelResultKind,
+		"name":    v1alpha1.LabelResultName,
+		"verdict": v1alpha1.LabelResultVerdict,
+	} {
+		if value := c.Query(query); value != "" {
+			labels[label] = value
+		}
+	}
+
+	var list v1alpha1.ChaosResultList
+	if err := s.kubeCli.List(context.Background(), &list, client.InNamespace(c.Query("namespace")), labels); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return nil, false
+	}
+
+	results := make([]v1alpha1.ChaosResult, 0, len(list.Items))
+	for _, result := range list.Items {
+		allowed, err := auth.IsAllowed(c, "list", resultResource, result.Namespace)
+		if err != nil {
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+			return nil, false
+		}
+		if allowed {
+			results = append(results, result)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[j].Spec.StartTime.Before(&results[i].Spec.StartTime)
+	})
+	return results, true
+}
+
+// summarize aggregates the results by their experiments. The results are sorted by the start time
+// in descending order, so the first result of each experiment is its last run.
+func summarize(results []v1alpha1.ChaosResult) []*Summary {
+	summaries := make([]*Summary, 0)
+	byExperiment := make(map[string]*Summary)
+	durations := make(map[*Summary]time.Duration)
+	for _, result := range results {
+		experiment := result.Spec.Experiment
+		key := experiment.Kind + "/" + result.Namespace + "/" + experiment.Name
+		summary, ok := byExperiment[key]
+		if !ok {
+			summary = &Summary{
+				Kind:        experiment.Kind,
+				Namespace:   result.Namespace,
+				Name:        experiment.Name,
+				LastVerdict: result.Status.Verdict,
+				LastRun:     result.Spec.StartTime.Time,
+			}
+			byExperiment[key] = summary
+			summaries = append(summaries, summary)
+		}
+
+		summary.Runs++
+		switch result.Status.Verdict {
+		case v1alpha1.VerdictPassed:
+			summary.Passed++
+		case v1alpha1.VerdictFailed:
+			summary.Failed++
+		case v1alpha1.VerdictAborted:
+			summary.Aborted++
+		default:
+			summary.Running++
+		}
+		if result.Status.EndTime != nil {
+			durations[summary] += result.Status.EndTime.Sub(result.Spec.StartTime.Time)
+		}
+	}
+
+	for _, summary := range summaries {
+		finished := summary.Passed + summary.Failed + summary.Aborted
+		if finished == 0 {
+			continue
+		}
+		summary.PassRate = float64(summary.Passed) / float64(finished)
+		summary.MeanDuration = (durations[summary] / time.Duration(finished)).Round(time.Second).String()
+	}
+	return summaries
+}
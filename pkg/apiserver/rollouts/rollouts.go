@@ -0,0 +1,206 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollouts
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/auth"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+)
+
+// Service defines a handler service for the analysis runs of Argo Rollouts.
+type Service struct {
+	kubeCli client.Client
+}
+
+// NewService returns a rollouts service instance.
+func NewService(cli client.Client) *Service {
+	return &Service{
+		kubeCli: cli,
+	}
+}
+
+// Register mounts our HTTP handler on the mux.
+func Register(r *gin.RouterGroup, s *Service) {
+	endpoint := r.Group("/rollouts")
+
+	endpoint.POST("/analysis", s.runAnalysis)
+}
+
+// AnalysisRequest defines the chaos run by a measurement of the web metric provider of Argo Rollouts.
+type AnalysisRequest struct {
+	Namespace string `json:"namespace" binding:"required,NameValid"`
+	// Name is the name of the chaos. The chaos is created by the first measurement and checked by
+	// the later ones, so it should be unique to the analysis run, e.g. "canary-{{args.rollout-hash}}".
+	Name string `json:"name" binding:"required,NameValid"`
+	// Template is the name of the ChaosTemplate which the chaos is instantiated from.
+	Template   string            `json:"template" binding:"required"`
+	Parameters map[string]string `json:"parameters"`
+}
+
+// AnalysisResult defines the state of the chaos, which is evaluated by the successCondition and
+// failureCondition of the metric, e.g. `result.failed == false`.
+type AnalysisResult struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Created is true if the chaos is created by this measurement.
+	Created bool                     `json:"created"`
+	Phase   v1alpha1.ExperimentPhase `json:"phase"`
+	Reason  string                   `json:"reason"`
+	// Injected is true once the chaos is injected into any pod.
+	Injected bool `json:"injected"`
+	// Finished is true once the chaos is recovered after its duration.
+	Finished bool `json:"finished"`
+	// Failed is true if the chaos failed to be injected.
+	Failed bool `json:"failed"`
+	// Targets is the number of the pods which the chaos is injected into.
+	Targets int `json:"targets"`
+	// ControlGroup is the number of the selected pods which are left untouched.
+	ControlGroup int `json:"control_group"`
+}
+
+// @Summary Run a chaos as a measurement of Argo Rollouts analysis.
+// @Description Instantiate the chaos from the ChaosTemplate if it doesn't exist, and return the state of the chaos.
+// @Description It's designed for the web metric provider of Argo Rollouts, which takes a measurement periodically.
+// @Tags rollouts
+// @Produce json
+// @Param request body AnalysisRequest true "Request body"
+// @Success 200 {object} AnalysisResult
+// @Failure 400 {object} utils.APIError
+// @Failure 403 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/rollouts/analysis [post]
+func (s *Service) runAnalysis(c *gin.Context) {
+	req := &AnalysisRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	ctx := context.Background()
+	tpl := &v1alpha1.ChaosTemplate{}
+	if err := s.kubeCli.Get(ctx, types.NamespacedName{Name: req.Template}, tpl); err != nil {
+		if apierrors.IsNotFound(err) {
+			c.Status(http.StatusNotFound)
+			_ = c.Error(utils.ErrNotFound.New("the template %s is not found", req.Template))
+		} else {
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		}
+		return
+	}
+
+	kind, ok := v1alpha1.AllKinds()[tpl.Spec.Kind]
+	if !ok {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.New("the template %s has unknown kind %s", tpl.Name, tpl.Spec.Kind))
+		return
+	}
+
+	if !auth.Authorize(c, "get", tpl.Spec.Kind, req.Namespace) {
+		return
+	}
+
+	// the chaos created by an earlier measurement is checked instead of created again
+	created := false
+	obj := kind.Chaos.DeepCopyObject()
+	err := s.kubeCli.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: req.Name}, obj)
+	if apierrors.IsNotFound(err) {
+		if !auth.Authorize(c, "create", tpl.Spec.Kind, req.Namespace) {
+			return
+		}
+
+		chaos, err := tpl.Instantiate(req.Namespace, req.Name, req.Parameters)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+			return
+		}
+		// the template may target the other namespaces, which the caller must be allowed to create the chaos in
+		if !auth.AuthorizeChaosTargets(c, tpl.Spec.Kind, chaos.(runtime.Object)) {
+			return
+		}
+
+		cli, err := auth.ClientFor(c, s.kubeCli)
+		if err != nil {
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+			return
+		}
+		if err := cli.Create(ctx, chaos.(runtime.Object)); err != nil {
+			if apierrors.IsForbidden(err) {
+				c.Status(http.StatusForbidden)
+				_ = c.Error(utils.ErrForbidden.WrapWithNoMessage(err))
+				return
+			}
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+			return
+		}
+		obj, created = chaos.(runtime.Object), true
+	} else if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	// the chaos with the same name may be created by others, it mustn't be reported as this analysis
+	if label := obj.(metav1.Object).GetLabels()[v1alpha1.LabelChaosTemplate]; label != tpl.Name {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.New("%s %s/%s isn't instantiated from the template %s",
+			tpl.Spec.Kind, req.Namespace, req.Name, tpl.Name))
+		return
+	}
+
+	c.JSON(http.StatusOK, newResult(obj.(v1alpha1.InnerObject), created))
+}
+
+// newResult returns the state of chaos evaluated by the analysis
+func newResult(chaos v1alpha1.InnerObject, created bool) *AnalysisResult {
+	instance := chaos.GetChaos()
+	status := chaos.GetStatus().Experiment
+
+	result := &AnalysisResult{
+		Kind:         instance.Kind,
+		Namespace:    instance.Namespace,
+		Name:         instance.Name,
+		Created:      created,
+		Phase:        status.Phase,
+		Reason:       status.Reason,
+		Injected:     len(status.PodRecords) > 0,
+		Finished:     status.Phase == v1alpha1.ExperimentPhaseFinished,
+		Failed:       status.Phase == v1alpha1.ExperimentPhaseFailed,
+		Targets:      len(status.PodRecords),
+		ControlGroup: len(status.ControlGroup),
+	}
+	if result.Failed && result.Reason == "" && len(status.FailedRecords) > 0 {
+		record := status.FailedRecords[0]
+		result.Reason = fmt.Sprintf("failed on pod %s/%s: %s", record.Namespace, record.Name, record.Error)
+	}
+	return result
+}
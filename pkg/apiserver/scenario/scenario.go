@@ -0,0 +1,153 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scenario
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/auth"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/experiment"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/scenario"
+)
+
+// Service defines a handler service for the scenario library.
+type Service struct {
+	kubeCli client.Client
+}
+
+// NewService returns a scenario service instance.
+func NewService(cli client.Client) *Service {
+	return &Service{
+		kubeCli: cli,
+	}
+}
+
+// Register mounts our HTTP handler on the mux.
+func Register(r *gin.RouterGroup, s *Service) {
+	endpoint := r.Group("/scenarios")
+
+	endpoint.GET("", s.listScenarios)
+	endpoint.GET("/detail/:name", s.getScenario)
+	endpoint.POST("/run/:name", s.runScenario)
+}
+
+// RunInfo defines the options to run a scenario.
+type RunInfo struct {
+	// Name is the name of the created chaos, default to the name of scenario.
+	Name      string `json:"name" binding:"omitempty,NameValid"`
+	Namespace string `json:"namespace" binding:"required,NameValid"`
+	// Selector selects the target pods, the pods in the namespace are selected if it's empty.
+	Selector   experiment.SelectorInfo `json:"selector"`
+	Parameters map[string]string       `json:"parameters"`
+}
+
+// @Summary Get the list of scenarios.
+// @Description Get the list of the curated scenarios, such as az-outage and dns-flake.
+// @Tags scenarios
+// @Produce json
+// @Success 200 {array} scenario.Scenario
+// @Router /api/scenarios [get]
+func (s *Service) listScenarios(c *gin.Context) {
+	c.JSON(http.StatusOK, scenario.List())
+}
+
+// @Summary Get the scenario.
+// @Description Get the scenario by its name.
+// @Tags scenarios
+// @Produce json
+// @Param name path string true "name"
+// @Success 200 {object} scenario.Scenario
+// @Failure 404 {object} utils.APIError
+// @Router /api/scenarios/detail/{name} [get]
+func (s *Service) getScenario(c *gin.Context) {
+	sc, ok := findScenario(c, c.Param("name"))
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, sc)
+}
+
+// @Summary Run a scenario.
+// @Description Generate the chaos of the scenario targeting the selected pods and create them.
+// @Tags scenarios
+// @Produce json
+// @Param name path string true "name"
+// @Param request body RunInfo true "Request body"
+// @Success 200 {array} object "the created chaos"
+// @Failure 400 {object} utils.APIError
+// @Failure 403 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/scenarios/run/{name} [post]
+func (s *Service) runScenario(c *gin.Context) {
+	info := &RunInfo{}
+	if err := c.ShouldBindJSON(info); err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	sc, ok := findScenario(c, c.Param("name"))
+	if !ok {
+		return
+	}
+
+	chaos, err := sc.Generate(&scenario.Options{
+		Namespace:  info.Namespace,
+		Name:       info.Name,
+		Selector:   info.Selector.ParseSelector(),
+		Parameters: info.Parameters,
+	})
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	// all the chaos must be allowed before any of them is created
+	for _, ch := range chaos {
+		if !auth.Authorize(c, "create", ch.GetChaos().Kind, info.Namespace) {
+			return
+		}
+	}
+
+	for _, ch := range chaos {
+		if err := s.kubeCli.Create(context.Background(), ch.(runtime.Object)); err != nil {
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, chaos)
+}
+
+// findScenario finds the scenario by name, the error is attached to c if it isn't found
+func findScenario(c *gin.Context, name string) (*scenario.Scenario, bool) {
+	sc, ok := scenario.Get(name)
+	if !ok {
+		c.Status(http.StatusNotFound)
+		_ = c.Error(utils.ErrNotFound.New("the scenario %s is not found", name))
+		return nil, false
+	}
+
+	return sc, true
+}
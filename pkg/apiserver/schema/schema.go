@@ -0,0 +1,76 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/openapi"
+)
+
+// Service defines a handler service for the schemas of chaos.
+type Service struct{}
+
+// NewService returns a schema service instance.
+func NewService() *Service {
+	return &Service{}
+}
+
+// Register mounts our HTTP handler on the mux.
+func Register(r *gin.RouterGroup, s *Service) {
+	endpoint := r.Group("/schemas")
+
+	endpoint.GET("", s.getDocument)
+	endpoint.GET("/:kind", s.getSchema)
+}
+
+// @Summary Get the OpenAPI v3 document of chaos.
+// @Description Get the OpenAPI v3 document which contains the schemas of all kinds of chaos, including the enum values of actions and modes.
+// @Tags schemas
+// @Produce json
+// @Success 200 {object} openapi.Document
+// @Failure 500 {object} utils.APIError
+// @Router /api/schemas [get]
+func (s *Service) getDocument(c *gin.Context) {
+	doc, err := openapi.NewDocument()
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, doc)
+}
+
+// @Summary Get the OpenAPI v3 schema of a kind of chaos.
+// @Description Get the OpenAPI v3 schema of a kind of chaos, which UI and third-party tools can build forms from.
+// @Tags schemas
+// @Produce json
+// @Param kind path string true "kind"
+// @Success 200 {object} v1beta1.JSONSchemaProps
+// @Failure 404 {object} utils.APIError
+// @Router /api/schemas/{kind} [get]
+func (s *Service) getSchema(c *gin.Context) {
+	schema, err := openapi.Schema(c.Param("kind"))
+	if err != nil {
+		c.Status(http.StatusNotFound)
+		_ = c.Error(utils.ErrNotFound.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, schema)
+}
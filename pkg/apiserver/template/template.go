@@ -0,0 +1,433 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/jinzhu/gorm"
+	"github.com/joomcode/errorx"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/auth"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/experiment"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/config"
+	"github.com/chaos-mesh/chaos-mesh/pkg/core"
+)
+
+// Service defines a handler service for experiment templates.
+type Service struct {
+	conf       *config.ChaosDashboardConfig
+	template   core.TemplateStore
+	experiment *experiment.Service
+}
+
+// NewService returns a template service instance.
+func NewService(
+	conf *config.ChaosDashboardConfig,
+	template core.TemplateStore,
+	experiment *experiment.Service,
+) *Service {
+	return &Service{
+		conf:       conf,
+		template:   template,
+		experiment: experiment,
+	}
+}
+
+// Register mounts our HTTP handler on the mux.
+func Register(r *gin.RouterGroup, s *Service) {
+	endpoint := r.Group("/templates")
+
+	endpoint.GET("", s.listTemplates)
+	endpoint.POST("/new", s.createTemplate)
+	endpoint.GET("/detail/:name", s.getTemplate)
+	endpoint.PUT("/update", s.updateTemplate)
+	endpoint.DELETE("/:name", s.deleteTemplate)
+	endpoint.POST("/instantiate/:name", s.instantiateTemplate)
+}
+
+// Parameter defines a parameter of the template.
+type Parameter struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+	// Default is used when the parameter is not given on instantiation.
+	Default string `json:"default"`
+	// Required means the parameter must be given on instantiation.
+	Required bool `json:"required"`
+}
+
+// Template defines a form data of experiment template from API.
+type Template struct {
+	Name        string      `json:"name" binding:"required,NameValid"`
+	Description string      `json:"description"`
+	Parameters  []Parameter `json:"parameters" binding:"dive"`
+	// Experiment is an ExperimentInfo, the `${name}` placeholders in its strings
+	// are substituted by the parameters on instantiation.
+	Experiment json.RawMessage `json:"experiment" binding:"required"`
+}
+
+// InstantiateInfo defines the parameters used to instantiate a template.
+type InstantiateInfo struct {
+	Parameters map[string]string `json:"parameters"`
+}
+
+// @Summary Get the list of experiment templates.
+// @Description Get the list of experiment templates.
+// @Tags templates
+// @Produce json
+// @Success 200 {array} Template
+// @Router /api/templates [get]
+// @Failure 500 {object} utils.APIError
+func (s *Service) listTemplates(c *gin.Context) {
+	templates, err := s.template.List(context.Background())
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	data := make([]*Template, 0, len(templates))
+	for _, tpl := range templates {
+		t, err := fromCore(tpl)
+		if err != nil {
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+			return
+		}
+		data = append(data, t)
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Create a new experiment template.
+// @Description Create a new experiment template.
+// @Tags templates
+// @Produce json
+// @Param request body Template true "Request body"
+// @Success 200 "create ok"
+// @Failure 400 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/templates/new [post]
+func (s *Service) createTemplate(c *gin.Context) {
+	tpl, ok := bindTemplate(c)
+	if !ok {
+		return
+	}
+
+	if err := s.template.Create(context.Background(), tpl); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, nil)
+}
+
+// @Summary Get the experiment template.
+// @Description Get the experiment template by its name.
+// @Tags templates
+// @Produce json
+// @Param name path string true "name"
+// @Success 200 {object} Template
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/templates/detail/{name} [get]
+func (s *Service) getTemplate(c *gin.Context) {
+	tpl, ok := s.findTemplate(c, c.Param("name"))
+	if !ok {
+		return
+	}
+
+	data, err := fromCore(tpl)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Update an experiment template.
+// @Description Update an experiment template.
+// @Tags templates
+// @Produce json
+// @Param request body Template true "Request body"
+// @Success 200 "update ok"
+// @Failure 400 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/templates/update [put]
+func (s *Service) updateTemplate(c *gin.Context) {
+	tpl, ok := bindTemplate(c)
+	if !ok {
+		return
+	}
+
+	if _, ok := s.findTemplate(c, tpl.Name); !ok {
+		return
+	}
+
+	if err := s.template.Update(context.Background(), tpl); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, nil)
+}
+
+// @Summary Delete an experiment template.
+// @Description Delete an experiment template.
+// @Tags templates
+// @Produce json
+// @Param name path string true "name"
+// @Success 200 "delete ok"
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/templates/{name} [delete]
+func (s *Service) deleteTemplate(c *gin.Context) {
+	tpl, ok := s.findTemplate(c, c.Param("name"))
+	if !ok {
+		return
+	}
+
+	if err := s.template.Delete(context.Background(), tpl); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, nil)
+}
+
+// @Summary Create a chaos experiment from an experiment template.
+// @Description Substitute the parameters into the experiment template and create the chaos experiment.
+// @Tags templates
+// @Produce json
+// @Param name path string true "name"
+// @Param request body InstantiateInfo true "Request body"
+// @Success 200 {object} experiment.ExperimentInfo
+// @Failure 400 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/templates/instantiate/{name} [post]
+func (s *Service) instantiateTemplate(c *gin.Context) {
+	info := &InstantiateInfo{}
+	if err := c.ShouldBindJSON(info); err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	tpl, ok := s.findTemplate(c, c.Param("name"))
+	if !ok {
+		return
+	}
+
+	t, err := fromCore(tpl)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	exp, err := t.Instantiate(info.Parameters)
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	if !auth.Authorize(c, "create", exp.Target.Kind, exp.Namespace) ||
+		!auth.AuthorizeTargets(c, exp.Target.Kind, exp.Selectors()...) {
+		return
+	}
+
+	if err := s.experiment.CreateExperiment(c, exp); err != nil {
+		if errorx.IsOfType(err, utils.ErrInvalidRequest) {
+			c.Status(http.StatusBadRequest)
+			_ = c.Error(err)
+			return
+		}
+		if apierrors.IsForbidden(err) {
+			c.Status(http.StatusForbidden)
+			_ = c.Error(utils.ErrForbidden.WrapWithNoMessage(err))
+			return
+		}
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, exp)
+}
+
+// findTemplate finds the template by name, the error is attached to c if it fails
+func (s *Service) findTemplate(c *gin.Context, name string) (*core.ExperimentTemplate, bool) {
+	tpl, err := s.template.FindByName(context.Background(), name)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			c.Status(http.StatusNotFound)
+			_ = c.Error(utils.ErrNotFound.New("the template %s is not found", name))
+		} else {
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		}
+		return nil, false
+	}
+
+	return tpl, true
+}
+
+// bindTemplate binds and validates the template in request, the error is attached to c if it fails
+func bindTemplate(c *gin.Context) (*core.ExperimentTemplate, bool) {
+	t := &Template{}
+	if err := c.ShouldBindJSON(t); err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return nil, false
+	}
+
+	if err := t.Validate(); err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return nil, false
+	}
+
+	tpl, err := t.toCore()
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return nil, false
+	}
+
+	return tpl, true
+}
+
+// Validate checks that the parameters are unique, and every placeholder
+// in the experiment is declared as a parameter.
+func (t *Template) Validate() error {
+	declared := make(map[string]struct{}, len(t.Parameters))
+	for _, param := range t.Parameters {
+		if _, ok := declared[param.Name]; ok {
+			return fmt.Errorf("parameter %s is declared more than once", param.Name)
+		}
+		declared[param.Name] = struct{}{}
+	}
+
+	var experiment map[string]interface{}
+	if err := json.Unmarshal(t.Experiment, &experiment); err != nil {
+		return fmt.Errorf("experiment must be a JSON object: %v", err)
+	}
+
+	for _, name := range v1alpha1.TemplatePlaceholders(string(t.Experiment)) {
+		if _, ok := declared[name]; !ok {
+			return fmt.Errorf("parameter %s is used but not declared", name)
+		}
+	}
+
+	return nil
+}
+
+// Instantiate substitutes the parameters into the experiment of template.
+// The default values are used for the parameters which are not given.
+func (t *Template) Instantiate(values map[string]string) (*experiment.ExperimentInfo, error) {
+	params := make(map[string]string, len(t.Parameters))
+	for _, param := range t.Parameters {
+		value, ok := values[param.Name]
+		if !ok {
+			if param.Required {
+				return nil, fmt.Errorf("parameter %s is required", param.Name)
+			}
+			value = param.Default
+		}
+		params[param.Name] = value
+	}
+
+	var unknown []string
+	for name := range values {
+		if _, ok := params[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown parameters %v", unknown)
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal(t.Experiment, &raw); err != nil {
+		return nil, err
+	}
+
+	// the placeholders are substituted in the decoded strings instead of the raw JSON,
+	// so the values of parameters can't break the structure of the experiment
+	data, err := json.Marshal(v1alpha1.SubstituteTemplateParameters(raw, params))
+	if err != nil {
+		return nil, err
+	}
+
+	exp := &experiment.ExperimentInfo{}
+	if err := json.Unmarshal(data, exp); err != nil {
+		return nil, err
+	}
+
+	if err := binding.Validator.ValidateStruct(exp); err != nil {
+		return nil, err
+	}
+
+	return exp, nil
+}
+
+func (t *Template) toCore() (*core.ExperimentTemplate, error) {
+	params, err := json.Marshal(t.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.ExperimentTemplate{
+		Name:        t.Name,
+		Description: t.Description,
+		Parameters:  string(params),
+		Experiment:  string(t.Experiment),
+	}, nil
+}
+
+func fromCore(tpl *core.ExperimentTemplate) (*Template, error) {
+	t := &Template{
+		Name:        tpl.Name,
+		Description: tpl.Description,
+		Parameters:  make([]Parameter, 0),
+		Experiment:  json.RawMessage(tpl.Experiment),
+	}
+
+	if tpl.Parameters != "" {
+		if err := json.Unmarshal([]byte(tpl.Parameters), &t.Parameters); err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
@@ -27,6 +27,8 @@ var (
 	ErrInvalidRequest = ErrNS.NewType("invalid_request")
 	ErrInternalServer = ErrNS.NewType("internal_server_error")
 	ErrNotFound       = ErrNS.NewType("resource_not_found")
+	ErrUnauthorized   = ErrNS.NewType("unauthorized")
+	ErrForbidden      = ErrNS.NewType("forbidden")
 )
 
 type APIError struct {
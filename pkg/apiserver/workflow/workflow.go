@@ -0,0 +1,292 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/auth"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/experiment"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/cireport"
+	"github.com/chaos-mesh/chaos-mesh/pkg/scenario"
+)
+
+var log = ctrl.Log.WithName("workflow api")
+
+const (
+	// LabelTrigger is the label of the chaos with the id of the trigger which creates them
+	LabelTrigger = "chaos-mesh.org/trigger"
+
+	defaultTimeout = 30 * time.Minute
+	pollInterval   = 2 * time.Second
+)
+
+// Service defines a handler service for triggering the workflows from CI pipelines.
+// A workflow is a scenario of the library, the chaos of which run as a suite.
+type Service struct {
+	kubeCli client.Client
+}
+
+// NewService returns a workflow service instance.
+func NewService(cli client.Client) *Service {
+	return &Service{
+		kubeCli: cli,
+	}
+}
+
+// Register mounts our HTTP handler on the mux.
+func Register(r *gin.RouterGroup, s *Service) {
+	endpoint := r.Group("/workflows")
+
+	endpoint.POST("/:name/trigger", s.triggerWorkflow)
+}
+
+// TriggerInfo defines the options to trigger a workflow.
+type TriggerInfo struct {
+	Namespace string `json:"namespace" binding:"required,NameValid"`
+	// Selector selects the target pods, the pods in the namespace are selected if it's empty.
+	Selector experiment.SelectorInfo `json:"selector"`
+	// Parameters override the default values of the parameters of the workflow.
+	Parameters map[string]string `json:"parameters"`
+}
+
+// ChaosReference refers to a chaos created by the trigger.
+type ChaosReference struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+}
+
+// TriggerResult defines the runs of a triggered workflow.
+type TriggerResult struct {
+	Workflow string `json:"workflow"`
+	// ID is the id of the trigger, which is the value of the label chaos-mesh.org/trigger of the chaos.
+	ID    string           `json:"id"`
+	Chaos []ChaosReference `json:"chaos"`
+	// Finished is true if all the chaos finish their runs, it's only set with wait=true.
+	Finished bool `json:"finished"`
+	// Passed is true if all the chaos finish their runs and all the runs pass.
+	Passed bool `json:"passed"`
+	// Reason is the reason why the workflow doesn't finish.
+	Reason  string            `json:"reason,omitempty"`
+	Summary *cireport.Summary `json:"summary,omitempty"`
+}
+
+// @Summary Trigger a workflow.
+// @Description Create the chaos of the workflow with the overridden parameters. With wait=true, the request
+// @Description blocks until the chaos finish their first runs or the timeout, then the chaos are deleted and
+// @Description the verdict of the runs is returned.
+// @Tags workflows
+// @Produce json
+// @Param name path string true "the name of workflow"
+// @Param wait query bool false "wait for the verdict"
+// @Param timeout query string false "the timeout of waiting, default to 30m"
+// @Param request body TriggerInfo true "Request body"
+// @Success 200 {object} TriggerResult
+// @Failure 400 {object} utils.APIError
+// @Failure 403 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /api/workflows/{name}/trigger [post]
+func (s *Service) triggerWorkflow(c *gin.Context) {
+	info := &TriggerInfo{}
+	if err := c.ShouldBindJSON(info); err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	block := c.Query("wait") == "true"
+	timeout := defaultTimeout
+	if value := c.Query("timeout"); value != "" {
+		var err error
+		if timeout, err = v1alpha1.ParseDuration(value); err != nil || timeout <= 0 {
+			c.Status(http.StatusBadRequest)
+			_ = c.Error(utils.ErrInvalidRequest.New("timeout %s is invalid", value))
+			return
+		}
+	}
+
+	name := c.Param("name")
+	sc, ok := scenario.Get(name)
+	if !ok {
+		c.Status(http.StatusNotFound)
+		_ = c.Error(utils.ErrNotFound.New("the workflow %s is not found", name))
+		return
+	}
+
+	// each trigger is a new run of the workflow, so the chaos get unique names
+	id := utilrand.String(5)
+	chaos, err := sc.Generate(&scenario.Options{
+		Namespace:  info.Namespace,
+		Name:       fmt.Sprintf("%s-%s", sc.Name, id),
+		Selector:   info.Selector.ParseSelector(),
+		Parameters: info.Parameters,
+	})
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	// all the chaos and the namespaces they target must be allowed before any of them is created,
+	// and they're deleted after waiting
+	for _, ch := range chaos {
+		kind := ch.GetChaos().Kind
+		if !auth.Authorize(c, "create", kind, info.Namespace) || !auth.AuthorizeChaosTargets(c, kind, ch.(runtime.Object)) {
+			return
+		}
+		if block && !auth.Authorize(c, "delete", kind, info.Namespace) {
+			return
+		}
+	}
+
+	// the chaos are created as the caller, who is recorded as their creator
+	cli, err := auth.ClientFor(c, s.kubeCli)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	result := &TriggerResult{Workflow: sc.Name, ID: id}
+	ctx := context.Background()
+	for i, ch := range chaos {
+		meta := ch.(metav1.Object)
+		meta.GetLabels()[LabelTrigger] = id
+		if err := cli.Create(ctx, ch.(runtime.Object)); err != nil {
+			// the workflow isn't run partially, so the chaos created so far are deleted
+			if err := s.deleteChaos(ctx, chaos[:i]); err != nil {
+				log.Error(err, "failed to delete the chaos of workflow", "workflow", sc.Name, "id", id)
+			}
+			if apierrors.IsForbidden(err) {
+				c.Status(http.StatusForbidden)
+				_ = c.Error(utils.ErrForbidden.WrapWithNoMessage(err))
+				return
+			}
+			c.Status(http.StatusInternalServerError)
+			_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+			return
+		}
+		result.Chaos = append(result.Chaos, ChaosReference{
+			Kind:      ch.GetChaos().Kind,
+			Namespace: meta.GetNamespace(),
+			Name:      meta.GetName(),
+			UID:       string(meta.GetUID()),
+		})
+	}
+
+	if !block {
+		c.JSON(http.StatusOK, result)
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+	defer cancel()
+	results, err := s.waitForRuns(waitCtx, info.Namespace, result.Chaos)
+	if err != nil {
+		result.Reason = err.Error()
+	} else {
+		result.Finished = true
+	}
+	result.Summary = cireport.Summarize(results)
+	result.Passed = result.Finished && result.Summary.Passed
+
+	// the triggered runs are one-off, the chaos are archived and their results are kept
+	if err := s.deleteChaos(ctx, chaos); err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// deleteChaos deletes the chaos created by a trigger, the ones which are not found are ignored
+func (s *Service) deleteChaos(ctx context.Context, chaos []v1alpha1.InnerObject) error {
+	for _, ch := range chaos {
+		if err := s.kubeCli.Delete(ctx, ch.(runtime.Object)); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForRuns waits until each chaos finishes its first run, it returns the first runs of the chaos so far
+// and an error if any of them doesn't finish before the context is done
+func (s *Service) waitForRuns(ctx context.Context, namespace string, chaos []ChaosReference) ([]v1alpha1.ChaosResult, error) {
+	var runs []v1alpha1.ChaosResult
+	err := wait.PollImmediateUntil(pollInterval, func() (bool, error) {
+		runs = runs[:0]
+		finished := true
+		for _, ch := range chaos {
+			var list v1alpha1.ChaosResultList
+			if err := s.kubeCli.List(context.Background(), &list, client.InNamespace(namespace),
+				client.MatchingLabels{v1alpha1.LabelExperimentUID: ch.UID}); err != nil {
+				return false, err
+			}
+
+			run := firstRun(list.Items)
+			if run == nil {
+				finished = false
+				continue
+			}
+			runs = append(runs, *run)
+			if !run.IsFinished() {
+				finished = false
+			}
+		}
+		return finished, nil
+	}, ctx.Done())
+	if err == wait.ErrWaitTimeout {
+		return runs, fmt.Errorf("%d of %d chaos finished their runs: %v", countFinished(runs), len(chaos), ctx.Err())
+	}
+	return runs, err
+}
+
+// firstRun returns the earliest run in the results, or nil if there is none
+func firstRun(results []v1alpha1.ChaosResult) *v1alpha1.ChaosResult {
+	var first *v1alpha1.ChaosResult
+	for i := range results {
+		if first == nil || results[i].Spec.StartTime.Before(&first.Spec.StartTime) {
+			first = &results[i]
+		}
+	}
+	return first
+}
+
+func countFinished(runs []v1alpha1.ChaosResult) int {
+	count := 0
+	for i := range runs {
+		if runs[i].IsFinished() {
+			count++
+		}
+	}
+	return count
+}
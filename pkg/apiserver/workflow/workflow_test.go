@@ -0,0 +1,237 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apivalidator"
+)
+
+// chaosClient runs the chaos like the controller: every chaos created gets a uid and, if verdict is set,
+// the result of its first run. The creation fails with failure after the chaos of failAfter are created.
+type chaosClient struct {
+	client.Client
+
+	verdict   v1alpha1.ChaosVerdict
+	failAfter int
+	failure   error
+	created   int
+}
+
+func (c *chaosClient) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOption) error {
+	if c.failAfter > 0 && c.created == c.failAfter {
+		if c.failure != nil {
+			return c.failure
+		}
+		return fmt.Errorf("failed to create chaos")
+	}
+	c.created++
+
+	meta := obj.(metav1.Object)
+	meta.SetUID(types.UID(fmt.Sprintf("uid-%d", c.created)))
+	if err := c.Client.Create(ctx, obj, opts...); err != nil {
+		return err
+	}
+	if c.verdict == "" {
+		return nil
+	}
+
+	result := &v1alpha1.ChaosResult{}
+	result.Namespace = meta.GetNamespace()
+	result.Name = meta.GetName() + "-1"
+	result.Labels = map[string]string{v1alpha1.LabelExperimentUID: string(meta.GetUID())}
+	result.Spec.StartTime = metav1.Now()
+	result.Status.Verdict = c.verdict
+	return c.Client.Create(ctx, result)
+}
+
+func newTestService() (*Service, *chaosClient) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+
+	cli := &chaosClient{Client: fake.NewFakeClientWithScheme(scheme)}
+	return NewService(cli), cli
+}
+
+func trigger(s *Service, name, query string, info *TriggerInfo) (*httptest.ResponseRecorder, *TriggerResult) {
+	gin.SetMode(gin.TestMode)
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		_ = v.RegisterValidation("NameValid", apivalidator.NameValid)
+		_ = v.RegisterValidation("NamespaceSelectorsValid", apivalidator.NamespaceSelectorsValid)
+		_ = v.RegisterValidation("MapSelectorsValid", apivalidator.MapSelectorsValid)
+		_ = v.RegisterValidation("PhaseSelectorsValid", apivalidator.PhaseSelectorsValid)
+		_ = v.RegisterValidation("PodsValid", apivalidator.PodsValid)
+	}
+	r := gin.New()
+	r.Use(utils.MWHandleErrors())
+	Register(&r.RouterGroup, s)
+
+	body, _ := json.Marshal(info)
+	req := httptest.NewRequest(http.MethodPost, "/workflows/"+name+"/trigger"+query, bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	result := &TriggerResult{}
+	if w.Code == http.StatusOK {
+		_ = json.Unmarshal(w.Body.Bytes(), result)
+	}
+	return w, result
+}
+
+func listChaos(g *GomegaWithT, s *Service) (pods []v1alpha1.PodChaos, networks []v1alpha1.NetworkChaos) {
+	var podList v1alpha1.PodChaosList
+	g.Expect(s.kubeCli.List(context.TODO(), &podList)).To(Succeed())
+	var networkList v1alpha1.NetworkChaosList
+	g.Expect(s.kubeCli.List(context.TODO(), &networkList)).To(Succeed())
+	return podList.Items, networkList.Items
+}
+
+func TestTriggerWorkflow(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	s, _ := newTestService()
+	info := &TriggerInfo{Namespace: "app", Parameters: map[string]string{"driver": "ebs.csi.aws.com"}}
+	w, result := trigger(s, "csi-driver", "", info)
+	g.Expect(w.Code).To(Equal(http.StatusOK))
+	g.Expect(result.Workflow).To(Equal("csi-driver"))
+	g.Expect(result.Chaos).To(HaveLen(2))
+	g.Expect(result.Finished).To(BeFalse())
+
+	// the chaos are created with the id of trigger, and kept running without waiting
+	pods, networks := listChaos(g, s)
+	g.Expect(pods).To(HaveLen(1))
+	g.Expect(networks).To(HaveLen(1))
+	g.Expect(pods[0].Namespace).To(Equal("app"))
+	g.Expect(pods[0].Labels[LabelTrigger]).To(Equal(result.ID))
+	g.Expect(result.Chaos[0]).To(Equal(ChaosReference{
+		Kind:      v1alpha1.KindPodChaos,
+		Namespace: "app",
+		Name:      pods[0].Name,
+		UID:       "uid-1",
+	}))
+}
+
+func TestTriggerWorkflowFailed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	s, _ := newTestService()
+	info := &TriggerInfo{Namespace: "app", Parameters: map[string]string{"driver": "ebs.csi.aws.com"}}
+
+	w, _ := trigger(s, "not-found", "", info)
+	g.Expect(w.Code).To(Equal(http.StatusNotFound))
+
+	w, _ = trigger(s, "csi-driver", "", &TriggerInfo{Namespace: "app"})
+	g.Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+	for _, timeout := range []string{"1x", "0s", "-1m"} {
+		w, _ = trigger(s, "csi-driver", "?wait=true&timeout="+timeout, info)
+		g.Expect(w.Code).To(Equal(http.StatusBadRequest), timeout)
+	}
+
+	pods, networks := listChaos(g, s)
+	g.Expect(pods).To(BeEmpty())
+	g.Expect(networks).To(BeEmpty())
+}
+
+func TestTriggerWorkflowCreatedPartially(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	s, cli := newTestService()
+	cli.failAfter = 1
+	info := &TriggerInfo{Namespace: "app", Parameters: map[string]string{"driver": "ebs.csi.aws.com"}}
+	w, _ := trigger(s, "csi-driver", "", info)
+	g.Expect(w.Code).To(Equal(http.StatusInternalServerError))
+
+	// the chaos created before the failure are deleted
+	g.Expect(cli.created).To(Equal(1))
+	pods, networks := listChaos(g, s)
+	g.Expect(pods).To(BeEmpty())
+	g.Expect(networks).To(BeEmpty())
+}
+
+func TestTriggerWorkflowForbidden(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// the caller isn't allowed to create the second chaos by kube-apiserver
+	s, cli := newTestService()
+	cli.failAfter = 1
+	cli.failure = apierrors.NewForbidden(schema.GroupResource{Group: "chaos-mesh.org", Resource: "networkchaos"},
+		"", fmt.Errorf("alice is not allowed"))
+	info := &TriggerInfo{Namespace: "app", Parameters: map[string]string{"driver": "ebs.csi.aws.com"}}
+	w, _ := trigger(s, "csi-driver", "", info)
+	g.Expect(w.Code).To(Equal(http.StatusForbidden))
+
+	pods, networks := listChaos(g, s)
+	g.Expect(pods).To(BeEmpty())
+	g.Expect(networks).To(BeEmpty())
+}
+
+func TestTriggerWorkflowAndWait(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type TestCase struct {
+		name     string
+		verdict  v1alpha1.ChaosVerdict
+		timeout  string
+		finished bool
+		passed   bool
+	}
+
+	tcs := []TestCase{
+		{name: "passed", verdict: v1alpha1.VerdictPassed, timeout: "1d", finished: true, passed: true},
+		{name: "failed", verdict: v1alpha1.VerdictFailed, timeout: "1w", finished: true},
+		{name: "timeout", verdict: v1alpha1.VerdictRunning, timeout: "100ms"},
+	}
+
+	for _, tc := range tcs {
+		s, cli := newTestService()
+		cli.verdict = tc.verdict
+		info := &TriggerInfo{Namespace: "app"}
+		w, result := trigger(s, "rolling-pod-kill", "?wait=true&timeout="+tc.timeout, info)
+		g.Expect(w.Code).To(Equal(http.StatusOK), tc.name)
+		g.Expect(result.Finished).To(Equal(tc.finished), tc.name)
+		g.Expect(result.Passed).To(Equal(tc.passed), tc.name)
+		g.Expect(result.Summary.Total).To(Equal(1), tc.name)
+		if !tc.finished {
+			g.Expect(result.Reason).To(ContainSubstring("0 of 1 chaos finished"), tc.name)
+		}
+
+		// the chaos are deleted after waiting
+		pods, _ := listChaos(g, s)
+		g.Expect(pods).To(BeEmpty(), tc.name)
+	}
+}
@@ -15,10 +15,11 @@ package apivalidator
 
 import (
 	"regexp"
-	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/robfig/cron/v3"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 )
 
 // NameValid can be used to check whether the given name is valid.
@@ -67,7 +68,7 @@ func DurationValid(fl validator.FieldLevel) bool {
 		return true
 	}
 
-	_, err := time.ParseDuration(dur)
+	_, err := v1alpha1.ParseDuration(dur)
 	if err != nil {
 		return false
 	}
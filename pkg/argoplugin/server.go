@@ -0,0 +1,463 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argoplugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hashicorp/go-multierror"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+)
+
+var log = ctrl.Log.WithName("argo-plugin")
+
+// RequeueInterval is the interval between two checks of the chaos of a running step
+var RequeueInterval = 10 * time.Second
+
+var workflowGVK = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Workflow"}
+
+type createdChaos struct {
+	chaos    v1alpha1.InnerObject
+	workflow WorkflowMeta
+}
+
+// Server is the executor plugin of Argo Workflows, which runs the chaos declared inline by the steps
+type Server struct {
+	client client.Client
+	token  string
+
+	mu sync.Mutex
+	// created are the chaos created by the server, which are aborted if their workflows are cancelled
+	created map[types.NamespacedName]createdChaos
+}
+
+// NewServer returns a plugin server, the requests must have the bearer token unless it's empty
+func NewServer(c client.Client, token string) *Server {
+	return &Server{
+		client:  c,
+		token:   token,
+		created: make(map[types.NamespacedName]createdChaos),
+	}
+}
+
+// Handler returns the http handler of the plugin api
+func (s *Server) Handler() http.Handler {
+	gin.SetMode(gin.ReleaseMode)
+	r := gin.New()
+	r.Use(gin.Recovery(), utils.MWHandleErrors())
+
+	r.GET("/healthz", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	r.POST("/api/v1/template.execute", s.authenticate, s.executeTemplate)
+
+	return r
+}
+
+func (s *Server) authenticate(c *gin.Context) {
+	if s.token != "" && c.GetHeader("Authorization") != "Bearer "+s.token {
+		c.Status(http.StatusUnauthorized)
+		_ = c.Error(utils.ErrUnauthorized.New("the token is invalid"))
+		c.Abort()
+	}
+}
+
+func (s *Server) executeTemplate(c *gin.Context) {
+	args := &ExecuteTemplateArgs{}
+	if err := c.ShouldBindJSON(args); err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	reply, err := s.Execute(c.Request.Context(), args)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		_ = c.Error(utils.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, reply)
+}
+
+// Execute creates the chaos of the step if it doesn't exist, and returns the state of the step. The chaos
+// is deleted and the step fails once the workflow is cancelled.
+func (s *Server) Execute(ctx context.Context, args *ExecuteTemplateArgs) (*ExecuteTemplateReply, error) {
+	raw, ok := args.Template.Plugin[PluginName]
+	if !ok {
+		return &ExecuteTemplateReply{}, nil
+	}
+
+	spec := &Spec{}
+	if err := json.Unmarshal(raw, spec); err != nil {
+		return reply(NodeError, fmt.Sprintf("invalid plugin spec: %v", err), nil), nil
+	}
+	if spec.WaitFor == "" {
+		spec.WaitFor = WaitForFinished
+	}
+	if spec.WaitFor != WaitForInjected && spec.WaitFor != WaitForFinished {
+		return reply(NodeError, fmt.Sprintf("waitFor %s is not supported", spec.WaitFor), nil), nil
+	}
+
+	workflow := args.Workflow.ObjectMeta
+	chaos, err := newChaos(spec, workflow, args.Template.Name)
+	if err != nil {
+		return reply(NodeError, err.Error(), nil), nil
+	}
+	instance := chaos.GetChaos()
+	key := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+
+	existing := chaos.(runtime.Object).DeepCopyObject()
+	err = s.client.Get(ctx, key, existing)
+	exists := err == nil
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if exists && existing.(metav1.Object).GetLabels()[LabelWorkflowUID] != workflow.UID {
+		return reply(NodeFailed, fmt.Sprintf("%s %s already exists and isn't created by the workflow", instance.Kind, key), nil), nil
+	}
+
+	cancelled, err := s.cancelled(ctx, workflow)
+	if err != nil {
+		return nil, err
+	}
+	if cancelled != "" {
+		if exists {
+			if err := s.abort(ctx, existing.(v1alpha1.InnerObject)); err != nil {
+				return nil, err
+			}
+		}
+		return reply(NodeFailed, fmt.Sprintf("%s %s is aborted since %s", instance.Kind, key, cancelled), nil), nil
+	}
+
+	if !exists {
+		// the chaos is garbage collected with the workflow
+		meta := chaos.(metav1.Object)
+		labels := meta.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[LabelWorkflowUID] = workflow.UID
+		meta.SetLabels(labels)
+		meta.SetOwnerReferences(append(meta.GetOwnerReferences(), metav1.OwnerReference{
+			APIVersion: workflowGVK.GroupVersion().String(),
+			Kind:       workflowGVK.Kind,
+			Name:       workflow.Name,
+			UID:        types.UID(workflow.UID),
+		}))
+
+		denied, err := s.authorize(ctx, chaos)
+		if err != nil {
+			return nil, err
+		}
+		if denied != "" {
+			return reply(NodeFailed, denied, nil), nil
+		}
+
+		log.Info("Create chaos", "kind", instance.Kind, "chaos", key, "workflow", workflow.Name)
+		if err := s.client.Create(ctx, chaos.(runtime.Object)); err != nil {
+			if apierrors.IsInvalid(err) || apierrors.IsBadRequest(err) {
+				return reply(NodeError, err.Error(), nil), nil
+			}
+			return nil, err
+		}
+		s.track(key, chaos, workflow)
+		return reply(NodeRunning, fmt.Sprintf("%s %s is created", instance.Kind, key), outputsOf(chaos, "")), nil
+	}
+
+	s.track(key, existing.(v1alpha1.InnerObject), workflow)
+	return s.evaluate(ctx, existing.(v1alpha1.InnerObject), spec.WaitFor)
+}
+
+// authorize returns the reason if the plugin, which runs as the service account of the workflow, isn't allowed
+// to create the chaos in any namespace it targets. The chaos is created in the namespace of the workflow, but its
+// selectors may reach the other namespaces, which the workflow mustn't inject chaos into beyond its permission.
+func (s *Server) authorize(ctx context.Context, chaos v1alpha1.InnerObject) (string, error) {
+	// the selectors are checked as defaulted by the webhook, which selects the namespace of chaos if it's not set
+	defaulted := chaos.(runtime.Object).DeepCopyObject()
+	if defaulter, ok := defaulted.(interface{ Default() }); ok {
+		defaulter.Default()
+	}
+	selectorObject, ok := defaulted.(v1alpha1.SelectorObject)
+	if !ok {
+		return "", nil
+	}
+
+	kind := chaos.GetChaos().Kind
+	for _, namespace := range common.SelectorNamespaces(selectorObject.GetSelectorSpecs()...) {
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: namespace,
+					Verb:      "create",
+					Group:     v1alpha1.GroupVersion.Group,
+					Resource:  strings.ToLower(kind),
+				},
+			},
+		}
+		if err := s.client.Create(ctx, review); err != nil {
+			return "", err
+		}
+
+		if !review.Status.Allowed {
+			scope := "namespace " + namespace
+			if namespace == "" {
+				scope = "all namespaces"
+			}
+			return fmt.Sprintf("the workflow is not allowed to create %s in %s", kind, scope), nil
+		}
+	}
+	return "", nil
+}
+
+// AbortCancelled deletes the chaos created by the server whose workflows are cancelled, it's called when
+// the plugin exits with the workflow
+func (s *Server) AbortCancelled(ctx context.Context) error {
+	s.mu.Lock()
+	created := make([]createdChaos, 0, len(s.created))
+	for _, c := range s.created {
+		created = append(created, c)
+	}
+	s.mu.Unlock()
+
+	var result error
+	for _, c := range created {
+		cancelled, err := s.cancelled(ctx, c.workflow)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+		if cancelled == "" {
+			continue
+		}
+		if err := s.abort(ctx, c.chaos); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	return result
+}
+
+func (s *Server) track(key types.NamespacedName, chaos v1alpha1.InnerObject, workflow WorkflowMeta) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.created[key] = createdChaos{chaos: chaos, workflow: workflow}
+}
+
+func (s *Server) abort(ctx context.Context, chaos v1alpha1.InnerObject) error {
+	instance := chaos.GetChaos()
+	log.Info("Abort chaos of cancelled workflow", "kind", instance.Kind, "namespace", instance.Namespace, "name", instance.Name)
+	if err := s.client.Delete(ctx, chaos.(runtime.Object)); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.created, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name})
+	return nil
+}
+
+// cancelled returns the reason if the workflow is cancelled, that is deleted, shut down or failed
+func (s *Server) cancelled(ctx context.Context, workflow WorkflowMeta) (string, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(workflowGVK)
+	err := s.client.Get(ctx, types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, obj)
+	if apierrors.IsNotFound(err) {
+		return "the workflow is deleted", nil
+	}
+	if meta.IsNoMatchError(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if string(obj.GetUID()) != workflow.UID {
+		return "the workflow is deleted", nil
+	}
+	if obj.GetDeletionTimestamp() != nil {
+		return "the workflow is being deleted", nil
+	}
+	if shutdown, _, _ := unstructured.NestedString(obj.Object, "spec", "shutdown"); shutdown != "" {
+		return fmt.Sprintf("the workflow is shut down by %s", shutdown), nil
+	}
+	if phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase"); phase == NodeFailed || phase == NodeError {
+		return fmt.Sprintf("the workflow is %s", strings.ToLower(phase)), nil
+	}
+	return "", nil
+}
+
+// evaluate returns the state of the step by the state of the chaos and the verdict of its first run
+func (s *Server) evaluate(ctx context.Context, chaos v1alpha1.InnerObject, waitFor string) (*ExecuteTemplateReply, error) {
+	instance := chaos.GetChaos()
+	name := instance.Kind + " " + instance.Namespace + "/" + instance.Name
+	status := chaos.GetStatus().Experiment
+
+	switch {
+	case status.Phase == v1alpha1.ExperimentPhaseFailed:
+		message := fmt.Sprintf("%s failed: %s", name, status.Reason)
+		if len(status.FailedRecords) > 0 {
+			record := status.FailedRecords[0]
+			message = fmt.Sprintf("%s failed on pod %s/%s: %s", name, record.Namespace, record.Name, record.Error)
+		}
+		return reply(NodeFailed, message, outputsOf(chaos, "")), nil
+	case waitFor == WaitForInjected && (len(status.PodRecords) > 0 || status.Phase == v1alpha1.ExperimentPhaseFinished):
+		return reply(NodeSucceeded, fmt.Sprintf("%s is injected into %d pods", name, len(status.PodRecords)), outputsOf(chaos, "")), nil
+	case waitFor == WaitForFinished && status.Phase == v1alpha1.ExperimentPhaseFinished:
+		var list v1alpha1.ChaosResultList
+		if err := s.client.List(ctx, &list, client.InNamespace(instance.Namespace),
+			client.MatchingLabels{v1alpha1.LabelExperimentUID: string(chaos.(metav1.Object).GetUID())}); err != nil {
+			return nil, err
+		}
+		run := firstRun(list.Items)
+		if run == nil {
+			// the run isn't recorded, the chaos is judged by its phase
+			return reply(NodeSucceeded, fmt.Sprintf("%s is finished", name), outputsOf(chaos, "")), nil
+		}
+		if !run.IsFinished() {
+			break
+		}
+
+		outputs := outputsOf(chaos, run.Status.Verdict)
+		if run.Status.Verdict != v1alpha1.VerdictPassed {
+			return reply(NodeFailed, fmt.Sprintf("the run %s of %s is %s: %s",
+				run.Name, name, strings.ToLower(string(run.Status.Verdict)), run.Status.Reason), outputs), nil
+		}
+		return reply(NodeSucceeded, fmt.Sprintf("the run %s of %s passed", run.Name, name), outputs), nil
+	}
+
+	phase := status.Phase
+	if phase == "" {
+		phase = v1alpha1.ExperimentPhaseWaiting
+	}
+	return reply(NodeRunning, fmt.Sprintf("%s is %s", name, strings.ToLower(string(phase))), outputsOf(chaos, "")), nil
+}
+
+// newChaos decodes the chaos declared inline by the step
+func newChaos(spec *Spec, workflow WorkflowMeta, template string) (v1alpha1.InnerObject, error) {
+	if len(spec.Chaos) == 0 {
+		return nil, fmt.Errorf("chaos is required")
+	}
+
+	typeMeta := &metav1.TypeMeta{}
+	if err := json.Unmarshal(spec.Chaos, typeMeta); err != nil {
+		return nil, fmt.Errorf("invalid chaos: %v", err)
+	}
+	if typeMeta.APIVersion != v1alpha1.GroupVersion.String() {
+		return nil, fmt.Errorf("apiVersion %s is not supported, it must be %s", typeMeta.APIVersion, v1alpha1.GroupVersion)
+	}
+	kind, ok := v1alpha1.AllKinds()[typeMeta.Kind]
+	if !ok {
+		return nil, fmt.Errorf("kind %s is not supported", typeMeta.Kind)
+	}
+
+	obj := kind.Chaos.DeepCopyObject()
+	if err := json.Unmarshal(spec.Chaos, obj); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", typeMeta.Kind, err)
+	}
+	meta := obj.(metav1.Object)
+	if meta.GetNamespace() == "" {
+		meta.SetNamespace(workflow.Namespace)
+	}
+	if meta.GetName() == "" {
+		meta.SetName(strings.ToLower(workflow.Name + "-" + template))
+	}
+	return obj.(v1alpha1.InnerObject), nil
+}
+
+// firstRun returns the earliest run in the results, or nil if there is none
+func firstRun(results []v1alpha1.ChaosResult) *v1alpha1.ChaosResult {
+	var first *v1alpha1.ChaosResult
+	for i := range results {
+		if first == nil || results[i].Spec.StartTime.Before(&first.Spec.StartTime) {
+			first = &results[i]
+		}
+	}
+	return first
+}
+
+func outputsOf(chaos v1alpha1.InnerObject, verdict v1alpha1.ChaosVerdict) *Outputs {
+	status := chaos.GetStatus().Experiment
+	return &Outputs{Parameters: []Parameter{
+		{Name: "name", Value: chaos.GetChaos().Name},
+		{Name: "phase", Value: string(status.Phase)},
+		{Name: "targets", Value: strconv.Itoa(len(status.PodRecords))},
+		{Name: "verdict", Value: string(verdict)},
+	}}
+}
+
+func reply(phase string, message string, outputs *Outputs) *ExecuteTemplateReply {
+	r := &ExecuteTemplateReply{Node: &NodeResult{Phase: phase, Message: message, Outputs: outputs}}
+	if phase == NodeRunning {
+		r.Requeue = &metav1.Duration{Duration: RequeueInterval}
+	}
+	return r
+}
+
+// Config is the config of the plugin server
+type Config struct {
+	Host string
+	Port int
+}
+
+// StartServer starts the plugin server, and aborts the chaos of the cancelled workflows when ctx is done
+func StartServer(ctx context.Context, conf *Config, s *Server) error {
+	server := &http.Server{
+		Addr:    fmt.Sprintf("%s:%d", conf.Host, conf.Port),
+		Handler: s.Handler(),
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Info("Starting argo workflows plugin", "addr", server.Addr)
+		errCh <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Info("Shutting down argo workflows plugin")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Error(err, "failed to shut down http server")
+	}
+	return s.AbortCancelled(shutdownCtx)
+}
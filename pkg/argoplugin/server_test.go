@@ -0,0 +1,205 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argoplugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+const podChaos = `{
+	"apiVersion": "chaos-mesh.org/v1alpha1",
+	"kind": "PodChaos",
+	"spec": {"action": "pod-kill", "mode": "one", "selector": {"namespaces": ["shop"]}}
+}`
+
+func newWorkflow() *unstructured.Unstructured {
+	workflow := &unstructured.Unstructured{}
+	workflow.SetGroupVersionKind(workflowGVK)
+	workflow.SetNamespace("argo")
+	workflow.SetName("release")
+	workflow.SetUID("workflow-uid")
+	return workflow
+}
+
+func newArgs(waitFor string) *ExecuteTemplateArgs {
+	spec, _ := json.Marshal(map[string]interface{}{"chaos": json.RawMessage(podChaos), "waitFor": waitFor})
+	return &ExecuteTemplateArgs{
+		Workflow: &Workflow{ObjectMeta: WorkflowMeta{Namespace: "argo", Name: "release", UID: "workflow-uid"}},
+		Template: &Template{Name: "kill-pod", Plugin: map[string]json.RawMessage{PluginName: spec}},
+	}
+}
+
+// reviewClient allows the plugin to create the chaos in the namespaces of allowed
+type reviewClient struct {
+	client.Client
+
+	allowed []string
+}
+
+func (c *reviewClient) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOption) error {
+	review, ok := obj.(*authorizationv1.SelfSubjectAccessReview)
+	if !ok {
+		return c.Client.Create(ctx, obj, opts...)
+	}
+	for _, ns := range c.allowed {
+		review.Status.Allowed = review.Status.Allowed || ns == review.Spec.ResourceAttributes.Namespace
+	}
+	return nil
+}
+
+func newFakeClient(objs ...runtime.Object) *reviewClient {
+	scheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(scheme)
+	return &reviewClient{Client: fake.NewFakeClientWithScheme(scheme, objs...), allowed: []string{"argo", "shop"}}
+}
+
+func TestExecute(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	c := newFakeClient(newWorkflow())
+	s := NewServer(c, "")
+
+	// the template of other plugins isn't handled
+	reply, err := s.Execute(ctx, &ExecuteTemplateArgs{Workflow: &Workflow{}, Template: &Template{}})
+	g.Expect(err).To(BeNil())
+	g.Expect(reply.Node).To(BeNil())
+
+	reply, err = s.Execute(ctx, newArgs(""))
+	g.Expect(err).To(BeNil())
+	g.Expect(reply.Node.Phase).To(Equal(NodeRunning))
+	g.Expect(reply.Requeue.Duration).To(Equal(RequeueInterval))
+
+	chaos := &v1alpha1.PodChaos{}
+	key := types.NamespacedName{Namespace: "argo", Name: "release-kill-pod"}
+	g.Expect(c.Get(ctx, key, chaos)).To(Succeed())
+	g.Expect(chaos.Labels[LabelWorkflowUID]).To(Equal("workflow-uid"))
+	g.Expect(chaos.OwnerReferences).To(HaveLen(1))
+	g.Expect(chaos.OwnerReferences[0].Kind).To(Equal("Workflow"))
+
+	// the step succeeds once the first run of the chaos passes
+	chaos.UID = "chaos-uid"
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
+	chaos.Status.Experiment.PodRecords = []v1alpha1.PodStatus{{Namespace: "shop", Name: "web-0"}}
+	g.Expect(c.Update(ctx, chaos)).To(Succeed())
+	result := &v1alpha1.ChaosResult{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "argo",
+		Name:      "release-kill-pod-1",
+		Labels:    map[string]string{v1alpha1.LabelExperimentUID: "chaos-uid"},
+	}}
+	result.Status.Verdict = v1alpha1.VerdictRunning
+	g.Expect(c.Create(ctx, result)).To(Succeed())
+
+	reply, err = s.Execute(ctx, newArgs(WaitForFinished))
+	g.Expect(err).To(BeNil())
+	g.Expect(reply.Node.Phase).To(Equal(NodeRunning))
+
+	result.Finish(v1alpha1.VerdictFailed, "probe checkout failed", metav1.NewTime(time.Now()))
+	g.Expect(c.Update(ctx, result)).To(Succeed())
+	reply, err = s.Execute(ctx, newArgs(WaitForFinished))
+	g.Expect(err).To(BeNil())
+	g.Expect(reply.Node.Phase).To(Equal(NodeFailed))
+	g.Expect(reply.Node.Message).To(ContainSubstring("probe checkout failed"))
+	g.Expect(reply.Node.Outputs.Parameters).To(ContainElement(Parameter{Name: "verdict", Value: "Failed"}))
+	g.Expect(reply.Node.Outputs.Parameters).To(ContainElement(Parameter{Name: "targets", Value: "1"}))
+
+	reply, err = s.Execute(ctx, newArgs(WaitForInjected))
+	g.Expect(err).To(BeNil())
+	g.Expect(reply.Node.Phase).To(Equal(NodeSucceeded))
+	g.Expect(reply.Requeue).To(BeNil())
+
+	reply, err = s.Execute(ctx, newArgs("recovered"))
+	g.Expect(err).To(BeNil())
+	g.Expect(reply.Node.Phase).To(Equal(NodeError))
+}
+
+func TestExecuteBeyondPermission(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	c := newFakeClient(newWorkflow())
+	c.allowed = []string{"argo"}
+	s := NewServer(c, "")
+
+	// the workflow can't inject the chaos into the namespaces it isn't allowed to
+	reply, err := s.Execute(ctx, newArgs(""))
+	g.Expect(err).To(BeNil())
+	g.Expect(reply.Node.Phase).To(Equal(NodeFailed))
+	g.Expect(reply.Node.Message).To(Equal("the workflow is not allowed to create PodChaos in namespace shop"))
+
+	key := types.NamespacedName{Namespace: "argo", Name: "release-kill-pod"}
+	g.Expect(apierrors.IsNotFound(c.Get(ctx, key, &v1alpha1.PodChaos{}))).To(BeTrue())
+}
+
+func TestAbortCancelledWorkflow(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	workflow := newWorkflow()
+	c := newFakeClient(workflow)
+	s := NewServer(c, "")
+
+	reply, err := s.Execute(ctx, newArgs(""))
+	g.Expect(err).To(BeNil())
+	g.Expect(reply.Node.Phase).To(Equal(NodeRunning))
+	g.Expect(s.AbortCancelled(ctx)).To(Succeed())
+
+	key := types.NamespacedName{Namespace: "argo", Name: "release-kill-pod"}
+	g.Expect(c.Get(ctx, key, &v1alpha1.PodChaos{})).To(Succeed())
+
+	g.Expect(unstructured.SetNestedField(workflow.Object, "Terminate", "spec", "shutdown")).To(Succeed())
+	g.Expect(c.Update(ctx, workflow)).To(Succeed())
+	g.Expect(s.AbortCancelled(ctx)).To(Succeed())
+	g.Expect(apierrors.IsNotFound(c.Get(ctx, key, &v1alpha1.PodChaos{}))).To(BeTrue())
+
+	// the later executions of the step fail
+	reply, err = s.Execute(ctx, newArgs(""))
+	g.Expect(err).To(BeNil())
+	g.Expect(reply.Node.Phase).To(Equal(NodeFailed))
+	g.Expect(reply.Node.Message).To(ContainSubstring("shut down by Terminate"))
+}
+
+func TestAuthenticate(t *testing.T) {
+	g := NewGomegaWithT(t)
+	server := httptest.NewServer(NewServer(newFakeClient(newWorkflow()), "secret").Handler())
+	defer server.Close()
+
+	body, _ := json.Marshal(newArgs(""))
+	post := func(token string) int {
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/api/v1/template.execute", bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		g.Expect(err).To(BeNil())
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	g.Expect(post("wrong")).To(Equal(http.StatusUnauthorized))
+	g.Expect(post("secret")).To(Equal(http.StatusOK))
+}
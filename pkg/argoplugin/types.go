@@ -0,0 +1,105 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argoplugin
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultPort is the default port of the plugin server
+	DefaultPort = 4355
+	// DefaultTokenFile is the file of the token, which Argo Workflows mounts into the plugin container
+	DefaultTokenFile = "/var/run/argo/token"
+
+	// PluginName is the key of the plugin in the plugin templates of Argo Workflows
+	PluginName = "chaos-mesh"
+
+	// LabelWorkflowUID is the label of the chaos with the uid of the workflow which creates them
+	LabelWorkflowUID = "chaos-mesh.org/argo-workflow-uid"
+
+	// WaitForInjected makes the step succeed once the chaos is injected
+	WaitForInjected = "injected"
+	// WaitForFinished makes the step succeed once the chaos finishes its run and the run passes
+	WaitForFinished = "finished"
+)
+
+// The phases of the nodes of Argo Workflows
+const (
+	NodeRunning   = "Running"
+	NodeSucceeded = "Succeeded"
+	NodeFailed    = "Failed"
+	NodeError     = "Error"
+)
+
+// ExecuteTemplateArgs is the request of Argo Workflows to execute a plugin template
+type ExecuteTemplateArgs struct {
+	Workflow *Workflow `json:"workflow" binding:"required"`
+	Template *Template `json:"template" binding:"required"`
+}
+
+// Workflow is the workflow which the template belongs to
+type Workflow struct {
+	ObjectMeta WorkflowMeta `json:"metadata"`
+}
+
+// WorkflowMeta is the metadata of the workflow
+type WorkflowMeta struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	UID       string `json:"uid"`
+}
+
+// Template is the plugin template of a step
+type Template struct {
+	Name   string                     `json:"name"`
+	Plugin map[string]json.RawMessage `json:"plugin"`
+}
+
+// Spec is the spec of the plugin in the template, which is under the key chaos-mesh of the plugin
+type Spec struct {
+	// Chaos is the chaos experiment declared inline. Its namespace defaults to the namespace of
+	// the workflow, and its name defaults to the names of the workflow and the template.
+	Chaos json.RawMessage `json:"chaos"`
+	// WaitFor is injected or finished, default to finished.
+	WaitFor string `json:"waitFor,omitempty"`
+}
+
+// ExecuteTemplateReply is the reply to Argo Workflows, the reply without node means the
+// template isn't handled by the plugin
+type ExecuteTemplateReply struct {
+	Node *NodeResult `json:"node,omitempty"`
+	// Requeue is the interval until the template is executed again to check the chaos
+	Requeue *metav1.Duration `json:"requeue,omitempty"`
+}
+
+// NodeResult is the state of the step
+type NodeResult struct {
+	Phase   string   `json:"phase"`
+	Message string   `json:"message"`
+	Outputs *Outputs `json:"outputs,omitempty"`
+}
+
+// Outputs are the outputs of the step
+type Outputs struct {
+	Parameters []Parameter `json:"parameters"`
+}
+
+// Parameter is an output parameter of the step
+type Parameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
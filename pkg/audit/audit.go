@@ -0,0 +1,171 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var log = ctrl.Log.WithName("audit")
+
+// Operation is the type of the audited operation
+type Operation string
+
+const (
+	// OperationInject means the chaos is injected into the targets
+	OperationInject Operation = "inject"
+	// OperationRecover means the chaos is recovered from the targets
+	OperationRecover Operation = "recover"
+)
+
+// Outcome is the result of the audited operation
+type Outcome string
+
+const (
+	// OutcomeSuccess means the operation succeeded
+	OutcomeSuccess Outcome = "success"
+	// OutcomeFailure means the operation failed
+	OutcomeFailure Outcome = "failure"
+)
+
+// Target is a pod affected by the operation
+type Target struct {
+	Namespace  string   `json:"namespace"`
+	Name       string   `json:"name"`
+	Action     string   `json:"action,omitempty"`
+	Containers []string `json:"containers,omitempty"`
+}
+
+// Record is a structured audit record for an injection or a recovery
+type Record struct {
+	Operation Operation `json:"operation"`
+	// Creator is the user who created the chaos
+	Creator   string    `json:"creator,omitempty"`
+	Kind      string    `json:"kind"`
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	Targets   []Target  `json:"targets"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	Outcome   Outcome   `json:"outcome"`
+	Error     string    `json:"error,omitempty"`
+	// Simulated is true if the operation is simulated, in which the targets are left untouched
+	Simulated bool `json:"simulated,omitempty"`
+}
+
+// Sink writes audit records to a destination
+type Sink interface {
+	Write(ctx context.Context, record *Record) error
+	Close() error
+}
+
+// DefaultQueueSize is the default number of the records queued before they're written
+const DefaultQueueSize = 1000
+
+// Auditor writes every audit record to all of its sinks. The records are queued and written
+// by a background worker, so the chaos operations are never blocked by slow sinks.
+type Auditor struct {
+	sinks []Sink
+	// dropped is called with every record dropped as the queue is full
+	dropped func(record *Record)
+
+	sync.RWMutex
+	closed  bool
+	records chan *Record
+	done    chan struct{}
+}
+
+// NewAuditor creates an Auditor with the sinks and starts its worker. At most queueSize records
+// are queued, the others are dropped and passed to dropped, which may be nil.
+func NewAuditor(queueSize int, dropped func(record *Record), sinks ...Sink) *Auditor {
+	if queueSize <= 0 {
+		queueSize = DefaultQueueSize
+	}
+
+	a := &Auditor{
+		sinks:   sinks,
+		dropped: dropped,
+		records: make(chan *Record, queueSize),
+		done:    make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+// Audit queues the record to be written to all sinks. The record is dropped if the queue is full
+// or the Auditor is closed. Failures of sinks are logged and never block the chaos operation.
+func (a *Auditor) Audit(_ context.Context, record *Record) {
+	if a == nil {
+		return
+	}
+
+	a.RLock()
+	defer a.RUnlock()
+
+	if !a.closed {
+		select {
+		case a.records <- record:
+			return
+		default:
+		}
+	}
+
+	log.Info("audit record is dropped", "kind", record.Kind, "namespace", record.Namespace,
+		"name", record.Name, "operation", record.Operation)
+	if a.dropped != nil {
+		a.dropped(record)
+	}
+}
+
+// run writes the queued records until the queue is closed
+func (a *Auditor) run() {
+	defer close(a.done)
+
+	for record := range a.records {
+		for _, sink := range a.sinks {
+			// the sinks time out by themselves
+			if err := sink.Write(context.Background(), record); err != nil {
+				log.Error(err, "failed to write audit record", "kind", record.Kind,
+					"namespace", record.Namespace, "name", record.Name, "operation", record.Operation)
+			}
+		}
+	}
+}
+
+// Close writes the queued records, then closes all sinks of the Auditor
+func (a *Auditor) Close() error {
+	if a == nil {
+		return nil
+	}
+
+	a.Lock()
+	if !a.closed {
+		a.closed = true
+		close(a.records)
+	}
+	a.Unlock()
+	<-a.done
+
+	var lastErr error
+	for _, sink := range a.sinks {
+		if err := sink.Close(); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
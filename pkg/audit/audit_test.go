@@ -0,0 +1,72 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// blockingSink blocks the writes until it's released
+type blockingSink struct {
+	release chan struct{}
+	written []*Record
+	closed  bool
+}
+
+func (s *blockingSink) Write(_ context.Context, record *Record) error {
+	<-s.release
+	s.written = append(s.written, record)
+	return nil
+}
+
+func (s *blockingSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestAuditorNeverBlocks(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	sink := &blockingSink{release: make(chan struct{})}
+	var dropped []*Record
+	auditor := NewAuditor(2, func(record *Record) {
+		dropped = append(dropped, record)
+	}, sink)
+
+	// the records are queued while the sink is blocked, and dropped once the queue is full
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		auditor.Audit(context.TODO(), newTestRecord())
+	}
+	g.Expect(time.Since(start)).Should(BeNumerically("<", time.Second))
+	// one of the records may be taken by the worker
+	g.Expect(len(dropped)).Should(BeNumerically(">=", 2))
+	g.Expect(len(dropped)).Should(BeNumerically("<=", 3))
+
+	// the queued records are written before the sinks are closed
+	close(sink.release)
+	g.Expect(auditor.Close()).Should(Succeed())
+	g.Expect(sink.written).Should(HaveLen(5 - len(dropped)))
+	g.Expect(sink.closed).Should(BeTrue())
+
+	// the records audited after closing are dropped
+	written := len(sink.written)
+	auditor.Audit(context.TODO(), newTestRecord())
+	g.Expect(sink.written).Should(HaveLen(written))
+	g.Expect(dropped).Should(HaveLen(5 - written + 1))
+}
@@ -0,0 +1,284 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// SinkStdout writes records as JSON lines to stdout
+	SinkStdout = "stdout"
+	// SinkFile appends records as JSON lines to a file
+	SinkFile = "file"
+	// SinkWebhook posts records as JSON to a HTTP endpoint
+	SinkWebhook = "webhook"
+	// SinkKafka produces records to a Kafka topic through a Kafka REST proxy
+	SinkKafka = "kafka"
+	// SinkGrafana posts records as annotations to Grafana
+	SinkGrafana = "grafana"
+
+	defaultSinkTimeout = 10 * time.Second
+)
+
+// Config is the configuration of the audit sinks
+type Config struct {
+	// Sinks is a comma-separated list of sinks, e.g. `stdout,webhook`
+	Sinks          string
+	FilePath       string
+	WebhookURL     string
+	KafkaRESTProxy string
+	KafkaTopic     string
+	GrafanaURL     string
+	GrafanaAPIKey  string
+	// GrafanaDashboardID is the dashboard which the annotations are added to,
+	// the annotations are added to the organization if it is 0
+	GrafanaDashboardID int
+}
+
+// NewSinks creates the sinks enabled in config
+func NewSinks(config Config) ([]Sink, error) {
+	var sinks []Sink
+
+	for _, name := range strings.Split(config.Sinks, ",") {
+		var (
+			sink Sink
+			err  error
+		)
+
+		switch strings.TrimSpace(name) {
+		case "":
+			continue
+		case SinkStdout:
+			sink = NewWriterSink(os.Stdout)
+		case SinkFile:
+			sink, err = NewFileSink(config.FilePath)
+		case SinkWebhook:
+			sink, err = NewWebhookSink(config.WebhookURL)
+		case SinkKafka:
+			sink, err = NewKafkaSink(config.KafkaRESTProxy, config.KafkaTopic)
+		case SinkGrafana:
+			sink, err = NewGrafanaSink(config.GrafanaURL, config.GrafanaAPIKey, config.GrafanaDashboardID)
+		default:
+			err = fmt.Errorf("unknown audit sink %s", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		sinks = append(sinks, sink)
+	}
+
+	return sinks, nil
+}
+
+// writerSink writes records as JSON lines to a writer
+type writerSink struct {
+	sync.Mutex
+	w io.Writer
+	c io.Closer
+}
+
+// NewWriterSink creates a sink which writes records as JSON lines to w
+func NewWriterSink(w io.Writer) Sink {
+	return &writerSink{w: w}
+}
+
+// NewFileSink creates a sink which appends records as JSON lines to the file
+func NewFileSink(path string) (Sink, error) {
+	if path == "" {
+		return nil, fmt.Errorf("file path of audit sink is required")
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &writerSink{w: f, c: f}, nil
+}
+
+func (s *writerSink) Write(_ context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	_, err = s.w.Write(append(data, '\n'))
+	return err
+}
+
+func (s *writerSink) Close() error {
+	if s.c == nil {
+		return nil
+	}
+	return s.c.Close()
+}
+
+// httpSink posts records to a HTTP endpoint
+type httpSink struct {
+	url         string
+	contentType string
+	header      http.Header
+	encode      func(record *Record) ([]byte, error)
+	client      *http.Client
+}
+
+// NewWebhookSink creates a sink which posts every record as JSON to the url
+func NewWebhookSink(url string) (Sink, error) {
+	if url == "" {
+		return nil, fmt.Errorf("url of audit webhook is required")
+	}
+
+	return &httpSink{
+		url:         url,
+		contentType: "application/json",
+		encode: func(record *Record) ([]byte, error) {
+			return json.Marshal(record)
+		},
+		client: &http.Client{Timeout: defaultSinkTimeout},
+	}, nil
+}
+
+// NewKafkaSink creates a sink which produces every record to the topic
+// through the v2 API of a Kafka REST proxy
+func NewKafkaSink(proxy string, topic string) (Sink, error) {
+	if proxy == "" || topic == "" {
+		return nil, fmt.Errorf("kafka REST proxy and topic of audit sink are required")
+	}
+
+	return &httpSink{
+		url:         fmt.Sprintf("%s/topics/%s", strings.TrimSuffix(proxy, "/"), topic),
+		contentType: "application/vnd.kafka.json.v2+json",
+		encode: func(record *Record) ([]byte, error) {
+			return json.Marshal(map[string]interface{}{
+				"records": []map[string]interface{}{
+					{
+						"key":   fmt.Sprintf("%s/%s", record.Namespace, record.Name),
+						"value": record,
+					},
+				},
+			})
+		},
+		client: &http.Client{Timeout: defaultSinkTimeout},
+	}, nil
+}
+
+// NewGrafanaSink creates a sink which posts every record as an annotation to Grafana,
+// so the chaos windows are visible on the dashboards
+func NewGrafanaSink(url string, apiKey string, dashboardID int) (Sink, error) {
+	if url == "" {
+		return nil, fmt.Errorf("url of grafana is required")
+	}
+
+	header := http.Header{}
+	if apiKey != "" {
+		header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	return &httpSink{
+		url:         fmt.Sprintf("%s/api/annotations", strings.TrimSuffix(url, "/")),
+		contentType: "application/json",
+		header:      header,
+		encode: func(record *Record) ([]byte, error) {
+			return json.Marshal(newGrafanaAnnotation(record, dashboardID))
+		},
+		client: &http.Client{Timeout: defaultSinkTimeout},
+	}, nil
+}
+
+// grafanaAnnotation is the request of the annotations API of Grafana
+type grafanaAnnotation struct {
+	DashboardID int      `json:"dashboardId,omitempty"`
+	Time        int64    `json:"time"`
+	Tags        []string `json:"tags"`
+	Text        string   `json:"text"`
+}
+
+func newGrafanaAnnotation(record *Record, dashboardID int) *grafanaAnnotation {
+	event := "started"
+	if record.Operation == OperationRecover {
+		event = "stopped"
+	}
+	if record.Outcome == OutcomeFailure {
+		event = "failed"
+	}
+
+	tags := []string{"chaos-mesh", event, record.Kind, record.Namespace, record.Name}
+	text := fmt.Sprintf("%s %s/%s %s, %d target(s)", record.Kind, record.Namespace, record.Name, event, len(record.Targets))
+	if record.Simulated {
+		tags = append(tags, "simulated")
+		text = fmt.Sprintf("%s (simulated)", text)
+	}
+	if record.Creator != "" {
+		text = fmt.Sprintf("%s, created by %s", text, record.Creator)
+	}
+	if record.Error != "" {
+		text = fmt.Sprintf("%s: %s", text, record.Error)
+	}
+
+	return &grafanaAnnotation{
+		DashboardID: dashboardID,
+		Time:        record.EndTime.UnixNano() / int64(time.Millisecond),
+		Tags:        tags,
+		Text:        text,
+	}
+}
+
+func (s *httpSink) Write(ctx context.Context, record *Record) error {
+	data, err := s.encode(record)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", s.contentType)
+	for key, values := range s.header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to post audit record to %s, status: %s", s.url, resp.Status)
+	}
+
+	return nil
+}
+
+func (s *httpSink) Close() error {
+	return nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func newTestRecord() *Record {
+	return &Record{
+		Operation: OperationInject,
+		Creator:   "admin",
+		Kind:      "PodChaos",
+		Namespace: "default",
+		Name:      "pod-kill",
+		Targets: []Target{
+			{Namespace: "default", Name: "web-0", Action: "pod-kill"},
+		},
+		Outcome: OutcomeSuccess,
+	}
+}
+
+func TestWriterSink(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var buf bytes.Buffer
+	auditor := NewAuditor(DefaultQueueSize, nil, NewWriterSink(&buf))
+	auditor.Audit(context.TODO(), newTestRecord())
+	auditor.Audit(context.TODO(), newTestRecord())
+	g.Expect(auditor.Close()).Should(Succeed())
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	g.Expect(lines).Should(HaveLen(2))
+
+	var record Record
+	g.Expect(json.Unmarshal(lines[0], &record)).Should(Succeed())
+	g.Expect(record.Creator).Should(Equal("admin"))
+	g.Expect(record.Targets).Should(HaveLen(1))
+}
+
+func TestHTTPSinks(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var (
+		contentType string
+		body        []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body, _ = ioutil.ReadAll(r.Body)
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	webhook, err := NewWebhookSink(server.URL)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(webhook.Write(context.TODO(), newTestRecord())).Should(Succeed())
+	g.Expect(contentType).Should(Equal("application/json"))
+	var record Record
+	g.Expect(json.Unmarshal(body, &record)).Should(Succeed())
+	g.Expect(record.Name).Should(Equal("pod-kill"))
+
+	kafka, err := NewKafkaSink(server.URL, "audit")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(kafka.Write(context.TODO(), newTestRecord())).Should(Succeed())
+	g.Expect(contentType).Should(Equal("application/vnd.kafka.json.v2+json"))
+	var produced struct {
+		Records []struct {
+			Key   string `json:"key"`
+			Value Record `json:"value"`
+		} `json:"records"`
+	}
+	g.Expect(json.Unmarshal(body, &produced)).Should(Succeed())
+	g.Expect(produced.Records).Should(HaveLen(1))
+	g.Expect(produced.Records[0].Key).Should(Equal("default/pod-kill"))
+
+	failed, err := NewWebhookSink(server.URL + "/fail")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(failed.Write(context.TODO(), newTestRecord())).ShouldNot(Succeed())
+}
+
+func TestGrafanaSink(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var (
+		path          string
+		authorization string
+		annotation    grafanaAnnotation
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		authorization = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&annotation)
+	}))
+	defer server.Close()
+
+	sink, err := NewGrafanaSink(server.URL+"/", "key", 1)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	record := newTestRecord()
+	g.Expect(sink.Write(context.TODO(), record)).Should(Succeed())
+	g.Expect(path).Should(Equal("/api/annotations"))
+	g.Expect(authorization).Should(Equal("Bearer key"))
+	g.Expect(annotation.DashboardID).Should(Equal(1))
+	g.Expect(annotation.Tags).Should(ContainElement("started"))
+
+	record.Operation = OperationRecover
+	g.Expect(sink.Write(context.TODO(), record)).Should(Succeed())
+	g.Expect(annotation.Tags).Should(ContainElement("stopped"))
+
+	record.Outcome = OutcomeFailure
+	record.Error = "mock error"
+	g.Expect(sink.Write(context.TODO(), record)).Should(Succeed())
+	g.Expect(annotation.Tags).Should(ContainElement("failed"))
+	g.Expect(annotation.Text).Should(ContainSubstring("mock error"))
+
+	record.Simulated = true
+	g.Expect(sink.Write(context.TODO(), record)).Should(Succeed())
+	g.Expect(annotation.Tags).Should(ContainElement("simulated"))
+	g.Expect(annotation.Text).Should(ContainSubstring("(simulated)"))
+}
+
+func TestNewSinks(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	sinks, err := NewSinks(Config{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(sinks).Should(BeEmpty())
+
+	sinks, err = NewSinks(Config{Sinks: "stdout, webhook", WebhookURL: "http://localhost"})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(sinks).Should(HaveLen(2))
+
+	_, err = NewSinks(Config{Sinks: "webhook"})
+	g.Expect(err).Should(HaveOccurred())
+
+	_, err = NewSinks(Config{Sinks: "unknown"})
+	g.Expect(err).Should(HaveOccurred())
+}
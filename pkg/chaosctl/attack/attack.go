@@ -0,0 +1,374 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/scenario"
+)
+
+// LabelKey is set on the chaos created by `chaosctl attack`, so that they can be recovered all at once
+const LabelKey = "chaosctl.chaos-mesh.org/attack"
+
+const pollInterval = time.Second
+
+// Options defines the common options of an attack
+type Options struct {
+	// Namespace is the namespace of the created chaos
+	Namespace string
+	// Name is the name of the created chaos, it's generated if empty
+	Name string
+	// Selector is the label selector of target pods, such as `app=web,tier=frontend`
+	Selector string
+	// TargetNamespaces is the namespaces of target pods, default to Namespace
+	TargetNamespaces []string
+	Mode             string
+	Value            string
+	Duration         string
+}
+
+// objectMeta returns the meta of the chaos to create for the action
+func (o *Options) objectMeta(kind string, action string) metav1.ObjectMeta {
+	name := o.Name
+	if name == "" {
+		prefix := strings.ToLower(strings.TrimSuffix(kind, "Chaos"))
+		name = fmt.Sprintf("%s-%s-%s", prefix, action, utilrand.String(5))
+	}
+
+	return metav1.ObjectMeta{
+		Namespace: o.Namespace,
+		Name:      name,
+		Labels:    map[string]string{LabelKey: "true"},
+	}
+}
+
+func (o *Options) selector() (v1alpha1.SelectorSpec, error) {
+	selector := v1alpha1.SelectorSpec{
+		Namespaces: o.TargetNamespaces,
+	}
+	if len(selector.Namespaces) == 0 {
+		selector.Namespaces = []string{o.Namespace}
+	}
+
+	if o.Selector != "" {
+		set, err := labels.ConvertSelectorToLabelsMap(o.Selector)
+		if err != nil {
+			return selector, fmt.Errorf("invalid selector %q: %v", o.Selector, err)
+		}
+		selector.LabelSelectors = set
+	}
+
+	return selector, nil
+}
+
+func (o *Options) duration() *string {
+	if o.Duration == "" {
+		return nil
+	}
+	duration := o.Duration
+	return &duration
+}
+
+// NetworkOptions defines the options of a network attack
+type NetworkOptions struct {
+	Latency     string
+	Jitter      string
+	Loss        string
+	Duplicate   string
+	Corrupt     string
+	Correlation string
+	Rate        string
+	Limit       uint32
+	Buffer      uint32
+	Direction   string
+}
+
+// NewNetworkChaos constructs a NetworkChaos of action
+func NewNetworkChaos(opt *Options, action v1alpha1.NetworkChaosAction, net *NetworkOptions) (*v1alpha1.NetworkChaos, error) {
+	selector, err := opt.selector()
+	if err != nil {
+		return nil, err
+	}
+
+	chaos := &v1alpha1.NetworkChaos{
+		ObjectMeta: opt.objectMeta(v1alpha1.KindNetworkChaos, string(action)),
+		Spec: v1alpha1.NetworkChaosSpec{
+			Action:    action,
+			Mode:      v1alpha1.PodMode(opt.Mode),
+			Value:     opt.Value,
+			Selector:  selector,
+			Duration:  opt.duration(),
+			Direction: v1alpha1.Direction(net.Direction),
+		},
+	}
+
+	switch action {
+	case v1alpha1.DelayAction:
+		if net.Latency == "" {
+			return nil, fmt.Errorf("latency is required for network delay")
+		}
+		chaos.Spec.Delay = &v1alpha1.DelaySpec{
+			Latency:     net.Latency,
+			Jitter:      net.Jitter,
+			Correlation: net.Correlation,
+		}
+	case v1alpha1.LossAction:
+		if net.Loss == "" {
+			return nil, fmt.Errorf("loss is required for network loss")
+		}
+		chaos.Spec.Loss = &v1alpha1.LossSpec{
+			Loss:        net.Loss,
+			Correlation: net.Correlation,
+		}
+	case v1alpha1.DuplicateAction:
+		if net.Duplicate == "" {
+			return nil, fmt.Errorf("duplicate is required for network duplicate")
+		}
+		chaos.Spec.Duplicate = &v1alpha1.DuplicateSpec{
+			Duplicate:   net.Duplicate,
+			Correlation: net.Correlation,
+		}
+	case v1alpha1.CorruptAction:
+		if net.Corrupt == "" {
+			return nil, fmt.Errorf("corrupt is required for network corrupt")
+		}
+		chaos.Spec.Corrupt = &v1alpha1.CorruptSpec{
+			Corrupt:     net.Corrupt,
+			Correlation: net.Correlation,
+		}
+	case v1alpha1.BandwidthAction:
+		if net.Rate == "" {
+			return nil, fmt.Errorf("rate is required for network bandwidth")
+		}
+		chaos.Spec.Bandwidth = &v1alpha1.BandwidthSpec{
+			Rate:   net.Rate,
+			Limit:  net.Limit,
+			Buffer: net.Buffer,
+		}
+	default:
+		return nil, fmt.Errorf("network action %s is not supported by attack", action)
+	}
+
+	return chaos, nil
+}
+
+// NewPodChaos constructs a PodChaos of action
+func NewPodChaos(opt *Options, action v1alpha1.PodChaosAction, containerName string) (*v1alpha1.PodChaos, error) {
+	selector, err := opt.selector()
+	if err != nil {
+		return nil, err
+	}
+
+	if action == v1alpha1.ContainerKillAction && containerName == "" {
+		return nil, fmt.Errorf("container name is required for container-kill")
+	}
+
+	chaos := &v1alpha1.PodChaos{
+		ObjectMeta: opt.objectMeta(v1alpha1.KindPodChaos, string(action)),
+		Spec: v1alpha1.PodChaosSpec{
+			Action:   action,
+			Mode:     v1alpha1.PodMode(opt.Mode),
+			Value:    opt.Value,
+			Selector: selector,
+			Duration: opt.duration(),
+		},
+	}
+	if containerName != "" {
+		chaos.Spec.ContainerNames = []string{containerName}
+	}
+
+	return chaos, nil
+}
+
+// NewStressChaos constructs a StressChaos with the cpu or memory stressor
+func NewStressChaos(opt *Options, stressors *v1alpha1.Stressors) (*v1alpha1.StressChaos, error) {
+	selector, err := opt.selector()
+	if err != nil {
+		return nil, err
+	}
+
+	action := "cpu"
+	if stressors.CPUStressor == nil {
+		action = "memory"
+	}
+
+	return &v1alpha1.StressChaos{
+		ObjectMeta: opt.objectMeta(v1alpha1.KindStressChaos, action),
+		Spec: v1alpha1.StressChaosSpec{
+			Mode:      v1alpha1.PodMode(opt.Mode),
+			Value:     opt.Value,
+			Selector:  selector,
+			Stressors: stressors,
+			Duration:  opt.duration(),
+		},
+	}, nil
+}
+
+// NewChaosFromTemplate instantiates a chaos from the template with the parameters in the form of `name=value`
+func NewChaosFromTemplate(opt *Options, tpl *v1alpha1.ChaosTemplate, params []string) (v1alpha1.InnerObject, error) {
+	values, err := parseParameters(params)
+	if err != nil {
+		return nil, err
+	}
+
+	name := opt.Name
+	if name == "" {
+		name = fmt.Sprintf("%s-%s", tpl.Name, utilrand.String(5))
+	}
+
+	chaos, err := tpl.Instantiate(opt.Namespace, name, values)
+	if err != nil {
+		return nil, err
+	}
+
+	setAttackLabel(chaos)
+
+	return chaos, nil
+}
+
+// NewScenarioChaos generates the chaos of the scenario targeting the selected pods,
+// the parameters are in the form of `name=value`
+func NewScenarioChaos(opt *Options, s *scenario.Scenario, params []string) ([]v1alpha1.InnerObject, error) {
+	values, err := parseParameters(params)
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := opt.selector()
+	if err != nil {
+		return nil, err
+	}
+
+	chaos, err := s.Generate(&scenario.Options{
+		Namespace:  opt.Namespace,
+		Name:       opt.Name,
+		Selector:   selector,
+		Parameters: values,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range chaos {
+		setAttackLabel(c)
+	}
+
+	return chaos, nil
+}
+
+// parseParameters parses the parameters in the form of `name=value`
+func parseParameters(params []string) (map[string]string, error) {
+	values := make(map[string]string, len(params))
+	for _, param := range params {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid parameter %q, it should be in the form of name=value", param)
+		}
+		values[kv[0]] = kv[1]
+	}
+	return values, nil
+}
+
+// setAttackLabel sets LabelKey on the chaos besides its own labels
+func setAttackLabel(chaos v1alpha1.InnerObject) {
+	meta := chaos.(metav1.Object)
+	labels := meta.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[LabelKey] = "true"
+	meta.SetLabels(labels)
+}
+
+// WaitInjected waits until the chaos is injected into the target pods.
+// An error is returned if the chaos fails or it isn't injected before timeout.
+func WaitInjected(ctx context.Context, c client.Client, kind, namespace, name string, timeout time.Duration) (v1alpha1.InnerObject, error) {
+	var chaos v1alpha1.InnerObject
+	err := wait.PollImmediate(pollInterval, timeout, func() (bool, error) {
+		var err error
+		chaos, err = common.GetChaos(ctx, c, kind, namespace, name)
+		if err != nil {
+			return false, err
+		}
+
+		experiment := chaos.GetStatus().Experiment
+		switch experiment.Phase {
+		case v1alpha1.ExperimentPhaseFailed:
+			return false, fmt.Errorf("%s %s/%s failed: %s", kind, namespace, name, experiment.Reason)
+		case v1alpha1.ExperimentPhaseRunning:
+			return len(experiment.PodRecords) > 0, nil
+		case v1alpha1.ExperimentPhaseFinished:
+			return true, nil
+		}
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return chaos, fmt.Errorf("%s %s/%s is not injected in %s, run `chaosctl debug` to find out why", kind, namespace, name, timeout)
+	}
+	return chaos, err
+}
+
+// Recover deletes the chaos and waits until it's recovered from all target pods
+func Recover(ctx context.Context, c client.Client, kind, namespace, name string, timeout time.Duration) error {
+	chaos, err := common.GetChaos(ctx, c, kind, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Delete(ctx, chaos); err != nil && !k8serror.IsNotFound(err) {
+		return err
+	}
+
+	// the chaos is removed only after the finalizers are cleaned, which means
+	// all target pods are recovered
+	err = wait.PollImmediate(pollInterval, timeout, func() (bool, error) {
+		_, err := common.GetChaos(ctx, c, kind, namespace, name)
+		if k8serror.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("%s %s/%s is not recovered in %s, run `chaosctl debug` to find out why", kind, namespace, name, timeout)
+	}
+	return err
+}
+
+// ListAttacks returns the chaos created by `chaosctl attack` in the namespace
+func ListAttacks(ctx context.Context, c client.Client, namespace string) ([]*v1alpha1.ChaosInstance, error) {
+	var instances []*v1alpha1.ChaosInstance
+	for _, chaosKind := range v1alpha1.AllKinds() {
+		list := chaosKind.ChaosList.DeepCopyObject()
+		if err := c.List(ctx, list,
+			client.InNamespace(namespace),
+			client.MatchingLabels{LabelKey: "true"}); err != nil {
+			return nil, err
+		}
+		instances = append(instances, list.(v1alpha1.ChaosList).ListChaos()...)
+	}
+	return instances, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attack
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/scenario"
+)
+
+func TestNewNetworkChaos(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	opt := &Options{
+		Namespace: "default",
+		Selector:  "app=web,tier=frontend",
+		Mode:      string(v1alpha1.AllPodMode),
+		Duration:  "5m",
+	}
+	chaos, err := NewNetworkChaos(opt, v1alpha1.DelayAction, &NetworkOptions{Latency: "100ms", Direction: "to"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(chaos.Namespace).To(Equal("default"))
+	g.Expect(strings.HasPrefix(chaos.Name, "network-delay-")).To(BeTrue())
+	g.Expect(chaos.Labels).To(HaveKeyWithValue(LabelKey, "true"))
+	g.Expect(chaos.Spec.Selector.Namespaces).To(Equal([]string{"default"}))
+	g.Expect(chaos.Spec.Selector.LabelSelectors).To(Equal(map[string]string{"app": "web", "tier": "frontend"}))
+	g.Expect(*chaos.Spec.Duration).To(Equal("5m"))
+	g.Expect(chaos.Spec.Delay.Latency).To(Equal("100ms"))
+
+	_, err = NewNetworkChaos(opt, v1alpha1.LossAction, &NetworkOptions{})
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = NewNetworkChaos(opt, v1alpha1.PartitionAction, &NetworkOptions{})
+	g.Expect(err).To(HaveOccurred())
+
+	opt.Selector = "app in (web"
+	_, err = NewNetworkChaos(opt, v1alpha1.DelayAction, &NetworkOptions{Latency: "100ms"})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNewPodChaos(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	opt := &Options{
+		Namespace:        "default",
+		Name:             "kill-web",
+		TargetNamespaces: []string{"web"},
+		Mode:             string(v1alpha1.OnePodMode),
+	}
+	chaos, err := NewPodChaos(opt, v1alpha1.PodKillAction, "")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(chaos.Name).To(Equal("kill-web"))
+	g.Expect(chaos.Spec.Selector.Namespaces).To(Equal([]string{"web"}))
+	g.Expect(chaos.Spec.Duration).To(BeNil())
+
+	_, err = NewPodChaos(opt, v1alpha1.ContainerKillAction, "")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNewChaosFromTemplate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tpl := &v1alpha1.ChaosTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "network-delay"},
+		Spec: v1alpha1.ChaosTemplateSpec{
+			Kind: v1alpha1.KindNetworkChaos,
+			Parameters: []v1alpha1.ChaosTemplateParameter{
+				{Name: "selector", Required: true},
+				{Name: "latency", Default: "100ms"},
+			},
+			Template: runtime.RawExtension{Raw: []byte(
+				`{"action": "delay", "mode": "all", "selector": {"labelSelectors": {"app": "${selector}"}}, "delay": {"latency": "${latency}"}}`,
+			)},
+		},
+	}
+
+	opt := &Options{Namespace: "shop"}
+	chaos, err := NewChaosFromTemplate(opt, tpl, []string{"selector=web", "latency=200ms"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	networkChaos := chaos.(*v1alpha1.NetworkChaos)
+	g.Expect(networkChaos.Namespace).To(Equal("shop"))
+	g.Expect(strings.HasPrefix(networkChaos.Name, "network-delay-")).To(BeTrue())
+	g.Expect(networkChaos.Labels).To(HaveKeyWithValue(LabelKey, "true"))
+	g.Expect(networkChaos.Labels).To(HaveKeyWithValue(v1alpha1.LabelChaosTemplate, "network-delay"))
+	g.Expect(networkChaos.Spec.Selector.LabelSelectors).To(Equal(map[string]string{"app": "web"}))
+	g.Expect(networkChaos.Spec.Delay.Latency).To(Equal("200ms"))
+
+	_, err = NewChaosFromTemplate(opt, tpl, []string{"latency"})
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = NewChaosFromTemplate(opt, tpl, nil)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNewScenarioChaos(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	s, ok := scenario.Get("dns-flake")
+	g.Expect(ok).To(BeTrue())
+
+	opt := &Options{Namespace: "shop", Selector: "app=web"}
+	chaos, err := NewScenarioChaos(opt, s, []string{"loss=30"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(chaos).To(HaveLen(1))
+
+	networkChaos := chaos[0].(*v1alpha1.NetworkChaos)
+	g.Expect(networkChaos.Name).To(Equal("dns-flake"))
+	g.Expect(networkChaos.Labels).To(HaveKeyWithValue(LabelKey, "true"))
+	g.Expect(networkChaos.Labels).To(HaveKeyWithValue(scenario.LabelScenario, "dns-flake"))
+	g.Expect(networkChaos.Spec.Selector.Namespaces).To(Equal([]string{"shop"}))
+	g.Expect(networkChaos.Spec.Selector.LabelSelectors).To(Equal(map[string]string{"app": "web"}))
+	g.Expect(networkChaos.Spec.Loss.Loss).To(Equal("30"))
+
+	_, err = NewScenarioChaos(opt, s, []string{"delay=1s"})
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,91 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cleanup
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// Options defines the options of cleaning up a node
+type Options struct {
+	// Node is the name of node to clean up
+	Node string
+	// ChaosMeshNamespace is the namespace which chaos mesh is installed in
+	ChaosMeshNamespace string
+	// DryRun only lists the residual faults without removing them
+	DryRun bool
+}
+
+// Cleanup instructs the chaos-daemon on the node to remove all the faults left in the pods
+// on the node, including the tc qdiscs, iptables rules, ipsets and stress processes,
+// no matter whether the chaos which created them still exists.
+func Cleanup(ctx context.Context, c *common.ClientSet, opt Options) ([]string, error) {
+	daemon, err := common.FindDaemonPod(ctx, c.CtrlCli, opt.ChaosMeshNamespace, opt.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := c.KubeCli.CoreV1().Pods(metav1.NamespaceAll).List(metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", opt.Node).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	containerIDs := ContainerIDs(pods.Items)
+	if len(containerIDs) == 0 {
+		return nil, nil
+	}
+
+	daemonClient, closeDaemon, err := common.DialDaemon(ctx, c, opt.ChaosMeshNamespace, daemon)
+	if err != nil {
+		return nil, err
+	}
+	defer closeDaemon()
+
+	resp, err := daemonClient.Cleanup(ctx, &pb.CleanupRequest{
+		ContainerIds: containerIDs,
+		DryRun:       opt.DryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Removed, nil
+}
+
+// ContainerIDs returns the ids of running containers which could be injected by chaos.
+// Pods in the host network are skipped, otherwise the network of the node would be cleaned up.
+func ContainerIDs(pods []v1.Pod) []string {
+	var ids []string
+	for _, pod := range pods {
+		if pod.Spec.HostNetwork || pod.Status.Phase != v1.PodRunning {
+			continue
+		}
+
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Running != nil && status.ContainerID != "" {
+				ids = append(ids, status.ContainerID)
+			}
+		}
+	}
+	return ids
+}
@@ -0,0 +1,53 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cleanup
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestContainerIDs(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	running := v1.ContainerState{Running: &v1.ContainerStateRunning{}}
+	pods := []v1.Pod{
+		{
+			Status: v1.PodStatus{
+				Phase: v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{
+					{ContainerID: "containerd://a", State: running},
+					{ContainerID: "containerd://b"},
+				},
+			},
+		},
+		{
+			Spec: v1.PodSpec{HostNetwork: true},
+			Status: v1.PodStatus{
+				Phase:             v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{{ContainerID: "containerd://c", State: running}},
+			},
+		},
+		{
+			Status: v1.PodStatus{
+				Phase:             v1.PodSucceeded,
+				ContainerStatuses: []v1.ContainerStatus{{ContainerID: "containerd://d", State: running}},
+			},
+		},
+	}
+
+	g.Expect(ContainerIDs(pods)).To(Equal([]string{"containerd://a"}))
+}
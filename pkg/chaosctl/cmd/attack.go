@@ -0,0 +1,213 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/attack"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+)
+
+type attackFlags struct {
+	opt     attack.Options
+	wait    bool
+	timeout time.Duration
+}
+
+func newAttackCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	af := &attackFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "attack",
+		Short: "Inject a chaos without writing YAML",
+		Long: `Construct and apply a chaos from the command line, then wait until it's injected.
+The created chaos can be cleaned up by ` + "`chaosctl recover`" + `.
+
+Examples:
+  chaosctl attack network delay --latency 100ms --selector app=web --duration 5m
+  chaosctl attack pod kill --selector app=web --mode fixed --value 2
+  chaosctl attack stress cpu --workers 2 --load 80 --selector app=web`,
+	}
+
+	pflags := cmd.PersistentFlags()
+	pflags.StringVar(&af.opt.Name, "name", "", "the name of the chaos, generated if empty")
+	pflags.StringVarP(&af.opt.Selector, "selector", "l", "", "the label selector of target pods, such as app=web,tier=frontend")
+	pflags.StringSliceVar(&af.opt.TargetNamespaces, "target-namespaces", nil, "the namespaces of target pods, default to the namespace of the chaos")
+	pflags.StringVar(&af.opt.Mode, "mode", string(v1alpha1.OnePodMode), "the mode to run chaos action, one of one, all, fixed, fixed-percent and random-max-percent")
+	pflags.StringVar(&af.opt.Value, "value", "", "the value for the fixed, fixed-percent and random-max-percent mode")
+	pflags.StringVar(&af.opt.Duration, "duration", "", "the duration of the chaos, such as 5m, the chaos lasts until it's recovered if empty")
+	pflags.BoolVar(&af.wait, "wait", true, "wait until the chaos is injected")
+	pflags.DurationVar(&af.timeout, "timeout", time.Minute, "the timeout of waiting")
+
+	cmd.AddCommand(
+		newAttackNetworkCommand(flags, af),
+		newAttackPodCommand(flags, af),
+		newAttackStressCommand(flags, af),
+	)
+
+	return cmd
+}
+
+// run applies the chaos constructed by build and waits until it's injected
+func (af *attackFlags) run(cmd *cobra.Command, flags *genericclioptions.ConfigFlags, build func() (v1alpha1.InnerObject, error)) error {
+	return af.runAll(cmd, flags, func() ([]v1alpha1.InnerObject, error) {
+		chaos, err := build()
+		if err != nil {
+			return nil, err
+		}
+		return []v1alpha1.InnerObject{chaos}, nil
+	})
+}
+
+// runAll applies all the chaos constructed by build and waits until they're injected
+func (af *attackFlags) runAll(cmd *cobra.Command, flags *genericclioptions.ConfigFlags, build func() ([]v1alpha1.InnerObject, error)) error {
+	af.opt.Namespace = common.Namespace(flags)
+
+	chaos, err := build()
+	if err != nil {
+		return err
+	}
+
+	c, err := common.InitClientSet(flags)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	instances := make([]*v1alpha1.ChaosInstance, 0, len(chaos))
+	for _, ch := range chaos {
+		if err := c.CtrlCli.Create(ctx, ch.(runtime.Object)); err != nil {
+			return err
+		}
+
+		instance := ch.GetChaos()
+		fmt.Fprintf(cmd.OutOrStdout(), "%s %s/%s created\n", instance.Kind, instance.Namespace, instance.Name)
+		instances = append(instances, instance)
+	}
+	if !af.wait {
+		return nil
+	}
+
+	for _, instance := range instances {
+		injected, err := attack.WaitInjected(ctx, c.CtrlCli, instance.Kind, instance.Namespace, instance.Name, af.timeout)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range injected.GetStatus().Experiment.PodRecords {
+			fmt.Fprintf(cmd.OutOrStdout(), "injected into pod %s/%s\n", record.Namespace, record.Name)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "run `chaosctl recover %s %s -n %s` to recover\n", instance.Kind, instance.Name, instance.Namespace)
+	}
+	return nil
+}
+
+func newAttackNetworkCommand(flags *genericclioptions.ConfigFlags, af *attackFlags) *cobra.Command {
+	net := &attack.NetworkOptions{}
+
+	cmd := &cobra.Command{
+		Use:       "network <delay|loss|duplicate|corrupt|bandwidth>",
+		Short:     "Inject a NetworkChaos",
+		Args:      cobra.ExactValidArgs(1),
+		ValidArgs: []string{"delay", "loss", "duplicate", "corrupt", "bandwidth"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return af.run(cmd, flags, func() (v1alpha1.InnerObject, error) {
+				return attack.NewNetworkChaos(&af.opt, v1alpha1.NetworkChaosAction(args[0]), net)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&net.Latency, "latency", "", "the latency of delay, such as 100ms")
+	cmd.Flags().StringVar(&net.Jitter, "jitter", "", "the jitter of delay, such as 10ms")
+	cmd.Flags().StringVar(&net.Loss, "loss", "", "the percentage of packet loss, such as 25")
+	cmd.Flags().StringVar(&net.Duplicate, "duplicate", "", "the percentage of packet duplication, such as 25")
+	cmd.Flags().StringVar(&net.Corrupt, "corrupt", "", "the percentage of packet corruption, such as 25")
+	cmd.Flags().StringVar(&net.Correlation, "correlation", "", "the correlation with the previous packet, such as 25")
+	cmd.Flags().StringVar(&net.Rate, "rate", "", "the rate of bandwidth, such as 1mbps")
+	cmd.Flags().Uint32Var(&net.Limit, "limit", 20971520, "the number of bytes that can be queued waiting for bandwidth")
+	cmd.Flags().Uint32Var(&net.Buffer, "buffer", 10000, "the maximum amount of bytes that tokens can be available for instantaneously")
+	cmd.Flags().StringVar(&net.Direction, "direction", string(v1alpha1.To), "the direction of network chaos, one of to, from and both")
+
+	return cmd
+}
+
+func newAttackPodCommand(flags *genericclioptions.ConfigFlags, af *attackFlags) *cobra.Command {
+	var containerName string
+
+	cmd := &cobra.Command{
+		Use:       "pod <kill|failure|container-kill|reip>",
+		Short:     "Inject a PodChaos",
+		Args:      cobra.ExactValidArgs(1),
+		ValidArgs: []string{"kill", "failure", "container-kill", "reip"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			action := v1alpha1.PodChaosAction(args[0])
+			if action != v1alpha1.ContainerKillAction {
+				action = v1alpha1.PodChaosAction("pod-" + args[0])
+			}
+			return af.run(cmd, flags, func() (v1alpha1.InnerObject, error) {
+				return attack.NewPodChaos(&af.opt, action, containerName)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&containerName, "container", "", "the name of the container to kill, required by container-kill")
+
+	return cmd
+}
+
+func newAttackStressCommand(flags *genericclioptions.ConfigFlags, af *attackFlags) *cobra.Command {
+	var (
+		workers int
+		load    int
+		size    string
+	)
+
+	cmd := &cobra.Command{
+		Use:       "stress <cpu|memory>",
+		Short:     "Inject a StressChaos",
+		Args:      cobra.ExactValidArgs(1),
+		ValidArgs: []string{"cpu", "memory"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stressors := &v1alpha1.Stressors{}
+			if args[0] == "cpu" {
+				stressors.CPUStressor = &v1alpha1.CPUStressor{
+					Stressor: v1alpha1.Stressor{Workers: workers},
+					Load:     &load,
+				}
+			} else {
+				stressors.MemoryStressor = &v1alpha1.MemoryStressor{
+					Stressor: v1alpha1.Stressor{Workers: workers},
+					Size:     size,
+				}
+			}
+			return af.run(cmd, flags, func() (v1alpha1.InnerObject, error) {
+				return attack.NewStressChaos(&af.opt, stressors)
+			})
+		},
+	}
+
+	cmd.Flags().IntVar(&workers, "workers", 1, "the number of stressors")
+	cmd.Flags().IntVar(&load, "load", 100, "the percentage of cpu load of each cpu stressor")
+	cmd.Flags().StringVar(&size, "size", "", "the memory size to be occupied by each memory stressor, such as 256MB")
+
+	return cmd
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/cleanup"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+)
+
+func newCleanupCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	opt := cleanup.Options{}
+
+	cmd := &cobra.Command{
+		Use:   "cleanup --node <node>",
+		Short: "Remove the residual faults on a node",
+		Long: `Instruct chaos-daemon to remove all the tc qdiscs, iptables rules, ipsets and
+stress processes created by chaos in the pods on a node, even if the chaos is gone.
+It's used to recover from a crash of controller-manager.
+
+Examples:
+  chaosctl cleanup --node worker-1 --dry-run
+  chaosctl cleanup --node worker-1`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+
+			removed, err := cleanup.Cleanup(context.Background(), c, opt)
+			if err != nil {
+				return err
+			}
+
+			if len(removed) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "no residual fault is found on node %s\n", opt.Node)
+				return nil
+			}
+			for _, r := range removed {
+				fmt.Fprintln(cmd.OutOrStdout(), r)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&opt.Node, "node", "", "the name of node to clean up")
+	cmd.Flags().StringVar(&opt.ChaosMeshNamespace, "chaos-mesh-namespace", "chaos-testing", "the namespace which chaos mesh is installed in")
+	cmd.Flags().BoolVar(&opt.DryRun, "dry-run", false, "only list the residual faults without removing them")
+	_ = cmd.MarkFlagRequired("node")
+
+	return cmd
+}
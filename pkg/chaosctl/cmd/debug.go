@@ -0,0 +1,70 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/debug"
+)
+
+func newDebugCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	opt := debug.Options{}
+
+	cmd := &cobra.Command{
+		Use:   "debug <kind> <name>",
+		Short: "Diagnose why the injection or recovery of a chaos is stuck",
+		Long: `Gather the status of a chaos, the controller logs about it and the state of
+chaos-daemon on the nodes of its target pods, then diagnose why its injection
+or recovery is stuck.
+
+Examples:
+  chaosctl debug networkchaos web-delay -n default
+  chaosctl debug stress burn-cpu --chaos-mesh-namespace chaos-testing`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind, _, err := common.ParseKind(args[0])
+			if err != nil {
+				return err
+			}
+
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			chaos, err := common.GetChaos(ctx, c.CtrlCli, kind, common.Namespace(flags), args[1])
+			if err != nil {
+				return err
+			}
+
+			report, err := debug.Debug(ctx, c, chaos, opt)
+			if err != nil {
+				return err
+			}
+			report.Print(cmd.OutOrStdout())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&opt.ChaosMeshNamespace, "chaos-mesh-namespace", "chaos-testing", "the namespace which chaos mesh is installed in")
+	cmd.Flags().Int64Var(&opt.LogLines, "log-lines", 1000, "the number of the latest lines of controller logs to search in")
+
+	return cmd
+}
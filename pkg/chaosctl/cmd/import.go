@@ -0,0 +1,100 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/yaml"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/convert"
+)
+
+func newImportCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	var (
+		format string
+		apply  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Convert the experiments of LitmusChaos or Chaos Toolkit to chaos",
+		Long: `Convert the ChaosEngines of LitmusChaos or the experiment of Chaos Toolkit to chaos, which are
+printed as YAML or created with --apply. Each Litmus experiment or Chaos Toolkit action is converted
+to a chaos, and the experiments, actions, variables and arguments which have no counterpart in
+Chaos Mesh are reported as unsupported. The format is detected if --from is omitted.
+
+The namespace of the chaos defaults to the namespace of the ChaosEngine, or the namespace of the
+target pods of the Chaos Toolkit action, unless --namespace is set.
+
+Examples:
+  chaosctl import nginx-chaosengine.yaml
+  chaosctl import experiment.json --from chaostoolkit -n shop --apply`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			result, err := convert.Convert(data, format, &convert.Options{Namespace: *flags.Namespace})
+			if err != nil {
+				return err
+			}
+			for _, u := range result.Unsupported {
+				fmt.Fprintf(cmd.ErrOrStderr(), "unsupported %s\n", u)
+			}
+			if len(result.Chaos) == 0 {
+				return fmt.Errorf("no chaos is converted from %s", args[0])
+			}
+
+			if !apply {
+				for i, chaos := range result.Chaos {
+					data, err := yaml.Marshal(chaos)
+					if err != nil {
+						return err
+					}
+					if i > 0 {
+						fmt.Fprintln(cmd.OutOrStdout(), "---")
+					}
+					fmt.Fprint(cmd.OutOrStdout(), string(data))
+				}
+				return nil
+			}
+
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+			for _, chaos := range result.Chaos {
+				if err := c.CtrlCli.Create(context.Background(), chaos.(runtime.Object)); err != nil {
+					return err
+				}
+				instance := chaos.GetChaos()
+				fmt.Fprintf(cmd.OutOrStdout(), "%s %s/%s created\n", instance.Kind, instance.Namespace, instance.Name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "from", "", "the format of the experiments, litmus or chaostoolkit")
+	cmd.Flags().BoolVar(&apply, "apply", false, "create the chaos instead of printing them")
+
+	return cmd
+}
@@ -0,0 +1,126 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/install"
+)
+
+type installFlags struct {
+	opt          install.Options
+	featureGates string
+}
+
+func (f *installFlags) addFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&f.opt.Namespace, "chaos-mesh-namespace", "chaos-testing", "the namespace to install chaos mesh in")
+	flags.StringVar(&f.opt.Runtime, "runtime", "auto", "the container runtime of nodes, one of docker, containerd, crio and auto which detects the runtime of each node")
+	flags.StringVar(&f.opt.RuntimeSocketPath, "runtime-socket", "", "the socket path of the container runtime on nodes, the default socket of the runtime is used if it is empty")
+	flags.StringVar(&f.opt.ImageRegistry, "image-registry", "pingcap", "the registry to pull the images of chaos mesh from")
+	flags.StringVar(&f.opt.ImageTag, "image-tag", "latest", "the tag of the images of chaos mesh")
+	flags.StringVar(&f.featureGates, "feature-gates", "",
+		fmt.Sprintf("a set of key=value pairs to switch optional features, the available features are %s",
+			strings.Join(install.FeatureGateNames(), ", ")))
+}
+
+func (f *installFlags) render() ([]byte, error) {
+	gates, err := install.ParseFeatureGates(f.featureGates)
+	if err != nil {
+		return nil, err
+	}
+	f.opt.FeatureGates = gates
+
+	return install.Render(f.opt)
+}
+
+func newManifestsCommand() *cobra.Command {
+	f := &installFlags{}
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "manifests",
+		Short: "Print the manifests to install chaos mesh",
+		Long: `Render the CRDs, RBAC, webhook configurations and deployments of chaos mesh from
+the templates embedded in chaosctl. New certs of the webhooks are generated every time.
+The manifests can be applied by kubectl without Helm or network access.
+
+Examples:
+  chaosctl manifests --runtime containerd > chaos-mesh.yaml
+  chaosctl manifests --image-registry registry.local:5000/pingcap --feature-gates Dashboard=false -o chaos-mesh.yaml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := f.render()
+			if err != nil {
+				return err
+			}
+
+			if output == "" {
+				_, err = cmd.OutOrStdout().Write(data)
+				return err
+			}
+			return ioutil.WriteFile(output, data, 0644)
+		},
+	}
+
+	f.addFlags(cmd.Flags())
+	cmd.Flags().StringVarP(&output, "output", "o", "", "the file to write the manifests to, stdout is used if it is empty")
+
+	return cmd
+}
+
+func newInstallCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	f := &installFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "install",
+		Short: "Install chaos mesh without Helm",
+		Long: `Render the manifests as the manifests command does and apply them to the cluster.
+The existing resources are updated, and the certs of the webhooks are rotated.
+
+Examples:
+  chaosctl install --runtime containerd --runtime-socket /run/k3s/containerd/containerd.sock
+  chaosctl install --feature-gates SecurityMode=true,LeaderElection=true`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := f.render()
+			if err != nil {
+				return err
+			}
+			objects, err := install.Decode(data)
+			if err != nil {
+				return err
+			}
+
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+
+			return install.Apply(context.Background(), c.CtrlCli, objects, cmd.OutOrStdout())
+		},
+	}
+
+	f.addFlags(cmd.Flags())
+
+	return cmd
+}
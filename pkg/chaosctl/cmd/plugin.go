@@ -0,0 +1,209 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/plugin"
+)
+
+// NewPluginCommand creates the root command of kubectl-chaos, which is invoked as `kubectl chaos`
+func NewPluginCommand() *cobra.Command {
+	flags := genericclioptions.NewConfigFlags(true)
+
+	root := &cobra.Command{
+		Use:          "kubectl chaos",
+		Short:        "kubectl chaos lists, inspects and controls the chaos of chaos mesh",
+		SilenceUsage: true,
+	}
+	flags.AddFlags(root.PersistentFlags())
+
+	root.AddCommand(
+		newGetCommand(flags),
+		newTopCommand(flags),
+		newPauseCommand(flags, "pause", true),
+		newPauseCommand(flags, "resume", false),
+		newArchiveCommand(flags),
+	)
+
+	return root
+}
+
+func newGetCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	var allNamespaces bool
+
+	cmd := &cobra.Command{
+		Use:   "get [kind] [name]",
+		Short: "List the chaos with their phases, targets and remaining time",
+		Long: `List the chaos with their phases, the numbers of the injected pods and the time
+until they're recovered. The chaos of all the kinds are listed if the kind is omitted.
+
+Examples:
+  kubectl chaos get -A
+  kubectl chaos get network -n default
+  kubectl chaos get networkchaos web-delay`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var kinds []string
+			if len(args) > 0 {
+				kind, _, err := common.ParseKind(args[0])
+				if err != nil {
+					return err
+				}
+				kinds = append(kinds, kind)
+			}
+
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+			namespace := common.Namespace(flags)
+			if allNamespaces {
+				namespace = ""
+			}
+
+			chaos, err := plugin.ListChaos(context.Background(), c.CtrlCli, kinds, namespace)
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			var rows []plugin.ChaosRow
+			for _, ch := range chaos {
+				row := plugin.NewChaosRow(ch, now)
+				if len(args) == 2 && row.Name != args[1] {
+					continue
+				}
+				rows = append(rows, row)
+			}
+			if len(args) == 2 && len(rows) == 0 {
+				return fmt.Errorf("%s %s is not found", kinds[0], args[1])
+			}
+			return plugin.PrintChaos(cmd.OutOrStdout(), rows, allNamespaces)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "list the chaos in all the namespaces")
+
+	return cmd
+}
+
+func newTopCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	var allNamespaces bool
+
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "Show the pods which the running chaos is injected into",
+		Long: `Show the pods which the running chaos is injected into, with the actions and the chaos
+on each pod, and the number of the injected pods in each namespace. The chaos in the
+namespace is counted, or the chaos in all the namespaces with --all-namespaces.
+
+Examples:
+  kubectl chaos top -A`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+			namespace := common.Namespace(flags)
+			if allNamespaces {
+				namespace = ""
+			}
+
+			chaos, err := plugin.ListChaos(context.Background(), c.CtrlCli, nil, namespace)
+			if err != nil {
+				return err
+			}
+			return plugin.PrintTop(cmd.OutOrStdout(), plugin.Top(chaos))
+		},
+	}
+
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "count the chaos in all the namespaces")
+
+	return cmd
+}
+
+func newPauseCommand(flags *genericclioptions.ConfigFlags, verb string, paused bool) *cobra.Command {
+	short := "Pause the chaos, which is recovered until it's resumed"
+	if !paused {
+		short = "Resume the paused chaos"
+	}
+
+	return &cobra.Command{
+		Use:   verb + " <kind> <name>",
+		Short: short,
+		Long: fmt.Sprintf(`%s by the annotation %s.
+
+Examples:
+  kubectl chaos %s networkchaos web-delay -n default`, short, v1alpha1.PauseAnnotationKey, verb),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			chaos, err := common.GetChaos(ctx, c.CtrlCli, args[0], common.Namespace(flags), args[1])
+			if err != nil {
+				return err
+			}
+			if err := plugin.SetPaused(ctx, c.CtrlCli, chaos, paused); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s %s %sd\n", chaos.GetChaos().Kind, args[1], verb)
+			return nil
+		},
+	}
+}
+
+func newArchiveCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "archive <kind> <name>",
+		Short: "Recover and archive the chaos",
+		Long: `Delete the chaos, so it's recovered by the controller and archived by chaos-dashboard.
+The results of its runs are kept, which are listed by ` + "`kubectl get chaosresults`" + `.
+
+Examples:
+  kubectl chaos archive networkchaos web-delay -n default`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			chaos, err := common.GetChaos(ctx, c.CtrlCli, args[0], common.Namespace(flags), args[1])
+			if err != nil {
+				return err
+			}
+			if err := plugin.Archive(ctx, c.CtrlCli, chaos); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s %s archived\n", chaos.GetChaos().Kind, args[1])
+			return nil
+		},
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/attack"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+)
+
+func newRecoverCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	var (
+		all     bool
+		timeout time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "recover [<kind> <name>]",
+		Short: "Delete a chaos and wait until the target pods are recovered",
+		Long: `Delete a chaos and wait until the target pods are recovered.
+
+Examples:
+  chaosctl recover networkchaos network-delay-x2k9d
+  chaosctl recover --all -n default`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if all && len(args) != 0 {
+				return fmt.Errorf("no argument is accepted with --all")
+			}
+			if !all && len(args) != 2 {
+				return fmt.Errorf("kind and name of the chaos are required")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			namespace := common.Namespace(flags)
+
+			if !all {
+				kind, _, err := common.ParseKind(args[0])
+				if err != nil {
+					return err
+				}
+				if err := attack.Recover(ctx, c.CtrlCli, kind, namespace, args[1], timeout); err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%s %s/%s recovered\n", kind, namespace, args[1])
+				return nil
+			}
+
+			instances, err := attack.ListAttacks(ctx, c.CtrlCli, namespace)
+			if err != nil {
+				return err
+			}
+			for _, instance := range instances {
+				if err := attack.Recover(ctx, c.CtrlCli, instance.Kind, instance.Namespace, instance.Name, timeout); err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%s %s/%s recovered\n", instance.Kind, instance.Namespace, instance.Name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&all, "all", false, "recover all the chaos created by `chaosctl attack` in the namespace")
+	cmd.Flags().DurationVar(&timeout, "timeout", time.Minute, "the timeout of waiting for recovery")
+
+	return cmd
+}
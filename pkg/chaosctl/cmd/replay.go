@@ -0,0 +1,186 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/attack"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/replay"
+)
+
+func newReplayCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Export the runs of chaos as bundles and replay them",
+	}
+
+	cmd.AddCommand(
+		newReplayExportCommand(flags),
+		newReplayRunCommand(flags),
+	)
+
+	return cmd
+}
+
+func newReplayExportCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "export <result>",
+		Short: "Export a finished run recorded by a ChaosResult as a bundle",
+		Long: `Export a finished run recorded by a ChaosResult as a portable bundle, which includes the spec
+of the chaos when the run started, the targets and the timeline of the run.
+
+Examples:
+  chaosctl replay export pod-kill-example-1602921600 -n shop -o run.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+
+			result := &v1alpha1.ChaosResult{}
+			key := types.NamespacedName{Namespace: common.Namespace(flags), Name: args[0]}
+			if err := c.CtrlCli.Get(context.Background(), key, result); err != nil {
+				return err
+			}
+			bundle, err := replay.Export(result)
+			if err != nil {
+				return err
+			}
+
+			data, err := json.MarshalIndent(bundle, "", "  ")
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				fmt.Fprintln(cmd.OutOrStdout(), string(data))
+				return nil
+			}
+			return ioutil.WriteFile(output, append(data, '\n'), 0644)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "the file to write the bundle, default to stdout")
+
+	return cmd
+}
+
+func newReplayRunCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	var (
+		filename string
+		name     string
+		timeout  time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "run -f <bundle>",
+		Short: "Replay an exported run and recover it after the duration of the run",
+		Long: `Replay an exported run in the namespace. The chaos is created with the same parameters, and its
+targets are selected again by the selector. It runs once, and it's recovered after the duration
+of the original run. If the run is replayed in another namespace, the selector of the original
+namespace selects the pods in the new namespace.
+
+If the replay is interrupted, the chaos can be cleaned up by ` + "`chaosctl recover`" + `.
+
+Examples:
+  chaosctl replay run -f run.json -n shop-staging`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := ioutil.ReadFile(filename)
+			if err != nil {
+				return err
+			}
+			bundle, err := replay.Decode(data)
+			if err != nil {
+				return err
+			}
+			duration, err := bundle.GetDuration()
+			if err != nil {
+				return err
+			}
+
+			if name == "" {
+				name = fmt.Sprintf("%s-replay-%s", bundle.Experiment.Name, utilrand.String(5))
+			}
+			chaos, err := bundle.Chaos(common.Namespace(flags), name)
+			if err != nil {
+				return err
+			}
+			meta := chaos.(metav1.Object)
+			labels := meta.GetLabels()
+			labels[attack.LabelKey] = "true"
+			meta.SetLabels(labels)
+
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			if err := c.CtrlCli.Create(ctx, chaos.(runtime.Object)); err != nil {
+				return err
+			}
+			instance := chaos.GetChaos()
+			fmt.Fprintf(cmd.OutOrStdout(), "%s %s/%s created to replay %s\n", instance.Kind, instance.Namespace, instance.Name, bundle.Run.Result)
+
+			injected, err := attack.WaitInjected(ctx, c.CtrlCli, instance.Kind, instance.Namespace, instance.Name, timeout)
+			if err != nil {
+				return err
+			}
+			for _, record := range injected.GetStatus().Experiment.PodRecords {
+				fmt.Fprintf(cmd.OutOrStdout(), "injected into pod %s/%s\n", record.Namespace, record.Name)
+			}
+
+			// the replay lasts for the duration of the original run since it started
+			start := time.Now()
+			if started := injected.GetStatus().Experiment.StartTime; started != nil {
+				start = started.Time
+			}
+			if remaining := time.Until(start.Add(duration)); remaining > 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "recovering in %s\n", remaining.Round(time.Second))
+				time.Sleep(remaining)
+			}
+
+			if err := attack.Recover(ctx, c.CtrlCli, instance.Kind, instance.Namespace, instance.Name, timeout); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s %s/%s recovered, the original run was %s\n",
+				instance.Kind, instance.Namespace, instance.Name, bundle.Run.Verdict)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filename, "filename", "f", "", "the bundle exported by `chaosctl replay export`")
+	cmd.Flags().StringVar(&name, "name", "", "the name of the chaos, generated if empty")
+	cmd.Flags().DurationVar(&timeout, "timeout", time.Minute, "the timeout of waiting for injection and recovery")
+	_ = cmd.MarkFlagRequired("filename")
+
+	return cmd
+}
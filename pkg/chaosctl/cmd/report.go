@@ -0,0 +1,117 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/cireport"
+)
+
+func newReportCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	var (
+		format   string
+		output   string
+		allRuns  bool
+		exitCode bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "report [kind] [name]",
+		Short: "Export the runs of chaos as JUnit XML or a JSON summary for CI",
+		Long: `Export the runs recorded by ChaosResults as JUnit XML or a JSON summary. Each run is a test
+suite, and its injection, probe measurements, SLO evaluation, load and verdict are the test cases.
+Only the latest run of each experiment is exported unless --all-runs is set.
+
+The command fails if any of the exported runs didn't pass, so it gates the CI pipeline.
+
+Examples:
+  chaosctl report networkchaos web-delay -n shop --format junit -o chaos-junit.xml
+  chaosctl report -n shop --all-runs --exit-code=false`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != cireport.FormatJSON && format != cireport.FormatJUnit {
+				return fmt.Errorf("format %s is not supported", format)
+			}
+			labels := client.MatchingLabels{}
+			if len(args) > 0 {
+				kind, _, err := common.ParseKind(args[0])
+				if err != nil {
+					return err
+				}
+				labels[v1alpha1.LabelResultKind] = kind
+			}
+			if len(args) > 1 {
+				labels[v1alpha1.LabelResultName] = args[1]
+			}
+
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+			var list v1alpha1.ChaosResultList
+			if err := c.CtrlCli.List(context.Background(), &list, client.InNamespace(common.Namespace(flags)), labels); err != nil {
+				return err
+			}
+			results := list.Items
+			if !allRuns {
+				results = cireport.LatestRuns(results)
+			}
+			summary := cireport.Summarize(results)
+
+			var buf bytes.Buffer
+			if format == cireport.FormatJUnit {
+				err = cireport.WriteJUnit(&buf, summary)
+			} else {
+				var data []byte
+				data, err = json.MarshalIndent(summary, "", "  ")
+				buf.Write(append(data, '\n'))
+			}
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				_, err = cmd.OutOrStdout().Write(buf.Bytes())
+			} else {
+				err = ioutil.WriteFile(output, buf.Bytes(), 0644)
+			}
+			if err != nil {
+				return err
+			}
+
+			if exitCode && !summary.Passed {
+				return fmt.Errorf("%d of %d runs passed, %d failed, %d aborted and %d are running",
+					summary.Succeeded, summary.Total, summary.Failed, summary.Aborted, summary.Running)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", cireport.FormatJSON, "the format of the report, json or junit")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "the file to write the report, default to stdout")
+	cmd.Flags().BoolVar(&allRuns, "all-runs", false, "export all the runs instead of the latest run of each experiment")
+	cmd.Flags().BoolVar(&exitCode, "exit-code", true, "fail if any of the runs didn't pass")
+
+	return cmd
+}
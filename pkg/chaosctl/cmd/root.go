@@ -0,0 +1,49 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// NewRootCommand creates the root command of chaosctl
+func NewRootCommand() *cobra.Command {
+	flags := genericclioptions.NewConfigFlags(true)
+
+	root := &cobra.Command{
+		Use:          "chaosctl",
+		Short:        "chaosctl is a command line tool to operate chaos mesh",
+		SilenceUsage: true,
+	}
+	flags.AddFlags(root.PersistentFlags())
+
+	root.AddCommand(
+		newDebugCommand(flags),
+		newAttackCommand(flags),
+		newRecoverCommand(flags),
+		newTemplateCommand(flags),
+		newScenarioCommand(flags),
+		newReplayCommand(flags),
+		newReportCommand(flags),
+		newImportCommand(flags),
+		newCleanupCommand(flags),
+		newValidateCommand(flags),
+		newWatchCommand(flags),
+		newManifestsCommand(),
+		newInstallCommand(flags),
+	)
+
+	return root
+}
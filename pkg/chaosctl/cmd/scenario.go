@@ -0,0 +1,103 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/attack"
+	"github.com/chaos-mesh/chaos-mesh/pkg/scenario"
+)
+
+func newScenarioCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scenario",
+		Short: "Run the curated scenarios, such as az-outage and dns-flake",
+	}
+
+	cmd.AddCommand(
+		newScenarioListCommand(),
+		newScenarioRunCommand(flags),
+	)
+
+	return cmd
+}
+
+func newScenarioListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the scenarios and their parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tDESCRIPTION")
+			for _, s := range scenario.List() {
+				fmt.Fprintf(w, "%s\t%s\n", s.Name, s.Description)
+				for _, param := range s.Parameters {
+					fmt.Fprintf(w, "  --set %s=\t%s", param.Name, param.Description)
+					if param.Required {
+						fmt.Fprint(w, " (required)")
+					} else if param.Default != "" {
+						fmt.Fprintf(w, " (default %s)", param.Default)
+					}
+					fmt.Fprintln(w)
+				}
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func newScenarioRunCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	var params []string
+	af := &attackFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "run <scenario>",
+		Short: "Generate the chaos of a scenario targeting the selected pods and wait until they're injected",
+		Long: `Generate the chaos of a scenario targeting the selected pods, the parameters of the scenario
+are overridden by --set. The created chaos can be cleaned up by ` + "`chaosctl recover`" + `.
+
+Examples:
+  chaosctl scenario run az-outage -n shop --selector app=web --set zone=us-west-2a
+  chaosctl scenario run dns-flake -n shop --selector app=web --set loss=30
+  chaosctl scenario run csi-driver -n kube-system --set driver=ebs.csi.aws.com`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, ok := scenario.Get(args[0])
+			if !ok {
+				return fmt.Errorf("scenario %s is not found, run `chaosctl scenario list` to list the scenarios", args[0])
+			}
+
+			return af.runAll(cmd, flags, func() ([]v1alpha1.InnerObject, error) {
+				return attack.NewScenarioChaos(&af.opt, s, params)
+			})
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&params, "set", nil, "set a parameter of the scenario, such as zone=us-west-2a")
+	cmd.Flags().StringVar(&af.opt.Name, "name", "", "the name of the chaos, default to the name of scenario")
+	cmd.Flags().StringVarP(&af.opt.Selector, "selector", "l", "", "the label selector of target pods, such as app=web,tier=frontend")
+	cmd.Flags().StringSliceVar(&af.opt.TargetNamespaces, "target-namespaces", nil, "the namespaces of target pods, default to the namespace of the chaos")
+	cmd.Flags().BoolVar(&af.wait, "wait", true, "wait until the chaos is injected")
+	cmd.Flags().DurationVar(&af.timeout, "timeout", time.Minute, "the timeout of waiting")
+
+	return cmd
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/attack"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+)
+
+func newTemplateCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Run the chaos published as ChaosTemplates",
+	}
+
+	cmd.AddCommand(newTemplateRunCommand(flags))
+
+	return cmd
+}
+
+func newTemplateRunCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	var params []string
+	af := &attackFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "run <template>",
+		Short: "Instantiate a chaos from a ChaosTemplate and wait until it's injected",
+		Long: `Instantiate a chaos from a ChaosTemplate in the namespace, the parameters of the template
+are overridden by --set. The created chaos can be cleaned up by ` + "`chaosctl recover`" + `.
+
+Examples:
+  chaosctl template run pod-kill-one -n shop --set selector=app=web
+  chaosctl template run network-delay -n shop --set latency=200ms --set duration=10m`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+
+			tpl := &v1alpha1.ChaosTemplate{}
+			if err := c.CtrlCli.Get(context.Background(), types.NamespacedName{Name: args[0]}, tpl); err != nil {
+				return err
+			}
+
+			return af.run(cmd, flags, func() (v1alpha1.InnerObject, error) {
+				return attack.NewChaosFromTemplate(&af.opt, tpl, params)
+			})
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&params, "set", nil, "set a parameter of the template, such as latency=100ms")
+	cmd.Flags().StringVar(&af.opt.Name, "name", "", "the name of the chaos, generated if empty")
+	cmd.Flags().BoolVar(&af.wait, "wait", true, "wait until the chaos is injected")
+	cmd.Flags().DurationVar(&af.timeout, "timeout", time.Minute, "the timeout of waiting")
+
+	return cmd
+}
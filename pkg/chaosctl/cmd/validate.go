@@ -0,0 +1,127 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/yaml"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/validate"
+)
+
+func newValidateCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	var filename string
+
+	cmd := &cobra.Command{
+		Use:   "validate -f <file>",
+		Short: "Validate a chaos and show the pods it would target without creating it",
+		Long: `Run the chaos through the same defaulting and validation as the webhooks, then
+print the resolved spec and the pods it would target. Nothing is created in the cluster.
+The pods are just a sample for the random modes, such as random-max-percent.
+
+Examples:
+  chaosctl validate -f exp.yaml
+  cat exp.yaml | chaosctl validate -f -`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var r io.Reader = cmd.InOrStdin()
+			if filename != "-" {
+				f, err := os.Open(filename)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r = f
+			}
+
+			objects, err := validate.Decode(r, common.Namespace(flags))
+			if err != nil {
+				return err
+			}
+
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+
+			invalid := 0
+			for _, obj := range objects {
+				result, err := validate.Validate(context.Background(), c.CtrlCli, obj)
+				if err != nil {
+					return err
+				}
+				if len(result.Errors) > 0 {
+					invalid++
+				}
+				if err := printValidateResult(cmd.OutOrStdout(), result); err != nil {
+					return err
+				}
+			}
+
+			if invalid > 0 {
+				return fmt.Errorf("%d of %d chaos are invalid", invalid, len(objects))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filename, "filename", "f", "", "the file that contains the chaos, - for stdin")
+	_ = cmd.MarkFlagRequired("filename")
+
+	return cmd
+}
+
+func printValidateResult(w io.Writer, result *validate.Result) error {
+	instance := result.Chaos.(v1alpha1.InnerObject).GetChaos()
+	fmt.Fprintf(w, "# %s %s/%s\n", instance.Kind, instance.Namespace, instance.Name)
+
+	data, err := yaml.Marshal(result.Chaos)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "%s\n", data)
+
+	if len(result.Errors) > 0 {
+		fmt.Fprintln(w, "[Invalid]")
+		for _, e := range result.Errors {
+			fmt.Fprintf(w, "  %s\n", e)
+		}
+		fmt.Fprintln(w)
+		return nil
+	}
+
+	fmt.Fprintln(w, "[Valid]")
+	printPods(w, "Pods", result.Pods)
+	if result.TargetPods != nil {
+		printPods(w, "Target Pods", result.TargetPods)
+	}
+	fmt.Fprintln(w)
+	return nil
+}
+
+func printPods(w io.Writer, title string, pods []v1.Pod) {
+	fmt.Fprintf(w, "%s (%d):\n", title, len(pods))
+	for _, pod := range pods {
+		fmt.Fprintf(w, "  %s/%s node=%s ip=%s\n", pod.Namespace, pod.Name, pod.Spec.NodeName, pod.Status.PodIP)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/watch"
+)
+
+func newWatchCommand(flags *genericclioptions.ConfigFlags) *cobra.Command {
+	var interval time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "watch <kind> <name>",
+		Short: "Stream the status transitions of a chaos, its targets and the related events",
+		Long: `Stream the status transitions of a chaos, its target pods and the related events
+until the chaos is deleted or the command is interrupted.
+
+Examples:
+  chaosctl watch networkchaos web-delay -n default`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind, _, err := common.ParseKind(args[0])
+			if err != nil {
+				return err
+			}
+
+			c, err := common.InitClientSet(flags)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			sig := make(chan os.Signal, 1)
+			signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-sig
+				cancel()
+			}()
+
+			return watch.Watch(ctx, c, kind, common.Namespace(flags), args[1], interval, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().DurationVar(&interval, "interval", 2*time.Second, "the interval of refreshing the status")
+
+	return cmd
+}
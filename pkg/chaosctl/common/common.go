@@ -0,0 +1,212 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+}
+
+// ClientSet contains the clients used by chaosctl
+type ClientSet struct {
+	CtrlCli client.Client
+	KubeCli kubernetes.Interface
+	Config  *rest.Config
+}
+
+// InitClientSet creates the clients from the kubeconfig flags
+func InitClientSet(flags *genericclioptions.ConfigFlags) (*ClientSet, error) {
+	config, err := flags.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	ctrlCli, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, err
+	}
+
+	kubeCli, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientSet{
+		CtrlCli: ctrlCli,
+		KubeCli: kubeCli,
+		Config:  config,
+	}, nil
+}
+
+// Namespace returns the namespace in the kubeconfig flags, or `default` if it's not set
+func Namespace(flags *genericclioptions.ConfigFlags) string {
+	ns, _, err := flags.ToRawKubeConfigLoader().Namespace()
+	if err != nil || ns == "" {
+		return "default"
+	}
+	return ns
+}
+
+// ParseKind returns the kind of chaos by the name which is case insensitive,
+// and the `Chaos` suffix can be omitted, e.g. `network` for NetworkChaos.
+func ParseKind(name string) (string, *v1alpha1.ChaosKind, error) {
+	lower := strings.ToLower(name)
+	for kind, chaosKind := range v1alpha1.AllKinds() {
+		k := strings.ToLower(kind)
+		if lower == k || lower+"chaos" == k {
+			return kind, chaosKind, nil
+		}
+	}
+
+	kinds := make([]string, 0)
+	for kind := range v1alpha1.AllKinds() {
+		kinds = append(kinds, kind)
+	}
+	return "", nil, fmt.Errorf("unknown chaos kind %s, available kinds are %v", name, kinds)
+}
+
+// GetChaos gets the chaos of kind by namespace and name
+func GetChaos(ctx context.Context, c client.Client, kind, namespace, name string) (v1alpha1.InnerObject, error) {
+	_, chaosKind, err := ParseKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	chaos := chaosKind.Chaos.DeepCopyObject()
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, chaos); err != nil {
+		return nil, err
+	}
+
+	inner, ok := chaos.(v1alpha1.InnerObject)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a chaos object", kind)
+	}
+	return inner, nil
+}
+
+// Exec executes the shell command in the container of pod, and returns the stdout
+func Exec(ctx context.Context, c *ClientSet, pod *v1.Pod, container string, cmd string) (string, error) {
+	req := c.KubeCli.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: container,
+			Command:   []string{"/bin/sh", "-c", cmd},
+			Stdout:    true,
+			Stderr:    true,
+		}, clientgoscheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(c.Config, "POST", req.URL())
+	if err != nil {
+		return "", err
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err := executor.Stream(remotecommand.StreamOptions{
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}); err != nil {
+		return "", fmt.Errorf("failed to exec %q in %s/%s: %v, stderr: %s", cmd, pod.Namespace, pod.Name, err, stderr.String())
+	}
+
+	return stdout.String(), nil
+}
+
+// FindDaemonPod returns the running chaos-daemon pod on the node
+func FindDaemonPod(ctx context.Context, c client.Client, chaosMeshNamespace, node string) (*v1.Pod, error) {
+	var pods v1.PodList
+	if err := c.List(ctx, &pods,
+		client.InNamespace(chaosMeshNamespace),
+		client.MatchingLabels{"app.kubernetes.io/component": "chaos-daemon"}); err != nil {
+		return nil, err
+	}
+
+	for i := range pods.Items {
+		if pods.Items[i].Spec.NodeName == node {
+			return &pods.Items[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("chaos-daemon is not found on node %s in namespace %s", node, chaosMeshNamespace)
+}
+
+// PortForward forwards a random local port to the port of pod, and returns the local port.
+// The forwarding is stopped when the returned function is called.
+func PortForward(c *ClientSet, pod *v1.Pod, port int) (uint16, func(), error) {
+	transport, upgrader, err := spdy.RoundTripperFor(c.Config)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	url := c.KubeCli.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("portforward").URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", url)
+
+	stopChan := make(chan struct{})
+	readyChan := make(chan struct{})
+	fw, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, []string{fmt.Sprintf("0:%d", port)},
+		stopChan, readyChan, ioutil.Discard, ioutil.Discard)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- fw.ForwardPorts()
+	}()
+
+	select {
+	case <-readyChan:
+	case err := <-errChan:
+		return 0, nil, err
+	}
+
+	ports, err := fw.GetPorts()
+	if err != nil {
+		close(stopChan)
+		return 0, nil, err
+	}
+
+	return ports[0].Local, func() { close(stopChan) }, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+const (
+	// defaultDaemonPort is the grpc port of chaos-daemon if it's not declared in the daemon pod
+	defaultDaemonPort = 31767
+	// clientCertsSecret is the secret of the client certs to call chaos-daemon with mutual TLS
+	clientCertsSecret = "chaos-mesh-daemon-client-certs"
+)
+
+// DialDaemon connects to the chaos-daemon pod by port forwarding. The connection and the
+// forwarding are closed when the returned function is called.
+func DialDaemon(ctx context.Context, c *ClientSet, chaosMeshNamespace string, daemon *v1.Pod) (pb.ChaosDaemonClient, func(), error) {
+	port, stop, err := PortForward(c, daemon, daemonPort(daemon))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	security, err := daemonSecurity(c, chaosMeshNamespace)
+	if err != nil {
+		stop()
+		return nil, nil, err
+	}
+
+	conn, err := grpc.DialContext(ctx, fmt.Sprintf("127.0.0.1:%d", port), security)
+	if err != nil {
+		stop()
+		return nil, nil, err
+	}
+
+	return pb.NewChaosDaemonClient(conn), func() {
+		conn.Close()
+		stop()
+	}, nil
+}
+
+// daemonSecurity returns the credentials to call chaos-daemon. The client certs of controller-manager
+// are used if chaos-daemon is secured by mutual TLS, otherwise the connection is insecure.
+func daemonSecurity(c *ClientSet, namespace string) (grpc.DialOption, error) {
+	secret, err := c.KubeCli.CoreV1().Secrets(namespace).Get(clientCertsSecret, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return grpc.WithInsecure(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := utils.NewClientTLSConfig(secret.Data["ca.crt"], secret.Data["tls.crt"], secret.Data["tls.key"], utils.ChaosDaemonServerName)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(config)), nil
+}
+
+func daemonPort(daemon *v1.Pod) int {
+	for _, container := range daemon.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.Name == "grpc" {
+				return int(port.ContainerPort)
+			}
+		}
+	}
+	return defaultDaemonPort
+}
@@ -0,0 +1,349 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debug
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// Options defines the options of debugging a chaos
+type Options struct {
+	// ChaosMeshNamespace is the namespace which chaos mesh is installed in
+	ChaosMeshNamespace string
+	// LogLines is the number of the latest lines of controller logs to search in
+	LogLines int64
+}
+
+// Section is a titled part of the report
+type Section struct {
+	Title string
+	Lines []string
+}
+
+// Report is the result of debugging a chaos
+type Report struct {
+	Sections  []Section
+	Diagnoses []string
+}
+
+func (r *Report) addSection(title string, lines ...string) {
+	r.Sections = append(r.Sections, Section{Title: title, Lines: lines})
+}
+
+// Print prints the report to w
+func (r *Report) Print(w io.Writer) {
+	for _, section := range r.Sections {
+		fmt.Fprintf(w, "[%s]\n", section.Title)
+		if len(section.Lines) == 0 {
+			fmt.Fprintln(w, "  <none>")
+		}
+		for _, line := range section.Lines {
+			for _, l := range strings.Split(strings.TrimRight(line, "\n"), "\n") {
+				fmt.Fprintf(w, "  %s\n", l)
+			}
+		}
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintln(w, "[Diagnosis]")
+	for _, diagnosis := range r.Diagnoses {
+		fmt.Fprintf(w, "  - %s\n", diagnosis)
+	}
+}
+
+// Debug gathers the status, the targets, the controller logs and the daemon-side state
+// of the chaos, and diagnoses why its injection or recovery is stuck.
+func Debug(ctx context.Context, c *common.ClientSet, chaos v1alpha1.InnerObject, opt Options) (*Report, error) {
+	report := &Report{}
+	instance := chaos.GetChaos()
+
+	meta, ok := chaos.(metav1.Object)
+	if !ok {
+		return nil, fmt.Errorf("failed to get meta information of %s", instance.Kind)
+	}
+	report.addSection("Experiment", experimentLines(chaos, meta)...)
+
+	in := &diagnoseInput{
+		chaos:        chaos,
+		meta:         meta,
+		pods:         make(map[string]*v1.Pod),
+		daemons:      make(map[string]*v1.Pod),
+		capabilities: make(map[string]*pb.CapabilitiesResponse),
+	}
+
+	var targetLines []string
+	for _, record := range chaos.GetStatus().Experiment.PodRecords {
+		key := fmt.Sprintf("%s/%s", record.Namespace, record.Name)
+		line := fmt.Sprintf("%s action=%s", key, record.Action)
+		if record.Message != "" {
+			line += fmt.Sprintf(" message=%q", record.Message)
+		}
+
+		var pod v1.Pod
+		err := c.CtrlCli.Get(ctx, types.NamespacedName{Namespace: record.Namespace, Name: record.Name}, &pod)
+		if err != nil && !k8serror.IsNotFound(err) {
+			return nil, err
+		}
+		if k8serror.IsNotFound(err) {
+			in.pods[key] = nil
+			targetLines = append(targetLines, line+" (pod not found)")
+			continue
+		}
+		in.pods[key] = &pod
+		targetLines = append(targetLines, fmt.Sprintf("%s node=%s phase=%s", line, pod.Spec.NodeName, pod.Status.Phase))
+
+		if _, ok := in.daemons[pod.Spec.NodeName]; !ok {
+			daemon, err := common.FindDaemonPod(ctx, c.CtrlCli, opt.ChaosMeshNamespace, pod.Spec.NodeName)
+			if err != nil {
+				daemon = nil
+			}
+			in.daemons[pod.Spec.NodeName] = daemon
+		}
+	}
+	report.addSection("Targets", targetLines...)
+
+	logs, err := controllerLogs(ctx, c, opt, instance.Namespace, instance.Name)
+	if err != nil {
+		logs = []string{fmt.Sprintf("failed to get logs of controller: %v", err)}
+	}
+	report.addSection("Controller Logs", logs...)
+
+	nodes := make([]string, 0, len(in.daemons))
+	for node, daemon := range in.daemons {
+		if daemon != nil {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Strings(nodes)
+	for _, node := range nodes {
+		caps, err := daemonCapabilities(ctx, c, opt, in.daemons[node])
+		if err != nil {
+			report.addSection(fmt.Sprintf("Capabilities of %s", node), fmt.Sprintf("failed to get capabilities: %v", err))
+			continue
+		}
+		in.capabilities[node] = caps
+		report.addSection(fmt.Sprintf("Capabilities of %s", node), capabilityLines(caps)...)
+	}
+
+	for key, pod := range in.pods {
+		if pod == nil || in.daemons[pod.Spec.NodeName] == nil {
+			continue
+		}
+		report.addSection(fmt.Sprintf("Daemon State of %s", key),
+			daemonState(ctx, c, instance.Kind, in.daemons[pod.Spec.NodeName], pod)...)
+	}
+
+	report.Diagnoses = diagnose(in)
+	return report, nil
+}
+
+func experimentLines(chaos v1alpha1.InnerObject, meta metav1.Object) []string {
+	instance := chaos.GetChaos()
+	status := chaos.GetStatus()
+
+	lines := []string{
+		fmt.Sprintf("kind: %s", instance.Kind),
+		fmt.Sprintf("namespace: %s", instance.Namespace),
+		fmt.Sprintf("name: %s", instance.Name),
+		fmt.Sprintf("phase: %s", status.Experiment.Phase),
+	}
+	if status.Experiment.Reason != "" {
+		lines = append(lines, fmt.Sprintf("reason: %s", status.Experiment.Reason))
+	}
+	if status.Experiment.StartTime != nil {
+		lines = append(lines, fmt.Sprintf("start time: %s", status.Experiment.StartTime))
+	}
+	if status.Experiment.EndTime != nil {
+		lines = append(lines, fmt.Sprintf("end time: %s", status.Experiment.EndTime))
+	}
+	if status.Scheduler.NextStart != nil {
+		lines = append(lines, fmt.Sprintf("next start: %s", status.Scheduler.NextStart))
+	}
+	if status.Scheduler.NextRecover != nil {
+		lines = append(lines, fmt.Sprintf("next recover: %s", status.Scheduler.NextRecover))
+	}
+	if meta.GetAnnotations()[v1alpha1.PauseAnnotationKey] == "true" {
+		lines = append(lines, "paused: true")
+	}
+	if meta.GetDeletionTimestamp() != nil {
+		lines = append(lines, fmt.Sprintf("deletion time: %s", meta.GetDeletionTimestamp()))
+	}
+	if len(meta.GetFinalizers()) > 0 {
+		lines = append(lines, fmt.Sprintf("finalizers: %v", meta.GetFinalizers()))
+	}
+
+	return lines
+}
+
+// controllerLogs returns the lines of controller logs which mention the chaos
+func controllerLogs(ctx context.Context, c *common.ClientSet, opt Options, namespace, name string) ([]string, error) {
+	var pods v1.PodList
+	if err := c.CtrlCli.List(ctx, &pods,
+		client.InNamespace(opt.ChaosMeshNamespace),
+		client.MatchingLabels{"app.kubernetes.io/component": "controller-manager"}); err != nil {
+		return nil, err
+	}
+	if len(pods.Items) == 0 {
+		return nil, fmt.Errorf("controller-manager is not found in namespace %s", opt.ChaosMeshNamespace)
+	}
+
+	var lines []string
+	for _, pod := range pods.Items {
+		stream, err := c.KubeCli.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &v1.PodLogOptions{
+			TailLines: &opt.LogLines,
+		}).Stream()
+		if err != nil {
+			return nil, err
+		}
+
+		scanner := bufio.NewScanner(stream)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.Contains(line, name) && strings.Contains(line, namespace) {
+				lines = append(lines, line)
+			}
+		}
+		stream.Close()
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return lines, nil
+}
+
+// daemonCapabilities returns the capabilities reported by the chaos-daemon, or nil if the
+// chaos-daemon is too old to report them
+func daemonCapabilities(ctx context.Context, c *common.ClientSet, opt Options, daemon *v1.Pod) (*pb.CapabilitiesResponse, error) {
+	daemonClient, closeDaemon, err := common.DialDaemon(ctx, c, opt.ChaosMeshNamespace, daemon)
+	if err != nil {
+		return nil, err
+	}
+	defer closeDaemon()
+
+	return utils.GetNodeCapabilities(ctx, daemonClient, "")
+}
+
+// capabilityLines describes the capabilities of the node
+func capabilityLines(caps *pb.CapabilitiesResponse) []string {
+	if caps == nil {
+		return []string{"chaos-daemon doesn't report capabilities"}
+	}
+
+	goos := caps.Os
+	if goos == "" {
+		goos = utils.OSLinux
+	}
+	mode := caps.Mode
+	if mode == "" {
+		mode = utils.DaemonModePrivileged
+	}
+	lines := []string{
+		fmt.Sprintf("platform: %s/%s", goos, caps.Arch),
+		fmt.Sprintf("kernel: %s", caps.KernelVersion),
+		fmt.Sprintf("mode: %s", mode),
+	}
+	if goos != utils.OSLinux {
+		lines = append(lines, fmt.Sprintf("methods: %v", caps.Methods))
+	} else {
+		lines = append(lines,
+			fmt.Sprintf("modules: %v", caps.Modules),
+			fmt.Sprintf("cgroup: v%d", caps.CgroupVersion),
+			fmt.Sprintf("bpf: %t", caps.Bpf))
+		if caps.KernelConfig == "" {
+			lines = append(lines, "kernel features: unknown, the kernel config isn't readable")
+		} else {
+			lines = append(lines, fmt.Sprintf("kernel features: %v (from %s)", caps.KernelFeatures, caps.KernelConfig))
+		}
+	}
+	for _, runtime := range caps.Runtimes {
+		if runtime.Healthy {
+			lines = append(lines, fmt.Sprintf("runtime %s: healthy", runtime.Name))
+		} else {
+			lines = append(lines, fmt.Sprintf("runtime %s: %s", runtime.Name, runtime.Error))
+		}
+	}
+
+	return lines
+}
+
+// daemonState executes the commands in chaos-daemon to show the state which is
+// changed by the chaos in the namespaces of the target pod
+func daemonState(ctx context.Context, c *common.ClientSet, kind string, daemon *v1.Pod, pod *v1.Pod) []string {
+	var commands []string
+	switch kind {
+	case v1alpha1.KindNetworkChaos:
+		commands = []string{
+			"tc qdisc list",
+			"tc filter list dev eth0",
+			"iptables -L -n",
+			"ipset list -n",
+		}
+	case v1alpha1.KindStressChaos:
+		// the stressors are executed by chaos-daemon in the host pid namespace
+		commands = []string{"ps -ef | grep stress-ng | grep -v grep"}
+	case v1alpha1.KindTimeChaos:
+		commands = []string{"date"}
+	default:
+		return []string{fmt.Sprintf("no daemon-side state is available for %s", kind)}
+	}
+
+	containerID := ""
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.ContainerID != "" {
+			containerID = status.ContainerID
+			break
+		}
+	}
+	if i := strings.Index(containerID, "://"); i >= 0 {
+		containerID = containerID[i+3:]
+	}
+	if containerID == "" {
+		return []string{"no running container is found in the pod"}
+	}
+
+	// find the pid of the container by its cgroup, chaos-daemon is in the host pid namespace
+	findPid := fmt.Sprintf("pid=$(grep -l %s /proc/[0-9]*/cgroup 2>/dev/null | head -n 1 | cut -d/ -f3)", containerID)
+
+	var lines []string
+	for _, cmd := range commands {
+		script := fmt.Sprintf("%s; nsenter -t $pid -n -p -m -- sh -c %q", findPid, cmd)
+		if kind == v1alpha1.KindStressChaos {
+			script = cmd
+		}
+		out, err := common.Exec(ctx, c, daemon, "", script)
+		if err != nil {
+			out = err.Error()
+		}
+		lines = append(lines, fmt.Sprintf("$ %s\n%s", cmd, out))
+	}
+	return lines
+}
@@ -0,0 +1,134 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debug
+
+import (
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/webhook/inject"
+)
+
+// diagnoseInput is the state gathered for diagnosing a chaos
+type diagnoseInput struct {
+	chaos v1alpha1.InnerObject
+	meta  metav1.Object
+	// pods is the target pods keyed by `namespace/name`, nil means the pod is not found
+	pods map[string]*v1.Pod
+	// daemons is the chaos-daemon pods keyed by node name, nil means the daemon is not found
+	daemons map[string]*v1.Pod
+	// capabilities is the capabilities reported by chaos-daemon keyed by node name
+	capabilities map[string]*pb.CapabilitiesResponse
+}
+
+// diagnose explains why the injection or recovery of the chaos is stuck
+func diagnose(in *diagnoseInput) []string {
+	var diagnoses []string
+	status := in.chaos.GetStatus()
+	instance := in.chaos.GetChaos()
+
+	if in.meta.GetDeletionTimestamp() != nil && len(in.meta.GetFinalizers()) > 0 {
+		diagnoses = append(diagnoses, fmt.Sprintf(
+			"the chaos is being deleted but is still waiting for recovering from finalizers %v, "+
+				"if the targets can't be recovered, annotate the chaos with %s=%s to remove the finalizers forcibly",
+			in.meta.GetFinalizers(), common.AnnotationCleanFinalizer, common.AnnotationCleanFinalizerForced))
+	}
+
+	if in.meta.GetAnnotations()[v1alpha1.PauseAnnotationKey] == "true" {
+		diagnoses = append(diagnoses, fmt.Sprintf(
+			"the chaos is paused, remove the annotation %s to resume it", v1alpha1.PauseAnnotationKey))
+	}
+
+	switch status.Experiment.Phase {
+	case v1alpha1.ExperimentPhaseFailed:
+		diagnoses = append(diagnoses, fmt.Sprintf("the chaos failed: %s", status.Experiment.Reason))
+	case v1alpha1.ExperimentPhaseRunning:
+		if len(status.Experiment.PodRecords) == 0 {
+			diagnoses = append(diagnoses, "the chaos is running but no pod has been injected, check the selector and the controller logs")
+		}
+	case "":
+		diagnoses = append(diagnoses, fmt.Sprintf(
+			"the chaos has never been reconciled, check whether the controller of %s is running", instance.Kind))
+	}
+
+	keys := make([]string, 0, len(in.pods))
+	for key := range in.pods {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	nodes := make(map[string]struct{})
+	for _, key := range keys {
+		pod := in.pods[key]
+		if pod == nil {
+			diagnoses = append(diagnoses, fmt.Sprintf("target pod %s is not found, it may be deleted during the chaos", key))
+			continue
+		}
+		if pod.Status.Phase != v1.PodRunning {
+			diagnoses = append(diagnoses, fmt.Sprintf("target pod %s is %s rather than Running", key, pod.Status.Phase))
+		}
+
+		// IoChaos is injected by the chaosfs sidecar rather than chaos-daemon
+		if instance.Kind == v1alpha1.KindIOChaos {
+			if !inject.IsInjected(pod) {
+				diagnoses = append(diagnoses, fmt.Sprintf(
+					"the chaosfs sidecar isn't injected into target pod %s, check the injection annotations of its namespace and recreate it", key))
+			}
+			continue
+		}
+
+		if _, ok := nodes[pod.Spec.NodeName]; ok {
+			continue
+		}
+		nodes[pod.Spec.NodeName] = struct{}{}
+
+		daemon := in.daemons[pod.Spec.NodeName]
+		if daemon == nil {
+			diagnoses = append(diagnoses, fmt.Sprintf(
+				"chaos-daemon is not found on node %s, the chaos can't be injected into or recovered from pods on it", pod.Spec.NodeName))
+		} else if daemon.Status.Phase != v1.PodRunning {
+			diagnoses = append(diagnoses, fmt.Sprintf(
+				"chaos-daemon %s on node %s is %s rather than Running", daemon.Name, pod.Spec.NodeName, daemon.Status.Phase))
+		}
+	}
+
+	// the chaos may be injected differently on the nodes of a heterogeneous pool
+	arches := make(map[string]struct{})
+	for node := range nodes {
+		if caps := in.capabilities[node]; caps != nil && caps.Arch != "" {
+			arches[caps.Os+"/"+caps.Arch] = struct{}{}
+		}
+	}
+	if len(arches) > 1 {
+		platforms := make([]string, 0, len(arches))
+		for platform := range arches {
+			platforms = append(platforms, platform)
+		}
+		sort.Strings(platforms)
+		diagnoses = append(diagnoses, fmt.Sprintf(
+			"the target pods are on nodes of different platforms %v, the actions which some nodes can't support "+
+				"fail or fall back on them, check the capabilities of the nodes", platforms))
+	}
+
+	if len(diagnoses) == 0 {
+		diagnoses = append(diagnoses, "no problem is found")
+	}
+	return diagnoses
+}
@@ -0,0 +1,170 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debug
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func newInput(chaos *v1alpha1.NetworkChaos) *diagnoseInput {
+	return &diagnoseInput{
+		chaos:        chaos,
+		meta:         chaos,
+		pods:         make(map[string]*v1.Pod),
+		daemons:      make(map[string]*v1.Pod),
+		capabilities: make(map[string]*pb.CapabilitiesResponse),
+	}
+}
+
+func contains(diagnoses []string, substr string) bool {
+	for _, d := range diagnoses {
+		if strings.Contains(d, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDiagnose(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &v1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "delay"},
+	}
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	chaos.Status.Experiment.PodRecords = []v1alpha1.PodStatus{{Namespace: "default", Name: "p1"}}
+	in := newInput(chaos)
+	in.pods["default/p1"] = &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"},
+		Spec:       v1.PodSpec{NodeName: "n1"},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	}
+	in.daemons["n1"] = &v1.Pod{Status: v1.PodStatus{Phase: v1.PodRunning}}
+	g.Expect(diagnose(in)).To(Equal([]string{"no problem is found"}))
+
+	// daemon is not running and another target is gone
+	in.daemons["n1"] = &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "chaos-daemon-x"},
+		Status:     v1.PodStatus{Phase: v1.PodPending},
+	}
+	in.pods["default/p2"] = nil
+	diagnoses := diagnose(in)
+	g.Expect(contains(diagnoses, "chaos-daemon-x on node n1 is Pending")).To(BeTrue())
+	g.Expect(contains(diagnoses, "target pod default/p2 is not found")).To(BeTrue())
+
+	in.daemons["n1"] = nil
+	g.Expect(contains(diagnose(in), "chaos-daemon is not found on node n1")).To(BeTrue())
+
+	// running without any injected pod
+	chaos.Status.Experiment.PodRecords = nil
+	g.Expect(contains(diagnose(newInput(chaos)), "no pod has been injected")).To(BeTrue())
+
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFailed
+	chaos.Status.Experiment.Reason = "permission denied"
+	g.Expect(contains(diagnose(newInput(chaos)), "the chaos failed: permission denied")).To(BeTrue())
+
+	// stuck in deletion
+	now := metav1.Now()
+	chaos.DeletionTimestamp = &now
+	chaos.Finalizers = []string{"default/p1"}
+	chaos.Annotations = map[string]string{v1alpha1.PauseAnnotationKey: "true"}
+	diagnoses = diagnose(newInput(chaos))
+	g.Expect(contains(diagnoses, "cleanFinalizer=forced")).To(BeTrue())
+	g.Expect(contains(diagnoses, "the chaos is paused")).To(BeTrue())
+}
+
+func TestDiagnoseIoChaos(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &v1alpha1.IoChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "io-delay"},
+	}
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	chaos.Status.Experiment.PodRecords = []v1alpha1.PodStatus{{Namespace: "default", Name: "p1"}}
+	in := &diagnoseInput{
+		chaos: chaos,
+		meta:  chaos,
+		pods: map[string]*v1.Pod{"default/p1": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"},
+			Spec:       v1.PodSpec{NodeName: "n1"},
+			Status:     v1.PodStatus{Phase: v1.PodRunning},
+		}},
+		daemons: make(map[string]*v1.Pod),
+	}
+
+	// chaos-daemon isn't required by IoChaos
+	diagnoses := diagnose(in)
+	g.Expect(contains(diagnoses, "chaos-daemon is not found")).To(BeFalse())
+	g.Expect(contains(diagnoses, "the chaosfs sidecar isn't injected into target pod default/p1")).To(BeTrue())
+
+	in.pods["default/p1"].Annotations = map[string]string{"admission-webhook.chaos-mesh.org/status": "injected"}
+	g.Expect(diagnose(in)).To(Equal([]string{"no problem is found"}))
+}
+
+func TestDiagnoseHeterogeneousNodes(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &v1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "delay"},
+	}
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	chaos.Status.Experiment.PodRecords = []v1alpha1.PodStatus{{Namespace: "default", Name: "p1"}, {Namespace: "default", Name: "p2"}}
+	in := newInput(chaos)
+	for pod, node := range map[string]string{"p1": "n1", "p2": "n2"} {
+		in.pods["default/"+pod] = &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: pod},
+			Spec:       v1.PodSpec{NodeName: node},
+			Status:     v1.PodStatus{Phase: v1.PodRunning},
+		}
+		in.daemons[node] = &v1.Pod{Status: v1.PodStatus{Phase: v1.PodRunning}}
+	}
+	in.capabilities["n1"] = &pb.CapabilitiesResponse{Os: "linux", Arch: "amd64"}
+	in.capabilities["n2"] = &pb.CapabilitiesResponse{Os: "linux", Arch: "amd64"}
+	g.Expect(diagnose(in)).To(Equal([]string{"no problem is found"}))
+
+	in.capabilities["n2"].Arch = "arm64"
+	g.Expect(contains(diagnose(in), "different platforms [linux/amd64 linux/arm64]")).To(BeTrue())
+}
+
+func TestCapabilityLines(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(capabilityLines(nil)).To(Equal([]string{"chaos-daemon doesn't report capabilities"}))
+	g.Expect(capabilityLines(&pb.CapabilitiesResponse{
+		KernelVersion:  "5.10.0",
+		Arch:           "arm64",
+		Modules:        []string{"sch_netem"},
+		CgroupVersion:  2,
+		KernelConfig:   "/proc/config.gz",
+		KernelFeatures: []string{"bpf_syscall"},
+		Runtimes:       []*pb.RuntimeStatus{{Name: "containerd", Healthy: true}},
+	})).To(Equal([]string{
+		"platform: linux/arm64",
+		"kernel: 5.10.0",
+		"mode: privileged",
+		"modules: [sch_netem]",
+		"cgroup: v2",
+		"bpf: false",
+		"kernel features: [bpf_syscall] (from /proc/config.gz)",
+		"runtime containerd: healthy",
+	}))
+}
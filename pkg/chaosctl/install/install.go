@@ -0,0 +1,305 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/shurcooL/httpfs/vfsutil"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+const webhookService = "chaos-mesh-controller-manager"
+
+// runtimeSockets is the default socket path of the container runtimes supported by chaos-daemon,
+// chaos-daemon always finds the socket at the default path in its container.
+// With auto, the directory which contains the sockets is mounted and chaos-daemon detects the runtimes.
+var runtimeSockets = map[string]string{
+	"docker":     "/var/run/docker.sock",
+	"containerd": "/run/containerd/containerd.sock",
+	"crio":       "/var/run/crio/crio.sock",
+	"auto":       "/run",
+}
+
+// templates are rendered in order after the namespace and the CRDs
+var templates = []string{
+	"controller-manager-rbac.yaml",
+	"webhook-configuration.yaml",
+	"controller-manager.yaml",
+	"chaos-daemon.yaml",
+	"chaos-dashboard.yaml",
+}
+
+// FeatureGates switches the optional features of Chaos Mesh
+type FeatureGates struct {
+	// Dashboard deploys chaos-dashboard
+	Dashboard bool
+	// SidecarInjection registers the webhook which injects sidecars into the pods, which is required by IoChaos
+	SidecarInjection bool
+	// LeaderElection enables leader election of controller-manager
+	LeaderElection bool
+	// FilterNamespace only allows chaos in the namespaces annotated with `chaos-mesh.org/inject=enabled`
+	FilterNamespace bool
+	// SecurityMode checks the permission of the creator of chaos in the target namespaces
+	SecurityMode bool
+	// Simulate simulates all chaos without injecting them
+	Simulate bool
+}
+
+// DefaultFeatureGates returns the feature gates which are the same as the default install
+func DefaultFeatureGates() FeatureGates {
+	return FeatureGates{
+		Dashboard:        true,
+		SidecarInjection: true,
+	}
+}
+
+func (f *FeatureGates) gates() map[string]*bool {
+	return map[string]*bool{
+		"Dashboard":        &f.Dashboard,
+		"SidecarInjection": &f.SidecarInjection,
+		"LeaderElection":   &f.LeaderElection,
+		"FilterNamespace":  &f.FilterNamespace,
+		"SecurityMode":     &f.SecurityMode,
+		"Simulate":         &f.Simulate,
+	}
+}
+
+// FeatureGateNames returns the names of all feature gates
+func FeatureGateNames() []string {
+	f := FeatureGates{}
+	names := make([]string, 0)
+	for name := range f.gates() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseFeatureGates parses the feature gates such as `Dashboard=false,SecurityMode=true`,
+// the gates which are not specified are the same as DefaultFeatureGates
+func ParseFeatureGates(s string) (FeatureGates, error) {
+	f := DefaultFeatureGates()
+	gates := f.gates()
+
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 {
+			return f, fmt.Errorf("feature gate %s should be in the form of name=true|false", item)
+		}
+		gate, ok := gates[strings.TrimSpace(kv[0])]
+		if !ok {
+			return f, fmt.Errorf("unknown feature gate %s, the available gates are %s",
+				kv[0], strings.Join(FeatureGateNames(), ", "))
+		}
+		enabled, err := strconv.ParseBool(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return f, fmt.Errorf("invalid value of feature gate %s: %v", kv[0], err)
+		}
+		*gate = enabled
+	}
+
+	return f, nil
+}
+
+// Options is the options to render the manifests
+type Options struct {
+	Namespace         string
+	Runtime           string
+	RuntimeSocketPath string
+	ImageRegistry     string
+	ImageTag          string
+	FeatureGates      FeatureGates
+}
+
+type values struct {
+	Options
+
+	RuntimeSocketMountPath string
+	CRDs                   []string
+	TLSCert                string
+	TLSKey                 string
+	CABundle               string
+}
+
+// Render renders the manifests of Chaos Mesh, which contain the namespace, the CRDs,
+// the RBAC, the webhook configurations with new certs and the deployments
+func Render(opts Options) ([]byte, error) {
+	mountPath, ok := runtimeSockets[opts.Runtime]
+	if !ok {
+		return nil, fmt.Errorf("container runtime %s is not supported", opts.Runtime)
+	}
+	if opts.RuntimeSocketPath == "" {
+		opts.RuntimeSocketPath = mountPath
+	}
+
+	certs, err := generateWebhookCerts(webhookService, opts.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &values{
+		Options:                opts,
+		RuntimeSocketMountPath: mountPath,
+		CRDs:                   chaosResources(),
+		TLSCert:                base64.StdEncoding.EncodeToString(certs.Cert),
+		TLSKey:                 base64.StdEncoding.EncodeToString(certs.Key),
+		CABundle:               base64.StdEncoding.EncodeToString(certs.CA),
+	}
+
+	docs := make([][]byte, 0, len(templates)+2)
+
+	namespace, err := renderTemplate("namespace.yaml", v)
+	if err != nil {
+		return nil, err
+	}
+	crds, err := vfsutil.ReadFile(manifests, "/crd/crd.yaml")
+	if err != nil {
+		return nil, err
+	}
+	docs = append(docs, namespace, crds)
+
+	for _, name := range templates {
+		doc, err := renderTemplate(name, v)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	var buf bytes.Buffer
+	for _, doc := range docs {
+		doc = bytes.TrimSpace(doc)
+		// the template renders nothing if the feature is disabled
+		if len(doc) == 0 {
+			continue
+		}
+		buf.WriteString("---\n")
+		buf.Write(bytes.TrimPrefix(doc, []byte("---\n")))
+		buf.WriteString("\n")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func renderTemplate(name string, v *values) ([]byte, error) {
+	data, err := vfsutil.ReadFile(manifests, "/templates/"+name)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// chaosResources returns the resource names of all the chaos, which are registered in the webhooks
+func chaosResources() []string {
+	resources := make([]string, 0)
+	for kind := range v1alpha1.AllKinds() {
+		resources = append(resources, strings.ToLower(kind))
+	}
+	sort.Strings(resources)
+	return resources
+}
+
+// Decode decodes the rendered manifests into objects
+func Decode(data []byte) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+
+	decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		var raw runtime.RawExtension
+		if err := decoder.Decode(&raw); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		doc := bytes.TrimSpace(raw.Raw)
+		if len(doc) == 0 || bytes.Equal(doc, []byte("null")) {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(doc, &obj.Object); err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// Apply creates the objects, or merges them into the existing ones, and writes the result of each object into w
+func Apply(ctx context.Context, c client.Client, objects []*unstructured.Unstructured, w io.Writer) error {
+	for _, obj := range objects {
+		kind := strings.ToLower(obj.GetKind())
+
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(obj.GroupVersionKind())
+		err := c.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, existing)
+		if k8serror.IsNotFound(err) {
+			if err := c.Create(ctx, obj); err != nil {
+				return fmt.Errorf("failed to create %s %s: %v", kind, obj.GetName(), err)
+			}
+			fmt.Fprintf(w, "%s/%s created\n", kind, obj.GetName())
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get %s %s: %v", kind, obj.GetName(), err)
+		}
+
+		// merge patch keeps the fields which are set by the cluster, such as the clusterIP of services
+		patch, err := json.Marshal(obj.Object)
+		if err != nil {
+			return err
+		}
+		if err := c.Patch(ctx, existing, client.ConstantPatch(types.MergePatchType, patch)); err != nil {
+			return fmt.Errorf("failed to update %s %s: %v", kind, obj.GetName(), err)
+		}
+		fmt.Fprintf(w, "%s/%s configured\n", kind, obj.GetName())
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestParseFeatureGates(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	gates, err := ParseFeatureGates("")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(gates).To(Equal(DefaultFeatureGates()))
+
+	gates, err = ParseFeatureGates("Dashboard=false, SecurityMode=true")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(gates.Dashboard).To(BeFalse())
+	g.Expect(gates.SecurityMode).To(BeTrue())
+	g.Expect(gates.SidecarInjection).To(BeTrue())
+
+	_, err = ParseFeatureGates("Unknown=true")
+	g.Expect(err).To(HaveOccurred())
+	_, err = ParseFeatureGates("Dashboard")
+	g.Expect(err).To(HaveOccurred())
+	_, err = ParseFeatureGates("Dashboard=maybe")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestRender(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	gates := DefaultFeatureGates()
+	gates.Dashboard = false
+	data, err := Render(Options{
+		Namespace:         "chaos",
+		Runtime:           "containerd",
+		RuntimeSocketPath: "/run/k3s/containerd/containerd.sock",
+		ImageRegistry:     "registry.local/pingcap",
+		ImageTag:          "v1.0.0",
+		FeatureGates:      gates,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	objects, err := Decode(data)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	kinds := map[string]int{}
+	byName := map[string]*unstructured.Unstructured{}
+	for _, obj := range objects {
+		kinds[obj.GetKind()]++
+		byName[obj.GetKind()+"/"+obj.GetName()] = obj
+		if obj.GetKind() != "Namespace" && obj.GetKind() != "CustomResourceDefinition" && obj.GetNamespace() != "" {
+			g.Expect(obj.GetNamespace()).To(Equal("chaos"))
+		}
+	}
+	g.Expect(kinds["Namespace"]).To(Equal(1))
+	// ChaosProtection, ChaosTemplate, ChaosMonkey, ChaosNotification and ChaosResult aren't chaos resources
+	g.Expect(kinds["CustomResourceDefinition"]).To(Equal(len(chaosResources()) + 5))
+	g.Expect(kinds["DaemonSet"]).To(Equal(1))
+	g.Expect(kinds["Deployment"]).To(Equal(1), "the dashboard is disabled")
+
+	daemon := byName["DaemonSet/chaos-daemon"]
+	containers, _, _ := unstructured.NestedSlice(daemon.Object, "spec", "template", "spec", "containers")
+	container := containers[0].(map[string]interface{})
+	g.Expect(container["image"]).To(Equal("registry.local/pingcap/chaos-daemon:v1.0.0"))
+	g.Expect(container["command"]).To(ContainElement("containerd"))
+	volumes, _, _ := unstructured.NestedSlice(daemon.Object, "spec", "template", "spec", "volumes")
+	socket, _, _ := unstructured.NestedString(volumes[0].(map[string]interface{}), "hostPath", "path")
+	g.Expect(socket).To(Equal("/run/k3s/containerd/containerd.sock"))
+
+	validating := byName["ValidatingWebhookConfiguration/chaos-mesh-validation"]
+	webhooks, _, _ := unstructured.NestedSlice(validating.Object, "webhooks")
+	g.Expect(webhooks).To(HaveLen(len(chaosResources()) + 5))
+
+	// the serving cert is signed by the ca bundle of webhooks
+	caBundle, _, _ := unstructured.NestedString(webhooks[0].(map[string]interface{}), "clientConfig", "caBundle")
+	secret := byName["Secret/chaos-mesh-webhook-certs"]
+	tlsCrt, _, _ := unstructured.NestedString(secret.Object, "data", "tls.crt")
+
+	pool := x509.NewCertPool()
+	g.Expect(pool.AppendCertsFromPEM(decodeBase64(g, caBundle))).To(BeTrue())
+	block, _ := pem.Decode(decodeBase64(g, tlsCrt))
+	cert, err := x509.ParseCertificate(block.Bytes)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = cert.Verify(x509.VerifyOptions{
+		DNSName:   "chaos-mesh-controller-manager.chaos.svc",
+		Roots:     pool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = Render(Options{Namespace: "chaos", Runtime: "rkt"})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func decodeBase64(g *GomegaWithT, s string) []byte {
+	data, err := base64.StdEncoding.DecodeString(s)
+	g.Expect(err).NotTo(HaveOccurred())
+	return data
+}
+
+func TestApply(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	objects, err := Decode([]byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+  namespace: chaos
+data:
+  key: value
+`))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objects).To(HaveLen(1))
+
+	c := fake.NewFakeClientWithScheme(scheme.Scheme)
+	var out bytes.Buffer
+	g.Expect(Apply(context.Background(), c, objects, &out)).To(Succeed())
+	g.Expect(out.String()).To(Equal("configmap/foo created\n"))
+
+	objects[0].Object["data"] = map[string]interface{}{"key": "new"}
+	out.Reset()
+	g.Expect(Apply(context.Background(), c, objects, &out)).To(Succeed())
+	g.Expect(out.String()).To(Equal("configmap/foo configured\n"))
+
+	var cm v1.ConfigMap
+	g.Expect(c.Get(context.Background(), types.NamespacedName{Namespace: "chaos", Name: "foo"}, &cm)).To(Succeed())
+	g.Expect(cm.Data).To(Equal(map[string]string{"key": "new"}))
+}
@@ -0,0 +1,280 @@
+// Code generated by vfsgen; DO NOT EDIT.
+
+package install
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	pathpkg "path"
+	"time"
+)
+
+// manifests statically implements the virtual filesystem provided to vfsgen.
+var manifests = func() http.FileSystem {
+	fs := vfsgen۰FS{
+		"/": &vfsgen۰DirInfo{
+			name:    "/",
+			modTime: time.Time{},
+		},
+		"/crd": &vfsgen۰DirInfo{
+			name:    "crd",
+			modTime: time.Time{},
+		},
+		"/crd/crd.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "crd.yaml",
+			modTime:          time.Time{},
+			uncompressedSize: 325553,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xed\x72\xdc\x38\x92\xe0\x7f\x3e\x05\xa2\xf6\x22\xb4\x7b\xa7\x2a\x95\xc6\xa3\xbd\x75\x6d\xc4\xed\xc9\xb2\xdd\xad\x1b\xcb\x56\x4b\x72\xcf\xec\x5d\xdf\x6e\xa3\x48\x54\x15\x46\x24\xc1\x06\x48\x49\x35\x13\x7e\xf7\x8b\xc4\x17\x41\x12\xe0\x87\x24\xf7\xb9\x3b\xd4\x52\x44\x5b\x04\x90\x48\x24\x12\x89\x44\x22\x33\x31\x9f\xcf\x23\x5c\xd0\x1f\x09\x17\x94\xe5\x2b\x84\x0b\x4a\x1e\x4a\x92\xc3\x5f\x62\x71\xfb\x2f\x62\x41\xd9\xd1\xdd\xf1\x9a\x94\xf8\x38\xba\xa5\x79\xb2\x42\x67\x95\x28\x59\x76\x45\x04\xab\x78\x4c\xde\x92\x0d\xcd\x69\x49\x59\x1e\x65\xa4\xc4\x09\x2e\xf1\x2a\x42\x08\xe7\x39\x2b\x31\x7c\x16\xf0\x27\x42\x31\xcb\x4b\xce\xd2\x94\xf0\xf9\x96\xe4\x8b\xdb\x6a\x4d\xd6\x15\x4d\x13\xc2\x65\x0f\xa6\xff\xbb\xe5\xe2\x0f\x8b\x93\x08\xa1\x98\x13\xd9\xfc\x86\x66\x44\x94\x38\x2b\x56\x28\xaf\xd2\x34\x42\x28\xc7\x19\x59\xa1\x78\x87\x99\xc8\x58\x7e\x4b\xf6\x62\x21\xff\x98\x67\x44\xec\x16\x8c\x6f\x23\x51\x90\x18\x7a\xdd\x72\x56\x15\x2b\xd4\x2a\x55\x10\x34\x5a\x7a\x48\xd0\xfe\x42\x02\x93\x5f\x53\x2a\xca\x3f\xb5\x4b\x3e\x50\x51\xca\xd2\x22\xad\x38\x4e\x9b\x28\xc8\x02\x41\xf3\x6d\x95\x62\xde\x28\x8a\x10\x12\x31\x2b\xc8\x0a\x7d\xc4\x19\x11\x05\x8e\x49\x02\xdf\xaa\x35\xd7\x24\xd4\xa8\x88\x12\x97\x95\x58\xa1\xbf\x7f\x89\x10\xba\xc3\x29\x4d\x24\x01\x54\x21\x2b\x48\x7e\x7a\x79\xfe\xe3\xab\xeb\x78\x47\x32\x49\x62\xf8\x9c\x10\x11\x73\x5a\xc8\x7a\x2e\xae\x88\x0a\x54\xee\x08\x52\xb5\xd1\x86\x71\xf9\xa7\x8b\x31\x3a\xbd\x3c\x5f\xa0\x53\xb7\x95\x06\xaa\x26\x8b\xe6\x15\xab\x44\xba\x47\x5b\x92\x13\x8e\x4b\x22\x90\xc8\x70\x9a\x22\x8e\xf3\x84\x65\xf4\x6f\x24\x41\xe4\xa1\x20\x9c\x66\x24\x2f\x05\x62\xb9\xec\x42\x90\x94\xc4\x25\x49\x50\xc1\x12\xb1\xd0\x10\x0b\xce\x0a\xc2\x4b\x6a\xa8\x0e\x3f\x0e\xd7\xd9\x6f\xad\x01\x1d\xc0\x88\x55\x1d\x94\x00\x9f\x11\x35\xaa\x3b\xf5\x8d\x24\x48\xa8\xf1\xb1\x0d\x2a\x77\x54\x20\x4e\x0a\x4e\x04\xc9\x15\xe7\x39\x60\x11\x62\x1b\x84\x73\xc4\xd6\x7f\x25\x71\xb9\x40\xd7\x84\x03\x10\x24\x76\xac\x4a\x13\x18\xef\x1d\xe1\x25\xe2\x24\x66\xdb\x5c\x0e\x4d\x41\x16\xa8\x64\xb2\xcb\x14\x08\x50\x36\x20\xd2\xbc\x24\x3c\xc7\x29\xcc\x55\x45\x0e\x11\xce\x13\x94\xe1\x3d\xe2\x04\xfa\x40\x55\xee\x40\x93\x55\xc4\x02\x5d\x30\x4e\x10\xcd\x37\x6c\x85\x76\x65\x59\x88\xd5\xd1\xd1\x96\x96\x66\x9d\xc5\x2c\xcb\xaa\x9c\x96\xfb\x23\x98\x00\x4e\xd7\x55\xc9\xb8\x38\x4a\xc8\x1d\x49\x8f\x04\xdd\xce\x31\x8f\x77\xb4\x24\x71\x59\x71\x72\x84\x0b\x3a\x97\x88\xe7\x30\x58\xb1\xc8\x92\x7f\xb0\x1c\x75\xe0\x60\x5a\xee\x81\xf9\x44\xc9\x69\xbe\xb5\x9f\x25\xdf\x07\xe9\x0e\xbc\x0f\x2c\x84\x75\x33\x35\xc4\x9a\xbc\xf0\x09\xa8\x72\xf5\xee\xfa\x06\x99\x4e\xe5\x14\x38\x20\x91\xa6\x76\xdd\x4c\xd4\x84\x07\x42\xd1\x7c\x43\x80\x2f\xa9\x40\x1b\xce\x32\x49\x67\x92\x27\x05\xa3\x79\x29\xff\x88\x53\x4a\xf2\x26\xd1\x45\xb5\xce\x68\x09\x33\xfd\x4b\x45\x44\x09\xf3\xb3\x40\x67\x52\xda\xa0\x35\x41\x55\x91\xe0\x92\x24\x0b\x74\x9e\xa3\x33\x9c\x91\xf4\x0c\x0b\xf2\xd5\xc9\x0e\x14\x16\x73\x20\xe9\x30\xe1\x5d\x21\x69\xfe\x53\x15\x15\xb5\xec\x67\x23\xc4\xbc\x33\x74\x5d\x90\xb8\xb1\x24\xa4\x88\x91\x2c\x98\x52\x49\x20\xb9\x24\x88\x5d\xbc\x8d\xb5\xea\x40\xf5\xad\x4c\xf8\xc1\xb1\x23\xbb\x03\x48\x9c\xaa\x3a\x08\x73\x22\xfb\x92\x04\x80\x85\xe6\x8a\x05\x28\x50\x62\x1a\x15\x34\xbe\x55\x53\xdd\x82\x8a\xb4\x4c\x49\xf7\x8b\xa8\xf1\x19\xd1\x92\x64\x1d\x24\x5a\x68\x28\xd9\xa5\x90\x71\x78\x0d\x61\x89\x50\x13\x1f\x07\x9d\x0e\x50\x54\x4b\xba\x36\x1a\x08\x91\xbc\xca\xba\x78\xcc\x41\xca\xcd\x6f\xa9\xdc\x97\x9a\x3f\xaa\x68\x83\x69\x5a\x71\xe2\x29\xcd\x49\x79\xcf\xf8\xed\x3c\x21\x29\xde\x47\xad\x62\xa7\x3c\x65\x42\x78\x8a\xe3\xa2\x9a\x8b\x92\x13\x4f\xa1\x97\xed\x34\xf3\xd1\xfc\x5c\x52\x14\x1d\xb7\x4a\x54\x23\xcc\x79\x07\x99\x87\x39\xec\xd5\x3c\x27\x25\x11\x73\xd8\x18\xe7\xba\x6e\xc9\x32\x1a\x37\x2a\x03\xd3\xdc\x91\xef\x59\xc5\x87\x19\x47\xd7\x33\x1b\xd5\x3d\xcd\x13\x76\x8f\x68\x8e\xee\x77\x34\xde\xb9\x6c\xc3\xab\x5c\xb8\x2c\x75\xd8\x02\x8d\xdc\xca\x20\xb4\xd2\x7b\xbc\x17\x1a\x19\x44\x37\x88\x96\x07\x02\xe5\x34\x6d\xcf\x6a\x88\xf7\xe1\x27\xc1\x7b\xcf\xd7\xd6\x38\xde\xe2\x7d\xcd\xfd\xd0\x02\xb1\x0d\xba\x27\xe4\x16\xdd\xef\x48\xde\x18\x97\x90\x3b\x78\x17\x75\xf8\x21\x77\x84\xef\x51\x82\xf7\x16\x59\x92\x15\x65\x67\x2d\xf4\xac\x87\x0e\x66\x7f\x26\xe4\x56\x02\x04\x19\x0e\xff\xd0\x88\x79\x5b\xfa\x79\x1b\x7e\xe6\xe8\x82\xe5\x81\x92\x9b\xaa\xcb\xd6\xf0\x33\x47\x7f\x96\x0a\x4e\xf7\x67\x8e\x6e\x76\x55\xa0\xe4\x3d\xa7\x81\x92\x6b\x5c\x46\x9e\x02\x28\xa9\xfc\xb8\xf5\x2c\x80\x7e\x56\x9f\xc8\xee\xf0\x4b\x9a\xdb\xa8\x77\x32\xde\xa9\xcd\x54\x6f\x6f\x88\x6d\x1a\x9c\xa1\xf8\x64\xc3\x78\x06\x25\xb3\xe3\x93\xd5\xf2\x8f\x33\x3f\xa3\x88\x2a\xde\x21\x2c\xd0\xec\xf8\xbf\xaf\x96\xcb\xd9\x02\xdd\xd4\x70\xb6\x8c\x00\xcf\x73\x26\x04\xca\x68\x92\xd3\xed\xae\x04\x7e\xd2\x9d\xaf\xc9\x86\x79\xe4\x10\xfc\x5e\x97\x98\x97\x8b\x68\x22\x1d\x05\xb4\x1a\x1c\xba\x84\x6d\x06\xbf\x26\x5b\x9a\xe7\xa0\x3b\xf4\x91\xc0\x03\x12\x59\xb2\xd4\x24\x58\xbe\x96\x24\x98\x8a\x76\x49\x33\xf2\xbf\x59\x4e\x06\x31\x3f\xb8\xd1\x35\x0d\xf6\xe7\xa7\x1f\x4f\x65\x73\xf4\x37\x96\x93\xe6\x10\x14\x5e\x1e\x90\x48\x4e\xd7\xa9\xa0\xf8\xe8\x7a\x87\xf3\xed\x0e\xd3\xd9\x02\x36\x6e\x5c\xa5\xe5\x0a\x7d\xbe\x39\x5b\x1c\x44\x9d\x36\x7d\x43\x00\xc5\x87\x72\xd2\xe1\xba\x39\x22\x79\x7b\xd9\xcd\xd5\x2c\xb5\xbe\x7a\xb5\x0d\xf8\x4d\x2a\xee\x9c\x38\x42\x74\x79\xab\x6b\x01\x5d\x76\xec\x1e\xa5\x2c\xdf\x82\x6a\xed\x6c\xb2\x29\x06\xcd\x4c\xb1\x1c\x48\xdf\x83\xee\x26\xc5\x49\xcc\xee\x08\x27\xc9\xa1\xc3\xd5\x99\x4b\x9b\xe3\xac\x43\x9a\x20\x59\x76\x54\x94\x8c\xef\x3f\x80\xea\xd3\x8f\xfd\xf7\x4e\x4d\x33\xb3\x79\x95\xad\x09\x6f\x2b\x2e\xb7\xa4\x28\x35\x6b\xb6\x20\x9a\xa3\x9a\x8b\xec\xb2\x83\x6c\x46\x73\x9a\x55\xd9\x0a\x2d\x5b\x05\x6a\x14\x70\x7a\xd8\x12\xde\x28\x83\x6f\xb9\xa0\xe5\xbe\x77\x0c\xe7\xa6\x96\x51\xf5\x60\x0c\x6b\xa0\x39\xe2\x38\xa1\x95\x90\x6a\x20\x7c\x04\x05\x21\xdf\x96\x3b\x39\x34\xd8\x64\x5a\x60\x91\x33\xe0\x29\x9b\x63\x86\x1f\xce\x2e\x3f\x7f\x60\x78\x58\xf6\x1d\x5c\xd8\xba\x86\xdc\x19\x7e\x40\x05\xe1\x31\x1c\xd3\xb6\x72\x21\x9d\x5d\x7e\x46\x29\xd4\x60\x1b\x47\xb1\xf1\x89\x24\x54\x93\xfc\xa4\x4b\x72\x8d\x9b\x22\xfb\xf1\xb2\x4d\xf8\xde\x59\xe9\x9f\x19\x0d\xf9\x03\x2e\x49\x1e\xef\x47\x8d\x5a\xd7\x75\x47\x9d\xea\x4f\x6c\x63\xd5\x3b\xa9\xfe\x0d\x88\x8f\x3f\x2c\x97\x99\x68\x2c\x0d\xf8\x30\x55\x70\xa8\x01\x30\x21\xc6\x61\x0f\xfb\x48\x70\xc2\x12\xce\x8a\x82\x24\xa8\xc0\xf1\x2d\x91\x87\x0d\x0f\x4c\xd4\xd0\x61\xfb\x17\xcb\x57\x9f\xb9\x4b\x85\xff\xa8\xb1\xeb\xba\xe1\xe1\x77\xec\x1c\x1e\xa8\x08\xe1\xb2\x04\xf2\x24\x68\xbd\x6f\xca\xc7\xff\x7f\xa4\x08\x8a\x7e\xa8\xce\xef\x70\xba\x8a\xfa\x68\x73\xae\x6b\x19\xca\x98\x56\x68\x4d\xca\x7b\x02\x1a\xef\x3d\x73\xc6\x29\x02\x7c\x0d\x5b\xe2\xf1\xb2\x29\xec\x97\x13\xa4\x7d\x86\x1f\xce\x58\x1e\x57\x9c\x7b\x66\xb4\x33\x9b\x75\x55\x77\x42\xfd\x32\x9f\x57\x79\xde\xee\x0d\x7e\xb0\xb2\x47\x08\x9c\x11\xa9\x02\xb8\x98\x77\xf0\x0e\xce\x4e\x78\x66\x14\x33\x31\xde\x3b\x98\x6b\x5d\x09\x86\x51\x09\x92\x80\x69\x4a\x35\x94\xc8\x81\xbd\xad\x7b\x78\x6a\x59\x64\xe0\x17\xa7\x29\xbb\x57\xcd\x15\x8b\x2a\x3d\x52\xb6\xd7\xaa\x58\x6e\x2c\x95\x40\x20\x0d\x09\xf3\x9a\xe9\x3b\x30\xe9\x06\xe5\xcc\x69\x46\x05\xda\xd2\x3b\x92\x4f\xd9\x55\x6a\x93\xb1\x19\xa9\x57\x54\xe1\x24\x91\xe6\x66\x9c\x5e\xf6\x00\xeb\x65\x20\x0f\x71\x2f\x70\x01\x63\xd5\xe6\x2e\x69\x1f\x85\x5d\x54\xda\xbd\x80\x6b\x70\x89\x62\x9c\x4b\x13\x53\x83\xf4\xde\x8e\xd5\x02\x13\x60\x5d\x35\x33\x8b\xd6\x18\xda\xb1\xdc\xb5\x8c\xfb\x76\xb8\xe0\x12\x85\xdf\x0d\x25\x69\xf2\xbb\xa6\x8e\x1c\xe1\x74\xc2\xa4\x78\x4d\xd2\xdf\x35\x61\xe4\x08\xa7\x13\xc6\x2e\x49\xb1\x1a\x1a\x8b\xbd\x9e\x10\xda\xf4\x4b\x4a\x18\x5b\xbd\xa8\x4b\xa6\xe5\x8b\x46\x14\xad\x09\x28\xff\x13\xed\x14\xbf\xe6\xe9\x3c\x67\x09\xf9\xad\x73\x05\x8c\x41\x1a\xce\x35\x47\xa8\x29\xc8\x2a\x51\xa2\x0c\x97\x70\x74\x92\x55\x0e\x84\x66\x11\x75\x11\xa1\xa7\xc8\x0b\x51\xb6\x55\x73\xa7\xaf\x37\x84\xa3\xcf\x00\xb0\x47\xf0\x19\x4b\xfc\x94\x6b\xb2\x18\x4b\xda\xdc\xc5\x12\x22\xf7\x0d\x17\x6b\x17\x43\x0f\x48\x54\x63\x1d\x44\xf6\x1b\x61\xc0\x82\x25\x97\x3b\x2c\xfa\x99\xb0\x41\xa2\x83\xcb\x76\x93\x06\xbd\x62\x96\xab\xed\x0f\x18\x0c\x83\xf2\x89\x02\xf6\x31\xd0\x09\x8c\xe2\xa3\x74\x16\x51\x15\x05\xe3\xa5\xb9\x8e\x5a\xa1\x4b\x92\x27\x60\x8e\x39\x42\x57\x4a\xf1\x41\x47\xe8\xba\x8a\x63\x42\x92\x80\x09\xef\x08\xbd\xc7\x34\x25\x09\x3a\x42\x9f\xf3\xdb\x9c\xdd\xe7\x07\xdf\x34\xf1\x9f\xb8\xe8\x7b\x06\x32\x38\x94\xa1\xc1\x4c\x1e\x4e\x8b\x51\x2e\xa5\xbe\x06\xac\x91\xf9\xc5\x8d\xe2\x19\x47\xe8\x78\x91\x68\x4a\x20\x49\x33\xa5\x0f\x82\xf6\xe8\xde\x30\xd5\xfb\x80\x92\x40\xc1\x73\x8f\x92\x2c\x87\xd6\x0a\x41\x70\xbc\x33\x68\xb8\xac\x0c\xbc\x2b\x81\x4e\x14\x36\xc1\x22\x51\x89\xc2\x63\x8f\x6d\x50\xed\x5a\xd5\x41\xa2\x64\x85\x3e\x0d\x28\xf5\x16\xae\xa5\xcc\x05\x10\xac\x89\x9c\xdc\xbb\x47\x83\x36\x8e\x0a\x89\x35\x63\x29\xc1\x79\xd4\x6f\x9e\x9b\x9b\xdb\xb4\xc6\x37\xb3\xc5\x47\x03\x23\xd3\x6e\x01\x51\x60\x40\x17\x0c\xec\x3e\x04\x0e\xb7\xe9\x1e\xb1\xb5\x80\x9b\xed\x44\xb7\x32\x87\xd5\xce\x8d\x57\x48\x0f\x77\x46\xdc\x4b\xc6\x77\x75\x3d\x7b\xfd\x07\xd6\x0d\x51\xba\x20\xec\x85\x9a\x3c\x03\xfb\x0c\x69\x0a\xb1\x45\x34\x6a\xd9\xb5\xc6\x0d\x2d\x6b\x3c\x80\x06\x8c\x27\xa2\x65\x8a\x1c\xc4\xc0\xe0\xd0\x29\xe8\x3b\xaa\x98\xfb\x51\x5f\x89\x17\xcf\xf1\xb7\x93\x5e\x88\x06\x49\x3b\x1c\xb1\x98\x7c\x93\x13\xbc\xa9\x1c\xbe\xad\x1c\x73\x63\x39\xe2\xd6\x72\xf0\xe6\x72\x84\x54\x25\x79\x02\x86\xf9\x11\x84\x7f\xa7\x6a\x9a\x43\x3f\x6c\x81\xf5\xb5\x9c\x43\xf3\x7b\x2c\x1c\x6b\xb4\x17\x2e\xb2\x57\x88\xf6\x86\x4e\x5b\x0a\xfc\xd3\x00\x97\x39\xb8\x5c\x21\xf0\x45\x98\x43\xc7\x8f\x19\x69\xdb\x43\x63\x74\xc3\x1c\x87\xe8\x33\xd0\x50\x5e\x16\x84\xa9\xfb\x2c\xa3\x92\xfb\xc0\x88\xd9\xfb\x11\xea\x99\xb9\x6b\x5a\xdf\x60\xe3\x69\x18\xd7\x9a\x13\xba\x98\x8e\x56\xe8\x4e\xa5\x16\xdd\x9e\x02\x98\x1f\xcf\x67\xa0\xbe\xe7\xb3\xa5\xad\xa7\x4c\xd2\xa4\xf3\x3d\xb8\xcd\xf5\x29\x16\x13\x54\x0a\xb8\x30\xa8\xc5\xa7\x6f\xda\x1b\x13\xf2\xa1\x53\xdd\xbf\xb2\x00\xac\x33\x1b\x2d\x90\x48\x2e\x37\x2b\x94\x17\xd1\x34\x16\x0b\xcc\xa2\x87\x54\xed\x29\x9d\x4b\x7f\x9a\xc8\x5b\x5f\xbb\x93\xad\xd0\xdd\x31\x4e\x8b\x1d\x3e\xae\xbf\xc9\x5d\x68\xae\x5d\x0e\x9d\x62\x30\xd9\xc1\x3e\xbb\x42\x25\xd7\x73\x07\xf7\x4a\x78\x4b\xf4\x97\x7a\xd7\xc6\x71\x4c\x8a\x92\x24\x1f\xdb\x4e\x87\xb3\x59\xc3\x9b\x50\xfe\x69\xd5\x7b\xb1\x42\xff\xe7\xff\x82\x9b\x60\xc9\x38\x49\xb4\x13\x9c\xfa\xf8\xdb\x76\xd9\xcc\x59\x49\x37\x34\xd6\x06\xb0\x67\x71\xdc\xfc\xe8\x80\xf4\xb9\x6f\xba\xe5\x7e\x27\xce\x06\x52\x3e\x57\x4e\xb7\x42\xc0\xa1\xf3\xd1\x1e\x9b\x2e\x7a\x7d\x7e\x9b\x0d\x24\xa5\xf7\xa6\x06\x89\xd0\xa9\x07\x92\x20\xe0\x8d\x05\xc0\x32\x22\x04\xde\xc2\x21\x80\x21\x70\x0d\xe3\x24\x26\x14\x18\xbc\x5e\xb5\x92\xd2\x88\xd6\x72\x0e\xea\xd5\x1a\xbf\x94\x5e\xe2\x10\x81\x82\x20\xc1\xac\xc1\xf8\x0f\xcb\x0d\x2e\x70\x41\xa9\x5f\xab\x83\x25\xe3\x68\x43\x73\x2a\x76\xc4\xd8\x21\x0a\xe2\xe8\xbd\x34\x8f\x69\x22\xd5\x1f\xd9\xb3\x46\x06\x2e\x82\xf7\x0a\xf6\x22\x0a\x2b\x5f\x2f\x0e\xa3\x2f\x0e\xa3\x2f\x0e\xa3\xcf\xe5\x30\x4a\x80\x0d\x6a\x57\x81\x5a\x26\xe8\x23\x63\x4b\xe2\x21\x14\x5e\x98\xda\x87\x6d\xf0\xcc\x78\xd7\x3c\x2e\xd2\x0d\x89\xf7\x71\x6a\x51\x51\x66\x05\x28\x06\x8e\x39\x44\xe0\x6b\xae\x8a\x5a\x50\x91\xad\xd4\xef\x30\x37\xe6\x18\xe9\x8a\xcc\x77\x77\xfa\x92\x10\xb7\x91\x03\x61\x20\x45\x64\x07\x98\x77\xca\x9e\x51\x4b\x83\x65\xd7\x4f\x56\x58\x7a\x1e\x1f\x5c\x89\x2e\xba\xdf\x31\x61\x09\x5c\x93\x36\x78\x2d\x7b\xc9\x12\xb9\x27\x69\xdf\x32\xdd\x10\x4c\x8d\x69\xaa\x61\x3f\x89\xf6\x5f\x9b\x5c\x96\x8d\x7b\x49\x76\x65\x99\xdd\x90\xad\x66\x7f\xe7\x06\xd5\x6c\x9b\x66\xc4\x2d\x90\x60\x86\x7e\x2a\xcb\x19\x4c\xec\xd2\xbc\xdf\x11\x4e\x9a\xbb\x76\xb0\x7b\x89\x80\x9c\x27\xb3\xaf\xd6\xe3\x38\x44\x74\x41\x16\xa8\xc0\x5b\xc2\x93\xaa\xdc\xeb\xcd\x58\x6c\x49\x4e\xc9\x21\x62\x39\x18\x8b\x0a\xd2\xdc\xf2\xda\x9b\xb4\x0e\xe9\xb8\xd2\x5b\xb4\x36\xfd\x02\xa4\x2b\x22\x68\x52\xe1\xf4\x3d\x38\xa4\x08\xcb\x60\x79\x22\x85\x7b\x7a\xd7\x3d\x02\x49\x2f\xdd\xac\x05\x12\x42\x48\xa0\xe9\x21\xe8\x5b\x32\x82\x80\x20\x79\xaf\x2e\x50\x4a\x36\xc6\xfd\x0a\x95\x98\x6f\x89\xf7\x32\x03\xf3\x7a\xf0\x52\xae\x65\x82\xa4\x77\x3e\x93\x62\x48\x70\xe9\x65\x46\xf6\xef\x39\x0b\xd8\x4e\x1a\xb3\xf7\x27\x55\x13\x71\x82\xb5\x7a\x05\xd6\x43\x2d\x34\xbb\xf3\xe0\x77\x77\xd7\xc8\x23\x41\x62\x4e\xec\x30\x6b\x7d\xcb\x23\x83\x0f\x35\x67\x2a\x05\x31\x00\x51\xfa\x16\x68\xf7\x3c\x8d\xd6\x25\x70\xc0\x5b\xe0\x00\xad\x4b\x9e\x5e\x9e\x9b\xb2\x4f\x9a\x1f\xba\xd4\x1a\xa6\x98\xa6\x5a\xa8\xa8\x45\xb5\x9b\x26\x9d\xf4\xb8\x6b\xb3\x33\x50\x69\x81\xd0\x85\xba\xf7\x09\xc2\x04\x9a\x49\x3d\xdb\x50\xce\x63\x3c\x1c\x25\x6c\x86\x6d\x25\x9d\x21\x1c\x80\xb6\x6f\x06\xc0\xc9\x86\x80\x4b\x89\x57\x53\xa8\xa5\x15\x28\x0b\x09\x8b\x05\xa8\x67\x70\x0a\x14\x47\xb0\x90\xee\x28\xb9\x3f\x02\xc7\x28\x9a\x6f\xe7\xf7\xb4\xdc\xcd\xf5\xbd\xd7\x11\xa0\x23\x8e\xfe\x41\xfe\x2f\x88\x15\x42\x37\x9f\xde\x7e\x5a\xa1\xd3\x24\x41\xac\xdc\x11\x0e\xb7\x88\x9b\x2a\x35\x77\xe7\x8e\xa2\x7c\x28\x65\xf6\x21\xaa\x68\xf2\x6f\x07\x51\x00\xda\x18\x3a\x31\x49\x84\xae\x7f\x50\x80\x56\x10\x9e\x42\x37\x7b\x38\x5f\x48\x04\x81\x64\xd7\x6a\xc6\x18\x97\x67\x10\x60\x06\x7d\xcb\x17\x04\x89\xb4\x54\x4c\x06\x30\xef\x5a\xe1\xc7\x98\x76\xb4\x15\xc7\x63\xf9\x0d\x6a\x58\xc1\x4d\x29\xc3\x45\x78\x4f\xd2\x00\x49\x56\xc0\x99\x60\x15\x0d\x52\xef\x46\x57\x45\xc0\x2c\x9c\x26\x5a\x63\x2b\xed\xe7\x5e\x0d\xcd\xfd\x51\x71\x7f\xb4\x3e\xf2\x2d\xa2\x47\x30\x80\x2c\x1e\x81\xf6\xbe\xa8\xad\xad\xfb\xc2\x59\x2b\x7d\x7d\xf7\x99\xac\x45\x8a\xe3\xdb\x40\x59\x49\x70\xe6\xdb\x11\xa0\xdd\x3d\x59\xef\x18\x0b\xb5\xb4\x7b\x62\xa0\xdc\xec\x92\x8f\x21\x55\xc5\xd3\x11\x94\xfa\x7c\xf5\xc1\x10\xaa\xe2\x69\x48\xe5\x28\x98\x80\x23\x75\x57\xc9\x30\xff\x9d\x43\x5c\x8b\x59\x99\xd6\x56\xd0\xdd\x83\x0e\xb5\x1a\xa8\x7d\xd6\x50\xc5\x53\x3f\xe5\x90\x55\x23\x8b\x6a\x9d\xd2\xd8\x1e\xae\x44\x73\x27\x01\x0d\xa0\x7f\xef\x18\x26\xd3\xc8\xed\xf6\xf3\xd5\x87\xd6\x76\x0b\x14\x83\xed\x22\xbc\x7d\x7a\xa1\x22\xdf\xb2\x71\xf5\x0e\x9a\xc7\x2c\x83\x73\xaa\xe6\x1e\x39\xe6\x6b\xe0\x40\xd0\x9d\x02\x30\x6f\x80\x0b\x25\xd5\x62\x4e\x80\xea\x14\xd7\x46\x8c\x97\xed\xf4\x65\x3b\x7d\xd9\x4e\xbf\xc6\x76\x1a\x46\x63\x2e\xbb\x8a\x26\xf4\xff\x2c\xe7\x4e\xb3\x37\xaf\xa2\x9e\x69\x3c\xb0\x1b\xbb\x16\xfe\xdf\xb1\xce\xa6\x6e\x76\x80\x5a\xdd\x6f\x41\x84\x3b\x7a\x12\x57\xb0\x33\x00\xaf\xd7\xb6\x9c\x43\x44\x16\xdb\x05\x9a\xfd\xfd\xef\x68\xa1\x2c\x19\x5f\xbe\xac\x10\xfc\x05\x16\x02\xf4\xe5\x8b\xfc\xb7\x35\x59\x77\xc0\x7e\xf9\x72\x64\x2a\xa0\x2f\x5f\xb4\x01\xc0\x6c\x19\x16\x4d\xe3\x7b\xac\x0c\x2f\xf6\xf4\x7f\x10\x8d\x5a\x03\xbe\x79\x9b\xd7\x1b\x55\xd4\x3b\x61\x2f\x57\x3a\xdf\xe8\x95\x4e\xc1\x19\xd8\x3a\x9f\xef\x42\xe7\xd2\x02\xf4\x5d\xe7\xd4\xa5\xfe\xcb\x1c\x07\x1d\xdf\x55\x4e\x5d\x5c\x5f\xe4\x9c\xa5\x95\x28\x09\x7f\xca\x2d\x4e\x8d\x55\xdf\x1d\x8e\x83\x9b\xbc\xc1\x41\x37\xce\x59\x5e\x5e\x6c\x5b\x3f\x4c\x88\x1a\xe9\x80\x06\x4d\x23\x87\x78\x61\x6d\x13\x31\xd1\x25\x7b\x05\x7e\x11\x85\x75\x0d\x87\xbf\xa2\x90\x80\x7a\xb9\x3e\x79\xb9\x3e\x79\xb9\x3e\x19\x73\x7d\xa2\x17\x32\xec\xc2\x8c\xdf\x42\xf0\xa2\x88\x86\x75\x7d\x61\x7c\x7a\x9b\x9f\xdb\x9d\x99\x5a\x9a\x25\x94\xbb\xa4\x6d\xeb\xe8\xf9\x0d\x4c\x5a\x20\x91\xf6\xe5\x3c\x85\xff\x03\xa4\x1a\x65\xb9\x79\xa3\x8c\x40\xe8\x1e\xc8\x0e\xb9\x9c\x6b\x27\x70\xc6\x3b\x47\x98\x31\x76\x6d\x83\x76\x87\x56\x82\x65\xc4\x8b\xbe\xd6\xd3\xdb\x9d\xc1\xcf\xb9\x44\x49\x5e\x3b\xd4\x2d\x41\xfa\xc9\xd4\x08\xea\x72\x48\x37\x47\xf7\x34\x4d\xad\x33\x3d\xcd\x03\x37\x35\x7d\xc1\xae\xe1\x19\xd3\xb2\xd3\xee\xa3\x76\x6e\x7c\xd5\xa6\xb8\x16\x07\xd8\x35\x48\xdd\xc9\x31\x05\x81\x5e\x5b\xa4\xf7\xc6\x9a\x38\x6a\xc3\x22\x0a\xa3\xde\x5a\x40\x53\xc2\x94\x7e\x2f\x94\xd2\x67\xc4\xc7\x10\x69\x38\x64\xe9\xf7\x42\xa4\x70\xe8\xd2\x20\x91\xac\x4d\x47\xac\x86\xc7\x64\xcf\x36\x4d\xc1\x19\x0e\x60\xf2\x82\x34\x0e\xec\x7e\x7c\x03\x82\x70\xe4\x14\x84\xcf\x9a\x93\x4f\x9c\x23\x03\x9c\x7e\x43\x3c\xf4\xa8\x40\xa7\x00\x48\x3d\xc1\x8f\x0d\x75\x1a\xe6\x4b\x96\x8c\x63\xc9\x67\x0a\x78\x1a\x13\xf2\xf4\xcd\x31\xe7\xa8\xe0\xa7\xa7\x86\x3f\x79\x41\xda\x88\xed\x67\x0f\x80\x1a\x1b\x02\xf5\x2d\x4e\xc6\x33\x08\x88\xde\x41\x8d\x18\xd6\xf0\xc0\x1e\x31\xb4\xaf\x13\x18\xf5\x35\x42\xa3\x9e\x29\x38\x6a\x40\x3c\xf5\x14\x86\xa9\x3f\x9a\xea\x7e\x1b\x9e\xd9\xf5\x45\xd4\x8b\xc7\x8b\x0d\xef\x1b\xb5\xe1\x71\x22\xc0\xed\x26\x64\xbf\x73\x65\x04\x38\x99\xf0\x33\x96\x56\x99\x99\x87\xff\x75\xfd\xe9\xe3\x25\x2e\x77\x2b\xb4\x80\x06\x0b\xe7\xa0\x65\xa3\x24\x54\x5f\xf6\xcf\x96\xa4\xe8\x85\x61\x43\x2a\xe0\x1f\xab\x76\x70\x41\x2f\x24\xe9\x85\xbf\xb8\x23\x3c\xa1\x9a\x07\x35\xcf\x38\x5f\x46\x00\x30\x99\xb1\x1c\x08\x8d\x4f\x2d\x10\xd3\xac\x9d\x57\x92\xf4\x3e\x4b\xa7\x2a\xf1\x5b\x39\xf5\x84\xf9\x2c\x9c\xdc\x00\xfc\x35\xf3\x0e\x2b\x5c\xfb\x6c\x9f\x1a\xe3\x46\xde\x61\x67\xec\xf0\x4b\x85\xe2\x56\x92\xc8\xa6\x52\x38\xf2\x4a\x6e\xf6\x92\x31\x95\xdc\xa4\x25\xa2\x22\x3f\x28\x11\xbb\xcf\xeb\x08\x20\x5d\x41\xb0\x86\x7f\x94\xca\x0c\x46\x89\xf5\x6b\xad\xb9\x47\x20\x51\xf1\x3b\x7a\x57\x07\xaf\xec\x0f\xb8\x35\x69\x1d\x22\x4e\xe6\xb2\x6f\x8e\x12\x92\x12\x27\x5a\xe5\xc5\xba\xfa\x62\x5d\x7d\xb1\xae\x7e\x4d\xeb\x6a\xbd\x48\x6b\x07\xf5\x2a\xaf\xbd\x05\x1a\x42\x23\x6c\xbc\xab\xc1\x34\xbf\xb7\xba\xaf\xc3\xda\x8c\xf8\x32\xce\xd3\xda\xe1\x92\x77\x12\x8d\x86\x7a\x84\x1f\x1d\xdd\xd6\x12\x05\xde\x9e\xbf\xb3\x55\x4d\xcf\x75\x63\x33\xda\x26\x2e\x1e\x88\xc8\x83\x9f\x1b\x4c\x47\xf3\xf2\x9f\xff\x38\x31\x51\x58\x77\x49\xf5\xb2\xc1\x90\xff\x47\x6f\xa3\x8a\x26\x83\x84\xfa\x7c\xfe\x16\x28\x84\x25\xd2\x52\x67\x47\x3b\x96\x26\x02\x55\x39\xfd\xa5\x22\xe8\xfc\xad\x16\x4a\x87\x88\xe6\x71\x5a\x25\xbe\x8e\xe0\xf7\xf3\xe7\xf3\xb7\x62\x81\xd0\x1b\x12\xe3\x4a\x10\x74\x4f\x50\xc2\x60\x2f\xf9\xf4\xf1\xc3\xbf\x83\xc6\xaf\x6a\x1c\x2a\x09\x0c\x5d\xe6\x08\xa7\x54\x89\x4e\x35\x00\xd9\x3a\x04\x5f\x63\x18\xe3\x02\xa4\x9a\x90\xe4\xd5\x5c\xbc\x23\x69\x01\xa2\xe2\x96\xc0\xc6\x03\x02\x06\x97\x08\x3a\x93\xa5\x40\x3a\x81\x12\xbf\x8d\x04\xe4\xc1\x96\x94\xa0\x87\x6e\x52\x5f\xe0\xe4\x00\x91\x7d\x3a\xbb\x76\xd8\x30\x0a\xd9\x40\x0c\xeb\x1c\x9c\x5e\x5a\xdf\xbc\x0b\x1d\x7e\x45\x8e\x0b\xb1\x63\xfd\xab\xee\x5a\x57\x32\x9c\x0f\x12\xa2\xcd\xf3\x3a\x3a\x0d\xb6\x61\x19\x82\xe6\x0b\xd0\xb6\xab\x94\x3c\xe8\xc3\x7d\xc9\x40\xb6\xa7\x78\x6f\x1a\x8f\xc5\xbb\x75\x0a\x1a\xf0\xea\x6c\xd4\x95\x7b\x02\xbf\x23\xf3\x4a\xd9\x04\xe6\xca\x32\xec\x1c\x44\x06\xa3\xac\x9b\xd4\x31\xb5\xfc\x51\xb6\x0e\x45\x22\xff\xaa\x9f\x18\x42\xeb\xe3\x90\xb9\x23\x40\xa3\xa1\x60\x66\x2f\x51\xeb\xe3\x56\x68\x90\x90\xa4\xa2\xa5\x0c\x81\x62\x6e\xc5\x7e\x46\x30\x2c\x16\xfd\xc4\xc4\xc6\x33\xa3\x21\x51\x6c\x14\xf4\x5e\x32\xbb\xc9\x6c\x01\xb4\x69\xe4\x74\x65\xfc\x6a\x4e\xb2\xa5\x98\x8d\x23\x66\x30\x4d\xc1\xf8\x04\x05\x30\xc1\x3a\x92\x32\x51\x71\x97\x60\x4b\xe7\x10\x3e\xdd\x82\x89\x4c\xf0\xe5\xf3\x30\x02\x92\x99\x58\x7b\x11\x6f\x24\x73\xad\xe7\xc7\xd0\x0c\xda\xd7\xab\x52\x6f\x68\x1d\xf4\xe4\x04\x19\xcd\x6b\xda\x06\x4b\x38\xef\xa6\x4a\xec\x92\x17\x6a\x19\x34\x65\x13\x8d\x14\xa4\x7e\x81\xe4\xa5\x06\x57\x3d\xc1\xa1\xa8\x0a\xe5\x68\x01\x80\x68\x2e\x63\x0f\x3c\xb5\x82\xb4\xb4\xe8\x9e\x85\x0c\xc6\xe3\xad\x6f\x43\xfb\xf9\xd0\x8e\xee\xa3\x8f\x44\xcb\x7a\xfd\xaa\xa4\x98\x76\x9d\x69\xae\xb3\xca\xe9\xda\x6f\xb1\x2b\x77\x84\x72\x14\x03\x24\xb3\x56\xbe\xbf\xb9\xb9\x44\x27\xcb\x57\xb3\x43\x34\xfb\x9c\xe3\x3b\x4c\x53\xbc\x4e\xc9\x0c\x38\x78\x06\x4c\xcf\xaa\x72\x16\x85\x06\xe0\x95\xcc\x9a\x8e\x57\xa0\x2d\xad\x46\x8d\x4d\x56\x35\x0c\x20\x97\x75\x68\x60\x34\x37\x29\x26\x1e\x35\xb7\x62\x1c\x3e\xa2\x9b\x6f\x1a\x90\xb1\x58\x28\xee\x54\xb8\x45\xd3\xa7\xbf\x7f\xf2\x75\xea\xe1\x41\x4c\x5b\x59\x8b\xe5\xf1\x79\xcb\x71\x66\x8e\xcf\x0a\x8e\x73\x9e\x36\xe8\x47\x93\x8c\xc5\x8d\x3e\xbf\x37\x9d\xbc\xa9\x20\x9d\x30\x90\x09\xa3\xb5\xfa\x77\xa3\xdb\x7d\x8d\x8f\x17\x6a\x9f\xd0\x30\x0f\x1b\x55\x79\x19\x2a\x6c\xa1\x75\xc6\xaa\xbc\x1c\x9c\x33\x26\x1c\xa2\x04\x01\x9b\x48\x35\x39\x18\x35\xb4\xc3\xda\x8e\x11\x57\x59\x95\x62\x78\xae\x22\x08\x60\x78\xf1\x0f\xf3\x80\xe6\x04\xb2\x0a\x94\xb4\xc6\xff\xe1\x9d\x19\x7c\x55\x14\x84\xa3\x35\xab\xea\xd7\x0b\xcc\x18\xd4\x7a\x97\x09\xa9\x7d\x0b\xda\xfc\x07\xeb\xfe\xbf\x9d\xe7\x9b\x70\x9d\xde\x65\xd6\xa7\xc3\x1a\xa5\x44\x4e\x6d\xa0\x2c\x0d\x88\xf6\x3e\x71\xd3\x67\x2c\x9f\x64\x30\xd7\x27\x65\xfc\xb0\x8a\x06\x28\x7e\x81\x1f\x0c\xc9\x5b\xe9\xc2\x07\x56\x5a\x2f\xf1\x8a\x93\xe5\x60\xcf\x97\x27\x4b\xdb\x33\x49\x28\xce\x9f\xad\xf3\xd7\x23\x3a\x7f\x6d\x3b\x7f\xbd\x2c\x77\x46\x16\xd3\x94\x3c\x1f\x16\xaf\x47\x60\xf1\xda\x62\xf1\xfa\xab\x60\x61\xda\x0d\xa2\x72\xa5\x2b\x0e\x0a\x1f\x37\x60\x3e\x9a\x2e\x34\xfa\xc5\x85\x52\x7c\x06\x91\xbd\xb1\xfa\x51\x1d\xaa\x6b\x50\x05\xf5\x6a\x1a\x95\x42\xab\x7c\xae\xb7\xda\xce\x67\x43\x8d\x4e\x81\xc2\x24\x1a\xa9\x60\x14\x9c\xad\xbb\xdb\x46\x63\xa0\x97\xb2\x8a\x55\x96\x1a\x27\x93\x7a\x2a\x4a\x7c\x4b\x72\x47\xb7\x6d\x41\x44\xce\x91\x42\x1b\xae\x45\x49\x70\xb2\x9f\xc3\x59\x09\x4e\xbe\x24\xbe\xd5\xd7\x8c\xf2\x0c\x00\x59\x51\x20\xd4\xbe\xf6\xd3\xeb\x40\x6c\x60\xd2\x48\xa3\x32\xb0\x13\x77\x87\x77\x51\x83\xd2\x57\xa8\xce\x07\x75\xe5\x0e\xd5\xfa\xc6\xe7\x37\x47\xf5\x6f\xcd\x3a\xea\xc1\x57\xd4\xcb\x2d\x7d\x36\xa7\xd6\xf0\xe0\x9e\xcf\xae\x27\x27\xf4\x48\x4e\xfc\x63\xfa\x2d\xb0\x10\x24\x19\xd1\xf3\xa5\xac\x28\xfb\xe6\x90\xd3\xcc\x04\x7a\xd4\x84\x15\xb8\xa4\x62\x43\x89\x18\x85\x51\x38\x3e\xa7\xa4\xa3\x48\x11\x3e\x75\xba\x48\x01\xbe\xc0\xcb\x51\x9f\x3a\x12\x3a\x5c\x8e\xa2\xe0\xa3\x72\xc1\x69\x14\xf5\x25\xc4\xf4\x6e\xc3\x6a\x44\x30\x77\x9b\x9a\x69\x4f\x81\x77\xe0\x3d\x67\x98\xb0\x42\x31\x41\x99\xe0\x04\x8b\x01\xab\xc6\x95\xac\x02\xd6\x9b\x14\xd3\xbc\x61\x58\x89\x46\x92\x4a\xa4\xac\xb7\x8b\xeb\x0f\x9f\xcc\x8c\x10\x98\x89\x86\xd5\x04\x72\xa3\xd1\x98\xa0\x14\xee\x1c\x34\x29\xe0\x9e\xcd\xf3\x4c\x88\xb5\xf6\x79\x44\x1e\x94\xd1\x3c\xa1\x31\x06\x77\xb0\x84\x6c\x39\x86\xd3\x6a\x06\xb7\x4e\xe5\x0e\xeb\xdc\x10\x3b\x4e\x04\xd8\x82\x17\xd1\x78\x89\x03\x9e\xae\x29\x1d\xf1\x0a\xd4\x1b\x5d\xd1\xe1\x3e\x3b\xca\x1a\x35\xad\xd7\x17\x9c\xcc\xd5\x7b\x50\x1e\xb0\xc8\xbc\x87\x64\x47\x1c\x4d\xe4\x5c\x45\x80\x71\x17\x0b\x6f\xeb\xba\x06\x75\xa7\xb9\x6f\x04\x1e\x88\xc8\xe8\x40\x70\x54\x81\xfc\x97\x64\x8b\xcd\x9b\x7a\xad\xe6\x02\xd1\xac\xe0\xec\x8e\x24\x53\x47\xf5\x44\x63\x8e\x3e\xca\x37\xf9\xf0\x30\xb0\x31\x21\x6b\x5e\x54\xe7\x2e\xbc\xd9\xd8\x58\x1b\x5a\x4e\xc5\x3c\xbc\x01\x8c\x10\xff\xee\x6c\x24\x8c\x48\x74\xc8\x43\x4c\xd4\x58\x3c\x30\x51\xcd\xe9\xd1\xd4\x9d\xe1\x97\x8a\xf0\xe1\x47\x8b\x7e\x80\x5a\x86\xc6\x97\x9c\x65\x3f\x7c\x50\x2d\x3b\xec\x32\x95\x52\x16\xf3\x41\x1c\x6e\x4c\x4d\x83\x07\x9c\x83\x9e\xc0\xb9\x53\x31\x0d\x6e\x49\xbd\x1b\x52\x58\x24\xe8\x8b\x8c\x90\x50\x78\x94\x22\xec\xdd\x8e\xe6\x6a\xaa\x3a\x5f\x43\x4c\x13\xdc\xa5\x74\xae\x9d\x55\xd4\x33\x7e\xa5\xf2\xd7\xd9\x93\x5a\x4f\xcf\xe8\x64\x82\xc2\xa6\x08\x84\x03\x08\x7b\x84\x4a\xaa\xbc\x43\xea\x03\x86\xca\x77\x3f\xb1\xa3\xa7\x64\x72\x7e\xb4\xea\x39\xa2\xa1\xf4\xc8\x59\xfd\x2a\x0a\x8c\xed\xee\x57\x57\x56\xb4\xbc\x5d\x45\x3d\xb3\xfc\xa3\x95\xc9\x8d\x1b\x20\xb6\x09\x5c\x0d\xf8\x32\x86\xcc\x8d\x03\x6f\xe7\xfb\xa5\x7f\xb5\xbc\xf7\x99\x59\xe7\xe8\xd4\x7b\x95\x12\x98\x0f\x0f\xf1\x5e\xfc\x1b\xbf\x51\xff\x46\x13\xc0\xfe\x4c\x11\xca\x26\x92\xdf\xe7\xb5\x67\xca\xfc\x7e\x7b\x16\x11\x9f\xe7\x9e\x29\x7c\xd6\xc8\x64\x83\x8f\x59\x60\x3e\xdf\x3c\x8b\x55\xc3\x3b\xcf\xb4\xd4\xa0\xa5\x7f\x1e\x46\x77\xa4\x6c\xbe\x27\x5e\xe7\x2a\x40\x34\x17\x25\x86\x2c\x28\x46\x18\x23\x6c\xf7\x40\x9d\xac\xa0\xc0\x1c\x67\xa4\x74\xc3\xfd\x37\x34\x85\x37\x45\xa8\x7d\x2e\xec\xc5\xc3\xee\xc5\xc3\xee\xc5\xc3\xee\x6b\x7a\xd8\xd5\xab\xd0\xba\x5a\xa8\x75\xaa\xb7\x5d\x47\x12\x21\x14\x5e\x94\x3e\xd6\xe8\x74\x6e\xb8\xc3\xe4\x27\x35\x7d\x34\x84\x85\x71\xdf\x35\x8f\x01\xd7\x99\x48\x5b\xa0\xbd\x54\x82\xdf\x7a\x48\xbd\xd8\x5c\x3a\x23\x37\xfa\x6b\xfd\xa9\x12\xb5\x47\xf1\xcf\xff\xe5\xef\xb0\x83\x7c\xf9\x19\x15\x29\x8e\x09\x1c\x4b\x9a\x49\x4a\xcc\xba\x6e\x50\x6c\xf1\x08\x45\xb7\x21\x6d\x2d\x82\x76\xc2\x70\x8d\x61\xcf\xfc\xf4\xcf\x92\xe9\x55\xe6\x73\xf1\x15\xb5\x50\x7a\xab\x33\xbf\x98\x84\x2f\xd6\x46\x58\xa3\xa2\x0e\xd1\xf2\x9d\x47\xc4\x72\x2f\x48\xe4\x4c\x32\x65\x9d\xe7\x20\x07\x66\xb4\x8b\xd6\x18\xbc\xed\x1f\xba\x60\xad\x59\xbe\x12\xfa\x01\x89\xc6\x28\x16\xcf\xab\xf8\xf7\xdb\x9c\x87\xb9\xeb\x51\xe8\x84\x8f\x04\x1d\x94\xae\x74\x55\x79\x6c\x6d\x89\x02\x13\x85\x68\xa7\x74\xfc\xe4\x85\x2c\x0f\x93\x0f\x2b\x5e\xd1\x36\xfd\x40\x02\xae\x7b\x10\x90\xba\x8a\x46\xf4\x1a\x3a\xcf\x64\xb8\x68\xd4\x34\x6b\x6e\x15\xf5\x10\xd8\xac\x62\x9f\x5b\xa3\x4f\xe0\xd1\x5c\x69\x4f\x2d\x98\xa8\x87\x47\x8c\xc4\x51\xfc\xa0\x13\x04\x57\x6b\x51\xd2\xb2\xd2\xd6\xac\xc6\xbc\x8a\xae\xd3\xa4\x11\xb3\x86\x33\x75\xd8\xb4\x93\x66\x42\x6e\x0b\xc6\x1d\x6e\x11\x8d\x9e\xa5\x06\x31\xbf\x86\x07\xa5\x8f\xa5\x3a\x7e\xac\x73\x9f\x80\xf4\x60\xfd\x72\x5e\xfb\xf6\xce\x6b\xc9\x5a\xea\x02\x4f\x3e\xa8\xbd\x7d\x73\x66\xcd\xec\xf5\x11\x4d\x7f\xed\x1c\xce\x74\xaf\xad\x53\x59\xfd\xf5\x57\x8a\xa5\xd2\xe8\x05\xce\x6a\x1a\x1d\x38\xa4\x45\xe1\xed\xfe\xe5\xa4\xf4\x72\x52\x7a\x39\x29\x3d\xd7\x49\x69\x4d\x76\xf8\x8e\x32\xe9\xaf\x89\xc1\x9b\x1a\xc3\xed\xa1\xb6\x6a\x78\x1d\xe4\x43\x2a\xb8\xdf\xd2\xdc\xe4\x0f\xfd\x50\xa0\x8b\x00\xa0\x4b\x37\x34\x6e\xf7\xad\xc0\x2d\xd0\xb5\xc9\x6a\x10\x05\x4c\xdb\xea\x6a\x40\xbd\xdb\x87\x8e\xf4\x5f\xf2\xde\x0d\x1d\xc1\x56\x92\xc3\xf5\x2c\xcb\xe5\xeb\x80\xe8\x48\x3a\x9b\x43\xe6\x69\x74\x04\xc7\x8e\x92\xe2\x74\x0e\xc9\xee\x0f\x46\x99\x61\x9d\xae\x02\x65\xb2\xe3\x4e\x59\x0b\x8d\x4e\xb9\x41\xaa\x53\xe0\xa2\xd8\x2a\xf4\x72\x89\xb3\x63\x7d\x07\x26\xbf\x4b\x75\xc5\xd9\x3b\x2b\x67\xdd\xfa\x46\x40\x37\x9f\xa6\x2b\x77\x4e\x76\x11\x6f\x16\x80\xda\x51\x48\x3e\x70\x50\xe5\x25\xab\xe2\x1d\xbc\xaa\xa0\x23\xcf\x54\x57\xca\x1c\x69\xa2\x59\x51\xa9\x83\x62\xbd\x20\x75\x8e\x95\x98\x65\x05\xe6\x6e\x5a\xd0\x2a\x37\xed\x58\x4e\xb4\x4f\x91\x1e\xba\x82\x0f\x83\x00\x51\xca\x7d\xd9\x2e\xac\x92\x09\x3b\x9a\x0a\xbc\xc5\x25\x4a\x09\xbc\xef\xc6\x72\x62\xb2\x8a\xe9\x2e\xda\x1a\x62\x86\x1f\x68\x56\x65\x2b\xf4\xfa\x75\xbb\x84\xe6\xaa\x64\x19\x8d\xf5\x46\x93\xec\xd4\x3b\x43\x6f\xa1\x86\x99\x13\xe3\xae\x87\x93\x04\xae\x53\x99\xbe\x48\x2c\x25\x9d\x81\x41\xc1\xd3\x46\xdd\xc6\xb7\x60\x22\x1b\x05\x0c\xb2\xd8\x34\x35\xeb\xce\xb1\x4e\x9c\x48\x87\xd7\x85\x4a\xc1\x6d\x94\x48\xad\x78\x77\x60\xba\xcb\x4f\x2f\xda\xb1\x9c\x6a\x14\xf0\xfe\xc1\xeb\x4a\xce\x76\xe0\x0d\x66\x71\xc5\xc6\x58\x04\xbc\xd7\xf3\xe3\xae\xe6\x7d\x54\x07\xc6\x57\xbb\xb8\x87\xe3\x64\x3e\x5b\xcd\x76\xae\x90\xf2\xd3\x2c\x24\x65\xe1\x07\x82\x11\xba\x5f\x5b\x88\x43\xec\x43\x13\x6f\x68\x06\xcb\xf8\x62\x7f\xfd\xc3\x07\x63\xd5\xd6\x26\x13\x11\xca\x5f\x74\x7c\xbc\x3c\x41\xff\xf8\xee\xea\x3f\x3f\x7f\xfc\xd3\xc7\x4f\x7f\xfe\xf8\x9f\xef\xae\xae\x3e\x5d\xfd\x93\xe6\x0d\x00\x2e\xa3\x21\xea\x07\x79\xc5\xab\x36\x68\x2f\xdc\x92\x41\x04\x85\x89\x78\x3f\x10\x88\x6e\x73\x56\x73\x99\x4c\x0f\xbf\x85\x10\x43\xa8\xc0\x49\xa2\x33\xfc\xb1\x98\x75\xfd\xff\x5a\xce\xa0\xaf\xfe\xe0\x29\xb7\x2b\xf6\x9f\x4f\x4e\x5e\x9d\xf8\x2a\x84\x16\x6e\xff\xe2\x1d\xf0\xef\x6b\x4c\xc9\x85\xf2\x03\x34\xb3\xa2\x9b\x19\xfe\x95\x93\xa4\xc4\x58\x5d\xe2\x01\x89\x34\x39\x34\x94\xfb\x1d\x4b\xcd\x0c\x83\xd3\x0f\x88\x34\x2e\x15\x2d\x2b\x29\x25\x64\x54\x70\xb2\xa1\x0f\xdd\xc3\xb2\x7b\x60\x9e\x7d\xfa\x74\x81\x40\xad\x01\xaa\x83\x6a\x84\xd3\x94\xdd\x1b\xfb\x98\xb4\xef\x64\x24\x63\x7c\x8f\xfe\x07\x3a\xc8\xf0\x83\xfa\xe3\x60\xe1\x77\x7c\x07\xb7\xf8\x0f\x9f\x4e\xdf\x9e\x7f\xfc\x0e\x5d\x19\x9c\xc1\x69\xd6\xa8\x81\x20\x7a\x20\x59\x0d\xcd\x91\x82\x34\x6b\x8c\xdf\x0f\x14\x58\xcc\xcf\xd8\xd7\x1d\xd6\x03\x19\x37\xbb\x4e\xd9\xfd\x5b\x76\x9f\xcf\x16\xc1\x99\xf5\x88\x06\xf8\x15\xbf\xa4\xd7\x25\x2e\x87\x67\xf6\xfa\x87\x0f\xb2\xa2\xc1\xeb\x64\x1e\xef\x30\xc7\x31\x98\x9d\xa0\xec\xe6\xf4\xe6\x5d\x63\x9e\x07\xa6\xe1\x8f\xcb\xe5\xf2\x78\x66\x5a\x08\xc2\x29\x4e\xe9\xdf\x94\xb8\xd3\xcf\x18\xc3\x0a\x6c\x8e\xf3\xfb\x7f\x5f\x2e\x97\xfe\x99\x80\x74\x13\xd9\x5e\xfc\x92\xca\xf5\x36\xfb\xcb\x5f\xa0\xa6\x3c\x77\x99\x75\xe6\x5f\x89\x92\xd3\xbc\x10\xa1\xb6\x78\x35\x91\xa2\x5e\x7d\x15\x7e\x8d\xfe\xb3\x8a\x7a\x88\xfc\x5e\x57\x32\x44\x36\x8d\x60\x1c\x71\x2d\x39\xec\xe7\xe9\x82\x55\x02\x1a\x9c\xec\xf7\x50\x4b\xfb\xf1\xc9\x8e\xa0\xd7\x0c\x0b\x6d\xbf\x56\x9c\xae\x6f\x36\xa5\xba\xc2\xaa\xe6\x68\xcd\x7f\xd0\x0e\x6f\x39\x69\xc4\x17\x2a\x40\xda\x87\xda\x81\x0c\x96\xea\x9c\x43\xce\x10\x88\x35\x5b\x44\x53\x6d\x95\x0a\x0c\xd8\x5f\x06\x07\x78\x61\xab\x1a\x52\xbb\x2e\xc5\x1a\x9f\x8c\xe5\xb4\x74\x24\xb6\x07\x28\xe4\xbe\x83\x33\x9f\xcc\x66\x47\x3d\xba\x84\x2d\x47\x19\x4b\xc8\x44\x66\x82\x57\xc3\x47\xec\x83\x17\xce\x3e\x78\x8f\x5b\x2c\x02\x27\x48\x98\x4d\x75\xf0\x83\xd1\xc5\xf6\x46\xba\xfb\x03\xfd\x1d\x22\x5c\xeb\xc0\x10\x87\x4d\x63\xac\xd3\xcf\x66\x4c\x26\x56\x61\x1c\x61\x5b\xc2\x36\x4e\x7d\x3f\x50\x3b\xbd\x06\x84\xc5\xa5\x41\x9d\x43\xfd\x49\x77\x6d\xca\xfc\xbb\x2b\x30\x67\x9b\x37\x7d\xe4\xf5\x9d\x72\xf4\x89\x25\x48\x87\xb9\x9d\xb5\xa9\xf3\x05\xae\x5d\xf7\x8c\x27\xea\xad\xa4\xc1\x99\xbb\x6c\x54\x37\x73\xd8\x7d\x18\x84\xe6\x4d\x43\x6f\xd4\xf3\x3c\x8a\xbe\xad\x97\x9b\x04\xdc\x78\x99\x3b\x02\xd5\x93\x5d\xbe\x3e\x5a\xf5\x09\x8e\x81\xf7\x4d\x1a\xc3\xba\xf1\x0e\x62\xf2\xeb\x26\xe3\xdf\x36\xe9\x9d\x93\xfe\xfb\x9e\x6f\xf5\x55\x93\x67\x7d\xd3\x64\x90\x3e\x43\xef\x99\x3c\xfe\x35\x13\x6d\x8a\x49\x7a\x31\x0b\x49\x74\xff\x65\xc1\xd0\x6b\x25\xc1\x3d\x38\x0c\x6e\x2e\x05\x50\x34\x12\x8e\xd6\x4f\x2e\x59\x4a\xbb\x11\xb9\x4d\x76\x7a\xef\x56\x35\x96\x20\x22\xd0\x8e\xdd\x83\xe6\xb6\xc3\x79\x92\x3a\x2e\xe5\x81\xab\x24\xe3\x24\xcd\x20\x17\x2b\x2e\x8a\x94\x42\x32\x81\x1c\x09\x56\x33\x2b\x98\x16\x16\xe0\xd9\x4a\x8d\x4d\x15\xee\x95\x56\x68\x8e\x66\xa7\x69\xfa\x89\x7f\x64\xe5\x8e\xe6\xdb\x59\x1b\x5f\x84\xc0\xf6\x2f\xd0\x1a\x1e\x4a\xaa\x11\x81\x35\x2a\x93\x74\x1b\xd8\x4e\xca\x43\x6b\x33\x93\xdb\x8c\xf8\xd7\x0e\xc4\x39\x9a\xbd\x21\xa2\x7c\xb7\xd9\x30\x5e\xce\x56\xe8\x96\x90\xc2\x4d\xcc\xc3\xf2\xb6\xdb\xa9\x7a\x8b\xa4\x1e\x5a\x57\x7b\xf4\xf4\x5e\x70\x06\x0e\xd7\xa0\x36\xa4\x44\x08\x88\xc7\x0d\x51\x0a\x77\x4c\x52\xc8\xa1\xda\xb9\x79\x0c\x85\x65\x14\x7c\xa3\x0e\xbd\xe3\x94\x04\x90\x0f\x6f\x9a\x1d\xd6\xb3\xe1\x01\x40\x20\xbc\xed\x5c\x3f\x94\x0d\xd0\x39\x29\x39\x85\xdd\xef\xc6\x28\xc9\xd6\x5d\x43\xe3\xed\xb5\x0f\x01\x34\x63\xe5\x69\x5a\x75\x16\xe3\x6c\x7a\xee\xfc\x77\x0a\xeb\x89\xea\x14\xe9\x9b\xab\x11\x72\x84\xe6\x37\x1c\xe7\x62\x84\x81\xf4\xdc\xad\xa9\x9e\x31\x55\x5e\xfc\xc2\x9a\x0d\xc0\xce\x00\x1b\x30\x8c\x15\xa3\xb2\xae\xde\x65\x0a\x6d\x5e\x53\x84\xad\x7d\x5f\xea\x26\xc2\x64\x30\xd6\x77\x39\xc4\xc9\x16\xe1\x98\x4e\x3b\x80\xa9\x40\xb7\xc6\xa9\x4d\xd6\xcd\x68\x02\x2b\x95\x6d\x9a\x28\xa9\xb9\x6c\x74\xc8\x36\x81\x43\x85\xf1\xb6\xd6\xa7\x4f\x30\x61\x95\xf7\x84\xe4\xe8\xe2\xf3\x87\x9b\x73\xc9\x5d\xef\xfe\xf2\xee\x6c\x11\x8d\x15\x91\x93\x12\x7d\x04\xb2\x7a\x20\xbc\x85\x10\xa3\x52\x2a\x71\x2a\xea\x47\xc5\x06\xb6\xe0\x22\xe3\xcc\x6b\xa9\xdc\x78\xb0\x64\x8c\x0a\xb1\x66\x89\x57\x7f\x68\xa0\xfc\x86\x25\xd6\x0e\x08\x0d\x4c\x6f\xd2\x06\x63\xae\x36\xda\xbd\xf6\xf2\x66\x9f\x19\x2e\x6c\x8a\xd3\x28\xc8\xf0\x05\xf3\x4d\xa3\x02\x94\x74\x4d\x89\x99\xf7\xd8\x8d\x24\x67\x40\x5d\x94\x62\x88\x05\xae\x20\x16\xd9\x50\x11\x12\xcc\x08\x38\xcf\xe9\xf3\xed\xe4\x11\xed\x08\x4e\x08\x7f\x62\x46\x92\xde\x1e\x5a\x94\xf9\x5e\x75\x68\x7d\xae\x34\x02\xde\xf9\xd1\x4f\xd5\x7a\xfb\x34\xb7\x3c\xa6\xe1\xf6\xea\xf2\x0c\xc5\x38\xf5\x1b\xbd\x7a\xf6\x72\xb0\x4a\x95\x3b\x96\xac\x86\x30\xbf\x90\xd5\xcc\x8c\x4a\x44\x55\x4b\x63\x4b\xa1\x02\x7d\xf7\xee\xc6\x99\x8d\xc3\x90\xb1\x07\x00\x6c\xaa\x34\x6d\x1c\x17\xe5\x08\x3a\x10\x67\x47\x5b\x5e\xc4\x8b\x1d\xc1\x69\xb9\x5b\xdc\x1d\x2f\xbe\x97\xff\x3a\x3a\xdb\x91\xf8\xd6\x6f\xc3\x70\xd8\x41\x32\x8f\xd2\xfc\x1c\xf0\xfa\xa5\x42\x9c\xa6\xe6\x9a\x00\xe7\xea\x01\x2f\x43\xfa\xc9\x7c\x64\x4c\x8e\x83\x54\xbc\xd4\x15\x0d\x1d\x4d\x43\x43\x05\xe0\x74\x7d\x06\xde\x95\x65\x11\x4a\x36\x63\x06\x38\xe9\xa8\x06\x00\xbd\x05\x40\xe2\xa9\x23\xfe\xa5\x10\x83\x83\xfd\xe1\xf2\xba\x1b\xbb\x6f\xb9\x5b\x6e\x4e\x90\x51\x43\x90\x98\xe5\xc9\x62\xb2\x39\xf5\x51\x91\xf9\x15\xb7\xb4\x36\x92\x1a\xcc\x5b\x40\x1b\x2b\x8d\x3c\x10\x11\x9a\x41\x95\xd5\xd1\x91\x0c\x4d\x67\x55\xb9\x10\x3b\x56\xac\xfe\x65\xf9\x2f\xcb\x23\xc5\x9d\x7f\x9b\x1d\x1a\xcd\x1d\x27\x09\x07\x55\x0a\x20\x6f\x79\x11\xfb\x57\x82\x95\x7d\x4d\x90\xaf\x97\xaf\x97\xd3\x4d\x90\x10\x84\xcb\xaa\x11\xd4\x50\xf5\x0c\x39\xa0\x19\x4a\x69\x46\x4b\x27\xd5\xac\x9a\xa1\x01\x72\x1c\xdb\x8b\x9f\x93\x27\x88\xe0\xf0\x51\xe2\x97\xe2\xa9\xc9\x0c\x7c\x66\x9f\x06\x2d\x0e\xa4\xcd\xc7\xbd\x64\x86\x26\xa0\xf6\x42\x00\x6e\xe0\x7a\x39\x60\x4d\x82\xbb\xc0\x23\xa9\xea\x1f\xa1\x0d\x7d\x90\x89\xf1\xe5\xff\xe7\xfa\x6e\x14\x1d\x21\x8e\xf3\x84\x65\xf3\x0c\x3f\x98\x8f\x07\xd1\x48\x4a\xe9\xfa\xbd\xc3\x19\xbc\x93\x6d\x5f\x43\xf9\x8f\x49\x20\x1c\x4d\x48\xa8\x51\xea\x1d\x9d\xde\x9c\x66\x7c\x77\x5a\xb6\x59\x34\xfe\xd2\xc5\x5e\xb8\x1c\x2f\x97\x4f\xbf\x23\x0d\x09\xe2\x26\xa1\x8c\xd0\xd5\x94\xba\xa7\xbc\x2b\x89\xcd\x75\xa7\xbd\x30\xd6\x4a\x6e\x77\x39\x90\x3c\xe6\xfb\x42\xbb\x1c\xde\x7c\xb8\x06\x29\x00\xd7\xd0\x20\x02\xe0\x4a\x5b\x1e\x3c\x52\xbc\xd7\xbb\x0d\xab\x4a\xfd\xba\xbf\x02\x6d\x8c\x8c\x1d\xb8\xae\x76\xaf\xdf\x6c\x80\xd3\x4f\x42\x9d\x53\xa4\x7b\xef\xef\x6d\xd0\x81\xda\x35\xc6\xfb\x8f\x3b\xf2\x1a\xa0\xf3\xd5\x5c\x03\x74\x0a\x7c\xca\xfa\x1c\x89\x57\x63\x19\xdc\x1b\x9c\x1b\x0e\xcc\xe5\x44\xba\xa6\x41\x70\x3f\x90\xd9\xd1\x2b\x0d\x0f\x1a\xc6\x3c\x8c\x82\x72\xf7\x3f\xfe\xf1\xdf\xe8\x3f\xa9\x44\xd7\x88\x71\xd0\xcb\xf4\xbd\x52\xc1\x89\x72\x22\x80\x03\xa2\xb4\xba\x2b\xfe\xd6\x3c\xdf\x81\x68\xac\xd4\x75\x6d\x7b\xf7\x2b\x93\x69\x5b\x78\x60\x0f\x30\x0c\xa5\x8f\x2f\xbd\x07\x1d\xb3\xc8\x54\x07\x94\x23\xcc\xb7\x95\xea\xe1\xaf\x8c\xea\xb4\xdf\xca\x6b\xf5\xb0\x35\x2e\xd1\x11\x94\x60\x66\x97\xa4\x5a\xe9\x61\xda\xad\x18\x1c\x5e\x5f\xf9\x3b\xb4\x2a\x93\xbc\x5e\xd5\xde\x5f\x1d\xc0\xfa\x78\x57\xe0\x72\x87\x44\xb9\x4f\x5d\x27\x81\xff\x00\xad\xf0\x08\x0c\x23\x55\x21\x8e\x66\x35\xef\xaa\xe4\x59\xe6\x24\xd4\x81\x79\x47\x79\x59\xe1\x74\xbe\x83\xb8\x2c\x98\x8a\x7d\xea\xa0\x2c\x91\x85\x48\x7b\x10\x03\xcc\xf6\x2e\xba\x12\xab\x03\x18\x24\xd8\x18\xa9\x15\xe4\x55\x30\x23\xdc\x11\xbe\xd7\x29\xfc\x7a\xb9\xf6\xaa\x59\x37\xb0\xef\x6a\x88\xe6\xbe\xb3\xf6\xc1\x74\xff\x33\x36\x10\x61\x32\xb1\x3b\x44\x3e\xc9\x60\x37\x36\x06\x57\x09\x6c\xaf\x23\x13\x40\x14\xc9\xea\xbe\x20\x71\xc0\xe3\xb0\xee\x13\xd0\x8b\x21\x78\x9e\x24\xa8\x2a\x00\xb5\x98\xae\xd3\xfd\x68\xca\x08\x60\x9e\x2a\x1d\xb8\xa0\xbb\x36\xb5\xac\x87\x99\x34\xc1\x99\xc6\x88\x57\x29\x38\x62\x32\xeb\x06\xa4\x8f\x7b\x9e\xc8\x56\x35\x02\xb3\xf6\xdb\xde\x68\x90\x13\xb4\x2a\x6b\x51\x3e\xe1\x98\x0d\x56\x68\xf9\x6a\x4e\xbc\xf7\x5b\x28\x3b\xa3\x3a\x38\x6b\x37\xe9\x5a\x2a\xa5\x9b\x10\x5c\xfb\x54\x21\x7f\x77\x6d\x36\x29\x39\xdd\x6e\x09\x88\xa0\xfb\x1d\xd5\xb6\xcd\x82\x93\x3b\xca\xc0\x7b\x22\x97\x37\x55\xa2\x04\x4f\x35\x4d\x97\xa6\xd1\xd2\x0b\xd6\x18\x32\xdf\x33\xbe\xa6\xc9\x6c\x85\xc4\x2d\xd5\x06\xc5\x9c\xdc\x03\x4e\xff\xaa\xed\x9c\xec\xbe\x6b\x6e\xf4\x63\x6a\x22\xce\x61\x4d\xc3\xde\x50\x30\xa3\x4a\x59\x2e\xd4\xab\x93\xd4\x11\x4f\xa6\x37\x2f\xc8\x39\x9a\x5d\x41\xda\xe2\x98\xcc\x56\x86\x95\x35\x44\xfd\x38\x91\x56\xcc\xe0\x62\x19\x5c\x18\x1a\x23\x68\x4f\xb1\x7d\x46\xd1\x63\x99\xb4\x1c\xaf\xec\xc7\xd2\xf9\xcc\x10\xc7\x0a\x29\x63\xf3\xf0\xc2\x35\xd6\x79\xb1\x03\xd5\x90\xd7\xe9\x58\x34\x2b\xc3\x7a\x06\x67\x10\x7e\x87\xd3\xc5\xc1\xa4\xc3\x9a\xc2\xc3\x5b\x24\x27\xc8\x5b\xa2\x09\xe7\x29\x0b\xae\x58\xf8\x8d\xf9\x08\x9b\xce\xec\x8c\x3b\x1e\x99\x58\x36\x42\x7f\x65\x6b\xb9\x5a\x17\xe8\xa7\x1c\x5d\xc3\x22\x86\xbf\x10\x79\xc0\x20\x73\xbc\x27\x44\x84\x7e\x9a\x2d\xd1\xab\x25\xfa\xaf\xea\xe7\xa7\x99\x09\xc2\x61\xe8\xa7\xd9\x3b\xc9\x32\x3b\x56\x71\x63\xde\xde\xe1\x74\x23\x3f\xfc\x34\x43\x3f\xcd\xfe\x27\xfc\x2b\xdd\xff\xe4\x3f\xfa\xff\xa4\x0d\x1a\x1e\x70\xaa\x35\xbc\x19\xbc\x47\xc7\xbb\x57\xcb\xcc\xd3\xaf\x17\x26\x74\x88\xca\x1d\xe5\xe5\x1e\x60\xe4\xca\xc5\x5a\x0e\xb3\xe5\xf0\xcb\x12\x16\x2f\x18\xdf\x82\xeb\xef\xae\x5a\x2f\x62\x96\x1d\x71\xb6\xde\xd0\xed\x11\x10\x6b\x36\x75\x5a\x64\x96\x4f\xbe\xff\x00\xbb\xc4\xe0\xf4\x7c\xef\x54\xee\x1e\xba\x6b\x0f\x73\x58\xe6\xc8\x7d\x35\xa5\xfd\x73\x4b\x8a\xb2\x65\x1f\x37\x51\x70\x92\xa8\xc7\xcb\x47\xb9\x55\x85\x54\xf9\x7e\x75\x5e\x07\x13\x48\x3f\xa5\xb7\x04\x27\xe0\xb8\x74\x2d\xcd\x05\x62\x90\x22\xd7\xfe\x76\x86\x38\x89\xfe\x0c\x63\x55\x16\x08\x3f\x3d\xe0\x0c\x6f\x5d\xa5\x94\xe4\xd6\x0f\xc7\x52\x21\x88\x70\x97\x3b\x5c\x99\xc0\x79\x1a\x9a\x40\xa2\x3a\x95\xa3\xca\x2f\x92\x2e\xa0\x75\x02\xe0\x84\xf7\x6a\x42\x4f\xbe\x94\x43\xe2\x96\x16\x05\x49\x06\xe8\xee\xcd\x6d\xf8\x68\xba\x87\x0f\xe6\xc0\xcb\xad\x8f\xc1\x23\xb8\x09\xa3\x5a\x45\x7d\x13\x65\x62\xad\xa8\xf0\x3d\x36\x06\x52\xb5\x94\x34\x32\x85\x34\xef\x74\x04\xbf\x8d\x03\xfb\x84\xad\xbe\x0e\xe5\xb1\xaf\xed\xfd\x7a\x06\xe1\xc9\xaf\x47\x6a\xd2\x78\x3b\xee\x7d\x7f\xd4\x89\x58\xf2\x71\x52\x70\x0e\xc7\x3d\x65\xfb\x5b\xa7\x4e\xf8\x09\xdb\x5e\xc2\x0c\x3f\x5f\xfb\x5b\x27\x4c\xf8\xd9\xda\x5e\xc2\xd4\xe1\x94\xab\xa1\xb1\xd8\x60\xb2\xe6\xdb\x7f\xe1\x07\x6b\xf5\xf3\x81\x3e\x9c\x02\x61\xe6\x23\xc8\x1b\x8e\xab\xed\x0b\x8e\xf5\x06\x74\x8e\x78\x90\xf6\x37\xc0\x15\x8f\x7a\x88\xd6\x4c\x51\x9f\xba\x3c\xf5\x19\xda\x7e\x3e\x63\xc9\x18\x16\x7b\xa6\x07\x68\x87\x9f\x9f\xfd\x46\x18\x70\xd4\xa3\xb3\x4f\x7b\x72\x16\x05\x5e\x0c\xfd\x2a\x0f\xce\x8e\x7b\x6e\xf6\xdb\x21\xfe\x13\x17\x7d\xcf\x40\x06\x87\x32\x34\x98\xc9\xc3\xf9\x3a\x0f\xcb\x36\x25\x90\x76\x21\x32\x3e\x8f\xe6\x98\xd9\xf0\xd7\xd4\x12\xc8\xeb\xdd\xf3\x6c\x8f\xca\xf6\x08\x9b\x60\x91\xd9\x34\xaf\x48\xc9\x03\xd6\xa2\x06\x05\xaf\xbb\xf5\xed\x88\xb5\xa1\x88\x03\x28\x7d\x4b\x99\x06\xbc\x6b\xa4\x3d\x30\x67\x40\x3a\x94\x11\xa2\x0d\x21\x06\x19\xb8\x9c\xd3\x9e\x41\xd2\x87\xca\x46\x1a\x6b\x17\xb9\x80\xbf\xb9\x39\xca\xf0\x43\x88\xff\x8e\x49\xeb\x7c\xa3\x1a\xab\x3e\x84\xf2\x7a\x31\x27\x96\x9c\x3c\x94\x3e\x13\x4c\x9f\xea\x0d\x56\x61\xb6\xd9\xac\x86\x78\xee\xe0\x8d\xaa\x68\x0e\x6f\xc6\xa8\xa2\xe3\xd7\x24\x92\x1b\xca\xe1\x78\x0b\x84\x53\x86\x51\x0f\x50\x24\x6d\x3c\x27\x62\xe6\xb8\x18\x24\xac\x5a\xc3\xd0\xf0\x06\x4c\x38\xca\x42\x20\xc9\xbf\x30\x77\x9a\x2b\x74\xd2\x75\x12\x1b\x5c\x89\x19\x7e\x78\x33\x76\x78\x17\xf8\xa1\x35\x42\x75\x27\xcb\x36\xed\xe1\x2a\x5f\xa7\x90\x5b\x88\x76\xe1\x72\x0c\xc3\xc7\xd9\xcc\x19\xc7\x71\x36\x7d\x1c\x2a\x97\xef\xe0\x18\xfe\x2c\xab\x05\xed\xdb\x25\xdf\x1b\xeb\xb6\xe5\xe8\x81\x6b\x71\x69\xd3\xbe\xf1\xb9\x10\xd2\x8d\xe1\x7b\x6a\x98\x51\x5f\xae\x05\xf2\xa0\x42\xb7\x6a\x1c\x8b\x69\xc3\x0f\x1f\x83\xbd\x29\x8e\xc3\x22\x82\xca\xb7\x4d\x3a\x17\xc7\x0d\x12\x5e\xeb\x4a\xb0\x8a\x5c\x47\x4f\x73\x6b\xa8\xce\xbd\x40\x46\xaa\x7d\x5b\x74\x9a\xd2\x16\x50\xa4\x5f\x43\x00\x32\x93\xa4\x16\x88\x26\x88\x15\xfe\x4d\x20\xac\x5f\xd8\xa2\x0c\x18\x27\x6e\x9a\x22\xba\x50\xe1\x7c\x5e\xe1\xf4\x10\xad\xab\x52\xa7\x9e\x48\x30\xc9\x64\x76\x65\x79\xd5\xa0\x7c\x6a\x0c\x50\x8d\x9d\x27\xce\xb7\x3d\x0b\x61\xef\xbc\x09\x19\xb8\x8d\x3f\x47\x3b\xdf\xb6\x5a\xe9\x7f\xad\x92\xad\xde\x51\x80\xbc\x51\xaf\xe3\xff\x7a\x8f\x30\x5c\x13\xc3\xed\x17\xa9\x84\xbc\x4b\xdc\x4f\x11\x6c\xda\x03\xa4\x5b\xd0\x42\xff\x54\x7b\x8a\xe8\x21\xe8\x66\x80\x4c\xdd\xbb\xb3\x9a\xb5\x0f\x4a\x61\xcb\x16\x3a\xbc\x86\xe6\xdb\xb0\xe3\x88\x72\xa2\x33\xb0\x63\x96\x6f\xe8\xb6\xe2\xb5\xad\x49\x5f\xb3\xc0\x85\x4c\x86\x73\xbc\xd5\x51\x44\xb0\x41\x4b\x53\xd7\x81\x7f\xbf\x95\x2e\x53\x13\xd7\x13\x42\x3b\xba\xdd\x11\x7e\x2e\xde\x40\xfe\x44\x3e\x48\xa0\xef\x1b\xd5\x8d\xc9\xb6\x95\x3a\x5a\x53\x2f\xe1\xac\x08\xc5\x42\x36\xf2\x12\x3b\xde\xab\x02\xd4\x4e\x21\xcc\xcb\x58\x5c\xbe\x8c\x55\xee\x38\xab\xb6\xbb\xa2\x2a\x17\xe8\x8d\x75\x6d\xf1\x8b\xab\x00\x2e\x9c\x0a\xd2\xc9\x87\x1c\x26\x96\x8f\xf5\x83\xd7\xe2\xfd\x57\xe3\xbe\x9c\xd5\xcd\xa5\x51\xd3\xc1\x03\xd8\x04\xe7\xcb\x83\x1e\x27\x65\xc5\xc1\x7f\x58\xd0\x7c\x9b\x12\x93\x89\xc4\xf0\x23\x80\x2e\x5e\xbf\xae\x5f\x86\x09\x3b\x2c\x4a\x47\xed\xfa\xfd\x31\xeb\x27\xbb\xf8\xed\x64\xd1\x76\xd6\xe1\x1f\x96\xea\x11\xb7\xe3\x3f\x2c\x4e\x66\xcf\xb6\xa5\x3c\x53\xee\x6a\x51\x62\xb8\x3b\x3c\xd7\x4a\xc3\x2a\xea\xa1\xd1\x75\xb3\x2e\x12\x05\x78\x92\x18\x25\x0b\x40\x03\xa1\x70\xcc\x99\x10\xde\xb9\xb5\xbb\x2f\xa8\xe5\x3a\x94\x4c\xde\x29\xcb\x15\x36\x7b\xb5\x14\x33\x0d\xc8\xdd\xd1\xe4\x9d\xbd\x4e\x9f\xd0\x01\xa9\x54\xb0\x57\x4b\x63\x96\x97\xf9\xd7\x08\x96\xd7\x87\x6e\x2c\x05\xc2\x90\x82\x21\xd6\x1e\x3a\xc1\x8b\x3a\x7d\x49\x68\x33\xf7\x29\x3d\x51\x2b\x0f\xe6\x02\xb3\xde\x24\x25\x64\x08\x69\x36\x45\x1d\x80\x7b\xa2\xcc\xd1\xda\x2c\x2f\x71\x30\x61\x8b\xf2\xce\x33\xb4\xbf\x79\xd8\xc1\xef\xc3\xd8\xe3\xbf\x68\xee\xb2\xf5\x2a\xad\xf5\x29\x33\x05\xda\x53\xa9\x05\x11\xc9\x3c\x36\xe7\x79\x33\xac\x51\x07\x04\xe8\x71\x73\xdb\x09\xcc\x8c\x82\x2f\xa3\xdc\x80\xd5\xaf\x43\xb1\x7c\x90\x53\x8a\x80\xc3\xbe\x3d\x08\x34\xf0\x79\xc4\xa6\xe9\x2b\xf2\x6f\x9b\xda\x81\x03\xd0\x3f\xbf\xbc\xfb\x63\x0d\x00\x30\x8e\x69\x52\x3b\x56\x87\x5c\x00\xbe\x29\xab\x01\x1f\xbe\xe6\xbb\x74\x66\x09\xfc\xbb\x1c\x36\x70\x67\xdf\x9c\xf5\xcc\xb0\x3d\x60\x11\x62\xf9\xc0\x8d\xd2\x13\x13\x24\x1c\x4f\x4e\x90\xa0\xf7\x84\x41\x2a\x5c\xeb\xed\x4c\x13\xc2\xfc\xd9\x9a\x6b\xe7\x8c\x07\x79\xab\x52\xff\xb3\x1c\x90\xe2\xa5\x64\x32\xe0\x4e\xc7\xf4\xa2\xf3\x4b\x2b\x0d\x1c\x8e\x02\x4d\x48\xd8\xfc\x51\xca\xd1\x28\x27\x14\xbc\xcb\xbd\x60\x9b\xcd\x73\xad\x5a\xe8\x21\xc2\x1c\x1a\xef\x90\xe4\xb0\x15\x47\x13\x4c\xb2\x61\x67\x1d\x64\x72\x9a\x3a\xe0\xfb\xfc\x98\x86\x96\xdd\xa4\xd0\x4e\x37\xb2\x53\xd3\x3d\xd0\x6c\x60\xed\x38\x57\x04\xa3\x3b\x96\xb5\x5b\xbd\x1f\x1a\xf5\xcc\x1a\x38\x2c\xdc\x00\xd8\xa6\xae\xff\x38\xec\x43\x9b\x78\x4f\x4e\xcf\x9e\x1d\xbb\x4f\x29\x28\xba\x31\x65\x41\x38\xde\x77\x3b\xfc\x6f\x76\x98\x0e\x9d\x57\xaf\x74\x00\x3c\x18\xef\x4a\x86\x7e\x7e\x0f\xde\xcb\x97\x2c\x01\x27\xe9\x9f\x5b\x30\x11\x3a\x32\x15\x94\x97\xb1\xaa\xf7\x33\x7c\xbe\x92\x5e\xce\x17\xf8\xa1\x59\x24\xd7\x4b\x13\x68\x57\xfd\x84\xc7\x73\x68\x42\x10\xce\x8d\x8c\xd0\x06\x3b\x39\xb9\x09\x6b\xde\xf1\x3a\x10\x1b\x5d\xf5\xc0\x35\x3e\x11\xe0\x88\x89\x96\xf3\xe3\xe5\x12\x00\x0b\x1d\x8b\xeb\xb8\x4d\xd7\xfd\xea\x45\xeb\x59\xe5\x10\x14\xe7\xc5\x29\x40\x82\xc3\x2e\x22\x1d\x98\x61\xc4\x40\x7b\xed\x20\xd7\x21\x4a\x34\x8a\x8d\x7d\xec\x36\xef\x42\xe8\x04\xf4\xce\xad\x9b\x74\xe3\xa3\xb1\x39\x46\xbd\xce\xfa\x5e\xb6\xad\x53\x7f\x7a\xf9\xf5\x82\xc9\x53\x08\x10\x31\xdd\x23\xb6\x06\xf1\xa9\xfd\x72\xab\xce\xe6\xde\xf1\xff\x8b\x86\x05\xa0\x93\x43\xb4\x6f\xdd\x9c\xd9\x6a\xed\xf4\x52\xda\xad\x46\xa1\x86\x6d\xf0\xa2\x95\x2f\x8b\x68\x94\xb6\xd1\xec\x0d\xc6\x61\xbb\xd4\x98\x40\x02\x67\x9c\xbb\x1d\xf5\xf6\x33\x24\xf3\x21\x9c\x4e\x06\x91\xca\x3e\x7c\xcf\xb4\x7b\x10\xfb\xd0\x69\x64\xf6\x60\x00\xa7\xbc\x5f\x6a\x4b\x53\xe8\x2c\xae\xef\x88\xec\xf8\xe2\x1d\xce\xb7\xfe\x7d\xab\xd6\x47\x9e\xf4\xc6\x9e\x4e\x14\xb4\x7a\x4c\x5b\xff\x4b\x73\xa3\x9a\x76\x99\x7b\x74\x53\x59\x3c\x3c\x21\x4d\x4e\xb9\xd9\x17\x56\x29\x02\x00\xc0\x20\xe6\xe5\x09\xcb\xe8\x8b\xe9\xe8\xf8\xc4\x85\x5d\xfb\x72\x8c\x9e\x02\x80\xd8\xf9\x1c\xdc\xba\xfa\x94\xe9\x29\x89\xad\x3d\xbd\x8e\x4d\x6c\x5d\xcb\x8d\x55\xd4\x43\xf2\x77\xb6\x9a\xb6\x94\x3a\x0b\xa0\x06\x21\x45\xd4\x54\x8f\x63\x9b\x5e\x71\x15\x0d\xcd\xbb\x53\xd9\x9e\x81\x9a\x07\xc1\x50\x8e\x45\x0f\x68\xe4\xcd\xbb\x38\xf1\xac\xe4\xc3\xef\x92\x25\x90\xef\xaa\x72\x32\xe7\x9a\xf7\xab\x9a\xb6\x47\x2f\x44\x9d\xe5\xd8\xe8\xf1\x56\xd4\x69\x6b\x73\x9d\x98\xc0\xdb\xba\x5f\xfc\xc1\x0f\x44\x16\x9c\x5f\x86\x4a\x07\xd6\xc4\xb0\xde\x3c\x1a\x40\xaf\x12\x3c\x0a\x4a\xc1\x92\x27\x0d\x24\xbc\xc0\x75\xe8\xa8\xa4\x54\xa0\x30\xa0\xed\x9a\xa2\xb0\x2a\x0e\xca\x6d\x12\x00\xdb\x23\x28\xfa\x85\xc5\x23\x4e\xde\xc6\x74\xb4\x8a\x26\x92\x8e\xe4\x49\x68\xe3\x1c\xb3\x6d\xf5\xc2\x86\xb0\x30\x92\x40\xf8\x08\x4f\xc4\xa0\x3c\x78\xef\xd6\xb6\x02\xc1\x91\x03\xf5\xe2\xb1\xc9\x3d\x3c\x30\x51\x2b\xe1\x87\x5e\xa4\x52\xb6\xe1\x12\x92\xc9\xeb\x8b\x27\x09\x99\xe6\xe0\x0c\xe0\x74\xea\x85\x68\xcc\x64\xb5\x01\x4e\x21\xa0\xe1\x21\x5a\xa7\xf4\x78\x82\xc0\x51\x04\xe8\x91\x37\x2d\x32\x78\x21\x06\x73\xc4\x3c\x52\xc0\x78\xd3\x86\x06\x06\xd0\x48\x20\xaa\x74\x0e\x74\xbf\xdb\x3b\x52\xcf\xc5\x2d\x08\x13\xb9\xd3\xa7\x79\x20\x58\x79\xa4\x7c\x5a\x3d\x15\xc0\x53\x05\xdc\x90\x78\xf2\x25\x53\x7e\xb2\x74\xfa\x35\x25\x10\xcd\x0a\xdc\x7d\x1f\xb0\xc3\x23\xe7\xb2\x9a\x61\x12\xd5\xc8\x1c\x02\xdc\x35\x8a\x88\x28\x69\x86\x43\xa9\xe4\xda\x4f\xf0\xba\x86\xf0\x45\x34\x9d\xd1\x13\x52\xa4\x6c\x0f\x5a\x4f\x70\x25\x34\x86\xf1\xb6\xae\x6f\x45\x95\x03\xc3\x91\x58\x46\xa3\x09\x40\xd5\x89\x82\xac\x4b\x61\xa0\x5a\x8f\x0c\xe9\xc1\xad\x49\x6c\x08\xb4\xd5\xb9\xcf\x6d\x8d\x20\x44\x73\x87\x46\x85\x35\xca\x41\x44\x65\x9f\xe0\x19\x23\x4e\xe0\xc7\xc0\xeb\xab\xd3\x1a\xd2\xa9\x41\xc1\x1b\x16\xd2\x50\x1a\x7b\x81\x5a\xbb\x59\x3d\x57\xbd\xf5\xfb\x6d\xbd\x63\x45\xcc\x28\x01\x31\x5a\xd4\x4c\x80\xa6\x6d\x2d\x13\x08\xad\xad\x3d\xe1\x00\x7c\x9d\xed\x31\xcc\x03\x2e\xf3\xb8\x26\xdd\x67\xa1\xb3\xe9\x7d\xc2\x88\xae\x74\x13\x33\xa4\x84\x08\x90\xc3\x2d\x16\x7a\x76\xce\x19\xba\x83\x98\x3a\xf6\xfe\xfd\x43\x1b\xbf\x86\x48\xdd\xb3\x95\x8c\xd9\x4e\xb4\xc2\x1b\x7c\x90\xd9\xd4\x18\xe4\x91\x81\x7d\x69\x78\x6f\x7a\xc4\xfe\x64\x44\xed\x2a\x1a\xc1\x34\xc6\x41\xf4\x71\xb2\x66\x78\x46\x95\x2f\xc2\x3b\x7d\x11\xa3\xaf\x01\xc6\xa1\x76\xed\x6d\x6a\x37\x21\x7d\x39\x03\x59\x68\xc3\x3e\x76\x86\x41\x61\x50\x33\x3b\xe7\x32\x1f\xa5\x74\x2b\x64\xe0\xf8\x01\x7e\x97\x70\x93\xbe\xaf\x1f\x1c\xa1\x70\x47\x4a\xa2\x81\x15\x32\x86\x44\x03\xfb\xd9\x08\xf9\xf6\x55\x18\xe4\x8e\x32\x30\x86\x26\x6f\xa9\xe0\x95\xe4\x85\x37\x55\xe2\x7b\x45\xdb\x3b\x35\x3f\x86\x5a\xdb\xd9\xb9\x64\xdd\xc2\xae\xa9\xdf\xfc\xa7\x8f\x2e\x7d\x33\x05\x52\xf6\x5e\xbe\xcf\xb3\x26\x16\xfb\x50\xca\x25\xf8\x81\x64\x76\x4d\x2f\x46\x3d\x51\x3a\x13\x8e\x74\xb7\xb5\xba\x97\xce\x9b\x9d\xc8\xa7\x3c\x16\xbf\xa5\xb9\xec\x93\x97\xf3\x10\x67\xf6\xca\xa5\x02\x22\x0b\x56\xd1\x00\x0f\xd4\xa6\xb5\x4b\xa8\x6f\x84\x88\x89\x26\xa8\xad\xfe\x6d\x43\xff\x22\x9a\x48\xba\xc2\x9e\x5b\x57\xd1\xa4\x49\x69\xe0\xeb\x3d\x6e\x42\x54\x30\x6c\x5f\x60\xb9\x57\xf9\x07\x6a\x8b\xb8\x17\x24\xaa\x43\x2a\x68\x3e\x6a\x68\x63\x94\x45\x7f\x16\xc9\x91\xe4\x79\x36\xfb\x58\xaf\xe1\xbd\x43\xcf\x53\xb4\xe6\x94\x6c\xea\x97\x95\x4c\x86\x7f\xe3\xa3\x05\x8b\x2f\x21\x25\x38\x01\x07\x21\x22\x87\xea\xcd\xab\x39\xe5\x92\xa4\x92\x67\xa8\xf7\xbc\x0a\x96\x28\x47\xae\x02\x57\x22\x2c\x9b\x91\xad\x5d\xa7\x31\x3c\xc9\x66\x4f\x21\xcc\xef\xc4\x70\x28\x39\x71\x1b\x72\x75\xf5\x2d\x19\x5d\xdd\xac\x6d\xd3\xdc\xec\x7f\xed\xd4\xb6\x60\x38\xf6\x3f\xeb\x54\xff\x67\x8e\x56\xda\x19\xf9\x89\x27\x2c\xf0\x86\xc5\x34\x27\xbc\xb7\x56\x6b\x58\x67\xb6\x51\x57\xf3\xd1\xde\x09\xc6\x2e\x53\x32\x95\x66\xcd\xf6\x33\x4e\x75\xee\xb3\xe3\x8c\xd8\x48\xfa\x70\xee\x9b\x13\x3b\x1f\x78\x34\xc2\xe3\xc8\xac\x97\xaa\x22\xca\x70\xc5\x16\xee\xa7\x9a\x98\x23\x88\xdd\xa7\xc7\x21\x84\x10\x42\xe8\xff\xb1\x77\xf5\xbb\x71\xe3\x48\xfe\x7f\x3d\x05\xd1\x87\x43\xcf\x2c\xdc\x92\x9d\xd9\x00\xb3\x7d\xc0\xe2\x26\x4e\xb2\x09\x36\x99\xf5\xd8\xd9\x5b\x1c\xce\x87\x58\x2d\xb1\xbb\xb5\x56\x8b\x1d\x51\xb2\xdd\x73\xb8\xc7\xba\x17\xb8\x27\x3b\x54\xb1\x48\x7d\x51\x1f\x6d\x3b\x83\xd9\x39\xc6\x83\x49\xdc\x4d\x95\xc8\x62\xb1\x48\x16\x8b\xbf\x5f\xb3\xdf\x99\x26\x35\x31\xe9\x7c\xec\x60\x01\x53\x7a\xcc\xd2\xb5\xfe\x27\x39\xbe\xd5\xef\x5f\xeb\x2e\x4a\x0c\x98\x8a\xa9\x36\xa5\x5c\xc6\x22\xba\xe5\xf9\x32\x08\x1e\x1e\x1e\x26\xc8\x9f\x30\xc2\xa7\xac\x0a\xea\x7f\x16\x5a\xfb\xa3\xe5\xac\x00\x27\x93\xd7\x14\xc7\x2d\x82\x1e\xbd\xac\xad\x8e\xcb\xff\x8a\x50\x61\xfd\x47\xe5\xd6\x3e\xfb\xd0\x78\x50\xf7\x5f\x1d\xb5\x08\x44\xab\xfb\x18\x93\x36\xc5\x43\x27\x0a\x47\xf7\xeb\xe8\xfc\x3c\x46\x4e\x84\xa1\xd7\x6e\x4b\xf4\xc5\x01\xba\xb6\x39\x28\x1e\x31\x61\xdf\x3c\x24\xc3\x4e\xfe\x88\x26\xf5\xe1\xd9\xf6\xb6\xa8\x8e\x6c\x0b\x57\x3e\x44\x6c\x5c\x1f\xcd\x29\xb4\x5b\xd0\x8d\x1c\x14\xce\xb4\x0a\x00\x36\x9b\xb8\xae\x69\x70\x5e\xf1\xe2\x47\x5e\xf0\xdd\x73\xb4\xb3\x77\x49\xdd\xd3\xcc\xc6\x92\x1a\x1f\xee\x76\xdb\x09\x6c\x51\xe1\x63\xf2\xaf\xfd\xdb\x2b\xf5\xa3\x7a\xf7\x44\xa3\x9c\xd1\xdd\x9f\xe7\xeb\xcb\x7d\x12\xcb\x63\x9a\x98\xd4\x0f\xbf\x00\xd7\x1e\x93\x23\x09\x22\xcb\xf0\xc7\x52\xd0\x7b\x78\x8b\x4f\x15\x10\x75\xa8\x73\x59\xc0\xb4\x28\x2c\xec\xf7\x47\xcf\xc4\x63\xc0\x2d\x8f\x9b\x57\xbe\xb2\x1b\x1c\x9f\x01\x16\x2d\x47\x39\x50\x10\x4d\xd0\x7b\x82\xe7\x1f\x4a\xcc\xe9\x98\xc6\x25\x16\x6e\x9d\xaf\x91\xfd\xc3\xde\x4c\xdf\x58\x98\x96\x40\x5a\x5f\x95\x81\xcc\x15\x07\xfb\x22\x58\x3e\xef\x09\x46\x2f\xfb\x68\xc4\x2c\x4d\x6a\x30\x89\xe1\x83\xf5\x5a\x99\x5c\xb9\xd5\xa1\x9e\x68\x91\x0e\x5a\x50\x05\x36\x67\x0e\x86\x68\x2e\x27\x50\x6a\x38\x5d\x3a\x80\x73\xcf\x14\x11\x02\xdb\x86\xfb\x3d\xcf\xfa\xcf\x93\xa9\xe5\x23\x0b\xd8\x51\xcd\x0c\x5b\x9e\x25\x75\xf1\xff\x4f\xf6\x42\xff\x30\x18\xd4\xaa\xb9\x85\xbb\xf4\xc6\xec\x4c\x97\x6c\xe4\x3b\x89\xb2\x88\xc4\xae\xce\x96\xaf\x23\x63\xbd\x91\xcb\x24\xeb\x9c\x54\xfa\xde\xf1\xfb\x87\x75\x92\x16\x00\x8d\xf8\xea\x60\x72\xc2\x97\xde\x84\xf1\xf2\xb6\xfb\x5c\x77\xff\xa0\x01\x50\x7b\x1b\x51\xaf\x01\xc1\xa4\x56\x86\xc2\xf6\x78\x9b\xdf\x7b\xac\x1f\xdf\x88\x6c\x5a\x5b\xfe\x24\xb2\x91\xca\x9b\xeb\x49\x2b\x1e\x0d\x06\x37\x34\x48\x6d\xc3\x87\xb1\x30\x15\x35\x6a\xc8\x24\xaf\xda\x28\x61\xb9\x06\x94\x4c\x80\x55\x2f\x1f\xdd\x54\xaa\xeb\xa4\xd6\x7e\xa4\x76\x75\x1a\x0c\xbd\xa4\x5a\xdd\x0c\xcb\x8a\xfc\xd1\xf5\x82\x04\x67\x1e\x15\x13\x6b\x76\xa1\x4b\x8f\x74\x86\x91\xda\x23\x14\x83\x18\x98\xcc\x49\x12\xad\x48\xd3\x53\xdb\x20\xc3\x35\xdf\x94\x61\x1e\x4f\x6c\xc5\x55\x55\xbe\xdb\x8e\x68\x2b\x24\xcf\x1a\x36\xd5\x23\x93\xe9\xf1\x60\xde\x5f\x85\x10\x74\xbc\x3d\xcc\x39\xde\x14\x17\x65\x86\x5a\xcb\xc8\xbd\xf0\xf8\xf1\xad\xe5\xe9\xf4\x0e\xd3\x6f\xeb\xb6\x13\x1b\xb8\x4e\xb2\x30\x4d\x0f\xba\xd1\x03\x67\x01\xfa\xca\xc4\x23\xab\x3d\x34\xa1\x2d\x6c\x2e\xce\x5a\x8e\x6c\xcc\xfa\xdd\x90\xc9\xe9\x94\x7d\xeb\x97\x83\x93\x97\x99\x36\x10\xbc\x64\xe9\x4d\x52\xb7\x2e\xde\x98\x40\x5a\x74\x43\x46\xb0\xd7\xbf\x30\xe9\x07\x3b\x79\xc4\x34\x42\xef\x9f\x64\x35\x97\x54\xd7\x8e\xd1\xd4\x1a\xf2\x48\x43\xc0\x35\x5f\x5e\x0c\x45\x15\x9a\x1a\xd5\xa5\x6d\xa1\x04\x75\xb7\x95\x12\xc5\x86\x35\x3a\x3d\xa0\x30\xb2\x38\x1b\xb6\xe4\x7e\xe5\x2c\xaa\x86\x1f\x6d\x84\x04\x94\x61\x7d\xa7\x15\x52\x83\x48\xda\xe1\xee\xd3\xba\xb3\x08\x61\xf7\xa1\xac\x64\xda\xf7\xbc\x09\x01\x36\x9b\x15\x0e\xbb\xe7\x35\x90\x0d\xc2\x47\x27\x0c\xcf\x55\xe3\xf8\xe1\x3e\x94\xd9\xbc\x18\xc6\x4f\xd6\x20\xe6\xbb\xe3\x21\x02\xe8\xba\xf7\xb8\x2a\x54\xb9\xc6\x18\x6c\x47\xdf\xab\x2b\xdf\xb8\x13\xf0\x7a\xef\x6e\xb4\xd3\xc6\x6a\x28\xec\xa0\x1b\x58\x9d\xac\x0e\xfa\x2f\xda\x9f\xe0\xdb\x35\x94\x8d\xad\x9d\x4c\x13\xc9\x2b\x60\x77\xbc\x57\xdd\xbc\xf0\x9d\xf3\x27\x25\xaa\x65\xfc\x61\xfa\x40\xfb\x91\x3f\x74\xc7\x99\x49\x62\xed\x68\xa0\x47\x24\x5d\x2f\x35\x00\x49\xfd\xbb\xa0\x67\x1a\x8e\x00\x57\x80\xe8\x6a\x93\x1a\xa9\x91\xd8\xac\x8e\xcd\x96\x3e\xdc\x23\x94\x81\x31\x6c\x04\x88\xc2\xfc\x54\xa3\x16\x6c\xfe\x23\x5d\xe3\xb0\x67\xd1\x9d\x69\xfd\x92\x74\x70\xb4\x63\xd1\x1e\x69\xe9\x3d\xae\x83\x06\x3a\xa7\xf7\xc5\x7a\xa7\xfe\x27\xc5\x26\x67\xd9\x92\x35\xba\xec\x2f\x9d\xe2\xba\xf7\x88\x8e\xae\x76\x33\x0c\xee\x0f\xf6\x00\xcd\xeb\xa0\x41\x89\x56\x6c\xa2\x05\x15\x43\x45\xed\xcb\xb2\x80\x36\x1b\x34\x18\x86\x3c\x91\x00\x76\x6e\x95\xda\xad\x86\xf5\x82\xda\x50\x04\xac\xdf\x32\xac\x51\xcf\xfe\x38\x27\xe1\x95\x61\x53\x7c\x6f\x62\x5f\xd9\x77\xd4\xbd\xc5\x0d\x64\xda\x24\xd2\x03\x4a\x18\x18\x49\x6d\x30\x32\x7d\x6f\xba\x8b\x23\xcc\xe8\xee\x17\x76\xac\xf0\xc6\x4c\x40\x90\xe0\xba\xc7\xe8\x56\xa3\xae\x86\xdd\x67\xe5\x65\x46\x68\x52\x22\x8d\xb9\x2c\xd4\xf2\xc3\xf7\x8e\x0a\x89\x5a\x95\x04\xf1\xe4\xdc\xac\xd1\x55\x3d\x09\x36\x46\xd7\xa8\x1f\xed\x7f\x6c\x16\x18\xbc\xb7\x61\xa9\xd4\x1b\x75\xcb\xc3\x3e\x0f\x00\x1e\xf8\x3a\xc9\x12\x69\x41\xb5\xea\xda\xfa\x98\x6f\x1f\xb0\xb2\x23\xce\x6c\x26\xc8\x50\xdd\x3d\x51\x01\x55\xaf\xc0\x43\x55\xaf\xe0\x6f\x53\x7b\x65\x62\xc5\x8c\xa4\x23\x3a\x48\xd7\x6f\xa4\x9b\x70\x95\xa7\x8b\xf6\x4a\x06\xa4\x14\x18\x8e\x79\xf1\x0b\x75\xe7\xe8\xf2\xbf\xdd\x5a\x5d\xde\xde\x52\xb3\xe6\x34\x53\xf0\x2f\xd2\x8e\xa1\xb9\x1a\x66\x6b\x65\x2d\x3d\x5f\x36\x3a\xdd\x5a\x66\x70\xd6\x7e\xe6\x80\x2a\x2c\x2c\xe8\x40\x6b\xe9\x8d\x74\x06\x2c\x12\x1b\x1d\x90\xe8\x74\x23\x76\x0f\xec\x2c\x2b\x43\x84\x62\x35\xb9\x29\x1d\x30\xa8\x7a\xa8\x2b\x12\x1d\x3c\x47\x4d\x29\xd4\xaf\x60\x3d\x9f\xbf\xb6\x3d\x7d\x68\xb3\x9c\x45\x2d\x01\xce\x1b\x3a\x34\xb2\xc8\x6c\xcb\x5b\x20\x0d\x8f\x67\x2d\x7f\xa7\xd8\xdf\x97\xec\xee\x2c\x4c\xf7\xdb\xf0\xac\xfa\x0c\xa7\x2b\x75\x06\xd0\xf8\x5a\xa1\xd4\xf0\x78\x89\xdb\x49\xf5\x41\x21\x72\x70\xc8\xea\x93\xea\xd2\x77\x18\x45\x1c\x78\xe1\x30\x4c\x0d\x1f\x40\x06\x69\x16\x2f\x35\x25\xf4\x3e\x2d\xf3\x30\xa5\x5f\x6b\x40\x04\xec\x3f\xfe\xd3\x03\xbf\x20\x72\x1e\x13\x3f\xbd\xfa\x70\xb1\x58\x78\x15\x67\xfd\x12\xf8\xeb\xf9\x43\xc1\x33\xf8\x4d\xfa\xb7\xdf\x23\x89\xfe\xdd\xd9\x8a\x17\xe1\x99\xa7\x5e\x75\x5e\xca\x42\xec\x2e\x89\xb9\xeb\x35\xa0\xcc\xe2\x5b\x3c\xcd\x20\xbb\xf4\xea\x0c\x08\x34\x65\x56\x87\x45\x8b\x0d\xcf\x7c\x18\x3e\xab\x32\x49\x63\x9e\xe3\x1b\x8c\xd6\x4e\xfd\x17\x3e\x60\x00\x45\xc0\x6b\x44\x88\x00\xb2\x08\x77\xfb\x25\xcb\x4a\x24\x0b\x57\xfa\x4b\x04\xba\x25\x1f\xff\xbf\xd8\x71\xb9\x05\xa2\x14\x0f\x3a\x06\x5e\x88\xd7\x7c\x97\xac\xf5\x2d\xe5\xb8\xd5\x15\xf7\x5e\x9c\x9b\x4d\x08\x9c\x8c\xfc\xb9\xfe\xe9\x87\x44\x16\x0d\xbd\xd2\x5b\x3d\x9d\x34\x0e\x8c\x71\xf5\x4f\x65\x24\xc0\x7a\x4c\x88\x0d\x46\xa8\x2c\x57\x86\xe6\x6c\x49\xbd\x8b\xfd\xc9\xfe\xeb\xbf\xc1\x38\x14\x01\xb6\x59\xa3\x8b\x3d\xcf\x7e\xb8\x78\xff\x6f\xdf\xc1\x1c\xb4\x0b\x97\x9e\x65\xe4\x51\xf5\xb4\xbb\x56\x25\xcd\x61\x34\x55\x87\xfd\x70\xf1\xde\xeb\x5f\xbf\xd4\x7a\xdd\xeb\x19\xe0\x73\xa8\x87\x2a\xd3\xc0\x4f\x26\x7b\x86\x80\x90\x7a\x33\x2e\xf1\x92\x5a\xce\x6a\x17\x37\x0d\x26\xf5\x8c\x86\x89\xcf\x20\xc5\x1e\x48\x98\xe4\x16\x93\xa5\x23\x91\xdd\xf1\x1c\x61\x39\xc4\x26\x4b\x7e\x36\x92\x0d\x02\x8f\x5a\x3d\x36\x24\xc2\x5a\x3e\xcf\xc2\x54\x43\x08\x42\xbc\x64\x17\x42\x92\x3c\xbc\x83\x95\x59\x4d\x1a\x16\x91\xbe\x62\xda\x69\x91\xec\x24\x85\xb6\x73\x60\xd0\x2b\xb3\xa4\x38\x04\x68\xad\xc9\xaa\x2c\x44\x2e\x83\x18\xd0\x3f\x03\x99\x6c\x16\x61\x1e\x6d\x93\x82\x47\x45\x99\xf3\x20\xdc\x27\x0b\xac\x78\x06\x8d\x95\xfe\x2e\xfe\x27\xd3\xcf\x73\x6f\xc4\x7f\xa1\xf1\xf5\xea\x1d\x8c\x90\x00\xa6\xf1\x31\xd5\xc4\x4a\xbd\xfa\x68\xe2\xf2\xcd\xd5\x27\xc3\xa1\x87\x5d\xe0\x75\x59\x18\xaa\xc7\x64\xa5\x78\x50\x54\x92\xad\x39\x40\x48\x25\x12\xf7\x68\x9a\x53\x4b\xdd\x2d\x80\x5f\xa2\x34\x69\xa7\x10\xc9\x72\xb5\x03\xd0\x2a\xc3\x9a\x07\x20\x70\xe7\x38\xda\xc1\xdf\x2b\xea\xc3\xd8\x67\xef\xb3\x2a\xe5\xf6\xab\xab\x1d\x34\x2c\x17\xa0\xd2\x71\xc5\xd7\x9d\x14\x63\x56\x17\x4e\x2d\x25\x4f\x62\xed\x21\x1a\x80\x57\xb0\x19\xae\x8f\x0c\x7d\x9b\xc9\x9c\x9d\x53\x41\x6f\x7c\x2f\x31\x81\xa3\x7f\xfe\x43\x54\xb4\xc7\x22\x41\x7e\x45\x10\x41\x6c\x25\x27\x1b\x82\x59\xaf\x27\x93\x1b\x58\xff\xc2\x03\x0b\x18\xcf\xf3\x4c\xb0\x80\xed\x00\xf9\x48\x73\x33\x35\x4b\xcd\xbd\x71\x9e\xb1\x85\x2a\xda\xf9\x14\xc5\x77\x3e\xc5\x97\xb5\x3e\xb5\x76\x99\x06\xf2\x1b\xd4\x0d\xc0\xde\x36\x14\xa3\xc1\x69\xc1\x2b\xca\x24\xe6\x51\x98\x57\x09\x99\xfe\xd4\xf7\x62\x83\x06\x5f\x3c\x7b\x0d\x45\x1a\xaf\x56\xa3\x15\x7a\x3f\xf8\x4b\xa3\x4f\x94\x7e\x80\x15\xc5\xa6\x28\x46\x15\x50\x23\x7f\x2f\xa4\x04\xa6\x44\x26\x93\x0d\x64\x43\x48\x18\x71\x10\xbf\x14\x6b\x16\xf3\x28\xd9\x85\x29\x05\xd7\xe4\x89\x4a\xff\x05\x50\xe7\x8e\x48\x81\xd5\x0c\x53\xb6\xce\xa9\x0e\xe0\x27\x43\x06\x03\x8e\xc9\x72\xbd\x4e\x1e\x2a\x14\xd2\xeb\xd9\x77\xa7\xa7\x3b\x79\x3d\xd3\xdc\x7f\xd6\x25\x19\x3c\xaa\x92\xa2\xae\x67\x99\xbc\x9e\x9d\xb0\xeb\x59\x29\xaf\x67\xec\x1b\x91\xb3\xeb\xd9\xff\xfe\x8f\xbc\x9e\x7d\x0b\x1f\xee\xe8\x4b\xfa\x6b\xa7\xfe\xda\x5e\x5b\xf0\x4d\xaf\x33\x84\xde\x42\x5d\xde\x80\x02\x20\x8a\x7e\xa0\x50\xc0\x1e\xa2\x52\x70\x44\x0c\xeb\x5f\x8a\x05\x01\xe0\x95\xd2\x33\x74\x70\xd2\xf4\x52\xf0\xa3\xa8\x91\xd3\x83\x3f\x9b\xdc\xd7\x3d\xa0\x0d\x8d\xee\x7e\xad\xb3\xf5\x2b\xaf\xda\x60\xf5\xd3\xe1\x8e\xe6\x50\x7c\xdf\xad\x9f\x15\x31\x8d\xba\x28\x91\xec\xe6\x42\xc4\x90\x0b\x57\xe6\x5c\x8d\xfa\x1b\x34\x1b\xfd\x16\x4b\xf5\x49\xe8\x63\x2d\xc7\x58\x4a\x47\xe8\x14\xcb\x51\x86\x33\x3b\x61\xb3\xc5\x99\xff\x72\x0b\xff\x78\xb1\xfd\xfd\xcb\x1d\x81\xda\xc6\x67\x2f\xb6\x96\x5e\xaf\x8c\xac\x66\x54\xb3\x0c\xc0\xf4\x67\xa5\x54\x06\x05\xf6\x04\xe6\x34\x53\xe2\xf1\x7f\x3b\xf8\x1f\xbe\x24\x9e\x75\x0f\x57\x66\xf7\x33\x7f\x6a\x9f\xa3\x6b\x1a\x1e\xdf\x6f\xa0\x48\x63\x7c\xab\x0c\xd5\x08\xd0\xed\x90\x01\x47\x61\x1a\xc3\x29\xcd\x01\x87\x3c\xf5\x7a\x4b\x2a\xe4\xd5\xe0\x84\x5b\x9b\x05\xef\xef\xef\x17\x59\xb9\x4b\xfc\x75\x16\xa6\xfe\x46\xdc\x05\x62\xbd\x06\x4a\xba\xcf\x52\xac\x8b\xfb\x30\xe7\x81\xcc\x8b\xcf\xfb\x72\x95\x26\xd1\x67\x70\x5f\xfc\xa1\x08\xfe\xc6\x57\xaf\x45\x24\x03\x04\x78\x90\x41\x99\x25\x0f\x9f\xe5\x41\x16\x7c\xf7\x19\xab\x26\xfd\x6d\xb1\x4b\xfb\xc6\x18\xb6\x67\xea\x18\xcb\x28\x1d\x17\x1b\xbb\xb6\x24\x41\x24\xc5\x23\x46\x1a\x50\x57\x0f\xbb\xf3\xf9\x07\x28\xd2\x1e\x64\xf8\x9c\x1e\x61\x35\x4d\x57\x5c\xea\x2d\x99\x1a\xc0\x90\xad\x2b\xce\x41\x25\x65\xc9\xd6\x72\xda\x9c\xb6\x96\x93\x9b\x25\xc2\x78\xb0\x55\x1f\x44\x68\x42\x90\x90\xeb\x42\x47\x21\x89\x34\x1a\xa7\xad\xb2\x2c\x2a\x3c\x6b\x70\x2d\xed\x37\xe9\xc0\x38\xc4\x4b\x1b\x1e\xa7\x6d\xf8\x7d\xab\x0d\xf8\x59\x89\x78\x1c\x08\xfc\x95\x88\x0d\x0e\x38\x3c\xa0\xdf\xf6\xee\xd3\xa7\x0b\xb3\x0a\x6c\xbf\x75\x50\x4b\x43\xae\xb6\xdf\xdd\x52\x15\x00\xb2\xb0\xed\x6a\x41\x93\x35\x4f\x04\x24\x0f\x16\xb1\x0a\xb2\x1e\xca\x62\xba\x98\x04\xf8\xaa\xc4\x10\x01\xc3\xc2\x17\x41\xfa\x09\xfa\xf3\xe8\x16\x6d\x79\x18\xf3\xfc\x89\x24\x3d\x83\x6f\x68\x69\xe6\x9d\x7a\xa1\x49\x4e\xa6\x0a\x58\xfb\x67\x08\x46\x5d\x2f\x88\xf5\x83\x9b\xcb\x8b\x73\x16\x85\xe9\x23\x18\xda\xfa\xf3\xe4\x07\xb2\xe3\xb1\xa2\xea\xc9\x93\x6a\x3c\x00\xc3\x77\xd5\x1b\xf6\x03\x74\xda\xee\xae\xcb\x34\xc5\x7d\x7d\xa3\x05\x1d\x89\xb3\x60\x93\xef\x23\x7f\xcb\xc3\xb4\xd8\xfa\x77\x67\xfe\x3b\xfc\x57\x70\xbe\xe5\xd1\xed\xcc\xeb\xc9\x3d\xd2\xe6\x80\xc6\x23\x00\x99\xb7\x2e\x5e\xad\x82\x40\x59\x44\x16\x82\x0e\x13\xbc\xaa\x56\xfd\xd1\x76\xa4\xd1\x30\x47\xb5\x78\x41\x05\xb5\x1e\xf5\x83\x5a\x0b\x60\xe9\x94\x62\x0b\x5b\xdd\xbe\x4b\xc8\xba\x81\x96\x2f\x6d\xee\x90\xd2\x5f\x8b\x62\x6f\xfd\x02\x54\x7c\x6c\x8b\xbf\xec\xe5\x68\x63\x7f\xba\xb8\xea\x1e\x28\x1b\xeb\x86\x09\x82\xed\x79\x4e\xf0\xee\xbe\x77\xec\xc1\xb0\x1d\x33\x7d\x04\x37\xbd\xcc\x8d\xae\xb5\xa7\x86\xa5\x28\x28\xdb\x78\x23\x8b\x44\x66\x38\x37\x22\xb0\x3c\x51\x16\xbe\xdc\x8a\xfd\xf2\xfb\xd3\xef\x4f\x03\x65\x9d\x3f\xcf\x4e\x60\xc4\x26\x85\x6c\xec\x62\x36\xf9\x3e\xb2\x8f\x04\xe3\xfb\x9a\x22\x7b\xe9\x3b\x06\xfb\xa3\xb0\x73\xd0\x77\xb5\x31\xc8\x3f\x8f\x3b\x12\xea\xa1\x11\x75\x9c\xc9\x19\xdd\x76\x7f\xf9\x04\x17\xdc\x0e\xd2\xea\x3f\x0b\xf6\x65\xdf\x7e\xb1\x05\x4a\x76\xd0\xb9\xd1\x68\x5f\x7a\x03\xea\x98\x7f\x24\x97\x50\x5f\x29\xc2\x1a\x45\xbb\x0a\x30\x0e\xca\x86\xc9\x36\xd5\xd6\xb0\x67\x73\x5e\xa3\x58\x82\x84\x95\x9a\x20\x75\x96\x7e\x27\xd2\x12\x72\xa5\x93\x8c\xad\x52\x11\xdd\x22\xae\x2e\x70\x31\xce\x2d\x89\x41\xb5\xec\x09\x15\x27\x0b\xd3\x54\x44\x78\x45\x61\xc3\x8b\x87\xb0\x28\xf2\x13\x8c\x7e\xd2\x3f\x55\xbe\x0c\xfe\x82\xc5\x25\x15\xea\xd6\x15\x72\x2f\xe7\x45\x8d\x8f\x4f\x64\xec\xc7\xb7\x57\xba\x7a\x00\x7b\x11\xa5\x65\xac\x03\x56\x02\x14\x83\x88\xc5\x14\x40\xb4\x78\x25\x78\x1c\xb0\x77\x63\x76\x7e\xf5\x9e\xc5\x79\x02\x01\xab\x0e\xc9\x54\xcf\xf9\xef\x80\x81\xf4\x9f\xe8\x1c\x71\x9a\x03\x5a\x1e\x31\x03\xe8\x87\xba\x0d\xc0\x23\x10\xc6\x84\x55\x46\x4f\x80\xc6\x2a\x16\xe0\xf4\x39\x0b\x10\x96\x3d\x60\x6b\x88\x96\xe8\xbf\x17\x04\x04\xc4\x02\x5a\x7c\x2f\x76\xe1\x83\xfe\x70\xee\x4d\x54\xc9\x3e\x2c\xb6\x83\x6d\xb9\x08\x8b\x6d\xa3\x29\xf0\x04\xf8\xbc\x75\x92\xf2\xa3\x0d\x7a\x72\xb5\xec\x28\x49\x4d\x2d\x6b\x60\xa4\x46\xed\x1a\xe8\x48\x54\x33\x41\x3b\x18\x40\x3a\x8e\xfb\x50\xbc\xa5\x0d\xc6\xbb\x4e\x72\x76\x7a\xea\xcf\x8f\xac\xff\x15\x1e\x05\x4c\x69\x04\x96\x34\x2d\xb9\x87\x1d\x65\xad\x39\x74\x86\x26\x59\xc3\x62\x5a\x72\xcd\xc9\x03\xac\xf6\xd5\x82\x19\x4c\x25\x05\xfb\x59\xc7\x55\x43\xcc\xd7\xfe\xb4\xbd\x8f\x29\xdf\xf9\x66\xdd\x4d\xe6\x5b\xb0\x75\x5b\xc3\x0b\x7d\x30\x35\x41\x6f\x74\xaa\x79\xa0\x79\x65\xb0\xff\x2f\x9b\x65\x7b\xe6\x20\x92\xa8\x9d\x8f\xdd\x3a\x31\xf6\x92\xc0\xec\x43\x17\x15\xcc\x6c\xa5\xb6\x12\xb0\x67\xd6\x29\x23\xf0\x4a\x4d\x4c\x26\x76\x7b\x2c\x6e\xa3\xf8\x81\x7a\x9c\x34\x93\xfa\xa2\x94\x87\x10\x8c\x29\xf7\x50\xb5\x08\xe2\x33\xfe\x54\xcd\xe8\xc3\xec\xe1\x2d\xb3\xce\x59\xa8\xc2\xa0\x52\xec\x2a\xa6\x45\x96\x97\x30\x68\x0b\xa1\xef\x6d\xe9\xad\x4f\xd6\xb7\x08\xd0\xab\x9b\x36\x7e\x08\xa1\x61\x1c\xcb\xc5\x12\x89\x4c\xc1\xae\x44\x3d\x8c\x96\x9d\x16\xcd\xcf\xdb\x8f\xe8\x70\x77\x45\x6b\x59\xc0\x39\x21\xb1\xe8\xdb\xd3\xd2\x28\x2e\x56\xe4\x09\xa4\x8b\xf2\xb8\x76\x25\x6d\x9f\xf3\xbb\x44\x00\x00\x8c\xba\xfb\x22\x0b\x88\x7e\x90\x4e\x74\x0c\x14\x23\x08\x5d\xbb\xa1\xe9\x6f\xc9\x16\x6c\xf6\x56\xe4\xab\x24\x9e\x2d\xf1\x86\x0c\x2d\x53\xf9\x3d\xd4\xe9\x5f\xe0\xeb\x1f\xd2\x54\xdc\xcf\x96\xec\x96\xf3\x7d\x2d\x89\xcc\x5e\x53\x9a\xa7\xc1\x5d\x41\x24\xaf\xd8\x0b\xed\xdf\x8c\x05\xd2\x99\x18\xcf\xcc\x95\x7d\xfd\x36\xab\xc8\x05\x9b\x5d\xf2\x7d\x1a\x46\x7c\xb6\xd4\x66\x4c\x12\xe9\x1a\x2b\x4d\x4b\x30\xd3\xc3\xc9\x7f\xa3\x05\x3d\x69\xb5\x78\x19\x1b\x00\x8a\x76\x49\x81\x83\xa6\xb2\x94\x44\xb2\x6d\x98\xc5\x88\xc8\x2a\x8d\x72\x0c\x01\x8a\xde\xbe\x5b\xe5\x22\x47\xd8\x8a\x33\xb9\x05\x37\x07\x1b\xbc\x90\x28\x80\x94\x75\xc3\x58\x36\x09\xbf\xf3\xa3\x36\x2d\xaa\x1e\xd6\xaf\xb0\x83\xac\xdf\x90\xe2\x2c\xdf\xf5\x8e\x56\xf8\x2f\xca\x27\xc4\x36\x66\xe7\x79\xed\x30\x27\xc4\x87\xd8\xdf\xc5\x0a\x47\xaa\xcf\xae\x33\x76\x05\x03\x18\x7e\x63\xfc\x21\x04\x7f\x63\x19\x56\xf0\xdf\xf5\xec\x94\x7d\x77\xca\x7e\xa7\x7e\xae\x67\x9a\xd4\x4e\xb0\xeb\xd9\x1b\x34\x99\xad\x28\x73\x8d\x81\xb2\x0d\xd3\x35\x7e\x70\x3d\x63\xd7\xb3\x7f\x85\x7f\xa5\x87\x6b\xfb\x16\xf8\x9a\xd6\xbf\x16\x71\xea\x69\x45\xad\x75\xb6\xfd\xee\x74\x67\x79\xaf\x55\x26\xbc\x10\x8e\x1d\xf3\xe2\x00\x32\x32\x75\x3c\x88\xcd\x6c\x9d\x11\x8a\x58\x44\xbe\xc8\x37\x70\x5a\xb8\x2d\x57\x7e\x24\x76\x41\x2e\x56\xeb\x64\x13\x80\xb2\x66\xc7\x76\x0b\xa5\x33\x7e\x80\x19\x62\x6a\x4e\x23\x16\xee\x6e\x3e\x6b\x29\x8d\xe0\x7a\x28\xd9\xd1\x22\x93\xb1\x5b\xbe\x2f\x34\x78\x18\x1c\x0c\x96\x55\x2c\x12\x95\x7a\x76\xea\x0f\xa4\xc6\xf4\xb2\x26\x69\x52\xa4\xd3\xa3\xb7\xbb\x98\x1f\x96\x64\x9b\xd7\x3c\x8c\x21\xd8\x7c\x85\xdb\x66\x39\xaa\x91\x2b\xfb\x73\x5a\x39\x31\x7d\x0c\x6d\x25\xa2\x35\x8b\x44\x6c\x99\xa9\x02\x79\x6e\x4c\x03\x66\xbb\x04\x2f\xd7\x37\xf9\x81\x61\x2e\xc5\x47\xc2\xec\x40\x37\x53\xed\x2e\xe9\x23\x3c\x1d\x83\xb8\x26\xe9\xa8\x56\x3d\x75\x3e\xfa\x21\xba\x75\xe6\x7b\xc7\x5f\xa3\x7f\xb4\xde\xfb\x37\xa8\x60\xcb\xad\x0f\x7b\xb7\xa2\xfa\x7e\xd2\xd2\x1b\xea\x28\x2a\x64\x48\x37\x9b\xac\xd8\xe0\x55\x15\xe1\x9c\xfe\x32\xc9\x3a\x2f\x82\xff\x1a\xdb\x95\x23\xa6\xfa\x2a\xfb\x47\xd7\xe4\x17\x0c\x8c\x7e\xb4\x73\x88\xd7\xd8\xc3\x61\x9b\xcc\x56\x55\xeb\x95\x4e\xad\x2f\x26\x1a\x7f\x38\x7b\xd3\x9a\xa7\xcd\xa9\xc8\x6a\xcd\x3c\x3e\x56\xba\x4e\x78\x1a\xff\xa6\xb5\x83\x2d\x3c\x5e\x31\x69\xb8\xe2\xe9\x6f\x5a\x31\xd8\xc2\xe3\x15\x53\x5d\x97\x5e\x8e\xb5\xc5\xe4\x9f\x35\x69\xea\x8d\x04\x30\x7a\x5c\x2c\xeb\x8a\x12\xd3\xfd\x91\x89\xf6\x23\xea\x7d\xde\x14\x5a\x11\xf3\x7f\x74\xab\xc8\x44\xcc\x31\x07\x8b\x2c\xa2\x46\x8b\x8b\xd7\x6e\x21\x0a\x21\x62\x3e\x97\x64\x22\x2a\x56\x47\x5d\x34\xb4\x5c\x26\xb0\x72\x58\x61\xc8\xda\x0d\x42\xf5\xbe\xa3\xed\x4c\xc4\x76\xcd\x35\x4d\x4c\xc4\x6d\xeb\x82\x38\x17\x98\x58\xbd\xd6\xf5\x1a\x5a\x44\xb2\xaa\xd6\xbd\x95\xfd\x95\x18\xe0\x5e\xc4\x88\x77\x34\x68\x84\x0d\x15\xcd\x2f\xda\x8f\x34\xf4\x65\xd2\x73\x2b\xb0\xce\xd0\x6e\x37\xf5\x6b\x3d\xb0\x1c\xf2\x6b\xf1\x56\x3a\xdf\xd6\xb7\xee\x02\x76\x49\x7b\xba\x80\x5d\x01\x11\x35\xb7\xd1\xae\xc3\x4f\x40\x50\x4b\x2c\x60\x7f\xcd\x6e\x33\x71\x9f\xcd\x7f\xd5\xca\x7f\xe2\xa0\x1f\x68\xc8\x68\x53\xc6\x1a\x73\x74\x73\x7a\xe0\xad\x43\xb6\xb3\xbb\x1b\x65\x33\x35\xa7\x63\xad\x44\xd3\x03\x11\x8a\x30\x9c\x15\xdc\xf2\x66\x7e\x5a\x35\x0f\x28\x0f\xd4\x8b\x4c\xa2\xc6\x6d\xc5\x64\x8c\x87\x3a\x54\x8d\xba\x29\x83\xed\xa2\xd0\x23\x9d\x4d\xef\x57\x7a\xd2\x84\x5b\xf2\x3d\xd1\xa2\x86\x06\xaf\xba\xe5\x4d\x8b\x29\x50\x94\x83\xa8\x11\x5c\x00\x8c\x05\xf6\x43\x02\xb0\xf7\x40\x0f\x9d\x1e\x4c\x48\xb6\x10\xf5\x0b\x32\x49\xb1\x15\x65\xbb\x89\xb5\xe8\xdd\x09\xa4\x8c\x47\xbc\xb5\xbf\xa1\xdb\x79\xf8\x0e\xa9\xb2\x3f\xf4\x8e\x25\x83\x0b\x16\x79\x79\xd4\xd2\x7b\x15\x46\xb7\x62\xbd\x5e\x8e\xd9\xdc\xfc\x95\x2a\xa8\x37\x6f\x3a\xa8\x52\xa7\xf2\xc0\xcb\x77\x4a\x71\x2a\x28\x6a\x11\x8a\x44\x5f\xb3\x97\xd2\xc0\x5f\x27\x92\xc5\xa2\x5c\x41\xd3\xc2\x35\x84\x70\x54\x84\x00\xa5\xd4\x42\xea\x2f\xbb\x47\x3a\xa3\x23\x71\x17\x3e\xbc\x9a\xda\xbc\x8f\xe1\x43\xab\x85\x29\x6e\xe4\xc5\xba\xdd\xdc\xe2\x9e\xf3\x7e\xe0\x36\x42\x1b\xa8\x05\x85\xcf\x76\xb3\x5a\x3b\xce\x76\xc7\xb7\xe3\x3e\xc9\x62\x71\x3f\xda\x86\xbf\x61\xb1\xde\xd8\x76\x91\x1f\x74\x64\xdb\x58\xf4\xc8\xf1\x30\xc6\xb3\xc1\x23\xd4\x82\xb9\x00\xed\x20\xe1\x1e\x2e\xd9\x7d\xa2\x8d\x91\x52\x1a\xac\xf7\x76\xd4\x9c\xa4\xda\xe1\x1f\xd7\xfc\xfe\x6d\xb0\x12\x37\xd9\x45\x10\xba\xc2\xd2\x1b\x50\xa1\x06\x6b\x50\x51\x81\xce\x58\xa5\x7d\x2f\xa8\x31\xa1\x1c\x0f\x75\x30\xdc\x75\x87\x61\x0d\x9a\xa1\x72\x88\x2a\x9c\xa3\x42\xfd\x08\xd1\x28\xcd\x57\x3b\x30\x9c\xa8\x19\x8a\xe8\x4a\x85\xfd\x79\x19\xa6\x27\x08\xf0\xd0\x80\x87\xa4\x63\x06\x95\x5b\xa2\x85\x52\xed\x2c\x1c\x7c\xed\x5e\xe8\x47\x78\x90\xa9\x18\xd6\xd9\x87\xbf\x68\x9b\xd3\x79\x0d\x29\xa4\xe2\x53\x27\x24\x77\x7a\xda\xf8\x3b\x00\xe6\x9b\x40\x72\x57\x65\x34\xd4\xb0\x55\x70\xae\x1f\x02\x54\x33\x1c\x62\xf3\x52\xb2\x2f\x25\xcf\x0f\xc7\x38\x36\xca\x84\xe8\x7e\x61\x27\x72\xd7\x4d\xd0\x09\x14\x62\x5d\x7b\x7b\x6d\x34\x53\x2e\xc6\xde\x7c\xe7\xef\x44\x96\x14\x02\x2c\xb7\x3f\x81\x42\x25\x93\x69\xd9\x91\xc8\xd6\xc9\xa6\xcc\xab\x58\x53\x75\xdb\x89\xed\xc2\x2c\xdc\xf0\x2a\x14\x43\x37\xde\xad\x42\x31\xe9\xf9\xc8\xf1\x04\x97\xb4\x37\x5b\x9e\xbf\x97\xaf\x78\x51\xf0\x7c\x54\x41\xef\x1a\xc5\x75\xc8\x16\x02\xf3\x7c\x93\x87\x71\x23\xdd\x2e\xce\xc5\xbe\x8f\x73\xb5\xd8\x1a\x10\x75\x91\xd7\x21\x2d\x61\xd9\x29\x25\xc3\x28\xbf\x4e\xd2\x2a\xb6\xb9\x28\x37\xdb\x7d\x59\xf8\xec\x95\x49\xf1\xb0\xbb\xab\x9e\xba\xe4\x89\x34\xa8\x34\xe6\xbd\xc7\x83\x9b\xa0\xe1\x8d\x2a\xe9\x27\x28\xa5\xdf\x0c\x86\xf3\xd3\x07\x65\xb2\xed\x94\x1f\x35\x34\x2a\x3d\x78\xfd\xf4\x3b\xb8\x15\x52\xb9\xc2\xb0\x74\x42\xe2\x10\x7d\x79\x49\xdb\x23\x88\xde\xff\xe1\x0f\x0c\x9c\x56\x16\x1d\x48\x7d\x56\xa1\x78\xac\xad\xb5\xbc\xae\xf2\x45\x8f\xb6\x9f\x62\x9b\x73\xb9\x15\x69\x3c\xaa\x95\x4f\xba\xa4\xd6\x0c\xa6\x63\xd6\xba\xaa\xdd\x3d\xb6\xc3\xd1\xda\x19\x79\x6d\x1c\xbe\x38\xa5\x54\xf1\x17\xfe\xcb\xd9\xb3\x4d\x29\xd8\x65\x9d\x4f\x4d\x83\xa7\xce\x35\x8e\x05\xfd\x57\xc0\x82\xfe\xf6\xb7\xc4\x82\x8e\x39\xe8\xc3\xbc\xe8\x7a\xdb\xe1\x8d\x18\x68\x75\x3b\xd8\x6a\x99\xfa\xaa\x1a\x96\x6a\x6c\x03\x0d\x42\xcb\x23\x6e\xab\xd5\xee\x16\x0f\x0d\x0b\xc3\x23\xdd\xe0\x33\x71\x24\xe7\x8e\xe4\xdc\x91\x9c\x3b\x92\x73\x47\x72\xee\x48\xce\x1d\xc9\xb9\x23\x39\x77\x24\xe7\x8e\xe4\xdc\x91\x9c\x3b\x92\x73\x47\x72\xee\x48\xce\x1d\xc9\xb9\x23\x39\x77\x24\xe7\x8e\xe4\xdc\x91\x9c\x3b\x92\x73\x47\x72\xee\x48\xce\x1d\xc9\xb9\x23\x39\x77\x24\xe7\x8e\xe4\xdc\x91\x9c\x3b\x92\x73\x47\x72\xee\x48\xce\x1d\xc9\xb9\x23\x39\x77\x24\xe7\x8e\xe4\xdc\x91\x9c\x3b\x92\x73\x47\x72\xee\x48\xce\x1d\xc9\xb9\x23\x39\x77\x24\xe7\x8e\xe4\xdc\x91\x9c\x3b\x92\x73\x47\x72\xee\x48\xce\x1d\xc9\xb9\x23\x39\x77\x24\xe7\x8e\xe4\xdc\x91\x9c\x3b\x92\x73\x47\x72\xee\x48\xce\x1d\xc9\xb9\x23\x39\x77\x24\xe7\x8e\xe4\xdc\x91\x9c\x3b\x92\x73\x47\x72\xee\x48\xce\x1d\xc9\x79\x0f\xc9\x79\xeb\x23\xe2\x78\x6e\x10\x95\x3b\x1e\xf3\xe7\xe7\x31\xbf\x85\x01\x96\x3e\x0f\x97\xf9\x9f\x51\x96\x8d\xcf\xbc\xf6\x4d\x87\xd3\xbc\x56\x83\x16\xaf\x79\xf3\x1b\x62\x18\xf9\xda\xdc\xe6\xb5\xaa\xf6\xf0\x9b\xd7\xaa\xe5\x38\xce\x1d\xc7\xb9\xe3\x38\xff\x3a\x1c\xe7\x1d\x72\xf3\x15\xdf\x86\x77\x89\xc0\x38\x47\x48\x8e\xab\x93\x77\xed\x8d\x6f\x28\xfa\xee\x8d\xdb\x89\x3f\x8f\xe0\x59\x6e\xc9\xb3\xea\x46\xdf\x56\x06\x37\x73\xa9\x3a\x78\xb0\x1e\x6f\x9b\x65\x1b\x0a\x21\x03\x01\x7d\x90\x36\x4c\x20\xb0\x25\xb2\x4f\x15\xf0\x03\xf4\x91\xd1\x36\x4c\x3a\xfa\xe8\xd4\x65\x7e\xae\x8b\xea\xc4\x6e\x00\x3c\x42\x90\xa5\x30\x45\x39\xa0\x8e\x24\x33\xb0\x5a\x4b\x02\xe8\x28\x7e\xff\x79\x27\xca\xac\x20\xa1\x8b\x3f\x5a\xde\xc4\x18\x16\xf9\x2c\xcb\x55\x91\x73\xae\x3f\x64\x6c\xf1\x47\xe6\xfb\xbe\xfe\x4d\x7f\xa4\xbc\xda\x67\x50\xa5\x4c\xc3\x15\xfb\x9b\x8d\x7c\x1c\x7e\xc2\xcc\x30\x4b\x1b\xb8\xd8\x9c\x63\x5a\x3a\x27\x74\x5b\x4b\x89\x30\x0f\x77\xbc\x00\x86\x6a\xab\x50\x8c\xcd\x28\x3c\x3d\x80\x72\xac\x49\xf4\xd9\xbf\x8b\x12\x11\xa4\xe0\x1e\x91\x51\x0a\x80\xf5\xc7\x55\x31\xab\x50\xcd\xb1\x82\xc1\x9c\xfa\x20\xd6\xd4\x23\xd5\x0c\x1c\xac\xf6\xeb\xdb\x24\x00\x45\x29\xd7\x29\xf6\x81\x7e\xdc\x2a\xbb\x10\x2c\xe5\x61\x9e\xb1\x9d\xc8\x39\x02\x6c\x65\xc2\xda\x73\x7f\x07\x90\x38\x20\x0a\x62\x55\x67\xc3\x89\xff\x61\x48\x11\x0a\xc3\x2f\x29\xd4\x92\x14\xfa\x84\x01\x29\x52\x76\xa8\x89\x56\x87\xd2\xd8\x57\xc8\xfe\xc7\xbe\xe1\x3d\xb1\xce\xdb\x1d\x16\xf8\xd6\x9f\x3f\x21\x24\xf1\x16\x3a\xb0\x31\x5a\xd6\x65\x86\x43\x03\x69\xc9\x43\xf0\x73\x13\xfa\x04\xa6\x40\xf3\xe4\x5c\x22\x05\xb1\xb5\xe0\xd0\x08\xa3\x51\x5f\x66\xd1\xf0\xf5\x81\x66\x03\xa8\xb8\xc6\x77\x5f\xc3\x7c\x85\x96\x41\x63\x9d\x66\x24\x91\xb3\x9b\x60\x9f\x8b\x28\xb8\x05\xd2\xdc\xc3\x4e\xde\x9c\xf4\xbe\xa1\xc2\x62\xbd\xa9\x46\xe5\x8d\xd7\x53\xd6\xee\xdd\x9b\x7f\xaa\x91\x32\xb1\x5d\x17\xe6\x01\x03\x49\xd9\x1c\x42\x27\xc8\xb6\x42\xd6\x3c\xd4\x94\x64\xcd\x0e\xa2\x64\xf7\x61\x56\x54\x1c\x22\xca\xc2\x10\x59\x00\xba\xee\x26\xfe\x8c\xc6\xf4\x19\xea\x99\xa6\x3c\xfd\x46\x16\x79\x59\x9b\x82\xba\x3f\x31\xcf\x00\xa9\xf9\x77\xfb\x10\x6e\x2f\x9d\xc0\xc2\x09\x42\x6a\xf8\x18\xfb\x22\x8b\x9c\xfd\x0e\xba\xf1\xdb\x1b\x62\xdc\xd5\xc3\x68\x40\x24\x94\x67\x37\xab\x30\x0b\xb3\x50\xde\x9c\x60\xb5\x33\xae\xd1\xb2\x0b\xe0\x9e\x29\x04\xbb\xa1\x77\xb4\x2a\x30\x20\xb7\xa7\x6a\x37\x8a\x29\xf8\x1e\x01\x2b\x01\x74\x38\x29\xfc\x27\xf5\xb1\xee\x9a\xa9\x5d\xac\xcb\x2b\x7f\x00\x9b\x2d\x49\x68\xa8\xf9\xa6\x84\xdb\x51\x14\xb0\x4c\xa4\x1a\xa7\x43\xbd\x4c\x86\xa0\x94\x5d\x19\xcf\x5c\x2a\x35\xc2\xe8\xa8\xa9\xf0\xea\xd3\xe5\x8f\xe7\x1f\x2f\xbe\x01\x8d\x2f\xfe\x98\x8d\xc8\x9e\x51\x97\xcc\x4e\xd8\xf7\xdf\xde\x80\x80\x5d\x78\xab\x0f\x41\xd4\x6d\x50\x7c\x6d\x02\x19\xb6\xb9\xd6\xe5\x70\xce\x1c\x3d\x0c\x36\x0c\xbe\xaf\x6d\x7f\x35\x8f\xf8\x84\x3e\xb1\xae\xa6\xbe\x52\x5c\x05\xdc\x79\x1f\xac\x59\xa3\xdb\xe7\xb0\x56\xf9\x74\xd8\x9b\x6b\x5f\x86\x4d\x52\xe0\xd9\xf6\x89\x76\x65\x84\x44\x39\x9f\x9f\xce\x6d\x2e\x1e\xb8\x01\xe6\xf3\xb3\xf9\x1c\xff\x7e\x31\x9f\x23\x1e\xe4\xe9\xcd\x49\x4d\x2e\x8e\x72\x92\xcb\xbe\x69\x2d\x06\xbe\xb5\x0a\x05\x21\x67\x0d\x21\xba\x67\x36\xdc\x2a\xca\xf4\xdc\x86\xf7\x4b\x7c\xd1\x90\xb8\x4a\x84\x5d\xd4\x2a\x11\xdf\x36\x56\x06\xb0\x34\x3a\xb3\x5b\x80\x9e\xf9\xef\xef\xef\x7d\xe5\xeb\x61\xc3\x1d\xc4\x22\x0a\xb6\xc5\x2e\x0d\x54\x90\x3f\xc0\xfb\x49\x0b\xb3\xe2\x6b\xff\xee\x43\x59\xc6\xd8\x8b\xfe\x97\x34\x57\x17\x09\xb0\xe2\x8a\x3c\x58\x45\x51\xb0\x4a\xc5\x2a\xd8\x85\xb2\xe0\x79\x50\x08\x91\xca\x40\xbd\xe7\x33\x8d\x46\xbf\x78\x28\xc6\xd7\x19\xf3\x81\x60\x54\x2f\xad\x58\xf8\xa0\x68\xc5\xac\x5f\x3e\x96\xfb\x6a\x90\x1f\xbf\x69\xc4\x9a\xd7\xbe\xea\x54\xd8\xa6\x85\x7b\x98\xe0\xf3\x04\x96\xbc\x34\xff\x92\x44\xf0\x42\x16\xa1\x10\xe3\xe3\xb1\xcf\xde\x6c\x96\x6c\x96\x26\x59\xf9\x10\xec\x76\x3f\x8b\x8c\xfb\xdb\xd9\x89\xfe\x64\x95\xde\xc6\xfc\xce\xdf\xce\x70\x25\x22\x05\x13\xbf\x6a\x12\x8c\x5c\xac\xc2\x55\x92\x26\xc5\xf8\x59\xd5\x45\x55\xb6\xa5\x49\x18\x1b\x52\xaf\x9a\x4d\x21\x58\x92\x5a\x64\xb2\x6a\x86\x3f\xfb\xe7\x13\xb6\x4f\x39\xdc\x7f\x40\xff\x81\x5b\x6a\xa0\x78\x52\xb2\xce\xfc\xa7\x18\xdb\xd9\xe9\xe9\x73\x52\xad\x29\x06\xd6\x71\x63\xc3\xa0\x5c\x4b\x3f\x00\x69\x8c\x4f\xc3\x14\x89\xca\x7a\x54\xcb\x1e\x5b\xf5\xbe\x03\x81\x85\x99\x07\xbc\x89\x53\x11\xe4\x99\x2e\xbd\x81\xd6\x7f\x10\xa1\x39\x2b\x84\xc2\xb4\x99\x80\x8c\x04\x75\x22\xae\x63\xda\xb2\xa8\xd0\xa5\x61\x03\x6e\xb3\x75\x3a\x2d\x92\x83\x67\xd7\x43\x8b\x74\x58\xd9\x8f\x76\xd8\x2b\x11\x1b\x54\x6e\x78\x40\xbf\xed\xdd\xa7\x4f\x17\x26\x5e\xe3\x7b\x47\x8e\xd3\xbe\x80\x44\x7f\x50\x82\xaa\x00\xb6\xd2\x0e\x48\x80\x26\x6b\xb8\xd6\x40\xb9\x60\x11\xab\x00\xe4\xa1\x2c\xa6\x54\x49\x56\x66\x45\x62\x28\x79\x21\x44\xf5\x04\x2a\xfc\x41\x6f\xfb\x75\x78\xb2\xb4\xd7\xd6\xb7\x88\xb4\x73\xb6\xf5\xcf\x10\xa8\xb9\x0e\x5d\xe9\x07\x37\x97\x17\xe7\xb8\x64\x93\xfd\x3a\xe8\x59\x86\xf5\x5f\x68\x6b\xd4\xbc\x79\x8d\x0d\x0d\x49\x3d\x79\x52\x8d\x87\x3f\xbd\xf9\x54\xeb\x0d\xfb\x8a\x96\x82\xd3\xeb\x32\x4d\x31\x32\xdf\x68\x41\x47\xe2\x2c\xd8\xe4\xfb\xc8\xdf\xf2\x30\x2d\xb6\xfe\xdd\x99\xff\x0e\xff\x15\x9c\x6f\x79\x74\x6b\xbf\x21\x5f\x33\x07\x34\x1e\xdc\x63\xd4\xc5\x2b\xed\x83\xb2\xf4\x9e\x2e\xa4\xd8\x81\x56\xfd\xd1\x76\x04\xf9\xd7\x22\x12\xe9\xa8\x16\x2f\xa8\xa0\xd6\xa3\x7e\x50\x6b\x01\x2c\x9d\xee\xc2\xc0\xca\xa7\x2f\x43\x58\x37\xd0\xf2\x65\x3f\xb1\x2f\x08\xb4\x7e\x01\x2a\x3e\xb6\xc5\x5f\xf6\x72\xb4\xb1\x3f\x5d\x5c\x75\x33\xbf\x8c\x75\x43\x14\x1a\x50\xb0\x88\x03\xd5\x3f\x72\x02\x60\x44\xf2\x31\x5a\x8b\x4f\x58\x4c\x57\xa4\xcc\x8d\xae\xb5\xa7\x86\x2d\x10\xe8\xc6\x78\x23\x8b\x44\x66\x18\x30\x22\xb0\x3c\x51\x16\xbe\xdc\x8a\xfd\xf2\xfb\xd3\xef\x4f\x03\x65\x9d\x3f\xcf\x70\xf3\x95\x14\xd2\x10\x5e\x80\x64\x50\xae\x7d\x24\x18\xdf\xd7\x14\xd9\x4b\xa6\x31\xd8\x1f\x85\x9d\x0d\xbe\xab\x8d\x41\x26\x78\x44\x0e\xa0\x1e\x1a\x51\xc7\x99\x9c\x11\x2c\xcd\xcb\x27\xb8\xe0\xfe\x69\xfc\xcb\xbe\xfd\xe2\x05\xf5\xb8\x37\xd1\xb9\x41\xfa\xfc\xd2\x1b\xd0\xc5\x1c\x58\x00\x1a\xb1\x37\x78\x04\x36\x46\x30\xb7\x34\xc1\x3d\xae\x0c\x93\x9c\x4d\x2c\xc3\x94\xd4\x00\xd3\x49\x03\xb6\x06\xe6\x02\xfd\xf7\x82\x30\xb9\x58\xc0\x72\x64\x26\x58\xec\xc2\x07\xfd\xe1\xdc\x9b\xa8\xa9\xbc\x49\xe4\x3f\xd8\xac\xcb\x66\xd9\x9e\xae\x26\x89\xfa\xf8\xa8\x3a\xd7\x74\xa4\xff\xcf\x40\xfa\x0f\x45\x8e\x59\xd5\x39\xd2\x7f\x47\xfa\xef\x48\xff\x1d\xe9\xbf\x23\xfd\x77\xa4\xff\x8e\xf4\xdf\x91\xfe\x3b\xd2\x7f\x47\xfa\xef\x48\xff\x1d\xe9\xbf\x23\xfd\x77\xa4\xff\x8e\xf4\xdf\x91\xfe\x3b\xd2\x7f\x47\xfa\xef\x48\xff\x1d\xe9\xbf\x23\xfd\x77\xa4\xff\x8e\xf4\xdf\x91\xfe\x3b\xd2\x7f\x47\xfa\xef\x48\xff\x1d\xe9\xbf\x23\xfd\x77\xa4\xff\x40\xfa\xbf\xfe\x87\x25\xfd\x6f\x5d\x32\xfc\x45\xb8\xfe\x3f\x0a\x38\x99\xe2\xd0\xaa\xf4\x50\x81\x13\x55\x28\x39\xd5\xf5\xe9\xce\x01\xbf\x37\x3e\x23\xd7\x2e\xe2\x0f\x0d\x0b\xc3\xab\xde\xe0\xf7\x71\xa4\xff\x8e\xf4\xdf\x91\xfe\x3b\xd2\x7f\x47\xfa\xef\x48\xff\x1d\xe9\xbf\x23\xfd\x77\xa4\xff\x8e\xf4\xdf\x91\xfe\x3b\xd2\x7f\x47\xfa\xef\x48\xff\x1d\xe9\xbf\x23\xfd\x77\xa4\xff\x8e\xf4\xdf\x91\xfe\x3b\xd2\x7f\x47\xfa\xef\x48\xff\x1d\xe9\xbf\x23\xfd\x77\xa4\xff\x8e\xf4\xdf\x91\xfe\x3b\xd2\x7f\x47\xfa\xef\x48\xff\x1d\xe9\xbf\x23\xfd\xff\x87\x22\xfd\xff\x3f\xf6\xbe\xf7\x39\x6e\x5b\x49\xf0\x3b\xff\x0a\xd4\x5c\x5d\xe9\x6e\x4b\x33\x92\x9c\x38\xeb\xcc\x7d\x39\x5b\x76\x62\xdd\xda\x79\x8a\xa4\xe4\x3e\xac\xb7\x2a\x18\x12\x33\xc3\x88\x43\x4c\x08\x52\xb2\xb2\xb5\xff\xfb\x55\x37\x1a\xe0\x2f\x00\x24\x47\xb2\x2b\x79\x27\xcb\x2f\xcf\x22\x81\x66\xa3\xd1\x68\x00\xfd\xf3\xb9\xe8\xff\x73\xd1\xff\xe7\xa2\xff\xcf\x45\xff\x9f\x8b\xfe\x3f\x17\xfd\x7f\x2e\xfa\xff\x5c\xf4\xff\xb9\xe8\xff\x73\xd1\xff\xe7\xa2\xff\xcf\x45\xff\x9f\x8b\xfe\x3f\x17\xfd\x7f\x2e\xfa\xff\x5c\xf4\xff\xb9\xe8\xff\x73\xd1\xff\xe7\xa2\xff\xcf\x45\xff\x9f\x8b\xfe\x3f\x17\xfd\x7f\x2e\xfa\xff\x5c\xf4\xff\xb9\xe8\xff\x73\xd1\xff\xe7\xa2\xff\xcf\x45\xff\x9f\x8b\xfe\x3f\x17\xfd\xff\x9a\x45\xff\xbb\xf0\xe6\x98\x24\x21\x72\xb6\xaf\x6b\xdb\xd7\x25\xff\xe9\x19\x6e\x1e\x73\xaa\x68\xdf\x78\x0d\x2a\x10\x38\x7e\x2d\xf1\x3a\x49\xf5\xe1\x65\x01\x46\x74\xfd\xa4\x0e\xfa\x86\x22\x95\xfb\x52\x24\x3f\x75\x8b\xda\xcf\x66\xad\x6a\xf5\xf8\x6b\x23\x11\x01\xfb\xf7\xff\x80\x3a\xf4\xa5\x2c\x44\x42\x75\xdc\xf5\xc3\xf9\x7c\x1e\x35\xea\xbf\x43\x2d\x78\xf1\xb9\x14\x39\xfc\xa6\x4c\x59\xee\xbb\xb3\x95\x28\xf9\x59\x04\x75\xb5\x97\xec\xbc\x52\xa5\xdc\x5d\x51\xad\xf1\xb7\x90\xa3\x1d\xbf\x12\x35\x0b\x6a\x37\xf2\xff\x2e\x31\xff\x6f\x6d\x2c\x9a\x6f\x44\xbe\x80\xe5\xb3\xaa\xd2\x2c\x11\x05\x7e\xc1\x52\xed\x74\xf1\x62\xf1\x12\x90\x2f\x04\x37\x69\x04\x54\xc9\x77\xfb\x25\xcb\xab\x2c\xa3\x82\xfe\x4b\x96\x8b\xf2\x5e\x16\xb7\x78\x69\x5a\x74\x8a\xfe\x9b\x82\xdd\x18\xeb\xbb\x64\x9d\xb7\xe4\xe8\xd6\xa4\xde\x4f\x1a\xd8\xb9\xbd\x8e\x80\x8d\xe4\xdf\x7a\xaf\x3e\xa4\xaa\x6c\x91\xb9\x89\x44\x64\x1c\xc9\xab\x8c\x17\xbd\x57\x2a\x96\xc0\xc5\x56\xf7\x06\x4b\x57\x55\x2b\x5b\x26\x7e\x49\xd3\x8e\x13\xcd\xfe\xf3\xbf\x22\xcc\xac\x92\x26\x8d\xc3\xbb\xdc\x8b\xfc\xf5\xe5\xc5\xaf\xdf\xc0\xe6\xb4\xe3\xcb\xc8\xb9\x24\x6b\x6c\x8d\x30\xd7\xcd\xad\xa9\xba\x89\x18\x7b\x7d\x79\x11\xf9\x8f\x38\x0d\xc6\x88\x3c\x32\xe0\x08\x30\xd2\x6d\x5a\x79\x14\x89\xe5\x6d\xf9\x7e\x5b\x49\xd5\xba\xb5\xf6\x0b\x1d\x80\xcf\x6a\x4e\x2b\xa9\xae\x4f\xaf\xeb\x53\x82\xb1\xf1\x4e\x14\x25\x6b\x94\xf2\x87\x8d\x7d\xc7\x6d\xaa\x41\x9d\x36\xa3\x05\x11\x8e\xfb\x05\x94\xd4\xa6\x54\x42\xa0\x52\xd9\x71\x38\xa6\xc2\x37\x58\x95\x37\xa0\x61\x13\xf5\xc5\x2b\xd4\xdb\x19\x3f\x8a\x06\x44\x1c\xb2\xa6\x97\xee\xc0\x9d\x94\x68\x12\xbb\x01\xbb\x54\xa2\x0e\x52\x35\xd6\x8b\xab\x77\xd7\x37\xcc\x7c\x14\x8b\xd9\x46\xfd\x6c\xcc\x8d\xd8\xd6\x9a\xf0\x40\xa8\x34\x5f\x63\xa5\x89\x94\xca\x7d\x53\x6d\x0d\x8c\x75\xc0\x79\x8e\xb3\xb4\x1b\x7c\xa2\xaa\xd5\x0e\xca\x20\xd9\x52\x4f\xa5\x5c\xb0\x73\x14\x08\xb0\x25\x54\x68\xc9\x4f\x16\xec\x22\xaf\xbd\x72\xbf\x38\xd9\x81\xc2\x6a\x0e\x24\x1d\x26\x7c\x53\x8e\x31\xe6\x94\xf2\x34\x52\x92\x33\xce\x19\xba\xde\x8b\xb8\xb5\x24\x56\x62\xcb\xef\x52\x59\xd4\x39\x6d\x0f\x48\x2e\xe3\xf6\xc1\x6e\xb3\xc6\xeb\xb8\xec\x2e\x47\xaa\x0d\x13\x1b\xb1\xd4\x49\x20\x64\xcb\x1b\x45\x1e\x9f\x6f\x0c\x56\x44\x29\x7f\x0c\x20\xc4\xee\x98\x25\x22\xe3\x0f\xc7\x2c\x93\x4a\x1d\xb3\xa4\xc2\x10\x23\x28\x8b\x1d\xcb\x02\x02\x2a\x4c\x19\x07\x2f\x44\xec\x7f\x14\x0d\x97\x28\x01\xbd\x4c\xdf\xc1\x69\xae\x11\xe8\x3d\x05\x7c\xfa\x4d\x0d\x7a\xbd\x37\x84\x6d\xef\xb9\x1d\x6f\xef\xcd\x8a\xe7\xc9\x7d\x9a\xf4\xea\xf8\xcf\x41\x44\xe5\x65\xc1\xe3\xdb\xde\x9b\x5d\xd9\x2d\xbe\x3a\xc7\xa4\xce\xe0\x87\xdc\x6f\x4c\xec\xd7\x79\xe1\x64\x55\xf8\x6b\x11\x0a\x72\xc5\x1b\xd3\xaa\xb1\xd6\x91\x2d\xb5\xf3\x3b\x79\xb8\x5b\x58\x66\xbf\xf6\x4c\x5f\xe7\xb1\x8f\x5b\xe1\x67\x55\xad\xd7\x23\x8e\xbd\x6f\xb0\x99\xd9\xb3\xa8\xe4\x29\xe3\x3b\x59\xe9\xdc\x53\xab\x07\x5d\xe4\x96\x43\xa6\xb0\x5b\x91\x83\x27\x9c\xeb\x44\xc9\x40\xc6\xf0\x3b\x9e\x66\x7c\x95\xe9\xea\x18\x50\x9a\x93\xe7\x25\xcf\x85\xac\x54\xbf\xb6\xd4\x98\xf0\x40\x5b\xd9\xe2\x6c\x62\x65\x0b\x38\x4a\x8c\xa9\xa8\xe2\x29\xa5\xd2\x18\x35\xe5\x52\xff\xa3\x12\x15\xa4\x3e\xe0\x69\xd9\x65\x04\xf3\x03\x63\x26\x1a\xa1\xe6\x14\x9c\x2a\x6a\x92\x7c\xe5\xe1\xef\xd2\x7c\x55\x15\x6a\x98\x02\x1f\xa9\xa1\x91\x55\x46\x72\xa5\x7f\xd6\x4e\x48\x82\xdf\x16\xe0\x94\xb4\xaa\xe2\x5b\xe1\xb9\x4d\xff\x00\x21\x52\xa2\x80\xd0\x4b\xc6\xe3\xb8\x2a\x78\x0c\x29\x7a\xb7\x26\x2a\x8b\x72\xcd\x01\x97\x7d\xbc\xf9\xc5\x80\x86\xd9\x2b\xd6\x3c\x16\x0b\xe6\xab\xf1\xcb\xeb\xef\xa7\x0a\xeb\x26\x83\x0f\x13\x24\x27\x55\x40\x60\x44\x1e\x84\x3d\x6a\xe2\xf4\x41\x1d\xe8\x7d\xdc\xdf\x74\xcd\x0f\x8e\x8d\xe6\xb5\xe0\xa9\x82\x4a\xcc\xaf\xd9\x37\xa7\xa7\xa7\x38\xf1\x96\x76\x50\x58\x4e\xde\x83\x1f\xa2\xac\xf2\x84\x7d\xb3\x5b\xa5\xe5\x89\x1b\xa4\x5c\x5b\x2c\x8f\xd9\x26\xbd\x13\x39\x3b\xb3\xf0\xf6\x1c\xc8\xa6\x1e\xc5\x01\xd3\x4b\xea\x18\x7c\x06\x39\xe0\x92\x1a\x76\x85\x40\x22\xa0\x9c\x1c\x6c\x69\x45\xc3\x23\x2d\xc4\x03\x37\x4d\x66\x49\xa4\x50\xe8\x7c\x0f\x53\x46\xb6\x04\x25\x4a\x70\x3f\x83\x2f\x61\x84\x5d\x2e\xa0\xf8\x3f\x2f\x1e\x58\xea\x9e\x7c\xc3\x51\xbb\x34\xcb\x52\x5d\xb2\x12\xb5\x24\x2a\xe6\x99\x60\x6a\xcb\xf7\x64\xf3\x30\x77\xc5\x45\x34\xdd\x43\xf3\x11\x44\x1e\x45\xe0\xab\x06\x71\xd5\x1e\xa8\x71\x9b\xcb\xd5\x82\x61\x11\x36\xc5\x56\x7b\x75\xcc\x6e\xf1\xbf\x3b\xfc\xef\x06\xfe\xeb\x00\xca\x58\xb9\xda\x43\x31\x5e\xc8\x0c\x0c\xff\xd2\xb5\xad\x80\xc7\xd4\xa8\x9a\x9e\x8e\x5d\xcc\x7d\x6b\xa7\x2d\x17\xf7\x86\xde\x63\x94\xac\xbd\xa7\x45\x7f\x9b\x77\x1e\xde\xe8\x52\xaa\x77\xec\x65\x14\x20\xdb\xb9\x69\x15\xdc\x3a\x2d\x2c\x26\x3e\x6f\x79\xa5\x1c\xdb\xa4\xd9\x3d\xb5\x35\xa4\xee\x80\x72\x82\x72\x4e\xd8\xeb\x0c\x9c\x0f\x8e\x99\xd2\x77\x1b\x5d\xc5\xd6\xe9\x41\x46\x95\xcb\xa0\xb9\xd5\xb5\x5b\x1b\xb2\xb5\x71\x9a\x58\x74\x56\x4a\xb9\x98\xb0\x7b\x43\x85\xe2\x42\xca\xdd\x20\x6f\xbd\xa7\x86\xfd\x3d\xcc\x14\xcc\x83\xf1\x92\x1b\x92\xc7\x80\x64\x12\x5c\xaa\x92\xd4\x47\x48\x1e\x90\x8a\x37\x94\x8c\x92\xc0\x75\x49\x97\x2a\x96\xc9\x7b\x8f\x46\xcc\x14\xd2\xe8\xa0\x44\xb0\x5b\x68\xed\xb3\x4a\x63\x6f\x86\x8d\xf2\xdd\x09\x33\x87\xdc\xed\x38\x67\xf7\xec\xec\xf4\xc5\xb7\x76\xaa\x9a\xf0\x56\xe2\x41\xc2\x35\x4d\x57\x94\x82\x8c\xc1\xe0\x3e\x87\x45\xac\xdd\x88\x5a\x6d\x6b\xaa\x6a\x2d\xdf\x80\x28\x79\x5a\x79\x1d\x58\x2b\x78\x52\x5e\x46\x01\x26\x38\xa7\xb3\x7f\x78\x9d\xe8\x36\x93\x0f\x92\xd0\x51\x64\x4e\x6b\xe6\x80\x64\xf1\x22\x7f\xb0\x44\x6a\xe0\x32\xf2\x4a\xe1\xa5\x2b\x5e\x63\x82\x54\x7d\x0b\x2d\x82\xb2\x07\x61\x7c\x5d\x8a\xfe\x9e\xfa\x32\x6a\x07\xbb\x51\xf2\xe6\xc9\xfd\x0a\x01\x59\xe2\x87\xaf\x11\x57\xba\x5d\xeb\xf2\xad\x49\x85\xc2\x51\x9f\x80\x0c\x34\xd7\xc2\x0a\xd1\x6b\x04\xcd\x06\x07\x02\x7f\x37\x7c\x1f\xee\xeb\xdb\xe5\x07\x28\x31\xe2\xe3\x3e\x96\x1e\x62\x6b\xf8\x99\xb3\x0d\x77\x97\x10\x27\x9c\x1c\xef\xbc\x6c\x1f\x5a\x5d\xc4\x24\xd1\x48\x50\x89\x80\x9c\x1b\xcb\x28\xc0\x16\x6f\xb1\x89\xd9\x9b\x36\x99\x5c\xb1\x3d\x07\x06\xb6\x0e\x05\x46\x31\x62\x2f\x02\xce\x5c\xb3\x0e\x1f\x96\x32\xa6\xf2\xc1\xbc\xa8\xed\x1d\x32\xa7\xe0\x8f\x59\x2e\xca\x33\x1d\x10\x2e\xca\xed\xbf\xf4\x03\xbc\x8d\x5a\x56\x43\x4d\x4b\x28\x7a\x95\x95\xe9\x3e\x6b\xdc\x49\x3a\x45\x31\x67\xa2\xdc\xf6\x73\xef\x7b\x27\x3e\x49\x0b\xb7\x5b\x7e\x9b\x42\xa6\x55\x4f\xd0\x98\x17\xc7\x64\xe1\xa1\x02\x2f\x32\x77\xea\x65\x40\xf5\x9f\x58\x35\x93\x55\xa3\xb8\x85\x93\x5b\xdd\xd3\xcb\xba\x35\x47\xe5\x63\xef\xe1\x4a\x3a\x94\x30\x64\x08\x19\x43\x17\xa3\x14\x0a\xd3\xc5\xb4\x42\x91\x12\x12\xc2\xa0\x79\xfa\xba\x32\xd8\x3b\x82\x81\x9e\xfe\x95\xe7\x17\x00\x7e\x25\x9a\x7f\x5d\x7a\x92\x60\x76\xe8\x5b\x70\x27\xdb\x99\xac\x08\xb4\x3e\xa7\x27\x1c\x87\xf4\xbb\xb0\xc2\x79\x76\xa3\x4b\x83\x04\xf1\x78\xd7\x6e\xdb\x44\xc7\x30\xb3\xa9\x30\x22\xab\x52\x41\x42\xf5\xdb\x57\x2a\x1a\xe5\x94\x12\x98\x0a\xbf\x99\x79\x82\x89\x39\x93\x3c\x09\x0e\xee\x83\xe4\xd6\xf5\x05\x1a\x93\xf8\x4a\x95\xf1\xac\x32\x26\x5a\x55\xd6\xc5\x12\x60\xfe\xfa\x08\x33\xe3\xfc\xa0\x82\xae\x58\x21\x96\x5f\xc9\x64\xd8\xa3\xe8\x8d\x4c\x6c\x91\x09\xe8\x60\xbe\xf6\xfe\xe6\xe6\xd2\x9a\x16\xba\x5f\x1d\x20\xb6\x9f\x25\xfd\x6c\x49\x28\xc0\xe5\xc3\x74\x36\xa8\x00\x25\x1b\x65\x1a\xa0\x22\xbe\x03\xac\xd6\x46\x40\x5b\x0c\x53\x82\x6b\x73\x99\xda\x0a\xf3\xe0\x38\x04\x37\x67\x53\xf6\x63\xf2\x88\xe0\xa6\x22\x8a\x47\x56\x80\x0b\x7e\xa1\x43\x19\xb8\xe8\x89\xa2\x0e\x8a\x25\x04\x9c\xf3\x13\xaa\xd1\x61\xd4\xdc\xa6\xe3\xe6\xea\xf2\x1c\x6b\xfa\x38\xf5\x53\x5e\x21\x13\x8e\xcf\x0e\x44\x65\x23\xa2\xba\xe7\x71\xbd\x1e\x7e\x7c\x77\xd3\x98\x0d\xb7\xf2\x83\x76\xec\x75\x95\x65\x68\x4a\x6e\x8d\xa0\x07\x71\x76\xb2\x29\xf6\xf1\x62\x2b\x78\x56\x6e\x17\x77\x67\x8b\xf7\xf8\xaf\x93\xf3\xad\x88\x6f\xfb\xe7\x01\xf8\x69\xb0\x03\x32\x8f\xbe\xfd\x37\xc0\x6b\xea\x53\x01\x24\x8c\xa8\xe2\x39\x05\x52\x12\xe9\x27\xf3\x11\xc4\x76\xc8\x58\x66\x83\x54\xbc\xa4\x86\x86\x8e\xa6\xa3\xa1\x02\x70\x3a\x85\x76\x42\x01\x21\x5f\xc0\x8b\x19\xa0\xe3\xa5\xeb\x54\x40\x61\x97\x65\xe9\x3e\x80\x02\x89\xa7\x8e\xf8\x8f\xbd\x1a\x1c\xec\xcf\x97\xd7\x7d\x85\x86\xe5\x6e\xd8\x1e\x46\xe9\xbb\x7c\xe7\x79\xbd\xa3\x0c\x62\xa1\xf7\x2f\x83\x48\x55\x58\x5a\x1b\x49\x0d\x87\x48\x20\xb6\x95\x46\x0e\x88\xcc\x16\x74\x8a\x81\xf3\x64\x55\x2e\xd4\x56\xee\x97\xaf\x4e\x5f\x9d\x9e\x68\xee\xfc\x73\x76\x0c\x2b\x36\x2d\x95\xad\xdf\x04\x90\x81\xb8\xee\x95\x60\x65\x5f\x1b\xa4\xb7\x36\x54\x70\x3e\x40\x9d\x2a\xab\x11\xd4\xd0\xed\x3c\xc5\xdf\x30\x11\x0e\xcd\xd0\x00\x39\xce\xd4\x8c\xb2\xac\xbd\x7c\x84\x08\xf6\x1f\xa6\xfe\xd8\x77\x3f\x3c\xa7\x19\x8f\x46\x0a\x37\x38\x52\x2e\xa3\x00\x2d\x3e\x48\xd5\x3a\xaa\xfc\x05\x0e\xa5\x2e\x9c\x0f\x26\x61\xe8\x3c\xea\xb0\xf4\x7a\x09\x69\x36\x9b\x20\x31\x3f\x9a\x1d\x29\x44\x50\xbb\x6d\x71\x7b\x29\xea\xef\x6f\x60\xfd\x51\x56\x93\x0b\xc7\x2a\x0e\x91\x27\xb6\xaf\x5d\xb7\xc6\xa7\xa2\xad\xb0\x75\x98\x8b\x56\x99\x44\x6d\xa5\x2c\xb4\xdd\x5b\x24\x53\x4e\x5c\xb4\x9a\x5d\xaf\xdc\x25\xe2\xe8\x3a\x0b\xa8\x5d\x5c\xde\x7d\x5b\x03\x30\x92\xa7\x3b\x94\x01\xf9\x70\x76\x7a\xba\x30\xff\x7b\x71\x7a\x3a\x03\x30\xaf\xb3\x74\xc5\x57\x9c\x9d\x67\xb2\x4a\xda\x37\xdc\xb3\xef\xbe\x5f\xbc\x78\xf9\xed\xc2\xfc\xff\x34\xff\xef\x20\x7b\x86\x8e\xdc\x07\x78\x76\xee\x64\x22\x06\x89\xfa\x91\xca\x5c\x01\xe1\xee\xb9\x8e\x5e\x6a\xb3\x48\x97\x9c\x0e\x88\x0c\x20\x24\x3a\x5b\x25\xa8\xc4\x67\xc8\x12\x33\xd4\x2a\xd3\x4e\x8c\xca\x2d\x64\xbb\xb4\x3c\xc6\xb4\x74\xfc\x61\xa6\xf9\xa5\xcf\x51\x56\x9d\xd1\xec\x65\x62\xab\xb0\x8f\xdd\x65\xf6\x22\x9e\xb6\x4f\x23\x6a\xce\x37\x2e\xa7\x8d\x83\xa5\x83\x23\x58\xd6\x2f\x01\x1c\x13\xd5\x9a\xa4\x23\x9c\xa5\xa6\xd3\x0c\x80\x07\xb2\xc0\x31\xdd\xe3\x2f\xe3\x04\xcb\x30\x4a\xed\x04\xac\xb6\xec\x84\xad\xa1\x58\x98\xf9\xff\x39\x65\xeb\x65\x27\xac\xc0\x82\x5d\xf3\x1d\xff\x6c\x1e\x1e\x45\x23\x69\xb2\x2b\xab\xe0\x50\xc0\xae\x1d\x94\x61\x65\x45\x43\x41\xf1\xc5\x76\x65\xe5\x92\x39\x54\x44\xc9\xa4\xce\x73\x68\xc8\x5a\x71\xf2\x5a\x0d\x37\x45\x2c\x39\xc6\xe1\x1c\x0b\x2d\x1d\x40\x53\xae\x7d\xb8\xe8\xdb\xaa\xf7\xba\x81\x13\x08\xe6\x75\x34\x14\xe1\x90\x21\xdc\x88\x96\x00\xba\xc5\xdc\x09\x9e\xd1\x85\xb3\xda\x90\xba\xb8\xdc\x3a\x9c\x77\x08\xcd\x54\xa1\x1b\xf6\x03\x6a\xbc\xd6\x05\xdf\xec\x8c\x5b\xe3\x41\x16\x1b\xed\xf2\xb2\x64\xdf\xbd\x7c\xf9\xcd\xcb\x90\x49\xe7\xbb\x57\x13\x0f\x9a\x81\x15\x54\x56\x63\x17\x10\x18\x18\x2f\x65\x51\x06\x39\xef\x27\x6a\x14\x64\x3f\xe3\xf9\x64\x78\xb0\x03\x90\x59\x7b\x26\x83\x56\x7a\x27\xb2\xfb\xdf\x90\xb5\x73\x0a\xff\xe9\x53\x2f\x5e\xc4\x06\xf9\xf0\x7d\xdd\x56\x23\x63\x0d\x85\x59\xb9\x65\x78\xfe\x6d\xe2\xac\x51\x73\x00\x65\xa8\x8c\x79\xc3\x33\x38\x11\x14\xcc\xe4\x9a\xc6\x43\x82\xe0\x09\x75\x4c\x8b\x06\x30\x6b\x5a\x84\xab\x95\x13\xe4\x8a\xc0\x29\x56\xf2\x5b\xd1\xa0\x10\x14\xd1\xcd\x35\x8e\xc4\xa6\x20\x05\x80\x6b\x7b\x15\xbb\x9d\x80\x69\x7b\xb2\x58\xde\x43\x12\x83\x5a\xaf\x56\xf0\xf5\x3a\x8d\x2f\x31\x43\x09\x6c\x4f\x1f\x64\xcc\xb3\xe9\xf1\xc6\x38\xca\xc1\x19\xb8\xb4\xcc\xd0\xe1\x0f\x70\xe9\x80\x19\x39\x46\x8d\x30\x2b\xe3\x3d\x0e\xb5\x4a\x5c\x77\x45\xd6\x64\xa7\x89\x07\x8b\xa1\x25\x1c\x5e\x85\x4f\x7e\xf6\xa0\xb3\xc2\x30\xe5\xec\xf4\x19\xe2\xd9\x65\x2a\x0b\x37\x3b\x3a\x20\x32\x4a\x44\xee\x5e\x98\x13\x29\xe9\x42\xef\x4a\xac\x45\x21\xe0\x9c\x5c\xc0\xbf\xf0\x1c\xc3\xcd\x4b\x27\x94\xd0\xda\x1e\x93\x13\xb7\x85\xc5\x4f\x0d\x4d\x4e\xe8\xa3\xc1\x9d\xb9\xfe\xb1\xc9\x6d\x26\x7c\x1e\xdb\x77\x70\x38\x36\xd7\x52\x7b\x56\xb4\x90\x87\x73\x89\xd5\x81\x09\x07\x8d\xc2\xb7\x6f\x0c\x26\xba\xf2\x6e\x22\x4f\xbe\x0a\xbc\x5f\xda\x17\x72\x9d\x66\xbd\xc9\x6f\xd1\xfc\x52\xb7\x69\xf1\xdb\x39\x16\xac\xfe\xc8\xf7\x26\x7b\xab\x39\xde\x28\xbe\xdb\x67\x7e\xab\xa0\x39\x91\xec\x04\x57\xa6\xdc\xf5\xbe\x90\x49\x45\xe7\x2c\x9b\xab\x06\xb6\x46\xbc\xc0\xc1\x59\x1b\x9d\xe1\x5d\xe4\x27\x90\x62\x07\xfa\xa5\x54\x42\xaa\xea\x2c\x7b\x20\x75\x05\xba\xac\xd5\x3e\xae\x26\xa5\x12\x75\xa0\x43\x6a\x34\x7e\xad\xc4\x66\xcc\xcb\x68\x80\x4b\x6b\xea\xd0\x99\xac\xa9\xff\x6c\xbc\xcc\x47\x70\xea\x00\x8f\x06\xb9\xd3\xe4\x7c\x18\x44\xf8\x82\x1a\xc2\xee\xb4\x95\xf7\x0c\x73\x50\x81\xeb\xce\x03\x4d\x28\x29\xe5\x29\x87\x07\x71\x8d\x03\x2a\x63\x89\x14\x58\x24\x70\xcb\xef\x44\xad\x79\x8a\x65\x56\xed\x72\x5d\xf6\xde\x7e\xc0\xa6\x03\x69\x7e\xc3\x25\x22\x59\xdb\xb0\x0b\x1a\xa9\xa9\xa4\xb8\x15\xc3\xc6\x94\x7f\x13\xd6\x96\x72\x2b\xac\x29\x85\x06\x6b\x66\xcb\x4e\x5f\x2d\x72\x1c\x70\xd1\x9b\x6a\x46\x5d\x67\x54\xba\xcd\x00\xc2\x14\x98\xb1\xba\xa3\xc3\xae\xb6\x67\x73\x32\x11\xd0\x67\x9d\x30\x35\x15\x15\x9b\x01\x4d\x67\xf6\xee\x7a\xcc\x66\xda\xcf\x64\x86\xf4\x9d\x81\xca\x67\x66\x2c\xeb\xd0\xf4\x18\xef\x93\xc7\xf0\xfc\x53\x7e\xaa\x8e\xcf\x5e\x9c\xee\xd4\xf1\xe9\xa7\xfc\x0c\x7e\x79\x85\xbf\x78\x8a\x66\x6b\xbb\x4c\x63\x0e\x01\x7d\x89\x6c\xc3\xb3\xe3\xd6\x92\x87\x61\xc0\xbd\x29\x2d\x9b\x46\x7e\x27\xcc\x46\x9a\x12\xcd\x65\x86\x53\x8f\x59\x96\xde\x42\x11\x66\x2b\x20\xc8\xcb\x81\x25\x29\x30\xf9\xaa\xf2\x5d\x1c\x82\xb3\x9f\x49\x39\xbc\x6a\x3f\x48\xb9\x27\xb1\x63\x75\xf6\x38\x61\x36\xa6\x67\x25\x36\xa9\xce\x68\xca\xd7\xa5\xf0\x1b\x6d\x1a\x4c\x3d\xf5\x98\xe7\xbf\x84\x58\xf1\x33\x56\xb6\x93\x67\xdc\xc3\x8d\x5b\x63\xdc\x1a\xfb\x55\xbb\xad\x47\x6b\x4c\x10\x8d\xac\x77\xcb\x24\x0c\x88\x4e\x41\x5f\x4c\x29\xed\xac\x7e\x59\x1b\xff\xd8\x85\xc9\xde\x44\xf7\x42\x5d\x54\x34\x96\xc0\x45\x70\x3f\x71\x84\xc0\x02\x1e\xc7\xf5\x37\x01\xbd\x18\x6a\xf3\x83\x9b\xe0\x1e\x50\x8b\xd3\x55\x3f\x64\xc1\xcb\x12\x26\xec\xb9\x58\x46\x23\xa2\xdb\x0b\xeb\x22\x85\x1e\xec\xa6\x33\xf9\xb3\x94\xd2\x04\x81\x18\x63\x65\xee\xe2\xc0\x66\xb2\xa8\x6e\x40\x93\xb9\xf0\xe9\xbd\x71\xe2\x96\xa4\x13\x59\xc4\x0f\xfa\x7a\xd1\x6f\xd2\x19\xd4\xd1\x79\xb7\x4b\x23\x92\x00\x24\x33\x1c\x9f\x20\xa8\x94\x71\x18\x4b\xc0\x05\x15\x58\xa4\x48\x37\x1b\xf0\xbd\x6c\xe4\x2f\xdd\x17\xe2\x2e\x95\x50\x21\x3c\x47\x8f\x53\xed\xa5\x4a\x64\x59\x40\x99\xfb\xd4\x44\x11\x3a\xc1\xf2\x42\x2c\xc1\x41\xe5\x07\x59\xac\xd2\x64\xb6\xc4\x32\x96\xb4\x8b\x8a\x7b\xc0\xe9\x7f\xc1\x6b\x74\xc6\x9e\x2d\xd9\xad\x10\x7b\x15\xe0\xc6\x56\xb2\x27\x90\x8e\x7b\xa9\xca\xbd\x34\xca\x2b\xcb\x84\x74\x6a\x14\xb9\xb9\x5b\xda\xaf\x39\x41\xce\xd9\xec\x0a\x24\x45\x2c\x66\x4b\x03\x84\x20\x52\xce\x63\x52\x85\xe5\x58\x31\xbd\x28\x55\x13\xa6\x27\x07\x13\x26\x79\x81\x83\xcb\x0e\xa4\x79\xd2\x61\xf8\x2d\xcf\x13\x48\xf4\xc0\x95\x25\x0e\x8a\xfb\xa6\x1b\x88\x13\xee\xae\x52\x25\xb8\x09\xab\x2d\x28\xe3\x8a\x5a\xa1\x43\x9c\x0c\xcb\xd9\xc8\xdf\xc5\xd1\x24\x0d\xa6\xc6\xc3\xf9\x0a\x27\xc8\xf9\x86\x08\x37\x55\x86\xc7\xc5\x08\x87\x84\xd9\x79\xd1\x88\xe9\xe3\xd8\x89\xfd\x2e\x57\xb8\x58\x17\xec\x53\xce\xae\x61\x0d\xc3\x6f\x4c\x7c\x46\x09\xed\x58\x56\xf0\xf7\xd3\xec\x94\x7d\x73\xca\xfe\x45\xff\x7c\x9a\x91\xf7\x7e\x29\xd9\xa7\xd9\x3b\x64\x99\xad\xac\x0a\xa3\x69\xd9\xf2\x6c\x8d\x0f\x3e\xcd\xd8\xa7\xd9\xff\x86\x7f\x65\x0f\x9f\xdc\x76\xeb\x4f\x64\x6b\x71\x80\xd3\xbd\xf5\x96\x78\xb6\xfd\xe6\x74\xe7\xf8\xae\x13\x26\x7c\x10\x1c\xcf\x8a\xf2\x01\x60\xe4\x3a\x50\x14\x87\xd9\x89\x16\x95\x89\x8c\x17\xb2\xd8\x40\xdc\xe8\xb6\x5a\x2d\x62\xb9\x3b\x29\xe4\x6a\x9d\x6e\x4e\x80\x58\xb3\xa9\xd3\x42\xb9\x6f\x3e\x8c\x8a\xdf\xa2\x04\x38\x9e\x30\xae\x46\xfe\x1b\x10\x3d\x94\x19\xc7\x01\x13\x8e\x73\x50\xc0\x96\xd8\x18\xe3\xfe\x3b\xa6\x91\xd3\x45\x34\x5d\x33\x71\xb0\x3b\x38\xc6\xa4\x17\x10\x6a\xf6\x56\xf0\x24\x4b\x73\x71\x8d\xb6\x6e\x35\x48\x91\x6b\x77\x3f\x43\x9c\x84\x1e\xc3\x58\x75\x3c\x8d\x5b\xc4\x81\x01\xda\xa0\x40\x92\x1b\x0b\x01\xb0\x5d\x8a\xf6\xa8\xc6\x72\x87\xe8\x06\xd8\x8c\xa0\x0b\xcf\x1f\x28\xe9\x92\x5b\x24\x7d\x84\xde\x98\x5b\x46\xd1\xa5\x8c\x12\xed\x11\xe9\x69\xf2\x51\x0e\x51\xb9\xe1\xaf\x1a\xd1\x13\x38\x2d\x15\x3d\x69\xe8\x3d\x28\x99\x64\x96\xcb\x28\x34\x51\xd4\x08\x66\x06\x6b\x0b\x94\x92\x54\xaa\xda\x1a\x89\xd1\x87\x40\x23\xf3\x32\xcd\x7b\x1f\xb2\x1a\x76\xd2\xe9\x4e\xd9\xea\xeb\x54\x11\x06\x93\xaf\xe8\xcd\x04\xb7\x55\xb9\xa6\xc6\xec\x56\x3c\xe8\x9d\x4d\x6f\xe3\xad\xc0\xcb\x36\x69\x9c\x1f\xd6\x33\x80\x56\x06\x43\x79\xb6\xe2\xd0\x4f\xe6\x8d\x61\x3a\x6f\x82\xde\x39\x84\xbf\xeb\x54\x64\xc9\x3f\x35\x75\x70\x84\xd3\x09\x93\xf1\x95\xc8\xfe\xa9\x09\x83\x23\x9c\x4e\x18\xab\x7a\x51\xcb\xa1\xb1\x58\xcd\xa3\xa2\x7c\x12\x02\x03\xae\x2d\x04\x60\x7a\x3c\x2c\x1b\x44\x29\x52\x6d\xa2\xca\x77\x80\xbc\x4f\xa8\x13\xd4\xb6\xaa\xbf\x3b\x57\xc0\x18\xd4\xa2\x96\xce\x7a\x0a\xf0\xc8\x8b\x66\x57\xc6\x71\x98\x90\x3b\x11\x59\x44\xab\xa0\x68\x8a\x42\xc7\x65\xad\xa1\x92\xb0\xcd\xa9\x86\xf1\x0c\x80\x1d\xc0\x67\x32\x71\x53\xae\xcd\x62\x32\xe9\x72\x17\x58\x11\x80\xc5\x9a\x58\x37\x31\x0c\x98\xa3\x94\xf0\x23\xfb\x17\x61\xc0\xbd\x4c\x30\x69\x64\x90\x09\x5b\x24\x3a\xba\xec\x76\x69\xd1\xcb\xe6\x72\xaa\xb3\x87\x70\x37\xdf\x34\x73\x40\xc2\xdd\x7c\xc1\x94\xf5\x56\x40\x4e\xac\x53\xb4\x9e\xb0\x2b\xba\xd3\x9d\xb0\xeb\x2a\x8e\x31\xa4\xdd\x09\xf4\x84\xea\xf2\xb0\x13\xf6\x4b\x7e\x9b\xcb\xfb\xfc\xe8\x2f\x4d\xfc\x47\x2e\xfa\xc0\x40\x06\x87\x32\x34\x98\xc9\xc3\xf1\xd4\x42\xe6\x6c\xe7\x16\x37\x9a\x67\x1a\x42\xc7\x89\x44\x5b\x02\x51\xc9\xd9\x1b\x52\x10\x9b\x6b\x66\x4b\x89\x4f\x9b\x80\x33\x08\x19\xfe\xea\x75\x7b\x6c\xaf\xee\xe8\x89\x49\x68\x34\x59\x19\x78\x17\x81\x4e\x14\x36\xde\x57\x66\xd3\x84\x94\xea\x1e\x6d\x51\x8b\x82\xd7\xfd\xf6\x76\xc4\xa4\x28\x2a\x00\x54\xc3\xb9\xc0\xa5\x81\x40\x75\xa0\x3f\x7f\x7c\xd7\xbe\x4e\xc2\x8b\x8e\xc9\xa4\x52\xee\x01\x35\x57\x99\xe2\x18\x72\x89\xc5\xa2\x73\xbf\xa1\x54\xae\xf8\x0d\xa5\x43\x36\xcc\x65\x31\x87\x6c\x7c\x45\x35\xe9\xe8\xbd\xe2\xf1\xad\x5c\xaf\x97\x43\x3c\x77\xf4\x46\x37\x34\x97\x37\xa3\x54\x61\x2b\xb1\x96\x64\x53\xd6\x89\xe2\x91\x70\x5a\x2f\xea\x00\xca\xb4\xae\x54\xd9\x5a\xc9\xe0\xd2\x26\xab\x15\x0c\x4d\x2b\x9e\xb5\x86\x00\xa1\x2c\x8c\x19\x62\xc9\x5e\x2a\xa7\xfa\x26\xb8\x12\x77\xfc\xf3\x9b\xb1\xc3\xfb\xc8\x3f\x77\x46\x88\xb9\x05\xcc\xd5\xbd\x31\xdc\xf2\x5e\x08\x7f\x95\x2f\x4a\x4d\xdf\xd0\x0b\x9f\xed\x66\x8d\x71\x9c\xed\xa6\x8f\xe3\x3e\xcd\x13\x79\x3f\x38\x86\xff\x8b\xcd\xbc\xea\xed\xb2\x78\xb0\x86\x4c\xc3\xd1\x03\x3e\x9b\xa8\xd2\x06\x89\xd0\xd0\xe7\x42\x1d\x00\x05\x49\x9b\x89\xef\x53\xc3\x8c\x14\x87\xe0\x4c\xf2\xa8\xf7\x24\x3d\x8e\xc5\xb4\xe1\xfb\xaf\xc1\x1a\xdc\x78\x11\x81\xc6\xf4\x8f\x42\x6d\x97\x51\x80\x8a\x64\x74\x87\x76\x6d\x91\x00\x6e\xbc\x3a\x9f\x8b\x22\xf5\xb8\x5c\xd7\x49\xb1\x3a\x20\x19\x16\xb3\xa9\xed\xb2\xf4\x75\x48\xb3\xbb\x65\x10\x3a\x16\xf3\x42\x2d\xd8\x8c\x57\xa5\x04\xff\x4c\xc8\x5b\x46\xd2\x83\x5e\xba\xac\xcc\x17\x90\x52\x02\xa5\xeb\x87\x34\xbf\x15\x45\x72\xdc\xd0\x11\x97\xda\x15\xc7\x08\x19\x13\xaa\x86\x06\x1e\x5d\x86\xa7\x10\x3a\x10\xd4\xb1\xc3\x53\x27\x83\x19\x7e\x23\xcd\x95\x30\xca\x5d\x3d\xe0\x34\x87\x30\x4c\x9d\x8d\x09\x5d\x94\x1a\x61\xa9\x2e\x90\xb3\x5c\xe6\xa2\x67\x7a\x73\xa9\x5c\x21\x19\x56\xde\x5d\x51\x73\x06\xe4\xe9\x3d\x1c\x1f\xdf\x69\xca\x2f\x84\x27\x9c\x1a\x69\x4d\x50\x4f\x3e\x93\xae\x03\xe8\x97\x52\x30\x0e\x91\xb6\x03\x54\xbb\x15\x99\xb5\x50\x6f\x82\x3a\xa3\xa3\x56\x78\x63\x19\x4b\x65\x5f\xed\x40\x58\xc4\x6d\xf5\x53\x1f\xaa\x62\x95\xaa\xc0\x7c\x08\x89\x86\x5a\xf5\x23\xc9\xba\xa4\x83\x80\x0c\x50\x33\xf1\x00\x34\x13\xeb\x12\xa2\xcd\x64\x15\x6f\xfb\xea\x2b\xbf\xad\x4e\x65\x32\x4c\xb3\x0f\xff\x30\x72\xc6\x70\x76\x06\x89\xf8\x68\xe1\xa5\x77\x82\xa4\xfb\xef\x50\x51\xdf\x1a\x0f\xfa\xa7\x06\x12\xaf\x38\x2a\xf0\x86\xe0\x50\xfc\x08\x02\xa8\x44\xa5\xd8\x1f\x95\x28\x1e\xa6\x6c\x66\xe4\xa3\xde\x7f\xe1\x76\x71\x37\x43\xa0\x6e\xc0\xd5\xf5\xd7\x1b\x12\x9c\x82\x66\xf6\xf6\xdd\x62\x27\xf3\xb4\x94\x20\xad\xfc\x91\x2e\xda\xba\x6c\x60\x6b\x0b\xa7\x71\x0b\x69\xd7\xce\x63\x3b\x9e\xf3\x8d\xa8\xd5\x6f\x94\x12\xdf\x09\x14\x63\xbc\x26\xca\x50\xc8\xe2\xbe\xd9\x8a\xe2\x42\xbd\x11\xbe\x9c\x0d\x2d\x02\xbd\x6f\x35\x37\x6a\x7a\x30\xc6\x88\x4d\xc1\x93\x56\x5c\x24\x78\x9f\xbb\x84\x95\x91\xfa\x54\x65\x5d\x9a\x5a\xc2\xf0\x4c\xc1\x55\x03\xe2\x56\x00\x92\x89\xa6\x2b\xb7\x85\xac\x36\xdb\x7d\x55\x2e\xd8\x1b\x1b\x8b\xe3\xde\xa2\x3c\xb8\x14\x69\x5d\x80\xd4\x7e\x77\xaa\x99\x9a\x69\xc6\x1b\x24\xd2\xcf\xd0\xca\x7c\x19\x18\xe7\xe7\x0f\xba\xa7\xc1\xa0\xbd\x34\x6a\x3a\x38\x00\x33\x5a\x2f\x78\xfd\x2d\x44\x59\x15\x50\x1c\x5b\xa5\xf9\x26\x13\x26\x75\xa9\xe1\x47\x00\xbd\xff\xfe\x7b\x93\x23\x81\xc8\xe7\x04\xaa\x0b\xe9\x12\x95\xd7\x75\x60\xef\x64\xfe\x29\xb7\x85\x50\x5b\x99\x25\x83\x54\xb9\x31\x2d\x0d\x65\x30\x6e\xb6\x31\x55\xdd\xe9\x71\xd9\xc4\x29\xdf\x16\xb8\xc4\x37\xd6\xe1\x0b\x88\x1a\x29\xd8\xec\xec\x85\xc7\xa1\xe3\xa0\x63\x04\x4e\x59\xef\xa9\x1d\xf0\xd8\xf3\x85\x3b\x90\xaf\x4d\x1a\x6c\xd2\x74\x8c\x36\x2e\x63\xba\xf3\x17\x48\xaf\x10\x92\x96\xee\xc0\x05\x27\xce\xbd\xc0\x08\x8d\x30\xed\x76\xb2\xf0\x15\x2d\x0b\xf2\x94\xe9\x3c\x12\x07\x73\x67\x0b\xe1\xe1\x80\x14\xa2\xc0\x04\xdb\xc3\x34\xed\xc1\xe0\xd8\x1f\xad\x3a\xf4\x7f\xd6\x5e\xe8\x83\x4a\xe5\x01\x5b\x44\x90\xd5\xc7\xdb\x24\xfe\xd9\xa8\xe6\xb7\x51\x8c\x22\xd8\xb0\xad\xe2\x9f\x8d\x60\x7e\xdb\xc5\x28\x82\x59\xcd\x93\x5a\x8e\x19\xdb\x64\x3b\x86\x07\xa8\xd1\x64\xf9\xf0\x0e\x2a\x07\x47\x4d\xc9\x90\x82\xf0\x00\x15\xe1\x08\x9b\xc7\xdf\x90\xbb\x26\xdb\x40\xbc\x30\x3d\x56\x86\x29\x76\x90\x71\x3c\x2b\x93\xb1\xec\xfa\x44\x36\x91\x71\x76\x91\xbf\x30\xe3\x8e\xb2\x95\x3c\xde\x5e\xe2\x01\xca\x18\x2f\x0f\xb4\x99\x78\x21\x5a\x5b\xca\x48\xbb\xc9\x5f\x7b\x72\x9e\x48\x98\x0c\x0c\x70\xd4\x10\xc7\x0c\xf2\xa0\x61\x7e\x19\x1b\xcb\x97\xb1\xb3\x3c\x99\xad\x65\x84\x40\x0b\xbe\xc6\xe1\x2f\xa3\x01\x5a\xea\xe4\x25\xbf\x42\xdb\x66\x7e\x64\x53\x33\x88\xc2\xac\x51\x91\x8c\x89\xb9\x7f\xfb\x01\x02\xa5\x2f\x65\x02\xd7\x8e\xdf\x1c\xd0\x19\x3b\x31\x8d\x74\xdc\xb4\x6e\xfb\x1b\x3c\xbe\xc2\xa0\xea\x8f\xfc\x73\xfb\x15\xba\x66\xb7\x01\xbb\xef\xe1\xfb\x42\xde\x41\x6a\x2f\x9e\x1b\xbf\x2c\xa2\x22\x3a\x34\x26\xb2\xed\xe4\xd4\x80\xda\xfa\xdc\x00\x6c\xe3\x18\x88\xca\xd9\xd3\xf9\xd9\xe9\x29\x00\xd7\xce\xca\x3a\xfc\x9e\x6e\xbf\xf5\xb7\x3d\x37\x7c\xb8\xd0\x8b\x02\x37\x55\x27\x6e\x1e\x72\x1c\x5b\x64\x9c\x50\x47\x20\x08\xd7\xfa\x1e\x92\x35\x81\xdc\x60\xdd\x66\xb4\xe0\xc2\x9f\x14\xfb\x3f\xf7\xdd\x04\xbd\x5c\x5c\xd2\xdd\xd2\x1d\x47\xe9\x60\x63\xd3\xb4\x1b\xdf\x6c\x9f\xd7\x61\x5e\x14\x90\x05\x96\x80\x0e\x5c\xf3\x5d\x12\x04\x71\x56\x29\xd0\xb3\x5d\x5c\xd6\xba\x61\x53\x63\xc5\xc6\x38\xdb\x0f\x00\x68\x28\xe7\x92\xdd\xb9\xea\x0a\x43\x7f\xe3\x41\xaa\x1e\xf2\xb8\xe1\xbe\x6e\xdd\xad\x8d\xc7\x7a\x34\x4a\x3e\xb7\x88\x40\x58\x4c\x8b\xd4\x0c\xdf\xc1\xfd\x11\x9a\x07\x45\x67\x0e\x6c\x22\x56\xc8\x2e\xa3\xaf\x18\x91\x29\xd7\x03\x7e\xfc\x41\xac\x7d\x6b\xc0\x1b\x81\x19\x10\xda\xfe\xed\x73\xc2\xa6\xe9\x94\xfb\x2d\xd2\x3d\x99\xb4\xff\x32\x92\xfe\x4b\x49\xf9\xc7\x49\x78\xcc\xfd\xe2\x70\xc0\x3e\x5c\xba\x5b\x44\x7a\x30\x0f\x96\xec\x84\x40\x34\x8a\x7f\x5d\x9c\x3b\xef\x43\xe8\x09\x73\xa7\x20\x77\x72\x75\x5d\x19\xcf\xc9\x87\x1f\x25\x2a\xd4\x81\xd2\xd9\x43\x5d\x91\xb8\x2e\x8d\xeb\x0f\x5a\x6a\x80\x64\xdd\xb3\x98\x4f\xa2\xd9\x4b\x87\x0a\xae\x8e\x73\xdb\xac\xbb\x8d\x50\x8c\x80\x46\x14\xf4\xf6\x79\x1b\xcb\x43\x64\x36\x56\xae\xb3\x9f\xa4\x77\x2b\xd8\x49\x72\xa2\x48\x4b\x33\xef\xfc\xce\x90\x10\x87\xb0\xc0\x9b\x82\xe7\x0a\xbf\xe1\xaf\x3c\xda\x42\xec\x43\xaf\x93\x31\x1a\x00\x38\xbc\x87\x35\x4c\xa8\x3e\x23\x13\x39\xbc\xd9\xf1\x51\xd2\x9a\x85\x2f\x98\x60\xb0\xdc\xa4\x97\x9b\x47\x15\xcb\x1d\xe8\xeb\xae\x03\x3d\xaa\x6b\x9f\xd5\x47\x77\xc5\xd7\xc3\x13\xd2\xe6\x94\x9b\x87\xbd\x4d\x5a\x05\x00\x80\x11\x39\x2d\x16\xcb\xe8\x8b\xe9\xe8\xb8\x84\x82\x5d\xf6\x38\x46\xc7\x0b\x80\xf8\xa5\xf6\xb9\x1d\xdf\xcf\x41\x57\xb5\x8c\x46\x7c\xd5\xb7\x4d\xee\x3a\xd1\xab\xb5\x38\x59\x46\x01\x92\xbf\xab\xa5\x4e\xb3\x74\x36\x2e\x80\x86\x44\x82\xb9\x9f\x94\x59\x89\xcc\xcb\x3f\x62\x01\xcc\x68\x68\xde\x1b\x8d\xed\xd1\xd5\xea\xc3\x1a\xe9\xce\xfb\x4e\x05\x0e\xd0\xcc\x58\x29\x09\x07\xb6\x01\x24\x5c\x8c\xe2\x91\x5e\x3e\xfc\x2e\x65\x42\x95\xce\x1b\x52\x53\xbb\xc2\xb6\x8d\xea\x4e\x88\x54\x0d\xd4\x38\x9e\x59\x51\x47\x6e\x14\x75\xe2\x76\x67\xef\xb0\xf8\x83\x9f\xad\x54\xe5\xc5\xa5\xef\xed\xc0\x9a\x18\x3a\x0a\x3f\x5d\xce\x91\x11\x50\xf6\x32\x79\xd4\x40\xfc\x0b\x9c\x92\xb8\x22\xa5\xa6\xa6\x14\x99\x0f\x9c\xb2\xe7\xc0\x08\x1e\xb0\x01\x41\x11\x16\x16\x13\x0f\xc6\x43\x19\xa6\x83\xa4\x0b\x54\x90\x1f\xb3\x6d\x05\x61\x83\xdb\x9e\x48\x20\x14\xbe\x48\xd4\xa0\x3c\xf8\xa1\xd9\xda\x0a\x84\x4e\xd9\x03\xbd\x78\x34\xe0\x7e\xc6\x7e\xa3\xa5\x6a\xac\x2b\xb3\x48\x51\xb6\x41\xed\x85\xdd\x9e\x3c\xaa\x10\x72\x9a\x83\xd6\xad\xf1\x51\x27\x44\x0a\xc5\xab\xaf\x13\x84\x00\xc1\x83\xfd\xaa\x00\xb7\x2a\x91\x3c\x46\xe0\x68\x02\x04\xe4\x4d\x87\x0c\x4e\x88\x86\xea\x54\x71\xec\xd1\x02\x06\xbd\x39\x7c\x2f\x3b\x03\x78\x07\x6d\xcd\xe6\xad\xcf\x1c\xec\x7e\xfb\xe0\x9a\x38\xb6\x72\xaf\x27\x12\xe5\xf5\xf4\x11\x0f\x78\x1b\x8f\x94\x4f\xcb\xc7\x02\x78\xac\x80\x1b\x12\x4f\x48\xe7\xa7\x96\x4e\x5f\x53\x02\xa5\xbb\x3d\x8f\x7b\xc7\x8e\x1e\x8f\x5c\x60\x33\xc3\x24\xba\x93\xb9\x04\x34\xd7\x28\x13\xaa\x4c\x77\xdc\xe5\x37\x0a\x7f\x1b\x1e\xe0\x56\xc3\x64\xd2\x12\x2c\xa2\xe9\x8c\x0e\xc5\xfe\xe4\x03\x9c\x7a\xbc\x2b\xa1\x35\x8c\xb7\x75\x7b\x2b\xaa\x1a\x30\x1a\x12\xcb\x9c\x68\x3c\x50\xf5\x2d\x8f\x34\xeb\x3e\x89\x16\x94\x21\x01\xdc\xda\xc4\x06\xb7\x1d\x20\x35\x6f\x8c\xd6\x0b\xd1\x38\x87\xa5\xaa\x2e\xe2\xb6\x7a\x08\x0a\x9e\x31\xe2\x04\x7e\x0c\xbc\x50\x9b\xce\x90\x5e\x1b\x14\x68\x28\xa4\xe8\x90\xeb\x16\x89\xc3\xc6\x8b\x96\x52\x6c\x14\x05\xcc\x0a\x21\x6d\x4d\xa0\x65\x58\xc4\x8c\x12\x10\xa3\x45\xcd\x04\x68\xa4\x51\x99\x40\x68\xd2\xe9\x58\x96\xd1\xbf\xf2\x8d\xd5\x46\x16\x02\xcb\xb1\x0c\x91\xb9\x3e\xb9\x9b\xd9\x7e\x12\x3a\x9b\xaf\x4f\x18\xd1\x15\x75\x31\x43\x4a\x84\x02\x39\x6c\x74\x65\x34\xac\x27\xe7\x9c\xa1\xd4\x08\x53\xc7\x1e\xde\x3f\x48\xc5\x35\x44\xea\xc0\x56\x32\x66\x3b\xa1\x03\xaf\xe6\x89\x40\x8b\x41\x1e\x19\xd8\x97\x86\xf7\xa6\x03\xf6\x27\x23\x6a\x97\xd1\x08\xa6\x31\x96\xd8\xc3\x64\xcd\xf0\x8c\x6a\x27\xdb\x77\x64\x6e\x21\x0d\xff\x38\xd4\xae\x9d\x5d\xed\x26\x44\xce\xb6\xea\x98\x0e\xbe\x1e\x90\x5a\x29\x05\x83\x9a\xd9\x39\x3f\x81\x7f\x61\x8c\x14\x24\xdc\xc4\x20\xb2\x42\xf0\xe4\xa1\x2e\xbd\x0f\xa9\xca\x7a\x11\x13\xbd\x15\x32\x86\x44\x03\xfb\xd9\x08\xf9\xf6\x45\x18\xe4\x2e\x95\xa0\x0c\x4d\xde\xea\x84\xe7\xa9\xcc\xdf\x40\x30\xc1\xc8\x43\xc1\xaf\xbe\xde\x76\x76\x2e\x65\xff\x65\x5f\xa1\x6f\xfe\xd0\xd5\x25\x34\x53\x20\x65\xef\x4d\x8d\x69\x83\xbd\xaf\xf8\x09\xfc\xdc\x42\x25\xe1\x7c\xd3\x9f\xa8\x66\x92\x47\x7b\xf6\x02\x2e\x81\x69\x9c\x43\xb7\xc5\xdf\x69\x2e\x43\xf2\x72\xee\xe3\xcc\xa0\x5c\xda\x83\xdb\xcf\x32\x1a\xe0\x81\x5a\xb5\x86\x61\xd5\x46\x88\x98\xd0\xe8\xda\x06\xd0\xd5\xff\x2f\xa2\x89\xa4\xdb\xdb\x7b\xeb\x32\x9a\x34\x29\x2d\x7c\x9d\xd7\x4d\x48\x71\x04\xdb\x17\x28\xe8\x75\x2e\xb5\x5a\x23\xee\x04\xc9\xea\xf8\xf0\x34\x1f\x35\xb4\x31\x87\x45\xee\xac\x1f\x38\x92\x3c\x4f\xa6\x1f\x0b\x2a\xde\x7b\xf4\x7c\xcd\x56\x45\x2a\xd6\xec\x9c\xef\x44\x76\x0e\x1c\x40\xfd\x4d\xf0\x01\xf8\x70\x51\x69\x50\x2f\x44\xd6\xa0\x7a\xdb\x00\xa7\x73\x50\xea\x44\x80\xda\x73\x7f\x2f\x13\x1d\xa1\xb0\xe7\x95\xf2\xcb\x66\x66\x5b\x5b\x5d\x11\x7b\xb9\x9b\x45\xde\xd6\x7f\x93\x7b\xf5\xe3\x15\x87\xc8\x89\x1b\x5f\x0c\x97\x6b\xc9\x50\x73\xb3\xb6\x4d\x77\xb3\xff\xe9\x19\xab\x75\x17\x60\x6e\x93\x45\xe8\x5c\xc6\xec\xd5\x8a\xa2\xec\x1e\x79\xc3\xa2\x1c\xc2\xa2\x08\xb6\xea\x0c\xeb\xdc\x76\xea\x9f\x7c\xc8\xf1\xc0\x68\x93\x4a\xa9\x5d\xbf\xec\x77\xc6\x1d\x9d\x43\x7a\x9c\x11\x1b\x49\x08\xe7\xd0\x9c\xd8\xf9\xe0\xa3\x11\x1e\x47\x66\x5a\xaa\x9a\x28\xc3\x0d\x3b\xb8\xbf\x26\x62\x8e\x20\x76\xe8\x1c\xd7\xfc\x63\x87\x87\x1e\x3d\xb9\x2c\x4d\x2a\x45\x91\xb0\x87\x5e\xfd\xa7\xc3\x8e\xae\xcd\x3f\xe9\xf4\x51\x5f\xbc\x35\x53\x94\xda\xcc\x90\x16\x6d\x8a\xd6\x4b\x20\x4b\x7e\xb1\x3c\x39\xf9\xfc\xf9\xf3\x08\xf8\x23\x56\xf8\x98\x53\x41\xf3\xcf\xdc\x50\x7f\xb0\x9d\x33\x5b\xe3\xe8\x33\xc5\xb4\x43\xd0\xc1\xc7\xda\xda\x5c\xfe\x0b\x64\xfe\x16\x7e\x53\xb9\x73\xce\x3e\xb4\x3a\x9a\xf9\x6b\x66\x60\x05\xd0\x3a\xd0\x78\xd4\xa5\x38\x64\x51\x98\x3c\xaf\x83\xfb\x73\x6f\x3c\x1f\xcd\x8e\xac\x07\x82\xaa\xd7\xfe\x48\x4c\x44\x2c\xe9\xe5\x83\xe0\x19\xec\xc0\xef\x3e\xa7\x61\x21\x3f\x61\x48\xbe\xca\x92\xde\x11\x35\x6b\x4c\x42\xc4\xb0\x4c\xac\xe8\x33\x91\xdb\xb5\x4e\x12\x06\x19\x04\xce\x0c\x09\x14\x8b\xe1\x58\x01\xba\x3f\x5a\x9c\xd7\xa2\xfc\xc9\x19\x21\x78\xc0\x38\xbd\x47\x6a\xcf\x30\x5b\x47\x6a\xec\xdc\x9f\xb6\x63\xb8\xa2\xc2\x63\x92\xaf\xfe\xeb\x95\xfe\xd1\xb3\x7b\x6c\x32\x36\x53\x50\xfb\xd3\xcd\xe5\x3e\x4d\xd4\x94\x21\xa6\x4d\xe3\x57\x21\x21\x64\x59\x58\xef\x49\x5b\xb7\x9b\x94\xde\xe1\x2b\x3e\x21\x20\x93\x66\x14\x74\x09\xdb\xa2\x2c\x54\x14\x14\xec\x63\x76\xe2\xa1\x2c\x94\x87\xed\x2b\x5f\x58\x0c\x0e\xef\x00\xf3\x8e\xa0\x0c\x34\x44\x16\x8c\x1e\x21\xf9\x43\x8e\x39\x3d\xd6\xb8\xc2\xc6\x1d\xfb\x1a\xf1\x3f\xdc\xcd\x44\x63\x9a\xed\xd1\x3a\x1a\x73\x2a\x03\x98\x3a\x5d\x07\xa5\x18\x8f\x1e\xc1\xf4\x88\xca\xc8\x21\x81\x67\x85\x5d\xd0\xd8\xb1\x89\x95\xf5\x9c\x5b\x3d\x34\x1d\x2d\xb2\x20\x07\x39\x5c\x8f\x69\x2f\xa7\xf2\xb0\x60\x5d\x7a\x00\xe1\x9e\xcb\x12\xab\x88\x6d\xf9\x7e\x2f\x72\xbf\x3d\x99\x46\x3e\x70\x80\x1d\xa4\x4c\x98\xf3\x1c\x0e\x8a\xff\xff\x78\x2f\xf8\x97\x41\x90\xaa\x36\x49\xd6\x32\x1a\xe2\x33\xd3\xb2\xe5\xef\x24\xab\x32\x96\x3b\x88\x7a\x5f\x93\x2e\xcc\x68\xc6\xbc\x9a\xcb\x34\xef\x59\x2a\x17\xd1\xf4\xfb\xc3\x3a\xcd\x4a\xc8\xf3\xfe\xe6\xc1\xba\x7b\x2f\xa3\x11\xeb\xe5\x87\x7e\xbf\xfe\xfd\x01\xf3\x34\x86\xd5\xaf\x35\x06\xa6\xf4\xa2\x65\x14\xb6\xc7\xd4\x64\xd1\xa1\x72\x7c\x23\xf3\x71\x63\xf9\x51\xe6\x03\xc8\x5b\xbf\x8b\x95\x88\x83\xca\x8d\x72\x2b\x1e\x8e\x0a\xd1\x96\x61\x8c\x43\xb0\x92\x4d\x87\x94\x16\xf5\x18\x15\x1c\xd7\x20\x44\x02\x4a\x9b\xa9\x83\x87\x4a\xb8\x8e\x1a\xed\x47\x1a\x57\x6f\xc0\xc0\x6a\xed\x8a\x86\x9e\x70\x92\xf1\x78\x41\x6d\x6c\x11\x97\x23\x31\xbb\x34\xad\x07\x26\xc3\x42\xf5\x00\x45\x25\x06\x3a\x73\x12\x44\xf0\x23\x3e\x78\x0c\x8a\xaf\xc5\xa6\xe2\x45\x32\x72\x14\xd7\x75\xfb\xfe\x38\x62\xa8\x1f\x97\xb7\x78\xca\x03\x93\x99\xf5\x60\xbf\x5f\xab\x10\x8c\xbe\x9d\x17\x02\x7c\xf7\x62\x59\xe5\x48\xb5\x9c\xc4\x8b\x48\x0e\x1f\xad\xc8\xc6\x4f\x98\xf9\x5a\x7f\x9c\x38\xc0\x75\x9a\xf3\x2c\x7b\x30\x83\x0e\xd8\x02\x4c\x60\xc4\x81\x68\x87\x36\xb4\xb9\x4b\xc4\x39\xdb\x11\x8f\x39\xdf\x85\x58\xce\x78\xeb\x3b\x5f\x06\x37\x2f\xbb\x6d\x60\x26\xc6\x65\x34\x8a\xdc\xa6\x79\x6b\x03\xa1\xc4\x7b\xe6\xcc\x62\x01\x47\xfe\x83\x89\x3f\x73\xe3\x01\xdb\x08\x7d\x7f\x14\xd7\x5c\x11\xae\x3d\xa6\x69\x0c\xe4\x40\x46\xc0\x33\x5f\x51\x86\xb4\x0a\x6d\x8a\x9a\xd6\x2e\x55\x82\x4e\xe8\x48\x8e\x62\x61\x8a\x8e\x57\x28\x0c\x1c\xce\xc2\x9c\xec\x27\xce\xbc\x1e\xf8\x64\x26\xa4\x0c\x70\xce\x6f\x3a\x73\xc5\x69\xe7\x97\x02\xe2\x59\xd7\xbd\x43\x08\xbb\xe7\xaa\x86\xe9\xbe\xf3\xa6\x4d\x15\x00\x8a\x8a\x7b\xd1\xc8\x1e\x87\xf7\x5e\x5b\x90\x60\xd5\x32\x3f\xdc\x73\x95\x1f\x95\xe1\x62\x30\x69\xae\x35\x95\x3b\x17\x27\x87\x73\x5f\xa9\x92\x43\xdd\x9b\x61\x52\xe8\x76\xad\x35\xd8\xd5\xbe\x53\xea\x3c\x74\x3d\xf4\xd5\x54\x73\xba\x8d\xd9\x01\xc0\x02\x55\xcd\x92\x5a\x66\x4b\xa0\xaf\x9b\xbc\x9c\xae\x71\x32\x6d\x45\x2d\xc4\x4e\x42\xc8\x8f\x84\xba\x92\x50\x90\xd9\x82\x05\x4b\xb0\xf9\xe2\x21\x4b\x3e\x17\x9f\xc7\x2f\xb4\x9f\xc4\xe7\xfe\x3a\xb3\x4e\xac\x3d\x0a\x78\x40\xb2\x9a\x32\xf0\xf5\xc0\x2d\xe8\x89\x96\x23\xe4\xe1\xc2\xb4\x07\xa3\x06\x69\x52\x24\x38\x05\x9b\xcb\x7d\xd8\x03\x94\xc1\xfa\xda\x48\x00\xa5\x7d\x67\x0d\x59\x70\xf8\x07\x8a\xc6\xb0\x64\x31\x93\xe9\x7c\x49\x34\x98\x2c\x58\x8c\x44\x5a\x46\x87\x4d\x50\x60\x72\xbc\x1f\x36\x37\xf5\x1f\x45\x2e\xdc\x8e\xe8\xad\x29\xfb\x47\xaf\xb9\x99\xbd\x4d\xfd\x84\x66\x10\xa2\x04\x3d\x55\xb3\x8c\xd2\xa0\x42\x2e\xb6\xda\x02\x88\x32\xd4\x2e\xde\x8d\x97\x55\x09\x63\xb6\x69\x0e\x59\x06\x06\x3c\xa8\xdc\xe4\x84\xda\x47\xc3\x19\xa0\x16\xd2\x80\xf9\x39\xc3\xa9\xf5\xf4\xeb\x39\x29\xf9\x32\x0e\x65\x11\x8d\x9c\x2b\xf7\x8d\xda\xdf\xbc\x72\xd4\x6d\x6a\xa1\x74\x05\x2d\x98\xaa\x76\x3b\x5e\xa4\x7f\x52\xf2\x08\x9d\xa1\xb5\xef\xad\x6d\x6b\x47\x47\xde\xdd\x82\x22\xba\x74\x5e\x1e\x5e\x25\xa0\x6e\xb5\xe9\x4f\x39\x66\x6a\x34\xf7\x21\x67\x76\x19\x8f\xad\xb0\x85\xf3\x4f\x3a\xd3\x9d\x13\xf5\x32\xc6\xfd\xae\x93\x5d\x97\x86\xd2\x03\x0b\xb5\xf5\x3d\xf9\x5c\xc2\x02\x5b\x57\xa7\x77\xbd\xe9\xe0\xfa\x16\x1b\x9a\x39\xef\x15\x9a\x6f\xc8\xb0\x32\xd6\xc8\x3a\x61\xb2\x66\xa9\x48\x76\x50\xa4\x9c\xd1\x0d\xa8\x11\x58\x1f\x69\x85\x44\xad\xb1\x2e\x63\xd3\x9b\x94\x91\xb3\x3d\x5c\x97\x4a\x76\xb6\x84\x04\x5d\x5e\xc5\x2c\xde\x02\xd8\xd1\x51\xba\x57\xa2\xfc\x1f\xa0\x8c\x60\x89\x2a\xff\xe7\xd1\x11\x8b\x33\xae\x54\x9a\xb0\xb3\xe5\xb7\x33\x67\x1a\xeb\x01\x6d\xf5\xc0\x58\x87\x34\x59\x07\x28\x9a\x71\x0c\x63\xa8\x77\x71\x79\xdd\x74\x0e\xd3\xfd\x74\x72\x83\x86\x66\x06\x27\x7b\x31\x7d\xe0\xfd\x4f\x5d\xe3\xea\x7d\xe8\x2e\x05\x30\xbf\xa2\xdb\x0f\xf8\xf0\xe4\x1a\x0d\x0f\xcc\xa1\xd3\x09\xfc\x88\x3c\x78\x29\xe9\xa1\xf6\x2e\x0f\x5c\x4c\xe2\x34\x29\x8c\x9d\x43\x63\xe6\xa6\xc4\x90\xcc\x6d\xfe\xd9\xf2\x7e\x3a\x6e\x2f\x76\xef\xb9\xda\x1a\xd4\xd4\x96\x9f\x19\xc4\x14\x7a\x74\xb4\xf0\x0b\x80\x64\x63\x71\x0f\xf0\xe9\xb0\xcb\xcd\x28\x20\xa1\x13\x09\x85\xa2\xe4\xa1\xcb\xe0\x1c\xe9\x17\x1d\xe0\x60\x1c\x3c\xb6\x7c\xa1\x95\xa8\xa5\xfb\x32\x1a\x9c\xe7\x0b\xb3\x11\xd4\xab\xb1\xb5\x33\x18\x35\x22\x1c\x21\xd3\xdc\xeb\xb4\xa9\x65\xde\x3f\x7e\xb9\xb9\xfc\xe5\x86\xcd\x77\x98\x6f\x62\x3e\x47\xe9\x36\x87\x7f\x1b\xc9\xc6\xe6\xbf\xb3\xb7\x57\xff\xb8\x9c\x1d\xb0\xb0\x07\x27\xf9\xc9\xe9\xe8\x67\xbb\x01\x5c\xac\xc6\xf5\xa0\xde\x78\xb5\xe3\xbb\x31\xd3\x77\x49\x4d\xed\xf4\x95\xf1\x7c\xb5\x5f\x5b\x10\x66\xd9\xc2\x33\x28\x3f\x21\xf2\x24\x3c\x85\x42\xbb\x78\x61\xa1\x67\x76\x76\xba\x53\x0c\xaa\x3d\xb3\x17\x2f\xff\x3b\x44\xaf\xbd\xd0\x0b\xff\xef\x31\x7b\x7f\x24\xa9\x8a\xc7\x10\xf1\xe8\x67\x6c\xd9\xa0\x21\xf5\xed\xed\xe5\xdf\x52\xf5\x54\x27\x4c\xc6\xbe\x3d\x5d\x52\x55\xf8\x9a\x7e\x7f\x8b\xcd\xdb\x2f\x1c\x3d\x92\x2d\x64\x61\x0b\xc8\x3b\x3f\xda\x13\x10\xb6\x45\x5b\x96\x51\x60\x52\x4d\xd5\x65\xf2\xf2\xa5\xad\xcc\xe7\x8f\x6c\x61\x2e\xa2\xf1\x3b\x3f\x55\xad\x5c\x46\x03\xfc\x45\xd5\x4a\x3b\x2a\x54\x2c\x4a\x6a\xd6\x27\xa5\x22\x31\x68\xb8\x15\x0d\x45\x95\x53\x6d\x03\x99\x25\x42\x95\x5a\x67\xb8\x88\x26\x31\x97\x93\x48\xe0\x04\x52\x58\xc5\xba\xc6\x93\x92\x98\x1b\x8c\xfc\xf5\x86\x87\x0f\x47\x81\x60\x6b\x07\x52\xef\x74\x6b\xb7\xf2\x06\x0a\xba\xae\xd3\x3c\x55\x8e\x1a\x0b\xfd\x0b\xea\x90\x42\x66\xc4\x4a\x1b\xe1\x68\x35\x02\x86\xae\x41\x3b\x92\x00\xf5\xac\x40\xa7\x7a\x56\xf0\xb7\xb1\xb3\x32\x12\x31\x0b\x69\xc2\x04\x19\xfc\x06\xa6\x09\x55\xb3\xa6\xa9\x17\x32\xa6\x53\x45\x75\xf2\x57\x9a\xce\x41\x9d\x7d\x77\xb4\xa6\xbd\x7b\xa4\x56\x51\x6c\xef\xf8\x5f\x65\x1c\xe1\xe3\xec\x9c\xb8\xc5\xf3\xb2\x35\xe9\xd1\x01\x67\x56\xbf\x1c\x3f\x60\xf3\x01\x6d\x20\x79\xa1\x2d\xa3\x81\xc9\x00\xcd\x6e\x6b\x02\x52\x13\x23\xc0\xee\xa1\x3e\xfc\xca\x96\x62\x77\xb2\xdc\x98\x09\x08\x92\x1e\x70\xc5\x52\xcb\x4f\x81\xa9\x51\x57\x60\x61\xb1\xa7\xc7\xd6\x3b\x87\x3a\x1f\x22\xde\xc2\x97\x51\x60\x08\x37\x75\x3b\xc3\xfb\x78\x9d\x33\x9b\x96\xa9\x7e\x63\x23\xa6\x29\x17\x62\x07\x26\xa3\xdc\x88\xe6\x26\xd1\xcb\xb6\x68\x0b\x0b\xe8\x10\xbe\x45\x34\x7e\x7f\xc1\xe3\xe8\xe0\x5c\x9c\x43\x2b\x7b\xbc\xc3\x3e\x9d\x6f\xa3\xbe\xdc\xa6\x83\xfc\x5c\x8a\x22\xe7\xee\xcc\x36\x26\x99\xe4\xb4\x5d\x37\xc8\x54\x4f\xbd\x9e\x3c\xb7\x96\x20\x0e\xbe\x10\xd1\x16\x1d\x2f\x8d\x25\xc7\x61\x62\xd0\x9c\x91\x2a\xb6\xce\x2a\xd8\x9c\x99\xcc\x8f\x7d\xe5\x46\x42\xd1\x7d\x7f\x61\xc2\x12\x83\x9e\x8f\xe2\x39\x8a\x50\x75\xb1\x9e\x49\x28\xaa\x13\x16\x96\x75\x52\x52\x07\x4c\x16\x4e\x54\x3a\xb0\x74\xfe\x32\xc4\xf3\x6d\x57\xce\xeb\xc5\x80\xe0\xb2\x8e\x09\xcb\x28\x40\xff\x66\x21\x93\xf1\x0e\x70\x9a\x9e\x91\x33\x57\xc5\x08\x1f\xb8\x90\xa8\x72\x38\x86\x0c\x32\xd1\x64\xbf\x37\xfb\x95\x28\xe0\xe3\x33\xc2\xe7\x2d\xac\x51\xf4\xf9\xba\x7d\x09\x3f\xb7\x27\xf7\x71\x0b\x0f\x8d\xf0\x5b\x46\x5f\xc5\xaf\x2d\x8c\x8b\x75\x03\x5a\x46\x4f\xed\xcb\xe6\x0b\x95\x1e\xe1\xc7\x16\xc6\x79\xc0\x7f\xed\x51\xbe\x6b\x3e\xa4\x9f\xd2\x6f\x6d\x60\x74\x22\x1b\x37\x21\xe6\x0b\x63\x7d\xd5\xbc\x7a\x74\x8f\x9f\x5a\x08\x4d\xbf\xac\x75\x88\xa0\x5e\x1b\xe2\x97\xde\x73\xcb\x3a\xd1\x48\x7f\x34\x8f\x14\x77\x61\x37\x6f\x04\x4d\x47\xa1\x40\x03\x07\xcc\x2e\xbc\x39\x26\xfe\x8d\x9c\xed\xef\x44\xa1\x90\xf3\xee\xce\x78\xb6\xdf\xf2\xb3\xfa\x19\xee\x90\x7a\x37\x6a\xbd\xa6\xac\xef\xc9\x12\x5d\x90\xf4\x83\x52\x16\xa0\x0f\xd0\x4f\xea\x44\xa1\x60\xbd\xdd\x97\x22\x41\xda\xc2\x03\xc8\x3a\x90\x27\x4b\x53\x44\x73\x9f\x55\x05\xcf\xe8\xd7\x46\xf2\x5a\xf6\xef\xff\x11\x81\xff\x82\x2c\x44\xf2\xab\xc1\x06\x1e\xce\xe7\xf3\x88\xef\x53\x7a\xb6\x64\x7c\x9f\x8a\xcf\xa5\xc8\xb1\xc5\xe2\xf6\x95\x5a\xa4\xf2\xe4\xee\x6c\x25\x4a\x7e\x16\xe9\x4f\x9d\x57\xaa\x94\xbb\x2b\xa1\x64\x55\xc4\xe2\x2d\x14\x3c\xc0\xaf\x44\x3b\x51\xf2\x84\x97\x7c\x19\x35\xcb\x70\xd1\x36\x55\x07\x18\xcc\x37\x22\x5f\xc0\xbe\xbe\xaa\xd2\x2c\x11\x05\x7e\xc1\x52\xed\x74\xf1\x62\xf1\x12\x90\x2f\x04\x37\x59\x64\x55\xc9\x77\xfb\x25\xcb\xab\x0c\xce\xcb\x9a\x7e\xfb\xed\x83\x4a\x63\x9e\xed\x38\x48\x41\x81\xab\x71\x81\xff\x9d\x43\x51\xd6\x85\x2c\x36\x11\xcc\x12\x7c\x1d\xf3\x44\x2e\x59\xe7\x2d\xa9\xd0\x9b\x54\xbc\x24\xa0\x1f\x35\xd0\x73\xeb\xd2\x02\xba\xe0\x7f\xf3\x36\xf9\x90\xaa\xb2\x45\x7e\x17\x72\x91\x49\x4e\x52\x65\xbc\xf0\x36\x51\xb1\x04\xf6\xb3\x6b\x07\x38\x5e\x55\xab\x82\xa8\x4d\xc4\x24\x86\x60\xff\xf9\x5f\xc0\x5d\x3c\x4b\x93\x86\x63\x88\xdc\x8b\xfc\xf5\xe5\xc5\xaf\xdf\x80\x0e\x65\xc7\x97\x91\x43\x76\xb8\x46\x61\xe4\x88\xee\x66\xc3\xa1\xbc\x63\x81\xbf\xaf\x2f\x2f\xb4\x3b\x88\x09\xac\x6d\xb8\x60\x99\xa3\x00\x92\x1d\x6e\xa0\x8d\xec\x5d\xa0\x62\x93\x79\x0b\xbe\x85\x49\x1f\xc2\x4d\xf6\x2e\x2d\xca\x8a\x67\xf6\xd9\x22\xf2\x1f\x82\x1a\x6c\x1c\x79\x44\xe6\x11\xd0\x45\xb7\x69\x55\xea\xa0\x05\x0a\x5e\x91\x7a\xf0\x78\x73\x4b\x1b\x89\x1b\x78\xcf\x53\x12\x94\x64\x39\xad\xfb\x05\x66\xb0\x07\x63\xbd\xda\x62\xc6\x90\x58\xe6\x77\xa2\xd0\x31\xc2\x9b\x3c\xfd\xd3\x42\xb6\x19\xe6\xb5\x36\xb6\x05\x11\x44\x2d\xdc\x09\x4d\x81\x48\xb8\x2b\xee\x38\xd4\xce\x86\x6f\xb0\x2a\x6f\x40\xc3\x26\x6a\xc1\x3e\x42\xb1\x6e\xc8\x29\xb1\x64\x50\xd6\x54\x2d\x4f\x4e\x36\x69\x69\x16\x6e\x2c\x77\xbb\x2a\x4f\xcb\x87\x13\x5c\x7e\xe9\xaa\x2a\x65\xa1\x4e\x12\xa8\xed\x7a\xa2\xd2\xcd\x9c\x17\xf1\x36\x85\x8d\xb8\x2a\xc4\x09\xdf\xa7\x73\x44\x3c\x87\xc1\xaa\xc5\x2e\xf9\x6f\x96\xef\x8e\xa2\x81\x23\x3d\x2e\x20\x2f\xdd\x61\xed\x50\x19\x13\xec\xa6\x87\x58\x93\xd7\x9c\x63\xae\xde\x5d\xdf\x30\xf3\x51\x9c\x82\x06\x48\x46\xd4\xae\xbb\xa9\x9a\xf0\x40\xa8\x34\x5f\x0b\x28\x9e\x99\x52\x99\xe4\xe6\x75\x86\xee\x40\x69\x37\x8e\x56\x55\xab\x5d\x8a\xae\x01\x7f\x54\x42\x81\xe3\xb0\x5c\xb0\x73\x14\x5f\xa0\x3f\xa9\x30\xa8\x37\x59\xb0\x8b\xbc\xce\x3b\xf1\xc5\xc9\x0e\x14\x56\x73\x20\xe9\x30\xe1\x9b\x52\x97\x31\xe7\x9e\x44\x23\x25\x69\xe8\x9c\xa1\x6b\x70\x05\x6b\x2e\x89\x95\xd8\xf2\xbb\x54\x16\x94\x7d\x84\x16\xa9\x59\x88\xbd\x3c\x24\xd1\xf0\x05\xc5\x9d\x72\xa4\xcd\x27\xaf\xe3\xb2\xbb\x36\x41\x88\xa7\xeb\x34\xf6\xe1\x40\xe9\x3b\x3a\x60\x19\xbb\xb6\xf5\x9e\xe8\xc3\xc6\xf3\x67\xae\x0d\x67\x27\xf6\x77\xb4\x3e\xd6\xbf\xc6\xb2\x80\x2c\x42\x8e\x6a\x50\xa6\x45\x52\x61\x12\xae\x52\xb0\x13\x0a\xfa\x9c\xc7\xfb\xaa\xfe\x65\x27\x76\xec\x84\x25\xa9\xba\x9d\xaf\x41\x63\x78\x62\xa2\x4d\x31\xd7\xcf\xd1\xb8\xfa\xd9\xe6\x5b\x80\xac\xf7\x2d\xa0\xee\x7d\x49\x03\xf1\xbe\xb7\xc3\xe8\xb5\xa8\x07\xe5\x7b\xb5\xeb\x45\x29\xcf\xeb\x01\xf7\xde\x34\x87\xdf\x79\xe9\xe4\x69\xaa\x45\x05\x48\x08\x15\xe4\x18\x2a\xfd\x5c\xfb\xb8\xd8\x6e\x4c\xae\x1d\xdb\x4f\x29\x69\x7f\xea\x00\x65\xb4\x83\x91\x39\xf6\x37\xaa\x11\x7d\xf6\xaf\x2f\x16\x67\xdf\x2d\x4e\x17\x67\xa7\xcb\x6f\xce\xfe\xf5\xbb\x57\xbf\x45\xa3\x34\x1f\xde\x51\x31\xb6\x4b\xf3\x0b\x54\xe2\xb1\xb3\xce\x1b\xbf\x2e\x64\x82\x1e\x04\x26\x21\x48\xb1\xb7\xa9\xba\x6d\x2d\x30\x9d\x31\x07\xc8\x05\x7d\x69\x3d\x75\xb9\xca\xb7\xa8\xe1\x67\xcf\x4b\xa7\xd3\x4f\xeb\xb3\x97\xbc\xb4\xce\x3e\xd0\xc1\x4c\xcf\x1a\x43\x4a\x25\x28\x15\xb4\x2b\x37\x20\x71\xec\xbd\xab\x60\xf3\xb4\xf6\x02\x27\xc5\xb7\x11\x06\xa9\x0a\xea\xe6\x03\xd3\x02\x61\x03\x7f\x0e\x6b\x1c\xae\xd3\x3f\xad\xc6\x01\x3a\x34\x87\x61\x78\xe7\xec\xf4\xc7\xdf\xa6\x7d\xdc\x75\x61\xa1\x95\xc3\xcb\xae\x43\xd0\x1c\x31\xed\x3c\x74\x4a\xfc\x50\xf6\xea\xd6\xa8\xde\x9a\xb4\x45\xf5\xce\x8a\x23\xb4\xe9\x8c\xe4\xba\x97\x30\x29\x1a\x39\x3a\x88\x03\x0c\x7e\xfc\x83\xe4\xf6\x0e\x0b\x8d\xe9\x88\x08\x1e\xe3\xda\x55\xd8\x98\x2f\x20\x24\xc3\xa8\x21\x21\xd3\x52\x9f\x8e\xcc\x18\x06\x55\xd0\xb7\x38\xc4\xcc\x2b\x99\x0c\x97\x0a\x7f\x23\x13\x5b\x29\x1c\x3a\x98\xaf\xbd\xbf\xb9\xb9\xb4\x27\x89\xee\x57\x83\x54\x1a\x4a\x34\xee\x9e\x2e\x42\x01\x2a\xbc\x74\xa7\x0a\x28\xd9\xa8\xb5\xfd\x72\xe7\x71\xa8\xb9\x31\x54\x87\x90\x17\x05\x75\x00\xd2\xcc\x9a\x57\x45\x0e\xf9\x73\x6d\xed\xf6\xc9\x23\xda\x0a\x9e\x78\xbc\x5d\xc7\x97\x1a\x0c\x7e\xa1\x43\x99\xf7\xfa\x83\x56\x19\x4e\x08\x38\xe7\x27\x54\x68\xdd\x1c\xaa\x4c\xc7\xcd\xd5\xe5\x39\x8b\x79\xe6\xae\x7d\xeb\x5d\x7c\xe1\x84\x23\x81\x34\x23\x88\xa8\xee\x69\x54\x4c\xa9\x62\x3f\xbe\xbb\x69\xcc\x86\xdb\xc9\x8a\x6e\x6d\xeb\x2a\xcb\xf0\x7e\xdb\x1a\x41\x0f\xe2\xec\x64\x53\xec\xe3\xc5\x56\xf0\xac\xdc\x2e\xee\xce\x16\xef\xf1\x5f\x27\xe7\x5b\x11\xdf\xba\x33\x98\x35\xd8\x01\x6f\x7e\xb2\xdc\x8a\xa2\x09\x5e\x9b\x22\x80\x58\xe4\x76\x0e\xf7\x24\x08\xa4\x7a\x30\xa4\x9f\xcc\x47\xa0\x18\x92\xb1\xcc\x06\xa9\x78\x49\x0d\x0d\x1d\x4d\x47\x43\x05\xe0\x74\xca\x55\x00\x3b\xbc\x4f\xdb\x67\x06\xe8\x78\xe9\x3a\xad\x91\xef\x66\x59\xee\x9d\x2f\x80\xc4\x53\x47\xfc\xc7\x5e\x0d\x0e\xf6\xe7\xcb\xeb\xbe\xee\xcf\x72\x37\xdc\x8f\xa0\x28\x15\x53\x02\x14\x41\x8b\x89\x1a\x3e\x63\x09\x19\xc4\x82\xaa\xe0\x13\x22\x55\x61\x69\x6d\x24\x35\x28\x12\x80\xd8\x56\x1a\x39\x20\x32\x36\xa3\x13\x57\x0c\x9c\x27\xab\x72\xa1\xb6\x72\xbf\x7c\x75\xfa\xea\xf4\x44\x73\xe7\x9f\xb3\x63\x58\xb1\x29\x68\x19\xf4\x19\x0f\x55\x14\x40\x5c\xf7\x4a\xb0\xb2\xaf\x0d\xf2\xfb\xd3\xef\x4f\x67\x8b\xa9\xf3\x01\xa6\x71\x59\x8d\xa0\x86\x6e\x67\xe6\x05\xba\xb1\x2c\xdd\xa5\x25\x22\x8b\x99\xdd\x68\x86\x06\xc8\x71\xa6\x66\x94\x36\xf4\xe5\x23\x44\xb0\xff\x60\xf1\xc7\xbe\xfb\xe1\xb9\xdb\x32\xe5\x15\x6e\x74\x8d\x58\x46\x01\x72\x50\x98\x88\xe7\xbc\x49\x10\xe8\x40\xa1\x26\xec\xd2\x70\xb5\x11\xd9\xb8\xdd\xf2\xbc\x6e\x6b\xa6\xa5\xd1\xbd\xf6\x19\xd8\x17\xe2\x2e\x95\x95\x62\x7b\x70\x62\x2d\xdd\x4c\xa5\x4f\x78\x2f\x5e\x4e\x3c\xe0\x85\xa2\x55\x46\xc4\xaa\xe8\xce\xf5\xe5\xa5\x75\x59\x71\x80\x64\xec\x37\x51\x6e\x4f\x27\x23\xf9\x7b\x5a\x96\x23\x3c\x64\xfe\x0f\x36\x33\x48\xea\x4e\xb0\xea\xf1\x62\x5d\x9f\x82\x77\x6a\x32\x02\xa0\x15\xcb\xe3\xe1\x03\xd8\x07\xdd\xce\xa0\x40\xdd\x1c\x38\x1c\x82\x44\x20\xb5\xfb\xf8\x84\xee\x9a\x89\xf0\xc2\x09\x97\xf4\x63\xe6\xbe\x8d\xdb\x4d\xdb\x5e\xc6\x8f\x0f\xe5\x31\xff\x5a\xd7\xec\x33\x76\x5d\xd3\x65\x7d\x19\x85\x86\xae\xdb\x78\xd6\x35\x41\x38\x60\x5d\x7b\xbe\xed\xfd\x3e\x91\x5e\x1f\x74\x48\x5f\x5d\x27\x3f\x24\x68\x42\xf9\xd2\x0f\x39\xf4\x11\x03\x44\x86\x6b\xe2\x26\xe7\xc3\x27\x91\x6b\x6c\x66\x78\x43\x77\x32\xdb\x34\x6e\xcd\xa5\x6c\xe3\xe8\x96\x37\xdf\x37\x64\xff\xc4\x1d\xdc\xcf\x0f\xf4\xcd\xb1\x0c\x41\x37\xea\x87\x1b\xf7\x1e\x98\x08\x15\x17\xe9\xbe\x4c\x65\xfe\xff\xd8\xfb\xde\x26\xb7\x71\xa4\xbf\xf7\xfc\x14\x28\xa5\x52\x93\xa4\x46\x9a\x99\xdd\x38\x15\x2b\x6f\xe2\xbf\x7b\xae\xc7\xde\xf5\x79\x7c\xfb\xbc\x88\x53\xb5\x94\x08\x8d\x78\x16\x09\x3d\x24\xe5\x19\x5d\x2a\x1f\x2b\x5f\x20\x9f\x2c\xd5\x8d\x6e\x10\x24\x01\x10\x94\xc7\xce\xed\xae\xec\xad\xbb\x5d\x11\x6c\x02\x8d\x46\x03\xe8\x3f\xbf\x5e\x8a\x0f\xdd\xb6\x9e\x7d\x90\x28\xb2\xd5\xb7\xeb\x53\xe0\x3f\x78\xb5\xc7\xa3\x1a\x79\xe4\xcd\x8e\xa9\xaf\x33\xe2\x0d\x03\x2c\x20\xb1\x23\xd5\xfd\x5a\xab\x62\x8f\xcd\x5d\x91\x41\xd0\x8f\xcb\xf6\x9b\xd0\xbd\xf5\x4e\xa6\x80\x50\x75\xd8\x43\xd7\xd6\xf9\x6a\x77\x5c\x24\x91\xf2\xc0\x41\x8e\x03\x95\xd9\x15\x05\x6e\x65\x96\x4b\xad\x0a\x69\xe2\x56\x29\x0b\xa6\x51\x0c\xc2\xc5\xd7\xaf\xd2\x25\x7e\x36\x9e\x43\xdf\x08\x4b\xd0\xc6\x7d\x43\xe9\xa4\xfb\xef\x5a\x95\x3a\x80\x7d\x7d\x7c\x8f\x68\x45\xa3\x92\x7e\xf1\xa2\xff\x0a\xdb\x6c\x65\x2d\xb6\xea\x1e\x54\x60\x03\xde\x3c\x91\xc2\xa8\x3c\x09\xc7\x94\x24\xde\x54\x39\x00\x01\xc8\xcc\x02\x1b\x33\x1b\x33\x45\x7b\xd4\x0d\xd8\x8a\x88\x41\x0b\xf1\x2b\xf8\xbf\xa8\x54\xba\x93\x6c\x5a\xc9\xa5\x98\x8b\xd9\x6b\x55\xad\xf2\x6c\xb6\x44\xec\x23\xde\x60\xef\xa1\x4f\xff\x0d\x1e\x3f\xdb\xed\xd4\xfd\x6c\x29\x3e\x4b\xb9\xaf\x03\x72\xd9\xc9\xb5\x05\x1f\xcd\x5e\xd5\xcd\x5e\xb1\x1a\x34\xe2\x48\x0b\x5c\x96\x46\x1f\xf1\xd7\x9c\x24\xe7\x62\xf6\x41\xee\x77\xe9\x5a\xce\x96\x4c\x84\x28\x12\x40\x21\x59\x5f\x4a\x2c\x77\x5a\x35\xb5\x4d\x73\xe1\x8e\xeb\x42\x98\x4d\x80\x9e\x2f\x60\x93\xce\x7a\xa2\xaf\x13\x4d\x32\x5c\x51\xc4\x1c\x13\x06\xc6\xf6\x04\x27\xdd\xe2\x50\xa3\x77\xa4\xde\x42\xaa\x1e\xe8\xdd\x54\x3b\xf3\x48\xa6\x61\x61\x1b\x28\x87\x8b\x49\xb7\x28\xdd\x0f\xe7\x23\x9c\x20\xe7\x13\x62\xdc\x54\x55\xbe\xae\x22\x8e\x8f\xb3\x17\x95\xe5\x91\x48\xf1\x25\xf1\x77\xb5\xc2\x65\xbb\x10\x9f\x4a\x71\x0b\xab\x19\xfe\x4b\xc8\x87\x14\x94\x8f\x73\xff\x12\xe2\xd3\xec\x5a\xfc\x78\x2d\xfe\x93\xfe\xfb\x69\x26\x0a\x99\x96\xb0\x37\x89\x4f\xb3\x57\x28\x32\x5b\x75\xa8\xd8\x2f\xba\x4d\x77\x1b\xfc\xe1\xd3\x4c\x7c\x9a\xfd\x77\xf8\xb7\xdd\xf1\x93\xfb\x4e\xfe\x89\x8c\x16\x0e\x72\xfa\x6d\x89\xff\x7d\xb3\xfd\xf1\xba\x70\x7c\xd7\x49\x13\x3e\x08\xbe\xb4\xaa\x39\x02\x8d\x52\xfb\xbc\x70\x98\x3d\xc7\x97\xca\xd4\x7a\xa1\xaa\x3b\x70\x81\x6d\x0f\xab\xc5\x5a\x15\x57\x95\x5a\x6d\xf2\xbb\x2b\x60\xd6\x6c\xea\xb4\x50\xce\xcb\x5b\xd8\x2e\x62\x13\x5f\xb0\xf1\xf0\x36\xdc\x7a\x5a\x61\x91\x50\xc4\xba\x7b\x49\x73\x91\xf5\x16\xf9\x60\x21\x5e\x5a\x65\xc1\x6f\xae\x17\x81\xf8\x69\x5f\x2d\x9a\x22\x2f\xf3\xe2\x50\x2c\xc5\xf5\xc4\xdd\x9b\x92\x08\xf2\xf2\xee\xa5\x4c\xb3\x5d\x5e\xca\x5b\xbc\xc7\xd7\xa3\x1c\xb9\x75\xbf\xc7\xcc\xc9\xe8\x67\x18\xab\x36\x0d\xb8\xf9\x01\xf7\x55\xee\x02\x69\x6e\x04\x78\x10\x45\x0e\xc7\x15\x7b\xb9\x03\xbe\x23\x6c\x4b\xf0\x4a\x5a\x1e\x09\x73\xd0\xad\x92\xde\xc1\xdb\x19\x90\xe3\xca\xf7\x84\x8a\x43\xac\xa7\xc9\x47\x3d\x44\x31\x59\x8b\x64\x3a\x40\xea\xc9\x7c\xf7\x9f\x9a\x40\x96\x7b\x3f\x7a\x8f\x4c\x0c\x17\xb4\x4c\x42\x13\x45\x8d\x34\x33\x5a\xcd\xcc\x50\x0d\x04\xb7\x03\xba\x94\x6c\x6d\x14\x18\xde\x23\xaa\x81\x09\x38\x44\xea\xd2\xa8\x70\x2d\xc5\x5a\xe7\x23\xe6\x70\x1b\xe4\x5b\x00\xee\xd2\xba\x3b\x03\x43\xaa\xb5\x38\xd4\x87\x74\x77\x89\x88\x45\x1d\xbc\x63\x3a\x6a\x69\x1b\x1f\x13\xa5\xde\x39\x8a\xca\x2e\x92\x58\xc8\xa2\x7a\xa7\xc2\x3c\x7b\xfb\x0b\x0b\x32\xdb\x97\x76\xe0\x56\xa7\x49\xc8\xbf\x48\x32\x6e\xfe\x1d\x2a\xc0\x98\xfd\x73\x28\xe2\xa4\x1c\x70\x54\x70\xc6\x4e\xe1\x4e\x01\x06\x4c\x79\xa8\xc5\xbf\x1d\x64\x75\x9c\x72\x6a\x22\x8b\xd4\xf0\x81\xdb\x5d\xc9\x43\x60\x43\x96\xda\x58\x5f\xb7\x8e\xb9\x64\x13\xdb\x9b\x67\x8b\x42\x95\x79\xa3\x40\x6d\xfa\x0d\x59\x42\x7c\xb4\x68\xaf\x55\xb9\xc9\xef\x0e\x55\xbb\xc4\xda\x50\x2c\x51\xa4\x65\x7a\x27\x2b\xe8\xcf\xa1\x6e\x21\x5c\x9c\x44\xd1\x84\xbb\x98\xae\xcc\xef\xb6\xb2\x7a\x53\x3f\x97\x51\xa6\x85\xbf\x74\x9a\xf3\x4e\x05\xe7\x11\x79\x57\xa5\x59\xc7\x8a\x93\x55\x6a\xef\x2b\x22\xde\x6c\x4d\x55\x10\x55\xd1\x95\x1a\x7e\xab\x0f\x88\x7f\x22\xf0\x70\xc3\x57\xc6\x66\x5b\xa9\xc3\xdd\x76\x7f\x68\x16\xe2\xb9\xb9\x6e\xb9\x2f\x64\x9e\xbe\x54\x79\x6d\x8e\xe5\xe6\xbb\xd3\xd1\xba\x50\xf0\x46\x99\xf4\x57\x68\xc5\x5f\x06\xc1\xf9\xeb\x5b\x2d\xb2\x7d\xd3\xab\x5e\x1a\x2d\x1f\x12\x7f\x3d\x39\x3c\xd2\x55\xb2\x39\x54\x25\x78\xd9\xb0\x12\x16\x07\x22\xb1\x3c\x02\xe9\xfd\xd3\xa7\xad\xb9\xc5\xef\x40\xd1\xc0\xef\xc4\xe5\x4d\xeb\xb7\x9b\x2c\x3f\xcd\xb6\x92\xf5\x56\xed\xb2\x51\xae\x7c\xe4\x96\xcc\x19\x74\x8b\x59\x53\xd5\x9f\x1e\x5f\xea\x08\x19\x72\xac\x75\xf8\xc3\xb5\xae\x03\x73\xf3\xc3\xe2\xc9\x54\xdb\xb1\x7f\x43\xc1\x29\x1b\xfc\x6a\x06\x1c\xbd\xd7\x34\x2e\xd5\xd3\x61\xcd\x6d\x53\xf9\xad\x35\x3a\xd8\xe3\x04\x63\x8d\xcb\xb3\x3b\xf8\x34\x7a\x77\xdb\xfb\xe0\xd0\x50\x06\x91\x33\x40\x09\xfe\x1d\xed\xe3\xc3\xa8\x13\x7b\x9c\xb0\x9a\x6f\xae\xaf\x4f\x37\x8b\x4c\x71\xf4\x77\xfb\x5d\xc8\x02\x4e\x26\xf0\x3a\xc1\x98\xa9\xf5\xfa\xb0\x87\xf4\xba\xd5\x11\xab\xb6\x38\x88\x0a\xf3\x9a\xe9\x3e\xdb\xf7\xfe\xcb\xbb\xe7\x93\x6d\x93\x60\x0e\xf6\x78\x54\x3b\xdd\xff\x57\xdd\xae\x37\x82\xf6\x60\xcc\xbd\xa9\x43\x67\xa7\x1b\x6f\xef\xa6\x9e\x9d\xa8\xdb\x71\x22\xed\x22\xe3\xc0\xf0\x9e\xf3\x16\xd7\xb9\xf6\x3b\x69\xb6\x01\xd4\x4e\x66\xbd\x53\xa8\xfb\xc0\x98\xbb\x3b\xb6\xd0\xe8\x6d\x02\xbf\x1d\xa7\xda\x0d\x3f\xb3\x48\x8a\x69\xe1\x70\x56\x74\x76\x12\x98\xc8\x17\xa6\x59\x3f\x38\x83\xee\x35\xba\xbb\xa0\x68\x47\xa2\x1e\x3c\xa1\x4a\xdd\xaf\xc1\x8b\xe6\x93\xd4\x93\x15\xdc\x7b\x4b\xe2\x4b\x47\x95\x3a\xbf\x13\x56\x1a\x02\x93\x9b\x3e\x56\x69\x59\xe3\x37\xfc\x59\xd2\x9d\x8e\xbd\x1d\xbc\xc4\x5a\x1e\xc8\xe9\xeb\x47\x7b\xe6\xf5\x9d\x0a\x08\x9e\xd2\x8c\x6f\xbd\x4d\xcb\x3b\xf7\x05\xa3\xbd\x62\x84\x52\x63\x47\x56\xeb\x48\x62\xff\xc8\xbb\x7e\xe8\xf6\xd1\x57\x87\x02\x1f\xfd\x2a\x3e\x1e\x9f\x90\xae\xa4\x7c\x3c\xee\xcd\x84\x00\x01\x10\xc4\x94\xae\x33\x46\xd0\x17\xd3\xbb\xe3\xd3\x28\x84\x0f\xeb\xaa\x99\x37\x47\xde\x0c\x7e\xf6\xe8\x9a\xa9\x71\x77\x45\xba\x9f\x7f\x96\xc7\x01\x6b\x9d\x5f\xf5\x85\xed\x15\xe9\xde\xa3\x37\x96\x49\x80\xe5\x6d\xfd\x43\xba\xb3\x59\x0b\xa0\x25\x01\x76\x83\x46\x2e\x92\xf8\x25\x49\xf7\x81\x9f\x30\x4b\x22\x19\x9b\x77\xab\xb1\x09\xb3\xe1\xbb\xa7\x9d\xb0\x3b\xbc\x05\x3a\x48\x0b\x3e\x56\x52\x1f\x74\xaa\x86\x4b\x50\x3c\xda\xcb\xd7\xbf\x40\xa9\xff\xee\x2d\xc8\x49\x91\x52\x46\x38\x50\xc6\xa8\x3a\x72\x31\xb4\x18\x80\xce\xb7\xc3\xea\xef\x91\x4a\x27\xfa\x71\xa3\x26\x10\x08\xe0\x47\x7d\xa7\x0a\x81\xfe\x05\xfe\x67\x28\xce\xc1\x46\xf6\x65\x32\x91\x75\x01\xb4\x9b\x98\x6d\x2b\x48\x5b\xe3\x83\x07\xea\x9f\x76\xd6\xdb\x6b\xbb\xb5\x2f\x71\x5f\x2f\x1e\x02\x1e\xf7\x14\xe2\xb7\xc0\x2a\x54\xe9\x4c\x84\x46\xb3\x06\xa7\x49\xbf\x37\x25\x5a\xe1\xa3\x4e\x8a\x64\x3e\x64\x70\x0c\xc9\x1d\x20\x7a\xb0\x5f\x69\xfc\xf1\xec\x6b\x14\x8e\x66\x40\x40\xdf\xf4\xd8\xe0\xa4\xc8\x5c\xa7\x7b\xc5\x57\x2b\x18\xbc\x7e\xfb\x1e\xf6\x06\xf0\x0a\xda\xf6\x4a\x1f\xdd\x6f\xad\xdc\xad\x4e\xdf\x92\xd1\x6a\x8d\x26\xb1\xcb\x81\xe8\x1a\x25\x80\xff\x44\x0a\x6e\x4c\x3d\x21\x9f\x1f\x5b\x3b\x7d\x4f\x0d\x94\x17\xfb\x74\x3d\xee\x65\x79\x83\xcd\x58\x48\xf4\x4b\x7c\x09\xb0\xd7\x68\x5b\xde\xda\x41\x51\x88\x95\xdc\xa8\x4a\x76\xf6\x53\xe3\x4a\x5d\x24\xd3\x05\x3d\x93\xfb\x9d\x3a\xc2\xa9\xc7\xbb\x12\x3a\xc3\x78\xd9\xb6\x37\xaa\xca\xa2\x61\x69\x2c\x3e\xd1\x78\xa8\x12\x5c\xc3\x4a\x62\x1d\x1c\x8f\x46\x0b\xea\x90\x40\xdf\xba\xcc\xde\xa7\x15\x21\x72\xb5\x3d\xf5\x52\x64\x6b\x1e\xa4\xda\x6d\x36\x26\x0d\x33\xa4\x78\x62\xd4\x09\xfc\x65\x7a\xa1\x36\xbd\x21\x3d\xe3\x2e\xe4\x7d\xf3\xc3\xe0\xd0\x18\x24\x6a\xac\xf5\x51\x1c\x08\xdb\x28\xe2\x55\x4c\x94\x82\x88\x56\x35\x13\xa8\x91\x7d\x6c\x02\xa3\x83\x91\x68\xc0\xeb\x4a\x62\x74\x99\x5f\x06\x6c\xe1\x81\x95\xc1\xb3\xfd\x28\x7c\xe6\xaf\x4f\x18\xd1\x07\x7a\x85\x87\x94\xc9\x1a\xf4\x70\x4f\x84\x1e\x5d\x72\xc6\xdc\xb9\x53\xc7\x1e\xde\x3f\xc8\x8a\x35\xc6\xea\xc0\x56\x12\xb3\x9d\xd0\x81\x57\xcb\x44\xa0\xc5\xa8\x8c\x8c\xec\x4b\xe3\x7b\xd3\x09\xfb\x13\xab\xda\x65\x12\x21\x34\x88\x1d\x75\xb2\xae\x19\x9f\x51\xed\x15\x79\x45\x00\x49\x84\xbe\x14\xd7\xb5\x5b\xe7\xab\x66\x13\x22\xef\x48\x7d\x49\x07\x5f\x0f\x49\xe1\x85\xb4\x82\xab\xaa\x02\x17\x54\xb9\x43\xff\x7b\x76\x6c\x33\x9f\xa1\x80\x43\x29\x93\x91\x15\x12\xc3\xa2\x91\xfd\x2c\x42\xbf\x7d\x13\x01\xf9\x92\x2b\x30\x86\x66\x2f\xf3\x1a\x32\x77\x73\x55\x3e\x07\xef\x6f\xe4\xa1\xe0\x57\xdf\xdb\x66\x76\xde\xab\xe1\x43\xb7\x2f\xcd\xaa\x63\x18\x9a\x29\xd0\xb2\xf7\x08\x14\xb0\x92\xa6\xf7\xbe\x64\x14\x0e\x54\xe5\x68\xc9\xce\x44\x51\x8e\x00\x4e\xbb\x39\x7b\x81\x94\xc0\x53\x4c\x37\x5e\xfc\x9e\xe6\x32\xa4\x2f\xe7\x3e\xc9\x0c\xea\x25\x6f\x1d\x64\x8f\x69\xad\x5b\x1f\xc4\x03\xe5\xdb\x5a\xdb\x16\xc9\x44\xd6\xed\xcd\xbd\x75\x99\x4c\x9a\x94\x4e\x7f\x9d\xd7\x4d\x08\xcb\x82\xed\x0b\x0c\xf4\x3a\x12\xb4\xb5\x88\x3b\x49\x0a\x4a\xee\xd7\x46\xb1\x98\xa1\xc5\x1c\x16\xdd\x79\xfe\x91\xec\x79\x34\xfb\x58\xd0\xf0\x3e\xe0\xe7\x33\xb1\xaa\x72\xb9\x69\x21\x1e\xd8\x70\xcf\xde\x62\x58\x7c\xda\x65\xea\x63\x25\xfc\x6d\xb9\x6e\x67\xca\x2e\x08\xe2\x5b\x87\x31\x43\x4c\x5d\x0d\x3c\xd7\x2e\xe5\x7d\xb0\xfa\xa6\x30\xad\xdb\x04\xcf\x27\xc5\xec\x6b\x18\xf3\x07\x31\x1c\x12\x84\xbd\x27\xe8\xc6\xb5\x64\xb8\x98\x59\xee\x2e\x6e\xa6\x67\xac\xb5\x5d\x80\xbb\x4d\x55\xa1\x73\x99\x30\x57\x2b\x0a\x8b\xfa\xca\x1b\x16\xd8\xc0\xd3\xbc\x94\x55\xb0\x55\x6f\x58\x2f\xcc\x4b\xc3\x93\x0f\x83\xf2\x90\x5d\xa6\x51\xe4\x60\xe7\x57\xe2\x8e\xce\xae\xca\x3c\x91\x3a\x6b\xac\xcf\xa1\x39\x31\xf3\x91\x46\x77\x38\x8e\xcd\xb4\x54\x35\x53\xc6\x1b\xf6\xfa\x4e\xa5\xef\x63\x98\x1d\x3a\xc7\x39\xe7\x1d\xc3\xf3\x00\x78\x86\xc2\xbf\x65\x26\x8e\x0e\xa4\xc8\x53\x8e\xae\xf6\x9f\x7c\xfa\xa8\xdf\xbc\xe4\x01\xb7\xd9\x35\xa6\xdb\x14\xd1\x90\xa9\xf5\x67\x59\x2d\xaf\xae\x1e\x1e\x1e\x22\xe8\x47\xac\xf0\x98\x53\x81\xfd\x67\xce\xdc\x1f\x6d\xe7\x8c\x30\x8f\x3e\x53\x4c\x3b\x04\x9d\x7c\xac\x6d\xdd\xe5\x6d\xc5\xfc\x65\x12\x3d\x67\x6f\x3b\x2f\xf2\xfc\xd9\xf9\x23\x40\x5a\x47\x86\x46\x5d\x8a\x43\x1e\x85\xc9\xf3\x3a\xba\x3f\x0f\xc6\xf3\x8e\x77\x64\x3d\x10\x34\xbd\x0e\x47\x62\xaa\xd0\x69\xbb\x7c\x90\xbc\x80\x1d\xf8\xd5\x43\x1e\x56\xf2\x13\x86\xe4\xcb\xf4\xf7\x8e\xc8\xce\xf9\x87\x10\x4f\x95\x19\xd5\xc7\xa1\xb6\xad\x4d\x12\x06\x19\x24\x2e\x98\x05\x50\xfd\x0d\xe3\x39\x57\x9c\x0a\x79\x2b\x9b\x9f\xa1\x6a\xc8\x63\x8c\xd3\x7b\xa4\xf6\x0c\xb3\x73\xa4\xc6\x97\x87\xd3\x76\x09\x57\x54\xf8\x99\xf4\xab\xff\x7a\xa5\xff\xea\xd9\xbd\xe4\x7c\x33\x8a\x42\x7e\xbc\xb9\xdc\xe7\x59\x3d\x65\x88\xb9\xed\xfc\xe2\x94\x3e\x93\xb9\xc3\x58\x7a\x64\xf4\x1e\x2f\x69\x85\x46\x0f\x9a\x3a\x78\x31\x14\xae\x35\x71\x27\x1e\x8b\x9c\x3f\x6d\x5f\xf9\xc6\x6a\x70\x7c\x07\x98\xf7\x14\x65\xa0\x61\x1f\xf1\x73\xb2\xe6\x0f\x05\xe6\x0c\x44\xe3\x03\x36\xee\xf9\xd7\x48\xfe\xe1\x6e\xc6\xb0\x41\x9c\xc7\x1a\x36\xe4\xd9\xa7\x32\xa0\xd9\x81\x2c\x4e\xbe\x42\xe8\xb1\x2b\x91\x43\x82\x7a\x37\x66\x41\xe3\x8b\x76\xaf\x4c\xfc\xdc\xea\xd8\x0b\x37\xf7\x52\xe7\x0a\xde\xd6\x1a\x31\x27\x29\x82\xeb\x00\xef\xd2\x11\x94\x7b\xa9\x9a\x2d\x8c\x79\x9b\xee\xf7\xb2\xf4\xfb\x93\x69\xe4\x23\x07\xd8\x51\xce\x84\x25\xcf\x11\x96\xf8\xe7\x89\x5e\xf0\x2f\x83\x20\x57\x09\x0d\xdd\xfd\x66\x57\xce\x26\x22\xab\x7b\x2d\x97\x79\x39\xf0\x54\x2e\x92\xe9\xf7\x07\x07\xb6\xf1\x32\x89\x58\x2f\x93\x21\xd6\x3d\x44\xdb\x1e\x30\xb6\x6a\x04\xcc\x7a\x9c\x1e\xf7\xc1\xad\x7f\x2b\xc8\xf5\x6f\x02\xbb\x1e\x37\x54\xea\x6b\xd4\x68\x1f\x07\x82\x3d\xae\x5f\x06\xfd\x3a\xaa\x67\x93\xe1\xd8\x3d\x44\xd1\x88\x11\x01\xc9\x1e\x37\x86\x11\x68\xf6\xaf\x86\x67\xf7\xd0\x14\xbc\x1e\x1e\x0b\xa2\x3d\x72\xb4\x72\x17\x3f\x61\xfc\xb5\x58\xb8\x76\x0f\x4d\x5c\x36\x3e\xc8\xf6\x98\x6e\x87\x36\xb4\x18\xf8\xf6\x10\x84\x3b\xed\x4e\x01\x91\xf3\x42\xb9\x8f\x6e\x5e\x66\xdb\xf8\x20\x9b\xea\x18\xbf\x77\x60\xf3\xce\x06\xa2\x83\xbe\x8c\xe1\xcf\x10\x4e\xfc\x07\x93\x52\x81\x18\x8a\x42\xca\xa6\xee\xac\xf6\x53\xb6\x11\xfa\x7e\x94\xd4\x7c\xa0\xbe\x0e\x84\xc6\x1a\xc8\x89\x82\x30\x52\xcf\x3e\xb2\x48\x19\x75\x05\xcb\x04\x72\xa0\x58\x98\xa3\xf1\x06\x85\x91\xc3\x59\x58\x92\xfd\xcc\x99\xb7\x03\x9f\x2c\x84\x94\xb2\xeb\xfc\xa6\x33\xb9\x57\xeb\xe7\xea\x20\x45\x6e\xdd\x79\x39\x5c\x0a\x6a\xba\x71\xae\xb0\xa7\x6a\x6a\x6e\x9b\x00\x50\x55\xdc\x4b\x2b\xdd\x17\xef\xbd\x26\x89\x7a\xd5\x71\x3f\xdc\xa7\x75\x79\xd1\x84\x01\x2c\xf2\x52\xe3\x54\x14\xd3\x93\x15\xeb\x26\x05\xac\x8e\x71\x56\xe8\x76\x9d\x35\xd8\xb7\xbe\x53\xae\x33\x86\x1e\xe6\x9e\xe2\xa7\xce\xb0\x31\x33\x00\x58\xa0\x60\x2f\x96\xb0\x17\xd0\xff\xd1\xfd\x04\xbf\x6e\xf0\x28\x9c\xb4\xd1\x8b\xca\xa0\xb2\xaa\x5c\x4b\x91\xee\x76\x2d\x59\x1b\xd7\xfe\x94\x25\x5f\xca\x87\xf8\x85\xf6\xb3\x7c\x18\xae\xb3\x21\xd0\x2d\xf7\xc7\x43\x52\xb4\x9c\x81\xaf\x07\x6e\x41\x8f\xb4\x1c\x21\x71\xb2\xcc\x20\x33\x39\x66\x90\xef\x75\x5b\xb7\x62\x73\x85\x0f\x7b\x88\x0a\xa0\x70\xa7\x80\x14\xc6\xa7\x1a\xb6\xe0\xf0\x4f\x54\x8d\x61\xcd\xc2\x93\xe9\x7c\x48\x3c\x98\xac\x58\x58\x23\x2d\x93\xd3\x26\x28\x30\x39\xde\x0f\xf3\x4d\xfd\x27\x8d\xb3\xeb\xf0\xda\x76\xa6\xec\x97\x41\x73\x9e\x3d\x02\xea\xb5\x32\xc3\x20\xef\xd0\x83\xf4\xc3\x46\x03\x5d\x31\xd7\x58\x0b\xa0\x50\x99\x46\x39\xb0\x1e\x1e\x1a\x18\xb3\xc9\x4b\x17\x3b\x70\xd8\x01\xda\x8c\x93\xea\xb0\x1b\xce\x04\xb5\x90\x05\xcc\x2f\x19\x4e\xab\xa7\xdf\xce\x49\x35\x7f\x71\x28\x8b\x24\x72\xae\xdc\x37\x6a\x6f\x73\x86\xdf\xa8\x82\xdd\x62\xf8\x29\x0a\x18\x18\x09\x6d\x30\x34\x17\x49\xbc\x8a\x23\xd0\x8e\xe1\x03\x37\x58\xcb\xb9\x4a\xf1\xb9\x4a\xf1\xb9\x4a\xf1\xb9\x4a\xf1\xb9\x4a\xf1\xb9\x4a\xf1\xff\xc7\x2a\xc5\xe7\x32\x71\x7f\x82\x32\x71\x2a\x7b\xa4\xd2\x70\x2a\x73\x96\x83\x53\x99\xa7\x04\x9c\xca\x9c\x65\xdf\x54\xf6\xbd\x4b\xbd\x51\x0f\x59\x69\x13\x87\x85\x6e\xf2\x9b\x86\x08\x59\x24\xfe\x13\xcc\xb9\xae\xda\xb9\xae\xda\xb9\xae\xda\x37\xaa\xab\xa6\xb2\x41\xdc\x75\x32\x7e\xa1\x70\x87\x58\x77\x45\x23\x58\x4a\x4d\x65\xbd\x08\x65\x53\x2d\x2d\xf1\x84\x73\x9b\x7c\x02\x71\x85\xff\x0a\xa6\xde\x43\x05\x15\xd0\x4c\x1c\xa0\xfd\xb8\x92\xf9\xde\x51\x41\xad\x6d\x4b\x88\xd8\xf8\xfa\x26\xbf\x33\x55\xd7\x08\x72\x73\xf0\xd9\x8b\x64\x1c\x41\x76\x6e\x5a\x3b\x1f\x50\x97\x07\xcf\xba\x03\x70\xbe\x0a\xc3\x09\xbc\xa7\x07\xe3\x6a\x60\x0d\xad\xf7\xd8\x29\x74\xc4\xa3\x4d\x7e\x07\x55\x0b\x0e\xfb\xd1\x69\x7e\xd1\x6b\xcc\x5a\x7e\x03\xe1\x14\x74\xa5\xd6\x6d\xde\xa5\x7b\x08\x77\xbb\x95\xeb\xca\x11\x53\xfa\x45\xed\x0e\x05\x63\x27\x62\x62\x3c\x22\xea\xa2\x9f\xac\x3b\x8c\xfe\xa1\xde\x27\xa3\x3c\xdd\x9e\x4c\xc6\xfe\x28\x1a\x2b\x7f\x91\x5e\xa3\xde\x50\x57\xda\x71\xf5\x7b\x10\xe4\x26\x57\x8b\x70\xf3\x72\xd0\x93\x0b\x8b\x95\xf4\x1a\x45\x1d\x54\xf9\x1d\xb8\xe8\x78\x4c\xcc\xdc\x8d\xcf\x5a\x9d\x6f\x3a\x63\x01\xe4\x90\x8b\x06\x56\xf4\x97\x3c\x93\x59\x17\xb6\xda\x40\x54\x37\xd5\xa1\x5c\xa7\x8d\x9c\xb9\x3a\x2a\x2c\x68\x6a\x34\x78\x68\x8c\x60\xd5\xf9\x10\x42\x59\x57\x69\x99\xa9\x62\xb6\xec\xf0\xce\x49\x91\xbb\xc7\xfe\x76\xa1\x5f\x15\xab\x63\x63\x79\xc8\x00\x5e\x7f\x27\xcb\xbb\x66\x8b\xe4\x31\x16\xc7\xd3\x45\x78\xc6\x70\x63\xc0\x1b\x03\xa2\x6b\xcd\x02\xb9\x44\x70\xa4\x13\xe1\xa1\xf9\x35\xe7\x43\xdd\x77\xe7\x23\xe8\x96\xeb\x86\x36\xe7\x23\xf1\x04\x71\xfa\x2c\xc7\xdd\x8f\xff\x22\x8f\xde\x6a\x77\x14\x8d\xa2\x97\x9c\x41\x19\x74\x50\x14\x62\x96\xee\xf7\x8b\x63\x5a\xec\x74\xb2\x08\xac\xc4\x2b\xf3\xd3\xe4\x75\xa0\xbf\x38\xda\xf7\x5f\xb1\x19\x77\xdf\x2e\x22\x65\x34\xc9\x25\xe9\x11\xe8\xd5\xbe\x52\x01\x47\x04\x29\x16\x1a\xf3\x5e\x65\xda\xc6\x4b\x3f\x17\xe4\x8d\x47\xf1\xab\x0f\x2b\xaa\x12\x08\x8e\xfa\x9a\x77\xa4\x89\xc3\x74\xdd\xea\xe0\xef\x5c\x7c\x96\x7d\x09\x98\x53\x3f\x7a\x3f\x3b\xb7\x79\x5e\x2d\xb8\x53\xfc\xec\x48\xa2\xe9\xf0\xf0\xc2\xe4\x38\x40\x53\xce\x22\x92\x43\x96\x1a\x92\xb0\x50\xf6\x95\x5c\xbb\xbd\x8b\x87\x5a\xb6\x69\x13\x40\x12\xac\x2c\x75\x23\xd3\x6c\xb0\x80\xbc\xbc\xe9\xf4\xbe\x0e\x76\x7f\xf0\xa9\x7e\xf7\xeb\x41\xff\xc9\x61\xd3\xa3\xaa\xb1\x89\x61\x5f\x05\x60\x90\x8a\x0a\x61\x80\xfe\xfb\x59\x4a\x82\x7d\x36\x24\x70\xa3\x87\xe3\xf9\x80\x48\xdb\x44\x13\x58\x24\x51\x66\xde\x80\x98\xf8\x0d\x37\x13\x8c\x36\x74\x97\x42\x30\x2c\xca\xc1\x5f\x26\x91\xe0\x59\xd4\xde\x9f\xb3\x6f\x3c\xbc\xce\x88\x30\x1f\xc8\x96\x13\x50\xeb\x52\xd4\x0a\x67\x0b\xeb\x30\xf8\x48\xae\xd3\x12\x1c\x68\x6b\x55\xec\xd3\x8a\x17\x25\xbc\x75\x28\xf9\x3d\x55\xca\x5a\x2f\xe0\x0e\x7d\xae\xbb\xe9\x84\x91\xce\xcb\x0e\x1a\x35\xf8\xab\xd3\x46\xec\x24\x04\xe3\xa9\xd2\x44\xb2\xd2\x27\xfa\x33\x5b\xa4\x0f\x1a\x40\xfd\xe9\xd3\xc4\x03\x53\x79\x1d\xed\xc3\xf1\xe1\x2d\xb9\xab\x2b\x4e\x28\x86\x09\x69\xb8\x3d\x9a\xe8\x98\x64\x65\xc4\xa6\x2f\x49\xed\xe1\xd9\x6f\xef\x55\x06\x61\xec\x87\x4a\x3e\xc3\x1f\x7f\x5b\x88\x67\xed\x57\x9c\x52\x8b\x44\xe1\xda\x50\xd7\x50\xa8\x04\x97\x13\xdc\x81\xa1\xa4\x18\x38\xad\xb1\x00\xd2\x3a\x2f\x4c\xad\x19\xa8\x11\x05\x39\x5f\x38\x97\x0a\x47\x38\x38\xa8\x0a\xb1\xa9\xa8\x5b\x38\x39\x02\xee\x58\xa2\x3e\x6c\x36\xf9\x83\x05\x82\xfb\xe3\xf5\x75\x51\xcf\x2e\xc5\x6c\x7e\xb3\x78\xb2\x85\x7f\xf9\x61\xfb\x9f\x9f\x14\x84\x8c\x9b\xdd\xfc\xb0\x75\x40\xe3\xea\x53\x0e\x98\xec\x90\xaa\x0e\x92\x9f\x95\x48\xe7\x50\xcf\xc4\x7f\x80\x97\xff\xef\xff\xa9\x67\xff\xf1\x52\xcc\x34\x79\xfc\x9f\x02\xfe\x07\x3f\x92\xcd\x86\x71\x11\xb3\xfb\xd9\x22\x56\xeb\xd1\xa9\xdf\x5d\xcc\xa4\x33\xf1\x17\x34\x1b\xbe\x22\x26\xba\x5c\x86\x6d\xeb\x76\x2a\x3c\x0f\x92\x93\x2e\xfa\x42\xb2\x03\x4b\xda\x73\x04\x7c\xb6\xdb\xfd\x52\xfd\xac\x83\x9c\x1d\x67\x2c\x30\x8e\xd5\x62\x95\xae\x3f\x5b\x1d\x01\xdf\x69\x27\x60\xa1\x05\x81\x6f\xef\x95\x18\x9d\x53\x0f\x8b\x8f\xcc\xc5\xec\xb9\xac\x9b\x57\x9b\x8d\xaa\x9a\x61\xfd\x13\x2b\x25\x92\x46\x4c\x35\x45\xda\xa1\x0d\x27\xc8\xf1\x75\x2c\x3a\x24\x33\x28\x31\x8a\x5e\xdc\xbc\xf1\x72\x2a\x1d\xdc\xc2\x4c\x20\x77\xbd\xf0\x14\x35\xe9\x8f\x13\x19\x80\x69\xf1\x2d\xf2\xd7\x80\xa8\x89\xed\xe0\x8f\x73\xcd\xa1\x8b\x16\x1a\x0c\xb5\x1d\x26\x1b\x99\x3d\x8f\xfa\xed\x54\xa2\xae\x9a\x06\xda\x86\xb7\x88\xbb\xc2\xda\xf3\x3f\x78\xd8\x4e\x54\x32\x7a\x8e\xf5\xae\x88\xbb\x2a\x5d\xcb\xf7\xb2\xca\x55\x16\x5c\x0f\x3f\xb5\xed\x5a\x80\xf8\xd2\xdc\xb1\x2d\xd5\xd7\x53\x95\x5e\x9c\x3b\xab\xe6\x84\x8d\x05\x05\x3b\x00\x19\xf8\x56\x92\x03\x81\x71\x79\x1c\xa4\x06\x26\x77\xe0\x8e\x95\xaa\x9c\x97\xf2\x2e\x45\xcc\x7f\x52\xf6\x7a\x6b\x22\x5c\x68\xb2\x85\xe5\xb5\xf8\x87\xac\x60\xfb\x4b\x1b\xeb\x20\xa3\xbf\x32\xa0\x9a\x17\x85\xcc\xf2\xb4\x91\xc3\x2a\x50\xa1\xb0\x81\x13\xf6\xa2\x73\x5d\xe4\x73\x5d\xe4\x73\x5d\xe4\x73\x5d\xe4\x73\x5d\xe4\x73\x5d\xe4\x73\x5d\xe4\x3f\x50\x5d\x64\xc8\xcd\x58\x26\x01\x5e\x5c\xbc\x53\x99\xec\x38\x66\xe0\x15\xb8\x30\xc0\xde\xe2\xf1\xcb\x38\xc9\x0a\x8c\x77\xbe\xc2\xa3\xff\x95\xd8\xe4\x0f\x32\xe3\xff\x9f\x93\x41\x41\x5c\x91\x49\x77\x5e\xa4\x0f\xfc\x63\xb4\xc9\xa8\xea\x96\xf3\x0c\x0e\xeb\x43\xb7\xad\x67\xaa\x89\x22\x3b\x26\x5b\x1f\xf9\xef\xad\xf4\xa7\xc9\x34\x5a\x26\x31\x09\x4e\xb5\x75\xaf\x32\x39\x6c\x4e\x53\x8f\x48\xbf\xa4\xf9\x2e\x5d\x39\xfc\x0a\xd6\xe9\xfb\xb2\xe3\x85\x83\xcb\x0e\xfb\xa9\xa6\x9c\xea\x8a\xbc\x7c\xc6\x5f\x1b\x3e\x85\x7b\xdc\xf1\x97\x8d\xeb\xc1\x7c\x44\x49\xb6\x2d\x1c\xbc\x73\xf0\xe9\x9d\xd5\x91\xbe\xf2\xae\xdc\x06\xb2\x24\x04\x35\x69\xaa\xc9\x30\xaf\x2f\x45\xbe\x90\x3a\xcb\x9a\xb0\x25\x6f\x65\x03\xb4\x21\x2c\x56\x6e\x0e\xbb\x5b\x87\x57\x0c\xfe\x51\xf7\x25\x0b\x2b\x5c\xf8\xf8\x90\x40\xd7\x12\x5d\x36\xd0\xcc\x58\x2b\xa7\x37\x40\x7b\xf6\xe4\xfa\xdf\x07\xce\x97\xdd\x69\xb7\x61\xe1\x2a\x99\x1d\xd6\x52\xa4\x26\xdd\x51\xac\xe4\x4e\xdd\x43\xf9\x3d\xb8\x60\x52\x36\xdc\x62\x2c\xe4\x2c\x2f\x9b\xb9\xaa\xe6\x7a\x0a\xac\xb8\x21\xfb\x2f\x84\x88\x55\xb9\x4b\xb7\xf4\xa6\xe8\x17\x6a\x28\xb2\xbc\x06\x79\xa1\xd0\x6f\x14\x6e\x63\x60\x8c\xcb\x4e\x80\xd0\x7c\x63\xaf\xe8\x72\x01\x10\x2c\x28\x55\x26\xb7\xb2\x3d\xc5\x4a\x42\xd2\xaf\x38\x94\x86\xd7\x53\x13\x56\xfc\x3a\xdf\x5e\x05\xb1\x5a\x9e\x23\x07\x07\x91\x7a\xce\x00\xd1\xef\x56\xfa\x17\xec\x4a\x13\xd6\xff\xb9\xda\xef\xb9\xda\xef\xb9\xda\xef\xb9\xda\xef\xb9\xda\xef\xb9\xda\xef\xb9\xda\xef\xc9\xd5\x7e\x29\x19\x7c\x99\x84\x26\x8a\x1a\x19\x53\x3a\x64\x7b\xe0\x6f\xfa\xe0\x83\xf6\x69\xe0\x11\x3f\xcc\xcb\xc1\x87\xe0\x9f\xce\xfd\x70\xc2\x56\xdf\x86\x5a\x73\x4f\xbe\xa3\xfd\x11\x83\xcf\x36\xd4\x18\x02\x31\xa0\xa2\x9c\x71\x7d\xe1\xd0\xc9\xfb\xdc\x65\x8d\xf3\xc3\xfa\xce\x5d\x83\xb7\x94\xd3\xf0\xc5\x2a\xad\xc9\x77\x64\x86\x39\xdd\x24\xb9\xc9\xe5\x2e\xfb\x43\x73\x07\x47\x38\x9d\x31\xbb\x74\x25\x77\x7f\x68\xc6\xe0\x08\xa7\x33\xa6\xc5\xa6\x59\x8e\x8d\xc5\xc4\xf9\xd7\x14\xb0\x0d\xd7\xcf\x4d\x4b\x01\x84\x1e\x0f\xcb\xdc\x51\xbc\xf2\x95\x77\x13\xb3\x1a\x47\xd8\xeb\x0f\x7b\x99\x18\xfa\x02\xff\x94\x2a\x93\xbf\x77\xa9\x80\x31\xd4\x8b\x56\x3b\x5b\xb7\x7b\xc4\x38\x11\x29\x36\xb9\xa8\x49\x44\xb4\x67\x9d\xa6\x28\x74\x5c\xa6\xca\x30\xb0\x73\xd6\xd6\x25\x17\x88\x9d\x20\x67\x2a\x73\x73\xae\x2b\x62\x2a\xeb\x4b\x17\x18\x16\x41\xc4\xec\x5e\xdb\x3d\x74\x90\x14\x6d\xaf\xbd\x9d\xfd\x27\x11\xc0\xbd\xca\x10\x5c\x32\x28\x84\x1d\x16\x5d\xbc\xef\xbf\xd2\xe1\x97\xc9\x85\x6a\xc3\xf3\x53\xb7\xdc\xd8\x39\xd4\x60\x5a\x5c\xb4\x01\x8b\x7a\x5b\x6b\x21\x0e\xae\xc4\x07\xba\xd3\x5d\x89\x5b\xa8\x3f\x2e\x5d\x61\x52\xf0\xcf\x15\xe1\x5a\x8a\x2b\xf1\xb7\xf2\x73\xa9\xee\xcb\x8b\x7f\x6a\xe6\x7f\xe5\xa2\x0f\x0c\x64\x74\x28\x63\x83\x99\x3c\x1c\x4f\x2d\x91\x54\x14\x6e\x75\xa3\x65\xc6\x52\x3a\xce\x4e\x74\x35\x10\x45\xb0\x80\x7b\xf0\xb3\x3c\x9a\x6b\xa6\x09\xaa\xc4\x7d\x40\x6b\x20\x67\x70\x09\xfc\xa3\xd7\xad\x15\xe0\x03\xe1\x5d\xd4\x0d\x5b\x94\x41\x76\x91\xe8\x44\x65\xe3\x7d\xc4\x9b\x26\x40\x12\x79\xac\x45\x1d\x0e\xde\x0e\xdb\x9b\x11\x93\xa1\xa8\x02\x52\x96\x11\xd0\x05\x19\x84\xbe\x00\x3f\xfe\x12\x44\x9f\x60\x98\x8f\x0e\xe1\x31\x79\x60\x14\xa1\x95\x37\x5b\x75\xe8\x0f\xd1\xb2\xde\x5d\x42\xa9\xfb\xb5\xec\xdd\x6f\x08\x0a\x01\xbf\x51\xeb\x20\x0b\xbe\xb1\x20\x96\x4a\x75\x98\x74\xf4\x86\x68\x2d\xb5\xd9\x2c\xc7\x64\xee\xe2\xb9\x6e\xc8\x97\x37\x36\xaa\xd8\xb1\x32\x3a\xf0\x1f\x19\xa7\x8d\xcd\x0e\xa2\x58\x55\x75\xf6\xa4\x36\xb5\x46\xf2\x5a\x64\xea\xb0\x02\xcd\x92\x6e\x1a\x59\x01\xe6\x2e\xc2\x30\x34\xd5\x71\xc1\x2e\xb4\xa5\x78\x32\x8c\x51\x1a\x5d\x89\x45\xfa\xf0\x3c\x76\x78\xef\xd2\x87\xde\x08\xb5\x0b\x50\x6d\xfa\xc3\x6d\xee\xa5\xf4\xa3\xe4\x12\xb4\x93\xe5\x14\xba\x29\x66\xd6\x38\x6e\x8a\xe9\xe3\xb8\xcf\xcb\x4c\xdd\x8f\x8e\xe1\x5f\xb1\x99\xd7\xb7\xd5\x54\x47\x76\x16\x18\x89\x1e\xf1\xc2\xa2\x3f\xeb\xa3\x2b\x82\x0d\xc1\x4e\x39\x3c\xd6\x1c\x20\x20\x72\xc0\x99\x24\xad\xf7\x24\x3d\x8e\xc5\xb4\xe1\xfb\xaf\xc1\x9a\x5c\xb4\x8a\xc0\xe0\xec\x65\x12\x60\xe0\x2d\x36\x61\x06\x52\x16\x98\x91\x52\x74\xce\x37\xaa\x07\xa3\xec\xf1\xf7\x98\xa0\xf0\xba\x1b\x45\xee\x0e\x11\x0f\x2d\xd0\xf5\x36\xdf\x65\xa3\x73\xff\x02\x5a\x71\xcf\xf3\x32\x93\x0f\x2c\xb9\x59\x5e\xc1\x3d\x1e\xc9\xf0\x6f\x85\x3b\x95\x5d\xf0\xb8\xb8\x9d\xe9\x37\xad\xd5\x4a\xae\x65\xfe\x85\x4d\x2e\x7a\x28\xe8\x2d\x42\xea\x95\x74\x13\x05\xc3\x81\xaa\x32\x0b\x1b\x34\x27\x83\x8e\x36\xd6\xc0\x6e\xc1\xd0\x87\x18\x2d\x7a\xa3\xa3\x54\xa0\x97\xdc\x23\x27\x61\x47\x77\x0c\x1a\x0f\xc4\xd5\x2d\x1e\xd3\x1c\xe3\xaf\x4e\xd2\x99\x08\xb8\x4a\xf1\x3c\xc0\x0b\xec\x22\xec\x5a\x02\xb5\x18\x18\x25\xe1\x20\x2a\xc4\xec\xf6\xcd\x4f\x7f\xf9\xdb\x7b\x88\x33\xfe\xdb\xed\x87\x1b\x1d\xcb\xfc\x74\xf6\x68\xcb\xc7\x81\xf1\x1b\x58\x3c\x1a\x07\x6e\x99\x04\x86\xce\xb0\x72\xda\xa4\x36\xd8\xe8\xc8\x68\x04\x3a\x28\xa7\x38\xa4\x06\xe3\x50\x86\x93\x9b\x5a\x20\x72\xed\x69\x82\xa3\xf4\xe1\xdf\xc1\x15\xd7\xd4\xe6\x51\x01\x5a\x77\xdd\xb5\xe3\x0d\xa9\x82\x71\xeb\x00\x6c\x07\x28\xba\x0e\x90\x3d\xf9\xe8\xd3\xdd\x8e\x3c\x29\x4d\xdb\x3b\x47\x22\x43\x7f\x0e\xfc\xae\xbd\x7a\xa7\xc2\x3c\x7b\xfb\x0b\x4b\x0b\xc7\xde\xec\x20\x65\x98\x26\x01\xe2\x47\xf5\xd2\xfb\x3b\x94\xf6\x32\x5e\x98\x21\xcb\x78\xc5\xc2\xa8\x60\x95\xa5\x10\x57\x05\xb1\x63\xf2\x50\x8b\x7f\x3b\xc8\xea\x38\x45\xe9\x50\xb4\xce\xf0\x41\xaf\xfb\xcf\x28\xaa\x87\x86\xc0\x41\x3e\x6a\x63\x7d\xdd\xda\x0a\x29\x5e\x68\x6f\x9e\x2d\x0a\x55\xe6\x8d\x02\xb5\xef\x0f\xf2\x11\xe2\xa3\x45\x1b\xf2\xcc\xf2\xbb\x43\xd5\x1a\x6a\xc9\x3f\xb9\x93\x95\x28\xd2\x32\xbd\x93\xad\x1d\x93\xb4\x81\x93\x28\x86\xb7\x4d\x5c\x4d\x00\x27\x75\xb7\x95\xd5\x9b\xfa\xb9\x6c\x9a\x08\x28\x94\xbf\x74\x9a\xb3\xbf\x03\x8d\xdf\x77\x55\x9a\x75\x42\x42\xb3\x4a\xc1\x69\xde\x41\x52\xb0\x5a\xcf\xd7\x69\xa3\x2a\x1b\x7c\x1f\xee\x6c\x75\x2d\xd0\x45\xc6\xba\xa6\xd9\x56\xea\x70\xb7\xdd\x1f\x9a\x85\x78\x6e\xc2\x90\xdc\x7b\xbd\xa7\x2f\x55\x5e\x1b\x7f\xae\xf9\xee\x54\xaf\xb6\xd0\x82\x37\xca\xa4\xbf\x42\x2b\xfe\x32\x08\xce\x5f\xdf\xea\x37\xfb\x61\x69\x7a\x69\xb4\x7c\x48\xfc\x85\x42\xd1\x8e\x50\xc9\xe6\x50\x95\x22\xa5\xea\x88\x0c\xb2\xc0\xf2\x08\xa4\xf7\x4f\x9f\x0a\x50\x5a\xe5\xfa\x48\xec\x73\x12\xc5\x20\x7b\xe6\xf2\xa6\x8d\x69\x9e\x2c\x3f\xcd\xb6\x92\xf5\x56\x45\x6c\xe9\x1f\xb9\x25\x73\xa6\x48\x1f\x3a\x53\xd5\x9f\x1e\x57\x64\x91\x55\xb3\xd5\x5a\x87\x3f\x5c\x53\x66\xcc\x0f\x8b\x27\x8f\xb7\xa1\xe0\x94\x0d\x7e\x35\x03\x8e\xde\x6b\x34\x24\xe7\x1b\x3a\x71\x2f\x93\x00\x8f\x6e\xbb\x6d\x45\xbd\x87\xfa\x92\x75\x0f\xb6\x33\x5d\x57\xaa\x76\x47\xff\x9b\xa3\x2b\xdc\x69\x05\xb8\xcb\x29\x18\x0b\x57\xd8\xec\xc7\xeb\x7a\x46\x84\xec\x1d\x0d\x63\x44\x28\x3f\x6c\x40\x52\xdf\x5f\x7e\xbc\x66\x9f\x16\x27\x43\xa2\xdc\x50\x5c\x09\x7e\x2d\x85\xdb\xe1\x5a\x52\x62\x80\xcf\xcb\x4d\x1e\x76\x93\xa4\xa5\x2f\x59\x74\xf2\x66\xef\x7f\xbb\x49\x22\x65\x00\xb2\xe0\x47\x03\x82\x47\xd9\x09\xd4\xc1\x3e\xa0\x96\x64\x5f\xbf\x6f\x7f\x73\x88\x03\x2e\xa8\xe0\x0c\xfd\xca\x89\x0e\xc3\x74\x33\x88\x1a\x04\xd9\x06\x5b\x45\xa3\xc4\x6f\xaf\x21\x46\xf0\xbd\xca\x20\x04\xf1\xb7\x1e\x4d\xb0\x3f\x51\x03\x1d\x26\xa8\xdb\xfd\x06\x3f\x7f\xc0\x18\xc2\x77\xe9\x43\xf7\x11\x66\xe3\x74\x89\x0e\x15\x06\xe5\xbc\x8b\xb4\xe4\x63\x9f\x41\xbd\x6e\x94\xc8\x54\xd7\xa5\x65\x51\xec\x7c\x2a\x40\x97\x0f\x7e\x78\xb0\xbd\x9e\xdf\x5c\x5f\x83\x15\x40\xa3\x5d\x1c\xed\xe0\xb1\xf6\xbb\xa4\xf0\x1c\x27\x50\x30\x1d\x0f\xfb\xf4\xda\xcb\x82\xcb\x61\x47\x06\x34\xfd\x1d\x03\x7d\x33\xe8\xdc\x80\x29\x71\xa2\xe2\xd2\x1a\x8e\x02\x15\xf3\x3e\x7a\xf8\xdc\x85\x5d\xef\x54\x1d\x2d\xc2\x94\x53\x0e\xdf\x29\xdc\x0f\x80\x39\xbb\x63\x8b\xec\xd9\x42\x4c\xfa\x23\x97\x2c\x92\xa2\x6f\xfb\xf2\x1d\xa2\x2c\xe0\xaa\xd0\xea\x78\x61\x9a\xf5\x33\x3a\x29\x56\x40\x77\x14\x35\x43\xb7\x97\x8b\x24\xca\x5e\xd9\xfd\x1a\xbc\x68\x3e\x49\x3d\x59\x41\x2c\x49\x49\x1c\xe9\x6c\x2c\xce\xef\x84\xcf\x8d\x02\xeb\x58\x7c\xac\xd2\xb2\xc6\x6f\xf8\x11\xfc\x3a\x1d\x7b\x3b\x78\x89\xf7\x3c\x20\x87\x1a\xd9\xba\x01\xf8\xce\x48\x64\xf8\x36\xe3\x5b\x6f\xd3\xf2\x6e\xa8\xcc\xba\x6e\xfb\x30\xca\x9f\x57\xf1\x45\x80\x4e\x8e\xbc\xeb\xc6\x53\x8d\x7a\x75\x28\xea\xd1\xaf\xe2\xe3\xf1\x09\xe9\x4a\xca\xc7\xe3\xde\x4c\x08\x10\x00\x41\x4c\x69\xb1\x18\x41\x5f\x4c\xef\x8e\x4b\x29\x98\x65\xef\x2e\x0d\xab\x23\x77\x07\x3f\x7b\x0f\x13\x21\x53\xfc\xd0\x08\x5f\xa4\xfb\x39\x04\x00\x2c\x93\x88\xaf\x0e\x5f\x87\x46\x4b\xb0\xc9\x77\x5a\xb6\xea\x64\x99\x04\x58\xde\x96\xf9\xa5\x1b\xac\xb5\x00\x2c\x8d\x04\x73\x3f\x40\x99\x09\x2d\x49\xba\x1d\x61\x5e\xff\x32\x19\x9b\x77\xab\xb1\x49\xc8\xea\x9e\x91\x7c\xc9\xfd\x0e\xd2\xc2\x99\xf0\xbf\x48\x26\x79\x5b\x5c\xfd\x73\x96\x18\x36\xc5\x82\xad\x2f\x3a\x29\x12\xb2\x1e\x1b\xa0\x8d\xaa\xa3\x48\xfd\x36\xd9\xd7\xf9\x76\x58\xfd\x3d\x52\x85\x60\x9f\xa9\xe9\x77\x56\x08\xd7\xbf\xc0\xff\x0c\x35\xa8\x38\x70\x75\x99\x4c\x64\x5d\x00\x89\x39\x66\xdb\x0a\xd2\xd6\xf9\xe1\x81\x32\xdf\x9d\xf5\xf6\xda\x6e\x6d\x14\x82\xa5\x07\xda\xc5\x63\x12\xe6\x1d\x34\x45\x2f\x89\x3e\x2f\x07\xe5\x26\xf4\x2d\x08\x29\xe7\x25\x78\x38\xad\x8f\x3a\x29\xf2\xf5\xa5\xbd\x18\xe9\x0e\x70\xf9\x8a\xbc\x4d\x93\xff\x0a\x85\xa3\x19\x10\xd0\x37\x3d\x36\x38\x29\x7a\x71\x17\x4e\x54\x30\x68\x8c\xf0\x3d\xec\x0d\xe0\x15\xb4\xed\x55\xf8\xbb\xdf\x1e\x5d\x13\xe7\xca\x64\x6f\xff\x58\xd3\x47\x32\xe0\x6d\x1c\xa9\x9f\x96\x5f\x4b\xe0\x6b\x15\xdc\x98\x7a\x42\x3e\x3f\xb6\x76\xfa\x9e\x1a\x28\x2f\xf6\xe9\x7a\x3c\x72\xf9\x0d\x36\x63\x21\xd1\x2f\x09\xe5\x28\x09\x23\xeb\x26\x2f\x52\x5f\xe6\x8c\xe5\x09\xe6\xfd\xd4\x58\x21\x16\xc9\x74\x41\xcf\xe4\x7e\xa7\x8e\x70\xea\xf1\xae\x84\xce\x30\x5e\xb6\xed\x8d\xaa\xb2\x68\x58\x1a\x8b\x4f\x34\x1e\xaa\x04\xbe\x61\xe2\xa4\x3c\xcd\x02\x3a\x24\xd0\xb7\x2e\xb3\xf7\xe0\x05\xc3\xe3\x74\xdb\x53\x2f\x45\xb6\x6d\x42\x28\xc5\x66\x83\x7c\x35\x65\xde\xbd\x2f\x8d\xab\x13\xf8\xcb\xf4\x42\x6d\x7a\x43\x7a\xc6\x5d\xc8\x6b\x97\x87\xcb\x3e\x34\x06\x89\x1a\xdf\x45\x14\x07\xc6\x9c\x74\xb1\x2a\x26\x4a\x41\x44\xab\x9a\x09\xd4\xc8\xa2\x32\x81\xd1\x64\xd3\xf1\xa3\x71\x71\x62\x61\x90\x24\x0b\x0f\xac\x0c\x9e\xed\x47\xe1\x33\x7f\x7d\xc2\x88\x28\xcb\xd1\xca\x04\xa8\x41\x0f\xf7\x44\xe8\xd1\x25\x67\x2c\x45\x62\xea\xd8\xc3\xfb\x07\x99\xb8\xc6\x58\x1d\xd8\x4a\x62\xb6\x13\x3a\xf0\x6a\x11\x09\xb4\x18\x95\x91\x91\x7d\x69\x7c\x6f\x3a\x61\x7f\x62\x55\xbb\x4c\x22\x84\x86\xa3\xde\x4e\xd3\x35\xe3\x33\xaa\x7d\x44\xaf\x08\x3b\x1a\x30\xa6\xf3\xb5\x8c\xeb\xda\xad\xf3\x55\xb3\x09\x91\xaf\xa8\xbe\xa4\x83\xaf\x87\xa4\x36\x4a\xc1\xa0\x66\x66\xce\xaf\xe0\xdf\x30\x56\x4a\x81\x43\x0e\x82\xc9\xc0\xc3\x71\x6c\x31\xae\x73\xcc\x46\x4c\x46\x56\x48\x0c\x8b\x46\xf6\xb3\x08\xfd\xf6\x4d\x04\xe4\x4b\xae\xc0\x18\x9a\xbd\xcc\x6b\xc2\x68\x7d\x0e\xbe\xf0\xc8\x43\xc1\xaf\xbe\xb7\xcd\xec\xbc\x57\xc3\x87\x43\x83\x3e\xff\xa1\xab\x4b\x68\xa6\xda\x54\xe9\x95\x34\xbd\x0f\x55\xcd\x04\x68\xc9\x6e\x68\x16\x4d\x14\xa1\x49\x60\x0c\xa1\x39\x7b\x81\x94\x98\x54\xfb\xc5\xef\x69\x2e\x43\xfa\x72\xee\x93\xcc\xa0\x5e\xf2\x96\xfb\xf7\x98\xd6\xba\x65\xb0\x28\x44\xba\xf5\x01\xf4\xed\xff\x8b\x64\x22\xeb\xf6\xe6\xde\xba\x4c\x26\x4d\x4a\xa7\xbf\xce\xeb\x26\xa4\x3a\xc2\xf6\x05\x06\x7a\x9d\x50\xdd\x5a\xc4\x9d\x24\x45\x1b\x27\x9e\x97\x51\x43\x8b\x39\x2c\x12\xf6\xb9\xe7\xe9\x08\x7b\x1e\xcd\x3e\x16\x34\xbc\x0f\xf8\xf9\x4c\xac\xaa\x5c\x6e\x5a\x30\x7f\x36\xdc\xb3\xef\x1c\x16\x5f\x26\x1b\x88\x6c\xf4\x52\x14\x16\xd7\xbb\x0e\x38\xed\x2a\xd6\x68\x20\xba\x84\xc4\x5e\x65\xda\xc1\xbe\x0f\x16\x99\x16\xa6\xb5\xb1\x15\x89\x27\xc5\xec\x6b\x18\xf3\x07\x31\x1c\xa2\x24\xde\xf9\x42\x90\x5c\x4b\x86\x6b\x76\xe6\xee\x1a\x9e\x7d\xb8\x48\x70\xb7\xb9\x6b\x0a\xb4\x7f\x56\xc7\x4e\x90\xd8\x57\xde\xb0\x4c\x2c\x65\xb0\x95\x0f\xeb\xd8\x71\xf2\xa1\x84\x60\xb6\xcb\x34\x4a\xa3\xa9\x9a\xef\xc4\x1d\x9d\x43\x76\x9c\x88\x8d\x24\xd4\xe7\xd0\x9c\x98\xf9\x48\xa3\x3b\x1c\xc7\x66\x5a\xaa\x9a\x29\xe3\x0d\x7b\x7d\x7f\x46\xcc\x8c\x60\x76\xe8\x1c\x67\xff\x31\xc3\xc3\x10\x0d\x88\xcc\x30\x61\x16\xe2\xe8\xc1\x75\x99\x7a\x74\xb5\xff\xe4\xd3\x47\xfd\xe6\x25\x4f\x51\x6e\x10\x22\x4c\xb7\x29\x14\x26\x53\xeb\xcf\xb2\x5a\x5e\x5d\x3d\x3c\x3c\x44\xd0\x8f\x58\xe1\x31\xa7\x02\xfb\xcf\x9c\xb9\x3f\xda\xce\x89\xda\x10\x7d\xa6\x98\x76\x08\x3a\xf9\x58\xdb\xba\xcb\xff\x86\xf5\x66\xfc\xae\x72\xe7\x9c\xbd\xed\xbc\xc8\xf3\x67\xc3\xb0\x00\x69\x48\xa6\x88\xbc\x14\x87\x3c\x0a\x93\xe7\x75\x74\x7f\x1e\x8c\xe7\x1d\xef\xc8\x7a\x20\x68\x7a\x1d\x8e\xc4\x84\x77\xbf\x76\x83\xe6\x76\xff\xaa\x4a\xbc\x7a\xc8\xc3\x4a\x7e\xc2\x90\x7c\x98\x90\xde\x11\xd9\xe8\x90\x10\xf0\xaa\x32\xa3\xfa\x38\xf0\xb8\xb5\x49\xc2\x20\x83\xc4\x05\xb3\xa0\x16\x6b\x38\x56\x64\x62\x75\xa4\xc5\x79\x2b\x9b\x9f\x65\x23\x8b\xc4\xfb\x6a\xfc\x38\xbd\x47\x6a\xcf\x30\x3b\x47\x6a\x7c\x79\x38\x6d\x97\x70\x45\x85\x9f\x49\xbf\xfa\xaf\x57\xfa\xaf\x9e\xdd\x4b\x86\x6d\xa3\x98\xec\xc7\x9b\xcb\x7d\x9e\xd5\x53\x86\x98\xdb\xce\x2f\x93\xfa\xc1\x68\x38\x1b\x55\xd9\x46\xef\xf0\x15\x9f\x3a\xa0\x32\x3b\x88\xb7\x81\x6d\x51\x55\xf5\xd7\xef\xc4\x63\x68\x14\xa7\xed\x2b\xdf\x58\x0d\x8e\xef\x00\xf3\x9e\xa2\x0c\x34\xec\x17\x43\x9c\xac\xf9\x43\x81\x39\x03\xd1\xf8\x80\x8d\x7b\xfe\x35\x92\x7f\xb8\x9b\x71\x24\x69\x27\x8d\x32\x89\x39\x95\x01\xcd\x95\x04\xf9\x22\x9c\xc1\xe4\x2b\x84\x1e\xbb\x12\x39\x24\x04\x9d\xe3\x11\xe1\x8b\x76\xaf\x4c\xe4\xdc\xea\x68\x07\x5a\xec\x82\x12\xd4\x22\x68\x19\xc7\x10\xed\xe5\x04\xec\x0a\xde\xa5\x23\x28\xf7\x52\x83\x8b\x8b\x6d\xba\xdf\xcb\xd2\xef\x4f\xa6\x91\x8f\x1c\x60\x47\x39\x13\x96\x3c\x47\x80\xe2\x9f\x27\x7a\xc1\xbf\x0c\x82\x5c\x35\xa9\x85\xcb\x64\x4c\xce\xb8\x65\x27\xde\x49\x1d\x1a\x00\xd3\xc3\x8b\x1b\xd1\x22\xcb\x98\xd7\x72\x99\x97\x03\x4f\xe5\x22\x99\x7e\x7f\xd8\xe4\xbb\x06\x82\x9f\x9f\x1f\x0d\x1c\xc6\x32\x89\x58\x2f\xaf\x87\xef\x0d\xef\x0f\x88\xd7\x10\x36\xbf\xb6\x3d\xa0\x2c\xb9\x56\x50\xc4\x1e\x53\x94\x93\x53\xf5\xf8\x9d\x2a\xe3\xc6\xf2\x93\x2a\x47\x3a\x6f\xc2\xc6\x57\x72\x1d\x34\x6e\x34\x5b\x79\xbc\xa8\x64\x57\x87\x89\x14\x5d\xaa\x5c\xbd\x25\xaf\xda\x31\x22\xb6\xb7\xc1\x54\x3c\x79\xa8\xd4\xd7\xa8\xd1\xbe\xa3\x71\x0d\x06\x0c\xa2\xa6\x47\xdd\x35\xcb\xaa\xea\xe4\x7e\x01\x1c\xb6\x5c\x37\x91\x3d\x7b\xcf\xad\x47\x26\xc3\x50\xf5\x10\x45\x23\x06\x06\x73\x12\x45\x88\x23\x3e\x79\x0c\x06\x57\x36\x72\x14\x06\x60\xd6\x35\x8e\xf5\x56\xd5\xb2\xec\xc8\x94\x87\xa6\xe0\xf5\xd0\xe2\xda\x9a\x73\x34\xdb\xdb\xd3\x4a\x62\x06\x1f\xa5\x91\xe6\x25\xa9\x17\x99\x9d\x3e\x5a\xb9\x8b\x9f\x30\xfe\xda\x70\x9c\x38\xc0\x0d\x94\xe7\xdb\x1d\x79\xd0\x01\x5f\x00\x27\x46\x9c\xd8\xed\xd0\x86\x36\x77\xa9\x38\x67\x3b\x92\x31\xe7\xb3\x90\xc8\x71\xb4\xbe\xf3\x61\x70\xf3\x32\xdb\x06\x22\x32\x2c\x93\x28\x76\x73\xf3\xce\x06\x42\x09\xf8\x7c\x66\x31\x84\x13\xff\xc1\xc4\x8f\xe0\x70\xc2\x36\x42\xdf\x8f\x92\x9a\x0f\xd4\xd7\x81\xd0\x58\x03\x39\x51\x10\x22\x4a\xe8\x47\x14\xd0\xa7\xae\xe8\x9c\x23\x0a\x14\x0b\x73\x34\xde\xa0\x30\x72\x38\x0b\x4b\xb2\x9f\x39\xf3\x76\xe0\x93\x85\x90\x12\x98\x9d\xdf\x74\xa6\x3a\x53\x75\x30\x28\xe5\xb2\x19\x1c\x42\xc4\x7d\x5a\xb7\x34\xdd\x77\xde\xdc\x36\x01\xa0\xaa\xb8\x97\x56\xf2\x33\xde\x7b\x0d\x30\xe1\xaa\xe3\x7e\xb8\x4f\xeb\xf2\xa2\x09\x83\xc2\x62\xa2\x5a\xb3\x95\xc5\xf4\xd4\xcd\x5a\xa7\xd6\x8d\xb3\x42\xb7\xeb\xac\xc1\xbe\xf5\xbd\x4d\xc5\xc3\x9b\x40\xe2\xcd\xdd\xe8\x87\x8d\x99\x01\xc0\x02\x05\x7b\xb1\x84\xbd\x80\xfe\x8f\xee\x27\xf8\x75\xc6\xe7\x70\x8d\x53\x68\x2f\x6a\x25\x0b\xf5\x85\xf3\xdd\xec\x82\x54\x78\xb5\xe7\x2f\x9e\xb2\xe4\x4b\xf9\x10\xbf\xd0\x7e\x96\x0f\xc3\x75\x66\x82\x58\x07\x1c\xf0\x90\x24\x9c\x6a\x83\xfa\xe2\xbf\x05\x3d\xd2\x72\x84\x34\x52\x84\x8c\x8a\x1a\x24\xc3\x4b\x39\x15\x9b\x2b\x7c\xd8\x43\x54\x80\x30\xdc\x29\x20\xa5\x2b\x71\x31\x5b\x70\xf8\x27\xaa\xc6\xb0\x66\xe1\xc9\x74\x3e\x24\x1e\x4c\x56\x2c\xac\x91\x96\xc9\x69\x13\x14\x98\x1c\xef\x87\xf9\xa6\xfe\x93\xae\xc8\xe4\xb8\x92\x75\xa6\xec\x97\x41\x73\x9e\x3d\x2a\xe9\x64\x65\x86\x41\x96\xa0\x07\x3d\x9b\x8d\x06\x07\x94\x62\x63\x2d\x80\x2c\x43\x1d\xe2\x6d\x3d\x3c\x34\x30\x66\x93\xa5\x2f\xb0\xf6\x5a\xb3\x75\x28\x23\x77\x37\x9c\x09\x6a\x21\x0b\x98\x5f\x32\x9c\x56\x4f\xbf\x9d\x93\x40\x98\x70\x28\x8b\x24\x72\xae\xdc\x37\x6a\x6f\x73\x83\x03\x15\x85\xe2\x4e\x01\x03\x23\xa1\x0d\x86\xe6\x22\x89\x57\x71\x04\x84\x3b\x7c\xe0\x06\x40\xee\x9d\xc6\x6a\xf4\x52\xaa\x8d\x9d\xd5\xc8\xdd\x70\xeb\xac\xea\x50\x12\xca\x87\xda\x65\xd0\x1c\x8f\x1f\x8b\x64\x92\x49\xd4\xc9\x24\xb0\x27\x57\xe6\x8c\xae\xfb\x49\xe9\xfc\xdc\x23\x3f\x84\xf9\xd8\x2e\x10\xcc\xdb\x70\x74\xea\x95\xce\xf2\x70\xef\x03\x00\x72\xbc\xc9\xcb\xbc\x76\xa0\x8d\x0c\x65\x7d\x4c\xb7\x07\xa4\x6c\x82\xcf\x26\x82\x86\x86\xb5\x8e\x64\x40\x3b\x2b\xf0\x52\x3b\x2b\xf8\x5f\xb1\xb3\x12\xd9\x31\x43\x69\xc2\x04\x71\xff\x46\xa6\x09\x4f\x79\xdc\xd4\x4b\x19\x91\x2b\xf1\x64\xfa\x9d\xa6\x73\xf4\xf8\xdf\x1f\x2d\xb7\x77\x8f\xd4\x9c\x39\xcd\x16\xfc\x5d\xc6\x11\xda\xab\x61\xb7\xd6\xd2\xe2\x79\xd8\x99\x74\x67\x9b\xe0\xae\xfd\xc8\x06\x55\x38\x58\x90\x43\x6b\x99\x8c\x4c\x06\x1c\x12\x3b\x13\x90\x73\xb8\x91\xb8\x87\xf2\x96\x2b\x53\xdd\xc1\x29\x72\x31\x13\x10\x64\x3d\xf4\x15\xd1\xdb\x1f\xa3\xa7\x64\xea\xd7\x58\x85\x8f\xdf\x5b\xcf\x1c\xba\x24\x67\x6e\x05\xc0\x25\x21\xa7\x91\x83\x66\x9f\xde\x1c\x6b\x8b\x24\xce\xf6\x50\x30\x03\xb9\xf3\xe5\x26\xdd\xed\xb7\xe9\x4d\xfb\x1b\x6e\x57\xda\x07\xd0\x79\x0c\x48\xed\x70\x52\xb2\x2a\xe1\xc0\xae\x0b\x0a\x59\xff\xd2\x26\x7d\xa7\xeb\xb5\xdc\x37\x32\xb3\x4a\xa5\x7f\xce\xcb\x6c\xc9\x65\x56\xf7\xbb\x43\x95\xee\xe8\x3f\x2d\x20\x02\xf1\x3f\xfe\x67\x02\x67\x51\x55\xc9\xec\x57\xee\x0d\xfc\x38\x9f\xcf\x93\x74\x9f\xd3\x6f\x4b\x91\xee\x73\xf9\xd0\xc8\x12\x5b\x2c\x3e\xff\xd7\x7a\x91\xab\xab\x2f\x37\x2b\xd9\xa4\x37\x89\xfe\xd4\x8b\x43\xdd\xa8\xe2\x83\xac\xd5\xa1\x5a\xcb\x97\x00\x9d\x89\x5f\x49\xb8\x0a\xe3\x32\xb1\x61\xdd\xa9\x9b\xad\xb3\x68\x7e\x27\xcb\x05\x2c\x9f\xd5\x21\xdf\x65\xb2\xc2\x2f\x18\xae\x5d\x2f\x7e\x58\x3c\x81\xce\x43\xdd\x6d\x42\x04\xa8\x9b\xb4\xd8\x2f\x45\x79\xc0\x02\xbc\x9a\x7f\xda\x71\x8a\xaa\x69\x81\xff\x3b\x2f\x64\xbd\x5d\xa8\xea\x2e\x81\xc9\x81\x8f\x62\xaa\xef\x52\xf4\x9e\x52\x9c\x9b\xcd\xbc\x5b\xa4\xf5\xc2\x5c\x46\xc0\x43\xf2\x2f\xfd\x27\x6f\xf3\xba\xe9\xf0\xd8\xea\x41\xc2\x41\xe4\x87\x5d\x5a\xf5\x9f\xd4\x6b\x05\x12\x65\xcc\x6e\xb0\x6a\xeb\xc3\xaa\x22\x06\x12\x7f\x68\x8e\xc5\xff\xfa\xdf\x20\x30\x50\x9e\xda\x3a\xb7\xab\xbd\x2c\x9f\xbd\x7f\xf3\xeb\x8f\xb0\x2f\x15\xe9\x32\x71\xac\x46\xab\xab\xac\xc6\x75\x6b\xe3\xa4\xb6\xba\x25\x9e\xbd\x7f\x93\xf8\xcf\x36\x96\x44\x24\x9e\xc5\x7f\x01\xfd\xd1\x6d\x3a\x80\xb1\x24\xeb\x60\x2c\xd2\x5f\xc7\xe3\x5f\x6e\xc5\xb3\x0e\xb1\x6e\x60\xc3\x2f\x69\x09\x01\xe6\x75\x05\x44\xb8\xf4\xee\x5a\x95\x5f\x64\xa5\x43\xa7\xee\xca\xfc\x1f\x86\xb2\xc1\x54\x85\x20\xef\x5e\x00\x05\x9c\xf3\x2b\xc0\x04\x24\xd8\x27\xb0\xa5\x14\x29\x04\xd0\xc3\x37\xc4\xa1\xb4\xa8\x61\x93\x7a\xa1\x4b\x8b\xf4\xaa\x8a\xe4\x0d\xaf\x81\xb5\x2a\x0a\x28\x15\x7e\xbc\x42\x49\xce\x57\x87\x46\x55\xf5\x55\x06\xb0\x54\x57\x75\x7e\x37\x4f\xab\xf5\x36\x07\xcb\xfd\xa1\x92\x57\xe9\x3e\x9f\x63\xc7\x4b\x18\x6c\xbd\x28\xb2\x7f\x67\xe6\xfb\x22\x19\xd1\x6d\x28\x94\x5e\xbe\x83\x60\x12\x38\x34\xbe\x46\xf5\x84\x0d\x7b\xd9\x6d\xf1\xe1\xd5\xed\x47\xc1\x1f\xc5\x29\x48\x86\xb0\xf3\x56\x94\x71\xcb\x78\x60\x54\x5e\x6e\x00\x0f\x09\x22\x5a\xe1\xfe\xc6\x45\x84\x74\xde\x01\xfc\xc7\x7a\x97\xf7\xc3\x8b\xea\xc3\xaa\xc8\x9b\xba\xad\x69\xd9\xa8\x85\x78\x81\x9a\x00\xf6\x82\x03\xba\xf0\xb3\x85\x78\x53\xb6\xe1\xb8\xdf\x9c\xed\xc0\xe1\x7a\x0e\x2c\x1d\x67\xbc\xad\xc0\x84\x70\xaa\x77\x1a\x29\x69\x18\xe7\x0c\xdd\xc2\x0d\xd9\x5e\x12\x2b\xb9\x4d\xbf\xe4\x0a\x8d\x20\xa9\xde\xda\xfb\x21\xd9\xc9\xf8\x5d\x83\x14\x28\xe2\x33\x50\x5a\x58\xb7\x81\x1b\x2f\xc1\x6e\xef\x4f\x23\x33\x46\x47\xa7\x93\xd2\x87\xfb\xe0\xc4\x78\x30\x35\xd7\xb0\xdc\x96\x8f\x24\x21\xe1\xaf\x55\xb1\x4f\x2b\x2e\x3c\x0b\xb4\x0e\x25\xbf\xa7\x4a\x49\xa0\xd4\x1d\xfa\x30\x08\x2f\xce\x63\x5e\x76\xe0\x22\x61\xd9\xa7\x8d\xd8\x49\xf0\x0f\x13\x68\x17\xbc\x4f\x9f\xe8\x1f\x63\x8b\xf4\x41\xd7\x6b\x79\xfa\x34\x89\x84\x0e\xf5\x9b\x15\x7c\x10\x00\xee\xd2\xd0\xed\x3a\xec\x14\xbe\xe2\xf9\x99\x8e\xba\xa4\x13\xcf\x0f\x95\x8c\x80\xc4\xbe\x78\x6d\x37\x1d\x16\x4e\xd3\x25\xba\xec\xbb\x80\xd3\xfe\xe3\xc9\x74\xd7\xb5\xe5\x68\x20\x20\x5f\xdd\x6a\x69\xa6\x32\x9a\x5d\x61\x7e\xd6\xef\xaf\x10\x70\x78\xa8\x11\xb3\xda\xea\x08\xea\x26\xdb\xa0\xdb\xa2\xa1\xb5\x8b\x0b\x39\x51\x0f\x0b\x9e\xcd\xc5\xac\x2d\x5c\x3f\xac\xb9\x66\x85\x8c\xb3\xcd\x52\xd7\x31\x6b\x87\x36\x34\xec\x3b\xbe\x8e\x30\xb6\x32\x83\x62\xdd\x68\xe5\xca\x1b\x2f\xa7\x52\x3c\xe0\x74\xff\x72\x17\x16\x9e\x42\x6a\xfd\x71\x22\x03\x30\x6d\xa8\x45\x46\x18\x10\x35\xb6\x6f\xfe\x38\x97\x36\xbd\x68\xa1\x13\x70\xe9\x61\x30\xa6\xb1\xe1\x50\xbf\x9d\x2b\xda\x55\x47\x89\x4a\x58\x5d\x24\xe3\x85\xd7\xe6\xc2\x9e\xff\xc1\xc3\x76\xa2\x06\x8f\xe8\xe8\x1b\xb1\x22\xce\xb5\xed\xcf\xb5\xed\xcf\xb5\xed\xcf\xb5\xed\xcf\xb5\xed\xcf\xb5\xed\xcf\xb5\xed\xcf\xb5\xed\xcf\xb5\xed\x7f\x5f\xb5\xed\xc9\xa6\x5f\x2d\x93\x08\xd7\xcd\xf7\xaa\x6d\x0d\x83\x9c\x72\xaa\x3b\xd7\xb6\x3e\xd7\xb6\x3e\xd7\xb6\x3e\xd7\xb6\x3e\xd7\xb6\x3e\xd7\xb6\x3e\xd7\xb6\x3e\xd7\xb6\x3e\xd7\xb6\x3e\xd7\xb6\x3e\xd7\xb6\xfe\x96\xb5\xad\xff\x1f\x7b\xdf\xdf\x1c\xb7\x8d\x2c\xf8\x3f\x3f\x05\x6a\xae\x5e\x79\xf7\x4a\x33\x92\x92\xe7\xab\xf5\xbc\x7f\x4e\x92\xed\xc4\xb5\x96\xad\x95\x94\xa4\xae\x5e\xb6\x62\xcc\x10\xa3\xe1\x9a\x43\xcc\x23\x39\x92\x66\xaf\xee\x63\xdd\x17\xb8\x4f\x76\xd5\x8d\x06\x08\x92\x00\x08\x8e\x94\xad\xdd\x44\x56\x2a\x89\x45\xa0\x81\x6e\x34\x1a\x8d\x46\xff\x78\xa9\x6d\xfd\x52\xdb\xfa\xa5\xb6\xf5\x4b\x6d\xeb\x97\xda\xd6\x2f\xb5\xad\x5f\x6a\x5b\xbf\xd4\xb6\x7e\xa9\x6d\xfd\x52\xdb\xfa\x37\x54\xdb\xfa\xa5\x3c\xef\x4b\x79\xde\x97\xf2\xbc\x2f\xe5\x79\x5f\xca\xf3\xbe\x94\xe7\x7d\x29\xcf\xfb\x52\x9e\x57\x95\xe7\x55\xc1\x1e\xc5\xdd\x8d\x4e\x30\x38\xb0\x5a\x9d\xd6\xe6\xda\xb0\xcd\x45\x51\xef\xe9\x36\x46\xdf\xfe\x06\xaf\x93\x79\xf6\xb5\xbf\x68\x5f\x0c\x80\x2f\x4c\x3c\x42\xac\x92\x32\x0e\x21\x12\xbc\xb0\x14\x2e\x9e\xb3\x95\xe0\xf5\x0e\x7c\x50\x21\x38\x03\x94\xed\xad\x7c\x10\xe5\x6a\xe7\xc8\xe4\xf0\xbf\xe4\x0e\xed\xb1\x6a\x56\xd6\x54\xb2\x82\x7d\x51\x7f\x9b\x16\x77\x5f\xd8\x1f\x2a\x21\x18\xcf\x2b\xc9\xbe\x6c\x78\x41\xed\xe0\xcb\x1f\x7b\x20\xd3\x8c\x83\x7a\x77\x04\x2f\xe8\xc0\x18\xb8\x34\xc0\x05\x5a\xb2\x19\xdb\x42\x33\x1a\x2c\xce\x83\x00\x77\x63\x51\x39\xfc\xc7\x21\xdb\x04\xdb\xf0\x3d\x86\xe8\xd5\x19\x1d\x84\x20\xc3\x40\x51\x05\x2f\x74\x51\xcd\x10\x17\x8a\x6d\xe1\xf9\x03\xdf\x83\xee\x23\x6c\xca\xf5\xa0\x42\x39\x62\x85\x78\x2b\x65\x24\x12\x0e\xfa\x62\x98\x06\xde\xc8\x90\x17\xf7\x72\xc7\x1e\x78\x51\x2b\xa2\x9a\xe6\x3d\xb0\xbb\xa2\xc1\x71\xb1\xb7\x67\x30\x63\x3f\x01\xa0\x85\xac\xd7\xec\x4b\x8f\x37\xbe\xe0\xc0\xa1\x09\x03\x9d\xd4\x52\xa5\x47\x4e\x00\x0f\x59\x51\x8d\x64\xe5\x38\x16\x1e\x62\xdd\x06\x63\xb0\x10\x20\x06\x1d\xa0\x8c\x55\xfb\xaa\x16\x1b\x74\x6d\x91\x05\x2a\xb3\xe0\x04\x66\x78\x10\x28\x0e\x12\x46\x96\x8a\xc0\x8a\x5f\x36\x70\x0b\xde\xf0\xaf\x10\xe7\xd2\x83\x78\xcf\x4b\xf4\xaf\xc0\x30\x94\x66\x42\xc0\x0d\x67\x76\x78\x82\x66\xbd\x66\xba\x6b\x2a\x26\xd1\x9f\x24\x79\x80\xa4\x63\x94\xc7\xe5\x76\xd7\xff\x65\x87\x8e\x17\x57\x3f\x68\x52\x9a\x69\xb2\x8b\xab\x1f\x98\xeb\x52\x1f\x1e\x8e\x86\x3c\x5b\x61\x88\xa0\x53\xdb\x70\x0d\xaf\xdb\xb3\x6d\x46\x2a\x19\xe4\x86\x13\x65\x63\x68\x90\xa5\xa8\xe8\xa2\xed\x81\xc9\x40\x06\x8b\x0c\x1d\x42\x39\x03\xcb\x1a\x90\x1d\x3b\x36\x87\xed\xc9\xf4\xdb\xa3\xff\xa1\xb2\xf5\xab\x1b\xc5\x04\x16\x71\x20\xb3\x1d\xab\xc0\xb6\xab\x20\x91\xa6\xa2\x3a\xeb\xf4\xb0\xea\xd4\xd8\x88\x0d\xbc\xb2\xea\x99\xfb\x4a\x19\xc2\xcf\x36\x2b\x20\xdd\x25\xaf\x50\xb6\x58\xe6\x11\x4c\xa2\x07\xef\xc2\x3a\xe3\x0d\x8c\x6c\x64\x9a\x19\xcf\x03\xd8\xbb\x9f\x42\xee\xf0\xce\x35\xf9\x08\x4e\xd9\x4d\x54\xc8\x95\xd6\x5b\xd0\x87\x15\x2c\x74\x5b\x51\xc2\xc2\x11\xb6\xb3\xc4\x09\x92\x31\x76\x82\xab\x82\x65\x91\xb2\x7b\x01\x16\x24\x56\xe5\x42\x6c\xd9\x1f\x0a\x89\xc0\xfe\x88\xa2\x05\x2a\xa5\x43\xe0\xd9\x2e\xcf\xf5\x10\x3e\x98\xe1\x17\x7e\xf8\x29\xb2\xc8\x64\x8e\x9f\xe0\x8a\xd7\x60\x09\x4b\x0d\x7d\x29\xbe\x4e\xae\x6c\x4e\x3c\xc2\xc8\x13\x0f\x54\xc6\xa6\xdf\x60\xa9\xf7\xd3\x37\x87\x4f\x5b\x6e\xad\xe0\xd9\x81\x99\x63\xc4\xae\x3e\xa7\x80\x33\xa8\xb3\xa7\x6f\xd0\x06\x1c\xc1\x37\xc3\x76\xe0\x03\x2c\xc1\x60\x62\xe2\x39\x68\x78\x57\x65\x26\xcb\x58\x71\x71\xdd\xe9\xd4\x58\x38\xf4\xce\xa3\xbd\xf3\xfe\xc3\xfb\xcf\x38\x84\x2f\xcc\xbc\x63\xa5\x6c\x22\xd2\xb6\x04\x5a\xad\x39\x3b\x85\x95\x7d\xf3\x06\x3c\x62\xe1\x38\x78\xa5\xfc\xb4\x44\x8d\x1d\xbc\x70\x81\xb9\xb4\x69\x30\x95\xc2\x54\x1b\x36\x5e\x6c\x66\x6a\xcd\x14\x0e\x67\x1e\xc2\x3d\x8a\x82\x3f\x11\x9d\x1a\xce\xff\x64\x68\x57\x4b\x3d\x49\xeb\x54\x3a\x74\x5a\xbe\xeb\x09\x59\xbd\xd4\x90\x8e\x6f\xde\xeb\x08\xfc\x23\xb6\x6b\xb1\x11\x25\xcf\x6f\x28\x62\x3e\x19\x40\xf7\x5d\xa7\x83\x39\xeb\x56\x19\xc4\x97\xc1\x82\x3f\x94\x59\xcd\x17\x39\x38\x36\xed\x45\x19\x0a\x6d\x40\x43\xc5\xdb\xac\x64\xf7\x32\xdf\x35\x9e\xa2\x74\x1e\x98\x3a\x0a\x07\x1c\x9b\x5b\x5e\xaf\xa3\x96\xef\x8a\xd7\x6b\x7d\x0d\x4d\xb3\x12\x2d\xf4\x7b\x6d\x7f\x69\x63\xe2\xb3\x60\xe8\x83\xad\x3b\x6d\x62\x57\x90\xc4\x19\x98\xdf\x8e\x2c\xfb\x31\x8d\x68\xc8\xef\x05\x4c\x99\x0c\xa8\x1b\x11\xc8\x0c\x61\x3c\xc0\x00\x85\xe3\x7a\xb3\x6d\xb6\x95\x17\xa2\xb5\xdd\xd8\x8f\x48\xf7\x59\x72\xa0\x24\xa3\x73\x2c\x8e\xd0\x74\xe6\xb5\x4f\x08\x7d\x12\x12\x62\x0a\xcb\x41\xc5\x04\x7c\x4a\xa0\x22\xdc\x32\xdf\xa5\xc6\x78\x4d\x84\xe2\x39\x5c\x94\xf7\x64\x25\x2b\xda\x0b\xeb\xc3\x94\x82\xa7\xf4\xd2\xc0\xd5\x4b\xa4\x8d\x95\x4d\x29\xaa\x90\x5e\x8b\x9d\x9e\x9c\x68\x39\x74\x47\x2f\x3b\x5e\x98\x10\x2d\x2b\xee\xb3\x50\xc6\x17\x13\x32\xfb\xcd\x89\xcb\x11\xac\x15\x3b\x7b\x9a\x38\xbf\x0f\x0a\x0e\x46\xdb\x2b\x6a\x99\x14\x47\x68\xfe\xb4\xc3\x8e\xba\x9b\x75\xa3\x12\xc6\x7a\x80\x9a\x58\xe2\xee\xae\x38\xea\x6e\x0b\xb0\x16\x64\x75\xa5\xe0\xe1\xc6\x8d\x5c\x25\x98\x54\x95\xfd\x5d\xff\x86\xa6\xa9\x70\x3d\x32\x22\xe6\xb0\x1d\x06\x4b\x47\x79\x29\x71\x08\x65\x46\xc7\x81\x44\x7a\xe0\x7e\x09\x0b\x70\xda\x09\x63\x05\xf8\x2a\xcb\xc5\x5b\x5a\x40\x77\x65\xeb\xd6\xf2\xbe\x6f\x35\x37\xc2\x5b\x3c\xae\xf9\xae\x22\x4b\x10\x80\x34\xbd\x7c\xa5\x15\xe4\xca\x5e\x5d\x52\xa5\x0f\x11\xd4\xcf\x2d\x42\x2a\xb9\xa2\x85\xf2\x80\xc4\xb9\x43\x46\x10\xe0\x3f\x51\xb9\x31\x69\x98\xb4\x23\x6b\xbc\x40\x5d\x94\x33\xc2\x08\x86\x6b\x92\x93\xd3\x18\x83\x32\xe1\xf4\xd7\x93\x09\xbf\x0a\x2f\xaa\x3b\xdb\x3c\x19\x58\xc6\x4b\x6c\xd6\xbf\x24\xdf\x67\x65\xbd\xe3\xb9\xbe\xfa\x1d\x76\x5f\xfe\xfd\xe9\xfd\x20\x9f\xa2\xd0\xbd\x01\x41\xd6\xec\x9d\x4f\x6c\xb1\xaf\x45\x05\x92\xb9\xda\x6d\x20\x2c\x5d\x94\xec\x7e\x43\x9a\xa4\xfb\x05\xc2\x8a\xa8\xd4\x12\xb8\x96\x35\xcf\x19\xbf\xe7\x59\x8e\xaa\x92\x5a\xbd\x19\xfb\x5c\x08\x50\x44\x68\xc0\x7d\xf0\xa0\x04\x14\xc0\x7c\xff\x6f\xb8\x19\x9d\x00\x41\x9e\x67\x05\x83\x5c\x26\xb8\x65\xcf\x8f\xd8\x9f\xcf\x8f\xff\x9c\x9d\xfb\x27\x7a\x79\x7e\x7c\x99\x9d\x1f\xb1\xef\xce\x8f\xbf\x83\xff\xde\x9e\x1f\xdf\x66\xe7\x07\x0a\xef\xdf\xd3\xa5\x40\x2d\xe1\x39\x2f\xd2\x87\x2c\x75\xeb\xd1\x2d\x6c\x2f\xdb\xed\x9b\x9d\x0d\xf6\x69\x48\x2e\x44\x2f\xd9\xd0\x8a\x2d\x74\x33\xff\xc5\xa0\xe4\xd5\x9a\x3a\x41\x28\x3b\x3d\x8e\x2d\xf9\x72\x2d\x0e\x90\x08\xd8\xef\x27\xbe\x8f\x5b\xb9\x0b\xdd\xda\x5a\xbb\x7e\xc8\x06\x9a\x9d\xe5\x2a\xc8\xd4\xdd\xa9\xd3\x91\x02\xb6\x5d\x42\x91\x6e\x04\x2b\xf1\x00\x97\x05\x00\xa9\x3e\x30\xee\x05\x5a\x6d\x20\x18\xbb\x34\xc5\xef\x61\x32\x88\x60\xeb\x26\xa0\x1f\x63\x60\x9a\x56\x21\x5c\x2f\x54\x2a\x89\x45\x90\x0e\x64\xc5\x17\xd9\x1b\x2f\x7b\x8d\x5e\x49\x4b\x88\xa6\x20\x10\xc5\xdb\x2c\x5c\xef\x18\xc3\x8e\xc1\x50\xc5\xb5\xa0\xf6\x48\xc5\x9e\xf4\x0b\xb3\x6a\x09\xbe\x05\x38\xa3\x9a\x7f\x15\x74\xe1\x31\x5b\xb5\xc5\x5c\xff\x8e\x3e\x44\x0d\x0e\x5e\xa8\x72\xe5\xdc\xc2\x16\x43\x1a\xc6\x53\x18\x1d\x2c\x9a\xb1\x41\xcc\x32\xdc\xee\xb7\xdd\x65\x78\xe0\xfb\xe6\x2d\xe3\x48\xd3\xd6\x88\xae\x70\xb5\x89\x8e\x48\x43\xeb\x2b\x22\x19\x96\x62\x3e\x44\x99\x4d\xe7\x30\x4d\x7c\x01\x8a\x94\x5f\x11\xfb\x7a\x3f\xfb\x44\x69\x14\xb1\xe9\x50\xf9\x1d\x9c\x83\xe0\xd1\xe0\x36\xf1\xb7\x99\x0a\x9b\xb9\xef\x52\x50\x2e\xaf\x7b\xc3\x90\x9e\x82\x06\xea\x7c\xa0\xeb\x2d\xde\xc5\x00\x6e\x75\xc0\x91\x47\xfa\x7b\xd4\x12\x45\x5d\xaa\x36\xfc\x51\xa3\x92\x0c\x54\x14\xf3\xde\x9c\xb4\x03\x0f\xe0\x84\x1b\x85\xae\x41\x81\x3d\xa6\xaf\x4f\xf0\x86\x43\xe6\x84\xad\x4c\x7f\x63\xf7\x26\xef\x27\x7c\x38\x99\x27\x81\xb5\xfb\x11\x5a\x00\xa9\xf5\xb4\x1a\x67\x0a\xc8\xbd\x00\x5f\xc0\xb5\xba\x96\xec\xcb\xfb\xec\x51\xa4\x57\x32\xbd\x94\xa9\xe8\x3f\x38\x1f\xeb\x06\x0a\x01\xd5\xee\x0b\x3b\x66\x5f\xae\x79\x91\xca\xcd\x25\x7f\x6c\x7f\xc2\x6c\x5a\x6d\xa0\x7d\xa6\xde\x96\xf2\x3e\x4b\x05\x24\x8d\x21\x8a\x9a\xaa\x3e\xf0\x24\x2f\x3b\x89\x23\x3e\xac\x9c\xb3\x08\xc0\xd5\x5a\x19\x3e\x2c\x9c\x4c\xe1\xdd\xab\x96\xf6\x8d\x03\x78\x82\xfd\x5b\x33\x2a\x79\x5c\x39\x96\x16\xae\x2a\xce\x19\x79\x08\x70\xd4\x9f\x46\x0f\xa6\x7f\x5a\xff\x16\x20\x44\x12\x25\x8f\x5d\x8c\x38\xed\x96\x3f\x9a\xba\x8a\x6f\x39\xf9\xad\x49\x91\xeb\x64\xb4\x4b\x89\x3e\x67\x80\x7f\xbe\x6f\x4a\x13\x34\x39\xf2\x8d\x6b\xf1\x61\x99\x19\x75\x9e\xdd\x10\xb3\x5f\x98\x66\xdd\x6c\x7f\x14\x40\xad\xa6\x05\x8e\x6c\x03\x49\xc3\x3c\xca\x64\x7b\x34\xc0\xc3\x0c\x49\x33\x59\x40\x80\x7d\x41\xf8\xb7\x5c\xd5\x9c\xe3\x0c\x09\x6a\x50\x0b\x6e\x4b\x5e\x54\x38\x86\x3f\xe1\x78\x6b\x62\x1f\x7b\x9d\xf4\x4d\x1c\xc0\xa1\x6e\x46\x27\x2a\xad\x8d\x13\x24\x45\x03\x19\xfc\x96\x6b\x5e\xdc\xf9\x2c\x9b\x31\x59\xa6\xbd\x8c\x1a\x95\x23\x7f\xa0\xaf\xbb\xfc\x43\x54\xd7\x3e\x63\x47\x77\xf5\x6b\x95\x01\x4e\xb9\xdd\x6f\xcd\x82\x00\x00\x60\x44\x4e\xfb\xdb\x30\xfa\x6c\xfc\x74\x5c\xfb\xdd\x6c\x72\xc4\xd1\xf1\x01\x20\xf6\x7e\xed\x3d\x6f\x42\x77\xa4\xfe\xdd\x68\xc3\xb7\x53\x88\x2c\x9a\x27\x11\xa3\xfa\xae\x56\x1b\xde\x76\xf6\x69\xe4\xc6\x3c\x09\x90\xfc\x9d\x69\x46\x3e\xf1\xd6\x06\x68\x40\xe0\x16\xe8\xe9\xda\xa1\x2d\x49\xfe\xd6\x98\xf3\x75\x9e\x0c\xad\xbb\xd5\xd8\x64\xa9\x6b\x7b\x5d\xfa\x12\xbf\x3a\x40\x33\xed\x0c\x43\x73\x60\x77\x30\x89\x59\x32\xea\x2a\xec\x9a\xdf\x95\x4c\xa9\xc0\x89\x25\x35\x39\xcc\x4f\xab\x54\x34\xa2\x13\x22\x65\x01\xd7\x8f\x36\x46\xd4\x51\x5c\x41\x93\x7b\xd3\xd9\x3b\x2c\xfe\xe0\x47\x15\x82\xf5\x7d\x1d\xd8\x13\xe4\xa0\xc2\x37\xe2\xc9\x00\x82\x35\x4b\xa3\xa0\x60\xe9\xd9\x27\x40\xf0\x6f\xf0\xdf\x43\xc9\x5c\xed\x24\x3c\x4f\x46\x92\x2e\x50\x38\xe6\x89\xc5\x11\x18\x25\x7f\x85\xc2\x13\x65\x5a\x0d\xca\x83\xf7\x76\x6b\x23\x10\x2c\x39\xd0\x6c\x1e\x93\xbf\xd6\x01\x93\x75\x72\xda\x66\x45\xaf\x3a\x9e\xf2\x90\x43\xc8\x59\xc1\xae\xa4\x3d\xa8\x13\xa2\x76\x88\x6e\x5c\xad\xd5\x04\x74\xb5\xbd\xac\xc9\x5a\xfb\x04\x81\xa3\x08\x10\x90\x37\x1d\x32\x38\x21\x32\x5f\x72\xdf\x03\x05\x0c\x26\xe0\xf5\x7d\xec\x20\xf0\x0e\xda\xea\xc3\x5b\xe9\x1c\xec\x61\xbd\x77\x2d\x9c\xcb\xa5\xb4\xf9\x63\x2d\x1f\xf1\x80\xb7\x71\xa4\x7c\x9a\x3f\x15\xc0\x53\x05\xdc\x90\x78\x42\x3a\x3f\xb7\x74\xfa\x47\x4a\xa0\x6c\xb3\xe5\x4b\xe7\x3b\x74\x8b\x47\x3e\x60\x33\xcd\x24\xaa\x13\x93\x8e\x0a\x96\xa2\xaa\xb3\x0d\xf7\x39\x48\x58\xe1\xb1\xfa\x3c\x35\x71\x0d\xb3\x64\x3c\xa3\xa7\x62\x9b\xcb\x3d\x68\x3d\xde\x9d\xd0\x42\xe3\x6d\xd3\xde\x88\x2a\x0b\x86\x25\xb1\xb4\x46\xe3\x81\x4a\xb9\xb0\x4d\xf2\x08\x4f\xb3\x80\x0c\x09\xcc\xad\x4d\x6c\xfd\xe6\xc1\x2d\x6c\xbd\x10\xb5\x39\x0d\x5e\x43\xd0\x61\x96\x5e\x38\x02\x82\x27\x46\x9c\xc0\x8f\x86\x17\x6a\xd3\x41\xe9\x4c\x4f\x21\x73\xbd\x26\xb5\x94\xc6\x20\x50\x63\x54\x8f\xa2\xc0\xb0\x39\x2b\x4e\xc4\x44\x09\x88\x68\x51\x33\x02\x1a\xd9\xd3\x46\x10\x9a\x8c\x34\xfe\x4a\x0d\xa5\xd8\xe6\xd9\x92\x57\x41\x90\x9a\x79\x60\x67\xe8\xd5\x7e\x16\x3a\xeb\xd1\x47\x60\x74\x4d\x5d\x34\x4a\xa9\xa8\x40\x0e\x77\x58\xe8\xd9\x39\x47\x2b\x4f\xbe\xbc\x71\x63\x71\x0f\x9f\x1f\xf0\x33\x1d\x26\x75\xe0\x28\x89\x39\x4e\x86\x8c\xb4\xba\xc5\x20\x8f\x0c\x9c\x4b\xc3\x67\xd3\x01\xe7\x93\x16\xb5\xf3\x24\x82\x69\x74\x2a\x90\xc3\x64\xcd\xf0\x8a\xaa\xa8\xd3\x77\x54\xd0\x06\x0a\xdf\x64\xa6\x12\xd3\xc0\xd4\x6e\x9c\x5d\xcd\x21\x44\xd1\xa7\xd5\x11\xdd\x4e\x3d\x20\x95\x51\x0a\x90\x9a\x98\x35\x3f\x86\xff\xc3\x04\x12\x12\x43\x8c\x72\x48\x17\x01\xcf\x07\xa6\xf0\x0e\x86\x79\x89\x64\x60\x87\xc4\x90\x68\xe0\x3c\x8b\x90\x6f\xbf\x0a\x83\xdc\x67\x12\x8c\xa1\xe9\xdb\xac\x2a\x77\x48\xf0\xf3\x5d\x0a\x7e\xaa\x51\x4b\xf3\xa3\xaf\xb7\x59\x9d\x2b\xd9\xff\xd8\xb7\xcf\xeb\x3f\x74\x75\x09\xad\x14\x48\xd9\x07\x8a\xd1\x32\xb3\x0f\x3d\x80\x7f\xcd\xf2\xdc\xb8\xfc\xda\x0b\x65\x3f\x2b\x1b\xdd\x0b\xb8\x04\x96\x71\x0a\xdd\x66\xff\x4a\x6b\x19\x92\x97\x53\x1f\x67\x06\xe5\x92\xb3\x4e\x6f\xc0\xb4\xd6\xae\xda\xeb\xa9\x8a\xdb\x58\xdb\x66\xc9\x48\xd2\x6d\xcd\xbd\x75\x9e\x8c\x5a\x94\xd6\x7c\x9d\xd7\x4d\xc8\xff\x0a\xc7\x17\x18\xe8\x29\xcb\xb4\xb1\x88\x3b\x41\xb2\x26\x79\x56\x56\x44\xa1\x16\xa3\x2c\xf2\xa5\xcf\xa4\x11\x41\x9e\x67\xb3\x8f\x05\x0d\xef\x3d\x7a\x9e\xb1\x45\x99\x89\x55\x53\x61\x4c\x1b\xee\x75\x34\x3e\x6c\xbe\x54\xd4\x90\xee\xc5\x0b\x91\x59\x54\x6f\xbf\xa8\xa9\xe0\x73\x95\x22\x5d\x95\x47\xdb\xca\x54\x85\x10\x6e\x79\x28\x72\x90\x99\xd6\xc6\x56\xc4\x5e\x6f\x26\x89\xb7\xf5\xbf\xc8\xbd\xfa\xe9\x86\x43\xe4\xc4\x3b\x5f\x52\x13\xd7\x96\xa1\xe6\x7a\x6f\xeb\xee\xfa\xfc\xeb\x56\x6f\x82\xe7\x36\x8c\xf9\xf5\x82\x67\xe6\x6a\x45\x69\x67\x9e\x78\xc3\x32\xc1\x02\xc1\x56\x1d\xb4\x2e\x4c\xa7\xbe\xe6\x83\xce\x4b\x4d\xe5\xa9\x5a\xaa\x1c\x64\x66\x9c\x38\xd5\x39\x64\xc7\x89\x38\x48\x42\x73\x0e\xad\x89\x59\x0f\x1e\x3d\xe1\x38\x32\xd3\x56\x55\xc6\xaa\xe1\x86\x9d\xb9\x9f\x11\x31\x23\x88\x1d\xd2\xe3\xec\x3f\x06\x3d\xf4\x0f\x81\x84\x02\x26\x71\x03\xdb\xf7\xca\x59\x1c\xa6\xba\xda\x7f\xb2\xf1\x58\x7f\x78\xab\x97\x28\x4b\xcd\x86\xd1\xd3\xa6\xe4\x1a\xa9\x5c\x7e\x15\xe5\xfc\xf8\xf8\xf1\xf1\x31\x02\x7e\xc4\x0e\x8f\xd1\x0a\xec\x3f\x53\x4d\xfd\xc1\x76\xce\x54\xf6\xd1\x3a\xc5\x38\x25\xe8\x60\xb5\xb6\x79\x2e\xff\x01\x8b\x60\xfa\x9f\xca\x9d\x6b\xf6\xb1\xd5\x51\xaf\x9f\x5d\x9b\x02\x40\xab\xcc\x5b\x51\x97\xe2\xd0\x8b\xc2\xe8\x75\x1d\x3c\x9f\x7b\xf8\x5c\xea\x13\x99\x82\x08\xc1\xf4\xda\xc7\xc4\x04\xaf\xbd\x77\xd7\xb0\x6b\xff\xc8\x92\xbd\x7b\xcc\xc2\x42\x7e\x04\x4a\xbe\x42\x59\x5e\x8c\xec\x92\x59\xaa\xb6\x95\x11\x7d\x3a\x95\x59\x63\x93\x04\x24\x83\xc0\x99\x26\x41\xc5\x96\x9c\x9c\x4c\x69\x73\xde\x88\xfa\x93\xa8\xc5\xe6\x39\xf0\xf4\xaa\xd4\x1e\x34\x5b\x2a\x35\x76\xee\x2f\xdb\x91\xce\x64\x41\xf2\xd5\x7f\xbd\x52\x3f\x6a\x75\x8f\x74\x2d\x1b\xca\xf2\xf6\x7c\x6b\x09\x6e\x7f\x63\x50\xcc\xec\xc7\x2f\xed\xe1\xa7\x0b\xc4\x98\x5a\xca\x64\xf4\x0e\x5f\xf1\x69\x02\x32\xb5\xd3\x82\x91\x9b\x65\xf5\xf4\x93\x78\x28\x45\xff\x61\xe7\xca\xaf\x2c\x06\x87\x4f\x80\x69\x47\x50\x06\x1a\x76\x6b\xb7\x8f\x96\xfc\x21\xc7\x9c\x1e\x6b\x5c\x63\xe3\xce\xfb\x1a\xf1\x3f\xba\x6a\x58\xcb\x6c\x54\xeb\x24\x46\x2b\x03\x98\x0b\xa1\x6e\x26\xb9\x08\x31\x7e\x04\xd3\xe3\x54\x22\x51\x02\xcf\x0a\xb3\xa1\xb1\xa3\x3d\x2b\xe3\x27\xb7\xd8\xdb\x8e\x16\x79\x90\x83\x9a\xb2\x42\xe6\x61\x88\xce\x72\xaa\x76\x07\xaf\x4b\x7b\x10\xee\x85\xaa\xf5\xc9\xd6\x7c\xab\xc2\x0b\xbd\xaf\x2f\x96\x7b\xee\xe1\x94\x09\x73\xde\x94\xae\x78\xbf\x4b\xef\x05\xff\x36\x08\x52\xd5\xe4\x5b\x9d\x27\x43\x7c\xa6\x5b\xb6\xfc\x9d\xe4\xae\x5e\xca\x8d\x0a\x61\x25\x58\x64\x19\xf3\x5a\x2e\xb3\xa2\xf7\x52\x39\x4b\xc6\xdf\x1f\x56\x59\x5e\xc3\x59\x73\xbe\x37\x65\xf9\xe7\x49\xc4\x7e\x79\xdf\xef\xd7\xbf\x3f\x60\x12\xfb\xb0\xf9\xb5\x99\x81\x8e\xa9\x35\x8c\xc2\xb6\x98\xb7\x39\x39\x54\x8e\xdf\xc9\x22\x0e\x97\xef\x64\x31\x30\x79\x93\x88\x6e\x21\x96\x41\xe3\x86\xce\x55\xd4\x92\x61\x8c\xe3\x93\xaa\xce\xa3\x92\x95\x0d\x8e\x58\xf0\x14\x82\x1a\x20\xbb\x4f\x75\x30\xaa\x34\xd7\x28\x6c\x2f\x09\xaf\x1e\xc2\xc0\x6a\x0a\xeb\xb6\x59\x56\x96\x07\xcf\x0b\x6a\x84\x8a\x65\x1d\x39\xb3\x2b\xdd\x7a\x60\x31\x0c\x54\x0f\x50\x34\x62\x5c\x80\xcc\x25\x88\x81\x70\xb4\x61\x1c\x2a\xbe\x12\x77\x3b\x5e\xa6\x91\x58\xdc\x34\xed\xfb\x78\x2c\xd7\xb2\x12\x45\x8b\xa7\x3c\x30\x99\xde\x0f\x66\xfc\xc6\x84\xa0\xed\xed\xbc\x14\x90\xcc\x60\xa9\xf2\x37\xc0\x3b\xcb\x0d\x19\xd2\x0f\xc7\x56\xe4\xf1\x0b\xa6\x47\xeb\xe3\x89\x08\xae\xb2\x82\xe7\xf9\x5e\x23\x1d\x78\x0b\xd0\x71\x0e\x07\x4e\x3b\x74\xa0\x4d\x5d\x22\xce\xd9\x8e\x78\xcc\xf9\x2d\xc4\x72\xda\x37\xdf\xf9\x31\x78\x78\x99\x63\x03\xd3\xd4\xcf\x93\x28\x72\xeb\xe6\xad\x03\x84\xb2\x92\x6b\x9d\xc5\x00\x4e\xfc\x8a\x89\x3f\xad\xfd\x01\xc7\x08\x8d\x1f\xc5\x35\xd7\x34\xd7\x1e\xd3\x58\x88\x1c\xc8\x08\x90\x22\x91\x97\x75\xc8\xaa\xd0\xa6\xa8\x6e\xed\x32\x25\xa8\x6c\xf7\xe4\x28\x16\xa6\x68\xbc\x41\x61\x40\x39\x0b\x73\xb2\x9f\x38\xd3\x06\xf1\xd1\x4c\x48\x29\xd1\x9d\x63\x3a\x93\xa7\x2b\xe7\x97\x12\x02\x96\x56\x3d\x25\x84\x3d\xf0\xaa\x81\xe9\xbe\xf3\x66\xb6\x09\x00\x45\xc5\x83\xb0\xd2\xa9\xe3\xbd\xd7\x54\x6b\x5b\xb4\x9e\x1f\x1e\x78\xe5\x4b\x89\xa4\xbd\xae\x80\x3f\x24\x74\xd9\x8c\x4f\x06\x4d\x89\x7d\x87\x49\xa1\xda\xb5\xf6\x60\xd7\xfa\xde\x24\xf7\xc5\x9b\x40\xe2\x8d\xdd\xe8\xba\x8d\x19\x04\x28\xe3\x6f\x21\xe0\x2c\x00\x25\x45\x1f\x09\x34\xba\x2e\x5a\xe0\xc2\x13\x52\xb0\xbe\x82\xb7\xb4\x8d\xbc\xd7\x19\x74\xdb\xa9\x7d\x4b\xf1\x24\x47\xb5\x42\x3c\xc6\x6f\xb4\x4f\xe2\xb1\xbf\xcf\x8c\x13\x6b\x8f\x02\x1e\x90\xac\xa1\x0c\x8c\x1e\xb8\x05\x3d\xd3\x76\x84\x00\xcc\x02\x12\x53\x45\x21\xa9\x6b\xee\x38\x05\x9b\xcb\x7d\xd8\x03\x14\x33\x63\xdd\x49\x00\xa5\x7c\x67\x35\x59\x10\xfd\x03\x45\x63\x58\xb2\xe8\xc5\x74\x7e\x24\x1a\x8c\x16\x2c\x5a\x22\xcd\x93\xc3\x16\x28\xb0\x38\xde\x81\xb3\xa2\xaa\x79\xe1\x70\x61\x89\xab\x0c\xd4\x5a\x4f\x15\x08\xfc\x81\x20\xc2\xa2\x60\xfc\x23\xfd\xf5\x4e\x14\x02\x03\xcb\xc9\x92\xe5\x90\xcb\xe1\x2d\x34\x70\x54\x75\xa6\x42\x6d\x29\xf8\x10\xce\x4f\xb3\x7d\xcc\x94\x10\x62\x95\x1c\xbe\x21\x06\xb6\xc3\x2e\x4b\x23\xe6\xfa\x83\xf5\xb2\x62\x68\x97\x8a\xa2\x86\xa0\xe4\x72\xfc\xb8\x01\x26\x6b\x8d\xab\x17\xaa\xd2\xd9\xa4\x1b\x52\xa9\x15\x82\x0d\xa5\x69\x55\x25\x91\x83\x68\xd3\xcf\x77\x6a\xb9\x1d\x77\xfc\xd6\x24\x3e\xf7\x9a\x6b\x5a\x10\xbf\x58\xa1\x86\x30\x3f\x4f\x8a\x3c\x6d\x85\xda\xa1\x58\x34\xe6\x27\x88\x43\x55\x31\x03\xd6\xc7\x5d\x0d\x8b\xda\xa4\xb8\xcb\xe1\x45\x18\x52\xdc\x39\xa1\xf6\xa7\xe1\x8c\x78\x0c\x99\x54\xfd\xa2\xc6\x69\x46\xf7\x1b\xce\xa9\xd4\x11\xa2\x32\x4b\x22\x59\xc2\x6d\xa2\xf1\x36\x37\x49\x44\xa3\x6a\xa5\x93\x07\xca\x80\xaf\x8c\x37\x31\x69\x68\xc3\x53\xb9\xd9\xfe\x07\x77\x99\xe1\x8e\x7a\x5f\xe1\xb3\xb7\x5c\xd9\x61\xb2\x7a\x1a\xee\x43\xb0\xdc\x15\x54\x88\x46\xe6\x29\x34\x47\x7d\x76\x96\x8c\xb2\xb1\x3b\x89\x04\x0f\x14\xa5\xb9\xf4\xa9\x79\x52\xc5\x09\x3d\x23\x57\x95\xaa\x61\x12\x0d\x06\x02\x39\x26\xf5\x4e\x85\x0d\xb9\x15\x0b\x48\x37\x00\x29\xb4\x2b\x47\x41\x9c\x3e\xaf\x87\x65\x63\x90\xcb\x46\x3c\x02\x46\xc0\x50\xc5\xa3\x23\x09\xd0\xac\x4a\x45\xf9\x46\x1a\x8e\x89\x5e\x95\xc8\x89\x19\x48\x23\x16\x48\xcf\x6f\x60\x99\xf0\xda\xa0\x9b\x7a\x21\x63\x7d\x48\x3c\xe6\xfe\x41\xcb\x39\x70\x48\xf7\xb1\xd5\xed\xdd\x98\x9a\x4b\x4c\x50\xf5\x7e\x76\x3c\x42\xca\x1f\xa8\x7f\x8a\x5b\x3c\x1f\x5b\x8b\xee\x6c\x13\x38\xa1\x9f\xdd\x42\x0f\x9a\x2a\xbd\x90\xce\x93\x81\xc5\x80\x5b\x47\x6b\x01\x32\xed\xbf\xc6\x1e\xb2\x1c\x6a\xbd\x35\x6e\x29\xc9\x61\x0b\x10\x24\x3d\xcc\x15\x6b\xa4\x3f\xc7\x4c\xe9\xed\x48\x55\x04\x7c\xfe\xd9\x7a\xd6\xd0\xc5\x39\x53\xcb\xa3\x32\x09\xbd\x42\x3a\x60\x76\xe1\x4d\x51\xa1\x4d\x9c\xed\xef\x45\x59\x21\x75\xee\x4f\x79\xbe\x5d\xf3\xd3\xe6\x77\x78\x5c\xa9\xf7\xa6\xd6\x67\xa8\x14\x09\x9a\xd2\x1c\xed\x13\xea\x17\x94\x0f\x5b\xfd\xa6\xc9\x22\xc0\x97\x50\x95\x45\xa4\xf8\xee\x01\xbf\x00\x97\xe4\x22\x9d\xb3\x89\xf2\x45\xdc\xe6\xbb\x92\xe7\xf4\x57\x2b\xb3\x05\xfb\xcf\xbf\x26\xa0\xbc\xcb\x52\xa4\x3f\xea\xd9\xc0\x2f\xa7\xd3\x69\xc2\xb7\x19\xfd\x6e\xce\xf8\x36\xc3\xdc\x68\xd8\x62\xf6\xf5\x4f\xd5\x2c\x93\xc7\xf7\xa7\x0b\x51\xf3\xd3\x44\x0d\x75\xb1\xab\x6a\xb9\xb9\x16\x95\xdc\x95\x4b\xf1\x16\x0a\x85\xe0\x28\xc9\x46\xd4\x50\xed\x8a\xc3\xbc\xac\xaa\xe2\x73\xac\x2a\xde\xbc\x3e\x4e\xef\x44\x31\x83\xed\xb3\xd8\x65\x79\x2a\x4a\x1c\xc1\x50\xed\x64\xf6\xcd\xec\x35\x4c\xbe\x14\x5c\xa7\x98\xa8\x6a\xbe\xd9\xce\x59\xb1\xcb\xf3\x84\x1c\x27\x71\x87\xa0\x60\x9a\xe1\xbf\xa7\x1b\x51\xad\x67\xb2\xbc\x4b\x60\x69\x60\x48\x8c\x1c\x9f\xb3\xce\x57\x72\x9b\xb4\x49\x07\x82\xef\xc2\xdc\x6c\x21\x54\xef\xcf\xed\xdf\x7f\xcc\xaa\xba\x45\x5d\x33\x76\xa2\xa3\x11\x76\x39\x2f\xdb\xbf\xaf\x96\x12\xf8\xc8\x58\x6f\x41\xdf\xa9\x76\x8b\x92\xc8\x46\x8a\x04\xad\x2c\xfb\xdf\xff\x07\xd8\x84\xe7\x59\x6a\x69\xeb\x90\xb6\xf5\xec\xea\xc3\x8f\xdf\xc2\x69\xb4\xe1\xf3\xc4\xb1\x07\xcd\x24\xb5\xe8\x56\x6d\x8d\xa7\x83\x99\x12\x3b\xbb\xfa\x90\xf8\xb5\x19\x8b\x07\x12\xcf\x76\x7f\x05\x73\x51\x6d\x4c\x91\x16\x18\x91\xb8\x1b\xec\x8d\x6a\x6c\x54\xf8\x32\xcb\x25\xba\x5f\x80\x09\x8e\xf8\x82\x36\xcd\x8c\x41\x04\x87\x68\xea\xa5\x2c\x65\x71\x2f\x4a\xe5\x7d\x77\x57\x64\x7f\x37\x90\x4d\x09\x11\x88\x13\xe8\xf8\xe0\x80\x66\x5f\x16\x3c\xd7\xb5\xc8\xc0\x1c\xb7\xe1\x10\x83\x01\x63\xb0\x5d\x61\x41\xc3\x26\xd5\x8c\x5d\x42\x5e\x6d\xf0\xd6\x9e\xb3\x75\x5d\x6f\xab\xf9\xf1\xf1\x5d\x56\x6b\xae\x5f\xca\xcd\x06\x52\xdb\xed\x8f\x91\x77\xb3\xc5\xae\x96\x65\x75\x9c\x42\x22\xb5\xe3\x2a\xbb\x9b\xf2\x72\xb9\xce\xe0\xf1\x67\x57\x8a\x63\xbe\xcd\xa6\x38\xf1\x02\x90\xad\x66\x9b\xf4\xbf\x99\xb5\x7e\x95\x0c\x48\x33\x64\x44\x2f\xdd\x81\x1d\xa9\x52\x2d\x76\xa3\x9a\x16\x86\xbc\xfa\xe5\xeb\xfa\xdd\xcd\x2d\xd3\x83\xe2\x12\x24\xfd\x72\xee\x96\xa3\x7a\x43\x78\x20\x54\x56\xac\x04\xa4\xbf\x84\xcc\xd8\x90\x39\x08\x20\x9a\xd0\x15\xf8\xcb\x32\xcf\xba\x1e\x6a\xd5\x6e\xb1\x81\xdc\x7f\x20\x20\x45\x05\x26\x79\x39\x63\x17\xb8\xf7\x41\xfa\xef\xd0\x0b\x24\x9d\xb1\x0f\x45\xe3\xd1\xfd\xab\x93\x1d\x28\x5c\x4d\x81\xa4\xc3\x84\xb7\x45\x16\x63\x4e\x81\x4e\x98\x92\x54\x71\xae\xd0\x0d\xdc\x89\xed\x2d\xb1\x10\x6b\x7e\x9f\x49\xb4\xa3\xf1\xc3\x33\x13\xe5\x72\xf9\xf5\x43\xdf\xff\xa9\x35\xf6\x05\x35\x32\xe3\x83\xbd\x54\x87\xb2\x29\x10\x2c\x4b\xd9\x59\x6e\x27\xb5\xf5\x65\xbb\x04\xcf\xa9\xff\x9c\x5c\x7c\xfc\x7c\xf1\xe7\x5f\xae\xdf\x9d\x7d\xbc\xfd\x70\xf9\x6e\x72\x44\xbf\xb8\xfc\xfc\xe9\xf3\xed\xe7\x4f\x1f\x2e\xcc\x6f\xae\xae\x3f\x5f\xbc\xbb\xb9\xf9\xe5\xe2\xea\x07\x68\xf9\xcb\x87\xb7\xe6\xd3\xed\xf7\xd7\xef\xce\xde\xb6\xbe\xf4\x46\xeb\xc2\xfd\xe5\xfa\xec\xa7\xc9\x51\x67\xf8\x5f\x2e\x3e\x9f\x5d\xdf\x38\x66\xd1\xfd\x70\xfe\xf9\xf3\x6d\x6b\xbe\x06\xc2\xd9\xc7\xb3\xeb\x4b\xff\xf8\xba\x23\xb5\xfb\xab\xc9\x82\x78\xaf\xf3\x9b\xf5\x48\xf2\xd7\x24\xea\x32\xea\x64\xb9\x21\xf5\x72\x84\x6a\x69\x1c\x96\x2d\x75\xc0\xc7\x26\x76\x53\x1d\x4c\x21\xda\x69\xf6\x1b\xae\xd1\x8d\xfb\x7a\xfe\x07\x74\xfc\x81\x12\x0e\x47\xc8\x69\xa6\x69\x65\x74\x3e\x7d\x49\xf8\xe7\xa1\x91\xce\x93\x43\xe1\xb9\xf3\x24\x32\xaf\xce\x60\x38\xaf\x79\xfc\x71\x3a\x8b\xf8\xf2\xef\x38\x73\xed\x1c\xb1\x4a\x22\x4c\xc8\x9d\xe9\x05\x09\x79\xe2\x16\xd0\x73\xb3\xe5\x25\xd5\x36\xc6\x5e\xbb\x42\xf7\x93\x85\xa0\x22\x56\x2d\xf8\x70\x80\x78\x2b\xf8\x66\x45\xab\x10\x30\x9c\x9d\xdc\xae\x6c\x46\x4e\x6e\x34\xc4\x2c\xf1\x64\x3e\x7c\xf3\x26\xf1\x24\x3c\x3c\x89\xb6\xc6\xf9\x52\xb1\xb4\x16\xe9\xad\x8e\xc1\x69\x0e\x33\x44\xc0\xc4\xe6\xc8\x55\x2f\xfa\x27\x89\xe4\x3b\x78\x3b\xdd\x95\x22\xa2\x5e\xfb\xab\xf7\x76\x53\xcb\xc8\x4d\xc5\xda\xd7\xbc\x48\x5b\xce\x74\x6e\xb3\xa9\x27\xe3\x08\xab\x64\xf3\xac\x0b\xcc\x30\x63\x3f\x82\x82\xa8\x4b\xd7\xf3\x52\xcc\xd9\x94\x4d\xce\xf2\xfc\x73\xf9\x49\x39\xe3\x4d\xba\xf3\x65\x0c\x74\xee\x0a\x0b\xaa\x5b\x13\x01\x93\x6c\xeb\x61\xad\xa9\x73\xd9\x9c\x50\x98\x0a\xa5\xfa\x8f\x1e\xc4\x29\x9b\x9c\x8b\xaa\x7e\xb7\x5a\xc9\xb2\x9e\xcc\xd9\x57\x21\xb6\x95\x33\x74\x47\xbf\x1d\x65\xf5\xab\x57\x4d\x44\x86\xab\xc0\xb7\x63\x74\xcc\xcf\x29\xd2\x8a\xed\x0a\x34\x0e\x67\xb5\x97\x52\x3c\xef\xd7\xd7\xd0\x53\xc0\x54\x91\x6a\x02\x72\x93\xd5\x58\xf8\xda\x85\x27\x12\x00\xab\x30\x36\x19\x6a\x7a\x40\xcd\x1b\xa4\x1e\x1c\xaf\xe8\x0a\xba\x4e\x61\x83\x5b\x0f\xf3\x91\x18\xd3\x27\xcd\xdb\xb9\xa3\xed\xca\xda\x3a\x64\x55\xdd\x0a\x7a\x25\xd9\x5d\x49\x77\xa7\xcc\x5e\xff\xde\xc7\x66\xa1\x7a\x9f\xe8\xc6\x18\xb1\x23\x5c\x95\xe6\x5a\x1b\x01\x2b\xcc\x91\x90\x84\xc6\x7a\xd9\x2b\xfd\x7e\xa0\x2d\x00\x55\xdd\x54\xf8\x85\x40\xba\xfe\x94\x99\xb6\xad\x55\x41\x4b\xbf\x4f\x65\x82\x9f\x85\x4c\x87\xab\x40\x9c\xcb\xd4\x54\x46\x86\x0e\x7a\xb4\xef\x6f\x6f\xaf\x8c\x3a\xdb\x1d\x35\x48\xa5\xa1\x3c\x52\x6e\x01\x46\x53\x80\x44\xa1\x5d\xe1\x05\x94\xb4\x6a\x0b\xbf\xde\x04\x8a\x78\x43\x5b\x74\xab\x84\xed\x52\x67\xb9\xb1\x50\x0a\x28\x59\xb9\x30\xb5\xaa\x47\x63\xb4\x16\x3c\xf5\x04\xc2\xc5\x3d\x4e\x0e\x8e\xd0\xa1\xcc\xf7\x6a\x40\xe3\xc4\x4f\x13\x70\xae\x0f\x55\xd9\x71\x8e\xa9\x35\x7b\xdd\xf1\xee\xfa\xea\x02\x0b\xd1\x57\x7e\x1a\x74\x74\xfe\xe1\x78\x92\x40\x14\x09\x4e\x54\xf5\x3c\x6a\xf6\xc3\x77\xef\x6e\xad\xd5\x38\x4a\x3c\xb1\x30\x30\x61\xac\x9f\x68\x57\x40\x42\x0c\x7a\x10\x27\xc7\x77\xe5\x76\x39\x5b\x0b\x9e\xd7\xeb\xd9\xfd\xe9\xec\x7b\xfc\xbf\xe3\x8b\xb5\x58\x7e\x75\x07\xa8\x5a\xec\x80\xcc\x23\xb1\xce\xa6\x05\x1e\x0f\x17\x5d\xb5\x1f\x55\x0c\x28\xfe\x8b\x8e\xdf\x44\xfa\xd1\x7c\x04\xbe\x68\x72\x29\xf3\x41\x2a\x5e\x51\x43\x4d\x47\xdd\x51\x53\x01\x38\x9d\x5c\xd1\xe1\xce\xee\x0b\xd6\xd7\x08\x3a\x3e\xba\xc4\x28\xb9\x89\xd7\x75\xbf\xfa\x2b\x7c\x00\x12\x8f\xc5\xf8\xbf\xb6\xd5\x20\xb2\x7f\xb9\xba\xe9\x3b\x5e\x18\xee\x86\x4b\x3a\x28\x9d\x54\xf0\xda\x4f\x73\x97\x1e\x05\x3f\x2a\xe5\xf7\xe0\x2c\x6e\xb1\x99\x9e\xc8\xae\xcc\xbb\xe5\xe1\xc1\x98\x04\xc4\x36\xd2\xc8\x01\x91\xb1\x09\x34\x99\x1f\x1f\x2f\x81\xf3\xa0\xe6\x6e\xb5\x96\xdb\xf9\x9f\x4e\xfe\x74\x72\xac\xb8\xf3\xef\x13\xac\x8b\x95\x41\x46\x87\x34\x85\xc7\x6d\x34\x53\x01\x71\xdd\x3b\xc1\xc8\xbe\x36\xc8\x37\x27\x6f\x4e\xc6\x56\x57\x67\x78\x0b\x97\xbb\x08\x6a\xa8\x76\x9a\x1c\xd0\x8d\xb2\xa2\xc3\x64\x31\x70\x97\x56\x68\x80\x1c\xa7\xd5\x84\xb2\x42\xbc\x7e\x82\x08\x0e\x94\x85\xdf\x76\x07\x9e\xd2\x8a\x27\x91\xc2\x6d\x23\xd3\xde\x13\x51\x8b\x16\xaf\x20\x5f\x77\xcb\xb2\x01\x5d\x40\x01\x83\xb3\xa5\x1d\x5b\x7f\x63\x6a\x33\xbb\xc0\x32\xbc\x47\x1c\xa3\xd2\x79\xcc\x56\x90\xb9\x5b\xff\x77\x4a\xf7\x2a\x76\xcc\x4a\xcc\x76\x3d\xdd\xf0\x47\xfd\xcb\x57\x49\x24\xa5\xe8\x4d\x64\x7f\xeb\x5e\xe6\x16\x5a\xd7\xed\xb6\x9e\xa5\x26\x88\xda\xba\xd6\x18\x7c\xed\x3f\x5a\x01\xac\xb4\xdf\xbc\x61\x0a\x75\x62\x83\xf6\xa9\x1f\x9c\x61\x48\xca\x5c\x0a\x17\x38\x6c\xce\x1c\xcf\x22\x30\x8f\xa3\x66\x4c\x98\xde\x12\x1c\xff\x44\xca\x76\x5b\x98\xda\x32\x5b\xe4\xfb\x59\x2c\x65\xf4\x53\x58\x39\x4f\x22\x5e\x3c\x4b\xb3\xdc\x78\xff\xd0\x9d\x59\xb9\xcb\x81\x05\xa4\xb9\x55\x92\x86\x51\xf8\xf6\x9a\x16\x22\x5d\xc3\x97\x4e\xce\x90\x6d\xc6\x26\xc7\x55\x9e\x0d\xcb\xbd\xfb\x6a\xd6\xc3\xe8\xd5\x45\xb7\x4b\xff\x8a\x86\x37\x5a\xc6\xd5\x63\xae\xf3\x76\x46\x9e\x6d\x75\x99\x81\xf7\xa2\x48\xad\x08\xa9\x6d\x29\xee\xb1\x30\x38\x85\x62\x54\x35\xbc\xcf\x11\x4d\xda\xb7\x35\x27\x58\x7d\x83\x7b\x2f\xcb\x45\x96\x4e\xe6\x18\xb0\x41\xa7\x81\x78\x80\x39\xfd\x07\x5d\xf0\xe4\x43\xff\x9e\xe5\x9e\x29\xd9\x5e\xf0\x36\xb3\x95\x55\xbd\x95\x7a\xe7\x1a\x0e\x24\x1b\xba\x28\x4c\x04\xb9\x1e\xcd\x09\x72\xca\x26\xd7\x62\x9b\xf3\xa5\x98\xcc\x35\x1b\x13\x44\x8a\xaa\x24\x39\x80\xf5\x74\xc0\x97\xcb\x86\xe9\xf1\xf2\x74\x5e\xc9\x0c\xb7\xab\x8b\x33\xd6\xd4\xd6\xc4\x31\x37\x44\xad\x25\x3b\xe1\x6e\x76\x55\x0d\x26\xa8\x6a\x0d\x19\x23\x40\x39\xe4\x74\xa3\x52\x6c\x0c\x7b\xd9\xf8\x9f\xbe\x1a\xa5\x1b\xa8\x79\x38\x3f\xe1\x02\x39\xbf\x10\xe1\x1c\xdf\xbc\xbb\x15\xfe\x59\x96\x11\x57\x88\xc9\x45\x69\x3d\xc4\x70\xec\xc4\xfe\x26\x17\xb8\x53\x67\xec\xe7\x82\xdd\xc0\x06\x86\xbf\x31\xf1\xc8\x41\xde\x38\x15\x12\xc6\x7e\x9e\x9c\xb0\x6f\x4f\xd8\x7f\x57\x3f\x3f\x4f\xd8\x46\x70\x28\xdd\x2e\xd9\xcf\x93\x77\xc8\x32\x6b\xb9\x2b\xf5\xbd\x7e\xcd\xf3\x15\xfe\xe2\xe7\x09\xfb\x79\xf2\x3f\xe1\xff\xf2\xfd\xcf\x6e\x4d\xf3\x67\xd2\x9f\x1d\xe0\x54\x6f\x81\x7f\x3f\x5d\x7f\x7b\xb2\x71\x8c\xeb\x84\x09\x03\xc2\x33\x45\x59\xef\x01\x46\xa1\x9e\x13\x10\xcd\xce\x9b\x82\x4c\xe5\x72\x26\xcb\x3b\x78\x5d\x58\xef\x16\xb3\xa5\xdc\x1c\x97\x72\xb1\xca\xee\x8e\x81\x58\x93\xb1\xcb\x42\xce\x50\x1f\xe1\x84\x88\xf5\x88\xfa\x68\x97\x0b\x6d\x3b\xd7\x92\x43\x14\x88\x1e\x72\x95\x72\xc0\x64\xec\xab\xd8\xd6\x1d\xc3\x80\xb6\x50\x23\x51\x4f\x4f\x66\x81\x87\x75\x5f\x02\x3d\xaf\x65\x6e\x58\xab\x44\xef\x92\xac\xb8\x7b\x2b\x78\x9a\x67\x85\xb8\x41\xed\xb4\x1a\xa4\xc8\x8d\xbb\x9f\x26\x4e\x4a\xbf\x06\x5c\x95\xc2\xeb\x16\x71\xa0\x85\xe9\x29\x90\xe4\x46\x27\x42\xb6\xc9\x30\xd6\xdb\xda\xee\x60\x2b\x82\x93\x08\xba\xf0\x62\x4f\x41\xbf\x6e\x91\x74\x09\xbd\xd1\xd9\xa8\x72\xda\x64\x68\xf1\x51\x0e\x51\x10\xd4\x2c\x19\x1f\xd5\x7d\x30\xdd\xfd\x7a\x20\xf0\x72\xe7\x97\x5e\x8d\x4f\x87\xcb\xcc\x93\xd0\x42\x51\x23\x5d\x45\x07\xb8\x4c\x75\xd4\x75\x4c\x78\x8d\x34\xd2\x1f\xb3\xa2\x37\x10\xfc\xd3\xd2\x0f\x47\x1c\xf5\x8d\xef\x80\x9e\xc9\x3f\xd0\xfe\x70\xc9\xb7\xb0\x41\x95\x08\x60\x50\x62\x00\x97\x9c\x8c\xae\x88\x3a\x19\xe1\xdb\xa4\x71\x0e\xac\x56\xa0\x9a\xb1\x33\x6a\x25\x4b\xb6\xe0\x15\x59\x2d\x0d\x9a\xe3\x4d\x12\xab\x4c\xe4\xe9\x6f\x9a\x3a\x88\xe1\x78\xc2\xe4\x7c\x21\xf2\xdf\x34\x61\x10\xc3\xf1\x84\x69\xa2\x77\xe7\x43\xb8\x18\x9f\x95\x8a\xbc\x0e\x04\x56\xe2\x32\x10\x80\xe9\x95\xfd\x89\x26\x4a\xf9\x97\x5d\x73\xf2\xbc\xfa\x45\x90\xd7\xff\xfa\x37\xf2\x05\x10\xfe\x29\x64\x2a\xfe\xd5\xb9\x02\x70\xa8\x66\x8d\x74\x56\x4b\x80\x2a\x2f\x46\x81\x32\x8e\x4d\x5e\x55\xc4\x22\xea\x4d\x87\x96\x28\xa4\x2e\x53\xee\x6c\x50\x51\x2a\xeb\x4d\x13\x80\x1d\xc0\x67\x32\x75\x53\xae\xcd\x62\x32\xed\x72\x17\x18\x16\x80\xc5\xec\x59\xdb\x33\x74\x80\x64\xcd\xac\xbd\x93\xfd\x27\x61\xc0\xad\x4c\x31\xfd\x4e\x90\x09\x5b\x24\x7a\x75\xd5\xed\xd2\xa2\x97\x71\xee\x6b\x72\x47\x72\x37\xdf\xd8\x41\x01\x60\x5a\x98\xb1\xca\x98\x6a\x90\x13\x9b\x20\xb0\x63\x76\x4d\x77\xba\x63\x76\xb3\x5b\x2e\x85\x70\xbd\x16\xc3\xcf\x31\x65\xfe\x61\xc7\xec\x87\xe2\x6b\x21\x1f\x8a\x57\xff\xd4\xc4\x7f\xe2\xa6\x0f\x20\x32\x88\xca\x10\x32\xa3\xd1\xf1\x64\x5b\xe6\x6c\xe3\x16\x37\x8a\x67\x2c\xa1\xe3\x9c\x44\x5b\x02\xd1\xdb\x29\x3c\x0f\x7c\x15\x7b\x73\xcd\xd4\xee\x22\xea\x1c\x50\x12\xc8\xf9\xac\x09\xff\xa8\x7d\x6b\x3d\x2d\x83\xed\x94\xa6\x61\xb3\x32\xf0\x2e\x02\x1d\x29\x6c\xbc\x9f\xf4\xa1\x09\x41\xdb\x1e\x6b\x51\x8b\x82\x37\xfd\xf6\x06\x63\x32\x14\x95\x00\x6a\x20\x4c\x1d\x6d\x81\xfe\x08\x75\xf6\x01\x9c\x29\xf2\x3d\xbd\x5c\x1b\x67\x46\xf2\x0d\xc8\xea\xb5\xab\xe6\xbc\xbe\xca\x94\x47\xe0\x68\xba\x14\xb6\x39\x03\x5c\x75\xb0\xb3\x42\xb8\x52\x8f\xac\xfa\xc6\x52\x80\x7b\x76\xb9\x1b\xa5\x7a\x83\x9f\x80\x5c\xad\xe6\x43\x3c\xf7\xea\x5c\x35\xd4\x97\x37\x6d\x54\xb1\x2b\x4b\x60\xe8\x0e\x56\x76\xd9\x2b\xa3\xa8\x03\x28\xd6\x9d\x9a\xbc\xae\x4c\x36\xe6\xac\x62\xa9\xdc\x2d\x40\xb2\xf0\x15\x98\x70\x94\x85\x00\xa1\xcc\xb4\x09\x7d\xce\x5e\xf7\x5f\xc7\x07\x77\xe2\x86\x3f\x9e\xc7\xa2\x77\xc9\x1f\x3b\x18\xaa\x27\x00\xb9\xea\xa2\x5b\x3f\x08\xe1\xcf\x23\x46\xc1\xef\x96\x51\xf8\x74\x33\xb1\xf0\x38\xdd\x8c\xc7\xe3\x21\x2b\x52\xf9\x30\x88\xc3\x4f\xd8\xcc\x6b\xdb\xae\xcb\xbd\xb6\x6c\x1b\x8e\x1e\x78\x85\x41\x7b\xf6\xad\xcb\x77\x02\xd3\x41\x69\x2f\x21\xa3\x40\xc0\xcb\xa1\xd3\xeb\x5f\x9d\x49\x0a\x8f\xd9\x38\xf4\xfd\xd7\x60\x05\x2e\x5a\x44\x50\xb0\xff\x3c\x09\x90\x50\xe7\x0e\x50\x56\x81\xde\x5e\xa5\x7b\x2f\x90\x31\xa3\xa7\x54\xf5\xfe\xd2\x17\x87\xdc\xca\x14\xd0\x08\x44\xed\x6f\x05\xff\x8f\x19\x03\x2b\xf3\x69\x03\x8c\xb3\x6c\x9b\x22\xfa\x50\xe1\x7e\xbe\xe3\xf9\x11\xe6\x1b\x68\x65\x2b\xa4\x67\x06\xf5\x84\xab\x81\xd2\xec\x1c\x2e\x69\xdd\x55\xf0\x27\x1c\xa8\x72\x19\xa6\xd9\xc7\xcf\x9a\xe7\xf4\xf3\xa1\xaa\xbe\xad\xe4\x74\x76\xaf\x8f\x8d\xbf\x41\xfe\x76\x63\x48\xee\x93\x8c\xb6\x1a\x62\x05\xcf\x67\x1c\x32\x07\xc3\xf3\xb7\xd8\x55\xec\xbf\x76\xa2\xdc\x8f\x11\x6c\xf4\xe0\xd8\xff\xd0\x99\xfe\x19\x3d\x4c\x12\x0a\xfa\x9d\x52\xae\xac\xd1\xad\xdd\x4c\x4f\x9e\x5b\xf3\x6d\xb6\x91\x45\x56\x4b\xe0\x5c\xff\x3b\x25\x63\xb7\x16\xec\xa5\x2c\x56\xd9\xdd\xae\x6c\x6c\x4d\xf4\xc4\x02\x8f\x31\x1b\x5e\xf0\x3b\xd1\x98\x62\x28\x5e\xd6\x09\x14\x32\x59\xf4\x88\x32\xb0\x9f\x20\xc4\xf3\x6e\x2d\xca\x0f\xd5\xb9\xa8\xeb\x88\xf0\xa4\xef\x5b\xcd\xb5\xc9\x16\x0c\xf3\xe2\xae\xe4\x69\xcb\xab\x25\x2d\xe5\xd6\x57\x02\xb4\x5e\x1b\x37\x54\xa9\x33\xd7\xc2\xef\x2a\x50\x3b\xab\x8a\xa1\x95\x5f\xfb\x42\xd4\xeb\x52\xee\xee\xd6\xdb\x5d\x3d\x63\xe7\xe6\x25\xd5\x2d\xae\x3c\x73\x29\xb3\xca\x78\x52\x98\x71\xc7\xe7\xda\x40\xc6\x1b\x24\xd2\x5f\xa0\x95\x1e\x19\x18\xe7\x2f\x1f\x15\xcb\x76\x5f\xd6\xd5\xd6\x68\xe8\x90\xf8\xab\xc1\xe0\x55\xa8\x14\xf5\xae\x2c\x40\x75\xc2\x3a\x16\x3a\xd8\x41\xf3\x23\x80\xde\xbe\x79\x83\xb1\xb6\xc5\x72\x4f\xe4\x73\x02\x45\x0f\x35\x4d\xe5\x55\xe3\x96\x35\x9a\x7f\xa0\x4c\x79\xb5\x96\x79\x3a\x48\x95\x5b\xdd\x52\x53\x06\xbd\x9e\xac\xa5\xea\x2e\x8f\xeb\x71\xd4\x2a\xcc\x63\xed\xc3\x6f\x4e\x54\x16\xf7\xd3\x6f\x66\xaf\x27\xcf\x76\xa4\xe0\x92\xf5\x7e\x6b\x10\x8e\x3e\x6b\x54\xe6\x93\x0f\xa4\x34\xcc\x93\x00\x8d\x6e\xda\x6d\x59\xb5\x85\x22\x22\x55\x27\x37\x0b\x5f\x96\xb2\xaa\x9c\x5a\x87\x39\x7d\x41\x2d\x67\xf0\x58\x87\x36\x70\xda\x61\x93\x6f\x4f\xaa\x09\x01\xb2\x4f\x34\x4c\xde\x42\x9e\xbe\x3d\x90\x4a\x05\xfb\xf6\x44\x9b\xe5\x31\x9d\x84\xe0\xf8\x7c\xd8\xce\xce\x02\x0a\xee\x52\xa8\xa3\xd0\xfb\x50\x47\x8f\x84\x14\x33\xa8\xf5\x44\x52\x1e\xf4\x03\x66\x73\x48\x22\x64\xf0\x39\xd7\x9f\x7a\x00\xf7\x42\x99\xa3\xc9\x2c\x8f\x73\x40\x29\xa9\x9f\x2b\x7d\xe7\x9b\x83\x1d\x80\x56\x9f\x57\xab\x4a\xd4\xc1\x65\xba\x35\xcd\x5a\x57\xa3\x54\xe4\x35\xc5\x7b\xc8\x95\x99\x30\x1c\x4c\x77\x25\x77\x64\xf6\x41\xef\x10\xb8\xbc\x57\x15\x3c\xe9\xb3\x2a\xbb\x83\x4c\x9a\x15\xf8\x97\x00\x12\x72\xc5\x52\xb1\xcc\x36\x3c\x27\xff\xa0\xca\xe2\xf9\x6f\x4f\x4e\x36\x95\x2b\xac\x61\x7a\x3a\x7b\xbd\x56\xfb\xe1\x9b\xf5\xbf\xa3\xd6\xa6\xde\xa2\x71\x62\x10\x5b\xa3\xf4\x8a\x49\x01\x7a\xf7\x64\x57\x4d\xd8\x1f\xa0\xf1\xff\xfb\xbf\xd5\xe4\x8f\x47\x6c\xe2\x86\x8a\x6d\x37\xf0\xaf\xf5\x64\xc6\xce\x6c\xf7\x70\xb9\xb2\x09\x02\x0b\x07\x7f\x2d\x6f\x72\xf0\x3a\xa6\xa0\x2e\x47\xc5\x42\xfd\x1a\x3f\x6e\x75\x14\xd8\xc1\xd5\xa1\xd1\x53\x91\xf3\x7d\xa3\x02\x37\x8e\x84\xdd\xc5\xe9\xc0\x43\x2f\xb9\xdd\x56\xdf\xd1\x2a\x00\x66\xd1\xfe\x14\x49\x4f\x92\x79\x51\x0a\xfe\x55\x7b\xab\x89\x65\x86\x11\x72\x8e\x63\xaf\xe0\x85\xac\x72\x21\xb6\x47\x66\x3e\x72\xa7\x12\x24\x6c\x65\x9e\x1f\x31\x01\xff\xf9\xe5\x81\x67\x8a\x88\x6a\x2b\x37\x3b\x61\xb5\xab\x45\x3f\xa3\x3b\x34\xa7\x9b\x3a\xce\x52\x97\xdd\x97\x85\xd9\x9c\x20\xb1\x78\x6a\x50\x27\x8c\x29\x63\x55\xe5\xc8\x6c\x4f\x58\xd3\x39\xcb\x28\xf1\x27\x88\x01\xf0\x68\x62\xa9\x14\xa6\xfe\xee\x1e\x49\x04\x04\x5f\x59\x8a\xa6\x1a\xd0\x7b\x99\x85\x84\xd8\xf0\x76\x27\x78\x8e\x41\xe2\x94\x35\x33\x9a\x0f\xf0\xd8\x0b\xb2\xc0\x8f\x3a\x76\x47\x4b\x77\x1d\xa3\x4c\x2e\x4c\x44\xa4\x5a\xea\xa2\xff\x58\x57\x5f\x7c\xe9\xc0\x04\x43\x17\x35\x68\xd5\xdf\x67\xc7\xde\xd2\xfc\xe8\x70\xde\x06\xda\xdf\x4d\xa6\x78\x7f\xa1\x9f\xfb\x02\x25\xf9\x6d\x88\xad\xa1\x02\x70\xb5\x3f\x21\x7a\xfa\x9f\x4c\x4f\x4f\x4e\x00\xb0\xda\x71\x7b\x3b\x9a\xa5\x19\x97\xd4\x12\xc7\x8b\x2f\xd8\xa8\x9d\x73\xf2\x90\xe0\xa8\x3f\x91\x1e\x4c\xff\xc4\x40\x2b\xe8\x4d\xae\x47\x94\x38\x56\x71\x9d\xed\xd3\x6e\xd6\xc6\xa9\x2b\x67\xa8\xf3\x34\x6f\x02\xb1\x9d\x4c\x77\x29\x51\x45\x03\x4a\xe4\xfb\x26\xff\x72\x93\x89\x45\x6f\xfb\x9e\x53\x54\x32\x7c\x8b\xb1\xa2\xb9\x43\x8c\x6f\x4a\xc1\xb7\x4a\x12\x59\xfe\x06\x6a\x5a\xa0\xf7\x0d\xf8\xd8\x7b\x6c\x9e\x81\xea\xf3\x34\x93\x05\xf8\xa3\x14\x84\x7f\x4b\xb3\x73\x8e\x13\xbe\xb8\x31\x74\x6b\xbf\x2d\x79\x51\xe1\x18\xfe\xb4\x16\xad\x89\x7d\xec\x75\xd2\x4a\x27\x80\x53\x47\x74\x73\x05\xf7\x5d\x52\xc8\x78\x6e\xf0\x5b\xae\x79\x71\xd7\x3f\xaf\xda\x4f\xff\xa1\x5c\x06\x5e\x46\x8d\xca\xc4\x32\xd0\xd7\x9f\x07\x7a\xb0\x6b\x9f\xb1\xa3\xbb\xe2\xe7\xe1\x05\x69\x73\xca\xed\x7e\xdb\x24\x1b\x81\xff\x07\x55\x92\x76\x85\x61\xf4\xd9\xf8\xe9\xb8\xf6\xbb\xd9\xe4\xee\x02\x5c\x53\x9c\x40\xef\xd7\x5e\x6d\x3e\x64\xce\xef\x1b\xf2\x37\x7c\x3b\x05\x27\x82\x79\x12\x31\x6a\xbf\x3b\x34\x9a\x83\x5d\xbf\xd5\xb2\x91\x1b\xf3\x24\x40\xf2\xa6\x98\x1a\x99\x90\xac\x0d\xd0\x80\x00\xa7\xbd\x7a\xac\x2b\xa6\x09\x91\x9c\x27\x43\xeb\x6e\x35\x36\x41\x1d\xed\x4b\x8a\x2f\x4e\xd2\x01\x9a\x39\x63\x27\x67\xc9\xa8\x17\x1b\xd7\xfc\x9c\x85\xdc\x4c\x49\x36\x6b\x44\x27\x44\xca\x36\xa1\x8d\xd8\x46\xd4\x91\xb7\x6f\x13\xaa\xe6\xec\x1d\x16\x7f\xcf\x54\x87\xed\x37\x52\x6e\xcc\xbf\xc1\x7f\x0f\x99\xfe\xf5\x9d\x7a\x9e\x8c\x24\x5d\x20\x3d\x59\xcc\xb1\x15\x84\xad\xa2\x1b\x03\xc5\x14\x5b\xfb\xed\xbd\xdd\xda\x08\x04\x4b\x0e\x34\x9b\xc7\x84\x7b\x3a\x60\xb2\x4e\x08\x68\x56\xf4\x92\xfa\xaa\x3b\x11\x42\xce\x0a\x78\x25\xb5\x06\x75\x42\xd4\xf6\x83\xc6\x32\xa1\x26\xa0\x93\x04\x67\x4d\x90\xe7\x13\x04\x8e\x22\x40\x40\xde\x74\xc8\xe0\x84\xe8\x8d\x1a\x3e\x50\xc0\xa0\x35\xd0\xf7\xb1\x83\xc0\x3b\x68\xdb\xa9\xa3\xf2\xb0\xde\xbb\x16\x8e\x2d\x84\x17\xa6\x29\xfd\x66\x05\x0c\x7b\x1b\x47\xca\xa7\xf9\x53\x01\x3c\x55\xc0\x0d\x89\x27\xa4\xf3\x73\x4b\xa7\x7f\xa4\x04\xca\xb0\x26\xfe\x3c\x19\xe0\x91\x76\xe9\x7c\xd5\x89\x49\x47\xe2\x6d\x53\x2b\xd7\x01\x91\xd9\xaf\xc9\xfa\x3c\x35\x86\x9b\x59\x32\x9e\xd1\x9b\xea\xe3\xde\x9d\xd0\x42\xe3\x6d\xd3\xde\x88\x2a\x0b\x86\x25\xb1\xb4\x46\xe3\x81\x4a\xa1\xe3\xc6\xd7\xca\xd3\x2c\x20\x43\x02\x73\x6b\x13\x7b\xcb\x4b\x4a\xa1\xd8\xcc\xd4\x0b\x51\x3f\x2e\x64\x55\x93\xfd\x43\x17\xd3\xf4\x76\x1a\x16\x27\xf0\xa3\xe1\x85\xda\x74\x50\x3a\xd3\x53\x70\xfa\xcb\xb7\x94\xc6\x20\x50\xf3\x78\x18\x45\x81\xb0\xdf\x75\xbc\x88\x89\x12\x10\xd1\xa2\x66\x04\x34\x32\x96\x8c\x20\x34\x99\x6b\xfc\x89\x4d\x06\x6b\xd0\xdb\xcc\x03\x3b\x43\xaf\xf6\xb3\xd0\x59\x8f\x3e\x02\xa3\x6b\xea\xa2\x51\x4a\x45\x05\x72\xb8\xc3\x42\xcf\xce\x39\x43\x61\x16\x63\x71\x0f\x9f\x1f\x54\xe9\x6a\x88\xd4\x81\xa3\x24\xe6\x38\x21\x85\x57\xb1\x48\xa0\xc5\x20\x8f\x0c\x9c\x4b\xc3\x67\xd3\x01\xe7\x93\x16\xb5\xf3\x24\x82\x69\xb4\xe7\xdc\x61\xb2\x66\x78\x45\xd5\x23\xed\x3b\x4a\xa2\x06\xc9\xd6\xb2\xa5\x88\x9b\xda\x8d\xb3\xab\x39\x84\xe8\xb1\xb6\x3a\x22\xc5\xd7\x03\x92\x05\xab\xdf\x4b\x78\x11\x87\x8c\x27\x60\xac\xdf\x37\xc9\xde\x20\x79\x77\x21\x92\x81\x1d\x12\x43\xa2\x81\xf3\x2c\x42\xbe\xfd\x2a\x0c\xa2\x2b\xfd\xbf\xcd\xaa\x72\x87\xbc\x70\x0e\xce\x28\x91\x4a\xc1\x8f\xbe\xde\x66\x75\xae\x64\xff\x63\xdf\x56\xaf\xff\xd0\xd5\x25\xb4\x52\x20\x65\x1f\xe8\x19\xcd\xcc\xde\x97\xfa\x00\x7e\xbe\x66\x79\xde\x76\xef\xa2\x85\xa2\x88\x74\xf4\x43\x34\xba\x17\x70\x09\x7c\x9d\x42\xb7\xd9\xbf\xd2\x5a\x86\xe4\xe5\xd4\xc7\x99\x41\xb9\xe4\x2d\xaa\xea\x31\xad\xb5\x73\xc3\x93\x9b\x75\x63\xf1\xef\x1a\xfa\x67\xc9\x48\xd2\x6d\xcd\xbd\x75\x9e\x8c\x5a\x94\xd6\x7c\x9d\xd7\x4d\x08\x97\x84\xe3\x0b\x0c\xf4\x4d\xc5\x7c\x8f\x99\xd4\xec\x7d\x63\x14\x8b\x41\x2d\x46\x59\xe4\xde\x22\x84\x11\xe4\x79\x36\xfb\x58\xd0\xf0\xde\xa3\xe7\x19\x5b\x94\x99\x58\x35\x59\x2d\xb5\xe1\x5e\x3b\xaf\xc0\xe6\x4b\x45\x0d\xde\x91\x5e\x88\xcc\xa2\x7a\xfb\x6d\x4d\xf9\x6a\xa8\x8c\x02\x10\xeb\x5a\x01\xcd\xd5\x8b\xfe\x36\x58\xca\x8f\x99\xd6\xc6\x56\xc4\x5e\x6f\x26\x4f\x21\xcc\x6f\xc4\x70\xc8\x4c\x51\xfb\xc8\x25\x0e\xd5\xc4\x6f\x56\xac\xb1\x5d\xc0\x73\x9b\x2c\x43\x7a\x19\x33\x57\x2b\xf2\xd2\x7c\xe2\x0d\xab\xc9\xbd\x18\x6a\xe5\x4b\x04\x59\xc5\x94\xce\xc7\x74\x27\x66\x9c\x38\xd5\x39\x64\xc7\x89\x38\x48\x42\x73\x0e\xad\x89\x59\x0f\xde\x24\xa5\x1c\x18\x24\x8e\xcc\xb4\x55\x95\x89\x6d\xb8\x61\x67\xee\x54\x47\x3b\x86\xd8\x21\x3d\xce\xfe\x63\xd0\x43\x7f\x0b\x70\x8d\x32\x7e\x4e\x6c\xef\xf0\xe6\x38\x44\x75\xb5\xff\xf8\x0a\xe8\x04\xb0\xb6\xaa\xe9\x98\x2c\x13\x66\xda\xe4\x8b\x96\xca\xe5\x57\x51\xce\x8f\x8f\x1f\x1f\x1f\x93\x20\xe8\xd8\x1d\x1e\xa3\x15\xd8\x7f\xa6\x9a\xfa\x83\xed\x9c\x99\x1f\xa2\x75\x8a\x71\x4a\xd0\xc1\x6a\x6d\xf3\x5c\xde\x94\xdf\x9e\x27\xd1\x6b\xf6\xb1\xd5\x51\xaf\x9f\x76\x66\x33\x96\x3b\x74\x54\x8f\xba\x14\x87\x5e\x14\x46\xaf\xeb\xe0\xf9\xdc\xc3\xe7\x52\x9f\xc8\x0a\x11\x34\xbd\xf6\x31\x31\x15\x88\xde\xbb\x53\x3e\xb6\x7f\x64\xf9\x8c\xb5\xed\xfd\x79\xe5\xbc\x18\xd9\x19\xe6\x54\x77\x23\xfa\xb4\xe7\x7f\x63\x93\x04\x24\x83\xc0\x99\x26\x41\xc5\xb0\x42\x30\xd8\xfe\x68\x73\xde\x88\xfa\x93\xa8\xc5\x26\x09\x75\x8f\xc4\xd3\xab\x52\x7b\xd0\x6c\xa9\xd4\xd8\xb9\xbf\x6c\x47\xda\xf1\x90\xe4\xab\xff\x7a\xa5\x7e\xd4\xea\x1e\xe9\xd4\x4f\x14\x14\xf1\x7c\x6b\xb9\xcd\xd2\x6a\x0c\x8a\x99\xfd\xf8\x05\x99\x4e\x31\x77\x05\xe5\x0e\x32\xd9\xfb\xc9\xe8\x1d\xbe\xe2\xd3\x04\x64\x6a\x7b\xd1\x63\xed\x30\x59\x56\x4f\x3f\x89\x87\x32\x5a\x1c\x76\xae\xfc\xca\x62\x70\xf8\x04\x98\x76\x04\x65\xa0\x61\xb7\x42\xc8\x68\xc9\x1f\x72\xcc\xe9\xb1\xc6\x35\x36\xee\xbc\xaf\x11\xff\xc3\xdd\x4c\x58\xcb\x6c\x54\xeb\x24\x46\x2b\x03\x98\xad\x1a\xdf\xc9\x13\x98\x1e\xa7\x12\x89\x12\x14\x28\x33\x1b\x1a\x3b\xda\xb3\x32\x7e\x72\x8b\xbd\xed\x68\x91\x07\x39\xa8\xc9\xc2\x65\x1e\x86\xe8\x2c\xa7\xe4\x90\xf0\xba\xb4\x07\xe1\x5e\xa8\xd4\xc8\x6c\xcd\xb7\x5b\x51\xf8\xdf\x93\x09\xf3\x01\x05\x76\x90\x32\x61\xce\x9b\xd2\x15\xef\x77\xe9\xbd\xe0\xdf\x06\x41\xaa\x9a\xf0\xc4\x79\x32\xc4\x67\xba\x65\xcb\xdf\x49\xee\xea\xa5\xdc\x08\x74\x76\x24\x58\x64\x19\xf3\x5a\x2e\xb3\xa2\xf7\x52\x39\x4b\xc6\xdf\x1f\x1c\x45\xbc\xe7\x49\xc4\x7e\x79\xdf\xef\xd7\xbf\x3f\xd8\xd5\xe5\x3d\x40\x9b\x19\xe8\x22\xbc\x86\x51\xc8\xe3\x3a\x39\x54\x8e\xdf\xc9\x22\x0e\x97\xef\x64\x31\x30\x79\x13\xb7\xb1\x10\xcb\xa0\x71\xa3\x5e\x8b\xfd\xab\x52\xb4\x65\x18\xe3\xf8\xa4\xaa\x13\xe1\x67\x65\x83\x63\x05\x06\x93\x07\x59\x7e\x85\x9c\xb1\xd5\xc1\xa8\xd2\x5c\xa3\xb0\xbd\x24\xbc\x7a\x08\xc3\x2a\x29\xac\xdb\x66\x59\x59\x1e\x3c\x2f\x53\xde\x3d\x6a\x66\x57\xba\xf5\xc0\x62\x84\x8a\xc6\x1b\x23\x06\x3a\x73\x12\x44\x67\x21\x91\x58\x1c\x2a\xbe\x12\x77\x3b\x5e\xa6\x91\x58\xdc\x34\xed\xfb\x78\x50\xc9\x7e\x9b\xa7\x3c\x30\x99\x29\x4a\xad\xe1\x35\x26\x04\x6d\x6f\xe7\xa5\x80\x18\x87\xa5\xdc\x15\x48\xb5\x82\xc4\x8b\x48\x0f\xc7\x56\xe4\xf1\x0b\xa6\x47\xeb\xe3\x89\x08\xae\xb2\x82\xe7\xf9\x5e\x23\x1d\x78\x0b\xd0\x31\x0f\x07\x4e\x3b\x74\xa0\x4d\x5d\x22\xce\xd9\x8e\x78\xcc\xf9\x2d\xc4\x72\xda\x37\xdf\xf9\x31\x78\x78\x99\x63\x03\xb3\x3a\xcc\x93\x28\x72\xeb\xe6\xad\x03\x84\x82\xf8\xb5\xce\x62\x00\x27\x7e\xc5\xc4\x9f\x05\xe2\x80\x63\x84\xc6\x8f\xe2\x9a\x6b\x9a\x6b\x8f\x69\x2c\x44\x0e\x64\x84\xc1\xe2\xcf\x51\x55\x25\x69\x2a\x2a\xe8\x8f\x1c\xc5\xc2\x14\x8d\x37\x28\x0c\x28\x67\x61\x4e\xf6\x13\x67\xda\x20\x3e\x9a\x09\x29\x83\x80\x73\x4c\x67\xae\x01\x2a\xb4\x02\xc1\x4b\xab\x9e\x12\x82\x45\x38\x2b\xdd\xd2\x7d\xe7\xcd\x6c\x13\x00\x8a\x8a\x07\x61\x65\x1f\xa0\xa8\x2f\x4a\x6e\xb8\x68\x3d\x3f\x3c\xf0\xaa\x78\x55\x87\x13\xcb\xea\x62\xf6\x9b\x99\x97\x16\xbe\xd8\xe9\x4a\xc5\xb6\x0e\x93\x42\xb5\x6b\xed\xc1\xae\xf5\xbd\x89\x85\xc5\x9b\x40\xe2\x8d\xdd\xe8\xba\x8d\x35\xd5\xf8\x91\x36\xa0\x9d\x2c\xf6\xfa\x3f\x74\x3f\xc1\xd1\x75\x8e\x0f\x17\x9e\x14\xb9\x59\x8a\x8d\xbc\xd7\x01\xa7\xed\x48\xd8\xb2\x89\x30\x3c\x64\xcb\xeb\xd2\xf6\xee\xaf\x1d\x7a\x7d\x12\x8f\xfd\x7d\x66\x9c\x58\x7b\x14\xf0\x80\x64\x0d\x65\x60\xf4\xc0\x2d\xe8\x99\xb6\x23\xc4\x71\x63\xda\xa9\x28\x24\x75\x8a\x2a\xa7\x60\x73\xb9\x0f\x7b\x80\x32\xa0\xd2\x9d\x04\x50\xb5\xb4\xcb\x55\x21\xfa\x07\x8a\xc6\xb0\x64\xd1\x8b\xe9\xfc\x48\x34\x18\x2d\x58\xb4\x44\x9a\x27\x87\x2d\x50\x60\x71\xbc\x03\xcb\x5e\x9d\xf8\x79\x12\x58\xb2\x97\xb2\xf2\x2f\x65\xe5\x5f\xca\xca\xbf\x94\x95\x7f\x29\x2b\xff\x52\x56\xfe\xa5\xac\xfc\x4b\x59\xf9\x97\xb2\xf2\xbf\x9b\xb2\xf2\xff\x7f\x00\x55\xf6\xd5\x87\xb1\xf7\x04\x00"),
+		},
+		"/templates": &vfsgen۰DirInfo{
+			name:    "templates",
+			modTime: time.Time{},
+		},
+		"/templates/chaos-daemon.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "chaos-daemon.yaml",
+			modTime:          time.Time{},
+			uncompressedSize: 2039,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x53\xc1\x6e\xa3\x30\x10\xbd\xe7\x2b\xa6\x1f\x40\x51\xb5\x52\x2b\x71\x5b\xb5\x7b\x88\xb4\xed\xa2\xa6\x5a\x69\x4f\x2b\x63\x66\x83\x55\xdb\x63\xd9\x43\x55\x54\xe5\xdf\x57\x4e\xa0\x35\x24\x21\x09\xe6\x00\x7e\x33\xcf\x8f\x37\x0f\xe1\xd4\x6f\xf4\x41\x91\x2d\x40\x38\x17\xf2\xb7\x9b\xc5\xab\xb2\x75\x01\x0f\x02\x0d\xd9\x15\xf2\xc2\x20\x8b\x5a\xb0\x28\x16\x00\x56\x18\x0c\x4e\x48\x2c\xe0\xe3\x03\xae\x9f\x86\x57\xd8\x6c\x7a\xb4\x00\xd9\x08\x0a\x59\xbd\xed\x5f\x00\x68\x51\xa1\x0e\xb1\x19\xe2\x11\xd7\xaf\x6d\x85\xde\x22\x63\xb8\x56\x94\xa7\x2d\x06\x43\x73\xa4\x4c\xd9\xc0\xc2\xca\x73\x4a\x25\x19\x47\x16\x2d\x4f\x94\x04\x87\x32\xaa\x08\xa8\x51\x32\xf9\xf8\x0c\x60\x04\xcb\xe6\x67\x22\xf1\x6c\x91\x17\xc9\xbc\x44\x28\x00\xa3\x71\x5a\x30\xf6\x12\x13\xff\x01\xc6\x86\x5e\xa4\xf7\x42\xc5\x97\x69\x06\x18\x0c\x8e\xab\xa1\xc0\xcb\xf2\xbe\x00\xf6\x2d\x26\x7b\xe5\xf2\x61\xb4\x67\xa9\xc6\xd5\x68\x20\xf1\x1e\x9f\x49\xa1\x00\xad\x6c\xfb\xde\xe3\x92\x2c\x0b\x65\xd1\x27\x1e\x64\x87\xb3\x37\x5c\xca\x88\x75\x1f\xd9\x65\x7c\x7c\xc6\xb5\x0a\xec\x3b\xd8\x6c\xf2\xb4\xa5\xf8\xac\x78\x11\xeb\x5d\xa6\x87\x6b\x4b\x51\xb6\x5a\x97\xa4\x95\xec\x0a\x58\xfe\x7b\x22\x2e\x3d\x06\xb4\x9c\xd4\x49\x32\x46\xd8\xfa\x4b\x5a\x5c\x19\xe4\x6d\xf0\xb9\x26\x29\x74\x5e\x29\x3b\x3a\x74\x52\x99\x65\xbe\xb5\xac\x0c\x4e\xf6\xa3\xb4\xe7\x1d\x32\x56\x16\xc1\x2c\x6b\x98\x5d\xe6\xc8\xa7\x5a\x22\x72\x75\x15\xd8\xc3\xb7\x9b\xbb\xdb\xdb\xbd\x9e\xb5\x77\x72\xbe\xe7\x2e\x41\x02\xca\xd6\x2b\xee\xee\xc9\x32\xbe\xf3\xf8\x0b\x9d\x57\x6f\x4a\xe3\x1a\xeb\xd1\x7c\x7b\x4f\x84\x13\x95\xd2\x8a\x15\x26\x33\xeb\x33\x56\x4f\xbc\x8a\x77\x06\xab\x3f\xab\xbf\xe5\xcb\xf3\xf7\xfb\x1f\x09\xf8\x46\xba\x35\xf8\x48\xad\xe5\x09\xcf\x30\xff\x40\xf2\x15\x39\x73\x82\xbf\x52\xbc\x5b\x26\x76\x95\x82\x9b\x22\x75\x72\xb5\xad\x7f\x1c\xb0\x7d\x63\x7b\xda\x2e\x9c\xe0\xcc\x43\x17\x0e\xb6\x1a\xaa\x5b\x8d\x27\xdb\xb5\xaa\xf2\xbe\x74\x52\xe5\x51\xd4\xbf\xac\xee\x0e\xf8\x3a\x9c\x51\x11\x9d\xfa\xe6\x3c\xd6\x9c\xcb\x1c\x23\x71\xc4\xe0\x18\x99\x11\x90\xfc\x8f\x25\x79\x2e\xf6\x62\xf3\xf9\xeb\x1f\x41\x07\xe6\x18\xe0\xd3\xcc\x43\x88\x77\x51\x48\x44\xce\x27\x60\x2b\x20\x0e\x2a\xd9\x03\x70\x87\xe3\x30\x4d\xc2\x4c\x0a\xe6\x78\x47\x91\x38\x11\x87\x59\x9e\x43\xd9\x98\x1b\xfd\x2c\x59\x45\xc4\x8b\xff\x03\x00\x5c\xbd\xb3\xb2\xf7\x07\x00\x00"),
+		},
+		"/templates/chaos-dashboard.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "chaos-dashboard.yaml",
+			modTime:          time.Time{},
+			uncompressedSize: 2088,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x55\x4d\x6f\xe2\x30\x10\xbd\xe7\x57\x8c\x7a\x0f\xd9\x16\xed\x1e\x7c\x63\x0b\xbb\x8b\xd4\xd2\x08\xd8\x5e\xab\xc1\x99\x82\x55\x7f\xd5\x76\x90\x50\x94\xff\xbe\x32\x21\x6d\x48\xcb\xb6\xdc\x56\x1b\x73\x08\x9e\xf7\x9e\x9f\x3d\xe3\x49\x55\xa5\x20\x1e\x61\xf0\x83\x30\x94\x8e\x7e\x62\x20\x3f\x18\xa3\xdf\xac\x0c\xba\x02\xd2\xba\x4e\xd0\x8a\x7b\x72\x5e\x18\xcd\x60\x7b\x99\x3c\x09\x5d\x30\x58\x90\xdb\x0a\x4e\x89\xa2\x80\x05\x06\x64\x09\x80\x46\x45\xde\x22\x27\x06\x55\x05\x83\x59\xfb\x17\xea\xfa\x10\x65\xc0\x37\x68\x7c\x5a\xb4\x0b\x24\x00\x12\x57\x24\x7d\xe4\x03\xa0\xb5\x83\xa7\x72\x45\x4e\x53\xf4\x21\x4c\xd6\x65\x29\xf2\x9b\x13\x30\xa1\x7d\x40\xcd\x3f\x03\xe5\x46\x59\xa3\x49\x87\xb7\x66\xbc\x25\x1e\x8d\x78\x92\xc4\x83\x71\xff\x84\x29\x80\xb0\xb3\xc4\x60\x66\x0a\xca\x8d\x0b\x09\x80\x35\x2e\x1c\x4e\x2c\x05\xeb\x4c\x30\xdc\x48\x06\xcb\xeb\x7c\x3f\xd7\x00\x18\x5c\x0d\x87\xc3\xc3\x44\x40\xb7\xa6\x90\xf7\xa7\x9b\x8d\x6c\x42\xb0\x49\x9a\xa6\x47\x99\x46\x6b\x7d\xf6\x92\xee\x31\x59\x69\x76\x8a\x74\xf8\x3f\x33\xee\xc8\x4a\xc1\xd1\x33\xb8\x7c\x93\x7f\x85\x81\x6f\x6e\x3a\x9e\x3f\xed\xfa\x2c\xdf\x67\x3a\x07\x08\xa4\xac\xc4\x40\x07\x97\x9d\xb4\x00\x1c\x1f\xf2\x59\x96\xcf\x34\x7d\xb6\x6d\x80\xf6\xd0\xe3\xf0\x4d\x1f\x19\x71\x6e\xca\x57\x3c\x37\x3a\x38\x23\x25\xb9\x54\xa1\xc6\x35\xb9\x03\x3c\x06\x50\x68\x72\x9d\x9d\xa5\x27\x0b\xad\x1d\x42\xe1\xfa\x50\xa2\xd3\xf8\x3a\xa7\xb5\xf0\xc1\xed\xa0\xae\xb3\x1e\x8b\xbd\x80\x96\xb8\x6e\x1a\x57\xfb\xec\x55\xf2\x52\xca\xdc\x48\xc1\x77\x0c\xa6\x8f\x33\x13\x72\x47\x3e\x5e\x8b\x16\x15\x6b\xc9\x9b\xd2\x71\xea\x58\x8c\x3f\x29\x94\x08\x9e\x41\xd5\xd5\x8c\xe8\xe7\x92\x7c\x7b\x9d\x5f\x1f\x6e\x4b\x06\x57\x5f\x55\x6f\x5a\x91\x32\x6e\x17\x23\xdf\x6e\x45\x27\xc6\x8d\x52\xa8\x8b\x63\x95\x14\xb2\xd2\xbb\x4c\x1a\x8e\x32\x5b\x09\xdd\xdf\x6c\x07\x4c\x7a\xdb\xe7\x36\xa7\x3a\x1e\x2d\x47\xdf\x47\x8b\xc9\x43\x7c\x59\xdc\xfd\x9e\x5f\x4f\x8e\x70\x00\x5b\x94\x25\x31\xb8\xc8\xe2\x77\x20\xe3\xc6\xd1\xc0\x3f\x4b\x11\xe8\xe2\x03\xc1\xf9\xf4\x7e\x32\x3f\x21\xd6\x28\x0c\xdf\x97\xb8\x99\x2e\x96\x93\xd9\xc3\xaf\xbb\xc5\xf2\x04\xfd\xcb\x60\x3f\xfe\x4a\xcf\xef\xe6\xa7\xe8\xb1\x45\x76\xb9\x5b\x23\x4b\x45\xb7\xb1\x44\x3d\x7b\x57\xd3\x07\xe3\x70\x4d\x69\x83\x3c\x82\x00\xa8\x48\xcc\x31\x6c\x18\xec\x0f\xa9\x17\xf6\xe5\xaa\x09\x5e\x74\xd7\xec\x34\xf9\xfe\x62\xfb\x86\xdd\x2b\x97\xf6\x62\xbc\xe9\xf1\x8d\xa3\x17\xa5\x0f\x0c\x93\xb2\x61\x37\x16\x6e\x5f\xa7\x55\x95\x02\xe9\x02\xea\x3a\xf9\x33\x00\xa5\xee\x70\xb8\x28\x08\x00\x00"),
+		},
+		"/templates/controller-manager-rbac.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "controller-manager-rbac.yaml",
+			modTime:          time.Time{},
+			uncompressedSize: 3331,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x57\xc1\x6e\xe4\x36\x0c\xbd\xfb\x2b\x08\xf7\xb6\x88\x27\xd8\x5b\xe1\x5b\xbb\x05\x7a\xeb\x21\x05\x7a\x59\xe4\x40\xcb\x1c\x8f\x76\x64\x52\x15\x25\x07\x69\xb0\xff\x5e\xc8\xf6\xc6\x93\xb1\x33\x9d\x0d\x82\x02\x45\x4f\x63\x4b\xd4\x7b\x8f\x34\x49\x71\x8e\x96\xdb\x1a\x7e\xa7\x30\x58\x43\x3f\x19\x23\x89\x63\x81\xde\xfe\x41\x41\xad\x70\x0d\xc3\xc7\xa2\xa7\x88\x2d\x46\xac\x0b\x00\xc6\x9e\xd4\xa3\xa1\x1a\x9e\x9e\x60\xf7\xdb\xb7\x57\xf8\xfa\x75\xde\xad\xc1\x1c\x50\xb4\x32\xc2\x31\x88\x73\x14\xaa\x1e\x19\x3b\x0a\x05\x80\xc3\x86\x9c\x66\x20\x00\xf4\x7e\x77\x4c\x0d\x05\xa6\x48\xba\xb3\x72\x7b\x7a\xbc\x27\x3d\xbc\x62\x66\x59\x23\xb2\xb9\xc6\xd4\x48\xef\x85\x89\x63\x0d\x1b\x7a\xaa\xaa\x2a\xa6\x00\x7c\x72\x49\x23\x85\x3b\x71\xf4\xc2\xfb\xd0\xa0\xd9\x61\x8a\x07\x09\xf6\x2f\x8c\x56\x78\x77\xfc\x71\x44\x1e\x3e\x36\x14\x71\x1d\x9c\x53\x51\xf5\x7f\x26\x12\x21\x39\xd2\xba\xa8\x00\xbd\xfd\x35\x48\xf2\x5a\xc3\xe7\xb2\xbc\x2f\x00\x02\xa9\xa4\x60\xf2\x36\x40\x05\x3a\xa5\x8a\x8e\x2f\x34\x10\xc7\xe9\xf1\x39\x31\xf2\xeb\x40\xa1\x19\x11\x3e\x94\xf7\xff\x00\x0a\x9f\x4b\xe2\xd6\x8b\xe5\xa8\xe5\xfd\xe9\x59\x13\x08\x23\x95\x37\x50\x76\x14\xf3\x8f\xb3\x3a\xfe\x3e\x60\x34\x87\xfc\x90\x7c\x9b\x2d\x56\x14\xcd\x68\xb0\xe2\xf9\x22\xcd\x19\xc5\x6b\xc0\x0b\xf5\x4c\x71\x03\x65\x4b\x8e\x22\x5d\xe3\x8f\xcf\xb5\xa3\x91\x38\x0e\xe2\x52\x4f\xc6\xa1\xed\xff\x1d\x66\x69\xaf\xe2\xb9\x02\x1c\xbd\xd7\x35\x81\x46\x8c\xb4\x4f\x4e\xe9\xfc\x6b\x7d\xb8\x16\xa2\x25\xef\xe4\xb1\xcf\x89\x93\x15\x05\xf2\xce\x1a\x5c\x03\x6e\x2a\x5f\x71\x78\x71\xd6\x3c\xae\x59\xbc\xb4\xad\xd5\x90\x7c\xae\xd9\x26\xb5\xdd\x1b\xf1\xd7\xc8\x46\x78\x6f\xbb\x1e\xfd\x3b\xe1\xb1\xb4\xf4\x4e\x50\xe7\x89\x77\x0d\xec\x0d\x94\xfe\x65\x52\xac\x88\x96\xde\xb2\x93\xd0\xad\x69\xc7\x7d\x1f\x24\x92\xc9\xd1\xd6\x0c\x3e\xae\x45\xea\xbd\xc3\x48\xcb\x0a\x4b\xb4\x7b\x6b\xc6\x4e\xfa\x26\xa7\x0d\x85\x19\x81\x74\x6e\xc5\x1b\x82\x4e\x8c\x6c\xc7\x96\xbb\x40\x7f\x26\xd2\x29\xe3\x5e\xdf\xbd\x45\xef\x83\x0c\xe8\x36\x95\xcd\x95\xb2\x55\xa4\x6f\x90\x39\xb6\xe9\x0a\x4a\xb5\x1d\x53\xd0\xf2\x64\x73\xbc\x54\x9f\x0d\x5e\xb6\x71\x47\x1d\x9a\xc7\x2a\xf1\x91\xe5\x81\xcb\x53\x99\x93\xf8\x0d\x31\xeb\x00\x29\x99\xf0\x5a\x41\x2c\xce\xad\x12\xe5\x8a\xae\xd1\xf6\x56\xf3\xe0\x10\xa8\xb3\x1a\xc3\xe9\x95\xb9\x96\xd1\xa7\x88\xd1\x72\xf7\x40\xcd\x41\xe4\x38\x55\x56\x0a\x73\x76\xdc\x94\x03\x3a\xdb\x5e\xb0\xb8\x2c\x7f\xf9\x5e\x8b\x6e\xbf\x9d\x55\x5b\x37\xfc\x46\xd4\x52\xf3\x85\x4c\x44\x63\x48\x35\xd0\x60\xe9\xe1\x4c\xc3\x4c\xbe\x89\x4f\x1c\xad\xb9\x4c\x10\xe5\x48\x7c\x19\xf8\x07\x88\x07\x82\x16\xf5\xd0\x08\x86\x16\x26\x46\x1d\x57\xc7\x9a\x03\x61\x68\xe8\x80\x6e\x0f\xb2\x07\x1b\x15\x92\x52\xd0\x73\x45\x6b\xf2\xd1\x2c\xc7\xa8\x1b\x65\xe7\xa7\xf9\xae\xc7\x69\x2c\x3c\x93\x64\xfb\xdc\x6e\x84\xbf\xbf\x65\x14\x00\x4f\x4f\x15\x04\xe4\x8e\x60\xf7\xe9\xee\x17\x9d\xa6\xc7\x9c\xee\x79\xa8\x5c\xbd\xdd\xe6\x6b\x27\xe9\x7c\x8e\xb8\x5d\x2c\x46\x9f\x7b\xe1\x23\x3d\xea\xc6\xd2\x72\xf2\x79\x27\x90\x26\x17\xb7\x96\x16\xe3\x67\x27\xc7\x5b\x6d\x6b\x4a\xfc\xd9\x72\x6b\xb9\xfb\x5f\x0e\x8b\x73\x1d\x8c\xf3\xe2\xe6\xff\x87\x97\xce\x6d\x7a\x74\xe9\x7f\x44\x10\x47\x77\xb4\xcf\x1d\x70\x3d\x9d\x7f\x5f\xe0\xbe\x25\xe5\x85\x8f\x53\xfc\x3d\x00\x6e\xa2\xea\x78\x03\x0d\x00\x00"),
+		},
+		"/templates/controller-manager.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "controller-manager.yaml",
+			modTime:          time.Time{},
+			uncompressedSize: 2781,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\x4b\x73\xe2\x38\x10\xbe\xf3\x2b\x7a\xe6\xee\x18\x76\x26\x33\xbb\xba\x39\xc4\xcc\xba\xd6\x06\x17\x38\x5b\xb5\x7b\xa1\x14\xd1\x01\x55\xf4\xf0\x4a\x32\x5b\x54\x8a\xff\xbe\xa5\x60\x40\x64\x9c\x07\xdc\x36\xca\x01\xf7\xe3\xeb\x4f\xad\x56\xab\x69\xcd\xff\x44\x63\xb9\x56\x04\xd6\x83\xde\x23\x57\x0b\x02\x33\x34\x6b\xce\xb0\x27\xd1\xd1\x05\x75\x94\xf4\x00\x14\x95\x68\x6b\xca\x90\xc0\xd3\x13\x5c\x8d\xf7\x9f\xb0\xdd\xb6\x5a\x02\x6c\x45\xb5\x8d\x24\xda\x55\xc4\xb4\x72\x46\x0b\x81\x26\x92\x54\xd1\x25\x9a\x1e\x80\xa0\xf7\x28\xac\x47\x03\xa0\x75\x7d\xf5\xd8\xdc\xa3\x51\xe8\xd0\x5e\x71\x1d\xbf\xc4\x78\xc5\x8c\x2b\xeb\xa8\x62\x1f\x31\x65\x5a\xd6\x5a\xa1\x72\x04\x3a\xf8\xd8\x1a\x99\xe7\xe2\x36\x35\x12\x18\x8a\xc6\x3a\x34\x59\xd9\x03\xa8\xb5\x71\x2d\xcd\xe8\xf9\x83\xc0\xa0\xdf\xff\xb5\xff\x2c\x01\x70\xd4\x2c\xd1\x95\xcf\xf2\x95\x73\x75\x2b\xae\x8d\x76\x9a\x69\x41\xa0\x1a\x96\xad\x6c\xb7\xa7\x83\xd1\x1e\xed\xeb\xd7\x2f\x1d\x58\xff\xe2\xfd\x4a\xeb\xc7\x77\xe1\x8e\x76\x16\x05\x32\xa7\x0d\xb9\x28\x01\x67\xe4\x37\x8a\xa2\x5e\x58\x2a\xb4\xae\x6d\x7c\xa8\x97\x5b\xac\x85\xde\x48\x54\xee\xc2\x92\xf9\x1f\x55\x8b\xc1\x5a\x70\x46\x2d\x81\xc1\x4f\x07\x20\xa9\x63\xab\x3c\xa0\xfd\x61\xe2\x67\x51\x3f\x9f\x3c\x80\x43\x59\x0b\xea\xb0\x25\x1a\x9c\x12\xc0\x69\xaa\xcf\x62\x7d\x26\xef\x4b\x98\x03\xec\x53\xef\x97\xdd\xb5\xa6\x84\x31\xdd\x28\xf7\x66\xfd\x78\x73\xaf\xa0\x5c\xa1\x39\x6c\x2e\x82\x57\xb7\xc2\x25\x5d\xb6\xb5\x9a\xf9\x9f\x53\x5c\x72\xeb\xcc\x06\xb6\xdb\xf8\x68\x4e\x0e\xfa\x8a\x2e\x77\xdd\x2f\x70\x2f\x1b\x21\x4a\x2d\x38\xdb\x10\xc8\x1e\xc6\xda\x95\x06\xad\xbf\x18\x7b\x2b\x83\x56\x37\x86\x61\x90\x6d\xff\x2f\xb8\xe4\xce\x12\x78\x3a\xe2\xf9\x65\xf0\x9f\x06\xed\xbe\x19\x1d\xff\x58\xdd\x10\xf8\xe5\x5a\xbe\x10\x4b\x94\xda\x6c\xbc\xe6\x5b\xc1\x0f\x3a\xa6\xa5\xa4\x6a\x11\x62\x44\x10\x37\xd6\xc4\x42\x33\x2a\xe2\x7b\xae\xe2\x77\xf2\x08\x80\x6a\x7d\x0a\xb0\x4b\xe3\x38\x29\xd2\x59\x99\x0c\xd3\x40\x07\xb0\xa6\xa2\xc1\x91\xd1\x32\x74\xf1\xeb\x81\xa3\x58\x4c\xf1\xe1\xa5\xbc\xd5\x94\xd4\xad\xc8\xa1\x3a\xaf\x0e\x0d\xa4\x23\x70\xf5\xf7\x09\xc4\x73\x44\x02\x77\xd5\xb0\xc3\x76\xf8\x7b\x32\x99\xcd\x6f\x93\xb4\x98\x8c\xe7\xe5\x64\x5a\x75\xb9\x7e\xfa\x64\x9d\x81\x2f\x83\xef\xdf\xbe\x77\x40\xdc\x94\xa3\x3f\xb2\x77\x7c\xaf\xfb\xfd\xeb\x41\x87\x6f\x95\x16\x65\x9e\x54\xe9\x3c\x4f\x6e\xd2\x7c\xd6\x05\xf0\xf9\xad\x7b\xb1\xbf\xbb\x9f\x3b\xb0\x87\x93\xf1\x28\xfb\x51\x24\xe5\xa5\xe0\xed\x63\xd2\x85\x9d\x8e\x93\x9b\x3c\x9d\xe7\x69\x72\x9b\x4e\xe7\x69\x9e\x0e\xab\x6c\x32\xee\x8c\xe0\xef\xc4\x08\xa9\x6b\x0c\xfe\xa0\xbe\x9f\xe6\x48\x17\x68\x52\xff\x3c\x71\xad\x60\xbb\x7d\x23\xc0\x28\xcb\xab\x74\x3a\x7f\xa3\x94\xba\x22\x8c\xb8\x70\x68\xc2\x47\xa5\x2b\xc4\x2c\x1d\xde\x4d\xb3\xea\xaf\x79\x31\xb9\xfd\x20\xf0\x0c\x59\x63\xb8\xdb\x14\x7a\xf1\x2a\x6a\x56\xdc\xf9\x13\xfd\x20\x20\x97\x8d\x3f\xbf\x13\xb0\xb5\x16\x8d\xc4\xc2\x77\x31\x4b\x3a\x42\xb4\xe7\x12\x31\x34\xce\x06\x7a\x00\xe9\x7d\x76\x37\x25\x46\xc7\xe2\xd6\x32\xfe\xd9\xd2\x20\x5d\x4c\x94\xd8\x10\x70\xa6\xc1\x83\x2a\x18\x6f\x3a\x63\x06\x9a\xa0\x83\xee\xe6\x9d\xdf\x8e\xa3\x4b\xe8\x19\x8c\x41\x9d\x6e\xe1\xf8\xb4\xdb\x79\x40\xe0\xbd\x2d\x5b\x64\x06\x5d\x48\x78\x2f\x1b\xbf\x68\xe5\xd1\x29\xc4\x7f\x03\x00\xf9\x15\x8e\x75\xdd\x0a\x00\x00"),
+		},
+		"/templates/namespace.yaml": &vfsgen۰FileInfo{
+			name:    "namespace.yaml",
+			modTime: time.Time{},
+			content: []byte("\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x76\x31\x0a\x6b\x69\x6e\x64\x3a\x20\x4e\x61\x6d\x65\x73\x70\x61\x63\x65\x0a\x6d\x65\x74\x61\x64\x61\x74\x61\x3a\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x7b\x7b\x20\x2e\x4e\x61\x6d\x65\x73\x70\x61\x63\x65\x20\x7d\x7d\x0a"),
+		},
+		"/templates/webhook-configuration.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "webhook-configuration.yaml",
+			modTime:          time.Time{},
+			uncompressedSize: 5285,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x96\x5d\x6f\xda\x3c\x14\xc7\xef\xf3\x29\x2c\xd4\x5b\xa7\xe2\xee\x91\xef\xfa\xd0\x17\x55\xeb\xb6\xaa\x74\xdd\xc5\xb4\x8b\x83\x73\x08\x1e\x8e\xed\xd9\x4e\x26\x84\xf8\xee\x93\xe3\x04\x12\x4a\x57\xb4\x4d\x6a\x61\x15\x95\x0a\xe7\xc5\xfa\x9f\xf3\x3b\x39\xf1\x5c\xa8\x8c\x91\x31\x72\x8b\x3e\x01\x23\x1e\xd0\x3a\xa1\x15\x23\xd5\x30\x29\xd0\x43\x06\x1e\x58\x42\x88\x82\x02\x19\xe1\x33\xd0\x8e\x16\xe8\x66\xf4\x07\x4e\x66\x5a\xcf\x29\x47\xeb\x5d\x13\xe0\x0c\x70\x64\x64\xb9\x24\xe9\x87\xf6\x27\x59\xad\x12\x42\x24\x4c\x50\xba\x70\x10\x21\x60\x4c\x3a\x2f\x27\x68\x15\x7a\x74\xa9\xd0\xa7\xdb\x87\x3f\x11\x26\x94\xf3\xa0\xf8\x3e\xa1\x5c\x17\x46\x2b\x54\x9e\x91\x56\xa8\x8b\x35\xfa\x85\x41\x46\x3e\x1a\xf8\x5e\x62\xd2\x56\xe7\xa5\x4b\xb9\xf5\x8c\x0c\x82\xf6\xfb\x9b\xf1\x08\xad\x27\xab\xd5\xa0\xf1\xcd\x71\xb1\xf1\xbd\xc3\x45\xed\xa2\x94\xf6\x3a\x06\x59\x21\x5c\x68\x9e\xc5\x5c\x38\x6f\xc1\x0b\xad\xd2\xf9\x7f\xb5\xa0\x6a\x38\x41\x0f\xc3\x24\xf6\xfb\x7d\xe9\xc1\x0b\x95\x7f\x8e\xda\x46\x5a\x4d\x45\x5e\xc6\x8c\x5f\xb7\xdd\x89\x0c\x39\x58\x2a\xd4\x37\xe4\x5e\xdb\x97\xed\xed\xba\xe4\x76\x1c\x92\xe6\xbf\x63\xc9\x72\x49\x89\x98\x92\xf4\x12\xc1\x97\x16\xaf\x20\xa4\x8f\xa3\xfc\xeb\x5a\xbd\xd0\x2a\x4e\x07\x6d\xea\x7c\x74\x5c\xba\x11\x93\x6a\x9b\xd7\x82\xb8\x14\xa8\x7c\x6c\x59\x60\x17\x3e\x1c\xfe\x2f\x55\x26\x31\x42\x3a\x49\x47\x67\xf1\x77\x83\x30\x84\x38\xb4\x95\xe0\xd8\x66\xec\x68\x2d\xd7\xca\x5b\x2d\x25\x5a\x5a\x80\x82\x1c\x6d\x2f\x76\x33\xdc\x27\xdb\xd3\x1d\x3f\x06\xfc\x8c\x91\xc1\x69\x44\x43\xab\x21\x35\x3a\x0b\x13\x44\x88\x2d\x25\x36\x84\x42\xb5\xda\x60\x64\xed\x18\xf9\x42\x06\xa3\xbb\x8b\xb3\xfb\x8b\x01\xf9\xba\x3e\x0a\x8c\xb8\xb2\xba\x34\xc1\x3f\x18\xf4\xec\xcd\xb8\xd5\x9e\x6a\xd8\xf1\x59\x74\xba\xb4\x1c\x6b\x8f\xd1\x99\x6b\x7c\x6b\xed\x63\x94\xf5\xc8\xb4\x3a\x0a\xf0\x7c\x76\xd3\x19\x9e\xf0\xf7\x08\x01\x23\xa8\x60\x22\x31\xab\x43\xa6\x20\x64\x69\xf1\x56\x4b\xc1\x17\x8c\x5c\xe7\x4a\x5b\xac\x51\xa3\xca\x42\x33\xc2\x57\x0b\x2a\x47\x92\x8e\xee\xce\x5d\xcb\xf7\x95\x43\x3b\x2d\xc2\x03\x89\xb4\x73\xb0\xb6\x39\xad\x86\x20\xcd\x0c\x86\x34\x6c\x85\x36\x6b\xab\x05\x97\x20\xe4\xba\xcf\x8c\x14\x4d\x68\x3a\x9f\xa4\x42\xef\x82\xbf\x61\xbb\x16\x11\xcc\x3b\x26\x7d\x9b\x78\x2f\xbe\xd5\xb6\x36\x76\x86\xaa\x17\x18\xa7\xab\x67\xfa\x74\x7b\xde\x35\x6d\x26\xa7\x17\xd5\x16\xdd\xa1\x7b\x04\x28\xb9\x45\x88\x7b\xf3\x59\x94\x4d\xe8\x81\xa3\xdc\xf9\x44\x3e\xa6\xdc\x5a\x7a\xb4\xb7\x6a\x2a\xb4\x9a\xe3\xc2\xfd\xc9\x9b\xef\x01\xa4\xc8\x7e\xf3\xdd\x57\x35\xb9\x5a\xbd\xee\xb7\xde\x01\xee\xbf\xa6\xb5\x7f\x63\x03\x56\x6f\x1b\xf0\x85\x37\xe0\xb3\x30\x8d\xd5\x3e\xde\xbf\xf6\xe1\xb9\x89\xfe\x87\x37\xe1\x11\x50\xcf\xd0\x58\xe4\xf0\x14\xf6\xe6\x2e\xb7\x91\x58\x75\x12\xde\xc8\x1f\x32\xf9\xda\xee\xb1\x30\x12\x3c\xee\xf3\xc8\xf7\x12\x0e\x9c\xfd\x5a\x51\x5b\x8f\x3b\x22\xa8\xf1\x46\xb6\x37\xd2\x18\x7e\x2c\x40\xdb\xeb\xe8\xf1\xe0\x54\xda\x8b\xa9\x78\x7a\x47\xef\x84\xda\x4d\x3a\x16\xb4\xdd\x9a\x5c\xf2\x73\x00\x02\x3f\x1c\x6d\xa5\x14\x00\x00"),
+		},
+	}
+	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/crd"].(os.FileInfo),
+		fs["/templates"].(os.FileInfo),
+	}
+	fs["/crd"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/crd/crd.yaml"].(os.FileInfo),
+	}
+	fs["/templates"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/templates/chaos-daemon.yaml"].(os.FileInfo),
+		fs["/templates/chaos-dashboard.yaml"].(os.FileInfo),
+		fs["/templates/controller-manager-rbac.yaml"].(os.FileInfo),
+		fs["/templates/controller-manager.yaml"].(os.FileInfo),
+		fs["/templates/namespace.yaml"].(os.FileInfo),
+		fs["/templates/webhook-configuration.yaml"].(os.FileInfo),
+	}
+
+	return fs
+}()
+
+type vfsgen۰FS map[string]interface{}
+
+func (fs vfsgen۰FS) Open(path string) (http.File, error) {
+	path = pathpkg.Clean("/" + path)
+	f, ok := fs[path]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+
+	switch f := f.(type) {
+	case *vfsgen۰CompressedFileInfo:
+		gr, err := gzip.NewReader(bytes.NewReader(f.compressedContent))
+		if err != nil {
+			// This should never happen because we generate the gzip bytes such that they are always valid.
+			panic("unexpected error reading own gzip compressed bytes: " + err.Error())
+		}
+		return &vfsgen۰CompressedFile{
+			vfsgen۰CompressedFileInfo: f,
+			gr:                        gr,
+		}, nil
+	case *vfsgen۰FileInfo:
+		return &vfsgen۰File{
+			vfsgen۰FileInfo: f,
+			Reader:          bytes.NewReader(f.content),
+		}, nil
+	case *vfsgen۰DirInfo:
+		return &vfsgen۰Dir{
+			vfsgen۰DirInfo: f,
+		}, nil
+	default:
+		// This should never happen because we generate only the above types.
+		panic(fmt.Sprintf("unexpected type %T", f))
+	}
+}
+
+// vfsgen۰CompressedFileInfo is a static definition of a gzip compressed file.
+type vfsgen۰CompressedFileInfo struct {
+	name              string
+	modTime           time.Time
+	compressedContent []byte
+	uncompressedSize  int64
+}
+
+func (f *vfsgen۰CompressedFileInfo) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
+}
+func (f *vfsgen۰CompressedFileInfo) Stat() (os.FileInfo, error) { return f, nil }
+
+func (f *vfsgen۰CompressedFileInfo) GzipBytes() []byte {
+	return f.compressedContent
+}
+
+func (f *vfsgen۰CompressedFileInfo) Name() string       { return f.name }
+func (f *vfsgen۰CompressedFileInfo) Size() int64        { return f.uncompressedSize }
+func (f *vfsgen۰CompressedFileInfo) Mode() os.FileMode  { return 0444 }
+func (f *vfsgen۰CompressedFileInfo) ModTime() time.Time { return f.modTime }
+func (f *vfsgen۰CompressedFileInfo) IsDir() bool        { return false }
+func (f *vfsgen۰CompressedFileInfo) Sys() interface{}   { return nil }
+
+// vfsgen۰CompressedFile is an opened compressedFile instance.
+type vfsgen۰CompressedFile struct {
+	*vfsgen۰CompressedFileInfo
+	gr      *gzip.Reader
+	grPos   int64 // Actual gr uncompressed position.
+	seekPos int64 // Seek uncompressed position.
+}
+
+func (f *vfsgen۰CompressedFile) Read(p []byte) (n int, err error) {
+	if f.grPos > f.seekPos {
+		// Rewind to beginning.
+		err = f.gr.Reset(bytes.NewReader(f.compressedContent))
+		if err != nil {
+			return 0, err
+		}
+		f.grPos = 0
+	}
+	if f.grPos < f.seekPos {
+		// Fast-forward.
+		_, err = io.CopyN(ioutil.Discard, f.gr, f.seekPos-f.grPos)
+		if err != nil {
+			return 0, err
+		}
+		f.grPos = f.seekPos
+	}
+	n, err = f.gr.Read(p)
+	f.grPos += int64(n)
+	f.seekPos = f.grPos
+	return n, err
+}
+func (f *vfsgen۰CompressedFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		f.seekPos = 0 + offset
+	case io.SeekCurrent:
+		f.seekPos += offset
+	case io.SeekEnd:
+		f.seekPos = f.uncompressedSize + offset
+	default:
+		panic(fmt.Errorf("invalid whence value: %v", whence))
+	}
+	return f.seekPos, nil
+}
+func (f *vfsgen۰CompressedFile) Close() error {
+	return f.gr.Close()
+}
+
+// vfsgen۰FileInfo is a static definition of an uncompressed file (because it's not worth gzip compressing).
+type vfsgen۰FileInfo struct {
+	name    string
+	modTime time.Time
+	content []byte
+}
+
+func (f *vfsgen۰FileInfo) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
+}
+func (f *vfsgen۰FileInfo) Stat() (os.FileInfo, error) { return f, nil }
+
+func (f *vfsgen۰FileInfo) NotWorthGzipCompressing() {}
+
+func (f *vfsgen۰FileInfo) Name() string       { return f.name }
+func (f *vfsgen۰FileInfo) Size() int64        { return int64(len(f.content)) }
+func (f *vfsgen۰FileInfo) Mode() os.FileMode  { return 0444 }
+func (f *vfsgen۰FileInfo) ModTime() time.Time { return f.modTime }
+func (f *vfsgen۰FileInfo) IsDir() bool        { return false }
+func (f *vfsgen۰FileInfo) Sys() interface{}   { return nil }
+
+// vfsgen۰File is an opened file instance.
+type vfsgen۰File struct {
+	*vfsgen۰FileInfo
+	*bytes.Reader
+}
+
+func (f *vfsgen۰File) Close() error {
+	return nil
+}
+
+// vfsgen۰DirInfo is a static definition of a directory.
+type vfsgen۰DirInfo struct {
+	name    string
+	modTime time.Time
+	entries []os.FileInfo
+}
+
+func (d *vfsgen۰DirInfo) Read([]byte) (int, error) {
+	return 0, fmt.Errorf("cannot Read from directory %s", d.name)
+}
+func (d *vfsgen۰DirInfo) Close() error               { return nil }
+func (d *vfsgen۰DirInfo) Stat() (os.FileInfo, error) { return d, nil }
+
+func (d *vfsgen۰DirInfo) Name() string       { return d.name }
+func (d *vfsgen۰DirInfo) Size() int64        { return 0 }
+func (d *vfsgen۰DirInfo) Mode() os.FileMode  { return 0755 | os.ModeDir }
+func (d *vfsgen۰DirInfo) ModTime() time.Time { return d.modTime }
+func (d *vfsgen۰DirInfo) IsDir() bool        { return true }
+func (d *vfsgen۰DirInfo) Sys() interface{}   { return nil }
+
+// vfsgen۰Dir is an opened dir instance.
+type vfsgen۰Dir struct {
+	*vfsgen۰DirInfo
+	pos int // Position within entries for Seek and Readdir.
+}
+
+func (d *vfsgen۰Dir) Seek(offset int64, whence int) (int64, error) {
+	if offset == 0 && whence == io.SeekStart {
+		d.pos = 0
+		return 0, nil
+	}
+	return 0, fmt.Errorf("unsupported Seek in directory %s", d.name)
+}
+
+func (d *vfsgen۰Dir) Readdir(count int) ([]os.FileInfo, error) {
+	if d.pos >= len(d.entries) && count > 0 {
+		return nil, io.EOF
+	}
+	if count <= 0 || count > len(d.entries)-d.pos {
+		count = len(d.entries) - d.pos
+	}
+	e := d.entries[d.pos : d.pos+count]
+	d.pos += count
+	return e, nil
+}
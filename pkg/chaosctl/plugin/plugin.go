@@ -0,0 +1,264 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// none is printed in the columns which have no value
+const none = "<none>"
+
+// ChaosRow is a line of `kubectl chaos get`
+type ChaosRow struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Action    string
+	Phase     string
+	Paused    bool
+	// Targets is the number of the pods which the chaos is injected into
+	Targets int
+	// Remaining is the time until the chaos is recovered, it's negative if the chaos isn't going to be recovered
+	Remaining time.Duration
+	Age       time.Duration
+}
+
+// InjectedPod is a line of `kubectl chaos top`, which is a pod with the running chaos injected
+type InjectedPod struct {
+	Namespace string
+	Name      string
+	HostIP    string
+	// Chaos are the running chaos on the pod in the format of kind/namespace/name
+	Chaos []string
+	// Actions are the actions of the chaos
+	Actions []string
+}
+
+// ListChaos lists the chaos of the kinds in the namespace, the chaos of all the kinds are listed if kinds is empty,
+// and the chaos in all the namespaces are listed if namespace is empty
+func ListChaos(ctx context.Context, c client.Client, kinds []string, namespace string) ([]v1alpha1.InnerObject, error) {
+	if len(kinds) == 0 {
+		for kind := range v1alpha1.AllKinds() {
+			kinds = append(kinds, kind)
+		}
+	}
+	sort.Strings(kinds)
+
+	var chaos []v1alpha1.InnerObject
+	for _, kind := range kinds {
+		chaosKind, ok := v1alpha1.AllKinds()[kind]
+		if !ok {
+			return nil, fmt.Errorf("unknown chaos kind %s", kind)
+		}
+		list := chaosKind.ChaosList.DeepCopyObject()
+		if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			inner, ok := item.(v1alpha1.InnerObject)
+			if !ok {
+				return nil, fmt.Errorf("%s is not a chaos object", kind)
+			}
+			chaos = append(chaos, inner)
+		}
+	}
+	return chaos, nil
+}
+
+// NewChaosRow returns the row of the chaos observed at now
+func NewChaosRow(chaos v1alpha1.InnerObject, now time.Time) ChaosRow {
+	instance := chaos.GetChaos()
+	status := chaos.GetStatus()
+	accessor := chaos.(metav1.Object)
+
+	row := ChaosRow{
+		Kind:      instance.Kind,
+		Namespace: instance.Namespace,
+		Name:      instance.Name,
+		Action:    instance.Action,
+		Phase:     string(status.Experiment.Phase),
+		Paused:    chaos.IsPaused(),
+		Remaining: -1,
+		Age:       now.Sub(accessor.GetCreationTimestamp().Time),
+	}
+	if status.Experiment.Phase != v1alpha1.ExperimentPhaseRunning {
+		return row
+	}
+
+	row.Targets = len(status.Experiment.PodRecords)
+	var end time.Time
+	if scheduled, ok := chaos.(v1alpha1.InnerSchedulerObject); ok {
+		if next := scheduled.GetNextRecover(); !next.IsZero() {
+			end = next
+		} else if d, err := scheduled.GetDuration(); err == nil && d != nil && status.Experiment.StartTime != nil {
+			end = status.Experiment.StartTime.Add(*d)
+		}
+	}
+	if !end.IsZero() {
+		row.Remaining = end.Sub(now)
+		// the recovery is overdue
+		if row.Remaining < 0 {
+			row.Remaining = 0
+		}
+	}
+	return row
+}
+
+// Top returns the pods with the running chaos injected, which are sorted by their namespaces and names
+func Top(chaos []v1alpha1.InnerObject) []InjectedPod {
+	byKey := make(map[string]*InjectedPod)
+	for _, ch := range chaos {
+		status := ch.GetStatus()
+		if status.Experiment.Phase != v1alpha1.ExperimentPhaseRunning {
+			continue
+		}
+		instance := ch.GetChaos()
+		for _, record := range status.Experiment.PodRecords {
+			key := record.Namespace + "/" + record.Name
+			pod, ok := byKey[key]
+			if !ok {
+				pod = &InjectedPod{Namespace: record.Namespace, Name: record.Name, HostIP: record.HostIP}
+				byKey[key] = pod
+			}
+			pod.Chaos = append(pod.Chaos, instance.Kind+"/"+instance.Namespace+"/"+instance.Name)
+			action := record.Action
+			if action == "" {
+				action = instance.Action
+			}
+			pod.Actions = append(pod.Actions, action)
+		}
+	}
+
+	pods := make([]InjectedPod, 0, len(byKey))
+	for _, pod := range byKey {
+		pods = append(pods, *pod)
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+		return pods[i].Name < pods[j].Name
+	})
+	return pods
+}
+
+// PrintChaos prints the rows in columns, the namespace is printed if allNamespaces is true
+func PrintChaos(w io.Writer, rows []ChaosRow, allNamespaces bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	header := "KIND\tNAME\tACTION\tPHASE\tTARGETS\tREMAINING\tAGE"
+	if allNamespaces {
+		header = "NAMESPACE\t" + header
+	}
+	fmt.Fprintln(tw, header)
+
+	for _, row := range rows {
+		phase := row.Phase
+		if phase == "" {
+			phase = none
+		}
+		if row.Paused {
+			phase += "(paused)"
+		}
+		remaining := none
+		if row.Remaining >= 0 {
+			remaining = duration.HumanDuration(row.Remaining)
+		}
+		action := row.Action
+		if action == "" {
+			action = none
+		}
+
+		line := fmt.Sprintf("%s\t%s\t%s\t%s\t%d\t%s\t%s", row.Kind, row.Name, action, phase, row.Targets,
+			remaining, duration.HumanDuration(row.Age))
+		if allNamespaces {
+			line = row.Namespace + "\t" + line
+		}
+		fmt.Fprintln(tw, line)
+	}
+	return tw.Flush()
+}
+
+// PrintTop prints the injected pods in columns, and the number of them in each namespace
+func PrintTop(w io.Writer, pods []InjectedPod) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, "NAMESPACE\tPOD\tHOST\tACTIONS\tCHAOS")
+	for _, pod := range pods {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", pod.Namespace, pod.Name, pod.HostIP,
+			strings.Join(pod.Actions, ","), strings.Join(pod.Chaos, ","))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	var namespaces []string
+	counts := make(map[string]int)
+	for _, pod := range pods {
+		if counts[pod.Namespace] == 0 {
+			namespaces = append(namespaces, pod.Namespace)
+		}
+		counts[pod.Namespace]++
+	}
+	if len(namespaces) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, "NAMESPACE\tINJECTED PODS")
+	for _, ns := range namespaces {
+		fmt.Fprintf(tw, "%s\t%d\n", ns, counts[ns])
+	}
+	return tw.Flush()
+}
+
+// SetPaused pauses or resumes the chaos by the pause annotation
+func SetPaused(ctx context.Context, c client.Client, chaos v1alpha1.InnerObject, paused bool) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				v1alpha1.PauseAnnotationKey: fmt.Sprint(paused),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return c.Patch(ctx, chaos, client.ConstantPatch(types.MergePatchType, patch))
+}
+
+// Archive deletes the chaos, the chaos is recovered by the controller, then it's archived by chaos-dashboard
+// and its results are kept
+func Archive(ctx context.Context, c client.Client, chaos v1alpha1.InnerObject) error {
+	return c.Delete(ctx, chaos)
+}
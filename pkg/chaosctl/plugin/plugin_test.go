@@ -0,0 +1,144 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func runningNetworkChaos(name string, start time.Time, pods ...string) *v1alpha1.NetworkChaos {
+	duration := "10m"
+	chaos := &v1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(start),
+		},
+		Spec: v1alpha1.NetworkChaosSpec{
+			Action:   v1alpha1.DelayAction,
+			Duration: &duration,
+		},
+	}
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	chaos.Status.Experiment.StartTime = &metav1.Time{Time: start}
+	for _, pod := range pods {
+		chaos.Status.Experiment.PodRecords = append(chaos.Status.Experiment.PodRecords, v1alpha1.PodStatus{
+			Namespace: "app",
+			Name:      pod,
+			HostIP:    "10.0.0.1",
+			Action:    string(v1alpha1.DelayAction),
+		})
+	}
+	return chaos
+}
+
+func TestNewChaosRow(t *testing.T) {
+	g := NewGomegaWithT(t)
+	now := time.Now()
+
+	chaos := runningNetworkChaos("delay", now.Add(-4*time.Minute), "web-0", "web-1")
+	g.Expect(NewChaosRow(chaos, now)).To(Equal(ChaosRow{
+		Kind:      v1alpha1.KindNetworkChaos,
+		Namespace: "default",
+		Name:      "delay",
+		Action:    string(v1alpha1.DelayAction),
+		Phase:     string(v1alpha1.ExperimentPhaseRunning),
+		Targets:   2,
+		Remaining: 6 * time.Minute,
+		Age:       4 * time.Minute,
+	}))
+
+	// the next recovery of the scheduler comes first
+	chaos.Status.Scheduler.NextRecover = &metav1.Time{Time: now.Add(time.Minute)}
+	g.Expect(NewChaosRow(chaos, now).Remaining).To(Equal(time.Minute))
+
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
+	row := NewChaosRow(chaos, now)
+	g.Expect(row.Targets).To(Equal(0))
+	g.Expect(row.Remaining).To(BeNumerically("<", 0))
+}
+
+func TestTop(t *testing.T) {
+	g := NewGomegaWithT(t)
+	now := time.Now()
+
+	finished := runningNetworkChaos("finished", now, "web-2")
+	finished.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFinished
+	chaos := []v1alpha1.InnerObject{
+		runningNetworkChaos("delay", now, "web-1", "web-0"),
+		runningNetworkChaos("another", now, "web-0"),
+		finished,
+	}
+
+	pods := Top(chaos)
+	g.Expect(pods).To(Equal([]InjectedPod{
+		{
+			Namespace: "app",
+			Name:      "web-0",
+			HostIP:    "10.0.0.1",
+			Chaos:     []string{"NetworkChaos/default/delay", "NetworkChaos/default/another"},
+			Actions:   []string{"delay", "delay"},
+		},
+		{
+			Namespace: "app",
+			Name:      "web-1",
+			HostIP:    "10.0.0.1",
+			Chaos:     []string{"NetworkChaos/default/delay"},
+			Actions:   []string{"delay"},
+		},
+	}))
+
+	var out bytes.Buffer
+	g.Expect(PrintTop(&out, pods)).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("NAMESPACE   INJECTED PODS\napp         2\n"))
+}
+
+func TestPauseAndArchive(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(scheme)
+	chaos := runningNetworkChaos("delay", time.Now())
+	c := fake.NewFakeClientWithScheme(scheme, chaos)
+	ctx := context.Background()
+
+	list, err := ListChaos(ctx, c, nil, "default")
+	g.Expect(err).To(BeNil())
+	g.Expect(list).To(HaveLen(1))
+
+	key := types.NamespacedName{Namespace: "default", Name: "delay"}
+	g.Expect(SetPaused(ctx, c, list[0], true)).To(Succeed())
+	g.Expect(c.Get(ctx, key, chaos)).To(Succeed())
+	g.Expect(chaos.IsPaused()).To(BeTrue())
+
+	g.Expect(SetPaused(ctx, c, chaos, false)).To(Succeed())
+	g.Expect(c.Get(ctx, key, chaos)).To(Succeed())
+	g.Expect(chaos.IsPaused()).To(BeFalse())
+
+	g.Expect(Archive(ctx, c, chaos)).To(Succeed())
+	list, err = ListChaos(ctx, c, []string{v1alpha1.KindNetworkChaos}, "")
+	g.Expect(err).To(BeNil())
+	g.Expect(list).To(BeEmpty())
+}
@@ -0,0 +1,142 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/yaml"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// Result is the result of validating a chaos
+type Result struct {
+	// Chaos is the chaos resolved by the defaulting webhook
+	Chaos runtime.Object
+	// Errors is the errors reported by the validating webhooks
+	Errors []string
+	// Pods is the pods which the chaos would target
+	Pods []v1.Pod
+	// TargetPods is the pods which are selected by the target of NetworkChaos
+	TargetPods []v1.Pod
+}
+
+// Decode decodes the chaos in the YAML or JSON documents, the namespace
+// of chaos is set to defaultNamespace if it's empty.
+func Decode(r io.Reader, defaultNamespace string) ([]runtime.Object, error) {
+	var objects []runtime.Object
+
+	decoder := yamlutil.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var raw runtime.RawExtension
+		if err := decoder.Decode(&raw); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		data := bytes.TrimSpace(raw.Raw)
+		if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+			continue
+		}
+
+		var u unstructured.Unstructured
+		if err := yaml.Unmarshal(data, &u.Object); err != nil {
+			return nil, err
+		}
+		kind, ok := v1alpha1.AllKinds()[u.GetKind()]
+		if !ok {
+			return nil, fmt.Errorf("%s %s is not a chaos", u.GetKind(), u.GetName())
+		}
+
+		obj := kind.Chaos.DeepCopyObject()
+		if err := yaml.UnmarshalStrict(data, obj); err != nil {
+			return nil, fmt.Errorf("failed to decode %s %s: %v", u.GetKind(), u.GetName(), err)
+		}
+		if meta, ok := obj.(metav1.Object); ok && meta.GetNamespace() == "" {
+			meta.SetNamespace(defaultNamespace)
+		}
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// Validate runs the chaos through the same defaulting and validation as the webhooks,
+// and selects the pods which it would target without creating anything in the cluster.
+func Validate(ctx context.Context, c client.Client, chaos runtime.Object) (*Result, error) {
+	result := &Result{Chaos: chaos}
+
+	if defaulter, ok := chaos.(webhook.Defaulter); ok {
+		defaulter.Default()
+	}
+
+	if validator, ok := chaos.(webhook.Validator); ok {
+		if err := validator.ValidateCreate(); err != nil {
+			result.Errors = append(result.Errors, err.Error())
+		}
+	}
+
+	if selectorObject, ok := chaos.(v1alpha1.SelectorObject); ok {
+		protected, err := utils.IsExclusivelyProtected(ctx, c, selectorObject.GetSelectorSpecs())
+		if err != nil {
+			return nil, err
+		}
+		if protected {
+			result.Errors = append(result.Errors, "the chaos only targets the pods protected by ChaosProtection")
+		}
+	}
+
+	// the selection is meaningless if the selector is invalid
+	if len(result.Errors) > 0 {
+		return result, nil
+	}
+
+	spec := reflect.ValueOf(chaos).Elem().FieldByName("Spec")
+	if !spec.IsValid() {
+		return result, nil
+	}
+
+	if selectSpec, ok := spec.Addr().Interface().(utils.SelectSpec); ok {
+		pods, err := utils.SelectAndFilterPods(ctx, c, selectSpec)
+		if err != nil && !errors.Is(err, utils.ErrNoPodSelected) {
+			return nil, err
+		}
+		result.Pods = pods
+	}
+
+	if networkChaos, ok := chaos.(*v1alpha1.NetworkChaos); ok && networkChaos.Spec.Target != nil {
+		pods, err := utils.SelectAndFilterPods(ctx, c, networkChaos.Spec.Target)
+		if err != nil && !errors.Is(err, utils.ErrNoPodSelected) {
+			return nil, err
+		}
+		result.TargetPods = pods
+	}
+
+	return result, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestDecode(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	objects, err := Decode(strings.NewReader(`
+apiVersion: chaos-mesh.org/v1alpha1
+kind: PodChaos
+metadata:
+  name: kill
+spec:
+  action: pod-kill
+  mode: one
+  selector:
+    labelSelectors:
+      app: web
+---
+apiVersion: chaos-mesh.org/v1alpha1
+kind: NetworkChaos
+metadata:
+  name: delay
+  namespace: web
+spec:
+  action: delay
+  mode: all
+  selector: {}
+  delay:
+    latency: 10ms
+`), "default")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(objects).To(HaveLen(2))
+	g.Expect(objects[0].(*v1alpha1.PodChaos).Namespace).To(Equal("default"))
+	g.Expect(objects[1].(*v1alpha1.NetworkChaos).Namespace).To(Equal("web"))
+
+	_, err = Decode(strings.NewReader("apiVersion: v1\nkind: Pod\nmetadata:\n  name: p\n"), "default")
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = Decode(strings.NewReader("apiVersion: chaos-mesh.org/v1alpha1\nkind: PodChaos\nspec:\n  unknown: 1\n"), "default")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestValidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web-0", Labels: map[string]string{"app": "web"}},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	}
+	c := fake.NewFakeClientWithScheme(scheme, pod)
+
+	duration := "30"
+	chaos := &v1alpha1.PodChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "kill"},
+		Spec: v1alpha1.PodChaosSpec{
+			Action:    v1alpha1.PodFailureAction,
+			Mode:      v1alpha1.AllPodMode,
+			Duration:  &duration,
+			Scheduler: &v1alpha1.SchedulerSpec{Cron: " @every 2m "},
+			Selector:  v1alpha1.SelectorSpec{LabelSelectors: map[string]string{"app": "web"}},
+		},
+	}
+	result, err := Validate(context.TODO(), c, chaos)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Errors).To(BeEmpty())
+	g.Expect(*chaos.Spec.Duration).To(Equal("30s"))
+	g.Expect(chaos.Spec.Scheduler.Cron).To(Equal("@every 2m"))
+	g.Expect(chaos.Spec.Selector.Namespaces).To(Equal([]string{"default"}))
+	g.Expect(result.Pods).To(HaveLen(1))
+	g.Expect(result.Pods[0].Name).To(Equal("web-0"))
+
+	chaos.Spec.Mode = v1alpha1.FixedPodMode
+	chaos.Spec.Value = "abc"
+	result, err = Validate(context.TODO(), c, chaos)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Errors).ToNot(BeEmpty())
+	g.Expect(result.Pods).To(BeNil())
+}
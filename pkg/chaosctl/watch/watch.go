@@ -0,0 +1,216 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+)
+
+// TargetState is the state of a target pod of the chaos
+type TargetState struct {
+	Action   string
+	Message  string
+	PodPhase string
+}
+
+// Snapshot is the state of the chaos and its targets at a moment
+type Snapshot struct {
+	Phase   string
+	Reason  string
+	Targets map[string]TargetState
+}
+
+// Row is a line of the watch output
+type Row struct {
+	Time    time.Time
+	Object  string
+	Status  string
+	Message string
+}
+
+// Diff returns the rows of the transitions from the old snapshot to the new one.
+// The old snapshot is nil for the first observation.
+func Diff(old, new *Snapshot, now time.Time) []Row {
+	var rows []Row
+	if old == nil {
+		old = &Snapshot{Targets: map[string]TargetState{}}
+	}
+
+	if old.Phase != new.Phase || old.Reason != new.Reason {
+		rows = append(rows, Row{Time: now, Object: "experiment", Status: new.Phase, Message: new.Reason})
+	}
+
+	keys := make([]string, 0, len(new.Targets))
+	for key := range new.Targets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		state := new.Targets[key]
+		if oldState, ok := old.Targets[key]; ok && oldState == state {
+			continue
+		}
+		status := "injected"
+		if state.Action != "" {
+			status = fmt.Sprintf("injected(%s)", state.Action)
+		}
+		message := fmt.Sprintf("pod %s", state.PodPhase)
+		if state.Message != "" {
+			message = fmt.Sprintf("%s, %s", message, state.Message)
+		}
+		rows = append(rows, Row{Time: now, Object: "pod/" + key, Status: status, Message: message})
+	}
+
+	var removed []string
+	for key := range old.Targets {
+		if _, ok := new.Targets[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+	for _, key := range removed {
+		rows = append(rows, Row{Time: now, Object: "pod/" + key, Status: "recovered"})
+	}
+
+	return rows
+}
+
+// Watch streams the status transitions of the chaos, its targets and the related events
+// to w until the chaos is deleted or ctx is done.
+func Watch(ctx context.Context, c *common.ClientSet, kind, namespace, name string, interval time.Duration, w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIME\tOBJECT\tSTATUS\tMESSAGE")
+	tw.Flush()
+
+	var last *Snapshot
+	seenEvents := make(map[string]bool)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		chaos, err := common.GetChaos(ctx, c.CtrlCli, kind, namespace, name)
+		if k8serror.IsNotFound(err) && last != nil {
+			printRows(tw, []Row{{Time: time.Now(), Object: "experiment", Status: "deleted"}})
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		snapshot, err := takeSnapshot(ctx, c, chaos)
+		if err != nil {
+			return err
+		}
+		rows := Diff(last, snapshot, time.Now())
+		last = snapshot
+
+		events, err := c.KubeCli.CoreV1().Events(namespace).List(metav1.ListOptions{
+			FieldSelector: fields.Set{
+				"involvedObject.kind": kind,
+				"involvedObject.name": name,
+			}.AsSelector().String(),
+		})
+		if err != nil {
+			return err
+		}
+		rows = append(rows, eventRows(events.Items, seenEvents)...)
+
+		printRows(tw, rows)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func takeSnapshot(ctx context.Context, c *common.ClientSet, chaos v1alpha1.InnerObject) (*Snapshot, error) {
+	experiment := chaos.GetStatus().Experiment
+	snapshot := &Snapshot{
+		Phase:   string(experiment.Phase),
+		Reason:  experiment.Reason,
+		Targets: make(map[string]TargetState),
+	}
+
+	for _, record := range experiment.PodRecords {
+		state := TargetState{
+			Action:  record.Action,
+			Message: record.Message,
+		}
+
+		var pod v1.Pod
+		err := c.CtrlCli.Get(ctx, types.NamespacedName{Namespace: record.Namespace, Name: record.Name}, &pod)
+		switch {
+		case k8serror.IsNotFound(err):
+			state.PodPhase = "NotFound"
+		case err != nil:
+			return nil, err
+		default:
+			state.PodPhase = string(pod.Status.Phase)
+		}
+
+		snapshot.Targets[fmt.Sprintf("%s/%s", record.Namespace, record.Name)] = state
+	}
+
+	return snapshot, nil
+}
+
+// eventRows returns the rows of events which haven't been seen, an event is seen
+// again when it occurs repeatedly.
+func eventRows(events []v1.Event, seen map[string]bool) []Row {
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].LastTimestamp.Before(&events[j].LastTimestamp)
+	})
+
+	var rows []Row
+	for _, event := range events {
+		key := fmt.Sprintf("%s/%d", event.UID, event.Count)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		rows = append(rows, Row{
+			Time:    event.LastTimestamp.Time,
+			Object:  "event",
+			Status:  fmt.Sprintf("%s(%s)", event.Type, event.Reason),
+			Message: event.Message,
+		})
+	}
+	return rows
+}
+
+func printRows(tw *tabwriter.Writer, rows []Row) {
+	for _, row := range rows {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", row.Time.Format("15:04:05"), row.Object, row.Status, row.Message)
+	}
+	tw.Flush()
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDiff(t *testing.T) {
+	g := NewGomegaWithT(t)
+	now := time.Now()
+
+	first := &Snapshot{
+		Phase: "Running",
+		Targets: map[string]TargetState{
+			"default/web-0": {Action: "delay", PodPhase: "Running"},
+		},
+	}
+	g.Expect(Diff(nil, first, now)).To(Equal([]Row{
+		{Time: now, Object: "experiment", Status: "Running"},
+		{Time: now, Object: "pod/default/web-0", Status: "injected(delay)", Message: "pod Running"},
+	}))
+
+	g.Expect(Diff(first, first, now)).To(BeEmpty())
+
+	second := &Snapshot{
+		Phase: "Running",
+		Targets: map[string]TargetState{
+			"default/web-1": {PodPhase: "NotFound"},
+		},
+	}
+	g.Expect(Diff(first, second, now)).To(Equal([]Row{
+		{Time: now, Object: "pod/default/web-1", Status: "injected", Message: "pod NotFound"},
+		{Time: now, Object: "pod/default/web-0", Status: "recovered"},
+	}))
+}
+
+func TestEventRows(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := metav1.Now()
+	events := []v1.Event{
+		{
+			ObjectMeta:    metav1.ObjectMeta{UID: "a"},
+			Type:          v1.EventTypeWarning,
+			Reason:        "InjectFailed",
+			Message:       "failed",
+			Count:         1,
+			LastTimestamp: now,
+		},
+	}
+	seen := make(map[string]bool)
+	g.Expect(eventRows(events, seen)).To(HaveLen(1))
+	g.Expect(eventRows(events, seen)).To(BeEmpty())
+
+	events[0].Count = 2
+	rows := eventRows(events, seen)
+	g.Expect(rows).To(HaveLen(1))
+	g.Expect(rows[0].Status).To(Equal("Warning(InjectFailed)"))
+}
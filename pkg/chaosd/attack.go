@@ -0,0 +1,218 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// commander runs the commands of attacks on the machine
+type commander interface {
+	// Run runs the command and waits for it to complete
+	Run(ctx context.Context, name string, args ...string) error
+	// Start starts the command in background, the command is stopped by calling stop
+	Start(name string, args ...string) (stop func() error, err error)
+}
+
+type execCommander struct{}
+
+func (execCommander) Run(ctx context.Context, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s failed: %v, output: %s", cmd.String(), err, string(out))
+	}
+	return nil
+}
+
+func (execCommander) Start(name string, args ...string) (func() error, error) {
+	cmd := exec.Command(name, args...)
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start %s: %v", cmd.String(), err)
+	}
+
+	return func() error {
+		// the process may have exited already, in which case Kill fails and Wait reports the exit status
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return nil
+	}, nil
+}
+
+// attacker injects and recovers a specific fault
+type attacker interface {
+	Apply(ctx context.Context, c commander) error
+	Recover(ctx context.Context, c commander) error
+}
+
+func newAttacker(req *AttackRequest) (attacker, error) {
+	switch req.Action {
+	case v1alpha1.PMNetworkDelayAction, v1alpha1.PMNetworkLossAction,
+		v1alpha1.PMNetworkCorruptAction, v1alpha1.PMNetworkDuplicateAction:
+		if req.Network == nil || req.Network.Device == "" {
+			return nil, fmt.Errorf("network device is required by action %s", req.Action)
+		}
+		return &networkAttacker{action: req.Action, spec: req.Network}, nil
+	case v1alpha1.PMStressCPUAction, v1alpha1.PMStressMemAction:
+		if req.Stress == nil || req.Stress.Workers <= 0 {
+			return nil, fmt.Errorf("stress workers is required by action %s", req.Action)
+		}
+		return &stressAttacker{action: req.Action, spec: req.Stress}, nil
+	case v1alpha1.PMDiskFillAction:
+		if req.Disk == nil || req.Disk.Path == "" {
+			return nil, fmt.Errorf("disk path is required by action %s", req.Action)
+		}
+		return &diskAttacker{spec: req.Disk}, nil
+	case v1alpha1.PMProcessKillAction:
+		if req.Process == nil || req.Process.Process == "" {
+			return nil, fmt.Errorf("process is required by action %s", req.Action)
+		}
+		return &processAttacker{spec: req.Process}, nil
+	}
+
+	return nil, fmt.Errorf("unknown action %s", req.Action)
+}
+
+// networkAttacker adds a netem qdisc to the root of the network device
+type networkAttacker struct {
+	action v1alpha1.PhysicalMachineChaosAction
+	spec   *v1alpha1.PhysicalNetworkSpec
+}
+
+func (a *networkAttacker) args() []string {
+	args := []string{"qdisc", "add", "dev", a.spec.Device, "root", "netem"}
+
+	switch a.action {
+	case v1alpha1.PMNetworkDelayAction:
+		args = append(args, "delay", a.spec.Latency)
+		if a.spec.Jitter != "" {
+			args = append(args, a.spec.Jitter)
+		}
+	case v1alpha1.PMNetworkLossAction:
+		args = append(args, "loss", a.spec.Percent+"%")
+	case v1alpha1.PMNetworkCorruptAction:
+		args = append(args, "corrupt", a.spec.Percent+"%")
+	case v1alpha1.PMNetworkDuplicateAction:
+		args = append(args, "duplicate", a.spec.Percent+"%")
+	}
+
+	if a.spec.Correlation != "" {
+		args = append(args, a.spec.Correlation+"%")
+	}
+
+	return args
+}
+
+func (a *networkAttacker) Apply(ctx context.Context, c commander) error {
+	return c.Run(ctx, "tc", a.args()...)
+}
+
+func (a *networkAttacker) Recover(ctx context.Context, c commander) error {
+	return c.Run(ctx, "tc", "qdisc", "del", "dev", a.spec.Device, "root")
+}
+
+// stressAttacker runs stress-ng in background until it is recovered
+type stressAttacker struct {
+	action v1alpha1.PhysicalMachineChaosAction
+	spec   *v1alpha1.PhysicalStressSpec
+
+	stop func() error
+}
+
+func (a *stressAttacker) args() ([]string, error) {
+	workers := strconv.Itoa(a.spec.Workers)
+
+	if a.action == v1alpha1.PMStressCPUAction {
+		args := []string{"--cpu", workers}
+		if a.spec.Load != nil {
+			args = append(args, "--cpu-load", strconv.Itoa(*a.spec.Load))
+		}
+		return args, nil
+	}
+
+	size, err := v1alpha1.ParsePhysicalSize(a.spec.Size)
+	if err != nil {
+		return nil, err
+	}
+	return []string{"--vm", workers, "--vm-bytes", strconv.FormatInt(size, 10), "--vm-keep"}, nil
+}
+
+func (a *stressAttacker) Apply(ctx context.Context, c commander) error {
+	args, err := a.args()
+	if err != nil {
+		return err
+	}
+
+	a.stop, err = c.Start("stress-ng", args...)
+	return err
+}
+
+func (a *stressAttacker) Recover(ctx context.Context, c commander) error {
+	if a.stop == nil {
+		return nil
+	}
+	return a.stop()
+}
+
+// diskAttacker allocates a file to fill the disk
+type diskAttacker struct {
+	spec *v1alpha1.PhysicalDiskSpec
+}
+
+func (a *diskAttacker) Apply(ctx context.Context, c commander) error {
+	if _, err := os.Stat(a.spec.Path); err == nil {
+		// never remove a file which is not created by chaosd when recovering
+		return fmt.Errorf("file %s already exists", a.spec.Path)
+	}
+
+	size, err := v1alpha1.ParsePhysicalSize(a.spec.Size)
+	if err != nil {
+		return err
+	}
+	return c.Run(ctx, "fallocate", "-l", strconv.FormatInt(size, 10), a.spec.Path)
+}
+
+func (a *diskAttacker) Recover(ctx context.Context, c commander) error {
+	if err := os.Remove(a.spec.Path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// processAttacker sends a signal to the processes, it can't be recovered
+type processAttacker struct {
+	spec *v1alpha1.PhysicalProcessSpec
+}
+
+func (a *processAttacker) Apply(ctx context.Context, c commander) error {
+	signal := a.spec.Signal
+	if signal == 0 {
+		signal = 9
+	}
+
+	if _, err := strconv.Atoi(a.spec.Process); err == nil {
+		return c.Run(ctx, "kill", "-"+strconv.Itoa(signal), a.spec.Process)
+	}
+	return c.Run(ctx, "pkill", "-"+strconv.Itoa(signal), "-x", a.spec.Process)
+}
+
+func (a *processAttacker) Recover(ctx context.Context, c commander) error {
+	return nil
+}
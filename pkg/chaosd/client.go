@@ -0,0 +1,135 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/simulation"
+)
+
+const defaultClientTimeout = 30 * time.Second
+
+// Client is the client of chaosd api
+type Client struct {
+	address string
+	client  *http.Client
+}
+
+// NewClient returns a client of the chaosd which listens on address, e.g. `http://172.16.0.10:31768`
+func NewClient(address string) *Client {
+	return &Client{
+		address: strings.TrimSuffix(address, "/"),
+		client: &http.Client{
+			Timeout: defaultClientTimeout,
+		},
+	}
+}
+
+// Attack injects the attack into the machine
+func (c *Client) Attack(ctx context.Context, req *AttackRequest) (*Attack, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	attack := &Attack{}
+	if err := c.do(ctx, http.MethodPost, "/api/attack", body, attack); err != nil {
+		return nil, err
+	}
+	return attack, nil
+}
+
+// Recover recovers the attack with the uid, it succeeds if the attack is not found
+func (c *Client) Recover(ctx context.Context, uid string) error {
+	err := c.do(ctx, http.MethodDelete, "/api/attack/"+url.PathEscape(uid), nil, nil)
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// List lists the running attacks on the machine
+func (c *Client) List(ctx context.Context) ([]Attack, error) {
+	var attacks []Attack
+	if err := c.do(ctx, http.MethodGet, "/api/attack", nil, &attacks); err != nil {
+		return nil, err
+	}
+	return attacks, nil
+}
+
+// Error is returned by Client when chaosd responds with an error
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("chaosd responds %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound returns whether the err is caused by a not found attack
+func IsNotFound(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.StatusCode == http.StatusNotFound
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body []byte, out interface{}) error {
+	// the simulated requests succeed without being sent, the responses are left empty
+	if simulation.IsSimulated(ctx) {
+		return nil
+	}
+
+	req, err := http.NewRequest(method, c.address+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		apiErr := utils.APIError{}
+		if err := json.Unmarshal(data, &apiErr); err != nil || apiErr.Message == "" {
+			apiErr.Message = string(data)
+		}
+		return &Error{StatusCode: resp.StatusCode, Message: apiErr.Message}
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
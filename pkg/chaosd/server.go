@@ -0,0 +1,237 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hashicorp/go-multierror"
+	"github.com/joomcode/errorx"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/apiserver/utils"
+)
+
+var log = ctrl.Log.WithName("chaosd")
+
+type runningAttack struct {
+	Attack
+	attacker attacker
+}
+
+// Server accepts the attacks through http api and injects them into the machine
+type Server struct {
+	mu      sync.Mutex
+	attacks map[string]*runningAttack
+
+	commander commander
+}
+
+// NewServer returns a chaosd server which runs the commands of attacks on the machine
+func NewServer() *Server {
+	return newServer(execCommander{})
+}
+
+func newServer(c commander) *Server {
+	return &Server{
+		attacks:   make(map[string]*runningAttack),
+		commander: c,
+	}
+}
+
+// Handler returns the http handler of the chaosd api
+func (s *Server) Handler() http.Handler {
+	gin.SetMode(gin.ReleaseMode)
+	r := gin.New()
+	r.Use(gin.Recovery(), utils.MWHandleErrors())
+
+	r.GET("/healthz", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	endpoint := r.Group("/api/attack")
+	endpoint.POST("", s.createAttack)
+	endpoint.GET("", s.listAttacks)
+	endpoint.DELETE("/:uid", s.recoverAttack)
+
+	return r
+}
+
+// Attack injects the attack, nothing is done if an attack with the same uid is running
+func (s *Server) Attack(ctx context.Context, req *AttackRequest) (*Attack, error) {
+	if req.UID == "" {
+		return nil, utils.ErrInvalidRequest.New("uid is required")
+	}
+
+	a, err := newAttacker(req)
+	if err != nil {
+		return nil, utils.ErrInvalidRequest.WrapWithNoMessage(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if running, ok := s.attacks[req.UID]; ok {
+		log.Info("Attack is already running", "uid", req.UID)
+		return &running.Attack, nil
+	}
+
+	log.Info("Apply attack", "uid", req.UID, "action", req.Action)
+	if err := a.Apply(ctx, s.commander); err != nil {
+		return nil, utils.ErrInternalServer.Wrap(err, "failed to apply attack %s", req.UID)
+	}
+
+	running := &runningAttack{
+		Attack: Attack{
+			AttackRequest: *req,
+			StartTime:     time.Now(),
+		},
+		attacker: a,
+	}
+	s.attacks[req.UID] = running
+
+	return &running.Attack, nil
+}
+
+// Recover recovers the attack with the uid
+func (s *Server) Recover(ctx context.Context, uid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	running, ok := s.attacks[uid]
+	if !ok {
+		return utils.ErrNotFound.New("attack %s is not found", uid)
+	}
+
+	log.Info("Recover attack", "uid", uid, "action", running.Action)
+	if err := running.attacker.Recover(ctx, s.commander); err != nil {
+		return utils.ErrInternalServer.Wrap(err, "failed to recover attack %s", uid)
+	}
+	delete(s.attacks, uid)
+
+	return nil
+}
+
+// RecoverAll recovers all the running attacks, it is called when chaosd exits
+func (s *Server) RecoverAll(ctx context.Context) error {
+	var result error
+	for _, attack := range s.List() {
+		if err := s.Recover(ctx, attack.UID); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	return result
+}
+
+// List returns the running attacks in the order of start time
+func (s *Server) List() []Attack {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attacks := make([]Attack, 0, len(s.attacks))
+	for _, running := range s.attacks {
+		attacks = append(attacks, running.Attack)
+	}
+	sort.Slice(attacks, func(i, j int) bool {
+		if attacks[i].StartTime.Equal(attacks[j].StartTime) {
+			return attacks[i].UID < attacks[j].UID
+		}
+		return attacks[i].StartTime.Before(attacks[j].StartTime)
+	})
+
+	return attacks
+}
+
+func (s *Server) createAttack(c *gin.Context) {
+	req := &AttackRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.Status(http.StatusBadRequest)
+		_ = c.Error(utils.ErrInvalidRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	attack, err := s.Attack(c.Request.Context(), req)
+	if err != nil {
+		c.Status(statusOf(err))
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, attack)
+}
+
+func (s *Server) listAttacks(c *gin.Context) {
+	c.JSON(http.StatusOK, s.List())
+}
+
+func (s *Server) recoverAttack(c *gin.Context) {
+	if err := s.Recover(c.Request.Context(), c.Param("uid")); err != nil {
+		c.Status(statusOf(err))
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func statusOf(err error) int {
+	switch {
+	case errorx.IsOfType(err, utils.ErrInvalidRequest):
+		return http.StatusBadRequest
+	case errorx.IsOfType(err, utils.ErrNotFound):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+// Config is the config of chaosd
+type Config struct {
+	Host string
+	Port int
+}
+
+// StartServer starts chaosd and recovers all the running attacks when ctx is done
+func StartServer(ctx context.Context, conf *Config, s *Server) error {
+	server := &http.Server{
+		Addr:    fmt.Sprintf("%s:%d", conf.Host, conf.Port),
+		Handler: s.Handler(),
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Info("Starting chaosd", "addr", server.Addr)
+		errCh <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Info("Shutting down chaosd")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Error(err, "failed to shut down http server")
+	}
+	return s.RecoverAll(shutdownCtx)
+}
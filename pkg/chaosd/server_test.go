@@ -0,0 +1,170 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosd
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+type fakeCommander struct {
+	commands []string
+	stopped  []string
+	err      error
+}
+
+func (c *fakeCommander) Run(ctx context.Context, name string, args ...string) error {
+	c.commands = append(c.commands, strings.Join(append([]string{name}, args...), " "))
+	return c.err
+}
+
+func (c *fakeCommander) Start(name string, args ...string) (func() error, error) {
+	command := strings.Join(append([]string{name}, args...), " ")
+	c.commands = append(c.commands, command)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return func() error {
+		c.stopped = append(c.stopped, command)
+		return nil
+	}, nil
+}
+
+func TestAttackAndRecover(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	commander := &fakeCommander{}
+	server := httptest.NewServer(newServer(commander).Handler())
+	defer server.Close()
+
+	client := NewClient(server.URL + "/")
+	ctx := context.Background()
+
+	req := &AttackRequest{
+		UID:    "uid-1",
+		Action: v1alpha1.PMNetworkDelayAction,
+		Network: &v1alpha1.PhysicalNetworkSpec{
+			Device:      "eth0",
+			Latency:     "100ms",
+			Jitter:      "10ms",
+			Correlation: "25",
+		},
+	}
+	attack, err := client.Attack(ctx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(attack.UID).To(Equal("uid-1"))
+	g.Expect(attack.Network.Device).To(Equal("eth0"))
+
+	// the attack with the same uid is applied only once
+	_, err = client.Attack(ctx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(commander.commands).To(Equal([]string{"tc qdisc add dev eth0 root netem delay 100ms 10ms 25%"}))
+
+	load := 80
+	_, err = client.Attack(ctx, &AttackRequest{
+		UID:    "uid-2",
+		Action: v1alpha1.PMStressCPUAction,
+		Stress: &v1alpha1.PhysicalStressSpec{Workers: 2, Load: &load},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	attacks, err := client.List(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(attacks).To(HaveLen(2))
+	g.Expect(attacks[0].UID).To(Equal("uid-1"))
+	g.Expect(attacks[1].UID).To(Equal("uid-2"))
+
+	g.Expect(client.Recover(ctx, "uid-2")).To(Succeed())
+	g.Expect(commander.stopped).To(Equal([]string{"stress-ng --cpu 2 --cpu-load 80"}))
+
+	g.Expect(client.Recover(ctx, "uid-1")).To(Succeed())
+	g.Expect(commander.commands[len(commander.commands)-1]).To(Equal("tc qdisc del dev eth0 root"))
+
+	// recovering an attack which is not found succeeds
+	g.Expect(client.Recover(ctx, "uid-1")).To(Succeed())
+
+	attacks, err = client.List(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(attacks).To(BeEmpty())
+}
+
+func TestAttackErrors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	commander := &fakeCommander{}
+	server := httptest.NewServer(newServer(commander).Handler())
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	ctx := context.Background()
+
+	_, err := client.Attack(ctx, &AttackRequest{Action: v1alpha1.PMDiskFillAction})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.(*Error).StatusCode).To(Equal(400))
+
+	_, err = client.Attack(ctx, &AttackRequest{UID: "uid-1", Action: "unknown"})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("unknown action"))
+
+	commander.err = errors.New("pkill not found")
+	_, err = client.Attack(ctx, &AttackRequest{
+		UID:     "uid-1",
+		Action:  v1alpha1.PMProcessKillAction,
+		Process: &v1alpha1.PhysicalProcessSpec{Process: "nginx", Signal: 15},
+	})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.(*Error).StatusCode).To(Equal(500))
+	g.Expect(commander.commands).To(Equal([]string{"pkill -15 -x nginx"}))
+
+	attacks, err := client.List(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(attacks).To(BeEmpty())
+}
+
+func TestRecoverAll(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	commander := &fakeCommander{}
+	s := newServer(commander)
+	ctx := context.Background()
+
+	_, err := s.Attack(ctx, &AttackRequest{
+		UID:    "uid-1",
+		Action: v1alpha1.PMStressMemAction,
+		Stress: &v1alpha1.PhysicalStressSpec{Workers: 1, Size: "256MB"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = s.Attack(ctx, &AttackRequest{
+		UID:     "uid-2",
+		Action:  v1alpha1.PMNetworkLossAction,
+		Network: &v1alpha1.PhysicalNetworkSpec{Device: "eth1", Percent: "50"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(s.RecoverAll(ctx)).To(Succeed())
+	g.Expect(s.List()).To(BeEmpty())
+	g.Expect(commander.commands).To(Equal([]string{
+		"stress-ng --vm 1 --vm-bytes 256000000 --vm-keep",
+		"tc qdisc add dev eth1 root netem loss 50%",
+		"tc qdisc del dev eth1 root",
+	}))
+	g.Expect(commander.stopped).To(HaveLen(1))
+}
@@ -0,0 +1,45 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosd
+
+import (
+	"time"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// DefaultPort is the port which chaosd listens on by default
+const DefaultPort = 31768
+
+// AttackRequest is the request to inject a fault into the machine which chaosd runs on.
+// The details share the definitions with PhysicalMachineChaos.
+type AttackRequest struct {
+	// UID identifies the attack, it is used to recover the attack later.
+	// Injecting an attack with the UID of an existing attack has no effect.
+	UID string `json:"uid"`
+
+	Action v1alpha1.PhysicalMachineChaosAction `json:"action"`
+
+	Network *v1alpha1.PhysicalNetworkSpec `json:"network,omitempty"`
+	Stress  *v1alpha1.PhysicalStressSpec  `json:"stress,omitempty"`
+	Disk    *v1alpha1.PhysicalDiskSpec    `json:"disk,omitempty"`
+	Process *v1alpha1.PhysicalProcessSpec `json:"process,omitempty"`
+}
+
+// Attack is an attack which is running on the machine
+type Attack struct {
+	AttackRequest `json:",inline"`
+
+	StartTime time.Time `json:"start_time"`
+}
@@ -0,0 +1,197 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// containerVerifier verifies that the containers can be operated by chaos-daemon
+type containerVerifier interface {
+	Verify(ctx context.Context, containerIDs []string) error
+}
+
+// authorizer authorizes every request of chaos-daemon, it verifies the identity of the caller
+// and the containers requested, so that nobody else can attack arbitrary containers through chaos-daemon
+type authorizer struct {
+	// allowedClients is the names of the client certs which are allowed to call chaos-daemon,
+	// the name is either the common name or a DNS name of the cert. The caller isn't verified if it's empty
+	allowedClients map[string]struct{}
+	// containers is nil if the containers aren't verified
+	containers containerVerifier
+}
+
+func newAuthorizer(allowedClients []string, containers containerVerifier) *authorizer {
+	a := &authorizer{containers: containers}
+	if len(allowedClients) > 0 {
+		a.allowedClients = make(map[string]struct{}, len(allowedClients))
+		for _, name := range allowedClients {
+			a.allowedClients[name] = struct{}{}
+		}
+	}
+	return a
+}
+
+// UnaryServerInterceptor rejects the unauthorized requests with PermissionDenied
+func (a *authorizer) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorizeClient(ctx); err != nil {
+		return nil, err
+	}
+
+	if a.containers != nil {
+		if err := a.containers.Verify(ctx, requestedContainers(req)); err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor rejects the streams of the unauthorized callers with PermissionDenied,
+// the streams only watch chaos-daemon, so no containers are verified
+func (a *authorizer) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if err := a.authorizeClient(ss.Context()); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+func (a *authorizer) authorizeClient(ctx context.Context) error {
+	if a.allowedClients == nil {
+		return nil
+	}
+
+	cert := clientCert(ctx)
+	if cert == nil {
+		return status.Error(codes.Unauthenticated, "client cert is required")
+	}
+
+	for _, name := range append([]string{cert.Subject.CommonName}, cert.DNSNames...) {
+		if _, ok := a.allowedClients[name]; ok {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "client %s is not allowed", cert.Subject.CommonName)
+}
+
+// clientCert returns the verified cert of the caller, nil is returned if the connection isn't mutual TLS
+func clientCert(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return info.State.VerifiedChains[0][0]
+}
+
+// requestedContainers returns the ids of the containers which the request operates on
+func requestedContainers(req interface{}) []string {
+	switch r := req.(type) {
+	case interface{ GetContainerIds() []string }:
+		return r.GetContainerIds()
+	case interface{ GetContainerId() string }:
+		return []string{r.GetContainerId()}
+	case *pb.ExecStressRequest:
+		return []string{r.GetTarget()}
+	}
+	return nil
+}
+
+// nodeContainerVerifier verifies that the containers belong to the pods scheduled to the node.
+// The containers on the node are cached and refreshed only when an unknown container is requested.
+type nodeContainerVerifier struct {
+	sync.Mutex
+
+	client     client.Reader
+	nodeName   string
+	containers map[string]struct{}
+}
+
+func newNodeContainerVerifier(c client.Reader, nodeName string) *nodeContainerVerifier {
+	return &nodeContainerVerifier{
+		client:   c,
+		nodeName: nodeName,
+	}
+}
+
+// Verify returns an error if any of the containers isn't on the node
+func (v *nodeContainerVerifier) Verify(ctx context.Context, containerIDs []string) error {
+	v.Lock()
+	defer v.Unlock()
+
+	if v.unknownContainer(containerIDs) == "" {
+		return nil
+	}
+
+	if err := v.refresh(ctx); err != nil {
+		return err
+	}
+
+	if id := v.unknownContainer(containerIDs); id != "" {
+		return fmt.Errorf("container %s doesn't belong to any pod on node %s", id, v.nodeName)
+	}
+	return nil
+}
+
+// unknownContainer returns the first container which isn't cached, an empty string is returned if all are cached
+func (v *nodeContainerVerifier) unknownContainer(containerIDs []string) string {
+	for _, id := range containerIDs {
+		if _, ok := v.containers[id]; !ok {
+			if id == "" {
+				return "<empty>"
+			}
+			return id
+		}
+	}
+	return ""
+}
+
+func (v *nodeContainerVerifier) refresh(ctx context.Context) error {
+	var pods v1.PodList
+	if err := v.client.List(ctx, &pods, client.MatchingFields{"spec.nodeName": v.nodeName}); err != nil {
+		return err
+	}
+
+	containers := make(map[string]struct{})
+	for _, pod := range pods.Items {
+		for _, statuses := range [][]v1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+			for _, s := range statuses {
+				if s.ContainerID != "" {
+					containers[s.ContainerID] = struct{}{}
+				}
+			}
+		}
+	}
+	v.containers = containers
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func peerContext(commonName string, dnsNames ...string) context.Context {
+	cert := &x509.Certificate{
+		Subject:  pkix.Name{CommonName: commonName},
+		DNSNames: dnsNames,
+	}
+	return peer.NewContext(context.TODO(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	})
+}
+
+func nodePod(name string, containerIDs ...string) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       v1.PodSpec{NodeName: "node-1"},
+	}
+	for _, id := range containerIDs {
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, v1.ContainerStatus{ContainerID: id})
+	}
+	return pod
+}
+
+var _ = Describe("authorizer", func() {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/chaosdaemon.ChaosDaemon/ContainerKill"}
+
+	Context("client verification", func() {
+		a := newAuthorizer([]string{"chaos-mesh-controller-manager"}, nil)
+
+		It("should allow the client by common name or DNS name", func() {
+			resp, err := a.UnaryServerInterceptor(peerContext("chaos-mesh-controller-manager"), &pb.ContainerRequest{}, info, handler)
+			Expect(err).To(BeNil())
+			Expect(resp).To(Equal("ok"))
+
+			_, err = a.UnaryServerInterceptor(peerContext("controller", "chaos-mesh-controller-manager"), &pb.ContainerRequest{}, info, handler)
+			Expect(err).To(BeNil())
+		})
+
+		It("should reject other clients", func() {
+			_, err := a.UnaryServerInterceptor(peerContext("attacker"), &pb.ContainerRequest{}, info, handler)
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+			_, err = a.UnaryServerInterceptor(context.TODO(), &pb.ContainerRequest{}, info, handler)
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should allow every client if no client is configured", func() {
+			_, err := newAuthorizer(nil, nil).UnaryServerInterceptor(context.TODO(), &pb.ContainerRequest{}, info, handler)
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("container verification", func() {
+		It("should only allow the containers on the node", func() {
+			c := fake.NewFakeClientWithScheme(scheme.Scheme, nodePod("p1", "docker://c1", "docker://c2"))
+			v := newNodeContainerVerifier(c, "node-1")
+			a := newAuthorizer(nil, v)
+
+			_, err := a.UnaryServerInterceptor(context.TODO(), &pb.NetemRequest{ContainerId: "docker://c1"}, info, handler)
+			Expect(err).To(BeNil())
+
+			_, err = a.UnaryServerInterceptor(context.TODO(), &pb.CleanupRequest{ContainerIds: []string{"docker://c1", "docker://c2"}}, info, handler)
+			Expect(err).To(BeNil())
+
+			_, err = a.UnaryServerInterceptor(context.TODO(), &pb.ExecStressRequest{Target: "docker://c3"}, info, handler)
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			Expect(err.Error()).To(ContainSubstring("docker://c3"))
+
+			_, err = a.UnaryServerInterceptor(context.TODO(), &pb.ContainerRequest{}, info, handler)
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+		})
+
+		It("should refresh the containers when an unknown container is requested", func() {
+			c := fake.NewFakeClientWithScheme(scheme.Scheme, nodePod("p1", "docker://c1"))
+			v := newNodeContainerVerifier(c, "node-1")
+
+			Expect(v.Verify(context.TODO(), []string{"docker://c1"})).To(Succeed())
+			Expect(v.Verify(context.TODO(), []string{"docker://c2"})).ToNot(Succeed())
+
+			Expect(c.Create(context.TODO(), nodePod("p2", "docker://c2"))).To(Succeed())
+			Expect(v.Verify(context.TODO(), []string{"docker://c1", "docker://c2"})).To(Succeed())
+		})
+	})
+})
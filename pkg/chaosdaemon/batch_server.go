@@ -0,0 +1,122 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// batchConcurrency is the max number of requests in a batch which are handled at the same time
+const batchConcurrency = 16
+
+// batch handles the requests of a batch call concurrently. Every request is handled through
+// the interceptors of the single call, so that it's authorized and journaled as if it's called alone.
+func (s *daemonServer) batch(ctx context.Context, method string, requests []interface{}, handler grpc.UnaryHandler) *pb.BatchResponse {
+	resp := &pb.BatchResponse{Errors: make([]string, len(requests))}
+	info := &grpc.UnaryServerInfo{
+		Server:     s,
+		FullMethod: grpcServicePrefix + method,
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, batchConcurrency)
+	for i := range requests {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			var err error
+			if s.interceptor != nil {
+				_, err = s.interceptor(ctx, requests[i], info, handler)
+			} else {
+				_, err = handler(ctx, requests[i])
+			}
+			if err != nil {
+				resp.Errors[i] = err.Error()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return resp
+}
+
+func (s *daemonServer) BatchSetNetem(ctx context.Context, req *pb.BatchNetemRequest) (*pb.BatchResponse, error) {
+	requests := make([]interface{}, 0, len(req.Requests))
+	for _, r := range req.Requests {
+		requests = append(requests, r)
+	}
+	return s.batch(ctx, "SetNetem", requests, func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.SetNetem(ctx, r.(*pb.NetemRequest))
+	}), nil
+}
+
+func (s *daemonServer) BatchDeleteNetem(ctx context.Context, req *pb.BatchNetemRequest) (*pb.BatchResponse, error) {
+	requests := make([]interface{}, 0, len(req.Requests))
+	for _, r := range req.Requests {
+		requests = append(requests, r)
+	}
+	return s.batch(ctx, "DeleteNetem", requests, func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.DeleteNetem(ctx, r.(*pb.NetemRequest))
+	}), nil
+}
+
+func (s *daemonServer) BatchSetTbf(ctx context.Context, req *pb.BatchTbfRequest) (*pb.BatchResponse, error) {
+	requests := make([]interface{}, 0, len(req.Requests))
+	for _, r := range req.Requests {
+		requests = append(requests, r)
+	}
+	return s.batch(ctx, "SetTbf", requests, func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.SetTbf(ctx, r.(*pb.TbfRequest))
+	}), nil
+}
+
+func (s *daemonServer) BatchDeleteTbf(ctx context.Context, req *pb.BatchTbfRequest) (*pb.BatchResponse, error) {
+	requests := make([]interface{}, 0, len(req.Requests))
+	for _, r := range req.Requests {
+		requests = append(requests, r)
+	}
+	return s.batch(ctx, "DeleteTbf", requests, func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.DeleteTbf(ctx, r.(*pb.TbfRequest))
+	}), nil
+}
+
+func (s *daemonServer) BatchFlushIpSet(ctx context.Context, req *pb.BatchIpSetRequest) (*pb.BatchResponse, error) {
+	requests := make([]interface{}, 0, len(req.Requests))
+	for _, r := range req.Requests {
+		requests = append(requests, r)
+	}
+	return s.batch(ctx, "FlushIpSet", requests, func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FlushIpSet(ctx, r.(*pb.IpSetRequest))
+	}), nil
+}
+
+func (s *daemonServer) BatchFlushIptables(ctx context.Context, req *pb.BatchIpTablesRequest) (*pb.BatchResponse, error) {
+	requests := make([]interface{}, 0, len(req.Requests))
+	for _, r := range req.Requests {
+		requests = append(requests, r)
+	}
+	return s.batch(ctx, "FlushIptables", requests, func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FlushIptables(ctx, r.(*pb.IpTablesRequest))
+	}), nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+var _ = Describe("batch server", func() {
+	defer mock.With("MockContainerdClient", &MockClient{})()
+	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
+
+	Context("BatchSetTbf", func() {
+		It("should handle every request through the interceptor", func() {
+			const ignore = true
+			defer mock.With("TbfApplyError", ignore)()
+
+			var lock sync.Mutex
+			var methods []string
+			s := &daemonServer{
+				crClient: c,
+				interceptor: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+					lock.Lock()
+					methods = append(methods, info.FullMethod)
+					lock.Unlock()
+
+					if req.(*pb.TbfRequest).ContainerId == "containerd://denied" {
+						return nil, errors.New("permission denied")
+					}
+					return handler(ctx, req)
+				},
+			}
+
+			resp, err := s.BatchSetTbf(context.TODO(), &pb.BatchTbfRequest{
+				Requests: []*pb.TbfRequest{
+					{ContainerId: "containerd://container-id"},
+					{ContainerId: "containerd://denied"},
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Errors).To(HaveLen(2))
+			Expect(resp.Errors[0]).To(BeEmpty())
+			Expect(resp.Errors[1]).To(ContainSubstring("permission denied"))
+			Expect(methods).To(ConsistOf(grpcServicePrefix+"SetTbf", grpcServicePrefix+"SetTbf"))
+		})
+	})
+
+	Context("BatchSetNetem", func() {
+		It("should report the error of each request", func() {
+			const errorStr = "mock error on applyNetem()"
+			defer mock.With("NetemApplyError", errors.New(errorStr))()
+
+			s := &daemonServer{crClient: c}
+			resp, err := s.BatchSetNetem(context.TODO(), &pb.BatchNetemRequest{
+				Requests: []*pb.NetemRequest{
+					{ContainerId: "containerd://container-id"},
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Errors).To(HaveLen(1))
+			Expect(resp.Errors[0]).To(ContainSubstring(errorStr))
+		})
+	})
+})
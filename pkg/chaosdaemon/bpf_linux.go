@@ -0,0 +1,311 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strings"
+	"unsafe"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+	"golang.org/x/sys/unix"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+const bpfLogSize = 64 * 1024
+
+// bpfProgLoadAttr is the prefix of union bpf_attr used by BPF_PROG_LOAD
+type bpfProgLoadAttr struct {
+	progType    uint32
+	insnCnt     uint32
+	insns       uint64
+	license     uint64
+	logLevel    uint32
+	logSize     uint32
+	logBuf      uint64
+	kernVersion uint32
+	progFlags   uint32
+}
+
+// loadBpfProgram loads the program as a tc classifier and returns its file descriptor
+func loadBpfProgram(instructions []bpfInstruction) (int, error) {
+	code := encodeBpfInstructions(instructions)
+	// bpf_ktime_get_ns is only available to the GPL compatible programs
+	license := []byte("GPL\x00")
+	logBuf := make([]byte, bpfLogSize)
+
+	attr := bpfProgLoadAttr{
+		progType: unix.BPF_PROG_TYPE_SCHED_CLS,
+		insnCnt:  uint32(len(instructions)),
+		insns:    uint64(uintptr(unsafe.Pointer(&code[0]))),
+		license:  uint64(uintptr(unsafe.Pointer(&license[0]))),
+		logLevel: 1,
+		logSize:  bpfLogSize,
+		logBuf:   uint64(uintptr(unsafe.Pointer(&logBuf[0]))),
+	}
+	fd, _, errno := unix.Syscall(unix.SYS_BPF, unix.BPF_PROG_LOAD, uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr))
+	runtime.KeepAlive(code)
+	runtime.KeepAlive(license)
+	runtime.KeepAlive(logBuf)
+	if errno != 0 {
+		verifierLog := string(bytes.TrimRight(logBuf, "\x00"))
+		return -1, fmt.Errorf("failed to load bpf program: %v: %s", errno, verifierLog)
+	}
+
+	return int(fd), nil
+}
+
+func applyBpfFault(pid uint32, device string, egress *bpfFault, ingress *bpfFault) error {
+	// Mock point to return error in unit test
+	if err := mock.On("BpfFaultApplyError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			return nil
+		}
+	}
+
+	type program struct {
+		parent uint32
+		name   string
+		fd     int
+	}
+	var programs []program
+	defer func() {
+		// the attached programs are held by the filters
+		for _, p := range programs {
+			unix.Close(p.fd)
+		}
+	}()
+	for _, p := range []struct {
+		parent uint32
+		name   string
+		fault  *bpfFault
+	}{
+		{netlink.HANDLE_MIN_EGRESS, bpfFilterPrefix + "egress", egress},
+		{netlink.HANDLE_MIN_INGRESS, bpfFilterPrefix + "ingress", ingress},
+	} {
+		if p.fault == nil {
+			continue
+		}
+		instructions, err := p.fault.program()
+		if err != nil {
+			return err
+		}
+		fd, err := loadBpfProgram(instructions)
+		if err != nil {
+			log.Error(err, "failed to load bpf program", "name", p.name)
+			return err
+		}
+		programs = append(programs, program{parent: p.parent, name: p.name, fd: fd})
+	}
+
+	ns, err := netns.GetFromPath(GetNsPath(pid, netNS))
+	if err != nil {
+		log.Error(err, "failed to find network namespace", "pid", pid)
+		return err
+	}
+	defer ns.Close()
+
+	handle, err := netlink.NewHandleAt(ns)
+	if err != nil {
+		log.Error(err, "failed to get handle at network namespace", "network namespace", ns)
+		return err
+	}
+	defer handle.Delete()
+
+	links, err := matchLinks(handle, device)
+	if err != nil {
+		log.Error(err, "failed to find network interfaces", "device", device)
+		return err
+	}
+
+	for _, link := range links {
+		// the delay is the departure time of the packets, which is only respected by fq
+		if egress != nil && egress.delay > 0 {
+			if err := addFqQdisc(handle, link); err != nil {
+				return err
+			}
+		}
+
+		clsact := clsactQdisc(link)
+		if err := handle.QdiscAdd(clsact); err != nil && !strings.Contains(err.Error(), "file exists") {
+			log.Error(err, "failed to add clsact qdisc", "device", link.Attrs().Name)
+			return err
+		}
+
+		// the programs of the earlier injection are replaced
+		if _, err := removeBpfFilters(handle, link); err != nil {
+			return err
+		}
+		for _, p := range programs {
+			filter := &netlink.BpfFilter{
+				FilterAttrs: netlink.FilterAttrs{
+					LinkIndex: link.Attrs().Index,
+					Parent:    p.parent,
+					Handle:    netlink.MakeHandle(0, 1),
+					Priority:  1,
+					Protocol:  unix.ETH_P_ALL,
+				},
+				Fd:           p.fd,
+				Name:         p.name,
+				DirectAction: true,
+			}
+			log.Info("Add bpf filter", "name", p.name, "device", link.Attrs().Name)
+			if err := handle.FilterAdd(filter); err != nil {
+				log.Error(err, "failed to add bpf filter", "name", p.name, "device", link.Attrs().Name)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func deleteBpfFault(pid uint32, device string) error {
+	// Mock point to return error in unit test
+	if err := mock.On("BpfFaultDeleteError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			return nil
+		}
+	}
+
+	ns, err := netns.GetFromPath(GetNsPath(pid, netNS))
+	if err != nil {
+		log.Error(err, "failed to find network namespace", "pid", pid)
+		return err
+	}
+	defer ns.Close()
+
+	handle, err := netlink.NewHandleAt(ns)
+	if err != nil {
+		log.Error(err, "failed to get handle at network namespace", "network namespace", ns)
+		return err
+	}
+	defer handle.Delete()
+
+	links, err := matchLinks(handle, device)
+	if err != nil {
+		log.Error(err, "failed to find network interfaces", "device", device)
+		return err
+	}
+
+	for _, link := range links {
+		remaining, err := removeBpfFilters(handle, link)
+		if err != nil {
+			return err
+		}
+		// the clsact qdisc is kept for the filters of others
+		if remaining == 0 {
+			if err := handle.QdiscDel(clsactQdisc(link)); err != nil && !strings.Contains(err.Error(), "no such file") &&
+				!strings.Contains(err.Error(), "invalid argument") {
+				log.Error(err, "failed to remove clsact qdisc", "device", link.Attrs().Name)
+				return err
+			}
+		}
+
+		qdiscs, err := handle.QdiscList(link)
+		if err != nil {
+			log.Error(err, "failed to list qdiscs", "device", link.Attrs().Name)
+			return err
+		}
+		for _, qdisc := range qdiscs {
+			if qdisc.Type() == "fq" && qdisc.Attrs().Parent == netlink.HANDLE_ROOT &&
+				qdisc.Attrs().Handle == netlink.MakeHandle(1, 0) {
+				log.Info("Remove qdisc", "qdisc", qdisc, "device", link.Attrs().Name)
+				if err := handle.QdiscDel(qdisc); err != nil {
+					log.Error(err, "failed to remove qdisc", "qdisc", qdisc)
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func clsactQdisc(link netlink.Link) netlink.Qdisc {
+	return &netlink.GenericQdisc{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    netlink.MakeHandle(0xffff, 0),
+			Parent:    netlink.HANDLE_CLSACT,
+		},
+		QdiscType: "clsact",
+	}
+}
+
+// addFqQdisc adds the fq qdisc as the root qdisc, it fails if another root qdisc,
+// such as the netem of another chaos, has been added
+func addFqQdisc(handle *netlink.Handle, link netlink.Link) error {
+	fq := netlink.NewFq(netlink.QdiscAttrs{
+		LinkIndex: link.Attrs().Index,
+		Handle:    netlink.MakeHandle(1, 0),
+		Parent:    netlink.HANDLE_ROOT,
+	})
+	err := handle.QdiscAdd(fq)
+	if err == nil || !strings.Contains(err.Error(), "file exists") {
+		return err
+	}
+
+	qdiscs, err := handle.QdiscList(link)
+	if err != nil {
+		log.Error(err, "failed to list qdiscs", "device", link.Attrs().Name)
+		return err
+	}
+	for _, qdisc := range qdiscs {
+		if qdisc.Attrs().Parent == netlink.HANDLE_ROOT && qdisc.Type() != "fq" {
+			return fmt.Errorf("root qdisc %s of %s conflicts with the bpf delay", qdisc.Type(), link.Attrs().Name)
+		}
+	}
+	return nil
+}
+
+// removeBpfFilters removes the bpf filters attached by chaos and returns the number of remaining filters
+func removeBpfFilters(handle *netlink.Handle, link netlink.Link) (int, error) {
+	remaining := 0
+	for _, parent := range []uint32{netlink.HANDLE_MIN_EGRESS, netlink.HANDLE_MIN_INGRESS} {
+		filters, err := handle.FilterList(link, parent)
+		if err != nil {
+			// there are no filters if the clsact qdisc doesn't exist
+			if strings.Contains(err.Error(), "invalid argument") {
+				continue
+			}
+			log.Error(err, "failed to list filters", "device", link.Attrs().Name)
+			return 0, err
+		}
+
+		for _, filter := range filters {
+			bpf, ok := filter.(*netlink.BpfFilter)
+			if !ok || !strings.HasPrefix(bpf.Name, bpfFilterPrefix) {
+				remaining++
+				continue
+			}
+			log.Info("Remove bpf filter", "name", bpf.Name, "device", link.Attrs().Name)
+			if err := handle.FilterDel(bpf); err != nil {
+				log.Error(err, "failed to remove bpf filter", "name", bpf.Name, "device", link.Attrs().Name)
+				return 0, err
+			}
+		}
+	}
+	return remaining, nil
+}
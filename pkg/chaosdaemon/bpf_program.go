@@ -0,0 +1,316 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// The encoding of eBPF instructions, see https://www.kernel.org/doc/Documentation/networking/filter.txt
+const (
+	bpfLD    = 0x00
+	bpfLDX   = 0x01
+	bpfSTX   = 0x03
+	bpfALU   = 0x04
+	bpfJMP   = 0x05
+	bpfALU64 = 0x07
+
+	bpfW  = 0x00
+	bpfH  = 0x08
+	bpfDW = 0x18
+
+	bpfIMM = 0x00
+	bpfABS = 0x20
+	bpfMEM = 0x60
+
+	bpfK = 0x00
+	bpfX = 0x08
+
+	bpfADD = 0x00
+	bpfSUB = 0x10
+	bpfAND = 0x50
+	bpfMOD = 0x90
+	bpfMOV = 0xb0
+
+	bpfJA   = 0x00
+	bpfJEQ  = 0x10
+	bpfJGE  = 0x30
+	bpfJNE  = 0x50
+	bpfJLT  = 0xa0
+	bpfCALL = 0x80
+	bpfEXIT = 0x90
+)
+
+// The helper functions called by the programs
+const (
+	bpfFuncKtimeGetNs    = 5
+	bpfFuncGetPrandomU32 = 7
+)
+
+// The return values of the tc programs in direct action mode
+const (
+	tcActOK   = 0
+	tcActShot = 2
+)
+
+const (
+	ethTypeOffset = 12
+	ethTypeIPv4   = 0x0800
+	ipv4SrcOffset = 26
+	ipv4DstOffset = 30
+
+	// skbTstampOffset is the offset of tstamp in struct __sk_buff, the packets are sent
+	// by the fq qdisc no earlier than the time
+	skbTstampOffset = 152
+)
+
+// bpfFilterPrefix is the prefix of the names of the tc filters attached by chaos
+const bpfFilterPrefix = "chaos-mesh-"
+
+type bpfInstruction struct {
+	op  uint8
+	dst uint8
+	src uint8
+	off int16
+	imm int32
+}
+
+// bpfAssembler assembles the instructions, the jumps refer to the labels which are resolved
+// when the program is assembled
+type bpfAssembler struct {
+	instructions []bpfInstruction
+	labels       map[string]int
+	jumps        map[int]string
+}
+
+func newBpfAssembler() *bpfAssembler {
+	return &bpfAssembler{
+		labels: make(map[string]int),
+		jumps:  make(map[int]string),
+	}
+}
+
+func (a *bpfAssembler) emit(insn bpfInstruction) *bpfAssembler {
+	a.instructions = append(a.instructions, insn)
+	return a
+}
+
+func (a *bpfAssembler) label(name string) *bpfAssembler {
+	a.labels[name] = len(a.instructions)
+	return a
+}
+
+func (a *bpfAssembler) jump(op uint8, dst, src uint8, imm int32, label string) *bpfAssembler {
+	a.jumps[len(a.instructions)] = label
+	return a.emit(bpfInstruction{op: bpfJMP | op, dst: dst, src: src, imm: imm})
+}
+
+// loadImm64 loads the 64-bit immediate, which takes two instructions
+func (a *bpfAssembler) loadImm64(dst uint8, imm uint64) *bpfAssembler {
+	a.emit(bpfInstruction{op: bpfLD | bpfDW | bpfIMM, dst: dst, imm: int32(uint32(imm))})
+	return a.emit(bpfInstruction{imm: int32(uint32(imm >> 32))})
+}
+
+func (a *bpfAssembler) call(function int32) *bpfAssembler {
+	return a.emit(bpfInstruction{op: bpfJMP | bpfCALL, imm: function})
+}
+
+func (a *bpfAssembler) exit(ret int32) *bpfAssembler {
+	a.emit(bpfInstruction{op: bpfALU64 | bpfMOV | bpfK, dst: 0, imm: ret})
+	return a.emit(bpfInstruction{op: bpfJMP | bpfEXIT})
+}
+
+func (a *bpfAssembler) referenced(label string) bool {
+	for _, l := range a.jumps {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *bpfAssembler) assemble() ([]bpfInstruction, error) {
+	for i, label := range a.jumps {
+		target, ok := a.labels[label]
+		if !ok {
+			return nil, fmt.Errorf("label %s isn't defined", label)
+		}
+		off := target - i - 1
+		if off < math.MinInt16 || off > math.MaxInt16 {
+			return nil, fmt.Errorf("jump to %s is out of range", label)
+		}
+		a.instructions[i].off = int16(off)
+	}
+	return a.instructions, nil
+}
+
+// encodeBpfInstructions encodes the instructions in the little endian byte order,
+// which is the byte order of the nodes supported by chaos-daemon
+func encodeBpfInstructions(instructions []bpfInstruction) []byte {
+	code := make([]byte, len(instructions)*8)
+	for i, insn := range instructions {
+		b := code[i*8 : i*8+8]
+		b[0] = insn.op
+		b[1] = insn.src<<4 | insn.dst&0x0f
+		binary.LittleEndian.PutUint16(b[2:], uint16(insn.off))
+		binary.LittleEndian.PutUint32(b[4:], uint32(insn.imm))
+	}
+	return code
+}
+
+// bpfFault is the fault injected by a tc program on a direction of the network interfaces
+type bpfFault struct {
+	// addrOffset is the offset of the matched IPv4 address in the packet
+	addrOffset int32
+	cidrs      []*net.IPNet
+	partition  bool
+	delay      uint64
+	jitter     uint64
+	// lossThreshold is the threshold of the random u32 below which the packets are dropped
+	lossThreshold uint64
+}
+
+// bpfFaultsOf returns the faults of the egress and ingress in the request,
+// the fault is nil if nothing is injected on the direction
+func bpfFaultsOf(in *pb.BpfFaultRequest) (egress *bpfFault, ingress *bpfFault, err error) {
+	egressCidrs, err := parseCidrs(in.EgressCidrs)
+	if err != nil {
+		return nil, nil, err
+	}
+	ingressCidrs, err := parseCidrs(in.IngressCidrs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if in.Loss < 0 || in.Loss > 100 {
+		return nil, nil, fmt.Errorf("loss %v isn't a percentage", in.Loss)
+	}
+
+	if in.Partition {
+		if len(egressCidrs) == 0 && len(ingressCidrs) == 0 {
+			return nil, nil, fmt.Errorf("partition requires the cidrs to block")
+		}
+		if len(egressCidrs) > 0 {
+			egress = &bpfFault{addrOffset: ipv4DstOffset, cidrs: egressCidrs, partition: true}
+		}
+		if len(ingressCidrs) > 0 {
+			ingress = &bpfFault{addrOffset: ipv4SrcOffset, cidrs: ingressCidrs, partition: true}
+		}
+		return egress, ingress, nil
+	}
+
+	if len(ingressCidrs) > 0 {
+		return nil, nil, fmt.Errorf("ingress cidrs are only supported in partition")
+	}
+	if in.Delay == 0 && in.Loss == 0 {
+		return nil, nil, fmt.Errorf("neither delay nor loss is set")
+	}
+	if in.Jitter > in.Delay {
+		return nil, nil, fmt.Errorf("jitter %dus is larger than delay %dus", in.Jitter, in.Delay)
+	}
+
+	return &bpfFault{
+		addrOffset:    ipv4DstOffset,
+		cidrs:         egressCidrs,
+		delay:         uint64(in.Delay) * 1000,
+		jitter:        uint64(in.Jitter) * 1000,
+		lossThreshold: uint64(float64(in.Loss) / 100 * (1 << 32)),
+	}, nil, nil
+}
+
+func parseCidrs(cidrs []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		if len(ipNet.Mask) != net.IPv4len {
+			return nil, fmt.Errorf("cidr %s isn't IPv4", cidr)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+// program returns the tc program which injects the fault. The packets which match the cidrs,
+// or all packets if there are no cidrs, are dropped in partition, or dropped by the probability of loss
+// and delayed by setting their departure time, which is respected by the fq qdisc.
+func (f *bpfFault) program() ([]bpfInstruction, error) {
+	a := newBpfAssembler()
+	// r6 keeps the context, which is required by the packet loads and saved across the calls
+	a.emit(bpfInstruction{op: bpfALU64 | bpfMOV | bpfX, dst: 6, src: 1})
+
+	if len(f.cidrs) > 0 {
+		a.emit(bpfInstruction{op: bpfLD | bpfH | bpfABS, imm: ethTypeOffset})
+		a.jump(bpfJNE|bpfK, 0, 0, ethTypeIPv4, "pass")
+		a.emit(bpfInstruction{op: bpfLD | bpfW | bpfABS, imm: f.addrOffset})
+		for _, cidr := range f.cidrs {
+			mask := binary.BigEndian.Uint32(cidr.Mask)
+			network := binary.BigEndian.Uint32(cidr.IP.To4())
+			// the 32-bit operations clear the upper half of the register, which is compared
+			// with the network loaded as a 64-bit immediate
+			a.emit(bpfInstruction{op: bpfALU | bpfMOV | bpfX, dst: 1, src: 0})
+			a.emit(bpfInstruction{op: bpfALU | bpfAND | bpfK, dst: 1, imm: int32(mask)})
+			a.loadImm64(2, uint64(network))
+			a.jump(bpfJEQ|bpfX, 1, 2, 0, "matched")
+		}
+		a.jump(bpfJA, 0, 0, 0, "pass")
+	}
+
+	a.label("matched")
+	if f.partition {
+		a.jump(bpfJA, 0, 0, 0, "drop")
+	}
+
+	if f.lossThreshold > 0 {
+		a.call(bpfFuncGetPrandomU32)
+		a.loadImm64(1, f.lossThreshold)
+		a.jump(bpfJLT|bpfX, 0, 1, 0, "drop")
+	}
+
+	if f.delay > 0 {
+		a.call(bpfFuncKtimeGetNs)
+		a.emit(bpfInstruction{op: bpfALU64 | bpfMOV | bpfX, dst: 7, src: 0})
+		if f.jitter > 0 {
+			// r7 += random % (2 * jitter) - jitter
+			a.call(bpfFuncGetPrandomU32)
+			a.loadImm64(1, 2*f.jitter)
+			a.emit(bpfInstruction{op: bpfALU64 | bpfMOD | bpfX, dst: 0, src: 1})
+			a.emit(bpfInstruction{op: bpfALU64 | bpfADD | bpfX, dst: 7, src: 0})
+			a.loadImm64(1, f.jitter)
+			a.emit(bpfInstruction{op: bpfALU64 | bpfSUB | bpfX, dst: 7, src: 1})
+		}
+		a.loadImm64(1, f.delay)
+		a.emit(bpfInstruction{op: bpfALU64 | bpfADD | bpfX, dst: 7, src: 1})
+		// the departure time which has been set later, e.g. by the pacing of TCP, is kept
+		a.emit(bpfInstruction{op: bpfLDX | bpfMEM | bpfDW, dst: 1, src: 6, off: skbTstampOffset})
+		a.jump(bpfJGE|bpfX, 1, 7, 0, "pass")
+		a.emit(bpfInstruction{op: bpfSTX | bpfMEM | bpfDW, dst: 6, src: 7, off: skbTstampOffset})
+	}
+
+	a.label("pass")
+	a.exit(tcActOK)
+	// the verifier rejects the unreachable instructions
+	if a.referenced("drop") {
+		a.label("drop")
+		a.exit(tcActShot)
+	}
+
+	return a.assemble()
+}
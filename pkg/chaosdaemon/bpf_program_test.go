@@ -0,0 +1,122 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var _ = Describe("bpf program", func() {
+	// target returns the index of the instruction which the jump at i goes to
+	target := func(instructions []bpfInstruction, i int) int {
+		return i + 1 + int(instructions[i].off)
+	}
+
+	Context("bpfFaultsOf", func() {
+		It("should build the faults of both directions in partition", func() {
+			egress, ingress, err := bpfFaultsOf(&pb.BpfFaultRequest{
+				Partition:    true,
+				EgressCidrs:  []string{"10.0.0.0/24"},
+				IngressCidrs: []string{"10.0.1.1/32"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(egress.addrOffset).To(Equal(int32(ipv4DstOffset)))
+			Expect(ingress.addrOffset).To(Equal(int32(ipv4SrcOffset)))
+			Expect(ingress.partition).To(BeTrue())
+		})
+
+		It("should only build the egress fault of delay and loss", func() {
+			egress, ingress, err := bpfFaultsOf(&pb.BpfFaultRequest{Delay: 100000, Jitter: 1000, Loss: 50})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ingress).To(BeNil())
+			Expect(egress.delay).To(Equal(uint64(100000000)))
+			Expect(egress.jitter).To(Equal(uint64(1000000)))
+			Expect(egress.lossThreshold).To(Equal(uint64(1 << 31)))
+		})
+
+		It("should reject invalid requests", func() {
+			for _, in := range []*pb.BpfFaultRequest{
+				{Partition: true},
+				{Delay: 10, Jitter: 20},
+				{Loss: 120},
+				{},
+				{Delay: 10, IngressCidrs: []string{"10.0.0.0/8"}},
+				{Delay: 10, EgressCidrs: []string{"fd00::/64"}},
+				{Delay: 10, EgressCidrs: []string{"10.0.0.1"}},
+			} {
+				_, _, err := bpfFaultsOf(in)
+				Expect(err).To(HaveOccurred(), "request %v", in)
+			}
+		})
+	})
+
+	Context("program", func() {
+		It("should drop the packets which match the cidrs in partition", func() {
+			egress, _, err := bpfFaultsOf(&pb.BpfFaultRequest{Partition: true, EgressCidrs: []string{"192.168.1.0/24"}})
+			Expect(err).ToNot(HaveOccurred())
+			instructions, err := egress.program()
+			Expect(err).ToNot(HaveOccurred())
+
+			n := len(instructions)
+			Expect(instructions[n-2]).To(Equal(bpfInstruction{op: bpfALU64 | bpfMOV | bpfK, imm: tcActShot}))
+			Expect(instructions[n-4]).To(Equal(bpfInstruction{op: bpfALU64 | bpfMOV | bpfK, imm: tcActOK}))
+
+			// the network is loaded without the extension of sign, which is compared with the masked address
+			Expect(instructions[5]).To(Equal(bpfInstruction{op: bpfALU | bpfAND | bpfK, dst: 1, imm: -256}))
+			Expect(instructions[6]).To(Equal(bpfInstruction{op: bpfLD | bpfDW | bpfIMM, dst: 2, imm: int32(-1062731520)}))
+			Expect(instructions[7]).To(Equal(bpfInstruction{}))
+			Expect(instructions[8].op).To(Equal(uint8(bpfJMP | bpfJEQ | bpfX)))
+			Expect(target(instructions, 8)).To(Equal(10))
+			Expect(target(instructions, 10)).To(Equal(n - 2))
+			Expect(target(instructions, 9)).To(Equal(n - 4))
+		})
+
+		It("should delay and drop all packets without cidrs", func() {
+			egress, _, err := bpfFaultsOf(&pb.BpfFaultRequest{Delay: 1000, Loss: 10})
+			Expect(err).ToNot(HaveOccurred())
+			instructions, err := egress.program()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(instructions[1]).To(Equal(bpfInstruction{op: bpfJMP | bpfCALL, imm: bpfFuncGetPrandomU32}))
+			Expect(instructions[4].op).To(Equal(uint8(bpfJMP | bpfJLT | bpfX)))
+			Expect(target(instructions, 4)).To(Equal(len(instructions) - 2))
+			Expect(instructions[5]).To(Equal(bpfInstruction{op: bpfJMP | bpfCALL, imm: bpfFuncKtimeGetNs}))
+
+			var stored bool
+			for _, insn := range instructions {
+				if insn.op == bpfSTX|bpfMEM|bpfDW {
+					Expect(insn.off).To(Equal(int16(skbTstampOffset)))
+					stored = true
+				}
+			}
+			Expect(stored).To(BeTrue())
+		})
+	})
+
+	Context("encodeBpfInstructions", func() {
+		It("should encode the registers and immediate in little endian", func() {
+			code := encodeBpfInstructions([]bpfInstruction{
+				{op: bpfALU64 | bpfMOV | bpfX, dst: 6, src: 1},
+				{op: bpfJMP | bpfJNE | bpfK, off: 3, imm: ethTypeIPv4},
+			})
+			Expect(code).To(Equal([]byte{
+				0xbf, 0x16, 0, 0, 0, 0, 0, 0,
+				0x55, 0x00, 3, 0, 0x00, 0x08, 0, 0,
+			}))
+		})
+	})
+})
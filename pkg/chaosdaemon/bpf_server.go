@@ -0,0 +1,60 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func (s *daemonServer) SetBpfFault(ctx context.Context, in *pb.BpfFaultRequest) (*empty.Empty, error) {
+	log.Info("Set bpf fault", "Request", in)
+
+	egress, ingress, err := bpfFaultsOf(in)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bpf fault: %v", err)
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, in.ContainerId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
+	}
+
+	if err := applyBpfFault(pid, in.Device, egress, ingress); err != nil {
+		return nil, status.Errorf(codes.Internal, "bpf fault apply error: %v", err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *daemonServer) DeleteBpfFault(ctx context.Context, in *pb.BpfFaultRequest) (*empty.Empty, error) {
+	log.Info("Delete bpf fault", "Request", in)
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, in.ContainerId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
+	}
+
+	if err := deleteBpfFault(pid, in.Device); err != nil {
+		return nil, status.Errorf(codes.Internal, "bpf fault delete error: %v", err)
+	}
+
+	return &empty.Empty{}, nil
+}
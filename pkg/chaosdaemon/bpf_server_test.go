@@ -0,0 +1,96 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+var _ = Describe("bpf server", func() {
+	defer mock.With("MockContainerdClient", &MockClient{})()
+	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
+	s := &daemonServer{crClient: c}
+
+	Context("SetBpfFault", func() {
+		It("should work", func() {
+			const ignore = true
+			defer mock.With("BpfFaultApplyError", ignore)()
+			_, err := s.SetBpfFault(context.TODO(), &pb.BpfFaultRequest{
+				ContainerId: "containerd://container-id",
+				Loss:        25,
+			})
+			Expect(err).To(BeNil())
+		})
+
+		It("should fail on invalid fault", func() {
+			_, err := s.SetBpfFault(context.TODO(), &pb.BpfFaultRequest{
+				ContainerId: "containerd://container-id",
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("should fail on get pid", func() {
+			const errorStr = "mock error on Task()"
+			defer mock.With("TaskError", errors.New(errorStr))()
+			_, err := s.SetBpfFault(context.TODO(), &pb.BpfFaultRequest{
+				ContainerId: "containerd://container-id",
+				Loss:        25,
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(errorStr))
+		})
+
+		It("should fail on applyBpfFault", func() {
+			const errorStr = "mock error on applyBpfFault()"
+			defer mock.With("BpfFaultApplyError", errors.New(errorStr))()
+			_, err := s.SetBpfFault(context.TODO(), &pb.BpfFaultRequest{
+				ContainerId: "containerd://container-id",
+				Loss:        25,
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(errorStr))
+		})
+	})
+
+	Context("DeleteBpfFault", func() {
+		It("should work", func() {
+			const ignore = true
+			defer mock.With("BpfFaultDeleteError", ignore)()
+			_, err := s.DeleteBpfFault(context.TODO(), &pb.BpfFaultRequest{
+				ContainerId: "containerd://container-id",
+			})
+			Expect(err).To(BeNil())
+		})
+
+		It("should fail on deleteBpfFault", func() {
+			const errorStr = "mock error on deleteBpfFault()"
+			defer mock.With("BpfFaultDeleteError", errors.New(errorStr))()
+			_, err := s.DeleteBpfFault(context.TODO(), &pb.BpfFaultRequest{
+				ContainerId: "containerd://container-id",
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(errorStr))
+		})
+	})
+})
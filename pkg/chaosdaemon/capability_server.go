@@ -0,0 +1,261 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// probedModules are the kernel modules which are required by some kinds of chaos
+var probedModules = []string{"ifb", "sch_netem", "sch_tbf", "sch_prio", "ip_set", "sch_fq", "cls_bpf", "nf_conntrack"}
+
+// moduleConfigs are the kernel config options of the probed modules, the module is built in
+// if its option is `y`. The kernels of other architectures, such as arm64, are often built
+// without some of them.
+var moduleConfigs = map[string]string{
+	"ifb":       "CONFIG_IFB",
+	"sch_netem": "CONFIG_NET_SCH_NETEM",
+	"sch_tbf":   "CONFIG_NET_SCH_TBF",
+	"sch_prio":  "CONFIG_NET_SCH_PRIO",
+	"ip_set":    "CONFIG_IP_SET",
+	"sch_fq":    "CONFIG_NET_SCH_FQ",
+	"cls_bpf":   "CONFIG_NET_CLS_BPF",
+}
+
+// probedFeatures are the kernel features which some kinds of chaos depend on, by their
+// kernel config options
+var probedFeatures = []struct {
+	Feature string
+	Config  string
+}{
+	{utils.KernelFeatureBPFSyscall, "CONFIG_BPF_SYSCALL"},
+	{utils.KernelFeatureNetClsAct, "CONFIG_NET_CLS_ACT"},
+	{utils.KernelFeatureNetSchIngress, "CONFIG_NET_SCH_INGRESS"},
+}
+
+const runtimeDialTimeout = time.Second
+
+// capabilityProber probes the capabilities of the node from the host filesystems
+// mounted into chaos-daemon
+type capabilityProber struct {
+	procPath    string
+	sysPath     string
+	modulesPath string
+	bootPath    string
+}
+
+var defaultCapabilityProber = capabilityProber{
+	procPath:    defaultProcPrefix,
+	sysPath:     "/sys",
+	modulesPath: "/lib/modules",
+	bootPath:    "/boot",
+}
+
+func (s *daemonServer) GetCapabilities(ctx context.Context, _ *empty.Empty) (*pb.CapabilitiesResponse, error) {
+	resp := probeNodeCapabilities()
+	resp.Os = runtime.GOOS
+	resp.Arch = runtime.GOARCH
+	resp.Methods = supportedMethods(runtime.GOOS)
+	resp.Runtimes = runtimeStatuses(s.runtime)
+	if s.guard != nil {
+		resp.Mode = s.guard.mode
+		resp.LinuxCapabilities = s.guard.capabilities
+	}
+
+	return resp, nil
+}
+
+func (p capabilityProber) probe() *pb.CapabilitiesResponse {
+	resp := &pb.CapabilitiesResponse{}
+
+	release, err := ioutil.ReadFile(filepath.Join(p.procPath, "sys/kernel/osrelease"))
+	if err != nil {
+		log.Error(err, "fail to read kernel version")
+	}
+	resp.KernelVersion = strings.TrimSpace(string(release))
+
+	configPath, config := p.kernelConfig(resp.KernelVersion)
+	resp.KernelConfig = configPath
+	for _, feature := range probedFeatures {
+		if enabled(config[feature.Config]) {
+			resp.KernelFeatures = append(resp.KernelFeatures, feature.Feature)
+		}
+	}
+
+	available := p.availableModules(resp.KernelVersion)
+	for module, option := range moduleConfigs {
+		if config[option] == "y" {
+			available[module] = true
+		}
+	}
+	for _, module := range probedModules {
+		if available[module] {
+			resp.Modules = append(resp.Modules, module)
+		}
+	}
+
+	if exists(filepath.Join(p.sysPath, "fs/cgroup/cgroup.controllers")) {
+		resp.CgroupVersion = 2
+	} else if exists(filepath.Join(p.sysPath, "fs/cgroup")) {
+		resp.CgroupVersion = 1
+	}
+
+	// the bpf filesystem is provided only if the kernel supports the bpf syscall
+	resp.Bpf = exists(filepath.Join(p.sysPath, "fs/bpf"))
+	if configPath != "" && !enabled(config["CONFIG_BPF_SYSCALL"]) {
+		resp.Bpf = false
+	}
+
+	return resp
+}
+
+// availableModules returns the probed modules which are loaded, built in, or installed
+// for the kernel release
+func (p capabilityProber) availableModules(release string) map[string]bool {
+	available := make(map[string]bool)
+	for _, module := range probedModules {
+		if exists(filepath.Join(p.sysPath, "module", module)) {
+			available[module] = true
+		}
+	}
+
+	if release == "" {
+		return available
+	}
+	for _, index := range []string{"modules.builtin", "modules.dep"} {
+		f, err := os.Open(filepath.Join(p.modulesPath, release, index))
+		if err != nil {
+			continue
+		}
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			// every line starts with the path of module file, such as
+			// `kernel/net/sched/sch_netem.ko.xz: `
+			path := strings.SplitN(scanner.Text(), ":", 2)[0]
+			name := strings.SplitN(filepath.Base(path), ".ko", 2)[0]
+			available[strings.ReplaceAll(name, "-", "_")] = true
+		}
+		f.Close()
+	}
+
+	return available
+}
+
+// kernelConfig reads the config of the running kernel, and returns the path which it's read from
+// with the options. The path is empty if no kernel config is readable.
+func (p capabilityProber) kernelConfig(release string) (string, map[string]string) {
+	paths := []string{filepath.Join(p.procPath, "config.gz")}
+	if release != "" {
+		paths = append(paths,
+			filepath.Join(p.bootPath, "config-"+release),
+			filepath.Join(p.modulesPath, release, "build/.config"))
+	}
+
+	for _, path := range paths {
+		config, err := readKernelConfig(path)
+		if err != nil {
+			continue
+		}
+		return path, config
+	}
+	return "", nil
+}
+
+// readKernelConfig parses the kernel config, which is gzipped if its name ends with .gz
+func readKernelConfig(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	config := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		// the options are like `CONFIG_NET_SCH_NETEM=m`, the unset ones are comments
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) == 2 {
+			config[kv[0]] = kv[1]
+		}
+	}
+	return config, scanner.Err()
+}
+
+// enabled returns true if the kernel config option is built in or built as a module
+func enabled(value string) bool {
+	return value == "y" || value == "m"
+}
+
+// runtimeStatuses checks whether the sockets of the container runtimes are able to be connected
+func runtimeStatuses(containerRuntime string) []*pb.RuntimeStatus {
+	var statuses []*pb.RuntimeStatus
+	for _, s := range runtimeSockets {
+		if containerRuntime == containerRuntimeAuto {
+			if !isSocket(s.Socket) {
+				continue
+			}
+		} else if containerRuntime != s.Runtime {
+			continue
+		}
+
+		status := &pb.RuntimeStatus{
+			Name:   s.Runtime,
+			Socket: s.Socket,
+		}
+		conn, err := dialSocket(s.Socket, runtimeDialTimeout)
+		if err != nil {
+			status.Error = err.Error()
+		} else {
+			conn.Close()
+			status.Healthy = true
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
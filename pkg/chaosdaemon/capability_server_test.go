@@ -0,0 +1,152 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+var _ = Describe("capability server", func() {
+	var root string
+
+	BeforeEach(func() {
+		var err error
+		root, err = ioutil.TempDir("", "capability")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(root)
+	})
+
+	write := func(path, content string) {
+		path = filepath.Join(root, path)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte(content), 0644)).To(Succeed())
+	}
+
+	prober := func() capabilityProber {
+		return capabilityProber{
+			procPath:    filepath.Join(root, "proc"),
+			sysPath:     filepath.Join(root, "sys"),
+			modulesPath: filepath.Join(root, "lib/modules"),
+			bootPath:    filepath.Join(root, "boot"),
+		}
+	}
+
+	Context("probe", func() {
+		It("should report the capabilities of the node", func() {
+			write("proc/sys/kernel/osrelease", "5.4.0-42-generic\n")
+			write("sys/module/sch_netem/refcnt", "1")
+			write("sys/fs/cgroup/cgroup.controllers", "cpu memory")
+			Expect(os.MkdirAll(filepath.Join(root, "sys/fs/bpf"), 0755)).To(Succeed())
+			write("lib/modules/5.4.0-42-generic/modules.builtin", "kernel/net/sched/sch_prio.ko\n")
+			write("lib/modules/5.4.0-42-generic/modules.dep",
+				"kernel/net/sched/sch_tbf.ko.xz:\nkernel/drivers/net/ifb.ko: kernel/net/core/foo.ko\n")
+
+			resp := prober().probe()
+			Expect(resp.KernelVersion).To(Equal("5.4.0-42-generic"))
+			Expect(resp.Modules).To(Equal([]string{"ifb", "sch_netem", "sch_tbf", "sch_prio"}))
+			Expect(resp.CgroupVersion).To(Equal(int32(2)))
+			Expect(resp.Bpf).To(BeTrue())
+		})
+
+		It("should report cgroup v1 and missing modules", func() {
+			write("proc/sys/kernel/osrelease", "4.19.0")
+			Expect(os.MkdirAll(filepath.Join(root, "sys/fs/cgroup/cpu"), 0755)).To(Succeed())
+
+			resp := prober().probe()
+			Expect(resp.Modules).To(BeEmpty())
+			Expect(resp.CgroupVersion).To(Equal(int32(1)))
+			Expect(resp.Bpf).To(BeFalse())
+			Expect(resp.KernelConfig).To(BeEmpty())
+			Expect(resp.KernelFeatures).To(BeEmpty())
+		})
+
+		It("should report the kernel features from the kernel config", func() {
+			write("proc/sys/kernel/osrelease", "5.10.0-1-arm64")
+			Expect(os.MkdirAll(filepath.Join(root, "sys/fs/bpf"), 0755)).To(Succeed())
+			write("boot/config-5.10.0-1-arm64", "CONFIG_BPF_SYSCALL=y\nCONFIG_NET_SCH_INGRESS=m\n"+
+				"# CONFIG_NET_CLS_ACT is not set\nCONFIG_NET_SCH_NETEM=y\nCONFIG_NET_SCH_TBF=m\n")
+
+			resp := prober().probe()
+			Expect(resp.KernelConfig).To(Equal(filepath.Join(root, "boot/config-5.10.0-1-arm64")))
+			Expect(resp.KernelFeatures).To(Equal([]string{"bpf_syscall", "net_sch_ingress"}))
+			// the modules built as modules are only available if they're installed
+			Expect(resp.Modules).To(Equal([]string{"sch_netem"}))
+			Expect(resp.Bpf).To(BeTrue())
+		})
+
+		It("should prefer the gzipped kernel config in proc", func() {
+			write("proc/sys/kernel/osrelease", "5.4.0")
+			Expect(os.MkdirAll(filepath.Join(root, "sys/fs/bpf"), 0755)).To(Succeed())
+			var buf bytes.Buffer
+			gz := gzip.NewWriter(&buf)
+			_, err := gz.Write([]byte("# CONFIG_BPF_SYSCALL is not set\n"))
+			Expect(err).To(BeNil())
+			Expect(gz.Close()).To(Succeed())
+			write("proc/config.gz", buf.String())
+			write("boot/config-5.4.0", "CONFIG_BPF_SYSCALL=y\n")
+
+			resp := prober().probe()
+			Expect(resp.KernelConfig).To(Equal(filepath.Join(root, "proc/config.gz")))
+			Expect(resp.KernelFeatures).To(BeEmpty())
+			Expect(resp.Bpf).To(BeFalse())
+		})
+	})
+
+	Context("runtimeStatuses", func() {
+		It("should check the socket of container runtime", func() {
+			socket := filepath.Join(root, "containerd.sock")
+			l, err := net.Listen("unix", socket)
+			Expect(err).To(BeNil())
+			defer l.Close()
+
+			saved := runtimeSockets
+			defer func() { runtimeSockets = saved }()
+			runtimeSockets = []struct {
+				Runtime string
+				Socket  string
+			}{
+				{containerRuntimeContainerd, socket},
+				{containerRuntimeCrio, filepath.Join(root, "crio.sock")},
+				{containerRuntimeDocker, filepath.Join(root, "docker.sock")},
+			}
+
+			statuses := runtimeStatuses(containerRuntimeDocker)
+			Expect(statuses).To(HaveLen(1))
+			Expect(statuses[0].Name).To(Equal(containerRuntimeDocker))
+			Expect(statuses[0].Healthy).To(BeFalse())
+			Expect(statuses[0].Error).NotTo(BeEmpty())
+
+			defer mock.With("MockIsSocket", func(path string) bool {
+				return path == socket
+			})()
+			statuses = runtimeStatuses(containerRuntimeAuto)
+			Expect(statuses).To(HaveLen(1))
+			Expect(statuses[0].Name).To(Equal(containerRuntimeContainerd))
+			Expect(statuses[0].Healthy).To(BeTrue())
+		})
+	})
+})
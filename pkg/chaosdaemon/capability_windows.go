@@ -0,0 +1,31 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/windows"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// probeNodeCapabilities reports the version of windows as the kernel version, the kernel
+// modules, cgroups and bpf of linux are unavailable on windows
+func probeNodeCapabilities() *pb.CapabilitiesResponse {
+	version := windows.RtlGetVersion()
+	return &pb.CapabilitiesResponse{
+		KernelVersion: fmt.Sprintf("%d.%d.%d", version.MajorVersion, version.MinorVersion, version.BuildNumber),
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// the kinds of root qdisc which are added by chaos
+var chaosQdiscKinds = map[string]bool{
+	"netem": true,
+	"tbf":   true,
+	"prio":  true,
+	// the bpf delay requires fq, which isn't used by the network interfaces of pods by default
+	"fq": true,
+}
+
+func (s *daemonServer) Cleanup(ctx context.Context, req *pb.CleanupRequest) (*pb.CleanupResponse, error) {
+	log.Info("Cleanup residual chaos", "request", req)
+
+	resp := &pb.CleanupResponse{}
+	// containers in the same pod share the network namespace, which should be cleaned up only once
+	cleaned := make(map[string]bool)
+
+	for _, containerID := range req.ContainerIds {
+		pid, err := s.crClient.GetPidFromContainerID(ctx, containerID)
+		if err != nil {
+			log.Error(err, "error while getting PID", "containerID", containerID)
+			return nil, err
+		}
+
+		nsPath := GetNsPath(pid, netNS)
+		ns, err := os.Readlink(nsPath)
+		if err != nil {
+			// the namespace can't be identified, so it's cleaned up anyway
+			ns = nsPath
+		}
+		if !cleaned[ns] {
+			cleaned[ns] = true
+
+			removed, err := cleanupNetwork(ctx, nsPath, req.DryRun)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range removed {
+				resp.Removed = append(resp.Removed, fmt.Sprintf("%s: %s", containerID, r))
+			}
+		}
+
+		removed, err := cleanupStressors(pid, req.DryRun)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range removed {
+			resp.Removed = append(resp.Removed, fmt.Sprintf("%s: %s", containerID, r))
+		}
+	}
+
+	log.Info("Cleanup residual chaos successfully", "removed", resp.Removed)
+	return resp, nil
+}
+
+// cleanupNetwork removes the qdiscs, iptables rules and ipsets added by chaos in the network namespace.
+// The iptables rules are removed before the ipsets because the ipsets in use can't be destroyed.
+func cleanupNetwork(ctx context.Context, nsPath string, dryRun bool) ([]string, error) {
+	var removed []string
+
+	out, err := withNetNS(ctx, nsPath, "tc", "qdisc", "show").CombinedOutput()
+	if err != nil {
+		log.Error(err, "failed to list qdiscs", "output", string(out))
+		return nil, err
+	}
+	for _, dev := range parseChaosQdiscs(string(out)) {
+		args := []string{"qdisc", "del", "dev", dev, "root"}
+		if !dryRun {
+			if err := runInNetNS(ctx, nsPath, "tc", args...); err != nil {
+				return nil, err
+			}
+		}
+		removed = append(removed, "tc "+strings.Join(args, " "))
+	}
+
+	for _, dev := range parseClsactDevices(string(out)) {
+		for _, direction := range []string{"egress", "ingress"} {
+			filters, err := withNetNS(ctx, nsPath, "tc", "filter", "show", "dev", dev, direction).CombinedOutput()
+			if err != nil {
+				log.Error(err, "failed to list filters", "device", dev, "output", string(filters))
+				return nil, err
+			}
+			for _, pref := range parseChaosBpfFilters(string(filters)) {
+				args := []string{"filter", "del", "dev", dev, direction, "pref", pref}
+				if !dryRun {
+					if err := runInNetNS(ctx, nsPath, "tc", args...); err != nil {
+						return nil, err
+					}
+				}
+				removed = append(removed, "tc "+strings.Join(args, " "))
+			}
+		}
+	}
+
+	for _, chain := range []string{"INPUT", "OUTPUT"} {
+		out, err := withNetNS(ctx, nsPath, iptablesCmd, "-S", chain, "-w", "5").CombinedOutput()
+		if err != nil {
+			log.Error(err, "failed to list iptables rules", "chain", chain, "output", string(out))
+			return nil, err
+		}
+		for _, rule := range parseChaosIptablesRules(string(out)) {
+			args := append([]string{"-D"}, rule[1:]...)
+			if !dryRun {
+				if err := runInNetNS(ctx, nsPath, iptablesCmd, append(args, "-w", "5")...); err != nil {
+					return nil, err
+				}
+			}
+			removed = append(removed, "iptables "+strings.Join(args, " "))
+		}
+	}
+
+	out, err = withNetNS(ctx, nsPath, iptablesCmd, "-t", "nat", "-S", "-w", "5").CombinedOutput()
+	if err != nil {
+		log.Error(err, "failed to list nat rules", "output", string(out))
+		return nil, err
+	}
+	// the proxies of the database faults are left to chaos-daemon, which stops them on recovery
+	rules := append(parseChaosMeshRules(string(out)), dbProxyRules(string(out), isDBProxyComment)...)
+	for _, rule := range rules {
+		args := append([]string{"-t", "nat", "-D"}, rule[1:]...)
+		if !dryRun {
+			if err := runInNetNS(ctx, nsPath, iptablesCmd, append(args, "-w", "5")...); err != nil {
+				return nil, err
+			}
+		}
+		removed = append(removed, "iptables "+strings.Join(args, " "))
+	}
+
+	out, err = withNetNS(ctx, nsPath, "ipset", "list", "-n").CombinedOutput()
+	if err != nil {
+		log.Error(err, "failed to list ipsets", "output", string(out))
+		return nil, err
+	}
+	// there is no ipset in the network namespace of pod unless it's created by chaos
+	for _, name := range strings.Fields(string(out)) {
+		args := []string{"destroy", name}
+		if !dryRun {
+			if err := runInNetNS(ctx, nsPath, "ipset", args...); err != nil {
+				return nil, err
+			}
+		}
+		removed = append(removed, "ipset "+strings.Join(args, " "))
+	}
+
+	return removed, nil
+}
+
+func runInNetNS(ctx context.Context, nsPath string, cmd string, args ...string) error {
+	c := withNetNS(ctx, nsPath, cmd, args...)
+	log.Info("Remove residual chaos", "command", c.String())
+
+	out, err := c.CombinedOutput()
+	if err != nil {
+		log.Error(err, "failed to remove residual chaos", "command", c.String(), "output", string(out))
+		return err
+	}
+	return nil
+}
+
+// parseChaosQdiscs returns the devices whose root qdisc is added by chaos from the output of `tc qdisc show`
+func parseChaosQdiscs(output string) []string {
+	var devs []string
+	for _, line := range strings.Split(output, "\n") {
+		// e.g. qdisc netem 1: dev eth0 root refcnt 2 limit 1000 delay 100.0ms
+		fields := strings.Fields(line)
+		if len(fields) < 6 || fields[0] != "qdisc" || !chaosQdiscKinds[fields[1]] {
+			continue
+		}
+		if fields[3] == "dev" && fields[5] == "root" {
+			devs = append(devs, fields[4])
+		}
+	}
+	return devs
+}
+
+// parseClsactDevices returns the devices with the clsact qdisc from the output of `tc qdisc show`
+func parseClsactDevices(output string) []string {
+	var devs []string
+	for _, line := range strings.Split(output, "\n") {
+		// e.g. qdisc clsact ffff: dev eth0 parent ffff:fff1
+		fields := strings.Fields(line)
+		if len(fields) >= 5 && fields[0] == "qdisc" && fields[1] == "clsact" && fields[3] == "dev" {
+			devs = append(devs, fields[4])
+		}
+	}
+	return devs
+}
+
+// parseChaosBpfFilters returns the preferences of the bpf filters attached by SetBpfFault
+// from the output of `tc filter show`
+func parseChaosBpfFilters(output string) []string {
+	var prefs []string
+	seen := make(map[string]bool)
+	for _, line := range strings.Split(output, "\n") {
+		// e.g. filter protocol all pref 1 bpf chain 0 handle 0x1 chaos-mesh-egress direct-action not_in_hw id 42
+		fields := strings.Fields(line)
+		if len(fields) < 6 || fields[0] != "filter" || fields[3] != "pref" || fields[5] != "bpf" ||
+			!strings.Contains(line, " "+bpfFilterPrefix) {
+			continue
+		}
+		if pref := fields[4]; !seen[pref] {
+			seen[pref] = true
+			prefs = append(prefs, pref)
+		}
+	}
+	return prefs
+}
+
+// parseChaosIptablesRules returns the rules added by FlushIptables from the output of `iptables -S`
+func parseChaosIptablesRules(output string) [][]string {
+	var rules [][]string
+	for _, line := range strings.Split(output, "\n") {
+		// e.g. -A INPUT -m set --match-set delay_src src -j DROP
+		if strings.HasPrefix(line, "-A ") && strings.Contains(line, "--match-set") && strings.HasSuffix(line, "-j DROP") {
+			rules = append(rules, strings.Fields(line))
+		}
+	}
+	return rules
+}
+
+// parseChaosMeshRules returns the nat rules added by FlushIptables in the chains of service mesh sidecars
+// from the output of `iptables -t nat -S`
+func parseChaosMeshRules(output string) [][]string {
+	chains := make(map[string]bool)
+	for _, c := range knownMeshChains {
+		chains[c.inbound] = true
+		chains[c.outbound] = true
+	}
+
+	var rules [][]string
+	for _, line := range strings.Split(output, "\n") {
+		// e.g. -A ISTIO_OUTPUT -m set --match-set part_tgt dst -j RETURN
+		fields := strings.Fields(line)
+		if len(fields) > 2 && fields[0] == "-A" && chains[fields[1]] &&
+			strings.Contains(line, "--match-set") && strings.HasSuffix(line, "-j RETURN") {
+			rules = append(rules, fields)
+		}
+	}
+	return rules
+}
+
+// cleanupStressors kills the stressors which run in the pid namespace of process pid
+func cleanupStressors(pid uint32, dryRun bool) ([]string, error) {
+	ns, err := os.Readlink(GetNsPath(pid, pidNS))
+	if err != nil {
+		return nil, err
+	}
+
+	procs, err := ioutil.ReadDir(defaultProcPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []string
+	for _, proc := range procs {
+		p, err := strconv.ParseUint(proc.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+
+		comm, err := ioutil.ReadFile(fmt.Sprintf("%s/%d/comm", defaultProcPrefix, p))
+		if err != nil || strings.TrimSpace(string(comm)) != "stress-ng" {
+			continue
+		}
+		if procNs, err := os.Readlink(GetNsPath(uint32(p), pidNS)); err != nil || procNs != ns {
+			continue
+		}
+
+		if !dryRun {
+			log.Info("Kill residual stressor", "pid", p)
+			if err := killPid(uint32(p)); err != nil && err != syscall.ESRCH {
+				return nil, err
+			}
+		}
+		removed = append(removed, fmt.Sprintf("kill stress-ng %d", p))
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+var _ = Describe("cleanup server", func() {
+	defer mock.With("MockContainerdClient", &MockClient{})()
+	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
+	s := &daemonServer{crClient: c}
+
+	Context("parseChaosQdiscs", func() {
+		It("should only return the devices with root qdisc added by chaos", func() {
+			devs := parseChaosQdiscs(`qdisc noqueue 0: dev lo root refcnt 2
+qdisc netem 1: dev eth0 root refcnt 2 limit 1000 delay 100.0ms
+qdisc tbf 1: dev eth1 root refcnt 2 rate 1Mbit burst 10000b lat 5.0ms
+qdisc netem 2: dev eth2 parent 1:1 limit 1000 loss 25%
+`)
+			Expect(devs).To(Equal([]string{"eth0", "eth1"}))
+		})
+	})
+
+	Context("parseChaosBpfFilters", func() {
+		It("should only return the preferences of the bpf filters added by chaos", func() {
+			Expect(parseClsactDevices(`qdisc noqueue 0: dev lo root refcnt 2
+qdisc fq 1: dev eth0 root refcnt 2 limit 10000p flow_limit 100p
+qdisc clsact ffff: dev eth0 parent ffff:fff1
+`)).To(Equal([]string{"eth0"}))
+
+			prefs := parseChaosBpfFilters(`filter protocol all pref 1 bpf chain 0
+filter protocol all pref 1 bpf chain 0 handle 0x1 chaos-mesh-egress direct-action not_in_hw id 42 tag 1a2b3c4d5e6f7a8b jited
+filter protocol all pref 2 bpf chain 0 handle 0x1 cilium-egress direct-action not_in_hw id 7 tag 0a1b2c3d4e5f6a7b jited
+`)
+			Expect(prefs).To(Equal([]string{"1"}))
+		})
+	})
+
+	Context("parseChaosIptablesRules", func() {
+		It("should only return the rules added by chaos", func() {
+			rules := parseChaosIptablesRules(`-P INPUT ACCEPT
+-A INPUT -m set --match-set delay_src src -j DROP
+-A INPUT -p tcp -m tcp --dport 22 -j ACCEPT
+`)
+			Expect(rules).To(Equal([][]string{
+				{"-A", "INPUT", "-m", "set", "--match-set", "delay_src", "src", "-j", "DROP"},
+			}))
+		})
+	})
+
+	Context("parseChaosMeshRules", func() {
+		It("should only return the rules added by chaos in the chains of sidecars", func() {
+			rules := parseChaosMeshRules(`-N ISTIO_OUTPUT
+-A ISTIO_OUTPUT -m set --match-set part_tgt dst -j RETURN
+-A ISTIO_OUTPUT -m owner --uid-owner 1337 -j RETURN
+-A OUTPUT -m set --match-set other dst -j RETURN
+`)
+			Expect(rules).To(Equal([][]string{
+				{"-A", "ISTIO_OUTPUT", "-m", "set", "--match-set", "part_tgt", "dst", "-j", "RETURN"},
+			}))
+		})
+	})
+
+	Context("Cleanup", func() {
+		It("should remove the residual faults once for each network namespace", func() {
+			defer mock.With("pid", os.Getpid())()
+
+			var commands []string
+			defer mock.With("MockWithNetNs", func(ctx context.Context, ns, cmd string, args ...string) *exec.Cmd {
+				command := cmd + " " + strings.Join(args, " ")
+				commands = append(commands, command)
+
+				switch command {
+				case "tc qdisc show":
+					return exec.Command("echo", "qdisc netem 1: dev eth0 root refcnt 2 limit 1000 delay 100.0ms")
+				case iptablesCmd + " -S INPUT -w 5":
+					return exec.Command("echo", "-A INPUT -m set --match-set part_src src -j DROP")
+				case "ipset list -n":
+					return exec.Command("echo", "part_src")
+				}
+				return exec.Command("echo", "mock command")
+			})()
+
+			resp, err := s.Cleanup(context.TODO(), &pb.CleanupRequest{
+				ContainerIds: []string{"containerd://container-1", "containerd://container-2"},
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Removed).To(Equal([]string{
+				"containerd://container-1: tc qdisc del dev eth0 root",
+				"containerd://container-1: iptables -D INPUT -m set --match-set part_src src -j DROP",
+				"containerd://container-1: ipset destroy part_src",
+			}))
+			Expect(commands).To(ContainElement("tc qdisc del dev eth0 root"))
+			Expect(commands).To(ContainElement("ipset destroy part_src"))
+		})
+
+		It("should not remove anything in dry run", func() {
+			defer mock.With("pid", os.Getpid())()
+
+			var commands []string
+			defer mock.With("MockWithNetNs", func(ctx context.Context, ns, cmd string, args ...string) *exec.Cmd {
+				commands = append(commands, cmd+" "+strings.Join(args, " "))
+				if cmd == "ipset" {
+					return exec.Command("echo", "part_src")
+				}
+				return exec.Command("echo", "")
+			})()
+
+			resp, err := s.Cleanup(context.TODO(), &pb.CleanupRequest{
+				ContainerIds: []string{"containerd://container-1"},
+				DryRun:       true,
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Removed).To(Equal([]string{"containerd://container-1: ipset destroy part_src"}))
+			Expect(commands).ToNot(ContainElement("ipset destroy part_src"))
+		})
+	})
+})
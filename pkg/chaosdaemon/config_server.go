@@ -0,0 +1,349 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+const (
+	// kubeletDataDir is the symlink of kubelet to the directory holding the files of the volume,
+	// the files in the volume are the symlinks to the files in it
+	kubeletDataDir = "..data"
+	// corruptedDataDir holds the corrupted copies of the files in the volume
+	corruptedDataDir = "..chaos-mesh"
+	// corruptedLinkSuffix is the suffix of the symlink which replaces the symlink of kubelet atomically
+	corruptedLinkSuffix = ".chaos-mesh_tmp"
+)
+
+// The corruptions of the original content of the file
+const (
+	corruptionTruncate = "truncate"
+	corruptionRandom   = "random"
+	corruptionEmpty    = "empty"
+)
+
+// CorruptConfig replaces the file of the ConfigMap or Secret volume mounted in the container.
+// Kubelet writes the files of the volume into a data directory and links them into the volume,
+// it only creates the links which are missing, so the link is pointed to a corrupted copy of the file,
+// which isn't reverted by kubelet until the recovery.
+func (s *daemonServer) CorruptConfig(ctx context.Context, req *pb.ConfigRequest) (*empty.Empty, error) {
+	log.Info("Corrupt Config", "request", req)
+
+	if err := validateConfigKey(req.Key); err != nil {
+		log.Error(err, "invalid key")
+		return nil, err
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting PID")
+		return nil, err
+	}
+
+	dir, err := volumeHostDir(defaultProcPrefix, pid, req.MountPath)
+	if err != nil {
+		log.Error(err, "error while finding volume", "mountPath", req.MountPath)
+		return nil, err
+	}
+
+	if err := corruptConfig(dir, req.Key, req.Content, req.Corruption); err != nil {
+		log.Error(err, "error while corrupting config", "dir", dir, "key", req.Key)
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// RecoverConfig points the link of the file back to the data directory of kubelet
+func (s *daemonServer) RecoverConfig(ctx context.Context, req *pb.ConfigRequest) (*empty.Empty, error) {
+	log.Info("Recover Config", "request", req)
+
+	if err := validateConfigKey(req.Key); err != nil {
+		log.Error(err, "invalid key")
+		return nil, err
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting PID")
+		return nil, err
+	}
+
+	dir, err := volumeHostDir(defaultProcPrefix, pid, req.MountPath)
+	if err != nil {
+		log.Error(err, "error while finding volume", "mountPath", req.MountPath)
+		return nil, err
+	}
+
+	if err := recoverConfig(dir, req.Key); err != nil {
+		log.Error(err, "error while recovering config", "dir", dir, "key", req.Key)
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// validateConfigKey checks the key is a path in the volume, the paths starting with ".." are
+// reserved by kubelet
+func validateConfigKey(key string) error {
+	if key == "" || filepath.IsAbs(key) || filepath.Clean(key) != key || strings.HasPrefix(key, "..") {
+		return fmt.Errorf("key %s isn't a path in the volume", key)
+	}
+	return nil
+}
+
+// mountEntry is an entry of the mountinfo of a process
+type mountEntry struct {
+	device     string
+	root       string
+	mountPoint string
+	options    []string
+}
+
+// readMountInfo reads the mount points of the process
+func readMountInfo(procPath string, pid uint32) ([]mountEntry, error) {
+	f, err := os.Open(filepath.Join(procPath, strconv.FormatUint(uint64(pid), 10), "mountinfo"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []mountEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// according to procfs's man page, the fields are the mount id, the parent id, the device,
+		// the root, the mount point and the mount options
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 6 {
+			continue
+		}
+		entries = append(entries, mountEntry{
+			device:     fields[2],
+			root:       unescapeMountPath(fields[3]),
+			mountPoint: unescapeMountPath(fields[4]),
+			options:    strings.Split(fields[5], ","),
+		})
+	}
+	return entries, scanner.Err()
+}
+
+// unescapeMountPath decodes the octal escapes of the spaces, tabs, newlines and backslashes in mountinfo
+func unescapeMountPath(path string) string {
+	if !strings.Contains(path, "\\") {
+		return path
+	}
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] == '\\' && i+3 < len(path) {
+			if c, err := strconv.ParseUint(path[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(path[i])
+	}
+	return b.String()
+}
+
+// volumeHostDir returns the writable path of the volume mounted on the mount path in the container.
+// The volume is mounted read-only in the container, so it's written through the mount of the same
+// device on the host, whose root contains the root of the volume.
+func volumeHostDir(procPath string, pid uint32, mountPath string) (string, error) {
+	mounts, err := readMountInfo(procPath, pid)
+	if err != nil {
+		return "", err
+	}
+	var volume *mountEntry
+	for i := range mounts {
+		if mounts[i].mountPoint == filepath.Clean(mountPath) {
+			volume = &mounts[i]
+		}
+	}
+	if volume == nil {
+		return "", fmt.Errorf("nothing is mounted on %s in process %d", mountPath, pid)
+	}
+
+	hostMounts, err := readMountInfo(procPath, 1)
+	if err != nil {
+		return "", err
+	}
+	for _, m := range hostMounts {
+		if m.device != volume.device || !isReadWrite(m.options) {
+			continue
+		}
+		rel, err := filepath.Rel(m.root, volume.root)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+			continue
+		}
+		return filepath.Join(procPath, "1/root", m.mountPoint, rel), nil
+	}
+
+	return "", fmt.Errorf("the writable mount of %s on the host isn't found", mountPath)
+}
+
+func isReadWrite(options []string) bool {
+	for _, option := range options {
+		if option == "rw" {
+			return true
+		}
+	}
+	return false
+}
+
+// corruptConfig copies the top directory or file of the key into the corrupted data directory,
+// replaces the file of the key with the content or the corrupted content, and points the link
+// of the top directory or file to the copy
+func corruptConfig(dir, key string, content []byte, corruption string) error {
+	top := strings.Split(key, "/")[0]
+	link := filepath.Join(dir, top)
+	target, err := os.Readlink(link)
+	if err != nil {
+		return fmt.Errorf("%s isn't a file of ConfigMap or Secret volume: %v", key, err)
+	}
+	original := filepath.Join(kubeletDataDir, top)
+	corrupted := filepath.Join(corruptedDataDir, top)
+	if target != original && target != corrupted {
+		return fmt.Errorf("%s isn't a file of ConfigMap or Secret volume, it's linked to %s", key, target)
+	}
+
+	source := filepath.Join(dir, kubeletDataDir, key)
+	info, err := os.Stat(source)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%s isn't a regular file", key)
+	}
+	if corruption != "" {
+		data, err := ioutil.ReadFile(source)
+		if err != nil {
+			return err
+		}
+		if content, err = corrupt(data, corruption); err != nil {
+			return err
+		}
+	}
+
+	copied := filepath.Join(dir, corrupted)
+	if err := os.RemoveAll(copied); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(copied), 0755); err != nil {
+		return err
+	}
+	if err := copyTree(filepath.Join(dir, original), copied); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, corruptedDataDir, key), content, info.Mode().Perm()); err != nil {
+		return err
+	}
+
+	return relink(link, corrupted)
+}
+
+// recoverConfig points the link of the top directory or file of the key back to the data directory
+// of kubelet, and removes the corrupted copy
+func recoverConfig(dir, key string) error {
+	top := strings.Split(key, "/")[0]
+	link := filepath.Join(dir, top)
+	target, err := os.Readlink(link)
+	// the key may be removed from the ConfigMap or Secret, then kubelet removes the link
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err == nil && target == filepath.Join(corruptedDataDir, top) {
+		if err := relink(link, filepath.Join(kubeletDataDir, top)); err != nil {
+			return err
+		}
+	}
+
+	if err := os.RemoveAll(filepath.Join(dir, corruptedDataDir, top)); err != nil {
+		return err
+	}
+	// the directory is kept if other keys are still corrupted
+	if err := os.Remove(filepath.Join(dir, corruptedDataDir)); err != nil && !os.IsNotExist(err) {
+		log.Info("corrupted data directory is kept", "dir", dir, "reason", err.Error())
+	}
+	return nil
+}
+
+// relink points the link to the target atomically
+func relink(link, target string) error {
+	tmp := link + corruptedLinkSuffix
+	os.Remove(tmp)
+	if err := os.Symlink(target, tmp); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, link); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// copyTree copies the regular files and directories from the source to the destination
+func copyTree(source, dest string) error {
+	return filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest, rel)
+
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm())
+		case info.Mode().IsRegular():
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			return ioutil.WriteFile(target, data, info.Mode().Perm())
+		}
+		return nil
+	})
+}
+
+// corrupt corrupts the content: truncate keeps the first half, random replaces it with
+// the random bytes of the same length, and empty removes it
+func corrupt(data []byte, corruption string) ([]byte, error) {
+	switch corruption {
+	case corruptionTruncate:
+		return data[:len(data)/2], nil
+	case corruptionRandom:
+		random := make([]byte, len(data))
+		rand.Read(random)
+		return random, nil
+	case corruptionEmpty:
+		return []byte{}, nil
+	}
+	return nil, fmt.Errorf("unknown corruption %s", corruption)
+}
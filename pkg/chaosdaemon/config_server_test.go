@@ -0,0 +1,171 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var _ = Describe("config server", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "config")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	// writeVolume lays out the files like the atomic writer of kubelet
+	writeVolume := func() {
+		data := filepath.Join(dir, "..2020_10_17_14_00_00.1")
+		Expect(os.MkdirAll(filepath.Join(data, "conf"), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(data, "app.yaml"), []byte("level: info\n"), 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(data, "conf/db.yaml"), []byte("host: db\n"), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(data, "conf/cache.yaml"), []byte("host: cache\n"), 0644)).To(Succeed())
+		Expect(os.Symlink("..2020_10_17_14_00_00.1", filepath.Join(dir, kubeletDataDir))).To(Succeed())
+		Expect(os.Symlink("..data/app.yaml", filepath.Join(dir, "app.yaml"))).To(Succeed())
+		Expect(os.Symlink("..data/conf", filepath.Join(dir, "conf"))).To(Succeed())
+	}
+
+	read := func(path string) string {
+		data, err := ioutil.ReadFile(filepath.Join(dir, path))
+		Expect(err).To(BeNil())
+		return string(data)
+	}
+
+	Context("corruptConfig", func() {
+		It("should replace the file and recover it", func() {
+			writeVolume()
+
+			Expect(corruptConfig(dir, "app.yaml", []byte("level: ???"), "")).To(Succeed())
+			Expect(read("app.yaml")).To(Equal("level: ???"))
+			Expect(read("..data/app.yaml")).To(Equal("level: info\n"))
+			info, err := os.Stat(filepath.Join(dir, "app.yaml"))
+			Expect(err).To(BeNil())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			Expect(recoverConfig(dir, "app.yaml")).To(Succeed())
+			Expect(read("app.yaml")).To(Equal("level: info\n"))
+			target, err := os.Readlink(filepath.Join(dir, "app.yaml"))
+			Expect(err).To(BeNil())
+			Expect(target).To(Equal("..data/app.yaml"))
+			_, err = os.Stat(filepath.Join(dir, corruptedDataDir))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("should keep the other files in the directory of the key", func() {
+			writeVolume()
+
+			Expect(corruptConfig(dir, "conf/db.yaml", nil, corruptionTruncate)).To(Succeed())
+			Expect(read("conf/db.yaml")).To(Equal("host"))
+			Expect(read("conf/cache.yaml")).To(Equal("host: cache\n"))
+
+			// the corruption is injected again
+			Expect(corruptConfig(dir, "conf/db.yaml", nil, corruptionEmpty)).To(Succeed())
+			Expect(read("conf/db.yaml")).To(Equal(""))
+
+			Expect(recoverConfig(dir, "conf/db.yaml")).To(Succeed())
+			Expect(read("conf/db.yaml")).To(Equal("host: db\n"))
+		})
+
+		It("should fail on the files which aren't linked by kubelet", func() {
+			writeVolume()
+			Expect(ioutil.WriteFile(filepath.Join(dir, "plain.yaml"), []byte("plain"), 0644)).To(Succeed())
+
+			err := corruptConfig(dir, "plain.yaml", nil, corruptionRandom)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("isn't a file of ConfigMap or Secret volume"))
+
+			err = corruptConfig(dir, "conf", nil, corruptionRandom)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("isn't a regular file"))
+		})
+
+		It("should recover the key removed by kubelet", func() {
+			writeVolume()
+
+			Expect(corruptConfig(dir, "app.yaml", nil, corruptionRandom)).To(Succeed())
+			Expect(read("app.yaml")).To(HaveLen(len("level: info\n")))
+			Expect(os.Remove(filepath.Join(dir, "app.yaml"))).To(Succeed())
+
+			Expect(recoverConfig(dir, "app.yaml")).To(Succeed())
+			_, err := os.Stat(filepath.Join(dir, corruptedDataDir))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Context("validateConfigKey", func() {
+		It("should only accept the paths in the volume", func() {
+			Expect(validateConfigKey("app.yaml")).To(Succeed())
+			Expect(validateConfigKey("conf/db.yaml")).To(Succeed())
+			for _, key := range []string{"", "/etc/passwd", "../app.yaml", "conf/../../app.yaml", "..data/app.yaml", "conf/"} {
+				Expect(validateConfigKey(key)).ToNot(Succeed(), key)
+			}
+		})
+	})
+
+	Context("volumeHostDir", func() {
+		It("should find the writable mount of the volume on the host", func() {
+			Expect(os.MkdirAll(filepath.Join(dir, "1"), 0755)).To(Succeed())
+			Expect(os.MkdirAll(filepath.Join(dir, "42"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, "1/mountinfo"), []byte(
+				"22 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw\n"+
+					"30 22 0:50 / /var/lib/kubelet/pods/uid/volumes/kubernetes.io~secret/token ro,relatime shared:2 - tmpfs tmpfs rw\n"+
+					"31 22 0:51 / /var/lib/kubelet/pods/uid/volumes/kubernetes.io~secret/my\\040secret rw,relatime shared:3 - tmpfs tmpfs rw\n"),
+				0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, "42/mountinfo"), []byte(
+				"100 99 0:60 / / rw,relatime - overlay overlay rw\n"+
+					"101 100 8:1 /var/lib/kubelet/pods/uid/volumes/kubernetes.io~configmap/config /etc/app ro,relatime - ext4 /dev/sda1 rw\n"+
+					"102 100 0:51 / /etc/secret ro,relatime - tmpfs tmpfs rw\n"),
+				0644)).To(Succeed())
+
+			path, err := volumeHostDir(dir, 42, "/etc/app/")
+			Expect(err).To(BeNil())
+			Expect(path).To(Equal(filepath.Join(dir, "1/root/var/lib/kubelet/pods/uid/volumes/kubernetes.io~configmap/config")))
+
+			path, err = volumeHostDir(dir, 42, "/etc/secret")
+			Expect(err).To(BeNil())
+			Expect(path).To(Equal(filepath.Join(dir, "1/root/var/lib/kubelet/pods/uid/volumes/kubernetes.io~secret/my secret")))
+
+			_, err = volumeHostDir(dir, 42, "/etc/missing")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Context("CorruptConfig", func() {
+		It("should fail on the key out of the volume", func() {
+			s := &daemonServer{}
+			_, err := s.CorruptConfig(context.TODO(), &pb.ConfigRequest{
+				ContainerId: "containerd://container-id",
+				MountPath:   "/etc/app",
+				Key:         "../passwd",
+				Corruption:  corruptionTruncate,
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("isn't a path in the volume"))
+		})
+	})
+})
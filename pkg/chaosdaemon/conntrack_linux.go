@@ -0,0 +1,78 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+const (
+	conntrackCountKey = "net.netfilter.nf_conntrack_count"
+	conntrackMaxKey   = "net.netfilter.nf_conntrack_max"
+)
+
+// readConntrack reads the count of the entries and the max of the conntrack table of the node,
+// the max is only writable in the initial network namespace
+func readConntrack(ctx context.Context) (count int64, max int64, err error) {
+	// Mock point to return error in unit test
+	if err := mock.On("ConntrackReadError"); err != nil {
+		if e, ok := err.(error); ok {
+			return 0, 0, e
+		}
+	}
+
+	if count, err = readSysctl(ctx, conntrackCountKey); err != nil {
+		return 0, 0, err
+	}
+	if max, err = readSysctl(ctx, conntrackMaxKey); err != nil {
+		return 0, 0, err
+	}
+	return count, max, nil
+}
+
+func writeConntrackMax(ctx context.Context, max int64) error {
+	// Mock point to return error in unit test
+	if err := mock.On("ConntrackWriteError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+	}
+
+	log.Info("Write the max of conntrack table", "max", max)
+	cmd := withNetNS(ctx, GetNsPath(1, netNS), "sysctl", "-w", fmt.Sprintf("%s=%d", conntrackMaxKey, max))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		log.Error(err, "failed to write sysctl", "key", conntrackMaxKey, "output", string(out))
+		return err
+	}
+	return nil
+}
+
+func readSysctl(ctx context.Context, key string) (int64, error) {
+	cmd := withNetNS(ctx, GetNsPath(1, netNS), "sysctl", "-n", key)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		log.Error(err, "failed to read sysctl", "key", key, "output", string(out))
+		return 0, err
+	}
+	value, err := strconv.ParseInt(strings.TrimSpace(string(out)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value of %s: %v", key, err)
+	}
+	return value, nil
+}
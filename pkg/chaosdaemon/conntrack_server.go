@@ -0,0 +1,144 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// conntrackMinMax is the least max which the conntrack table is lowered to, so the node
+// keeps tracking a few connections, such as the ones of kubelet, however many are requested
+const conntrackMinMax = 1024
+
+// conntrackLimits are the limits of the conntrack table requested for the containers. The conntrack
+// table belongs to the network namespace of the node, so the lowest limit is in effect for all
+// the containers, and the original max is restored once the limits of all the containers are deleted.
+type conntrackLimits struct {
+	sync.Mutex
+
+	originalMax int64
+	limits      map[string]int64
+}
+
+// set lowers the max of the conntrack table to the current count plus the headroom
+func (c *conntrackLimits) set(ctx context.Context, containerID string, headroom int64) (*pb.ConntrackResponse, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	count, max, err := readConntrack(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.limits) == 0 {
+		c.limits = make(map[string]int64)
+		c.originalMax = max
+	}
+
+	limit := count + headroom
+	if limit < conntrackMinMax {
+		limit = conntrackMinMax
+	}
+	if limit > c.originalMax {
+		limit = c.originalMax
+	}
+	previous, existed := c.limits[containerID]
+	c.limits[containerID] = limit
+
+	effective := c.effectiveMax()
+	if effective != max {
+		if err := writeConntrackMax(ctx, effective); err != nil {
+			if existed {
+				c.limits[containerID] = previous
+			} else {
+				delete(c.limits, containerID)
+			}
+			return nil, err
+		}
+	}
+
+	return &pb.ConntrackResponse{Max: effective, OriginalMax: c.originalMax, Count: count}, nil
+}
+
+// delete deletes the limit of the container and raises the max of the conntrack table
+// to the lowest limit left, or the original max if there are none
+func (c *conntrackLimits) delete(ctx context.Context, containerID string) (*pb.ConntrackResponse, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	count, max, err := readConntrack(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limit, ok := c.limits[containerID]
+	if !ok {
+		return &pb.ConntrackResponse{Max: max, OriginalMax: c.originalMax, Count: count}, nil
+	}
+	delete(c.limits, containerID)
+
+	effective := c.originalMax
+	if len(c.limits) > 0 {
+		effective = c.effectiveMax()
+	}
+	if effective != max {
+		if err := writeConntrackMax(ctx, effective); err != nil {
+			c.limits[containerID] = limit
+			return nil, err
+		}
+	}
+
+	return &pb.ConntrackResponse{Max: effective, OriginalMax: c.originalMax, Count: count}, nil
+}
+
+func (c *conntrackLimits) effectiveMax() int64 {
+	effective := c.originalMax
+	for _, limit := range c.limits {
+		if limit < effective {
+			effective = limit
+		}
+	}
+	return effective
+}
+
+func (s *daemonServer) SetConntrackLimit(ctx context.Context, in *pb.ConntrackRequest) (*pb.ConntrackResponse, error) {
+	log.Info("Set conntrack limit", "Request", in)
+
+	if in.Headroom < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid headroom %d", in.Headroom)
+	}
+
+	resp, err := s.conntrack.set(ctx, in.ContainerId, in.Headroom)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conntrack limit set error: %v", err)
+	}
+
+	log.Info("Conntrack limit set", "max", resp.Max, "original max", resp.OriginalMax, "count", resp.Count)
+	return resp, nil
+}
+
+func (s *daemonServer) DeleteConntrackLimit(ctx context.Context, in *pb.ConntrackRequest) (*pb.ConntrackResponse, error) {
+	log.Info("Delete conntrack limit", "Request", in)
+
+	resp, err := s.conntrack.delete(ctx, in.ContainerId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conntrack limit delete error: %v", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+// mockSysctl mocks the conntrack sysctls of the node
+func mockSysctl(count int64, max *int64) mock.Finalizer {
+	return mock.With("MockWithNetNs", func(ctx context.Context, ns string, cmd string, args ...string) *exec.Cmd {
+		Expect(ns).To(Equal(GetNsPath(1, netNS)))
+		Expect(cmd).To(Equal("sysctl"))
+		switch {
+		case args[0] == "-n" && args[1] == conntrackCountKey:
+			return exec.Command("echo", strconv.FormatInt(count, 10))
+		case args[0] == "-n" && args[1] == conntrackMaxKey:
+			return exec.Command("echo", strconv.FormatInt(*max, 10))
+		case args[0] == "-w" && strings.HasPrefix(args[1], conntrackMaxKey+"="):
+			value, err := strconv.ParseInt(strings.TrimPrefix(args[1], conntrackMaxKey+"="), 10, 64)
+			Expect(err).To(BeNil())
+			*max = value
+			return exec.Command("echo", args[1])
+		}
+		return exec.Command("false")
+	})
+}
+
+var _ = Describe("conntrack server", func() {
+	Context("SetConntrackLimit", func() {
+		It("should lower and restore the max", func() {
+			s := &daemonServer{}
+			max := int64(262144)
+			defer mockSysctl(5000, &max)()
+
+			resp, err := s.SetConntrackLimit(context.TODO(), &pb.ConntrackRequest{ContainerId: "containerd://a", Headroom: 100})
+			Expect(err).To(BeNil())
+			Expect(resp.Max).To(Equal(int64(5100)))
+			Expect(resp.OriginalMax).To(Equal(int64(262144)))
+			Expect(max).To(Equal(int64(5100)))
+
+			resp, err = s.SetConntrackLimit(context.TODO(), &pb.ConntrackRequest{ContainerId: "containerd://b", Headroom: 0})
+			Expect(err).To(BeNil())
+			Expect(resp.Max).To(Equal(int64(5000)))
+			Expect(max).To(Equal(int64(5000)))
+
+			_, err = s.DeleteConntrackLimit(context.TODO(), &pb.ConntrackRequest{ContainerId: "containerd://b"})
+			Expect(err).To(BeNil())
+			Expect(max).To(Equal(int64(5100)))
+
+			_, err = s.DeleteConntrackLimit(context.TODO(), &pb.ConntrackRequest{ContainerId: "containerd://a"})
+			Expect(err).To(BeNil())
+			Expect(max).To(Equal(int64(262144)))
+
+			// the limits which have been deleted are ignored
+			_, err = s.DeleteConntrackLimit(context.TODO(), &pb.ConntrackRequest{ContainerId: "containerd://a"})
+			Expect(err).To(BeNil())
+			Expect(max).To(Equal(int64(262144)))
+		})
+
+		It("should keep the max within the bounds", func() {
+			s := &daemonServer{}
+			max := int64(2048)
+			defer mockSysctl(10, &max)()
+
+			resp, err := s.SetConntrackLimit(context.TODO(), &pb.ConntrackRequest{ContainerId: "containerd://a", Headroom: 1})
+			Expect(err).To(BeNil())
+			Expect(resp.Max).To(Equal(int64(conntrackMinMax)))
+
+			resp, err = s.SetConntrackLimit(context.TODO(), &pb.ConntrackRequest{ContainerId: "containerd://b", Headroom: 100000})
+			Expect(err).To(BeNil())
+			Expect(resp.Max).To(Equal(int64(conntrackMinMax)))
+			Expect(s.conntrack.limits["containerd://b"]).To(Equal(int64(2048)))
+		})
+
+		It("should fail on invalid headroom", func() {
+			s := &daemonServer{}
+			_, err := s.SetConntrackLimit(context.TODO(), &pb.ConntrackRequest{ContainerId: "containerd://a", Headroom: -1})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("should forget the limit on write error", func() {
+			s := &daemonServer{}
+			max := int64(262144)
+			defer mockSysctl(5000, &max)()
+			const errorStr = "mock error on writeConntrackMax()"
+			defer mock.With("ConntrackWriteError", errors.New(errorStr))()
+
+			_, err := s.SetConntrackLimit(context.TODO(), &pb.ConntrackRequest{ContainerId: "containerd://a", Headroom: 100})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(errorStr))
+			Expect(s.conntrack.limits).To(BeEmpty())
+		})
+	})
+})
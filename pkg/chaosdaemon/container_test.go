@@ -27,7 +27,7 @@ import (
 var _ = Describe("container kill", func() {
 	defer mock.With("MockContainerdClient", &MockClient{})()
 	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
-	s := &daemonServer{c}
+	s := &daemonServer{crClient: c}
 
 	Context("ContainerKill", func() {
 		It("should work", func() {
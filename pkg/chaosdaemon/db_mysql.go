@@ -0,0 +1,314 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"encoding/binary"
+	"sync"
+)
+
+const (
+	mysqlHeaderSize = 4
+	// mysqlMaxPayload is the length of the packets followed by the rest of the payload
+	mysqlMaxPayload = 0xffffff
+
+	mysqlComQuery       = 0x03
+	mysqlComStmtPrepare = 0x16
+	mysqlComStmtExecute = 0x17
+	mysqlComStmtClose   = 0x19
+
+	mysqlClientCompress        = 0x20
+	mysqlClientProtocol41      = 0x200
+	mysqlClientSSL             = 0x800
+	mysqlClientQueryAttributes = 1 << 27
+
+	mysqlPacketOK  = 0x00
+	mysqlPacketEOF = 0xfe
+	mysqlPacketErr = 0xff
+	// the EOF packets are shorter than the rows starting with 0xfe
+	mysqlMaxEOFLength = 9
+
+	mysqlStatusInTransaction = 0x1
+)
+
+// mysqlSession keeps track of the prepared statements and the transaction of a MySQL connection.
+// A packet sent by the client with the sequence id 0 starts a command, and the first packet
+// of its response has the sequence id 1.
+type mysqlSession struct {
+	// handshaken is set after the client replies the handshake, it's only used by the client relay
+	handshaken bool
+
+	sync.Mutex
+	capabilities uint32
+	statements   map[uint32]string
+	// command is the command whose response is being relayed, it's 0 if there is none
+	command byte
+	// preparing is the statement of the COM_STMT_PREPARE being relayed
+	preparing     string
+	inTransaction bool
+}
+
+func newMySQLSession() *mysqlSession {
+	return &mysqlSession{statements: make(map[uint32]string)}
+}
+
+func (s *mysqlSession) relayClient(c *dbConn) error {
+	for {
+		header, err := readFrame(c.clientReader, mysqlHeaderSize)
+		if err != nil {
+			return err
+		}
+		length, seq := mysqlPacketLength(header), header[3]
+
+		if !s.handshaken && seq == 1 {
+			s.handshaken = true
+			payload, err := readFrame(c.clientReader, length)
+			if err != nil {
+				return err
+			}
+			capabilities := mysqlCapabilities(payload)
+			s.Lock()
+			s.capabilities = capabilities
+			s.Unlock()
+			// the packets can't be parsed after the connection is encrypted or compressed
+			if capabilities&(mysqlClientSSL|mysqlClientCompress) != 0 {
+				c.setRaw()
+				if err := c.toServer.write(header, payload); err != nil {
+					return err
+				}
+				return copyRaw(c.toServer, c.clientReader)
+			}
+			if err := relayFrame(c.toServer, c.clientReader, 0, header, payload); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// the commands split into several packets are too large to be queries worth delaying
+		if seq != 0 || length == 0 || length == mysqlMaxPayload {
+			if err := relayFrame(c.toServer, c.clientReader, length, header); err != nil {
+				return err
+			}
+			continue
+		}
+
+		payload, err := readFrame(c.clientReader, length)
+		if err != nil {
+			return err
+		}
+		if query, ok := s.query(payload); ok {
+			fault, err := c.intercept(query, s.isInTransaction())
+			if err != nil {
+				return err
+			}
+			if fault != nil {
+				if err := c.toClient.write(mysqlErrorPacket(fault)); err != nil {
+					return err
+				}
+				if err := c.toClient.flush(); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		s.start(payload)
+		if err := relayFrame(c.toServer, c.clientReader, 0, header, payload); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *mysqlSession) relayServer(c *dbConn) error {
+	for {
+		header, err := readFrame(c.serverReader, mysqlHeaderSize)
+		if err != nil {
+			return err
+		}
+		if c.isRaw() {
+			if err := c.toClient.write(header); err != nil {
+				return err
+			}
+			return copyRaw(c.toClient, c.serverReader)
+		}
+
+		length := mysqlPacketLength(header)
+		head, err := readFrame(c.serverReader, minInt(length, dbFrameHeadSize))
+		if err != nil {
+			return err
+		}
+		s.observe(header[3], head, length)
+
+		if err := relayFrame(c.toClient, c.serverReader, length-len(head), header, head); err != nil {
+			return err
+		}
+	}
+}
+
+// query returns the query executed by the command
+func (s *mysqlSession) query(payload []byte) (string, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	switch payload[0] {
+	case mysqlComQuery:
+		query := payload[1:]
+		if s.capabilities&mysqlClientQueryAttributes != 0 {
+			// the query follows the count of the parameters and the count of the parameter sets,
+			// the queries with the attributes are skipped
+			if len(query) < 2 || query[0] != 0 || query[1] != 1 {
+				return "", false
+			}
+			query = query[2:]
+		}
+		return string(query), true
+	case mysqlComStmtExecute:
+		if len(payload) < 5 {
+			return "", false
+		}
+		query, ok := s.statements[binary.LittleEndian.Uint32(payload[1:5])]
+		return query, ok
+	}
+	return "", false
+}
+
+// start records the command sent to the server
+func (s *mysqlSession) start(payload []byte) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.command = payload[0]
+	switch payload[0] {
+	case mysqlComStmtPrepare:
+		s.preparing = string(payload[1:])
+	case mysqlComStmtClose:
+		if len(payload) >= 5 {
+			delete(s.statements, binary.LittleEndian.Uint32(payload[1:5]))
+		}
+		// there is no response of COM_STMT_CLOSE
+		s.command = 0
+	}
+}
+
+// observe keeps track of the prepared statements and the status of the transaction by the responses
+func (s *mysqlSession) observe(seq byte, head []byte, length int) {
+	if len(head) == 0 {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	switch s.command {
+	case mysqlComStmtPrepare:
+		if seq == 1 {
+			// COM_STMT_PREPARE_OK starts with 0x00 and the statement id
+			if head[0] == mysqlPacketOK && len(head) >= 5 {
+				s.statements[binary.LittleEndian.Uint32(head[1:5])] = s.preparing
+			}
+			s.command, s.preparing = 0, ""
+		}
+	case mysqlComQuery, mysqlComStmtExecute:
+		switch {
+		case seq == 1 && head[0] == mysqlPacketOK:
+			// the rows may start with 0x00 as well, so only the first packet is the OK packet
+			if status, ok := mysqlOKStatus(head); ok {
+				s.inTransaction = status&mysqlStatusInTransaction != 0
+			}
+		case head[0] == mysqlPacketEOF && length < mysqlMaxEOFLength:
+			// the result set ends with the EOF packet, or the OK packet starting with 0xfe
+			status, ok := mysqlOKStatus(head)
+			if length == 5 {
+				status, ok = uint16(head[3])|uint16(head[4])<<8, true
+			}
+			if ok {
+				s.inTransaction = status&mysqlStatusInTransaction != 0
+			}
+		}
+	}
+}
+
+func (s *mysqlSession) isInTransaction() bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.inTransaction
+}
+
+func mysqlPacketLength(header []byte) int {
+	return int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+}
+
+// mysqlCapabilities returns the capabilities of the client from its handshake response
+func mysqlCapabilities(payload []byte) uint32 {
+	if len(payload) < 2 {
+		return 0
+	}
+	capabilities := uint32(binary.LittleEndian.Uint16(payload))
+	if capabilities&mysqlClientProtocol41 != 0 && len(payload) >= 4 {
+		capabilities = binary.LittleEndian.Uint32(payload)
+	}
+	return capabilities
+}
+
+// mysqlOKStatus returns the status flags of the OK packet, which follow the affected rows and
+// the last insert id
+func mysqlOKStatus(payload []byte) (uint16, bool) {
+	pos := 1
+	for i := 0; i < 2; i++ {
+		n, ok := mysqlLengthEncodedSize(payload, pos)
+		if !ok {
+			return 0, false
+		}
+		pos += n
+	}
+	if pos+2 > len(payload) {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint16(payload[pos:]), true
+}
+
+// mysqlLengthEncodedSize returns the size of the length-encoded integer at pos
+func mysqlLengthEncodedSize(payload []byte, pos int) (int, bool) {
+	if pos >= len(payload) {
+		return 0, false
+	}
+	switch payload[pos] {
+	case 0xfc:
+		return 3, true
+	case 0xfd:
+		return 4, true
+	case 0xfe:
+		return 9, true
+	case 0xfb, 0xff:
+		return 0, false
+	}
+	return 1, true
+}
+
+// mysqlErrorPacket returns the ERR packet of the fault replying the command
+func mysqlErrorPacket(fault *dbFault) []byte {
+	payload := []byte{mysqlPacketErr, byte(fault.errorCode), byte(fault.errorCode >> 8), '#'}
+	payload = append(payload, fault.sqlState...)
+	payload = append(payload, fault.errorMessage...)
+
+	length := len(payload)
+	return append([]byte{byte(length), byte(length >> 8), byte(length >> 16), 1}, payload...)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
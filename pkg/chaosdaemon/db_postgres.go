@@ -0,0 +1,391 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+)
+
+const (
+	postgresLengthSize = 4
+
+	// the codes of the startup messages which aren't followed by the typed messages
+	postgresSSLRequest    = 80877103
+	postgresGSSENCRequest = 80877104
+	postgresCancelRequest = 80877102
+
+	postgresQuery         = 'Q'
+	postgresParse         = 'P'
+	postgresBind          = 'B'
+	postgresExecute       = 'E'
+	postgresClose         = 'C'
+	postgresSync          = 'S'
+	postgresFunctionCall  = 'F'
+	postgresReadyForQuery = 'Z'
+	postgresErrorResponse = 'E'
+
+	postgresStatusIdle              = 'I'
+	postgresStatusTransaction       = 'T'
+	postgresStatusFailedTransaction = 'E'
+)
+
+// postgresPendingSync is a message answered by a ReadyForQuery of the server
+type postgresPendingSync struct {
+	// errorResponse replaces the ReadyForQuery answering the Sync sent by the proxy for the rejected query
+	errorResponse []byte
+	// failed marks the ReadyForQuery following the rejected query, whose transaction should have failed
+	failed bool
+}
+
+// postgresSession keeps track of the statements, the portals and the transaction of a Postgres connection.
+// A rejected query is replaced by a Sync, whose ReadyForQuery is replaced by the error, so the responses
+// are kept in order. Then the messages of the extended query are discarded until the Sync of the client,
+// like the server does after an error.
+type postgresSession struct {
+	// discarding is only used by the client relay
+	discarding bool
+
+	sync.Mutex
+	// answer receives the single byte answering the SSLRequest or the GSSENCRequest
+	answer     chan byte
+	statements map[string]string
+	portals    map[string]string
+	syncs      []postgresPendingSync
+	status     byte
+}
+
+func newPostgresSession() *postgresSession {
+	return &postgresSession{
+		statements: make(map[string]string),
+		portals:    make(map[string]string),
+		status:     postgresStatusIdle,
+	}
+}
+
+func (s *postgresSession) relayClient(c *dbConn) error {
+	if err := s.relayStartup(c); err != nil {
+		return err
+	}
+
+	for {
+		typ, err := c.clientReader.ReadByte()
+		if err != nil {
+			return err
+		}
+		header, err := readFrame(c.clientReader, postgresLengthSize)
+		if err != nil {
+			return err
+		}
+		length := int(binary.BigEndian.Uint32(header)) - postgresLengthSize
+		if length < 0 {
+			return fmt.Errorf("invalid length of message %c", typ)
+		}
+
+		if s.discarding {
+			if typ != postgresSync {
+				if _, err := c.clientReader.Discard(length); err != nil {
+					return err
+				}
+				continue
+			}
+			s.discarding = false
+			s.expect(postgresPendingSync{failed: true})
+			if err := relayFrame(c.toServer, c.clientReader, length, []byte{typ}, header); err != nil {
+				return err
+			}
+			continue
+		}
+
+		switch typ {
+		case postgresQuery, postgresParse, postgresBind, postgresExecute, postgresClose:
+		case postgresSync, postgresFunctionCall:
+			s.expect(postgresPendingSync{})
+			fallthrough
+		default:
+			if err := relayFrame(c.toServer, c.clientReader, length, []byte{typ}, header); err != nil {
+				return err
+			}
+			continue
+		}
+
+		payload, err := readFrame(c.clientReader, length)
+		if err != nil {
+			return err
+		}
+		if query, ok := s.query(typ, payload); ok {
+			fault, err := c.intercept(query, s.isInTransaction())
+			if err != nil {
+				return err
+			}
+			if fault != nil {
+				if err := s.reject(c, typ, fault); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		s.record(typ, payload)
+		if err := relayFrame(c.toServer, c.clientReader, 0, []byte{typ}, header, payload); err != nil {
+			return err
+		}
+	}
+}
+
+// relayStartup relays the untyped messages before the startup message
+func (s *postgresSession) relayStartup(c *dbConn) error {
+	for {
+		header, err := readFrame(c.clientReader, postgresLengthSize)
+		if err != nil {
+			return err
+		}
+		length := int(binary.BigEndian.Uint32(header)) - postgresLengthSize
+		if length < postgresLengthSize || length > dbStartupMessageLength {
+			return fmt.Errorf("invalid length of startup message %d", length)
+		}
+		payload, err := readFrame(c.clientReader, length)
+		if err != nil {
+			return err
+		}
+
+		switch binary.BigEndian.Uint32(payload) {
+		case postgresSSLRequest, postgresGSSENCRequest:
+			answer := make(chan byte, 1)
+			s.Lock()
+			s.answer = answer
+			s.Unlock()
+			if err := relayFrame(c.toServer, c.clientReader, 0, header, payload); err != nil {
+				return err
+			}
+			if err := c.toServer.flush(); err != nil {
+				return err
+			}
+
+			select {
+			case a := <-answer:
+				// the connection is encrypted if the request is accepted
+				if a == 'S' || a == 'G' {
+					return copyRaw(c.toServer, c.clientReader)
+				}
+			case <-c.serverDone:
+				return io.EOF
+			}
+		case postgresCancelRequest:
+			c.setRaw()
+			if err := c.toServer.write(header, payload); err != nil {
+				return err
+			}
+			return copyRaw(c.toServer, c.clientReader)
+		default:
+			return relayFrame(c.toServer, c.clientReader, 0, header, payload)
+		}
+	}
+}
+
+func (s *postgresSession) relayServer(c *dbConn) error {
+	for {
+		typ, err := c.serverReader.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		s.Lock()
+		answer := s.answer
+		s.answer = nil
+		s.Unlock()
+		if answer != nil {
+			if err := c.toClient.write([]byte{typ}); err != nil {
+				return err
+			}
+			if err := c.toClient.flush(); err != nil {
+				return err
+			}
+			answer <- typ
+			if typ == 'S' || typ == 'G' {
+				c.setRaw()
+			}
+		}
+		if c.isRaw() {
+			if answer == nil {
+				if err := c.toClient.write([]byte{typ}); err != nil {
+					return err
+				}
+			}
+			return copyRaw(c.toClient, c.serverReader)
+		}
+		if answer != nil {
+			continue
+		}
+
+		header, err := readFrame(c.serverReader, postgresLengthSize)
+		if err != nil {
+			return err
+		}
+		length := int(binary.BigEndian.Uint32(header)) - postgresLengthSize
+		if length < 0 {
+			return fmt.Errorf("invalid length of message %c", typ)
+		}
+
+		if typ == postgresReadyForQuery && length == 1 {
+			status, err := c.serverReader.ReadByte()
+			if err != nil {
+				return err
+			}
+			if err := c.toClient.write(s.readyForQuery(status)); err != nil {
+				return err
+			}
+			if err := c.toClient.flushIfIdle(c.serverReader); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := relayFrame(c.toClient, c.serverReader, length, []byte{typ}, header); err != nil {
+			return err
+		}
+	}
+}
+
+// reject replaces the query with a Sync, whose ReadyForQuery is replaced by the error. The simple query
+// is followed by another Sync, so the client receives the ReadyForQuery after the error.
+func (s *postgresSession) reject(c *dbConn, typ byte, fault *dbFault) error {
+	s.expect(postgresPendingSync{errorResponse: postgresErrorMessage(fault)})
+	msg := []byte{postgresSync, 0, 0, 0, postgresLengthSize}
+	if typ == postgresQuery {
+		s.expect(postgresPendingSync{failed: true})
+		if err := c.toServer.write(msg); err != nil {
+			return err
+		}
+	} else {
+		s.discarding = true
+	}
+	if err := c.toServer.write(msg); err != nil {
+		return err
+	}
+	return c.toServer.flush()
+}
+
+// query returns the query executed by the message
+func (s *postgresSession) query(typ byte, payload []byte) (string, bool) {
+	switch typ {
+	case postgresQuery:
+		query, _ := cString(payload)
+		return query, true
+	case postgresExecute:
+		portal, _ := cString(payload)
+		s.Lock()
+		defer s.Unlock()
+		query, ok := s.portals[portal]
+		return query, ok
+	}
+	return "", false
+}
+
+// record keeps track of the statements and the portals of the extended query
+func (s *postgresSession) record(typ byte, payload []byte) {
+	s.Lock()
+	defer s.Unlock()
+
+	switch typ {
+	case postgresQuery:
+		s.syncs = append(s.syncs, postgresPendingSync{})
+	case postgresParse:
+		name, rest := cString(payload)
+		query, _ := cString(rest)
+		s.statements[name] = query
+	case postgresBind:
+		portal, rest := cString(payload)
+		statement, _ := cString(rest)
+		s.portals[portal] = s.statements[statement]
+	case postgresClose:
+		if len(payload) == 0 {
+			return
+		}
+		name, _ := cString(payload[1:])
+		if payload[0] == 'S' {
+			delete(s.statements, name)
+		} else {
+			delete(s.portals, name)
+		}
+	}
+}
+
+// expect records the message which the server answers with a ReadyForQuery
+func (s *postgresSession) expect(pending postgresPendingSync) {
+	s.Lock()
+	defer s.Unlock()
+	s.syncs = append(s.syncs, pending)
+}
+
+// readyForQuery returns the message relayed for the ReadyForQuery of the server
+func (s *postgresSession) readyForQuery(status byte) []byte {
+	s.Lock()
+	defer s.Unlock()
+
+	s.status = status
+	var pending postgresPendingSync
+	// the first ReadyForQuery after the startup answers no message
+	if len(s.syncs) > 0 {
+		pending, s.syncs = s.syncs[0], s.syncs[1:]
+	}
+	if pending.errorResponse != nil {
+		return pending.errorResponse
+	}
+	if pending.failed && status == postgresStatusTransaction {
+		status = postgresStatusFailedTransaction
+	}
+	return []byte{postgresReadyForQuery, 0, 0, 0, postgresLengthSize + 1, status}
+}
+
+func (s *postgresSession) isInTransaction() bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.status != postgresStatusIdle
+}
+
+// postgresErrorMessage returns the ErrorResponse of the fault
+func postgresErrorMessage(fault *dbFault) []byte {
+	var fields bytes.Buffer
+	for _, field := range []struct {
+		typ   byte
+		value string
+	}{
+		{'S', "ERROR"},
+		{'V', "ERROR"},
+		{'C', fault.sqlState},
+		{'M', fault.errorMessage},
+	} {
+		fields.WriteByte(field.typ)
+		fields.WriteString(field.value)
+		fields.WriteByte(0)
+	}
+	fields.WriteByte(0)
+
+	msg := []byte{postgresErrorResponse, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(msg[1:], uint32(fields.Len()+postgresLengthSize))
+	return append(msg, fields.Bytes()...)
+}
+
+// cString returns the null-terminated string and the bytes after it
+func cString(data []byte) (string, []byte) {
+	i := bytes.IndexByte(data, 0)
+	if i < 0 {
+		return string(data), nil
+	}
+	return string(data[:i]), data[i+1:]
+}
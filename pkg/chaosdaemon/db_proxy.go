@@ -0,0 +1,484 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// The protocols of the databases
+const (
+	dbProtocolMySQL    = "mysql"
+	dbProtocolPostgres = "postgres"
+	dbProtocolRedis    = "redis"
+	dbProtocolS3       = "s3"
+)
+
+// The actions of the database faults
+const (
+	dbActionQueryDelay     = "query-delay"
+	dbActionQueryError     = "query-error"
+	dbActionConnectionKill = "connection-kill"
+	dbActionPartialRead    = "partial-read"
+)
+
+const (
+	defaultMySQLErrorCode  = 1105
+	defaultMySQLSQLState   = "HY000"
+	defaultPostgresState   = "XX000"
+	defaultDBErrorMessage  = "query is rejected by chaos mesh"
+	dbProxyBufferSize      = 32 * 1024
+	dbSQLStateLength       = 5
+	dbMaxPercent           = 100
+	maxMySQLErrorCode      = 0xffff
+	dbFrameHeadSize        = 64
+	dbStartupMessageLength = 10000
+	// dbTLSHandshake is the first byte of the TLS handshake, the protocols without negotiation are
+	// encrypted from the first byte
+	dbTLSHandshake = 0x16
+)
+
+// errDBConnectionKilled is returned by the relays of the connection killed by the fault
+var errDBConnectionKilled = errors.New("connection is killed by the fault")
+
+// dbFault is the fault injected into the queries passing the proxy
+type dbFault struct {
+	action string
+	// query is nil if all queries match
+	query         *regexp.Regexp
+	delay         time.Duration
+	errorCode     uint16
+	sqlState      string
+	errorMessage  string
+	percent       uint32
+	inTransaction bool
+}
+
+func newDBFault(protocol string, fault *pb.DBFault) (*dbFault, error) {
+	switch protocol {
+	case dbProtocolMySQL, dbProtocolPostgres, dbProtocolRedis, dbProtocolS3:
+	default:
+		return nil, fmt.Errorf("unknown protocol %s", protocol)
+	}
+	if fault == nil {
+		return nil, fmt.Errorf("fault is required")
+	}
+
+	f := &dbFault{
+		action:        fault.Action,
+		delay:         time.Duration(fault.DelayNs),
+		sqlState:      fault.SqlState,
+		errorMessage:  fault.ErrorMessage,
+		percent:       fault.Percent,
+		inTransaction: fault.InTransaction,
+	}
+	switch fault.Action {
+	case dbActionQueryDelay:
+		if f.delay <= 0 {
+			return nil, fmt.Errorf("delay should be positive")
+		}
+	case dbActionQueryError, dbActionConnectionKill:
+	case dbActionPartialRead:
+		// only the bodies of the objects can be cut
+		if protocol != dbProtocolS3 {
+			return nil, fmt.Errorf("action %s isn't supported by protocol %s", fault.Action, protocol)
+		}
+	default:
+		return nil, fmt.Errorf("unknown action %s", fault.Action)
+	}
+	if fault.Query != "" {
+		query, err := regexp.Compile(fault.Query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid query %s: %v", fault.Query, err)
+		}
+		f.query = query
+	}
+	if f.percent == 0 {
+		f.percent = dbMaxPercent
+	}
+	if f.percent > dbMaxPercent {
+		return nil, fmt.Errorf("percent %d is out of range", f.percent)
+	}
+	if fault.ErrorCode > maxMySQLErrorCode {
+		return nil, fmt.Errorf("error code %d is out of range", fault.ErrorCode)
+	}
+	f.errorCode = uint16(fault.ErrorCode)
+	// the error of S3 is replied with the HTTP status of the code and the error code of S3 in the message
+	if protocol == dbProtocolS3 {
+		if f.errorCode == 0 {
+			f.errorCode = http.StatusServiceUnavailable
+		}
+		if f.errorCode < http.StatusBadRequest || f.errorCode > maxS3ErrorStatus {
+			return nil, fmt.Errorf("http status %d of s3 error is out of range", f.errorCode)
+		}
+		if f.errorMessage == "" {
+			f.errorMessage = defaultS3ErrorCode
+		}
+	}
+	if f.sqlState != "" && len(f.sqlState) != dbSQLStateLength {
+		return nil, fmt.Errorf("sql state %s should have %d characters", f.sqlState, dbSQLStateLength)
+	}
+
+	if f.errorCode == 0 {
+		f.errorCode = defaultMySQLErrorCode
+	}
+	if f.sqlState == "" {
+		f.sqlState = defaultMySQLSQLState
+		if protocol == dbProtocolPostgres {
+			f.sqlState = defaultPostgresState
+		}
+	}
+	if f.errorMessage == "" {
+		f.errorMessage = defaultDBErrorMessage
+		if protocol == dbProtocolRedis {
+			f.errorMessage = defaultRedisErrorPrefix + defaultDBErrorMessage
+		}
+	}
+	// the error of Redis is a single line
+	if protocol == dbProtocolRedis && strings.ContainsAny(f.errorMessage, "\r\n") {
+		return nil, fmt.Errorf("error message of redis should be a single line")
+	}
+	return f, nil
+}
+
+// affects returns true if the query is affected by the fault
+func (f *dbFault) affects(query string, inTransaction bool) bool {
+	if f.inTransaction && !inTransaction {
+		return false
+	}
+	if f.query != nil && !f.query.MatchString(query) {
+		return false
+	}
+	return f.percent >= dbMaxPercent || uint32(rand.Intn(dbMaxPercent)) < f.percent
+}
+
+// dbProxy accepts the connections redirected from the database, and relays them to the database.
+// The queries sent by the clients are parsed from the protocol of the database, so the queries
+// affected by the fault are delayed, rejected with the error or killed with the connection.
+type dbProxy struct {
+	listener net.Listener
+	// dial connects to the database which the client connection is redirected from
+	dial     func(client net.Conn) (net.Conn, error)
+	protocol string
+
+	sync.Mutex
+	fault  *dbFault
+	conns  map[net.Conn]struct{}
+	closed bool
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newDBProxy(listener net.Listener, dial func(net.Conn) (net.Conn, error), protocol string, fault *dbFault) *dbProxy {
+	p := &dbProxy{
+		listener: listener,
+		dial:     dial,
+		protocol: protocol,
+		fault:    fault,
+		conns:    make(map[net.Conn]struct{}),
+		done:     make(chan struct{}),
+	}
+
+	p.wg.Add(1)
+	go p.serve()
+	return p
+}
+
+// setFault changes the fault of the queries sent afterwards
+func (p *dbProxy) setFault(fault *dbFault) {
+	p.Lock()
+	defer p.Unlock()
+	p.fault = fault
+}
+
+func (p *dbProxy) currentFault() *dbFault {
+	p.Lock()
+	defer p.Unlock()
+	return p.fault
+}
+
+// Close stops accepting connections and closes the relayed ones
+func (p *dbProxy) Close() error {
+	p.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.done)
+	}
+	for conn := range p.conns {
+		conn.Close()
+	}
+	p.Unlock()
+
+	err := p.listener.Close()
+	p.wg.Wait()
+	return err
+}
+
+func (p *dbProxy) track(conns ...net.Conn) bool {
+	p.Lock()
+	defer p.Unlock()
+	if p.closed {
+		return false
+	}
+	for _, conn := range conns {
+		p.conns[conn] = struct{}{}
+	}
+	return true
+}
+
+func (p *dbProxy) untrack(conns ...net.Conn) {
+	p.Lock()
+	defer p.Unlock()
+	for _, conn := range conns {
+		delete(p.conns, conn)
+	}
+}
+
+func (p *dbProxy) serve() {
+	defer p.wg.Done()
+	for {
+		client, err := p.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.relay(client)
+		}()
+	}
+}
+
+func (p *dbProxy) relay(client net.Conn) {
+	defer client.Close()
+	server, err := p.dial(client)
+	if err != nil {
+		log.Error(err, "failed to connect the database", "client", client.RemoteAddr().String())
+		return
+	}
+	defer server.Close()
+
+	if !p.track(client, server) {
+		return
+	}
+	defer p.untrack(client, server)
+
+	c := newDBConn(p, client, server)
+	var session dbSession
+	switch p.protocol {
+	case dbProtocolPostgres:
+		session = newPostgresSession()
+	case dbProtocolRedis:
+		session = newRedisSession()
+	case dbProtocolS3:
+		session = newS3Session()
+	default:
+		session = newMySQLSession()
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		c.finish(session.relayClient(c), server)
+	}()
+	go func() {
+		defer wg.Done()
+		defer close(c.serverDone)
+		c.finish(session.relayServer(c), client)
+	}()
+	wg.Wait()
+}
+
+// dbSession relays the messages of a connection in the protocol of the database
+type dbSession interface {
+	// relayClient relays the messages from the client to the server, and intercepts the queries
+	relayClient(c *dbConn) error
+	// relayServer relays the messages from the server to the client, and keeps track of
+	// the state of the session
+	relayServer(c *dbConn) error
+}
+
+// dbConn is a client connection relayed by the proxy
+type dbConn struct {
+	proxy          *dbProxy
+	client, server net.Conn
+
+	clientReader, serverReader *bufio.Reader
+	toClient, toServer         *dbWriter
+
+	// raw is set if the messages can't be parsed any more, e.g. they're encrypted by TLS
+	raw int32
+	// serverDone is closed when the relay from the server returns
+	serverDone chan struct{}
+}
+
+func newDBConn(p *dbProxy, client, server net.Conn) *dbConn {
+	return &dbConn{
+		proxy:        p,
+		client:       client,
+		server:       server,
+		clientReader: bufio.NewReaderSize(client, dbProxyBufferSize),
+		serverReader: bufio.NewReaderSize(server, dbProxyBufferSize),
+		toClient:     newDBWriter(client),
+		toServer:     newDBWriter(server),
+		serverDone:   make(chan struct{}),
+	}
+}
+
+func (c *dbConn) setRaw() {
+	atomic.StoreInt32(&c.raw, 1)
+}
+
+func (c *dbConn) isRaw() bool {
+	return atomic.LoadInt32(&c.raw) == 1
+}
+
+// finish ends the relay towards the peer, which reads EOF unless the connection is killed
+func (c *dbConn) finish(err error, peer net.Conn) {
+	if err == errDBConnectionKilled {
+		return
+	}
+	if w, ok := c.writerOf(peer); ok {
+		w.flush()
+	}
+	closeWrite(peer)
+}
+
+func (c *dbConn) writerOf(conn net.Conn) (*dbWriter, bool) {
+	switch conn {
+	case c.client:
+		return c.toClient, true
+	case c.server:
+		return c.toServer, true
+	}
+	return nil, false
+}
+
+// intercept applies the fault to the query. The fault is returned if the query should be rejected
+// with its error or its response should be cut, and errDBConnectionKilled is returned if the connection
+// has been killed.
+func (c *dbConn) intercept(query string, inTransaction bool) (*dbFault, error) {
+	fault := c.proxy.currentFault()
+	if fault == nil || !fault.affects(query, inTransaction) {
+		return nil, nil
+	}
+
+	switch fault.action {
+	case dbActionQueryDelay:
+		timer := time.NewTimer(fault.delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-c.proxy.done:
+			return nil, errDBConnectionKilled
+		}
+		return nil, nil
+	case dbActionQueryError, dbActionPartialRead:
+		return fault, nil
+	}
+
+	log.Info("kill database connection", "client", c.client.RemoteAddr().String(), "query", query)
+	c.client.Close()
+	c.server.Close()
+	return nil, errDBConnectionKilled
+}
+
+// relayFrame writes the frame whose head has been read, and copies the rest of it
+func relayFrame(w *dbWriter, r *bufio.Reader, rest int, head ...[]byte) error {
+	if err := w.write(head...); err != nil {
+		return err
+	}
+	if rest > 0 {
+		if err := w.copyN(r, int64(rest)); err != nil {
+			return err
+		}
+	}
+	return w.flushIfIdle(r)
+}
+
+// copyRaw copies the rest of the stream without parsing it
+func copyRaw(w *dbWriter, r *bufio.Reader) error {
+	if err := w.flush(); err != nil {
+		return err
+	}
+	_, err := io.Copy(w.conn, r)
+	return err
+}
+
+// readFrame reads n bytes of the frame
+func readFrame(r *bufio.Reader, n int) ([]byte, error) {
+	buf := make([]byte, n)
+	_, err := io.ReadFull(r, buf)
+	return buf, err
+}
+
+// dbWriter buffers the frames written to the connection, which are flushed when there is no more
+// frame to relay at once
+type dbWriter struct {
+	sync.Mutex
+	conn net.Conn
+	w    *bufio.Writer
+}
+
+func newDBWriter(conn net.Conn) *dbWriter {
+	return &dbWriter{conn: conn, w: bufio.NewWriterSize(conn, dbProxyBufferSize)}
+}
+
+func (w *dbWriter) write(data ...[]byte) error {
+	w.Lock()
+	defer w.Unlock()
+	for _, d := range data {
+		if _, err := w.w.Write(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *dbWriter) copyN(r io.Reader, n int64) error {
+	w.Lock()
+	defer w.Unlock()
+	_, err := io.CopyN(w.w, r, n)
+	return err
+}
+
+func (w *dbWriter) flush() error {
+	w.Lock()
+	defer w.Unlock()
+	return w.w.Flush()
+}
+
+// flushIfIdle flushes the frames if the next frame hasn't been received
+func (w *dbWriter) flushIfIdle(r *bufio.Reader) error {
+	if r.Buffered() > 0 {
+		return nil
+	}
+	return w.flush()
+}
@@ -0,0 +1,804 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// fakeMySQL serves the connections like a MySQL server, the OK packets of the queries carry
+// the status of the transaction started by BEGIN
+func fakeMySQL() net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).To(BeNil())
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				writeMySQLPacket(conn, 0, []byte{10, '8', 0})
+				if _, _, err := readMySQLPacket(r); err != nil {
+					return
+				}
+				writeMySQLPacket(conn, 2, []byte{0, 0, 0, 2, 0, 0, 0})
+
+				var status byte = 2
+				for {
+					_, payload, err := readMySQLPacket(r)
+					if err != nil {
+						return
+					}
+					switch payload[0] {
+					case mysqlComQuery:
+						switch string(payload[1:]) {
+						case "BEGIN":
+							status |= mysqlStatusInTransaction
+						case "COMMIT":
+							status &^= mysqlStatusInTransaction
+						}
+						writeMySQLPacket(conn, 1, []byte{0, 0, 0, status, 0, 0, 0})
+					case mysqlComStmtPrepare:
+						writeMySQLPacket(conn, 1, []byte{0, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+					case mysqlComStmtExecute:
+						writeMySQLPacket(conn, 1, []byte{0, 1, 0, status, 0, 0, 0})
+					}
+				}
+			}()
+		}
+	}()
+	return listener
+}
+
+func writeMySQLPacket(w io.Writer, seq byte, payload []byte) {
+	length := len(payload)
+	w.Write(append([]byte{byte(length), byte(length >> 8), byte(length >> 16), seq}, payload...))
+}
+
+func readMySQLPacket(r *bufio.Reader) (byte, []byte, error) {
+	header, err := readFrame(r, mysqlHeaderSize)
+	if err != nil {
+		return 0, nil, err
+	}
+	payload, err := readFrame(r, mysqlPacketLength(header))
+	return header[3], payload, err
+}
+
+// fakePostgres serves the connections like a Postgres server, the SSLRequest is refused
+func fakePostgres() net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).To(BeNil())
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				for {
+					header, err := readFrame(r, postgresLengthSize)
+					if err != nil {
+						return
+					}
+					payload, err := readFrame(r, int(binary.BigEndian.Uint32(header))-postgresLengthSize)
+					if err != nil {
+						return
+					}
+					if binary.BigEndian.Uint32(payload) != postgresSSLRequest {
+						break
+					}
+					conn.Write([]byte{'N'})
+				}
+				writePostgresMessage(conn, 'R', []byte{0, 0, 0, 0})
+				writePostgresMessage(conn, 'Z', []byte{postgresStatusIdle})
+
+				var status byte = postgresStatusIdle
+				for {
+					typ, payload, err := readPostgresMessage(r)
+					if err != nil {
+						return
+					}
+					switch typ {
+					case postgresQuery:
+						query, _ := cString(payload)
+						switch query {
+						case "BEGIN":
+							status = postgresStatusTransaction
+						case "COMMIT":
+							status = postgresStatusIdle
+						}
+						writePostgresMessage(conn, 'C', []byte(query+"\x00"))
+						writePostgresMessage(conn, 'Z', []byte{status})
+					case postgresParse:
+						writePostgresMessage(conn, '1', nil)
+					case postgresBind:
+						writePostgresMessage(conn, '2', nil)
+					case postgresExecute:
+						writePostgresMessage(conn, 'C', []byte("SELECT 1\x00"))
+					case postgresSync:
+						writePostgresMessage(conn, 'Z', []byte{status})
+					}
+				}
+			}()
+		}
+	}()
+	return listener
+}
+
+func writePostgresMessage(w io.Writer, typ byte, payload []byte) {
+	msg := []byte{typ, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(msg[1:], uint32(len(payload)+postgresLengthSize))
+	w.Write(append(msg, payload...))
+}
+
+func readPostgresMessage(r *bufio.Reader) (byte, []byte, error) {
+	typ, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	header, err := readFrame(r, postgresLengthSize)
+	if err != nil {
+		return 0, nil, err
+	}
+	payload, err := readFrame(r, int(binary.BigEndian.Uint32(header))-postgresLengthSize)
+	return typ, payload, err
+}
+
+// fakeRedis serves the connections like a Redis server, the commands of a connection are replied by
+// the function created for it
+func fakeRedis(newReply func() func(args []string) string) net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).To(BeNil())
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				reply := newReply()
+				for {
+					cmd, err := readRedisCommand(r)
+					if err != nil {
+						return
+					}
+					var args []string
+					for _, arg := range cmd.args {
+						args = append(args, string(arg))
+					}
+					conn.Write([]byte(reply(args)))
+				}
+			}()
+		}
+	}()
+	return listener
+}
+
+// redisEcho replies the names of the commands, and queues them between MULTI and EXEC
+func redisEcho() func(args []string) string {
+	var queued []string
+	inMulti := false
+	return func(args []string) string {
+		name := strings.ToUpper(args[0])
+		switch {
+		case name == "MULTI":
+			inMulti = true
+			return "+OK\r\n"
+		case name == "EXEC":
+			reply := fmt.Sprintf("*%d\r\n", len(queued))
+			for _, q := range queued {
+				reply += "+" + q + "\r\n"
+			}
+			inMulti, queued = false, nil
+			return reply
+		case name == "DISCARD":
+			inMulti, queued = false, nil
+			return "+OK\r\n"
+		case inMulti:
+			queued = append(queued, name)
+			return "+QUEUED\r\n"
+		}
+		return "+" + name + "\r\n"
+	}
+}
+
+// s3Object is the object served by fakeS3
+var s3Object = strings.Repeat("0123456789", 100)
+
+// fakeS3 serves the objects like S3, the GET requests are replied with s3Object and the other requests
+// are replied with the methods and the paths of them
+func fakeS3() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		io.Copy(ioutil.Discard, req.Body)
+		if req.Method == http.MethodGet {
+			io.WriteString(w, s3Object)
+			return
+		}
+		io.WriteString(w, req.Method+" "+req.URL.Path)
+	}))
+}
+
+var _ = Describe("db proxy", func() {
+	// startProxy starts the proxy of the upstream, and connects to it
+	startProxy := func(upstream net.Listener, protocol string, fault *pb.DBFault) (*dbProxy, net.Conn, *bufio.Reader) {
+		f, err := newDBFault(protocol, fault)
+		Expect(err).To(BeNil())
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(BeNil())
+		proxy := newDBProxy(listener, func(net.Conn) (net.Conn, error) {
+			return net.Dial("tcp", upstream.Addr().String())
+		}, protocol, f)
+
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		Expect(err).To(BeNil())
+		conn.SetDeadline(time.Now().Add(10 * time.Second))
+		return proxy, conn, bufio.NewReader(conn)
+	}
+
+	Context("newDBFault", func() {
+		It("should fill the defaults of the protocol", func() {
+			f, err := newDBFault(dbProtocolPostgres, &pb.DBFault{Action: dbActionQueryError})
+			Expect(err).To(BeNil())
+			Expect(f.sqlState).To(Equal(defaultPostgresState))
+			Expect(f.percent).To(Equal(uint32(dbMaxPercent)))
+			Expect(f.affects("SELECT 1", false)).To(BeTrue())
+
+			f, err = newDBFault(dbProtocolMySQL, &pb.DBFault{Action: dbActionQueryError, Query: "(?i)^update", InTransaction: true})
+			Expect(err).To(BeNil())
+			Expect(f.errorCode).To(Equal(uint16(defaultMySQLErrorCode)))
+			Expect(f.sqlState).To(Equal(defaultMySQLSQLState))
+			Expect(f.affects("update t set a = 1", true)).To(BeTrue())
+			Expect(f.affects("update t set a = 1", false)).To(BeFalse())
+			Expect(f.affects("select 1", true)).To(BeFalse())
+		})
+
+		It("should fail on invalid faults", func() {
+			for _, fault := range []*pb.DBFault{
+				{Action: "query-drop"},
+				{Action: dbActionQueryDelay},
+				{Action: dbActionQueryError, Query: "("},
+				{Action: dbActionQueryError, Percent: 101},
+				{Action: dbActionQueryError, SqlState: "HY"},
+				{Action: dbActionQueryError, ErrorCode: 70000},
+			} {
+				_, err := newDBFault(dbProtocolMySQL, fault)
+				Expect(err).NotTo(BeNil(), fault.String())
+			}
+			_, err := newDBFault("mongodb", &pb.DBFault{Action: dbActionConnectionKill})
+			Expect(err).NotTo(BeNil())
+			_, err = newDBFault(dbProtocolRedis, &pb.DBFault{Action: dbActionQueryError, ErrorMessage: "OOM\r\n+OK"})
+			Expect(err).NotTo(BeNil())
+			_, err = newDBFault(dbProtocolMySQL, &pb.DBFault{Action: dbActionPartialRead})
+			Expect(err).NotTo(BeNil())
+			_, err = newDBFault(dbProtocolS3, &pb.DBFault{Action: dbActionQueryError, ErrorCode: 1105})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("mysql", func() {
+		var upstream net.Listener
+
+		BeforeEach(func() {
+			upstream = fakeMySQL()
+		})
+
+		AfterEach(func() {
+			upstream.Close()
+		})
+
+		// handshake replies the greeting of the server
+		handshake := func(conn net.Conn, r *bufio.Reader) {
+			seq, payload, err := readMySQLPacket(r)
+			Expect(err).To(BeNil())
+			Expect(seq).To(Equal(byte(0)))
+			Expect(payload[0]).To(Equal(byte(10)))
+			writeMySQLPacket(conn, 1, []byte{0x00, 0x02, 0, 0, 0, 0, 0, 0})
+			_, payload, err = readMySQLPacket(r)
+			Expect(err).To(BeNil())
+			Expect(payload[0]).To(Equal(byte(mysqlPacketOK)))
+		}
+
+		query := func(conn net.Conn, r *bufio.Reader, command byte, data []byte) []byte {
+			writeMySQLPacket(conn, 0, append([]byte{command}, data...))
+			seq, payload, err := readMySQLPacket(r)
+			Expect(err).To(BeNil())
+			Expect(seq).To(Equal(byte(1)))
+			return payload
+		}
+
+		It("should reject the matched queries in transactions", func() {
+			proxy, conn, r := startProxy(upstream, dbProtocolMySQL, &pb.DBFault{
+				Action:        dbActionQueryError,
+				Query:         "^UPDATE",
+				ErrorCode:     1213,
+				SqlState:      "40001",
+				ErrorMessage:  "Deadlock found",
+				InTransaction: true,
+			})
+			defer proxy.Close()
+			defer conn.Close()
+			handshake(conn, r)
+
+			Expect(query(conn, r, mysqlComQuery, []byte("UPDATE t SET a = 1"))[0]).To(Equal(byte(mysqlPacketOK)))
+			Expect(query(conn, r, mysqlComQuery, []byte("BEGIN"))[0]).To(Equal(byte(mysqlPacketOK)))
+			Expect(query(conn, r, mysqlComQuery, []byte("SELECT 1"))[0]).To(Equal(byte(mysqlPacketOK)))
+			payload := query(conn, r, mysqlComQuery, []byte("UPDATE t SET a = 1"))
+			Expect(payload[0]).To(Equal(byte(mysqlPacketErr)))
+			Expect(binary.LittleEndian.Uint16(payload[1:3])).To(Equal(uint16(1213)))
+			Expect(string(payload[3:])).To(Equal("#40001Deadlock found"))
+			Expect(query(conn, r, mysqlComQuery, []byte("COMMIT"))[0]).To(Equal(byte(mysqlPacketOK)))
+			Expect(query(conn, r, mysqlComQuery, []byte("UPDATE t SET a = 1"))[0]).To(Equal(byte(mysqlPacketOK)))
+		})
+
+		It("should reject the prepared statements", func() {
+			proxy, conn, r := startProxy(upstream, dbProtocolMySQL, &pb.DBFault{
+				Action: dbActionQueryError,
+				Query:  "FROM orders",
+			})
+			defer proxy.Close()
+			defer conn.Close()
+			handshake(conn, r)
+
+			payload := query(conn, r, mysqlComStmtPrepare, []byte("SELECT * FROM orders WHERE id = ?"))
+			Expect(payload[0]).To(Equal(byte(mysqlPacketOK)))
+			payload = query(conn, r, mysqlComStmtExecute, []byte{7, 0, 0, 0, 0, 1, 0, 0, 0})
+			Expect(payload[0]).To(Equal(byte(mysqlPacketErr)))
+			Expect(binary.LittleEndian.Uint16(payload[1:3])).To(Equal(uint16(defaultMySQLErrorCode)))
+		})
+
+		It("should delay the queries", func() {
+			proxy, conn, r := startProxy(upstream, dbProtocolMySQL, &pb.DBFault{
+				Action:  dbActionQueryDelay,
+				DelayNs: uint64(200 * time.Millisecond),
+			})
+			defer proxy.Close()
+			defer conn.Close()
+			handshake(conn, r)
+
+			start := time.Now()
+			Expect(query(conn, r, mysqlComQuery, []byte("SELECT 1"))[0]).To(Equal(byte(mysqlPacketOK)))
+			Expect(time.Since(start)).To(BeNumerically(">=", 200*time.Millisecond))
+		})
+
+		It("should kill the connections", func() {
+			proxy, conn, r := startProxy(upstream, dbProtocolMySQL, &pb.DBFault{
+				Action: dbActionConnectionKill,
+				Query:  "^INSERT",
+			})
+			defer proxy.Close()
+			defer conn.Close()
+			handshake(conn, r)
+
+			Expect(query(conn, r, mysqlComQuery, []byte("SELECT 1"))[0]).To(Equal(byte(mysqlPacketOK)))
+			writeMySQLPacket(conn, 0, append([]byte{mysqlComQuery}, "INSERT INTO t VALUES (1)"...))
+			_, _, err := readMySQLPacket(r)
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should change the fault", func() {
+			proxy, conn, r := startProxy(upstream, dbProtocolMySQL, &pb.DBFault{
+				Action: dbActionQueryError,
+			})
+			defer proxy.Close()
+			defer conn.Close()
+			handshake(conn, r)
+
+			Expect(query(conn, r, mysqlComQuery, []byte("SELECT 1"))[0]).To(Equal(byte(mysqlPacketErr)))
+			f, err := newDBFault(dbProtocolMySQL, &pb.DBFault{Action: dbActionQueryError, Query: "^DELETE"})
+			Expect(err).To(BeNil())
+			proxy.setFault(f)
+			Expect(query(conn, r, mysqlComQuery, []byte("SELECT 1"))[0]).To(Equal(byte(mysqlPacketOK)))
+		})
+	})
+
+	Context("postgres", func() {
+		var upstream net.Listener
+
+		BeforeEach(func() {
+			upstream = fakePostgres()
+		})
+
+		AfterEach(func() {
+			upstream.Close()
+		})
+
+		startup := func(conn net.Conn, r *bufio.Reader) {
+			ssl := make([]byte, 8)
+			binary.BigEndian.PutUint32(ssl, 8)
+			binary.BigEndian.PutUint32(ssl[4:], postgresSSLRequest)
+			conn.Write(ssl)
+			answer, err := r.ReadByte()
+			Expect(err).To(BeNil())
+			Expect(answer).To(Equal(byte('N')))
+
+			msg := make([]byte, 8)
+			binary.BigEndian.PutUint32(msg, 8)
+			binary.BigEndian.PutUint32(msg[4:], 196608)
+			conn.Write(msg)
+			typ, _, err := readPostgresMessage(r)
+			Expect(err).To(BeNil())
+			Expect(typ).To(Equal(byte('R')))
+			typ, payload, err := readPostgresMessage(r)
+			Expect(err).To(BeNil())
+			Expect(typ).To(Equal(byte(postgresReadyForQuery)))
+			Expect(payload).To(Equal([]byte{postgresStatusIdle}))
+		}
+
+		// exchange sends the messages and returns the types and the payloads of the responses
+		// until the ReadyForQuery
+		exchange := func(conn net.Conn, r *bufio.Reader, messages ...[]byte) ([]byte, [][]byte) {
+			for i := 0; i < len(messages); i += 2 {
+				writePostgresMessage(conn, messages[i][0], messages[i+1])
+			}
+			var types []byte
+			var payloads [][]byte
+			for {
+				typ, payload, err := readPostgresMessage(r)
+				Expect(err).To(BeNil())
+				types = append(types, typ)
+				payloads = append(payloads, payload)
+				if typ == postgresReadyForQuery {
+					return types, payloads
+				}
+			}
+		}
+
+		It("should reject the simple queries in transactions", func() {
+			proxy, conn, r := startProxy(upstream, dbProtocolPostgres, &pb.DBFault{
+				Action:        dbActionQueryError,
+				Query:         "^UPDATE",
+				SqlState:      "40001",
+				ErrorMessage:  "could not serialize access",
+				InTransaction: true,
+			})
+			defer proxy.Close()
+			defer conn.Close()
+			startup(conn, r)
+
+			types, _ := exchange(conn, r, []byte{'Q'}, []byte("UPDATE t SET a = 1\x00"))
+			Expect(string(types)).To(Equal("CZ"))
+			types, payloads := exchange(conn, r, []byte{'Q'}, []byte("BEGIN\x00"))
+			Expect(string(types)).To(Equal("CZ"))
+			Expect(payloads[1]).To(Equal([]byte{postgresStatusTransaction}))
+
+			types, payloads = exchange(conn, r, []byte{'Q'}, []byte("UPDATE t SET a = 1\x00"))
+			Expect(string(types)).To(Equal("EZ"))
+			Expect(string(payloads[0])).To(ContainSubstring("C40001\x00Mcould not serialize access\x00"))
+			Expect(payloads[1]).To(Equal([]byte{postgresStatusFailedTransaction}))
+
+			types, payloads = exchange(conn, r, []byte{'Q'}, []byte("COMMIT\x00"))
+			Expect(string(types)).To(Equal("CZ"))
+			Expect(payloads[1]).To(Equal([]byte{postgresStatusIdle}))
+		})
+
+		It("should reject the extended queries", func() {
+			proxy, conn, r := startProxy(upstream, dbProtocolPostgres, &pb.DBFault{
+				Action: dbActionQueryError,
+				Query:  "FROM orders",
+			})
+			defer proxy.Close()
+			defer conn.Close()
+			startup(conn, r)
+
+			types, _ := exchange(conn, r,
+				[]byte{'P'}, []byte("\x00SELECT * FROM users\x00\x00\x00"),
+				[]byte{'B'}, []byte("\x00\x00\x00\x00\x00\x00\x00\x00"),
+				[]byte{'E'}, []byte("\x00\x00\x00\x00\x00"),
+				[]byte{'S'}, nil)
+			Expect(string(types)).To(Equal("12CZ"))
+
+			types, payloads := exchange(conn, r,
+				[]byte{'P'}, []byte("s1\x00SELECT * FROM orders\x00\x00\x00"),
+				[]byte{'B'}, []byte("\x00s1\x00\x00\x00\x00\x00\x00\x00"),
+				[]byte{'E'}, []byte("\x00\x00\x00\x00\x00"),
+				[]byte{'S'}, nil)
+			Expect(string(types)).To(Equal("12EZ"))
+			Expect(string(payloads[2])).To(ContainSubstring("C" + defaultPostgresState))
+			Expect(payloads[3]).To(Equal([]byte{postgresStatusIdle}))
+
+			types, _ = exchange(conn, r, []byte{'Q'}, []byte("SELECT 1\x00"))
+			Expect(string(types)).To(Equal("CZ"))
+		})
+
+		It("should kill the connections", func() {
+			proxy, conn, r := startProxy(upstream, dbProtocolPostgres, &pb.DBFault{
+				Action: dbActionConnectionKill,
+			})
+			defer proxy.Close()
+			defer conn.Close()
+			startup(conn, r)
+
+			writePostgresMessage(conn, 'Q', []byte("SELECT 1\x00"))
+			_, _, err := readPostgresMessage(r)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("redis", func() {
+		var upstream net.Listener
+
+		BeforeEach(func() {
+			upstream = fakeRedis(redisEcho)
+		})
+
+		AfterEach(func() {
+			upstream.Close()
+		})
+
+		// exchange sends the commands at once, and returns the replies in order
+		exchange := func(conn net.Conn, r *bufio.Reader, commands ...[]string) []interface{} {
+			var data []byte
+			for _, args := range commands {
+				data = append(data, redisCommand(args...)...)
+			}
+			_, err := conn.Write(data)
+			Expect(err).To(BeNil())
+
+			var replies []interface{}
+			for range commands {
+				reply, err := readRedisReply(r)
+				if serverErr, ok := err.(redisServerError); ok {
+					reply, err = serverErr, nil
+				}
+				Expect(err).To(BeNil())
+				replies = append(replies, reply)
+			}
+			return replies
+		}
+
+		It("should reject the matched commands in order", func() {
+			proxy, conn, r := startProxy(upstream, dbProtocolRedis, &pb.DBFault{
+				Action:       dbActionQueryError,
+				Query:        "(?i)^set ",
+				ErrorMessage: "OOM command not allowed when used memory > 'maxmemory'.",
+			})
+			defer proxy.Close()
+			defer conn.Close()
+
+			Expect(exchange(conn, r, []string{"PING"}, []string{"SET", "a", "1"}, []string{"GET", "a"},
+				[]string{"SET", "b", "2"}, []string{"SET", "c", "3"}, []string{"PING"})).To(Equal([]interface{}{
+				"PING",
+				redisServerError("OOM command not allowed when used memory > 'maxmemory'."),
+				"GET",
+				redisServerError("OOM command not allowed when used memory > 'maxmemory'."),
+				redisServerError("OOM command not allowed when used memory > 'maxmemory'."),
+				"PING",
+			}))
+		})
+
+		It("should abort the transactions with the rejected commands", func() {
+			proxy, conn, r := startProxy(upstream, dbProtocolRedis, &pb.DBFault{
+				Action:        dbActionQueryError,
+				Query:         "^SET",
+				ErrorMessage:  "LOADING Redis is loading the dataset in memory",
+				InTransaction: true,
+			})
+			defer proxy.Close()
+			defer conn.Close()
+
+			Expect(exchange(conn, r, []string{"SET", "a", "1"})).To(Equal([]interface{}{"SET"}))
+			Expect(exchange(conn, r, []string{"MULTI"}, []string{"SET", "a", "1"}, []string{"GET", "a"},
+				[]string{"EXEC"})).To(Equal([]interface{}{
+				"OK",
+				redisServerError("LOADING Redis is loading the dataset in memory"),
+				"QUEUED",
+				redisServerError(redisExecAbort),
+			}))
+			// the transaction has been discarded by the server
+			Expect(exchange(conn, r, []string{"GET", "a"})).To(Equal([]interface{}{"GET"}))
+			Expect(exchange(conn, r, []string{"MULTI"}, []string{"GET", "a"}, []string{"EXEC"})).To(Equal([]interface{}{
+				"OK", "QUEUED", []interface{}{"GET"},
+			}))
+		})
+
+		It("should reply the default error", func() {
+			proxy, conn, r := startProxy(upstream, dbProtocolRedis, &pb.DBFault{
+				Action: dbActionQueryError,
+			})
+			defer proxy.Close()
+			defer conn.Close()
+
+			Expect(exchange(conn, r, []string{"AUTH", "secret"}, []string{"GET", "a"})).To(Equal([]interface{}{
+				"AUTH", redisServerError(defaultRedisErrorPrefix + defaultDBErrorMessage),
+			}))
+		})
+
+		It("should delay the commands", func() {
+			proxy, conn, r := startProxy(upstream, dbProtocolRedis, &pb.DBFault{
+				Action:  dbActionQueryDelay,
+				DelayNs: uint64(200 * time.Millisecond),
+			})
+			defer proxy.Close()
+			defer conn.Close()
+
+			start := time.Now()
+			Expect(exchange(conn, r, []string{"PING"})).To(Equal([]interface{}{"PING"}))
+			Expect(time.Since(start)).To(BeNumerically(">=", 200*time.Millisecond))
+		})
+
+		It("should kill the connections", func() {
+			proxy, conn, r := startProxy(upstream, dbProtocolRedis, &pb.DBFault{
+				Action: dbActionConnectionKill,
+				Query:  "^DEL",
+			})
+			defer proxy.Close()
+			defer conn.Close()
+
+			Expect(exchange(conn, r, []string{"PING"})).To(Equal([]interface{}{"PING"}))
+			conn.Write(redisCommand("DEL", "a"))
+			_, err := readRedisReply(r)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("s3", func() {
+		var upstream *httptest.Server
+
+		BeforeEach(func() {
+			upstream = fakeS3()
+		})
+
+		AfterEach(func() {
+			upstream.Close()
+		})
+
+		// exchange sends the request, and returns the status and the body of the response
+		exchange := func(conn net.Conn, r *bufio.Reader, method, url, body string) (int, string, error) {
+			req, err := http.NewRequest(method, url, strings.NewReader(body))
+			Expect(err).To(BeNil())
+			Expect(req.Write(conn)).To(BeNil())
+
+			resp, err := http.ReadResponse(r, req)
+			if err != nil {
+				return 0, "", err
+			}
+			defer resp.Body.Close()
+			data, err := ioutil.ReadAll(resp.Body)
+			return resp.StatusCode, string(data), err
+		}
+
+		It("should reply SlowDown to the matched requests", func() {
+			proxy, conn, r := startProxy(upstream.Listener, dbProtocolS3, &pb.DBFault{
+				Action: dbActionQueryError,
+				Query:  "^PUT /backups/",
+			})
+			defer proxy.Close()
+			defer conn.Close()
+
+			status, body, err := exchange(conn, r, http.MethodGet, "http://minio:9000/backups/a", "")
+			Expect(err).To(BeNil())
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(Equal(s3Object))
+			status, body, err = exchange(conn, r, http.MethodPut, "http://minio:9000/logs/a", "log")
+			Expect(err).To(BeNil())
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(Equal("PUT /logs/a"))
+
+			status, body, err = exchange(conn, r, http.MethodPut, "http://backups.s3.amazonaws.com/b", "data")
+			Expect(err).To(BeNil())
+			Expect(status).To(Equal(http.StatusServiceUnavailable))
+			Expect(body).To(ContainSubstring("<Code>SlowDown</Code>"))
+			Expect(body).To(ContainSubstring("<Resource>/backups/b</Resource>"))
+			// the connection is closed after the error
+			_, err = r.ReadByte()
+			Expect(err).To(Equal(io.EOF))
+		})
+
+		It("should reply the error of the code", func() {
+			proxy, conn, r := startProxy(upstream.Listener, dbProtocolS3, &pb.DBFault{
+				Action:       dbActionQueryError,
+				ErrorCode:    http.StatusInternalServerError,
+				ErrorMessage: "InternalError",
+			})
+			defer proxy.Close()
+			defer conn.Close()
+
+			status, body, err := exchange(conn, r, http.MethodGet, "http://minio:9000/backups/a", "")
+			Expect(err).To(BeNil())
+			Expect(status).To(Equal(http.StatusInternalServerError))
+			Expect(body).To(ContainSubstring("<Code>InternalError</Code>"))
+		})
+
+		It("should delay the matched requests", func() {
+			proxy, conn, r := startProxy(upstream.Listener, dbProtocolS3, &pb.DBFault{
+				Action:  dbActionQueryDelay,
+				Query:   "^GET ",
+				DelayNs: uint64(200 * time.Millisecond),
+			})
+			defer proxy.Close()
+			defer conn.Close()
+
+			start := time.Now()
+			_, body, err := exchange(conn, r, http.MethodPut, "http://minio:9000/backups/a", "data")
+			Expect(err).To(BeNil())
+			Expect(body).To(Equal("PUT /backups/a"))
+			Expect(time.Since(start)).To(BeNumerically("<", 200*time.Millisecond))
+
+			start = time.Now()
+			_, body, err = exchange(conn, r, http.MethodGet, "http://minio:9000/backups/a", "")
+			Expect(err).To(BeNil())
+			Expect(body).To(Equal(s3Object))
+			Expect(time.Since(start)).To(BeNumerically(">=", 200*time.Millisecond))
+		})
+
+		It("should truncate the objects", func() {
+			proxy, conn, r := startProxy(upstream.Listener, dbProtocolS3, &pb.DBFault{
+				Action: dbActionPartialRead,
+				Query:  "^GET /backups/",
+			})
+			defer proxy.Close()
+			defer conn.Close()
+
+			_, body, err := exchange(conn, r, http.MethodGet, "http://minio:9000/logs/a", "")
+			Expect(err).To(BeNil())
+			Expect(body).To(Equal(s3Object))
+			// the other methods aren't affected
+			_, body, err = exchange(conn, r, http.MethodPut, "http://minio:9000/backups/a", "data")
+			Expect(err).To(BeNil())
+			Expect(body).To(Equal("PUT /backups/a"))
+
+			status, body, err := exchange(conn, r, http.MethodGet, "http://minio:9000/backups/a", "")
+			Expect(err).To(Equal(io.ErrUnexpectedEOF))
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(Equal(s3Object[:len(s3Object)/2]))
+		})
+
+		It("should get the resources in the path style", func() {
+			Expect(s3Resource("minio:9000", "/backups/a%20b?uploads")).To(Equal("/backups/a b"))
+			Expect(s3Resource("backups.s3.amazonaws.com", "/a")).To(Equal("/backups/a"))
+			Expect(s3Resource("backups.s3-us-west-2.amazonaws.com:443", "/a")).To(Equal("/backups/a"))
+			Expect(s3Resource("", "http://backups.s3.us-east-1.amazonaws.com/a")).To(Equal("/backups/a"))
+			Expect(s3Resource("10.0.0.1:9000", "/backups/a")).To(Equal("/backups/a"))
+		})
+	})
+
+	Context("dbProxyRules", func() {
+		It("should parse the rules of the proxies", func() {
+			output := `-P OUTPUT ACCEPT
+-A OUTPUT -d 10.0.0.1/32 -p tcp -m tcp --dport 3306 -m mark ! --mark 0x63686d -m comment --comment "chaos-mesh-dbproxy/containerd://id/3306" -j REDIRECT --to-ports 40001
+-A OUTPUT -p tcp -m tcp --dport 33060 -m mark ! --mark 0x63686d -m comment --comment "chaos-mesh-dbproxy/containerd://id/33060" -j REDIRECT --to-ports 40002
+-A OUTPUT -j ISTIO_OUTPUT`
+			rules := dbProxyRules(output, func(comment string) bool {
+				return comment == dbProxyCommentPrefix+dbProxyKey("containerd://id", 3306)
+			})
+			Expect(rules).To(HaveLen(1))
+			Expect(strings.Join(rules[0], " ")).To(ContainSubstring("--dport 3306"))
+			Expect(dbProxyRules(output, isDBProxyComment)).To(HaveLen(2))
+		})
+	})
+})
@@ -0,0 +1,530 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	redisArray        = '*'
+	redisBulkString   = '$'
+	redisSimpleString = '+'
+	redisError        = '-'
+	redisInteger      = ':'
+	// the types of RESP3, which are replied after the client switches the protocol by HELLO 3
+	redisBlobError = '!'
+	redisVerbatim  = '='
+	redisMap       = '%'
+	redisSet       = '~'
+	redisAttribute = '|'
+	redisPush      = '>'
+
+	// redisMaxArgumentSize is the size of the largest argument parsed, the larger ones are relayed as they are
+	redisMaxArgumentSize = 1 << 20
+
+	defaultRedisErrorPrefix = "ERR "
+	redisExecAbort          = "EXECABORT Transaction discarded because of previous errors."
+)
+
+var errRedisProtocol = errors.New("invalid redis protocol")
+
+// redisUnaffectedCommands set up the connections or end the transactions, they're never affected by the fault
+var redisUnaffectedCommands = map[string]bool{
+	"AUTH":    true,
+	"HELLO":   true,
+	"DISCARD": true,
+	"QUIT":    true,
+}
+
+// redisReply is a reply expected by the client
+type redisReply struct {
+	// forwarded is set if the command has been sent to the server, whose reply is relayed
+	forwarded bool
+	// err is the error replied by the proxy, it replaces the reply of the server if forwarded is set
+	err []byte
+}
+
+// redisSession keeps track of the replies expected by the client and the transaction of a Redis connection.
+// The commands are pipelined by the clients, so the error of a rejected command is queued after the replies
+// of the commands sent before it. The command queued by MULTI is rejected before it reaches the server,
+// so the transaction is discarded and its EXEC is answered by EXECABORT, like the server does.
+type redisSession struct {
+	// inTransaction and dirty are only used by the client relay, dirty is set if a command of
+	// the transaction has been rejected
+	inTransaction, dirty bool
+
+	sync.Mutex
+	// idle is broadcast when all the replies have been sent or the server relay has returned
+	idle    *sync.Cond
+	replies []redisReply
+	closed  bool
+}
+
+func newRedisSession() *redisSession {
+	s := &redisSession{}
+	s.idle = sync.NewCond(&s.Mutex)
+	return s
+}
+
+func (s *redisSession) relayClient(c *dbConn) error {
+	for {
+		first, err := c.clientReader.Peek(1)
+		if err != nil {
+			return err
+		}
+		if first[0] == dbTLSHandshake {
+			c.setRaw()
+			return copyRaw(c.toServer, c.clientReader)
+		}
+
+		cmd, err := readRedisCommand(c.clientReader)
+		if err != nil {
+			return err
+		}
+		// the empty inline command is ignored by the server without reply
+		if len(cmd.args) == 0 {
+			if err := relayFrame(c.toServer, c.clientReader, 0, cmd.frames...); err != nil {
+				return err
+			}
+			continue
+		}
+
+		name := strings.ToUpper(string(cmd.args[0]))
+		if cmd.streaming(name) {
+			// the replies following the command can't be told apart from the replies of the commands
+			if err := s.waitIdle(c); err != nil {
+				return err
+			}
+			c.setRaw()
+			if err := c.toServer.write(cmd.frames...); err != nil {
+				return err
+			}
+			return copyRaw(c.toServer, c.clientReader)
+		}
+
+		var rejected []byte
+		if !redisUnaffectedCommands[name] {
+			fault, err := c.intercept(cmd.query(), s.inTransaction)
+			if err != nil {
+				return err
+			}
+			if fault != nil {
+				rejected = redisErrorReply(fault.errorMessage)
+			}
+		}
+
+		switch {
+		case name == "EXEC" && s.inTransaction && (rejected != nil || s.dirty):
+			if rejected == nil {
+				rejected = redisErrorReply(redisExecAbort)
+			}
+			s.inTransaction, s.dirty = false, false
+			if err := cmd.relayRest(nil, c.clientReader); err != nil {
+				return err
+			}
+			// the transaction is discarded by the server, and the reply of DISCARD is replaced by the error
+			if err := s.forward(c, rejected, redisCommand("DISCARD")); err != nil {
+				return err
+			}
+		case rejected != nil:
+			s.dirty = s.dirty || s.inTransaction
+			if err := cmd.relayRest(nil, c.clientReader); err != nil {
+				return err
+			}
+			if err := s.reject(c, rejected); err != nil {
+				return err
+			}
+		default:
+			switch name {
+			case "MULTI":
+				s.inTransaction = true
+			case "EXEC", "DISCARD", "RESET":
+				s.inTransaction, s.dirty = false, false
+			}
+			if err := s.forward(c, nil, cmd.frames...); err != nil {
+				return err
+			}
+			if err := cmd.relayRest(c.toServer, c.clientReader); err != nil {
+				return err
+			}
+		}
+		if err := c.toServer.flushIfIdle(c.clientReader); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *redisSession) relayServer(c *dbConn) error {
+	defer func() {
+		s.Lock()
+		s.closed = true
+		s.idle.Broadcast()
+		s.Unlock()
+	}()
+
+	for {
+		if _, err := c.serverReader.Peek(1); err != nil {
+			return err
+		}
+		if c.isRaw() {
+			return copyRaw(c.toClient, c.serverReader)
+		}
+
+		// only the server relay removes the replies, so the first one is kept while it's relayed
+		s.Lock()
+		w := c.toClient
+		if len(s.replies) > 0 && s.replies[0].err != nil {
+			w = nil
+		}
+		s.Unlock()
+
+		typ, err := relayRedisValue(w, c.serverReader)
+		if err != nil {
+			return err
+		}
+		// the pushed messages and the attributes aren't the replies of the commands
+		if typ != redisPush && typ != redisAttribute {
+			if err := s.replied(c); err != nil {
+				return err
+			}
+		}
+		if err := c.toClient.flushIfIdle(c.serverReader); err != nil {
+			return err
+		}
+	}
+}
+
+// forward records the reply of the command sent to the server, which is replaced by err if it isn't nil
+func (s *redisSession) forward(c *dbConn, err []byte, frames ...[]byte) error {
+	s.Lock()
+	s.replies = append(s.replies, redisReply{forwarded: true, err: err})
+	s.Unlock()
+	return c.toServer.write(frames...)
+}
+
+// reject replies the error at once if no reply is expected, or queues it after the expected replies
+func (s *redisSession) reject(c *dbConn, err []byte) error {
+	s.Lock()
+	defer s.Unlock()
+	if len(s.replies) > 0 {
+		s.replies = append(s.replies, redisReply{err: err})
+		return nil
+	}
+	if err := c.toClient.write(err); err != nil {
+		return err
+	}
+	return c.toClient.flush()
+}
+
+// replied removes the reply relayed from the server, and sends the errors queued after it
+func (s *redisSession) replied(c *dbConn) error {
+	s.Lock()
+	defer s.Unlock()
+	if len(s.replies) == 0 {
+		return nil
+	}
+	if err := s.replies[0].err; err != nil {
+		if err := c.toClient.write(err); err != nil {
+			return err
+		}
+	}
+	s.replies = s.replies[1:]
+	for len(s.replies) > 0 && !s.replies[0].forwarded {
+		if err := c.toClient.write(s.replies[0].err); err != nil {
+			return err
+		}
+		s.replies = s.replies[1:]
+	}
+	if len(s.replies) == 0 {
+		s.idle.Broadcast()
+	}
+	return nil
+}
+
+// waitIdle flushes the commands sent to the server, and waits until all of their replies are sent
+func (s *redisSession) waitIdle(c *dbConn) error {
+	if err := c.toServer.flush(); err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	for len(s.replies) > 0 && !s.closed {
+		s.idle.Wait()
+	}
+	if s.closed {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// redisClientCommand is a command sent by the client
+type redisClientCommand struct {
+	// frames are the lines and the arguments read, which are relayed as they are
+	frames [][]byte
+	args   [][]byte
+	// pending is the size of the large argument left unread including its CRLF, and unread is
+	// the number of the arguments following it
+	pending int64
+	unread  int
+}
+
+// readRedisCommand reads a command of RESP or an inline command. The arguments after a large one
+// are left unread, they're relayed by relayRest.
+func readRedisCommand(r *bufio.Reader) (*redisClientCommand, error) {
+	line, err := readRedisLine(r)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &redisClientCommand{frames: [][]byte{line}}
+	if line[0] != redisArray {
+		cmd.args = bytes.Fields(line)
+		return cmd, nil
+	}
+
+	n, err := redisLength(line)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		header, size, err := readRedisBulkHeader(r)
+		if err != nil {
+			return nil, err
+		}
+		cmd.frames = append(cmd.frames, header)
+		if size > redisMaxArgumentSize {
+			cmd.pending, cmd.unread = int64(size)+2, n-i-1
+			return cmd, nil
+		}
+		arg, err := readFrame(r, size+2)
+		if err != nil {
+			return nil, err
+		}
+		cmd.frames = append(cmd.frames, arg)
+		cmd.args = append(cmd.args, arg[:size])
+	}
+	return cmd, nil
+}
+
+// query returns the command line matched by the fault
+func (cmd *redisClientCommand) query() string {
+	return string(bytes.Join(cmd.args, []byte(" ")))
+}
+
+// streaming returns true if the replies of the server become a stream after the command
+func (cmd *redisClientCommand) streaming(name string) bool {
+	switch name {
+	case "SUBSCRIBE", "PSUBSCRIBE", "SSUBSCRIBE", "MONITOR", "SYNC", "PSYNC":
+		return true
+	case "CLIENT":
+		return len(cmd.args) > 1 && strings.EqualFold(string(cmd.args[1]), "REPLY")
+	}
+	return false
+}
+
+// relayRest relays the arguments left unread, they're discarded if w is nil
+func (cmd *redisClientCommand) relayRest(w *dbWriter, r *bufio.Reader) error {
+	for {
+		if cmd.pending > 0 {
+			if err := relayRedisBytes(w, r, cmd.pending); err != nil {
+				return err
+			}
+			cmd.pending = 0
+		}
+		if cmd.unread == 0 {
+			return nil
+		}
+
+		header, size, err := readRedisBulkHeader(r)
+		if err != nil {
+			return err
+		}
+		if w != nil {
+			if err := w.write(header); err != nil {
+				return err
+			}
+		}
+		cmd.pending, cmd.unread = int64(size)+2, cmd.unread-1
+	}
+}
+
+// relayRedisValue relays a value replied by the server and returns its type, it's discarded if w is nil
+func relayRedisValue(w *dbWriter, r *bufio.Reader) (byte, error) {
+	line, err := readRedisLine(r)
+	if err != nil {
+		return 0, err
+	}
+	if w != nil {
+		if err := w.write(line); err != nil {
+			return 0, err
+		}
+	}
+
+	typ := line[0]
+	switch typ {
+	case redisBulkString, redisBlobError, redisVerbatim:
+		n, err := redisLength(line)
+		if err != nil {
+			return typ, err
+		}
+		if n >= 0 {
+			return typ, relayRedisBytes(w, r, int64(n)+2)
+		}
+	case redisArray, redisSet, redisPush, redisMap, redisAttribute:
+		n, err := redisLength(line)
+		if err != nil {
+			return typ, err
+		}
+		if typ == redisMap || typ == redisAttribute {
+			n *= 2
+		}
+		for i := 0; i < n; i++ {
+			if _, err := relayRedisValue(w, r); err != nil {
+				return typ, err
+			}
+		}
+	}
+	return typ, nil
+}
+
+// relayRedisBytes relays n bytes, they're discarded if w is nil
+func relayRedisBytes(w *dbWriter, r *bufio.Reader, n int64) error {
+	if w == nil {
+		_, err := io.CopyN(ioutil.Discard, r, n)
+		return err
+	}
+	return w.copyN(r, n)
+}
+
+// readRedisLine reads a line including its line break
+func readRedisLine(r *bufio.Reader) ([]byte, error) {
+	line, err := r.ReadSlice('\n')
+	if err == bufio.ErrBufferFull {
+		return nil, fmt.Errorf("line of redis protocol is longer than %d bytes", r.Size())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), line...), nil
+}
+
+func readRedisBulkHeader(r *bufio.Reader) ([]byte, int, error) {
+	header, err := readRedisLine(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	if header[0] != redisBulkString {
+		return nil, 0, errRedisProtocol
+	}
+	size, err := redisLength(header)
+	if err != nil {
+		return nil, 0, err
+	}
+	if size < 0 {
+		return nil, 0, errRedisProtocol
+	}
+	return header, size, nil
+}
+
+// redisLength parses the length of the line like "$5\r\n", -1 means the null value
+func redisLength(line []byte) (int, error) {
+	if len(line) < 4 || line[len(line)-2] != '\r' {
+		return 0, errRedisProtocol
+	}
+	n, err := strconv.Atoi(string(line[1 : len(line)-2]))
+	if err != nil || n < -1 {
+		return 0, errRedisProtocol
+	}
+	return n, nil
+}
+
+func redisErrorReply(message string) []byte {
+	return []byte(string(redisError) + message + "\r\n")
+}
+
+// redisCommand encodes the command in RESP
+func redisCommand(args ...string) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%c%d\r\n", redisArray, len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&buf, "%c%d\r\n%s\r\n", redisBulkString, len(arg), arg)
+	}
+	return buf.Bytes()
+}
+
+// redisServerError is the error replied by the server
+type redisServerError string
+
+func (e redisServerError) Error() string {
+	return string(e)
+}
+
+// readRedisReply reads a reply of RESP2, the errors replied are returned as redisServerError
+func readRedisReply(r *bufio.Reader) (interface{}, error) {
+	line, err := readRedisLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, errRedisProtocol
+	}
+
+	body := string(line[1 : len(line)-2])
+	switch line[0] {
+	case redisSimpleString:
+		return body, nil
+	case redisError:
+		return nil, redisServerError(body)
+	case redisInteger:
+		n, err := strconv.ParseInt(body, 10, 64)
+		if err != nil {
+			return nil, errRedisProtocol
+		}
+		return n, nil
+	case redisBulkString:
+		n, err := redisLength(line)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		data, err := readFrame(r, n+2)
+		if err != nil {
+			return nil, err
+		}
+		return string(data[:n]), nil
+	case redisArray:
+		n, err := redisLength(line)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		values := make([]interface{}, 0, n)
+		for i := 0; i < n; i++ {
+			value, err := readRedisReply(r)
+			if _, ok := err.(redisServerError); err != nil && !ok {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	}
+	return nil, errRedisProtocol
+}
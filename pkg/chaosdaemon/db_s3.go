@@ -0,0 +1,417 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultS3ErrorCode = "SlowDown"
+	maxS3ErrorStatus   = 599
+	// s3MaxHeadSize is the size of the largest head of the requests and the responses parsed
+	s3MaxHeadSize = 64 * 1024
+	// s3DrainTimeout is the time waiting for the client to close the connection after the error is replied
+	s3DrainTimeout = time.Second
+	s3RequestID    = "chaos-mesh"
+)
+
+var errS3Protocol = errors.New("invalid http protocol of s3")
+
+// s3ErrorMessages are the messages of the error codes of S3 which are usually injected
+var s3ErrorMessages = map[string]string{
+	"SlowDown":           "Please reduce your request rate.",
+	"ServiceUnavailable": "Please reduce your request rate.",
+	"InternalError":      "We encountered an internal error. Please try again.",
+	"RequestTimeout":     "Your socket connection to the server was not read from or written to within the timeout period.",
+}
+
+// s3Request is a request whose response is expected by the client
+type s3Request struct {
+	method string
+	// truncate cuts the body of the response in half, and kills the connection
+	truncate bool
+}
+
+// s3Session keeps track of the requests of an HTTP/1.1 connection to S3 or the compatible object storage.
+// The requests are matched by their methods and resources like "GET /bucket/key". The rejected request is
+// replied with the error of S3 after the responses of the earlier requests, then the connection is closed,
+// so the body of the request doesn't need to be read.
+type s3Session struct {
+	sync.Mutex
+	// idle is broadcast when all the responses have been relayed or the server relay has returned
+	idle     *sync.Cond
+	requests []s3Request
+	closed   bool
+}
+
+func newS3Session() *s3Session {
+	s := &s3Session{}
+	s.idle = sync.NewCond(&s.Mutex)
+	return s
+}
+
+func (s *s3Session) relayClient(c *dbConn) error {
+	for {
+		first, err := c.clientReader.Peek(1)
+		if err != nil {
+			return err
+		}
+		if first[0] == dbTLSHandshake {
+			c.setRaw()
+			return copyRaw(c.toServer, c.clientReader)
+		}
+
+		head, line, header, err := readHTTPHead(c.clientReader)
+		if err != nil {
+			return err
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return errS3Protocol
+		}
+		method := fields[0]
+		resource := s3Resource(header.Get("Host"), fields[1])
+
+		fault, err := c.intercept(method+" "+resource, false)
+		if err != nil {
+			return err
+		}
+		request := s3Request{method: method}
+		if fault != nil {
+			if fault.action == dbActionQueryError {
+				return s.reject(c, fault, resource)
+			}
+			request.truncate = method == http.MethodGet
+		}
+
+		s.Lock()
+		s.requests = append(s.requests, request)
+		s.Unlock()
+
+		// the head is flushed at once, since the client may wait for 100 Continue before sending the body
+		if err := c.toServer.write(head); err != nil {
+			return err
+		}
+		if err := c.toServer.flush(); err != nil {
+			return err
+		}
+		if header.Get("Upgrade") != "" || method == http.MethodConnect {
+			c.setRaw()
+			return copyRaw(c.toServer, c.clientReader)
+		}
+
+		length, chunked, err := httpBodyFraming(header)
+		if err != nil {
+			return err
+		}
+		if err := relayHTTPBody(c.toServer, c.clientReader, length, chunked); err != nil {
+			return err
+		}
+		if err := c.toServer.flushIfIdle(c.clientReader); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *s3Session) relayServer(c *dbConn) error {
+	defer func() {
+		s.Lock()
+		s.closed = true
+		s.idle.Broadcast()
+		s.Unlock()
+	}()
+
+	for {
+		if _, err := c.serverReader.Peek(1); err != nil {
+			return err
+		}
+		if c.isRaw() {
+			return copyRaw(c.toClient, c.serverReader)
+		}
+
+		head, line, header, err := readHTTPHead(c.serverReader)
+		if err != nil {
+			return err
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return errS3Protocol
+		}
+		status, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return errS3Protocol
+		}
+
+		if err := c.toClient.write(head); err != nil {
+			return err
+		}
+		if status == http.StatusSwitchingProtocols {
+			c.setRaw()
+			return copyRaw(c.toClient, c.serverReader)
+		}
+		// the interim responses, such as 100 Continue, are followed by the final one
+		if status < http.StatusOK {
+			if err := c.toClient.flush(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		s.Lock()
+		var request s3Request
+		if len(s.requests) > 0 {
+			request = s.requests[0]
+		}
+		s.Unlock()
+
+		length, chunked, err := httpBodyFraming(header)
+		if err != nil {
+			return err
+		}
+		if request.method == http.MethodHead || status == http.StatusNoContent || status == http.StatusNotModified {
+			length, chunked = 0, false
+		}
+
+		if request.truncate && (status == http.StatusOK || status == http.StatusPartialContent) {
+			return s.truncate(c, length)
+		}
+		// the body without length is ended by closing the connection
+		if length < 0 && !chunked {
+			return copyRaw(c.toClient, c.serverReader)
+		}
+		if err := relayHTTPBody(c.toClient, c.serverReader, length, chunked); err != nil {
+			return err
+		}
+
+		s.Lock()
+		if len(s.requests) > 0 {
+			s.requests = s.requests[1:]
+		}
+		if len(s.requests) == 0 {
+			s.idle.Broadcast()
+		}
+		s.Unlock()
+
+		if err := c.toClient.flushIfIdle(c.serverReader); err != nil {
+			return err
+		}
+	}
+}
+
+// reject replies the error of S3 after the responses of the earlier requests, and closes the connection
+func (s *s3Session) reject(c *dbConn, fault *dbFault, resource string) error {
+	if err := c.toServer.flush(); err != nil {
+		return err
+	}
+	s.Lock()
+	for len(s.requests) > 0 && !s.closed {
+		s.idle.Wait()
+	}
+	closed := s.closed
+	s.Unlock()
+	if closed {
+		return io.ErrUnexpectedEOF
+	}
+
+	if err := c.toClient.write(s3ErrorResponse(fault, resource)); err != nil {
+		return err
+	}
+	if err := c.toClient.flush(); err != nil {
+		return err
+	}
+	// the unread body of the request is drained, so the error isn't reset by closing the connection
+	closeWrite(c.client)
+	c.client.SetReadDeadline(time.Now().Add(s3DrainTimeout))
+	io.Copy(ioutil.Discard, c.clientReader)
+	c.server.Close()
+	return errDBConnectionKilled
+}
+
+// truncate relays the first half of the body, and kills the connection, so the client reads an incomplete object
+func (s *s3Session) truncate(c *dbConn, length int64) error {
+	if length > 0 {
+		if err := c.toClient.copyN(c.serverReader, length/2); err != nil {
+			return err
+		}
+	}
+	if err := c.toClient.flush(); err != nil {
+		return err
+	}
+
+	log.Info("truncate s3 response", "client", c.client.RemoteAddr().String(), "length", length)
+	c.client.Close()
+	c.server.Close()
+	return errDBConnectionKilled
+}
+
+// s3Resource returns the resource of the request in the path style, such as "/bucket/key".
+// The bucket of the virtual-hosted style request is moved from the host to the path.
+func s3Resource(host, target string) string {
+	if u, err := url.ParseRequestURI(target); err == nil {
+		if u.Host != "" {
+			host = u.Host
+		}
+		target = u.Path
+		if unescaped, err := url.PathUnescape(u.EscapedPath()); err == nil {
+			target = unescaped
+		}
+	}
+
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if net.ParseIP(host) == nil {
+		for _, sep := range []string{".s3.", ".s3-"} {
+			if i := strings.Index(host, sep); i > 0 {
+				return "/" + host[:i] + target
+			}
+		}
+	}
+	return target
+}
+
+// s3ErrorResponse is the response of the error, the code of fault is the HTTP status and the message
+// of fault is the error code of S3
+func s3ErrorResponse(fault *dbFault, resource string) []byte {
+	message, ok := s3ErrorMessages[fault.errorMessage]
+	if !ok {
+		message = defaultDBErrorMessage
+	}
+
+	var body bytes.Buffer
+	body.WriteString(xml.Header)
+	body.WriteString("<Error><Code>")
+	xml.EscapeText(&body, []byte(fault.errorMessage))
+	body.WriteString("</Code><Message>")
+	xml.EscapeText(&body, []byte(message))
+	body.WriteString("</Message><Resource>")
+	xml.EscapeText(&body, []byte(resource))
+	body.WriteString("</Resource><RequestId>" + s3RequestID + "</RequestId></Error>")
+
+	var response bytes.Buffer
+	fmt.Fprintf(&response, "HTTP/1.1 %d %s\r\n", fault.errorCode, http.StatusText(int(fault.errorCode)))
+	fmt.Fprintf(&response, "Content-Type: application/xml\r\nContent-Length: %d\r\n", body.Len())
+	fmt.Fprintf(&response, "x-amz-request-id: %s\r\nConnection: close\r\n\r\n", s3RequestID)
+	response.Write(body.Bytes())
+	return response.Bytes()
+}
+
+// readHTTPHead reads the start line and the header fields of a request or a response
+func readHTTPHead(r *bufio.Reader) ([]byte, string, http.Header, error) {
+	var head []byte
+	var line string
+	header := make(http.Header)
+	for {
+		data, err := r.ReadSlice('\n')
+		if err == bufio.ErrBufferFull || len(head)+len(data) > s3MaxHeadSize {
+			return nil, "", nil, fmt.Errorf("head of http is longer than %d bytes", s3MaxHeadSize)
+		}
+		if err != nil {
+			return nil, "", nil, err
+		}
+		head = append(head, data...)
+
+		text := strings.TrimRight(string(data), "\r\n")
+		if text == "" {
+			if line == "" {
+				return nil, "", nil, errS3Protocol
+			}
+			return head, line, header, nil
+		}
+		if line == "" {
+			line = text
+			continue
+		}
+		if i := strings.IndexByte(text, ':'); i > 0 {
+			header.Add(text[:i], strings.TrimSpace(text[i+1:]))
+		}
+	}
+}
+
+// httpBodyFraming returns the length of the body, which is -1 if the length is unknown, or whether it's chunked
+func httpBodyFraming(header http.Header) (int64, bool, error) {
+	if strings.Contains(strings.ToLower(header.Get("Transfer-Encoding")), "chunked") {
+		return -1, true, nil
+	}
+	if value := header.Get("Content-Length"); value != "" {
+		length, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || length < 0 {
+			return 0, false, errS3Protocol
+		}
+		return length, false, nil
+	}
+	return -1, false, nil
+}
+
+// relayHTTPBody relays the body of the length or the chunks, the body of the request without length is empty
+func relayHTTPBody(w *dbWriter, r *bufio.Reader, length int64, chunked bool) error {
+	if !chunked {
+		if length <= 0 {
+			return nil
+		}
+		return w.copyN(r, length)
+	}
+
+	for {
+		line, err := r.ReadSlice('\n')
+		if err != nil {
+			return err
+		}
+		size, err := strconv.ParseInt(strings.TrimSpace(strings.SplitN(string(line), ";", 2)[0]), 16, 64)
+		if err != nil || size < 0 {
+			return errS3Protocol
+		}
+		if err := w.write(line); err != nil {
+			return err
+		}
+		if size > 0 {
+			// the chunk is followed by CRLF
+			if err := w.copyN(r, size+2); err != nil {
+				return err
+			}
+			if err := w.flushIfIdle(r); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// the last chunk is followed by the trailer fields and an empty line
+		for {
+			line, err := r.ReadSlice('\n')
+			if err != nil {
+				return err
+			}
+			if err := w.write(line); err != nil {
+				return err
+			}
+			if strings.TrimRight(string(line), "\r\n") == "" {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// The modes of the failover
+const (
+	dbFailoverCluster  = "cluster"
+	dbFailoverSentinel = "sentinel"
+)
+
+// dbFailoverTimeout is the timeout of the commands of the failover
+const dbFailoverTimeout = 30 * time.Second
+
+// FailoverDB forces the failover of the database in the container. In the cluster mode, the Redis node
+// in the container is promoted by CLUSTER FAILOVER if it's a replica, or one of its replicas is promoted
+// if it's a master. In the sentinel mode, the sentinel in the container fails over the master.
+func (s *daemonServer) FailoverDB(ctx context.Context, req *pb.DBFailoverRequest) (*empty.Empty, error) {
+	// the password isn't logged
+	log.Info("Failover DB", "containerID", req.ContainerId, "protocol", req.Protocol, "port", req.Port,
+		"mode", req.Mode, "masterName", req.MasterName, "force", req.Force)
+
+	if err := validateDBFailoverRequest(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid db failover: %v", err)
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting PID")
+		return nil, err
+	}
+
+	client, err := dialRedis(pid, net.JoinHostPort("127.0.0.1", strconv.Itoa(int(req.Port))), req.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "connect redis error: %v", err)
+	}
+	defer client.Close()
+
+	if req.Mode == dbFailoverSentinel {
+		_, err = client.do("SENTINEL", "FAILOVER", req.MasterName)
+	} else {
+		err = failoverRedisCluster(pid, client, req)
+	}
+	if err != nil {
+		log.Error(err, "error while failing over redis", "pid", pid)
+		return nil, status.Errorf(codes.FailedPrecondition, "failover error: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+func validateDBFailoverRequest(req *pb.DBFailoverRequest) error {
+	if req.Protocol != dbProtocolRedis {
+		return fmt.Errorf("failover of protocol %s isn't supported", req.Protocol)
+	}
+	if req.Port == 0 || req.Port > 65535 {
+		return fmt.Errorf("port %d is out of range", req.Port)
+	}
+	switch req.Mode {
+	case dbFailoverCluster:
+	case dbFailoverSentinel:
+		if req.MasterName == "" {
+			return fmt.Errorf("master name is required by the sentinel mode")
+		}
+	default:
+		return fmt.Errorf("unknown mode %s", req.Mode)
+	}
+	return nil
+}
+
+// failoverRedisCluster promotes the node in the container if it's a replica, otherwise one of its replicas
+func failoverRedisCluster(pid uint32, client *redisClient, req *pb.DBFailoverRequest) error {
+	reply, err := client.do("ROLE")
+	if err != nil {
+		return err
+	}
+	role, ok := reply.([]interface{})
+	if !ok || len(role) == 0 {
+		return fmt.Errorf("unexpected reply of ROLE: %v", reply)
+	}
+	switch role[0] {
+	case "slave", "replica":
+		return client.clusterFailover(req.Force)
+	case "master":
+	default:
+		return fmt.Errorf("node is a %v, which can't be failed over", role[0])
+	}
+
+	id, err := client.do("CLUSTER", "MYID")
+	if err != nil {
+		return err
+	}
+	reply, err = client.do("CLUSTER", "REPLICAS", fmt.Sprint(id))
+	if err != nil {
+		return err
+	}
+	nodes, _ := reply.([]interface{})
+	address := healthyRedisReplica(nodes)
+	if address == "" {
+		return fmt.Errorf("node %v has no healthy replica", id)
+	}
+
+	// the replica is connected from the network namespace of the container, like the node does
+	replica, err := dialRedis(pid, address, req.Password)
+	if err != nil {
+		return fmt.Errorf("connect replica %s error: %v", address, err)
+	}
+	defer replica.Close()
+	return replica.clusterFailover(req.Force)
+}
+
+// healthyRedisReplica returns the address of the first connected replica which isn't failing,
+// the nodes are in the format of CLUSTER NODES
+func healthyRedisReplica(nodes []interface{}) string {
+	for _, node := range nodes {
+		line, _ := node.(string)
+		fields := strings.Fields(line)
+		if len(fields) < 8 || fields[7] != "connected" {
+			continue
+		}
+		if strings.Contains(fields[2], "fail") || strings.Contains(fields[2], "noaddr") {
+			continue
+		}
+		// the address is in the format of ip:port@cport[,hostname]
+		address := strings.SplitN(fields[1], "@", 2)[0]
+		if host, _, err := net.SplitHostPort(address); err == nil && host != "" {
+			return address
+		}
+	}
+	return ""
+}
+
+// redisClient sends the commands of the failover to Redis
+type redisClient struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// dialRedis connects to Redis in the network namespace of the process, and authenticates if the password is set
+func dialRedis(pid uint32, address, password string) (*redisClient, error) {
+	conn, err := dialDB(pid, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Now().Add(dbFailoverTimeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	client := &redisClient{conn: conn, r: bufio.NewReader(conn)}
+	if password != "" {
+		if _, err := client.do("AUTH", password); err != nil {
+			client.Close()
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+func (c *redisClient) do(args ...string) (interface{}, error) {
+	if _, err := c.conn.Write(redisCommand(args...)); err != nil {
+		return nil, err
+	}
+	return readRedisReply(c.r)
+}
+
+func (c *redisClient) clusterFailover(force bool) error {
+	args := []string{"CLUSTER", "FAILOVER"}
+	if force {
+		args = append(args, "FORCE")
+	}
+	_, err := c.do(args...)
+	return err
+}
+
+func (c *redisClient) Close() error {
+	return c.conn.Close()
+}
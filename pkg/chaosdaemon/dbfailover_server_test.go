@@ -0,0 +1,145 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+var _ = Describe("dbfailover server", func() {
+	defer mock.With("MockContainerdClient", &MockClient{})()
+	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
+
+	const replicaAddress = "10.0.0.2:6379"
+
+	var (
+		lock     sync.Mutex
+		commands []string
+	)
+
+	// redisNode records the commands sent to the node, and replies them by its role
+	redisNode := func(name, role string) func() func(args []string) string {
+		return func() func(args []string) string {
+			return func(args []string) string {
+				lock.Lock()
+				commands = append(commands, name+": "+strings.Join(args, " "))
+				lock.Unlock()
+
+				switch strings.ToUpper(strings.Join(args, " ")) {
+				case "ROLE":
+					return fmt.Sprintf("*1\r\n$%d\r\n%s\r\n", len(role), role)
+				case "CLUSTER MYID":
+					return "$6\r\nmaster\r\n"
+				case "CLUSTER REPLICAS MASTER":
+					nodes := []string{
+						"failed 10.0.0.3:6379@16379 slave,fail master 0 0 1 disconnected",
+						"replica " + replicaAddress + "@16379 slave master 0 0 1 connected",
+					}
+					reply := fmt.Sprintf("*%d\r\n", len(nodes))
+					for _, node := range nodes {
+						reply += fmt.Sprintf("$%d\r\n%s\r\n", len(node), node)
+					}
+					return reply
+				}
+				return "+OK\r\n"
+			}
+		}
+	}
+
+	// mockDialer connects to the master on the loopback address and the replica on its address
+	mockDialer := func(master, replica net.Listener) mock.Finalizer {
+		return mock.With("MockDBDialer", func(pid uint32, address string) (net.Conn, error) {
+			if address == replicaAddress {
+				return net.Dial("tcp", replica.Addr().String())
+			}
+			Expect(address).To(Equal("127.0.0.1:6379"))
+			return net.Dial("tcp", master.Addr().String())
+		})
+	}
+
+	BeforeEach(func() {
+		commands = nil
+	})
+
+	Context("FailoverDB", func() {
+		It("should promote the replica of the master", func() {
+			master := fakeRedis(redisNode("master", "master"))
+			defer master.Close()
+			replica := fakeRedis(redisNode("replica", "slave"))
+			defer replica.Close()
+			defer mockDialer(master, replica)()
+
+			s := &daemonServer{crClient: c}
+			_, err := s.FailoverDB(context.TODO(), &pb.DBFailoverRequest{
+				ContainerId: "containerd://container-id",
+				Protocol:    dbProtocolRedis,
+				Port:        6379,
+				Mode:        dbFailoverCluster,
+				Password:    "secret",
+				Force:       true,
+			})
+			Expect(err).To(BeNil())
+			Expect(commands).To(Equal([]string{
+				"master: AUTH secret",
+				"master: ROLE",
+				"master: CLUSTER MYID",
+				"master: CLUSTER REPLICAS master",
+				"replica: AUTH secret",
+				"replica: CLUSTER FAILOVER FORCE",
+			}))
+		})
+
+		It("should fail over the master of sentinels", func() {
+			sentinel := fakeRedis(redisNode("sentinel", "sentinel"))
+			defer sentinel.Close()
+			defer mockDialer(sentinel, nil)()
+
+			s := &daemonServer{crClient: c}
+			_, err := s.FailoverDB(context.TODO(), &pb.DBFailoverRequest{
+				ContainerId: "containerd://container-id",
+				Protocol:    dbProtocolRedis,
+				Port:        6379,
+				Mode:        dbFailoverSentinel,
+				MasterName:  "mymaster",
+			})
+			Expect(err).To(BeNil())
+			Expect(commands).To(Equal([]string{"sentinel: SENTINEL FAILOVER mymaster"}))
+		})
+
+		It("should fail on invalid requests", func() {
+			s := &daemonServer{crClient: c}
+			for _, req := range []*pb.DBFailoverRequest{
+				{ContainerId: "containerd://container-id", Protocol: dbProtocolMySQL, Port: 3306, Mode: dbFailoverCluster},
+				{ContainerId: "containerd://container-id", Protocol: dbProtocolRedis, Port: 0, Mode: dbFailoverCluster},
+				{ContainerId: "containerd://container-id", Protocol: dbProtocolRedis, Port: 26379, Mode: dbFailoverSentinel},
+				{ContainerId: "containerd://container-id", Protocol: dbProtocolRedis, Port: 6379, Mode: "takeover"},
+			} {
+				_, err := s.FailoverDB(context.TODO(), req)
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			}
+		})
+	})
+})
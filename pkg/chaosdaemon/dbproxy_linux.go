@@ -0,0 +1,175 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"fmt"
+	"net"
+	"runtime"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/vishvananda/netns"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+const (
+	// soOriginalDst is the socket option of the destination before it's redirected by iptables
+	soOriginalDst = 80
+	dbDialTimeout = 10 * time.Second
+)
+
+// listenDBProxy listens on the port of the loopback address in the network namespace of the process,
+// which the connections redirected by the rules of the output chain are sent to
+func listenDBProxy(pid uint32, port int) (net.Listener, error) {
+	// Mock point to return mock listener in unit test
+	if l := mock.On("MockDBProxyListener"); l != nil {
+		if e, ok := l.(error); ok {
+			return nil, e
+		}
+		return l.(func(uint32, int) (net.Listener, error))(pid, port)
+	}
+
+	var listener net.Listener
+	err := inNetNS(pid, func() error {
+		var err error
+		listener, err = net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+		return err
+	})
+	return listener, err
+}
+
+// dialDBUpstream returns the function which connects the original destination of the redirected
+// connection in the network namespace of the process. The connection is marked so that it isn't
+// redirected to the proxy again.
+func dialDBUpstream(pid uint32) func(net.Conn) (net.Conn, error) {
+	// Mock point to return mock dialer in unit test
+	if d := mock.On("MockDBUpstreamDialer"); d != nil {
+		return d.(func(net.Conn) (net.Conn, error))
+	}
+
+	dialer := &net.Dialer{
+		Timeout: dbDialTimeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			var err error
+			if cerr := c.Control(func(fd uintptr) {
+				err = syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_MARK, dbProxyMark)
+			}); cerr != nil {
+				return cerr
+			}
+			return err
+		},
+	}
+
+	return func(client net.Conn) (net.Conn, error) {
+		address, err := originalDst(client)
+		if err != nil {
+			return nil, err
+		}
+
+		var conn net.Conn
+		err = inNetNS(pid, func() error {
+			var err error
+			conn, err = dialer.Dial("tcp", address)
+			return err
+		})
+		return conn, err
+	}
+}
+
+// dialDB connects to the database in the network namespace of the process
+func dialDB(pid uint32, address string) (net.Conn, error) {
+	// Mock point to return mock connection in unit test
+	if d := mock.On("MockDBDialer"); d != nil {
+		return d.(func(uint32, string) (net.Conn, error))(pid, address)
+	}
+
+	var conn net.Conn
+	err := inNetNS(pid, func() error {
+		var err error
+		conn, err = net.DialTimeout("tcp", address, dbDialTimeout)
+		return err
+	})
+	return conn, err
+}
+
+// originalDst returns the IPv4 destination of the connection before it's redirected
+func originalDst(conn net.Conn) (string, error) {
+	tcpConn, ok := conn.(*net.TCPConn)
+	if !ok {
+		return "", fmt.Errorf("%s isn't a tcp connection", conn.RemoteAddr())
+	}
+	raw, err := tcpConn.SyscallConn()
+	if err != nil {
+		return "", err
+	}
+
+	// the sockaddr_in of the destination is returned in the buffer of ipv6_mreq
+	var addr *syscall.IPv6Mreq
+	if cerr := raw.Control(func(fd uintptr) {
+		addr, err = syscall.GetsockoptIPv6Mreq(int(fd), syscall.IPPROTO_IP, soOriginalDst)
+	}); cerr != nil {
+		return "", cerr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	port := int(addr.Multiaddr[2])<<8 | int(addr.Multiaddr[3])
+	ip := net.IPv4(addr.Multiaddr[4], addr.Multiaddr[5], addr.Multiaddr[6], addr.Multiaddr[7])
+	return net.JoinHostPort(ip.String(), strconv.Itoa(port)), nil
+}
+
+// inNetNS calls the function in the network namespace of the process. The sockets created by
+// the function belong to the namespace, even if they're used by other threads afterwards.
+func inNetNS(pid uint32, fn func() error) error {
+	errs := make(chan error, 1)
+	go func() {
+		runtime.LockOSThread()
+
+		origin, err := netns.Get()
+		if err != nil {
+			runtime.UnlockOSThread()
+			errs <- err
+			return
+		}
+		defer origin.Close()
+
+		target, err := netns.GetFromPath(GetNsPath(pid, netNS))
+		if err != nil {
+			runtime.UnlockOSThread()
+			errs <- err
+			return
+		}
+		defer target.Close()
+
+		if err := netns.Set(target); err != nil {
+			runtime.UnlockOSThread()
+			errs <- err
+			return
+		}
+		err = fn()
+
+		// the thread exits with the goroutine if it can't go back to the original namespace
+		if rerr := netns.Set(origin); rerr != nil {
+			log.Error(rerr, "fail to restore network namespace of thread")
+		} else {
+			runtime.UnlockOSThread()
+		}
+		errs <- err
+	}()
+	return <-errs
+}
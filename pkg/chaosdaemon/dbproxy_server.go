@@ -0,0 +1,344 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+const (
+	// dbProxyCommentPrefix is the prefix of the comments of the rules redirecting the connections
+	// to the proxies, the rules are told apart by the containers and the ports of the databases
+	dbProxyCommentPrefix = "chaos-mesh-dbproxy/"
+	// dbProxyMark marks the connections from the proxies to the databases, which aren't redirected
+	dbProxyMark = 0x63686d
+	// dbProxyStateSuffix is the suffix of the file next to the journal, which the proxies are persisted to
+	dbProxyStateSuffix = ".dbproxy"
+)
+
+// dbProxyState is the persisted state of a proxy, which is started on the same port again
+// if chaos-daemon restarts, since the rules keep redirecting the connections to the port
+type dbProxyState struct {
+	ContainerID string `json:"containerId"`
+	ListenPort  int    `json:"listenPort"`
+	// Request is the encoded request of the fault
+	Request []byte `json:"request"`
+}
+
+type runningDBProxy struct {
+	state dbProxyState
+	proxy *dbProxy
+}
+
+// dbProxies are the proxies by the containers and the ports of the databases
+type dbProxies struct {
+	sync.Mutex
+	// path is the file which the states are persisted to, they're only kept in memory if it's empty
+	path    string
+	proxies map[string]*runningDBProxy
+}
+
+func dbProxyKey(containerID string, port uint32) string {
+	return fmt.Sprintf("%s/%d", containerID, port)
+}
+
+// SetDBFault starts a proxy in the network namespace of the container, and redirects the connections
+// to the database to it. The fault of the proxy is changed if it has been started.
+func (s *daemonServer) SetDBFault(ctx context.Context, req *pb.DBFaultRequest) (*empty.Empty, error) {
+	log.Info("Set DB fault", "request", req)
+
+	fault, err := validateDBFaultRequest(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid db fault: %v", err)
+	}
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting PID")
+		return nil, err
+	}
+
+	s.dbProxies.Lock()
+	defer s.dbProxies.Unlock()
+
+	key := dbProxyKey(req.ContainerId, req.Port)
+	if running, ok := s.dbProxies.proxies[key]; ok {
+		running.proxy.setFault(fault)
+		// the addresses of the database may have been changed
+		if err := s.deleteDBProxyRules(ctx, pid, key); err != nil {
+			return nil, status.Errorf(codes.Internal, "delete rules of db proxy error: %v", err)
+		}
+		if err := s.addDBProxyRules(ctx, pid, req, running.state.ListenPort); err != nil {
+			return nil, status.Errorf(codes.Internal, "add rules of db proxy error: %v", err)
+		}
+		running.state.Request = data
+		return &empty.Empty{}, s.dbProxies.save()
+	}
+
+	listener, err := listenDBProxy(pid, 0)
+	if err != nil {
+		log.Error(err, "error while listening db proxy", "pid", pid)
+		return nil, err
+	}
+	proxy := newDBProxy(listener, dialDBUpstream(pid), req.Protocol, fault)
+	listenPort := listener.Addr().(*net.TCPAddr).Port
+
+	if err := s.addDBProxyRules(ctx, pid, req, listenPort); err != nil {
+		proxy.Close()
+		if derr := s.deleteDBProxyRules(ctx, pid, key); derr != nil {
+			log.Error(derr, "error while deleting rules of db proxy", "pid", pid)
+		}
+		return nil, status.Errorf(codes.Internal, "add rules of db proxy error: %v", err)
+	}
+
+	if s.dbProxies.proxies == nil {
+		s.dbProxies.proxies = make(map[string]*runningDBProxy)
+	}
+	s.dbProxies.proxies[key] = &runningDBProxy{
+		state: dbProxyState{ContainerID: req.ContainerId, ListenPort: listenPort, Request: data},
+		proxy: proxy,
+	}
+	return &empty.Empty{}, s.dbProxies.save()
+}
+
+// DeleteDBFault removes the rules redirecting the connections to the database, and stops the proxy.
+// The connections which have been relayed by the proxy are closed.
+func (s *daemonServer) DeleteDBFault(ctx context.Context, req *pb.DBFaultRequest) (*empty.Empty, error) {
+	log.Info("Delete DB fault", "request", req)
+
+	key := dbProxyKey(req.ContainerId, req.Port)
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		// the rules are gone with the network namespace of the container
+		log.Info("fail to get PID, skip deleting rules", "container", req.ContainerId, "error", err.Error())
+	} else if err := s.deleteDBProxyRules(ctx, pid, key); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete rules of db proxy error: %v", err)
+	}
+
+	s.dbProxies.Lock()
+	defer s.dbProxies.Unlock()
+
+	if running, ok := s.dbProxies.proxies[key]; ok {
+		if err := running.proxy.Close(); err != nil {
+			log.Error(err, "error while closing db proxy", "key", key)
+		}
+		delete(s.dbProxies.proxies, key)
+	}
+	return &empty.Empty{}, s.dbProxies.save()
+}
+
+// restoreDBProxies starts the proxies persisted before chaos-daemon restarts. The rules of the proxies
+// which can't be started are deleted, so the connections to the databases aren't refused.
+func (s *daemonServer) restoreDBProxies(ctx context.Context, path string) error {
+	s.dbProxies.Lock()
+	defer s.dbProxies.Unlock()
+
+	s.dbProxies.path = path
+	if path == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var states []dbProxyState
+	if err := json.Unmarshal(data, &states); err != nil {
+		return err
+	}
+
+	s.dbProxies.proxies = make(map[string]*runningDBProxy)
+	for _, state := range states {
+		req := &pb.DBFaultRequest{}
+		if err := proto.Unmarshal(state.Request, req); err != nil {
+			log.Error(err, "invalid request of db proxy", "container", state.ContainerID)
+			continue
+		}
+		key := dbProxyKey(state.ContainerID, req.Port)
+		pid, err := s.crClient.GetPidFromContainerID(ctx, state.ContainerID)
+		if err != nil {
+			log.Info("container of db proxy is gone", "container", state.ContainerID, "error", err.Error())
+			continue
+		}
+
+		fault, err := validateDBFaultRequest(req)
+		var listener net.Listener
+		if err == nil {
+			listener, err = listenDBProxy(pid, state.ListenPort)
+		}
+		if err != nil {
+			log.Error(err, "error while restoring db proxy", "container", state.ContainerID, "port", req.Port)
+			if derr := s.deleteDBProxyRules(ctx, pid, key); derr != nil {
+				log.Error(derr, "error while deleting rules of db proxy", "pid", pid)
+			}
+			continue
+		}
+
+		log.Info("restore db proxy", "container", state.ContainerID, "port", req.Port, "listenPort", state.ListenPort)
+		s.dbProxies.proxies[key] = &runningDBProxy{
+			state: state,
+			proxy: newDBProxy(listener, dialDBUpstream(pid), req.Protocol, fault),
+		}
+	}
+	return s.dbProxies.save()
+}
+
+// save persists the states of the proxies, the caller should hold the lock
+func (p *dbProxies) save() error {
+	if p.path == "" {
+		return nil
+	}
+
+	states := make([]dbProxyState, 0, len(p.proxies))
+	for _, running := range p.proxies {
+		states = append(states, running.state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return string(states[i].Request) < string(states[j].Request)
+	})
+	data, err := json.Marshal(states)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p.path), 0700); err != nil {
+		return err
+	}
+	tmp := p.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p.path)
+}
+
+func (s *daemonServer) addDBProxyRules(ctx context.Context, pid uint32, req *pb.DBFaultRequest, listenPort int) error {
+	nsPath := GetNsPath(pid, netNS)
+	addresses := req.Addresses
+	if len(addresses) == 0 {
+		addresses = []string{""}
+	}
+	for _, address := range addresses {
+		args := dbProxyRuleArgs(dbProxyKey(req.ContainerId, req.Port), address, req.Port, listenPort)
+		// the rule which has been added by the earlier injection isn't added again
+		if err := withNetNS(ctx, nsPath, iptablesCmd, append([]string{"-t", "nat", "-C", "OUTPUT"}, args...)...).Run(); err == nil {
+			continue
+		}
+
+		cmd := withNetNS(ctx, nsPath, iptablesCmd, append([]string{"-t", "nat", "-I", "OUTPUT"}, args...)...)
+		if err := s.addIptablesRules(ctx, cmd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *daemonServer) deleteDBProxyRules(ctx context.Context, pid uint32, key string) error {
+	nsPath := GetNsPath(pid, netNS)
+	out, err := withNetNS(ctx, nsPath, iptablesCmd, "-t", "nat", "-S", "OUTPUT", "-w", "5").CombinedOutput()
+	if err != nil {
+		log.Error(err, "failed to list nat rules", "output", string(out))
+		return err
+	}
+
+	for _, rule := range dbProxyRules(string(out), func(comment string) bool { return comment == dbProxyCommentPrefix+key }) {
+		args := append([]string{"-t", "nat", "-D"}, rule[1:]...)
+		cmd := withNetNS(ctx, nsPath, iptablesCmd, append(args, "-w", "5")...)
+		if err := s.deleteIptablesRules(ctx, cmd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dbProxyRules returns the rules whose comments match from the output of `iptables -t nat -S`,
+// e.g. -A OUTPUT -d 10.0.0.1/32 -p tcp -m tcp --dport 3306 -m mark ! --mark 0x63686d
+// -m comment --comment chaos-mesh-dbproxy/containerd://id/3306 -j REDIRECT --to-ports 40001
+func dbProxyRules(output string, match func(comment string) bool) [][]string {
+	var rules [][]string
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "-A" {
+			continue
+		}
+		// the comments are quoted by iptables, but the arguments of the command aren't
+		for i := range fields {
+			fields[i] = strings.Trim(fields[i], "\"")
+		}
+		for i, field := range fields {
+			if field == "--comment" && i+1 < len(fields) && match(fields[i+1]) {
+				rules = append(rules, fields)
+				break
+			}
+		}
+	}
+	return rules
+}
+
+func isDBProxyComment(comment string) bool {
+	return strings.HasPrefix(comment, dbProxyCommentPrefix)
+}
+
+// dbProxyRuleArgs returns the rule of the nat table which redirects the connections to the database
+// to the proxy, except the ones marked by the proxy itself
+func dbProxyRuleArgs(key string, address string, port uint32, listenPort int) []string {
+	args := []string{"-p", "tcp"}
+	if address != "" {
+		args = append(args, "-d", address)
+	}
+	return append(args,
+		"--dport", strconv.Itoa(int(port)),
+		"-m", "mark", "!", "--mark", fmt.Sprintf("%#x", dbProxyMark),
+		"-m", "comment", "--comment", dbProxyCommentPrefix+key,
+		"-j", "REDIRECT", "--to-ports", strconv.Itoa(listenPort), "-w", "5",
+	)
+}
+
+func validateDBFaultRequest(req *pb.DBFaultRequest) (*dbFault, error) {
+	if req.Port == 0 || req.Port > 65535 {
+		return nil, fmt.Errorf("port %d is out of range", req.Port)
+	}
+	for _, address := range req.Addresses {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			ip, _, _ = net.ParseCIDR(address)
+		}
+		if ip == nil || ip.To4() == nil {
+			return nil, fmt.Errorf("address %s isn't an IPv4 address or cidr", address)
+		}
+	}
+	return newDBFault(req.Protocol, req.Fault)
+}
@@ -0,0 +1,163 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+var _ = Describe("dbproxy server", func() {
+	defer mock.With("MockContainerdClient", &MockClient{})()
+	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "dbproxy")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	// mockProxy listens on the loopback address and connects to the upstream instead of the original destination
+	mockProxy := func(upstream net.Listener) func() {
+		listenerFinalizer := mock.With("MockDBProxyListener", func(pid uint32, port int) (net.Listener, error) {
+			return net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+		})
+		dialerFinalizer := mock.With("MockDBUpstreamDialer", func(net.Conn) (net.Conn, error) {
+			return net.Dial("tcp", upstream.Addr().String())
+		})
+		return func() {
+			listenerFinalizer()
+			dialerFinalizer()
+		}
+	}
+
+	// mockIptables records the iptables commands, and lists the rules of the output
+	mockIptables := func(commands *[]string, output string) mock.Finalizer {
+		return mock.With("MockWithNetNs", func(ctx context.Context, ns string, cmd string, args ...string) *exec.Cmd {
+			*commands = append(*commands, strings.Join(args, " "))
+			switch args[2] {
+			case "-C":
+				return exec.Command("false")
+			case "-S":
+				return exec.Command("echo", output)
+			}
+			return exec.Command("true")
+		})
+	}
+
+	request := &pb.DBFaultRequest{
+		ContainerId: "containerd://container-id",
+		Protocol:    dbProtocolMySQL,
+		Addresses:   []string{"10.0.0.1", "10.0.0.2"},
+		Port:        3306,
+		Fault:       &pb.DBFault{Action: dbActionQueryError},
+	}
+
+	Context("SetDBFault", func() {
+		It("should redirect the connections to the proxy and restore it", func() {
+			upstream := fakeMySQL()
+			defer upstream.Close()
+			defer mockProxy(upstream)()
+			var commands []string
+			defer mockIptables(&commands, "")()
+
+			path := filepath.Join(dir, "journal.dbproxy")
+			s := &daemonServer{crClient: c}
+			Expect(s.restoreDBProxies(context.TODO(), path)).To(Succeed())
+			_, err := s.SetDBFault(context.TODO(), request)
+			Expect(err).To(BeNil())
+
+			running := s.dbProxies.proxies[dbProxyKey(request.ContainerId, request.Port)]
+			Expect(running).NotTo(BeNil())
+			port := running.state.ListenPort
+			Expect(commands).To(HaveLen(4))
+			Expect(commands[1]).To(Equal(fmt.Sprintf("-t nat -I OUTPUT -p tcp -d 10.0.0.1 --dport 3306 -m mark ! --mark 0x63686d "+
+				"-m comment --comment chaos-mesh-dbproxy/containerd://container-id/3306 -j REDIRECT --to-ports %d -w 5", port)))
+
+			// the proxy is started on the same port after chaos-daemon restarts
+			Expect(running.proxy.Close()).To(Succeed())
+			restarted := &daemonServer{crClient: c}
+			Expect(restarted.restoreDBProxies(context.TODO(), path)).To(Succeed())
+			restored := restarted.dbProxies.proxies[dbProxyKey(request.ContainerId, request.Port)]
+			Expect(restored).NotTo(BeNil())
+			Expect(restored.state.ListenPort).To(Equal(port))
+			defer restored.proxy.Close()
+
+			conn, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+			Expect(err).To(BeNil())
+			conn.Close()
+		})
+
+		It("should fail on invalid requests", func() {
+			s := &daemonServer{crClient: c}
+			for _, req := range []*pb.DBFaultRequest{
+				{ContainerId: "containerd://container-id", Protocol: dbProtocolMySQL, Port: 0,
+					Fault: &pb.DBFault{Action: dbActionQueryError}},
+				{ContainerId: "containerd://container-id", Protocol: dbProtocolMySQL, Port: 3306,
+					Addresses: []string{"db.default"}, Fault: &pb.DBFault{Action: dbActionQueryError}},
+				{ContainerId: "containerd://container-id", Protocol: "mongodb", Port: 27017,
+					Fault: &pb.DBFault{Action: dbActionQueryError}},
+			} {
+				_, err := s.SetDBFault(context.TODO(), req)
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			}
+		})
+	})
+
+	Context("DeleteDBFault", func() {
+		It("should delete the rules and stop the proxy", func() {
+			upstream := fakeMySQL()
+			defer upstream.Close()
+			defer mockProxy(upstream)()
+			var commands []string
+			defer mockIptables(&commands, `-P OUTPUT ACCEPT
+-A OUTPUT -d 10.0.0.1/32 -p tcp -m tcp --dport 3306 -m mark ! --mark 0x63686d -m comment --comment "chaos-mesh-dbproxy/containerd://container-id/3306" -j REDIRECT --to-ports 40001
+-A OUTPUT -d 10.0.0.1/32 -p tcp -m tcp --dport 5432 -m mark ! --mark 0x63686d -m comment --comment "chaos-mesh-dbproxy/containerd://container-id/5432" -j REDIRECT --to-ports 40002`)()
+
+			s := &daemonServer{crClient: c}
+			_, err := s.SetDBFault(context.TODO(), request)
+			Expect(err).To(BeNil())
+			commands = nil
+
+			_, err = s.DeleteDBFault(context.TODO(), request)
+			Expect(err).To(BeNil())
+			Expect(commands).To(Equal([]string{
+				"-t nat -S OUTPUT -w 5",
+				"-t nat -D OUTPUT -d 10.0.0.1/32 -p tcp -m tcp --dport 3306 -m mark ! --mark 0x63686d -m comment --comment chaos-mesh-dbproxy/containerd://container-id/3306 -j REDIRECT --to-ports 40001 -w 5",
+			}))
+			Expect(s.dbProxies.proxies).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,52 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"net"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+func listenDBProxy(pid uint32, port int) (net.Listener, error) {
+	// Mock point to return mock listener in unit test
+	if l := mock.On("MockDBProxyListener"); l != nil {
+		if e, ok := l.(error); ok {
+			return nil, e
+		}
+		return l.(func(uint32, int) (net.Listener, error))(pid, port)
+	}
+
+	return nil, errUnsupportedOnWindows
+}
+
+func dialDBUpstream(pid uint32) func(net.Conn) (net.Conn, error) {
+	// Mock point to return mock dialer in unit test
+	if d := mock.On("MockDBUpstreamDialer"); d != nil {
+		return d.(func(net.Conn) (net.Conn, error))
+	}
+
+	return func(net.Conn) (net.Conn, error) {
+		return nil, errUnsupportedOnWindows
+	}
+}
+
+func dialDB(pid uint32, address string) (net.Conn, error) {
+	// Mock point to return mock connection in unit test
+	if d := mock.On("MockDBDialer"); d != nil {
+		return d.(func(uint32, string) (net.Conn, error))(pid, address)
+	}
+
+	return nil, errUnsupportedOnWindows
+}
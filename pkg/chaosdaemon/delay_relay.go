@@ -0,0 +1,199 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"io"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	relayBufferSize = 32 * 1024
+	// relayQueueSize is the number of chunks which are delayed at the same time on a connection
+	relayQueueSize = 64
+)
+
+// delayRelay accepts the connections on the listener and relays them to the target, the data
+// sent by the target is delayed by the latency, like the egress packets of the target delayed by netem
+type delayRelay struct {
+	listener net.Listener
+	target   string
+
+	sync.Mutex
+	delay  time.Duration
+	jitter time.Duration
+	conns  map[net.Conn]struct{}
+	closed bool
+
+	wg sync.WaitGroup
+}
+
+func newDelayRelay(listener net.Listener, target string, delay, jitter time.Duration) *delayRelay {
+	r := &delayRelay{
+		listener: listener,
+		target:   target,
+		delay:    delay,
+		jitter:   jitter,
+		conns:    make(map[net.Conn]struct{}),
+	}
+
+	r.wg.Add(1)
+	go r.serve()
+	return r
+}
+
+// setLatency changes the latency of the data relayed afterwards
+func (r *delayRelay) setLatency(delay, jitter time.Duration) {
+	r.Lock()
+	defer r.Unlock()
+	r.delay, r.jitter = delay, jitter
+}
+
+// latency returns the latency of a chunk, which is uniformly distributed in delay ± jitter
+func (r *delayRelay) latency() time.Duration {
+	r.Lock()
+	defer r.Unlock()
+	if r.jitter <= 0 {
+		return r.delay
+	}
+	return r.delay - r.jitter + time.Duration(rand.Int63n(int64(2*r.jitter)))
+}
+
+// Close stops accepting connections and closes the relayed ones
+func (r *delayRelay) Close() error {
+	r.Lock()
+	r.closed = true
+	for conn := range r.conns {
+		conn.Close()
+	}
+	r.Unlock()
+
+	err := r.listener.Close()
+	r.wg.Wait()
+	return err
+}
+
+func (r *delayRelay) track(conns ...net.Conn) bool {
+	r.Lock()
+	defer r.Unlock()
+	if r.closed {
+		return false
+	}
+	for _, conn := range conns {
+		r.conns[conn] = struct{}{}
+	}
+	return true
+}
+
+func (r *delayRelay) untrack(conns ...net.Conn) {
+	r.Lock()
+	defer r.Unlock()
+	for _, conn := range conns {
+		delete(r.conns, conn)
+	}
+}
+
+func (r *delayRelay) serve() {
+	defer r.wg.Done()
+	for {
+		client, err := r.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			r.relay(client)
+		}()
+	}
+}
+
+func (r *delayRelay) relay(client net.Conn) {
+	defer client.Close()
+	target, err := net.Dial("tcp", r.target)
+	if err != nil {
+		log.Error(err, "failed to connect the target of relay", "target", r.target)
+		return
+	}
+	defer target.Close()
+
+	if !r.track(client, target) {
+		return
+	}
+	defer r.untrack(client, target)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		io.Copy(target, client)
+		closeWrite(target)
+	}()
+	go func() {
+		defer wg.Done()
+		r.copyDelayed(client, target)
+		closeWrite(client)
+	}()
+	wg.Wait()
+}
+
+type delayedChunk struct {
+	data     []byte
+	deadline time.Time
+}
+
+// copyDelayed copies the data from src to dst, every chunk is written after its latency.
+// The chunks are written in order, so a chunk waits for the ones read before it.
+func (r *delayRelay) copyDelayed(dst io.Writer, src io.Reader) {
+	chunks := make(chan delayedChunk, relayQueueSize)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for chunk := range chunks {
+			time.Sleep(time.Until(chunk.deadline))
+			if _, err := dst.Write(chunk.data); err != nil {
+				// the remaining chunks are drained so that the reader isn't blocked
+				for range chunks {
+				}
+				return
+			}
+		}
+	}()
+
+	for {
+		buf := make([]byte, relayBufferSize)
+		n, err := src.Read(buf)
+		if n > 0 {
+			chunks <- delayedChunk{data: buf[:n], deadline: time.Now().Add(r.latency())}
+		}
+		if err != nil {
+			break
+		}
+	}
+	close(chunks)
+	<-done
+}
+
+// closeWrite shuts down the writing side of the connection, so the peer reads EOF
+func closeWrite(conn net.Conn) {
+	if c, ok := conn.(interface{ CloseWrite() error }); ok {
+		c.CloseWrite()
+		return
+	}
+	conn.Close()
+}
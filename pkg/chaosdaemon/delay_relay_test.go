@@ -0,0 +1,101 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("delay relay", func() {
+	// echo serves as the target, which sends back what it receives
+	echo := func() net.Listener {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(BeNil())
+		go func() {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				go func() {
+					defer conn.Close()
+					io.Copy(conn, conn)
+				}()
+			}
+		}()
+		return listener
+	}
+
+	roundTrip := func(addr string) (string, time.Duration) {
+		conn, err := net.Dial("tcp", addr)
+		Expect(err).To(BeNil())
+		defer conn.Close()
+
+		start := time.Now()
+		_, err = conn.Write([]byte("ping"))
+		Expect(err).To(BeNil())
+		Expect(conn.(*net.TCPConn).CloseWrite()).To(Succeed())
+		data, err := ioutil.ReadAll(conn)
+		Expect(err).To(BeNil())
+		return string(data), time.Since(start)
+	}
+
+	It("should delay the data from the target", func() {
+		target := echo()
+		defer target.Close()
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(BeNil())
+		relay := newDelayRelay(listener, target.Addr().String(), 100*time.Millisecond, 0)
+		defer relay.Close()
+
+		data, elapsed := roundTrip(listener.Addr().String())
+		Expect(data).To(Equal("ping"))
+		Expect(elapsed).To(BeNumerically(">=", 100*time.Millisecond))
+
+		relay.setLatency(0, 0)
+		data, elapsed = roundTrip(listener.Addr().String())
+		Expect(data).To(Equal("ping"))
+		Expect(elapsed).To(BeNumerically("<", 100*time.Millisecond))
+	})
+
+	It("should keep the latency within jitter", func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(BeNil())
+		relay := newDelayRelay(listener, "127.0.0.1:0", 100*time.Millisecond, 20*time.Millisecond)
+		defer relay.Close()
+
+		for i := 0; i < 100; i++ {
+			latency := relay.latency()
+			Expect(latency).To(BeNumerically(">=", 80*time.Millisecond))
+			Expect(latency).To(BeNumerically("<", 120*time.Millisecond))
+		}
+	})
+
+	It("should stop accepting connections after closed", func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(BeNil())
+		relay := newDelayRelay(listener, "127.0.0.1:0", 0, 0)
+		Expect(relay.Close()).To(Succeed())
+
+		_, err = net.Dial("tcp", listener.Addr().String())
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,350 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+
+	"github.com/containerd/cgroups"
+	"golang.org/x/sys/unix"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+	"github.com/chaos-mesh/chaos-mesh/pkg/ptrace"
+)
+
+// holderScript moves the shell into the pids cgroup of the pod, and spawns the sleeping children.
+// The children are forked after the shell is moved, so they're all counted in the cgroup.
+const holderScript = "echo 0 > %s && i=0 && while [ $i -lt %d ]; do sleep 2147483647 & i=$((i+1)); done; wait"
+
+// fillFileName is the name of the file which fills the storage, it's found by the name on recovery
+const fillFileName = ".chaos-mesh-fill"
+
+// fillChunkSize is the size of the zeros written at a time if the file can't be allocated at once
+const fillChunkSize = 1 << 20
+
+// openFds opens n file descriptors in the process by epoll_create1, which needs no arguments in
+// the memory of the process. The file descriptors which have been opened are returned on failure.
+func openFds(pid uint32, n int) ([]uint64, error) {
+	// Mock point to return error in unit test
+	if err := mock.On("OpenFdsError"); err != nil {
+		if e, ok := err.(error); ok {
+			return nil, e
+		}
+	}
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	program, err := ptrace.Trace(int(pid))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := program.Detach(); err != nil {
+			log.Error(err, "fail to detach program", "pid", pid)
+		}
+	}()
+
+	results, err := program.SyscallN(n, func(int) (uint64, []uint64) {
+		return syscall.SYS_EPOLL_CREATE1, []uint64{syscall.EPOLL_CLOEXEC}
+	})
+	fds := results
+	// the last one is an error such as EMFILE if the syscalls are stopped
+	if len(results) > 0 && int64(results[len(results)-1]) < 0 {
+		fds = results[:len(results)-1]
+		if err == nil {
+			err = fmt.Errorf("opened %d of %d file descriptors: %v", len(fds), n,
+				syscall.Errno(-int64(results[len(results)-1])))
+		}
+	}
+	if err != nil && len(fds) > 0 {
+		if cerr := closeFdsOf(program, fds); cerr != nil {
+			log.Error(cerr, "fail to close file descriptors", "pid", pid)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fds, nil
+}
+
+// closeFds closes the file descriptors opened by openFds
+func closeFds(pid uint32, fds []uint64) error {
+	// Mock point to return error in unit test
+	if err := mock.On("CloseFdsError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+	}
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	program, err := ptrace.Trace(int(pid))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := program.Detach(); err != nil {
+			log.Error(err, "fail to detach program", "pid", pid)
+		}
+	}()
+
+	return closeFdsOf(program, fds)
+}
+
+func closeFdsOf(program *ptrace.TracedProgram, fds []uint64) error {
+	_, err := program.SyscallN(len(fds), func(i int) (uint64, []uint64) {
+		return syscall.SYS_CLOSE, []uint64{fds[i]}
+	})
+	return err
+}
+
+// holdPids spawns the processes in the pids cgroup of the pod which the process belongs to,
+// until the percent of the max pids of the pod is used
+func holdPids(pid uint32, percent int32) (*exec.Cmd, error) {
+	path, err := pidPath(int(pid))(cgroups.Pids)
+	if err != nil {
+		return nil, err
+	}
+	// the cgroup of the pod is the parent of the cgroup of the container
+	cgroup := filepath.Join(defaultCgroupRoot, string(cgroups.Pids), filepath.Dir(path))
+	used, limit, err := pidUsage(cgroup)
+	if err != nil {
+		return nil, err
+	}
+	// the shell is counted as well
+	n := exhaustCount(used, limit, percent) - 1
+	if n <= 0 {
+		return nil, nil
+	}
+
+	cmd := withPidNS(context.Background(), GetNsPath(pid, pidNS), "sh", "-c",
+		fmt.Sprintf(holderScript, filepath.Join(cgroup, "cgroup.procs"), n))
+	// the holder and its children are killed as a process group
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	go func() {
+		if err := cmd.Wait(); err != nil {
+			log.Info("pids holder exited", "pid", cmd.Process.Pid, "error", err.Error())
+		}
+	}()
+
+	return cmd, nil
+}
+
+// killHolder kills the holder and its children
+func killHolder(cmd *exec.Cmd) error {
+	err := syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	if err == syscall.ESRCH {
+		return nil
+	}
+	return err
+}
+
+// fillStorage creates the file in the directory under the root, so that the storage used in the directory
+// reaches the bytes. It isn't an error if the storage is full before the bytes are reached.
+func fillStorage(root, dir string, bytes int64) error {
+	// Mock point to return error in unit test
+	if err := mock.On("FillStorageError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+	}
+
+	dirfd, err := openDirInRoot(root, dir)
+	if err != nil {
+		return fmt.Errorf("open directory %s: %v", dir, err)
+	}
+	defer unix.Close(dirfd)
+
+	used, err := dirUsage(dirfd)
+	if err != nil {
+		return fmt.Errorf("read the usage of directory %s: %v", dir, err)
+	}
+	size := bytes - used
+	if size <= 0 {
+		return nil
+	}
+
+	fd, err := unix.Openat(dirfd, fillFileName, unix.O_CREAT|unix.O_WRONLY|unix.O_TRUNC|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0600)
+	if err != nil {
+		return fmt.Errorf("create %s in directory %s: %v", fillFileName, dir, err)
+	}
+	err = allocate(fd, size)
+	if cerr := unix.Close(fd); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		if uerr := unix.Unlinkat(dirfd, fillFileName, 0); uerr != nil {
+			log.Error(uerr, "fail to remove file", "directory", dir, "file", fillFileName)
+		}
+		return err
+	}
+	return nil
+}
+
+// clearStorage removes the file created by fillStorage
+func clearStorage(root, dir string) error {
+	// Mock point to return error in unit test
+	if err := mock.On("ClearStorageError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+	}
+
+	dirfd, err := openDirInRoot(root, dir)
+	if err == unix.ENOENT {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("open directory %s: %v", dir, err)
+	}
+	defer unix.Close(dirfd)
+
+	if err := unix.Unlinkat(dirfd, fillFileName, 0); err != nil && err != unix.ENOENT {
+		return fmt.Errorf("remove %s in directory %s: %v", fillFileName, dir, err)
+	}
+	return nil
+}
+
+// allocate allocates the blocks of the file, or writes the zeros if the blocks can't be allocated at once.
+// The zeros are written until the storage is full.
+func allocate(fd int, size int64) error {
+	err := unix.Fallocate(fd, 0, 0, size)
+	if err == nil {
+		return nil
+	}
+	if err != unix.EOPNOTSUPP && err != unix.ENOSPC {
+		return err
+	}
+	if err := unix.Ftruncate(fd, 0); err != nil {
+		return err
+	}
+
+	zeros := make([]byte, fillChunkSize)
+	for size > 0 {
+		chunk := zeros
+		if size < int64(len(chunk)) {
+			chunk = chunk[:size]
+		}
+		n, err := unix.Write(fd, chunk)
+		if err == unix.ENOSPC {
+			log.Info("storage is full before the size is reached", "left", size)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		size -= int64(n)
+	}
+	return nil
+}
+
+// openDirInRoot opens the directory under the root component by component without following symlinks,
+// so that the directory in the container is never resolved to the directory of the host
+func openDirInRoot(root, dir string) (int, error) {
+	fd, err := unix.Open(root, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return -1, err
+	}
+	for _, name := range strings.Split(filepath.Clean("/"+dir), "/") {
+		if len(name) == 0 {
+			continue
+		}
+		next, err := unix.Openat(fd, name, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+		// the symlink fails with ELOOP or ENOTDIR, depending on the kernel
+		if err == unix.ELOOP || err == unix.ENOTDIR {
+			var st unix.Stat_t
+			if serr := unix.Fstatat(fd, name, &st, unix.AT_SYMLINK_NOFOLLOW); serr == nil && st.Mode&unix.S_IFMT == unix.S_IFLNK {
+				err = fmt.Errorf("%s is a symlink", name)
+			}
+		}
+		unix.Close(fd)
+		if err != nil {
+			return -1, err
+		}
+		fd = next
+	}
+	return fd, nil
+}
+
+// dirUsage counts the blocks used by the files in the directory, the file created by fillStorage and
+// the mounts under the directory aren't counted
+func dirUsage(dirfd int) (int64, error) {
+	var st unix.Stat_t
+	if err := unix.Fstat(dirfd, &st); err != nil {
+		return 0, err
+	}
+	return walkUsage(dirfd, uint64(st.Dev))
+}
+
+func walkUsage(dirfd int, dev uint64) (int64, error) {
+	fd, err := unix.Dup(dirfd)
+	if err != nil {
+		return 0, err
+	}
+	dir := os.NewFile(uintptr(fd), "")
+	defer dir.Close()
+	names, err := dir.Readdirnames(-1)
+	if err != nil {
+		return 0, err
+	}
+
+	var used int64
+	for _, name := range names {
+		if name == fillFileName {
+			continue
+		}
+		var st unix.Stat_t
+		err := unix.Fstatat(dirfd, name, &st, unix.AT_SYMLINK_NOFOLLOW)
+		if err == unix.ENOENT {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		if uint64(st.Dev) != dev {
+			continue
+		}
+		used += int64(st.Blocks) * 512
+
+		if st.Mode&unix.S_IFMT != unix.S_IFDIR {
+			continue
+		}
+		sub, err := unix.Openat(dirfd, name, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+		if err == unix.ENOENT {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		n, err := walkUsage(sub, dev)
+		unix.Close(sub)
+		if err != nil {
+			return 0, err
+		}
+		used += n
+	}
+	return used, nil
+}
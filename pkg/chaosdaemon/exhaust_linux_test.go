@@ -0,0 +1,81 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("exhaust storage", func() {
+	var root string
+
+	BeforeEach(func() {
+		var err error
+		root, err = ioutil.TempDir("", "storage")
+		Expect(err).To(BeNil())
+		Expect(os.MkdirAll(filepath.Join(root, "data/sub"), 0755)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(root)
+	})
+
+	It("should fill the directory up to the bytes and clear it", func() {
+		Expect(ioutil.WriteFile(filepath.Join(root, "data/sub/used"), make([]byte, 64<<10), 0644)).To(Succeed())
+
+		Expect(fillStorage(root, "/data", 1<<20)).To(Succeed())
+		fill, err := os.Stat(filepath.Join(root, "data", fillFileName))
+		Expect(err).To(BeNil())
+		Expect(fill.Size()).To(BeNumerically("<", 1<<20-64<<10))
+		Expect(fill.Size()).To(BeNumerically(">", 1<<19))
+
+		// the file is counted as the usage of the directory no more
+		Expect(fillStorage(root, "/data", 1<<20)).To(Succeed())
+		again, err := os.Stat(filepath.Join(root, "data", fillFileName))
+		Expect(err).To(BeNil())
+		Expect(again.Size()).To(Equal(fill.Size()))
+
+		Expect(clearStorage(root, "/data")).To(Succeed())
+		_, err = os.Stat(filepath.Join(root, "data", fillFileName))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+		Expect(clearStorage(root, "/data")).To(Succeed())
+		Expect(clearStorage(root, "/gone")).To(Succeed())
+	})
+
+	It("should not fill the directory which has been used up to the bytes", func() {
+		Expect(ioutil.WriteFile(filepath.Join(root, "data/used"), make([]byte, 64<<10), 0644)).To(Succeed())
+
+		Expect(fillStorage(root, "/data", 32<<10)).To(Succeed())
+		_, err := os.Stat(filepath.Join(root, "data", fillFileName))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("should not follow the symlinks out of the root", func() {
+		outside, err := ioutil.TempDir("", "outside")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(outside)
+		Expect(os.Symlink(outside, filepath.Join(root, "data/link"))).To(Succeed())
+
+		err = fillStorage(root, "/data/link", 1<<20)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("symlink"))
+		_, err = os.Stat(filepath.Join(outside, fillFileName))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+})
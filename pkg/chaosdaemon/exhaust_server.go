@@ -0,0 +1,252 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/shirou/gopsutil/process"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// defaultCgroupRoot is where the cgroup v1 hierarchies of the host are mounted
+const defaultCgroupRoot = "/sys/fs/cgroup"
+
+// exhaustion is the resources exhausted in a container
+type exhaustion struct {
+	// pid is the process of the container which the file descriptors are opened in,
+	// createTime tells whether the pid is reused by another process on recovery
+	pid        uint32
+	createTime int64
+	fds        []uint64
+
+	// holder holds the pids of the pod by its sleeping children
+	holder *exec.Cmd
+}
+
+// exhaustions are the exhaustions of the containers by their ids. They're kept in the memory, so
+// if chaos-daemon restarts, the file descriptors are kept open until the container restarts.
+type exhaustions struct {
+	sync.Mutex
+	containers map[string]*exhaustion
+}
+
+// ExhaustResources consumes the file descriptors of the container process, the pids of the pod and
+// the storage of the directory in the container, so that the container fails with EMFILE on opening
+// files, with EAGAIN on creating threads and with ENOSPC on writing the directory
+func (s *daemonServer) ExhaustResources(ctx context.Context, req *pb.ExhaustRequest) (*empty.Empty, error) {
+	log.Info("Exhaust resources", "request", req)
+
+	if req.FdPercent < 0 || req.FdPercent > 100 || req.PidPercent < 0 || req.PidPercent > 100 {
+		return nil, fmt.Errorf("the percents of the resources should be from 0 to 100")
+	}
+	if req.StorageBytes < 0 {
+		return nil, fmt.Errorf("the storage bytes should not be negative")
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting PID")
+		return nil, err
+	}
+
+	s.exhaustions.Lock()
+	defer s.exhaustions.Unlock()
+
+	if _, ok := s.exhaustions.containers[req.ContainerId]; ok {
+		return nil, fmt.Errorf("the resources of container %s have been exhausted", req.ContainerId)
+	}
+
+	e := &exhaustion{pid: pid}
+	if req.FdPercent > 0 {
+		used, limit, err := fdUsage(defaultProcPrefix, pid)
+		if err != nil {
+			return nil, err
+		}
+		if n := exhaustCount(used, limit, req.FdPercent); n > 0 {
+			if proc, err := process.NewProcess(int32(pid)); err == nil {
+				e.createTime, _ = proc.CreateTime()
+			}
+			e.fds, err = openFds(pid, n)
+			if err != nil {
+				log.Error(err, "error while opening file descriptors", "pid", pid)
+				return nil, err
+			}
+		}
+	}
+	if req.PidPercent > 0 {
+		e.holder, err = holdPids(pid, req.PidPercent)
+		if err != nil {
+			log.Error(err, "error while holding pids", "pid", pid)
+			if rerr := e.release(); rerr != nil {
+				log.Error(rerr, "error while releasing resources", "pid", pid)
+			}
+			return nil, err
+		}
+	}
+	if len(req.StoragePath) != 0 {
+		if err := fillStorage(procRoot(pid), req.StoragePath, req.StorageBytes); err != nil {
+			log.Error(err, "error while filling storage", "pid", pid, "path", req.StoragePath)
+			if rerr := e.release(); rerr != nil {
+				log.Error(rerr, "error while releasing resources", "pid", pid)
+			}
+			return nil, err
+		}
+	}
+
+	if s.exhaustions.containers == nil {
+		s.exhaustions.containers = make(map[string]*exhaustion)
+	}
+	s.exhaustions.containers[req.ContainerId] = e
+	return &empty.Empty{}, nil
+}
+
+// RecoverResources releases the resources exhausted in the container, whatever the percents in the request are
+func (s *daemonServer) RecoverResources(ctx context.Context, req *pb.ExhaustRequest) (*empty.Empty, error) {
+	log.Info("Recover resources", "request", req)
+
+	// the filled file is found by its name, so it's removed even if chaos-daemon has restarted,
+	// and through the current process of the container, which may have restarted as well
+	if len(req.StoragePath) != 0 {
+		pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+		if err != nil {
+			// the storage of the container is gone with it
+			log.Info("fail to get PID, skip clearing storage", "container", req.ContainerId, "error", err.Error())
+		} else if err := clearStorage(procRoot(pid), req.StoragePath); err != nil {
+			log.Error(err, "error while clearing storage", "pid", pid, "path", req.StoragePath)
+			return nil, err
+		}
+	}
+
+	s.exhaustions.Lock()
+	defer s.exhaustions.Unlock()
+
+	e, ok := s.exhaustions.containers[req.ContainerId]
+	if !ok {
+		return &empty.Empty{}, nil
+	}
+	if err := e.release(); err != nil {
+		log.Error(err, "error while releasing resources", "pid", e.pid)
+		return nil, err
+	}
+	delete(s.exhaustions.containers, req.ContainerId)
+
+	return &empty.Empty{}, nil
+}
+
+func (e *exhaustion) release() error {
+	if e.holder != nil {
+		if err := killHolder(e.holder); err != nil {
+			return err
+		}
+		e.holder = nil
+	}
+
+	if len(e.fds) > 0 {
+		// the file descriptors are gone with the process
+		proc, err := process.NewProcess(int32(e.pid))
+		if err != nil {
+			e.fds = nil
+			return nil
+		}
+		if ct, err := proc.CreateTime(); err != nil || ct != e.createTime {
+			e.fds = nil
+			return nil
+		}
+		if err := closeFds(e.pid, e.fds); err != nil {
+			return err
+		}
+		e.fds = nil
+	}
+
+	return nil
+}
+
+// procRoot is the root directory of the process, through which the files in its mount namespace are accessed
+func procRoot(pid uint32) string {
+	return filepath.Join(defaultProcPrefix, strconv.FormatUint(uint64(pid), 10), "root")
+}
+
+// exhaustCount is the number of the resources to consume, so that the percent of the limit is used
+func exhaustCount(used, limit int, percent int32) int {
+	return limit*int(percent)/100 - used
+}
+
+// fdUsage reads the number of the file descriptors opened by the process and its soft limit of open files
+func fdUsage(procPath string, pid uint32) (int, int, error) {
+	dir := filepath.Join(procPath, strconv.FormatUint(uint64(pid), 10))
+	fds, err := ioutil.ReadDir(filepath.Join(dir, "fd"))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	limits, err := ioutil.ReadFile(filepath.Join(dir, "limits"))
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, line := range strings.Split(string(limits), "\n") {
+		if !strings.HasPrefix(line, "Max open files") {
+			continue
+		}
+		fields := strings.Fields(strings.TrimPrefix(line, "Max open files"))
+		if len(fields) == 0 {
+			break
+		}
+		if fields[0] == "unlimited" {
+			return 0, 0, fmt.Errorf("the open files of process %d aren't limited", pid)
+		}
+		limit, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid limit of open files %s: %v", fields[0], err)
+		}
+		return len(fds), limit, nil
+	}
+
+	return 0, 0, fmt.Errorf("the limit of open files isn't found in the limits of process %d", pid)
+}
+
+// pidUsage reads the number of the tasks in the pids cgroup and its max
+func pidUsage(cgroup string) (int, int, error) {
+	max, err := ioutil.ReadFile(filepath.Join(cgroup, "pids.max"))
+	if err != nil {
+		return 0, 0, err
+	}
+	if strings.TrimSpace(string(max)) == "max" {
+		return 0, 0, fmt.Errorf("the pids of cgroup %s aren't limited", cgroup)
+	}
+	limit, err := strconv.Atoi(strings.TrimSpace(string(max)))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	current, err := ioutil.ReadFile(filepath.Join(cgroup, "pids.current"))
+	if err != nil {
+		return 0, 0, err
+	}
+	used, err := strconv.Atoi(strings.TrimSpace(string(current)))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return used, limit, nil
+}
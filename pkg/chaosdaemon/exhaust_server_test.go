@@ -0,0 +1,115 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var _ = Describe("exhaust server", func() {
+	var root string
+
+	BeforeEach(func() {
+		var err error
+		root, err = ioutil.TempDir("", "exhaust")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(root)
+	})
+
+	Context("fdUsage", func() {
+		addProc := func(pid string, fds int, limits string) {
+			Expect(os.MkdirAll(filepath.Join(root, pid, "fd"), 0755)).To(Succeed())
+			for i := 0; i < fds; i++ {
+				Expect(ioutil.WriteFile(filepath.Join(root, pid, "fd", string(rune('0'+i))), nil, 0644)).To(Succeed())
+			}
+			Expect(ioutil.WriteFile(filepath.Join(root, pid, "limits"), []byte(limits), 0644)).To(Succeed())
+		}
+
+		It("should read the open files and the soft limit", func() {
+			addProc("10", 3, "Limit                     Soft Limit           Hard Limit           Units     \n"+
+				"Max processes             63570                63570                processes \n"+
+				"Max open files            1024                 4096                 files     \n")
+
+			used, limit, err := fdUsage(root, 10)
+			Expect(err).To(BeNil())
+			Expect(used).To(Equal(3))
+			Expect(limit).To(Equal(1024))
+		})
+
+		It("should fail if the open files aren't limited", func() {
+			addProc("10", 1, "Max open files            unlimited            unlimited            files     \n")
+
+			_, _, err := fdUsage(root, 10)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("aren't limited"))
+		})
+	})
+
+	Context("pidUsage", func() {
+		It("should read the current and the max pids", func() {
+			Expect(ioutil.WriteFile(filepath.Join(root, "pids.max"), []byte("100\n"), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(root, "pids.current"), []byte("12\n"), 0644)).To(Succeed())
+
+			used, limit, err := pidUsage(root)
+			Expect(err).To(BeNil())
+			Expect(used).To(Equal(12))
+			Expect(limit).To(Equal(100))
+		})
+
+		It("should fail if the pids aren't limited", func() {
+			Expect(ioutil.WriteFile(filepath.Join(root, "pids.max"), []byte("max\n"), 0644)).To(Succeed())
+
+			_, _, err := pidUsage(root)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("aren't limited"))
+		})
+	})
+
+	Context("exhaustCount", func() {
+		It("should count the resources to consume", func() {
+			Expect(exhaustCount(24, 1024, 50)).To(Equal(488))
+			Expect(exhaustCount(600, 1024, 50)).To(BeNumerically("<", 0))
+		})
+	})
+
+	Context("ExhaustResources", func() {
+		It("should reject the invalid percents", func() {
+			s := &daemonServer{}
+			_, err := s.ExhaustResources(context.TODO(), &pb.ExhaustRequest{
+				ContainerId: "containerd://container-id",
+				FdPercent:   120,
+			})
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("should do nothing on recovery if the resources aren't exhausted", func() {
+			s := &daemonServer{}
+			_, err := s.RecoverResources(context.TODO(), &pb.ExhaustRequest{
+				ContainerId: "containerd://container-id",
+			})
+			Expect(err).To(BeNil())
+		})
+	})
+})
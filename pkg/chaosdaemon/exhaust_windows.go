@@ -0,0 +1,72 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"os/exec"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+func openFds(pid uint32, n int) ([]uint64, error) {
+	// Mock point to return error in unit test
+	if err := mock.On("OpenFdsError"); err != nil {
+		if e, ok := err.(error); ok {
+			return nil, e
+		}
+	}
+
+	return nil, errUnsupportedOnWindows
+}
+
+func closeFds(pid uint32, fds []uint64) error {
+	// Mock point to return error in unit test
+	if err := mock.On("CloseFdsError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+	}
+
+	return errUnsupportedOnWindows
+}
+
+func holdPids(pid uint32, percent int32) (*exec.Cmd, error) {
+	return nil, errUnsupportedOnWindows
+}
+
+func killHolder(cmd *exec.Cmd) error {
+	return errUnsupportedOnWindows
+}
+
+func fillStorage(root, dir string, bytes int64) error {
+	// Mock point to return error in unit test
+	if err := mock.On("FillStorageError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+	}
+
+	return errUnsupportedOnWindows
+}
+
+func clearStorage(root, dir string) error {
+	// Mock point to return error in unit test
+	if err := mock.On("ClearStorageError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+	}
+
+	return errUnsupportedOnWindows
+}
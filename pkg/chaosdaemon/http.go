@@ -26,6 +26,7 @@ type httpServerBuilder struct {
 	addr      string
 	profiling bool
 	reg       prometheus.Gatherer
+	journal   http.Handler
 }
 
 func newHTTPServerBuilder() *httpServerBuilder {
@@ -55,10 +56,21 @@ func (b *httpServerBuilder) Profiling(profiling bool) *httpServerBuilder {
 	return b
 }
 
+// Journal sets the handler listing the journal of injections for http server
+func (b *httpServerBuilder) Journal(journal http.Handler) *httpServerBuilder {
+	b.journal = journal
+
+	return b
+}
+
 // Build builds an http server
 func (b *httpServerBuilder) Build() *http.Server {
 	registerMetrics(b.mux, b.reg)
 
+	if b.journal != nil {
+		b.mux.Handle("/journal", b.journal)
+	}
+
 	if b.profiling {
 		registerProfiler(b.mux)
 	}
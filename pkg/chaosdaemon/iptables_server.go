@@ -55,7 +55,12 @@ func (s *daemonServer) FlushIptables(ctx context.Context, req *pb.IpTablesReques
 
 	switch rule.Action {
 	case pb.Rule_ADD:
-		command := fmt.Sprintf(format, "-A", rule.Set)
+		op := "-A"
+		if rule.Mesh {
+			// insert the rule at the head of the chain so that it can't be bypassed by the sidecar's rules
+			op = "-I"
+		}
+		command := fmt.Sprintf(format, op, rule.Set)
 		cmd := withNetNS(ctx, nsPath, iptablesCmd, strings.Split(command, " ")...)
 		err = s.addIptablesRules(ctx, cmd)
 	case pb.Rule_DELETE:
@@ -70,9 +75,84 @@ func (s *daemonServer) FlushIptables(ctx context.Context, req *pb.IpTablesReques
 		return nil, err
 	}
 
+	if rule.Mesh {
+		if err := s.flushMeshIptables(ctx, nsPath, rule); err != nil {
+			return nil, err
+		}
+	}
+
 	return &empty.Empty{}, nil
 }
 
+// meshChains are the nat chains through which a service mesh sidecar redirects the traffic of the pod
+type meshChains struct {
+	mesh     string
+	inbound  string
+	outbound string
+}
+
+var knownMeshChains = []meshChains{
+	{mesh: "istio", inbound: "ISTIO_INBOUND", outbound: "ISTIO_OUTPUT"},
+	{mesh: "linkerd", inbound: "PROXY_INIT_REDIRECT", outbound: "PROXY_INIT_OUTPUT"},
+}
+
+// detectMeshChains returns the chains of the service mesh sidecar from the output of `iptables -t nat -S`,
+// or nil if there is no sidecar in the pod
+func detectMeshChains(output string) *meshChains {
+	defined := make(map[string]bool)
+	for _, line := range strings.Split(output, "\n") {
+		// e.g. -N ISTIO_OUTPUT
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "-N" {
+			defined[fields[1]] = true
+		}
+	}
+
+	for i := range knownMeshChains {
+		if defined[knownMeshChains[i].inbound] && defined[knownMeshChains[i].outbound] {
+			return &knownMeshChains[i]
+		}
+	}
+	return nil
+}
+
+// bypassArgs returns the nat rule which returns from the chain of the sidecar before the packets
+// matching the ipset are redirected, e.g. ISTIO_OUTPUT -m set --match-set name dst -j RETURN
+func (m *meshChains) bypassArgs(rule *pb.Rule) []string {
+	chain, match := m.inbound, "src"
+	if rule.Direction == pb.Rule_OUTPUT {
+		chain, match = m.outbound, "dst"
+	}
+	return []string{chain, "-m", "set", "--match-set", rule.Set, match, "-j", "RETURN"}
+}
+
+// flushMeshIptables keeps the packets matching the ipset from being redirected to the service mesh sidecar.
+// Otherwise the sidecar accepts the connections on behalf of the peers and the DROP rules are bypassed.
+func (s *daemonServer) flushMeshIptables(ctx context.Context, nsPath string, rule *pb.Rule) error {
+	out, err := withNetNS(ctx, nsPath, iptablesCmd, "-t", "nat", "-S", "-w", "5").CombinedOutput()
+	if err != nil {
+		log.Error(err, "failed to list nat rules", "output", string(out))
+		return err
+	}
+
+	chains := detectMeshChains(string(out))
+	if chains == nil {
+		log.Info("No service mesh sidecar is detected", "namespace", nsPath)
+		return nil
+	}
+
+	args := append(chains.bypassArgs(rule), "-w", "5")
+	switch rule.Action {
+	case pb.Rule_ADD:
+		cmd := withNetNS(ctx, nsPath, iptablesCmd, append([]string{"-t", "nat", "-I"}, args...)...)
+		return s.addIptablesRules(ctx, cmd)
+	case pb.Rule_DELETE:
+		cmd := withNetNS(ctx, nsPath, iptablesCmd, append([]string{"-t", "nat", "-D"}, args...)...)
+		return s.deleteIptablesRules(ctx, cmd)
+	}
+	return nil
+}
+
 func (s *daemonServer) addIptablesRules(ctx context.Context, cmd *exec.Cmd) error {
 	log.Info("Add iptables rules", "command", cmd.String())
 
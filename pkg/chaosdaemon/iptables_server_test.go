@@ -19,6 +19,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"strings"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -27,10 +28,56 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
 )
 
+// istioNatRules is the nat table set up by istio-init
+const istioNatRules = `-P PREROUTING ACCEPT
+-P INPUT ACCEPT
+-P OUTPUT ACCEPT
+-P POSTROUTING ACCEPT
+-N ISTIO_INBOUND
+-N ISTIO_IN_REDIRECT
+-N ISTIO_OUTPUT
+-N ISTIO_REDIRECT
+-A PREROUTING -p tcp -j ISTIO_INBOUND
+-A OUTPUT -p tcp -j ISTIO_OUTPUT
+-A ISTIO_INBOUND -p tcp -m tcp --dport 15008 -j RETURN
+-A ISTIO_INBOUND -p tcp -m tcp --dport 22 -j RETURN
+-A ISTIO_INBOUND -p tcp -m tcp --dport 15090 -j RETURN
+-A ISTIO_INBOUND -p tcp -m tcp --dport 15021 -j RETURN
+-A ISTIO_INBOUND -p tcp -m tcp --dport 15020 -j RETURN
+-A ISTIO_INBOUND -p tcp -j ISTIO_IN_REDIRECT
+-A ISTIO_IN_REDIRECT -p tcp -j REDIRECT --to-ports 15006
+-A ISTIO_OUTPUT -s 127.0.0.6/32 -o lo -j RETURN
+-A ISTIO_OUTPUT ! -d 127.0.0.1/32 -o lo -m owner --uid-owner 1337 -j ISTIO_IN_REDIRECT
+-A ISTIO_OUTPUT -o lo -m owner ! --uid-owner 1337 -j RETURN
+-A ISTIO_OUTPUT -m owner --uid-owner 1337 -j RETURN
+-A ISTIO_OUTPUT ! -d 127.0.0.1/32 -o lo -m owner --gid-owner 1337 -j ISTIO_IN_REDIRECT
+-A ISTIO_OUTPUT -o lo -m owner ! --gid-owner 1337 -j RETURN
+-A ISTIO_OUTPUT -m owner --gid-owner 1337 -j RETURN
+-A ISTIO_OUTPUT -d 127.0.0.1/32 -j RETURN
+-A ISTIO_OUTPUT -j ISTIO_REDIRECT
+-A ISTIO_REDIRECT -p tcp -j REDIRECT --to-ports 15001
+`
+
+// linkerdNatRules is the nat table set up by linkerd proxy-init
+const linkerdNatRules = `-P PREROUTING ACCEPT
+-P INPUT ACCEPT
+-P OUTPUT ACCEPT
+-P POSTROUTING ACCEPT
+-N PROXY_INIT_OUTPUT
+-N PROXY_INIT_REDIRECT
+-A PREROUTING -m comment --comment "proxy-init/install-proxy-init-prerouting/1612" -j PROXY_INIT_REDIRECT
+-A OUTPUT -m comment --comment "proxy-init/install-proxy-init-output/1612" -j PROXY_INIT_OUTPUT
+-A PROXY_INIT_OUTPUT -m owner --uid-owner 2102 -m comment --comment "proxy-init/ignore-proxy-user-id/1612" -j RETURN
+-A PROXY_INIT_OUTPUT -o lo -m comment --comment "proxy-init/ignore-loopback/1612" -j RETURN
+-A PROXY_INIT_OUTPUT -p tcp -m comment --comment "proxy-init/redirect-all-outgoing-to-proxy-port/1612" -j REDIRECT --to-ports 4140
+-A PROXY_INIT_REDIRECT -p tcp -m multiport --dports 4190,4191 -m comment --comment "proxy-init/ignore-port-4190,4191/1612" -j RETURN
+-A PROXY_INIT_REDIRECT -p tcp -m comment --comment "proxy-init/redirect-all-incoming-to-proxy-port/1612" -j REDIRECT --to-ports 4143
+`
+
 var _ = Describe("iptables server", func() {
 	defer mock.With("MockContainerdClient", &MockClient{})()
 	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
-	s := &daemonServer{c}
+	s := &daemonServer{crClient: c}
 
 	Context("addIptablesRule", func() {
 		It("should work", func() {
@@ -82,6 +129,29 @@ exit 1
 		})
 	})
 
+	Context("detectMeshChains", func() {
+		It("should detect the chains of istio", func() {
+			chains := detectMeshChains(istioNatRules)
+			Expect(chains).ToNot(BeNil())
+			Expect(chains.mesh).To(Equal("istio"))
+			Expect(chains.bypassArgs(&pb.Rule{Direction: pb.Rule_OUTPUT, Set: "part_tgt"})).To(Equal(
+				[]string{"ISTIO_OUTPUT", "-m", "set", "--match-set", "part_tgt", "dst", "-j", "RETURN"}))
+			Expect(chains.bypassArgs(&pb.Rule{Direction: pb.Rule_INPUT, Set: "part_src"})).To(Equal(
+				[]string{"ISTIO_INBOUND", "-m", "set", "--match-set", "part_src", "src", "-j", "RETURN"}))
+		})
+
+		It("should detect the chains of linkerd", func() {
+			chains := detectMeshChains(linkerdNatRules)
+			Expect(chains).ToNot(BeNil())
+			Expect(chains.mesh).To(Equal("linkerd"))
+			Expect(chains.outbound).To(Equal("PROXY_INIT_OUTPUT"))
+		})
+
+		It("should return nil without sidecar", func() {
+			Expect(detectMeshChains("-P PREROUTING ACCEPT\n-P OUTPUT ACCEPT\n")).To(BeNil())
+		})
+	})
+
 	Context("FlushIptables", func() {
 		It("should work", func() {
 			defer mock.With("pid", 9527)()
@@ -100,6 +170,35 @@ exit 1
 			Expect(err).To(BeNil())
 		})
 
+		It("should insert rules in front of the sidecar", func() {
+			defer mock.With("pid", 9527)()
+
+			var commands []string
+			defer mock.With("MockWithNetNs", func(ctx context.Context, ns, cmd string, args ...string) *exec.Cmd {
+				command := cmd + " " + strings.Join(args, " ")
+				commands = append(commands, command)
+				if command == iptablesCmd+" -t nat -S -w 5" {
+					return exec.Command("echo", istioNatRules)
+				}
+				return exec.Command("echo", "mock command")
+			})()
+			_, err := s.FlushIptables(context.TODO(), &pb.IpTablesRequest{
+				Rule: &pb.Rule{
+					Direction: pb.Rule_OUTPUT,
+					Action:    pb.Rule_ADD,
+					Set:       "part_tgt",
+					Mesh:      true,
+				},
+				ContainerId: "containerd://container-id",
+			})
+			Expect(err).To(BeNil())
+			Expect(commands).To(Equal([]string{
+				iptablesCmd + " -I OUTPUT -m set --match-set part_tgt dst -j DROP -w 5",
+				iptablesCmd + " -t nat -S -w 5",
+				iptablesCmd + " -t nat -I ISTIO_OUTPUT -m set --match-set part_tgt dst -j RETURN -w 5",
+			}))
+		})
+
 		It("should fail on get pid", func() {
 			const errorStr = "mock error on Task()"
 			defer mock.With("TaskError", errors.New(errorStr))()
@@ -0,0 +1,440 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/shirou/gopsutil/process"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+const grpcServicePrefix = "/chaosdaemon.ChaosDaemon/"
+
+// injectionMethods are the RPCs which inject faults, the value creates the response to replay.
+// The one-off actions which leave no fault behind, such as the failover of databases, aren't injections:
+// every run of a scheduled chaos performs them again.
+var injectionMethods = map[string]func() proto.Message{
+	grpcServicePrefix + "SetNetem":          newEmpty,
+	grpcServicePrefix + "SetTbf":            newEmpty,
+	grpcServicePrefix + "SetBpfFault":       newEmpty,
+	grpcServicePrefix + "SetMtu":            newEmpty,
+	grpcServicePrefix + "BlockNodePorts":    newEmpty,
+	grpcServicePrefix + "AddQdisc":          newEmpty,
+	grpcServicePrefix + "AddEmatchFilter":   newEmpty,
+	grpcServicePrefix + "FlushIpSet":        newEmpty,
+	grpcServicePrefix + "FlushIptables":     newEmpty,
+	grpcServicePrefix + "SetTimeOffset":     newEmpty,
+	grpcServicePrefix + "SetTimerSlack":     newEmpty,
+	grpcServicePrefix + "ContainerKill":     newEmpty,
+	grpcServicePrefix + "SandboxKill":       newEmpty,
+	grpcServicePrefix + "ExhaustResources":  newEmpty,
+	grpcServicePrefix + "CorruptConfig":     newEmpty,
+	grpcServicePrefix + "SetDBFault":        newEmpty,
+	grpcServicePrefix + "ExecStressors":     func() proto.Message { return &pb.ExecStressResponse{} },
+	grpcServicePrefix + "SetConntrackLimit": func() proto.Message { return &pb.ConntrackResponse{} },
+}
+
+// recoveryMethods are the RPCs which recover the faults in containers
+var recoveryMethods = map[string]struct{}{
+	grpcServicePrefix + "DeleteNetem":          {},
+	grpcServicePrefix + "DeleteTbf":            {},
+	grpcServicePrefix + "DeleteBpfFault":       {},
+	grpcServicePrefix + "DeleteMtu":            {},
+	grpcServicePrefix + "UnblockNodePorts":     {},
+	grpcServicePrefix + "DelQdisc":             {},
+	grpcServicePrefix + "DelTcFilter":          {},
+	grpcServicePrefix + "RecoverTimeOffset":    {},
+	grpcServicePrefix + "ResetTimerSlack":      {},
+	grpcServicePrefix + "DeleteConntrackLimit": {},
+	grpcServicePrefix + "RecoverResources":     {},
+	grpcServicePrefix + "RecoverConfig":        {},
+	grpcServicePrefix + "DeleteDBFault":        {},
+}
+
+func newEmpty() proto.Message {
+	return &empty.Empty{}
+}
+
+// JournalEntry records an injection performed on behalf of an experiment
+type JournalEntry struct {
+	UID        string   `json:"uid"`
+	Method     string   `json:"method"`
+	Containers []string `json:"containers"`
+	// Target identifies the fault in the containers. The faults which are set as a whole,
+	// such as the netem of a container or the iptables rule of an ipset, are identified by their names,
+	// so a later injection overrides the earlier one. Others are identified by the requests.
+	Target string `json:"target"`
+	// Digest is the digest of the request
+	Digest string `json:"digest"`
+	// Response is the encoded response which is replayed if the injection is requested again
+	Response []byte    `json:"response,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+func (e *JournalEntry) key() string {
+	return strings.Join([]string{e.UID, e.Method, strings.Join(e.Containers, ","), e.Target}, "/")
+}
+
+func newJournalEntry(uid, method string, req proto.Message) (*JournalEntry, error) {
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	digest := hex.EncodeToString(sum[:])
+
+	target := digest
+	switch r := req.(type) {
+	case *pb.NetemRequest, *pb.TbfRequest, *pb.TimeRequest, *pb.BpfFaultRequest, *pb.ConntrackRequest,
+		*pb.MtuRequest, *pb.ExhaustRequest, *pb.TimerSlackRequest:
+		target = ""
+	case *pb.IpTablesRequest:
+		target = "rule/" + r.GetRule().GetDirection().String() + "/" + r.GetRule().GetSet()
+	case *pb.IpSetRequest:
+		target = "ipset/" + r.GetIpset().GetName()
+	case *pb.DBFaultRequest:
+		target = "db/" + strconv.Itoa(int(r.GetPort()))
+	}
+
+	return &JournalEntry{
+		UID:        uid,
+		Method:     method,
+		Containers: requestedContainers(req),
+		Target:     target,
+		Digest:     digest,
+		Time:       time.Now(),
+	}, nil
+}
+
+// journal is the transaction log of the injections. It makes the injections idempotent:
+// an injection which has been performed for the same experiment is replayed from the journal
+// instead of being performed again. The journal is persisted, so chaos-daemon keeps track
+// of the faults it owns after restart.
+type journal struct {
+	sync.Mutex
+
+	// path is the file which the journal is persisted to, the journal is kept in memory if it's empty
+	path    string
+	entries map[string]*JournalEntry
+}
+
+// newJournal loads the journal from path
+func newJournal(path string) (*journal, error) {
+	j := &journal{
+		path:    path,
+		entries: make(map[string]*JournalEntry),
+	}
+	if path == "" {
+		return j, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return j, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*JournalEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		j.entries[e.key()] = e
+	}
+	return j, nil
+}
+
+// Entries returns the entries sorted by time
+func (j *journal) Entries() []*JournalEntry {
+	j.Lock()
+	defer j.Unlock()
+
+	return j.sortedEntries()
+}
+
+func (j *journal) sortedEntries() []*JournalEntry {
+	entries := make([]*JournalEntry, 0, len(j.entries))
+	for _, e := range j.entries {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(a, b int) bool {
+		if !entries[a].Time.Equal(entries[b].Time) {
+			return entries[a].Time.Before(entries[b].Time)
+		}
+		return entries[a].key() < entries[b].key()
+	})
+	return entries
+}
+
+func (j *journal) lookup(e *JournalEntry) *JournalEntry {
+	j.Lock()
+	defer j.Unlock()
+
+	recorded, ok := j.entries[e.key()]
+	if !ok || recorded.Digest != e.Digest {
+		return nil
+	}
+	return recorded
+}
+
+func (j *journal) record(e *JournalEntry) error {
+	j.Lock()
+	defer j.Unlock()
+
+	j.entries[e.key()] = e
+	return j.save()
+}
+
+// forget removes the entries matched by match
+func (j *journal) forget(match func(e *JournalEntry) bool) error {
+	j.Lock()
+	defer j.Unlock()
+
+	removed := false
+	for key, e := range j.entries {
+		if match(e) {
+			delete(j.entries, key)
+			removed = true
+		}
+	}
+	if !removed {
+		return nil
+	}
+	return j.save()
+}
+
+// save persists the journal atomically
+func (j *journal) save() error {
+	if j.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(j.sortedEntries())
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(j.path), 0700); err != nil {
+		return err
+	}
+	tmp := j.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, j.path)
+}
+
+// ServeHTTP lists the entries of the journal in JSON, the entries are filtered by the uid of experiment
+// if the uid is in the query. The faults left on the node by an experiment are found by its uid.
+func (j *journal) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	uid := r.URL.Query().Get("uid")
+	entries := make([]*JournalEntry, 0)
+	for _, e := range j.Entries() {
+		if uid == "" || e.UID == uid {
+			entries = append(entries, e)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(entries); err != nil {
+		log.Error(err, "failed to write journal")
+	}
+}
+
+// UnaryServerInterceptor records the injections requested with the UID of experiment,
+// and replays the recorded response if the same injection is requested again.
+// The recovery of containers removes the records of the containers.
+func (j *journal) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+	uid := experimentUID(ctx)
+
+	if newResponse, ok := injectionMethods[info.FullMethod]; ok && uid != "" {
+		entry, err := newJournalEntry(uid, info.FullMethod, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		// the stress process may have exited, so it's performed again
+		if recorded := j.lookup(entry); recorded != nil && (recorded.Method != grpcServicePrefix+"ExecStressors" || stressAlive(recorded)) {
+			resp := newResponse()
+			if err := proto.Unmarshal(recorded.Response, resp); err == nil {
+				log.Info("injection has been performed, skip it", "uid", uid, "method", info.FullMethod, "containers", entry.Containers)
+				return resp, nil
+			}
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		if respMsg, ok := resp.(proto.Message); ok {
+			if entry.Response, err = proto.Marshal(respMsg); err != nil {
+				log.Error(err, "failed to encode response")
+			}
+		}
+		if err := j.record(entry); err != nil {
+			log.Error(err, "failed to record injection", "uid", uid, "method", info.FullMethod)
+		}
+		return resp, nil
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	var forgetErr error
+	switch r := req.(type) {
+	case *pb.CancelStressRequest:
+		forgetErr = j.forget(func(e *JournalEntry) bool {
+			return e.Method == grpcServicePrefix+"ExecStressors" && stressInstanceOf(e) == r.Instance
+		})
+	case *pb.CleanupRequest:
+		if !r.DryRun {
+			forgetErr = j.forget(func(e *JournalEntry) bool {
+				return overlaps(e.Containers, r.ContainerIds)
+			})
+		}
+	default:
+		if _, ok := recoveryMethods[info.FullMethod]; ok && uid != "" {
+			containers := requestedContainers(req)
+			forgetErr = j.forget(func(e *JournalEntry) bool {
+				return e.UID == uid && overlaps(e.Containers, containers)
+			})
+		}
+	}
+	if forgetErr != nil {
+		log.Error(forgetErr, "failed to remove the records of recovered faults", "method", info.FullMethod)
+	}
+
+	return resp, nil
+}
+
+// Reconcile removes the records of the faults which have gone while chaos-daemon was down,
+// i.e. the containers have been removed or the stress processes have exited
+func (j *journal) Reconcile(ctx context.Context, crClient ContainerRuntimeInfoClient) error {
+	alive := make(map[string]bool)
+	containerAlive := func(id string) bool {
+		if _, ok := alive[id]; !ok {
+			_, err := crClient.GetPidFromContainerID(ctx, id)
+			alive[id] = err == nil
+		}
+		return alive[id]
+	}
+
+	err := j.forget(func(e *JournalEntry) bool {
+		for _, id := range e.Containers {
+			if !containerAlive(id) {
+				return true
+			}
+		}
+		if e.Method == grpcServicePrefix+"ExecStressors" {
+			return !stressAlive(e)
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, e := range j.Entries() {
+		log.Info("fault is owned by chaos-daemon", "uid", e.UID, "method", e.Method, "containers", e.Containers)
+	}
+	return nil
+}
+
+func experimentUID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(pb.ExperimentUIDMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func stressResponse(e *JournalEntry) *pb.ExecStressResponse {
+	resp := &pb.ExecStressResponse{}
+	if err := proto.Unmarshal(e.Response, resp); err != nil {
+		return nil
+	}
+	return resp
+}
+
+func stressInstanceOf(e *JournalEntry) string {
+	if resp := stressResponse(e); resp != nil {
+		return resp.Instance
+	}
+	return ""
+}
+
+// stressAlive returns true if the stress process recorded in the entry is still running
+func stressAlive(e *JournalEntry) bool {
+	resp := stressResponse(e)
+	if resp == nil {
+		return false
+	}
+	pid, err := strconv.Atoi(resp.Instance)
+	if err != nil {
+		return false
+	}
+	p, err := process.NewProcess(int32(pid))
+	if err != nil {
+		return false
+	}
+	ct, err := p.CreateTime()
+	return err == nil && ct == resp.StartTime
+}
+
+func overlaps(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,209 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func experimentContext(uid string) context.Context {
+	return metadata.NewIncomingContext(context.TODO(), metadata.Pairs(pb.ExperimentUIDMetadataKey, uid))
+}
+
+// countingHandler counts the calls which reach the handler
+type countingHandler struct {
+	calls int
+	err   error
+}
+
+func (h *countingHandler) handle(ctx context.Context, req interface{}) (interface{}, error) {
+	h.calls++
+	if h.err != nil {
+		return nil, h.err
+	}
+	return &empty.Empty{}, nil
+}
+
+// aliveContainers is a container runtime which only has the containers in it
+type aliveContainers map[string]bool
+
+func (c aliveContainers) GetPidFromContainerID(ctx context.Context, containerID string) (uint32, error) {
+	if !c[containerID] {
+		return 0, errors.New("container not found")
+	}
+	return 1, nil
+}
+
+func (c aliveContainers) ContainerKillByContainerID(ctx context.Context, containerID string) error {
+	return nil
+}
+
+func (c aliveContainers) FormatContainerID(ctx context.Context, containerID string) (string, error) {
+	return containerID, nil
+}
+
+func call(j *journal, ctx context.Context, method string, req interface{}, h *countingHandler) error {
+	_, err := j.UnaryServerInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: grpcServicePrefix + method}, h.handle)
+	return err
+}
+
+var _ = Describe("journal", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "journal")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("should skip the injection performed for the same experiment", func() {
+		j, err := newJournal(filepath.Join(dir, "journal.json"))
+		Expect(err).To(BeNil())
+		h := &countingHandler{}
+
+		req := &pb.NetemRequest{ContainerId: "docker://c1", Netem: &pb.Netem{Time: 100}}
+		Expect(call(j, experimentContext("uid-1"), "SetNetem", req, h)).To(Succeed())
+		Expect(call(j, experimentContext("uid-1"), "SetNetem", req, h)).To(Succeed())
+		Expect(h.calls).To(Equal(1))
+
+		// another experiment or another request is performed
+		Expect(call(j, experimentContext("uid-2"), "SetNetem", req, h)).To(Succeed())
+		Expect(call(j, experimentContext("uid-1"), "SetNetem", &pb.NetemRequest{ContainerId: "docker://c1", Netem: &pb.Netem{Time: 200}}, h)).To(Succeed())
+		Expect(h.calls).To(Equal(3))
+
+		// the netem of a container is set as a whole, the earlier request is overridden
+		Expect(call(j, experimentContext("uid-1"), "SetNetem", req, h)).To(Succeed())
+		Expect(h.calls).To(Equal(4))
+
+		// the requests without experiment are always performed
+		Expect(call(j, context.TODO(), "SetNetem", req, h)).To(Succeed())
+		Expect(h.calls).To(Equal(5))
+	})
+
+	It("should perform the failover every time", func() {
+		j, err := newJournal("")
+		Expect(err).To(BeNil())
+		h := &countingHandler{}
+
+		// the scheduled chaos fails over the database in every run with the same request
+		req := &pb.DBFailoverRequest{ContainerId: "docker://c1", Protocol: "redis", Port: 6379}
+		Expect(call(j, experimentContext("uid-1"), "FailoverDB", req, h)).To(Succeed())
+		Expect(call(j, experimentContext("uid-1"), "FailoverDB", req, h)).To(Succeed())
+		Expect(h.calls).To(Equal(2))
+		Expect(j.Entries()).To(BeEmpty())
+	})
+
+	It("should not record the failed injection", func() {
+		j, err := newJournal("")
+		Expect(err).To(BeNil())
+		h := &countingHandler{err: errors.New("mocked error")}
+
+		req := &pb.QdiscRequest{ContainerId: "docker://c1", Qdisc: &pb.Qdisc{Type: "netem"}}
+		Expect(call(j, experimentContext("uid-1"), "AddQdisc", req, h)).ToNot(Succeed())
+		h.err = nil
+		Expect(call(j, experimentContext("uid-1"), "AddQdisc", req, h)).To(Succeed())
+		Expect(h.calls).To(Equal(2))
+		Expect(j.Entries()).To(HaveLen(1))
+	})
+
+	It("should list the entries of the experiment", func() {
+		j, err := newJournal("")
+		Expect(err).To(BeNil())
+		h := &countingHandler{}
+
+		Expect(call(j, experimentContext("uid-1"), "AddQdisc", &pb.QdiscRequest{ContainerId: "docker://c1"}, h)).To(Succeed())
+		Expect(call(j, experimentContext("uid-2"), "AddQdisc", &pb.QdiscRequest{ContainerId: "docker://c2"}, h)).To(Succeed())
+
+		rec := httptest.NewRecorder()
+		j.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/journal?uid=uid-2", nil))
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		var entries []*JournalEntry
+		Expect(json.Unmarshal(rec.Body.Bytes(), &entries)).To(Succeed())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].UID).To(Equal("uid-2"))
+		Expect(entries[0].Containers).To(Equal([]string{"docker://c2"}))
+
+		rec = httptest.NewRecorder()
+		j.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/journal", nil))
+		Expect(json.Unmarshal(rec.Body.Bytes(), &entries)).To(Succeed())
+		Expect(entries).To(HaveLen(2))
+	})
+
+	It("should forget the recovered containers", func() {
+		j, err := newJournal("")
+		Expect(err).To(BeNil())
+		h := &countingHandler{}
+
+		for _, id := range []string{"docker://c1", "docker://c2"} {
+			Expect(call(j, experimentContext("uid-1"), "AddQdisc", &pb.QdiscRequest{ContainerId: id}, h)).To(Succeed())
+		}
+		Expect(call(j, experimentContext("uid-1"), "SetTimeOffset", &pb.TimeRequest{ContainerId: "docker://c3"}, h)).To(Succeed())
+		Expect(j.Entries()).To(HaveLen(3))
+
+		Expect(call(j, experimentContext("uid-1"), "DelTcFilter", &pb.TcFilterRequest{ContainerId: "docker://c1"}, h)).To(Succeed())
+		Expect(j.Entries()).To(HaveLen(2))
+
+		Expect(call(j, context.TODO(), "Cleanup", &pb.CleanupRequest{ContainerIds: []string{"docker://c2"}, DryRun: true}, h)).To(Succeed())
+		Expect(j.Entries()).To(HaveLen(2))
+		Expect(call(j, context.TODO(), "Cleanup", &pb.CleanupRequest{ContainerIds: []string{"docker://c2"}}, h)).To(Succeed())
+		Expect(j.Entries()).To(HaveLen(1))
+
+		// the injection is performed again after recovery
+		Expect(call(j, experimentContext("uid-1"), "AddQdisc", &pb.QdiscRequest{ContainerId: "docker://c1"}, h)).To(Succeed())
+		Expect(h.calls).To(Equal(7))
+	})
+
+	It("should be persisted and reconciled after restart", func() {
+		path := filepath.Join(dir, "journal.json")
+		j, err := newJournal(path)
+		Expect(err).To(BeNil())
+		h := &countingHandler{}
+
+		Expect(call(j, experimentContext("uid-1"), "SetTbf", &pb.TbfRequest{ContainerId: "containerd://alive"}, h)).To(Succeed())
+		Expect(call(j, experimentContext("uid-1"), "SetTbf", &pb.TbfRequest{ContainerId: "containerd://gone"}, h)).To(Succeed())
+
+		restarted, err := newJournal(path)
+		Expect(err).To(BeNil())
+		Expect(restarted.Entries()).To(HaveLen(2))
+
+		c := aliveContainers{"containerd://alive": true}
+		Expect(restarted.Reconcile(context.TODO(), c)).To(Succeed())
+
+		entries := restarted.Entries()
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Containers).To(Equal([]string{"containerd://alive"}))
+
+		Expect(call(restarted, experimentContext("uid-1"), "SetTbf", &pb.TbfRequest{ContainerId: "containerd://alive"}, h)).To(Succeed())
+		Expect(h.calls).To(Equal(2))
+	})
+})
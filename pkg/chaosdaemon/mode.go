@@ -0,0 +1,161 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// capabilityNames are the names of the linux capabilities indexed by their bits
+var capabilityNames = []string{
+	"CAP_CHOWN", "CAP_DAC_OVERRIDE", "CAP_DAC_READ_SEARCH", "CAP_FOWNER", "CAP_FSETID",
+	"CAP_KILL", "CAP_SETGID", "CAP_SETUID", "CAP_SETPCAP", "CAP_LINUX_IMMUTABLE",
+	"CAP_NET_BIND_SERVICE", "CAP_NET_BROADCAST", "CAP_NET_ADMIN", "CAP_NET_RAW", "CAP_IPC_LOCK",
+	"CAP_IPC_OWNER", "CAP_SYS_MODULE", "CAP_SYS_RAWIO", "CAP_SYS_CHROOT", "CAP_SYS_PTRACE",
+	"CAP_SYS_PACCT", "CAP_SYS_ADMIN", "CAP_SYS_BOOT", "CAP_SYS_NICE", "CAP_SYS_RESOURCE",
+	"CAP_SYS_TIME", "CAP_SYS_TTY_CONFIG", "CAP_MKNOD", "CAP_LEASE", "CAP_AUDIT_WRITE",
+	"CAP_AUDIT_CONTROL", "CAP_SETFCAP", "CAP_MAC_OVERRIDE", "CAP_MAC_ADMIN", "CAP_SYSLOG",
+	"CAP_WAKE_ALARM", "CAP_BLOCK_SUSPEND", "CAP_AUDIT_READ", "CAP_PERFMON", "CAP_BPF",
+	"CAP_CHECKPOINT_RESTORE",
+}
+
+// methodCapabilities are the linux capabilities which the methods require in the least-privilege
+// mode. The methods which aren't listed, such as the stressors which write the cgroups of the host,
+// are only supported in the privileged mode.
+var methodCapabilities = map[string][]string{
+	"SetNetem":           utils.NetworkCapabilities,
+	"DeleteNetem":        utils.NetworkCapabilities,
+	"SetTbf":             utils.NetworkCapabilities,
+	"DeleteTbf":          utils.NetworkCapabilities,
+	"AddQdisc":           utils.NetworkCapabilities,
+	"DelQdisc":           utils.NetworkCapabilities,
+	"AddEmatchFilter":    utils.NetworkCapabilities,
+	"DelTcFilter":        utils.NetworkCapabilities,
+	"FlushIpSet":         utils.NetworkCapabilities,
+	"FlushIptables":      utils.NetworkCapabilities,
+	"BatchSetNetem":      utils.NetworkCapabilities,
+	"BatchDeleteNetem":   utils.NetworkCapabilities,
+	"BatchSetTbf":        utils.NetworkCapabilities,
+	"BatchDeleteTbf":     utils.NetworkCapabilities,
+	"BatchFlushIpSet":    utils.NetworkCapabilities,
+	"BatchFlushIptables": utils.NetworkCapabilities,
+	"Cleanup":            utils.NetworkCapabilities,
+	"SetBpfFault":        utils.NetworkCapabilities,
+	"DeleteBpfFault":     utils.NetworkCapabilities,
+	"SetMtu":             utils.NetworkCapabilities,
+	"DeleteMtu":          utils.NetworkCapabilities,
+	"SetDBFault":         utils.NetworkCapabilities,
+	"DeleteDBFault":      utils.NetworkCapabilities,
+	"SetTimeOffset":      utils.TimeCapabilities,
+	"RecoverTimeOffset":  utils.TimeCapabilities,
+	// the containers are killed and found through the socket of the container runtime
+	"ContainerKill":   nil,
+	"ContainerGetPid": nil,
+	// the processes of containers run as other users
+	"ContainerSignal": {"CAP_KILL"},
+	// the database is connected in the network namespace of the container
+	"FailoverDB":      {utils.CapSysAdmin, utils.CapSysPtrace},
+	"GetCapabilities": nil,
+}
+
+// effectiveCapabilities reads the effective linux capabilities of the process from its status file
+func effectiveCapabilities(procPath string) ([]string, error) {
+	f, err := os.Open(filepath.Join(procPath, "self/status"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || fields[0] != "CapEff:" {
+			continue
+		}
+
+		bits, err := strconv.ParseUint(fields[1], 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid effective capabilities %s: %v", fields[1], err)
+		}
+		var capabilities []string
+		for i, name := range capabilityNames {
+			if bits&(1<<uint(i)) != 0 {
+				capabilities = append(capabilities, name)
+			}
+		}
+		return capabilities, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("effective capabilities aren't found in the status of process")
+}
+
+// modeGuard rejects the requests which need more privileges than the mode of chaos-daemon allows,
+// so that the actions fail with a clear reason instead of failing in the middle of injection
+type modeGuard struct {
+	mode         string
+	capabilities []string
+}
+
+func newModeGuard(mode string, capabilities []string) (*modeGuard, error) {
+	switch mode {
+	case utils.DaemonModePrivileged, utils.DaemonModeLeastPrivilege:
+	default:
+		return nil, fmt.Errorf("unknown mode %s, it should be %s or %s", mode, utils.DaemonModePrivileged, utils.DaemonModeLeastPrivilege)
+	}
+
+	return &modeGuard{mode: mode, capabilities: capabilities}, nil
+}
+
+// check returns an error if the method isn't allowed in the mode
+func (g *modeGuard) check(fullMethod string) error {
+	if g.mode == utils.DaemonModePrivileged {
+		return nil
+	}
+
+	method := path.Base(fullMethod)
+	required, ok := methodCapabilities[method]
+	if !ok {
+		return fmt.Errorf("%s requires chaos-daemon in %s mode, but it runs in %s mode", method, utils.DaemonModePrivileged, g.mode)
+	}
+	if missing := utils.MissingCapabilities(g.capabilities, required); len(missing) > 0 {
+		return fmt.Errorf("%s requires linux capabilities %v which chaos-daemon doesn't have in %s mode", method, missing, g.mode)
+	}
+	return nil
+}
+
+// UnaryServerInterceptor rejects the methods which aren't allowed in the mode with FailedPrecondition
+func (g *modeGuard) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := g.check(info.FullMethod); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return handler(ctx, req)
+}
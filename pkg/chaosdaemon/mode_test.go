@@ -0,0 +1,88 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+var _ = Describe("mode", func() {
+	Context("effectiveCapabilities", func() {
+		It("should parse the effective capabilities", func() {
+			root, err := ioutil.TempDir("", "mode")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(root)
+
+			Expect(os.MkdirAll(filepath.Join(root, "self"), 0755)).To(Succeed())
+			// CAP_NET_ADMIN, CAP_SYS_PTRACE and CAP_SYS_ADMIN
+			status := "Name:\tchaos-daemon\nCapInh:\t0000000000000000\nCapEff:\t0000000000281000\n"
+			Expect(ioutil.WriteFile(filepath.Join(root, "self/status"), []byte(status), 0644)).To(Succeed())
+
+			capabilities, err := effectiveCapabilities(root)
+			Expect(err).To(BeNil())
+			Expect(capabilities).To(Equal([]string{utils.CapNetAdmin, utils.CapSysPtrace, utils.CapSysAdmin}))
+		})
+
+		It("should return error if the status is missing", func() {
+			_, err := effectiveCapabilities("/nonexistent")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("modeGuard", func() {
+		It("should reject unknown mode", func() {
+			_, err := newModeGuard("rootless", nil)
+			Expect(err).To(MatchError(ContainSubstring("unknown mode rootless")))
+		})
+
+		It("should allow every method in privileged mode", func() {
+			guard, err := newModeGuard(utils.DaemonModePrivileged, nil)
+			Expect(err).To(BeNil())
+			Expect(guard.check("/chaosdaemon.ChaosDaemon/ExecStressors")).To(Succeed())
+		})
+
+		It("should check the methods in least-privilege mode", func() {
+			guard, err := newModeGuard(utils.DaemonModeLeastPrivilege, []string{utils.CapSysPtrace})
+			Expect(err).To(BeNil())
+
+			Expect(guard.check("/chaosdaemon.ChaosDaemon/SetTimeOffset")).To(Succeed())
+			Expect(guard.check("/chaosdaemon.ChaosDaemon/ContainerKill")).To(Succeed())
+			Expect(guard.check("/chaosdaemon.ChaosDaemon/BatchSetNetem")).To(MatchError(
+				"BatchSetNetem requires linux capabilities [CAP_NET_ADMIN CAP_SYS_ADMIN] which chaos-daemon doesn't have in least-privilege mode"))
+			Expect(guard.check("/chaosdaemon.ChaosDaemon/ExecStressors")).To(MatchError(
+				"ExecStressors requires chaos-daemon in privileged mode, but it runs in least-privilege mode"))
+
+			called := false
+			_, err = guard.UnaryServerInterceptor(context.TODO(), nil,
+				&grpc.UnaryServerInfo{FullMethod: "/chaosdaemon.ChaosDaemon/ExecStressors"},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					called = true
+					return nil, nil
+				})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			Expect(called).To(BeFalse())
+		})
+	})
+})
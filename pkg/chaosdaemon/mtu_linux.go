@@ -0,0 +1,123 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+// setMtu sets the mtu of the network interfaces matching device, the original mtus
+// of the interfaces which aren't in originals are recorded before they're changed
+func setMtu(pid uint32, device string, mtu int, originals map[string]int) error {
+	// Mock point to return error in unit test
+	if err := mock.On("MtuSetError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			return nil
+		}
+	}
+
+	handle, err := netlinkHandleOf(pid)
+	if err != nil {
+		return err
+	}
+	defer handle.Delete()
+
+	links, err := matchLinks(handle, device)
+	if err != nil {
+		log.Error(err, "failed to find network interfaces", "device", device)
+		return err
+	}
+
+	for _, link := range links {
+		name := link.Attrs().Name
+		if _, ok := originals[name]; !ok {
+			originals[name] = link.Attrs().MTU
+		}
+		log.Info("Set mtu", "device", name, "mtu", mtu, "original", originals[name])
+		if err := handle.LinkSetMTU(link, mtu); err != nil {
+			log.Error(err, "failed to set mtu", "device", name, "mtu", mtu)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreMtu restores the mtus of the network interfaces, the interfaces are removed
+// from originals once they're restored
+func restoreMtu(pid uint32, originals map[string]int) error {
+	// Mock point to return error in unit test
+	if err := mock.On("MtuRestoreError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			for name := range originals {
+				delete(originals, name)
+			}
+			return nil
+		}
+	}
+
+	handle, err := netlinkHandleOf(pid)
+	if err != nil {
+		return err
+	}
+	defer handle.Delete()
+
+	for name, mtu := range originals {
+		link, err := handle.LinkByName(name)
+		if err != nil {
+			// the interface has been removed with its mtu
+			if _, ok := err.(netlink.LinkNotFoundError); ok {
+				delete(originals, name)
+				continue
+			}
+			log.Error(err, "failed to find network interface", "device", name)
+			return err
+		}
+
+		log.Info("Restore mtu", "device", name, "mtu", mtu)
+		if err := handle.LinkSetMTU(link, mtu); err != nil {
+			log.Error(err, "failed to restore mtu", "device", name, "mtu", mtu)
+			return err
+		}
+		delete(originals, name)
+	}
+
+	return nil
+}
+
+// netlinkHandleOf returns the netlink handle at the network namespace of the process
+func netlinkHandleOf(pid uint32) (*netlink.Handle, error) {
+	ns, err := netns.GetFromPath(GetNsPath(pid, netNS))
+	if err != nil {
+		log.Error(err, "failed to find network namespace", "pid", pid)
+		return nil, err
+	}
+	defer ns.Close()
+
+	handle, err := netlink.NewHandleAt(ns)
+	if err != nil {
+		log.Error(err, "failed to get handle at network namespace", "network namespace", ns)
+		return nil, err
+	}
+	return handle, nil
+}
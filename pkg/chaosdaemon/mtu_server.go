@@ -0,0 +1,100 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+const (
+	// minMtu is the least mtu which IPv4 requires
+	minMtu = 68
+	maxMtu = 65535
+)
+
+// mtuOriginals are the original mtus of the network interfaces of the containers,
+// which are restored when the mtus of the containers are deleted
+type mtuOriginals struct {
+	sync.Mutex
+
+	devices map[string]map[string]int
+}
+
+func (s *daemonServer) SetMtu(ctx context.Context, in *pb.MtuRequest) (*empty.Empty, error) {
+	log.Info("Set mtu", "Request", in)
+
+	if in.Mtu < minMtu || in.Mtu > maxMtu {
+		return nil, status.Errorf(codes.InvalidArgument, "mtu %d is out of range [%d, %d]", in.Mtu, minMtu, maxMtu)
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, in.ContainerId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
+	}
+
+	s.mtus.Lock()
+	defer s.mtus.Unlock()
+
+	// the mtus which have been changed by the earlier injection aren't recorded as the originals again
+	originals := s.mtus.devices[in.ContainerId]
+	if originals == nil {
+		originals = make(map[string]int)
+	}
+	err = setMtu(pid, in.Device, int(in.Mtu), originals)
+	if len(originals) > 0 {
+		if s.mtus.devices == nil {
+			s.mtus.devices = make(map[string]map[string]int)
+		}
+		s.mtus.devices[in.ContainerId] = originals
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "mtu set error: %v", err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *daemonServer) DeleteMtu(ctx context.Context, in *pb.MtuRequest) (*empty.Empty, error) {
+	log.Info("Delete mtu", "Request", in)
+
+	s.mtus.Lock()
+	defer s.mtus.Unlock()
+
+	originals := s.mtus.devices[in.ContainerId]
+	if len(originals) == 0 {
+		return &empty.Empty{}, nil
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, in.ContainerId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
+	}
+
+	err = restoreMtu(pid, originals)
+	if len(originals) == 0 {
+		delete(s.mtus.devices, in.ContainerId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "mtu restore error: %v", err)
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+var _ = Describe("mtu server", func() {
+	defer mock.With("MockContainerdClient", &MockClient{})()
+	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
+
+	Context("SetMtu", func() {
+		It("should work", func() {
+			s := &daemonServer{crClient: c}
+			const ignore = true
+			defer mock.With("MtuSetError", ignore)()
+			_, err := s.SetMtu(context.TODO(), &pb.MtuRequest{
+				ContainerId: "containerd://container-id",
+				Mtu:         1200,
+			})
+			Expect(err).To(BeNil())
+		})
+
+		It("should fail on invalid mtu", func() {
+			s := &daemonServer{crClient: c}
+			_, err := s.SetMtu(context.TODO(), &pb.MtuRequest{
+				ContainerId: "containerd://container-id",
+				Mtu:         60,
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("should fail on setMtu", func() {
+			s := &daemonServer{crClient: c}
+			const errorStr = "mock error on setMtu()"
+			defer mock.With("MtuSetError", errors.New(errorStr))()
+			_, err := s.SetMtu(context.TODO(), &pb.MtuRequest{
+				ContainerId: "containerd://container-id",
+				Mtu:         1200,
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(errorStr))
+		})
+	})
+
+	Context("DeleteMtu", func() {
+		It("should restore the original mtus", func() {
+			s := &daemonServer{crClient: c}
+			s.mtus.devices = map[string]map[string]int{
+				"containerd://container-id": {"eth0": 1500},
+			}
+			const ignore = true
+			defer mock.With("MtuRestoreError", ignore)()
+			_, err := s.DeleteMtu(context.TODO(), &pb.MtuRequest{
+				ContainerId: "containerd://container-id",
+			})
+			Expect(err).To(BeNil())
+			Expect(s.mtus.devices).To(BeEmpty())
+		})
+
+		It("should keep the original mtus on restoreMtu error", func() {
+			s := &daemonServer{crClient: c}
+			s.mtus.devices = map[string]map[string]int{
+				"containerd://container-id": {"eth0": 1500},
+			}
+			const errorStr = "mock error on restoreMtu()"
+			defer mock.With("MtuRestoreError", errors.New(errorStr))()
+			_, err := s.DeleteMtu(context.TODO(), &pb.MtuRequest{
+				ContainerId: "containerd://container-id",
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(errorStr))
+			Expect(s.mtus.devices).To(HaveKey("containerd://container-id"))
+		})
+
+		It("should ignore the containers whose mtus aren't changed", func() {
+			s := &daemonServer{crClient: c}
+			_, err := s.DeleteMtu(context.TODO(), &pb.MtuRequest{
+				ContainerId: "containerd://container-id",
+			})
+			Expect(err).To(BeNil())
+		})
+	})
+})
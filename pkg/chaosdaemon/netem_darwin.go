@@ -18,7 +18,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
 )
 
-func applyNetem(netem *pb.Netem, pid uint32) error {
+func applyNetem(netem *pb.Netem, pid uint32, device string) error {
 	// Mock point to return error in unit test
 	if err := mock.On("NetemApplyError"); err != nil {
 		if e, ok := err.(error); ok {
@@ -31,7 +31,20 @@ func applyNetem(netem *pb.Netem, pid uint32) error {
 	panic("unimplemented")
 }
 
-func deleteNetem(netem *pb.Netem, pid uint32) error {
+func changeNetem(netem *pb.Netem, pid uint32, device string) error {
+	// Mock point to return error in unit test
+	if err := mock.On("NetemChangeError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			return nil
+		}
+	}
+	panic("unimplemented")
+}
+
+func deleteNetem(netem *pb.Netem, pid uint32, device string) error {
 	// Mock point to return error in unit test
 	if err := mock.On("NetemCancelError"); err != nil {
 		if e, ok := err.(error); ok {
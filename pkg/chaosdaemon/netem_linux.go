@@ -20,7 +20,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
 )
 
-func applyNetem(netem *pb.Netem, pid uint32) error {
+func applyNetem(netem *pb.Netem, pid uint32, device string) error {
 	// Mock point to return error in unit test
 	if err := mock.On("NetemApplyError"); err != nil {
 		if e, ok := err.(error); ok {
@@ -33,7 +33,7 @@ func applyNetem(netem *pb.Netem, pid uint32) error {
 
 	p, h := buildHandles(netem)
 
-	return applyQdisc(pid, func(handle *netlink.Handle, link netlink.Link) netlink.Qdisc {
+	return applyQdisc(pid, device, func(handle *netlink.Handle, link netlink.Link) netlink.Qdisc {
 		return netlink.NewNetem(netlink.QdiscAttrs{
 			LinkIndex: link.Attrs().Index,
 			Handle:    h,
@@ -42,7 +42,29 @@ func applyNetem(netem *pb.Netem, pid uint32) error {
 	})
 }
 
-func deleteNetem(netem *pb.Netem, pid uint32) error {
+func changeNetem(netem *pb.Netem, pid uint32, device string) error {
+	// Mock point to return error in unit test
+	if err := mock.On("NetemChangeError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			return nil
+		}
+	}
+
+	p, h := buildHandles(netem)
+
+	return changeQdisc(pid, device, func(handle *netlink.Handle, link netlink.Link) netlink.Qdisc {
+		return netlink.NewNetem(netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    h,
+			Parent:    p,
+		}, ToNetlinkNetemAttrs(netem))
+	})
+}
+
+func deleteNetem(netem *pb.Netem, pid uint32, device string) error {
 	// Mock point to return error in unit test
 	if err := mock.On("NetemCancelError"); err != nil {
 		if e, ok := err.(error); ok {
@@ -55,7 +77,7 @@ func deleteNetem(netem *pb.Netem, pid uint32) error {
 
 	p, h := buildHandles(netem)
 
-	return deleteQdisc(pid, func(handle *netlink.Handle, link netlink.Link) netlink.Qdisc {
+	return deleteQdisc(pid, device, func(handle *netlink.Handle, link netlink.Link) netlink.Qdisc {
 		return &netlink.Netem{
 			QdiscAttrs: netlink.QdiscAttrs{
 				LinkIndex: link.Attrs().Index,
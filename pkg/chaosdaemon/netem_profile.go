@@ -0,0 +1,107 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// netemProfiles are the netem profiles being replayed, keyed by the container and the device
+type netemProfiles struct {
+	sync.Mutex
+	cancels map[string]context.CancelFunc
+}
+
+func profileKey(containerID, device string) string {
+	return containerID + "/" + device
+}
+
+// start stops the profile being replayed with the same key and replays the new one in background
+func (p *netemProfiles) start(key string, replay func(ctx context.Context)) {
+	p.Lock()
+	defer p.Unlock()
+
+	if cancel, ok := p.cancels[key]; ok {
+		cancel()
+	}
+	if p.cancels == nil {
+		p.cancels = make(map[string]context.CancelFunc)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancels[key] = cancel
+	go replay(ctx)
+}
+
+// stop stops replaying the profile, it returns false if there isn't any
+func (p *netemProfiles) stop(key string) bool {
+	p.Lock()
+	defer p.Unlock()
+
+	cancel, ok := p.cancels[key]
+	if ok {
+		cancel()
+		delete(p.cancels, key)
+	}
+	return ok
+}
+
+// sampleNetem returns the netem of the sample with the handles of base
+func sampleNetem(base *pb.Netem, sample *pb.NetemSample) *pb.Netem {
+	netem := &pb.Netem{}
+	if sample.Netem != nil {
+		netem = proto.Clone(sample.Netem).(*pb.Netem)
+	}
+	if base != nil {
+		netem.Parent = base.Parent
+		netem.Handle = base.Handle
+	}
+	return netem
+}
+
+// replayNetemProfile changes the netem at the offset of every sample except the first one, which
+// has been applied already. It returns when the profile ends or ctx is done.
+func replayNetemProfile(ctx context.Context, profile *pb.NetemProfile, base *pb.Netem, change func(*pb.Netem) error) {
+	start := time.Now()
+	for round := 0; ; round++ {
+		for i, sample := range profile.Samples {
+			if round == 0 && i == 0 {
+				continue
+			}
+
+			timer := time.NewTimer(time.Until(start.Add(time.Duration(sample.OffsetMs) * time.Millisecond)))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			if err := change(sampleNetem(base, sample)); err != nil {
+				log.Error(err, "failed to replay netem profile", "sample", sample)
+			}
+		}
+
+		if !profile.Loop || profile.PeriodMs <= 0 {
+			return
+		}
+		start = start.Add(time.Duration(profile.PeriodMs) * time.Millisecond)
+	}
+}
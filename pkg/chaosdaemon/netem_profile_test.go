@@ -0,0 +1,106 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+var _ = Describe("netem profile", func() {
+	defer mock.With("MockContainerdClient", &MockClient{})()
+	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
+
+	handle := &pb.TcHandle{Major: 1, Minor: 0}
+	profile := &pb.NetemProfile{
+		Samples: []*pb.NetemSample{
+			{OffsetMs: 0, Netem: &pb.Netem{Time: 100000}},
+			{OffsetMs: 10, Netem: &pb.Netem{Time: 200000}},
+			{OffsetMs: 20, Netem: &pb.Netem{Loss: 25}},
+		},
+		PeriodMs: 30,
+	}
+
+	Context("replayNetemProfile", func() {
+		It("should change netem with the handles of base", func() {
+			var changed []*pb.Netem
+			replayNetemProfile(context.TODO(), profile, &pb.Netem{Handle: handle}, func(netem *pb.Netem) error {
+				changed = append(changed, netem)
+				return nil
+			})
+			Expect(changed).To(HaveLen(2))
+			Expect(changed[0].Time).To(Equal(uint32(200000)))
+			Expect(changed[0].Handle).To(Equal(handle))
+			Expect(changed[1].Loss).To(Equal(float32(25)))
+		})
+
+		It("should replay again in loop until it's stopped", func() {
+			loop := *profile
+			loop.Loop = true
+
+			var lock sync.Mutex
+			changed := 0
+			ctx, cancel := context.WithCancel(context.TODO())
+			done := make(chan struct{})
+			go func() {
+				replayNetemProfile(ctx, &loop, nil, func(netem *pb.Netem) error {
+					lock.Lock()
+					defer lock.Unlock()
+					changed++
+					return nil
+				})
+				close(done)
+			}()
+
+			Eventually(func() int {
+				lock.Lock()
+				defer lock.Unlock()
+				return changed
+			}, time.Second).Should(BeNumerically(">", 3))
+			cancel()
+			Eventually(done, time.Second).Should(BeClosed())
+		})
+	})
+
+	Context("SetNetem", func() {
+		It("should stop replaying the profile on DeleteNetem", func() {
+			defer mock.With("NetemApplyError", true)()
+			defer mock.With("NetemChangeError", true)()
+			defer mock.With("NetemCancelError", true)()
+
+			s := &daemonServer{crClient: c}
+			loop := *profile
+			loop.Loop = true
+			_, err := s.SetNetem(context.TODO(), &pb.NetemRequest{
+				ContainerId: "containerd://container-id",
+				Profile:     &loop,
+			})
+			Expect(err).To(BeNil())
+			Expect(s.profiles.cancels).To(HaveKey(profileKey("containerd://container-id", "")))
+
+			_, err = s.DeleteNetem(context.TODO(), &pb.NetemRequest{
+				ContainerId: "containerd://container-id",
+			})
+			Expect(err).To(BeNil())
+			Expect(s.profiles.cancels).To(BeEmpty())
+		})
+	})
+})
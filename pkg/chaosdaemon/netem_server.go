@@ -33,23 +33,44 @@ func (s *daemonServer) SetNetem(ctx context.Context, in *pb.NetemRequest) (*empt
 		return nil, status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
 	}
 
-	if err := applyNetem(in.Netem, pid); err != nil {
+	key := profileKey(in.ContainerId, in.Device)
+	s.profiles.stop(key)
+
+	netem := in.Netem
+	if len(in.Profile.GetSamples()) > 0 {
+		netem = sampleNetem(in.Netem, in.Profile.Samples[0])
+	}
+	if err := applyNetem(netem, pid, in.Device); err != nil {
 		return nil, status.Errorf(codes.Internal, "netem apply error: %v", err)
 	}
 
+	if len(in.Profile.GetSamples()) > 1 {
+		log.Info("Replay netem profile", "samples", len(in.Profile.Samples), "loop", in.Profile.Loop)
+		s.profiles.start(key, func(ctx context.Context) {
+			replayNetemProfile(ctx, in.Profile, in.Netem, func(netem *pb.Netem) error {
+				return changeNetem(netem, pid, in.Device)
+			})
+		})
+	}
+
 	return &empty.Empty{}, nil
 }
 
 func (s *daemonServer) DeleteNetem(ctx context.Context, in *pb.NetemRequest) (*empty.Empty, error) {
 	log.Info("Delete netem", "Request", in)
 
+	// the profile is stopped before the netem is deleted, so that it won't be changed any more
+	if s.profiles.stop(profileKey(in.ContainerId, in.Device)) {
+		log.Info("Stop replaying netem profile", "containerID", in.ContainerId)
+	}
+
 	pid, err := s.crClient.GetPidFromContainerID(ctx, in.ContainerId)
 
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
 	}
 
-	if err := deleteNetem(in.Netem, pid); err != nil {
+	if err := deleteNetem(in.Netem, pid, in.Device); err != nil {
 		return nil, status.Errorf(codes.Internal, "netem cancel error: %v", err)
 	}
 
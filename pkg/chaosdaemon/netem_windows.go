@@ -0,0 +1,303 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	dockerclient "github.com/docker/docker/client"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+// The latency on windows is injected on the WinNAT static mappings which publish the ports
+// of the container. The mappings are replaced by the relays of chaos-daemon, which listen on
+// the external ports and delay the data sent by the container, like the egress packets delayed
+// by netem on linux. The mappings are restored when the latency is deleted.
+
+// natMapping is a WinNAT static mapping, it's persisted so that it's able to be restored
+// after chaos-daemon restarts
+type natMapping struct {
+	NatName           string
+	StaticMappingID   int
+	Protocol          string
+	ExternalIPAddress string
+	ExternalPort      int
+	InternalIPAddress string
+	InternalPort      int
+}
+
+// natRelays are the relays of the containers by their pids
+var natRelays = struct {
+	sync.Mutex
+	relays map[uint32][]*delayRelay
+}{
+	relays: make(map[uint32][]*delayRelay),
+}
+
+func applyNetem(netem *pb.Netem, pid uint32, device string) error {
+	// Mock point to return error in unit test
+	if err := mock.On("NetemApplyError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			return nil
+		}
+	}
+
+	delay, jitter, err := natLatencyOf(netem)
+	if err != nil {
+		return err
+	}
+
+	natRelays.Lock()
+	defer natRelays.Unlock()
+	if relays, ok := natRelays.relays[pid]; ok {
+		for _, relay := range relays {
+			relay.setLatency(delay, jitter)
+		}
+		return nil
+	}
+
+	address, err := containerAddress(context.Background(), pid)
+	if err != nil {
+		return err
+	}
+	mappings, err := listNatMappings(address)
+	if err != nil {
+		return err
+	}
+	if len(mappings) == 0 {
+		return fmt.Errorf("no tcp ports of container %s are published by WinNAT", address)
+	}
+	if err := saveNatMappings(pid, mappings); err != nil {
+		return err
+	}
+
+	var relays []*delayRelay
+	for _, mapping := range mappings {
+		log.Info("Replace WinNAT static mapping with relay", "mapping", mapping)
+		if err := removeNatMapping(mapping); err != nil {
+			return err
+		}
+		listener, err := net.Listen("tcp", net.JoinHostPort(mapping.ExternalIPAddress, strconv.Itoa(mapping.ExternalPort)))
+		if err != nil {
+			log.Error(err, "failed to listen on the external port", "mapping", mapping)
+			return err
+		}
+		target := net.JoinHostPort(mapping.InternalIPAddress, strconv.Itoa(mapping.InternalPort))
+		relays = append(relays, newDelayRelay(listener, target, delay, jitter))
+		natRelays.relays[pid] = relays
+	}
+
+	return nil
+}
+
+func changeNetem(netem *pb.Netem, pid uint32, device string) error {
+	// Mock point to return error in unit test
+	if err := mock.On("NetemChangeError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			return nil
+		}
+	}
+
+	delay, jitter, err := natLatencyOf(netem)
+	if err != nil {
+		return err
+	}
+
+	natRelays.Lock()
+	defer natRelays.Unlock()
+	relays, ok := natRelays.relays[pid]
+	if !ok {
+		return fmt.Errorf("no latency is injected on the process %d", pid)
+	}
+	for _, relay := range relays {
+		relay.setLatency(delay, jitter)
+	}
+	return nil
+}
+
+func deleteNetem(netem *pb.Netem, pid uint32, device string) error {
+	// Mock point to return error in unit test
+	if err := mock.On("NetemCancelError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			return nil
+		}
+	}
+
+	natRelays.Lock()
+	defer natRelays.Unlock()
+	for _, relay := range natRelays.relays[pid] {
+		relay.Close()
+	}
+	delete(natRelays.relays, pid)
+
+	mappings, err := loadNatMappings(pid)
+	if err != nil {
+		return err
+	}
+	for _, mapping := range mappings {
+		log.Info("Restore WinNAT static mapping", "mapping", mapping)
+		if err := addNatMapping(mapping); err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(natMappingsPath(pid))
+}
+
+// natLatencyOf returns the latency of the netem, the other faults of netem can't be injected by relays
+func natLatencyOf(netem *pb.Netem) (time.Duration, time.Duration, error) {
+	if netem.Loss != 0 || netem.Duplicate != 0 || netem.Reorder != 0 || netem.Corrupt != 0 {
+		return 0, 0, fmt.Errorf("only delay is supported on windows")
+	}
+	if netem.Jitter > netem.Time {
+		return 0, 0, fmt.Errorf("jitter %dus is larger than delay %dus", netem.Jitter, netem.Time)
+	}
+
+	return time.Duration(netem.Time) * time.Microsecond, time.Duration(netem.Jitter) * time.Microsecond, nil
+}
+
+// containerAddress finds the address of the container by its pid from docker, which attaches
+// the containers to the WinNAT network. The containers of a pod share the network of its sandbox.
+func containerAddress(ctx context.Context, pid uint32) (string, error) {
+	client, err := dockerclient.NewClient(defaultDockerSocket, "", nil, nil)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	containers, err := client.ContainerList(ctx, types.ContainerListOptions{})
+	if err != nil {
+		return "", err
+	}
+	for _, container := range containers {
+		info, err := client.ContainerInspect(ctx, container.ID)
+		if err != nil || info.State == nil || info.State.Pid != int(pid) {
+			continue
+		}
+
+		if info.HostConfig != nil && info.HostConfig.NetworkMode.IsContainer() {
+			info, err = client.ContainerInspect(ctx, info.HostConfig.NetworkMode.ConnectedContainer())
+			if err != nil {
+				return "", err
+			}
+		}
+		if info.NetworkSettings != nil {
+			for _, network := range info.NetworkSettings.Networks {
+				if network.IPAddress != "" {
+					return network.IPAddress, nil
+				}
+			}
+		}
+		return "", fmt.Errorf("container %s isn't attached to any network", container.ID)
+	}
+
+	return "", fmt.Errorf("no container of process %d is found", pid)
+}
+
+// listNatMappings lists the tcp static mappings to the address
+func listNatMappings(address string) ([]natMapping, error) {
+	script := fmt.Sprintf(`ConvertTo-Json -Compress -InputObject @(Get-NetNatStaticMapping | `+
+		`Where-Object { $_.InternalIPAddress -eq '%s' -and "$($_.Protocol)" -eq 'TCP' } | `+
+		`Select-Object NatName,StaticMappingID,@{n='Protocol';e={"$($_.Protocol)"}},`+
+		`ExternalIPAddress,ExternalPort,InternalIPAddress,InternalPort)`, address)
+	out, err := powershell(script)
+	if err != nil {
+		return nil, err
+	}
+
+	var mappings []natMapping
+	if err := json.Unmarshal(out, &mappings); err != nil {
+		return nil, fmt.Errorf("failed to parse WinNAT static mappings: %v", err)
+	}
+	return mappings, nil
+}
+
+func removeNatMapping(mapping natMapping) error {
+	_, err := powershell(fmt.Sprintf("Remove-NetNatStaticMapping -NatName '%s' -StaticMappingID %d -Confirm:$false",
+		mapping.NatName, mapping.StaticMappingID))
+	return err
+}
+
+func addNatMapping(mapping natMapping) error {
+	_, err := powershell(fmt.Sprintf("Add-NetNatStaticMapping -NatName '%s' -Protocol %s -ExternalIPAddress %s "+
+		"-ExternalPort %d -InternalIPAddress %s -InternalPort %d | Out-Null",
+		mapping.NatName, mapping.Protocol, mapping.ExternalIPAddress, mapping.ExternalPort,
+		mapping.InternalIPAddress, mapping.InternalPort))
+	return err
+}
+
+func powershell(script string) ([]byte, error) {
+	out, err := exec.Command("powershell.exe", "-NoProfile", "-NonInteractive", "-Command", script).CombinedOutput()
+	if err != nil {
+		log.Error(err, "powershell command failed", "script", script, "output", string(out))
+		return nil, fmt.Errorf("%v: %s", err, out)
+	}
+	return out, nil
+}
+
+// natMappingsPath is the file which the replaced mappings of the process are persisted to
+func natMappingsPath(pid uint32) string {
+	return filepath.Join(os.Getenv("ProgramData"), "chaos-mesh", "winnat", fmt.Sprintf("%d.json", pid))
+}
+
+func saveNatMappings(pid uint32, mappings []natMapping) error {
+	path := natMappingsPath(pid)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(mappings)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// loadNatMappings loads the replaced mappings of the process, there are none if nothing is injected
+func loadNatMappings(pid uint32) ([]natMapping, error) {
+	data, err := ioutil.ReadFile(natMappingsPath(pid))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var mappings []natMapping
+	if err := json.Unmarshal(data, &mappings); err != nil {
+		return nil, err
+	}
+	return mappings, nil
+}
@@ -14,6 +14,8 @@
 package chaosdaemon
 
 import (
+	"fmt"
+	"path"
 	"strings"
 
 	"github.com/vishvananda/netlink"
@@ -22,7 +24,64 @@ import (
 
 type toQdiscFunc func(*netlink.Handle, netlink.Link) netlink.Qdisc
 
-func applyQdisc(pid uint32, toQdisc toQdiscFunc) error {
+// matchLinks returns the links whose names match the glob pattern of device
+func matchLinks(handle *netlink.Handle, device string) ([]netlink.Link, error) {
+	if device == "" {
+		device = defaultDevice
+	}
+
+	links, err := handle.LinkList()
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []netlink.Link
+	for _, link := range links {
+		ok, err := path.Match(device, link.Attrs().Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, link)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("no network interface matches %s", device)
+	}
+
+	return matched, nil
+}
+
+// matchDevices returns the names of the network interfaces which match the glob pattern
+// of device in the network namespace of the process
+func matchDevices(pid uint32, device string) ([]string, error) {
+	ns, err := netns.GetFromPath(GetNsPath(pid, netNS))
+	if err != nil {
+		log.Error(err, "failed to find network namespace", "pid", pid)
+		return nil, err
+	}
+	defer ns.Close()
+
+	handle, err := netlink.NewHandleAt(ns)
+	if err != nil {
+		log.Error(err, "failed to get handle at network namespace", "network namespace", ns)
+		return nil, err
+	}
+	defer handle.Delete()
+
+	links, err := matchLinks(handle, device)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]string, 0, len(links))
+	for _, link := range links {
+		devices = append(devices, link.Attrs().Name)
+	}
+	return devices, nil
+}
+
+func applyQdisc(pid uint32, device string, toQdisc toQdiscFunc) error {
 	log.Info("Apply qdisc on PID", "pid", pid)
 
 	ns, err := netns.GetFromPath(GetNsPath(pid, netNS))
@@ -38,28 +97,30 @@ func applyQdisc(pid uint32, toQdisc toQdiscFunc) error {
 		return err
 	}
 
-	link, err := handle.LinkByName("eth0") // TODO: check whether interface name is eth0
+	links, err := matchLinks(handle, device)
 	if err != nil {
-		log.Error(err, "failed to find eth0 interface")
+		log.Error(err, "failed to find network interfaces", "device", device)
 		return err
 	}
 
-	qdisc := toQdisc(handle, link)
+	for _, link := range links {
+		qdisc := toQdisc(handle, link)
 
-	log.Info("Add qdisc", "qdisc", qdisc)
-	if err = handle.QdiscAdd(qdisc); err != nil {
-		if !strings.Contains(err.Error(), "file exists") {
-			log.Error(err, "failed to add Qdisc", "qdisc", qdisc)
-			return err
+		log.Info("Add qdisc", "qdisc", qdisc, "device", link.Attrs().Name)
+		if err = handle.QdiscAdd(qdisc); err != nil {
+			if !strings.Contains(err.Error(), "file exists") {
+				log.Error(err, "failed to add Qdisc", "qdisc", qdisc)
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
-func deleteQdisc(pid uint32, toQdisc toQdiscFunc) error {
-	log.Info("Delete qdisc on PID", "pid", pid)
-
+// changeQdisc changes the parameters of the existing qdiscs, it fails rather than adding the qdiscs
+// if they have been deleted
+func changeQdisc(pid uint32, device string, toQdisc toQdiscFunc) error {
 	ns, err := netns.GetFromPath(GetNsPath(pid, netNS))
 	if err != nil {
 		log.Error(err, "failed to find network namespace", "pid", pid)
@@ -69,36 +130,72 @@ func deleteQdisc(pid uint32, toQdisc toQdiscFunc) error {
 
 	handle, err := netlink.NewHandleAt(ns)
 	if err != nil {
-		log.Error(err, "failed to create new handle at network namespace", "network namespace", ns)
+		log.Error(err, "failed to get handle at network namespace", "network namespace", ns)
 		return err
 	}
+	defer handle.Delete()
 
-	link, err := handle.LinkByName("eth0") // TODO: check whether interface name is eth0
+	links, err := matchLinks(handle, device)
 	if err != nil {
-		log.Error(err, "failed to find eth0 interface")
+		log.Error(err, "failed to find network interfaces", "device", device)
 		return err
 	}
 
-	qdisc := toQdisc(handle, link)
+	for _, link := range links {
+		qdisc := toQdisc(handle, link)
+		if err = handle.QdiscChange(qdisc); err != nil {
+			log.Error(err, "failed to change Qdisc", "qdisc", qdisc)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteQdisc(pid uint32, device string, toQdisc toQdiscFunc) error {
+	log.Info("Delete qdisc on PID", "pid", pid)
 
-	exist, err := qdiscExists(qdisc, handle, link)
+	ns, err := netns.GetFromPath(GetNsPath(pid, netNS))
 	if err != nil {
-		log.Error(err, "failed to check qdisc", "qdisc", qdisc, "link", link)
+		log.Error(err, "failed to find network namespace", "pid", pid)
 		return err
 	}
+	defer ns.Close()
 
-	if !exist {
-		log.Error(nil, "qdisc not exists, qdisc may be deleted by mistake or not injected successfully, there may be bugs here", "qdisc", qdisc)
-		return nil
+	handle, err := netlink.NewHandleAt(ns)
+	if err != nil {
+		log.Error(err, "failed to create new handle at network namespace", "network namespace", ns)
+		return err
 	}
 
-	log.Info("Remove qdisc", "qdisc", qdisc)
-	if err = handle.QdiscDel(qdisc); err != nil {
-		log.Error(err, "failed to remove qdisc", "qdisc", qdisc)
-
+	links, err := matchLinks(handle, device)
+	if err != nil {
+		log.Error(err, "failed to find network interfaces", "device", device)
 		return err
 	}
 
+	for _, link := range links {
+		qdisc := toQdisc(handle, link)
+
+		exist, err := qdiscExists(qdisc, handle, link)
+		if err != nil {
+			log.Error(err, "failed to check qdisc", "qdisc", qdisc, "link", link)
+			return err
+		}
+
+		if !exist {
+			log.Error(nil, "qdisc not exists, qdisc may be deleted by mistake or not injected successfully, there may be bugs here", "qdisc", qdisc)
+			continue
+		}
+
+		log.Info("Remove qdisc", "qdisc", qdisc, "device", link.Attrs().Name)
+		if err = handle.QdiscDel(qdisc); err != nil {
+			log.Error(err, "failed to remove qdisc", "qdisc", qdisc)
+
+			return err
+		}
+	}
+
 	return nil
 }
 
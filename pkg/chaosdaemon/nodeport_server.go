@@ -0,0 +1,127 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// nodePortCommentPrefix is the prefix of the comments of the rules blocking the node ports,
+// the rules are told apart by the containers which they're added for
+const nodePortCommentPrefix = "chaos-mesh-nodeport/"
+
+func (s *daemonServer) BlockNodePorts(ctx context.Context, req *pb.NodePortsRequest) (*empty.Empty, error) {
+	log.Info("Block node ports", "request", req)
+
+	if err := validateNodePorts(req.Ports); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid node ports: %v", err)
+	}
+
+	nsPath := GetNsPath(1, netNS)
+	for _, port := range req.Ports {
+		args := nodePortRuleArgs(req.ContainerId, port)
+		// the rule which has been added by the earlier injection isn't added again
+		if err := withNetNS(ctx, nsPath, iptablesCmd, append([]string{"-t", "raw", "-C", "PREROUTING"}, args...)...).Run(); err == nil {
+			continue
+		}
+
+		cmd := withNetNS(ctx, nsPath, iptablesCmd, append([]string{"-t", "raw", "-I", "PREROUTING"}, args...)...)
+		if err := s.addIptablesRules(ctx, cmd); err != nil {
+			return nil, status.Errorf(codes.Internal, "block node port %s/%d error: %v", port.Protocol, port.Port, err)
+		}
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// UnblockNodePorts removes all the rules added for the container, so the node ports are unblocked
+// even if they have been changed since they were blocked
+func (s *daemonServer) UnblockNodePorts(ctx context.Context, req *pb.NodePortsRequest) (*empty.Empty, error) {
+	log.Info("Unblock node ports", "request", req)
+
+	nsPath := GetNsPath(1, netNS)
+	out, err := withNetNS(ctx, nsPath, iptablesCmd, "-t", "raw", "-S", "PREROUTING", "-w", "5").CombinedOutput()
+	if err != nil {
+		log.Error(err, "failed to list raw rules", "output", string(out))
+		return nil, status.Errorf(codes.Internal, "list raw rules error: %v", err)
+	}
+
+	for _, rule := range nodePortRules(string(out), req.ContainerId) {
+		args := append([]string{"-t", "raw", "-D"}, rule[1:]...)
+		cmd := withNetNS(ctx, nsPath, iptablesCmd, append(args, "-w", "5")...)
+		if err := s.deleteIptablesRules(ctx, cmd); err != nil {
+			return nil, status.Errorf(codes.Internal, "unblock node ports error: %v", err)
+		}
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// nodePortRules returns the rules added for the container from the output of `iptables -S`,
+// e.g. -A PREROUTING -p tcp -m tcp --dport 30080 ... --comment chaos-mesh-nodeport/containerd://id -j DROP
+func nodePortRules(output string, containerID string) [][]string {
+	var rules [][]string
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "-A" {
+			continue
+		}
+		// the comments are quoted by iptables, but the arguments of the command aren't
+		for i := range fields {
+			fields[i] = strings.Trim(fields[i], "\"")
+		}
+		for i, field := range fields {
+			if field == "--comment" && i+1 < len(fields) && fields[i+1] == nodePortCommentPrefix+containerID {
+				rules = append(rules, fields)
+				break
+			}
+		}
+	}
+	return rules
+}
+
+func validateNodePorts(ports []*pb.NodePort) error {
+	for _, port := range ports {
+		switch port.Protocol {
+		case "tcp", "udp", "sctp":
+		default:
+			return fmt.Errorf("unknown protocol %s", port.Protocol)
+		}
+		if port.Port <= 0 || port.Port > 65535 {
+			return fmt.Errorf("port %d is out of range", port.Port)
+		}
+	}
+	return nil
+}
+
+// nodePortRuleArgs returns the rule of the raw table which drops the packets to the port on the local
+// addresses of the node. The packets are dropped before they're translated by the nat rules of kube-proxy,
+// so both the node port of a Service and the health check node port served by kube-proxy are blocked.
+func nodePortRuleArgs(containerID string, port *pb.NodePort) []string {
+	return []string{
+		"-p", port.Protocol, "--dport", strconv.Itoa(int(port.Port)),
+		"-m", "addrtype", "--dst-type", "LOCAL",
+		"-m", "comment", "--comment", nodePortCommentPrefix + containerID,
+		"-j", "DROP", "-w", "5",
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+var _ = Describe("nodeport server", func() {
+	s := &daemonServer{}
+
+	// mockIptables records the iptables commands, the rules are checked to exist if exists is true
+	mockIptables := func(commands *[]string, exists bool) mock.Finalizer {
+		return mock.With("MockWithNetNs", func(ctx context.Context, ns string, cmd string, args ...string) *exec.Cmd {
+			Expect(ns).To(Equal(GetNsPath(1, netNS)))
+			Expect(cmd).To(Equal(iptablesCmd))
+			*commands = append(*commands, strings.Join(args, " "))
+			if args[2] == "-C" && !exists {
+				return exec.Command("false")
+			}
+			return exec.Command("true")
+		})
+	}
+
+	Context("BlockNodePorts", func() {
+		It("should insert the rules into the raw table", func() {
+			var commands []string
+			defer mockIptables(&commands, false)()
+			_, err := s.BlockNodePorts(context.TODO(), &pb.NodePortsRequest{
+				ContainerId: "containerd://container-id",
+				Ports:       []*pb.NodePort{{Protocol: "tcp", Port: 30080}},
+			})
+			Expect(err).To(BeNil())
+			Expect(commands).To(HaveLen(2))
+			Expect(commands[1]).To(Equal("-t raw -I PREROUTING -p tcp --dport 30080 -m addrtype --dst-type LOCAL " +
+				"-m comment --comment chaos-mesh-nodeport/containerd://container-id -j DROP -w 5"))
+		})
+
+		It("should skip the rules which exist", func() {
+			var commands []string
+			defer mockIptables(&commands, true)()
+			_, err := s.BlockNodePorts(context.TODO(), &pb.NodePortsRequest{
+				ContainerId: "containerd://container-id",
+				Ports:       []*pb.NodePort{{Protocol: "udp", Port: 30053}},
+			})
+			Expect(err).To(BeNil())
+			Expect(commands).To(HaveLen(1))
+		})
+
+		It("should fail on invalid ports", func() {
+			_, err := s.BlockNodePorts(context.TODO(), &pb.NodePortsRequest{
+				ContainerId: "containerd://container-id",
+				Ports:       []*pb.NodePort{{Protocol: "icmp", Port: 30080}},
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("UnblockNodePorts", func() {
+		It("should delete the rules of the container", func() {
+			var commands []string
+			defer mock.With("MockWithNetNs", func(ctx context.Context, ns string, cmd string, args ...string) *exec.Cmd {
+				commands = append(commands, strings.Join(args, " "))
+				if args[2] == "-S" {
+					return exec.Command("echo", `-P PREROUTING ACCEPT
+-A PREROUTING -p tcp -m addrtype --dst-type LOCAL -m tcp --dport 30080 -m comment --comment "chaos-mesh-nodeport/containerd://container-id" -j DROP
+-A PREROUTING -p tcp -m addrtype --dst-type LOCAL -m tcp --dport 30080 -m comment --comment "chaos-mesh-nodeport/containerd://another" -j DROP
+-A PREROUTING -p udp -m addrtype --dst-type LOCAL -m udp --dport 30053 -m comment --comment chaos-mesh-nodeport/containerd://container-id -j DROP`)
+				}
+				return exec.Command("true")
+			})()
+			_, err := s.UnblockNodePorts(context.TODO(), &pb.NodePortsRequest{
+				ContainerId: "containerd://container-id",
+			})
+			Expect(err).To(BeNil())
+			Expect(commands).To(Equal([]string{
+				"-t raw -S PREROUTING -w 5",
+				"-t raw -D PREROUTING -p tcp -m addrtype --dst-type LOCAL -m tcp --dport 30080 -m comment --comment chaos-mesh-nodeport/containerd://container-id -j DROP -w 5",
+				"-t raw -D PREROUTING -p udp -m addrtype --dst-type LOCAL -m udp --dport 30053 -m comment --comment chaos-mesh-nodeport/containerd://container-id -j DROP -w 5",
+			}))
+		})
+	})
+})
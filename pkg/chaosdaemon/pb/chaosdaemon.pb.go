@@ -48,7 +48,7 @@ func (x Rule_Action) String() string {
 	return proto.EnumName(Rule_Action_name, int32(x))
 }
 func (Rule_Action) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{16, 0}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{18, 0}
 }
 
 type Rule_Direction int32
@@ -71,7 +71,7 @@ func (x Rule_Direction) String() string {
 	return proto.EnumName(Rule_Direction_name, int32(x))
 }
 func (Rule_Direction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{16, 1}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{18, 1}
 }
 
 type ContainerAction_Action int32
@@ -94,7 +94,7 @@ func (x ContainerAction_Action) String() string {
 	return proto.EnumName(ContainerAction_Action_name, int32(x))
 }
 func (ContainerAction_Action) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{18, 0}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{20, 0}
 }
 
 type ExecStressRequest_Scope int32
@@ -117,7 +117,7 @@ func (x ExecStressRequest_Scope) String() string {
 	return proto.EnumName(ExecStressRequest_Scope_name, int32(x))
 }
 func (ExecStressRequest_Scope) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{19, 0}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{21, 0}
 }
 
 type TcHandle struct {
@@ -132,7 +132,7 @@ func (m *TcHandle) Reset()         { *m = TcHandle{} }
 func (m *TcHandle) String() string { return proto.CompactTextString(m) }
 func (*TcHandle) ProtoMessage()    {}
 func (*TcHandle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{0}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{0}
 }
 func (m *TcHandle) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcHandle.Unmarshal(m, b)
@@ -178,7 +178,7 @@ func (m *ContainerRequest) Reset()         { *m = ContainerRequest{} }
 func (m *ContainerRequest) String() string { return proto.CompactTextString(m) }
 func (*ContainerRequest) ProtoMessage()    {}
 func (*ContainerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{1}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{1}
 }
 func (m *ContainerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerRequest.Unmarshal(m, b)
@@ -223,7 +223,7 @@ func (m *ContainerResponse) Reset()         { *m = ContainerResponse{} }
 func (m *ContainerResponse) String() string { return proto.CompactTextString(m) }
 func (*ContainerResponse) ProtoMessage()    {}
 func (*ContainerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{2}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{2}
 }
 func (m *ContainerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerResponse.Unmarshal(m, b)
@@ -251,20 +251,24 @@ func (m *ContainerResponse) GetPid() uint32 {
 }
 
 type NetemRequest struct {
-	Netem                *Netem    `protobuf:"bytes,1,opt,name=netem,proto3" json:"netem,omitempty"`
-	ContainerId          string    `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Handle               *TcHandle `protobuf:"bytes,3,opt,name=handle,proto3" json:"handle,omitempty"`
-	Parent               *TcHandle `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Netem       *Netem    `protobuf:"bytes,1,opt,name=netem,proto3" json:"netem,omitempty"`
+	ContainerId string    `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Handle      *TcHandle `protobuf:"bytes,3,opt,name=handle,proto3" json:"handle,omitempty"`
+	Parent      *TcHandle `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	// device is the glob pattern of the network interfaces, eth0 if it's empty
+	Device string `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
+	// profile is replayed by updating the netem periodically if it's not empty
+	Profile              *NetemProfile `protobuf:"bytes,6,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *NetemRequest) Reset()         { *m = NetemRequest{} }
 func (m *NetemRequest) String() string { return proto.CompactTextString(m) }
 func (*NetemRequest) ProtoMessage()    {}
 func (*NetemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{3}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{3}
 }
 func (m *NetemRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetemRequest.Unmarshal(m, b)
@@ -312,6 +316,123 @@ func (m *NetemRequest) GetParent() *TcHandle {
 	return nil
 }
 
+func (m *NetemRequest) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *NetemRequest) GetProfile() *NetemProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type NetemProfile struct {
+	Samples []*NetemSample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+	// period_ms is the length of the profile in milliseconds, the samples are
+	// replayed from the beginning after it if loop is true
+	PeriodMs             int64    `protobuf:"varint,2,opt,name=period_ms,json=periodMs,proto3" json:"period_ms,omitempty"`
+	Loop                 bool     `protobuf:"varint,3,opt,name=loop,proto3" json:"loop,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetemProfile) Reset()         { *m = NetemProfile{} }
+func (m *NetemProfile) String() string { return proto.CompactTextString(m) }
+func (*NetemProfile) ProtoMessage()    {}
+func (*NetemProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{4}
+}
+func (m *NetemProfile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetemProfile.Unmarshal(m, b)
+}
+func (m *NetemProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetemProfile.Marshal(b, m, deterministic)
+}
+func (dst *NetemProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetemProfile.Merge(dst, src)
+}
+func (m *NetemProfile) XXX_Size() int {
+	return xxx_messageInfo_NetemProfile.Size(m)
+}
+func (m *NetemProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetemProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetemProfile proto.InternalMessageInfo
+
+func (m *NetemProfile) GetSamples() []*NetemSample {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+func (m *NetemProfile) GetPeriodMs() int64 {
+	if m != nil {
+		return m.PeriodMs
+	}
+	return 0
+}
+
+func (m *NetemProfile) GetLoop() bool {
+	if m != nil {
+		return m.Loop
+	}
+	return false
+}
+
+type NetemSample struct {
+	// offset_ms is the time in milliseconds since the profile starts when the sample is applied
+	OffsetMs             int64    `protobuf:"varint,1,opt,name=offset_ms,json=offsetMs,proto3" json:"offset_ms,omitempty"`
+	Netem                *Netem   `protobuf:"bytes,2,opt,name=netem,proto3" json:"netem,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetemSample) Reset()         { *m = NetemSample{} }
+func (m *NetemSample) String() string { return proto.CompactTextString(m) }
+func (*NetemSample) ProtoMessage()    {}
+func (*NetemSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{5}
+}
+func (m *NetemSample) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetemSample.Unmarshal(m, b)
+}
+func (m *NetemSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetemSample.Marshal(b, m, deterministic)
+}
+func (dst *NetemSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetemSample.Merge(dst, src)
+}
+func (m *NetemSample) XXX_Size() int {
+	return xxx_messageInfo_NetemSample.Size(m)
+}
+func (m *NetemSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetemSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetemSample proto.InternalMessageInfo
+
+func (m *NetemSample) GetOffsetMs() int64 {
+	if m != nil {
+		return m.OffsetMs
+	}
+	return 0
+}
+
+func (m *NetemSample) GetNetem() *Netem {
+	if m != nil {
+		return m.Netem
+	}
+	return nil
+}
+
 type Netem struct {
 	Time                 uint32    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
 	Jitter               uint32    `protobuf:"varint,2,opt,name=jitter,proto3" json:"jitter,omitempty"`
@@ -337,7 +458,7 @@ func (m *Netem) Reset()         { *m = Netem{} }
 func (m *Netem) String() string { return proto.CompactTextString(m) }
 func (*Netem) ProtoMessage()    {}
 func (*Netem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{4}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{6}
 }
 func (m *Netem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Netem.Unmarshal(m, b)
@@ -463,8 +584,10 @@ func (m *Netem) GetHandle() *TcHandle {
 }
 
 type TbfRequest struct {
-	Tbf                  *Tbf     `protobuf:"bytes,1,opt,name=tbf,proto3" json:"tbf,omitempty"`
-	ContainerId          string   `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Tbf         *Tbf   `protobuf:"bytes,1,opt,name=tbf,proto3" json:"tbf,omitempty"`
+	ContainerId string `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// device is the glob pattern of the network interfaces, eth0 if it's empty
+	Device               string   `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -474,7 +597,7 @@ func (m *TbfRequest) Reset()         { *m = TbfRequest{} }
 func (m *TbfRequest) String() string { return proto.CompactTextString(m) }
 func (*TbfRequest) ProtoMessage()    {}
 func (*TbfRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{5}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{7}
 }
 func (m *TbfRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TbfRequest.Unmarshal(m, b)
@@ -508,6 +631,13 @@ func (m *TbfRequest) GetContainerId() string {
 	return ""
 }
 
+func (m *TbfRequest) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
 type Tbf struct {
 	Rate                 uint64   `protobuf:"varint,1,opt,name=rate,proto3" json:"rate,omitempty"`
 	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -523,7 +653,7 @@ func (m *Tbf) Reset()         { *m = Tbf{} }
 func (m *Tbf) String() string { return proto.CompactTextString(m) }
 func (*Tbf) ProtoMessage()    {}
 func (*Tbf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{6}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{8}
 }
 func (m *Tbf) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Tbf.Unmarshal(m, b)
@@ -579,8 +709,10 @@ func (m *Tbf) GetMinBurst() uint32 {
 }
 
 type QdiscRequest struct {
-	Qdisc                *Qdisc   `protobuf:"bytes,1,opt,name=qdisc,proto3" json:"qdisc,omitempty"`
-	ContainerId          string   `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Qdisc       *Qdisc `protobuf:"bytes,1,opt,name=qdisc,proto3" json:"qdisc,omitempty"`
+	ContainerId string `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// device is the glob pattern of the network interfaces, eth0 if it's empty
+	Device               string   `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -590,7 +722,7 @@ func (m *QdiscRequest) Reset()         { *m = QdiscRequest{} }
 func (m *QdiscRequest) String() string { return proto.CompactTextString(m) }
 func (*QdiscRequest) ProtoMessage()    {}
 func (*QdiscRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{7}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{9}
 }
 func (m *QdiscRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QdiscRequest.Unmarshal(m, b)
@@ -624,6 +756,13 @@ func (m *QdiscRequest) GetContainerId() string {
 	return ""
 }
 
+func (m *QdiscRequest) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
 type Qdisc struct {
 	Parent               *TcHandle `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
 	Handle               *TcHandle `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
@@ -638,7 +777,7 @@ func (m *Qdisc) Reset()         { *m = Qdisc{} }
 func (m *Qdisc) String() string { return proto.CompactTextString(m) }
 func (*Qdisc) ProtoMessage()    {}
 func (*Qdisc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{8}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{10}
 }
 func (m *Qdisc) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Qdisc.Unmarshal(m, b)
@@ -687,18 +826,20 @@ func (m *Qdisc) GetArgs() []string {
 }
 
 type EmatchFilterRequest struct {
-	Filter               *EmatchFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	ContainerId          string        `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Filter      *EmatchFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	ContainerId string        `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// device is the glob pattern of the network interfaces, eth0 if it's empty
+	Device               string   `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EmatchFilterRequest) Reset()         { *m = EmatchFilterRequest{} }
 func (m *EmatchFilterRequest) String() string { return proto.CompactTextString(m) }
 func (*EmatchFilterRequest) ProtoMessage()    {}
 func (*EmatchFilterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{9}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{11}
 }
 func (m *EmatchFilterRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EmatchFilterRequest.Unmarshal(m, b)
@@ -732,6 +873,13 @@ func (m *EmatchFilterRequest) GetContainerId() string {
 	return ""
 }
 
+func (m *EmatchFilterRequest) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
 type EmatchFilter struct {
 	Match                string    `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
 	Parent               *TcHandle `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
@@ -745,7 +893,7 @@ func (m *EmatchFilter) Reset()         { *m = EmatchFilter{} }
 func (m *EmatchFilter) String() string { return proto.CompactTextString(m) }
 func (*EmatchFilter) ProtoMessage()    {}
 func (*EmatchFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{10}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{12}
 }
 func (m *EmatchFilter) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EmatchFilter.Unmarshal(m, b)
@@ -787,18 +935,20 @@ func (m *EmatchFilter) GetClassid() *TcHandle {
 }
 
 type TcFilterRequest struct {
-	Filter               *TcFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	ContainerId          string    `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Filter      *TcFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	ContainerId string    `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// device is the glob pattern of the network interfaces, eth0 if it's empty
+	Device               string   `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TcFilterRequest) Reset()         { *m = TcFilterRequest{} }
 func (m *TcFilterRequest) String() string { return proto.CompactTextString(m) }
 func (*TcFilterRequest) ProtoMessage()    {}
 func (*TcFilterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{11}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{13}
 }
 func (m *TcFilterRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcFilterRequest.Unmarshal(m, b)
@@ -832,6 +982,13 @@ func (m *TcFilterRequest) GetContainerId() string {
 	return ""
 }
 
+func (m *TcFilterRequest) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
 type TcFilter struct {
 	Parent               *TcHandle `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -843,7 +1000,7 @@ func (m *TcFilter) Reset()         { *m = TcFilter{} }
 func (m *TcFilter) String() string { return proto.CompactTextString(m) }
 func (*TcFilter) ProtoMessage()    {}
 func (*TcFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{12}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{14}
 }
 func (m *TcFilter) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcFilter.Unmarshal(m, b)
@@ -882,7 +1039,7 @@ func (m *IpSetRequest) Reset()         { *m = IpSetRequest{} }
 func (m *IpSetRequest) String() string { return proto.CompactTextString(m) }
 func (*IpSetRequest) ProtoMessage()    {}
 func (*IpSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{13}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{15}
 }
 func (m *IpSetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IpSetRequest.Unmarshal(m, b)
@@ -928,7 +1085,7 @@ func (m *IpSet) Reset()         { *m = IpSet{} }
 func (m *IpSet) String() string { return proto.CompactTextString(m) }
 func (*IpSet) ProtoMessage()    {}
 func (*IpSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{14}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{16}
 }
 func (m *IpSet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IpSet.Unmarshal(m, b)
@@ -974,7 +1131,7 @@ func (m *IpTablesRequest) Reset()         { *m = IpTablesRequest{} }
 func (m *IpTablesRequest) String() string { return proto.CompactTextString(m) }
 func (*IpTablesRequest) ProtoMessage()    {}
 func (*IpTablesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{15}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{17}
 }
 func (m *IpTablesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IpTablesRequest.Unmarshal(m, b)
@@ -1009,19 +1166,22 @@ func (m *IpTablesRequest) GetContainerId() string {
 }
 
 type Rule struct {
-	Action               Rule_Action    `protobuf:"varint,1,opt,name=action,proto3,enum=chaosdaemon.Rule_Action" json:"action,omitempty"`
-	Direction            Rule_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=chaosdaemon.Rule_Direction" json:"direction,omitempty"`
-	Set                  string         `protobuf:"bytes,3,opt,name=set,proto3" json:"set,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Action    Rule_Action    `protobuf:"varint,1,opt,name=action,proto3,enum=chaosdaemon.Rule_Action" json:"action,omitempty"`
+	Direction Rule_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=chaosdaemon.Rule_Direction" json:"direction,omitempty"`
+	Set       string         `protobuf:"bytes,3,opt,name=set,proto3" json:"set,omitempty"`
+	// mesh inserts the rule in front of the iptables rules of the service mesh sidecar
+	// and keeps the matched packets from being redirected to the sidecar
+	Mesh                 bool     `protobuf:"varint,4,opt,name=mesh,proto3" json:"mesh,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Rule) Reset()         { *m = Rule{} }
 func (m *Rule) String() string { return proto.CompactTextString(m) }
 func (*Rule) ProtoMessage()    {}
 func (*Rule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{16}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{18}
 }
 func (m *Rule) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Rule.Unmarshal(m, b)
@@ -1062,6 +1222,13 @@ func (m *Rule) GetSet() string {
 	return ""
 }
 
+func (m *Rule) GetMesh() bool {
+	if m != nil {
+		return m.Mesh
+	}
+	return false
+}
+
 type TimeRequest struct {
 	ContainerId          string   `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	Sec                  int64    `protobuf:"varint,2,opt,name=sec,proto3" json:"sec,omitempty"`
@@ -1076,7 +1243,7 @@ func (m *TimeRequest) Reset()         { *m = TimeRequest{} }
 func (m *TimeRequest) String() string { return proto.CompactTextString(m) }
 func (*TimeRequest) ProtoMessage()    {}
 func (*TimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{17}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{19}
 }
 func (m *TimeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TimeRequest.Unmarshal(m, b)
@@ -1135,7 +1302,7 @@ func (m *ContainerAction) Reset()         { *m = ContainerAction{} }
 func (m *ContainerAction) String() string { return proto.CompactTextString(m) }
 func (*ContainerAction) ProtoMessage()    {}
 func (*ContainerAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{18}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{20}
 }
 func (m *ContainerAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerAction.Unmarshal(m, b)
@@ -1163,19 +1330,23 @@ func (m *ContainerAction) GetAction() ContainerAction_Action {
 }
 
 type ExecStressRequest struct {
-	Scope                ExecStressRequest_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=chaosdaemon.ExecStressRequest_Scope" json:"scope,omitempty"`
-	Target               string                  `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
-	Stressors            string                  `protobuf:"bytes,3,opt,name=stressors,proto3" json:"stressors,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Scope     ExecStressRequest_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=chaosdaemon.ExecStressRequest_Scope" json:"scope,omitempty"`
+	Target    string                  `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Stressors string                  `protobuf:"bytes,3,opt,name=stressors,proto3" json:"stressors,omitempty"`
+	// pin the stressors to the same cores as the target process
+	TargetCpuAffinity bool `protobuf:"varint,4,opt,name=target_cpu_affinity,json=targetCpuAffinity,proto3" json:"target_cpu_affinity,omitempty"`
+	// the nice value of the stressors
+	Nice                 int32    `protobuf:"varint,5,opt,name=nice,proto3" json:"nice,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ExecStressRequest) Reset()         { *m = ExecStressRequest{} }
 func (m *ExecStressRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecStressRequest) ProtoMessage()    {}
 func (*ExecStressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{19}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{21}
 }
 func (m *ExecStressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExecStressRequest.Unmarshal(m, b)
@@ -1216,6 +1387,20 @@ func (m *ExecStressRequest) GetStressors() string {
 	return ""
 }
 
+func (m *ExecStressRequest) GetTargetCpuAffinity() bool {
+	if m != nil {
+		return m.TargetCpuAffinity
+	}
+	return false
+}
+
+func (m *ExecStressRequest) GetNice() int32 {
+	if m != nil {
+		return m.Nice
+	}
+	return 0
+}
+
 type ExecStressResponse struct {
 	Instance             string   `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
 	StartTime            int64    `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
@@ -1228,7 +1413,7 @@ func (m *ExecStressResponse) Reset()         { *m = ExecStressResponse{} }
 func (m *ExecStressResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecStressResponse) ProtoMessage()    {}
 func (*ExecStressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{20}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{22}
 }
 func (m *ExecStressResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExecStressResponse.Unmarshal(m, b)
@@ -1274,7 +1459,7 @@ func (m *CancelStressRequest) Reset()         { *m = CancelStressRequest{} }
 func (m *CancelStressRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelStressRequest) ProtoMessage()    {}
 func (*CancelStressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaosdaemon_be9ad25a34a9c4f2, []int{21}
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{23}
 }
 func (m *CancelStressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelStressRequest.Unmarshal(m, b)
@@ -1308,527 +1493,2924 @@ func (m *CancelStressRequest) GetStartTime() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*TcHandle)(nil), "chaosdaemon.TcHandle")
-	proto.RegisterType((*ContainerRequest)(nil), "chaosdaemon.ContainerRequest")
-	proto.RegisterType((*ContainerResponse)(nil), "chaosdaemon.ContainerResponse")
-	proto.RegisterType((*NetemRequest)(nil), "chaosdaemon.NetemRequest")
-	proto.RegisterType((*Netem)(nil), "chaosdaemon.Netem")
-	proto.RegisterType((*TbfRequest)(nil), "chaosdaemon.TbfRequest")
-	proto.RegisterType((*Tbf)(nil), "chaosdaemon.Tbf")
-	proto.RegisterType((*QdiscRequest)(nil), "chaosdaemon.QdiscRequest")
-	proto.RegisterType((*Qdisc)(nil), "chaosdaemon.Qdisc")
-	proto.RegisterType((*EmatchFilterRequest)(nil), "chaosdaemon.EmatchFilterRequest")
-	proto.RegisterType((*EmatchFilter)(nil), "chaosdaemon.EmatchFilter")
-	proto.RegisterType((*TcFilterRequest)(nil), "chaosdaemon.TcFilterRequest")
-	proto.RegisterType((*TcFilter)(nil), "chaosdaemon.TcFilter")
-	proto.RegisterType((*IpSetRequest)(nil), "chaosdaemon.IpSetRequest")
-	proto.RegisterType((*IpSet)(nil), "chaosdaemon.IpSet")
-	proto.RegisterType((*IpTablesRequest)(nil), "chaosdaemon.IpTablesRequest")
-	proto.RegisterType((*Rule)(nil), "chaosdaemon.Rule")
-	proto.RegisterType((*TimeRequest)(nil), "chaosdaemon.TimeRequest")
-	proto.RegisterType((*ContainerAction)(nil), "chaosdaemon.ContainerAction")
-	proto.RegisterType((*ExecStressRequest)(nil), "chaosdaemon.ExecStressRequest")
-	proto.RegisterType((*ExecStressResponse)(nil), "chaosdaemon.ExecStressResponse")
-	proto.RegisterType((*CancelStressRequest)(nil), "chaosdaemon.CancelStressRequest")
-	proto.RegisterEnum("chaosdaemon.Rule_Action", Rule_Action_name, Rule_Action_value)
-	proto.RegisterEnum("chaosdaemon.Rule_Direction", Rule_Direction_name, Rule_Direction_value)
-	proto.RegisterEnum("chaosdaemon.ContainerAction_Action", ContainerAction_Action_name, ContainerAction_Action_value)
-	proto.RegisterEnum("chaosdaemon.ExecStressRequest_Scope", ExecStressRequest_Scope_name, ExecStressRequest_Scope_value)
+type CleanupRequest struct {
+	ContainerIds         []string `protobuf:"bytes,1,rep,name=container_ids,json=containerIds,proto3" json:"container_ids,omitempty"`
+	DryRun               bool     `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *CleanupRequest) Reset()         { *m = CleanupRequest{} }
+func (m *CleanupRequest) String() string { return proto.CompactTextString(m) }
+func (*CleanupRequest) ProtoMessage()    {}
+func (*CleanupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{24}
+}
+func (m *CleanupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CleanupRequest.Unmarshal(m, b)
+}
+func (m *CleanupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CleanupRequest.Marshal(b, m, deterministic)
+}
+func (dst *CleanupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanupRequest.Merge(dst, src)
+}
+func (m *CleanupRequest) XXX_Size() int {
+	return xxx_messageInfo_CleanupRequest.Size(m)
+}
+func (m *CleanupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanupRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_CleanupRequest proto.InternalMessageInfo
 
-// ChaosDaemonClient is the client API for ChaosDaemon service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ChaosDaemonClient interface {
-	SetNetem(ctx context.Context, in *NetemRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	DeleteNetem(ctx context.Context, in *NetemRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	SetTbf(ctx context.Context, in *TbfRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	DeleteTbf(ctx context.Context, in *TbfRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	AddQdisc(ctx context.Context, in *QdiscRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	DelQdisc(ctx context.Context, in *QdiscRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	AddEmatchFilter(ctx context.Context, in *EmatchFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// generic tc filter del command
-	DelTcFilter(ctx context.Context, in *TcFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	FlushIpSet(ctx context.Context, in *IpSetRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	FlushIptables(ctx context.Context, in *IpTablesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	SetTimeOffset(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	RecoverTimeOffset(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	ContainerKill(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	ContainerGetPid(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*ContainerResponse, error)
-	ExecStressors(ctx context.Context, in *ExecStressRequest, opts ...grpc.CallOption) (*ExecStressResponse, error)
-	CancelStressors(ctx context.Context, in *CancelStressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+func (m *CleanupRequest) GetContainerIds() []string {
+	if m != nil {
+		return m.ContainerIds
+	}
+	return nil
 }
 
-type chaosDaemonClient struct {
-	cc *grpc.ClientConn
+func (m *CleanupRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
 }
 
-func NewChaosDaemonClient(cc *grpc.ClientConn) ChaosDaemonClient {
-	return &chaosDaemonClient{cc}
+type CleanupResponse struct {
+	Removed              []string `protobuf:"bytes,1,rep,name=removed,proto3" json:"removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *chaosDaemonClient) SetNetem(ctx context.Context, in *NetemRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/SetNetem", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CleanupResponse) Reset()         { *m = CleanupResponse{} }
+func (m *CleanupResponse) String() string { return proto.CompactTextString(m) }
+func (*CleanupResponse) ProtoMessage()    {}
+func (*CleanupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{25}
+}
+func (m *CleanupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CleanupResponse.Unmarshal(m, b)
+}
+func (m *CleanupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CleanupResponse.Marshal(b, m, deterministic)
+}
+func (dst *CleanupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanupResponse.Merge(dst, src)
+}
+func (m *CleanupResponse) XXX_Size() int {
+	return xxx_messageInfo_CleanupResponse.Size(m)
+}
+func (m *CleanupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanupResponse.DiscardUnknown(m)
 }
 
-func (c *chaosDaemonClient) DeleteNetem(ctx context.Context, in *NetemRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/DeleteNetem", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CleanupResponse proto.InternalMessageInfo
+
+func (m *CleanupResponse) GetRemoved() []string {
+	if m != nil {
+		return m.Removed
 	}
-	return out, nil
+	return nil
 }
 
-func (c *chaosDaemonClient) SetTbf(ctx context.Context, in *TbfRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/SetTbf", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CapabilitiesResponse struct {
+	KernelVersion string `protobuf:"bytes,1,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
+	// the probed kernel modules which are loaded, built in or able to be loaded
+	Modules []string `protobuf:"bytes,2,rep,name=modules,proto3" json:"modules,omitempty"`
+	// the version of the cgroup hierarchy, 0 if it's unknown
+	CgroupVersion int32            `protobuf:"varint,3,opt,name=cgroup_version,json=cgroupVersion,proto3" json:"cgroup_version,omitempty"`
+	Bpf           bool             `protobuf:"varint,4,opt,name=bpf,proto3" json:"bpf,omitempty"`
+	Runtimes      []*RuntimeStatus `protobuf:"bytes,5,rep,name=runtimes,proto3" json:"runtimes,omitempty"`
+	// the mode which chaos-daemon runs in, it's privileged if it's empty
+	Mode string `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	// the effective linux capabilities of chaos-daemon, such as CAP_NET_ADMIN
+	LinuxCapabilities []string `protobuf:"bytes,7,rep,name=linux_capabilities,json=linuxCapabilities,proto3" json:"linux_capabilities,omitempty"`
+	// the operating system of the node, such as linux or windows, it's linux if it's empty
+	Os string `protobuf:"bytes,8,opt,name=os,proto3" json:"os,omitempty"`
+	// the methods which chaos-daemon supports on the operating system, every method
+	// is supported if it's empty
+	Methods []string `protobuf:"bytes,9,rep,name=methods,proto3" json:"methods,omitempty"`
+	// the architecture of the node, such as amd64 or arm64
+	Arch string `protobuf:"bytes,10,opt,name=arch,proto3" json:"arch,omitempty"`
+	// the kernel config which the kernel features are probed from, such as /proc/config.gz,
+	// it's empty if no kernel config is readable and the kernel features are unknown
+	KernelConfig string `protobuf:"bytes,11,opt,name=kernel_config,json=kernelConfig,proto3" json:"kernel_config,omitempty"`
+	// the probed kernel features which are enabled, such as bpf_syscall
+	KernelFeatures       []string `protobuf:"bytes,12,rep,name=kernel_features,json=kernelFeatures,proto3" json:"kernel_features,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *chaosDaemonClient) DeleteTbf(ctx context.Context, in *TbfRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/DeleteTbf", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CapabilitiesResponse) Reset()         { *m = CapabilitiesResponse{} }
+func (m *CapabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*CapabilitiesResponse) ProtoMessage()    {}
+func (*CapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{26}
+}
+func (m *CapabilitiesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapabilitiesResponse.Unmarshal(m, b)
+}
+func (m *CapabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapabilitiesResponse.Marshal(b, m, deterministic)
+}
+func (dst *CapabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapabilitiesResponse.Merge(dst, src)
+}
+func (m *CapabilitiesResponse) XXX_Size() int {
+	return xxx_messageInfo_CapabilitiesResponse.Size(m)
+}
+func (m *CapabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapabilitiesResponse.DiscardUnknown(m)
 }
 
-func (c *chaosDaemonClient) AddQdisc(ctx context.Context, in *QdiscRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/AddQdisc", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CapabilitiesResponse proto.InternalMessageInfo
+
+func (m *CapabilitiesResponse) GetKernelVersion() string {
+	if m != nil {
+		return m.KernelVersion
 	}
-	return out, nil
+	return ""
 }
 
-func (c *chaosDaemonClient) DelQdisc(ctx context.Context, in *QdiscRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/DelQdisc", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CapabilitiesResponse) GetModules() []string {
+	if m != nil {
+		return m.Modules
 	}
-	return out, nil
+	return nil
 }
 
-func (c *chaosDaemonClient) AddEmatchFilter(ctx context.Context, in *EmatchFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (m *CapabilitiesResponse) GetCgroupVersion() int32 {
+	if m != nil {
+		return m.CgroupVersion
+	}
+	return 0
+}
+
+func (m *CapabilitiesResponse) GetBpf() bool {
+	if m != nil {
+		return m.Bpf
+	}
+	return false
+}
+
+func (m *CapabilitiesResponse) GetRuntimes() []*RuntimeStatus {
+	if m != nil {
+		return m.Runtimes
+	}
+	return nil
+}
+
+func (m *CapabilitiesResponse) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *CapabilitiesResponse) GetLinuxCapabilities() []string {
+	if m != nil {
+		return m.LinuxCapabilities
+	}
+	return nil
+}
+
+func (m *CapabilitiesResponse) GetOs() string {
+	if m != nil {
+		return m.Os
+	}
+	return ""
+}
+
+func (m *CapabilitiesResponse) GetMethods() []string {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+func (m *CapabilitiesResponse) GetArch() string {
+	if m != nil {
+		return m.Arch
+	}
+	return ""
+}
+
+func (m *CapabilitiesResponse) GetKernelConfig() string {
+	if m != nil {
+		return m.KernelConfig
+	}
+	return ""
+}
+
+func (m *CapabilitiesResponse) GetKernelFeatures() []string {
+	if m != nil {
+		return m.KernelFeatures
+	}
+	return nil
+}
+
+type RuntimeStatus struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Socket               string   `protobuf:"bytes,2,opt,name=socket,proto3" json:"socket,omitempty"`
+	Healthy              bool     `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuntimeStatus) Reset()         { *m = RuntimeStatus{} }
+func (m *RuntimeStatus) String() string { return proto.CompactTextString(m) }
+func (*RuntimeStatus) ProtoMessage()    {}
+func (*RuntimeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{27}
+}
+func (m *RuntimeStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuntimeStatus.Unmarshal(m, b)
+}
+func (m *RuntimeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuntimeStatus.Marshal(b, m, deterministic)
+}
+func (dst *RuntimeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeStatus.Merge(dst, src)
+}
+func (m *RuntimeStatus) XXX_Size() int {
+	return xxx_messageInfo_RuntimeStatus.Size(m)
+}
+func (m *RuntimeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeStatus proto.InternalMessageInfo
+
+func (m *RuntimeStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RuntimeStatus) GetSocket() string {
+	if m != nil {
+		return m.Socket
+	}
+	return ""
+}
+
+func (m *RuntimeStatus) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *RuntimeStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type BatchNetemRequest struct {
+	Requests             []*NetemRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *BatchNetemRequest) Reset()         { *m = BatchNetemRequest{} }
+func (m *BatchNetemRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchNetemRequest) ProtoMessage()    {}
+func (*BatchNetemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{28}
+}
+func (m *BatchNetemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchNetemRequest.Unmarshal(m, b)
+}
+func (m *BatchNetemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchNetemRequest.Marshal(b, m, deterministic)
+}
+func (dst *BatchNetemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchNetemRequest.Merge(dst, src)
+}
+func (m *BatchNetemRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchNetemRequest.Size(m)
+}
+func (m *BatchNetemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchNetemRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchNetemRequest proto.InternalMessageInfo
+
+func (m *BatchNetemRequest) GetRequests() []*NetemRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+type BatchTbfRequest struct {
+	Requests             []*TbfRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BatchTbfRequest) Reset()         { *m = BatchTbfRequest{} }
+func (m *BatchTbfRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchTbfRequest) ProtoMessage()    {}
+func (*BatchTbfRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{29}
+}
+func (m *BatchTbfRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchTbfRequest.Unmarshal(m, b)
+}
+func (m *BatchTbfRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchTbfRequest.Marshal(b, m, deterministic)
+}
+func (dst *BatchTbfRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTbfRequest.Merge(dst, src)
+}
+func (m *BatchTbfRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchTbfRequest.Size(m)
+}
+func (m *BatchTbfRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTbfRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchTbfRequest proto.InternalMessageInfo
+
+func (m *BatchTbfRequest) GetRequests() []*TbfRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+type BatchIpSetRequest struct {
+	Requests             []*IpSetRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *BatchIpSetRequest) Reset()         { *m = BatchIpSetRequest{} }
+func (m *BatchIpSetRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchIpSetRequest) ProtoMessage()    {}
+func (*BatchIpSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{30}
+}
+func (m *BatchIpSetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchIpSetRequest.Unmarshal(m, b)
+}
+func (m *BatchIpSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchIpSetRequest.Marshal(b, m, deterministic)
+}
+func (dst *BatchIpSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchIpSetRequest.Merge(dst, src)
+}
+func (m *BatchIpSetRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchIpSetRequest.Size(m)
+}
+func (m *BatchIpSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchIpSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchIpSetRequest proto.InternalMessageInfo
+
+func (m *BatchIpSetRequest) GetRequests() []*IpSetRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+type BatchIpTablesRequest struct {
+	Requests             []*IpTablesRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *BatchIpTablesRequest) Reset()         { *m = BatchIpTablesRequest{} }
+func (m *BatchIpTablesRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchIpTablesRequest) ProtoMessage()    {}
+func (*BatchIpTablesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{31}
+}
+func (m *BatchIpTablesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchIpTablesRequest.Unmarshal(m, b)
+}
+func (m *BatchIpTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchIpTablesRequest.Marshal(b, m, deterministic)
+}
+func (dst *BatchIpTablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchIpTablesRequest.Merge(dst, src)
+}
+func (m *BatchIpTablesRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchIpTablesRequest.Size(m)
+}
+func (m *BatchIpTablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchIpTablesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchIpTablesRequest proto.InternalMessageInfo
+
+func (m *BatchIpTablesRequest) GetRequests() []*IpTablesRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+type BatchResponse struct {
+	// the error of each request, it's empty if the request succeeded
+	Errors               []string `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchResponse) Reset()         { *m = BatchResponse{} }
+func (m *BatchResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchResponse) ProtoMessage()    {}
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{32}
+}
+func (m *BatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchResponse.Unmarshal(m, b)
+}
+func (m *BatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchResponse.Marshal(b, m, deterministic)
+}
+func (dst *BatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResponse.Merge(dst, src)
+}
+func (m *BatchResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchResponse.Size(m)
+}
+func (m *BatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResponse proto.InternalMessageInfo
+
+func (m *BatchResponse) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type BpfFaultRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// device is the glob pattern of the network interfaces, eth0 if it's empty
+	Device string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	// delay and jitter of the egress packets in microseconds
+	Delay  uint32 `protobuf:"varint,3,opt,name=delay,proto3" json:"delay,omitempty"`
+	Jitter uint32 `protobuf:"varint,4,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// loss is the percentage of the egress packets to drop
+	Loss float32 `protobuf:"fixed32,5,opt,name=loss,proto3" json:"loss,omitempty"`
+	// the packets are dropped if they match the cidrs in partition, otherwise the delay and loss
+	// are applied to the egress packets which match the cidrs, or all egress packets if there are no cidrs
+	Partition bool `protobuf:"varint,6,opt,name=partition,proto3" json:"partition,omitempty"`
+	// the cidrs of the destinations of the egress packets
+	EgressCidrs []string `protobuf:"bytes,7,rep,name=egress_cidrs,json=egressCidrs,proto3" json:"egress_cidrs,omitempty"`
+	// the cidrs of the sources of the ingress packets, they are only matched in partition
+	IngressCidrs         []string `protobuf:"bytes,8,rep,name=ingress_cidrs,json=ingressCidrs,proto3" json:"ingress_cidrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BpfFaultRequest) Reset()         { *m = BpfFaultRequest{} }
+func (m *BpfFaultRequest) String() string { return proto.CompactTextString(m) }
+func (*BpfFaultRequest) ProtoMessage()    {}
+func (*BpfFaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{33}
+}
+func (m *BpfFaultRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BpfFaultRequest.Unmarshal(m, b)
+}
+func (m *BpfFaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BpfFaultRequest.Marshal(b, m, deterministic)
+}
+func (dst *BpfFaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BpfFaultRequest.Merge(dst, src)
+}
+func (m *BpfFaultRequest) XXX_Size() int {
+	return xxx_messageInfo_BpfFaultRequest.Size(m)
+}
+func (m *BpfFaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BpfFaultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BpfFaultRequest proto.InternalMessageInfo
+
+func (m *BpfFaultRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *BpfFaultRequest) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *BpfFaultRequest) GetDelay() uint32 {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
+func (m *BpfFaultRequest) GetJitter() uint32 {
+	if m != nil {
+		return m.Jitter
+	}
+	return 0
+}
+
+func (m *BpfFaultRequest) GetLoss() float32 {
+	if m != nil {
+		return m.Loss
+	}
+	return 0
+}
+
+func (m *BpfFaultRequest) GetPartition() bool {
+	if m != nil {
+		return m.Partition
+	}
+	return false
+}
+
+func (m *BpfFaultRequest) GetEgressCidrs() []string {
+	if m != nil {
+		return m.EgressCidrs
+	}
+	return nil
+}
+
+func (m *BpfFaultRequest) GetIngressCidrs() []string {
+	if m != nil {
+		return m.IngressCidrs
+	}
+	return nil
+}
+
+type WatchStatusRequest struct {
+	// only the events of the experiment are sent if it's set, otherwise the events of all experiments are sent
+	ExperimentUid        string   `protobuf:"bytes,1,opt,name=experiment_uid,json=experimentUid,proto3" json:"experiment_uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchStatusRequest) Reset()         { *m = WatchStatusRequest{} }
+func (m *WatchStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchStatusRequest) ProtoMessage()    {}
+func (*WatchStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{34}
+}
+func (m *WatchStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchStatusRequest.Unmarshal(m, b)
+}
+func (m *WatchStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *WatchStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchStatusRequest.Merge(dst, src)
+}
+func (m *WatchStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchStatusRequest.Size(m)
+}
+func (m *WatchStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchStatusRequest proto.InternalMessageInfo
+
+func (m *WatchStatusRequest) GetExperimentUid() string {
+	if m != nil {
+		return m.ExperimentUid
+	}
+	return ""
+}
+
+type StatusEvent struct {
+	ExperimentUid string `protobuf:"bytes,1,opt,name=experiment_uid,json=experimentUid,proto3" json:"experiment_uid,omitempty"`
+	// the experiment in the form of kind/namespace/name
+	Experiment string `protobuf:"bytes,2,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	// the method which the event is caused by, e.g. SetNetem
+	Method       string   `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	ContainerIds []string `protobuf:"bytes,4,rep,name=container_ids,json=containerIds,proto3" json:"container_ids,omitempty"`
+	// one of Applied, Failed, Recovered and Exited
+	Phase   string `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// the processes started for the experiment, e.g. the stressors
+	Pids []int64 `protobuf:"varint,7,rep,packed,name=pids,proto3" json:"pids,omitempty"`
+	// the unix time in nanoseconds when the event happened
+	Time                 int64    `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusEvent) Reset()         { *m = StatusEvent{} }
+func (m *StatusEvent) String() string { return proto.CompactTextString(m) }
+func (*StatusEvent) ProtoMessage()    {}
+func (*StatusEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{35}
+}
+func (m *StatusEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusEvent.Unmarshal(m, b)
+}
+func (m *StatusEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusEvent.Marshal(b, m, deterministic)
+}
+func (dst *StatusEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusEvent.Merge(dst, src)
+}
+func (m *StatusEvent) XXX_Size() int {
+	return xxx_messageInfo_StatusEvent.Size(m)
+}
+func (m *StatusEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusEvent proto.InternalMessageInfo
+
+func (m *StatusEvent) GetExperimentUid() string {
+	if m != nil {
+		return m.ExperimentUid
+	}
+	return ""
+}
+
+func (m *StatusEvent) GetExperiment() string {
+	if m != nil {
+		return m.Experiment
+	}
+	return ""
+}
+
+func (m *StatusEvent) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *StatusEvent) GetContainerIds() []string {
+	if m != nil {
+		return m.ContainerIds
+	}
+	return nil
+}
+
+func (m *StatusEvent) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *StatusEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *StatusEvent) GetPids() []int64 {
+	if m != nil {
+		return m.Pids
+	}
+	return nil
+}
+
+func (m *StatusEvent) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+type ConntrackRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// headroom is the number of the new connections which can still be tracked, the max of
+	// the conntrack table is lowered to the current count of the entries plus the headroom
+	Headroom             int64    `protobuf:"varint,2,opt,name=headroom,proto3" json:"headroom,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConntrackRequest) Reset()         { *m = ConntrackRequest{} }
+func (m *ConntrackRequest) String() string { return proto.CompactTextString(m) }
+func (*ConntrackRequest) ProtoMessage()    {}
+func (*ConntrackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{36}
+}
+func (m *ConntrackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConntrackRequest.Unmarshal(m, b)
+}
+func (m *ConntrackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConntrackRequest.Marshal(b, m, deterministic)
+}
+func (dst *ConntrackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConntrackRequest.Merge(dst, src)
+}
+func (m *ConntrackRequest) XXX_Size() int {
+	return xxx_messageInfo_ConntrackRequest.Size(m)
+}
+func (m *ConntrackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConntrackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConntrackRequest proto.InternalMessageInfo
+
+func (m *ConntrackRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *ConntrackRequest) GetHeadroom() int64 {
+	if m != nil {
+		return m.Headroom
+	}
+	return 0
+}
+
+type ConntrackResponse struct {
+	// max is the max of the conntrack table in effect
+	Max int64 `protobuf:"varint,1,opt,name=max,proto3" json:"max,omitempty"`
+	// original_max is the max of the conntrack table before it's lowered
+	OriginalMax int64 `protobuf:"varint,2,opt,name=original_max,json=originalMax,proto3" json:"original_max,omitempty"`
+	// count is the count of the entries in the conntrack table
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConntrackResponse) Reset()         { *m = ConntrackResponse{} }
+func (m *ConntrackResponse) String() string { return proto.CompactTextString(m) }
+func (*ConntrackResponse) ProtoMessage()    {}
+func (*ConntrackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{37}
+}
+func (m *ConntrackResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConntrackResponse.Unmarshal(m, b)
+}
+func (m *ConntrackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConntrackResponse.Marshal(b, m, deterministic)
+}
+func (dst *ConntrackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConntrackResponse.Merge(dst, src)
+}
+func (m *ConntrackResponse) XXX_Size() int {
+	return xxx_messageInfo_ConntrackResponse.Size(m)
+}
+func (m *ConntrackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConntrackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConntrackResponse proto.InternalMessageInfo
+
+func (m *ConntrackResponse) GetMax() int64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *ConntrackResponse) GetOriginalMax() int64 {
+	if m != nil {
+		return m.OriginalMax
+	}
+	return 0
+}
+
+func (m *ConntrackResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type MtuRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// device is the glob pattern of the network interfaces, eth0 if it's empty
+	Device               string   `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Mtu                  int32    `protobuf:"varint,3,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MtuRequest) Reset()         { *m = MtuRequest{} }
+func (m *MtuRequest) String() string { return proto.CompactTextString(m) }
+func (*MtuRequest) ProtoMessage()    {}
+func (*MtuRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{38}
+}
+func (m *MtuRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MtuRequest.Unmarshal(m, b)
+}
+func (m *MtuRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MtuRequest.Marshal(b, m, deterministic)
+}
+func (dst *MtuRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MtuRequest.Merge(dst, src)
+}
+func (m *MtuRequest) XXX_Size() int {
+	return xxx_messageInfo_MtuRequest.Size(m)
+}
+func (m *MtuRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MtuRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MtuRequest proto.InternalMessageInfo
+
+func (m *MtuRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *MtuRequest) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *MtuRequest) GetMtu() int32 {
+	if m != nil {
+		return m.Mtu
+	}
+	return 0
+}
+
+type NodePortsRequest struct {
+	ContainerId          string      `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Ports                []*NodePort `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *NodePortsRequest) Reset()         { *m = NodePortsRequest{} }
+func (m *NodePortsRequest) String() string { return proto.CompactTextString(m) }
+func (*NodePortsRequest) ProtoMessage()    {}
+func (*NodePortsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{39}
+}
+func (m *NodePortsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodePortsRequest.Unmarshal(m, b)
+}
+func (m *NodePortsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodePortsRequest.Marshal(b, m, deterministic)
+}
+func (dst *NodePortsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodePortsRequest.Merge(dst, src)
+}
+func (m *NodePortsRequest) XXX_Size() int {
+	return xxx_messageInfo_NodePortsRequest.Size(m)
+}
+func (m *NodePortsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodePortsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodePortsRequest proto.InternalMessageInfo
+
+func (m *NodePortsRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *NodePortsRequest) GetPorts() []*NodePort {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+type NodePort struct {
+	// protocol is tcp, udp or sctp
+	Protocol             string   `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Port                 int32    `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodePort) Reset()         { *m = NodePort{} }
+func (m *NodePort) String() string { return proto.CompactTextString(m) }
+func (*NodePort) ProtoMessage()    {}
+func (*NodePort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{40}
+}
+func (m *NodePort) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodePort.Unmarshal(m, b)
+}
+func (m *NodePort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodePort.Marshal(b, m, deterministic)
+}
+func (dst *NodePort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodePort.Merge(dst, src)
+}
+func (m *NodePort) XXX_Size() int {
+	return xxx_messageInfo_NodePort.Size(m)
+}
+func (m *NodePort) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodePort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodePort proto.InternalMessageInfo
+
+func (m *NodePort) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *NodePort) GetPort() int32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+type ExhaustRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// the percent of the limit of open files of the container process which is used
+	FdPercent int32 `protobuf:"varint,2,opt,name=fd_percent,json=fdPercent,proto3" json:"fd_percent,omitempty"`
+	// the percent of the max pids of the pod which is used
+	PidPercent int32 `protobuf:"varint,3,opt,name=pid_percent,json=pidPercent,proto3" json:"pid_percent,omitempty"`
+	// the directory in the container which is filled up to the storage bytes
+	StoragePath          string   `protobuf:"bytes,4,opt,name=storage_path,json=storagePath,proto3" json:"storage_path,omitempty"`
+	StorageBytes         int64    `protobuf:"varint,5,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExhaustRequest) Reset()         { *m = ExhaustRequest{} }
+func (m *ExhaustRequest) String() string { return proto.CompactTextString(m) }
+func (*ExhaustRequest) ProtoMessage()    {}
+func (*ExhaustRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{41}
+}
+func (m *ExhaustRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExhaustRequest.Unmarshal(m, b)
+}
+func (m *ExhaustRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExhaustRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExhaustRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExhaustRequest.Merge(dst, src)
+}
+func (m *ExhaustRequest) XXX_Size() int {
+	return xxx_messageInfo_ExhaustRequest.Size(m)
+}
+func (m *ExhaustRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExhaustRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExhaustRequest proto.InternalMessageInfo
+
+func (m *ExhaustRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *ExhaustRequest) GetFdPercent() int32 {
+	if m != nil {
+		return m.FdPercent
+	}
+	return 0
+}
+
+func (m *ExhaustRequest) GetPidPercent() int32 {
+	if m != nil {
+		return m.PidPercent
+	}
+	return 0
+}
+
+func (m *ExhaustRequest) GetStoragePath() string {
+	if m != nil {
+		return m.StoragePath
+	}
+	return ""
+}
+
+func (m *ExhaustRequest) GetStorageBytes() int64 {
+	if m != nil {
+		return m.StorageBytes
+	}
+	return 0
+}
+
+type TimerSlackRequest struct {
+	ContainerId          string   `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	SlackNs              uint64   `protobuf:"varint,2,opt,name=slack_ns,json=slackNs,proto3" json:"slack_ns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimerSlackRequest) Reset()         { *m = TimerSlackRequest{} }
+func (m *TimerSlackRequest) String() string { return proto.CompactTextString(m) }
+func (*TimerSlackRequest) ProtoMessage()    {}
+func (*TimerSlackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{42}
+}
+func (m *TimerSlackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimerSlackRequest.Unmarshal(m, b)
+}
+func (m *TimerSlackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimerSlackRequest.Marshal(b, m, deterministic)
+}
+func (dst *TimerSlackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimerSlackRequest.Merge(dst, src)
+}
+func (m *TimerSlackRequest) XXX_Size() int {
+	return xxx_messageInfo_TimerSlackRequest.Size(m)
+}
+func (m *TimerSlackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimerSlackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimerSlackRequest proto.InternalMessageInfo
+
+func (m *TimerSlackRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *TimerSlackRequest) GetSlackNs() uint64 {
+	if m != nil {
+		return m.SlackNs
+	}
+	return 0
+}
+
+type SignalRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// the name or the number of the signal, such as SIGHUP, HUP or 1
+	Signal string `protobuf:"bytes,2,opt,name=signal,proto3" json:"signal,omitempty"`
+	// the index of the direct child of the main process, which are ordered by their start time
+	// and counted from 1, the main process receives the signal if it's 0
+	Child                uint32   `protobuf:"varint,3,opt,name=child,proto3" json:"child,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignalRequest) Reset()         { *m = SignalRequest{} }
+func (m *SignalRequest) String() string { return proto.CompactTextString(m) }
+func (*SignalRequest) ProtoMessage()    {}
+func (*SignalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{43}
+}
+func (m *SignalRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignalRequest.Unmarshal(m, b)
+}
+func (m *SignalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignalRequest.Marshal(b, m, deterministic)
+}
+func (dst *SignalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalRequest.Merge(dst, src)
+}
+func (m *SignalRequest) XXX_Size() int {
+	return xxx_messageInfo_SignalRequest.Size(m)
+}
+func (m *SignalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalRequest proto.InternalMessageInfo
+
+func (m *SignalRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *SignalRequest) GetSignal() string {
+	if m != nil {
+		return m.Signal
+	}
+	return ""
+}
+
+func (m *SignalRequest) GetChild() uint32 {
+	if m != nil {
+		return m.Child
+	}
+	return 0
+}
+
+type ConfigRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// the path which the volume is mounted on in the container
+	MountPath string `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	// the path of the file in the volume
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// the content which replaces the file if the corruption is empty
+	Content []byte `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// the corruption of the original content, which is truncate, random or empty
+	Corruption           string   `protobuf:"bytes,5,opt,name=corruption,proto3" json:"corruption,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfigRequest) Reset()         { *m = ConfigRequest{} }
+func (m *ConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfigRequest) ProtoMessage()    {}
+func (*ConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{44}
+}
+func (m *ConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigRequest.Unmarshal(m, b)
+}
+func (m *ConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigRequest.Marshal(b, m, deterministic)
+}
+func (dst *ConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigRequest.Merge(dst, src)
+}
+func (m *ConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfigRequest.Size(m)
+}
+func (m *ConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigRequest proto.InternalMessageInfo
+
+func (m *ConfigRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *ConfigRequest) GetMountPath() string {
+	if m != nil {
+		return m.MountPath
+	}
+	return ""
+}
+
+func (m *ConfigRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ConfigRequest) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *ConfigRequest) GetCorruption() string {
+	if m != nil {
+		return m.Corruption
+	}
+	return ""
+}
+
+type DBFaultRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// the protocol of the database, which is mysql, postgres, redis or s3
+	Protocol string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// the addresses or the cidrs of the database, the connections to all addresses are affected if it's empty
+	Addresses            []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Port                 uint32   `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Fault                *DBFault `protobuf:"bytes,5,opt,name=fault,proto3" json:"fault,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBFaultRequest) Reset()         { *m = DBFaultRequest{} }
+func (m *DBFaultRequest) String() string { return proto.CompactTextString(m) }
+func (*DBFaultRequest) ProtoMessage()    {}
+func (*DBFaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{45}
+}
+func (m *DBFaultRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DBFaultRequest.Unmarshal(m, b)
+}
+func (m *DBFaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DBFaultRequest.Marshal(b, m, deterministic)
+}
+func (dst *DBFaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBFaultRequest.Merge(dst, src)
+}
+func (m *DBFaultRequest) XXX_Size() int {
+	return xxx_messageInfo_DBFaultRequest.Size(m)
+}
+func (m *DBFaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBFaultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBFaultRequest proto.InternalMessageInfo
+
+func (m *DBFaultRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *DBFaultRequest) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *DBFaultRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *DBFaultRequest) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *DBFaultRequest) GetFault() *DBFault {
+	if m != nil {
+		return m.Fault
+	}
+	return nil
+}
+
+type DBFault struct {
+	// the action of the fault, which is query-delay, query-error, connection-kill or partial-read
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// the regular expression of the queries, all queries match if it's empty
+	Query   string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	DelayNs uint64 `protobuf:"varint,3,opt,name=delay_ns,json=delayNs,proto3" json:"delay_ns,omitempty"`
+	// the error code of MySQL
+	ErrorCode uint32 `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// the SQLSTATE of the error
+	SqlState     string `protobuf:"bytes,5,opt,name=sql_state,json=sqlState,proto3" json:"sql_state,omitempty"`
+	ErrorMessage string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// the percent of the matched queries which are affected, all of them are affected if it's 0
+	Percent uint32 `protobuf:"varint,7,opt,name=percent,proto3" json:"percent,omitempty"`
+	// only affect the queries in a transaction
+	InTransaction        bool     `protobuf:"varint,8,opt,name=in_transaction,json=inTransaction,proto3" json:"in_transaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBFault) Reset()         { *m = DBFault{} }
+func (m *DBFault) String() string { return proto.CompactTextString(m) }
+func (*DBFault) ProtoMessage()    {}
+func (*DBFault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{46}
+}
+func (m *DBFault) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DBFault.Unmarshal(m, b)
+}
+func (m *DBFault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DBFault.Marshal(b, m, deterministic)
+}
+func (dst *DBFault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBFault.Merge(dst, src)
+}
+func (m *DBFault) XXX_Size() int {
+	return xxx_messageInfo_DBFault.Size(m)
+}
+func (m *DBFault) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBFault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBFault proto.InternalMessageInfo
+
+func (m *DBFault) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *DBFault) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *DBFault) GetDelayNs() uint64 {
+	if m != nil {
+		return m.DelayNs
+	}
+	return 0
+}
+
+func (m *DBFault) GetErrorCode() uint32 {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return 0
+}
+
+func (m *DBFault) GetSqlState() string {
+	if m != nil {
+		return m.SqlState
+	}
+	return ""
+}
+
+func (m *DBFault) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *DBFault) GetPercent() uint32 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+func (m *DBFault) GetInTransaction() bool {
+	if m != nil {
+		return m.InTransaction
+	}
+	return false
+}
+
+type DBFailoverRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// the protocol of the database, only redis supports the failover
+	Protocol string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// the port which the database or the sentinel listens on in the container
+	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// the mode of the failover, which is cluster or sentinel
+	Mode string `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	// the name of the master monitored by the sentinels
+	MasterName string `protobuf:"bytes,5,opt,name=master_name,json=masterName,proto3" json:"master_name,omitempty"`
+	Password   string `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	// fail over without the agreement of the master
+	Force                bool     `protobuf:"varint,7,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBFailoverRequest) Reset()         { *m = DBFailoverRequest{} }
+func (m *DBFailoverRequest) String() string { return proto.CompactTextString(m) }
+func (*DBFailoverRequest) ProtoMessage()    {}
+func (*DBFailoverRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaosdaemon_14e0473aa4844e3b, []int{47}
+}
+func (m *DBFailoverRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DBFailoverRequest.Unmarshal(m, b)
+}
+func (m *DBFailoverRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DBFailoverRequest.Marshal(b, m, deterministic)
+}
+func (dst *DBFailoverRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBFailoverRequest.Merge(dst, src)
+}
+func (m *DBFailoverRequest) XXX_Size() int {
+	return xxx_messageInfo_DBFailoverRequest.Size(m)
+}
+func (m *DBFailoverRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBFailoverRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBFailoverRequest proto.InternalMessageInfo
+
+func (m *DBFailoverRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *DBFailoverRequest) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *DBFailoverRequest) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *DBFailoverRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *DBFailoverRequest) GetMasterName() string {
+	if m != nil {
+		return m.MasterName
+	}
+	return ""
+}
+
+func (m *DBFailoverRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *DBFailoverRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*TcHandle)(nil), "chaosdaemon.TcHandle")
+	proto.RegisterType((*ContainerRequest)(nil), "chaosdaemon.ContainerRequest")
+	proto.RegisterType((*ContainerResponse)(nil), "chaosdaemon.ContainerResponse")
+	proto.RegisterType((*NetemRequest)(nil), "chaosdaemon.NetemRequest")
+	proto.RegisterType((*NetemProfile)(nil), "chaosdaemon.NetemProfile")
+	proto.RegisterType((*NetemSample)(nil), "chaosdaemon.NetemSample")
+	proto.RegisterType((*Netem)(nil), "chaosdaemon.Netem")
+	proto.RegisterType((*TbfRequest)(nil), "chaosdaemon.TbfRequest")
+	proto.RegisterType((*Tbf)(nil), "chaosdaemon.Tbf")
+	proto.RegisterType((*QdiscRequest)(nil), "chaosdaemon.QdiscRequest")
+	proto.RegisterType((*Qdisc)(nil), "chaosdaemon.Qdisc")
+	proto.RegisterType((*EmatchFilterRequest)(nil), "chaosdaemon.EmatchFilterRequest")
+	proto.RegisterType((*EmatchFilter)(nil), "chaosdaemon.EmatchFilter")
+	proto.RegisterType((*TcFilterRequest)(nil), "chaosdaemon.TcFilterRequest")
+	proto.RegisterType((*TcFilter)(nil), "chaosdaemon.TcFilter")
+	proto.RegisterType((*IpSetRequest)(nil), "chaosdaemon.IpSetRequest")
+	proto.RegisterType((*IpSet)(nil), "chaosdaemon.IpSet")
+	proto.RegisterType((*IpTablesRequest)(nil), "chaosdaemon.IpTablesRequest")
+	proto.RegisterType((*Rule)(nil), "chaosdaemon.Rule")
+	proto.RegisterType((*TimeRequest)(nil), "chaosdaemon.TimeRequest")
+	proto.RegisterType((*ContainerAction)(nil), "chaosdaemon.ContainerAction")
+	proto.RegisterType((*ExecStressRequest)(nil), "chaosdaemon.ExecStressRequest")
+	proto.RegisterType((*ExecStressResponse)(nil), "chaosdaemon.ExecStressResponse")
+	proto.RegisterType((*CancelStressRequest)(nil), "chaosdaemon.CancelStressRequest")
+	proto.RegisterType((*CleanupRequest)(nil), "chaosdaemon.CleanupRequest")
+	proto.RegisterType((*CleanupResponse)(nil), "chaosdaemon.CleanupResponse")
+	proto.RegisterType((*CapabilitiesResponse)(nil), "chaosdaemon.CapabilitiesResponse")
+	proto.RegisterType((*RuntimeStatus)(nil), "chaosdaemon.RuntimeStatus")
+	proto.RegisterType((*BatchNetemRequest)(nil), "chaosdaemon.BatchNetemRequest")
+	proto.RegisterType((*BatchTbfRequest)(nil), "chaosdaemon.BatchTbfRequest")
+	proto.RegisterType((*BatchIpSetRequest)(nil), "chaosdaemon.BatchIpSetRequest")
+	proto.RegisterType((*BatchIpTablesRequest)(nil), "chaosdaemon.BatchIpTablesRequest")
+	proto.RegisterType((*BatchResponse)(nil), "chaosdaemon.BatchResponse")
+	proto.RegisterType((*BpfFaultRequest)(nil), "chaosdaemon.BpfFaultRequest")
+	proto.RegisterType((*WatchStatusRequest)(nil), "chaosdaemon.WatchStatusRequest")
+	proto.RegisterType((*StatusEvent)(nil), "chaosdaemon.StatusEvent")
+	proto.RegisterType((*ConntrackRequest)(nil), "chaosdaemon.ConntrackRequest")
+	proto.RegisterType((*ConntrackResponse)(nil), "chaosdaemon.ConntrackResponse")
+	proto.RegisterType((*MtuRequest)(nil), "chaosdaemon.MtuRequest")
+	proto.RegisterType((*NodePortsRequest)(nil), "chaosdaemon.NodePortsRequest")
+	proto.RegisterType((*NodePort)(nil), "chaosdaemon.NodePort")
+	proto.RegisterType((*ExhaustRequest)(nil), "chaosdaemon.ExhaustRequest")
+	proto.RegisterType((*TimerSlackRequest)(nil), "chaosdaemon.TimerSlackRequest")
+	proto.RegisterType((*SignalRequest)(nil), "chaosdaemon.SignalRequest")
+	proto.RegisterType((*ConfigRequest)(nil), "chaosdaemon.ConfigRequest")
+	proto.RegisterType((*DBFaultRequest)(nil), "chaosdaemon.DBFaultRequest")
+	proto.RegisterType((*DBFault)(nil), "chaosdaemon.DBFault")
+	proto.RegisterType((*DBFailoverRequest)(nil), "chaosdaemon.DBFailoverRequest")
+	proto.RegisterEnum("chaosdaemon.Rule_Action", Rule_Action_name, Rule_Action_value)
+	proto.RegisterEnum("chaosdaemon.Rule_Direction", Rule_Direction_name, Rule_Direction_value)
+	proto.RegisterEnum("chaosdaemon.ContainerAction_Action", ContainerAction_Action_name, ContainerAction_Action_value)
+	proto.RegisterEnum("chaosdaemon.ExecStressRequest_Scope", ExecStressRequest_Scope_name, ExecStressRequest_Scope_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ChaosDaemonClient is the client API for ChaosDaemon service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ChaosDaemonClient interface {
+	SetNetem(ctx context.Context, in *NetemRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteNetem(ctx context.Context, in *NetemRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	SetTbf(ctx context.Context, in *TbfRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteTbf(ctx context.Context, in *TbfRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	AddQdisc(ctx context.Context, in *QdiscRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DelQdisc(ctx context.Context, in *QdiscRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	AddEmatchFilter(ctx context.Context, in *EmatchFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// generic tc filter del command
+	DelTcFilter(ctx context.Context, in *TcFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	FlushIpSet(ctx context.Context, in *IpSetRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	FlushIptables(ctx context.Context, in *IpTablesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	SetTimeOffset(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RecoverTimeOffset(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ContainerKill(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ContainerGetPid(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*ContainerResponse, error)
+	ExecStressors(ctx context.Context, in *ExecStressRequest, opts ...grpc.CallOption) (*ExecStressResponse, error)
+	CancelStressors(ctx context.Context, in *CancelStressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// remove the residual faults in the namespaces of containers even if the chaos is gone
+	Cleanup(ctx context.Context, in *CleanupRequest, opts ...grpc.CallOption) (*CleanupResponse, error)
+	// report what the node supports, so that the chaos which can't be injected
+	// on the node is rejected before any injection
+	GetCapabilities(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CapabilitiesResponse, error)
+	// the batch variants handle the requests of many containers in one call,
+	// the error of each request is reported in the response by order
+	BatchSetNetem(ctx context.Context, in *BatchNetemRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	BatchDeleteNetem(ctx context.Context, in *BatchNetemRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	BatchSetTbf(ctx context.Context, in *BatchTbfRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	BatchDeleteTbf(ctx context.Context, in *BatchTbfRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	BatchFlushIpSet(ctx context.Context, in *BatchIpSetRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	BatchFlushIptables(ctx context.Context, in *BatchIpTablesRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// inject delay, loss and partition by tc-bpf programs, which needs neither ifb devices nor iptables
+	SetBpfFault(ctx context.Context, in *BpfFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteBpfFault(ctx context.Context, in *BpfFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// WatchStatus streams the progress of the experiments on the node, i.e. the faults applied
+	// to the containers, the processes started and the failures in the middle of experiments
+	WatchStatus(ctx context.Context, in *WatchStatusRequest, opts ...grpc.CallOption) (ChaosDaemon_WatchStatusClient, error)
+	// exhaust the conntrack table of the node by lowering its max, which is restored once
+	// the limits of all the containers on the node are deleted
+	SetConntrackLimit(ctx context.Context, in *ConntrackRequest, opts ...grpc.CallOption) (*ConntrackResponse, error)
+	DeleteConntrackLimit(ctx context.Context, in *ConntrackRequest, opts ...grpc.CallOption) (*ConntrackResponse, error)
+	// change the mtu of the network interfaces of the container, the original mtu is restored on deletion
+	SetMtu(ctx context.Context, in *MtuRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteMtu(ctx context.Context, in *MtuRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// block the node ports on the node of the container, the packets to the ports on the local
+	// addresses of the node are dropped before they're forwarded by kube-proxy. All the rules
+	// added for the container are removed on unblocking, whatever the ports in the request are
+	BlockNodePorts(ctx context.Context, in *NodePortsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	UnblockNodePorts(ctx context.Context, in *NodePortsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// kill the sandbox of the pod which the container belongs to, kubelet creates the sandbox again
+	// and the pod gets a new ip from the network plugin
+	SandboxKill(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// exhaust the file descriptors of the container and the pids of its pod up to the percents
+	// of their limits, the resources are released on recovery
+	ExhaustResources(ctx context.Context, in *ExhaustRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RecoverResources(ctx context.Context, in *ExhaustRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// set the timer slack of the threads of the container processes, which delays their timers
+	// by up to the slack, the reset restores the default slack of the processes
+	SetTimerSlack(ctx context.Context, in *TimerSlackRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ResetTimerSlack(ctx context.Context, in *TimerSlackRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// send the signal to the main process of the container, or to the nth child of it
+	ContainerSignal(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// replace the file of the ConfigMap or Secret volume mounted in the container, the symlink of kubelet
+	// to the file is pointed to a corrupted copy, and the recovery points it back to the file of kubelet
+	CorruptConfig(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RecoverConfig(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// inject the faults of the database protocol into the connections of the container by a proxy in its
+	// network namespace, the connections to the addresses and the port are redirected to the proxy
+	SetDBFault(ctx context.Context, in *DBFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteDBFault(ctx context.Context, in *DBFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// force the failover of the database in the container, the replica of a Redis cluster node is promoted,
+	// or the sentinels fail over their master
+	FailoverDB(ctx context.Context, in *DBFailoverRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type chaosDaemonClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewChaosDaemonClient(cc *grpc.ClientConn) ChaosDaemonClient {
+	return &chaosDaemonClient{cc}
+}
+
+func (c *chaosDaemonClient) SetNetem(ctx context.Context, in *NetemRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/SetNetem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) DeleteNetem(ctx context.Context, in *NetemRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/DeleteNetem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) SetTbf(ctx context.Context, in *TbfRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/SetTbf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) DeleteTbf(ctx context.Context, in *TbfRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/DeleteTbf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) AddQdisc(ctx context.Context, in *QdiscRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/AddQdisc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) DelQdisc(ctx context.Context, in *QdiscRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/DelQdisc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) AddEmatchFilter(ctx context.Context, in *EmatchFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/AddEmatchFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) DelTcFilter(ctx context.Context, in *TcFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/DelTcFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) FlushIpSet(ctx context.Context, in *IpSetRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/FlushIpSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) FlushIptables(ctx context.Context, in *IpTablesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/FlushIptables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) SetTimeOffset(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/SetTimeOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) RecoverTimeOffset(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/RecoverTimeOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) ContainerKill(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/ContainerKill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) ContainerGetPid(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*ContainerResponse, error) {
+	out := new(ContainerResponse)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/ContainerGetPid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) ExecStressors(ctx context.Context, in *ExecStressRequest, opts ...grpc.CallOption) (*ExecStressResponse, error) {
+	out := new(ExecStressResponse)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/ExecStressors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) CancelStressors(ctx context.Context, in *CancelStressRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/CancelStressors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) Cleanup(ctx context.Context, in *CleanupRequest, opts ...grpc.CallOption) (*CleanupResponse, error) {
+	out := new(CleanupResponse)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/Cleanup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) GetCapabilities(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CapabilitiesResponse, error) {
+	out := new(CapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/GetCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) BatchSetNetem(ctx context.Context, in *BatchNetemRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/BatchSetNetem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) BatchDeleteNetem(ctx context.Context, in *BatchNetemRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/BatchDeleteNetem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) BatchSetTbf(ctx context.Context, in *BatchTbfRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/BatchSetTbf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) BatchDeleteTbf(ctx context.Context, in *BatchTbfRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/BatchDeleteTbf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) BatchFlushIpSet(ctx context.Context, in *BatchIpSetRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/BatchFlushIpSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) BatchFlushIptables(ctx context.Context, in *BatchIpTablesRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/BatchFlushIptables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) SetBpfFault(ctx context.Context, in *BpfFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/SetBpfFault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) DeleteBpfFault(ctx context.Context, in *BpfFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/DeleteBpfFault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) WatchStatus(ctx context.Context, in *WatchStatusRequest, opts ...grpc.CallOption) (ChaosDaemon_WatchStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ChaosDaemon_serviceDesc.Streams[0], "/chaosdaemon.ChaosDaemon/WatchStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chaosDaemonWatchStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChaosDaemon_WatchStatusClient interface {
+	Recv() (*StatusEvent, error)
+	grpc.ClientStream
+}
+
+type chaosDaemonWatchStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *chaosDaemonWatchStatusClient) Recv() (*StatusEvent, error) {
+	m := new(StatusEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chaosDaemonClient) SetConntrackLimit(ctx context.Context, in *ConntrackRequest, opts ...grpc.CallOption) (*ConntrackResponse, error) {
+	out := new(ConntrackResponse)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/SetConntrackLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) DeleteConntrackLimit(ctx context.Context, in *ConntrackRequest, opts ...grpc.CallOption) (*ConntrackResponse, error) {
+	out := new(ConntrackResponse)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/DeleteConntrackLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) SetMtu(ctx context.Context, in *MtuRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/AddEmatchFilter", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/SetMtu", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) DeleteMtu(ctx context.Context, in *MtuRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/DeleteMtu", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) BlockNodePorts(ctx context.Context, in *NodePortsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/BlockNodePorts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) UnblockNodePorts(ctx context.Context, in *NodePortsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/UnblockNodePorts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) SandboxKill(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/SandboxKill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) ExhaustResources(ctx context.Context, in *ExhaustRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/ExhaustResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) RecoverResources(ctx context.Context, in *ExhaustRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/RecoverResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) SetTimerSlack(ctx context.Context, in *TimerSlackRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/SetTimerSlack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) ResetTimerSlack(ctx context.Context, in *TimerSlackRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/ResetTimerSlack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) ContainerSignal(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/ContainerSignal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) CorruptConfig(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/CorruptConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) RecoverConfig(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/RecoverConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) SetDBFault(ctx context.Context, in *DBFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/SetDBFault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) DeleteDBFault(ctx context.Context, in *DBFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/DeleteDBFault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) FailoverDB(ctx context.Context, in *DBFailoverRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/FailoverDB", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+// ChaosDaemonServer is the server API for ChaosDaemon service.
+type ChaosDaemonServer interface {
+	SetNetem(context.Context, *NetemRequest) (*empty.Empty, error)
+	DeleteNetem(context.Context, *NetemRequest) (*empty.Empty, error)
+	SetTbf(context.Context, *TbfRequest) (*empty.Empty, error)
+	DeleteTbf(context.Context, *TbfRequest) (*empty.Empty, error)
+	AddQdisc(context.Context, *QdiscRequest) (*empty.Empty, error)
+	DelQdisc(context.Context, *QdiscRequest) (*empty.Empty, error)
+	AddEmatchFilter(context.Context, *EmatchFilterRequest) (*empty.Empty, error)
+	// generic tc filter del command
+	DelTcFilter(context.Context, *TcFilterRequest) (*empty.Empty, error)
+	FlushIpSet(context.Context, *IpSetRequest) (*empty.Empty, error)
+	FlushIptables(context.Context, *IpTablesRequest) (*empty.Empty, error)
+	SetTimeOffset(context.Context, *TimeRequest) (*empty.Empty, error)
+	RecoverTimeOffset(context.Context, *TimeRequest) (*empty.Empty, error)
+	ContainerKill(context.Context, *ContainerRequest) (*empty.Empty, error)
+	ContainerGetPid(context.Context, *ContainerRequest) (*ContainerResponse, error)
+	ExecStressors(context.Context, *ExecStressRequest) (*ExecStressResponse, error)
+	CancelStressors(context.Context, *CancelStressRequest) (*empty.Empty, error)
+	// remove the residual faults in the namespaces of containers even if the chaos is gone
+	Cleanup(context.Context, *CleanupRequest) (*CleanupResponse, error)
+	// report what the node supports, so that the chaos which can't be injected
+	// on the node is rejected before any injection
+	GetCapabilities(context.Context, *empty.Empty) (*CapabilitiesResponse, error)
+	// the batch variants handle the requests of many containers in one call,
+	// the error of each request is reported in the response by order
+	BatchSetNetem(context.Context, *BatchNetemRequest) (*BatchResponse, error)
+	BatchDeleteNetem(context.Context, *BatchNetemRequest) (*BatchResponse, error)
+	BatchSetTbf(context.Context, *BatchTbfRequest) (*BatchResponse, error)
+	BatchDeleteTbf(context.Context, *BatchTbfRequest) (*BatchResponse, error)
+	BatchFlushIpSet(context.Context, *BatchIpSetRequest) (*BatchResponse, error)
+	BatchFlushIptables(context.Context, *BatchIpTablesRequest) (*BatchResponse, error)
+	// inject delay, loss and partition by tc-bpf programs, which needs neither ifb devices nor iptables
+	SetBpfFault(context.Context, *BpfFaultRequest) (*empty.Empty, error)
+	DeleteBpfFault(context.Context, *BpfFaultRequest) (*empty.Empty, error)
+	// WatchStatus streams the progress of the experiments on the node, i.e. the faults applied
+	// to the containers, the processes started and the failures in the middle of experiments
+	WatchStatus(*WatchStatusRequest, ChaosDaemon_WatchStatusServer) error
+	// exhaust the conntrack table of the node by lowering its max, which is restored once
+	// the limits of all the containers on the node are deleted
+	SetConntrackLimit(context.Context, *ConntrackRequest) (*ConntrackResponse, error)
+	DeleteConntrackLimit(context.Context, *ConntrackRequest) (*ConntrackResponse, error)
+	// change the mtu of the network interfaces of the container, the original mtu is restored on deletion
+	SetMtu(context.Context, *MtuRequest) (*empty.Empty, error)
+	DeleteMtu(context.Context, *MtuRequest) (*empty.Empty, error)
+	// block the node ports on the node of the container, the packets to the ports on the local
+	// addresses of the node are dropped before they're forwarded by kube-proxy. All the rules
+	// added for the container are removed on unblocking, whatever the ports in the request are
+	BlockNodePorts(context.Context, *NodePortsRequest) (*empty.Empty, error)
+	UnblockNodePorts(context.Context, *NodePortsRequest) (*empty.Empty, error)
+	// kill the sandbox of the pod which the container belongs to, kubelet creates the sandbox again
+	// and the pod gets a new ip from the network plugin
+	SandboxKill(context.Context, *ContainerRequest) (*empty.Empty, error)
+	// exhaust the file descriptors of the container and the pids of its pod up to the percents
+	// of their limits, the resources are released on recovery
+	ExhaustResources(context.Context, *ExhaustRequest) (*empty.Empty, error)
+	RecoverResources(context.Context, *ExhaustRequest) (*empty.Empty, error)
+	// set the timer slack of the threads of the container processes, which delays their timers
+	// by up to the slack, the reset restores the default slack of the processes
+	SetTimerSlack(context.Context, *TimerSlackRequest) (*empty.Empty, error)
+	ResetTimerSlack(context.Context, *TimerSlackRequest) (*empty.Empty, error)
+	// send the signal to the main process of the container, or to the nth child of it
+	ContainerSignal(context.Context, *SignalRequest) (*empty.Empty, error)
+	// replace the file of the ConfigMap or Secret volume mounted in the container, the symlink of kubelet
+	// to the file is pointed to a corrupted copy, and the recovery points it back to the file of kubelet
+	CorruptConfig(context.Context, *ConfigRequest) (*empty.Empty, error)
+	RecoverConfig(context.Context, *ConfigRequest) (*empty.Empty, error)
+	// inject the faults of the database protocol into the connections of the container by a proxy in its
+	// network namespace, the connections to the addresses and the port are redirected to the proxy
+	SetDBFault(context.Context, *DBFaultRequest) (*empty.Empty, error)
+	DeleteDBFault(context.Context, *DBFaultRequest) (*empty.Empty, error)
+	// force the failover of the database in the container, the replica of a Redis cluster node is promoted,
+	// or the sentinels fail over their master
+	FailoverDB(context.Context, *DBFailoverRequest) (*empty.Empty, error)
+}
+
+func RegisterChaosDaemonServer(s *grpc.Server, srv ChaosDaemonServer) {
+	s.RegisterService(&_ChaosDaemon_serviceDesc, srv)
+}
+
+func _ChaosDaemon_SetNetem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).SetNetem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/SetNetem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).SetNetem(ctx, req.(*NetemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_DeleteNetem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).DeleteNetem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/DeleteNetem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).DeleteNetem(ctx, req.(*NetemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_SetTbf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TbfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).SetTbf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/SetTbf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).SetTbf(ctx, req.(*TbfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_DeleteTbf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TbfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).DeleteTbf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/DeleteTbf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).DeleteTbf(ctx, req.(*TbfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_AddQdisc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QdiscRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).AddQdisc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/AddQdisc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).AddQdisc(ctx, req.(*QdiscRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_DelQdisc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QdiscRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).DelQdisc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/DelQdisc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).DelQdisc(ctx, req.(*QdiscRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_AddEmatchFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmatchFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).AddEmatchFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/AddEmatchFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).AddEmatchFilter(ctx, req.(*EmatchFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chaosDaemonClient) DelTcFilter(ctx context.Context, in *TcFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/DelTcFilter", in, out, opts...)
-	if err != nil {
+func _ChaosDaemon_DelTcFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TcFilterRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).DelTcFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/DelTcFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).DelTcFilter(ctx, req.(*TcFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chaosDaemonClient) FlushIpSet(ctx context.Context, in *IpSetRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/FlushIpSet", in, out, opts...)
-	if err != nil {
+func _ChaosDaemon_FlushIpSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IpSetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).FlushIpSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/FlushIpSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).FlushIpSet(ctx, req.(*IpSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_FlushIptables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IpTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).FlushIptables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/FlushIptables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).FlushIptables(ctx, req.(*IpTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_SetTimeOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).SetTimeOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/SetTimeOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).SetTimeOffset(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_RecoverTimeOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).RecoverTimeOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/RecoverTimeOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).RecoverTimeOffset(ctx, req.(*TimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_ContainerKill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).ContainerKill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/ContainerKill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).ContainerKill(ctx, req.(*ContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_ContainerGetPid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).ContainerGetPid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/ContainerGetPid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).ContainerGetPid(ctx, req.(*ContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_ExecStressors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecStressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).ExecStressors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/ExecStressors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).ExecStressors(ctx, req.(*ExecStressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_CancelStressors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelStressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).CancelStressors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/CancelStressors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).CancelStressors(ctx, req.(*CancelStressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_Cleanup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).Cleanup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/Cleanup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).Cleanup(ctx, req.(*CleanupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/GetCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).GetCapabilities(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_BatchSetNetem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchNetemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).BatchSetNetem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/BatchSetNetem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).BatchSetNetem(ctx, req.(*BatchNetemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_BatchDeleteNetem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchNetemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).BatchDeleteNetem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/BatchDeleteNetem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).BatchDeleteNetem(ctx, req.(*BatchNetemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_BatchSetTbf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTbfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).BatchSetTbf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/BatchSetTbf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).BatchSetTbf(ctx, req.(*BatchTbfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chaosDaemonClient) FlushIptables(ctx context.Context, in *IpTablesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/FlushIptables", in, out, opts...)
-	if err != nil {
+func _ChaosDaemon_BatchDeleteTbf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTbfRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).BatchDeleteTbf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/BatchDeleteTbf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).BatchDeleteTbf(ctx, req.(*BatchTbfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chaosDaemonClient) SetTimeOffset(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/SetTimeOffset", in, out, opts...)
-	if err != nil {
+func _ChaosDaemon_BatchFlushIpSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchIpSetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).BatchFlushIpSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/BatchFlushIpSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).BatchFlushIpSet(ctx, req.(*BatchIpSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chaosDaemonClient) RecoverTimeOffset(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/RecoverTimeOffset", in, out, opts...)
-	if err != nil {
+func _ChaosDaemon_BatchFlushIptables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchIpTablesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).BatchFlushIptables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/BatchFlushIptables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).BatchFlushIptables(ctx, req.(*BatchIpTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chaosDaemonClient) ContainerKill(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/ContainerKill", in, out, opts...)
-	if err != nil {
+func _ChaosDaemon_SetBpfFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BpfFaultRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).SetBpfFault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/SetBpfFault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).SetBpfFault(ctx, req.(*BpfFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chaosDaemonClient) ContainerGetPid(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*ContainerResponse, error) {
-	out := new(ContainerResponse)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/ContainerGetPid", in, out, opts...)
-	if err != nil {
+func _ChaosDaemon_DeleteBpfFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BpfFaultRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).DeleteBpfFault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/DeleteBpfFault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).DeleteBpfFault(ctx, req.(*BpfFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chaosDaemonClient) ExecStressors(ctx context.Context, in *ExecStressRequest, opts ...grpc.CallOption) (*ExecStressResponse, error) {
-	out := new(ExecStressResponse)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/ExecStressors", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _ChaosDaemon_WatchStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(ChaosDaemonServer).WatchStatus(m, &chaosDaemonWatchStatusServer{stream})
 }
 
-func (c *chaosDaemonClient) CancelStressors(ctx context.Context, in *CancelStressRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/chaosdaemon.ChaosDaemon/CancelStressors", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ChaosDaemon_WatchStatusServer interface {
+	Send(*StatusEvent) error
+	grpc.ServerStream
 }
 
-// ChaosDaemonServer is the server API for ChaosDaemon service.
-type ChaosDaemonServer interface {
-	SetNetem(context.Context, *NetemRequest) (*empty.Empty, error)
-	DeleteNetem(context.Context, *NetemRequest) (*empty.Empty, error)
-	SetTbf(context.Context, *TbfRequest) (*empty.Empty, error)
-	DeleteTbf(context.Context, *TbfRequest) (*empty.Empty, error)
-	AddQdisc(context.Context, *QdiscRequest) (*empty.Empty, error)
-	DelQdisc(context.Context, *QdiscRequest) (*empty.Empty, error)
-	AddEmatchFilter(context.Context, *EmatchFilterRequest) (*empty.Empty, error)
-	// generic tc filter del command
-	DelTcFilter(context.Context, *TcFilterRequest) (*empty.Empty, error)
-	FlushIpSet(context.Context, *IpSetRequest) (*empty.Empty, error)
-	FlushIptables(context.Context, *IpTablesRequest) (*empty.Empty, error)
-	SetTimeOffset(context.Context, *TimeRequest) (*empty.Empty, error)
-	RecoverTimeOffset(context.Context, *TimeRequest) (*empty.Empty, error)
-	ContainerKill(context.Context, *ContainerRequest) (*empty.Empty, error)
-	ContainerGetPid(context.Context, *ContainerRequest) (*ContainerResponse, error)
-	ExecStressors(context.Context, *ExecStressRequest) (*ExecStressResponse, error)
-	CancelStressors(context.Context, *CancelStressRequest) (*empty.Empty, error)
+type chaosDaemonWatchStatusServer struct {
+	grpc.ServerStream
 }
 
-func RegisterChaosDaemonServer(s *grpc.Server, srv ChaosDaemonServer) {
-	s.RegisterService(&_ChaosDaemon_serviceDesc, srv)
+func (x *chaosDaemonWatchStatusServer) Send(m *StatusEvent) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _ChaosDaemon_SetNetem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NetemRequest)
+func _ChaosDaemon_SetConntrackLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConntrackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).SetNetem(ctx, in)
+		return srv.(ChaosDaemonServer).SetConntrackLimit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/SetNetem",
+		FullMethod: "/chaosdaemon.ChaosDaemon/SetConntrackLimit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).SetNetem(ctx, req.(*NetemRequest))
+		return srv.(ChaosDaemonServer).SetConntrackLimit(ctx, req.(*ConntrackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_DeleteNetem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NetemRequest)
+func _ChaosDaemon_DeleteConntrackLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConntrackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).DeleteNetem(ctx, in)
+		return srv.(ChaosDaemonServer).DeleteConntrackLimit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/DeleteNetem",
+		FullMethod: "/chaosdaemon.ChaosDaemon/DeleteConntrackLimit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).DeleteNetem(ctx, req.(*NetemRequest))
+		return srv.(ChaosDaemonServer).DeleteConntrackLimit(ctx, req.(*ConntrackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_SetTbf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TbfRequest)
+func _ChaosDaemon_SetMtu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MtuRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).SetTbf(ctx, in)
+		return srv.(ChaosDaemonServer).SetMtu(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/SetTbf",
+		FullMethod: "/chaosdaemon.ChaosDaemon/SetMtu",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).SetTbf(ctx, req.(*TbfRequest))
+		return srv.(ChaosDaemonServer).SetMtu(ctx, req.(*MtuRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_DeleteTbf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TbfRequest)
+func _ChaosDaemon_DeleteMtu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MtuRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).DeleteTbf(ctx, in)
+		return srv.(ChaosDaemonServer).DeleteMtu(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/DeleteTbf",
+		FullMethod: "/chaosdaemon.ChaosDaemon/DeleteMtu",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).DeleteTbf(ctx, req.(*TbfRequest))
+		return srv.(ChaosDaemonServer).DeleteMtu(ctx, req.(*MtuRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_AddQdisc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QdiscRequest)
+func _ChaosDaemon_BlockNodePorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodePortsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).AddQdisc(ctx, in)
+		return srv.(ChaosDaemonServer).BlockNodePorts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/AddQdisc",
+		FullMethod: "/chaosdaemon.ChaosDaemon/BlockNodePorts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).AddQdisc(ctx, req.(*QdiscRequest))
+		return srv.(ChaosDaemonServer).BlockNodePorts(ctx, req.(*NodePortsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_DelQdisc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QdiscRequest)
+func _ChaosDaemon_UnblockNodePorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodePortsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).DelQdisc(ctx, in)
+		return srv.(ChaosDaemonServer).UnblockNodePorts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/DelQdisc",
+		FullMethod: "/chaosdaemon.ChaosDaemon/UnblockNodePorts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).DelQdisc(ctx, req.(*QdiscRequest))
+		return srv.(ChaosDaemonServer).UnblockNodePorts(ctx, req.(*NodePortsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_AddEmatchFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmatchFilterRequest)
+func _ChaosDaemon_SandboxKill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).AddEmatchFilter(ctx, in)
+		return srv.(ChaosDaemonServer).SandboxKill(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/AddEmatchFilter",
+		FullMethod: "/chaosdaemon.ChaosDaemon/SandboxKill",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).AddEmatchFilter(ctx, req.(*EmatchFilterRequest))
+		return srv.(ChaosDaemonServer).SandboxKill(ctx, req.(*ContainerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_DelTcFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TcFilterRequest)
+func _ChaosDaemon_ExhaustResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExhaustRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).DelTcFilter(ctx, in)
+		return srv.(ChaosDaemonServer).ExhaustResources(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/DelTcFilter",
+		FullMethod: "/chaosdaemon.ChaosDaemon/ExhaustResources",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).DelTcFilter(ctx, req.(*TcFilterRequest))
+		return srv.(ChaosDaemonServer).ExhaustResources(ctx, req.(*ExhaustRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_FlushIpSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IpSetRequest)
+func _ChaosDaemon_RecoverResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExhaustRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).FlushIpSet(ctx, in)
+		return srv.(ChaosDaemonServer).RecoverResources(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/FlushIpSet",
+		FullMethod: "/chaosdaemon.ChaosDaemon/RecoverResources",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).FlushIpSet(ctx, req.(*IpSetRequest))
+		return srv.(ChaosDaemonServer).RecoverResources(ctx, req.(*ExhaustRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_FlushIptables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IpTablesRequest)
+func _ChaosDaemon_SetTimerSlack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimerSlackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).FlushIptables(ctx, in)
+		return srv.(ChaosDaemonServer).SetTimerSlack(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/FlushIptables",
+		FullMethod: "/chaosdaemon.ChaosDaemon/SetTimerSlack",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).FlushIptables(ctx, req.(*IpTablesRequest))
+		return srv.(ChaosDaemonServer).SetTimerSlack(ctx, req.(*TimerSlackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_SetTimeOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TimeRequest)
+func _ChaosDaemon_ResetTimerSlack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimerSlackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).SetTimeOffset(ctx, in)
+		return srv.(ChaosDaemonServer).ResetTimerSlack(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/SetTimeOffset",
+		FullMethod: "/chaosdaemon.ChaosDaemon/ResetTimerSlack",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).SetTimeOffset(ctx, req.(*TimeRequest))
+		return srv.(ChaosDaemonServer).ResetTimerSlack(ctx, req.(*TimerSlackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_RecoverTimeOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TimeRequest)
+func _ChaosDaemon_ContainerSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).RecoverTimeOffset(ctx, in)
+		return srv.(ChaosDaemonServer).ContainerSignal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/RecoverTimeOffset",
+		FullMethod: "/chaosdaemon.ChaosDaemon/ContainerSignal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).RecoverTimeOffset(ctx, req.(*TimeRequest))
+		return srv.(ChaosDaemonServer).ContainerSignal(ctx, req.(*SignalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_ContainerKill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerRequest)
+func _ChaosDaemon_CorruptConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).ContainerKill(ctx, in)
+		return srv.(ChaosDaemonServer).CorruptConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/ContainerKill",
+		FullMethod: "/chaosdaemon.ChaosDaemon/CorruptConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).ContainerKill(ctx, req.(*ContainerRequest))
+		return srv.(ChaosDaemonServer).CorruptConfig(ctx, req.(*ConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_ContainerGetPid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerRequest)
+func _ChaosDaemon_RecoverConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).ContainerGetPid(ctx, in)
+		return srv.(ChaosDaemonServer).RecoverConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/ContainerGetPid",
+		FullMethod: "/chaosdaemon.ChaosDaemon/RecoverConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).ContainerGetPid(ctx, req.(*ContainerRequest))
+		return srv.(ChaosDaemonServer).RecoverConfig(ctx, req.(*ConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_ExecStressors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecStressRequest)
+func _ChaosDaemon_SetDBFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DBFaultRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).ExecStressors(ctx, in)
+		return srv.(ChaosDaemonServer).SetDBFault(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/ExecStressors",
+		FullMethod: "/chaosdaemon.ChaosDaemon/SetDBFault",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).ExecStressors(ctx, req.(*ExecStressRequest))
+		return srv.(ChaosDaemonServer).SetDBFault(ctx, req.(*DBFaultRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ChaosDaemon_CancelStressors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelStressRequest)
+func _ChaosDaemon_DeleteDBFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DBFaultRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChaosDaemonServer).CancelStressors(ctx, in)
+		return srv.(ChaosDaemonServer).DeleteDBFault(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chaosdaemon.ChaosDaemon/CancelStressors",
+		FullMethod: "/chaosdaemon.ChaosDaemon/DeleteDBFault",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChaosDaemonServer).CancelStressors(ctx, req.(*CancelStressRequest))
+		return srv.(ChaosDaemonServer).DeleteDBFault(ctx, req.(*DBFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_FailoverDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DBFailoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).FailoverDB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaosdaemon.ChaosDaemon/FailoverDB",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).FailoverDB(ctx, req.(*DBFailoverRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1901,94 +4483,308 @@ var _ChaosDaemon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelStressors",
 			Handler:    _ChaosDaemon_CancelStressors_Handler,
 		},
+		{
+			MethodName: "Cleanup",
+			Handler:    _ChaosDaemon_Cleanup_Handler,
+		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _ChaosDaemon_GetCapabilities_Handler,
+		},
+		{
+			MethodName: "BatchSetNetem",
+			Handler:    _ChaosDaemon_BatchSetNetem_Handler,
+		},
+		{
+			MethodName: "BatchDeleteNetem",
+			Handler:    _ChaosDaemon_BatchDeleteNetem_Handler,
+		},
+		{
+			MethodName: "BatchSetTbf",
+			Handler:    _ChaosDaemon_BatchSetTbf_Handler,
+		},
+		{
+			MethodName: "BatchDeleteTbf",
+			Handler:    _ChaosDaemon_BatchDeleteTbf_Handler,
+		},
+		{
+			MethodName: "BatchFlushIpSet",
+			Handler:    _ChaosDaemon_BatchFlushIpSet_Handler,
+		},
+		{
+			MethodName: "BatchFlushIptables",
+			Handler:    _ChaosDaemon_BatchFlushIptables_Handler,
+		},
+		{
+			MethodName: "SetBpfFault",
+			Handler:    _ChaosDaemon_SetBpfFault_Handler,
+		},
+		{
+			MethodName: "DeleteBpfFault",
+			Handler:    _ChaosDaemon_DeleteBpfFault_Handler,
+		},
+		{
+			MethodName: "SetConntrackLimit",
+			Handler:    _ChaosDaemon_SetConntrackLimit_Handler,
+		},
+		{
+			MethodName: "DeleteConntrackLimit",
+			Handler:    _ChaosDaemon_DeleteConntrackLimit_Handler,
+		},
+		{
+			MethodName: "SetMtu",
+			Handler:    _ChaosDaemon_SetMtu_Handler,
+		},
+		{
+			MethodName: "DeleteMtu",
+			Handler:    _ChaosDaemon_DeleteMtu_Handler,
+		},
+		{
+			MethodName: "BlockNodePorts",
+			Handler:    _ChaosDaemon_BlockNodePorts_Handler,
+		},
+		{
+			MethodName: "UnblockNodePorts",
+			Handler:    _ChaosDaemon_UnblockNodePorts_Handler,
+		},
+		{
+			MethodName: "SandboxKill",
+			Handler:    _ChaosDaemon_SandboxKill_Handler,
+		},
+		{
+			MethodName: "ExhaustResources",
+			Handler:    _ChaosDaemon_ExhaustResources_Handler,
+		},
+		{
+			MethodName: "RecoverResources",
+			Handler:    _ChaosDaemon_RecoverResources_Handler,
+		},
+		{
+			MethodName: "SetTimerSlack",
+			Handler:    _ChaosDaemon_SetTimerSlack_Handler,
+		},
+		{
+			MethodName: "ResetTimerSlack",
+			Handler:    _ChaosDaemon_ResetTimerSlack_Handler,
+		},
+		{
+			MethodName: "ContainerSignal",
+			Handler:    _ChaosDaemon_ContainerSignal_Handler,
+		},
+		{
+			MethodName: "CorruptConfig",
+			Handler:    _ChaosDaemon_CorruptConfig_Handler,
+		},
+		{
+			MethodName: "RecoverConfig",
+			Handler:    _ChaosDaemon_RecoverConfig_Handler,
+		},
+		{
+			MethodName: "SetDBFault",
+			Handler:    _ChaosDaemon_SetDBFault_Handler,
+		},
+		{
+			MethodName: "DeleteDBFault",
+			Handler:    _ChaosDaemon_DeleteDBFault_Handler,
+		},
+		{
+			MethodName: "FailoverDB",
+			Handler:    _ChaosDaemon_FailoverDB_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStatus",
+			Handler:       _ChaosDaemon_WatchStatus_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "chaosdaemon.proto",
 }
 
-func init() { proto.RegisterFile("chaosdaemon.proto", fileDescriptor_chaosdaemon_be9ad25a34a9c4f2) }
-
-var fileDescriptor_chaosdaemon_be9ad25a34a9c4f2 = []byte{
-	// 1290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdb, 0x92, 0xda, 0x46,
-	0x13, 0xb6, 0x38, 0x2d, 0x6a, 0xc0, 0xb0, 0xe3, 0xff, 0x77, 0xb4, 0xbb, 0x3e, 0x6c, 0x94, 0xb8,
-	0xca, 0x37, 0xc6, 0xb1, 0x93, 0x4a, 0x95, 0xe3, 0xaa, 0xb8, 0x30, 0x60, 0x9b, 0xb2, 0xf7, 0x10,
-	0x81, 0xaf, 0x7c, 0x41, 0x09, 0x69, 0x60, 0xc7, 0xe8, 0x64, 0xcd, 0x90, 0xf2, 0x5e, 0x26, 0x95,
-	0xdb, 0xbc, 0x46, 0xde, 0x21, 0x6f, 0xe0, 0xc7, 0x4a, 0xcd, 0x41, 0x20, 0xb1, 0x2c, 0xb0, 0xde,
-	0x2b, 0x4d, 0xf7, 0x7c, 0xfd, 0x4d, 0x4f, 0x77, 0xab, 0x7b, 0x60, 0xd7, 0x39, 0xb3, 0x43, 0xea,
-	0xda, 0xd8, 0x0f, 0x83, 0x66, 0x14, 0x87, 0x2c, 0x44, 0x95, 0x94, 0x6a, 0xff, 0x60, 0x12, 0x86,
-	0x13, 0x0f, 0x3f, 0x16, 0x5b, 0xa3, 0xd9, 0xf8, 0x31, 0xf6, 0x23, 0x76, 0x2e, 0x91, 0xe6, 0xcf,
-	0x50, 0x1e, 0x38, 0x6f, 0xec, 0xc0, 0xf5, 0x30, 0xfa, 0x1f, 0x14, 0x7d, 0xfb, 0x63, 0x18, 0x1b,
-	0xda, 0xa1, 0xf6, 0xb0, 0x66, 0x49, 0x41, 0x68, 0x49, 0x10, 0xc6, 0x46, 0x4e, 0x69, 0xb9, 0x60,
-	0x4e, 0xa1, 0xd1, 0x0e, 0x03, 0x66, 0x93, 0x00, 0xc7, 0x16, 0xfe, 0x34, 0xc3, 0x94, 0xa1, 0x9f,
-	0xa0, 0x64, 0x3b, 0x8c, 0x84, 0x81, 0x20, 0xa8, 0x3c, 0xbd, 0xd3, 0x4c, 0x7b, 0x36, 0x87, 0xb7,
-	0x04, 0xc6, 0x52, 0x58, 0xf4, 0x2d, 0x54, 0x9d, 0x64, 0x6b, 0x48, 0x5c, 0x71, 0x8c, 0x6e, 0x55,
-	0xe6, 0xba, 0x9e, 0x6b, 0x3e, 0x80, 0xdd, 0xd4, 0x61, 0x34, 0x0a, 0x03, 0x8a, 0x51, 0x03, 0xf2,
-	0x11, 0x71, 0x95, 0xaf, 0x7c, 0x69, 0xfe, 0xab, 0x41, 0xf5, 0x18, 0x33, 0xec, 0x27, 0x0e, 0x3d,
-	0x84, 0x62, 0xc0, 0x65, 0xe5, 0x0f, 0xca, 0xf8, 0x23, 0x91, 0x12, 0xb0, 0x85, 0x13, 0xe8, 0x11,
-	0x94, 0xce, 0x44, 0x9c, 0x8c, 0xbc, 0x60, 0xfb, 0x7f, 0x86, 0x2d, 0x09, 0xa2, 0xa5, 0x40, 0x1c,
-	0x1e, 0xd9, 0x31, 0x0e, 0x98, 0x51, 0x58, 0x0b, 0x97, 0x20, 0xf3, 0x4b, 0x1e, 0x8a, 0xc2, 0x23,
-	0x84, 0xa0, 0xc0, 0x88, 0x8f, 0xd5, 0xc5, 0xc4, 0x1a, 0xdd, 0x86, 0xd2, 0x47, 0xc2, 0x18, 0x4e,
-	0x92, 0xa0, 0x24, 0x74, 0x17, 0xc0, 0xc5, 0x9e, 0x7d, 0x3e, 0x74, 0xc2, 0x38, 0x16, 0x7e, 0xe5,
-	0x2c, 0x5d, 0x68, 0xda, 0x61, 0x2c, 0x52, 0xe7, 0x11, 0x9f, 0x48, 0x17, 0x6a, 0x96, 0x14, 0xf8,
-	0x01, 0x5e, 0x48, 0xa9, 0x51, 0x14, 0x70, 0xb1, 0x46, 0x07, 0xa0, 0xf3, 0xaf, 0xe4, 0x29, 0x89,
-	0x8d, 0x32, 0x57, 0x08, 0x9a, 0x06, 0xe4, 0x27, 0x76, 0x64, 0xec, 0xc8, 0x48, 0x4f, 0xec, 0x08,
-	0xdd, 0x01, 0xdd, 0x9d, 0x45, 0x1e, 0x71, 0x6c, 0x86, 0x8d, 0xb2, 0x3a, 0x36, 0x51, 0xa0, 0x07,
-	0x70, 0x73, 0x2e, 0x48, 0x46, 0x5d, 0x40, 0x6a, 0x73, 0xad, 0xa0, 0x35, 0x60, 0x27, 0xc6, 0x61,
-	0xec, 0xe2, 0xd8, 0x00, 0xb1, 0x9f, 0x88, 0x3c, 0x1b, 0x6a, 0x29, 0xcd, 0x2b, 0x62, 0xbb, 0xa2,
-	0x74, 0x89, 0x31, 0xdf, 0x9a, 0x45, 0xcc, 0xa8, 0x4a, 0x63, 0x25, 0xca, 0x54, 0x8a, 0xa5, 0x34,
-	0xae, 0x49, 0x63, 0xa5, 0x13, 0xc6, 0x8b, 0xdc, 0xdc, 0xdc, 0x22, 0x37, 0xa9, 0xcc, 0xd7, 0xb7,
-	0xc8, 0xbc, 0xd9, 0x07, 0x18, 0x8c, 0xc6, 0x49, 0x0d, 0x9a, 0x90, 0x67, 0xa3, 0xb1, 0xaa, 0xc0,
-	0x46, 0xd6, 0x72, 0x34, 0xb6, 0xf8, 0xe6, 0x36, 0xbf, 0xc0, 0x1f, 0x1a, 0xe4, 0x07, 0xa3, 0x31,
-	0x4f, 0x5e, 0xcc, 0x83, 0xce, 0xf9, 0x0a, 0x96, 0x58, 0x2f, 0xd2, 0x9c, 0x4b, 0xa7, 0xf9, 0x36,
-	0x94, 0x46, 0xb3, 0xf1, 0x18, 0xcb, 0xba, 0xa8, 0x59, 0x4a, 0xe2, 0xa9, 0x8e, 0xb0, 0x3d, 0x1d,
-	0x0a, 0x9a, 0x82, 0xa0, 0x29, 0x73, 0x85, 0xc5, 0xa9, 0x0e, 0x40, 0xf7, 0x49, 0x30, 0x1c, 0xcd,
-	0x62, 0xca, 0x44, 0x81, 0xd4, 0xac, 0xb2, 0x4f, 0x82, 0x97, 0x5c, 0x36, 0x3f, 0x40, 0xf5, 0x37,
-	0x97, 0x50, 0x27, 0xf5, 0x7b, 0x7d, 0xe2, 0xf2, 0xca, 0xdf, 0x4b, 0x22, 0x25, 0x60, 0x9b, 0x0b,
-	0xfe, 0xad, 0x41, 0x51, 0xd8, 0xa4, 0xb2, 0xa3, 0x5d, 0x2d, 0x3b, 0xb9, 0x6d, 0xfe, 0x4b, 0xfe,
-	0x7b, 0x9d, 0x47, 0xf2, 0x27, 0xd6, 0x2d, 0xb1, 0xe6, 0x3a, 0x3b, 0x9e, 0x50, 0xa3, 0x70, 0x98,
-	0xe7, 0x3a, 0xbe, 0x36, 0xa7, 0x70, 0xab, 0xeb, 0xdb, 0xcc, 0x39, 0x7b, 0x45, 0x3c, 0xb6, 0xe8,
-	0x71, 0x4f, 0xa0, 0x34, 0x16, 0x0a, 0xe5, 0xdc, 0x5e, 0xe6, 0xb4, 0x8c, 0x85, 0x02, 0x6e, 0x73,
-	0xf9, 0xbf, 0x34, 0xa8, 0xa6, 0x6d, 0x65, 0x2b, 0x66, 0xce, 0x99, 0x38, 0x45, 0xb7, 0xa4, 0x90,
-	0x8a, 0x4c, 0x6e, 0x9b, 0xc8, 0x3c, 0x86, 0x1d, 0xc7, 0xb3, 0x29, 0x25, 0xee, 0xfa, 0x96, 0x95,
-	0xa0, 0x4c, 0x07, 0xea, 0x03, 0x27, 0x7b, 0xdf, 0x47, 0x4b, 0xf7, 0x5d, 0xa6, 0xb8, 0xfa, 0x5d,
-	0x9f, 0xf1, 0x89, 0xa3, 0xae, 0x79, 0xb5, 0x54, 0xf3, 0x02, 0xec, 0x45, 0x7d, 0xcc, 0x52, 0x05,
-	0x48, 0x22, 0x8a, 0xd9, 0xca, 0x02, 0x94, 0x48, 0x09, 0xd8, 0xc6, 0xaf, 0x27, 0x50, 0x14, 0x26,
-	0xbc, 0x1a, 0x02, 0x5b, 0x35, 0x60, 0xdd, 0x12, 0x6b, 0x9e, 0x0f, 0x87, 0xb8, 0x31, 0x35, 0x72,
-	0xa2, 0x44, 0xa4, 0x60, 0x7e, 0x80, 0x7a, 0x2f, 0x1a, 0xd8, 0x23, 0x0f, 0xd3, 0xc4, 0xa5, 0x07,
-	0x50, 0x88, 0x67, 0x1e, 0x56, 0x1e, 0xed, 0x66, 0x3c, 0xb2, 0x66, 0x1e, 0xb6, 0xc4, 0xf6, 0x36,
-	0xfe, 0x7c, 0xd1, 0xa0, 0xc0, 0x2d, 0xd0, 0x0f, 0x99, 0xb1, 0x7a, 0xf3, 0xa9, 0x71, 0x81, 0xb4,
-	0xb9, 0x34, 0x52, 0x9f, 0x81, 0xee, 0x92, 0x18, 0x4b, 0xa3, 0x9c, 0x30, 0x3a, 0xb8, 0x68, 0xd4,
-	0x49, 0x20, 0xd6, 0x02, 0xcd, 0x7b, 0x3d, 0x0f, 0xa8, 0xfc, 0x3b, 0xf8, 0xd2, 0xbc, 0x0b, 0x25,
-	0x49, 0x8f, 0x76, 0x20, 0xdf, 0xea, 0x74, 0x1a, 0x37, 0x10, 0x40, 0xa9, 0xd3, 0x7d, 0xd7, 0x1d,
-	0x74, 0x1b, 0x9a, 0x69, 0x82, 0x3e, 0x27, 0x42, 0x3a, 0x14, 0x7b, 0xc7, 0xa7, 0xef, 0x07, 0x12,
-	0x73, 0xf2, 0x7e, 0xc0, 0xd7, 0x9a, 0xf9, 0x19, 0x2a, 0x03, 0xe2, 0xe3, 0x24, 0x46, 0xcb, 0x97,
-	0xd7, 0x2e, 0x0e, 0x5b, 0xe1, 0x86, 0x23, 0x7c, 0xcf, 0x73, 0x37, 0x1c, 0x91, 0x15, 0xae, 0xca,
-	0x0b, 0x95, 0x58, 0xa3, 0x43, 0xa8, 0x3a, 0xde, 0x74, 0x48, 0x5c, 0x3a, 0xf4, 0x6d, 0x3a, 0x55,
-	0xdd, 0x0c, 0x1c, 0x6f, 0xda, 0x73, 0xe9, 0x91, 0x4d, 0xa7, 0x66, 0x00, 0xf5, 0xa5, 0x77, 0x07,
-	0x7a, 0xbe, 0x14, 0xce, 0xef, 0xd6, 0xbd, 0x52, 0x96, 0x22, 0x6b, 0xde, 0x9b, 0x07, 0xa3, 0x0c,
-	0x85, 0xb7, 0xbd, 0x77, 0xef, 0xe4, 0x4d, 0x5f, 0x77, 0x07, 0xa7, 0xbd, 0x4e, 0x43, 0x33, 0xff,
-	0xd1, 0x60, 0xb7, 0xfb, 0x19, 0x3b, 0x7d, 0x16, 0x63, 0x3a, 0x2f, 0x8a, 0x5f, 0xa0, 0x48, 0x9d,
-	0x30, 0xc2, 0xea, 0xc4, 0xef, 0xb3, 0x3d, 0x63, 0x19, 0xde, 0xec, 0x73, 0xac, 0x25, 0x4d, 0x78,
-	0x1b, 0x67, 0x76, 0x3c, 0xc1, 0x4c, 0xd5, 0x88, 0x92, 0xf8, 0x08, 0xa6, 0xc2, 0x2a, 0x8c, 0xa9,
-	0x4a, 0xd7, 0x42, 0x61, 0xde, 0x87, 0xa2, 0x60, 0x41, 0x35, 0xd0, 0xdb, 0x27, 0xc7, 0x83, 0x56,
-	0xef, 0xb8, 0x6b, 0x35, 0x6e, 0xf0, 0x14, 0x9e, 0x9e, 0x70, 0x47, 0x8f, 0x01, 0xa5, 0x0f, 0x56,
-	0x6f, 0xaa, 0x7d, 0x28, 0x93, 0x80, 0x32, 0x3b, 0x70, 0x92, 0xf2, 0x9f, 0xcb, 0xf2, 0x40, 0x3b,
-	0x66, 0x3c, 0x93, 0x2a, 0x31, 0x0b, 0x85, 0x79, 0x02, 0xb7, 0xda, 0x1c, 0xe6, 0x65, 0x6f, 0xfe,
-	0xd5, 0x84, 0x4f, 0xff, 0xd4, 0xa1, 0xd2, 0xe6, 0x61, 0xea, 0x88, 0x30, 0xa1, 0x17, 0x50, 0xee,
-	0x63, 0x26, 0x9f, 0x48, 0x7b, 0x2b, 0x1e, 0x72, 0xf2, 0xc0, 0xfd, 0xdb, 0x4d, 0xf9, 0xda, 0x6d,
-	0x26, 0xaf, 0xdd, 0x66, 0x97, 0xbf, 0x76, 0xcd, 0x1b, 0xe8, 0x25, 0x54, 0x3a, 0xd8, 0xc3, 0x0c,
-	0x5f, 0x83, 0xe3, 0x39, 0x94, 0xfa, 0x98, 0xf1, 0x39, 0xfc, 0xcd, 0x85, 0x49, 0xbe, 0xd1, 0xf8,
-	0x57, 0xd0, 0xa5, 0x03, 0x5f, 0x69, 0xff, 0x02, 0xca, 0x2d, 0xd7, 0x95, 0x33, 0x72, 0x6f, 0xc5,
-	0xac, 0xdd, 0x86, 0xa0, 0x83, 0xbd, 0x6b, 0x10, 0x1c, 0x41, 0xbd, 0xe5, 0xba, 0x99, 0x41, 0x75,
-	0x78, 0xf9, 0xfc, 0xdb, 0x48, 0xd7, 0x15, 0x19, 0x99, 0x0f, 0x83, 0x3b, 0xab, 0x47, 0xcb, 0x46,
-	0x9a, 0x16, 0xc0, 0x2b, 0x6f, 0x46, 0xcf, 0x64, 0xf7, 0xde, 0x5b, 0x31, 0x04, 0x36, 0x52, 0xbc,
-	0x86, 0x9a, 0xa2, 0x60, 0xa2, 0x9b, 0x2f, 0xf9, 0xb2, 0xd4, 0xe4, 0xd7, 0x10, 0xb5, 0xa1, 0xc6,
-	0x0b, 0x84, 0xf8, 0xf8, 0x64, 0x3c, 0xe6, 0x83, 0x27, 0xdb, 0xac, 0x53, 0x5d, 0x70, 0xad, 0x37,
-	0xbb, 0x16, 0x76, 0xc2, 0xdf, 0x71, 0x7c, 0x4d, 0xa2, 0x37, 0x50, 0x9b, 0xf7, 0xb3, 0xb7, 0xc4,
-	0xf3, 0xd0, 0xdd, 0xd5, 0xbd, 0x6e, 0x33, 0x93, 0x95, 0xea, 0xa3, 0xaf, 0x31, 0x3b, 0x25, 0xee,
-	0x26, 0xae, 0x7b, 0x97, 0x6d, 0xcb, 0x56, 0x23, 0x38, 0x6b, 0x8b, 0x16, 0x14, 0xc6, 0x14, 0xdd,
-	0x5b, 0xdf, 0x17, 0xf7, 0xef, 0x5f, 0xba, 0x3f, 0xe7, 0x3c, 0x82, 0x7a, 0xba, 0x0d, 0x71, 0xd6,
-	0x6c, 0x85, 0xae, 0x68, 0x52, 0x97, 0x5f, 0x7b, 0x54, 0x12, 0x9a, 0x1f, 0xff, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0x0c, 0x39, 0xcc, 0x56, 0x63, 0x0f, 0x00, 0x00,
+func init() { proto.RegisterFile("chaosdaemon.proto", fileDescriptor_chaosdaemon_14e0473aa4844e3b) }
+
+var fileDescriptor_chaosdaemon_14e0473aa4844e3b = []byte{
+	// 2893 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x72, 0xe3, 0xc6,
+	0xf1, 0x5f, 0x8a, 0x1f, 0x22, 0x9a, 0xa4, 0x44, 0xc1, 0xfa, 0xaf, 0xb9, 0x5c, 0xed, 0x17, 0xfc,
+	0xdf, 0xb2, 0x2b, 0x2e, 0xcb, 0xf6, 0x3a, 0x71, 0xc5, 0x76, 0x55, 0x5c, 0x2b, 0x4a, 0x5a, 0x2b,
+	0x5e, 0x69, 0x65, 0x90, 0x1b, 0x57, 0xca, 0x55, 0x61, 0x40, 0x60, 0x48, 0xc2, 0x04, 0x01, 0xec,
+	0xcc, 0x60, 0x23, 0x55, 0x4e, 0xa9, 0xa4, 0x72, 0xcb, 0x39, 0xa7, 0x3c, 0x42, 0x9e, 0x20, 0xa7,
+	0xdc, 0x72, 0xc9, 0x1b, 0xe4, 0x05, 0x92, 0x73, 0x1e, 0x20, 0x35, 0x3d, 0x33, 0x20, 0x40, 0x52,
+	0x5c, 0xc9, 0xd2, 0x49, 0xd3, 0x3d, 0x3d, 0x3f, 0xcc, 0xf4, 0xf4, 0xf4, 0x17, 0x05, 0x5b, 0xee,
+	0xd8, 0x89, 0x98, 0xe7, 0x90, 0x69, 0x14, 0xee, 0xc6, 0x34, 0xe2, 0x91, 0x59, 0xcb, 0xb0, 0xda,
+	0x77, 0x47, 0x51, 0x34, 0x0a, 0xc8, 0x87, 0x38, 0x35, 0x48, 0x86, 0x1f, 0x92, 0x69, 0xcc, 0xcf,
+	0xa5, 0xa4, 0xf5, 0x29, 0x54, 0x7b, 0xee, 0x57, 0x4e, 0xe8, 0x05, 0xc4, 0xdc, 0x86, 0xf2, 0xd4,
+	0xf9, 0x3e, 0xa2, 0xad, 0xc2, 0xc3, 0xc2, 0x7b, 0x0d, 0x5b, 0x12, 0xc8, 0xf5, 0xc3, 0x88, 0xb6,
+	0xd6, 0x14, 0x57, 0x10, 0xd6, 0x04, 0x9a, 0x9d, 0x28, 0xe4, 0x8e, 0x1f, 0x12, 0x6a, 0x93, 0x57,
+	0x09, 0x61, 0xdc, 0xfc, 0x31, 0x54, 0x1c, 0x97, 0xfb, 0x51, 0x88, 0x00, 0xb5, 0x27, 0x3b, 0xbb,
+	0xd9, 0x9d, 0xa5, 0xe2, 0x4f, 0x51, 0xc6, 0x56, 0xb2, 0xe6, 0x23, 0xa8, 0xbb, 0x7a, 0xaa, 0xef,
+	0x7b, 0xf8, 0x19, 0xc3, 0xae, 0xa5, 0xbc, 0x23, 0xcf, 0x7a, 0x0c, 0x5b, 0x99, 0x8f, 0xb1, 0x38,
+	0x0a, 0x19, 0x31, 0x9b, 0x50, 0x8c, 0x7d, 0x4f, 0xed, 0x55, 0x0c, 0xad, 0x3f, 0xae, 0x41, 0xfd,
+	0x84, 0x70, 0x32, 0xd5, 0x1b, 0x7a, 0x0f, 0xca, 0xa1, 0xa0, 0xd5, 0x7e, 0xcc, 0xdc, 0x7e, 0xa4,
+	0xa4, 0x14, 0xb8, 0xc4, 0x26, 0xcc, 0x0f, 0xa0, 0x32, 0x46, 0x3d, 0xb5, 0x8a, 0x88, 0xf6, 0x7f,
+	0x39, 0x34, 0xad, 0x44, 0x5b, 0x09, 0x09, 0xf1, 0xd8, 0xa1, 0x24, 0xe4, 0xad, 0xd2, 0x4a, 0x71,
+	0x29, 0x64, 0xde, 0x86, 0x8a, 0x47, 0x5e, 0xfb, 0x2e, 0x69, 0x95, 0xf1, 0xd3, 0x8a, 0x32, 0x3f,
+	0x81, 0xf5, 0x98, 0x46, 0x43, 0x3f, 0x20, 0xad, 0x0a, 0xe2, 0xdc, 0x59, 0x3c, 0xc4, 0xa9, 0x14,
+	0xb0, 0xb5, 0xa4, 0xc5, 0xa0, 0x9e, 0x9d, 0x30, 0x9f, 0xc0, 0x3a, 0x73, 0xa6, 0x71, 0x40, 0x58,
+	0xab, 0xf0, 0xb0, 0xf8, 0x5e, 0xed, 0x49, 0x6b, 0x11, 0xa4, 0x8b, 0x02, 0xb6, 0x16, 0x34, 0xef,
+	0x82, 0x11, 0x13, 0xea, 0x47, 0x5e, 0x7f, 0xca, 0x50, 0x1d, 0x45, 0xbb, 0x2a, 0x19, 0xc7, 0xcc,
+	0x34, 0xa1, 0x14, 0x44, 0x51, 0x8c, 0x9a, 0xa8, 0xda, 0x38, 0xb6, 0x7a, 0x50, 0xcb, 0x00, 0x89,
+	0xf5, 0xd1, 0x70, 0xc8, 0x08, 0x17, 0xeb, 0x0b, 0x72, 0xbd, 0x64, 0x1c, 0xb3, 0xd9, 0xc5, 0xac,
+	0xbd, 0xe1, 0x62, 0xac, 0x7f, 0x14, 0xa1, 0x8c, 0x0c, 0xf1, 0x4d, 0xee, 0x4f, 0x89, 0xba, 0x70,
+	0x1c, 0x0b, 0xad, 0x7d, 0xef, 0x73, 0x4e, 0xb4, 0x71, 0x2a, 0xca, 0xbc, 0x07, 0xe0, 0x91, 0xc0,
+	0x39, 0xef, 0xbb, 0x11, 0xa5, 0xb8, 0xcb, 0x35, 0xdb, 0x40, 0x4e, 0x27, 0xa2, 0x68, 0xd2, 0x81,
+	0x3f, 0xf5, 0xe5, 0xd5, 0x34, 0x6c, 0x49, 0xc8, 0x43, 0x31, 0x86, 0x17, 0xb0, 0x66, 0xe3, 0x58,
+	0x9c, 0x42, 0xfc, 0x95, 0x38, 0x15, 0x9c, 0xa8, 0x0a, 0x06, 0xc2, 0x34, 0xa1, 0x38, 0x72, 0xe2,
+	0xd6, 0xba, 0xb4, 0xc0, 0x91, 0x13, 0x9b, 0x3b, 0x60, 0x78, 0x49, 0x1c, 0xf8, 0xae, 0xc3, 0x49,
+	0xab, 0xaa, 0x3e, 0xab, 0x19, 0xe6, 0x63, 0xd8, 0x48, 0x09, 0x89, 0x68, 0xa0, 0x48, 0x23, 0xe5,
+	0x22, 0x6c, 0x0b, 0xd6, 0x29, 0x89, 0xa8, 0x47, 0x68, 0x0b, 0x70, 0x5e, 0x93, 0xc2, 0x4a, 0xd5,
+	0x50, 0x2e, 0xaf, 0xe1, 0x74, 0x4d, 0xf1, 0xf4, 0x62, 0x31, 0x95, 0xc4, 0xbc, 0x55, 0x97, 0x8b,
+	0x15, 0x29, 0x4d, 0x1c, 0x87, 0x72, 0x71, 0x43, 0x2e, 0x56, 0x3c, 0x5c, 0x3c, 0xb3, 0xd9, 0x8d,
+	0xcb, 0xd8, 0xec, 0xec, 0x45, 0x6c, 0x5e, 0xe2, 0x45, 0x58, 0x13, 0x80, 0xde, 0x60, 0xa8, 0xdf,
+	0xa6, 0x05, 0x45, 0x3e, 0x18, 0xaa, 0x97, 0xd9, 0xcc, 0xaf, 0x1c, 0x0c, 0x6d, 0x31, 0x79, 0x99,
+	0x57, 0x39, 0x7b, 0x37, 0xc5, 0xec, 0xbb, 0xb1, 0x7e, 0x57, 0x80, 0x62, 0x6f, 0x30, 0x14, 0x97,
+	0x4a, 0xc5, 0x65, 0x88, 0xef, 0x94, 0x6c, 0x1c, 0xcf, 0xae, 0x7f, 0x2d, 0x7b, 0xfd, 0xb7, 0xa1,
+	0x32, 0x48, 0x86, 0x43, 0x22, 0xed, 0xa5, 0x61, 0x2b, 0x4a, 0x3e, 0x04, 0x67, 0xd2, 0x47, 0x98,
+	0x12, 0xc2, 0x54, 0x05, 0xc3, 0x16, 0x50, 0x77, 0xc1, 0x98, 0xfa, 0x61, 0x7f, 0x90, 0x50, 0xc6,
+	0xd1, 0x70, 0x1a, 0x76, 0x75, 0xea, 0x87, 0x7b, 0x82, 0x16, 0xcf, 0xf0, 0x1b, 0xcf, 0x67, 0x6e,
+	0xc6, 0x1d, 0xbd, 0x12, 0xf4, 0x52, 0x77, 0x24, 0x25, 0xa5, 0xc0, 0x75, 0x0e, 0xfe, 0xa7, 0x02,
+	0x94, 0x11, 0x2b, 0x73, 0x9b, 0x85, 0xab, 0xdd, 0xe6, 0xda, 0x65, 0xfc, 0x9b, 0x78, 0x8e, 0xe7,
+	0xb1, 0xfe, 0x3a, 0x8e, 0x05, 0xcf, 0xa1, 0x23, 0xd6, 0x2a, 0x3d, 0x2c, 0x0a, 0x9e, 0x18, 0x5b,
+	0xbf, 0x2f, 0xc0, 0x5b, 0x07, 0x53, 0x87, 0xbb, 0xe3, 0x43, 0x3f, 0xe0, 0xb3, 0x60, 0xf1, 0x31,
+	0x54, 0x86, 0xc8, 0x68, 0x15, 0x96, 0xf8, 0xb5, 0xdc, 0x0a, 0x25, 0x78, 0x1d, 0xad, 0xfc, 0xa1,
+	0x00, 0xf5, 0x2c, 0xa6, 0x8c, 0x75, 0xdc, 0x1d, 0xe3, 0xd7, 0x0d, 0x5b, 0x12, 0x19, 0x95, 0xad,
+	0x5d, 0x46, 0x65, 0x1f, 0xc2, 0xba, 0x1b, 0x38, 0x8c, 0xf9, 0xde, 0xea, 0x98, 0xa0, 0xa5, 0xac,
+	0xdf, 0xc2, 0x66, 0xcf, 0xcd, 0xeb, 0xe1, 0x83, 0x39, 0x3d, 0xcc, 0x43, 0xdc, 0x9c, 0x0e, 0x3e,
+	0x83, 0xaa, 0x86, 0xbb, 0xa2, 0x6d, 0x58, 0xdf, 0x41, 0xfd, 0x28, 0xee, 0x12, 0x9e, 0xb1, 0x64,
+	0x3f, 0x66, 0x84, 0x2f, 0xb5, 0x64, 0x29, 0x29, 0x05, 0x2e, 0x13, 0xdd, 0x3f, 0x86, 0x32, 0x2e,
+	0x11, 0xe6, 0x13, 0x3a, 0xca, 0xc3, 0x1b, 0x36, 0x8e, 0xc5, 0x3d, 0xb9, 0xbe, 0x47, 0x45, 0x08,
+	0x12, 0x36, 0x25, 0x09, 0xeb, 0x3b, 0xd8, 0x3c, 0x8a, 0x7b, 0xce, 0x20, 0x20, 0x4c, 0x6f, 0xe9,
+	0x31, 0x94, 0x68, 0x12, 0x10, 0xb5, 0xa3, 0xad, 0xdc, 0x8e, 0xec, 0x24, 0x20, 0x36, 0x4e, 0x5f,
+	0x66, 0x3f, 0xff, 0x2a, 0x40, 0x49, 0xac, 0x30, 0x3f, 0xca, 0xe5, 0x33, 0x1b, 0x73, 0x51, 0x53,
+	0x88, 0xec, 0xce, 0xe5, 0x32, 0x9f, 0x81, 0xe1, 0xf9, 0x94, 0xc8, 0x45, 0x6b, 0xb8, 0xe8, 0xee,
+	0xe2, 0xa2, 0x7d, 0x2d, 0x62, 0xcf, 0xa4, 0x45, 0x30, 0x11, 0x0a, 0x95, 0x57, 0x56, 0x64, 0x52,
+	0x1d, 0x53, 0xc2, 0xc6, 0xe8, 0x73, 0xaa, 0x36, 0x8e, 0xad, 0x7b, 0x50, 0x91, 0x9f, 0x34, 0xd7,
+	0xa1, 0xf8, 0x74, 0x7f, 0xbf, 0x79, 0xcb, 0x04, 0xa8, 0xec, 0x1f, 0x3c, 0x3f, 0xe8, 0x1d, 0x34,
+	0x0b, 0x96, 0x05, 0x46, 0x0a, 0x6e, 0x1a, 0x50, 0x3e, 0x3a, 0x39, 0x7d, 0xd9, 0x93, 0x32, 0x2f,
+	0x5e, 0xf6, 0xc4, 0xb8, 0x60, 0x9d, 0x41, 0xad, 0xe7, 0x4f, 0x89, 0xd6, 0xdb, 0xbc, 0x42, 0x0a,
+	0x8b, 0x06, 0x85, 0x5b, 0x73, 0x55, 0x12, 0x20, 0x86, 0x78, 0x53, 0x82, 0x55, 0x44, 0x16, 0x8e,
+	0xcd, 0x87, 0x50, 0x77, 0x83, 0x49, 0xdf, 0xf7, 0x58, 0x7f, 0xea, 0xb0, 0x89, 0x72, 0x95, 0xe0,
+	0x06, 0x93, 0x23, 0x8f, 0x1d, 0x3b, 0x6c, 0x62, 0x85, 0xb0, 0x39, 0x97, 0x04, 0x9a, 0x5f, 0xcc,
+	0xa9, 0xf8, 0x9d, 0x55, 0x29, 0xe3, 0x9c, 0xb6, 0xad, 0xfb, 0xa9, 0x32, 0xaa, 0x50, 0xfa, 0xfa,
+	0xe8, 0xf9, 0x73, 0x79, 0xd2, 0x67, 0x07, 0xbd, 0xd3, 0xa3, 0xfd, 0x66, 0xc1, 0xfa, 0x4f, 0x01,
+	0xb6, 0x0e, 0xce, 0x88, 0xdb, 0xe5, 0x94, 0xb0, 0xd4, 0x50, 0x3e, 0x87, 0x32, 0x73, 0xa3, 0x98,
+	0xa8, 0x2f, 0xfe, 0x7f, 0xde, 0xef, 0xcc, 0x8b, 0xef, 0x76, 0x85, 0xac, 0x2d, 0x97, 0x88, 0xa7,
+	0xc5, 0x1d, 0x3a, 0x22, 0x5c, 0xd9, 0x8d, 0xa2, 0x44, 0xdc, 0x67, 0xb8, 0x2a, 0xa2, 0x4c, 0x5d,
+	0xe1, 0x8c, 0x61, 0xee, 0xc2, 0x5b, 0x52, 0xae, 0xef, 0xc6, 0x49, 0xdf, 0x19, 0x0e, 0xfd, 0xd0,
+	0xe7, 0xe7, 0xea, 0x5e, 0xb7, 0xe4, 0x54, 0x27, 0x4e, 0x9e, 0xaa, 0x09, 0xd4, 0xae, 0xce, 0x04,
+	0xcb, 0x36, 0x8e, 0xad, 0x07, 0x50, 0xc6, 0x9d, 0x98, 0x0d, 0x30, 0x3a, 0x2f, 0x4e, 0x7a, 0x4f,
+	0x8f, 0x4e, 0x0e, 0xec, 0xe6, 0x2d, 0x61, 0x06, 0xa7, 0x2f, 0xc4, 0x61, 0x4f, 0xc0, 0xcc, 0x6e,
+	0x5e, 0x25, 0xc9, 0x6d, 0xa8, 0xfa, 0x21, 0xe3, 0x4e, 0xe8, 0xea, 0x67, 0x95, 0xd2, 0x72, 0xd3,
+	0x0e, 0xe5, 0xc2, 0x1a, 0xd4, 0xe5, 0xce, 0x18, 0xd6, 0x0b, 0x78, 0xab, 0x23, 0xc4, 0x82, 0xbc,
+	0xf6, 0x7e, 0x38, 0xe0, 0x09, 0x6c, 0x74, 0x02, 0xe2, 0x84, 0x49, 0xac, 0xb1, 0xde, 0x81, 0x46,
+	0xd6, 0xf4, 0x64, 0x72, 0x6a, 0xd8, 0xf5, 0x8c, 0xed, 0x31, 0xf3, 0x6d, 0x58, 0xf7, 0xe8, 0x79,
+	0x9f, 0x26, 0xf2, 0x41, 0x55, 0xed, 0x8a, 0x47, 0xcf, 0xed, 0x24, 0xb4, 0xde, 0x87, 0xcd, 0x14,
+	0x4f, 0x9d, 0x16, 0x33, 0xa7, 0x69, 0xf4, 0x9a, 0x78, 0x0a, 0x4a, 0x93, 0xd6, 0x9f, 0x8b, 0xb0,
+	0xdd, 0x71, 0x62, 0x67, 0xe0, 0x07, 0x3e, 0xf7, 0xc9, 0x4c, 0x41, 0x8f, 0x61, 0x63, 0x42, 0x68,
+	0x48, 0x82, 0xfe, 0x6b, 0x42, 0x99, 0x36, 0x44, 0xc3, 0x6e, 0x48, 0xee, 0x2f, 0x24, 0x53, 0x20,
+	0x4f, 0x23, 0x2f, 0x09, 0x88, 0x76, 0x44, 0x9a, 0x14, 0x00, 0xee, 0x88, 0x46, 0x49, 0x9c, 0x02,
+	0x14, 0xf1, 0xda, 0x1a, 0x92, 0xab, 0x01, 0x9a, 0x50, 0x1c, 0xc4, 0x43, 0x75, 0xe7, 0x62, 0x68,
+	0x7e, 0x0a, 0x55, 0x9a, 0x84, 0x22, 0x8d, 0x15, 0x29, 0xa7, 0xc8, 0xca, 0xdb, 0x73, 0xae, 0x02,
+	0x27, 0xbb, 0xdc, 0xe1, 0x09, 0xb3, 0x53, 0x59, 0x74, 0x0b, 0x91, 0x27, 0xcb, 0x01, 0xc3, 0xc6,
+	0xb1, 0xf9, 0x01, 0x98, 0x81, 0x1f, 0x26, 0x67, 0x7d, 0x37, 0x73, 0xc6, 0xd6, 0x3a, 0xee, 0x74,
+	0x0b, 0x67, 0xb2, 0x87, 0x37, 0x37, 0x60, 0x2d, 0x62, 0x98, 0x9f, 0x1a, 0xf6, 0x5a, 0xc4, 0xf0,
+	0x74, 0x84, 0x8f, 0x23, 0x8f, 0xb5, 0x0c, 0x75, 0x3a, 0x49, 0xca, 0x88, 0xee, 0x8e, 0x31, 0x11,
+	0xc5, 0x88, 0xee, 0x8e, 0xc5, 0xb5, 0x29, 0x95, 0xb9, 0x51, 0x38, 0xf4, 0x47, 0x98, 0x86, 0x1a,
+	0x76, 0x5d, 0x32, 0x3b, 0xc8, 0x33, 0xdf, 0x85, 0x4d, 0x25, 0x34, 0x24, 0x0e, 0x4f, 0x28, 0x61,
+	0xad, 0x3a, 0x42, 0x2b, 0x75, 0x1f, 0x2a, 0xae, 0x35, 0x81, 0x46, 0xee, 0xa4, 0x4b, 0xa3, 0xc0,
+	0x6d, 0xa8, 0xb0, 0xc8, 0x9d, 0xcc, 0xde, 0x9d, 0xa4, 0xc4, 0xc6, 0xc7, 0xc4, 0x09, 0xf8, 0xf8,
+	0x5c, 0x95, 0x22, 0x9a, 0x14, 0x71, 0x83, 0x50, 0x1a, 0x51, 0xd4, 0xb8, 0x61, 0x4b, 0xc2, 0xfa,
+	0x39, 0x6c, 0xed, 0x89, 0x40, 0x9f, 0xab, 0x12, 0x7f, 0x02, 0x55, 0x2a, 0x87, 0xba, 0x3c, 0x5a,
+	0x52, 0x63, 0x29, 0x61, 0x3b, 0x15, 0xb5, 0x0e, 0x61, 0x13, 0xb1, 0x32, 0x39, 0xed, 0x27, 0x0b,
+	0x48, 0x6f, 0x2f, 0x24, 0xb6, 0x0b, 0x38, 0x7a, 0x4f, 0xb9, 0x00, 0xfb, 0xa6, 0x3d, 0x65, 0x85,
+	0x33, 0x58, 0xa7, 0xb0, 0xad, 0xb0, 0xf2, 0xc1, 0xf1, 0xa7, 0x0b, 0x70, 0x3b, 0x73, 0x70, 0x39,
+	0xf9, 0x0c, 0xe2, 0xbb, 0xd0, 0x40, 0xc4, 0xf4, 0xc1, 0xdc, 0x86, 0x0a, 0xea, 0x52, 0xbf, 0x56,
+	0x45, 0x59, 0xff, 0x2d, 0xc0, 0xe6, 0x5e, 0x3c, 0x3c, 0x74, 0x92, 0x80, 0x5f, 0x21, 0xb6, 0xcc,
+	0x92, 0x95, 0xb5, 0x5c, 0xdd, 0xbb, 0x0d, 0x65, 0xac, 0xd7, 0x54, 0x32, 0x2e, 0x89, 0x4c, 0xbd,
+	0x57, 0xca, 0xd5, 0x7b, 0xcb, 0x4a, 0xb7, 0x1d, 0x30, 0x62, 0x87, 0x72, 0x1f, 0xa3, 0x4b, 0x05,
+	0xad, 0x63, 0xc6, 0x10, 0x5b, 0x23, 0x23, 0xe1, 0xd8, 0xfa, 0x32, 0xbd, 0x90, 0x6f, 0xa5, 0x26,
+	0x79, 0x1d, 0xc1, 0x12, 0x76, 0xee, 0x87, 0x59, 0x99, 0xaa, 0x74, 0x4f, 0x7e, 0x38, 0x13, 0xb2,
+	0xbe, 0x00, 0xf3, 0x5b, 0xa1, 0x1f, 0xf5, 0x4c, 0xd3, 0x64, 0x64, 0x83, 0x9c, 0x89, 0x6a, 0x79,
+	0x4a, 0x42, 0xde, 0x4f, 0xd2, 0xa3, 0x37, 0x66, 0xdc, 0x97, 0xbe, 0x67, 0xfd, 0xbb, 0x00, 0x35,
+	0xb9, 0xf0, 0xe0, 0x35, 0x09, 0x2f, 0xbb, 0xcc, 0xbc, 0x0f, 0x30, 0x63, 0x28, 0xbd, 0x65, 0x38,
+	0x42, 0x4b, 0xf2, 0xfd, 0xea, 0x04, 0x50, 0x52, 0x8b, 0xfe, 0xb6, 0xb4, 0xc4, 0xdf, 0x6e, 0x43,
+	0x39, 0x1e, 0x3b, 0x4c, 0xf7, 0x21, 0x24, 0x21, 0x3d, 0x04, 0x63, 0xce, 0x48, 0xfb, 0x1d, 0x4d,
+	0x0a, 0xd5, 0xc7, 0xbe, 0x27, 0x15, 0x58, 0xb4, 0x71, 0x9c, 0x96, 0xea, 0x55, 0x99, 0x1e, 0x88,
+	0xb1, 0xf5, 0x0d, 0x36, 0x8c, 0x42, 0x4e, 0x1d, 0x77, 0x72, 0x05, 0xfb, 0x68, 0x43, 0x75, 0x4c,
+	0x1c, 0x8f, 0x46, 0xd1, 0x54, 0x77, 0x21, 0x34, 0x6d, 0xfd, 0x0a, 0xb6, 0x32, 0x90, 0xb3, 0xb6,
+	0xd0, 0xd4, 0x39, 0x53, 0x1d, 0x07, 0x31, 0x14, 0x5f, 0x89, 0xa8, 0x3f, 0xf2, 0x43, 0x27, 0xe8,
+	0x8b, 0x29, 0x09, 0x53, 0xd3, 0xbc, 0x63, 0xe7, 0x0c, 0xb3, 0xcc, 0x28, 0x09, 0xb9, 0x4a, 0x68,
+	0x24, 0x61, 0xfd, 0x12, 0xe0, 0x98, 0x27, 0x37, 0x60, 0xcc, 0x62, 0x4f, 0x3c, 0x51, 0x81, 0x41,
+	0x0c, 0xad, 0x01, 0x34, 0x4f, 0x22, 0x8f, 0x9c, 0x46, 0x94, 0xb3, 0x2b, 0x7c, 0xe0, 0x7d, 0x28,
+	0xc7, 0x62, 0x09, 0x06, 0xa1, 0xf9, 0xac, 0x5d, 0x03, 0xda, 0x52, 0xc6, 0xfa, 0x1c, 0xaa, 0x9a,
+	0x25, 0xd4, 0x88, 0xfd, 0x3e, 0x37, 0x0a, 0x74, 0xd8, 0xd6, 0x34, 0xde, 0x60, 0x44, 0xa5, 0x21,
+	0x95, 0x6d, 0x1c, 0x5b, 0x7f, 0x2b, 0xc0, 0xc6, 0xc1, 0xd9, 0xd8, 0x49, 0xd8, 0x55, 0x1e, 0xf3,
+	0x3d, 0x80, 0xa1, 0xd7, 0x8f, 0x09, 0x75, 0xb5, 0x61, 0x96, 0x6d, 0x63, 0xe8, 0x9d, 0x4a, 0x86,
+	0xf9, 0x00, 0x6a, 0xb1, 0x3f, 0x9b, 0x97, 0xea, 0x80, 0xd8, 0x4f, 0x05, 0x1e, 0x41, 0x9d, 0xf1,
+	0x88, 0x3a, 0x23, 0xd2, 0x8f, 0x1d, 0x3e, 0x56, 0xbe, 0xbb, 0xa6, 0x78, 0xa7, 0x0e, 0xc7, 0xe0,
+	0xa3, 0x45, 0x06, 0xe7, 0x9c, 0xc8, 0x27, 0x5f, 0xb4, 0xf5, 0xba, 0x3d, 0xc1, 0xb3, 0xbe, 0x81,
+	0x2d, 0x91, 0x72, 0xd0, 0x6e, 0x70, 0x35, 0x63, 0xbb, 0x03, 0x55, 0x26, 0x96, 0xf4, 0x43, 0xd9,
+	0xf2, 0x2a, 0xd9, 0xeb, 0x48, 0x9f, 0x30, 0xeb, 0xd7, 0xd0, 0xe8, 0xfa, 0xa3, 0xd0, 0x09, 0xae,
+	0x66, 0x0e, 0x0c, 0xd7, 0xa4, 0x51, 0x0b, 0x29, 0xb4, 0xb6, 0xb1, 0x1f, 0x78, 0xda, 0xb7, 0x21,
+	0x61, 0xfd, 0xa5, 0x00, 0x0d, 0x19, 0x3c, 0xaf, 0xa6, 0xf1, 0xa9, 0xb0, 0x55, 0xa9, 0x2f, 0xf9,
+	0x19, 0x03, 0x39, 0xa8, 0xad, 0x26, 0x14, 0x27, 0xe4, 0x5c, 0x17, 0x15, 0x13, 0x72, 0x2e, 0xfb,
+	0x43, 0x21, 0xd7, 0x7d, 0xc9, 0xba, 0xad, 0x49, 0xe1, 0x55, 0x54, 0x2f, 0x48, 0x38, 0x4c, 0xf9,
+	0xfa, 0x33, 0x1c, 0xeb, 0xaf, 0x05, 0xd8, 0xd8, 0xdf, 0xbb, 0xaa, 0x7f, 0xcf, 0x1a, 0xde, 0xda,
+	0x9c, 0xe1, 0xed, 0x80, 0xe1, 0x78, 0x9e, 0xf0, 0xa5, 0x44, 0x64, 0xcd, 0xc2, 0x17, 0xcd, 0x18,
+	0xa9, 0x59, 0x4a, 0x4f, 0x8f, 0x63, 0xf3, 0x47, 0x50, 0x1e, 0x8a, 0x0d, 0xe0, 0xf6, 0x6a, 0x4f,
+	0xb6, 0x73, 0xf6, 0xaf, 0x37, 0x27, 0x45, 0x44, 0x40, 0x5a, 0x57, 0x2c, 0x71, 0x13, 0x99, 0x32,
+	0xc3, 0x48, 0xeb, 0xb5, 0x6d, 0x28, 0xbf, 0x4a, 0x08, 0x3d, 0x57, 0x5b, 0x93, 0x84, 0x30, 0x03,
+	0xd9, 0x3d, 0x0c, 0x65, 0x32, 0x5f, 0xb2, 0xd7, 0x91, 0x3e, 0x61, 0x42, 0xdf, 0x18, 0xef, 0xfa,
+	0xae, 0x48, 0xc1, 0xe4, 0xd6, 0x0c, 0xe4, 0x74, 0x44, 0x1e, 0x76, 0x17, 0x0c, 0xf6, 0x2a, 0xe8,
+	0x33, 0xee, 0x70, 0xed, 0x40, 0xab, 0xec, 0x55, 0x20, 0x7c, 0x3c, 0x11, 0xa6, 0x2b, 0xd7, 0xe6,
+	0x3d, 0x69, 0x1d, 0x99, 0xc7, 0x92, 0x27, 0xee, 0x47, 0xbf, 0x0f, 0xd9, 0x57, 0xd4, 0xa4, 0x08,
+	0x0e, 0x7e, 0xd8, 0xe7, 0xd4, 0x09, 0x99, 0x3a, 0x4b, 0x15, 0x83, 0x5a, 0xc3, 0x0f, 0x7b, 0x33,
+	0xa6, 0xf5, 0xcf, 0x02, 0x6c, 0x89, 0x63, 0xfb, 0x41, 0xf4, 0x9a, 0xd0, 0x1b, 0xba, 0x29, 0x7d,
+	0x17, 0xc5, 0xcc, 0x5d, 0xe8, 0x3c, 0xb4, 0x94, 0xc9, 0x43, 0x1f, 0x40, 0x6d, 0xea, 0x30, 0x4e,
+	0x68, 0x1f, 0x53, 0x38, 0x65, 0x44, 0x92, 0x75, 0x22, 0x12, 0x39, 0xf1, 0x11, 0x87, 0xb1, 0xdf,
+	0x44, 0xd4, 0x53, 0xc7, 0x4f, 0x69, 0x71, 0x19, 0xc3, 0x88, 0xba, 0x04, 0x0f, 0x5e, 0xb5, 0x25,
+	0xf1, 0xe4, 0xef, 0x77, 0xa0, 0xd6, 0x11, 0xb7, 0xbc, 0x8f, 0xb7, 0x6c, 0x7e, 0x09, 0xd5, 0x2e,
+	0xe1, 0xb2, 0x25, 0x7c, 0x71, 0x9e, 0xd6, 0xbe, 0xbd, 0x2b, 0x7f, 0xf5, 0xd8, 0xd5, 0xbf, 0x7a,
+	0xec, 0x1e, 0x88, 0x5f, 0x3d, 0xac, 0x5b, 0xe6, 0x1e, 0xd4, 0xf6, 0x49, 0x40, 0x38, 0xb9, 0x06,
+	0xc6, 0x17, 0x50, 0xe9, 0x12, 0x2e, 0xfa, 0x8b, 0x17, 0x25, 0x78, 0x2b, 0x16, 0xff, 0x0c, 0x0c,
+	0xb9, 0x81, 0x1f, 0xb8, 0xfe, 0x4b, 0xa8, 0x3e, 0xf5, 0x3c, 0xd9, 0xe3, 0xbb, 0xb3, 0xa4, 0x87,
+	0x78, 0x19, 0x80, 0x7d, 0x12, 0x5c, 0x03, 0xe0, 0x18, 0x36, 0x9f, 0x7a, 0x5e, 0xae, 0x9f, 0xf6,
+	0xf0, 0xe2, 0xf6, 0xdd, 0x1b, 0xe1, 0x0e, 0xf0, 0x46, 0xd2, 0xde, 0xd4, 0xce, 0xf2, 0x0e, 0xd8,
+	0x1b, 0x61, 0x9e, 0x02, 0x1c, 0x06, 0x09, 0x93, 0x89, 0xb4, 0x79, 0x71, 0xbe, 0xbc, 0x02, 0xe2,
+	0x19, 0x34, 0x14, 0x04, 0xc7, 0x7c, 0xd8, 0x5c, 0x99, 0x26, 0xaf, 0x00, 0xea, 0x40, 0x43, 0x18,
+	0x88, 0x3f, 0x25, 0x2f, 0xf0, 0x37, 0x0f, 0x33, 0xdf, 0x3b, 0xca, 0x34, 0x60, 0x56, 0xee, 0x66,
+	0xcb, 0x26, 0xae, 0x78, 0xc6, 0xd7, 0x04, 0xfa, 0x0a, 0x23, 0x8b, 0x7c, 0xe9, 0x5f, 0xfb, 0x41,
+	0x60, 0xde, 0x5b, 0xde, 0x66, 0x79, 0x33, 0x92, 0x9d, 0x69, 0xe1, 0x3c, 0x23, 0xfc, 0xd4, 0xf7,
+	0xde, 0x84, 0x75, 0xff, 0xa2, 0x69, 0x99, 0xaf, 0x21, 0x66, 0x63, 0xd6, 0xb9, 0x88, 0x28, 0x33,
+	0xef, 0xaf, 0x6e, 0xc9, 0xb4, 0x1f, 0x5c, 0x38, 0x9f, 0x62, 0x1e, 0xc3, 0x66, 0xb6, 0x7b, 0x21,
+	0x50, 0xf3, 0x16, 0xba, 0xa4, 0xb7, 0xb1, 0xe2, 0xd8, 0x87, 0xb0, 0xae, 0x7a, 0x0d, 0x66, 0xbe,
+	0x9f, 0x97, 0xef, 0x68, 0xb4, 0x77, 0x96, 0x4f, 0xa6, 0xdb, 0x3a, 0x81, 0xcd, 0x67, 0x84, 0xe7,
+	0x6a, 0xf1, 0x0b, 0x3e, 0xda, 0x7e, 0x34, 0xb7, 0xdd, 0xc5, 0xde, 0x05, 0x1e, 0x53, 0x56, 0x67,
+	0xa9, 0x47, 0xcc, 0xab, 0x6e, 0xa1, 0xd6, 0x6d, 0xb7, 0x17, 0xe7, 0x33, 0x70, 0xa7, 0xd0, 0x44,
+	0x56, 0xd6, 0x3f, 0x5e, 0x0f, 0xf1, 0x08, 0x6a, 0x7a, 0x83, 0xc2, 0xdb, 0xed, 0x2c, 0x0a, 0x67,
+	0x5c, 0xde, 0x6a, 0xa8, 0xe7, 0xb0, 0x91, 0xd9, 0xdc, 0x75, 0xd1, 0x5e, 0xa8, 0xea, 0x3d, 0xe3,
+	0x31, 0x96, 0x9c, 0x34, 0xe7, 0x36, 0x56, 0x03, 0xbe, 0x04, 0x33, 0x0b, 0xa8, 0xfc, 0xc7, 0xa3,
+	0x65, 0x98, 0x79, 0x27, 0xb2, 0x1a, 0xf6, 0x00, 0x6a, 0x5d, 0xc2, 0x75, 0x61, 0x3d, 0x7f, 0xe4,
+	0x7c, 0xbd, 0xbd, 0xd2, 0x03, 0x6c, 0x48, 0xbd, 0x5d, 0x1b, 0xe9, 0x39, 0xd4, 0x32, 0x05, 0xaf,
+	0x99, 0x7f, 0x8b, 0x8b, 0xa5, 0x70, 0x3b, 0xef, 0xaf, 0x32, 0xd5, 0xae, 0x75, 0xeb, 0xa3, 0x82,
+	0xd9, 0x83, 0xad, 0x2e, 0xe1, 0x69, 0x15, 0xf7, 0x1c, 0x7f, 0x89, 0x5b, 0xf0, 0x28, 0xb9, 0xaa,
+	0xb1, 0x7d, 0xff, 0xa2, 0xe9, 0x54, 0x69, 0xdf, 0xc2, 0xb6, 0x3c, 0xed, 0x4d, 0x03, 0xcb, 0xb8,
+	0x7f, 0xcc, 0x93, 0xb9, 0xb8, 0x3d, 0x2b, 0x13, 0x2f, 0x13, 0xf7, 0x7f, 0xe0, 0xfa, 0x23, 0xd8,
+	0xd8, 0x0b, 0x22, 0x77, 0x92, 0x16, 0x8e, 0x73, 0xe7, 0x99, 0x2f, 0x28, 0x57, 0x40, 0x7d, 0x0d,
+	0xcd, 0x97, 0xe1, 0xe0, 0x86, 0xc0, 0x0e, 0xa1, 0xd6, 0x75, 0x42, 0x6f, 0x10, 0x9d, 0x5d, 0x2f,
+	0xb6, 0x1c, 0x41, 0x33, 0x2d, 0x39, 0x59, 0x94, 0x50, 0x57, 0xfc, 0x17, 0xc2, 0x9c, 0xab, 0xcf,
+	0x56, 0xa4, 0xab, 0xa1, 0x54, 0xe4, 0xbc, 0x01, 0x28, 0x1d, 0xc9, 0x65, 0x39, 0x39, 0xe7, 0x26,
+	0x16, 0xea, 0xcc, 0x95, 0x5a, 0xdf, 0xb4, 0x09, 0xbb, 0x21, 0xb0, 0x67, 0x99, 0x48, 0x2c, 0x2b,
+	0x53, 0x33, 0xef, 0x49, 0x72, 0xe5, 0xea, 0xca, 0xec, 0xab, 0xd1, 0xd1, 0xff, 0x03, 0x80, 0xad,
+	0xdb, 0xf6, 0xfc, 0x05, 0xce, 0x4a, 0xd2, 0xd5, 0x30, 0x4a, 0xe5, 0xd7, 0x82, 0xe9, 0x00, 0x74,
+	0x09, 0xd7, 0x75, 0xdb, 0xdd, 0xa5, 0x05, 0xde, 0x25, 0x2c, 0xb2, 0x21, 0x5f, 0xda, 0xb5, 0x71,
+	0x40, 0x17, 0x52, 0xfb, 0x7b, 0xe6, 0xfd, 0x05, 0x90, 0x5c, 0x8d, 0x75, 0x31, 0xce, 0xa0, 0x82,
+	0x9c, 0x4f, 0xfe, 0x37, 0x00, 0x75, 0xd3, 0x19, 0x96, 0xaa, 0x25, 0x00, 0x00,
 }
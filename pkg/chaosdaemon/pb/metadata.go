@@ -0,0 +1,34 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+// ExperimentUIDMetadataKey is the key of the gRPC metadata which carries the UID of the
+// experiment on whose behalf chaos-daemon is called, chaos-daemon records the injections by it
+const ExperimentUIDMetadataKey = "chaos-mesh-experiment-uid"
+
+// ExperimentMetadataKey is the key of the gRPC metadata which carries the experiment in the form
+// of kind/namespace/name, chaos-daemon reports the progress of the experiment with it
+const ExperimentMetadataKey = "chaos-mesh-experiment"
+
+// The phases of StatusEvent
+const (
+	// StatusApplied is reported when a fault is applied to the containers
+	StatusApplied = "Applied"
+	// StatusFailed is reported when a fault fails to be applied to the containers
+	StatusFailed = "Failed"
+	// StatusRecovered is reported when a fault is recovered from the containers
+	StatusRecovered = "Recovered"
+	// StatusExited is reported when the processes started for an experiment exit before they're cancelled
+	StatusExited = "Exited"
+)
@@ -0,0 +1,86 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// windowsMethods are the methods which chaos-daemon supports on windows. The others depend on
+// the namespaces, cgroups and qdiscs of linux.
+var windowsMethods = []string{
+	"SetNetem",
+	"DeleteNetem",
+	"BatchSetNetem",
+	"BatchDeleteNetem",
+	"ContainerKill",
+	"ContainerGetPid",
+	"ExecStressors",
+	"CancelStressors",
+	"GetCapabilities",
+}
+
+// supportedMethods returns the methods which chaos-daemon supports on the operating system,
+// every method is supported if it's nil
+func supportedMethods(goos string) []string {
+	if goos == utils.OSWindows {
+		return windowsMethods
+	}
+	return nil
+}
+
+// platformGuard rejects the methods which aren't supported on the operating system
+type platformGuard struct {
+	os      string
+	methods map[string]bool
+}
+
+func newPlatformGuard(goos string) *platformGuard {
+	g := &platformGuard{os: goos}
+	if methods := supportedMethods(goos); methods != nil {
+		g.methods = make(map[string]bool, len(methods))
+		for _, method := range methods {
+			g.methods[method] = true
+		}
+	}
+	return g
+}
+
+// check returns an error if the method isn't supported on the operating system
+func (g *platformGuard) check(fullMethod string) error {
+	method := path.Base(fullMethod)
+	if g.methods != nil && !g.methods[method] {
+		return fmt.Errorf("%s is unsupported by chaos-daemon on %s", method, g.os)
+	}
+	return nil
+}
+
+// UnaryServerInterceptor rejects the unsupported methods with Unimplemented, like the methods
+// unknown to an old chaos-daemon, so that the callers are able to skip the recoveries of them
+func (g *platformGuard) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := g.check(info.FullMethod); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	return handler(ctx, req)
+}
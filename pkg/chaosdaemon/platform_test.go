@@ -0,0 +1,54 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+var _ = Describe("platform", func() {
+	Context("platformGuard", func() {
+		It("should allow every method on linux", func() {
+			guard := newPlatformGuard(utils.OSLinux)
+			Expect(guard.check("/chaosdaemon.ChaosDaemon/SetTbf")).To(Succeed())
+			Expect(supportedMethods(utils.OSLinux)).To(BeNil())
+		})
+
+		It("should reject the methods unsupported on windows", func() {
+			guard := newPlatformGuard(utils.OSWindows)
+			Expect(guard.check("/chaosdaemon.ChaosDaemon/SetNetem")).To(Succeed())
+			Expect(guard.check("/chaosdaemon.ChaosDaemon/ExecStressors")).To(Succeed())
+			Expect(guard.check("/chaosdaemon.ChaosDaemon/SetTimeOffset")).To(MatchError(
+				"SetTimeOffset is unsupported by chaos-daemon on windows"))
+
+			called := false
+			_, err := guard.UnaryServerInterceptor(context.TODO(), nil,
+				&grpc.UnaryServerInfo{FullMethod: "/chaosdaemon.ChaosDaemon/SetTbf"},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					called = true
+					return nil, nil
+				})
+			Expect(called).To(BeFalse())
+			Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+		})
+	})
+})
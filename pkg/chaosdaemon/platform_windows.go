@@ -0,0 +1,22 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"errors"
+)
+
+// errUnsupportedOnWindows is returned by the actions which aren't supported on windows,
+// they are rejected by the platformGuard before they are called
+var errUnsupportedOnWindows = errors.New("the action is unsupported on windows")
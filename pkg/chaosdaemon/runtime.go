@@ -0,0 +1,280 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/containerd/containerd"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+// runtimeProtocolPrefixes is the prefix of the container IDs reported by kubelet for each container runtime
+var runtimeProtocolPrefixes = map[string]string{
+	containerRuntimeDocker:     dockerProtocolPrefix,
+	containerRuntimeContainerd: containerdProtocolPrefix,
+	containerRuntimeCrio:       crioProtocolPrefix,
+}
+
+// runtimeSockets is the default socket of each container runtime, which is probed in order
+// to detect the container runtimes running on the node
+var runtimeSockets = []struct {
+	Runtime string
+	Socket  string
+}{
+	{containerRuntimeContainerd, defaultContainerdSocket},
+	{containerRuntimeCrio, defaultCrioSocket},
+	{containerRuntimeDocker, defaultDockerSocketPath},
+}
+
+// CrioContainerInfo is the information of a container returned by CRI-O
+type CrioContainerInfo struct {
+	Name string `json:"name"`
+	Pid  int    `json:"pid"`
+}
+
+// CrioClientInterface represents the CrioClient, it's used to simply unit test
+type CrioClientInterface interface {
+	ContainerInfo(ctx context.Context, id string) (*CrioContainerInfo, error)
+}
+
+// CrioClient can get information from CRI-O
+type CrioClient struct {
+	client CrioClientInterface
+}
+
+// FormatContainerID strips protocol prefix from the container ID
+func (c CrioClient) FormatContainerID(ctx context.Context, containerID string) (string, error) {
+	if len(containerID) < len(crioProtocolPrefix) {
+		return "", fmt.Errorf("container id %s is not a cri-o container id", containerID)
+	}
+	if containerID[0:len(crioProtocolPrefix)] != crioProtocolPrefix {
+		return "", fmt.Errorf("expected %s but got %s", crioProtocolPrefix, containerID[0:len(crioProtocolPrefix)])
+	}
+	return containerID[len(crioProtocolPrefix):], nil
+}
+
+// GetPidFromContainerID fetches PID according to container id
+func (c CrioClient) GetPidFromContainerID(ctx context.Context, containerID string) (uint32, error) {
+	id, err := c.FormatContainerID(ctx, containerID)
+	if err != nil {
+		return 0, err
+	}
+	info, err := c.client.ContainerInfo(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	if info.Pid <= 0 {
+		return 0, fmt.Errorf("container %s is not running", id)
+	}
+
+	return uint32(info.Pid), nil
+}
+
+// ContainerKillByContainerID kills container according to container id.
+// CRI-O doesn't expose an API to kill a container, so the init process of the container is killed.
+func (c CrioClient) ContainerKillByContainerID(ctx context.Context, containerID string) error {
+	pid, err := c.GetPidFromContainerID(ctx, containerID)
+	if err != nil {
+		return err
+	}
+
+	return killProcess(pid)
+}
+
+func killProcess(pid uint32) error {
+	// Mock point to return error or skip killing in unit test
+	if err := mock.On("KillError"); err != nil {
+		return err.(error)
+	}
+	if mock.On("MockKillProcess") != nil {
+		return nil
+	}
+
+	return killPid(pid)
+}
+
+// crioHTTPClient requests the inspect API which CRI-O serves on its socket
+type crioHTTPClient struct {
+	client *http.Client
+}
+
+func (c *crioHTTPClient) ContainerInfo(ctx context.Context, id string) (*CrioContainerInfo, error) {
+	req, err := http.NewRequest(http.MethodGet, "http://crio/containers/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to inspect cri-o container %s: %s", id, resp.Status)
+	}
+
+	info := &CrioContainerInfo{}
+	if err := json.NewDecoder(resp.Body).Decode(info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// newCrioClient returns a client of the CRI-O inspect API with mock points
+func newCrioClient(socket string) (CrioClientInterface, error) {
+	// Mock point to return error or mock client in unit test
+	if err := mock.On("NewCrioClientError"); err != nil {
+		return nil, err.(error)
+	}
+	if client := mock.On("MockCrioClient"); client != nil {
+		return client.(CrioClientInterface), nil
+	}
+
+	// The real logic
+	if _, err := os.Stat(socket); err != nil {
+		return nil, err
+	}
+
+	return &crioHTTPClient{
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var d net.Dialer
+					return d.DialContext(ctx, "unix", socket)
+				},
+			},
+		},
+	}, nil
+}
+
+// newRuntimeClient creates the client of the container runtime with its default socket
+func newRuntimeClient(containerRuntime string) (ContainerRuntimeInfoClient, error) {
+	switch containerRuntime {
+	case containerRuntimeDocker:
+		client, err := newDockerClient(defaultDockerSocket, "", nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		return DockerClient{client}, nil
+
+	case containerRuntimeContainerd:
+		// TODO(yeya24): add more options?
+		client, err := newContainerdClient(defaultContainerdSocket, containerd.WithDefaultNamespace(containerdDefaultNS))
+		if err != nil {
+			return nil, err
+		}
+		return ContainerdClient{client}, nil
+
+	case containerRuntimeCrio:
+		client, err := newCrioClient(defaultCrioSocket)
+		if err != nil {
+			return nil, err
+		}
+		return CrioClient{client}, nil
+	}
+
+	return nil, fmt.Errorf("only docker, containerd and crio are supported, but got %s", containerRuntime)
+}
+
+// isSocket returns true if the path is the socket of a container runtime,
+// which is a unix socket on linux and a named pipe on windows
+func isSocket(path string) bool {
+	// Mock point to return mock result in unit test
+	if f := mock.On("MockIsSocket"); f != nil {
+		return f.(func(string) bool)(path)
+	}
+
+	return isSocketFile(path)
+}
+
+// detectContainerRuntimes returns the container runtimes whose sockets are found on the node
+func detectContainerRuntimes() []string {
+	var runtimes []string
+	for _, s := range runtimeSockets {
+		if isSocket(s.Socket) {
+			runtimes = append(runtimes, s.Runtime)
+		}
+	}
+	return runtimes
+}
+
+// MultiRuntimeClient dispatches the requests to the client of the container runtime
+// which the container belongs to, according to the protocol prefix of the container ID.
+// It makes chaos-daemon work on the nodes with different container runtimes.
+type MultiRuntimeClient struct {
+	clients map[string]ContainerRuntimeInfoClient
+}
+
+func newMultiRuntimeClient() (*MultiRuntimeClient, error) {
+	runtimes := detectContainerRuntimes()
+	if len(runtimes) == 0 {
+		return nil, fmt.Errorf("no container runtime socket is found")
+	}
+
+	c := &MultiRuntimeClient{clients: make(map[string]ContainerRuntimeInfoClient)}
+	for _, runtime := range runtimes {
+		client, err := newRuntimeClient(runtime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to %s: %v", runtime, err)
+		}
+		c.clients[runtimeProtocolPrefixes[runtime]] = client
+		log.Info("detected container runtime", "runtime", runtime)
+	}
+	return c, nil
+}
+
+func (c *MultiRuntimeClient) clientOf(containerID string) (ContainerRuntimeInfoClient, error) {
+	for prefix, client := range c.clients {
+		if strings.HasPrefix(containerID, prefix) {
+			return client, nil
+		}
+	}
+	return nil, fmt.Errorf("no container runtime is detected for container id %s", containerID)
+}
+
+// FormatContainerID strips protocol prefix from the container ID
+func (c *MultiRuntimeClient) FormatContainerID(ctx context.Context, containerID string) (string, error) {
+	client, err := c.clientOf(containerID)
+	if err != nil {
+		return "", err
+	}
+	return client.FormatContainerID(ctx, containerID)
+}
+
+// GetPidFromContainerID fetches PID according to container id
+func (c *MultiRuntimeClient) GetPidFromContainerID(ctx context.Context, containerID string) (uint32, error) {
+	client, err := c.clientOf(containerID)
+	if err != nil {
+		return 0, err
+	}
+	return client.GetPidFromContainerID(ctx, containerID)
+}
+
+// ContainerKillByContainerID kills container according to container id
+func (c *MultiRuntimeClient) ContainerKillByContainerID(ctx context.Context, containerID string) error {
+	client, err := c.clientOf(containerID)
+	if err != nil {
+		return err
+	}
+	return client.ContainerKillByContainerID(ctx, containerID)
+}
@@ -0,0 +1,124 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+var _ = Describe("container runtime", func() {
+	Context("CrioClient GetPidFromContainerID", func() {
+		It("should return the magic number 9527", func() {
+			defer mock.With("pid", int(9527))()
+			c := CrioClient{client: &MockClient{}}
+			pid, err := c.GetPidFromContainerID(context.TODO(), "cri-o://valid-container-id")
+			Expect(err).To(BeNil())
+			Expect(pid).To(Equal(uint32(9527)))
+		})
+
+		It("should error with wrong protocol", func() {
+			c := CrioClient{client: &MockClient{}}
+			_, err := c.GetPidFromContainerID(context.TODO(), "docker://this-is-a-wrong-protocol")
+			Expect(err).NotTo(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring(fmt.Sprintf("expected %s but got", crioProtocolPrefix)))
+		})
+
+		It("should error on stopped container", func() {
+			c := CrioClient{client: &MockClient{}}
+			_, err := c.GetPidFromContainerID(context.TODO(), "cri-o://valid-container-id")
+			Expect(err).NotTo(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("is not running"))
+		})
+
+		It("should error on ContainerInfoError", func() {
+			errorStr := "this is a mocked error"
+			defer mock.With("ContainerInfoError", errors.New(errorStr))()
+			c := CrioClient{client: &MockClient{}}
+			_, err := c.GetPidFromContainerID(context.TODO(), "cri-o://valid-container-id")
+			Expect(err).NotTo(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(Equal(errorStr))
+		})
+	})
+
+	Context("CrioClient ContainerKillByContainerID", func() {
+		It("should work", func() {
+			defer mock.With("pid", int(9527))()
+			defer mock.With("MockKillProcess", true)()
+			c := CrioClient{client: &MockClient{}}
+			err := c.ContainerKillByContainerID(context.TODO(), "cri-o://valid-container-id")
+			Expect(err).To(BeNil())
+		})
+
+		It("should error on Kill", func() {
+			errorStr := "this is a mocked error on Kill"
+			defer mock.With("pid", int(9527))()
+			defer mock.With("KillError", errors.New(errorStr))()
+			c := CrioClient{client: &MockClient{}}
+			err := c.ContainerKillByContainerID(context.TODO(), "cri-o://valid-container-id")
+			Expect(err).ToNot(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(Equal(errorStr))
+		})
+	})
+
+	Context("CreateContainerRuntimeInfoClient with auto", func() {
+		It("should dispatch by the protocol of container id", func() {
+			defer mock.With("pid", int(9527))()
+			defer mock.With("MockContainerdClient", &MockClient{})()
+			defer mock.With("MockCrioClient", &MockClient{})()
+			defer mock.With("MockIsSocket", func(path string) bool {
+				return path == defaultContainerdSocket || path == defaultCrioSocket
+			})()
+
+			c, err := CreateContainerRuntimeInfoClient(containerRuntimeAuto)
+			Expect(err).To(BeNil())
+
+			for _, id := range []string{"containerd://valid-container-id", "cri-o://valid-container-id"} {
+				pid, err := c.GetPidFromContainerID(context.TODO(), id)
+				Expect(err).To(BeNil())
+				Expect(pid).To(Equal(uint32(9527)))
+
+				formatted, err := c.FormatContainerID(context.TODO(), id)
+				Expect(err).To(BeNil())
+				Expect(formatted).To(Equal("valid-container-id"))
+			}
+
+			_, err = c.GetPidFromContainerID(context.TODO(), "docker://valid-container-id")
+			Expect(err).ToNot(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("no container runtime is detected"))
+		})
+
+		It("should error when no runtime is detected", func() {
+			defer mock.With("MockIsSocket", func(string) bool { return false })()
+			_, err := CreateContainerRuntimeInfoClient(containerRuntimeAuto)
+			Expect(err).ToNot(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("no container runtime socket is found"))
+		})
+
+		It("should error when the detected runtime is unavailable", func() {
+			errorStr := "this is a mocked error"
+			defer mock.With("NewCrioClientError", errors.New(errorStr))()
+			defer mock.With("MockIsSocket", func(path string) bool { return path == defaultCrioSocket })()
+			_, err := CreateContainerRuntimeInfoClient(containerRuntimeAuto)
+			Expect(err).ToNot(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring(errorStr))
+		})
+	})
+})
@@ -0,0 +1,121 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// sandboxCommands are the commands of the processes which hold the sandboxes of pods
+var sandboxCommands = map[string]struct{}{
+	"pause": {},
+}
+
+// SandboxKill kills the sandbox of the pod which the container belongs to. The sandbox holds
+// the network namespace of the pod, so kubelet creates the sandbox with a new network namespace
+// and restarts all the containers of the pod, the ip of the pod is allocated again by the network plugin.
+func (s *daemonServer) SandboxKill(ctx context.Context, req *pb.ContainerRequest) (*empty.Empty, error) {
+	log.Info("Sandbox Kill", "request", req)
+
+	action := req.Action.Action
+	if action != pb.ContainerAction_KILL {
+		err := fmt.Errorf("container action is %s , not kill", pb.ContainerAction_Action_name[int32(action)])
+		log.Error(err, "container action is not expected")
+		return nil, err
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting PID")
+		return nil, err
+	}
+
+	sandbox, err := findSandboxPid(defaultProcPrefix, pid)
+	if err != nil {
+		log.Error(err, "error while finding sandbox", "pid", pid)
+		return nil, err
+	}
+
+	if err := killProcess(sandbox); err != nil {
+		log.Error(err, "error while killing sandbox", "pid", sandbox)
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// findSandboxPid finds the process of the sandbox which shares the network namespace with the process
+func findSandboxPid(procPath string, pid uint32) (uint32, error) {
+	netNS, err := netNSOf(procPath, pid)
+	if err != nil {
+		return 0, err
+	}
+	hostNetNS, err := netNSOf(procPath, 1)
+	if err != nil {
+		return 0, err
+	}
+	if netNS == hostNetNS {
+		return 0, fmt.Errorf("process %d uses the network of the host, its ip can't be changed", pid)
+	}
+
+	procs, err := ioutil.ReadDir(procPath)
+	if err != nil {
+		return 0, err
+	}
+
+	var sandboxes []uint32
+	for _, proc := range procs {
+		p, err := strconv.ParseUint(proc.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+
+		// the processes may exit during the scan, they're skipped
+		ns, err := netNSOf(procPath, uint32(p))
+		if err != nil || ns != netNS {
+			continue
+		}
+		comm, err := ioutil.ReadFile(filepath.Join(procPath, proc.Name(), "comm"))
+		if err != nil {
+			continue
+		}
+		if _, ok := sandboxCommands[strings.TrimSpace(string(comm))]; ok {
+			sandboxes = append(sandboxes, uint32(p))
+		}
+	}
+
+	switch len(sandboxes) {
+	case 0:
+		return 0, fmt.Errorf("the sandbox of process %d isn't found in %s", pid, netNS)
+	case 1:
+		return sandboxes[0], nil
+	default:
+		return 0, fmt.Errorf("%d sandboxes are found in %s", len(sandboxes), netNS)
+	}
+}
+
+// netNSOf reads the network namespace of the process, e.g. net:[4026531992]
+func netNSOf(procPath string, pid uint32) (string, error) {
+	return os.Readlink(filepath.Join(procPath, strconv.FormatUint(uint64(pid), 10), "ns/net"))
+}
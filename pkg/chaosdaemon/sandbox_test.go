@@ -0,0 +1,94 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var _ = Describe("sandbox", func() {
+	var root string
+
+	BeforeEach(func() {
+		var err error
+		root, err = ioutil.TempDir("", "sandbox")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(root)
+	})
+
+	addProc := func(pid, comm, netNS string) {
+		Expect(os.MkdirAll(filepath.Join(root, pid, "ns"), 0755)).To(Succeed())
+		Expect(os.Symlink(netNS, filepath.Join(root, pid, "ns/net"))).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(root, pid, "comm"), []byte(comm+"\n"), 0644)).To(Succeed())
+	}
+
+	Context("findSandboxPid", func() {
+		It("should find the sandbox in the network namespace of the process", func() {
+			addProc("1", "systemd", "net:[1]")
+			addProc("10", "pause", "net:[1]")
+			addProc("20", "pause", "net:[2]")
+			addProc("21", "nginx", "net:[2]")
+			addProc("30", "pause", "net:[3]")
+
+			pid, err := findSandboxPid(root, 21)
+			Expect(err).To(BeNil())
+			Expect(pid).To(Equal(uint32(20)))
+		})
+
+		It("should reject the process in the network of the host", func() {
+			addProc("1", "systemd", "net:[1]")
+			addProc("10", "pause", "net:[1]")
+			addProc("11", "kube-proxy", "net:[1]")
+
+			_, err := findSandboxPid(root, 11)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("network of the host"))
+		})
+
+		It("should fail if the sandbox isn't found", func() {
+			addProc("1", "systemd", "net:[1]")
+			addProc("21", "nginx", "net:[2]")
+
+			_, err := findSandboxPid(root, 21)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("isn't found"))
+		})
+	})
+
+	Context("SandboxKill", func() {
+		It("should fail on wrong action type", func() {
+			const wrongActionType = 9527
+			s := &daemonServer{}
+			_, err := s.SandboxKill(context.TODO(), &pb.ContainerRequest{
+				Action: &pb.ContainerAction{
+					Action: pb.ContainerAction_Action(wrongActionType),
+				},
+				ContainerId: "containerd://container-id",
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("not kill"))
+		})
+	})
+})
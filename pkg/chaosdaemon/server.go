@@ -17,16 +17,21 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"runtime"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+	"github.com/chaos-mesh/chaos-mesh/pkg/tracing"
 	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
 )
 
@@ -41,6 +46,22 @@ type Config struct {
 	Host      string
 	Runtime   string
 	Profiling bool
+
+	// TLS is the files used to serve grpc with mutual TLS, the grpc server is insecure if it's not enabled
+	TLS utils.TLSFiles
+	// AllowedClients is the names of the client certs which are allowed to call
+	// the grpc server, it only takes effect if TLS is enabled
+	AllowedClients []string
+	// NodeName is the node which chaos-daemon runs on. If it's set, the containers in
+	// the requests are verified to belong to the pods on the node
+	NodeName string
+	// JournalPath is the file which the journal of injections is persisted to,
+	// the journal is only kept in memory if it's empty. The proxies of the database faults
+	// are persisted next to it.
+	JournalPath string
+	// Mode is the mode which chaos-daemon runs in, the methods which need more privileges
+	// than the mode allows are rejected
+	Mode string
 }
 
 // Get the http address
@@ -56,6 +77,24 @@ func (c *Config) GrpcAddr() string {
 // Server represents a grpc server for tc daemon
 type daemonServer struct {
 	crClient ContainerRuntimeInfoClient
+	runtime  string
+
+	// interceptor handles every request in the batch calls like the single call
+	interceptor grpc.UnaryServerInterceptor
+
+	profiles netemProfiles
+
+	// guard is nil if the mode isn't checked
+	guard *modeGuard
+
+	// status broadcasts the progress of experiments to the watchers
+	status *statusHub
+
+	conntrack conntrackLimits
+	mtus      mtuOriginals
+
+	exhaustions exhaustions
+	dbProxies   dbProxies
 }
 
 func newDaemonServer(containerRuntime string) (*daemonServer, error) {
@@ -66,13 +105,52 @@ func newDaemonServer(containerRuntime string) (*daemonServer, error) {
 
 	return &daemonServer{
 		crClient: crClient,
+		runtime:  containerRuntime,
+		status:   newStatusHub(),
 	}, nil
 }
 
-func newGRPCServer(containerRuntime string, reg prometheus.Registerer) (*grpc.Server, error) {
-	ds, err := newDaemonServer(containerRuntime)
+func newGRPCServer(conf *Config, reg prometheus.Registerer) (*grpc.Server, *journal, error) {
+	ds, err := newDaemonServer(conf.Runtime)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	auth, err := newConfiguredAuthorizer(conf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mode := conf.Mode
+	if mode == "" {
+		mode = utils.DaemonModePrivileged
+	}
+	var capabilities []string
+	if runtime.GOOS == utils.OSLinux {
+		capabilities, err = effectiveCapabilities(defaultProcPrefix)
+		if err != nil {
+			log.Error(err, "fail to read effective capabilities")
+		}
+	}
+	ds.guard, err = newModeGuard(mode, capabilities)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	j, err := newJournal(conf.JournalPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := j.Reconcile(context.Background(), ds.crClient); err != nil {
+		return nil, nil, err
+	}
+
+	var dbProxyPath string
+	if conf.JournalPath != "" {
+		dbProxyPath = conf.JournalPath + dbProxyStateSuffix
+	}
+	if err := ds.restoreDBProxies(context.Background(), dbProxyPath); err != nil {
+		return nil, nil, err
 	}
 
 	grpcMetrics := grpc_prometheus.NewServerMetrics()
@@ -81,11 +159,41 @@ func newGRPCServer(containerRuntime string, reg prometheus.Registerer) (*grpc.Se
 	)
 	reg.MustRegister(grpcMetrics)
 
+	rpcErrors := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "chaos_daemon_grpc_errors_total",
+		Help: "Total number of failed gRPC calls of chaos-daemon",
+	}, []string{"method"})
+	reg.MustRegister(rpcErrors)
+
+	ds.interceptor = grpc_middleware.ChainUnaryServer(auth.UnaryServerInterceptor, j.UnaryServerInterceptor,
+		ds.status.UnaryServerInterceptor)
+
 	grpcOpts := []grpc.ServerOption{
 		grpc_middleware.WithUnaryServerChain(
 			utils.TimeoutServerInterceptor,
+			tracing.UnaryServerInterceptor,
 			grpcMetrics.UnaryServerInterceptor(),
+			errorCountInterceptor(rpcErrors),
+			newPlatformGuard(runtime.GOOS).UnaryServerInterceptor,
+			ds.guard.UnaryServerInterceptor,
+			auth.UnaryServerInterceptor,
+			j.UnaryServerInterceptor,
+			ds.status.UnaryServerInterceptor,
 		),
+		grpc_middleware.WithStreamServerChain(
+			grpcMetrics.StreamServerInterceptor(),
+			auth.StreamServerInterceptor,
+		),
+	}
+
+	if conf.TLS.Enabled() {
+		tlsConfig, err := conf.TLS.ServerTLSConfig()
+		if err != nil {
+			return nil, nil, err
+		}
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else {
+		log.Info("grpc server is insecure, every caller is able to inject chaos through chaos-daemon")
 	}
 
 	s := grpc.NewServer(grpcOpts...)
@@ -94,7 +202,47 @@ func newGRPCServer(containerRuntime string, reg prometheus.Registerer) (*grpc.Se
 	pb.RegisterChaosDaemonServer(s, ds)
 	reflection.Register(s)
 
-	return s, nil
+	return s, j, nil
+}
+
+// newConfiguredAuthorizer creates the authorizer according to the config
+func newConfiguredAuthorizer(conf *Config) (*authorizer, error) {
+	var allowedClients []string
+	if conf.TLS.Enabled() {
+		allowedClients = conf.AllowedClients
+	}
+
+	if conf.NodeName == "" {
+		return newAuthorizer(allowedClients, nil), nil
+	}
+
+	// Mock point to return mock client in unit test
+	if c := mock.On("MockKubeClient"); c != nil {
+		return newAuthorizer(allowedClients, newNodeContainerVerifier(c.(client.Reader), conf.NodeName)), nil
+	}
+
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	c, err := client.New(cfg, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+	return newAuthorizer(allowedClients, newNodeContainerVerifier(c, conf.NodeName)), nil
+}
+
+// errorCountInterceptor counts the failed calls by gRPC method
+func errorCountInterceptor(counter *prometheus.CounterVec) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			counter.WithLabelValues(info.FullMethod).Inc()
+			log.Error(err, "grpc call failed", "method", info.FullMethod)
+		}
+		return resp, err
+	}
 }
 
 // RegisterGatherer combine prometheus.Registerer and prometheus.Gatherer
@@ -107,9 +255,6 @@ type RegisterGatherer interface {
 func StartServer(conf *Config, reg RegisterGatherer) error {
 	g := &errgroup.Group{}
 
-	httpBindAddr := conf.HttpAddr()
-	httpServer := newHTTPServerBuilder().Addr(httpBindAddr).Metrics(reg).Profiling(conf.Profiling).Build()
-
 	grpcBindAddr := conf.GrpcAddr()
 	grpcListener, err := net.Listen("tcp", grpcBindAddr)
 	if err != nil {
@@ -117,12 +262,15 @@ func StartServer(conf *Config, reg RegisterGatherer) error {
 		return err
 	}
 
-	grpcServer, err := newGRPCServer(conf.Runtime, reg)
+	grpcServer, j, err := newGRPCServer(conf, reg)
 	if err != nil {
 		log.Error(err, "failed to create grpc server")
 		return err
 	}
 
+	httpBindAddr := conf.HttpAddr()
+	httpServer := newHTTPServerBuilder().Addr(httpBindAddr).Metrics(reg).Profiling(conf.Profiling).Journal(j).Build()
+
 	g.Go(func() error {
 		log.Info("Starting http endpoint", "address", httpBindAddr)
 		if err := httpServer.ListenAndServe(); err != nil {
@@ -134,7 +282,7 @@ func StartServer(conf *Config, reg RegisterGatherer) error {
 	})
 
 	g.Go(func() error {
-		log.Info("Starting grpc endpoint", "address", grpcBindAddr, "runtime", conf.Runtime)
+		log.Info("Starting grpc endpoint", "address", grpcBindAddr, "runtime", conf.Runtime, "tls", conf.TLS.Enabled(), "mode", conf.Mode)
 		if err := grpcServer.Serve(grpcListener); err != nil {
 			log.Error(err, "failed to start grpc endpoint")
 			grpcServer.Stop()
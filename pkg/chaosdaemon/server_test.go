@@ -14,8 +14,14 @@
 package chaosdaemon
 
 import (
+	"context"
+	"errors"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
 )
@@ -37,7 +43,7 @@ var _ = Describe("netem server", func() {
 	Context("newGRPCServer", func() {
 		It("should work", func() {
 			defer mock.With("MockContainerdClient", &MockClient{})()
-			_, err := newGRPCServer(containerRuntimeContainerd, &MockRegisterer{})
+			_, _, err := newGRPCServer(&Config{Runtime: containerRuntimeContainerd}, &MockRegisterer{})
 			Expect(err).To(BeNil())
 		})
 
@@ -45,8 +51,28 @@ var _ = Describe("netem server", func() {
 			Ω(func() {
 				defer mock.With("MockContainerdClient", &MockClient{})()
 				defer mock.With("PanicOnMustRegister", "mock panic")()
-				newGRPCServer(containerRuntimeContainerd, &MockRegisterer{})
+				newGRPCServer(&Config{Runtime: containerRuntimeContainerd}, &MockRegisterer{})
 			}).Should(Panic())
 		})
 	})
+
+	Context("errorCountInterceptor", func() {
+		It("should count failed calls", func() {
+			counter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_errors_total"}, []string{"method"})
+			interceptor := errorCountInterceptor(counter)
+			info := &grpc.UnaryServerInfo{FullMethod: "/pb.ChaosDaemon/SetNetem"}
+
+			_, err := interceptor(context.TODO(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+			Expect(err).To(BeNil())
+			Expect(testutil.ToFloat64(counter.WithLabelValues(info.FullMethod))).To(Equal(float64(0)))
+
+			_, err = interceptor(context.TODO(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, errors.New("mock error")
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(testutil.ToFloat64(counter.WithLabelValues(info.FullMethod))).To(Equal(float64(1)))
+		})
+	})
 })
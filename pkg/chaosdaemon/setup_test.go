@@ -74,6 +74,18 @@ func (m *MockClient) LoadContainer(ctx context.Context, id string) (containerd.C
 	return &MockContainer{}, nil
 }
 
+func (m *MockClient) ContainerInfo(ctx context.Context, id string) (*CrioContainerInfo, error) {
+	if err := mock.On("ContainerInfoError"); err != nil {
+		return nil, err.(error)
+	}
+
+	var pid int
+	if p := mock.On("pid"); p != nil {
+		pid = p.(int)
+	}
+	return &CrioContainerInfo{Name: id, Pid: pid}, nil
+}
+
 type MockContainer struct {
 	containerd.Container
 }
@@ -0,0 +1,142 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// ContainerSignal sends the signal to the main process of the container, or to the nth direct child
+// of the main process, so the signal handlers of the processes, such as reloading the configuration
+// on SIGHUP, can be tested. It isn't recorded in the journal, since the same signal is sent again
+// in every run of the chaos.
+func (s *daemonServer) ContainerSignal(ctx context.Context, req *pb.SignalRequest) (*empty.Empty, error) {
+	log.Info("Container Signal", "request", req)
+
+	sig, err := parseSignal(req.Signal)
+	if err != nil {
+		log.Error(err, "invalid signal", "signal", req.Signal)
+		return nil, err
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting PID")
+		return nil, err
+	}
+
+	target := pid
+	if req.Child > 0 {
+		target, err = nthChild(defaultProcPrefix, pid, int(req.Child))
+		if err != nil {
+			log.Error(err, "error while finding child", "pid", pid, "child", req.Child)
+			return nil, err
+		}
+	}
+
+	if err := sendSignal(target, sig); err != nil {
+		log.Error(err, "error while sending signal", "pid", target, "signal", req.Signal)
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// procStat is the stat of a process read from the proc filesystem
+type procStat struct {
+	pid       uint32
+	ppid      uint32
+	startTime uint64
+}
+
+// nthChild returns the nth direct child of the process, the children are ordered by their start time
+// and counted from 1
+func nthChild(procPath string, ppid uint32, n int) (uint32, error) {
+	procs, err := ioutil.ReadDir(procPath)
+	if err != nil {
+		return 0, err
+	}
+
+	var children []procStat
+	for _, proc := range procs {
+		if _, err := strconv.ParseUint(proc.Name(), 10, 32); err != nil {
+			continue
+		}
+
+		// the processes may exit during the scan, they're skipped
+		p, err := readProcStat(filepath.Join(procPath, proc.Name(), "stat"))
+		if err != nil || p.ppid != ppid {
+			continue
+		}
+		children = append(children, p)
+	}
+
+	if n > len(children) {
+		return 0, fmt.Errorf("process %d has %d children, the child %d isn't found", ppid, len(children), n)
+	}
+	sort.Slice(children, func(i, j int) bool {
+		if children[i].startTime != children[j].startTime {
+			return children[i].startTime < children[j].startTime
+		}
+		return children[i].pid < children[j].pid
+	})
+
+	return children[n-1].pid, nil
+}
+
+// readProcStat reads the pid, the parent and the start time of the process from its stat file
+func readProcStat(statPath string) (procStat, error) {
+	content, err := ioutil.ReadFile(statPath)
+	if err != nil {
+		return procStat{}, err
+	}
+
+	// the command may contain spaces and parentheses, so the fields are split after the last parenthesis
+	stat := string(content)
+	start, end := strings.Index(stat, "("), strings.LastIndex(stat, ")")
+	if start < 0 || end < start {
+		return procStat{}, fmt.Errorf("invalid stat %s", statPath)
+	}
+	pid, err := strconv.ParseUint(strings.TrimSpace(stat[:start]), 10, 32)
+	if err != nil {
+		return procStat{}, fmt.Errorf("invalid pid in %s: %v", statPath, err)
+	}
+
+	// according to procfs's man page, the fields after the command start from the state,
+	// the parent is the 4th field and the start time is the 22nd field
+	fields := strings.Fields(stat[end+1:])
+	if len(fields) < 20 {
+		return procStat{}, fmt.Errorf("invalid stat %s", statPath)
+	}
+	ppid, err := strconv.ParseUint(fields[1], 10, 32)
+	if err != nil {
+		return procStat{}, fmt.Errorf("invalid parent in %s: %v", statPath, err)
+	}
+	startTime, err := strconv.ParseUint(fields[19], 10, 64)
+	if err != nil {
+		return procStat{}, fmt.Errorf("invalid start time in %s: %v", statPath, err)
+	}
+
+	return procStat{pid: uint32(pid), ppid: uint32(ppid), startTime: startTime}, nil
+}
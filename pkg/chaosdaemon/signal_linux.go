@@ -0,0 +1,62 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+// maxSignal is the max number of the signals on linux, including the real-time signals
+const maxSignal = 64
+
+// parseSignal parses the name or the number of the signal, such as SIGHUP, HUP or 1
+func parseSignal(name string) (syscall.Signal, error) {
+	if num, err := strconv.Atoi(name); err == nil {
+		if num <= 0 || num > maxSignal {
+			return 0, fmt.Errorf("signal %d is out of range", num)
+		}
+		return syscall.Signal(num), nil
+	}
+
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "SIG") {
+		name = "SIG" + name
+	}
+	sig := unix.SignalNum(name)
+	if sig == 0 {
+		return 0, fmt.Errorf("unknown signal %s", name)
+	}
+	return sig, nil
+}
+
+func sendSignal(pid uint32, sig syscall.Signal) error {
+	// Mock point to return error or skip sending in unit test
+	if err := mock.On("SignalError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			return nil
+		}
+	}
+
+	return syscall.Kill(int(pid), sig)
+}
@@ -0,0 +1,62 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"syscall"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var _ = Describe("signal on linux", func() {
+	Context("parseSignal", func() {
+		It("should parse the names and the numbers of signals", func() {
+			for name, expected := range map[string]syscall.Signal{
+				"SIGHUP":  syscall.SIGHUP,
+				"usr1":    syscall.SIGUSR1,
+				"KILL":    syscall.SIGKILL,
+				"15":      syscall.SIGTERM,
+				"34":      syscall.Signal(34),
+				"SIGTERM": syscall.SIGTERM,
+			} {
+				sig, err := parseSignal(name)
+				Expect(err).To(BeNil())
+				Expect(sig).To(Equal(expected))
+			}
+		})
+
+		It("should reject the unknown signals", func() {
+			for _, name := range []string{"SIGFOO", "0", "65", "-1", ""} {
+				_, err := parseSignal(name)
+				Expect(err).ToNot(BeNil(), name)
+			}
+		})
+	})
+
+	Context("ContainerSignal", func() {
+		It("should fail on the unknown signal", func() {
+			s := &daemonServer{}
+			_, err := s.ContainerSignal(context.TODO(), &pb.SignalRequest{
+				ContainerId: "containerd://container-id",
+				Signal:      "SIGFOO",
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("unknown signal"))
+		})
+	})
+})
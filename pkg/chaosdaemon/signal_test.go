@@ -0,0 +1,88 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("signal", func() {
+	var root string
+
+	BeforeEach(func() {
+		var err error
+		root, err = ioutil.TempDir("", "signal")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(root)
+	})
+
+	addProc := func(pid int, comm string, ppid int, startTime int) {
+		Expect(os.MkdirAll(filepath.Join(root, fmt.Sprint(pid)), 0755)).To(Succeed())
+		stat := fmt.Sprintf("%d (%s) S %d %d %d 0 -1 4194560 100 0 0 0 1 2 0 0 20 0 1 0 %d 1000 10 18446744073709551615\n",
+			pid, comm, ppid, pid, pid, startTime)
+		Expect(ioutil.WriteFile(filepath.Join(root, fmt.Sprint(pid), "stat"), []byte(stat), 0644)).To(Succeed())
+	}
+
+	Context("readProcStat", func() {
+		It("should read the command with spaces and parentheses", func() {
+			addProc(12, "worker (1) x", 10, 300)
+
+			stat, err := readProcStat(filepath.Join(root, "12", "stat"))
+			Expect(err).To(BeNil())
+			Expect(stat).To(Equal(procStat{pid: 12, ppid: 10, startTime: 300}))
+		})
+
+		It("should fail on the truncated stat", func() {
+			Expect(os.MkdirAll(filepath.Join(root, "12"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(root, "12", "stat"), []byte("12 (worker) S 10"), 0644)).To(Succeed())
+
+			_, err := readProcStat(filepath.Join(root, "12", "stat"))
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Context("nthChild", func() {
+		BeforeEach(func() {
+			addProc(1, "init", 0, 1)
+			addProc(10, "nginx", 1, 100)
+			addProc(13, "worker", 10, 300)
+			addProc(11, "worker", 10, 200)
+			addProc(12, "cache", 10, 200)
+			addProc(20, "grandchild", 11, 400)
+		})
+
+		It("should order the children by their start time", func() {
+			for n, expected := range []uint32{11, 12, 13} {
+				pid, err := nthChild(root, 10, n+1)
+				Expect(err).To(BeNil())
+				Expect(pid).To(Equal(expected))
+			}
+		})
+
+		It("should fail if the child isn't found", func() {
+			_, err := nthChild(root, 10, 4)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("has 3 children"))
+		})
+	})
+})
@@ -0,0 +1,44 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"net"
+	"os"
+	"time"
+)
+
+const (
+	defaultDockerSocketPath = "/var/run/docker.sock"
+	defaultDockerSocket     = "unix://" + defaultDockerSocketPath
+
+	// TODO(yeya24): make socket and ns configurable
+	defaultContainerdSocket = "/run/containerd/containerd.sock"
+
+	defaultCrioSocket = "/var/run/crio/crio.sock"
+)
+
+// isSocketFile returns true if the path is a unix socket
+func isSocketFile(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeSocket != 0
+}
+
+// dialSocket connects to the unix socket of the container runtime
+func dialSocket(path string, timeout time.Duration) (net.Conn, error) {
+	return net.DialTimeout("unix", path, timeout)
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"net"
+	"time"
+
+	"github.com/Microsoft/go-winio"
+)
+
+// the container runtimes on windows listen on the named pipes
+const (
+	defaultDockerSocketPath = `\\.\pipe\docker_engine`
+	defaultDockerSocket     = "npipe:////./pipe/docker_engine"
+
+	defaultContainerdSocket = `\\.\pipe\containerd-containerd`
+
+	// CRI-O doesn't run on windows
+	defaultCrioSocket = ""
+)
+
+// pipeProbeTimeout is how long the named pipe is waited for when it's probed
+const pipeProbeTimeout = 100 * time.Millisecond
+
+// isSocketFile returns true if the path is a named pipe which is able to be connected
+func isSocketFile(path string) bool {
+	if path == "" {
+		return false
+	}
+
+	conn, err := dialSocket(path, pipeProbeTimeout)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+// dialSocket connects to the named pipe of the container runtime
+func dialSocket(path string, timeout time.Duration) (net.Conn, error) {
+	return winio.DialPipe(path, &timeout)
+}
@@ -0,0 +1,189 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// statusBufferSize is the number of events buffered for each watcher
+const statusBufferSize = 64
+
+// statusHub broadcasts the progress of experiments to the watchers of WatchStatus.
+// The events are only published for the calls made on behalf of experiments.
+type statusHub struct {
+	sync.Mutex
+
+	watchers map[*statusWatcher]struct{}
+}
+
+type statusWatcher struct {
+	// uid is the experiment watched, every experiment is watched if it's empty
+	uid    string
+	events chan *pb.StatusEvent
+}
+
+func newStatusHub() *statusHub {
+	return &statusHub{
+		watchers: make(map[*statusWatcher]struct{}),
+	}
+}
+
+func (h *statusHub) subscribe(uid string) *statusWatcher {
+	h.Lock()
+	defer h.Unlock()
+
+	w := &statusWatcher{
+		uid:    uid,
+		events: make(chan *pb.StatusEvent, statusBufferSize),
+	}
+	h.watchers[w] = struct{}{}
+	return w
+}
+
+func (h *statusHub) unsubscribe(w *statusWatcher) {
+	h.Lock()
+	defer h.Unlock()
+
+	delete(h.watchers, w)
+}
+
+// publish sends the event to the watchers of its experiment. The event is dropped for the watchers
+// which fall behind, so the injections are never blocked by a slow watcher.
+func (h *statusHub) publish(event *pb.StatusEvent) {
+	if h == nil || event == nil {
+		return
+	}
+	event.Time = time.Now().UnixNano()
+
+	h.Lock()
+	defer h.Unlock()
+
+	for w := range h.watchers {
+		if w.uid != "" && w.uid != event.ExperimentUid {
+			continue
+		}
+		select {
+		case w.events <- event:
+		default:
+			log.Info("status watcher falls behind, dropping the event", "uid", event.ExperimentUid, "method", event.Method)
+		}
+	}
+}
+
+// UnaryServerInterceptor publishes the outcomes of the injections and the recoveries made on behalf of experiments
+func (h *statusHub) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+
+	// the cancelled stressors are reported by their pids, the request carries no containers
+	if r, ok := req.(*pb.CancelStressRequest); ok && err == nil {
+		event := newStatusEvent(ctx, info.FullMethod, nil)
+		if pid, err := strconv.ParseInt(r.Instance, 10, 64); err == nil && event != nil {
+			event.Phase = pb.StatusRecovered
+			event.Pids = []int64{pid}
+			h.publish(event)
+		}
+		return resp, err
+	}
+
+	_, injection := injectionMethods[info.FullMethod]
+	_, recovery := recoveryMethods[info.FullMethod]
+	if !injection && !recovery {
+		return resp, err
+	}
+
+	event := newStatusEvent(ctx, info.FullMethod, requestedContainers(req))
+	if event == nil {
+		return resp, err
+	}
+	switch {
+	case err != nil:
+		event.Phase = pb.StatusFailed
+		event.Message = err.Error()
+	case injection:
+		event.Phase = pb.StatusApplied
+		if r, ok := resp.(*pb.ExecStressResponse); ok {
+			if pid, err := strconv.ParseInt(r.Instance, 10, 64); err == nil {
+				event.Pids = []int64{pid}
+			}
+		}
+	default:
+		event.Phase = pb.StatusRecovered
+	}
+	h.publish(event)
+
+	return resp, err
+}
+
+// newStatusEvent creates the event of the call on behalf of the experiment in ctx,
+// it returns nil if the call isn't made for any experiment
+func newStatusEvent(ctx context.Context, method string, containers []string) *pb.StatusEvent {
+	uid := experimentUID(ctx)
+	if uid == "" {
+		return nil
+	}
+
+	event := &pb.StatusEvent{
+		ExperimentUid: uid,
+		Method:        strings.TrimPrefix(method, grpcServicePrefix),
+		ContainerIds:  containers,
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(pb.ExperimentMetadataKey); len(values) > 0 {
+			event.Experiment = values[0]
+		}
+	}
+	return event
+}
+
+// newExitEvent creates the event which is published when the stressors exit before they're cancelled
+func newExitEvent(ctx context.Context, req *pb.ExecStressRequest) *pb.StatusEvent {
+	event := newStatusEvent(ctx, grpcServicePrefix+"ExecStressors", []string{req.Target})
+	if event != nil {
+		event.Phase = pb.StatusExited
+	}
+	return event
+}
+
+// WatchStatus sends the events of the experiment in the request to the stream until the stream is closed,
+// the events of every experiment are sent if no experiment is requested
+func (s *daemonServer) WatchStatus(req *pb.WatchStatusRequest, stream pb.ChaosDaemon_WatchStatusServer) error {
+	w := s.status.subscribe(req.ExperimentUid)
+	defer s.status.unsubscribe(w)
+	// the header tells the watcher that it's subscribed, so it receives the events of the following calls
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-w.events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func callWithStatus(h *statusHub, ctx context.Context, method string, req interface{}, resp interface{}, err error) {
+	_, _ = h.UnaryServerInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: grpcServicePrefix + method},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return resp, err
+		})
+}
+
+func receivedEvent(w *statusWatcher) *pb.StatusEvent {
+	select {
+	case event := <-w.events:
+		return event
+	default:
+		return nil
+	}
+}
+
+var _ = Describe("status hub", func() {
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(
+		pb.ExperimentUIDMetadataKey, "uid1",
+		pb.ExperimentMetadataKey, "StressChaos/default/stress",
+	))
+
+	It("should publish the outcomes of the calls made for experiments", func() {
+		h := newStatusHub()
+		w := h.subscribe("")
+
+		callWithStatus(h, ctx, "ExecStressors", &pb.ExecStressRequest{Target: "docker://c1"},
+			&pb.ExecStressResponse{Instance: "1234"}, nil)
+		event := receivedEvent(w)
+		Expect(event).NotTo(BeNil())
+		Expect(event.ExperimentUid).To(Equal("uid1"))
+		Expect(event.Experiment).To(Equal("StressChaos/default/stress"))
+		Expect(event.Method).To(Equal("ExecStressors"))
+		Expect(event.ContainerIds).To(Equal([]string{"docker://c1"}))
+		Expect(event.Phase).To(Equal(pb.StatusApplied))
+		Expect(event.Pids).To(Equal([]int64{1234}))
+		Expect(event.Time).NotTo(BeZero())
+
+		callWithStatus(h, ctx, "SetNetem", &pb.NetemRequest{ContainerId: "docker://c2"}, nil, errors.New("no such device"))
+		event = receivedEvent(w)
+		Expect(event.Phase).To(Equal(pb.StatusFailed))
+		Expect(event.Message).To(Equal("no such device"))
+
+		callWithStatus(h, ctx, "DeleteNetem", &pb.NetemRequest{ContainerId: "docker://c2"}, &empty.Empty{}, nil)
+		Expect(receivedEvent(w).Phase).To(Equal(pb.StatusRecovered))
+
+		callWithStatus(h, ctx, "CancelStressors", &pb.CancelStressRequest{Instance: "1234"}, &empty.Empty{}, nil)
+		event = receivedEvent(w)
+		Expect(event.Phase).To(Equal(pb.StatusRecovered))
+		Expect(event.Pids).To(Equal([]int64{1234}))
+	})
+
+	It("should not publish the calls made for no experiment or the queries", func() {
+		h := newStatusHub()
+		w := h.subscribe("")
+
+		callWithStatus(h, context.TODO(), "SetNetem", &pb.NetemRequest{ContainerId: "docker://c1"}, &empty.Empty{}, nil)
+		callWithStatus(h, ctx, "ContainerGetPid", &pb.ContainerRequest{}, &pb.ContainerResponse{}, nil)
+		Expect(receivedEvent(w)).To(BeNil())
+	})
+
+	It("should only send the events of the watched experiment", func() {
+		h := newStatusHub()
+		w1 := h.subscribe("uid1")
+		w2 := h.subscribe("uid2")
+
+		callWithStatus(h, ctx, "SetTimeOffset", &pb.TimeRequest{ContainerId: "docker://c1"}, &empty.Empty{}, nil)
+		Expect(receivedEvent(w1)).NotTo(BeNil())
+		Expect(receivedEvent(w2)).To(BeNil())
+
+		h.unsubscribe(w1)
+		callWithStatus(h, ctx, "SetTimeOffset", &pb.TimeRequest{ContainerId: "docker://c1"}, &empty.Empty{}, nil)
+		Expect(receivedEvent(w1)).To(BeNil())
+	})
+
+	It("should drop the events for the watchers which fall behind", func() {
+		h := newStatusHub()
+		w := h.subscribe("")
+
+		for i := 0; i < statusBufferSize+1; i++ {
+			callWithStatus(h, ctx, "SetTimeOffset", &pb.TimeRequest{ContainerId: "docker://c1"}, &empty.Empty{}, nil)
+		}
+		Expect(w.events).To(HaveLen(statusBufferSize))
+	})
+})
@@ -64,7 +64,22 @@ func (s *daemonServer) ExecStressors(ctx context.Context,
 		return nil, err
 	}
 
-	cmd := withPidNS(context.Background(), GetNsPath(pid, pidNS), "stress-ng", strings.Fields(req.Stressors)...)
+	args := strings.Fields(req.Stressors)
+	if req.TargetCpuAffinity {
+		cpus, err := allowedCPUs(defaultProcPrefix, pid)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, "--taskset", cpus)
+	}
+	name := "stress-ng"
+	if req.Nice != 0 {
+		// nice executes stress-ng in place, so the stressors are still the children of nsenter
+		args = append([]string{"-n", strconv.Itoa(int(req.Nice)), name}, args...)
+		name = "nice"
+	}
+
+	cmd := withPidNS(context.Background(), GetNsPath(pid, pidNS), name, args...)
 
 	if err := cmd.Start(); err != nil {
 		return nil, err
@@ -88,6 +103,7 @@ func (s *daemonServer) ExecStressors(ctx context.Context,
 		}
 		return nil, err
 	}
+	exited := newExitEvent(ctx, req)
 	go func() {
 		if err, ok := cmd.Wait().(*exec.ExitError); ok {
 			status := err.Sys().(syscall.WaitStatus)
@@ -95,6 +111,11 @@ func (s *daemonServer) ExecStressors(ctx context.Context,
 				log.Info("Stressors cancelled", "request", req)
 			} else {
 				log.Error(err, "stressors exited accidentally", "request", req)
+				if exited != nil {
+					exited.Pids = []int64{int64(cmd.Process.Pid)}
+					exited.Message = err.Error()
+					s.status.publish(exited)
+				}
 			}
 		}
 	}()
@@ -134,6 +155,28 @@ func (s *daemonServer) CancelStressors(ctx context.Context,
 	return &empty.Empty{}, nil
 }
 
+// allowedCPUs reads the list of the cores which the process is allowed to run on, such as 0-3,6
+func allowedCPUs(procPath string, pid uint32) (string, error) {
+	p := filepath.Join(procPath, strconv.FormatUint(uint64(pid), 10), "status")
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 2 && fields[0] == "Cpus_allowed_list:" {
+			return fields[1], nil
+		}
+	}
+	if err := s.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("allowed cpus aren't found in %s", p)
+}
+
 func findValidCgroup(path cgroups.Path, target string) (string, error) {
 	for _, subsys := range cgroupSubsys {
 		p, err := path(cgroups.Name(subsys))
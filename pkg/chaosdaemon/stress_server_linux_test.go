@@ -0,0 +1,44 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("stress server", func() {
+	Context("allowedCPUs", func() {
+		It("should read the allowed cpus of the process", func() {
+			root, err := ioutil.TempDir("", "stress")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(root)
+
+			Expect(os.MkdirAll(filepath.Join(root, "42"), 0755)).To(Succeed())
+			status := "Name:\tnginx\nCpus_allowed:\tff\nCpus_allowed_list:\t0-3,6\nMems_allowed_list:\t0\n"
+			Expect(ioutil.WriteFile(filepath.Join(root, "42/status"), []byte(status), 0644)).To(Succeed())
+
+			cpus, err := allowedCPUs(root, 42)
+			Expect(err).To(BeNil())
+			Expect(cpus).To(Equal("0-3,6"))
+
+			_, err = allowedCPUs(root, 43)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
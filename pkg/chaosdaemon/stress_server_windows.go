@@ -0,0 +1,220 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"sync"
+	"unsafe"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/shirou/gopsutil/mem"
+	"github.com/shirou/gopsutil/process"
+	"golang.org/x/sys/windows"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// The cpu rate control of job objects, which isn't defined in x/sys/windows
+const (
+	jobObjectCPURateControlEnable  = 0x1
+	jobObjectCPURateControlHardCap = 0x4
+	// jobObjectCPURateMax is the cpu rate of all cpus, the rate is in 1/100 of a percent
+	jobObjectCPURateMax = 10000
+)
+
+type jobObjectCPURateControlInformation struct {
+	ControlFlags uint32
+	CPURate      uint32
+}
+
+// stressJobs are the job objects of the running workers by their pids. The workers are
+// killed when the job objects are closed, including when chaos-daemon exits.
+var stressJobs = struct {
+	sync.Mutex
+	jobs map[int]windows.Handle
+}{
+	jobs: make(map[int]windows.Handle),
+}
+
+// ExecStressors runs the stressors by the worker of chaos-daemon in a job object. The job object
+// limits the cpu rate of the worker to the load of the cpu stressors. There are no cgroups on windows,
+// so the stressors load the node where the target runs, whatever the scope is.
+func (s *daemonServer) ExecStressors(ctx context.Context,
+	req *pb.ExecStressRequest) (*pb.ExecStressResponse, error) {
+	log.Info("Executing stressors", "request", req)
+	// the stressors are only run for the running targets
+	if _, err := s.crClient.GetPidFromContainerID(ctx, req.Target); err != nil {
+		return nil, err
+	}
+
+	if req.TargetCpuAffinity || req.Nice != 0 {
+		return nil, fmt.Errorf("the cpu affinity and the nice value of stressors are unsupported on windows")
+	}
+
+	memory, err := mem.VirtualMemory()
+	if err != nil {
+		return nil, err
+	}
+	opts, err := parseStressors(req.Stressors, memory.Total)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := newStressJob(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		windows.CloseHandle(job)
+		return nil, err
+	}
+	cmd := exec.Command(executable, opts.args()...)
+	if err := cmd.Start(); err != nil {
+		windows.CloseHandle(job)
+		return nil, err
+	}
+	log.Info("Start process successfully")
+
+	pid := cmd.Process.Pid
+	if err := assignToJob(job, pid); err != nil {
+		if kerr := cmd.Process.Kill(); kerr != nil {
+			log.Error(kerr, "kill stressors failed", "request", req)
+		}
+		windows.CloseHandle(job)
+		return nil, err
+	}
+
+	procState, err := process.NewProcess(int32(pid))
+	if err != nil {
+		windows.CloseHandle(job)
+		return nil, err
+	}
+	ct, err := procState.CreateTime()
+	if err != nil {
+		windows.CloseHandle(job)
+		return nil, err
+	}
+
+	stressJobs.Lock()
+	stressJobs.jobs[pid] = job
+	stressJobs.Unlock()
+	exited := newExitEvent(ctx, req)
+	go func() {
+		err := cmd.Wait()
+		if err != nil {
+			log.Info("Stressors exited", "request", req, "error", err.Error())
+		}
+		// the job is still open if the worker isn't cancelled
+		if closeStressJob(pid) && exited != nil {
+			exited.Pids = []int64{int64(pid)}
+			if err != nil {
+				exited.Message = err.Error()
+			}
+			s.status.publish(exited)
+		}
+	}()
+
+	return &pb.ExecStressResponse{
+		Instance:  strconv.Itoa(pid),
+		StartTime: ct,
+	}, nil
+}
+
+func (s *daemonServer) CancelStressors(ctx context.Context,
+	req *pb.CancelStressRequest) (*empty.Empty, error) {
+	pid, err := strconv.Atoi(req.Instance)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Canceling stressors", "request", req)
+
+	// the pid may be reused by another process after the worker exits
+	ins, err := process.NewProcess(int32(pid))
+	if err != nil {
+		return &empty.Empty{}, nil
+	}
+	if ct, err := ins.CreateTime(); err == nil && ct == req.StartTime {
+		closeStressJob(pid)
+	}
+
+	log.Info("Successfully canceled stressors")
+	return &empty.Empty{}, nil
+}
+
+// newStressJob creates the job object which kills the worker when it's closed
+// and limits the cpu rate to the load of the cpu stressors
+func newStressJob(opts *stressWorkerOptions) (windows.Handle, error) {
+	job, err := windows.CreateJobObject(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	limit := windows.JOBOBJECT_EXTENDED_LIMIT_INFORMATION{}
+	limit.BasicLimitInformation.LimitFlags = windows.JOB_OBJECT_LIMIT_KILL_ON_JOB_CLOSE
+	if _, err := windows.SetInformationJobObject(job, windows.JobObjectExtendedLimitInformation,
+		uintptr(unsafe.Pointer(&limit)), uint32(unsafe.Sizeof(limit))); err != nil {
+		windows.CloseHandle(job)
+		return 0, err
+	}
+
+	rate := opts.cpuWorkers * opts.cpuLoad * jobObjectCPURateMax / 100 / runtime.NumCPU()
+	if opts.cpuWorkers > 0 && rate < jobObjectCPURateMax {
+		if rate < 1 {
+			rate = 1
+		}
+		control := jobObjectCPURateControlInformation{
+			ControlFlags: jobObjectCPURateControlEnable | jobObjectCPURateControlHardCap,
+			CPURate:      uint32(rate),
+		}
+		if _, err := windows.SetInformationJobObject(job, windows.JobObjectCpuRateControlInformation,
+			uintptr(unsafe.Pointer(&control)), uint32(unsafe.Sizeof(control))); err != nil {
+			windows.CloseHandle(job)
+			return 0, err
+		}
+	}
+
+	return job, nil
+}
+
+func assignToJob(job windows.Handle, pid int) error {
+	handle, err := windows.OpenProcess(windows.PROCESS_SET_QUOTA|windows.PROCESS_TERMINATE, false, uint32(pid))
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(handle)
+
+	return windows.AssignProcessToJobObject(job, handle)
+}
+
+// closeStressJob closes the job object of the worker, which kills the worker.
+// It returns false if the job object has been closed.
+func closeStressJob(pid int) bool {
+	stressJobs.Lock()
+	defer stressJobs.Unlock()
+
+	job, ok := stressJobs.jobs[pid]
+	if ok {
+		windows.CloseHandle(job)
+		delete(stressJobs.jobs, pid)
+	}
+	return ok
+}
@@ -0,0 +1,171 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StressWorkerCommand is the argument which runs chaos-daemon as the worker of stressors,
+// stress-ng is unavailable on windows so chaos-daemon stresses the node by itself
+const StressWorkerCommand = "stress-worker"
+
+const (
+	// defaultVMBytes is the memory allocated by every vm worker if it isn't set, the same as stress-ng
+	defaultVMBytes = 256 << 20
+	pageSize       = 4096
+	// vmTouchInterval is how often the memory of the vm workers is touched to keep it resident
+	vmTouchInterval = time.Second
+)
+
+// stressWorkerOptions are the stressors run by the worker
+type stressWorkerOptions struct {
+	cpuWorkers int
+	// cpuLoad is the percentage of load on every cpu worker, it's enforced by the job object
+	// which the worker belongs to
+	cpuLoad   int
+	vmWorkers int
+	// vmBytes is the memory allocated by every vm worker
+	vmBytes uint64
+}
+
+// parseStressors parses the options of stress-ng which are supported by the worker, the memory
+// of every vm worker in percentage is the percentage of the total memory of the node
+func parseStressors(stressors string, totalMemory uint64) (*stressWorkerOptions, error) {
+	opts := &stressWorkerOptions{cpuLoad: 100}
+	vmBytes := ""
+
+	fields := strings.Fields(stressors)
+	for i := 0; i < len(fields); i++ {
+		option := fields[i]
+		// the workers always keep their memory
+		if option == "--vm-keep" {
+			continue
+		}
+
+		if i+1 >= len(fields) {
+			return nil, fmt.Errorf("stressor option %s requires a value", option)
+		}
+		value := fields[i+1]
+		i++
+
+		var err error
+		switch option {
+		case "--cpu":
+			opts.cpuWorkers, err = strconv.Atoi(value)
+		case "--cpu-load":
+			opts.cpuLoad, err = strconv.Atoi(value)
+		case "--vm":
+			opts.vmWorkers, err = strconv.Atoi(value)
+		case "--vm-bytes":
+			vmBytes = value
+		default:
+			return nil, fmt.Errorf("stressor option %s is unsupported on windows", option)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %s of %s: %v", value, option, err)
+		}
+	}
+
+	if opts.cpuWorkers < 0 || opts.vmWorkers < 0 || opts.cpuWorkers+opts.vmWorkers == 0 {
+		return nil, fmt.Errorf("no workers in stressors %q", stressors)
+	}
+	if opts.cpuLoad <= 0 || opts.cpuLoad > 100 {
+		return nil, fmt.Errorf("cpu load %d isn't a percentage", opts.cpuLoad)
+	}
+
+	opts.vmBytes = defaultVMBytes
+	if vmBytes != "" {
+		size, err := parseVMBytes(vmBytes, totalMemory)
+		if err != nil {
+			return nil, err
+		}
+		opts.vmBytes = size
+	}
+
+	return opts, nil
+}
+
+// parseVMBytes parses the memory in the format of stress-ng, such as 512m or 50%
+func parseVMBytes(size string, totalMemory uint64) (uint64, error) {
+	if strings.HasSuffix(size, "%") {
+		percent, err := strconv.ParseFloat(strings.TrimSuffix(size, "%"), 64)
+		if err != nil || percent <= 0 || percent > 100 {
+			return 0, fmt.Errorf("invalid memory size %s", size)
+		}
+		return uint64(float64(totalMemory) * percent / 100), nil
+	}
+
+	var unit uint64 = 1
+	switch strings.ToLower(size[len(size)-1:]) {
+	case "b":
+		size = size[:len(size)-1]
+	case "k":
+		unit, size = 1<<10, size[:len(size)-1]
+	case "m":
+		unit, size = 1<<20, size[:len(size)-1]
+	case "g":
+		unit, size = 1<<30, size[:len(size)-1]
+	}
+	n, err := strconv.ParseUint(size, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory size %s", size)
+	}
+	return n * unit, nil
+}
+
+// args returns the arguments of the worker
+func (o *stressWorkerOptions) args() []string {
+	return []string{
+		StressWorkerCommand,
+		"--cpu", strconv.Itoa(o.cpuWorkers),
+		"--vm", strconv.Itoa(o.vmWorkers),
+		"--vm-bytes", strconv.FormatUint(o.vmBytes, 10),
+	}
+}
+
+// RunStressWorker runs the cpu and vm workers in the arguments until the process is killed
+func RunStressWorker(args []string) error {
+	flags := flag.NewFlagSet(StressWorkerCommand, flag.ContinueOnError)
+	cpuWorkers := flags.Int("cpu", 0, "the number of the workers spinning on cpu")
+	vmWorkers := flags.Int("vm", 0, "the number of the workers allocating memory")
+	vmBytes := flags.Uint64("vm-bytes", defaultVMBytes, "the memory allocated by every vm worker")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	for i := 0; i < *cpuWorkers; i++ {
+		go func() {
+			for {
+			}
+		}()
+	}
+	for i := 0; i < *vmWorkers; i++ {
+		go func() {
+			memory := make([]byte, *vmBytes)
+			for {
+				for i := 0; i < len(memory); i += pageSize {
+					memory[i]++
+				}
+				time.Sleep(vmTouchInterval)
+			}
+		}()
+	}
+
+	select {}
+}
@@ -0,0 +1,58 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaosdaemon
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("stress worker", func() {
+	Context("parseStressors", func() {
+		It("should parse the normalized stressors", func() {
+			opts, err := parseStressors(" --vm 2 --vm-keep --vm-bytes 536870912 --cpu 1 --cpu-load 50", 0)
+			Expect(err).To(BeNil())
+			Expect(opts).To(Equal(&stressWorkerOptions{cpuWorkers: 1, cpuLoad: 50, vmWorkers: 2, vmBytes: 512 << 20}))
+			Expect(opts.args()).To(Equal([]string{StressWorkerCommand, "--cpu", "1", "--vm", "2", "--vm-bytes", "536870912"}))
+		})
+
+		It("should parse the memory in the format of stress-ng", func() {
+			opts, err := parseStressors("--vm 1 --vm-bytes 25%", 8<<30)
+			Expect(err).To(BeNil())
+			Expect(opts.vmBytes).To(Equal(uint64(2 << 30)))
+
+			opts, err = parseStressors("--vm 1 --vm-bytes 64m", 0)
+			Expect(err).To(BeNil())
+			Expect(opts.vmBytes).To(Equal(uint64(64 << 20)))
+
+			opts, err = parseStressors("--vm 1", 0)
+			Expect(err).To(BeNil())
+			Expect(opts.vmBytes).To(Equal(uint64(defaultVMBytes)))
+		})
+
+		It("should reject the unsupported stressors", func() {
+			_, err := parseStressors("--io 1", 0)
+			Expect(err).To(MatchError("stressor option --io is unsupported on windows"))
+
+			_, err = parseStressors("--cpu 1 --cpu-load 150", 0)
+			Expect(err).To(MatchError("cpu load 150 isn't a percentage"))
+
+			_, err = parseStressors("--vm-keep", 0)
+			Expect(err).To(HaveOccurred())
+
+			_, err = parseStressors("--vm 1 --vm-bytes 1x", 0)
+			Expect(err).To(MatchError("invalid memory size 1x"))
+		})
+	})
+})
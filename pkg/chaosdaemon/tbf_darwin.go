@@ -18,7 +18,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
 )
 
-func applyTbf(tbf *pb.Tbf, pid uint32) error {
+func applyTbf(tbf *pb.Tbf, pid uint32, device string) error {
 	// Mock point to return error in unit test
 	if err := mock.On("TbfApplyError"); err != nil {
 		if e, ok := err.(error); ok {
@@ -32,7 +32,7 @@ func applyTbf(tbf *pb.Tbf, pid uint32) error {
 	panic("unimplemented")
 }
 
-func deleteTbf(tbf *pb.Tbf, pid uint32) error {
+func deleteTbf(tbf *pb.Tbf, pid uint32, device string) error {
 	// Mock point to return error in unit test
 	if err := mock.On("TbfDeleteError"); err != nil {
 		if e, ok := err.(error); ok {
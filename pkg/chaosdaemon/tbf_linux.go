@@ -20,7 +20,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
 )
 
-func applyTbf(tbf *pb.Tbf, pid uint32) error {
+func applyTbf(tbf *pb.Tbf, pid uint32, device string) error {
 	// Mock point to return error in unit test
 	if err := mock.On("TbfApplyError"); err != nil {
 		if e, ok := err.(error); ok {
@@ -31,7 +31,7 @@ func applyTbf(tbf *pb.Tbf, pid uint32) error {
 		}
 	}
 
-	return applyQdisc(pid, func(handle *netlink.Handle, link netlink.Link) netlink.Qdisc {
+	return applyQdisc(pid, device, func(handle *netlink.Handle, link netlink.Link) netlink.Qdisc {
 		return &netlink.Tbf{
 			QdiscAttrs: netlink.QdiscAttrs{
 				LinkIndex: link.Attrs().Index,
@@ -47,7 +47,7 @@ func applyTbf(tbf *pb.Tbf, pid uint32) error {
 	})
 }
 
-func deleteTbf(tbf *pb.Tbf, pid uint32) error {
+func deleteTbf(tbf *pb.Tbf, pid uint32, device string) error {
 	// Mock point to return error in unit test
 	if err := mock.On("TbfDeleteError"); err != nil {
 		if e, ok := err.(error); ok {
@@ -58,7 +58,7 @@ func deleteTbf(tbf *pb.Tbf, pid uint32) error {
 		}
 	}
 
-	return deleteQdisc(pid, func(handle *netlink.Handle, link netlink.Link) netlink.Qdisc {
+	return deleteQdisc(pid, device, func(handle *netlink.Handle, link netlink.Link) netlink.Qdisc {
 		return &netlink.Tbf{
 			QdiscAttrs: netlink.QdiscAttrs{
 				LinkIndex: link.Attrs().Index,
@@ -33,7 +33,7 @@ func (s *daemonServer) SetTbf(ctx context.Context, in *pb.TbfRequest) (*empty.Em
 		return nil, status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
 	}
 
-	if err := applyTbf(in.Tbf, pid); err != nil {
+	if err := applyTbf(in.Tbf, pid, in.Device); err != nil {
 		return nil, status.Errorf(codes.Internal, "tbf apply error: %v", err)
 	}
 
@@ -49,7 +49,7 @@ func (s *daemonServer) DeleteTbf(ctx context.Context, in *pb.TbfRequest) (*empty
 		return nil, status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
 	}
 
-	if err := deleteTbf(in.Tbf, pid); err != nil {
+	if err := deleteTbf(in.Tbf, pid, in.Device); err != nil {
 		return nil, status.Errorf(codes.Internal, "tbf delete error: %v", err)
 	}
 
@@ -19,7 +19,8 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
 )
 
-func applyTc(ctx context.Context, pid uint32, args ...string) error {
+// applyTc runs the tc command built by argsOf on every network interface matching the device
+func applyTc(ctx context.Context, pid uint32, device string, argsOf func(dev string) []string) error {
 	// Mock point to return error in unit test
 	if err := mock.On("TcApplyError"); err != nil {
 		if e, ok := err.(error); ok {
@@ -30,16 +31,24 @@ func applyTc(ctx context.Context, pid uint32, args ...string) error {
 		}
 	}
 
+	devices, err := matchDevices(pid, device)
+	if err != nil {
+		return err
+	}
+
 	nsPath := GetNsPath(pid, netNS)
 
-	cmd := withNetNS(ctx, nsPath, "tc", args...)
-	log.Info("tc command", "command", cmd.String(), "args", args)
+	for _, dev := range devices {
+		args := argsOf(dev)
+		cmd := withNetNS(ctx, nsPath, "tc", args...)
+		log.Info("tc command", "command", cmd.String(), "args", args)
 
-	out, err := cmd.CombinedOutput()
+		out, err := cmd.CombinedOutput()
 
-	if err != nil {
-		log.Error(err, "tc command error", "command", cmd.String(), "output", string(out))
-		return err
+		if err != nil {
+			log.Error(err, "tc command error", "command", cmd.String(), "output", string(out))
+			return err
+		}
 	}
 
 	return nil
@@ -25,6 +25,9 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 )
 
+// defaultDevice is the network interface which chaos is applied on if the device isn't specified
+const defaultDevice = "eth0"
+
 func (s *daemonServer) AddQdisc(ctx context.Context, in *pb.QdiscRequest) (*empty.Empty, error) {
 	log.Info("Add Qdisc", "Request", in)
 
@@ -34,13 +37,14 @@ func (s *daemonServer) AddQdisc(ctx context.Context, in *pb.QdiscRequest) (*empt
 		return nil, status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
 	}
 
-	args, err := generateQdiscArgs("add", in.Qdisc)
-
-	if err != nil {
+	if _, err := generateQdiscArgs("add", defaultDevice, in.Qdisc); err != nil {
 		return nil, status.Errorf(codes.Internal, "generate qdisc args error: %v", err)
 	}
 
-	if err := applyTc(ctx, pid, args...); err != nil {
+	if err := applyTc(ctx, pid, in.Device, func(dev string) []string {
+		args, _ := generateQdiscArgs("add", dev, in.Qdisc)
+		return args
+	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "tbf apply error: %v", err)
 	}
 
@@ -56,13 +60,14 @@ func (s *daemonServer) DelQdisc(ctx context.Context, in *pb.QdiscRequest) (*empt
 		return nil, status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
 	}
 
-	args, err := generateQdiscArgs("del", in.Qdisc)
-
-	if err != nil {
+	if _, err := generateQdiscArgs("del", defaultDevice, in.Qdisc); err != nil {
 		return nil, status.Errorf(codes.Internal, "generate qdisc args error: %v", err)
 	}
 
-	if err := applyTc(ctx, pid, args...); err != nil {
+	if err := applyTc(ctx, pid, in.Device, func(dev string) []string {
+		args, _ := generateQdiscArgs("del", dev, in.Qdisc)
+		return args
+	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "tbf apply error: %v", err)
 	}
 
@@ -78,15 +83,15 @@ func (s *daemonServer) AddEmatchFilter(ctx context.Context, in *pb.EmatchFilterR
 		return nil, status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
 	}
 
-	args := []string{"filter", "add", "dev", "eth0"}
-
-	args = append(args, "parent", fmt.Sprintf("%d:%d", in.Filter.Parent.Major, in.Filter.Parent.Minor))
+	if err := applyTc(ctx, pid, in.Device, func(dev string) []string {
+		args := []string{"filter", "add", "dev", dev}
 
-	args = append(args, "basic", "match", in.Filter.Match)
+		args = append(args, "parent", fmt.Sprintf("%d:%d", in.Filter.Parent.Major, in.Filter.Parent.Minor))
 
-	args = append(args, "classid", fmt.Sprintf("%d:%d", in.Filter.Classid.Major, in.Filter.Classid.Minor))
+		args = append(args, "basic", "match", in.Filter.Match)
 
-	if err := applyTc(ctx, pid, args...); err != nil {
+		return append(args, "classid", fmt.Sprintf("%d:%d", in.Filter.Classid.Major, in.Filter.Classid.Minor))
+	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "tbf apply error: %v", err)
 	}
 
@@ -103,18 +108,18 @@ func (s *daemonServer) DelTcFilter(ctx context.Context, in *pb.TcFilterRequest)
 		return nil, status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
 	}
 
-	args := []string{"filter", "del", "dev", "eth0"}
-
-	args = append(args, "parent", fmt.Sprintf("%d:%d", in.Filter.Parent.Major, in.Filter.Parent.Minor))
+	if err := applyTc(ctx, pid, in.Device, func(dev string) []string {
+		args := []string{"filter", "del", "dev", dev}
 
-	if err := applyTc(ctx, pid, args...); err != nil {
+		return append(args, "parent", fmt.Sprintf("%d:%d", in.Filter.Parent.Major, in.Filter.Parent.Minor))
+	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "tbf apply error: %v", err)
 	}
 
 	return &empty.Empty{}, nil
 }
 
-func generateQdiscArgs(action string, qdisc *pb.Qdisc) ([]string, error) {
+func generateQdiscArgs(action string, device string, qdisc *pb.Qdisc) ([]string, error) {
 
 	if qdisc == nil {
 		return nil, fmt.Errorf("qdisc is required")
@@ -124,7 +129,7 @@ func generateQdiscArgs(action string, qdisc *pb.Qdisc) ([]string, error) {
 		return nil, fmt.Errorf("qdisc.Type is required")
 	}
 
-	args := []string{"qdisc", action, "dev", "eth0"}
+	args := []string{"qdisc", action, "dev", device}
 
 	if qdisc.Parent == nil {
 		args = append(args, "root")
@@ -29,7 +29,7 @@ func commonTcTest(t *testing.T, fpname, errString string, tcFunc func(s *daemonS
 
 	defer mock.With("MockContainerdClient", &MockClient{})()
 	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
-	s := &daemonServer{c}
+	s := &daemonServer{crClient: c}
 
 	if errString == "" {
 		defer mock.With(fpname, true)()
@@ -172,14 +172,14 @@ func Test_generateQdiscArgs(t *testing.T) {
 
 	t.Run("without parent and handle", func(t *testing.T) {
 
-		args, err := generateQdiscArgs("add", &pb.Qdisc{Type: typ})
+		args, err := generateQdiscArgs("add", "eth0", &pb.Qdisc{Type: typ})
 
 		g.Expect(err).To(BeNil())
 		g.Expect(args).To(Equal([]string{"qdisc", "add", "dev", "eth0", "root", "handle", "1:0", typ}))
 	})
 
 	t.Run("with parent and handle", func(t *testing.T) {
-		args, err := generateQdiscArgs("add", &pb.Qdisc{
+		args, err := generateQdiscArgs("add", "eth0", &pb.Qdisc{
 			Type: typ,
 			Parent: &pb.TcHandle{
 				Major: 1,
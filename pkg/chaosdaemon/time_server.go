@@ -77,3 +77,57 @@ func (s *daemonServer) RecoverTimeOffset(ctx context.Context, req *pb.TimeReques
 
 	return &empty.Empty{}, nil
 }
+
+func (s *daemonServer) SetTimerSlack(ctx context.Context, req *pb.TimerSlackRequest) (*empty.Empty, error) {
+	log.Info("Set timer slack", "Request", req)
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting PID")
+		return nil, err
+	}
+
+	childPids, err := GetChildProcesses(pid)
+	if err != nil {
+		log.Error(err, "fail to get child processes")
+	}
+	allPids := append(childPids, pid)
+	log.Info("all related processes found", "pids", allPids)
+
+	for _, pid := range allPids {
+		err = time.SetTimerSlack(int(pid), req.SlackNs)
+		if err != nil {
+			log.Error(err, "error while setting timer slack", "pid", pid)
+			return nil, err
+		}
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *daemonServer) ResetTimerSlack(ctx context.Context, req *pb.TimerSlackRequest) (*empty.Empty, error) {
+	log.Info("Reset timer slack", "Request", req)
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting PID")
+		return nil, err
+	}
+
+	childPids, err := GetChildProcesses(pid)
+	if err != nil {
+		log.Error(err, "fail to get child processes")
+	}
+	allPids := append(childPids, pid)
+	log.Info("get all related process pids", "pids", allPids)
+
+	for _, pid := range allPids {
+		err = time.ResetTimerSlack(int(pid))
+		if err != nil {
+			log.Error(err, "error while resetting timer slack", "pid", pid)
+			return nil, err
+		}
+	}
+
+	return &empty.Empty{}, nil
+}
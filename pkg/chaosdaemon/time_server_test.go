@@ -27,7 +27,7 @@ import (
 var _ = Describe("time server", func() {
 	defer mock.With("MockContainerdClient", &MockClient{})()
 	c, _ := CreateContainerRuntimeInfoClient(containerRuntimeContainerd)
-	s := &daemonServer{c}
+	s := &daemonServer{crClient: c}
 
 	Context("SetTimeOffset", func() {
 		It("should work", func() {
@@ -98,4 +98,54 @@ var _ = Describe("time server", func() {
 			Expect(err.Error()).To(Equal(errorStr))
 		})
 	})
+
+	Context("SetTimerSlack", func() {
+		It("should work", func() {
+			// Inject nil error to ignore any error
+			const ignore = true
+			defer mock.With("SetTimerSlackError", ignore)()
+
+			_, err := s.SetTimerSlack(context.TODO(), &pb.TimerSlackRequest{
+				ContainerId: "containerd://container-id",
+				SlackNs:     1000000,
+			})
+			Expect(err).To(BeNil())
+		})
+
+		It("should fail on set timer slack", func() {
+			const errorStr = "mock error on set timer slack"
+			defer mock.With("SetTimerSlackError", errors.New(errorStr))()
+
+			_, err := s.SetTimerSlack(context.TODO(), &pb.TimerSlackRequest{
+				ContainerId: "containerd://container-id",
+				SlackNs:     1000000,
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal(errorStr))
+		})
+	})
+
+	Context("ResetTimerSlack", func() {
+		It("should work", func() {
+			// Inject nil error to ignore any error
+			const ignore = true
+			defer mock.With("ResetTimerSlackError", ignore)()
+
+			_, err := s.ResetTimerSlack(context.TODO(), &pb.TimerSlackRequest{
+				ContainerId: "containerd://container-id",
+			})
+			Expect(err).To(BeNil())
+		})
+
+		It("should fail on get pid", func() {
+			const errorStr = "mock error on load container"
+			defer mock.With("LoadContainerError", errors.New(errorStr))()
+
+			_, err := s.ResetTimerSlack(context.TODO(), &pb.TimerSlackRequest{
+				ContainerId: "containerd://container-id",
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal(errorStr))
+		})
+	})
 })
@@ -36,15 +36,17 @@ import (
 const (
 	containerRuntimeDocker     = "docker"
 	containerRuntimeContainerd = "containerd"
+	containerRuntimeCrio       = "crio"
+	// containerRuntimeAuto detects the container runtimes by their sockets on the node
+	containerRuntimeAuto = "auto"
 
-	defaultDockerSocket  = "unix:///var/run/docker.sock"
 	dockerProtocolPrefix = "docker://"
 
-	// TODO(yeya24): make socket and ns configurable
-	defaultContainerdSocket  = "/run/containerd/containerd.sock"
 	containerdProtocolPrefix = "containerd://"
 	containerdDefaultNS      = "k8s.io"
 
+	crioProtocolPrefix = "cri-o://"
+
 	defaultProcPrefix = "/proc"
 )
 
@@ -158,31 +160,13 @@ func newContainerdClient(address string, opts ...containerd.ClientOpt) (Containe
 }
 
 // CreateContainerRuntimeInfoClient creates a container runtime information client.
+// If the container runtime is auto, the client works with all the container runtimes detected on the node.
 func CreateContainerRuntimeInfoClient(containerRuntime string) (ContainerRuntimeInfoClient, error) {
-	// TODO: support more container runtime
-
-	var cli ContainerRuntimeInfoClient
-	switch containerRuntime {
-	case containerRuntimeDocker:
-		client, err := newDockerClient(defaultDockerSocket, "", nil, nil)
-		if err != nil {
-			return nil, err
-		}
-		cli = DockerClient{client}
-
-	case containerRuntimeContainerd:
-		// TODO(yeya24): add more options?
-		client, err := newContainerdClient(defaultContainerdSocket, containerd.WithDefaultNamespace(containerdDefaultNS))
-		if err != nil {
-			return nil, err
-		}
-		cli = ContainerdClient{client}
-
-	default:
-		return nil, fmt.Errorf("only docker and containerd is supported, but got %s", containerRuntime)
+	if containerRuntime == containerRuntimeAuto {
+		return newMultiRuntimeClient()
 	}
 
-	return cli, nil
+	return newRuntimeClient(containerRuntime)
 }
 
 type nsType string
@@ -35,6 +35,7 @@ type Request struct {
 	Pct                  uint32   `protobuf:"varint,4,opt,name=pct,proto3" json:"pct,omitempty"`
 	Path                 string   `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
 	Delay                uint32   `protobuf:"varint,6,opt,name=delay,proto3" json:"delay,omitempty"`
+	Scope                string   `protobuf:"bytes,7,opt,name=scope,proto3" json:"scope,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -44,7 +45,7 @@ func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_injure_1d89429f7ab71d2e, []int{0}
+	return fileDescriptor_injure_aa24afaf3a1aa645, []int{0}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Request.Unmarshal(m, b)
@@ -106,6 +107,13 @@ func (m *Request) GetDelay() uint32 {
 	return 0
 }
 
+func (m *Request) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
 type Response struct {
 	Methods              []string `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -117,7 +125,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_injure_1d89429f7ab71d2e, []int{1}
+	return fileDescriptor_injure_aa24afaf3a1aa645, []int{1}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response.Unmarshal(m, b)
@@ -155,7 +163,7 @@ func (m *InjectedResponse) Reset()         { *m = InjectedResponse{} }
 func (m *InjectedResponse) String() string { return proto.CompactTextString(m) }
 func (*InjectedResponse) ProtoMessage()    {}
 func (*InjectedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_injure_1d89429f7ab71d2e, []int{2}
+	return fileDescriptor_injure_aa24afaf3a1aa645, []int{2}
 }
 func (m *InjectedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InjectedResponse.Unmarshal(m, b)
@@ -425,28 +433,29 @@ var _Injure_serviceDesc = grpc.ServiceDesc{
 	Metadata: "injure.proto",
 }
 
-func init() { proto.RegisterFile("injure.proto", fileDescriptor_injure_1d89429f7ab71d2e) }
-
-var fileDescriptor_injure_1d89429f7ab71d2e = []byte{
-	// 319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x4f, 0x4b, 0xc3, 0x30,
-	0x18, 0xc6, 0xd7, 0xfd, 0xe9, 0xba, 0x57, 0x87, 0x23, 0xc8, 0x08, 0xf3, 0x52, 0x82, 0x87, 0x9e,
-	0x32, 0x50, 0x06, 0xe2, 0x61, 0xe0, 0x41, 0x61, 0x07, 0x2f, 0xf1, 0x13, 0x74, 0xeb, 0xeb, 0xfe,
-	0xd0, 0x36, 0x35, 0x4d, 0x85, 0x7d, 0x08, 0xbf, 0xa9, 0x1f, 0x42, 0x9a, 0x34, 0x13, 0x84, 0x0a,
-	0xbb, 0xe5, 0x79, 0x79, 0x9e, 0x27, 0x6f, 0x7e, 0x81, 0xcb, 0x7d, 0x7e, 0xa8, 0x14, 0xf2, 0x42,
-	0x49, 0x2d, 0x89, 0x6f, 0xd5, 0xec, 0x66, 0x2b, 0xe5, 0x36, 0xc5, 0xb9, 0x99, 0xae, 0xab, 0xf7,
-	0x39, 0x66, 0x85, 0x3e, 0x5a, 0x13, 0xfb, 0xf2, 0x60, 0x28, 0xf0, 0xa3, 0xc2, 0x52, 0x13, 0x0a,
-	0xc3, 0x0c, 0xf5, 0x4e, 0x26, 0x25, 0xf5, 0xc2, 0x5e, 0x34, 0x12, 0x4e, 0x92, 0x6b, 0x18, 0xa0,
-	0x52, 0xb9, 0xa4, 0xdd, 0xd0, 0x8b, 0xc6, 0xc2, 0x0a, 0x32, 0x05, 0x5f, 0xc5, 0x79, 0x22, 0x33,
-	0xda, 0x0b, 0xbd, 0x28, 0x10, 0x8d, 0x22, 0x13, 0xe8, 0x15, 0x1b, 0x4d, 0xfb, 0xc6, 0x5b, 0x1f,
-	0x09, 0x81, 0x7e, 0x11, 0xeb, 0x1d, 0x1d, 0x84, 0x5e, 0x34, 0x12, 0xe6, 0x5c, 0x77, 0x26, 0x98,
-	0xc6, 0x47, 0xea, 0xdb, 0x4e, 0x23, 0xd8, 0x2d, 0x04, 0x02, 0xcb, 0x42, 0xe6, 0x25, 0xb6, 0xef,
-	0xc3, 0x38, 0x4c, 0x56, 0xf9, 0x01, 0x37, 0x1a, 0x93, 0x93, 0x7b, 0x06, 0xc1, 0xbe, 0x99, 0x51,
-	0xcf, 0xec, 0x73, 0xd2, 0x77, 0xdf, 0x5d, 0xf0, 0x57, 0x86, 0x06, 0x59, 0xc0, 0xf0, 0xb5, 0x79,
-	0xd5, 0x94, 0x5b, 0x32, 0xdc, 0x91, 0xe1, 0xcf, 0x35, 0x99, 0xd9, 0x84, 0x37, 0x1c, 0x5d, 0x37,
-	0xeb, 0x90, 0x25, 0x80, 0xc0, 0x8d, 0xfc, 0x44, 0xf5, 0x94, 0xa6, 0xad, 0xc9, 0x96, 0x39, 0xeb,
-	0x90, 0x47, 0x18, 0x37, 0x79, 0x7b, 0x3b, 0xb9, 0xfa, 0xbd, 0xc4, 0xd0, 0xff, 0x27, 0xbb, 0x80,
-	0xe0, 0x0d, 0xf5, 0x4b, 0x5c, 0xa5, 0xfa, 0x9c, 0xd8, 0x03, 0x5c, 0xb8, 0x58, 0xbd, 0xf3, 0x19,
-	0xc9, 0x25, 0x04, 0x0e, 0x6f, 0xeb, 0x53, 0xa9, 0xab, 0xfb, 0xfb, 0x11, 0xac, 0xb3, 0xf6, 0x8d,
-	0xf7, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x81, 0x47, 0x82, 0x90, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("injure.proto", fileDescriptor_injure_aa24afaf3a1aa645) }
+
+var fileDescriptor_injure_aa24afaf3a1aa645 = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0x4d, 0x4b, 0xc3, 0x40,
+	0x14, 0x6c, 0xfa, 0x91, 0xa4, 0x4f, 0x8b, 0x65, 0x91, 0xb2, 0xd4, 0x4b, 0x08, 0x1e, 0x72, 0x4a,
+	0x41, 0x29, 0x88, 0x87, 0x82, 0x07, 0x85, 0x1e, 0xbc, 0xac, 0xbf, 0x20, 0x4d, 0x9e, 0xfd, 0x20,
+	0xc9, 0xae, 0x9b, 0x8d, 0xd0, 0xdf, 0xe3, 0xdf, 0xf3, 0x47, 0x48, 0x76, 0xb3, 0x15, 0x84, 0x08,
+	0xbd, 0xed, 0x0c, 0x33, 0xb3, 0xef, 0xcd, 0x83, 0xcb, 0x7d, 0x79, 0xa8, 0x25, 0xc6, 0x42, 0x72,
+	0xc5, 0x89, 0x6b, 0xd0, 0xfc, 0x66, 0xcb, 0xf9, 0x36, 0xc7, 0x85, 0x66, 0x37, 0xf5, 0xfb, 0x02,
+	0x0b, 0xa1, 0x8e, 0x46, 0x14, 0x7e, 0x39, 0xe0, 0x31, 0xfc, 0xa8, 0xb1, 0x52, 0x84, 0x82, 0x57,
+	0xa0, 0xda, 0xf1, 0xac, 0xa2, 0x4e, 0x30, 0x88, 0xc6, 0xcc, 0x42, 0x72, 0x0d, 0x23, 0x94, 0xb2,
+	0xe4, 0xb4, 0x1f, 0x38, 0xd1, 0x84, 0x19, 0x40, 0x66, 0xe0, 0xca, 0xa4, 0xcc, 0x78, 0x41, 0x07,
+	0x81, 0x13, 0xf9, 0xac, 0x45, 0x64, 0x0a, 0x03, 0x91, 0x2a, 0x3a, 0xd4, 0xda, 0xe6, 0x49, 0x08,
+	0x0c, 0x45, 0xa2, 0x76, 0x74, 0x14, 0x38, 0xd1, 0x98, 0xe9, 0x77, 0x93, 0x99, 0x61, 0x9e, 0x1c,
+	0xa9, 0x6b, 0x32, 0x35, 0x68, 0xd8, 0x2a, 0xe5, 0x02, 0xa9, 0xa7, 0xa5, 0x06, 0x84, 0xb7, 0xe0,
+	0x33, 0xac, 0x04, 0x2f, 0x2b, 0xec, 0x9e, 0x32, 0x8c, 0x61, 0xba, 0x2e, 0x0f, 0x98, 0x2a, 0xcc,
+	0x4e, 0xea, 0x39, 0xf8, 0xfb, 0x96, 0xa3, 0x8e, 0x9e, 0xf2, 0x84, 0xef, 0xbe, 0xfb, 0xe0, 0xae,
+	0x75, 0x47, 0x64, 0x09, 0xde, 0x6b, 0xbb, 0xeb, 0x2c, 0x36, 0x7d, 0xc5, 0xb6, 0xaf, 0xf8, 0xb9,
+	0xe9, 0x6b, 0x3e, 0x8d, 0xdb, 0x76, 0x6d, 0x76, 0xd8, 0x23, 0x2b, 0x00, 0x86, 0x29, 0xff, 0x44,
+	0xf9, 0x94, 0xe7, 0x9d, 0xce, 0x0e, 0x3e, 0xec, 0x91, 0x47, 0x98, 0xb4, 0x7e, 0xf3, 0x3b, 0xb9,
+	0xfa, 0xfd, 0x44, 0xdf, 0xe4, 0x1f, 0xef, 0x12, 0xfc, 0x37, 0x54, 0x2f, 0x49, 0x9d, 0xab, 0x73,
+	0x6c, 0x0f, 0x70, 0x61, 0x6d, 0xcd, 0xcc, 0x67, 0x38, 0x57, 0xe0, 0xdb, 0x7a, 0x3b, 0x57, 0xa5,
+	0x36, 0xee, 0xef, 0x21, 0xc2, 0xde, 0xc6, 0xd5, 0xda, 0xfb, 0x9f, 0x01, 0x00, 0x90, 0x3a, 0x2e,
+	0xbc, 0xa6, 0x02, 0x00, 0x00,
 }
@@ -20,6 +20,7 @@ import (
 	"os"
 	"regexp"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -42,6 +43,20 @@ var (
 	faultMap sync.Map
 
 	methods map[string]bool
+
+	// generations counts the openings of every path, the faults in fd scope
+	// are decided once for each opening
+	generations sync.Map
+)
+
+const (
+	// operationScope decides whether to inject the fault on every operation
+	operationScope = "operation"
+	// fileScope decides once for every file, all operations on an unlucky file fail
+	fileScope = "file"
+	// fdScope decides once for every opening of a file, all operations on an unlucky fd fail.
+	// The hooks only know the path, so the fds opening the same file share the latest decision.
+	fdScope = "fd"
 )
 
 func init() {
@@ -55,6 +70,46 @@ type faultContext struct {
 	pct    uint32
 	path   string
 	delay  time.Duration
+	scope  string
+
+	// unlucky records the decisions of the files or fds
+	unlucky sync.Map
+}
+
+type fdKey struct {
+	path       string
+	generation uint64
+}
+
+// hit decides whether the operation on the path should be injected
+func (fc *faultContext) hit(path string) bool {
+	switch fc.scope {
+	case fileScope:
+		v, _ := fc.unlucky.LoadOrStore(path, probab(fc.pct))
+		return v.(bool)
+	case fdScope:
+		generation := currentGeneration(path)
+		v, loaded := fc.unlucky.LoadOrStore(fdKey{path, generation}, probab(fc.pct))
+		if !loaded && generation > 0 {
+			fc.unlucky.Delete(fdKey{path, generation - 1})
+		}
+		return v.(bool)
+	default:
+		return probab(fc.pct)
+	}
+}
+
+func openFile(path string) {
+	v, _ := generations.LoadOrStore(path, new(uint64))
+	atomic.AddUint64(v.(*uint64), 1)
+}
+
+func currentGeneration(path string) uint64 {
+	v, ok := generations.Load(path)
+	if !ok {
+		return 0
+	}
+	return atomic.LoadUint64(v.(*uint64))
 }
 
 func initMethods() {
@@ -102,16 +157,16 @@ func probab(percentage uint32) bool {
 }
 
 func faultInject(path, method string) error {
+	if method == "open" || method == "create" {
+		openFile(path)
+	}
+
 	val, ok := faultMap.Load(method)
 	if !ok {
 		return nil
 	}
 
 	fc := val.(*faultContext)
-	if !probab(fc.pct) {
-		return nil
-	}
-
 	if len(fc.path) > 0 {
 		re, err := regexp.Compile(fc.path)
 		if err != nil {
@@ -123,6 +178,10 @@ func faultInject(path, method string) error {
 		}
 	}
 
+	if !fc.hit(path) {
+		return nil
+	}
+
 	log.V(6).Info("Inject fault", "method", method, "path", path)
 	log.V(6).Info("Inject fault", "context", fc)
 
@@ -201,6 +260,7 @@ func (s *server) SetFault(_ context.Context, in *pb.Request) (*empty.Empty, erro
 		pct:    in.Pct,
 		path:   in.Path,
 		delay:  time.Duration(in.Delay) * time.Microsecond,
+		scope:  in.Scope,
 	}
 
 	s.setFault(in.Methods, f)
@@ -221,6 +281,7 @@ func (s *server) SetFaultAll(ctx context.Context, in *pb.Request) (*empty.Empty,
 		pct:    in.Pct,
 		path:   in.Path,
 		delay:  time.Duration(in.Delay) * time.Microsecond,
+		scope:  in.Scope,
 	}
 
 	s.setFault(s.methods(), f)
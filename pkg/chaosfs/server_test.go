@@ -97,6 +97,55 @@ var _ = Describe("server", func() {
 		})
 	})
 
+	Context("faultContext hit", func() {
+		It("should decide once for every file in file scope", func() {
+			fc := &faultContext{pct: 50, scope: fileScope}
+			first := fc.hit(faultInjectPath)
+			for i := 0; i < 20; i++ {
+				Expect(fc.hit(faultInjectPath)).To(Equal(first))
+			}
+
+			fc.unlucky.Store("unlucky-file", true)
+			fc.unlucky.Store("lucky-file", false)
+			Expect(fc.hit("unlucky-file")).To(BeTrue())
+			Expect(fc.hit("lucky-file")).To(BeFalse())
+		})
+
+		It("should decide again after opening the file in fd scope", func() {
+			const path = "fd-scope-path"
+			fc := &faultContext{pct: 50, scope: fdScope}
+			openFile(path)
+			generation := currentGeneration(path)
+			fc.unlucky.Store(fdKey{path, generation}, true)
+			Expect(fc.hit(path)).To(BeTrue())
+			Expect(fc.hit(path)).To(BeTrue())
+
+			openFile(path)
+			Expect(currentGeneration(path)).To(Equal(generation + 1))
+			fc.unlucky.Store(fdKey{path, generation + 1}, false)
+			Expect(fc.hit(path)).To(BeFalse())
+		})
+
+		It("should drop the decision of the closed fd", func() {
+			const path = "fd-scope-drop-path"
+			fc := &faultContext{pct: 100, scope: fdScope}
+			openFile(path)
+			Expect(fc.hit(path)).To(BeTrue())
+			openFile(path)
+			Expect(fc.hit(path)).To(BeTrue())
+
+			_, ok := fc.unlucky.Load(fdKey{path, currentGeneration(path) - 1})
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should decide on every operation by default", func() {
+			fc := &faultContext{pct: 100}
+			Expect(fc.hit(faultInjectPath)).To(BeTrue())
+			fc.pct = 0
+			Expect(fc.hit(faultInjectPath)).To(BeFalse())
+		})
+	})
+
 	Context("RecoverAll", func() {
 		It("should work", func() {
 			s := &server{}
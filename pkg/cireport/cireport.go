@@ -0,0 +1,313 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cireport
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// The formats of the report
+const (
+	FormatJSON  = "json"
+	FormatJUnit = "junit"
+)
+
+// The steps of a run
+const (
+	// StepInject is the injection of the chaos into the targets
+	StepInject = "inject"
+	// StepProbe is the prefix of the steps of the probe measurements
+	StepProbe = "probe "
+	// StepSLO is the evaluation of the service level objective
+	StepSLO = "slo"
+	// StepLoad is the load which is generated during the run
+	StepLoad = "load"
+	// StepVerdict is the verdict of the run
+	StepVerdict = "verdict"
+)
+
+// The outcomes of the steps
+const (
+	OutcomePassed  = "passed"
+	OutcomeFailed  = "failed"
+	OutcomeError   = "error"
+	OutcomeSkipped = "skipped"
+)
+
+// Summary is the machine-readable summary of the runs, which gates the CI pipelines
+type Summary struct {
+	// Passed is true if there is any run, and all of the runs passed
+	Passed    bool  `json:"passed"`
+	Total     int   `json:"total"`
+	Running   int   `json:"running"`
+	Succeeded int   `json:"succeeded"`
+	Failed    int   `json:"failed"`
+	Aborted   int   `json:"aborted"`
+	Runs      []Run `json:"runs"`
+}
+
+// Run is the summary of a run recorded by a ChaosResult
+type Run struct {
+	// Result is the name of the ChaosResult of the run
+	Result     string                `json:"result"`
+	Kind       string                `json:"kind"`
+	Namespace  string                `json:"namespace"`
+	Experiment string                `json:"experiment"`
+	StartTime  metav1.Time           `json:"startTime"`
+	Duration   string                `json:"duration,omitempty"`
+	Verdict    v1alpha1.ChaosVerdict `json:"verdict"`
+	Reason     string                `json:"reason,omitempty"`
+	// Steps are the timeline of the run
+	Steps []Step `json:"steps"`
+}
+
+// Step is a step of the run, which is a test case in JUnit
+type Step struct {
+	Name string `json:"name"`
+	// Offset is the time of the step since the start of the run, e.g. "1m30s"
+	Offset  string `json:"offset"`
+	Outcome string `json:"outcome"`
+	Message string `json:"message,omitempty"`
+
+	offset time.Duration
+}
+
+// Summarize summarizes the runs, which are sorted by the start time
+func Summarize(results []v1alpha1.ChaosResult) *Summary {
+	sorted := append([]v1alpha1.ChaosResult(nil), results...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Spec.StartTime.Before(&sorted[j].Spec.StartTime)
+	})
+
+	summary := &Summary{Runs: make([]Run, 0, len(sorted))}
+	for i := range sorted {
+		run := newRun(&sorted[i])
+		summary.Runs = append(summary.Runs, run)
+
+		summary.Total++
+		switch run.Verdict {
+		case v1alpha1.VerdictPassed:
+			summary.Succeeded++
+		case v1alpha1.VerdictFailed:
+			summary.Failed++
+		case v1alpha1.VerdictAborted:
+			summary.Aborted++
+		default:
+			summary.Running++
+		}
+	}
+	summary.Passed = summary.Total > 0 && summary.Succeeded == summary.Total
+	return summary
+}
+
+// LatestRuns returns the latest run of each experiment
+func LatestRuns(results []v1alpha1.ChaosResult) []v1alpha1.ChaosResult {
+	latest := make(map[string]int)
+	var runs []v1alpha1.ChaosResult
+	for _, result := range results {
+		experiment := result.Spec.Experiment
+		key := experiment.Kind + "/" + result.Namespace + "/" + experiment.Name
+		i, ok := latest[key]
+		if !ok {
+			latest[key] = len(runs)
+			runs = append(runs, result)
+			continue
+		}
+		if runs[i].Spec.StartTime.Before(&result.Spec.StartTime) {
+			runs[i] = result
+		}
+	}
+	return runs
+}
+
+func newRun(result *v1alpha1.ChaosResult) Run {
+	verdict := result.Status.Verdict
+	if verdict == "" {
+		verdict = v1alpha1.VerdictRunning
+	}
+	run := Run{
+		Result:     result.Name,
+		Kind:       result.Spec.Experiment.Kind,
+		Namespace:  result.Namespace,
+		Experiment: result.Spec.Experiment.Name,
+		StartTime:  result.Spec.StartTime,
+		Duration:   result.Status.Duration,
+		Verdict:    verdict,
+		Reason:     result.Status.Reason,
+	}
+	start := result.Spec.StartTime.Time
+
+	inject := Step{Name: StepInject, Outcome: OutcomePassed,
+		Message: fmt.Sprintf("chaos is injected into %d pods", len(result.Status.Targets))}
+	if len(result.Status.Targets) == 0 {
+		inject.Outcome = OutcomeSkipped
+		inject.Message = "no pod is recorded"
+	}
+	steps := []Step{inject}
+
+	for _, probe := range result.Status.Probes {
+		step := Step{Name: StepProbe + probe.Name, offset: probe.Time.Sub(start), Outcome: OutcomePassed, Message: probe.Value}
+		if !probe.Passed {
+			step.Outcome = OutcomeFailed
+			step.Message = fmt.Sprintf("%s: %s", probe.Value, probe.Message)
+		}
+		steps = append(steps, step)
+	}
+
+	var end time.Duration
+	if result.Status.EndTime != nil {
+		end = result.Status.EndTime.Sub(start)
+	}
+	if slo := result.Status.SLO; slo != nil {
+		step := Step{Name: StepSLO, offset: end, Outcome: OutcomePassed,
+			Message: fmt.Sprintf("degradation %s%% of %s, threshold %s%%", slo.Degradation, slo.Query, slo.Threshold)}
+		if slo.Error != "" {
+			step.Outcome = OutcomeError
+			step.Message = slo.Error
+		} else if !slo.Passed {
+			step.Outcome = OutcomeFailed
+		}
+		steps = append(steps, step)
+	}
+	if load := result.Status.Load; load != nil {
+		step := Step{Name: StepLoad, offset: end, Outcome: OutcomePassed,
+			Message: fmt.Sprintf("%d requests to %s, %d errors, p99 %s", load.Requests, load.Target, load.Errors, load.P99)}
+		if load.Error != "" {
+			step.Outcome = OutcomeError
+			step.Message = load.Error
+		}
+		steps = append(steps, step)
+	}
+
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].offset < steps[j].offset })
+
+	// the verdict is the last step, the running one is after the steps so far
+	step := Step{Name: StepVerdict, offset: end, Message: result.Status.Reason}
+	switch verdict {
+	case v1alpha1.VerdictPassed:
+		step.Outcome = OutcomePassed
+	case v1alpha1.VerdictFailed:
+		step.Outcome = OutcomeFailed
+	case v1alpha1.VerdictAborted:
+		step.Outcome = OutcomeError
+	default:
+		step.Outcome = OutcomeSkipped
+		step.Message = "the run isn't finished"
+		step.offset = steps[len(steps)-1].offset
+	}
+	steps = append(steps, step)
+
+	for i := range steps {
+		steps[i].Offset = steps[i].offset.String()
+	}
+	run.Steps = steps
+	return run
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+}
+
+// WriteJUnit writes the summary as JUnit XML, each run is a test suite and each step is a test case
+func WriteJUnit(w io.Writer, summary *Summary) error {
+	suites := junitTestSuites{Name: "chaos-mesh", Suites: make([]junitTestSuite, 0, len(summary.Runs))}
+	for _, run := range summary.Runs {
+		suite := junitTestSuite{
+			Name:      run.Kind + "/" + run.Namespace + "/" + run.Experiment,
+			Timestamp: run.StartTime.UTC().Format("2006-01-02T15:04:05"),
+			Time:      "0",
+		}
+		if d, err := time.ParseDuration(run.Duration); err == nil {
+			suite.Time = seconds(d)
+		}
+
+		for _, step := range run.Steps {
+			c := junitTestCase{Name: step.Name, ClassName: run.Namespace + "." + run.Result}
+			message := &junitMessage{Message: step.Message}
+			switch step.Outcome {
+			case OutcomeFailed:
+				c.Failure = message
+				suite.Failures++
+			case OutcomeError:
+				c.Error = message
+				suite.Errors++
+			case OutcomeSkipped:
+				c.Skipped = message
+				suite.Skipped++
+			}
+			c.SystemOut = "+" + step.Offset + " " + step.Message
+			suite.Cases = append(suite.Cases, c)
+			suite.Tests++
+		}
+
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
@@ -0,0 +1,126 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cireport
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func newResult(name string, start time.Time) v1alpha1.ChaosResult {
+	return v1alpha1.ChaosResult{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: name},
+		Spec: v1alpha1.ChaosResultSpec{
+			Experiment: v1alpha1.ExperimentReference{Kind: v1alpha1.KindNetworkChaos, Name: "web-delay"},
+			StartTime:  metav1.NewTime(start),
+		},
+		Status: v1alpha1.ChaosResultStatus{
+			Verdict: v1alpha1.VerdictRunning,
+			Targets: []v1alpha1.ResultTarget{{Namespace: "shop", Name: "web-0"}},
+		},
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	g := NewGomegaWithT(t)
+	start := time.Date(2020, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	failed := newResult("web-delay-2", start.Add(time.Hour))
+	failed.Status.Probes = []v1alpha1.ProbeMeasurement{
+		{Name: "checkout", Time: metav1.NewTime(start.Add(time.Hour + time.Minute)), Value: "503", Message: "status code"},
+	}
+	failed.Status.SLO = &v1alpha1.SLOResult{Query: "p99", Degradation: "5", Threshold: "10", Passed: true}
+	failed.Finish(v1alpha1.VerdictPassed, "", metav1.NewTime(start.Add(time.Hour+5*time.Minute)))
+
+	passed := newResult("web-delay-1", start)
+	passed.Finish(v1alpha1.VerdictPassed, "", metav1.NewTime(start.Add(5*time.Minute)))
+
+	summary := Summarize([]v1alpha1.ChaosResult{failed, passed})
+	g.Expect(summary.Passed).To(BeFalse())
+	g.Expect(summary.Total).To(Equal(2))
+	g.Expect(summary.Succeeded).To(Equal(1))
+	g.Expect(summary.Failed).To(Equal(1))
+	g.Expect(summary.Runs[0].Result).To(Equal("web-delay-1"))
+
+	steps := summary.Runs[1].Steps
+	g.Expect(steps).To(HaveLen(4))
+	g.Expect(steps[0].Name).To(Equal(StepInject))
+	g.Expect(steps[1]).To(MatchFields(IgnoreExtras, Fields{
+		"Name": Equal("probe checkout"), "Offset": Equal("1m0s"), "Outcome": Equal(OutcomeFailed),
+	}))
+	g.Expect(steps[2].Name).To(Equal(StepSLO))
+	g.Expect(steps[2].Outcome).To(Equal(OutcomePassed))
+	g.Expect(steps[3]).To(MatchFields(IgnoreExtras, Fields{
+		"Name": Equal(StepVerdict), "Offset": Equal("5m0s"), "Outcome": Equal(OutcomeFailed),
+	}))
+
+	g.Expect(Summarize(nil).Passed).To(BeFalse())
+	g.Expect(Summarize([]v1alpha1.ChaosResult{passed}).Passed).To(BeTrue())
+
+	running := Summarize([]v1alpha1.ChaosResult{newResult("web-delay-3", start)})
+	g.Expect(running.Running).To(Equal(1))
+	g.Expect(running.Runs[0].Steps[1].Outcome).To(Equal(OutcomeSkipped))
+}
+
+func TestLatestRuns(t *testing.T) {
+	g := NewGomegaWithT(t)
+	start := time.Now()
+
+	first := newResult("web-delay-1", start)
+	second := newResult("web-delay-2", start.Add(time.Hour))
+	other := newResult("api-delay-1", start)
+	other.Spec.Experiment.Name = "api-delay"
+
+	runs := LatestRuns([]v1alpha1.ChaosResult{first, other, second})
+	g.Expect(runs).To(HaveLen(2))
+	g.Expect(runs[0].Name).To(Equal("web-delay-2"))
+	g.Expect(runs[1].Name).To(Equal("api-delay-1"))
+}
+
+func TestWriteJUnit(t *testing.T) {
+	g := NewGomegaWithT(t)
+	start := time.Date(2020, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	result := newResult("web-delay-1", start)
+	result.Status.Probes = []v1alpha1.ProbeMeasurement{
+		{Name: "checkout", Time: metav1.NewTime(start.Add(time.Minute)), Value: "503", Message: "status code"},
+	}
+	result.Finish(v1alpha1.VerdictPassed, "", metav1.NewTime(start.Add(90*time.Second)))
+
+	var buf bytes.Buffer
+	g.Expect(WriteJUnit(&buf, Summarize([]v1alpha1.ChaosResult{result}))).To(Succeed())
+
+	var suites junitTestSuites
+	g.Expect(xml.Unmarshal(buf.Bytes(), &suites)).To(Succeed())
+	g.Expect(suites.Tests).To(Equal(3))
+	g.Expect(suites.Failures).To(Equal(2))
+	g.Expect(suites.Suites).To(HaveLen(1))
+
+	suite := suites.Suites[0]
+	g.Expect(suite.Name).To(Equal("NetworkChaos/shop/web-delay"))
+	g.Expect(suite.Time).To(Equal("90.000"))
+	g.Expect(suite.Timestamp).To(Equal("2020-10-17T00:00:00"))
+	g.Expect(suite.Cases[1].Name).To(Equal("probe checkout"))
+	g.Expect(suite.Cases[1].ClassName).To(Equal("shop.web-delay-1"))
+	g.Expect(suite.Cases[1].Failure.Message).To(Equal("503: status code"))
+	g.Expect(suite.Cases[2].Failure.Message).To(Equal("probe checkout failed: status code"))
+}
@@ -17,6 +17,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/jinzhu/gorm"
@@ -109,6 +110,10 @@ func (r *ChaosCollector) recordEvent(req ctrl.Request, obj v1alpha1.InnerObject)
 	status := obj.GetStatus()
 	kind := obj.GetObjectKind().GroupVersionKind().Kind
 
+	if err := r.recordPhase(req, kind, status, string(UID)); err != nil {
+		r.Log.Error(err, "failed to record phase", "request", req.NamespacedName)
+	}
+
 	switch status.Experiment.Phase {
 	case v1alpha1.ExperimentPhaseRunning:
 		return r.createEvent(req, kind, status, string(UID))
@@ -119,6 +124,39 @@ func (r *ChaosCollector) recordEvent(req ctrl.Request, obj v1alpha1.InnerObject)
 	return nil
 }
 
+// recordPhase records a lifecycle event of the experiment if its phase has changed
+// since the latest record.
+func (r *ChaosCollector) recordPhase(req ctrl.Request, kind string, status *v1alpha1.ChaosStatus, UID string) error {
+	phase := string(status.Experiment.Phase)
+	if phase == "" {
+		return nil
+	}
+
+	latest, err := r.event.FindLatestPhaseRecord(context.Background(), UID)
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+	if latest != nil && latest.Phase == phase {
+		return nil
+	}
+
+	record := &core.PhaseRecord{
+		ExperimentID: UID,
+		Experiment:   req.Name,
+		Namespace:    req.Namespace,
+		Kind:         kind,
+		Phase:        phase,
+		Reason:       status.Experiment.Reason,
+		Time:         time.Now(),
+	}
+	if err := r.event.CreatePhaseRecord(context.Background(), record); err != nil {
+		r.Log.Error(err, "failed to store phase record", "record", record)
+		return err
+	}
+
+	return nil
+}
+
 func (r *ChaosCollector) createEvent(req ctrl.Request, kind string, status *v1alpha1.ChaosStatus, UID string) error {
 	event := &core.Event{
 		Experiment:   req.Name,
@@ -201,6 +239,10 @@ func (r *ChaosCollector) setUnarchivedExperiment(req ctrl.Request, obj v1alpha1.
 		archive.Action = string(chaos.Spec.Action)
 	case *v1alpha1.IoChaos:
 		archive.Action = string(chaos.Spec.Action)
+	case *v1alpha1.PhysicalMachineChaos:
+		archive.Action = string(chaos.Spec.Action)
+	case *v1alpha1.DBChaos:
+		archive.Action = string(chaos.Spec.Action)
 	case *v1alpha1.TimeChaos, *v1alpha1.KernelChaos, *v1alpha1.StressChaos:
 		archive.Action = ""
 	default:
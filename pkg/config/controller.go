@@ -14,6 +14,7 @@
 package config
 
 import (
+	"strings"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
@@ -25,26 +26,146 @@ import (
 type ChaosControllerConfig struct {
 	// ChaosDaemonPort is the port which grpc server listens on
 	ChaosDaemonPort int `envconfig:"CHAOS_DAEMON_PORT" default:"31767"`
+	// ChaosDaemonCACert is the CA cert to verify chaos-daemon. The connections to chaos-daemon
+	// use mutual TLS if the CA cert, the client cert and the client key are all configured
+	ChaosDaemonCACert string `envconfig:"CHAOS_DAEMON_CA_CERT" default:""`
+	// ChaosDaemonClientCert is the cert presented to chaos-daemon
+	ChaosDaemonClientCert string `envconfig:"CHAOS_DAEMON_CLIENT_CERT" default:""`
+	// ChaosDaemonClientKey is the key of ChaosDaemonClientCert
+	ChaosDaemonClientKey string `envconfig:"CHAOS_DAEMON_CLIENT_KEY" default:""`
 	// BPFKIPort is the port which BFFKI grpc server listens on
 	BPFKIPort int `envconfig:"BPFKI_PORT" default:"50051"`
 	// MetricsAddr is the address the metric endpoint binds to
 	MetricsAddr string `envconfig:"METRICS_ADDR" default:":10080"`
+	// ExperimentLogAddr is the address the endpoints which serve the log lines of experiments and
+	// the effective namespace policy bind to. They aren't authenticated, so they're only served
+	// on the loopback interface by default and reached by port forwarding.
+	ExperimentLogAddr string `envconfig:"EXPERIMENT_LOG_ADDR" default:"127.0.0.1:10082"`
+	// ExperimentLogLines is the max number of the latest log lines kept for each experiment
+	ExperimentLogLines int `envconfig:"EXPERIMENT_LOG_LINES" default:"200"`
+	// ExperimentLogMaxExperiments is the max number of the latest experiments whose log lines are kept
+	ExperimentLogMaxExperiments int `envconfig:"EXPERIMENT_LOG_MAX_EXPERIMENTS" default:"1000"`
+	// RecordResults indicates that a ChaosResult is recorded for each run of chaos
+	RecordResults bool `envconfig:"RECORD_RESULTS" default:"true"`
+	// DaemonStatus indicates that the progress of chaos pushed by chaos-daemon is written into
+	// the records of the targets in the status of chaos
+	DaemonStatus bool `envconfig:"DAEMON_STATUS" default:"true"`
+	// AnnotationTrigger indicates that the experiments instantiated from templates are attached to
+	// the Deployments, StatefulSets and DaemonSets annotated with `chaos-mesh.org/experiment`
+	AnnotationTrigger bool `envconfig:"ANNOTATION_TRIGGER" default:"false"`
+	// PrometheusAddress is the address of Prometheus which evaluates the SLOs of chaos by default
+	PrometheusAddress string `envconfig:"PROMETHEUS_ADDRESS" default:""`
 	// PprofAddr is the address the pprof endpoint binds to.
 	PprofAddr string `envconfig:"PPROF_ADDR" default:"0"`
 	// EnableLeaderElection is enable leader election for controller manager
 	// Enabling this will ensure there is only one active controller manager
 	EnableLeaderElection bool `envconfig:"ENABLE_LEADER_ELECTION" default:"false"`
+	// LeaderElectionID is the name of the configmap which is used as the lock of leader election
+	LeaderElectionID string `envconfig:"LEADER_ELECTION_ID" default:"chaos-mesh"`
+	// LeaderElectionNamespace is the namespace of the lock of leader election,
+	// the namespace which controller manager runs in is used if it is empty
+	LeaderElectionNamespace string `envconfig:"LEADER_ELECTION_NAMESPACE" default:""`
+	// LeaderElectionLeaseDuration is the duration that non-leader candidates will wait to force acquire leadership
+	LeaderElectionLeaseDuration time.Duration `envconfig:"LEADER_ELECTION_LEASE_DURATION" default:"15s"`
+	// LeaderElectionRenewDeadline is the duration that the acting leader will retry refreshing leadership before giving up
+	LeaderElectionRenewDeadline time.Duration `envconfig:"LEADER_ELECTION_RENEW_DEADLINE" default:"10s"`
+	// LeaderElectionRetryPeriod is the duration the candidates should wait between tries of actions
+	LeaderElectionRetryPeriod time.Duration `envconfig:"LEADER_ELECTION_RETRY_PERIOD" default:"2s"`
+	// Shards is the number of shards which the chaos are partitioned into by the hash of their namespaces,
+	// each shard is reconciled by a separate controller manager
+	Shards int `envconfig:"SHARDS" default:"1"`
+	// ShardIndex is the index of the shard which this controller manager reconciles. If it is negative,
+	// the index is the ordinal of the pod in a StatefulSet, which is parsed from the hostname
+	ShardIndex int `envconfig:"SHARD_INDEX" default:"-1"`
+	// MaxConcurrentReconciles is the max number of concurrent reconciles of each kind of chaos
+	MaxConcurrentReconciles int `envconfig:"MAX_CONCURRENT_RECONCILES" default:"1"`
+	// ConcurrentReconciles overrides MaxConcurrentReconciles for the specified kinds of chaos,
+	// e.g. `NetworkChaos:8,PodChaos:4`
+	ConcurrentReconciles map[string]int `envconfig:"CONCURRENT_RECONCILES"`
 	// CertsDir is the directory for storing certs key file and cert file
 	CertsDir string `envconfig:"CERTS_DIR" default:"/etc/webhook/certs"`
 	// AllowedNamespaces is a regular expression, and matching namespace will allow the chaos task to be performed
 	AllowedNamespaces string `envconfig:"ALLOWED_NAMESPACES" default:""`
 	// AllowedNamespaces is a regular expression, and the chaos task will be ignored by a matching namespace
 	IgnoredNamespaces string `envconfig:"IGNORED_NAMESPACES" default:""`
+	// EnableFilterNamespace indicates that only the namespace with the annotation
+	// `chaos-mesh.org/inject=enabled` will allow the chaos task to be performed
+	EnableFilterNamespace bool `envconfig:"ENABLE_FILTER_NAMESPACE" default:"false"`
+	// Namespace is the namespace which the controller manager runs in
+	Namespace string `envconfig:"NAMESPACE" default:""`
+	// ControllerConfigMap is the name of the ConfigMap in Namespace which the namespace policy is hot
+	// reloaded from, its keys `allowedNamespaces`, `ignoredNamespaces` and `enableFilterNamespace`
+	// override the ones configured by the environment
+	ControllerConfigMap string `envconfig:"CONTROLLER_CONFIGMAP" default:""`
+	// SecurityMode indicates that the creator of chaos should be allowed to create
+	// the chaos in all target namespaces before the chaos is injected. The chaos whose
+	// creator is unknown, e.g. the one created before upgrading, fails until it's recreated
+	SecurityMode bool `envconfig:"SECURITY_MODE" default:"false"`
+	// Simulate indicates that all the chaos are simulated: the targets are selected and recorded,
+	// but the chaos isn't injected into them, as if `simulate` is set in every chaos
+	Simulate bool `envconfig:"SIMULATE" default:"false"`
+	// AuditSinks is a comma-separated list of sinks which the audit records of
+	// injections and recoveries are written to, the available sinks are `stdout`,
+	// `file`, `webhook`, `kafka` and `grafana`. Audit is disabled if it is empty
+	AuditSinks string `envconfig:"AUDIT_SINKS" default:""`
+	// AuditFilePath is the path of the file which the `file` audit sink appends to
+	AuditFilePath string `envconfig:"AUDIT_FILE_PATH" default:""`
+	// AuditWebhookURL is the url which the `webhook` audit sink posts to
+	AuditWebhookURL string `envconfig:"AUDIT_WEBHOOK_URL" default:""`
+	// AuditKafkaRESTProxy is the address of the Kafka REST proxy used by the `kafka` audit sink
+	AuditKafkaRESTProxy string `envconfig:"AUDIT_KAFKA_REST_PROXY" default:""`
+	// AuditKafkaTopic is the topic which the `kafka` audit sink produces to
+	AuditKafkaTopic string `envconfig:"AUDIT_KAFKA_TOPIC" default:"chaos-mesh-audit"`
+	// AuditGrafanaURL is the url of Grafana which the `grafana` audit sink adds annotations to
+	AuditGrafanaURL string `envconfig:"AUDIT_GRAFANA_URL" default:""`
+	// AuditGrafanaAPIKey is the API key used to authenticate to Grafana
+	AuditGrafanaAPIKey string `envconfig:"AUDIT_GRAFANA_API_KEY" default:""`
+	// AuditGrafanaDashboardID is the dashboard which the annotations are added to,
+	// the annotations are added to the organization if it is 0
+	AuditGrafanaDashboardID int `envconfig:"AUDIT_GRAFANA_DASHBOARD_ID" default:"0"`
+	// AuditQueueSize is the max number of the audit records queued before they're written to the sinks,
+	// the records are dropped once the queue is full
+	AuditQueueSize int `envconfig:"AUDIT_QUEUE_SIZE" default:"1000"`
+	// TracingEndpoint is the url of the collector which accepts spans in zipkin v2 format,
+	// e.g. `http://jaeger-collector:9411/api/v2/spans`. Tracing is disabled if it is empty
+	TracingEndpoint string `envconfig:"TRACING_ENDPOINT" default:""`
+	// TracingSampleRatio is the ratio of the traces which are sampled
+	TracingSampleRatio float64 `envconfig:"TRACING_SAMPLE_RATIO" default:"1"`
+	// PodWorkers is the max number of pods which a chaos is applied on or recovered from at the same time
+	PodWorkers int `envconfig:"POD_WORKERS" default:"32"`
+	// NodeRateLimit is the max number of operations per second on the pods of each node,
+	// it's unlimited if it is not positive
+	NodeRateLimit float64 `envconfig:"NODE_RATE_LIMIT" default:"20"`
+	// BPFNetworkBackend enables the tc-bpf backend of NetworkChaos, which injects delay, loss and partition
+	// without ifb devices or iptables on the nodes labeled `chaos-mesh.org/network-backend=bpf`
+	BPFNetworkBackend bool `envconfig:"BPF_NETWORK_BACKEND" default:"false"`
+	// TargetProvider is the name of the target provider which vetoes or supplies the targets of chaos,
+	// e.g. `http` which posts the requests to TargetProviderURL. It's disabled if it is empty
+	TargetProvider string `envconfig:"TARGET_PROVIDER" default:""`
+	// TargetProviderURL is the url which the `http` target provider posts the requests to
+	TargetProviderURL string `envconfig:"TARGET_PROVIDER_URL" default:""`
+	// TargetProviderIgnoreFailures keeps the selected targets if the target provider fails,
+	// instead of failing the selection
+	TargetProviderIgnoreFailures bool `envconfig:"TARGET_PROVIDER_IGNORE_FAILURES" default:"false"`
 	// RPCTimeout is timeout of RPC between controllers and chaos-operator
 	RPCTimeout    time.Duration `envconfig:"RPC_TIMEOUT" default:"1m"`
 	WatcherConfig *watcher.Config
 }
 
+// ConcurrentReconcilesOf returns the max number of concurrent reconciles of the kind of chaos
+func (c *ChaosControllerConfig) ConcurrentReconcilesOf(kind string) int {
+	for k, n := range c.ConcurrentReconciles {
+		if strings.EqualFold(k, kind) && n > 0 {
+			return n
+		}
+	}
+
+	if c.MaxConcurrentReconciles > 0 {
+		return c.MaxConcurrentReconciles
+	}
+	return 1
+}
+
 // EnvironChaosController returns the settings from the environment.
 func EnvironChaosController() (ChaosControllerConfig, error) {
 	cfg := ChaosControllerConfig{}
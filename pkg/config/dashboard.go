@@ -25,6 +25,10 @@ type ChaosDashboardConfig struct {
 	EnableLeaderElection bool   `envconfig:"ENABLE_LEADER_ELECTION"`
 	Database             *DatabaseConfig
 	PersistTTL           PersistTTLConfig
+	Auth                 AuthConfig
+	// ReportGrafanaURL is the url of the Grafana dashboard which is linked in
+	// experiment reports with the time range of the experiment
+	ReportGrafanaURL string `envconfig:"REPORT_GRAFANA_URL"`
 }
 
 // PersistTTLConfig defines the configuration of ttl
@@ -34,6 +38,21 @@ type PersistTTLConfig struct {
 	Experiment string `envconfig:"TTL_EXPERIMENT"  default:"336h"`
 }
 
+// AuthConfig defines the configuration of the authentication and authorization of API.
+// The requests are authenticated by the bearer token, which is either an OIDC ID token
+// or a Kubernetes token validated by TokenReview, and authorized by the RBAC of Kubernetes.
+type AuthConfig struct {
+	Enabled bool `envconfig:"AUTH_ENABLED"`
+	// The following OIDC options should be the same as the ones of kube-apiserver,
+	// so the users are mapped to the same subjects of RBAC. OIDC is disabled if the issuer is empty.
+	OIDCIssuerURL      string `envconfig:"AUTH_OIDC_ISSUER_URL"`
+	OIDCClientID       string `envconfig:"AUTH_OIDC_CLIENT_ID"`
+	OIDCUsernameClaim  string `envconfig:"AUTH_OIDC_USERNAME_CLAIM" default:"sub"`
+	OIDCUsernamePrefix string `envconfig:"AUTH_OIDC_USERNAME_PREFIX"`
+	OIDCGroupsClaim    string `envconfig:"AUTH_OIDC_GROUPS_CLAIM"`
+	OIDCGroupsPrefix   string `envconfig:"AUTH_OIDC_GROUPS_PREFIX"`
+}
+
 // DatabaseConfig defines the configuration for databases
 type DatabaseConfig struct {
 	// Archive  Chaos Experiments to DB.
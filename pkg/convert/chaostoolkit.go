@@ -0,0 +1,317 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/sdk"
+)
+
+type toolkitExperiment struct {
+	Title       string             `json:"title"`
+	SteadyState *toolkitHypothesis `json:"steady-state-hypothesis"`
+	Method      []toolkitActivity  `json:"method"`
+	Rollbacks   []toolkitActivity  `json:"rollbacks"`
+}
+
+type toolkitHypothesis struct {
+	Title  string            `json:"title"`
+	Probes []toolkitActivity `json:"probes"`
+}
+
+type toolkitActivity struct {
+	Type     string           `json:"type"`
+	Name     string           `json:"name"`
+	Ref      string           `json:"ref"`
+	Provider *toolkitProvider `json:"provider"`
+}
+
+type toolkitProvider struct {
+	Type      string                 `json:"type"`
+	Module    string                 `json:"module"`
+	Func      string                 `json:"func"`
+	Arguments map[string]interface{} `json:"arguments"`
+}
+
+// KillInterval is the interval between the kills of the PodChaos converted from the actions which kill pods once,
+// since PodChaos kills pods by a scheduler
+var KillInterval = "1m"
+
+// toolkitUnsupportedArguments are the reasons why the arguments aren't converted, other than that the kind doesn't support them
+var toolkitUnsupportedArguments = map[string]string{
+	"duration": "the chaos lasts until it's deleted, since its duration must be set together with a scheduler",
+}
+
+// toolkitArguments are the arguments of an action, which records the arguments used by the conversion
+type toolkitArguments struct {
+	values map[string]interface{}
+	used   map[string]bool
+}
+
+func (a *toolkitArguments) string(name string, defaultValue string) string {
+	a.used[name] = true
+	switch value := a.values[name].(type) {
+	case string:
+		if value != "" {
+			return value
+		}
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	}
+	return defaultValue
+}
+
+// required returns the value of the argument, it returns an error if the argument isn't set
+func (a *toolkitArguments) required(name string) (string, error) {
+	value := a.string(name, "")
+	if value == "" {
+		return "", fmt.Errorf("%s is required", name)
+	}
+	return value, nil
+}
+
+// selector returns the labels of the argument, which is either `app=web,tier=frontend` or a map
+func (a *toolkitArguments) selector(name string) (map[string]string, error) {
+	a.used[name] = true
+	switch value := a.values[name].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if value == "" {
+			return nil, nil
+		}
+		return labels.ConvertSelectorToLabelsMap(value)
+	case map[string]interface{}:
+		selector := make(map[string]string, len(value))
+		for k, v := range value {
+			selector[k] = fmt.Sprint(v)
+		}
+		return selector, nil
+	}
+	return nil, fmt.Errorf("%s is neither a string nor a map", name)
+}
+
+// unused returns the arguments which are set but not converted, in the order of names
+func (a *toolkitArguments) unused() []string {
+	var names []string
+	for name := range a.values {
+		if !a.used[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+type toolkitConverter func(namespace string, name string, args *toolkitArguments) (v1alpha1.InnerObject, error)
+
+// toolkitConverters are the converters of the actions of chaostoolkit-kubernetes, by module and func
+var toolkitConverters = map[string]toolkitConverter{
+	"chaosk8s.pod.actions.terminate_pods": func(namespace string, name string, args *toolkitArguments) (v1alpha1.InnerObject, error) {
+		opts, err := toolkitSelector(args, "label_selector")
+		if err != nil {
+			return nil, err
+		}
+		args.used["rand"] = true
+		args.used["grace_period"] = true
+
+		qty := args.string("qty", "1")
+		switch {
+		case args.string("all", "false") == "true":
+			opts = append(opts, sdk.Mode(v1alpha1.AllPodMode, ""))
+		case args.string("mode", "fixed") == "percentage":
+			opts = append(opts, sdk.Mode(v1alpha1.FixedPercentPodMode, qty))
+		default:
+			opts = append(opts, sdk.Mode(v1alpha1.FixedPodMode, qty))
+		}
+		return sdk.NewPodChaos(namespace, name, v1alpha1.PodKillAction, append(opts, sdk.Scheduler("@every "+KillInterval))...)
+	},
+	"chaosk8s.pod.actions.delete_pods": func(namespace string, name string, args *toolkitArguments) (v1alpha1.InnerObject, error) {
+		opts, err := toolkitSelector(args, "label_selector")
+		if err != nil {
+			return nil, err
+		}
+		if pod := args.string("name", ""); pod != "" {
+			opts = append(opts, sdk.Pods(args.string("ns", "default"), pod))
+		}
+		return sdk.NewPodChaos(namespace, name, v1alpha1.PodKillAction, append(opts, sdk.Scheduler("@every "+KillInterval))...)
+	},
+	"chaosk8s.chaosmesh.network.actions.add_latency": func(namespace string, name string, args *toolkitArguments) (v1alpha1.InnerObject, error) {
+		opts, err := toolkitNetworkOptions(args)
+		if err != nil {
+			return nil, err
+		}
+		latency, err := args.required("latency")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdk.NetworkDelay(latency, args.string("jitter", "0ms"), args.string("correlation", "0")))
+		return sdk.NewNetworkChaos(namespace, name, v1alpha1.DelayAction, opts...)
+	},
+	"chaosk8s.chaosmesh.network.actions.add_packet_loss": func(namespace string, name string, args *toolkitArguments) (v1alpha1.InnerObject, error) {
+		opts, err := toolkitNetworkOptions(args)
+		if err != nil {
+			return nil, err
+		}
+		loss, err := args.required("loss")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdk.NetworkLoss(loss, args.string("correlation", "0")))
+		return sdk.NewNetworkChaos(namespace, name, v1alpha1.LossAction, opts...)
+	},
+	"chaosk8s.chaosmesh.network.actions.add_packet_corruption": func(namespace string, name string, args *toolkitArguments) (v1alpha1.InnerObject, error) {
+		opts, err := toolkitNetworkOptions(args)
+		if err != nil {
+			return nil, err
+		}
+		corrupt, err := args.required("corrupt")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdk.NetworkCorrupt(corrupt, args.string("correlation", "0")))
+		return sdk.NewNetworkChaos(namespace, name, v1alpha1.CorruptAction, opts...)
+	},
+	"chaosk8s.chaosmesh.network.actions.add_packet_duplication": func(namespace string, name string, args *toolkitArguments) (v1alpha1.InnerObject, error) {
+		opts, err := toolkitNetworkOptions(args)
+		if err != nil {
+			return nil, err
+		}
+		duplicate, err := args.required("duplicate")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdk.NetworkDuplicate(duplicate, args.string("correlation", "0")))
+		return sdk.NewNetworkChaos(namespace, name, v1alpha1.DuplicateAction, opts...)
+	},
+}
+
+// toolkitSelector selects the pods in the namespace `ns` with the labels of the argument
+func toolkitSelector(args *toolkitArguments, labelsArgument string) ([]sdk.Option, error) {
+	opts := []sdk.Option{sdk.Namespaces(args.string("ns", "default"))}
+	selector, err := args.selector(labelsArgument)
+	if err != nil {
+		return nil, err
+	}
+	if len(selector) > 0 {
+		opts = append(opts, sdk.LabelSelectors(selector))
+	}
+	return opts, nil
+}
+
+// toolkitNetworkOptions converts the common arguments of the network actions of chaosk8s.chaosmesh
+func toolkitNetworkOptions(args *toolkitArguments) ([]sdk.Option, error) {
+	args.used["name"] = true
+	opts, err := toolkitSelector(args, "label_selectors")
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, sdk.Mode(v1alpha1.PodMode(args.string("mode", string(v1alpha1.OnePodMode))), args.string("value", "")))
+	return opts, nil
+}
+
+// convertChaosToolkit converts the actions of the method, each action is converted to a chaos
+func convertChaosToolkit(data []byte, opt *Options) (*Result, error) {
+	docs, err := decodeDocuments(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(docs) != 1 {
+		return nil, fmt.Errorf("an experiment of Chaos Toolkit is expected, but there are %d documents", len(docs))
+	}
+	experiment := &toolkitExperiment{}
+	if err := json.Unmarshal(docs[0], experiment); err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	if experiment.SteadyState != nil {
+		for i, probe := range experiment.SteadyState.Probes {
+			result.unsupported(fmt.Sprintf("steady-state-hypothesis.probes[%d]", i), probe.Name,
+				"the probes aren't converted, record them as the probes of ChaosResult or judge the runs by SLO")
+		}
+	}
+
+	names := make(map[string]int)
+	for i, activity := range experiment.Method {
+		source := fmt.Sprintf("method[%d]", i)
+		switch {
+		case activity.Ref != "":
+			result.unsupported(source, activity.Ref, "the references to the activities aren't converted")
+			continue
+		case activity.Type != "action":
+			result.unsupported(source, activity.Name, "the %ss aren't converted, only the actions are", activity.Type)
+			continue
+		case activity.Provider == nil || activity.Provider.Type != "python":
+			result.unsupported(source, activity.Name, "only the actions of python providers are converted")
+			continue
+		}
+
+		provider := activity.Provider
+		convert, ok := toolkitConverters[provider.Module+"."+provider.Func]
+		if !ok {
+			result.unsupported(source, activity.Name, "%s.%s has no counterpart in Chaos Mesh", provider.Module, provider.Func)
+			continue
+		}
+
+		args := &toolkitArguments{values: provider.Arguments, used: make(map[string]bool)}
+		if args.values == nil {
+			args.values = make(map[string]interface{})
+		}
+		namespace := opt.Namespace
+		if namespace == "" {
+			namespace = args.string("ns", "default")
+		}
+
+		base := nameOf(experiment.Title, activity.Name)
+		name := base
+		if n := names[base]; n > 0 {
+			name = nameOf(base, strconv.Itoa(n))
+		}
+		names[base]++
+
+		chaos, err := convert(namespace, name, args)
+		if err != nil {
+			result.unsupported(source, activity.Name, "%v", err)
+			continue
+		}
+		result.Chaos = append(result.Chaos, chaos)
+
+		if pod, ok := chaos.(*v1alpha1.PodChaos); ok && pod.Spec.Action == v1alpha1.PodKillAction {
+			result.unsupported(source, activity.Name, "the pods are killed every %s until the chaos is deleted, since PodChaos kills pods by a scheduler", KillInterval)
+		}
+		for _, arg := range args.unused() {
+			reason, ok := toolkitUnsupportedArguments[arg]
+			if !ok {
+				reason = fmt.Sprintf("the argument isn't supported by %s", chaos.GetChaos().Kind)
+			}
+			result.unsupported(source+".arguments."+arg, activity.Name, "%s", reason)
+		}
+	}
+
+	for i, activity := range experiment.Rollbacks {
+		result.unsupported(fmt.Sprintf("rollbacks[%d]", i), activity.Name,
+			"the rollbacks aren't converted, the chaos is recovered when it's deleted or its duration is over")
+	}
+	return result, nil
+}
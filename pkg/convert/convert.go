@@ -0,0 +1,139 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// The formats of the experiments which are converted
+const (
+	// FormatLitmus is the ChaosEngine of LitmusChaos in YAML or JSON
+	FormatLitmus = "litmus"
+	// FormatChaosToolkit is the experiment of Chaos Toolkit in JSON or YAML
+	FormatChaosToolkit = "chaostoolkit"
+)
+
+// Unsupported is a part of the experiment which isn't converted
+type Unsupported struct {
+	// Source is where the part is in the experiment, e.g. `experiments[1]` or `method[0]`
+	Source string `json:"source"`
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+func (u Unsupported) String() string {
+	return fmt.Sprintf("%s %s: %s", u.Source, u.Name, u.Reason)
+}
+
+// Result is the chaos converted from an experiment, and the parts which aren't converted
+type Result struct {
+	Chaos       []v1alpha1.InnerObject
+	Unsupported []Unsupported
+}
+
+func (r *Result) unsupported(source string, name string, format string, args ...interface{}) {
+	r.Unsupported = append(r.Unsupported, Unsupported{Source: source, Name: name, Reason: fmt.Sprintf(format, args...)})
+}
+
+// Options defines the options of the conversion
+type Options struct {
+	// Namespace is the namespace of the chaos, default to the namespace of the experiment
+	Namespace string
+}
+
+// Convert converts the experiment in the format to chaos, the format is detected if it's empty
+func Convert(data []byte, format string, opt *Options) (*Result, error) {
+	if format == "" {
+		var err error
+		if format, err = DetectFormat(data); err != nil {
+			return nil, err
+		}
+	}
+
+	switch format {
+	case FormatLitmus:
+		return convertLitmus(data, opt)
+	case FormatChaosToolkit:
+		return convertChaosToolkit(data, opt)
+	}
+	return nil, fmt.Errorf("format %s is not supported, it must be %s or %s", format, FormatLitmus, FormatChaosToolkit)
+}
+
+// DetectFormat detects the format of the experiment
+func DetectFormat(data []byte) (string, error) {
+	docs, err := decodeDocuments(data)
+	if err != nil {
+		return "", err
+	}
+
+	for _, doc := range docs {
+		var probe struct {
+			APIVersion string          `json:"apiVersion"`
+			Method     json.RawMessage `json:"method"`
+		}
+		if err := json.Unmarshal(doc, &probe); err != nil {
+			return "", err
+		}
+		if strings.HasPrefix(probe.APIVersion, "litmuschaos.io/") {
+			return FormatLitmus, nil
+		}
+		if len(probe.Method) > 0 {
+			return FormatChaosToolkit, nil
+		}
+	}
+	return "", fmt.Errorf("the format of the experiment is unknown, it's neither a ChaosEngine of LitmusChaos nor an experiment of Chaos Toolkit")
+}
+
+// decodeDocuments decodes the YAML or JSON documents into JSON
+func decodeDocuments(data []byte) ([]json.RawMessage, error) {
+	decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+
+	var docs []json.RawMessage
+	for {
+		var doc json.RawMessage
+		if err := decoder.Decode(&doc); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		if len(doc) == 0 || string(doc) == "null" {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// nameOf returns a valid name of chaos from the names of the experiment and the action
+func nameOf(names ...string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(strings.Join(names, "-")), "-")
+	name = strings.Trim(name, "-")
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+	return name
+}
@@ -0,0 +1,180 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+const litmusEngineYAML = `
+apiVersion: litmuschaos.io/v1alpha1
+kind: ChaosEngine
+metadata:
+  name: nginx-chaos
+  namespace: litmus
+spec:
+  appinfo:
+    appns: shop
+    applabel: app=nginx
+    appkind: deployment
+  engineState: active
+  experiments:
+  - name: pod-network-latency
+    spec:
+      components:
+        env:
+        - name: NETWORK_LATENCY
+          value: "300"
+        - name: TOTAL_CHAOS_DURATION
+          value: "120"
+        - name: PODS_AFFECTED_PERC
+          value: "50"
+        - name: RAMP_TIME
+          value: "10"
+  - name: pod-delete
+    spec:
+      components:
+        env:
+        - name: CHAOS_INTERVAL
+          value: "10"
+        - name: FORCE
+          value: "false"
+      probe:
+      - name: check-frontend
+  - name: node-drain
+---
+apiVersion: litmuschaos.io/v1alpha1
+kind: ChaosExperiment
+metadata:
+  name: pod-delete
+`
+
+const toolkitJSON = `{
+  "title": "Web survives pod kills",
+  "steady-state-hypothesis": {
+    "title": "web is up",
+    "probes": [{"type": "probe", "name": "web-is-ok", "tolerance": 200,
+      "provider": {"type": "http", "url": "http://web"}}]
+  },
+  "method": [
+    {"type": "action", "name": "kill-web",
+     "provider": {"type": "python", "module": "chaosk8s.pod.actions", "func": "terminate_pods",
+       "arguments": {"label_selector": "app=web", "ns": "shop", "qty": 2, "rand": true, "name_pattern": "web-.*"}}},
+    {"type": "action", "name": "slow-web",
+     "provider": {"type": "python", "module": "chaosk8s.chaosmesh.network.actions", "func": "add_latency",
+       "arguments": {"name": "slow-web", "ns": "shop", "label_selectors": {"app": "web"}, "latency": "100ms", "duration": "2m"}}},
+    {"type": "action", "name": "drain",
+     "provider": {"type": "python", "module": "chaosk8s.node.actions", "func": "drain_nodes"}},
+    {"type": "action", "name": "curl", "provider": {"type": "process", "path": "curl"}}
+  ],
+  "rollbacks": [{"type": "action", "name": "uncordon"}]
+}`
+
+func TestDetectFormat(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	format, err := DetectFormat([]byte(litmusEngineYAML))
+	g.Expect(err).To(BeNil())
+	g.Expect(format).To(Equal(FormatLitmus))
+
+	format, err = DetectFormat([]byte(toolkitJSON))
+	g.Expect(err).To(BeNil())
+	g.Expect(format).To(Equal(FormatChaosToolkit))
+
+	_, err = DetectFormat([]byte("kind: PodChaos"))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestConvertLitmus(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	result, err := Convert([]byte(litmusEngineYAML), "", &Options{})
+	g.Expect(err).To(BeNil())
+	g.Expect(result.Chaos).To(HaveLen(2))
+
+	network := result.Chaos[0].(*v1alpha1.NetworkChaos)
+	g.Expect(network.Namespace).To(Equal("litmus"))
+	g.Expect(network.Name).To(Equal("nginx-chaos-pod-network-latency"))
+	g.Expect(network.Spec.Action).To(Equal(v1alpha1.DelayAction))
+	g.Expect(network.Spec.Delay.Latency).To(Equal("300ms"))
+	g.Expect(network.Spec.Duration).To(BeNil())
+	g.Expect(network.Spec.Mode).To(Equal(v1alpha1.FixedPercentPodMode))
+	g.Expect(network.Spec.Value).To(Equal("50"))
+	g.Expect(network.Spec.Selector.Namespaces).To(Equal([]string{"shop"}))
+	g.Expect(network.Spec.Selector.LabelSelectors).To(Equal(map[string]string{"app": "nginx"}))
+
+	pod := result.Chaos[1].(*v1alpha1.PodChaos)
+	g.Expect(pod.Spec.Action).To(Equal(v1alpha1.PodKillAction))
+	g.Expect(pod.Spec.Mode).To(Equal(v1alpha1.OnePodMode))
+	g.Expect(pod.Spec.Scheduler.Cron).To(Equal("@every 10s"))
+
+	g.Expect(result.Unsupported).To(Equal([]Unsupported{
+		{Source: "nginx-chaos.experiments[0].env.TOTAL_CHAOS_DURATION", Name: "pod-network-latency",
+			Reason: "the chaos lasts until it's deleted, since its duration must be set together with a scheduler"},
+		{Source: "nginx-chaos.experiments[1].env.FORCE", Name: "pod-delete", Reason: "the variable isn't supported by PodChaos"},
+		{Source: "nginx-chaos.experiments[1].probe", Name: "check-frontend", Reason: "the probes aren't converted, record them as the probes of ChaosResult"},
+		{Source: "nginx-chaos.experiments[2]", Name: "node-drain", Reason: "the experiment has no counterpart in Chaos Mesh"},
+		{Source: "documents[1]", Name: "pod-delete", Reason: "ChaosExperiment isn't converted, only ChaosEngine is"},
+	}))
+
+	result, err = Convert([]byte(litmusEngineYAML), FormatLitmus, &Options{Namespace: "chaos-testing"})
+	g.Expect(err).To(BeNil())
+	g.Expect(result.Chaos[0].GetChaos().Namespace).To(Equal("chaos-testing"))
+}
+
+func TestConvertChaosToolkit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	result, err := Convert([]byte(toolkitJSON), FormatChaosToolkit, &Options{})
+	g.Expect(err).To(BeNil())
+	g.Expect(result.Chaos).To(HaveLen(2))
+
+	pod := result.Chaos[0].(*v1alpha1.PodChaos)
+	g.Expect(pod.Namespace).To(Equal("shop"))
+	g.Expect(pod.Name).To(Equal("web-survives-pod-kills-kill-web"))
+	g.Expect(pod.Spec.Mode).To(Equal(v1alpha1.FixedPodMode))
+	g.Expect(pod.Spec.Value).To(Equal("2"))
+	g.Expect(pod.Spec.Selector.LabelSelectors).To(Equal(map[string]string{"app": "web"}))
+	g.Expect(pod.Spec.Scheduler.Cron).To(Equal("@every " + KillInterval))
+
+	network := result.Chaos[1].(*v1alpha1.NetworkChaos)
+	g.Expect(network.Spec.Delay.Latency).To(Equal("100ms"))
+	g.Expect(network.Spec.Duration).To(BeNil())
+	g.Expect(network.Spec.Mode).To(Equal(v1alpha1.OnePodMode))
+
+	var sources []string
+	for _, u := range result.Unsupported {
+		sources = append(sources, u.Source)
+	}
+	g.Expect(sources).To(Equal([]string{
+		"steady-state-hypothesis.probes[0]",
+		"method[0]",
+		"method[0].arguments.name_pattern",
+		"method[1].arguments.duration",
+		"method[2]",
+		"method[3]",
+		"rollbacks[0]",
+	}))
+}
+
+func TestNameOf(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(nameOf("Web Survives", "kill_pods!")).To(Equal("web-survives-kill-pods"))
+	g.Expect(len(nameOf(string(make([]byte, 100)), "a"))).To(BeNumerically("<=", 63))
+}
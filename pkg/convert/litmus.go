@@ -0,0 +1,263 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/sdk"
+)
+
+type litmusEngine struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"metadata"`
+	Spec struct {
+		AppInfo struct {
+			AppNS    string `json:"appns"`
+			AppLabel string `json:"applabel"`
+		} `json:"appinfo"`
+		Experiments []litmusExperiment `json:"experiments"`
+	} `json:"spec"`
+}
+
+type litmusExperiment struct {
+	Name string `json:"name"`
+	Spec struct {
+		Components struct {
+			Env []struct {
+				Name  string `json:"name"`
+				Value string `json:"value"`
+			} `json:"env"`
+		} `json:"components"`
+		Probe []struct {
+			Name string `json:"name"`
+		} `json:"probe"`
+	} `json:"spec"`
+}
+
+// litmusEnv is the env of a Litmus experiment, which records the variables used by the conversion
+type litmusEnv struct {
+	values map[string]string
+	used   map[string]bool
+}
+
+// litmusIgnoredEnv are the variables about how Litmus runs the experiment, which have no effect on the chaos
+var litmusIgnoredEnv = map[string]bool{
+	"RAMP_TIME":            true,
+	"LIB":                  true,
+	"LIB_IMAGE":            true,
+	"TC_IMAGE":             true,
+	"STRESS_IMAGE":         true,
+	"SEQUENCE":             true,
+	"CONTAINER_RUNTIME":    true,
+	"SOCKET_PATH":          true,
+	"DEFAULT_HEALTH_CHECK": true,
+	"INSTANCE_ID":          true,
+}
+
+// litmusUnsupportedEnv are the reasons why the variables aren't converted, other than that the kind doesn't support them
+var litmusUnsupportedEnv = map[string]string{
+	"TOTAL_CHAOS_DURATION": "the chaos lasts until it's deleted, since its duration must be set together with a scheduler",
+}
+
+func (e *litmusEnv) get(name string, defaultValue string) string {
+	e.used[name] = true
+	if value := e.values[name]; value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// unused returns the variables which are set but not converted, in the order of names
+func (e *litmusEnv) unused() []string {
+	var names []string
+	for name, value := range e.values {
+		if value != "" && !e.used[name] && !litmusIgnoredEnv[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+type litmusConverter func(namespace string, name string, env *litmusEnv, opts []sdk.Option) (v1alpha1.InnerObject, error)
+
+// litmusConverters are the converters of the Litmus experiments which have counterparts in Chaos Mesh
+var litmusConverters = map[string]litmusConverter{
+	"pod-delete": func(namespace string, name string, env *litmusEnv, opts []sdk.Option) (v1alpha1.InnerObject, error) {
+		return sdk.NewPodChaos(namespace, name, v1alpha1.PodKillAction, append(opts, litmusInterval(env))...)
+	},
+	"container-kill": func(namespace string, name string, env *litmusEnv, opts []sdk.Option) (v1alpha1.InnerObject, error) {
+		container := env.get("TARGET_CONTAINER", "")
+		if container == "" {
+			return nil, fmt.Errorf("TARGET_CONTAINER is required")
+		}
+		return sdk.NewPodChaos(namespace, name, v1alpha1.ContainerKillAction, append(opts, sdk.ContainerName(container), litmusInterval(env))...)
+	},
+	"pod-network-latency": func(namespace string, name string, env *litmusEnv, opts []sdk.Option) (v1alpha1.InnerObject, error) {
+		opts = append(opts, sdk.NetworkDelay(env.get("NETWORK_LATENCY", "2000")+"ms", env.get("JITTER", "0")+"ms", "0"))
+		return newLitmusNetworkChaos(namespace, name, v1alpha1.DelayAction, env, opts)
+	},
+	"pod-network-loss": func(namespace string, name string, env *litmusEnv, opts []sdk.Option) (v1alpha1.InnerObject, error) {
+		opts = append(opts, sdk.NetworkLoss(env.get("NETWORK_PACKET_LOSS_PERCENTAGE", "100"), "0"))
+		return newLitmusNetworkChaos(namespace, name, v1alpha1.LossAction, env, opts)
+	},
+	"pod-network-corruption": func(namespace string, name string, env *litmusEnv, opts []sdk.Option) (v1alpha1.InnerObject, error) {
+		opts = append(opts, sdk.NetworkCorrupt(env.get("NETWORK_PACKET_CORRUPTION_PERCENTAGE", "100"), "0"))
+		return newLitmusNetworkChaos(namespace, name, v1alpha1.CorruptAction, env, opts)
+	},
+	"pod-network-duplication": func(namespace string, name string, env *litmusEnv, opts []sdk.Option) (v1alpha1.InnerObject, error) {
+		opts = append(opts, sdk.NetworkDuplicate(env.get("NETWORK_PACKET_DUPLICATION_PERCENTAGE", "100"), "0"))
+		return newLitmusNetworkChaos(namespace, name, v1alpha1.DuplicateAction, env, opts)
+	},
+	"pod-cpu-hog": func(namespace string, name string, env *litmusEnv, opts []sdk.Option) (v1alpha1.InnerObject, error) {
+		workers, err := strconv.Atoi(env.get("CPU_CORES", "1"))
+		if err != nil {
+			return nil, fmt.Errorf("CPU_CORES is invalid: %v", err)
+		}
+		load, err := strconv.Atoi(env.get("CPU_LOAD", "100"))
+		if err != nil {
+			return nil, fmt.Errorf("CPU_LOAD is invalid: %v", err)
+		}
+		stressors := &v1alpha1.Stressors{CPUStressor: &v1alpha1.CPUStressor{Stressor: v1alpha1.Stressor{Workers: workers}, Load: &load}}
+		return sdk.NewStressChaos(namespace, name, stressors, opts...)
+	},
+	"pod-memory-hog": func(namespace string, name string, env *litmusEnv, opts []sdk.Option) (v1alpha1.InnerObject, error) {
+		workers, err := strconv.Atoi(env.get("NUMBER_OF_WORKERS", "1"))
+		if err != nil {
+			return nil, fmt.Errorf("NUMBER_OF_WORKERS is invalid: %v", err)
+		}
+		stressors := &v1alpha1.Stressors{MemoryStressor: &v1alpha1.MemoryStressor{
+			Stressor: v1alpha1.Stressor{Workers: workers},
+			Size:     env.get("MEMORY_CONSUMPTION", "500") + "MB",
+		}}
+		return sdk.NewStressChaos(namespace, name, stressors, opts...)
+	},
+}
+
+func newLitmusNetworkChaos(namespace string, name string, action v1alpha1.NetworkChaosAction, env *litmusEnv, opts []sdk.Option) (*v1alpha1.NetworkChaos, error) {
+	if device := env.get("NETWORK_INTERFACE", ""); device != "" {
+		opts = append(opts, sdk.NetworkDevice(device))
+	}
+	return sdk.NewNetworkChaos(namespace, name, action, opts...)
+}
+
+// litmusInterval converts the interval between the kills in seconds, which is 10 seconds by default in Litmus
+func litmusInterval(env *litmusEnv) sdk.Option {
+	return sdk.Scheduler("@every " + env.get("CHAOS_INTERVAL", "10") + "s")
+}
+
+// convertLitmus converts the experiments of the ChaosEngines, each experiment is converted to a chaos
+func convertLitmus(data []byte, opt *Options) (*Result, error) {
+	docs, err := decodeDocuments(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	for i, doc := range docs {
+		engine := &litmusEngine{}
+		if err := json.Unmarshal(doc, engine); err != nil {
+			return nil, err
+		}
+		if engine.Kind != "ChaosEngine" {
+			result.unsupported(fmt.Sprintf("documents[%d]", i), engine.Metadata.Name, "%s isn't converted, only ChaosEngine is", engine.Kind)
+			continue
+		}
+
+		for j, experiment := range engine.Spec.Experiments {
+			source := fmt.Sprintf("%s.experiments[%d]", engine.Metadata.Name, j)
+			convertLitmusExperiment(result, source, engine, &experiment, opt)
+		}
+	}
+	return result, nil
+}
+
+func convertLitmusExperiment(result *Result, source string, engine *litmusEngine, experiment *litmusExperiment, opt *Options) {
+	convert, ok := litmusConverters[experiment.Name]
+	if !ok {
+		result.unsupported(source, experiment.Name, "the experiment has no counterpart in Chaos Mesh")
+		return
+	}
+
+	env := &litmusEnv{values: make(map[string]string), used: make(map[string]bool)}
+	for _, e := range experiment.Spec.Components.Env {
+		env.values[e.Name] = e.Value
+	}
+
+	namespace := opt.Namespace
+	if namespace == "" {
+		namespace = engine.Metadata.Namespace
+	}
+	appNamespace := engine.Spec.AppInfo.AppNS
+	if appNamespace == "" {
+		appNamespace = engine.Metadata.Namespace
+	}
+	if namespace == "" {
+		namespace = appNamespace
+	}
+	if namespace == "" {
+		namespace = "default"
+		appNamespace = namespace
+	}
+
+	var opts []sdk.Option
+	if pods := env.get("TARGET_PODS", ""); pods != "" {
+		opts = append(opts, sdk.Pods(appNamespace, strings.Split(pods, ",")...))
+	} else {
+		opts = append(opts, sdk.Namespaces(appNamespace))
+		if engine.Spec.AppInfo.AppLabel != "" {
+			selector, err := labels.ConvertSelectorToLabelsMap(engine.Spec.AppInfo.AppLabel)
+			if err != nil {
+				result.unsupported(source, experiment.Name, "applabel %s isn't an equality selector: %v", engine.Spec.AppInfo.AppLabel, err)
+				return
+			}
+			opts = append(opts, sdk.LabelSelectors(selector))
+		}
+	}
+	// Litmus affects one pod unless the percentage is set
+	if percent := env.get("PODS_AFFECTED_PERC", "0"); percent == "0" {
+		opts = append(opts, sdk.Mode(v1alpha1.OnePodMode, ""))
+	} else {
+		opts = append(opts, sdk.Mode(v1alpha1.FixedPercentPodMode, percent))
+	}
+
+	chaos, err := convert(namespace, nameOf(engine.Metadata.Name, experiment.Name), env, opts)
+	if err != nil {
+		result.unsupported(source, experiment.Name, "%v", err)
+		return
+	}
+	result.Chaos = append(result.Chaos, chaos)
+
+	for _, name := range env.unused() {
+		reason, ok := litmusUnsupportedEnv[name]
+		if !ok {
+			reason = fmt.Sprintf("the variable isn't supported by %s", chaos.GetChaos().Kind)
+		}
+		result.unsupported(source+".env."+name, experiment.Name, "%s", reason)
+	}
+	for _, probe := range experiment.Spec.Probe {
+		result.unsupported(source+".probe", probe.Name, "the probes aren't converted, record them as the probes of ChaosResult")
+	}
+}
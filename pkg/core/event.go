@@ -37,6 +37,10 @@ type EventStore interface {
 	// ListByPod returns an event list by the name and namespace of the pod.
 	ListByPod(context.Context, string, string) ([]*Event, error)
 
+	// ListByPodAndTime returns the events which injected chaos into the pod and overlapped with
+	// the time range between start and end. A nil start or end means the range is unbounded on that side.
+	ListByPodAndTime(ctx context.Context, namespace, name string, start, end *time.Time) ([]*Event, error)
+
 	// DryListByFilter returns an event list by experimentName, experimentNamespace, uid, kind, startTime and finishTime.
 	DryListByFilter(context.Context, Filter) ([]*Event, error)
 
@@ -66,6 +70,15 @@ type EventStore interface {
 	// which means the event would never save the finish_time.
 	// UpdateIncompleteEvents can update the finish_time when the chaos is deleted.
 	UpdateIncompleteEvents(context.Context, string, string) error
+
+	// CreatePhaseRecord persists a lifecycle event of an experiment to the datastore.
+	CreatePhaseRecord(context.Context, *PhaseRecord) error
+
+	// FindLatestPhaseRecord returns the latest lifecycle event of the experiment by its uid.
+	FindLatestPhaseRecord(context.Context, string) (*PhaseRecord, error)
+
+	// ListPhaseRecords returns the lifecycle events of the experiment by its uid, ordered by time.
+	ListPhaseRecords(context.Context, string) ([]*PhaseRecord, error)
 }
 
 // Event represents an event instance.
@@ -93,6 +106,19 @@ type PodRecord struct {
 	Action    string
 }
 
+// PhaseRecord represents a lifecycle event of an experiment, which is recorded
+// every time the phase of the experiment changes.
+type PhaseRecord struct {
+	gorm.Model
+	ExperimentID string `gorm:"index:phase_experiment_id"`
+	Experiment   string
+	Namespace    string
+	Kind         string
+	Phase        string
+	Reason       string
+	Time         time.Time `gorm:"index:phase_time"`
+}
+
 // Filter represents the filter to list events
 type Filter struct {
 	PodName             string
@@ -0,0 +1,51 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// TemplateStore defines operations for working with experiment templates.
+type TemplateStore interface {
+	// List returns the experiment template list from the datastore.
+	List(context.Context) ([]*ExperimentTemplate, error)
+
+	// FindByName returns an experiment template by its name.
+	FindByName(context.Context, string) (*ExperimentTemplate, error)
+
+	// Create persists a new experiment template to the datastore.
+	Create(context.Context, *ExperimentTemplate) error
+
+	// Update persists an updated experiment template to the datastore.
+	Update(context.Context, *ExperimentTemplate) error
+
+	// Delete deletes the experiment template from the datastore.
+	Delete(context.Context, *ExperimentTemplate) error
+}
+
+// ExperimentTemplate represents a server-side template of experiments,
+// which can be instantiated with different parameters.
+type ExperimentTemplate struct {
+	gorm.Model
+	Name        string `gorm:"unique_index:template_name"`
+	Description string
+	// Parameters is the JSON encoded list of the parameters of the template.
+	Parameters string `gorm:"type:text"`
+	// Experiment is the JSON encoded experiment, in which the `${name}` placeholders
+	// are substituted by the parameters when the template is instantiated.
+	Experiment string `gorm:"type:text"`
+}
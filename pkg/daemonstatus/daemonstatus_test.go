@@ -0,0 +1,165 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonstatus
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// statusServer is the chaos-daemon which pushes the events sent to it
+type statusServer struct {
+	pb.ChaosDaemonServer
+	events chan *pb.StatusEvent
+}
+
+func (s *statusServer) WatchStatus(req *pb.WatchStatusRequest, stream pb.ChaosDaemon_WatchStatusServer) error {
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-s.events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+type grpcClient struct {
+	pb.ChaosDaemonClient
+	conn *grpc.ClientConn
+}
+
+func (c *grpcClient) Close() error {
+	return c.conn.Close()
+}
+
+func TestProgress(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	p := NewProgress()
+	g.Expect(p.Pod("uid1", []string{"docker://c1"})).To(BeNil())
+
+	p.Record(&pb.StatusEvent{ExperimentUid: "uid1", Method: "SetNetem", ContainerIds: []string{"docker://c1"},
+		Phase: pb.StatusApplied, Time: 1})
+	p.Record(&pb.StatusEvent{ExperimentUid: "uid1", Method: "ExecStressors", ContainerIds: []string{"docker://c2"},
+		Phase: pb.StatusApplied, Pids: []int64{100}, Time: 2})
+	p.Record(&pb.StatusEvent{ExperimentUid: "uid1", Method: "ExecStressors", ContainerIds: []string{"docker://c2"},
+		Phase: pb.StatusApplied, Pids: []int64{200}, Time: 3})
+	p.Record(&pb.StatusEvent{ExperimentUid: "uid2", Method: "SetNetem", ContainerIds: []string{"docker://c1"},
+		Phase: pb.StatusApplied, Time: 4})
+
+	progress := p.Pod("uid1", []string{"docker://c2", "docker://c1"})
+	g.Expect(progress.Phase).To(Equal(pb.StatusApplied))
+	g.Expect(progress.Method).To(Equal("ExecStressors"))
+	g.Expect(progress.Containers).To(Equal([]v1alpha1.ContainerProgress{
+		{ID: "docker://c1", Applied: 1},
+		{ID: "docker://c2", Applied: 2},
+	}))
+	g.Expect(progress.Pids).To(Equal([]int64{100, 200}))
+	g.Expect(progress.LastUpdateTime.UnixNano()).To(Equal(int64(3)))
+
+	// the exited stressors are removed, and the failure is the last event
+	p.Record(&pb.StatusEvent{ExperimentUid: "uid1", Method: "ExecStressors", ContainerIds: []string{"docker://c2"},
+		Phase: pb.StatusExited, Pids: []int64{100}, Message: "exit status 1", Time: 5})
+	progress = p.Pod("uid1", []string{"docker://c1", "docker://c2"})
+	g.Expect(progress.Phase).To(Equal(pb.StatusExited))
+	g.Expect(progress.Message).To(Equal("exit status 1"))
+	g.Expect(progress.Pids).To(Equal([]int64{200}))
+
+	// the cancelled stressors are reported without containers
+	p.Record(&pb.StatusEvent{ExperimentUid: "uid1", Method: "CancelStressors", Phase: pb.StatusRecovered,
+		Pids: []int64{200}, Time: 6})
+	p.Record(&pb.StatusEvent{ExperimentUid: "uid1", Method: "DeleteNetem", ContainerIds: []string{"docker://c1"},
+		Phase: pb.StatusRecovered, Time: 7})
+	progress = p.Pod("uid1", []string{"docker://c1", "docker://c2"})
+	g.Expect(progress.Phase).To(Equal(pb.StatusRecovered))
+	g.Expect(progress.Pids).To(BeEmpty())
+	g.Expect(progress.Containers[0].Applied).To(Equal(0))
+
+	p.Forget("uid1")
+	g.Expect(p.Pod("uid1", []string{"docker://c1"})).To(BeNil())
+	g.Expect(p.Pod("uid2", []string{"docker://c1"})).NotTo(BeNil())
+}
+
+func TestWatcher(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	daemon := &statusServer{events: make(chan *pb.StatusEvent)}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ToNot(HaveOccurred())
+	server := grpc.NewServer()
+	pb.RegisterChaosDaemonServer(server, daemon)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	dial := func(ctx context.Context, node string) (Client, error) {
+		if node != "n1" {
+			return nil, k8serror.NewNotFound(schema.GroupResource{Resource: "nodes"}, node)
+		}
+		conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+		if err != nil {
+			return nil, err
+		}
+		return &grpcClient{ChaosDaemonClient: pb.NewChaosDaemonClient(conn), conn: conn}, nil
+	}
+	handled := make(chan *pb.StatusEvent, 1)
+	w := NewWatcher(dial, func(ctx context.Context, event *pb.StatusEvent) {
+		handled <- event
+	}, nil)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go w.Start(stop)
+	g.Eventually(func() bool {
+		w.Lock()
+		defer w.Unlock()
+		return w.ctx != nil
+	}).Should(BeTrue())
+
+	// the stream is subscribed when Watch returns, so the event is received
+	w.Watch(context.TODO(), "n1")
+	event := &pb.StatusEvent{ExperimentUid: "uid1", ContainerIds: []string{"docker://c1"}, Phase: pb.StatusApplied}
+	select {
+	case daemon.events <- event:
+	case <-time.After(time.Second):
+		t.Fatal("the stream isn't subscribed")
+	}
+	g.Eventually(handled).Should(Receive())
+	g.Expect(w.Progress.Pod("uid1", []string{"docker://c1"}).Containers).To(HaveLen(1))
+
+	// the node which is gone isn't watched
+	w.Watch(context.TODO(), "n2")
+	g.Eventually(func() bool {
+		w.Lock()
+		defer w.Unlock()
+		_, ok := w.streams["n2"]
+		return ok
+	}).Should(BeFalse())
+}
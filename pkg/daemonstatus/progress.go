@@ -0,0 +1,155 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonstatus
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// containerProgress is the progress of an experiment on a container
+type containerProgress struct {
+	applied int
+	pids    []int64
+	last    *pb.StatusEvent
+}
+
+// Progress accumulates the events of experiments by their UIDs and the containers.
+// The events may arrive before the targets are recorded in the status of the experiments,
+// so the progress is kept until the experiments are forgotten.
+type Progress struct {
+	sync.Mutex
+
+	experiments map[string]map[string]*containerProgress
+}
+
+// NewProgress creates an empty Progress
+func NewProgress() *Progress {
+	return &Progress{
+		experiments: make(map[string]map[string]*containerProgress),
+	}
+}
+
+// Record accumulates the event into the progress of its experiment
+func (p *Progress) Record(event *pb.StatusEvent) {
+	if event.ExperimentUid == "" {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	containers, ok := p.experiments[event.ExperimentUid]
+	if !ok {
+		containers = make(map[string]*containerProgress)
+		p.experiments[event.ExperimentUid] = containers
+	}
+	// the processes which are gone may be reported without their containers, e.g. the cancelled stressors
+	if event.Phase == pb.StatusRecovered || event.Phase == pb.StatusExited {
+		for _, c := range containers {
+			c.pids = removePids(c.pids, event.Pids)
+		}
+	}
+	for _, id := range event.ContainerIds {
+		c, ok := containers[id]
+		if !ok {
+			c = &containerProgress{}
+			containers[id] = c
+		}
+
+		switch event.Phase {
+		case pb.StatusApplied:
+			c.applied++
+			c.pids = append(c.pids, event.Pids...)
+		case pb.StatusRecovered:
+			if c.applied > 0 {
+				c.applied--
+			}
+		}
+		c.last = event
+	}
+}
+
+// Pod returns the progress of the experiment on the pod of the containers,
+// it returns nil if no event of the containers is recorded
+func (p *Progress) Pod(uid string, containerIDs []string) *v1alpha1.PodProgress {
+	if p == nil {
+		return nil
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	var (
+		progress *v1alpha1.PodProgress
+		last     *pb.StatusEvent
+	)
+	for _, id := range containerIDs {
+		c, ok := p.experiments[uid][id]
+		if !ok {
+			continue
+		}
+		if progress == nil {
+			progress = &v1alpha1.PodProgress{}
+		}
+		progress.Containers = append(progress.Containers, v1alpha1.ContainerProgress{ID: id, Applied: c.applied})
+		progress.Pids = append(progress.Pids, c.pids...)
+		if last == nil || c.last.Time > last.Time {
+			last = c.last
+		}
+	}
+	if progress == nil {
+		return nil
+	}
+
+	sort.Slice(progress.Containers, func(i, j int) bool {
+		return progress.Containers[i].ID < progress.Containers[j].ID
+	})
+	progress.Phase = last.Phase
+	progress.Method = last.Method
+	progress.Message = last.Message
+	progress.LastUpdateTime = metav1.NewTime(time.Unix(0, last.Time))
+	return progress
+}
+
+// Forget drops the progress of the experiment
+func (p *Progress) Forget(uid string) {
+	p.Lock()
+	defer p.Unlock()
+
+	delete(p.experiments, uid)
+}
+
+func removePids(pids, removed []int64) []int64 {
+	var kept []int64
+	for _, pid := range pids {
+		found := false
+		for _, r := range removed {
+			if pid == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			kept = append(kept, pid)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,230 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonstatus
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var log = ctrl.Log.WithName("daemonstatus")
+
+const (
+	// subscribeTimeout bounds the time which Watch waits for a new stream to be subscribed
+	subscribeTimeout = 5 * time.Second
+
+	minRetryInterval = time.Second
+	maxRetryInterval = time.Minute
+)
+
+// Client is the client of chaos-daemon which the events are watched by
+type Client interface {
+	WatchStatus(ctx context.Context, in *pb.WatchStatusRequest, opts ...grpc.CallOption) (pb.ChaosDaemon_WatchStatusClient, error)
+	Close() error
+}
+
+// Dialer creates a client of chaos-daemon on the node
+type Dialer func(ctx context.Context, node string) (Client, error)
+
+// Handler handles an event pushed by chaos-daemon, the event has been recorded in the progress when it's handled
+type Handler func(ctx context.Context, event *pb.StatusEvent)
+
+// Resumer returns the nodes to watch when the watcher is started, e.g. the nodes of the running experiments
+type Resumer func(ctx context.Context) ([]string, error)
+
+// Watcher keeps a stream of the events pushed by chaos-daemon on each watched node, and records the events
+// in Progress before they're handled. The broken streams are reopened with backoff, and the streams
+// of the nodes which are gone are closed.
+type Watcher struct {
+	// Progress is the progress of the experiments accumulated from the events
+	Progress *Progress
+
+	dial   Dialer
+	handle Handler
+	resume Resumer
+
+	sync.Mutex
+	// ctx is the context of Start, it's nil until the watcher is started
+	ctx     context.Context
+	streams map[string]*nodeStream
+}
+
+type nodeStream struct {
+	once sync.Once
+	// subscribed is closed once the stream is subscribed for the first time, or the node is gone
+	subscribed chan struct{}
+}
+
+func (s *nodeStream) markSubscribed() {
+	s.once.Do(func() { close(s.subscribed) })
+}
+
+// NewWatcher creates a Watcher, resume may be nil if no node is watched at start
+func NewWatcher(dial Dialer, handle Handler, resume Resumer) *Watcher {
+	return &Watcher{
+		Progress: NewProgress(),
+		dial:     dial,
+		handle:   handle,
+		resume:   resume,
+		streams:  make(map[string]*nodeStream),
+	}
+}
+
+// Watch opens the stream of the node if it isn't opened yet, and waits a while until it's subscribed,
+// so the events of the calls made to chaos-daemon afterwards are received. The nodes watched before
+// the watcher is started are opened at start.
+func (w *Watcher) Watch(ctx context.Context, node string) {
+	if w == nil || node == "" {
+		return
+	}
+
+	s, started := w.add(node)
+	if !started {
+		return
+	}
+
+	timer := time.NewTimer(subscribeTimeout)
+	defer timer.Stop()
+	select {
+	case <-s.subscribed:
+	case <-timer.C:
+		log.Info("status stream of chaos-daemon isn't subscribed in time", "node", node)
+	case <-ctx.Done():
+	}
+}
+
+// add registers the stream of the node, it returns whether the watcher has been started
+func (w *Watcher) add(node string) (*nodeStream, bool) {
+	w.Lock()
+	defer w.Unlock()
+
+	s, ok := w.streams[node]
+	if !ok {
+		s = &nodeStream{subscribed: make(chan struct{})}
+		w.streams[node] = s
+		if w.ctx != nil {
+			go w.run(w.ctx, node, s)
+		}
+	}
+	return s, w.ctx != nil
+}
+
+func (w *Watcher) remove(node string) {
+	w.Lock()
+	defer w.Unlock()
+
+	if s, ok := w.streams[node]; ok {
+		s.markSubscribed()
+		delete(w.streams, node)
+	}
+}
+
+// Start keeps the streams until stop is closed, it implements manager.Runnable
+func (w *Watcher) Start(stop <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w.Lock()
+	w.ctx = ctx
+	for node, s := range w.streams {
+		go w.run(ctx, node, s)
+	}
+	w.Unlock()
+
+	if w.resume != nil {
+		nodes, err := w.resume(ctx)
+		if err != nil {
+			log.Error(err, "failed to find the nodes of running experiments")
+		}
+		for _, node := range nodes {
+			w.add(node)
+		}
+	}
+
+	<-stop
+	return nil
+}
+
+// run keeps the stream of the node until ctx is done or the node is gone
+func (w *Watcher) run(ctx context.Context, node string, s *nodeStream) {
+	interval := minRetryInterval
+	for {
+		received, err := w.receive(ctx, node, s)
+		if ctx.Err() != nil {
+			return
+		}
+		if k8serror.IsNotFound(err) {
+			log.Info("node is gone, stop watching its chaos-daemon", "node", node)
+			w.remove(node)
+			return
+		}
+
+		if received {
+			interval = minRetryInterval
+		}
+		log.Error(err, "status stream of chaos-daemon is broken, reopen it later", "node", node, "interval", interval)
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		if interval *= 2; interval > maxRetryInterval {
+			interval = maxRetryInterval
+		}
+	}
+}
+
+// receive handles the events of the stream until it's broken, it returns whether any event is received
+func (w *Watcher) receive(ctx context.Context, node string, s *nodeStream) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	client, err := w.dial(ctx, node)
+	if err != nil {
+		return false, err
+	}
+	defer client.Close()
+
+	stream, err := client.WatchStatus(ctx, &pb.WatchStatusRequest{})
+	if err != nil {
+		return false, err
+	}
+	if _, err := stream.Header(); err != nil {
+		return false, err
+	}
+	s.markSubscribed()
+
+	received := false
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+		received = true
+
+		w.Progress.Record(event)
+		if w.handle != nil {
+			w.handle(ctx, event)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experimentlog
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// Level is the level of a log entry
+type Level string
+
+const (
+	// LevelInfo is the level of the entries written by Info
+	LevelInfo Level = "info"
+	// LevelError is the level of the entries written by Error
+	LevelError Level = "error"
+)
+
+// Experiment identifies the experiment which the log entries belong to
+type Experiment struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+}
+
+// Entry is a log line of an experiment
+type Entry struct {
+	Time    time.Time         `json:"time"`
+	Level   Level             `json:"level"`
+	Logger  string            `json:"logger,omitempty"`
+	Message string            `json:"message"`
+	Error   string            `json:"error,omitempty"`
+	Values  map[string]string `json:"values,omitempty"`
+}
+
+// ring keeps the latest entries of an experiment
+type ring struct {
+	experiment Experiment
+	entries    []Entry
+	// next is the index which the next entry is written to
+	next int
+	full bool
+}
+
+func (r *ring) add(entry Entry) {
+	r.entries[r.next] = entry
+	r.next = (r.next + 1) % len(r.entries)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+func (r *ring) list() []Entry {
+	if !r.full {
+		return append([]Entry(nil), r.entries[:r.next]...)
+	}
+
+	entries := make([]Entry, 0, len(r.entries))
+	entries = append(entries, r.entries[r.next:]...)
+	return append(entries, r.entries[:r.next]...)
+}
+
+// Store keeps the latest log entries of the latest experiments in memory.
+// The experiment which is logged least recently is evicted when the store is full.
+type Store struct {
+	sync.Mutex
+
+	maxExperiments int
+	maxEntries     int
+
+	// order is the experiments ordered by the time they are logged, the front is the latest
+	order *list.List
+	rings map[string]*list.Element
+}
+
+// NewStore creates a Store which keeps at most maxEntries entries for each of at most maxExperiments experiments
+func NewStore(maxExperiments int, maxEntries int) *Store {
+	if maxExperiments <= 0 {
+		maxExperiments = 1
+	}
+	if maxEntries <= 0 {
+		maxEntries = 1
+	}
+
+	return &Store{
+		maxExperiments: maxExperiments,
+		maxEntries:     maxEntries,
+		order:          list.New(),
+		rings:          make(map[string]*list.Element),
+	}
+}
+
+// Add appends the entry to the logs of the experiment
+func (s *Store) Add(experiment Experiment, entry Entry) {
+	if s == nil {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	elem, ok := s.rings[experiment.UID]
+	if ok {
+		s.order.MoveToFront(elem)
+	} else {
+		elem = s.order.PushFront(&ring{
+			experiment: experiment,
+			entries:    make([]Entry, s.maxEntries),
+		})
+		s.rings[experiment.UID] = elem
+
+		for s.order.Len() > s.maxExperiments {
+			oldest := s.order.Back()
+			s.order.Remove(oldest)
+			delete(s.rings, oldest.Value.(*ring).experiment.UID)
+		}
+	}
+
+	elem.Value.(*ring).add(entry)
+}
+
+// Get returns the log entries of the experiment with the uid, ordered by time
+func (s *Store) Get(uid string) (Experiment, []Entry, bool) {
+	if s == nil {
+		return Experiment{}, nil, false
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	elem, ok := s.rings[uid]
+	if !ok {
+		return Experiment{}, nil, false
+	}
+
+	r := elem.Value.(*ring)
+	return r.experiment, r.list(), true
+}
+
+// Find returns the uids of the experiments with the kind, namespace and name.
+// There could be multiple experiments if an experiment is deleted and created again.
+func (s *Store) Find(kind string, namespace string, name string) []string {
+	if s == nil {
+		return nil
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	var uids []string
+	for elem := s.order.Front(); elem != nil; elem = elem.Next() {
+		experiment := elem.Value.(*ring).experiment
+		if (kind == "" || experiment.Kind == kind) && experiment.Namespace == namespace && experiment.Name == name {
+			uids = append(uids, experiment.UID)
+		}
+	}
+	return uids
+}
+
+// logger writes every log line to both the underlying logger and the store
+type logger struct {
+	base       logr.Logger
+	store      *Store
+	experiment Experiment
+	name       string
+	values     []interface{}
+}
+
+// NewLogger returns a logger which attaches the kind, namespace, name and uid of the experiment
+// to every log line, and keeps the log lines in the store as well
+func NewLogger(base logr.Logger, store *Store, experiment Experiment) logr.Logger {
+	return &logger{
+		base: base.WithValues(
+			"chaosKind", experiment.Kind,
+			"chaosNamespace", experiment.Namespace,
+			"chaosName", experiment.Name,
+			"chaosUID", experiment.UID,
+		),
+		store:      store,
+		experiment: experiment,
+	}
+}
+
+func (l *logger) Info(msg string, keysAndValues ...interface{}) {
+	l.base.Info(msg, keysAndValues...)
+	l.add(LevelInfo, msg, nil, keysAndValues)
+}
+
+func (l *logger) Enabled() bool {
+	return l.base.Enabled()
+}
+
+func (l *logger) Error(err error, msg string, keysAndValues ...interface{}) {
+	l.base.Error(err, msg, keysAndValues...)
+	l.add(LevelError, msg, err, keysAndValues)
+}
+
+// V returns the underlying verbose logger, the verbose log lines are not kept in the store
+func (l *logger) V(level int) logr.InfoLogger {
+	return l.base.V(level)
+}
+
+func (l *logger) WithValues(keysAndValues ...interface{}) logr.Logger {
+	return &logger{
+		base:       l.base.WithValues(keysAndValues...),
+		store:      l.store,
+		experiment: l.experiment,
+		name:       l.name,
+		values:     append(append([]interface{}(nil), l.values...), keysAndValues...),
+	}
+}
+
+func (l *logger) WithName(name string) logr.Logger {
+	fullName := name
+	if l.name != "" {
+		fullName = l.name + "." + name
+	}
+
+	return &logger{
+		base:       l.base.WithName(name),
+		store:      l.store,
+		experiment: l.experiment,
+		name:       fullName,
+		values:     l.values,
+	}
+}
+
+func (l *logger) add(level Level, msg string, err error, keysAndValues []interface{}) {
+	entry := Entry{
+		Time:    time.Now(),
+		Level:   level,
+		Logger:  l.name,
+		Message: msg,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	values := append(append([]interface{}(nil), l.values...), keysAndValues...)
+	if len(values) > 0 {
+		entry.Values = make(map[string]string, (len(values)+1)/2)
+		for i := 0; i < len(values); i += 2 {
+			key := fmt.Sprint(values[i])
+			if i+1 < len(values) {
+				entry.Values[key] = fmt.Sprint(values[i+1])
+			} else {
+				entry.Values[key] = ""
+			}
+		}
+	}
+
+	l.store.Add(l.experiment, entry)
+}
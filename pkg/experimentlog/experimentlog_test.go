@@ -0,0 +1,101 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experimentlog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	logrtesting "github.com/go-logr/logr/testing"
+	. "github.com/onsi/gomega"
+)
+
+func TestLogger(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	store := NewStore(10, 10)
+	experiment := Experiment{Kind: "PodChaos", Namespace: "default", Name: "pod-kill", UID: "uid-1"}
+	log := NewLogger(logrtesting.NullLogger{}, store, experiment).WithName("podkill").WithValues("action", "pod-kill")
+
+	log.Info("Performing Action", "pods", 2)
+	log.Error(errors.New("timeout"), "failed to apply chaos action")
+	log.V(1).Info("verbose")
+
+	got, entries, ok := store.Get("uid-1")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(got).To(Equal(experiment))
+	g.Expect(entries).To(HaveLen(2))
+	g.Expect(entries[0].Level).To(Equal(LevelInfo))
+	g.Expect(entries[0].Logger).To(Equal("podkill"))
+	g.Expect(entries[0].Message).To(Equal("Performing Action"))
+	g.Expect(entries[0].Values).To(Equal(map[string]string{"action": "pod-kill", "pods": "2"}))
+	g.Expect(entries[1].Level).To(Equal(LevelError))
+	g.Expect(entries[1].Error).To(Equal("timeout"))
+}
+
+func TestStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	store := NewStore(2, 3)
+	for i := 0; i < 5; i++ {
+		store.Add(Experiment{Namespace: "default", Name: "a", UID: "a"}, Entry{Message: fmt.Sprint(i)})
+	}
+
+	_, entries, ok := store.Get("a")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(entries).To(HaveLen(3))
+	g.Expect(entries[0].Message).To(Equal("2"))
+	g.Expect(entries[2].Message).To(Equal("4"))
+
+	store.Add(Experiment{Namespace: "default", Name: "b", UID: "b"}, Entry{Message: "b"})
+	store.Add(Experiment{Namespace: "default", Name: "a", UID: "a"}, Entry{Message: "5"})
+	store.Add(Experiment{Namespace: "default", Name: "c", UID: "c"}, Entry{Message: "c"})
+
+	// b is the experiment logged least recently
+	_, _, ok = store.Get("b")
+	g.Expect(ok).To(BeFalse())
+	g.Expect(store.Find("", "default", "a")).To(Equal([]string{"a"}))
+	g.Expect(store.Find("", "default", "c")).To(Equal([]string{"c"}))
+}
+
+func TestHandler(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	store := NewStore(10, 10)
+	store.Add(Experiment{Kind: "PodChaos", Namespace: "default", Name: "a", UID: "a"}, Entry{Message: "a"})
+	handler := Handler(store)
+
+	for _, url := range []string{"/?uid=a", "/?namespace=default&name=a", "/?kind=PodChaos&namespace=default&name=a"} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		g.Expect(w.Code).To(Equal(http.StatusOK))
+
+		logs := &Logs{}
+		g.Expect(json.Unmarshal(w.Body.Bytes(), logs)).To(Succeed())
+		g.Expect(logs.Experiment.UID).To(Equal("a"))
+		g.Expect(logs.Entries).To(HaveLen(1))
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?uid=b", nil))
+	g.Expect(w.Code).To(Equal(http.StatusNotFound))
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?namespace=default", nil))
+	g.Expect(w.Code).To(Equal(http.StatusBadRequest))
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experimentlog
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Logs is the response of the log retrieval API
+type Logs struct {
+	Experiment Experiment `json:"experiment"`
+	Entries    []Entry    `json:"entries"`
+}
+
+// Handler serves the log entries of an experiment in the store. The experiment is
+// specified by the `uid` query parameter, or the `namespace`, `name` and the optional
+// `kind` query parameters, in which case the logs of the latest experiment are returned.
+func Handler(store *Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		uid := query.Get("uid")
+		if uid == "" {
+			namespace, name := query.Get("namespace"), query.Get("name")
+			if namespace == "" || name == "" {
+				http.Error(w, "uid or namespace and name must be specified", http.StatusBadRequest)
+				return
+			}
+
+			uids := store.Find(query.Get("kind"), namespace, name)
+			if len(uids) == 0 {
+				http.Error(w, "no logs of the experiment", http.StatusNotFound)
+				return
+			}
+			uid = uids[0]
+		}
+
+		experiment, entries, ok := store.Get(uid)
+		if !ok {
+			http.Error(w, "no logs of the experiment", http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(&Logs{
+			Experiment: experiment,
+			Entries:    entries,
+		}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,212 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impact
+
+import (
+	"context"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// Estimate estimates the impact of chaos on the selected pods before it's injected: the fraction of
+// each deployment which is affected, the PodDisruptionBudgets which would be violated if the pods are
+// killed, and the services whose only ready endpoint is one of the pods. The disruption budgets are
+// only checked if podKill is true, since the other chaos doesn't evict the pods.
+func Estimate(ctx context.Context, c client.Reader, pods []v1.Pod, podKill bool) (*v1alpha1.ImpactStatus, error) {
+	impact := &v1alpha1.ImpactStatus{Pods: len(pods)}
+	if len(pods) == 0 {
+		return impact, nil
+	}
+
+	deployments, err := deploymentImpacts(ctx, c, pods)
+	if err != nil {
+		return nil, err
+	}
+	impact.Deployments = deployments
+
+	if podKill {
+		budgets, err := violatedDisruptionBudgets(ctx, c, pods)
+		if err != nil {
+			return nil, err
+		}
+		impact.ViolatedDisruptionBudgets = budgets
+	}
+
+	services, err := singleEndpointServices(ctx, c, pods)
+	if err != nil {
+		return nil, err
+	}
+	impact.SingleEndpointServices = services
+
+	return impact, nil
+}
+
+// deploymentImpacts groups the pods by the deployments which own their replica sets
+func deploymentImpacts(ctx context.Context, c client.Reader, pods []v1.Pod) ([]v1alpha1.DeploymentImpact, error) {
+	affected := make(map[types.NamespacedName]int)
+	// the replica sets are shared by the pods, so each of them is read once
+	owners := make(map[types.NamespacedName]*metav1.OwnerReference)
+	for _, pod := range pods {
+		ref := metav1.GetControllerOf(&pod)
+		if ref == nil || ref.Kind != "ReplicaSet" {
+			continue
+		}
+
+		key := types.NamespacedName{Namespace: pod.Namespace, Name: ref.Name}
+		owner, ok := owners[key]
+		if !ok {
+			var rs appsv1.ReplicaSet
+			if err := c.Get(ctx, key, &rs); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return nil, err
+				}
+			} else {
+				owner = metav1.GetControllerOf(&rs)
+			}
+			owners[key] = owner
+		}
+		if owner == nil || owner.Kind != "Deployment" {
+			continue
+		}
+		affected[types.NamespacedName{Namespace: pod.Namespace, Name: owner.Name}]++
+	}
+
+	impacts := make([]v1alpha1.DeploymentImpact, 0, len(affected))
+	for key, count := range affected {
+		var deployment appsv1.Deployment
+		if err := c.Get(ctx, key, &deployment); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		replicas := int32(1)
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+		impact := v1alpha1.DeploymentImpact{
+			Namespace: key.Namespace,
+			Name:      key.Name,
+			Affected:  count,
+			Replicas:  replicas,
+		}
+		if replicas > 0 {
+			impact.Percent = count * 100 / int(replicas)
+		}
+		impacts = append(impacts, impact)
+	}
+	sort.Slice(impacts, func(i, j int) bool {
+		if impacts[i].Namespace != impacts[j].Namespace {
+			return impacts[i].Namespace < impacts[j].Namespace
+		}
+		return impacts[i].Name < impacts[j].Name
+	})
+	if len(impacts) == 0 {
+		return nil, nil
+	}
+	return impacts, nil
+}
+
+// violatedDisruptionBudgets returns the disruption budgets which allow fewer disruptions than the
+// healthy pods which are killed
+func violatedDisruptionBudgets(ctx context.Context, c client.Reader, pods []v1.Pod) ([]string, error) {
+	var violated []string
+	for namespace, pods := range byNamespace(pods) {
+		var budgets policyv1beta1.PodDisruptionBudgetList
+		if err := c.List(ctx, &budgets, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+
+		for _, budget := range budgets.Items {
+			selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+			if err != nil || selector.Empty() {
+				continue
+			}
+
+			disrupted := int32(0)
+			for _, pod := range pods {
+				if isPodReady(pod) && selector.Matches(labels.Set(pod.Labels)) {
+					disrupted++
+				}
+			}
+			if disrupted > 0 && disrupted > budget.Status.PodDisruptionsAllowed {
+				violated = append(violated, namespace+"/"+budget.Name)
+			}
+		}
+	}
+	sort.Strings(violated)
+	return violated, nil
+}
+
+// singleEndpointServices returns the services whose only ready endpoint is one of the pods
+func singleEndpointServices(ctx context.Context, c client.Reader, pods []v1.Pod) ([]string, error) {
+	var services []string
+	for namespace, pods := range byNamespace(pods) {
+		selected := make(map[string]bool, len(pods))
+		for _, pod := range pods {
+			selected[pod.Name] = true
+		}
+
+		var endpoints v1.EndpointsList
+		if err := c.List(ctx, &endpoints, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+
+		for _, ep := range endpoints.Items {
+			var addresses []v1.EndpointAddress
+			for _, subset := range ep.Subsets {
+				addresses = append(addresses, subset.Addresses...)
+			}
+			if len(addresses) != 1 {
+				continue
+			}
+			if ref := addresses[0].TargetRef; ref != nil && ref.Kind == "Pod" && selected[ref.Name] {
+				services = append(services, namespace+"/"+ep.Name)
+			}
+		}
+	}
+	sort.Strings(services)
+	return services, nil
+}
+
+func byNamespace(pods []v1.Pod) map[string][]v1.Pod {
+	namespaces := make(map[string][]v1.Pod)
+	for _, pod := range pods {
+		namespaces[pod.Namespace] = append(namespaces[pod.Namespace], pod)
+	}
+	return namespaces
+}
+
+func isPodReady(pod v1.Pod) bool {
+	if pod.DeletionTimestamp != nil {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impact
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func controllerRef(kind, name string) []metav1.OwnerReference {
+	controller := true
+	return []metav1.OwnerReference{{Kind: kind, Name: name, Controller: &controller}}
+}
+
+func newPod(name string, owner string) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "shop",
+			Name:            name,
+			Labels:          map[string]string{"app": "web"},
+			OwnerReferences: controllerRef("ReplicaSet", owner),
+		},
+		Status: v1.PodStatus{
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}},
+		},
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	replicas := int32(4)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+	}
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web-1", OwnerReferences: controllerRef("Deployment", "web")},
+	}
+	budget := &policyv1beta1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web"},
+		Spec: policyv1beta1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+		Status: policyv1beta1.PodDisruptionBudgetStatus{PodDisruptionsAllowed: 1},
+	}
+	single := &v1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "admin"},
+		Subsets: []v1.EndpointSubset{{
+			Addresses: []v1.EndpointAddress{{IP: "10.0.0.1", TargetRef: &v1.ObjectReference{Kind: "Pod", Name: "web-1-a"}}},
+		}},
+	}
+	multiple := &v1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web"},
+		Subsets: []v1.EndpointSubset{{
+			Addresses: []v1.EndpointAddress{
+				{IP: "10.0.0.1", TargetRef: &v1.ObjectReference{Kind: "Pod", Name: "web-1-a"}},
+				{IP: "10.0.0.2", TargetRef: &v1.ObjectReference{Kind: "Pod", Name: "web-1-b"}},
+			},
+		}},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	c := fake.NewFakeClientWithScheme(scheme, deployment, rs, budget, single, multiple)
+
+	pods := []v1.Pod{newPod("web-1-a", "web-1"), newPod("web-1-b", "web-1"), newPod("orphan", "missing")}
+	impact, err := Estimate(context.TODO(), c, pods, true)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(impact.Pods).Should(Equal(3))
+	g.Expect(impact.Deployments).Should(Equal([]v1alpha1.DeploymentImpact{
+		{Namespace: "shop", Name: "web", Affected: 2, Replicas: 4, Percent: 50},
+	}))
+	g.Expect(impact.ViolatedDisruptionBudgets).Should(Equal([]string{"shop/web"}))
+	g.Expect(impact.SingleEndpointServices).Should(Equal([]string{"shop/admin"}))
+
+	// the disruption budgets are only checked for pod-kill
+	impact, err = Estimate(context.TODO(), c, pods, false)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(impact.ViolatedDisruptionBudgets).Should(BeEmpty())
+
+	// the budget allows killing a single pod
+	impact, err = Estimate(context.TODO(), c, pods[1:2], true)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(impact.ViolatedDisruptionBudgets).Should(BeEmpty())
+	g.Expect(impact.SingleEndpointServices).Should(BeEmpty())
+
+	impact, err = Estimate(context.TODO(), c, nil, true)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(impact).Should(Equal(&v1alpha1.ImpactStatus{}))
+}
@@ -0,0 +1,82 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadgen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// healthCheckMethod is the default method of the gRPC load
+const healthCheckMethod = "/grpc.health.v1.Health/Check"
+
+type grpcRequester struct {
+	conn     *grpc.ClientConn
+	method   string
+	metadata metadata.MD
+}
+
+func newGRPCRequester(spec *v1alpha1.LoadSpec) (*grpcRequester, error) {
+	if spec.Target == "" {
+		return nil, fmt.Errorf("target is required")
+	}
+	// the connection is established in the background, so the target which is unavailable
+	// during the chaos is measured by the errors of the calls
+	conn, err := grpc.Dial(spec.Target, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	method := spec.Method
+	if method == "" {
+		method = healthCheckMethod
+	}
+	return &grpcRequester{
+		conn:     conn,
+		method:   method,
+		metadata: metadata.New(spec.Headers),
+	}, nil
+}
+
+func (r *grpcRequester) do(ctx context.Context) string {
+	ctx = metadata.NewOutgoingContext(ctx, r.metadata)
+	if r.method == healthCheckMethod {
+		resp := &healthpb.HealthCheckResponse{}
+		if err := r.conn.Invoke(ctx, r.method, &healthpb.HealthCheckRequest{}, resp); err != nil {
+			return status.Code(err).String()
+		}
+		if resp.Status != healthpb.HealthCheckResponse_SERVING {
+			return resp.Status.String()
+		}
+		return ""
+	}
+
+	// the fields of the response are kept as the unknown fields of the empty message
+	if err := r.conn.Invoke(ctx, r.method, &empty.Empty{}, &empty.Empty{}); err != nil {
+		return status.Code(err).String()
+	}
+	return ""
+}
+
+func (r *grpcRequester) close() {
+	r.conn.Close()
+}
@@ -0,0 +1,109 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadgen
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+const (
+	// codeTimeout is the error code of the requests which time out
+	codeTimeout = "Timeout"
+	// codeConnection is the error code of the HTTP requests which fail to connect or to read the response
+	codeConnection = "ConnectionError"
+	// maxIdleConns is the max number of the idle connections kept to the target
+	maxIdleConns = 100
+)
+
+type httpRequester struct {
+	client  *http.Client
+	method  string
+	url     string
+	headers map[string]string
+	body    string
+}
+
+func newHTTPRequester(spec *v1alpha1.LoadSpec) (*httpRequester, error) {
+	u, err := url.Parse(spec.Target)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("target %s must be a http or https url", spec.Target)
+	}
+
+	method := spec.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = maxIdleConns
+	transport.MaxIdleConnsPerHost = maxIdleConns
+	return &httpRequester{
+		client:  &http.Client{Transport: transport},
+		method:  method,
+		url:     spec.Target,
+		headers: spec.Headers,
+		body:    spec.Body,
+	}, nil
+}
+
+func (r *httpRequester) do(ctx context.Context) string {
+	var body io.Reader
+	if r.body != "" {
+		body = strings.NewReader(r.body)
+	}
+	req, err := http.NewRequest(r.method, r.url, body)
+	if err != nil {
+		return codeConnection
+	}
+	for key, value := range r.headers {
+		if strings.EqualFold(key, "Host") {
+			req.Host = value
+			continue
+		}
+		req.Header.Set(key, value)
+	}
+
+	resp, err := r.client.Do(req.WithContext(ctx))
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return codeTimeout
+		}
+		return codeConnection
+	}
+	defer resp.Body.Close()
+	if _, err := io.Copy(ioutil.Discard, resp.Body); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return codeTimeout
+		}
+		return codeConnection
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Sprintf("HTTP %d", resp.StatusCode)
+	}
+	return ""
+}
+
+func (r *httpRequester) close() {
+	r.client.CloseIdleConnections()
+}
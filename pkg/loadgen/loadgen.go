@@ -0,0 +1,235 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadgen
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// maxSamples is the max number of the latencies which are sampled for the percentiles
+const maxSamples = 10000
+
+// buckets are the upper bounds of the latency histogram, the last bucket is +Inf
+var buckets = []time.Duration{
+	time.Millisecond,
+	2500 * time.Microsecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// requester sends a request to the target, it returns the error code of the request or an empty
+// string if the request succeeded
+type requester interface {
+	do(ctx context.Context) string
+	close()
+}
+
+// Generator generates the load in the background
+type Generator struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+	result *v1alpha1.LoadResult
+}
+
+// Start starts to generate the load in the background until the generator is stopped or the
+// duration of the load elapses
+func Start(spec *v1alpha1.LoadSpec) *Generator {
+	ctx, cancel := context.WithCancel(context.Background())
+	g := &Generator{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(g.done)
+		g.result = Run(ctx, spec)
+	}()
+	return g
+}
+
+// Stop stops the load and returns its measurement
+func (g *Generator) Stop() *v1alpha1.LoadResult {
+	g.cancel()
+	<-g.done
+	return g.result
+}
+
+// Run generates the load until ctx is done or the duration of the load elapses, and returns the
+// measurement of the requests. The requests which are cancelled when the load stops aren't counted.
+func Run(ctx context.Context, spec *v1alpha1.LoadSpec) *v1alpha1.LoadResult {
+	result := &v1alpha1.LoadResult{Target: spec.Target}
+
+	timeout, err := spec.GetTimeout()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	duration, err := spec.GetDuration()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if spec.QPS <= 0 {
+		result.Error = fmt.Sprintf("qps %d must be greater than 0", spec.QPS)
+		return result
+	}
+
+	r, err := newRequester(spec)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer r.close()
+
+	if duration != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *duration)
+		defer cancel()
+	}
+
+	s := newStats()
+	var wg sync.WaitGroup
+	ticker := time.NewTicker(time.Second / time.Duration(spec.QPS))
+	defer ticker.Stop()
+	for done := false; !done; {
+		select {
+		case <-ctx.Done():
+			done = true
+		case <-ticker.C:
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				reqCtx, cancel := context.WithTimeout(ctx, timeout)
+				defer cancel()
+
+				start := time.Now()
+				code := r.do(reqCtx)
+				if code != "" && ctx.Err() != nil {
+					// the request is cancelled by the end of the load
+					return
+				}
+				s.observe(time.Since(start), code)
+			}()
+		}
+	}
+	wg.Wait()
+
+	s.fill(result)
+	return result
+}
+
+func newRequester(spec *v1alpha1.LoadSpec) (requester, error) {
+	switch spec.GetProtocol() {
+	case v1alpha1.LoadProtocolHTTP:
+		return newHTTPRequester(spec)
+	case v1alpha1.LoadProtocolGRPC:
+		return newGRPCRequester(spec)
+	default:
+		return nil, fmt.Errorf("protocol %s isn't supported", spec.Protocol)
+	}
+}
+
+// stats collects the latencies and the errors of the requests
+type stats struct {
+	sync.Mutex
+
+	requests int64
+	errors   int64
+	codes    map[string]int64
+	counts   []int64
+	max      time.Duration
+	// samples are the latencies which are sampled by reservoir sampling
+	samples []time.Duration
+	random  *rand.Rand
+}
+
+func newStats() *stats {
+	return &stats{
+		codes:  make(map[string]int64),
+		counts: make([]int64, len(buckets)+1),
+		random: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (s *stats) observe(latency time.Duration, code string) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.requests++
+	if code != "" {
+		s.errors++
+		s.codes[code]++
+	}
+	s.counts[sort.Search(len(buckets), func(i int) bool { return latency <= buckets[i] })]++
+	if latency > s.max {
+		s.max = latency
+	}
+	if len(s.samples) < maxSamples {
+		s.samples = append(s.samples, latency)
+	} else if i := s.random.Int63n(s.requests); i < maxSamples {
+		s.samples[i] = latency
+	}
+}
+
+func (s *stats) fill(result *v1alpha1.LoadResult) {
+	s.Lock()
+	defer s.Unlock()
+
+	result.Requests = s.requests
+	result.Errors = s.errors
+	if s.requests == 0 {
+		return
+	}
+	result.ErrorRatio = fmt.Sprintf("%.2f", float64(s.errors)/float64(s.requests)*100)
+	if len(s.codes) > 0 {
+		result.ErrorCodes = s.codes
+	}
+
+	sort.Slice(s.samples, func(i, j int) bool { return s.samples[i] < s.samples[j] })
+	percentile := func(p float64) string {
+		return formatLatency(s.samples[int(p*float64(len(s.samples)-1))])
+	}
+	result.P50 = percentile(0.5)
+	result.P90 = percentile(0.9)
+	result.P99 = percentile(0.99)
+	result.Max = formatLatency(s.max)
+
+	result.Latency = make([]v1alpha1.HistogramBucket, 0, len(s.counts))
+	for i, count := range s.counts {
+		le := "+Inf"
+		if i < len(buckets) {
+			le = buckets[i].String()
+		}
+		result.Latency = append(result.Latency, v1alpha1.HistogramBucket{LE: le, Count: count})
+	}
+}
+
+func formatLatency(latency time.Duration) string {
+	return latency.Round(time.Microsecond).String()
+}
@@ -0,0 +1,127 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadgen
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestRunHTTP(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var count int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodPost))
+		g.Expect(r.Header.Get("X-Chaos")).To(Equal("load"))
+		// every fourth request fails
+		if atomic.AddInt64(&count, 1)%4 == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	duration := "500ms"
+	result := Run(context.TODO(), &v1alpha1.LoadSpec{
+		Target:   server.URL,
+		Method:   http.MethodPost,
+		Headers:  map[string]string{"X-Chaos": "load"},
+		Body:     "{}",
+		QPS:      100,
+		Duration: &duration,
+	})
+	g.Expect(result.Error).To(BeEmpty())
+	g.Expect(result.Target).To(Equal(server.URL))
+	g.Expect(result.Requests).To(BeNumerically(">", 10))
+	// the last request may be cancelled by the end of the load
+	g.Expect(result.Requests).To(BeNumerically("~", atomic.LoadInt64(&count), 1))
+	g.Expect(result.Errors).To(BeNumerically("~", result.Requests/4, 1))
+	g.Expect(result.ErrorCodes).To(Equal(map[string]int64{"HTTP 503": result.Errors}))
+	g.Expect(result.P50).ToNot(BeEmpty())
+	g.Expect(result.Max).ToNot(BeEmpty())
+
+	g.Expect(result.Latency).To(HaveLen(len(buckets) + 1))
+	g.Expect(result.Latency[len(buckets)].LE).To(Equal("+Inf"))
+	var total int64
+	for _, bucket := range result.Latency {
+		total += bucket.Count
+	}
+	g.Expect(total).To(Equal(result.Requests))
+}
+
+func TestRunHTTPTimeout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	duration := "500ms"
+	timeout := "10ms"
+	result := Run(context.TODO(), &v1alpha1.LoadSpec{Target: server.URL, QPS: 20, Duration: &duration, Timeout: &timeout})
+	g.Expect(result.Requests).To(BeNumerically(">", 0))
+	g.Expect(result.Errors).To(Equal(result.Requests))
+	g.Expect(result.ErrorRatio).To(Equal("100.00"))
+	g.Expect(result.ErrorCodes).To(Equal(map[string]int64{"Timeout": result.Errors}))
+
+	result = Run(context.TODO(), &v1alpha1.LoadSpec{Target: "checkout:8080", QPS: 20})
+	g.Expect(result.Error).To(Equal("target checkout:8080 must be a http or https url"))
+}
+
+func TestRunGRPC(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ToNot(HaveOccurred())
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	spec := &v1alpha1.LoadSpec{Protocol: v1alpha1.LoadProtocolGRPC, Target: lis.Addr().String(), QPS: 50}
+	generator := Start(spec)
+	time.Sleep(300 * time.Millisecond)
+	result := generator.Stop()
+	g.Expect(result.Error).To(BeEmpty())
+	g.Expect(result.Requests).To(BeNumerically(">", 0))
+	g.Expect(result.Errors).To(BeZero())
+	g.Expect(result.ErrorCodes).To(BeNil())
+
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	duration := "300ms"
+	spec.Duration = &duration
+	result = Run(context.TODO(), spec)
+	g.Expect(result.Errors).To(Equal(result.Requests))
+	g.Expect(result.ErrorCodes).To(HaveKey("NOT_SERVING"))
+
+	// the other methods are called with an empty request
+	spec.Method = "/shop.Checkout/Status"
+	result = Run(context.TODO(), spec)
+	g.Expect(result.Errors).To(Equal(result.Requests))
+	g.Expect(result.ErrorCodes).To(HaveKey("Unimplemented"))
+}
@@ -0,0 +1,197 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+const (
+	// DefaultPagerDutyURL is the endpoint of the Events API v2 of PagerDuty
+	DefaultPagerDutyURL = "https://events.pagerduty.com/v2/enqueue"
+	// DefaultOpsgenieURL is the endpoint of the Alert API of Opsgenie, the accounts in the EU
+	// region should use "https://api.eu.opsgenie.com" instead
+	DefaultOpsgenieURL = "https://api.opsgenie.com"
+
+	// incidentSource is the source of the incidents opened by Chaos Mesh
+	incidentSource = "chaos-mesh"
+	// opsgenieMaxMessageLength is the max length of the message of Opsgenie alerts
+	opsgenieMaxMessageLength = 130
+)
+
+// incidentAction is the action of the incident receivers on an event
+type incidentAction string
+
+const (
+	incidentTrigger incidentAction = "trigger"
+	incidentResolve incidentAction = "resolve"
+)
+
+// incidentActionOf returns the action of the incident receivers on the event. The incidents are
+// opened when the faults are left in the targets, and they're resolved once the chaos is recovered.
+// The residual faults are never resolved automatically, since no one recovers them.
+func incidentActionOf(event v1alpha1.NotificationEvent) (incidentAction, bool) {
+	switch event {
+	case v1alpha1.NotificationEventRecoveryFailed, v1alpha1.NotificationEventResidualFaults:
+		return incidentTrigger, true
+	case v1alpha1.NotificationEventRecovered:
+		return incidentResolve, true
+	default:
+		return "", false
+	}
+}
+
+// isIncidentReceiver returns true if the receiver opens incidents instead of sending messages
+func isIncidentReceiver(receiverType v1alpha1.ReceiverType) bool {
+	return receiverType == v1alpha1.PagerDutyReceiver || receiverType == v1alpha1.OpsgenieReceiver
+}
+
+// incidentKey is the key to deduplicate the incidents of the chaos, so the residual faults are
+// reported in the incident of the failed recovery, and the incident is resolved by the recovery
+func incidentKey(event *Event) string {
+	return fmt.Sprintf("%s/%s/%s/%s", incidentSource, event.Kind, event.Namespace, event.Name)
+}
+
+// pagerDutyEvent is the request of the Events API v2 of PagerDuty
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction incidentAction    `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string `json:"summary"`
+	Source        string `json:"source"`
+	Severity      string `json:"severity"`
+	Component     string `json:"component"`
+	Group         string `json:"group"`
+	Class         string `json:"class"`
+	CustomDetails *Event `json:"custom_details"`
+}
+
+func newPagerDutyRequest(endpoint string, key string, message string, event *Event) (*http.Request, error) {
+	action, _ := incidentActionOf(event.Event)
+	body := &pagerDutyEvent{
+		RoutingKey:  key,
+		EventAction: action,
+		DedupKey:    incidentKey(event),
+	}
+	if action == incidentTrigger {
+		severity := "error"
+		if event.Event == v1alpha1.NotificationEventResidualFaults {
+			severity = "critical"
+		}
+		body.Payload = &pagerDutyPayload{
+			Summary:       message,
+			Source:        incidentSource,
+			Severity:      severity,
+			Component:     fmt.Sprintf("%s/%s", event.Namespace, event.Name),
+			Group:         event.Namespace,
+			Class:         event.Kind,
+			CustomDetails: event,
+		}
+	}
+
+	if endpoint == "" {
+		endpoint = DefaultPagerDutyURL
+	}
+	return newJSONRequest(endpoint, body, nil)
+}
+
+// opsgenieAlert is the request to create an alert by the Alert API of Opsgenie
+type opsgenieAlert struct {
+	Message     string            `json:"message"`
+	Alias       string            `json:"alias"`
+	Description string            `json:"description"`
+	Priority    string            `json:"priority"`
+	Source      string            `json:"source"`
+	Tags        []string          `json:"tags"`
+	Details     map[string]string `json:"details"`
+}
+
+// opsgenieClose is the request to close an alert by the Alert API of Opsgenie
+type opsgenieClose struct {
+	Source string `json:"source"`
+	Note   string `json:"note"`
+}
+
+func newOpsgenieRequest(endpoint string, key string, message string, event *Event) (*http.Request, error) {
+	if endpoint == "" {
+		endpoint = DefaultOpsgenieURL
+	}
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	header := http.Header{}
+	header.Set("Authorization", "GenieKey "+key)
+
+	alias := incidentKey(event)
+	if action, _ := incidentActionOf(event.Event); action == incidentResolve {
+		return newJSONRequest(
+			fmt.Sprintf("%s/v2/alerts/%s/close?identifierType=alias", endpoint, url.PathEscape(alias)),
+			&opsgenieClose{Source: incidentSource, Note: message},
+			header,
+		)
+	}
+
+	priority := "P2"
+	if event.Event == v1alpha1.NotificationEventResidualFaults {
+		priority = "P1"
+	}
+	summary := message
+	if len(summary) > opsgenieMaxMessageLength {
+		summary = summary[:opsgenieMaxMessageLength]
+	}
+	return newJSONRequest(endpoint+"/v2/alerts", &opsgenieAlert{
+		Message:     summary,
+		Alias:       alias,
+		Description: message,
+		Priority:    priority,
+		Source:      incidentSource,
+		Tags:        []string{incidentSource, string(event.Event), event.Kind, event.Namespace},
+		Details: map[string]string{
+			"kind":      event.Kind,
+			"namespace": event.Namespace,
+			"name":      event.Name,
+			"phase":     string(event.Phase),
+			"reason":    event.Reason,
+		},
+	}, header)
+}
+
+// newJSONRequest creates a request which posts the body as JSON to the url
+func newJSONRequest(url string, body interface{}, header http.Header) (*http.Request, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
@@ -0,0 +1,261 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+var log = ctrl.Log.WithName("notify")
+
+const (
+	// DefaultTemplate is the template of the messages if the notification has no template
+	DefaultTemplate = `[{{ .Event }}] {{ .Kind }} {{ .Namespace }}/{{ .Name }}` +
+		`{{ if .Targets }}, {{ .Targets }} target(s){{ end }}{{ if .Reason }}: {{ .Reason }}{{ end }}`
+
+	defaultQueueSize   = 100
+	defaultSendTimeout = 10 * time.Second
+)
+
+// Event is a lifecycle event of chaos, which the templates of messages are executed with
+type Event struct {
+	Event     v1alpha1.NotificationEvent `json:"event"`
+	Kind      string                     `json:"kind"`
+	Namespace string                     `json:"namespace"`
+	Name      string                     `json:"name"`
+	Phase     v1alpha1.ExperimentPhase   `json:"phase"`
+	Reason    string                     `json:"reason,omitempty"`
+	// Targets is the number of the pods which the chaos is injected into
+	Targets int       `json:"targets"`
+	Time    time.Time `json:"time"`
+}
+
+// Render executes the template with the event, the default template is used if it's empty
+func Render(text string, event *Event) (string, error) {
+	if text == "" {
+		text = DefaultTemplate
+	}
+
+	tpl, err := template.New("notification").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, event); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Notifier sends the events of chaos to the receivers of the ChaosNotifications in the namespace
+// of chaos. The events are queued and sent in background, so the reconciles are never blocked
+// by the receivers.
+type Notifier struct {
+	reader client.Reader
+	queue  chan *Event
+	client *http.Client
+}
+
+// NewNotifier creates a Notifier which reads the ChaosNotifications and the secrets by the reader
+func NewNotifier(reader client.Reader) *Notifier {
+	return &Notifier{
+		reader: reader,
+		queue:  make(chan *Event, defaultQueueSize),
+		client: &http.Client{Timeout: defaultSendTimeout},
+	}
+}
+
+// Notify queues the event, the event is dropped if the queue is full
+func (n *Notifier) Notify(event *Event) {
+	if n == nil {
+		return
+	}
+
+	select {
+	case n.queue <- event:
+	default:
+		log.Info("the notification queue is full, dropping the event", "event", event.Event,
+			"kind", event.Kind, "namespace", event.Namespace, "name", event.Name)
+	}
+}
+
+// Start sends the queued events until stop is closed, it implements manager.Runnable
+func (n *Notifier) Start(stop <-chan struct{}) error {
+	for {
+		select {
+		case <-stop:
+			return nil
+		case event := <-n.queue:
+			if err := n.Send(context.Background(), event); err != nil {
+				log.Error(err, "failed to send notification", "event", event.Event,
+					"kind", event.Kind, "namespace", event.Namespace, "name", event.Name)
+			}
+		}
+	}
+}
+
+// Send sends the event to the receivers of all matching ChaosNotifications at once.
+// Every receiver is tried, and the last error is returned.
+func (n *Notifier) Send(ctx context.Context, event *Event) error {
+	var notifications v1alpha1.ChaosNotificationList
+	if err := n.reader.List(ctx, &notifications, client.InNamespace(event.Namespace)); err != nil {
+		return err
+	}
+
+	var lastErr error
+	for _, notification := range notifications.Items {
+		if !notification.Spec.Matches(event.Kind, event.Event) {
+			continue
+		}
+
+		for _, receiver := range notification.Spec.Receivers {
+			if err := n.sendTo(ctx, &notification, &receiver, event); err != nil {
+				log.Error(err, "failed to send notification to receiver", "notification", notification.Name,
+					"namespace", notification.Namespace, "receiver", receiver.Type)
+				lastErr = err
+			}
+		}
+	}
+	return lastErr
+}
+
+func (n *Notifier) sendTo(ctx context.Context, notification *v1alpha1.ChaosNotification,
+	receiver *v1alpha1.NotificationReceiver, event *Event) error {
+	if _, ok := incidentActionOf(event.Event); isIncidentReceiver(receiver.Type) && !ok {
+		// the incident receivers only care about the faults left in the targets
+		return nil
+	}
+
+	text := receiver.Template
+	if text == "" {
+		text = notification.Spec.Template
+	}
+	message, err := Render(text, event)
+	if err != nil {
+		return err
+	}
+
+	url, err := n.secretOr(ctx, notification.Namespace, receiver.URL, receiver.URLFrom)
+	if err != nil {
+		return err
+	}
+
+	req, err := n.newRequest(ctx, notification.Namespace, receiver, url, message, event)
+	if err != nil {
+		return err
+	}
+
+	resp, err := n.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to post notification to %s receiver, status: %s", receiver.Type, resp.Status)
+	}
+	return nil
+}
+
+// newRequest creates the request which posts the message to the url of receiver
+func (n *Notifier) newRequest(ctx context.Context, namespace string, receiver *v1alpha1.NotificationReceiver,
+	url string, message string, event *Event) (*http.Request, error) {
+	switch receiver.Type {
+	case v1alpha1.PagerDutyReceiver, v1alpha1.OpsgenieReceiver:
+		key, err := n.secretOr(ctx, namespace, "", receiver.KeyFrom)
+		if err != nil {
+			return nil, err
+		}
+		if receiver.Type == v1alpha1.PagerDutyReceiver {
+			return newPagerDutyRequest(url, key, message, event)
+		}
+		return newOpsgenieRequest(url, key, message, event)
+	default:
+		payload, err := encode(receiver.Type, message, event)
+		if err != nil {
+			return nil, err
+		}
+		return newJSONRequest(url, payload, nil)
+	}
+}
+
+// secretOr returns the value which is read from the secret in the namespace if it's referenced,
+// otherwise the value itself is returned
+func (n *Notifier) secretOr(ctx context.Context, namespace string, value string, selector *corev1.SecretKeySelector) (string, error) {
+	if selector == nil {
+		return value, nil
+	}
+
+	var secret corev1.Secret
+	key := types.NamespacedName{Namespace: namespace, Name: selector.Name}
+	if err := n.reader.Get(ctx, key, &secret); err != nil {
+		return "", err
+	}
+
+	data, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", fmt.Errorf("key %s is not found in secret %s/%s", selector.Key, namespace, selector.Name)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// teamsColors are the theme colors of the message cards of Teams for the events
+var teamsColors = map[v1alpha1.NotificationEvent]string{
+	v1alpha1.NotificationEventStarted:        "0078D7",
+	v1alpha1.NotificationEventFailed:         "D13438",
+	v1alpha1.NotificationEventAborted:        "FFB900",
+	v1alpha1.NotificationEventFinished:       "107C10",
+	v1alpha1.NotificationEventRecoveryFailed: "D13438",
+	v1alpha1.NotificationEventRecovered:      "107C10",
+	v1alpha1.NotificationEventResidualFaults: "A80000",
+}
+
+// encode encodes the message into the payload of the receiver type
+func encode(receiverType v1alpha1.ReceiverType, message string, event *Event) (interface{}, error) {
+	switch receiverType {
+	case v1alpha1.SlackReceiver:
+		return map[string]string{
+			"text": message,
+		}, nil
+	case v1alpha1.TeamsReceiver:
+		return map[string]string{
+			"@type":      "MessageCard",
+			"@context":   "https://schema.org/extensions",
+			"summary":    message,
+			"themeColor": teamsColors[event.Event],
+			"text":       message,
+		}, nil
+	case v1alpha1.WebhookReceiver:
+		return struct {
+			*Event
+			Message string `json:"message"`
+		}{event, message}, nil
+	default:
+		return nil, fmt.Errorf("unknown receiver type %s", receiverType)
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func newTestEvent(event v1alpha1.NotificationEvent) *Event {
+	return &Event{
+		Event:     event,
+		Kind:      v1alpha1.KindPodChaos,
+		Namespace: "shop",
+		Name:      "pod-kill",
+		Phase:     v1alpha1.ExperimentPhaseRunning,
+		Targets:   2,
+	}
+}
+
+// receiverServer records the payloads posted to the paths
+type receiverServer struct {
+	*httptest.Server
+	sync.Mutex
+	payloads map[string]map[string]interface{}
+}
+
+func newReceiverServer() *receiverServer {
+	s := &receiverServer{payloads: make(map[string]map[string]interface{})}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		payload := make(map[string]interface{})
+		_ = json.Unmarshal(body, &payload)
+
+		s.Lock()
+		s.payloads[r.URL.Path] = payload
+		s.Unlock()
+
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	return s
+}
+
+func (s *receiverServer) payload(path string) map[string]interface{} {
+	s.Lock()
+	defer s.Unlock()
+	return s.payloads[path]
+}
+
+func newNotifier(objs ...runtime.Object) *Notifier {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+	return NewNotifier(fake.NewFakeClientWithScheme(scheme, objs...))
+}
+
+func TestRender(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	message, err := Render("", newTestEvent(v1alpha1.NotificationEventStarted))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(message).Should(Equal("[Started] PodChaos shop/pod-kill, 2 target(s)"))
+
+	event := newTestEvent(v1alpha1.NotificationEventFailed)
+	event.Targets = 0
+	event.Reason = "no pod is selected"
+	message, err = Render("", event)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(message).Should(Equal("[Failed] PodChaos shop/pod-kill: no pod is selected"))
+
+	message, err = Render(":boom: {{ .Name }} is {{ .Phase }}", event)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(message).Should(Equal(":boom: pod-kill is Running"))
+
+	_, err = Render("{{ .Unknown }}", event)
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestSend(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := newReceiverServer()
+	defer server.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "slack"},
+		Data:       map[string][]byte{"url": []byte(server.URL + "/slack\n")},
+	}
+	all := &v1alpha1.ChaosNotification{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "all"},
+		Spec: v1alpha1.ChaosNotificationSpec{
+			Receivers: []v1alpha1.NotificationReceiver{
+				{
+					Type: v1alpha1.SlackReceiver,
+					URLFrom: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "slack"},
+						Key:                  "url",
+					},
+				},
+				{Type: v1alpha1.TeamsReceiver, URL: server.URL + "/teams", Template: "{{ .Name }} {{ .Event }}"},
+			},
+		},
+	}
+	failures := &v1alpha1.ChaosNotification{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "failures"},
+		Spec: v1alpha1.ChaosNotificationSpec{
+			Events:    []v1alpha1.NotificationEvent{v1alpha1.NotificationEventFailed},
+			Receivers: []v1alpha1.NotificationReceiver{{Type: v1alpha1.WebhookReceiver, URL: server.URL + "/webhook"}},
+		},
+	}
+	// the notifications in the other namespaces never receive the events
+	others := &v1alpha1.ChaosNotification{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "others"},
+		Spec: v1alpha1.ChaosNotificationSpec{
+			Receivers: []v1alpha1.NotificationReceiver{{Type: v1alpha1.WebhookReceiver, URL: server.URL + "/others"}},
+		},
+	}
+	n := newNotifier(secret, all, failures, others)
+
+	g.Expect(n.Send(context.TODO(), newTestEvent(v1alpha1.NotificationEventStarted))).Should(Succeed())
+	g.Expect(server.payload("/slack")).Should(HaveKeyWithValue("text", "[Started] PodChaos shop/pod-kill, 2 target(s)"))
+	g.Expect(server.payload("/teams")).Should(HaveKeyWithValue("text", "pod-kill Started"))
+	g.Expect(server.payload("/teams")).Should(HaveKeyWithValue("@type", "MessageCard"))
+	g.Expect(server.payload("/webhook")).Should(BeNil())
+	g.Expect(server.payload("/others")).Should(BeNil())
+
+	g.Expect(n.Send(context.TODO(), newTestEvent(v1alpha1.NotificationEventFailed))).Should(Succeed())
+	webhook := server.payload("/webhook")
+	g.Expect(webhook).Should(HaveKeyWithValue("event", "Failed"))
+	g.Expect(webhook).Should(HaveKeyWithValue("name", "pod-kill"))
+	g.Expect(webhook).Should(HaveKeyWithValue("message", "[Failed] PodChaos shop/pod-kill, 2 target(s)"))
+}
+
+func TestSendFailure(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := newReceiverServer()
+	defer server.Close()
+
+	notification := &v1alpha1.ChaosNotification{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "notification"},
+		Spec: v1alpha1.ChaosNotificationSpec{
+			Receivers: []v1alpha1.NotificationReceiver{
+				{Type: v1alpha1.WebhookReceiver, URL: server.URL + "/fail"},
+				{
+					Type: v1alpha1.SlackReceiver,
+					URLFrom: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "missing"},
+						Key:                  "url",
+					},
+				},
+				{Type: v1alpha1.WebhookReceiver, URL: server.URL + "/webhook"},
+			},
+		},
+	}
+	n := newNotifier(notification)
+
+	// the failed receivers don't stop the others
+	g.Expect(n.Send(context.TODO(), newTestEvent(v1alpha1.NotificationEventFinished))).ShouldNot(Succeed())
+	g.Expect(server.payload("/fail")).ShouldNot(BeNil())
+	g.Expect(server.payload("/webhook")).ShouldNot(BeNil())
+}
+
+func TestNotify(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := newReceiverServer()
+	defer server.Close()
+
+	notification := &v1alpha1.ChaosNotification{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "notification"},
+		Spec: v1alpha1.ChaosNotificationSpec{
+			Receivers: []v1alpha1.NotificationReceiver{{Type: v1alpha1.SlackReceiver, URL: server.URL + "/slack"}},
+		},
+	}
+	n := newNotifier(notification)
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		_ = n.Start(stop)
+		close(done)
+	}()
+
+	n.Notify(newTestEvent(v1alpha1.NotificationEventAborted))
+	g.Eventually(func() map[string]interface{} {
+		return server.payload("/slack")
+	}).Should(HaveKeyWithValue("text", "[Aborted] PodChaos shop/pod-kill, 2 target(s)"))
+
+	close(stop)
+	g.Eventually(done).Should(BeClosed())
+
+	// the events are dropped instead of blocking if the queue is full
+	for i := 0; i < defaultQueueSize+1; i++ {
+		n.Notify(newTestEvent(v1alpha1.NotificationEventStarted))
+	}
+	g.Expect(n.queue).Should(HaveLen(defaultQueueSize))
+
+	// the nil notifier sends nothing
+	var nilNotifier *Notifier
+	nilNotifier.Notify(newTestEvent(v1alpha1.NotificationEventStarted))
+}
+
+func TestSendIncidents(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := newReceiverServer()
+	defer server.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "incident"},
+		Data:       map[string][]byte{"pagerduty": []byte("routing-key"), "opsgenie": []byte("api-key")},
+	}
+	keyFrom := func(key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "incident"},
+			Key:                  key,
+		}
+	}
+	notification := &v1alpha1.ChaosNotification{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "incident"},
+		Spec: v1alpha1.ChaosNotificationSpec{
+			Receivers: []v1alpha1.NotificationReceiver{
+				{Type: v1alpha1.PagerDutyReceiver, URL: server.URL + "/pagerduty", KeyFrom: keyFrom("pagerduty")},
+				{Type: v1alpha1.OpsgenieReceiver, URL: server.URL, KeyFrom: keyFrom("opsgenie")},
+			},
+		},
+	}
+	n := newNotifier(secret, notification)
+
+	// the incident receivers ignore the lifecycle events
+	g.Expect(n.Send(context.TODO(), newTestEvent(v1alpha1.NotificationEventFailed))).Should(Succeed())
+	g.Expect(server.payload("/pagerduty")).Should(BeNil())
+	g.Expect(server.payload("/v2/alerts")).Should(BeNil())
+
+	event := newTestEvent(v1alpha1.NotificationEventResidualFaults)
+	event.Reason = "netem is left"
+	g.Expect(n.Send(context.TODO(), event)).Should(Succeed())
+	pagerDuty := server.payload("/pagerduty")
+	g.Expect(pagerDuty).Should(HaveKeyWithValue("routing_key", "routing-key"))
+	g.Expect(pagerDuty).Should(HaveKeyWithValue("event_action", "trigger"))
+	g.Expect(pagerDuty).Should(HaveKeyWithValue("dedup_key", "chaos-mesh/PodChaos/shop/pod-kill"))
+	g.Expect(pagerDuty["payload"]).Should(HaveKeyWithValue("severity", "critical"))
+	g.Expect(pagerDuty["payload"]).Should(HaveKeyWithValue("summary", "[ResidualFaults] PodChaos shop/pod-kill, 2 target(s): netem is left"))
+	opsgenie := server.payload("/v2/alerts")
+	g.Expect(opsgenie).Should(HaveKeyWithValue("alias", "chaos-mesh/PodChaos/shop/pod-kill"))
+	g.Expect(opsgenie).Should(HaveKeyWithValue("priority", "P1"))
+
+	g.Expect(n.Send(context.TODO(), newTestEvent(v1alpha1.NotificationEventRecovered))).Should(Succeed())
+	g.Expect(server.payload("/pagerduty")).Should(HaveKeyWithValue("event_action", "resolve"))
+	g.Expect(server.payload("/pagerduty")).ShouldNot(HaveKey("payload"))
+	g.Expect(server.payload("/v2/alerts/chaos-mesh/PodChaos/shop/pod-kill/close")).Should(HaveKeyWithValue("source", "chaos-mesh"))
+}
+
+func TestOpsgenieRequest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	event := newTestEvent(v1alpha1.NotificationEventRecoveryFailed)
+	req, err := newOpsgenieRequest("", "api-key", strings.Repeat("x", 200), event)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(req.URL.String()).Should(Equal(DefaultOpsgenieURL + "/v2/alerts"))
+	g.Expect(req.Header.Get("Authorization")).Should(Equal("GenieKey api-key"))
+
+	var alert opsgenieAlert
+	body, _ := ioutil.ReadAll(req.Body)
+	g.Expect(json.Unmarshal(body, &alert)).Should(Succeed())
+	g.Expect(alert.Message).Should(HaveLen(opsgenieMaxMessageLength))
+	g.Expect(alert.Description).Should(HaveLen(200))
+	g.Expect(alert.Priority).Should(Equal("P2"))
+
+	req, err = newOpsgenieRequest("https://api.eu.opsgenie.com/", "api-key", "", newTestEvent(v1alpha1.NotificationEventRecovered))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(req.URL.String()).Should(Equal("https://api.eu.opsgenie.com/v2/alerts/chaos-mesh%2FPodChaos%2Fshop%2Fpod-kill/close?identifierType=alias"))
+}
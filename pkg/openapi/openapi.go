@@ -0,0 +1,138 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package openapi serves the OpenAPI v3 schemas of chaos, which are generated from the Go types
+// into the CRDs by controller-gen, and validates chaos objects against them.
+package openapi
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/shurcooL/httpfs/vfsutil"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"sigs.k8s.io/yaml"
+)
+
+// Version is the version of OpenAPI which the document conforms to
+const Version = "3.0.0"
+
+// Document is an OpenAPI v3 document which only contains the schemas of chaos
+type Document struct {
+	OpenAPI    string                 `json:"openapi"`
+	Info       Info                   `json:"info"`
+	Paths      map[string]interface{} `json:"paths"`
+	Components Components             `json:"components"`
+}
+
+// Info is the metadata of the document
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// Components holds the schemas of chaos, the key is the kind of chaos
+type Components struct {
+	Schemas map[string]*apiextensionsv1beta1.JSONSchemaProps `json:"schemas"`
+}
+
+var (
+	loadOnce   sync.Once
+	schemas    map[string]*apiextensionsv1beta1.JSONSchemaProps
+	apiVersion string
+	loadErr    error
+)
+
+func load() {
+	data, err := vfsutil.ReadFile(crds, "/crd.yaml")
+	if err != nil {
+		loadErr = err
+		return
+	}
+
+	schemas = make(map[string]*apiextensionsv1beta1.JSONSchemaProps)
+	for _, doc := range bytes.Split(data, []byte("\n---\n")) {
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		crd := &apiextensionsv1beta1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(doc, crd); err != nil {
+			loadErr = err
+			return
+		}
+		if crd.Spec.Validation == nil || crd.Spec.Validation.OpenAPIV3Schema == nil {
+			continue
+		}
+
+		schemas[crd.Spec.Names.Kind] = crd.Spec.Validation.OpenAPIV3Schema
+		apiVersion = crd.Spec.Group + "/" + crd.Spec.Version
+	}
+}
+
+// Schemas returns the schemas of all kinds of chaos, the key is the kind
+func Schemas() (map[string]*apiextensionsv1beta1.JSONSchemaProps, error) {
+	loadOnce.Do(load)
+	return schemas, loadErr
+}
+
+// Schema returns the schema of the kind of chaos
+func Schema(kind string) (*apiextensionsv1beta1.JSONSchemaProps, error) {
+	schemas, err := Schemas()
+	if err != nil {
+		return nil, err
+	}
+
+	schema, ok := schemas[kind]
+	if !ok {
+		return nil, fmt.Errorf("schema of %s is not found", kind)
+	}
+	return schema, nil
+}
+
+// Kinds returns the kinds which have schemas in order
+func Kinds() ([]string, error) {
+	schemas, err := Schemas()
+	if err != nil {
+		return nil, err
+	}
+
+	kinds := make([]string, 0, len(schemas))
+	for kind := range schemas {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds, nil
+}
+
+// NewDocument returns the OpenAPI v3 document which contains the schemas of all kinds of chaos
+func NewDocument() (*Document, error) {
+	schemas, err := Schemas()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:   "Chaos Mesh",
+			Version: apiVersion,
+		},
+		Paths: map[string]interface{}{},
+		Components: Components{
+			Schemas: schemas,
+		},
+	}, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestNewDocument(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	doc, err := NewDocument()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(doc.OpenAPI).To(Equal(Version))
+	g.Expect(doc.Info.Version).To(Equal(v1alpha1.GroupVersion.String()))
+
+	for kind := range v1alpha1.AllKinds() {
+		g.Expect(doc.Components.Schemas).To(HaveKey(kind))
+	}
+
+	schema, err := Schema(v1alpha1.KindPodChaos)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(schema.Properties["spec"].Properties["action"].Enum).To(HaveLen(6))
+
+	_, err = Schema("UnknownChaos")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestValidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := map[string]interface{}{
+		"apiVersion": v1alpha1.GroupVersion.String(),
+		"kind":       v1alpha1.KindNetworkChaos,
+		"metadata":   map[string]interface{}{"name": "delay", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"action":   "delay",
+			"mode":     "one",
+			"selector": map[string]interface{}{"labelSelectors": map[string]interface{}{"app": "web"}},
+			"delay":    map[string]interface{}{"latency": "100ms"},
+		},
+	}
+	g.Expect(Validate(v1alpha1.KindNetworkChaos, chaos)).To(Succeed())
+
+	spec := chaos["spec"].(map[string]interface{})
+	spec["action"] = "slow"
+	spec["mode"] = 1
+	spec["unknown"] = true
+	delete(spec["delay"].(map[string]interface{}), "latency")
+
+	err := Validate(v1alpha1.KindNetworkChaos, chaos)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring(`spec.action: Unsupported value: "slow"`))
+	g.Expect(err.Error()).To(ContainSubstring("spec.mode: Invalid value"))
+	g.Expect(err.Error()).To(ContainSubstring("spec.unknown: Forbidden: unknown field"))
+	g.Expect(err.Error()).To(ContainSubstring("spec.delay.latency: Required value"))
+}
@@ -0,0 +1,159 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// TestListTypes checks that server-side apply knows how to merge every list and map, so the
+// fields of chaos are owned by the managers which set them without conflicts.
+func TestListTypes(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schemas, err := Schemas()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(schemas).ToNot(BeEmpty())
+
+	for kind, schema := range schemas {
+		walkSchema(kind, schema, func(path string, schema *apiextensionsv1beta1.JSONSchemaProps) {
+			if schema.XMapType != nil {
+				g.Expect(*schema.XMapType).To(BeElementOf("atomic", "granular"), path)
+			}
+			if schema.Type != "array" {
+				return
+			}
+
+			g.Expect(schema.XListType).ToNot(BeNil(), "%s doesn't declare its list type", path)
+			switch *schema.XListType {
+			case "map":
+				g.Expect(schema.XListMapKeys).ToNot(BeEmpty(), path)
+				for _, key := range schema.XListMapKeys {
+					g.Expect(schema.Items.Schema.Required).To(ContainElement(key), "the key %s of %s must be required", key, path)
+				}
+			case "set":
+				g.Expect(schema.Items.Schema.Type).ToNot(Equal("object"), "the items of %s must be scalars", path)
+			default:
+				g.Expect(*schema.XListType).To(Equal("atomic"), path)
+			}
+		})
+	}
+}
+
+// TestApplyRoundTrip checks that the spec applied by IaC tools is kept as it is when it's
+// decoded and encoded by the controllers, otherwise the fields added by the controllers,
+// such as the empty strings which aren't omitted, show up as diffs in every plan.
+func TestApplyRoundTrip(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	schemas, err := Schemas()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	for kind, schema := range schemas {
+		spec, ok := schema.Properties["spec"]
+		g.Expect(ok).To(BeTrue(), "%s has no spec", kind)
+
+		// the required fields only, and all fields
+		for _, full := range []bool{false, true} {
+			applied := map[string]interface{}{
+				"apiVersion": v1alpha1.GroupVersion.String(),
+				"kind":       kind,
+				"spec":       sampleOf(&spec, full),
+			}
+			data, err := json.Marshal(applied)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			obj, err := scheme.New(v1alpha1.GroupVersion.WithKind(kind))
+			g.Expect(err).ToNot(HaveOccurred(), "%s isn't registered", kind)
+			g.Expect(json.Unmarshal(data, obj)).To(Succeed(), "%s: %s", kind, data)
+
+			encoded, err := json.Marshal(obj)
+			g.Expect(err).ToNot(HaveOccurred())
+			var roundTrip map[string]interface{}
+			g.Expect(json.Unmarshal(encoded, &roundTrip)).To(Succeed())
+			g.Expect(roundTrip["spec"]).To(Equal(applied["spec"]), "the spec of %s is changed by the round trip", kind)
+		}
+	}
+}
+
+func walkSchema(path string, schema *apiextensionsv1beta1.JSONSchemaProps, fn func(string, *apiextensionsv1beta1.JSONSchemaProps)) {
+	fn(path, schema)
+	for name, property := range schema.Properties {
+		property := property
+		walkSchema(path+"."+name, &property, fn)
+	}
+	if schema.Items != nil && schema.Items.Schema != nil {
+		walkSchema(path+"[]", schema.Items.Schema, fn)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		walkSchema(path+"{}", schema.AdditionalProperties.Schema, fn)
+	}
+}
+
+// sampleOf returns a value which conforms to the schema. The values are not zero, since the
+// zero values of the optional fields are omitted as if they were not set.
+func sampleOf(schema *apiextensionsv1beta1.JSONSchemaProps, full bool) interface{} {
+	if len(schema.Enum) > 0 {
+		var value interface{}
+		_ = json.Unmarshal(schema.Enum[0].Raw, &value)
+		return value
+	}
+	if schema.XIntOrString {
+		return "50%"
+	}
+
+	switch schema.Type {
+	case "object":
+		object := map[string]interface{}{}
+		required := make(map[string]bool)
+		for _, name := range schema.Required {
+			required[name] = true
+		}
+		for name, property := range schema.Properties {
+			property := property
+			if full || required[name] {
+				object[name] = sampleOf(&property, full)
+			}
+		}
+		if full && schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+			object["key"] = sampleOf(schema.AdditionalProperties.Schema, full)
+		}
+		return object
+	case "array":
+		return []interface{}{sampleOf(schema.Items.Schema, full)}
+	case "string":
+		if schema.Format == "date-time" {
+			return "2020-01-01T00:00:00Z"
+		}
+		return "value"
+	case "integer", "number":
+		if schema.Minimum != nil {
+			return *schema.Minimum + 1
+		}
+		return float64(1)
+	case "boolean":
+		return true
+	}
+	return map[string]interface{}{}
+}
@@ -0,0 +1,182 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate validates the object of the kind of chaos against its schema
+func Validate(kind string, obj interface{}) error {
+	schema, err := Schema(kind)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	allErrs := validate(nil, schema, value)
+	if len(allErrs) > 0 {
+		return allErrs.ToAggregate()
+	}
+	return nil
+}
+
+func validate(path *field.Path, schema *apiextensionsv1beta1.JSONSchemaProps, value interface{}) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if value == nil {
+		return allErrs
+	}
+
+	if schema.XIntOrString {
+		switch value.(type) {
+		case string, float64:
+		default:
+			allErrs = append(allErrs, field.Invalid(path, value, "must be an integer or a string"))
+		}
+		return allErrs
+	}
+
+	if len(schema.Enum) > 0 {
+		allErrs = append(allErrs, validateEnum(path, schema.Enum, value)...)
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return append(allErrs, field.Invalid(path, value, "must be an object"))
+		}
+		allErrs = append(allErrs, validateObject(path, schema, object)...)
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			return append(allErrs, field.Invalid(path, value, "must be an array"))
+		}
+		if schema.MinItems != nil && int64(len(array)) < *schema.MinItems {
+			allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("must have at least %d items", *schema.MinItems)))
+		}
+		if schema.MaxItems != nil && int64(len(array)) > *schema.MaxItems {
+			allErrs = append(allErrs, field.TooMany(path, len(array), int(*schema.MaxItems)))
+		}
+		if schema.Items != nil && schema.Items.Schema != nil {
+			for i, item := range array {
+				allErrs = append(allErrs, validate(path.Index(i), schema.Items.Schema, item)...)
+			}
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return append(allErrs, field.Invalid(path, value, "must be a string"))
+		}
+		if schema.MinLength != nil && int64(len(str)) < *schema.MinLength {
+			allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("must be at least %d characters", *schema.MinLength)))
+		}
+		if schema.MaxLength != nil && int64(len(str)) > *schema.MaxLength {
+			allErrs = append(allErrs, field.TooLong(path, value, int(*schema.MaxLength)))
+		}
+		if schema.Pattern != "" {
+			if matched, err := regexp.MatchString(schema.Pattern, str); err == nil && !matched {
+				allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("must match %s", schema.Pattern)))
+			}
+		}
+	case "integer", "number":
+		number, ok := value.(float64)
+		if !ok || (schema.Type == "integer" && number != float64(int64(number))) {
+			return append(allErrs, field.Invalid(path, value, "must be an "+schema.Type))
+		}
+		if schema.Minimum != nil && (number < *schema.Minimum || (schema.ExclusiveMinimum && number == *schema.Minimum)) {
+			allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("must be greater than %v", *schema.Minimum)))
+		}
+		if schema.Maximum != nil && (number > *schema.Maximum || (schema.ExclusiveMaximum && number == *schema.Maximum)) {
+			allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("must be less than %v", *schema.Maximum)))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return append(allErrs, field.Invalid(path, value, "must be a boolean"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateObject(path *field.Path, schema *apiextensionsv1beta1.JSONSchemaProps, object map[string]interface{}) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, name := range schema.Required {
+		if _, ok := object[name]; !ok {
+			allErrs = append(allErrs, field.Required(path.Child(name), ""))
+		}
+	}
+
+	// keep the order of errors stable
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		child := path.Child(name)
+
+		if property, ok := schema.Properties[name]; ok {
+			allErrs = append(allErrs, validate(child, &property, object[name])...)
+			continue
+		}
+
+		if schema.AdditionalProperties != nil {
+			if schema.AdditionalProperties.Schema != nil {
+				allErrs = append(allErrs, validate(child, schema.AdditionalProperties.Schema, object[name])...)
+			}
+			continue
+		}
+
+		// the fields of the objects without properties, like metadata, are not checked
+		if len(schema.Properties) > 0 && (schema.XPreserveUnknownFields == nil || !*schema.XPreserveUnknownFields) {
+			allErrs = append(allErrs, field.Forbidden(child, "unknown field"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateEnum(path *field.Path, enum []apiextensionsv1beta1.JSON, value interface{}) field.ErrorList {
+	values := make([]string, 0, len(enum))
+	for _, e := range enum {
+		var expected interface{}
+		if err := json.Unmarshal(e.Raw, &expected); err != nil {
+			continue
+		}
+		if reflect.DeepEqual(expected, value) {
+			return nil
+		}
+		values = append(values, fmt.Sprint(expected))
+	}
+
+	return field.ErrorList{field.NotSupported(path, value, values)}
+}
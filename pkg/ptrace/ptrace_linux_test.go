@@ -18,6 +18,7 @@ import (
 	"math/rand"
 	"os"
 	"os/exec"
+	"syscall"
 	"testing"
 	"time"
 	"unsafe"
@@ -135,6 +136,20 @@ var _ = Describe("PTrace", func() {
 		Expect(*readBuf).Should(Equal(expectBuf))
 	})
 
+	It("should run syscalls repeatedly", func() {
+		fds, err := program.SyscallN(3, func(int) (uint64, []uint64) {
+			return syscall.SYS_EPOLL_CREATE1, []uint64{syscall.EPOLL_CLOEXEC}
+		})
+		Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+		Expect(fds).Should(HaveLen(3))
+
+		results, err := program.SyscallN(len(fds), func(i int) (uint64, []uint64) {
+			return syscall.SYS_CLOSE, []uint64{fds[i]}
+		})
+		Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+		Expect(results).Should(Equal([]uint64{0, 0, 0}))
+	})
+
 	It("should be able to detach and reattach", func() {
 		err := program.Detach()
 		Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
@@ -0,0 +1,220 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+const (
+	// BundleVersion is the version of the bundle format
+	BundleVersion = "v1"
+
+	// LabelReplayOf is set on the replayed chaos, its value is the name of the result of the original run
+	LabelReplayOf = "chaos-mesh.org/replay-of"
+
+	// EventStarted is the event in the timeline when the run started
+	EventStarted = "Started"
+	// EventProbe is the event in the timeline when a probe measurement is taken
+	EventProbe = "Probe"
+)
+
+// Bundle is a portable record of a finished run, which is replayed later or in another cluster
+type Bundle struct {
+	Version    string     `json:"version"`
+	Experiment Experiment `json:"experiment"`
+	Run        Run        `json:"run"`
+	// Targets are the pods which the chaos was injected into in the original run,
+	// the replay selects the targets again by the selector
+	Targets  []v1alpha1.ResultTarget `json:"targets,omitempty"`
+	Timeline []TimelineEntry         `json:"timeline"`
+}
+
+// Experiment is the chaos of the run
+type Experiment struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Spec is the spec of the chaos when the run started
+	Spec json.RawMessage `json:"spec"`
+}
+
+// Run is the original run
+type Run struct {
+	// Result is the name of the ChaosResult of the run
+	Result    string                `json:"result"`
+	StartTime metav1.Time           `json:"startTime"`
+	Duration  string                `json:"duration"`
+	Verdict   v1alpha1.ChaosVerdict `json:"verdict"`
+	Reason    string                `json:"reason,omitempty"`
+}
+
+// TimelineEntry is an event of the run
+type TimelineEntry struct {
+	// Offset is the time of the event since the start of the run, e.g. "1m30s"
+	Offset  string `json:"offset"`
+	Event   string `json:"event"`
+	Message string `json:"message,omitempty"`
+}
+
+// Export exports the finished run which is recorded by the result
+func Export(result *v1alpha1.ChaosResult) (*Bundle, error) {
+	if !result.IsFinished() || result.Status.EndTime == nil {
+		return nil, fmt.Errorf("the run %s/%s isn't finished", result.Namespace, result.Name)
+	}
+	if result.Spec.Snapshot == nil || len(result.Spec.Snapshot.Raw) == 0 {
+		return nil, fmt.Errorf("the result %s/%s has no snapshot of the chaos", result.Namespace, result.Name)
+	}
+
+	start := result.Spec.StartTime.Time
+	duration := result.Status.EndTime.Sub(start)
+	type event struct {
+		offset time.Duration
+		entry  TimelineEntry
+	}
+	events := []event{{0, TimelineEntry{Event: EventStarted}}}
+	for _, probe := range result.Status.Probes {
+		message := probe.Value
+		if !probe.Passed {
+			message = fmt.Sprintf("%s failed: %s", probe.Value, probe.Message)
+		}
+		events = append(events, event{probe.Time.Sub(start), TimelineEntry{
+			Event:   EventProbe + " " + probe.Name,
+			Message: message,
+		}})
+	}
+	events = append(events, event{duration, TimelineEntry{
+		Event:   string(result.Status.Verdict),
+		Message: result.Status.Reason,
+	}})
+	sort.SliceStable(events, func(i, j int) bool { return events[i].offset < events[j].offset })
+	timeline := make([]TimelineEntry, 0, len(events))
+	for _, e := range events {
+		e.entry.Offset = e.offset.String()
+		timeline = append(timeline, e.entry)
+	}
+
+	experiment := result.Spec.Experiment
+	return &Bundle{
+		Version: BundleVersion,
+		Experiment: Experiment{
+			Kind:      experiment.Kind,
+			Namespace: result.Namespace,
+			Name:      experiment.Name,
+			Spec:      json.RawMessage(result.Spec.Snapshot.Raw),
+		},
+		Run: Run{
+			Result:    result.Name,
+			StartTime: result.Spec.StartTime,
+			Duration:  duration.String(),
+			Verdict:   result.Status.Verdict,
+			Reason:    result.Status.Reason,
+		},
+		Targets:  result.Status.Targets,
+		Timeline: timeline,
+	}, nil
+}
+
+// Decode decodes and checks the bundle
+func Decode(data []byte) (*Bundle, error) {
+	bundle := &Bundle{}
+	if err := json.Unmarshal(data, bundle); err != nil {
+		return nil, fmt.Errorf("the bundle isn't valid JSON: %v", err)
+	}
+	if bundle.Version != BundleVersion {
+		return nil, fmt.Errorf("the version %q of the bundle isn't supported, it should be %s", bundle.Version, BundleVersion)
+	}
+	if _, err := bundle.GetDuration(); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// GetDuration returns the duration of the original run, which the replay lasts for
+func (b *Bundle) GetDuration() (time.Duration, error) {
+	duration, err := time.ParseDuration(b.Run.Duration)
+	if err != nil {
+		return 0, fmt.Errorf("the duration %q of the run is invalid: %v", b.Run.Duration, err)
+	}
+	return duration, nil
+}
+
+// Chaos constructs the chaos which replays the run in the namespace. The parameters of the chaos are
+// kept, and its targets are selected again by the selector. The scheduler and the duration are removed,
+// so the chaos runs once, and the replayer recovers it after the duration of the original run. If the
+// chaos is replayed in another namespace, the selector of the original namespace selects the pods in the
+// new namespace.
+func (b *Bundle) Chaos(namespace, name string) (v1alpha1.InnerObject, error) {
+	kind, ok := v1alpha1.AllKinds()[b.Experiment.Kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown kind %s", b.Experiment.Kind)
+	}
+
+	var spec map[string]interface{}
+	if err := json.Unmarshal(b.Experiment.Spec, &spec); err != nil {
+		return nil, fmt.Errorf("the spec of the chaos must be a JSON object: %v", err)
+	}
+	delete(spec, "scheduler")
+	delete(spec, "duration")
+	if namespace != b.Experiment.Namespace {
+		remapNamespace(spec, b.Experiment.Namespace, namespace)
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"spec": spec})
+	if err != nil {
+		return nil, err
+	}
+	obj := kind.Chaos.DeepCopyObject()
+	if err := json.Unmarshal(data, obj); err != nil {
+		return nil, fmt.Errorf("the spec isn't a valid %s: %v", b.Experiment.Kind, err)
+	}
+
+	chaos, ok := obj.(v1alpha1.InnerObject)
+	if !ok {
+		return nil, fmt.Errorf("%s isn't a chaos", b.Experiment.Kind)
+	}
+	meta := obj.(metav1.Object)
+	meta.SetNamespace(namespace)
+	meta.SetName(name)
+	meta.SetLabels(map[string]string{LabelReplayOf: b.Run.Result})
+	return chaos, nil
+}
+
+// remapNamespace replaces the original namespace in the namespaces of the selectors,
+// e.g. `selector` of the chaos and `target.selector` of NetworkChaos
+func remapNamespace(spec map[string]interface{}, from, to string) {
+	for key, value := range spec {
+		child, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if key == "selector" {
+			if namespaces, ok := child["namespaces"].([]interface{}); ok {
+				for i, ns := range namespaces {
+					if ns == from {
+						namespaces[i] = to
+					}
+				}
+			}
+		}
+		remapNamespace(child, from, to)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func newResult() *v1alpha1.ChaosResult {
+	start := metav1.NewTime(time.Unix(1602921600, 0))
+	end := metav1.NewTime(start.Add(5 * time.Minute))
+	return &v1alpha1.ChaosResult{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "network-delay-1602921600"},
+		Spec: v1alpha1.ChaosResultSpec{
+			Experiment: v1alpha1.ExperimentReference{Kind: v1alpha1.KindNetworkChaos, Name: "network-delay", UID: "uid-1"},
+			StartTime:  start,
+			Snapshot: &runtime.RawExtension{Raw: []byte(`{
+				"action": "delay",
+				"mode": "one",
+				"selector": {"namespaces": ["shop", "payment"], "labelSelectors": {"app": "checkout"}},
+				"target": {"mode": "all", "selector": {"namespaces": ["shop"]}},
+				"delay": {"latency": "100ms"},
+				"duration": "5m",
+				"scheduler": {"cron": "@every 1h"}
+			}`)},
+		},
+		Status: v1alpha1.ChaosResultStatus{
+			Verdict: v1alpha1.VerdictFailed,
+			Reason:  "probe latency failed: p99 is above 500ms",
+			EndTime: &end,
+			Targets: []v1alpha1.ResultTarget{{Namespace: "shop", Name: "checkout-0", Action: "delay"}},
+			Probes: []v1alpha1.ProbeMeasurement{
+				{Name: "latency", Time: metav1.NewTime(start.Add(2 * time.Minute)), Value: "730ms", Message: "p99 is above 500ms"},
+				{Name: "availability", Time: metav1.NewTime(start.Add(time.Minute)), Value: "100%", Passed: true},
+			},
+		},
+	}
+}
+
+func TestExport(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	result := newResult()
+	bundle, err := Export(result)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(bundle.Version).To(Equal(BundleVersion))
+	g.Expect(bundle.Experiment.Kind).To(Equal(v1alpha1.KindNetworkChaos))
+	g.Expect(bundle.Experiment.Namespace).To(Equal("shop"))
+	g.Expect(bundle.Experiment.Name).To(Equal("network-delay"))
+	g.Expect(bundle.Run.Result).To(Equal("network-delay-1602921600"))
+	g.Expect(bundle.Run.Duration).To(Equal("5m0s"))
+	g.Expect(bundle.Run.Verdict).To(Equal(v1alpha1.VerdictFailed))
+	g.Expect(bundle.Targets).To(Equal(result.Status.Targets))
+	g.Expect(bundle.Timeline).To(Equal([]TimelineEntry{
+		{Offset: "0s", Event: EventStarted},
+		{Offset: "1m0s", Event: "Probe availability", Message: "100%"},
+		{Offset: "2m0s", Event: "Probe latency", Message: "730ms failed: p99 is above 500ms"},
+		{Offset: "5m0s", Event: "Failed", Message: "probe latency failed: p99 is above 500ms"},
+	}))
+
+	// the bundle survives the round trip
+	data, err := json.Marshal(bundle)
+	g.Expect(err).ToNot(HaveOccurred())
+	decoded, err := Decode(data)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(decoded.Run).To(Equal(bundle.Run))
+
+	_, err = Decode([]byte(`{"version": "v2"}`))
+	g.Expect(err).To(MatchError(`the version "v2" of the bundle isn't supported, it should be v1`))
+
+	// the running run can't be exported
+	result.Status.Verdict = v1alpha1.VerdictRunning
+	_, err = Export(result)
+	g.Expect(err).To(MatchError("the run shop/network-delay-1602921600 isn't finished"))
+}
+
+func TestChaos(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	bundle, err := Export(newResult())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	chaos, err := bundle.Chaos("shop", "network-delay-replay")
+	g.Expect(err).ToNot(HaveOccurred())
+	network, ok := chaos.(*v1alpha1.NetworkChaos)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(network.Namespace).To(Equal("shop"))
+	g.Expect(network.Name).To(Equal("network-delay-replay"))
+	g.Expect(network.Labels).To(Equal(map[string]string{LabelReplayOf: "network-delay-1602921600"}))
+	g.Expect(network.Spec.Action).To(Equal(v1alpha1.DelayAction))
+	g.Expect(network.Spec.Delay.Latency).To(Equal("100ms"))
+	g.Expect(network.Spec.Selector.Namespaces).To(Equal([]string{"shop", "payment"}))
+	// the replay runs once
+	g.Expect(network.Spec.Scheduler).To(BeNil())
+	g.Expect(network.Spec.Duration).To(BeNil())
+
+	// the original namespace is replaced in the selectors in another namespace
+	chaos, err = bundle.Chaos("shop-staging", "network-delay-replay")
+	g.Expect(err).ToNot(HaveOccurred())
+	network = chaos.(*v1alpha1.NetworkChaos)
+	g.Expect(network.Spec.Selector.Namespaces).To(Equal([]string{"shop-staging", "payment"}))
+	g.Expect(network.Spec.Target.TargetSelector.Namespaces).To(Equal([]string{"shop-staging"}))
+
+	bundle.Experiment.Kind = "UnknownChaos"
+	_, err = bundle.Chaos("shop", "replay")
+	g.Expect(err).To(MatchError("unknown kind UnknownChaos"))
+}
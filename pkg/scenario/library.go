@@ -0,0 +1,276 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scenario
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func init() {
+	register(&Scenario{
+		Name:        "az-outage",
+		Description: "Make the selected pods running on the nodes of an availability zone unavailable",
+		Parameters: []Parameter{
+			{Name: "zone", Description: "the availability zone to take down, such as us-west-2a", Required: true},
+			{Name: "zoneLabel", Description: "the node label of the availability zone", Default: "topology.kubernetes.io/zone"},
+		},
+		build: azOutage,
+	})
+
+	register(&Scenario{
+		Name:        "slow-disk-on-leader",
+		Description: "Delay the file system operations of the leader among the selected pods",
+		Parameters: []Parameter{
+			{Name: "leaderLabel", Description: "the label which marks the leader, such as role=leader", Default: "role=leader"},
+			{Name: "path", Description: "the path of files to delay, all files are delayed if empty"},
+			{Name: "delay", Description: "the delay of every operation", Default: "100ms"},
+			{Name: "percent", Description: "the percentage of delayed operations", Default: "100"},
+		},
+		build: slowDiskOnLeader,
+	})
+
+	register(&Scenario{
+		Name:        "dns-flake",
+		Description: "Drop a part of the packets from the selected pods to the cluster DNS",
+		Parameters: []Parameter{
+			{Name: "loss", Description: "the percentage of packet loss", Default: "50"},
+			{Name: "dnsNamespace", Description: "the namespace of the DNS service", Default: "kube-system"},
+			{Name: "dnsService", Description: "the name of the DNS service", Default: "kube-dns"},
+		},
+		build: dnsFlake,
+	})
+
+	register(&Scenario{
+		Name:        "rolling-pod-kill",
+		Description: "Kill one of the selected pods periodically",
+		Parameters: []Parameter{
+			{Name: "interval", Description: "the interval between the kills", Default: "1m"},
+		},
+		build: rollingPodKill,
+	})
+
+	register(&Scenario{
+		Name: "csi-driver",
+		Description: "Disrupt one replica of the controller or node plugin of a CSI driver at a time, " +
+			"the controller plugin is killed and partitioned from the API server alternately",
+		Parameters: []Parameter{
+			{Name: "driver", Description: "the name of the CSI driver, such as ebs.csi.aws.com", Required: true},
+			{Name: "component", Description: "the plugin to disrupt, controller or node", Default: csiController},
+			{Name: "label", Description: "the label of the plugin pods, which is known for the drivers of AWS, Ceph, OpenStack and vSphere"},
+			{Name: "interval", Description: "the minutes between the kills, the partitions run in the middle of them", Default: "10"},
+			{Name: "partitionDuration", Description: "the duration of every partition of the controller plugin, " +
+				"which must be shorter than half of the interval", Default: "2m"},
+		},
+		validate: validateCSIDriver,
+		build:    csiDriver,
+	})
+}
+
+func azOutage(selector v1alpha1.SelectorSpec, params map[string]string) []v1alpha1.InnerObject {
+	if selector.NodeSelectors == nil {
+		selector.NodeSelectors = map[string]string{}
+	}
+	selector.NodeSelectors[params["zoneLabel"]] = params["zone"]
+
+	return []v1alpha1.InnerObject{
+		&v1alpha1.PodChaos{
+			TypeMeta: typeMeta(v1alpha1.KindPodChaos),
+			Spec: v1alpha1.PodChaosSpec{
+				Action:   v1alpha1.PodFailureAction,
+				Mode:     v1alpha1.AllPodMode,
+				Selector: selector,
+			},
+		},
+	}
+}
+
+func slowDiskOnLeader(selector v1alpha1.SelectorSpec, params map[string]string) []v1alpha1.InnerObject {
+	addLabel(&selector, params["leaderLabel"])
+
+	return []v1alpha1.InnerObject{
+		&v1alpha1.IoChaos{
+			TypeMeta: typeMeta(v1alpha1.KindIOChaos),
+			Spec: v1alpha1.IoChaosSpec{
+				Action:   v1alpha1.IODelayAction,
+				Mode:     v1alpha1.OnePodMode,
+				Selector: selector,
+				Layer:    v1alpha1.FileSystemLayer,
+				Delay:    params["delay"],
+				Percent:  params["percent"],
+				Path:     params["path"],
+			},
+		},
+	}
+}
+
+func dnsFlake(selector v1alpha1.SelectorSpec, params map[string]string) []v1alpha1.InnerObject {
+	return []v1alpha1.InnerObject{
+		&v1alpha1.NetworkChaos{
+			TypeMeta: typeMeta(v1alpha1.KindNetworkChaos),
+			Spec: v1alpha1.NetworkChaosSpec{
+				Action:    v1alpha1.LossAction,
+				Mode:      v1alpha1.AllPodMode,
+				Selector:  selector,
+				Direction: v1alpha1.To,
+				Loss:      &v1alpha1.LossSpec{Loss: params["loss"]},
+				TargetServices: []v1alpha1.ServiceReference{
+					{Namespace: params["dnsNamespace"], Name: params["dnsService"]},
+				},
+			},
+		},
+	}
+}
+
+func rollingPodKill(selector v1alpha1.SelectorSpec, params map[string]string) []v1alpha1.InnerObject {
+	return []v1alpha1.InnerObject{
+		&v1alpha1.PodChaos{
+			TypeMeta: typeMeta(v1alpha1.KindPodChaos),
+			Spec: v1alpha1.PodChaosSpec{
+				Action:    v1alpha1.PodKillAction,
+				Mode:      v1alpha1.OnePodMode,
+				Selector:  selector,
+				Scheduler: &v1alpha1.SchedulerSpec{Cron: "@every " + params["interval"]},
+			},
+		},
+	}
+}
+
+// The components of a CSI driver
+const (
+	csiController = "controller"
+	csiNode       = "node"
+)
+
+// knownCSILabels are the labels of the controller and node plugin pods of the CSI drivers in their
+// upstream manifests and charts. The drivers whose controllers run in the host network, such as the ones
+// of Azure, aren't included, since partitioning them cuts their nodes off the API server.
+var knownCSILabels = map[string]map[string]string{
+	"ebs.csi.aws.com":          {csiController: "app=ebs-csi-controller", csiNode: "app=ebs-csi-node"},
+	"efs.csi.aws.com":          {csiController: "app=efs-csi-controller", csiNode: "app=efs-csi-node"},
+	"rbd.csi.ceph.com":         {csiController: "app=csi-rbdplugin-provisioner", csiNode: "app=csi-rbdplugin"},
+	"cephfs.csi.ceph.com":      {csiController: "app=csi-cephfsplugin-provisioner", csiNode: "app=csi-cephfsplugin"},
+	"cinder.csi.openstack.org": {csiController: "app=csi-cinder-controllerplugin", csiNode: "app=csi-cinder-nodeplugin"},
+	"csi.vsphere.vmware.com":   {csiController: "app=vsphere-csi-controller", csiNode: "app=vsphere-csi-node"},
+}
+
+// csiLabel returns the label of the plugin pods of the component
+func csiLabel(params map[string]string) string {
+	if label := params["label"]; label != "" {
+		return label
+	}
+	// the drivers of Ceph are usually prefixed by the namespace of the cluster, such as rook-ceph.rbd.csi.ceph.com
+	for driver, labels := range knownCSILabels {
+		if params["driver"] == driver || strings.HasSuffix(params["driver"], "."+driver) {
+			return labels[params["component"]]
+		}
+	}
+	return ""
+}
+
+func validateCSIDriver(params map[string]string) error {
+	component := params["component"]
+	if component != csiController && component != csiNode {
+		return fmt.Errorf("component %s must be %s or %s", component, csiController, csiNode)
+	}
+	if csiLabel(params) == "" {
+		return fmt.Errorf("the %s plugin of driver %s is unknown, label is required", component, params["driver"])
+	}
+	interval, err := strconv.Atoi(params["interval"])
+	if err != nil || interval < 2 || interval > 60 {
+		return fmt.Errorf("interval %s must be the minutes between 2 and 60", params["interval"])
+	}
+	duration, err := time.ParseDuration(params["partitionDuration"])
+	if err != nil {
+		return fmt.Errorf("parse partitionDuration error: %v", err)
+	}
+	if duration <= 0 || duration >= time.Duration(interval)*time.Minute/2 {
+		return fmt.Errorf("partitionDuration %s must be shorter than half of the interval", params["partitionDuration"])
+	}
+	return nil
+}
+
+// csiDriver kills a replica of the plugin at the start of every interval. The controller plugin is also
+// partitioned from the API server in the middle of the interval, so the two faults never overlap, and the
+// kill is skipped by the safeguards while a replica is unavailable, e.g. the killed one isn't ready yet.
+// The node plugins usually run in the host network, so they're only killed.
+func csiDriver(selector v1alpha1.SelectorSpec, params map[string]string) []v1alpha1.InnerObject {
+	addLabel(&selector, csiLabel(params))
+	interval, _ := strconv.Atoi(params["interval"])
+
+	kill := &v1alpha1.PodChaos{
+		TypeMeta: typeMeta(v1alpha1.KindPodChaos),
+		Spec: v1alpha1.PodChaosSpec{
+			Action:     v1alpha1.PodKillAction,
+			Mode:       v1alpha1.OnePodMode,
+			Selector:   selector,
+			Safeguards: &v1alpha1.Safeguards{MinAvailable: intstr.FromInt(1)},
+			Scheduler:  &v1alpha1.SchedulerSpec{Cron: fmt.Sprintf("*/%d * * * *", interval)},
+		},
+	}
+	if params["component"] == csiNode {
+		// the safeguards don't apply to the pods of DaemonSets
+		kill.Spec.Safeguards = nil
+		return []v1alpha1.InnerObject{kill}
+	}
+
+	duration := params["partitionDuration"]
+	return []v1alpha1.InnerObject{
+		kill,
+		&v1alpha1.NetworkChaos{
+			TypeMeta: typeMeta(v1alpha1.KindNetworkChaos),
+			Spec: v1alpha1.NetworkChaosSpec{
+				Action:    v1alpha1.PartitionAction,
+				Mode:      v1alpha1.OnePodMode,
+				Selector:  *selector.DeepCopy(),
+				Direction: v1alpha1.To,
+				TargetServices: []v1alpha1.ServiceReference{
+					{Namespace: metav1.NamespaceDefault, Name: "kubernetes"},
+				},
+				Duration:  &duration,
+				Scheduler: &v1alpha1.SchedulerSpec{Cron: fmt.Sprintf("%d-59/%d * * * *", interval/2, interval)},
+			},
+		},
+	}
+}
+
+// addLabel adds the label in the form of "key=value" or "key" to the label selectors
+func addLabel(selector *v1alpha1.SelectorSpec, label string) {
+	if label == "" {
+		return
+	}
+	if selector.LabelSelectors == nil {
+		selector.LabelSelectors = map[string]string{}
+	}
+	kv := strings.SplitN(label, "=", 2)
+	if len(kv) == 2 {
+		selector.LabelSelectors[kv[0]] = kv[1]
+	} else {
+		selector.LabelSelectors[kv[0]] = ""
+	}
+}
+
+func typeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{
+		Kind:       kind,
+		APIVersion: v1alpha1.GroupVersion.String(),
+	}
+}
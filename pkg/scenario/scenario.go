@@ -0,0 +1,155 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scenario
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// LabelScenario is the label of the chaos generated from a scenario, its value is the name of scenario
+const LabelScenario = "chaos-mesh.org/scenario"
+
+// Parameter defines a parameter of the scenario
+type Parameter struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Default is used when the parameter isn't given.
+	Default string `json:"default,omitempty"`
+	// Required means the parameter must be given.
+	Required bool `json:"required,omitempty"`
+}
+
+// Scenario is a curated experiment, which generates the chaos targeting the pods
+// selected by the user
+type Scenario struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Parameters  []Parameter `json:"parameters"`
+
+	// validate checks the resolved parameters which depend on each other, it's optional
+	validate func(params map[string]string) error
+	// build generates the chaos with the selector of target pods and the resolved parameters
+	build func(selector v1alpha1.SelectorSpec, params map[string]string) []v1alpha1.InnerObject
+}
+
+// Options defines the options to generate the chaos of a scenario
+type Options struct {
+	// Namespace is the namespace of the generated chaos
+	Namespace string
+	// Name is the name of the generated chaos, default to the name of scenario.
+	// The kind of chaos is appended to it if the scenario generates more than one chaos.
+	Name string
+	// Selector selects the target pods, the pods in Namespace are selected if no namespace is given
+	Selector v1alpha1.SelectorSpec
+	// Parameters overrides the default values of the parameters
+	Parameters map[string]string
+}
+
+var library = map[string]*Scenario{}
+
+func register(s *Scenario) {
+	if _, ok := library[s.Name]; ok {
+		panic(fmt.Sprintf("scenario %s is registered twice", s.Name))
+	}
+	library[s.Name] = s
+}
+
+// List returns all the scenarios in the library, sorted by name
+func List() []*Scenario {
+	scenarios := make([]*Scenario, 0, len(library))
+	for _, s := range library {
+		scenarios = append(scenarios, s)
+	}
+	sort.Slice(scenarios, func(i, j int) bool {
+		return scenarios[i].Name < scenarios[j].Name
+	})
+	return scenarios
+}
+
+// Get returns the scenario by name
+func Get(name string) (*Scenario, bool) {
+	s, ok := library[name]
+	return s, ok
+}
+
+// Generate generates the chaos of the scenario with the options
+func (s *Scenario) Generate(opt *Options) ([]v1alpha1.InnerObject, error) {
+	params, err := s.resolveParameters(opt.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	if s.validate != nil {
+		if err := s.validate(params); err != nil {
+			return nil, fmt.Errorf("invalid parameters of scenario %s: %v", s.Name, err)
+		}
+	}
+
+	selector := *opt.Selector.DeepCopy()
+	if len(selector.Namespaces) == 0 && len(selector.Pods) == 0 {
+		selector.Namespaces = []string{opt.Namespace}
+	}
+
+	name := opt.Name
+	if name == "" {
+		name = s.Name
+	}
+
+	chaos := s.build(selector, params)
+	for _, c := range chaos {
+		meta := c.(metav1.Object)
+		meta.SetNamespace(opt.Namespace)
+		meta.SetName(name)
+		if len(chaos) > 1 {
+			meta.SetName(name + "-" + strings.ToLower(c.GetObjectKind().GroupVersionKind().Kind))
+		}
+		meta.SetLabels(map[string]string{LabelScenario: s.Name})
+	}
+
+	return chaos, nil
+}
+
+// resolveParameters returns the values of all the parameters, the default values are used
+// for the parameters which aren't given
+func (s *Scenario) resolveParameters(values map[string]string) (map[string]string, error) {
+	params := make(map[string]string, len(s.Parameters))
+	for _, param := range s.Parameters {
+		value, ok := values[param.Name]
+		if !ok || value == "" {
+			if param.Required {
+				return nil, fmt.Errorf("parameter %s is required", param.Name)
+			}
+			value = param.Default
+		}
+		params[param.Name] = value
+	}
+
+	var unknown []string
+	for name := range values {
+		if _, ok := params[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown parameters %v of scenario %s", unknown, s.Name)
+	}
+
+	return params, nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scenario
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestLibraryGeneratesValidChaos(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// the required parameters of every scenario
+	required := map[string]map[string]string{
+		"az-outage": {"zone": "us-west-2a"},
+		"csi-driver": {"driver": "ebs.csi.aws.com"},
+	}
+
+	g.Expect(List()).ToNot(BeEmpty())
+	for _, s := range List() {
+		chaos, err := s.Generate(&Options{
+			Namespace:  "shop",
+			Selector:   v1alpha1.SelectorSpec{LabelSelectors: map[string]string{"app": "web"}},
+			Parameters: required[s.Name],
+		})
+		g.Expect(err).ToNot(HaveOccurred(), s.Name)
+		g.Expect(chaos).ToNot(BeEmpty(), s.Name)
+
+		for _, c := range chaos {
+			instance := c.GetChaos()
+			g.Expect(instance.Namespace).To(Equal("shop"), s.Name)
+			g.Expect(instance.Name).To(HavePrefix(s.Name), s.Name)
+			g.Expect(c.(v1alpha1.SelectorObject).GetSelectorSpecs()[0].Namespaces).To(Equal([]string{"shop"}), s.Name)
+
+			c.(webhook.Defaulter).Default()
+			g.Expect(c.(v1alpha1.ChaosValidator).Validate()).To(Succeed(), s.Name)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	s, ok := Get("slow-disk-on-leader")
+	g.Expect(ok).To(BeTrue())
+
+	chaos, err := s.Generate(&Options{
+		Namespace: "db",
+		Name:      "slow-etcd",
+		Selector: v1alpha1.SelectorSpec{
+			Namespaces:     []string{"etcd"},
+			LabelSelectors: map[string]string{"app": "etcd"},
+		},
+		Parameters: map[string]string{"leaderLabel": "etcd/leader=true", "delay": "1s"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(chaos).To(HaveLen(1))
+
+	io := chaos[0].(*v1alpha1.IoChaos)
+	g.Expect(io.Name).To(Equal("slow-etcd"))
+	g.Expect(io.Labels).To(HaveKeyWithValue(LabelScenario, "slow-disk-on-leader"))
+	g.Expect(io.Spec.Selector.Namespaces).To(Equal([]string{"etcd"}))
+	g.Expect(io.Spec.Selector.LabelSelectors).To(Equal(map[string]string{"app": "etcd", "etcd/leader": "true"}))
+	g.Expect(io.Spec.Delay).To(Equal("1s"))
+	g.Expect(io.Spec.Percent).To(Equal("100"))
+
+	_, err = s.Generate(&Options{Namespace: "db", Parameters: map[string]string{"latency": "1s"}})
+	g.Expect(err).To(HaveOccurred())
+
+	s, _ = Get("az-outage")
+	_, err = s.Generate(&Options{Namespace: "db"})
+	g.Expect(err).To(HaveOccurred())
+
+	_, ok = Get("disk-full")
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestCSIDriver(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	s, ok := Get("csi-driver")
+	g.Expect(ok).To(BeTrue())
+
+	chaos, err := s.Generate(&Options{
+		Namespace:  "kube-system",
+		Parameters: map[string]string{"driver": "rook-ceph.rbd.csi.ceph.com", "interval": "20"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(chaos).To(HaveLen(2))
+
+	kill := chaos[0].(*v1alpha1.PodChaos)
+	g.Expect(kill.Name).To(Equal("csi-driver-podchaos"))
+	g.Expect(kill.Spec.Mode).To(Equal(v1alpha1.OnePodMode))
+	g.Expect(kill.Spec.Selector.LabelSelectors).To(Equal(map[string]string{"app": "csi-rbdplugin-provisioner"}))
+	g.Expect(kill.Spec.Safeguards).ToNot(BeNil())
+	g.Expect(kill.Spec.Scheduler.Cron).To(Equal("*/20 * * * *"))
+
+	partition := chaos[1].(*v1alpha1.NetworkChaos)
+	g.Expect(partition.Spec.Mode).To(Equal(v1alpha1.OnePodMode))
+	g.Expect(partition.Spec.Selector.LabelSelectors).To(Equal(map[string]string{"app": "csi-rbdplugin-provisioner"}))
+	g.Expect(partition.Spec.TargetServices).To(Equal([]v1alpha1.ServiceReference{{Namespace: "default", Name: "kubernetes"}}))
+	// the partitions run in the middle of the kills
+	g.Expect(partition.Spec.Scheduler.Cron).To(Equal("10-59/20 * * * *"))
+	g.Expect(*partition.Spec.Duration).To(Equal("2m"))
+
+	// the node plugins are only killed
+	chaos, err = s.Generate(&Options{
+		Namespace:  "kube-system",
+		Parameters: map[string]string{"driver": "ebs.csi.aws.com", "component": "node"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(chaos).To(HaveLen(1))
+	g.Expect(chaos[0].(*v1alpha1.PodChaos).Spec.Selector.LabelSelectors).To(Equal(map[string]string{"app": "ebs-csi-node"}))
+
+	for _, params := range []map[string]string{
+		{"driver": "disk.csi.azure.com"},
+		{"driver": "ebs.csi.aws.com", "component": "attacher"},
+		{"driver": "ebs.csi.aws.com", "interval": "1"},
+		{"driver": "ebs.csi.aws.com", "interval": "4", "partitionDuration": "2m"},
+	} {
+		_, err = s.Generate(&Options{Namespace: "kube-system", Parameters: params})
+		g.Expect(err).To(HaveOccurred(), params["driver"])
+	}
+
+	// the unknown drivers are selected by the label
+	chaos, err = s.Generate(&Options{
+		Namespace:  "kube-system",
+		Parameters: map[string]string{"driver": "disk.csi.azure.com", "component": "node", "label": "app=csi-azuredisk-node"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(chaos).To(HaveLen(1))
+}
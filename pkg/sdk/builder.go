@@ -0,0 +1,188 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sdk helps to drive chaos programmatically from Go, e.g. in the test suites of
+// platform teams. It provides typed builders which validate the chaos like the admission
+// webhooks do, helpers to wait for the chaos to be injected or recovered, and a fake
+// chaos-daemon client for unit tests.
+package sdk
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// Scheme contains the types of Kubernetes and Chaos Mesh
+var Scheme = runtime.NewScheme()
+
+func init() {
+	_ = clientgoscheme.AddToScheme(Scheme)
+	_ = v1alpha1.AddToScheme(Scheme)
+}
+
+// NewClient creates a client which can read and write chaos with the config
+func NewClient(config *rest.Config) (client.Client, error) {
+	return client.New(config, client.Options{Scheme: Scheme})
+}
+
+func newObjectMeta(namespace string, name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Namespace: namespace,
+		Name:      name,
+	}
+}
+
+func newTypeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{
+		Kind:       kind,
+		APIVersion: v1alpha1.GroupVersion.String(),
+	}
+}
+
+// build applies the options to the chaos, then defaults and validates it like the admission webhooks
+func build(obj runtime.Object, opts []Option) error {
+	for _, opt := range opts {
+		if err := opt(obj); err != nil {
+			return err
+		}
+	}
+
+	if defaulter, ok := obj.(interface{ Default() }); ok {
+		defaulter.Default()
+	}
+	if validator, ok := obj.(interface{ Validate() error }); ok {
+		if err := validator.Validate(); err != nil {
+			return fmt.Errorf("invalid %s: %v", obj.GetObjectKind().GroupVersionKind().Kind, err)
+		}
+	}
+	return nil
+}
+
+// NewPodChaos builds a PodChaos which performs the action on the pods in all pods mode by default
+func NewPodChaos(namespace string, name string, action v1alpha1.PodChaosAction, opts ...Option) (*v1alpha1.PodChaos, error) {
+	chaos := &v1alpha1.PodChaos{
+		TypeMeta:   newTypeMeta(v1alpha1.KindPodChaos),
+		ObjectMeta: newObjectMeta(namespace, name),
+		Spec: v1alpha1.PodChaosSpec{
+			Action: action,
+			Mode:   v1alpha1.AllPodMode,
+		},
+	}
+	if err := build(chaos, opts); err != nil {
+		return nil, err
+	}
+	return chaos, nil
+}
+
+// NewNetworkChaos builds a NetworkChaos which performs the action on the pods in all pods mode by default
+func NewNetworkChaos(namespace string, name string, action v1alpha1.NetworkChaosAction, opts ...Option) (*v1alpha1.NetworkChaos, error) {
+	chaos := &v1alpha1.NetworkChaos{
+		TypeMeta:   newTypeMeta(v1alpha1.KindNetworkChaos),
+		ObjectMeta: newObjectMeta(namespace, name),
+		Spec: v1alpha1.NetworkChaosSpec{
+			Action: action,
+			Mode:   v1alpha1.AllPodMode,
+		},
+	}
+	if err := build(chaos, opts); err != nil {
+		return nil, err
+	}
+	return chaos, nil
+}
+
+// NewIoChaos builds an IoChaos which performs the action on the pods in all pods mode by default
+func NewIoChaos(namespace string, name string, action v1alpha1.IOChaosAction, opts ...Option) (*v1alpha1.IoChaos, error) {
+	chaos := &v1alpha1.IoChaos{
+		TypeMeta:   newTypeMeta(v1alpha1.KindIOChaos),
+		ObjectMeta: newObjectMeta(namespace, name),
+		Spec: v1alpha1.IoChaosSpec{
+			Action: action,
+			Mode:   v1alpha1.AllPodMode,
+		},
+	}
+	if err := build(chaos, opts); err != nil {
+		return nil, err
+	}
+	return chaos, nil
+}
+
+// NewTimeChaos builds a TimeChaos which shifts the clocks of the pods by the offset in all pods mode by default
+func NewTimeChaos(namespace string, name string, timeOffset string, opts ...Option) (*v1alpha1.TimeChaos, error) {
+	chaos := &v1alpha1.TimeChaos{
+		TypeMeta:   newTypeMeta(v1alpha1.KindTimeChaos),
+		ObjectMeta: newObjectMeta(namespace, name),
+		Spec: v1alpha1.TimeChaosSpec{
+			TimeOffset: timeOffset,
+			Mode:       v1alpha1.AllPodMode,
+		},
+	}
+	if err := build(chaos, opts); err != nil {
+		return nil, err
+	}
+	return chaos, nil
+}
+
+// NewKernelChaos builds a KernelChaos which fails the kernel requests of the pods in all pods mode by default
+func NewKernelChaos(namespace string, name string, request v1alpha1.FailKernRequest, opts ...Option) (*v1alpha1.KernelChaos, error) {
+	chaos := &v1alpha1.KernelChaos{
+		TypeMeta:   newTypeMeta(v1alpha1.KindKernelChaos),
+		ObjectMeta: newObjectMeta(namespace, name),
+		Spec: v1alpha1.KernelChaosSpec{
+			FailKernRequest: request,
+			Mode:            v1alpha1.AllPodMode,
+		},
+	}
+	if err := build(chaos, opts); err != nil {
+		return nil, err
+	}
+	return chaos, nil
+}
+
+// NewStressChaos builds a StressChaos which runs the stressors in the pods in all pods mode by default
+func NewStressChaos(namespace string, name string, stressors *v1alpha1.Stressors, opts ...Option) (*v1alpha1.StressChaos, error) {
+	chaos := &v1alpha1.StressChaos{
+		TypeMeta:   newTypeMeta(v1alpha1.KindStressChaos),
+		ObjectMeta: newObjectMeta(namespace, name),
+		Spec: v1alpha1.StressChaosSpec{
+			Stressors: stressors,
+			Mode:      v1alpha1.AllPodMode,
+		},
+	}
+	if err := build(chaos, opts); err != nil {
+		return nil, err
+	}
+	return chaos, nil
+}
+
+// NewPhysicalMachineChaos builds a PhysicalMachineChaos which performs the action on the physical machines
+func NewPhysicalMachineChaos(namespace string, name string, action v1alpha1.PhysicalMachineChaosAction, addresses []string, opts ...Option) (*v1alpha1.PhysicalMachineChaos, error) {
+	chaos := &v1alpha1.PhysicalMachineChaos{
+		TypeMeta:   newTypeMeta(v1alpha1.KindPhysicalMachineChaos),
+		ObjectMeta: newObjectMeta(namespace, name),
+		Spec: v1alpha1.PhysicalMachineChaosSpec{
+			Action:    action,
+			Addresses: addresses,
+		},
+	}
+	if err := build(chaos, opts); err != nil {
+		return nil, err
+	}
+	return chaos, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func TestNewPodChaos(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos, err := NewPodChaos("default", "kill-nginx", v1alpha1.PodKillAction,
+		Labels(map[string]string{"team": "platform"}),
+		Mode(v1alpha1.FixedPodMode, "2"),
+		LabelSelectors(map[string]string{"app": "nginx"}),
+		Scheduler("@every 5m"),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(chaos.Kind).To(Equal(v1alpha1.KindPodChaos))
+	g.Expect(chaos.APIVersion).To(Equal(v1alpha1.GroupVersion.String()))
+	g.Expect(chaos.Labels).To(Equal(map[string]string{"team": "platform"}))
+	g.Expect(chaos.Spec.Mode).To(Equal(v1alpha1.FixedPodMode))
+	g.Expect(chaos.Spec.Value).To(Equal("2"))
+	g.Expect(chaos.Spec.Selector.LabelSelectors).To(Equal(map[string]string{"app": "nginx"}))
+	// the selector is defaulted to the namespace of the chaos
+	g.Expect(chaos.Spec.Selector.Namespaces).To(Equal([]string{"default"}))
+
+	// pod-kill must be scheduled
+	_, err = NewPodChaos("default", "kill-nginx", v1alpha1.PodKillAction)
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = NewPodChaos("default", "kill-nginx", v1alpha1.PodKillAction, NetworkDelay("100ms", "", ""))
+	g.Expect(err).To(MatchError("PodChaos doesn't support network delay"))
+
+	chaos, err = NewPodChaos("default", "kill-nginx", v1alpha1.PodKillAction, Scheduler("@every 5m"), StaggerInterval("30s"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*chaos.Spec.StaggerInterval).To(Equal("30s"))
+}
+
+func TestNewNetworkChaos(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos, err := NewNetworkChaos("default", "delay", v1alpha1.DelayAction,
+		Pods("default", "web-0", "web-1"),
+		NetworkDelay("100ms", "10ms", "25"),
+		NetworkDevice("eth*"),
+		NetworkTargetService("shop", "payments"),
+		Duration("30s"),
+		Scheduler("@every 5m"),
+		Simulate(),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(chaos.Spec.Selector.Pods).To(Equal(map[string][]string{"default": {"web-0", "web-1"}}))
+	g.Expect(chaos.Spec.Delay.Latency).To(Equal("100ms"))
+	g.Expect(chaos.Spec.GetDevice()).To(Equal("eth*"))
+	g.Expect(chaos.Spec.TargetServices).To(Equal([]v1alpha1.ServiceReference{{Namespace: "shop", Name: "payments"}}))
+	g.Expect(*chaos.Spec.Duration).To(Equal("30s"))
+	g.Expect(chaos.Spec.Scheduler.Cron).To(Equal("@every 5m"))
+	g.Expect(chaos.IsSimulated()).To(BeTrue())
+}
+
+func TestNewPhysicalMachineChaos(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, err := NewPhysicalMachineChaos("default", "delay", v1alpha1.PMNetworkDelayAction, []string{"10.0.0.1:31767"},
+		Namespaces("default"),
+	)
+	g.Expect(err).To(MatchError("PhysicalMachineChaos doesn't support selector"))
+}
+
+func TestFakeDaemon(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	daemon := NewFakeDaemon()
+	daemon.SetError("SetTbf", errors.New("tbf is unsupported"))
+	daemon.SetResponse("ContainerGetPid", &pb.ContainerResponse{Pid: 42})
+
+	_, err := daemon.SetNetem(nil, &pb.NetemRequest{ContainerId: "a"})
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = daemon.SetTbf(nil, &pb.TbfRequest{ContainerId: "a"})
+	g.Expect(err).To(MatchError("tbf is unsupported"))
+	resp, err := daemon.ContainerGetPid(nil, &pb.ContainerRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resp.Pid).To(Equal(uint32(42)))
+	g.Expect(daemon.Close()).To(Succeed())
+
+	g.Expect(daemon.Calls()).To(HaveLen(3))
+	g.Expect(daemon.CallsOf("SetNetem")).To(HaveLen(1))
+	g.Expect(daemon.CallsOf("SetNetem")[0].(*pb.NetemRequest).ContainerId).To(Equal("a"))
+	g.Expect(daemon.Closed()).To(BeTrue())
+}
@@ -0,0 +1,662 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// Assert *FakeDaemon implements utils.ChaosDaemonClientInterface.
+var _ utils.ChaosDaemonClientInterface = (*FakeDaemon)(nil)
+
+// Call is a request received by FakeDaemon
+type Call struct {
+	// Method is the name of the RPC, e.g. `SetNetem`
+	Method  string
+	Request proto.Message
+}
+
+// FakeDaemon is an in-memory chaos-daemon client for unit tests. It records every request,
+// and returns the configured response and error of the method, or an empty response by default.
+type FakeDaemon struct {
+	sync.Mutex
+
+	calls     []Call
+	errors    map[string]error
+	responses map[string]proto.Message
+	closed    bool
+}
+
+// NewFakeDaemon creates a FakeDaemon
+func NewFakeDaemon() *FakeDaemon {
+	return &FakeDaemon{
+		errors:    make(map[string]error),
+		responses: make(map[string]proto.Message),
+	}
+}
+
+// SetError makes the method return the error
+func (f *FakeDaemon) SetError(method string, err error) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.errors[method] = err
+}
+
+// SetResponse makes the method return the response, its type must be the response type of the method
+func (f *FakeDaemon) SetResponse(method string, resp proto.Message) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.responses[method] = resp
+}
+
+// Calls returns the requests received by the daemon in order
+func (f *FakeDaemon) Calls() []Call {
+	f.Lock()
+	defer f.Unlock()
+
+	return append([]Call(nil), f.calls...)
+}
+
+// CallsOf returns the requests of the method received by the daemon in order
+func (f *FakeDaemon) CallsOf(method string) []proto.Message {
+	f.Lock()
+	defer f.Unlock()
+
+	var requests []proto.Message
+	for _, call := range f.calls {
+		if call.Method == method {
+			requests = append(requests, call.Request)
+		}
+	}
+	return requests
+}
+
+// Closed returns whether the client is closed
+func (f *FakeDaemon) Closed() bool {
+	f.Lock()
+	defer f.Unlock()
+
+	return f.closed
+}
+
+// Close implements utils.ChaosDaemonClientInterface
+func (f *FakeDaemon) Close() error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.closed = true
+	return f.errors["Close"]
+}
+
+func (f *FakeDaemon) call(method string, req proto.Message) (proto.Message, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.calls = append(f.calls, Call{Method: method, Request: req})
+	return f.responses[method], f.errors[method]
+}
+
+// SetNetem implements pb.ChaosDaemonClient
+func (f *FakeDaemon) SetNetem(ctx context.Context, in *pb.NetemRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("SetNetem", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteNetem implements pb.ChaosDaemonClient
+func (f *FakeDaemon) DeleteNetem(ctx context.Context, in *pb.NetemRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("DeleteNetem", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// SetTbf implements pb.ChaosDaemonClient
+func (f *FakeDaemon) SetTbf(ctx context.Context, in *pb.TbfRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("SetTbf", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteTbf implements pb.ChaosDaemonClient
+func (f *FakeDaemon) DeleteTbf(ctx context.Context, in *pb.TbfRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("DeleteTbf", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// AddQdisc implements pb.ChaosDaemonClient
+func (f *FakeDaemon) AddQdisc(ctx context.Context, in *pb.QdiscRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("AddQdisc", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// DelQdisc implements pb.ChaosDaemonClient
+func (f *FakeDaemon) DelQdisc(ctx context.Context, in *pb.QdiscRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("DelQdisc", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// AddEmatchFilter implements pb.ChaosDaemonClient
+func (f *FakeDaemon) AddEmatchFilter(ctx context.Context, in *pb.EmatchFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("AddEmatchFilter", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// DelTcFilter implements pb.ChaosDaemonClient
+func (f *FakeDaemon) DelTcFilter(ctx context.Context, in *pb.TcFilterRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("DelTcFilter", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// FlushIpSet implements pb.ChaosDaemonClient
+func (f *FakeDaemon) FlushIpSet(ctx context.Context, in *pb.IpSetRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("FlushIpSet", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// FlushIptables implements pb.ChaosDaemonClient
+func (f *FakeDaemon) FlushIptables(ctx context.Context, in *pb.IpTablesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("FlushIptables", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// SetTimeOffset implements pb.ChaosDaemonClient
+func (f *FakeDaemon) SetTimeOffset(ctx context.Context, in *pb.TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("SetTimeOffset", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// RecoverTimeOffset implements pb.ChaosDaemonClient
+func (f *FakeDaemon) RecoverTimeOffset(ctx context.Context, in *pb.TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("RecoverTimeOffset", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// ContainerKill implements pb.ChaosDaemonClient
+func (f *FakeDaemon) ContainerKill(ctx context.Context, in *pb.ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("ContainerKill", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// ContainerGetPid implements pb.ChaosDaemonClient
+func (f *FakeDaemon) ContainerGetPid(ctx context.Context, in *pb.ContainerRequest, opts ...grpc.CallOption) (*pb.ContainerResponse, error) {
+	resp, err := f.call("ContainerGetPid", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*pb.ContainerResponse), nil
+	}
+	return &pb.ContainerResponse{}, nil
+}
+
+// ExecStressors implements pb.ChaosDaemonClient
+func (f *FakeDaemon) ExecStressors(ctx context.Context, in *pb.ExecStressRequest, opts ...grpc.CallOption) (*pb.ExecStressResponse, error) {
+	resp, err := f.call("ExecStressors", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*pb.ExecStressResponse), nil
+	}
+	return &pb.ExecStressResponse{}, nil
+}
+
+// CancelStressors implements pb.ChaosDaemonClient
+func (f *FakeDaemon) CancelStressors(ctx context.Context, in *pb.CancelStressRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("CancelStressors", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// Cleanup implements pb.ChaosDaemonClient
+func (f *FakeDaemon) Cleanup(ctx context.Context, in *pb.CleanupRequest, opts ...grpc.CallOption) (*pb.CleanupResponse, error) {
+	resp, err := f.call("Cleanup", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*pb.CleanupResponse), nil
+	}
+	return &pb.CleanupResponse{}, nil
+}
+
+// GetCapabilities implements pb.ChaosDaemonClient
+func (f *FakeDaemon) GetCapabilities(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*pb.CapabilitiesResponse, error) {
+	resp, err := f.call("GetCapabilities", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*pb.CapabilitiesResponse), nil
+	}
+	return &pb.CapabilitiesResponse{}, nil
+}
+
+// BatchSetNetem implements pb.ChaosDaemonClient
+func (f *FakeDaemon) BatchSetNetem(ctx context.Context, in *pb.BatchNetemRequest, opts ...grpc.CallOption) (*pb.BatchResponse, error) {
+	resp, err := f.call("BatchSetNetem", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*pb.BatchResponse), nil
+	}
+	return &pb.BatchResponse{}, nil
+}
+
+// BatchDeleteNetem implements pb.ChaosDaemonClient
+func (f *FakeDaemon) BatchDeleteNetem(ctx context.Context, in *pb.BatchNetemRequest, opts ...grpc.CallOption) (*pb.BatchResponse, error) {
+	resp, err := f.call("BatchDeleteNetem", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*pb.BatchResponse), nil
+	}
+	return &pb.BatchResponse{}, nil
+}
+
+// BatchSetTbf implements pb.ChaosDaemonClient
+func (f *FakeDaemon) BatchSetTbf(ctx context.Context, in *pb.BatchTbfRequest, opts ...grpc.CallOption) (*pb.BatchResponse, error) {
+	resp, err := f.call("BatchSetTbf", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*pb.BatchResponse), nil
+	}
+	return &pb.BatchResponse{}, nil
+}
+
+// BatchDeleteTbf implements pb.ChaosDaemonClient
+func (f *FakeDaemon) BatchDeleteTbf(ctx context.Context, in *pb.BatchTbfRequest, opts ...grpc.CallOption) (*pb.BatchResponse, error) {
+	resp, err := f.call("BatchDeleteTbf", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*pb.BatchResponse), nil
+	}
+	return &pb.BatchResponse{}, nil
+}
+
+// BatchFlushIpSet implements pb.ChaosDaemonClient
+func (f *FakeDaemon) BatchFlushIpSet(ctx context.Context, in *pb.BatchIpSetRequest, opts ...grpc.CallOption) (*pb.BatchResponse, error) {
+	resp, err := f.call("BatchFlushIpSet", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*pb.BatchResponse), nil
+	}
+	return &pb.BatchResponse{}, nil
+}
+
+// BatchFlushIptables implements pb.ChaosDaemonClient
+func (f *FakeDaemon) BatchFlushIptables(ctx context.Context, in *pb.BatchIpTablesRequest, opts ...grpc.CallOption) (*pb.BatchResponse, error) {
+	resp, err := f.call("BatchFlushIptables", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*pb.BatchResponse), nil
+	}
+	return &pb.BatchResponse{}, nil
+}
+
+// SetBpfFault implements pb.ChaosDaemonClient
+func (f *FakeDaemon) SetBpfFault(ctx context.Context, in *pb.BpfFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("SetBpfFault", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteBpfFault implements pb.ChaosDaemonClient
+func (f *FakeDaemon) DeleteBpfFault(ctx context.Context, in *pb.BpfFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("DeleteBpfFault", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// SetConntrackLimit implements pb.ChaosDaemonClient
+func (f *FakeDaemon) SetConntrackLimit(ctx context.Context, in *pb.ConntrackRequest, opts ...grpc.CallOption) (*pb.ConntrackResponse, error) {
+	resp, err := f.call("SetConntrackLimit", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*pb.ConntrackResponse), nil
+	}
+	return &pb.ConntrackResponse{}, nil
+}
+
+// DeleteConntrackLimit implements pb.ChaosDaemonClient
+func (f *FakeDaemon) DeleteConntrackLimit(ctx context.Context, in *pb.ConntrackRequest, opts ...grpc.CallOption) (*pb.ConntrackResponse, error) {
+	resp, err := f.call("DeleteConntrackLimit", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*pb.ConntrackResponse), nil
+	}
+	return &pb.ConntrackResponse{}, nil
+}
+
+// SetMtu implements pb.ChaosDaemonClient
+func (f *FakeDaemon) SetMtu(ctx context.Context, in *pb.MtuRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("SetMtu", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteMtu implements pb.ChaosDaemonClient
+func (f *FakeDaemon) DeleteMtu(ctx context.Context, in *pb.MtuRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("DeleteMtu", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// BlockNodePorts implements pb.ChaosDaemonClient
+func (f *FakeDaemon) BlockNodePorts(ctx context.Context, in *pb.NodePortsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("BlockNodePorts", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// UnblockNodePorts implements pb.ChaosDaemonClient
+func (f *FakeDaemon) UnblockNodePorts(ctx context.Context, in *pb.NodePortsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("UnblockNodePorts", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// SandboxKill implements pb.ChaosDaemonClient
+func (f *FakeDaemon) SandboxKill(ctx context.Context, in *pb.ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("SandboxKill", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// ExhaustResources implements pb.ChaosDaemonClient
+func (f *FakeDaemon) ExhaustResources(ctx context.Context, in *pb.ExhaustRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("ExhaustResources", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// RecoverResources implements pb.ChaosDaemonClient
+func (f *FakeDaemon) RecoverResources(ctx context.Context, in *pb.ExhaustRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("RecoverResources", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// SetTimerSlack implements pb.ChaosDaemonClient
+func (f *FakeDaemon) SetTimerSlack(ctx context.Context, in *pb.TimerSlackRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("SetTimerSlack", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// ResetTimerSlack implements pb.ChaosDaemonClient
+func (f *FakeDaemon) ResetTimerSlack(ctx context.Context, in *pb.TimerSlackRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("ResetTimerSlack", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// ContainerSignal implements pb.ChaosDaemonClient
+func (f *FakeDaemon) ContainerSignal(ctx context.Context, in *pb.SignalRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("ContainerSignal", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// CorruptConfig implements pb.ChaosDaemonClient
+func (f *FakeDaemon) CorruptConfig(ctx context.Context, in *pb.ConfigRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("CorruptConfig", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// RecoverConfig implements pb.ChaosDaemonClient
+func (f *FakeDaemon) RecoverConfig(ctx context.Context, in *pb.ConfigRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("RecoverConfig", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// SetDBFault implements pb.ChaosDaemonClient
+func (f *FakeDaemon) SetDBFault(ctx context.Context, in *pb.DBFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("SetDBFault", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteDBFault implements pb.ChaosDaemonClient
+func (f *FakeDaemon) DeleteDBFault(ctx context.Context, in *pb.DBFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("DeleteDBFault", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// FailoverDB implements pb.ChaosDaemonClient
+func (f *FakeDaemon) FailoverDB(ctx context.Context, in *pb.DBFailoverRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	resp, err := f.call("FailoverDB", in)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp.(*empty.Empty), nil
+	}
+	return &empty.Empty{}, nil
+}
+
+// WatchStatus implements pb.ChaosDaemonClient, the stream receives no events until ctx is done
+func (f *FakeDaemon) WatchStatus(ctx context.Context, in *pb.WatchStatusRequest, opts ...grpc.CallOption) (pb.ChaosDaemon_WatchStatusClient, error) {
+	if _, err := f.call("WatchStatus", in); err != nil {
+		return nil, err
+	}
+	return &idleStatusStream{ctx: ctx}, nil
+}
+
+// idleStatusStream is a status stream without events
+type idleStatusStream struct {
+	grpc.ClientStream
+	ctx context.Context
+}
+
+func (s *idleStatusStream) Recv() (*pb.StatusEvent, error) {
+	<-s.ctx.Done()
+	return nil, s.ctx.Err()
+}
+
+func (s *idleStatusStream) Header() (metadata.MD, error) {
+	return metadata.MD{}, nil
+}
+
+func (s *idleStatusStream) Context() context.Context {
+	return s.ctx
+}
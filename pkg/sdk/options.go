@@ -0,0 +1,551 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// Option customizes a chaos built by the builders. It returns an error if the chaos doesn't support it.
+type Option func(obj runtime.Object) error
+
+// commonFields points to the fields shared by the specs of chaos,
+// the fields which the chaos doesn't have are nil
+type commonFields struct {
+	mode            *v1alpha1.PodMode
+	value           *string
+	selector        *v1alpha1.SelectorSpec
+	duration        **string
+	scheduler       **v1alpha1.SchedulerSpec
+	recoveryTimeout **string
+	retryPolicy     **v1alpha1.SelectorRetryPolicy
+}
+
+func fieldsOf(obj runtime.Object) *commonFields {
+	switch chaos := obj.(type) {
+	case *v1alpha1.PodChaos:
+		spec := &chaos.Spec
+		return &commonFields{&spec.Mode, &spec.Value, &spec.Selector, &spec.Duration, &spec.Scheduler, &spec.RecoveryTimeout, &spec.SelectorRetryPolicy}
+	case *v1alpha1.NetworkChaos:
+		spec := &chaos.Spec
+		return &commonFields{&spec.Mode, &spec.Value, &spec.Selector, &spec.Duration, &spec.Scheduler, &spec.RecoveryTimeout, &spec.SelectorRetryPolicy}
+	case *v1alpha1.IoChaos:
+		spec := &chaos.Spec
+		return &commonFields{&spec.Mode, &spec.Value, &spec.Selector, &spec.Duration, &spec.Scheduler, &spec.RecoveryTimeout, &spec.SelectorRetryPolicy}
+	case *v1alpha1.TimeChaos:
+		spec := &chaos.Spec
+		return &commonFields{&spec.Mode, &spec.Value, &spec.Selector, &spec.Duration, &spec.Scheduler, &spec.RecoveryTimeout, &spec.SelectorRetryPolicy}
+	case *v1alpha1.KernelChaos:
+		spec := &chaos.Spec
+		return &commonFields{&spec.Mode, &spec.Value, &spec.Selector, &spec.Duration, &spec.Scheduler, &spec.RecoveryTimeout, &spec.SelectorRetryPolicy}
+	case *v1alpha1.StressChaos:
+		spec := &chaos.Spec
+		return &commonFields{&spec.Mode, &spec.Value, &spec.Selector, &spec.Duration, &spec.Scheduler, &spec.RecoveryTimeout, &spec.SelectorRetryPolicy}
+	case *v1alpha1.PhysicalMachineChaos:
+		spec := &chaos.Spec
+		return &commonFields{duration: &spec.Duration, scheduler: &spec.Scheduler, recoveryTimeout: &spec.RecoveryTimeout}
+	}
+	return &commonFields{}
+}
+
+func unsupported(obj runtime.Object, option string) error {
+	return fmt.Errorf("%s doesn't support %s", obj.GetObjectKind().GroupVersionKind().Kind, option)
+}
+
+// withSelector applies f to the selector of the chaos
+func withSelector(f func(selector *v1alpha1.SelectorSpec)) Option {
+	return func(obj runtime.Object) error {
+		fields := fieldsOf(obj)
+		if fields.selector == nil {
+			return unsupported(obj, "selector")
+		}
+		f(fields.selector)
+		return nil
+	}
+}
+
+// Labels sets the labels of the chaos
+func Labels(labels map[string]string) Option {
+	return func(obj runtime.Object) error {
+		meta, ok := obj.(metav1.Object)
+		if !ok {
+			return unsupported(obj, "labels")
+		}
+		meta.SetLabels(labels)
+		return nil
+	}
+}
+
+// Annotations sets the annotations of the chaos
+func Annotations(annotations map[string]string) Option {
+	return func(obj runtime.Object) error {
+		meta, ok := obj.(metav1.Object)
+		if !ok {
+			return unsupported(obj, "annotations")
+		}
+		meta.SetAnnotations(annotations)
+		return nil
+	}
+}
+
+// Mode sets the mode and the value of the mode which the targets are selected in
+func Mode(mode v1alpha1.PodMode, value string) Option {
+	return func(obj runtime.Object) error {
+		fields := fieldsOf(obj)
+		if fields.mode == nil {
+			return unsupported(obj, "mode")
+		}
+		*fields.mode = mode
+		*fields.value = value
+		return nil
+	}
+}
+
+// Selector replaces the selector of the chaos
+func Selector(selector v1alpha1.SelectorSpec) Option {
+	return withSelector(func(s *v1alpha1.SelectorSpec) {
+		*s = selector
+	})
+}
+
+// Namespaces selects the pods in the namespaces
+func Namespaces(namespaces ...string) Option {
+	return withSelector(func(s *v1alpha1.SelectorSpec) {
+		s.Namespaces = append(s.Namespaces, namespaces...)
+	})
+}
+
+// LabelSelectors selects the pods with the labels
+func LabelSelectors(labels map[string]string) Option {
+	return withSelector(func(s *v1alpha1.SelectorSpec) {
+		if s.LabelSelectors == nil {
+			s.LabelSelectors = make(map[string]string)
+		}
+		for k, v := range labels {
+			s.LabelSelectors[k] = v
+		}
+	})
+}
+
+// Pods selects the pods with the names in the namespace
+func Pods(namespace string, names ...string) Option {
+	return withSelector(func(s *v1alpha1.SelectorSpec) {
+		if s.Pods == nil {
+			s.Pods = make(map[string][]string)
+		}
+		s.Pods[namespace] = append(s.Pods[namespace], names...)
+	})
+}
+
+// Duration sets the duration of each run of the chaos, e.g. `30s`
+func Duration(duration string) Option {
+	return func(obj runtime.Object) error {
+		fields := fieldsOf(obj)
+		if fields.duration == nil {
+			return unsupported(obj, "duration")
+		}
+		*fields.duration = &duration
+		return nil
+	}
+}
+
+// RecoveryTimeout limits the recovery of the deleted chaos, e.g. `5m`.
+// The chaos is cleaned up forcibly if the recovery isn't completed in time.
+func RecoveryTimeout(timeout string) Option {
+	return func(obj runtime.Object) error {
+		fields := fieldsOf(obj)
+		if fields.recoveryTimeout == nil {
+			return unsupported(obj, "recovery timeout")
+		}
+		*fields.recoveryTimeout = &timeout
+		return nil
+	}
+}
+
+// SelectorRetryPolicy retries the selection with backoff within the window, e.g. `5m`,
+// if no pod meets the selector when the chaos is applied
+func SelectorRetryPolicy(window string) Option {
+	return func(obj runtime.Object) error {
+		fields := fieldsOf(obj)
+		if fields.retryPolicy == nil {
+			return unsupported(obj, "selector retry policy")
+		}
+		*fields.retryPolicy = &v1alpha1.SelectorRetryPolicy{Window: window}
+		return nil
+	}
+}
+
+// FailurePolicy sets how to handle the chaos which failed to be applied on some of the pods
+func FailurePolicy(policy v1alpha1.FailurePolicy) Option {
+	return func(obj runtime.Object) error {
+		switch chaos := obj.(type) {
+		case *v1alpha1.PodChaos:
+			chaos.Spec.FailurePolicy = policy
+		case *v1alpha1.StressChaos:
+			chaos.Spec.FailurePolicy = policy
+		case *v1alpha1.TimeChaos:
+			chaos.Spec.FailurePolicy = policy
+		default:
+			return unsupported(obj, "failure policy")
+		}
+		return nil
+	}
+}
+
+// ControlGroupPercent leaves the percentage of the selected pods untouched as the control group
+func ControlGroupPercent(percent int) Option {
+	return func(obj runtime.Object) error {
+		switch chaos := obj.(type) {
+		case *v1alpha1.PodChaos:
+			chaos.Spec.ControlGroupPercent = percent
+		case *v1alpha1.StressChaos:
+			chaos.Spec.ControlGroupPercent = percent
+		case *v1alpha1.TimeChaos:
+			chaos.Spec.ControlGroupPercent = percent
+		default:
+			return unsupported(obj, "control group")
+		}
+		return nil
+	}
+}
+
+// StaggerInterval injects the chaos into the selected pods one by one by the interval, e.g. `30s`
+func StaggerInterval(interval string) Option {
+	return func(obj runtime.Object) error {
+		switch chaos := obj.(type) {
+		case *v1alpha1.PodChaos:
+			chaos.Spec.StaggerInterval = &interval
+		case *v1alpha1.StressChaos:
+			chaos.Spec.StaggerInterval = &interval
+		case *v1alpha1.TimeChaos:
+			chaos.Spec.StaggerInterval = &interval
+		case *v1alpha1.DBChaos:
+			chaos.Spec.StaggerInterval = &interval
+		default:
+			return unsupported(obj, "stagger interval")
+		}
+		return nil
+	}
+}
+
+// Simulate runs the chaos without injecting it, the targets are selected and recorded but left untouched
+func Simulate() Option {
+	return func(obj runtime.Object) error {
+		switch chaos := obj.(type) {
+		case *v1alpha1.PodChaos:
+			chaos.Spec.Simulate = true
+		case *v1alpha1.NetworkChaos:
+			chaos.Spec.Simulate = true
+		case *v1alpha1.IoChaos:
+			chaos.Spec.Simulate = true
+		case *v1alpha1.TimeChaos:
+			chaos.Spec.Simulate = true
+		case *v1alpha1.KernelChaos:
+			chaos.Spec.Simulate = true
+		case *v1alpha1.StressChaos:
+			chaos.Spec.Simulate = true
+		case *v1alpha1.PhysicalMachineChaos:
+			chaos.Spec.Simulate = true
+		case *v1alpha1.DBChaos:
+			chaos.Spec.Simulate = true
+		default:
+			return unsupported(obj, "simulation")
+		}
+		return nil
+	}
+}
+
+// Scheduler runs the chaos periodically by the cron rule, e.g. `@every 5m`.
+// The duration must be set together.
+func Scheduler(cron string) Option {
+	return func(obj runtime.Object) error {
+		fields := fieldsOf(obj)
+		if fields.scheduler == nil {
+			return unsupported(obj, "scheduler")
+		}
+		*fields.scheduler = &v1alpha1.SchedulerSpec{Cron: cron}
+		return nil
+	}
+}
+
+// ContainerName adds the container which the PodChaos kills
+func ContainerName(name string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.PodChaos)
+		if !ok {
+			return unsupported(obj, "container name")
+		}
+		chaos.Spec.ContainerNames = append(chaos.Spec.ContainerNames, name)
+		return nil
+	}
+}
+
+// NetworkDelay sets the delay of the NetworkChaos, e.g. `100ms`
+func NetworkDelay(latency string, jitter string, correlation string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.NetworkChaos)
+		if !ok {
+			return unsupported(obj, "network delay")
+		}
+		chaos.Spec.Delay = &v1alpha1.DelaySpec{
+			Latency:     latency,
+			Jitter:      jitter,
+			Correlation: correlation,
+		}
+		return nil
+	}
+}
+
+// NetworkLoss sets the percentage of the packets lost by the NetworkChaos, e.g. `25`
+func NetworkLoss(loss string, correlation string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.NetworkChaos)
+		if !ok {
+			return unsupported(obj, "network loss")
+		}
+		chaos.Spec.Loss = &v1alpha1.LossSpec{
+			Loss:        loss,
+			Correlation: correlation,
+		}
+		return nil
+	}
+}
+
+// NetworkCorrupt sets the percentage of the packets corrupted by the NetworkChaos, e.g. `25`
+func NetworkCorrupt(corrupt string, correlation string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.NetworkChaos)
+		if !ok {
+			return unsupported(obj, "network corrupt")
+		}
+		chaos.Spec.Corrupt = &v1alpha1.CorruptSpec{
+			Corrupt:     corrupt,
+			Correlation: correlation,
+		}
+		return nil
+	}
+}
+
+// NetworkDuplicate sets the percentage of the packets duplicated by the NetworkChaos, e.g. `25`
+func NetworkDuplicate(duplicate string, correlation string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.NetworkChaos)
+		if !ok {
+			return unsupported(obj, "network duplicate")
+		}
+		chaos.Spec.Duplicate = &v1alpha1.DuplicateSpec{
+			Duplicate:   duplicate,
+			Correlation: correlation,
+		}
+		return nil
+	}
+}
+
+// NetworkBandwidth sets the bandwidth limit of the NetworkChaos, e.g. `1mbps`
+func NetworkBandwidth(rate string, limit uint32, buffer uint32) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.NetworkChaos)
+		if !ok {
+			return unsupported(obj, "network bandwidth")
+		}
+		chaos.Spec.Bandwidth = &v1alpha1.BandwidthSpec{
+			Rate:   rate,
+			Limit:  limit,
+			Buffer: buffer,
+		}
+		return nil
+	}
+}
+
+// NetworkTarget sets the direction and the target pods of the NetworkChaos
+func NetworkTarget(direction v1alpha1.Direction, selector v1alpha1.SelectorSpec, mode v1alpha1.PodMode, value string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.NetworkChaos)
+		if !ok {
+			return unsupported(obj, "network target")
+		}
+		chaos.Spec.Direction = direction
+		chaos.Spec.Target = &v1alpha1.Target{
+			TargetSelector: selector,
+			TargetMode:     mode,
+			TargetValue:    value,
+		}
+		return nil
+	}
+}
+
+// NetworkTargetService adds a Service as the target of the NetworkChaos, the namespace of the chaos is used if namespace is empty
+func NetworkTargetService(namespace string, name string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.NetworkChaos)
+		if !ok {
+			return unsupported(obj, "network target service")
+		}
+		chaos.Spec.TargetServices = append(chaos.Spec.TargetServices, v1alpha1.ServiceReference{
+			Namespace: namespace,
+			Name:      name,
+		})
+		return nil
+	}
+}
+
+// NetworkDevice sets the glob pattern of the network interfaces which the NetworkChaos is applied on, e.g. `eth*`
+func NetworkDevice(device string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.NetworkChaos)
+		if !ok {
+			return unsupported(obj, "network device")
+		}
+		chaos.Spec.Device = device
+		return nil
+	}
+}
+
+// IOPath sets the path and the methods of the file system operations which the IoChaos affects
+func IOPath(path string, methods ...string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.IoChaos)
+		if !ok {
+			return unsupported(obj, "io path")
+		}
+		chaos.Spec.Path = path
+		chaos.Spec.Methods = methods
+		return nil
+	}
+}
+
+// IODelay sets the delay of the file system operations, e.g. `100ms`
+func IODelay(delay string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.IoChaos)
+		if !ok {
+			return unsupported(obj, "io delay")
+		}
+		chaos.Spec.Delay = delay
+		return nil
+	}
+}
+
+// IOErrno sets the errno returned by the file system operations, e.g. `5`
+func IOErrno(errno string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.IoChaos)
+		if !ok {
+			return unsupported(obj, "io errno")
+		}
+		chaos.Spec.Errno = errno
+		return nil
+	}
+}
+
+// IOPercent sets the percentage of the file system operations which the IoChaos affects, e.g. `50`
+func IOPercent(percent string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.IoChaos)
+		if !ok {
+			return unsupported(obj, "io percent")
+		}
+		chaos.Spec.Percent = percent
+		return nil
+	}
+}
+
+// IOPercentScope sets what the percentage applies on, the operations, the files or the fds
+func IOPercentScope(scope v1alpha1.IOPercentScope) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.IoChaos)
+		if !ok {
+			return unsupported(obj, "io percent scope")
+		}
+		chaos.Spec.PercentScope = scope
+		return nil
+	}
+}
+
+// ContainerNames sets the containers whose clocks are shifted by the TimeChaos
+func ContainerNames(names ...string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.TimeChaos)
+		if !ok {
+			return unsupported(obj, "container names")
+		}
+		chaos.Spec.ContainerNames = names
+		return nil
+	}
+}
+
+// ClockIds sets the clocks which the TimeChaos shifts, e.g. `CLOCK_REALTIME`
+func ClockIds(ids ...string) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.TimeChaos)
+		if !ok {
+			return unsupported(obj, "clock ids")
+		}
+		chaos.Spec.ClockIds = ids
+		return nil
+	}
+}
+
+// PhysicalNetwork sets the network faults injected into the physical machines
+func PhysicalNetwork(network v1alpha1.PhysicalNetworkSpec) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.PhysicalMachineChaos)
+		if !ok {
+			return unsupported(obj, "physical network")
+		}
+		chaos.Spec.Network = &network
+		return nil
+	}
+}
+
+// PhysicalStress sets the stress faults injected into the physical machines
+func PhysicalStress(stress v1alpha1.PhysicalStressSpec) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.PhysicalMachineChaos)
+		if !ok {
+			return unsupported(obj, "physical stress")
+		}
+		chaos.Spec.Stress = &stress
+		return nil
+	}
+}
+
+// PhysicalDisk sets the disk faults injected into the physical machines
+func PhysicalDisk(disk v1alpha1.PhysicalDiskSpec) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.PhysicalMachineChaos)
+		if !ok {
+			return unsupported(obj, "physical disk")
+		}
+		chaos.Spec.Disk = &disk
+		return nil
+	}
+}
+
+// PhysicalProcess sets the process faults injected into the physical machines
+func PhysicalProcess(process v1alpha1.PhysicalProcessSpec) Option {
+	return func(obj runtime.Object) error {
+		chaos, ok := obj.(*v1alpha1.PhysicalMachineChaos)
+		if !ok {
+			return unsupported(obj, "physical process")
+		}
+		chaos.Spec.Process = &process
+		return nil
+	}
+}
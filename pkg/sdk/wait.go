@@ -0,0 +1,88 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// PollInterval is the interval between two checks of the status of chaos
+var PollInterval = time.Second
+
+// WaitForInjected waits until the chaos is injected into its targets, it returns an error
+// if the injection fails or the context is done
+func WaitForInjected(ctx context.Context, c client.Client, obj v1alpha1.InnerObject) error {
+	return WaitForPhase(ctx, c, obj, v1alpha1.ExperimentPhaseRunning)
+}
+
+// WaitForRecovered waits until the chaos is recovered from its targets, that is finished or paused
+func WaitForRecovered(ctx context.Context, c client.Client, obj v1alpha1.InnerObject) error {
+	return WaitForPhase(ctx, c, obj, v1alpha1.ExperimentPhaseFinished, v1alpha1.ExperimentPhasePaused)
+}
+
+// WaitForPhase waits until the experiment of the chaos is in any of the phases, and keeps obj
+// up to date. It returns an error once the experiment fails, unless the failed phase is expected.
+func WaitForPhase(ctx context.Context, c client.Client, obj v1alpha1.InnerObject, phases ...v1alpha1.ExperimentPhase) error {
+	meta, ok := obj.(metav1.Object)
+	if !ok {
+		return fmt.Errorf("%T has no object meta", obj)
+	}
+	key := types.NamespacedName{Namespace: meta.GetNamespace(), Name: meta.GetName()}
+
+	err := wait.PollImmediateUntil(PollInterval, func() (bool, error) {
+		if err := c.Get(ctx, key, obj); err != nil {
+			return false, err
+		}
+
+		phase := obj.GetStatus().Experiment.Phase
+		for _, expected := range phases {
+			if phase == expected {
+				return true, nil
+			}
+		}
+
+		if phase == v1alpha1.ExperimentPhaseFailed {
+			return false, failedError(obj)
+		}
+		return false, nil
+	}, ctx.Done())
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("chaos %s is still %s: %v", key, obj.GetStatus().Experiment.Phase, ctx.Err())
+	}
+	return err
+}
+
+func failedError(obj v1alpha1.InnerObject) error {
+	instance := obj.GetChaos()
+	status := obj.GetStatus()
+
+	var failures []string
+	for _, record := range status.Experiment.FailedRecords {
+		failures = append(failures, fmt.Sprintf("%s/%s: %s", record.Namespace, record.Name, record.Error))
+	}
+	if len(failures) == 0 {
+		return fmt.Errorf("%s %s/%s failed: %s", instance.Kind, instance.Namespace, instance.Name, status.Reason)
+	}
+	return fmt.Errorf("%s %s/%s failed on pods %s", instance.Kind, instance.Namespace, instance.Name, strings.Join(failures, ", "))
+}
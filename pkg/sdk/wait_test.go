@@ -0,0 +1,59 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestWaitForInjected(t *testing.T) {
+	g := NewGomegaWithT(t)
+	PollInterval = 10 * time.Millisecond
+
+	chaos, err := NewPodChaos("default", "failure", v1alpha1.PodFailureAction)
+	g.Expect(err).ToNot(HaveOccurred())
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseRunning
+	c := fake.NewFakeClientWithScheme(Scheme, chaos)
+
+	obj := &v1alpha1.PodChaos{}
+	obj.Namespace, obj.Name = "default", "failure"
+	g.Expect(WaitForInjected(context.Background(), c, obj)).To(Succeed())
+	g.Expect(obj.Status.Experiment.Phase).To(Equal(v1alpha1.ExperimentPhaseRunning))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	g.Expect(WaitForRecovered(ctx, c, obj)).To(HaveOccurred())
+}
+
+func TestWaitForFailed(t *testing.T) {
+	g := NewGomegaWithT(t)
+	PollInterval = 10 * time.Millisecond
+
+	chaos, err := NewPodChaos("default", "failure", v1alpha1.PodFailureAction)
+	g.Expect(err).ToNot(HaveOccurred())
+	chaos.Status.Experiment.Phase = v1alpha1.ExperimentPhaseFailed
+	chaos.Status.Experiment.FailedRecords = []v1alpha1.FailedPodStatus{{Namespace: "default", Name: "web-0", Error: "timeout"}}
+	c := fake.NewFakeClientWithScheme(Scheme, chaos)
+
+	obj := &v1alpha1.PodChaos{}
+	obj.Namespace, obj.Name = "default", "failure"
+	g.Expect(WaitForInjected(context.Background(), c, obj)).To(MatchError("PodChaos default/failure failed on pods default/web-0: timeout"))
+}
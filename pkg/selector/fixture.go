@@ -0,0 +1,114 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selector
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+}
+
+// NewFakeClient returns a fake client serving the objects, e.g. the pods, nodes, namespaces
+// and ChaosProtections which the selection depends on
+func NewFakeClient(objects ...runtime.Object) client.Client {
+	return fake.NewFakeClientWithScheme(scheme, objects...)
+}
+
+// LoadFixtures loads the objects in the YAML or JSON files, such as the output of
+// `kubectl get pods,nodes,namespaces -A -o yaml`
+func LoadFixtures(paths ...string) ([]runtime.Object, error) {
+	var objects []runtime.Object
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		objs, err := DecodeFixtures(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load fixtures from %s: %v", path, err)
+		}
+		objects = append(objects, objs...)
+	}
+
+	return objects, nil
+}
+
+// DecodeFixtures decodes the objects in the YAML or JSON documents, the items of the lists are decoded as well
+func DecodeFixtures(r io.Reader) ([]runtime.Object, error) {
+	var objects []runtime.Object
+
+	decoder := yamlutil.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var raw runtime.RawExtension
+		if err := decoder.Decode(&raw); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		data := bytes.TrimSpace(raw.Raw)
+		if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+			continue
+		}
+
+		objs, err := decodeFixture(data)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, objs...)
+	}
+
+	return objects, nil
+}
+
+func decodeFixture(data []byte) ([]runtime.Object, error) {
+	obj, _, err := serializer.NewCodecFactory(scheme).UniversalDeserializer().Decode(data, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	list, ok := obj.(*v1.List)
+	if !ok {
+		return []runtime.Object{obj}, nil
+	}
+
+	var objects []runtime.Object
+	for _, item := range list.Items {
+		objs, err := decodeFixture(item.Raw)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, objs...)
+	}
+	return objects, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package selector selects the pods of chaos experiments like the controller does, against any
+// client. With the fake client of the fixtures, e.g. the snapshots of the inventory of a cluster,
+// the selectors of the experiments are able to be tested in unit tests before they're run for real.
+package selector
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/targetprovider"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// Options configures the selector
+type Options struct {
+	// NamespacePolicy is the namespace policy of the controller, e.g. its allowed namespaces,
+	// all the namespaces are allowed by default
+	NamespacePolicy utils.NamespacePolicy
+	// Seed seeds the random choice of the pods in the random modes, so the selection is reproducible
+	Seed int64
+	// Provider vetoes or supplies the candidates like the target provider of the controller, if it's not nil
+	Provider targetprovider.Provider
+}
+
+// Result is the result of a selection
+type Result struct {
+	// Pods are the selected pods
+	Pods []v1.Pod
+	// Selection records the numbers of the pods matched, filtered, protected and selected,
+	// it's recorded even if no pod is selected
+	Selection v1alpha1.SelectionStatus
+}
+
+// Selector selects the pods of chaos experiments
+type Selector interface {
+	// Select selects the pods of the spec, e.g. the spec of a chaos or a Spec. The errors are
+	// the utils.SelectionErrors whose reasons are the ones of the Selected condition.
+	Select(ctx context.Context, spec utils.SelectSpec) (*Result, error)
+}
+
+// Spec is a SelectSpec built from its fields, for the selectors not belonging to any chaos
+type Spec struct {
+	Selector v1alpha1.SelectorSpec
+	Mode     v1alpha1.PodMode
+	Value    string
+}
+
+func (s Spec) GetSelector() v1alpha1.SelectorSpec {
+	return s.Selector
+}
+
+func (s Spec) GetMode() v1alpha1.PodMode {
+	return s.Mode
+}
+
+func (s Spec) GetValue() string {
+	return s.Value
+}
+
+type selector struct {
+	sync.Mutex
+	podSelector *utils.PodSelector
+}
+
+// New returns a Selector which selects the pods from the client with the options
+func New(c client.Client, opts Options) Selector {
+	return &selector{
+		podSelector: &utils.PodSelector{
+			Client:   c,
+			Policy:   opts.NamespacePolicy,
+			Rand:     rand.New(rand.NewSource(opts.Seed)),
+			Provider: opts.Provider,
+		},
+	}
+}
+
+func (s *selector) Select(ctx context.Context, spec utils.SelectSpec) (*Result, error) {
+	// the source of the random choice isn't safe for concurrent use
+	s.Lock()
+	defer s.Unlock()
+
+	result := &Result{}
+	pods, err := s.podSelector.SelectAndRecordPods(ctx, spec, &result.Selection)
+	result.Pods = pods
+	return result, err
+}
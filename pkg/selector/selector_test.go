@@ -0,0 +1,128 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selector
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+func TestLoadFixtures(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	objects, err := LoadFixtures("testdata/inventory.yaml")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(objects).To(HaveLen(7))
+	g.Expect(objects[6]).To(BeAssignableToTypeOf(&v1alpha1.ChaosProtection{}))
+
+	_, err = LoadFixtures("testdata/missing.yaml")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestSelect(t *testing.T) {
+	objects, err := LoadFixtures("testdata/inventory.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := New(NewFakeClient(objects...), Options{
+		NamespacePolicy: utils.NamespacePolicy{IgnoredNamespaces: "kube-system"},
+		Seed:            1,
+	})
+
+	Run(t, s, []Case{
+		{
+			Name: "all web pods",
+			Spec: Spec{
+				Selector: v1alpha1.SelectorSpec{LabelSelectors: map[string]string{"app": "web"}},
+				Mode:     v1alpha1.AllPodMode,
+			},
+			Want:          []string{"payments/web-0", "shop/web-0", "shop/web-1", "shop/web-2"},
+			WantSelection: &v1alpha1.SelectionStatus{Matched: 4, Selected: 4},
+		},
+		{
+			Name: "running web pods in shop",
+			Spec: Spec{
+				Selector: v1alpha1.SelectorSpec{
+					Namespaces:        []string{"shop"},
+					LabelSelectors:    map[string]string{"app": "web"},
+					PodPhaseSelectors: []string{"Running"},
+				},
+				Mode: v1alpha1.AllPodMode,
+			},
+			Want: []string{"shop/web-0", "shop/web-1"},
+		},
+		{
+			Name: "one pod of shop",
+			Spec: Spec{
+				Selector: v1alpha1.SelectorSpec{Namespaces: []string{"shop"}},
+				Mode:     v1alpha1.OnePodMode,
+			},
+			WantCount:     1,
+			WantSelection: &v1alpha1.SelectionStatus{Matched: 4, Protected: 1, Selected: 1},
+		},
+		{
+			Name: "protected db",
+			Spec: Spec{
+				Selector: v1alpha1.SelectorSpec{LabelSelectors: map[string]string{"app": "db"}},
+				Mode:     v1alpha1.AllPodMode,
+			},
+			WantReason:    v1alpha1.SelectedReasonNoPodSelected,
+			WantSelection: &v1alpha1.SelectionStatus{Matched: 1, Protected: 1},
+		},
+		{
+			Name: "ignored namespace",
+			Spec: Spec{
+				Selector: v1alpha1.SelectorSpec{Namespaces: []string{"kube-system"}},
+				Mode:     v1alpha1.AllPodMode,
+			},
+			WantReason:    v1alpha1.SelectedReasonNamespaceNotAllowed,
+			WantSelection: &v1alpha1.SelectionStatus{Matched: 1, FilteredByNamespace: 1},
+		},
+		{
+			Name: "invalid mode",
+			Spec: Spec{
+				Selector: v1alpha1.SelectorSpec{LabelSelectors: map[string]string{"app": "web"}},
+				Mode:     v1alpha1.FixedPodMode,
+				Value:    "two",
+			},
+			WantReason: v1alpha1.SelectedReasonInvalidMode,
+		},
+	})
+}
+
+func TestSelectReproducible(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	objects, err := LoadFixtures("testdata/inventory.yaml")
+	g.Expect(err).ToNot(HaveOccurred())
+	spec := Spec{
+		Selector: v1alpha1.SelectorSpec{LabelSelectors: map[string]string{"app": "web"}},
+		Mode:     v1alpha1.FixedPodMode,
+		Value:    "2",
+	}
+
+	var selected [][]string
+	for i := 0; i < 2; i++ {
+		result, err := New(NewFakeClient(objects...), Options{Seed: 42}).Select(context.Background(), spec)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Pods).To(HaveLen(2))
+		selected = append(selected, PodNames(result))
+	}
+	g.Expect(selected[0]).To(Equal(selected[1]))
+}
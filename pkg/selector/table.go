@@ -0,0 +1,110 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selector
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/utils"
+)
+
+// Case is a case of the table tests of selectors
+type Case struct {
+	Name string
+	Spec utils.SelectSpec
+	// Want are the pods expected to be selected in the form of namespace/name regardless of their order,
+	// they're not checked if it's nil, e.g. when the pods are chosen randomly
+	Want []string
+	// WantCount is the number of the pods expected to be selected if it's positive, e.g. in the random modes.
+	// No pod is selected if the selection fails, which is checked by WantReason.
+	WantCount int
+	// WantSelection is the expected numbers of the pods in each step of the selection if it's not nil
+	WantSelection *v1alpha1.SelectionStatus
+	// WantReason is the reason of the Selected condition expected when the selection fails,
+	// e.g. v1alpha1.SelectedReasonNoPodSelected
+	WantReason string
+}
+
+// Run runs the cases against the selector as the subtests of t
+func Run(t *testing.T, s Selector, cases []Case) {
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			result, err := s.Select(context.Background(), tc.Spec)
+			Check(t, tc, result, err)
+		})
+	}
+}
+
+// Check checks the result of the selection against the case
+func Check(t *testing.T, tc Case, result *Result, err error) {
+	t.Helper()
+
+	if tc.WantReason != "" {
+		var selectionErr *utils.SelectionError
+		if !errors.As(err, &selectionErr) {
+			t.Fatalf("want selection to fail with reason %s, got error %v", tc.WantReason, err)
+		}
+		if selectionErr.Reason() != tc.WantReason {
+			t.Fatalf("want selection to fail with reason %s, got reason %s: %v", tc.WantReason, selectionErr.Reason(), err)
+		}
+	} else if err != nil {
+		t.Fatalf("selection failed: %v", err)
+	}
+
+	got := PodNames(result)
+	if tc.Want != nil {
+		want := append([]string(nil), tc.Want...)
+		sort.Strings(want)
+		if !equal(got, want) {
+			t.Errorf("want pods %v to be selected, got %v", want, got)
+		}
+	}
+	if tc.WantCount > 0 && len(got) != tc.WantCount {
+		t.Errorf("want %d pods to be selected, got %d: %v", tc.WantCount, len(got), got)
+	}
+	if tc.WantSelection != nil && result.Selection != *tc.WantSelection {
+		t.Errorf("want selection %+v, got %+v", *tc.WantSelection, result.Selection)
+	}
+}
+
+// PodNames returns the selected pods in the form of namespace/name in order
+func PodNames(result *Result) []string {
+	if result == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(result.Pods))
+	for _, pod := range result.Pods {
+		names = append(names, pod.Namespace+"/"+pod.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simulation marks the operations of chaos which are simulated. The targets of a
+// simulated operation are selected and recorded as usual, but the clients of chaos-daemon,
+// chaosfs, BPFKI and chaosd skip their calls, and the pods aren't modified.
+package simulation
+
+import "context"
+
+type simulatedKey struct{}
+
+// WithSimulation returns the context of a simulated operation
+func WithSimulation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, simulatedKey{}, true)
+}
+
+// IsSimulated returns true if the operation of the context is simulated
+func IsSimulated(ctx context.Context) bool {
+	simulated, _ := ctx.Value(simulatedKey{}).(bool)
+	return simulated
+}
@@ -0,0 +1,178 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// Evaluator evaluates the service level objectives by the query API of Prometheus
+type Evaluator struct {
+	// Address is the default address of Prometheus, which is used if the SLO has no address
+	Address string
+	Client  *http.Client
+}
+
+// Evaluate evaluates the indicator of the SLO over the chaos window from start to end, and over the
+// pre-window of the same length before start. The degradation of the mean of the indicator in the
+// chaos window from the one in the pre-window is judged by the threshold. The error of the evaluation
+// is recorded in the result instead of failing the run.
+func (e *Evaluator) Evaluate(ctx context.Context, slo *v1alpha1.SLOSpec, start, end time.Time) *v1alpha1.SLOResult {
+	result := &v1alpha1.SLOResult{Query: slo.Query, Threshold: slo.Threshold}
+
+	threshold, err := slo.GetThreshold()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	address := slo.Address
+	if address == "" {
+		address = e.Address
+	}
+	if address == "" {
+		result.Error = "no address of Prometheus is configured"
+		return result
+	}
+
+	// the window is at least 1s, since it's the range of the subquery
+	window := end.Sub(start).Truncate(time.Second)
+	if window < time.Second {
+		window = time.Second
+	}
+	query := fmt.Sprintf("avg_over_time((%s)[%ds:])", slo.Query, int64(window/time.Second))
+
+	baseline, err := e.query(ctx, address, query, start)
+	if err != nil {
+		result.Error = fmt.Sprintf("evaluate the pre-window: %s", err)
+		return result
+	}
+	value, err := e.query(ctx, address, query, end)
+	if err != nil {
+		result.Error = fmt.Sprintf("evaluate the chaos window: %s", err)
+		return result
+	}
+
+	degradation := Degradation(baseline, value, slo.HigherIsBetter)
+	result.Baseline = strconv.FormatFloat(baseline, 'g', -1, 64)
+	result.Value = strconv.FormatFloat(value, 'g', -1, 64)
+	result.Degradation = strconv.FormatFloat(degradation, 'f', 2, 64)
+	result.Passed = degradation <= threshold
+	return result
+}
+
+// Degradation returns the degradation of the indicator from the baseline in percent. It's infinite if
+// the baseline is 0 and the indicator degrades.
+func Degradation(baseline, value float64, higherIsBetter bool) float64 {
+	change := value - baseline
+	if higherIsBetter {
+		change = -change
+	}
+	if change == 0 {
+		return 0
+	}
+	if baseline == 0 {
+		return math.Inf(int(change / math.Abs(change)))
+	}
+	return change / math.Abs(baseline) * 100
+}
+
+// queryResponse is the response of the instant query API of Prometheus
+type queryResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+type sample struct {
+	Value []interface{} `json:"value"`
+}
+
+// query evaluates the instant query at the time, the result must be a scalar or a single series
+func (e *Evaluator) query(ctx context.Context, address string, query string, at time.Time) (float64, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("time", strconv.FormatInt(at.Unix(), 10))
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(address, "/")+"/api/v1/query?"+params.Encode(), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	client := e.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var body queryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return 0, fmt.Errorf("decode the response with status %d: %s", resp.StatusCode, err)
+	}
+	if body.Status != "success" {
+		return 0, fmt.Errorf("%s: %s", body.ErrorType, body.Error)
+	}
+
+	var pair []interface{}
+	switch body.Data.ResultType {
+	case "scalar":
+		if err := json.Unmarshal(body.Data.Result, &pair); err != nil {
+			return 0, err
+		}
+	case "vector":
+		var samples []sample
+		if err := json.Unmarshal(body.Data.Result, &samples); err != nil {
+			return 0, err
+		}
+		if len(samples) != 1 {
+			return 0, fmt.Errorf("the query returns %d series instead of a single value", len(samples))
+		}
+		pair = samples[0].Value
+	default:
+		return 0, fmt.Errorf("the result type %s isn't supported", body.Data.ResultType)
+	}
+
+	if len(pair) != 2 {
+		return 0, fmt.Errorf("the value %v is malformed", pair)
+	}
+	s, ok := pair[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("the value %v is malformed", pair)
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(value) {
+		return 0, fmt.Errorf("the indicator has no data")
+	}
+	return value, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestEvaluate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	start := time.Unix(1600000000, 0)
+	end := start.Add(5 * time.Minute)
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.URL.Path).To(Equal("/api/v1/query"))
+		queries = append(queries, r.URL.Query().Get("query"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("time") {
+		case fmt.Sprint(start.Unix()):
+			fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1600000000,"0.2"]}]}}`)
+		case fmt.Sprint(end.Unix()):
+			fmt.Fprint(w, `{"status":"success","data":{"resultType":"scalar","result":[1600000300,"0.25"]}}`)
+		default:
+			t.Errorf("unexpected time %s", r.URL.Query().Get("time"))
+		}
+	}))
+	defer server.Close()
+
+	e := &Evaluator{Address: server.URL}
+	slo := &v1alpha1.SLOSpec{Query: "histogram_quantile(0.99, rate(latency_bucket[1m]))", Threshold: "20"}
+	result := e.Evaluate(context.TODO(), slo, start, end)
+	g.Expect(result).To(Equal(&v1alpha1.SLOResult{
+		Query:       slo.Query,
+		Baseline:    "0.2",
+		Value:       "0.25",
+		Degradation: "25.00",
+		Threshold:   "20",
+		Passed:      false,
+	}))
+	g.Expect(queries).To(ConsistOf(
+		"avg_over_time((histogram_quantile(0.99, rate(latency_bucket[1m])))[300s:])",
+		"avg_over_time((histogram_quantile(0.99, rate(latency_bucket[1m])))[300s:])",
+	))
+
+	// the rise of the success ratio is an improvement
+	slo = &v1alpha1.SLOSpec{Query: "success_ratio", Threshold: "20", HigherIsBetter: true}
+	result = e.Evaluate(context.TODO(), slo, start, end)
+	g.Expect(result.Degradation).To(Equal("-25.00"))
+	g.Expect(result.Passed).To(BeTrue())
+	g.Expect(result.Error).To(BeEmpty())
+
+	// the address of the SLO overrides the default one
+	e = &Evaluator{}
+	result = e.Evaluate(context.TODO(), &v1alpha1.SLOSpec{Query: "up", Threshold: "10"}, start, end)
+	g.Expect(result.Error).To(Equal("no address of Prometheus is configured"))
+	result = e.Evaluate(context.TODO(), &v1alpha1.SLOSpec{Address: server.URL, Query: "up", Threshold: "30"}, start, end)
+	g.Expect(result.Passed).To(BeTrue())
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	responses := map[string]string{
+		"multiple": `{"status":"success","data":{"resultType":"vector","result":[{"value":[1,"1"]},{"value":[1,"2"]}]}}`,
+		"empty":    `{"status":"success","data":{"resultType":"vector","result":[{"value":[1,"NaN"]}]}}`,
+		"invalid":  `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for query, response := range responses {
+			if r.URL.Query().Get("query") == fmt.Sprintf("avg_over_time((%s)[60s:])", query) {
+				fmt.Fprint(w, response)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	e := &Evaluator{Address: server.URL}
+	start := time.Now().Add(-time.Minute)
+	for query, message := range map[string]string{
+		"multiple": "evaluate the pre-window: the query returns 2 series instead of a single value",
+		"empty":    "evaluate the pre-window: the indicator has no data",
+		"invalid":  "evaluate the pre-window: bad_data: parse error",
+	} {
+		result := e.Evaluate(context.TODO(), &v1alpha1.SLOSpec{Query: query, Threshold: "10"}, start, start.Add(time.Minute))
+		g.Expect(result.Error).To(Equal(message), query)
+		g.Expect(result.Passed).To(BeFalse(), query)
+	}
+}
+
+func TestDegradation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(Degradation(100, 150, false)).To(Equal(50.0))
+	g.Expect(Degradation(100, 50, true)).To(Equal(50.0))
+	g.Expect(Degradation(-10, -5, false)).To(Equal(50.0))
+	g.Expect(Degradation(0, 0, false)).To(Equal(0.0))
+	g.Expect(Degradation(0, 1, false)).To(Equal(math.Inf(1)))
+	g.Expect(Degradation(0, 1, true)).To(Equal(math.Inf(-1)))
+}
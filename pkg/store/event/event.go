@@ -33,6 +33,7 @@ var log = ctrl.Log.WithName("eventStore")
 func NewStore(db *dbstore.DB) core.EventStore {
 	db.AutoMigrate(&core.Event{})
 	db.AutoMigrate(&core.PodRecord{})
+	db.AutoMigrate(&core.PhaseRecord{})
 
 	es := &eventStore{db}
 
@@ -335,6 +336,37 @@ func (e *eventStore) ListByFilter(_ context.Context, filter core.Filter) ([]*cor
 	return eventList, nil
 }
 
+// ListByPodAndTime returns the events which injected chaos into the pod and overlapped with
+// the time range between start and end.
+func (e *eventStore) ListByPodAndTime(_ context.Context, namespace, name string, start, end *time.Time) ([]*core.Event, error) {
+	db := e.db.Model(&core.Event{}).
+		Select("DISTINCT events.*").
+		Joins("JOIN pod_records ON pod_records.event_id = events.id AND pod_records.deleted_at IS NULL").
+		Where("pod_records.namespace = ? AND pod_records.pod_name = ?", namespace, name)
+	if end != nil {
+		db = db.Where("events.start_time <= ?", end)
+	}
+	if start != nil {
+		// the events which haven't finished are still touching the pod
+		db = db.Where("events.finish_time IS NULL OR events.finish_time >= ?", start)
+	}
+
+	var resList []*core.Event
+	if err := db.Order("events.start_time").Find(&resList).Error; err != nil && !gorm.IsRecordNotFoundError(err) {
+		return nil, err
+	}
+
+	for _, et := range resList {
+		pods, err := e.findPodRecordsByEventID(context.Background(), et.ID)
+		if err != nil {
+			return nil, err
+		}
+		et.Pods = pods
+	}
+
+	return resList, nil
+}
+
 // DryListByFilter returns an event list by experimentName, experimentNamespace, uid, kind, startTime and finishTime.
 func (e *eventStore) DryListByFilter(_ context.Context, filter core.Filter) ([]*core.Event, error) {
 	var (
@@ -378,6 +410,10 @@ func (e *eventStore) DeleteByFinishTime(_ context.Context, ttl time.Duration) er
 		return err
 	}
 	nowTime := time.Now()
+	if err := e.db.Where("time < ?", nowTime.Add(-ttl)).Unscoped().
+		Delete(core.PhaseRecord{}).Error; err != nil {
+		return err
+	}
 	for _, et := range eventList {
 		if et.FinishTime == nil {
 			continue
@@ -433,6 +469,35 @@ func (e *eventStore) UpdateIncompleteEvents(_ context.Context, ns, name string)
 		Error
 }
 
+// CreatePhaseRecord persists a lifecycle event of an experiment to the datastore.
+func (e *eventStore) CreatePhaseRecord(_ context.Context, record *core.PhaseRecord) error {
+	return e.db.Create(record).Error
+}
+
+// FindLatestPhaseRecord returns the latest lifecycle event of the experiment by its uid.
+func (e *eventStore) FindLatestPhaseRecord(_ context.Context, uid string) (*core.PhaseRecord, error) {
+	record := new(core.PhaseRecord)
+	if err := e.db.Where(
+		"experiment_id = ?", uid).
+		Order("time desc, id desc").
+		First(record).Error; err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// ListPhaseRecords returns the lifecycle events of the experiment by its uid, ordered by time.
+func (e *eventStore) ListPhaseRecords(_ context.Context, uid string) ([]*core.PhaseRecord, error) {
+	records := make([]*core.PhaseRecord, 0)
+	if err := e.db.Where(
+		"experiment_id = ?", uid).
+		Order("time, id").
+		Find(&records).Error; err != nil && !gorm.IsRecordNotFoundError(err) {
+		return nil, err
+	}
+	return records, nil
+}
+
 func constructQueryArgs(experimentName, experimentNamespace, uid, kind, startTime, finishTime string) (string, []interface{}) {
 	args := make([]interface{}, 0)
 	query := ""
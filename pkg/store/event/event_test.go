@@ -0,0 +1,108 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/core"
+	"github.com/chaos-mesh/chaos-mesh/pkg/store/dbstore"
+)
+
+func newTestStore(g *WithT) core.EventStore {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	return NewStore(&dbstore.DB{DB: db})
+}
+
+func TestListByPodAndTime(t *testing.T) {
+	g := NewGomegaWithT(t)
+	store := newTestStore(g)
+
+	base := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hour int) *time.Time {
+		t := base.Add(time.Duration(hour) * time.Hour)
+		return &t
+	}
+	pod := func(name string) *core.PodRecord {
+		return &core.PodRecord{Namespace: "default", PodName: name}
+	}
+
+	events := []*core.Event{
+		{Experiment: "early", Namespace: "default", StartTime: at(0), FinishTime: at(1), Pods: []*core.PodRecord{pod("web-0")}},
+		{Experiment: "overlap", Namespace: "default", StartTime: at(2), FinishTime: at(4), Pods: []*core.PodRecord{pod("web-0"), pod("web-1")}},
+		{Experiment: "running", Namespace: "default", StartTime: at(5), Pods: []*core.PodRecord{pod("web-0")}},
+		{Experiment: "other-pod", Namespace: "default", StartTime: at(3), FinishTime: at(4), Pods: []*core.PodRecord{pod("web-1")}},
+	}
+	for _, et := range events {
+		g.Expect(store.Create(context.TODO(), et)).Should(Succeed())
+	}
+
+	names := func(events []*core.Event) []string {
+		ret := make([]string, 0, len(events))
+		for _, et := range events {
+			ret = append(ret, et.Experiment)
+		}
+		return ret
+	}
+
+	list, err := store.ListByPodAndTime(context.TODO(), "default", "web-0", at(3), at(6))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(names(list)).Should(Equal([]string{"overlap", "running"}))
+	g.Expect(list[0].Pods).Should(HaveLen(2))
+
+	list, err = store.ListByPodAndTime(context.TODO(), "default", "web-0", nil, at(1))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(names(list)).Should(Equal([]string{"early"}))
+
+	list, err = store.ListByPodAndTime(context.TODO(), "default", "web-0", nil, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(names(list)).Should(Equal([]string{"early", "overlap", "running"}))
+
+	list, err = store.ListByPodAndTime(context.TODO(), "other", "web-0", nil, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(list).Should(BeEmpty())
+}
+
+func TestPhaseRecords(t *testing.T) {
+	g := NewGomegaWithT(t)
+	store := newTestStore(g)
+
+	_, err := store.FindLatestPhaseRecord(context.TODO(), "uid")
+	g.Expect(gorm.IsRecordNotFoundError(err)).Should(BeTrue())
+
+	now := time.Now()
+	for i, phase := range []string{"Running", "Paused", "Running", "Finished"} {
+		g.Expect(store.CreatePhaseRecord(context.TODO(), &core.PhaseRecord{
+			ExperimentID: "uid",
+			Phase:        phase,
+			Time:         now.Add(time.Duration(i) * time.Second),
+		})).Should(Succeed())
+	}
+
+	latest, err := store.FindLatestPhaseRecord(context.TODO(), "uid")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(latest.Phase).Should(Equal("Finished"))
+
+	records, err := store.ListPhaseRecords(context.TODO(), "uid")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(records).Should(HaveLen(4))
+	g.Expect(records[1].Phase).Should(Equal("Paused"))
+}
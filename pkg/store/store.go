@@ -18,6 +18,7 @@ import (
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/store/event"
 	"github.com/chaos-mesh/chaos-mesh/pkg/store/experiment"
+	"github.com/chaos-mesh/chaos-mesh/pkg/store/template"
 )
 
 // Module includes the providers provided by store.
@@ -25,4 +26,5 @@ var Module = fx.Options(
 	fx.Provide(
 		event.NewStore,
 		experiment.NewStore,
+		template.NewStore,
 	))
@@ -0,0 +1,79 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/core"
+	"github.com/chaos-mesh/chaos-mesh/pkg/store/dbstore"
+)
+
+// NewStore returns a new TemplateStore.
+func NewStore(db *dbstore.DB) core.TemplateStore {
+	db.AutoMigrate(&core.ExperimentTemplate{})
+
+	return &templateStore{db}
+}
+
+type templateStore struct {
+	db *dbstore.DB
+}
+
+// List returns the experiment template list from the datastore.
+func (t *templateStore) List(_ context.Context) ([]*core.ExperimentTemplate, error) {
+	templates := make([]*core.ExperimentTemplate, 0)
+
+	if err := t.db.Order("name").Find(&templates).Error; err != nil && !gorm.IsRecordNotFoundError(err) {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// FindByName returns an experiment template by its name.
+func (t *templateStore) FindByName(_ context.Context, name string) (*core.ExperimentTemplate, error) {
+	template := new(core.ExperimentTemplate)
+	if err := t.db.Where(
+		"name = ?", name).
+		First(template).Error; err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// Create persists a new experiment template to the datastore.
+func (t *templateStore) Create(_ context.Context, template *core.ExperimentTemplate) error {
+	return t.db.Create(template).Error
+}
+
+// Update persists an updated experiment template to the datastore.
+func (t *templateStore) Update(_ context.Context, template *core.ExperimentTemplate) error {
+	return t.db.Model(core.ExperimentTemplate{}).
+		Where("name = ?", template.Name).
+		Updates(map[string]interface{}{
+			"description": template.Description,
+			"parameters":  template.Parameters,
+			"experiment":  template.Experiment,
+		}).Error
+}
+
+// Delete deletes the experiment template from the datastore.
+func (t *templateStore) Delete(_ context.Context, template *core.ExperimentTemplate) error {
+	return t.db.Where("name = ?", template.Name).Unscoped().
+		Delete(core.ExperimentTemplate{}).Error
+}
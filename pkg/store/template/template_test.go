@@ -0,0 +1,64 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/core"
+	"github.com/chaos-mesh/chaos-mesh/pkg/store/dbstore"
+)
+
+func TestTemplateStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	db, err := gorm.Open("sqlite3", ":memory:")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	store := NewStore(&dbstore.DB{DB: db})
+
+	for _, name := range []string{"pod-kill", "network-delay"} {
+		g.Expect(store.Create(context.TODO(), &core.ExperimentTemplate{
+			Name:       name,
+			Parameters: "[]",
+			Experiment: `{"name":"${name}"}`,
+		})).Should(Succeed())
+	}
+	g.Expect(store.Create(context.TODO(), &core.ExperimentTemplate{Name: "pod-kill"})).ShouldNot(Succeed())
+
+	templates, err := store.List(context.TODO())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(templates).Should(HaveLen(2))
+	g.Expect(templates[0].Name).Should(Equal("network-delay"))
+
+	g.Expect(store.Update(context.TODO(), &core.ExperimentTemplate{
+		Name:        "pod-kill",
+		Description: "kill a pod",
+		Parameters:  `[{"name":"name"}]`,
+		Experiment:  `{"name":"${name}"}`,
+	})).Should(Succeed())
+
+	tpl, err := store.FindByName(context.TODO(), "pod-kill")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(tpl.Description).Should(Equal("kill a pod"))
+	g.Expect(tpl.Parameters).Should(Equal(`[{"name":"name"}]`))
+
+	g.Expect(store.Delete(context.TODO(), tpl)).Should(Succeed())
+	_, err = store.FindByName(context.TODO(), "pod-kill")
+	g.Expect(gorm.IsRecordNotFoundError(err)).Should(BeTrue())
+}
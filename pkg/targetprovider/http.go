@@ -0,0 +1,86 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package targetprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// HTTPProvider is the name of the built-in provider which posts the requests as JSON to a HTTP endpoint
+const HTTPProvider = "http"
+
+const defaultTimeout = 10 * time.Second
+
+var log = ctrl.Log.WithName("target-provider")
+
+func init() {
+	Register(HTTPProvider, func(config Config) (Provider, error) {
+		return NewHTTPProvider(config.URL)
+	})
+}
+
+type httpProvider struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPProvider creates a provider which posts every Request as JSON to the url,
+// the endpoint responds with a Response in JSON
+func NewHTTPProvider(url string) (Provider, error) {
+	if url == "" {
+		return nil, fmt.Errorf("url of target provider is required")
+	}
+
+	return &httpProvider{
+		url:    url,
+		client: &http.Client{Timeout: defaultTimeout},
+	}, nil
+}
+
+func (p *httpProvider) Resolve(ctx context.Context, request *Request) (*Response, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, p.url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("failed to resolve targets from %s, status: %s", p.url, resp.Status)
+	}
+
+	response := &Response{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, fmt.Errorf("failed to decode the response of %s: %v", p.url, err)
+	}
+	return response, nil
+}
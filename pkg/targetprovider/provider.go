@@ -0,0 +1,169 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package targetprovider lets an external system, such as a CMDB or a service-ownership system,
+// veto the targets of experiments or supply them. The provider is consulted after the pods are
+// selected by the selectors and filtered by the namespace policy and ChaosProtections.
+package targetprovider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Experiment identifies the experiment whose targets are resolved
+type Experiment struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid,omitempty"`
+}
+
+// Target is a pod which an experiment targets
+type Target struct {
+	Namespace string            `json:"namespace"`
+	Name      string            `json:"name"`
+	NodeName  string            `json:"nodeName,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// Request is the request to resolve the targets of an experiment
+type Request struct {
+	// Experiment is empty if the targets aren't resolved for an experiment, e.g. in a dry run
+	Experiment Experiment `json:"experiment"`
+	// Candidates are the pods selected by the selectors, which aren't protected and are in the allowed namespaces
+	Candidates []Target `json:"candidates"`
+}
+
+// Response is the response of a provider
+type Response struct {
+	// Vetoed rejects the experiment, then no pod is targeted
+	Vetoed bool `json:"vetoed,omitempty"`
+	// Reason tells why the experiment is vetoed
+	Reason string `json:"reason,omitempty"`
+	// Targets are the pods supplied instead of the candidates, the candidates are kept if it's nil.
+	// The supplied pods are still filtered by the namespace policy and ChaosProtections.
+	Targets []Target `json:"targets,omitempty"`
+}
+
+// Provider resolves the targets of experiments
+type Provider interface {
+	Resolve(ctx context.Context, req *Request) (*Response, error)
+}
+
+// Factory creates the provider with the configuration of controller manager
+type Factory func(config Config) (Provider, error)
+
+// Config is the configuration of the providers
+type Config struct {
+	// Name is the name of the enabled provider, the providers are disabled if it's empty
+	Name string
+	// URL is the url which the http provider posts the requests to
+	URL string
+	// IgnoreFailures keeps the candidates if the provider fails, instead of failing the selection
+	IgnoreFailures bool
+}
+
+var (
+	factoryLock sync.Mutex
+	factories   = map[string]Factory{}
+
+	defaultProvider Provider
+)
+
+// Register registers the factory of the provider named name, so the provider compiled into
+// controller manager can be enabled by the configuration. It panics if the name is registered twice.
+func Register(name string, factory Factory) {
+	factoryLock.Lock()
+	defer factoryLock.Unlock()
+
+	if _, ok := factories[name]; ok {
+		panic(fmt.Sprintf("target provider %s is registered twice", name))
+	}
+	factories[name] = factory
+}
+
+// New creates the provider enabled in the configuration, it returns nil if no provider is enabled
+func New(config Config) (Provider, error) {
+	if config.Name == "" {
+		return nil, nil
+	}
+
+	factoryLock.Lock()
+	factory, ok := factories[config.Name]
+	factoryLock.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("target provider %s is unknown, the registered providers are %s", config.Name, registered())
+	}
+
+	provider, err := factory(config)
+	if err != nil {
+		return nil, err
+	}
+	if config.IgnoreFailures {
+		provider = &ignoreFailures{provider: provider}
+	}
+	return provider, nil
+}
+
+func registered() string {
+	factoryLock.Lock()
+	defer factoryLock.Unlock()
+
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// SetDefault sets the provider which the controllers consult, nil disables it
+func SetDefault(provider Provider) {
+	defaultProvider = provider
+}
+
+// Default returns the provider which the controllers consult, it's nil if no provider is enabled
+func Default() Provider {
+	return defaultProvider
+}
+
+type experimentKey struct{}
+
+// WithExperiment returns the context of resolving the targets of the experiment
+func WithExperiment(ctx context.Context, experiment Experiment) context.Context {
+	return context.WithValue(ctx, experimentKey{}, experiment)
+}
+
+// ExperimentFrom returns the experiment in the context
+func ExperimentFrom(ctx context.Context) (Experiment, bool) {
+	experiment, ok := ctx.Value(experimentKey{}).(Experiment)
+	return experiment, ok
+}
+
+// ignoreFailures keeps the candidates if the provider fails
+type ignoreFailures struct {
+	provider Provider
+}
+
+func (p *ignoreFailures) Resolve(ctx context.Context, req *Request) (*Response, error) {
+	resp, err := p.provider.Resolve(ctx, req)
+	if err != nil {
+		log.Error(err, "target provider failed, keep the candidates", "experiment", req.Experiment)
+		return &Response{}, nil
+	}
+	return resp, nil
+}
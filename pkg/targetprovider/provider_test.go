@@ -0,0 +1,81 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package targetprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestHTTPProvider(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var received Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if received.Experiment.Namespace == "prod" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(Response{Vetoed: true, Reason: "owned by payments"})
+	}))
+	defer server.Close()
+
+	provider, err := New(Config{Name: HTTPProvider, URL: server.URL})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ctx := WithExperiment(context.Background(), Experiment{Kind: "PodChaos", Namespace: "default", Name: "kill"})
+	experiment, _ := ExperimentFrom(ctx)
+	resp, err := provider.Resolve(ctx, &Request{
+		Experiment: experiment,
+		Candidates: []Target{{Namespace: "default", Name: "web-0"}},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resp).To(Equal(&Response{Vetoed: true, Reason: "owned by payments"}))
+	g.Expect(received.Experiment.Name).To(Equal("kill"))
+	g.Expect(received.Candidates).To(HaveLen(1))
+
+	_, err = provider.Resolve(ctx, &Request{Experiment: Experiment{Namespace: "prod"}})
+	g.Expect(err).To(HaveOccurred())
+
+	provider, err = New(Config{Name: HTTPProvider, URL: server.URL, IgnoreFailures: true})
+	g.Expect(err).ToNot(HaveOccurred())
+	resp, err = provider.Resolve(ctx, &Request{Experiment: Experiment{Namespace: "prod"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resp).To(Equal(&Response{}))
+}
+
+func TestNew(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	provider, err := New(Config{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(provider).To(BeNil())
+
+	_, err = New(Config{Name: "cmdb"})
+	g.Expect(err).To(MatchError(ContainSubstring("the registered providers are http")))
+
+	_, err = New(Config{Name: HTTPProvider})
+	g.Expect(err).To(HaveOccurred())
+
+	g.Expect(func() { Register(HTTPProvider, nil) }).To(Panic())
+}
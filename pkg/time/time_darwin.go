@@ -32,3 +32,31 @@ func ModifyTime(pid int, deltaSec int64, deltaNsec int64, clockIdsMask uint64) e
 	}
 	return errors.New("darwin is not supported")
 }
+
+// SetTimerSlack sets the timer slack of all the threads of target process
+func SetTimerSlack(pid int, slackNs uint64) error {
+	// Mock point to return error in unit test
+	if err := mock.On("SetTimerSlackError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			return nil
+		}
+	}
+	return errors.New("darwin is not supported")
+}
+
+// ResetTimerSlack resets the timer slack of all the threads of target process
+func ResetTimerSlack(pid int) error {
+	// Mock point to return error in unit test
+	if err := mock.On("ResetTimerSlackError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			return nil
+		}
+	}
+	return errors.New("darwin is not supported")
+}
@@ -16,7 +16,13 @@ package time
 import (
 	"bytes"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"runtime"
+	"strconv"
+	"strings"
 
 	"github.com/go-logr/logr"
 
@@ -29,6 +35,9 @@ import (
 
 var log = ctrl.Log.WithName("time")
 
+// procPath is where the proc filesystem is mounted
+var procPath = "/proc"
+
 // RegisterLogger registers a logger on time pkg
 func RegisterLogger(logger logr.Logger) {
 	log = logger
@@ -177,3 +186,117 @@ func ModifyTime(pid int, deltaSec int64, deltaNsec int64, clockIdsMask uint64) e
 
 	return nil
 }
+
+// SetTimerSlack sets the timer slack of all the threads of target process. The timers of the threads,
+// such as nanosleep, the timeouts of poll and epoll_wait and the futex waits, expire late by up to the
+// slack, since the kernel coalesces them with the other timers in the slack.
+func SetTimerSlack(pid int, slackNs uint64) error {
+	// Mock point to return error in unit test
+	if err := mock.On("SetTimerSlackError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			return nil
+		}
+	}
+
+	if slackNs == 0 {
+		return errors.New("timer slack should be positive")
+	}
+
+	tids, err := threads(pid)
+	if err != nil {
+		return err
+	}
+	for _, tid := range tids {
+		if err := writeTimerSlack(tid, slackNs); err != nil {
+			// the thread has exited
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// ResetTimerSlack resets the timer slack of all the threads of target process. Writing 0 resets the slack
+// of a thread to its default, but the default of the threads created during the chaos is the slack of
+// their creators, so every thread is reset to the default of the main thread instead.
+func ResetTimerSlack(pid int) error {
+	// Mock point to return error in unit test
+	if err := mock.On("ResetTimerSlackError"); err != nil {
+		if e, ok := err.(error); ok {
+			return e
+		}
+		if ignore, ok := err.(bool); ok && ignore {
+			return nil
+		}
+	}
+
+	if err := writeTimerSlack(pid, 0); err != nil {
+		return err
+	}
+	slackNs, err := readTimerSlack(pid)
+	if err != nil {
+		return err
+	}
+
+	tids, err := threads(pid)
+	if err != nil {
+		return err
+	}
+	for _, tid := range tids {
+		if tid == pid {
+			continue
+		}
+		if err := writeTimerSlack(tid, slackNs); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// threads returns the ids of the threads of the process
+func threads(pid int) ([]int, error) {
+	infos, err := ioutil.ReadDir(filepath.Join(procPath, strconv.Itoa(pid), "task"))
+	if err != nil {
+		return nil, err
+	}
+
+	tids := make([]int, 0, len(infos))
+	for _, info := range infos {
+		tid, err := strconv.Atoi(info.Name())
+		if err != nil {
+			continue
+		}
+		tids = append(tids, tid)
+	}
+	return tids, nil
+}
+
+// timerSlackPath is the timer slack file of the thread, which is only found in the directory of the
+// thread at the top of the proc filesystem rather than in the tasks of the process
+func timerSlackPath(tid int) string {
+	return filepath.Join(procPath, strconv.Itoa(tid), "timerslack_ns")
+}
+
+func readTimerSlack(tid int) (uint64, error) {
+	data, err := ioutil.ReadFile(timerSlackPath(tid))
+	if err != nil {
+		return 0, err
+	}
+	slackNs, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timer slack of thread %d: %v", tid, err)
+	}
+	return slackNs, nil
+}
+
+func writeTimerSlack(tid int, slackNs uint64) error {
+	return ioutil.WriteFile(timerSlackPath(tid), []byte(strconv.FormatUint(slackNs, 10)), 0644)
+}
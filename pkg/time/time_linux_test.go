@@ -128,3 +128,47 @@ var _ = Describe("ModifyTime", func() {
 		})
 	})
 })
+
+var _ = Describe("TimerSlack", func() {
+
+	var t *timer.Timer
+
+	BeforeEach(func() {
+		var err error
+
+		t, err = timer.StartTimer()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := t.Stop()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should set and reset the timer slack of all the threads", func() {
+		Expect(t).NotTo(BeNil())
+
+		origin, err := readTimerSlack(t.Pid())
+		Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+		err = SetTimerSlack(t.Pid(), 10000000)
+		Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+		tids, err := threads(t.Pid())
+		Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+		for _, tid := range tids {
+			slack, err := readTimerSlack(tid)
+			Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+			Expect(slack).Should(Equal(uint64(10000000)), "tid %d", tid)
+		}
+
+		err = ResetTimerSlack(t.Pid())
+		Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+		for _, tid := range tids {
+			slack, err := readTimerSlack(tid)
+			Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+			Expect(slack).Should(Equal(origin), "tid %d", tid)
+		}
+	})
+})
@@ -0,0 +1,113 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var log = ctrl.Log.WithName("tracing")
+
+// serviceNameKey is the key of the service name in the resource of spans
+const serviceNameKey = attribute.Key("service.name")
+
+// Config is the configuration of tracing
+type Config struct {
+	// ServiceName is the name of the component which produces the spans
+	ServiceName string
+	// Endpoint is the url of the collector which accepts spans in zipkin v2 format,
+	// e.g. `http://jaeger-collector:9411/api/v2/spans`. Tracing is disabled if it is empty
+	Endpoint string
+	// SampleRatio is the ratio of the traces which are sampled
+	SampleRatio float64
+}
+
+// Setup registers the global tracer provider which exports spans to the endpoint
+// in config, and the W3C trace context propagator. The returned function flushes
+// and stops the tracer provider.
+func Setup(config Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	if config.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := NewZipkinExporter(config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+		sdktrace.WithResource(sdkresource.NewSchemaless(serviceNameKey.String(config.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	log.Info("Tracing is enabled", "service", config.ServiceName, "endpoint", config.Endpoint)
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of chaos mesh from the global tracer provider
+func Tracer() trace.Tracer {
+	return otel.Tracer("github.com/chaos-mesh/chaos-mesh")
+}
+
+// Inject returns the W3C `traceparent` of the span in ctx, it returns
+// an empty string if there is no valid span in ctx
+func Inject(ctx context.Context) string {
+	carrier := mapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier[traceparentHeader]
+}
+
+// Extract returns a copy of ctx with the remote span described by the
+// W3C `traceparent`, so the spans started with it are in the same trace
+func Extract(ctx context.Context, traceparent string) context.Context {
+	if traceparent == "" {
+		return ctx
+	}
+	carrier := mapCarrier{traceparentHeader: traceparent}
+	return propagation.TraceContext{}.Extract(ctx, carrier)
+}
+
+const traceparentHeader = "traceparent"
+
+// mapCarrier is a propagation.TextMapCarrier backed by a map
+type mapCarrier map[string]string
+
+func (c mapCarrier) Get(key string) string {
+	return c[key]
+}
+
+func (c mapCarrier) Set(key string, value string) {
+	c[key] = value
+}
+
+func (c mapCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestInjectAndExtract(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	provider := sdktrace.NewTracerProvider()
+	ctx, span := provider.Tracer("test").Start(context.TODO(), "inject")
+	defer span.End()
+
+	traceparent := Inject(ctx)
+	g.Expect(traceparent).ShouldNot(BeEmpty())
+
+	remote := trace.SpanContextFromContext(Extract(context.TODO(), traceparent))
+	g.Expect(remote.IsRemote()).Should(BeTrue())
+	g.Expect(remote.TraceID()).Should(Equal(span.SpanContext().TraceID()))
+	g.Expect(remote.SpanID()).Should(Equal(span.SpanContext().SpanID()))
+
+	g.Expect(Inject(context.TODO())).Should(BeEmpty())
+	g.Expect(trace.SpanContextFromContext(Extract(context.TODO(), "")).IsValid()).Should(BeFalse())
+}
+
+func TestInterceptorsPropagateTrace(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	provider := sdktrace.NewTracerProvider()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	ctx, parent := Tracer().Start(context.TODO(), "parent")
+	defer parent.End()
+
+	var serverSpan trace.SpanContext
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		serverCtx := metadata.NewIncomingContext(context.TODO(), md)
+
+		_, err := UnaryServerInterceptor(serverCtx, req, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				serverSpan = trace.SpanContextFromContext(ctx)
+				return nil, errors.New("injection failed")
+			})
+		return err
+	}
+
+	err := UnaryClientInterceptor(ctx, "/pb.ChaosDaemon/SetTcs", nil, nil, &grpc.ClientConn{}, invoker)
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(serverSpan.IsValid()).Should(BeTrue())
+	g.Expect(serverSpan.TraceID()).Should(Equal(parent.SpanContext().TraceID()))
+	g.Expect(serverSpan.SpanID()).ShouldNot(Equal(parent.SpanContext().SpanID()))
+}
+
+func TestZipkinExporter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var spans []zipkinSpan
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Header.Get("Content-Type")).Should(Equal("application/json"))
+		g.Expect(json.NewDecoder(r.Body).Decode(&spans)).Should(Succeed())
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	exporter, err := NewZipkinExporter(server.URL)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(exporter),
+		sdktrace.WithResource(sdkresource.NewSchemaless(serviceNameKey.String("chaos-daemon"))),
+	)
+	ctx, parent := provider.Tracer("test").Start(context.TODO(), "parent")
+	_, child := provider.Tracer("test").Start(ctx, "child", trace.WithSpanKind(trace.SpanKindServer))
+	child.RecordError(errors.New("injection failed"))
+	child.SetStatus(codes.Error, "injection failed")
+	child.End()
+
+	g.Expect(spans).Should(HaveLen(1))
+	g.Expect(spans[0].Name).Should(Equal("child"))
+	g.Expect(spans[0].Kind).Should(Equal("SERVER"))
+	g.Expect(spans[0].TraceID).Should(Equal(parent.SpanContext().TraceID().String()))
+	g.Expect(spans[0].ParentID).Should(Equal(parent.SpanContext().SpanID().String()))
+	g.Expect(spans[0].LocalEndpoint.ServiceName).Should(Equal("chaos-daemon"))
+	g.Expect(spans[0].Tags).Should(HaveKeyWithValue("error", "injection failed"))
+
+	_, err = NewZipkinExporter("")
+	g.Expect(err).Should(HaveOccurred())
+}
@@ -0,0 +1,159 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const defaultExportTimeout = 10 * time.Second
+
+// zipkinExporter exports spans to a collector in zipkin v2 JSON format,
+// which is accepted by zipkin, jaeger and the OpenTelemetry collector
+type zipkinExporter struct {
+	url    string
+	client *http.Client
+}
+
+var _ sdktrace.SpanExporter = &zipkinExporter{}
+
+// NewZipkinExporter creates a span exporter which posts spans to the url in zipkin v2 format
+func NewZipkinExporter(url string) (sdktrace.SpanExporter, error) {
+	if url == "" {
+		return nil, fmt.Errorf("url of zipkin collector is required")
+	}
+
+	return &zipkinExporter{
+		url:    url,
+		client: &http.Client{Timeout: defaultExportTimeout},
+	}, nil
+}
+
+type zipkinEndpoint struct {
+	ServiceName string `json:"serviceName,omitempty"`
+}
+
+type zipkinSpan struct {
+	TraceID       string            `json:"traceId"`
+	ID            string            `json:"id"`
+	ParentID      string            `json:"parentId,omitempty"`
+	Name          string            `json:"name"`
+	Kind          string            `json:"kind,omitempty"`
+	Timestamp     int64             `json:"timestamp"`
+	Duration      int64             `json:"duration"`
+	LocalEndpoint *zipkinEndpoint   `json:"localEndpoint,omitempty"`
+	Tags          map[string]string `json:"tags,omitempty"`
+}
+
+func (e *zipkinExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	models := make([]zipkinSpan, 0, len(spans))
+	for _, span := range spans {
+		models = append(models, toZipkinSpan(span))
+	}
+
+	data, err := json.Marshal(models)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to export spans to %s, status: %s", e.url, resp.Status)
+	}
+
+	return nil
+}
+
+func (e *zipkinExporter) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+func toZipkinSpan(span sdktrace.ReadOnlySpan) zipkinSpan {
+	model := zipkinSpan{
+		TraceID:   span.SpanContext().TraceID().String(),
+		ID:        span.SpanContext().SpanID().String(),
+		Name:      span.Name(),
+		Timestamp: span.StartTime().UnixNano() / int64(time.Microsecond),
+		Duration:  span.EndTime().Sub(span.StartTime()).Nanoseconds() / int64(time.Microsecond),
+		Tags:      make(map[string]string),
+	}
+
+	if span.Parent().IsValid() {
+		model.ParentID = span.Parent().SpanID().String()
+	}
+
+	switch span.SpanKind() {
+	case trace.SpanKindClient:
+		model.Kind = "CLIENT"
+	case trace.SpanKindServer:
+		model.Kind = "SERVER"
+	case trace.SpanKindProducer:
+		model.Kind = "PRODUCER"
+	case trace.SpanKindConsumer:
+		model.Kind = "CONSUMER"
+	}
+
+	if resource := span.Resource(); resource != nil {
+		if value, ok := resource.Set().Value(serviceNameKey); ok {
+			model.LocalEndpoint = &zipkinEndpoint{ServiceName: value.AsString()}
+		}
+	}
+
+	for _, kv := range span.Attributes() {
+		model.Tags[string(kv.Key)] = kv.Value.Emit()
+	}
+
+	if span.Status().Code == codes.Error {
+		model.Tags["error"] = span.Status().Description
+	}
+
+	for _, event := range span.Events() {
+		if event.Name != "exception" {
+			continue
+		}
+		var attrs []string
+		for _, kv := range event.Attributes {
+			attrs = append(attrs, fmt.Sprintf("%s=%s", kv.Key, kv.Value.Emit()))
+		}
+		model.Tags["exception"] = strings.Join(attrs, ",")
+	}
+
+	return model
+}
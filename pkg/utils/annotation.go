@@ -22,6 +22,11 @@ import (
 const (
 	// AnnotationPrefix defines the prefix of annotation key for chaos-mesh.
 	AnnotationPrefix = "chaos-mesh"
+
+	// NamespaceInjectAnnotationKey defines the annotation key of namespace to opt into chaos.
+	NamespaceInjectAnnotationKey = "chaos-mesh.org/inject"
+	// NamespaceInjectEnabled defines the annotation value of namespace which opts into chaos.
+	NamespaceInjectEnabled = "enabled"
 )
 
 func GenAnnotationKeyForImage(pc *v1alpha1.PodChaos, containerName string) string {
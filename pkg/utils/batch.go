@@ -0,0 +1,114 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosdaemon "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// MaxBatchSize is the max number of containers in one batch call to chaos-daemon
+const MaxBatchSize = 100
+
+// BatchCall calls the chaos-daemon on node with the containers on it in a batch
+type BatchCall func(ctx context.Context, daemon ChaosDaemonClientInterface, node string, containerIDs []string) (*chaosdaemon.BatchResponse, error)
+
+// BatchByNode groups the pods by the nodes they run on, and calls the chaos-daemon of each node
+// with the first containers of the pods in batches. The nodes are handled in parallel.
+// The error of each pod is returned by the index of pods.
+func BatchByNode(ctx context.Context, c client.Client, pods []*v1.Pod, port int, call BatchCall) []error {
+	return batchByNode(ctx, pods, func(pod *v1.Pod) (ChaosDaemonClientInterface, error) {
+		return NewChaosDaemonClient(ctx, c, pod, port)
+	}, call)
+}
+
+func batchByNode(ctx context.Context, pods []*v1.Pod, newClient func(pod *v1.Pod) (ChaosDaemonClientInterface, error), call BatchCall) []error {
+	errs := make([]error, len(pods))
+
+	nodes := make(map[string][]int)
+	for i, pod := range pods {
+		if len(pod.Status.ContainerStatuses) == 0 {
+			errs[i] = fmt.Errorf("%s %s can't get the state of container", pod.Namespace, pod.Name)
+			continue
+		}
+		nodes[pod.Spec.NodeName] = append(nodes[pod.Spec.NodeName], i)
+	}
+
+	var wg sync.WaitGroup
+	for node, indexes := range nodes {
+		wg.Add(1)
+		go func(node string, indexes []int) {
+			defer wg.Done()
+
+			// the indexes are owned by this node, so errs is written without lock
+			fail := func(indexes []int, err error) {
+				for _, i := range indexes {
+					errs[i] = fmt.Errorf("%s %s: %v", pods[i].Namespace, pods[i].Name, err)
+				}
+			}
+
+			daemon, err := newClient(pods[indexes[0]])
+			if err != nil {
+				fail(indexes, err)
+				return
+			}
+			defer daemon.Close()
+
+			for start := 0; start < len(indexes); start += MaxBatchSize {
+				end := start + MaxBatchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+
+				containerIDs := make([]string, 0, len(batch))
+				for _, i := range batch {
+					containerIDs = append(containerIDs, pods[i].Status.ContainerStatuses[0].ContainerID)
+				}
+
+				resp, err := call(ctx, daemon, node, containerIDs)
+				if err != nil {
+					fail(batch, err)
+					continue
+				}
+				for j, i := range batch {
+					if j < len(resp.GetErrors()) && resp.Errors[j] != "" {
+						errs[i] = fmt.Errorf("%s %s: %s", pods[i].Namespace, pods[i].Name, resp.Errors[j])
+					}
+				}
+			}
+		}(node, indexes)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// MergeErrors merges the errors returned by BatchByNode into one error
+func MergeErrors(errs []error) error {
+	var result error
+	for _, err := range errs {
+		if err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	return result
+}
@@ -0,0 +1,111 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosdaemonpb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+type batchClient struct {
+	chaosdaemonpb.ChaosDaemonClient
+}
+
+func (c *batchClient) Close() error {
+	return nil
+}
+
+func newBatchClient(*v1.Pod) (ChaosDaemonClientInterface, error) {
+	return &batchClient{}, nil
+}
+
+func newBatchPod(name, node, containerID string) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       v1.PodSpec{NodeName: node},
+	}
+	if containerID != "" {
+		pod.Status.ContainerStatuses = []v1.ContainerStatus{{ContainerID: containerID}}
+	}
+	return pod
+}
+
+func TestBatchByNode(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	pods := []*v1.Pod{
+		newBatchPod("p1", "node-1", "docker://c1"),
+		newBatchPod("p2", "node-2", "docker://c2"),
+		newBatchPod("p3", "node-1", "docker://c3"),
+		newBatchPod("p4", "node-1", ""),
+		newBatchPod("p5", "node-3", "docker://c5"),
+	}
+
+	var lock sync.Mutex
+	calls := make(map[string][]string)
+	errs := batchByNode(context.TODO(), pods, newBatchClient,
+		func(ctx context.Context, _ ChaosDaemonClientInterface, node string, containerIDs []string) (*chaosdaemonpb.BatchResponse, error) {
+			lock.Lock()
+			calls[node] = containerIDs
+			lock.Unlock()
+
+			switch node {
+			case "node-1":
+				return &chaosdaemonpb.BatchResponse{Errors: []string{"", "tc failed"}}, nil
+			case "node-3":
+				return nil, errors.New("unavailable")
+			}
+			return &chaosdaemonpb.BatchResponse{Errors: []string{""}}, nil
+		})
+
+	g.Expect(calls).To(Equal(map[string][]string{
+		"node-1": {"docker://c1", "docker://c3"},
+		"node-2": {"docker://c2"},
+		"node-3": {"docker://c5"},
+	}))
+	g.Expect(errs[0]).ShouldNot(HaveOccurred())
+	g.Expect(errs[1]).ShouldNot(HaveOccurred())
+	g.Expect(errs[2]).Should(MatchError("default p3: tc failed"))
+	g.Expect(errs[3]).Should(MatchError("default p4 can't get the state of container"))
+	g.Expect(errs[4]).Should(MatchError("default p5: unavailable"))
+	g.Expect(MergeErrors(errs)).Should(HaveOccurred())
+	g.Expect(MergeErrors(errs[:2])).ShouldNot(HaveOccurred())
+}
+
+func TestBatchByNodeSplitsBatches(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var pods []*v1.Pod
+	for i := 0; i < MaxBatchSize+1; i++ {
+		pods = append(pods, newBatchPod("p", "node-1", "docker://c"))
+	}
+
+	var sizes []int
+	errs := batchByNode(context.TODO(), pods, newBatchClient,
+		func(ctx context.Context, _ ChaosDaemonClientInterface, _ string, containerIDs []string) (*chaosdaemonpb.BatchResponse, error) {
+			sizes = append(sizes, len(containerIDs))
+			return &chaosdaemonpb.BatchResponse{}, nil
+		})
+
+	g.Expect(sizes).To(Equal([]int{MaxBatchSize, 1}))
+	g.Expect(MergeErrors(errs)).ShouldNot(HaveOccurred())
+}
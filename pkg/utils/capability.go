@@ -0,0 +1,255 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	chaosdaemon "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// capabilityTTL is how long the capabilities reported by chaos-daemon are cached,
+// they rarely change while the node is running
+const capabilityTTL = time.Minute
+
+// the modes which chaos-daemon runs in
+const (
+	// DaemonModePrivileged runs chaos-daemon in a privileged container with the host
+	// filesystems mounted, every action is supported
+	DaemonModePrivileged = "privileged"
+	// DaemonModeLeastPrivilege runs chaos-daemon with a few linux capabilities and without
+	// the host filesystems, only the actions in the namespaces of the targets are supported
+	DaemonModeLeastPrivilege = "least-privilege"
+)
+
+// the operating systems of the nodes which chaos-daemon runs on
+const (
+	OSLinux   = "linux"
+	OSWindows = "windows"
+)
+
+// the kernel features which chaos-daemon probes from the kernel config
+const (
+	KernelFeatureBPFSyscall    = "bpf_syscall"
+	KernelFeatureBPFJIT        = "bpf_jit"
+	KernelFeatureNetClsAct     = "net_cls_act"
+	KernelFeatureNetSchIngress = "net_sch_ingress"
+)
+
+// the linux capabilities which chaos-daemon requires in the least-privilege mode
+const (
+	CapNetAdmin  = "CAP_NET_ADMIN"
+	CapSysAdmin  = "CAP_SYS_ADMIN"
+	CapSysPtrace = "CAP_SYS_PTRACE"
+)
+
+var (
+	// NetworkCapabilities are required to enter the network namespaces of the targets
+	// and change their qdiscs, iptables and ipsets
+	NetworkCapabilities = []string{CapNetAdmin, CapSysAdmin, CapSysPtrace}
+	// TimeCapabilities are required to attach the processes of the targets by ptrace
+	TimeCapabilities = []string{CapSysPtrace}
+)
+
+// MissingCapabilities returns the required linux capabilities which aren't in the effective ones
+func MissingCapabilities(effective, required []string) []string {
+	has := make(map[string]bool, len(effective))
+	for _, capability := range effective {
+		has[capability] = true
+	}
+
+	var missing []string
+	for _, capability := range required {
+		if !has[capability] {
+			missing = append(missing, capability)
+		}
+	}
+	return missing
+}
+
+// Requirement describes what the node must support to inject a chaos action
+type Requirement struct {
+	// Action is the chaos action, which is used in the error message
+	Action string
+	// Modules are the kernel modules which must be available
+	Modules []string
+	// CgroupVersion is the required version of the cgroup hierarchy, 0 means any version
+	CgroupVersion int32
+	BPF           bool
+	// KernelFeatures are the kernel features which must be enabled, they're only checked
+	// if chaos-daemon is able to read the kernel config
+	KernelFeatures []string
+	// LinuxCapabilities are the linux capabilities which chaos-daemon must have if it
+	// doesn't run in the privileged mode
+	LinuxCapabilities []string
+	// Privileged is true if the action is only supported by chaos-daemon in the privileged mode
+	Privileged bool
+	// Methods are the methods of chaos-daemon which the action calls, they must be supported
+	// by chaos-daemon on the operating system of the node
+	Methods []string
+}
+
+// unsupported returns the reason why the requirement is not met, or an empty string if it's met
+func (r Requirement) unsupported(caps *chaosdaemon.CapabilitiesResponse) string {
+	if len(caps.Methods) > 0 {
+		if missing := MissingCapabilities(caps.Methods, r.Methods); len(missing) > 0 {
+			return fmt.Sprintf("chaos-daemon on %s doesn't support %v", caps.Os, missing)
+		}
+	}
+
+	// the kernel modules, cgroups and linux capabilities only make sense on linux
+	if caps.Os != "" && caps.Os != OSLinux {
+		return runtimesUnsupported(caps.Runtimes)
+	}
+
+	available := make(map[string]bool, len(caps.Modules))
+	for _, module := range caps.Modules {
+		available[module] = true
+	}
+	for _, module := range r.Modules {
+		if !available[module] {
+			return fmt.Sprintf("kernel module %s is unavailable in kernel %s", module, kernelOf(caps))
+		}
+	}
+
+	if caps.KernelConfig != "" {
+		if missing := MissingCapabilities(caps.KernelFeatures, r.KernelFeatures); len(missing) > 0 {
+			return fmt.Sprintf("kernel features %v are disabled in kernel %s", missing, kernelOf(caps))
+		}
+	}
+
+	if r.CgroupVersion != 0 && caps.CgroupVersion != 0 && r.CgroupVersion != caps.CgroupVersion {
+		return fmt.Sprintf("cgroup v%d is required but the node uses cgroup v%d", r.CgroupVersion, caps.CgroupVersion)
+	}
+
+	if r.BPF && !caps.Bpf {
+		return fmt.Sprintf("bpf is unsupported by kernel %s", kernelOf(caps))
+	}
+
+	// the chaos-daemon which doesn't report its mode runs in the privileged mode
+	if caps.Mode != "" && caps.Mode != DaemonModePrivileged {
+		if r.Privileged {
+			return fmt.Sprintf("chaos-daemon runs in %s mode but the action requires %s mode", caps.Mode, DaemonModePrivileged)
+		}
+		if missing := MissingCapabilities(caps.LinuxCapabilities, r.LinuxCapabilities); len(missing) > 0 {
+			return fmt.Sprintf("chaos-daemon runs in %s mode without linux capabilities %v", caps.Mode, missing)
+		}
+	}
+
+	return runtimesUnsupported(caps.Runtimes)
+}
+
+// kernelOf describes the kernel in the capabilities, with the architecture if it's reported,
+// so that the nodes of different architectures are told apart in the errors
+func kernelOf(caps *chaosdaemon.CapabilitiesResponse) string {
+	if caps.Arch == "" {
+		return caps.KernelVersion
+	}
+	return fmt.Sprintf("%s (%s)", caps.KernelVersion, caps.Arch)
+}
+
+// runtimesUnsupported returns the reason if none of the container runtimes is healthy
+func runtimesUnsupported(runtimes []*chaosdaemon.RuntimeStatus) string {
+	if len(runtimes) == 0 {
+		return ""
+	}
+
+	var errs []string
+	for _, runtime := range runtimes {
+		if runtime.Healthy {
+			return ""
+		}
+		errs = append(errs, fmt.Sprintf("%s: %s", runtime.Name, runtime.Error))
+	}
+	return fmt.Sprintf("container runtime is unhealthy (%s)", strings.Join(errs, "; "))
+}
+
+type cachedCapabilities struct {
+	caps   *chaosdaemon.CapabilitiesResponse
+	expire time.Time
+}
+
+var nodeCapabilities = struct {
+	sync.Mutex
+	nodes map[string]cachedCapabilities
+}{
+	nodes: make(map[string]cachedCapabilities),
+}
+
+// GetNodeCapabilities returns the capabilities of the node reported by the chaos-daemon on it.
+// A nil response is returned if the chaos-daemon is too old to report capabilities.
+func GetNodeCapabilities(ctx context.Context, c chaosdaemon.ChaosDaemonClient, node string) (*chaosdaemon.CapabilitiesResponse, error) {
+	nodeCapabilities.Lock()
+	cached, ok := nodeCapabilities.nodes[node]
+	nodeCapabilities.Unlock()
+	if ok && time.Now().Before(cached.expire) {
+		return cached.caps, nil
+	}
+
+	caps, err := c.GetCapabilities(ctx, &empty.Empty{})
+	if status.Code(err) == codes.Unimplemented {
+		caps, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if node != "" {
+		nodeCapabilities.Lock()
+		nodeCapabilities.nodes[node] = cachedCapabilities{
+			caps:   caps,
+			expire: time.Now().Add(capabilityTTL),
+		}
+		nodeCapabilities.Unlock()
+	}
+
+	return caps, nil
+}
+
+// CheckCapabilities returns an error if the node can't support the chaos action,
+// so that the chaos fails fast instead of failing in the middle of injection.
+// The check is skipped if the capabilities of the node are unknown.
+func CheckCapabilities(ctx context.Context, c chaosdaemon.ChaosDaemonClient, node string, req Requirement) error {
+	reason, err := UnsupportedReason(ctx, c, node, req)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		return fmt.Errorf("node %s can't support %s action: %s", node, req.Action, reason)
+	}
+	return nil
+}
+
+// UnsupportedReason returns the reason why the node can't support the chaos action, or an empty
+// string if it's supported or the capabilities of the node are unknown. It's used to choose
+// another way to inject the chaos on the nodes of a heterogeneous pool.
+func UnsupportedReason(ctx context.Context, c chaosdaemon.ChaosDaemonClient, node string, req Requirement) (string, error) {
+	caps, err := GetNodeCapabilities(ctx, c, node)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the capabilities of node %s: %v", node, err)
+	}
+	if caps == nil {
+		return "", nil
+	}
+
+	return req.unsupported(caps), nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	chaosdaemonpb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+type capabilityClient struct {
+	chaosdaemonpb.ChaosDaemonClient
+	caps  *chaosdaemonpb.CapabilitiesResponse
+	err   error
+	calls int
+}
+
+func (c *capabilityClient) GetCapabilities(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*chaosdaemonpb.CapabilitiesResponse, error) {
+	c.calls++
+	return c.caps, c.err
+}
+
+func TestCheckCapabilities(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	caps := &chaosdaemonpb.CapabilitiesResponse{
+		KernelVersion: "5.4.0",
+		Modules:       []string{"sch_netem"},
+		CgroupVersion: 2,
+		Runtimes: []*chaosdaemonpb.RuntimeStatus{
+			{Name: "docker", Error: "connection refused"},
+			{Name: "containerd", Healthy: true},
+		},
+	}
+
+	cases := []struct {
+		name string
+		req  Requirement
+		err  string
+	}{
+		{"supported", Requirement{Action: "delay", Modules: []string{"sch_netem"}}, ""},
+		{"missing module", Requirement{Action: "bandwidth", Modules: []string{"sch_tbf"}},
+			"node node-1 can't support bandwidth action: kernel module sch_tbf is unavailable in kernel 5.4.0"},
+		{"cgroup version", Requirement{Action: "stress", CgroupVersion: 1},
+			"node node-1 can't support stress action: cgroup v1 is required but the node uses cgroup v2"},
+		{"bpf", Requirement{Action: "kernel", BPF: true},
+			"node node-1 can't support kernel action: bpf is unsupported by kernel 5.4.0"},
+	}
+
+	c := &capabilityClient{caps: caps}
+	for _, tc := range cases {
+		err := CheckCapabilities(context.TODO(), c, "node-1", tc.req)
+		if tc.err == "" {
+			g.Expect(err).ShouldNot(HaveOccurred(), tc.name)
+		} else {
+			g.Expect(err).Should(MatchError(tc.err), tc.name)
+		}
+	}
+	// the capabilities are cached for the node
+	g.Expect(c.calls).To(Equal(1))
+
+	unhealthy := &capabilityClient{caps: &chaosdaemonpb.CapabilitiesResponse{
+		Runtimes: []*chaosdaemonpb.RuntimeStatus{{Name: "docker", Error: "connection refused"}},
+	}}
+	err := CheckCapabilities(context.TODO(), unhealthy, "node-2", Requirement{Action: "delay"})
+	g.Expect(err).Should(MatchError("node node-2 can't support delay action: container runtime is unhealthy (docker: connection refused)"))
+
+	// the check is skipped if chaos-daemon doesn't report capabilities
+	old := &capabilityClient{err: status.Error(codes.Unimplemented, "unknown method")}
+	err = CheckCapabilities(context.TODO(), old, "node-3", Requirement{Action: "bandwidth", Modules: []string{"sch_tbf"}})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	leastPrivilege := &capabilityClient{caps: &chaosdaemonpb.CapabilitiesResponse{
+		Mode:              DaemonModeLeastPrivilege,
+		LinuxCapabilities: []string{CapNetAdmin, CapSysPtrace},
+	}}
+	err = CheckCapabilities(context.TODO(), leastPrivilege, "node-4", Requirement{Action: "time", LinuxCapabilities: TimeCapabilities})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	err = CheckCapabilities(context.TODO(), leastPrivilege, "node-4", Requirement{Action: "delay", LinuxCapabilities: NetworkCapabilities})
+	g.Expect(err).Should(MatchError("node node-4 can't support delay action: chaos-daemon runs in least-privilege mode without linux capabilities [CAP_SYS_ADMIN]"))
+	err = CheckCapabilities(context.TODO(), leastPrivilege, "node-4", Requirement{Action: "stress", Privileged: true})
+	g.Expect(err).Should(MatchError("node node-4 can't support stress action: chaos-daemon runs in least-privilege mode but the action requires privileged mode"))
+
+	// the linux capabilities aren't checked in the privileged mode
+	privileged := &capabilityClient{caps: &chaosdaemonpb.CapabilitiesResponse{Mode: DaemonModePrivileged}}
+	err = CheckCapabilities(context.TODO(), privileged, "node-5", Requirement{Action: "stress", Privileged: true, LinuxCapabilities: NetworkCapabilities})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// only the methods are checked on windows
+	windows := &capabilityClient{caps: &chaosdaemonpb.CapabilitiesResponse{
+		Os:      OSWindows,
+		Methods: []string{"SetNetem", "ExecStressors"},
+	}}
+	err = CheckCapabilities(context.TODO(), windows, "node-6", Requirement{Action: "delay", Modules: []string{"sch_netem"},
+		LinuxCapabilities: NetworkCapabilities, Methods: []string{"SetNetem"}})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	err = CheckCapabilities(context.TODO(), windows, "node-6", Requirement{Action: "bandwidth", Methods: []string{"SetTbf"}})
+	g.Expect(err).Should(MatchError("node node-6 can't support bandwidth action: chaos-daemon on windows doesn't support [SetTbf]"))
+
+	// the kernel features are only checked if the kernel config is read
+	arm := &capabilityClient{caps: &chaosdaemonpb.CapabilitiesResponse{
+		KernelVersion:  "5.10.0",
+		Arch:           "arm64",
+		Bpf:            true,
+		KernelConfig:   "/proc/config.gz",
+		KernelFeatures: []string{KernelFeatureBPFSyscall},
+	}}
+	err = CheckCapabilities(context.TODO(), arm, "node-7", Requirement{Action: "delay", BPF: true,
+		KernelFeatures: []string{KernelFeatureBPFSyscall, KernelFeatureNetClsAct}})
+	g.Expect(err).Should(MatchError("node node-7 can't support delay action: kernel features [net_cls_act] are disabled in kernel 5.10.0 (arm64)"))
+	err = CheckCapabilities(context.TODO(), arm, "node-7", Requirement{Action: "delay", Modules: []string{"sch_netem"}})
+	g.Expect(err).Should(MatchError("node node-7 can't support delay action: kernel module sch_netem is unavailable in kernel 5.10.0 (arm64)"))
+	err = CheckCapabilities(context.TODO(), c, "node-1", Requirement{Action: "delay", KernelFeatures: []string{KernelFeatureNetClsAct}})
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
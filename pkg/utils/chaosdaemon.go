@@ -50,7 +50,7 @@ func NewChaosDaemonClient(ctx context.Context, c client.Client, pod *v1.Pod, por
 		return nil, err.(error)
 	}
 
-	cc, err := CreateGrpcConnection(ctx, c, pod, port)
+	cc, err := createChaosDaemonConnection(ctx, c, pod, port)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +60,11 @@ func NewChaosDaemonClient(ctx context.Context, c client.Client, pod *v1.Pod, por
 	}, nil
 }
 
+// NewChaosDaemonClientOnNode creates ChaosDaemonClient of the chaos-daemon on the node
+func NewChaosDaemonClientOnNode(ctx context.Context, c client.Client, node string, port int) (ChaosDaemonClientInterface, error) {
+	return NewChaosDaemonClient(ctx, c, &v1.Pod{Spec: v1.PodSpec{NodeName: node}}, port)
+}
+
 // MergeNetem merges two Netem protos into a new one.
 // REMEMBER to assign the return value, i.e. merged = utils.MergeNetm(merged, em)
 // For each field it takes the bigger value of the two.
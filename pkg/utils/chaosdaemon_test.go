@@ -14,11 +14,13 @@
 package utils
 
 import (
+	"context"
 	"testing"
 
 	. "github.com/onsi/gomega"
 
 	chaosdaemonpb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/simulation"
 )
 
 func TestMergeNetem(t *testing.T) {
@@ -49,3 +51,21 @@ func TestMergeNetem(t *testing.T) {
 		g.Expect(tc.merged).Should(Equal(m))
 	}
 }
+
+func TestSimulatedChaosDaemonClient(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// the simulated client neither looks up the node nor connects to chaos-daemon
+	ctx := simulation.WithSimulation(context.TODO())
+	cli, err := NewChaosDaemonClientOnNode(ctx, nil, "node", 31767)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	defer cli.Close()
+
+	resp, err := cli.ExecStressors(ctx, &chaosdaemonpb.ExecStressRequest{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resp).ShouldNot(BeNil())
+	g.Expect(resp.Instance).To(BeEmpty())
+
+	_, err = cli.WatchStatus(ctx, &chaosdaemonpb.WatchStatusRequest{})
+	g.Expect(err).Should(MatchError(errSimulated))
+}
@@ -17,6 +17,8 @@ import (
 	"strings"
 
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 )
 
 func IgnoreNotFound(err error) error {
@@ -43,3 +45,56 @@ func IsCaredNetError(err error) bool {
 
 	return false
 }
+
+// SelectionError is returned when the pods fail to be selected, its reason is one of
+// the machine-readable reasons of the Selected condition
+type SelectionError struct {
+	reason string
+	msg    string
+	err    error
+}
+
+func newSelectionError(reason string, msg string, err error) *SelectionError {
+	return &SelectionError{reason: reason, msg: msg, err: err}
+}
+
+func (e *SelectionError) Error() string {
+	if e.err == nil {
+		return e.msg
+	}
+	if e.msg == "" {
+		return e.err.Error()
+	}
+	return e.msg + ": " + e.err.Error()
+}
+
+// Reason returns the machine-readable reason of the error
+func (e *SelectionError) Reason() string {
+	return e.reason
+}
+
+// Unwrap returns the underlying error
+func (e *SelectionError) Unwrap() error {
+	return e.err
+}
+
+// Is reports whether the target is a SelectionError with the same reason,
+// so the sentinel errors match the detailed errors of the same reason.
+// The pods not allowed by the namespace policy aren't selected either,
+// so ErrNamespaceNotAllowed is also ErrNoPodSelected.
+func (e *SelectionError) Is(target error) bool {
+	t, ok := target.(*SelectionError)
+	if !ok {
+		return false
+	}
+	return t.reason == e.reason ||
+		(t.reason == v1alpha1.SelectedReasonNoPodSelected && e.reason == v1alpha1.SelectedReasonNamespaceNotAllowed)
+}
+
+// asSelectionError wraps the error as a SelectionError with the reason if it isn't a SelectionError
+func asSelectionError(reason string, err error) error {
+	if _, ok := err.(*SelectionError); ok || err == nil {
+		return err
+	}
+	return newSelectionError(reason, "", err)
+}
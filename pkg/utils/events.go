@@ -30,3 +30,28 @@ const (
 	// The chaos just completed
 	EventChaosRecovered string = "ChaosRecovered"
 )
+
+// The events recorded on a ChaosMonkey for the experiments it generates.
+const (
+	// The monkey just created an experiment
+	EventMonkeyExperimentCreated string = "MonkeyExperimentCreated"
+
+	// The monkey just failed to create or recover an experiment. The message should include detailed error
+	EventMonkeyExperimentFailed string = "MonkeyExperimentFailed"
+
+	// The monkey just recovered an experiment
+	EventMonkeyExperimentRecovered string = "MonkeyExperimentRecovered"
+)
+
+// The events recorded on a workload for the experiments attached to it by the annotation chaos-mesh.org/experiment.
+const (
+	// The experiment attached to the workload was just created
+	EventWorkloadExperimentCreated string = "WorkloadExperimentCreated"
+
+	// The experiment attached to the workload just failed to be created. The message should include detailed error
+	EventWorkloadExperimentFailed string = "WorkloadExperimentFailed"
+
+	// The experiment attached to the workload was just deleted, as the annotation was removed or changed,
+	// or the rollout of the workload finished
+	EventWorkloadExperimentDeleted string = "WorkloadExperimentDeleted"
+)
@@ -15,14 +15,20 @@ package utils
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/simulation"
+	"github.com/chaos-mesh/chaos-mesh/pkg/tracing"
 )
 
 // DefaultRPCTimeout specifies default timeout of RPC between controller and chaos-operator
@@ -31,8 +37,33 @@ const DefaultRPCTimeout = 60 * time.Second
 // RPCTimeout specifies timeout of RPC between controller and chaos-operator
 var RPCTimeout = DefaultRPCTimeout
 
+// ChaosDaemonTLS is the files used to dial chaos-daemon with mutual TLS,
+// the connections are insecure if it's not enabled
+var ChaosDaemonTLS TLSFiles
+
 // CreateGrpcConnection create a grpc connection with given port
 func CreateGrpcConnection(ctx context.Context, c client.Client, pod *v1.Pod, port int) (*grpc.ClientConn, error) {
+	return createGrpcConnection(ctx, c, pod, port, grpc.WithInsecure())
+}
+
+// createChaosDaemonConnection creates a grpc connection to chaos-daemon, which uses mutual TLS if ChaosDaemonTLS is enabled
+func createChaosDaemonConnection(ctx context.Context, c client.Client, pod *v1.Pod, port int) (*grpc.ClientConn, error) {
+	if !ChaosDaemonTLS.Enabled() {
+		return CreateGrpcConnection(ctx, c, pod, port)
+	}
+
+	config, err := ChaosDaemonTLS.ClientTLSConfig(ChaosDaemonServerName)
+	if err != nil {
+		return nil, err
+	}
+	return createGrpcConnection(ctx, c, pod, port, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+}
+
+func createGrpcConnection(ctx context.Context, c client.Client, pod *v1.Pod, port int, security grpc.DialOption) (*grpc.ClientConn, error) {
+	if simulation.IsSimulated(ctx) {
+		return createSimulatedConnection(ctx)
+	}
+
 	nodeName := pod.Spec.NodeName
 	log.Info("Creating client to chaos-daemon", "node", nodeName)
 
@@ -45,14 +76,36 @@ func CreateGrpcConnection(ctx context.Context, c client.Client, pod *v1.Pod, por
 	}
 
 	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", node.Status.Addresses[0].Address, port),
-		grpc.WithInsecure(),
-		grpc.WithUnaryInterceptor(TimeoutClientInterceptor))
+		security,
+		grpc.WithChainUnaryInterceptor(TimeoutClientInterceptor, tracing.UnaryClientInterceptor))
 	if err != nil {
 		return nil, err
 	}
 	return conn, nil
 }
 
+// errSimulated is returned by the simulated connection if it's dialed or streamed
+var errSimulated = errors.New("the connection is simulated")
+
+// createSimulatedConnection creates a connection which never connects to the server. Its unary
+// calls succeed without being sent, so the replies are left empty, and its streams fail.
+func createSimulatedConnection(ctx context.Context) (*grpc.ClientConn, error) {
+	return grpc.DialContext(ctx, "simulated",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return nil, errSimulated
+		}),
+		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{},
+			cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			log.Info("Skip the simulated call", "method", method)
+			return nil
+		}),
+		grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn,
+			method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return nil, errSimulated
+		}))
+}
+
 // TimeoutClientInterceptor wraps the RPC with a timeout.
 func TimeoutClientInterceptor(ctx context.Context, method string, req, reply interface{},
 	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
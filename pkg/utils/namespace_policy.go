@@ -0,0 +1,376 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// NamespacePolicyAllowedKey is the key of AllowedNamespaces in the controller ConfigMap
+	NamespacePolicyAllowedKey = "allowedNamespaces"
+	// NamespacePolicyIgnoredKey is the key of IgnoredNamespaces in the controller ConfigMap
+	NamespacePolicyIgnoredKey = "ignoredNamespaces"
+	// NamespacePolicyFilterKey is the key of EnableFilterNamespace in the controller ConfigMap
+	NamespacePolicyFilterKey = "enableFilterNamespace"
+
+	// maxCompiledPolicies is the max number of compiled policies kept, the cache is reset once it's full
+	maxCompiledPolicies = 16
+	// maxCachedDecisions is the max number of decisions kept for each compiled policy
+	maxCachedDecisions = 4096
+)
+
+// IsAllowedNamespaces returns whether namespace allows the execution of a chaos task
+func IsAllowedNamespaces(ctx context.Context, c client.Client, namespace string) (bool, error) {
+	return ControllerNamespacePolicy().IsAllowed(ctx, c, namespace)
+}
+
+// NamespacePolicy decides which namespaces the chaos is allowed to be injected into
+type NamespacePolicy struct {
+	// EnableFilterNamespace only allows the namespaces annotated with chaos-mesh.org/inject=enabled
+	EnableFilterNamespace bool `json:"enableFilterNamespace"`
+	// AllowedNamespaces is the regexp of the allowed namespaces
+	AllowedNamespaces string `json:"allowedNamespaces"`
+	// IgnoredNamespaces is the regexp of the ignored namespaces, it's ignored if AllowedNamespaces is set
+	IgnoredNamespaces string `json:"ignoredNamespaces"`
+}
+
+// namespacePolicyOverride is the namespace policy reloaded from the controller ConfigMap
+var namespacePolicyOverride = struct {
+	sync.RWMutex
+	// policy overrides the policy configured by the environment if it isn't nil
+	policy *NamespacePolicy
+	// source is where policy is reloaded from
+	source string
+	// reloadTime and reloadErr are the time and the error of the last reload
+	reloadTime time.Time
+	reloadErr  error
+}{}
+
+// ControllerNamespacePolicy returns the namespace policy in effect for the controller, which is the one
+// reloaded from the controller ConfigMap if there is one, otherwise the one configured by the environment
+func ControllerNamespacePolicy() NamespacePolicy {
+	namespacePolicyOverride.RLock()
+	defer namespacePolicyOverride.RUnlock()
+
+	if namespacePolicyOverride.policy != nil {
+		return *namespacePolicyOverride.policy
+	}
+	return environmentNamespacePolicy()
+}
+
+func environmentNamespacePolicy() NamespacePolicy {
+	return NamespacePolicy{
+		EnableFilterNamespace: common.ControllerCfg.EnableFilterNamespace,
+		AllowedNamespaces:     common.ControllerCfg.AllowedNamespaces,
+		IgnoredNamespaces:     common.ControllerCfg.IgnoredNamespaces,
+	}
+}
+
+// setNamespacePolicyOverride overrides the namespace policy of the controller, the policy configured
+// by the environment is restored if policy is nil. The current policy is kept if err isn't nil.
+func setNamespacePolicyOverride(policy *NamespacePolicy, source string, err error) {
+	namespacePolicyOverride.Lock()
+	defer namespacePolicyOverride.Unlock()
+
+	namespacePolicyOverride.reloadTime = time.Now()
+	namespacePolicyOverride.reloadErr = err
+	if err != nil {
+		return
+	}
+	namespacePolicyOverride.policy = policy
+	namespacePolicyOverride.source = source
+}
+
+// IsAllowed returns whether the policy allows the execution of a chaos task in the namespace
+func (p NamespacePolicy) IsAllowed(ctx context.Context, c client.Client, namespace string) (bool, error) {
+	if p.EnableFilterNamespace {
+		ok, err := IsNamespaceInjectEnabled(ctx, c, namespace)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return p.compile().matches(namespace), nil
+}
+
+// Validate returns the error of compiling the regexps of the policy
+func (p NamespacePolicy) Validate() error {
+	return p.compile().err
+}
+
+// allows decides whether the policy allows the namespace locally by its annotations
+func (p NamespacePolicy) allows(ns *v1.Namespace) bool {
+	if p.EnableFilterNamespace && ns.Annotations[NamespaceInjectAnnotationKey] != NamespaceInjectEnabled {
+		return false
+	}
+	return p.compile().matches(ns.Name)
+}
+
+// compiledPolicies caches the compiled regexps and the decisions of the namespace policies,
+// so that the regexps aren't compiled on every call
+var compiledPolicies = struct {
+	sync.Mutex
+	policies map[NamespacePolicy]*compiledNamespacePolicy
+}{
+	policies: make(map[NamespacePolicy]*compiledNamespacePolicy),
+}
+
+type compiledNamespacePolicy struct {
+	allowed *regexp.Regexp
+	ignored *regexp.Regexp
+	// err is the error of compiling the regexps, every namespace is denied if it isn't nil
+	err error
+
+	sync.Mutex
+	decisions map[string]bool
+}
+
+func (p NamespacePolicy) compile() *compiledNamespacePolicy {
+	// the decisions only depend on the regexps
+	key := NamespacePolicy{AllowedNamespaces: p.AllowedNamespaces, IgnoredNamespaces: p.IgnoredNamespaces}
+
+	compiledPolicies.Lock()
+	defer compiledPolicies.Unlock()
+	if compiled, ok := compiledPolicies.policies[key]; ok {
+		return compiled
+	}
+
+	compiled := &compiledNamespacePolicy{decisions: make(map[string]bool)}
+	if p.AllowedNamespaces != "" {
+		compiled.allowed, compiled.err = regexp.Compile(p.AllowedNamespaces)
+	} else if p.IgnoredNamespaces != "" {
+		compiled.ignored, compiled.err = regexp.Compile(p.IgnoredNamespaces)
+	}
+
+	if len(compiledPolicies.policies) >= maxCompiledPolicies {
+		compiledPolicies.policies = make(map[NamespacePolicy]*compiledNamespacePolicy)
+	}
+	compiledPolicies.policies[key] = compiled
+	return compiled
+}
+
+// matches returns whether the name of the namespace is allowed by the regexps
+func (c *compiledNamespacePolicy) matches(namespace string) bool {
+	if c.err != nil {
+		return false
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if allowed, ok := c.decisions[namespace]; ok {
+		return allowed
+	}
+
+	allowed := true
+	if c.allowed != nil {
+		allowed = c.allowed.MatchString(namespace)
+	} else if c.ignored != nil {
+		allowed = !c.ignored.MatchString(namespace)
+	}
+
+	if len(c.decisions) >= maxCachedDecisions {
+		c.decisions = make(map[string]bool)
+	}
+	c.decisions[namespace] = allowed
+	return allowed
+}
+
+// NamespacePolicyReloader hot reloads the namespace policy of the controller from a ConfigMap. The keys
+// of the ConfigMap override the policy configured by the environment, which is restored once the ConfigMap
+// is deleted. An invalid ConfigMap is reported and the current policy is kept.
+type NamespacePolicyReloader struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+}
+
+// NewNamespacePolicyReloader creates a reloader which watches the ConfigMap of the name in the namespace
+func NewNamespacePolicyReloader(client kubernetes.Interface, namespace, name string) *NamespacePolicyReloader {
+	return &NamespacePolicyReloader{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, the policy is reloaded on every
+// replica as the webhooks are served by all of them
+func (r *NamespacePolicyReloader) NeedLeaderElection() bool {
+	return false
+}
+
+// Start watches the ConfigMap until stop is closed
+func (r *NamespacePolicyReloader) Start(stop <-chan struct{}) error {
+	selector := fields.OneTermEqualSelector("metadata.name", r.name).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = selector
+			return r.client.CoreV1().ConfigMaps(r.namespace).List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = selector
+			return r.client.CoreV1().ConfigMaps(r.namespace).Watch(options)
+		},
+	}
+
+	informer := cache.NewSharedInformer(lw, &v1.ConfigMap{}, 0)
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: r.reload,
+		UpdateFunc: func(_, obj interface{}) {
+			r.reload(obj)
+		},
+		DeleteFunc: func(interface{}) {
+			log.Info("controller ConfigMap is deleted, restore the namespace policy of the environment",
+				"namespace", r.namespace, "name", r.name)
+			setNamespacePolicyOverride(nil, "", nil)
+		},
+	})
+	informer.Run(stop)
+	return nil
+}
+
+func (r *NamespacePolicyReloader) reload(obj interface{}) {
+	cm, ok := obj.(*v1.ConfigMap)
+	if !ok || cm.Name != r.name {
+		return
+	}
+
+	source := fmt.Sprintf("configmap %s/%s", cm.Namespace, cm.Name)
+	policy, err := namespacePolicyFromConfigMap(cm)
+	if err != nil {
+		log.Error(err, "invalid namespace policy, keep the current one", "source", source)
+		setNamespacePolicyOverride(nil, "", err)
+		return
+	}
+
+	log.Info("reload namespace policy", "source", source, "policy", policy)
+	setNamespacePolicyOverride(&policy, source, nil)
+}
+
+// namespacePolicyFromConfigMap overrides the namespace policy configured by the environment
+// with the keys of the ConfigMap
+func namespacePolicyFromConfigMap(cm *v1.ConfigMap) (NamespacePolicy, error) {
+	policy := environmentNamespacePolicy()
+	if value, ok := cm.Data[NamespacePolicyAllowedKey]; ok {
+		policy.AllowedNamespaces = value
+	}
+	if value, ok := cm.Data[NamespacePolicyIgnoredKey]; ok {
+		policy.IgnoredNamespaces = value
+	}
+	if value, ok := cm.Data[NamespacePolicyFilterKey]; ok {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return policy, fmt.Errorf("invalid %s %q: %v", NamespacePolicyFilterKey, value, err)
+		}
+		policy.EnableFilterNamespace = enabled
+	}
+
+	if err := policy.Validate(); err != nil {
+		return policy, err
+	}
+	return policy, nil
+}
+
+// NamespacePolicyStatus is the effective namespace policy of the controller
+type NamespacePolicyStatus struct {
+	Policy NamespacePolicy `json:"policy"`
+	// Source is where the policy comes from, either the environment or the controller ConfigMap
+	Source string `json:"source"`
+	// LastReloadTime is the time of the last reload from the controller ConfigMap
+	LastReloadTime *metav1.Time `json:"lastReloadTime,omitempty"`
+	// LastReloadError is the error of the last reload, the policy isn't changed by a failed reload
+	LastReloadError string `json:"lastReloadError,omitempty"`
+	// Allowed are the namespaces which the chaos is allowed to be injected into
+	Allowed []string `json:"allowed"`
+	// Denied are the namespaces which the chaos is denied to be injected into
+	Denied []string `json:"denied"`
+}
+
+// GetNamespacePolicyStatus returns the effective namespace policy and the decisions of it on the namespaces
+func GetNamespacePolicyStatus(ctx context.Context, c client.Reader) (*NamespacePolicyStatus, error) {
+	namespacePolicyOverride.RLock()
+	status := &NamespacePolicyStatus{
+		Source:  "environment",
+		Allowed: []string{},
+		Denied:  []string{},
+	}
+	if namespacePolicyOverride.policy != nil {
+		status.Policy = *namespacePolicyOverride.policy
+		status.Source = namespacePolicyOverride.source
+	} else {
+		status.Policy = environmentNamespacePolicy()
+	}
+	if !namespacePolicyOverride.reloadTime.IsZero() {
+		status.LastReloadTime = &metav1.Time{Time: namespacePolicyOverride.reloadTime}
+	}
+	if namespacePolicyOverride.reloadErr != nil {
+		status.LastReloadError = namespacePolicyOverride.reloadErr.Error()
+	}
+	namespacePolicyOverride.RUnlock()
+
+	var namespaces v1.NamespaceList
+	if err := c.List(ctx, &namespaces); err != nil {
+		return nil, err
+	}
+	for i := range namespaces.Items {
+		ns := &namespaces.Items[i]
+		if status.Policy.allows(ns) {
+			status.Allowed = append(status.Allowed, ns.Name)
+		} else {
+			status.Denied = append(status.Denied, ns.Name)
+		}
+	}
+	sort.Strings(status.Allowed)
+	sort.Strings(status.Denied)
+
+	return status, nil
+}
+
+// NamespacePolicyHandler serves the effective namespace policy of the controller and the namespaces
+// allowed and denied by it
+func NamespacePolicyHandler(c client.Reader) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status, err := GetNamespacePolicyStatus(r.Context(), c)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			log.Error(err, "failed to write namespace policy")
+		}
+	})
+}
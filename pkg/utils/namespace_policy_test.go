@@ -0,0 +1,157 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/controllers/common"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNamespacePolicyCompile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	policy := NamespacePolicy{AllowedNamespaces: "^app-.*"}
+	g.Expect(policy.compile()).To(BeIdenticalTo(policy.compile()))
+	g.Expect(policy.compile().matches("app-a")).To(BeTrue())
+	g.Expect(policy.compile().matches("kube-system")).To(BeFalse())
+
+	// the ignored namespaces are ignored if the allowed namespaces are set
+	policy.IgnoredNamespaces = "app-a"
+	g.Expect(policy.compile().matches("app-a")).To(BeTrue())
+
+	policy = NamespacePolicy{IgnoredNamespaces: "kube-.*"}
+	g.Expect(policy.compile().matches("app-a")).To(BeTrue())
+	g.Expect(policy.compile().matches("kube-system")).To(BeFalse())
+
+	policy = NamespacePolicy{AllowedNamespaces: "("}
+	g.Expect(policy.Validate()).To(HaveOccurred())
+	g.Expect(policy.compile().matches("app-a")).To(BeFalse())
+
+	g.Expect(NamespacePolicy{}.compile().matches("app-a")).To(BeTrue())
+}
+
+func TestNamespacePolicyFromConfigMap(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	common.ControllerCfg.IgnoredNamespaces = "kube-.*"
+	defer func() {
+		common.ControllerCfg.IgnoredNamespaces = ""
+	}()
+
+	policy, err := namespacePolicyFromConfigMap(&v1.ConfigMap{
+		Data: map[string]string{
+			NamespacePolicyAllowedKey: "app-.*",
+			NamespacePolicyFilterKey:  "true",
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(policy).To(Equal(NamespacePolicy{
+		EnableFilterNamespace: true,
+		AllowedNamespaces:     "app-.*",
+		IgnoredNamespaces:     "kube-.*",
+	}))
+
+	_, err = namespacePolicyFromConfigMap(&v1.ConfigMap{Data: map[string]string{NamespacePolicyFilterKey: "yes"}})
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = namespacePolicyFromConfigMap(&v1.ConfigMap{Data: map[string]string{NamespacePolicyIgnoredKey: "("}})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNamespacePolicyReloader(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	defer setNamespacePolicyOverride(nil, "", nil)
+
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "chaos-testing", Name: "chaos-mesh-controller"},
+		Data:       map[string]string{NamespacePolicyAllowedKey: "app-.*"},
+	}
+	clientset := kubefake.NewSimpleClientset(cm)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go NewNamespacePolicyReloader(clientset, "chaos-testing", "chaos-mesh-controller").Start(stop)
+
+	g.Eventually(ControllerNamespacePolicy, time.Second*5).Should(Equal(NamespacePolicy{AllowedNamespaces: "app-.*"}))
+
+	// an invalid policy is rejected and the current one is kept
+	cm.Data[NamespacePolicyAllowedKey] = "("
+	_, err := clientset.CoreV1().ConfigMaps("chaos-testing").Update(cm)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Eventually(func() error {
+		namespacePolicyOverride.RLock()
+		defer namespacePolicyOverride.RUnlock()
+		return namespacePolicyOverride.reloadErr
+	}, time.Second*5).Should(HaveOccurred())
+	g.Expect(ControllerNamespacePolicy()).To(Equal(NamespacePolicy{AllowedNamespaces: "app-.*"}))
+
+	cm.Data[NamespacePolicyAllowedKey] = "web"
+	_, err = clientset.CoreV1().ConfigMaps("chaos-testing").Update(cm)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Eventually(ControllerNamespacePolicy, time.Second*5).Should(Equal(NamespacePolicy{AllowedNamespaces: "web"}))
+
+	// the policy of the environment is restored once the ConfigMap is deleted
+	err = clientset.CoreV1().ConfigMaps("chaos-testing").Delete(cm.Name, &metav1.DeleteOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Eventually(ControllerNamespacePolicy, time.Second*5).Should(Equal(NamespacePolicy{}))
+}
+
+func TestNamespacePolicyHandler(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	policy := NamespacePolicy{EnableFilterNamespace: true, IgnoredNamespaces: "kube-.*"}
+	setNamespacePolicyOverride(&policy, "configmap chaos-testing/chaos-mesh-controller", nil)
+	defer setNamespacePolicyOverride(nil, "", nil)
+
+	enabled := map[string]string{NamespaceInjectAnnotationKey: NamespaceInjectEnabled}
+	c := fake.NewFakeClient(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "app", Annotations: enabled}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system", Annotations: enabled}},
+	)
+
+	recorder := httptest.NewRecorder()
+	NamespacePolicyHandler(c).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/namespaces/policy", nil))
+	g.Expect(recorder.Code).To(Equal(http.StatusOK))
+
+	var status NamespacePolicyStatus
+	g.Expect(json.Unmarshal(recorder.Body.Bytes(), &status)).To(Succeed())
+	g.Expect(status.Policy).To(Equal(policy))
+	g.Expect(status.Source).To(Equal("configmap chaos-testing/chaos-mesh-controller"))
+	g.Expect(status.LastReloadTime).ToNot(BeNil())
+	g.Expect(status.Allowed).To(Equal([]string{"app"}))
+	g.Expect(status.Denied).To(Equal([]string{"default", "kube-system"}))
+
+	allowed, err := IsAllowedNamespaces(context.TODO(), c, "app")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(allowed).To(BeTrue())
+	allowed, err = IsAllowedNamespaces(context.TODO(), c, "default")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(allowed).To(BeFalse())
+}
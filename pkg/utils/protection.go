@@ -0,0 +1,106 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// IsPodProtected checks whether the pod meets any selector of the protections
+func IsPodProtected(pod v1.Pod, protections []v1alpha1.ChaosProtection) (bool, error) {
+	for _, protection := range protections {
+		for _, selector := range protection.Spec.Selectors {
+			meet, err := CheckPodMeetSelector(pod, selector)
+			if err != nil {
+				return false, err
+			}
+			if meet {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// IsExclusivelyProtected checks whether all the pods selected by the selectors are protected.
+// It returns false if no pod is selected.
+func IsExclusivelyProtected(ctx context.Context, c client.Client, selectors []v1alpha1.SelectorSpec) (bool, error) {
+	protections, err := listProtections(ctx, c)
+	if err != nil || len(protections) == 0 {
+		return false, err
+	}
+
+	selected := 0
+	for _, selector := range selectors {
+		pods, err := selectPods(ctx, c, selector, ControllerNamespacePolicy(), &v1alpha1.SelectionStatus{})
+		if err != nil {
+			return false, err
+		}
+
+		for _, pod := range pods {
+			protected, err := IsPodProtected(pod, protections)
+			if err != nil {
+				return false, err
+			}
+			if !protected {
+				return false, nil
+			}
+			selected++
+		}
+	}
+
+	return selected > 0, nil
+}
+
+// filterProtectedPods removes the pods protected by any ChaosProtection
+func filterProtectedPods(ctx context.Context, c client.Client, pods []v1.Pod) ([]v1.Pod, error) {
+	if len(pods) == 0 {
+		return pods, nil
+	}
+
+	protections, err := listProtections(ctx, c)
+	if err != nil || len(protections) == 0 {
+		return pods, err
+	}
+
+	var filtered []v1.Pod
+	for _, pod := range pods {
+		protected, err := IsPodProtected(pod, protections)
+		if err != nil {
+			return nil, err
+		}
+		if protected {
+			log.Info("filter protected pod", "namespace", pod.Namespace, "name", pod.Name)
+			continue
+		}
+		filtered = append(filtered, pod)
+	}
+
+	return filtered, nil
+}
+
+func listProtections(ctx context.Context, c client.Client) ([]v1alpha1.ChaosProtection, error) {
+	var protectionList v1alpha1.ChaosProtectionList
+	if err := c.List(ctx, &protectionList); err != nil {
+		return nil, err
+	}
+
+	return protectionList.Items, nil
+}
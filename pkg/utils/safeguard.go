@@ -0,0 +1,150 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// workload is the ReplicaSet or StatefulSet which owns the pods
+type workload struct {
+	kind string
+	types.NamespacedName
+}
+
+// ApplySafeguards removes the selected pods which would reduce their workloads below the min available
+// replicas of the safeguards, and records the number of the removed pods in the selection.
+// The pods are kept if the safeguards is nil or overridden. A SelectionError is returned if all the
+// pods are removed.
+func ApplySafeguards(ctx context.Context, c client.Reader, safeguards *v1alpha1.Safeguards, pods []v1.Pod, selection *v1alpha1.SelectionStatus) ([]v1.Pod, error) {
+	if safeguards == nil || safeguards.Override || len(pods) == 0 {
+		return pods, nil
+	}
+
+	allowed, err := filterSafeguardedPods(ctx, c, safeguards.MinAvailable, pods)
+	if err != nil {
+		return nil, asSelectionError(v1alpha1.SelectedReasonAPIError, err)
+	}
+	selection.Safeguarded = len(pods) - len(allowed)
+	selection.Selected = len(allowed)
+
+	if len(allowed) == 0 {
+		return nil, newSelectionError(v1alpha1.SelectedReasonSafeguarded,
+			fmt.Sprintf("all the %d selected pods are skipped to keep %s of their workloads available",
+				len(pods), safeguards.MinAvailable.String()), nil)
+	}
+	return allowed, nil
+}
+
+// filterSafeguardedPods keeps the pods as long as the available replicas of their workloads don't drop
+// below minAvailable. The pods which aren't available don't reduce the availability, so they're
+// always kept, and so are the pods which aren't owned by any ReplicaSet or StatefulSet.
+func filterSafeguardedPods(ctx context.Context, c client.Reader, minAvailable intstr.IntOrString, pods []v1.Pod) ([]v1.Pod, error) {
+	// budgets are the numbers of the available pods of the workloads which can be disrupted
+	budgets := make(map[workload]int)
+
+	var filtered []v1.Pod
+	for _, pod := range pods {
+		ref := metav1.GetControllerOf(&pod)
+		if ref == nil || (ref.Kind != "ReplicaSet" && ref.Kind != "StatefulSet") || !isPodAvailable(pod) {
+			filtered = append(filtered, pod)
+			continue
+		}
+
+		key := workload{kind: ref.Kind, NamespacedName: types.NamespacedName{Namespace: pod.Namespace, Name: ref.Name}}
+		budget, ok := budgets[key]
+		if !ok {
+			replicas, available, found, err := workloadReplicas(ctx, c, key)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				// the orphaned pods have no workload to keep available
+				filtered = append(filtered, pod)
+				continue
+			}
+			min, err := intstr.GetValueFromIntOrPercent(&minAvailable, replicas, true)
+			if err != nil {
+				return nil, err
+			}
+			budget = available - min
+		}
+
+		if budget <= 0 {
+			log.Info("skip pod by safeguards", "namespace", pod.Namespace, "name", pod.Name,
+				"workload", key.Name, "minAvailable", minAvailable.String())
+			budgets[key] = budget
+			continue
+		}
+		budgets[key] = budget - 1
+		filtered = append(filtered, pod)
+	}
+	return filtered, nil
+}
+
+// workloadReplicas returns the desired and the available replicas of the workload, and whether it's found
+func workloadReplicas(ctx context.Context, c client.Reader, key workload) (int, int, bool, error) {
+	switch key.kind {
+	case "ReplicaSet":
+		var rs appsv1.ReplicaSet
+		if err := c.Get(ctx, key.NamespacedName, &rs); err != nil {
+			if k8serror.IsNotFound(err) {
+				return 0, 0, false, nil
+			}
+			return 0, 0, false, err
+		}
+		replicas := 1
+		if rs.Spec.Replicas != nil {
+			replicas = int(*rs.Spec.Replicas)
+		}
+		return replicas, int(rs.Status.AvailableReplicas), true, nil
+	default:
+		var sts appsv1.StatefulSet
+		if err := c.Get(ctx, key.NamespacedName, &sts); err != nil {
+			if k8serror.IsNotFound(err) {
+				return 0, 0, false, nil
+			}
+			return 0, 0, false, err
+		}
+		replicas := 1
+		if sts.Spec.Replicas != nil {
+			replicas = int(*sts.Spec.Replicas)
+		}
+		return replicas, int(sts.Status.ReadyReplicas), true, nil
+	}
+}
+
+// isPodAvailable returns true if the pod is ready and isn't being deleted
+func isPodAvailable(pod v1.Pod) bool {
+	if pod.DeletionTimestamp != nil {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func newOwnedPod(name string, kind string, owner string, ready bool) v1.Pod {
+	controller := true
+	status := v1.ConditionFalse
+	if ready {
+		status = v1.ConditionTrue
+	}
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       metav1.NamespaceDefault,
+			Name:            name,
+			OwnerReferences: []metav1.OwnerReference{{Kind: kind, Name: owner, Controller: &controller}},
+		},
+		Status: v1.PodStatus{Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: status}}},
+	}
+}
+
+func TestApplySafeguards(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	replicas := int32(3)
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "web"},
+		Spec:       appsv1.ReplicaSetSpec{Replicas: &replicas},
+		Status:     appsv1.ReplicaSetStatus{AvailableReplicas: 3},
+	}
+	single := int32(1)
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "db"},
+		Spec:       appsv1.StatefulSetSpec{Replicas: &single},
+		Status:     appsv1.StatefulSetStatus{ReadyReplicas: 1},
+	}
+	c := newFakeClient(rs, sts)
+
+	pods := []v1.Pod{
+		newOwnedPod("web-a", "ReplicaSet", "web", true),
+		newOwnedPod("web-b", "ReplicaSet", "web", true),
+		newOwnedPod("web-c", "ReplicaSet", "web", true),
+		newOwnedPod("db-0", "StatefulSet", "db", true),
+	}
+
+	// one replica of each workload is kept available
+	selection := &v1alpha1.SelectionStatus{Selected: len(pods)}
+	safeguards := &v1alpha1.Safeguards{MinAvailable: intstr.FromInt(1)}
+	allowed, err := ApplySafeguards(context.TODO(), c, safeguards, pods, selection)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(allowed).Should(Equal(pods[:2]))
+	g.Expect(selection.Safeguarded).Should(Equal(2))
+	g.Expect(selection.Selected).Should(Equal(2))
+
+	// the percentage is rounded up
+	selection = &v1alpha1.SelectionStatus{}
+	safeguards = &v1alpha1.Safeguards{MinAvailable: intstr.FromString("50%")}
+	allowed, err = ApplySafeguards(context.TODO(), c, safeguards, pods[:3], selection)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(allowed).Should(HaveLen(1))
+
+	// the unavailable and the orphaned pods don't reduce the availability
+	unready := newOwnedPod("web-d", "ReplicaSet", "web", false)
+	orphaned := newOwnedPod("job-a", "ReplicaSet", "missing", true)
+	allowed, err = ApplySafeguards(context.TODO(), c, safeguards, []v1.Pod{unready, orphaned}, selection)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(allowed).Should(HaveLen(2))
+
+	// all the pods are skipped
+	selection = &v1alpha1.SelectionStatus{}
+	safeguards = &v1alpha1.Safeguards{MinAvailable: intstr.FromString("100%")}
+	_, err = ApplySafeguards(context.TODO(), c, safeguards, pods, selection)
+	g.Expect(err).Should(HaveOccurred())
+	var selectionErr *SelectionError
+	g.Expect(errors.As(err, &selectionErr)).Should(BeTrue())
+	g.Expect(selectionErr.Reason()).Should(Equal(v1alpha1.SelectedReasonSafeguarded))
+	g.Expect(selection.Safeguarded).Should(Equal(4))
+
+	// the safeguards are overridden
+	safeguards.Override = true
+	allowed, err = ApplySafeguards(context.TODO(), c, safeguards, pods, &v1alpha1.SelectionStatus{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(allowed).Should(Equal(pods))
+}
@@ -15,18 +15,14 @@ package utils
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"math"
 	"math/rand"
-	"regexp"
-	"strconv"
 	"strings"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
-	"github.com/chaos-mesh/chaos-mesh/controllers/common"
 	"github.com/chaos-mesh/chaos-mesh/pkg/label"
 	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+	"github.com/chaos-mesh/chaos-mesh/pkg/targetprovider"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -38,6 +34,18 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
+var (
+	// ErrNoPodSelected is returned by SelectAndFilterPods when no pod meets the selector
+	ErrNoPodSelected = newSelectionError(v1alpha1.SelectedReasonNoPodSelected, "no pod is selected", nil)
+	// ErrNamespaceNotAllowed is returned by SelectAndFilterPods when all the pods meeting the selector
+	// are in the namespaces not allowed by the namespace policy
+	ErrNamespaceNotAllowed = newSelectionError(v1alpha1.SelectedReasonNamespaceNotAllowed, "namespace is not allowed", nil)
+	// ErrInvalidMode is returned by SelectAndFilterPods when the mode or its value is invalid
+	ErrInvalidMode = newSelectionError(v1alpha1.SelectedReasonInvalidMode, "invalid mode", nil)
+	// ErrInvalidSelector is returned by SelectAndFilterPods when the selector can't be parsed
+	ErrInvalidSelector = newSelectionError(v1alpha1.SelectedReasonInvalidSelector, "invalid selector", nil)
+)
+
 type SelectSpec interface {
 	GetSelector() v1alpha1.SelectorSpec
 	GetMode() v1alpha1.PodMode
@@ -46,31 +54,92 @@ type SelectSpec interface {
 
 // SelectAndFilterPods returns the list of pods that filtered by selector and PodMode
 func SelectAndFilterPods(ctx context.Context, c client.Client, spec SelectSpec) ([]v1.Pod, error) {
+	return SelectAndRecordPods(ctx, c, spec, &v1alpha1.SelectionStatus{})
+}
+
+// SelectAndRecordPods returns the list of pods that filtered by selector and PodMode,
+// and records how many pods are matched, filtered and finally selected in the selection.
+// The selection is recorded even if no pod is selected.
+// The errors are SelectionErrors, whose reasons tell the failures apart.
+func SelectAndRecordPods(ctx context.Context, c client.Client, spec SelectSpec, selection *v1alpha1.SelectionStatus) ([]v1.Pod, error) {
 	if pods := mock.On("MockSelectAndFilterPods"); pods != nil {
-		return pods.(func() []v1.Pod)(), nil
+		selected := pods.(func() []v1.Pod)()
+		*selection = v1alpha1.SelectionStatus{Matched: len(selected), Selected: len(selected)}
+		return selected, nil
 	}
 	if err := mock.On("MockSelectedAndFilterPodsError"); err != nil {
 		return nil, err.(error)
 	}
 
+	s := &PodSelector{Client: c, Policy: ControllerNamespacePolicy(), Provider: targetprovider.Default()}
+	return s.SelectAndRecordPods(ctx, spec, selection)
+}
+
+// PodSelector selects the pods of the chaos like SelectAndRecordPods, with the namespace policy and
+// the source of the random selection given instead of the ones of the controller
+type PodSelector struct {
+	Client client.Client
+	Policy NamespacePolicy
+	// Rand chooses the pods in the random modes, the global source of math/rand is used if it's nil
+	Rand *rand.Rand
+	// Provider vetoes or supplies the candidates before they're chosen by the mode if it's not nil
+	Provider targetprovider.Provider
+}
+
+// SelectAndRecordPods returns the list of pods that filtered by selector and PodMode,
+// and records the numbers of the pods in the selection like the package-level SelectAndRecordPods
+func (s *PodSelector) SelectAndRecordPods(ctx context.Context, spec SelectSpec, selection *v1alpha1.SelectionStatus) ([]v1.Pod, error) {
+	c := s.Client
 	selector := spec.GetSelector()
 	mode := spec.GetMode()
 	value := spec.GetValue()
 
-	pods, err := SelectPods(ctx, c, selector)
+	*selection = v1alpha1.SelectionStatus{}
+	pods, err := selectPods(ctx, c, selector, s.Policy, selection)
 	if err != nil {
-		return nil, err
+		return nil, asSelectionError(v1alpha1.SelectedReasonAPIError, err)
 	}
 
-	if len(pods) == 0 {
-		err = errors.New("no pod is selected")
-		return nil, err
+	unprotected, err := filterProtectedPods(ctx, c, pods)
+	if err != nil {
+		return nil, asSelectionError(v1alpha1.SelectedReasonAPIError, err)
+	}
+	selection.Protected = len(pods) - len(unprotected)
+
+	if len(unprotected) == 0 {
+		if selection.Matched > 0 && selection.Matched == selection.FilteredByNamespace {
+			return nil, newSelectionError(v1alpha1.SelectedReasonNamespaceNotAllowed,
+				fmt.Sprintf("all the %d pods meeting the selector are in the namespaces not allowed", selection.Matched), nil)
+		}
+		return nil, ErrNoPodSelected
+	}
+
+	live, err := filterGonePods(ctx, c, unprotected, selection)
+	if err != nil {
+		return nil, asSelectionError(v1alpha1.SelectedReasonAPIError, err)
+	}
+	if len(live) == 0 {
+		return nil, newSelectionError(v1alpha1.SelectedReasonTargetGone,
+			fmt.Sprintf("all the %d pods meeting the selector are gone with their namespaces or workloads", len(unprotected)), nil)
+	}
+	unprotected = live
+
+	if s.Provider != nil {
+		unprotected, err = resolveTargets(ctx, c, s.Provider, s.Policy, unprotected)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	filteredPod, err := filterPodsByMode(pods, mode, value)
+	intn := rand.Intn
+	if s.Rand != nil {
+		intn = s.Rand.Intn
+	}
+	filteredPod, err := filterPodsByMode(ctx, c, unprotected, mode, value, intn)
 	if err != nil {
 		return nil, err
 	}
+	selection.Selected = len(filteredPod)
 
 	return filteredPod, nil
 }
@@ -78,14 +147,33 @@ func SelectAndFilterPods(ctx context.Context, c client.Client, spec SelectSpec)
 // SelectPods returns the list of pods that are available for pod chaos action.
 // It returns all pods that match the configured label, annotation and namespace selectors.
 // If pods are specifically specified by `selector.Pods`, it just returns the selector.Pods.
+// Pods protected by any ChaosProtection are never returned.
 func SelectPods(ctx context.Context, c client.Client, selector v1alpha1.SelectorSpec) ([]v1.Pod, error) {
+	pods, err := selectPods(ctx, c, selector, ControllerNamespacePolicy(), &v1alpha1.SelectionStatus{})
+	if err != nil {
+		return nil, err
+	}
+
+	return filterProtectedPods(ctx, c, pods)
+}
+
+// selectPods returns the pods matching the selector and allowed by the namespace policy,
+// the numbers of the matched pods and the ones filtered by the policy are recorded in the selection
+func selectPods(ctx context.Context, c client.Client, selector v1alpha1.SelectorSpec, policy NamespacePolicy, selection *v1alpha1.SelectionStatus) ([]v1.Pod, error) {
 	var pods []v1.Pod
 
 	// pods are specifically specified
 	if len(selector.Pods) > 0 {
 		for ns, names := range selector.Pods {
-			if !IsAllowedNamespaces(ns) {
+			allowed, err := policy.IsAllowed(ctx, c, ns)
+			if err != nil {
+				return nil, err
+			}
+			if !allowed {
 				log.Info("filter pod by namespaces", "namespace", ns)
+				selection.Matched += len(names)
+				selection.FilteredByNamespace += len(names)
+				continue
 			}
 			for _, name := range names {
 				var pod v1.Pod
@@ -95,6 +183,7 @@ func SelectPods(ctx context.Context, c client.Client, selector v1alpha1.Selector
 				}, &pod)
 				if err == nil {
 					pods = append(pods, pod)
+					selection.Matched++
 					continue
 				}
 
@@ -151,7 +240,6 @@ func SelectPods(ctx context.Context, c client.Client, selector v1alpha1.Selector
 		}
 		pods = filterPodByNode(pods, nodes)
 	}
-	pods = filterByNamespaces(pods)
 
 	namespaceSelector, err := parseSelector(strings.Join(selector.Namespaces, ","))
 	if err != nil {
@@ -176,8 +264,15 @@ func SelectPods(ctx context.Context, c client.Client, selector v1alpha1.Selector
 	if err != nil {
 		return nil, err
 	}
+	selection.Matched = len(pods)
+
+	allowed, err := filterByNamespaces(ctx, c, pods, policy)
+	if err != nil {
+		return nil, err
+	}
+	selection.FilteredByNamespace = len(pods) - len(allowed)
 
-	return pods, nil
+	return allowed, nil
 }
 
 // CheckPodMeetSelector checks if this pod meets the selection criteria.
@@ -269,73 +364,28 @@ func filterPodByNode(pods []v1.Pod, nodes []v1.Node) []v1.Pod {
 	return filteredList
 }
 
-// filterPodsByMode filters pods by mode from pod list
-func filterPodsByMode(pods []v1.Pod, mode v1alpha1.PodMode, value string) ([]v1.Pod, error) {
+// filterPodsByMode chooses the pods by the selection strategy of the mode,
+// the pods are chosen randomly by intn in the random modes
+func filterPodsByMode(ctx context.Context, c client.Client, pods []v1.Pod, mode v1alpha1.PodMode, value string, intn func(int) int) ([]v1.Pod, error) {
 	if len(pods) == 0 {
-		return nil, errors.New("cannot generate pods from empty list")
+		return nil, ErrNoPodSelected
 	}
 
-	switch mode {
-	case v1alpha1.OnePodMode:
-		index := rand.Intn(len(pods))
-		pod := pods[index]
-
-		return []v1.Pod{pod}, nil
-	case v1alpha1.AllPodMode:
-		return pods, nil
-	case v1alpha1.FixedPodMode:
-		num, err := strconv.Atoi(value)
-		if err != nil {
-			return nil, err
-		}
-
-		if len(pods) < num {
-			num = len(pods)
-		}
-
-		if num <= 0 {
-			return nil, errors.New("cannot select any pod as value below or equal 0")
-		}
-
-		return getFixedSubListFromPodList(pods, num), nil
-	case v1alpha1.FixedPercentPodMode:
-		percentage, err := strconv.Atoi(value)
-		if err != nil {
-			return nil, err
-		}
-
-		if percentage == 0 {
-			return nil, errors.New("cannot select any pod as value below or equal 0")
-		}
-
-		if percentage < 0 || percentage > 100 {
-			return nil, fmt.Errorf("fixed percentage value of %d is invalid, Must be (0,100]", percentage)
-		}
-
-		num := int(math.Floor(float64(len(pods)) * float64(percentage) / 100))
-
-		return getFixedSubListFromPodList(pods, num), nil
-	case v1alpha1.RandomMaxPercentPodMode:
-		maxPercentage, err := strconv.Atoi(value)
-		if err != nil {
-			return nil, err
-		}
-
-		if maxPercentage == 0 {
-			return nil, errors.New("cannot select any pod as value below or equal 0")
-		}
-
-		if maxPercentage < 0 || maxPercentage > 100 {
-			return nil, fmt.Errorf("fixed percentage value of %d is invalid, Must be [0-100]", maxPercentage)
-		}
-
-		percentage := rand.Intn(maxPercentage + 1) // + 1 because Intn works with half open interval [0,n) and we want [0,n]
-		num := int(math.Floor(float64(len(pods)) * float64(percentage) / 100))
+	strategy, ok := GetSelectionStrategy(mode)
+	if !ok {
+		return nil, newSelectionError(v1alpha1.SelectedReasonInvalidMode, fmt.Sprintf("mode %s not supported", mode), nil)
+	}
 
-		return getFixedSubListFromPodList(pods, num), nil
-	default:
-		return nil, fmt.Errorf("mode %s not supported", mode)
+	selected, err := strategy.Select(ctx, &StrategyRequest{
+		Client:     c,
+		Candidates: pods,
+		Value:      value,
+		Intn:       intn,
+	})
+	if err != nil {
+		return nil, asSelectionError(v1alpha1.SelectedReasonAPIError, err)
 	}
+	return selected, nil
 }
 
 // filterByAnnotations filters a list of pods by a given annotation selector.
@@ -381,7 +431,7 @@ func filterByPhaseSelector(pods []v1.Pod, phases labels.Selector) ([]v1.Pod, err
 		case selection.DoesNotExist:
 			reqExcl = append(reqExcl, req)
 		default:
-			return nil, fmt.Errorf("unsupported operator: %s", req.Operator())
+			return nil, newSelectionError(v1alpha1.SelectedReasonInvalidSelector, fmt.Sprintf("unsupported operator: %s", req.Operator()), nil)
 		}
 	}
 
@@ -413,39 +463,44 @@ func filterByPhaseSelector(pods []v1.Pod, phases labels.Selector) ([]v1.Pod, err
 	return filteredList, nil
 }
 
-func filterByNamespaces(pods []v1.Pod) []v1.Pod {
+func filterByNamespaces(ctx context.Context, c client.Client, pods []v1.Pod, policy NamespacePolicy) ([]v1.Pod, error) {
 	var filteredList []v1.Pod
 
+	// cache the result of each namespace to avoid getting the same namespace repeatedly
+	allowedNamespaces := make(map[string]bool)
 	for _, pod := range pods {
-		if IsAllowedNamespaces(pod.Namespace) {
+		allowed, ok := allowedNamespaces[pod.Namespace]
+		if !ok {
+			var err error
+			allowed, err = policy.IsAllowed(ctx, c, pod.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			allowedNamespaces[pod.Namespace] = allowed
+		}
+
+		if allowed {
 			filteredList = append(filteredList, pod)
 		} else {
 			log.Info("filter pod by namespaces",
 				"pod", pod.Name, "namespace", pod.Namespace)
 		}
 	}
-	return filteredList
+	return filteredList, nil
 }
 
-// IsAllowedNamespaces returns whether namespace allows the execution of a chaos task
-func IsAllowedNamespaces(namespace string) bool {
-	if common.ControllerCfg.AllowedNamespaces != "" {
-		matched, err := regexp.MatchString(common.ControllerCfg.AllowedNamespaces, namespace)
-		if err != nil {
-			return false
-		}
-		return matched
-	}
-
-	if common.ControllerCfg.IgnoredNamespaces != "" {
-		matched, err := regexp.MatchString(common.ControllerCfg.IgnoredNamespaces, namespace)
-		if err != nil {
-			return false
+// IsNamespaceInjectEnabled returns whether the namespace has opted into chaos
+// by the annotation `chaos-mesh.org/inject=enabled`
+func IsNamespaceInjectEnabled(ctx context.Context, c client.Client, namespace string) (bool, error) {
+	var ns v1.Namespace
+	if err := c.Get(ctx, types.NamespacedName{Name: namespace}, &ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
 		}
-		return !matched
+		return false, err
 	}
 
-	return true
+	return ns.Annotations[NamespaceInjectAnnotationKey] == NamespaceInjectEnabled, nil
 }
 
 // filterByNamespaceSelector filters a list of pods by a given namespace selector.
@@ -472,7 +527,7 @@ func filterByNamespaceSelector(pods []v1.Pod, namespaces labels.Selector) ([]v1.
 		case selection.DoesNotExist:
 			reqExcl = append(reqExcl, req)
 		default:
-			return nil, fmt.Errorf("unsupported operator: %s", req.Operator())
+			return nil, newSelectionError(v1alpha1.SelectedReasonInvalidSelector, fmt.Sprintf("unsupported operator: %s", req.Operator()), nil)
 		}
 	}
 
@@ -510,13 +565,13 @@ func filterByNamespaceSelector(pods []v1.Pod, namespaces labels.Selector) ([]v1.
 func parseSelector(str string) (labels.Selector, error) {
 	selector, err := labels.Parse(str)
 	if err != nil {
-		return nil, err
+		return nil, asSelectionError(v1alpha1.SelectedReasonInvalidSelector, err)
 	}
 	return selector, nil
 }
 
-func getFixedSubListFromPodList(pods []v1.Pod, num int) []v1.Pod {
-	indexes := RandomFixedIndexes(0, uint(len(pods)), uint(num))
+func getFixedSubListFromPodList(pods []v1.Pod, num int, intn func(int) int) []v1.Pod {
+	indexes := randomFixedIndexes(0, uint(len(pods)), uint(num), intn)
 
 	var filteredPods []v1.Pod
 
@@ -531,6 +586,10 @@ func getFixedSubListFromPodList(pods []v1.Pod, num int) []v1.Pod {
 // RandomFixedIndexes returns the `count` random indexes between `start` and `end`.
 // [start, end)
 func RandomFixedIndexes(start, end, count uint) []uint {
+	return randomFixedIndexes(start, end, count, rand.Intn)
+}
+
+func randomFixedIndexes(start, end, count uint, intn func(int) int) []uint {
 	var indexes []uint
 	m := make(map[uint]uint, count)
 
@@ -547,7 +606,7 @@ func RandomFixedIndexes(start, end, count uint) []uint {
 	}
 
 	for i := 0; i < int(count); {
-		index := uint(rand.Intn(int(end-start))) + start
+		index := uint(intn(int(end-start))) + start
 
 		_, exist := m[index]
 		if exist {
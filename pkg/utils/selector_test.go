@@ -15,6 +15,7 @@ package utils
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -28,7 +29,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -47,7 +50,7 @@ func TestSelectPods(t *testing.T) {
 
 	pods = append(pods, pods2...)
 
-	c := fake.NewFakeClient(objects...)
+	c := newFakeClient(objects...)
 
 	type TestCase struct {
 		name         string
@@ -128,6 +131,64 @@ func TestSelectPods(t *testing.T) {
 	}
 }
 
+func TestSelectPodsWithProtection(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	objects, pods := generateNPods("p", 3, v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"app": "web"}, "")
+	protectedObjects, protectedPods := generateNPods("payment", 2, v1.PodRunning, metav1.NamespaceDefault, nil, map[string]string{"app": "payment"}, "")
+	objects = append(objects, protectedObjects...)
+	objects = append(objects, &v1alpha1.ChaosProtection{
+		ObjectMeta: metav1.ObjectMeta{Name: "payment"},
+		Spec: v1alpha1.ChaosProtectionSpec{
+			Selectors: []v1alpha1.SelectorSpec{
+				{LabelSelectors: map[string]string{"app": "payment"}},
+			},
+		},
+	})
+
+	c := newFakeClient(objects...)
+
+	selected, err := SelectPods(context.TODO(), c, v1alpha1.SelectorSpec{Namespaces: []string{metav1.NamespaceDefault}})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(selected).Should(ConsistOf(pods))
+
+	selected, err = SelectPods(context.TODO(), c, v1alpha1.SelectorSpec{
+		Pods: map[string][]string{metav1.NamespaceDefault: {protectedPods[0].Name, pods[0].Name}},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(selected).Should(ConsistOf(pods[0]))
+
+	type TestCase struct {
+		name      string
+		selectors []v1alpha1.SelectorSpec
+		expected  bool
+	}
+
+	tcs := []TestCase{
+		{
+			name:      "only protected pods",
+			selectors: []v1alpha1.SelectorSpec{{LabelSelectors: map[string]string{"app": "payment"}}},
+			expected:  true,
+		},
+		{
+			name:      "protected and unprotected pods",
+			selectors: []v1alpha1.SelectorSpec{{Namespaces: []string{metav1.NamespaceDefault}}},
+			expected:  false,
+		},
+		{
+			name:      "no pod selected",
+			selectors: []v1alpha1.SelectorSpec{{LabelSelectors: map[string]string{"app": "not-found"}}},
+			expected:  false,
+		},
+	}
+
+	for _, tc := range tcs {
+		protected, err := IsExclusivelyProtected(context.TODO(), c, tc.selectors)
+		g.Expect(err).ShouldNot(HaveOccurred(), tc.name)
+		g.Expect(protected).Should(Equal(tc.expected), tc.name)
+	}
+}
+
 func TestCheckPodMeetSelector(t *testing.T) {
 	g := NewGomegaWithT(t)
 
@@ -493,15 +554,140 @@ func TestIsAllowedNamespaces(t *testing.T) {
 		common.ControllerCfg.IgnoredNamespaces = ""
 	}
 
+	c := fake.NewFakeClient()
 	for _, tc := range tcs {
 		setRule(tc.allow, tc.ignore)
 		for index, pod := range tc.pods {
-			g.Expect(IsAllowedNamespaces(pod.Namespace)).Should(Equal(tc.ret[index]))
+			allowed, err := IsAllowedNamespaces(context.TODO(), c, pod.Namespace)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(allowed).Should(Equal(tc.ret[index]))
 		}
 		clean()
 	}
 }
 
+func TestSelectAndRecordPods(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	objects, _ := generateNPods("p", 3, v1.PodRunning, "enabled", nil, map[string]string{"app": "web"}, "")
+	protectedObjects, _ := generateNPods("payment", 2, v1.PodRunning, "enabled", nil, map[string]string{"app": "payment"}, "")
+	filteredObjects, _ := generateNPods("f", 2, v1.PodRunning, "disabled", nil, map[string]string{"app": "web"}, "")
+	objects = append(objects, protectedObjects...)
+	objects = append(objects, filteredObjects...)
+	objects = append(objects,
+		&v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "enabled",
+				Annotations: map[string]string{NamespaceInjectAnnotationKey: NamespaceInjectEnabled},
+			},
+		},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "disabled"}},
+		&v1alpha1.ChaosProtection{
+			ObjectMeta: metav1.ObjectMeta{Name: "payment"},
+			Spec: v1alpha1.ChaosProtectionSpec{
+				Selectors: []v1alpha1.SelectorSpec{
+					{LabelSelectors: map[string]string{"app": "payment"}},
+				},
+			},
+		},
+	)
+	c := newFakeClient(objects...)
+
+	common.ControllerCfg.EnableFilterNamespace = true
+	defer func() {
+		common.ControllerCfg.EnableFilterNamespace = false
+	}()
+
+	var selection v1alpha1.SelectionStatus
+	spec := &v1alpha1.PodChaosSpec{Mode: v1alpha1.OnePodMode}
+	selected, err := SelectAndRecordPods(context.TODO(), c, spec, &selection)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(selected).Should(HaveLen(1))
+	g.Expect(selection).Should(Equal(v1alpha1.SelectionStatus{
+		Matched:             7,
+		FilteredByNamespace: 2,
+		Protected:           2,
+		Selected:            1,
+	}))
+
+	spec.Selector = v1alpha1.SelectorSpec{Pods: map[string][]string{"disabled": {"f0", "f1"}}}
+	_, err = SelectAndRecordPods(context.TODO(), c, spec, &selection)
+	g.Expect(errors.Is(err, ErrNamespaceNotAllowed)).Should(BeTrue())
+	g.Expect(errors.Is(err, ErrNoPodSelected)).Should(BeTrue())
+	g.Expect(selection).Should(Equal(v1alpha1.SelectionStatus{
+		Matched:             2,
+		FilteredByNamespace: 2,
+	}))
+
+	spec.Selector = v1alpha1.SelectorSpec{LabelSelectors: map[string]string{"app": "not-found"}}
+	_, err = SelectAndRecordPods(context.TODO(), c, spec, &selection)
+	g.Expect(err).Should(Equal(ErrNoPodSelected))
+	g.Expect(errors.Is(err, ErrNamespaceNotAllowed)).Should(BeFalse())
+
+	spec.Selector = v1alpha1.SelectorSpec{}
+	spec.Mode = v1alpha1.FixedPodMode
+	spec.Value = "x"
+	_, err = SelectAndRecordPods(context.TODO(), c, spec, &selection)
+	g.Expect(errors.Is(err, ErrInvalidMode)).Should(BeTrue())
+	g.Expect(err.(*SelectionError).Reason()).Should(Equal(v1alpha1.SelectedReasonInvalidMode))
+
+	spec.Mode = v1alpha1.AllPodMode
+	spec.Selector = v1alpha1.SelectorSpec{PodPhaseSelectors: []string{"Running=true"}}
+	_, err = SelectAndRecordPods(context.TODO(), c, spec, &selection)
+	g.Expect(errors.Is(err, ErrInvalidSelector)).Should(BeTrue())
+	g.Expect(errors.Is(err, ErrNoPodSelected)).Should(BeFalse())
+}
+
+func TestIsAllowedNamespacesWithFilterNamespace(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	objects := []runtime.Object{
+		&v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "enabled",
+				Annotations: map[string]string{NamespaceInjectAnnotationKey: NamespaceInjectEnabled},
+			},
+		},
+		&v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "disabled",
+				Annotations: map[string]string{NamespaceInjectAnnotationKey: "disabled"},
+			},
+		},
+		&v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "empty",
+			},
+		},
+	}
+	c := fake.NewFakeClient(objects...)
+
+	common.ControllerCfg.EnableFilterNamespace = true
+	defer func() {
+		common.ControllerCfg.EnableFilterNamespace = false
+	}()
+
+	for ns, expected := range map[string]bool{
+		"enabled":   true,
+		"disabled":  false,
+		"empty":     false,
+		"not-found": false,
+	} {
+		allowed, err := IsAllowedNamespaces(context.TODO(), c, ns)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(allowed).Should(Equal(expected), ns)
+	}
+
+	// the regular expression still works with the opt-in namespace
+	common.ControllerCfg.IgnoredNamespaces = "enabled"
+	defer func() {
+		common.ControllerCfg.IgnoredNamespaces = ""
+	}()
+	allowed, err := IsAllowedNamespaces(context.TODO(), c, "enabled")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(allowed).Should(BeFalse())
+}
+
 func TestFilterNamespaceSelector(t *testing.T) {
 	g := NewGomegaWithT(t)
 
@@ -615,6 +801,14 @@ func TestFilterPodByNode(t *testing.T) {
 
 }
 
+func newFakeClient(objects ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+
+	return fake.NewFakeClientWithScheme(scheme, objects...)
+}
+
 func newPod(
 	name string,
 	status v1.PodPhase,
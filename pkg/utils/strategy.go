@@ -0,0 +1,186 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// SelectionStrategy chooses the pods which the chaos is injected into from the candidates,
+// the strategies are named by the modes of chaos
+type SelectionStrategy interface {
+	// Validate validates the value of the mode, it's called by the webhooks
+	Validate(value string) error
+	// Select chooses the pods from the candidates. The errors which aren't SelectionErrors,
+	// e.g. the errors of the client, are reported with the reason APIError.
+	Select(ctx context.Context, req *StrategyRequest) ([]v1.Pod, error)
+}
+
+// StrategyRequest is the request to choose the pods by a SelectionStrategy
+type StrategyRequest struct {
+	// Client reads the objects which the strategy depends on
+	Client client.Client
+	// Candidates are the pods which meet the selector and aren't protected, there is at least one candidate
+	Candidates []v1.Pod
+	// Value is the value of the mode
+	Value string
+	// Intn returns a random number in [0, n), the strategies choosing the pods randomly should use it
+	// so the selection is reproducible in tests
+	Intn func(n int) int
+}
+
+var strategies = map[v1alpha1.PodMode]SelectionStrategy{}
+
+// RegisterSelectionStrategy registers the strategy of the custom mode, so the chaos in the mode
+// is accepted by the webhooks and its pods are chosen by the strategy. It should be called in
+// init functions, and panics if the mode is registered twice.
+func RegisterSelectionStrategy(mode v1alpha1.PodMode, strategy SelectionStrategy) {
+	registerSelectionStrategy(mode, strategy)
+	v1alpha1.RegisterPodMode(mode, strategy.Validate)
+}
+
+// registerSelectionStrategy registers the strategy, the built-in modes are validated by the webhooks themselves
+func registerSelectionStrategy(mode v1alpha1.PodMode, strategy SelectionStrategy) {
+	if _, ok := strategies[mode]; ok {
+		panic(fmt.Sprintf("selection strategy %s is registered twice", mode))
+	}
+	strategies[mode] = strategy
+}
+
+// GetSelectionStrategy returns the strategy of the mode
+func GetSelectionStrategy(mode v1alpha1.PodMode) (SelectionStrategy, bool) {
+	strategy, ok := strategies[mode]
+	return strategy, ok
+}
+
+func init() {
+	registerSelectionStrategy(v1alpha1.OnePodMode, oneStrategy{})
+	registerSelectionStrategy(v1alpha1.AllPodMode, allStrategy{})
+	registerSelectionStrategy(v1alpha1.FixedPodMode, fixedStrategy{})
+	registerSelectionStrategy(v1alpha1.FixedPercentPodMode, fixedPercentStrategy{})
+	registerSelectionStrategy(v1alpha1.RandomMaxPercentPodMode, randomMaxPercentStrategy{})
+}
+
+// builtinStrategy validates the values of the built-in modes as the webhooks do
+type builtinStrategy v1alpha1.PodMode
+
+func (s builtinStrategy) validate(value string) error {
+	return v1alpha1.ValidatePodMode(value, v1alpha1.PodMode(s), field.NewPath("value")).ToAggregate()
+}
+
+type oneStrategy struct{}
+
+func (oneStrategy) Validate(value string) error {
+	return builtinStrategy(v1alpha1.OnePodMode).validate(value)
+}
+
+func (oneStrategy) Select(ctx context.Context, req *StrategyRequest) ([]v1.Pod, error) {
+	index := req.Intn(len(req.Candidates))
+	return []v1.Pod{req.Candidates[index]}, nil
+}
+
+type allStrategy struct{}
+
+func (allStrategy) Validate(value string) error {
+	return builtinStrategy(v1alpha1.AllPodMode).validate(value)
+}
+
+func (allStrategy) Select(ctx context.Context, req *StrategyRequest) ([]v1.Pod, error) {
+	return req.Candidates, nil
+}
+
+type fixedStrategy struct{}
+
+func (fixedStrategy) Validate(value string) error {
+	return builtinStrategy(v1alpha1.FixedPodMode).validate(value)
+}
+
+func (fixedStrategy) Select(ctx context.Context, req *StrategyRequest) ([]v1.Pod, error) {
+	num, err := strconv.Atoi(req.Value)
+	if err != nil {
+		return nil, asSelectionError(v1alpha1.SelectedReasonInvalidMode, err)
+	}
+
+	if len(req.Candidates) < num {
+		num = len(req.Candidates)
+	}
+
+	if num <= 0 {
+		return nil, newSelectionError(v1alpha1.SelectedReasonInvalidMode, "cannot select any pod as value below or equal 0", nil)
+	}
+
+	return getFixedSubListFromPodList(req.Candidates, num, req.Intn), nil
+}
+
+type fixedPercentStrategy struct{}
+
+func (fixedPercentStrategy) Validate(value string) error {
+	return builtinStrategy(v1alpha1.FixedPercentPodMode).validate(value)
+}
+
+func (fixedPercentStrategy) Select(ctx context.Context, req *StrategyRequest) ([]v1.Pod, error) {
+	percentage, err := strconv.Atoi(req.Value)
+	if err != nil {
+		return nil, asSelectionError(v1alpha1.SelectedReasonInvalidMode, err)
+	}
+
+	if percentage == 0 {
+		return nil, newSelectionError(v1alpha1.SelectedReasonInvalidMode, "cannot select any pod as value below or equal 0", nil)
+	}
+
+	if percentage < 0 || percentage > 100 {
+		return nil, newSelectionError(v1alpha1.SelectedReasonInvalidMode,
+			fmt.Sprintf("fixed percentage value of %d is invalid, Must be (0,100]", percentage), nil)
+	}
+
+	num := int(math.Floor(float64(len(req.Candidates)) * float64(percentage) / 100))
+
+	return getFixedSubListFromPodList(req.Candidates, num, req.Intn), nil
+}
+
+type randomMaxPercentStrategy struct{}
+
+func (randomMaxPercentStrategy) Validate(value string) error {
+	return builtinStrategy(v1alpha1.RandomMaxPercentPodMode).validate(value)
+}
+
+func (randomMaxPercentStrategy) Select(ctx context.Context, req *StrategyRequest) ([]v1.Pod, error) {
+	maxPercentage, err := strconv.Atoi(req.Value)
+	if err != nil {
+		return nil, asSelectionError(v1alpha1.SelectedReasonInvalidMode, err)
+	}
+
+	if maxPercentage == 0 {
+		return nil, newSelectionError(v1alpha1.SelectedReasonInvalidMode, "cannot select any pod as value below or equal 0", nil)
+	}
+
+	if maxPercentage < 0 || maxPercentage > 100 {
+		return nil, newSelectionError(v1alpha1.SelectedReasonInvalidMode,
+			fmt.Sprintf("fixed percentage value of %d is invalid, Must be [0-100]", maxPercentage), nil)
+	}
+
+	percentage := req.Intn(maxPercentage + 1) // + 1 because Intn works with half open interval [0,n) and we want [0,n]
+	num := int(math.Floor(float64(len(req.Candidates)) * float64(percentage) / 100))
+
+	return getFixedSubListFromPodList(req.Candidates, num, req.Intn), nil
+}
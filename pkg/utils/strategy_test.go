@@ -0,0 +1,78 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// followerStrategy chooses the pods which aren't labeled as the leader
+type followerStrategy struct{}
+
+func (followerStrategy) Validate(value string) error {
+	if value != "" {
+		return errors.New("value is not supported")
+	}
+	return nil
+}
+
+func (followerStrategy) Select(ctx context.Context, req *StrategyRequest) ([]v1.Pod, error) {
+	var followers []v1.Pod
+	for _, pod := range req.Candidates {
+		if pod.Labels["role"] != "leader" {
+			followers = append(followers, pod)
+		}
+	}
+	return followers, nil
+}
+
+func TestRegisterSelectionStrategy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	mode := v1alpha1.PodMode("followers")
+	RegisterSelectionStrategy(mode, followerStrategy{})
+	defer func() {
+		delete(strategies, mode)
+	}()
+
+	g.Expect(func() { RegisterSelectionStrategy(v1alpha1.OnePodMode, followerStrategy{}) }).Should(Panic())
+
+	valueField := field.NewPath("spec").Child("value")
+	g.Expect(v1alpha1.ValidatePodMode("", mode, valueField)).Should(BeEmpty())
+	g.Expect(v1alpha1.ValidatePodMode("1", mode, valueField)).ShouldNot(BeEmpty())
+	g.Expect(v1alpha1.ValidatePodMode("", v1alpha1.PodMode("unknown"), valueField)).ShouldNot(BeEmpty())
+
+	leader := newPod("p0", v1.PodRunning, "default", nil, map[string]string{"role": "leader"}, "")
+	follower := newPod("p1", v1.PodRunning, "default", nil, map[string]string{"role": "follower"}, "")
+	c := newFakeClient(&leader, &follower)
+
+	var selection v1alpha1.SelectionStatus
+	selected, err := SelectAndRecordPods(context.TODO(), c, &v1alpha1.PodChaosSpec{Mode: mode}, &selection)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(selected).Should(HaveLen(1))
+	g.Expect(selected[0].Name).Should(Equal("p1"))
+	g.Expect(selection.Selected).Should(Equal(1))
+
+	_, err = SelectAndRecordPods(context.TODO(), c, &v1alpha1.PodChaosSpec{Mode: v1alpha1.PodMode("unknown")}, &selection)
+	g.Expect(errors.Is(err, ErrInvalidMode)).Should(BeTrue())
+}
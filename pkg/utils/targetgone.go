@@ -0,0 +1,153 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// TargetGoneChecker tells whether the pods are going away with their namespaces or workloads.
+// The namespaces and the workloads are looked up once for all the pods checked by the same checker.
+type TargetGoneChecker struct {
+	Client client.Reader
+
+	// reasons are the reasons why the namespaces and the workloads are gone, which are empty
+	// if they aren't
+	reasons map[string]string
+}
+
+// NewTargetGoneChecker creates a TargetGoneChecker reading the namespaces and the workloads by c
+func NewTargetGoneChecker(c client.Reader) *TargetGoneChecker {
+	return &TargetGoneChecker{Client: c, reasons: make(map[string]string)}
+}
+
+// Gone returns the reason why the pod is gone, which is empty if it isn't. A pod is gone if it's
+// being deleted, or its namespace is being deleted, or its owning ReplicaSet, Deployment, StatefulSet
+// or DaemonSet is being deleted or has been deleted, unless the pods are orphaned by the deletion.
+func (g *TargetGoneChecker) Gone(ctx context.Context, pod *v1.Pod) (string, error) {
+	if pod.DeletionTimestamp != nil {
+		return fmt.Sprintf("pod %s/%s is being deleted", pod.Namespace, pod.Name), nil
+	}
+
+	reason, err := g.namespaceGone(ctx, pod.Namespace)
+	if reason != "" || err != nil {
+		return reason, err
+	}
+	return g.ownerGone(ctx, pod.Namespace, metav1.GetControllerOf(pod))
+}
+
+// namespaceGone returns the reason why the namespace is gone, which is empty if it isn't
+func (g *TargetGoneChecker) namespaceGone(ctx context.Context, name string) (string, error) {
+	key := "Namespace/" + name
+	if reason, ok := g.reasons[key]; ok {
+		return reason, nil
+	}
+
+	var ns v1.Namespace
+	err := g.Client.Get(ctx, types.NamespacedName{Name: name}, &ns)
+	if err != nil && !k8serror.IsNotFound(err) {
+		return "", err
+	}
+	// the namespace which isn't found yet may be just created, so only the terminating one is gone
+	reason := ""
+	if err == nil && (ns.DeletionTimestamp != nil || ns.Status.Phase == v1.NamespaceTerminating) {
+		reason = fmt.Sprintf("namespace %s is being deleted", name)
+	}
+	g.reasons[key] = reason
+	return reason, nil
+}
+
+// ownerGone returns the reason why the workload referred by ref is gone. The Deployment owning
+// the ReplicaSet is checked as well, and the owners of other kinds are never gone.
+func (g *TargetGoneChecker) ownerGone(ctx context.Context, namespace string, ref *metav1.OwnerReference) (string, error) {
+	if ref == nil {
+		return "", nil
+	}
+
+	var owner runtime.Object
+	switch ref.Kind {
+	case "ReplicaSet":
+		owner = &appsv1.ReplicaSet{}
+	case "Deployment":
+		owner = &appsv1.Deployment{}
+	case "StatefulSet":
+		owner = &appsv1.StatefulSet{}
+	case "DaemonSet":
+		owner = &appsv1.DaemonSet{}
+	default:
+		return "", nil
+	}
+
+	key := fmt.Sprintf("%s/%s/%s", ref.Kind, namespace, ref.Name)
+	if reason, ok := g.reasons[key]; ok {
+		return reason, nil
+	}
+
+	reason := ""
+	err := g.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, owner)
+	if k8serror.IsNotFound(err) {
+		// the pods still referring to the deleted owner are garbage collected
+		reason = fmt.Sprintf("%s %s/%s has been deleted", ref.Kind, namespace, ref.Name)
+	} else if err != nil {
+		return "", err
+	} else if meta := owner.(metav1.Object); meta.GetDeletionTimestamp() != nil && !orphansDependents(meta) {
+		reason = fmt.Sprintf("%s %s/%s is being deleted", ref.Kind, namespace, ref.Name)
+	} else if reason, err = g.ownerGone(ctx, namespace, metav1.GetControllerOf(meta)); err != nil {
+		return "", err
+	}
+	g.reasons[key] = reason
+	return reason, nil
+}
+
+// orphansDependents returns true if the object is deleted with its dependents orphaned, which are kept
+func orphansDependents(meta metav1.Object) bool {
+	for _, finalizer := range meta.GetFinalizers() {
+		if finalizer == metav1.FinalizerOrphanDependents {
+			return true
+		}
+	}
+	return false
+}
+
+// filterGonePods removes the pods which are gone with their namespaces or workloads, and records
+// the number of the removed pods in the selection
+func filterGonePods(ctx context.Context, c client.Reader, pods []v1.Pod, selection *v1alpha1.SelectionStatus) ([]v1.Pod, error) {
+	checker := NewTargetGoneChecker(c)
+
+	var live []v1.Pod
+	for i := range pods {
+		reason, err := checker.Gone(ctx, &pods[i])
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			log.Info("skip pod which is gone", "namespace", pods[i].Namespace, "name", pods[i].Name, "reason", reason)
+			continue
+		}
+		live = append(live, pods[i])
+	}
+	selection.Gone = len(pods) - len(live)
+	return live, nil
+}
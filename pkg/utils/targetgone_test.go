@@ -0,0 +1,98 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestTargetGone(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := metav1.Now()
+	controller := true
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "web", DeletionTimestamp: &now},
+	}
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       metav1.NamespaceDefault,
+			Name:            "web-1",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "web", Controller: &controller}},
+		},
+	}
+	orphaning := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         metav1.NamespaceDefault,
+			Name:              "db",
+			DeletionTimestamp: &now,
+			Finalizers:        []string{metav1.FinalizerOrphanDependents},
+		},
+	}
+	terminating := &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "terminating"},
+		Status:     v1.NamespaceStatus{Phase: v1.NamespaceTerminating},
+	}
+	c := newFakeClient(deploy, rs, orphaning, terminating)
+	checker := NewTargetGoneChecker(c)
+
+	// the pod is gone with the Deployment owning its ReplicaSet
+	pod := newOwnedPod("web-1-a", "ReplicaSet", "web-1", true)
+	reason, err := checker.Gone(context.TODO(), &pod)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(reason).Should(Equal("Deployment default/web is being deleted"))
+
+	// the owner has been deleted
+	pod = newOwnedPod("api-a", "ReplicaSet", "api", true)
+	reason, err = checker.Gone(context.TODO(), &pod)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(reason).Should(Equal("ReplicaSet default/api has been deleted"))
+
+	// the pods are orphaned by the deletion
+	pod = newOwnedPod("db-0", "StatefulSet", "db", true)
+	reason, err = checker.Gone(context.TODO(), &pod)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(reason).Should(BeEmpty())
+
+	// the namespace is being deleted
+	pod = v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "terminating", Name: "a"}}
+	reason, err = checker.Gone(context.TODO(), &pod)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(reason).Should(Equal("namespace terminating is being deleted"))
+
+	// the pod is being deleted
+	pod = v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "b", DeletionTimestamp: &now}}
+	reason, err = checker.Gone(context.TODO(), &pod)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(reason).Should(Equal("pod default/b is being deleted"))
+
+	// the gone pods are skipped in the selection
+	pods := []v1.Pod{
+		newOwnedPod("web-1-a", "ReplicaSet", "web-1", true),
+		newOwnedPod("db-0", "StatefulSet", "db", true),
+	}
+	selection := &v1alpha1.SelectionStatus{}
+	live, err := filterGonePods(context.TODO(), c, pods, selection)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(live).Should(Equal(pods[1:]))
+	g.Expect(selection.Gone).Should(Equal(1))
+}
@@ -0,0 +1,93 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/targetprovider"
+)
+
+// resolveTargets asks the provider to veto or supply the candidates. The supplied pods which aren't
+// candidates are filtered by the namespace policy and ChaosProtections like the selected ones.
+func resolveTargets(ctx context.Context, c client.Client, provider targetprovider.Provider, policy NamespacePolicy, candidates []v1.Pod) ([]v1.Pod, error) {
+	experiment, _ := targetprovider.ExperimentFrom(ctx)
+	req := &targetprovider.Request{Experiment: experiment}
+	byName := make(map[types.NamespacedName]v1.Pod, len(candidates))
+	for _, pod := range candidates {
+		req.Candidates = append(req.Candidates, targetprovider.Target{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			NodeName:  pod.Spec.NodeName,
+			Labels:    pod.Labels,
+		})
+		byName[types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}] = pod
+	}
+
+	resp, err := provider.Resolve(ctx, req)
+	if err != nil {
+		return nil, newSelectionError(v1alpha1.SelectedReasonAPIError, "target provider failed", err)
+	}
+	if resp.Vetoed {
+		return nil, newSelectionError(v1alpha1.SelectedReasonVetoed,
+			fmt.Sprintf("the targets are vetoed by the target provider: %s", resp.Reason), nil)
+	}
+	if resp.Targets == nil {
+		return candidates, nil
+	}
+
+	var supplied []v1.Pod
+	for _, target := range resp.Targets {
+		key := types.NamespacedName{Namespace: target.Namespace, Name: target.Name}
+		if pod, ok := byName[key]; ok {
+			supplied = append(supplied, pod)
+			continue
+		}
+
+		allowed, err := policy.IsAllowed(ctx, c, target.Namespace)
+		if err != nil {
+			return nil, asSelectionError(v1alpha1.SelectedReasonAPIError, err)
+		}
+		if !allowed {
+			log.Info("filter the pod supplied by the target provider by namespaces", "pod", target.Name, "namespace", target.Namespace)
+			continue
+		}
+
+		var pod v1.Pod
+		if err := c.Get(ctx, key, &pod); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Info("the pod supplied by the target provider is not found", "pod", target.Name, "namespace", target.Namespace)
+				continue
+			}
+			return nil, asSelectionError(v1alpha1.SelectedReasonAPIError, err)
+		}
+		supplied = append(supplied, pod)
+	}
+
+	supplied, err = filterProtectedPods(ctx, c, supplied)
+	if err != nil {
+		return nil, asSelectionError(v1alpha1.SelectedReasonAPIError, err)
+	}
+	if len(supplied) == 0 {
+		return nil, newSelectionError(v1alpha1.SelectedReasonNoPodSelected, "no pod supplied by the target provider is available", nil)
+	}
+	return supplied, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/targetprovider"
+)
+
+type fakeProvider struct {
+	req  *targetprovider.Request
+	resp *targetprovider.Response
+	err  error
+}
+
+func (p *fakeProvider) Resolve(ctx context.Context, req *targetprovider.Request) (*targetprovider.Response, error) {
+	p.req = req
+	return p.resp, p.err
+}
+
+func TestSelectWithTargetProvider(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	web := newPod("web", v1.PodRunning, "default", nil, map[string]string{"app": "web"}, "")
+	db := newPod("db", v1.PodRunning, "default", nil, map[string]string{"app": "db"}, "")
+	cache := newPod("cache", v1.PodRunning, "default", nil, map[string]string{"app": "cache"}, "")
+	c := newFakeClient(&web, &db, &cache, &v1alpha1.ChaosProtection{
+		ObjectMeta: metav1.ObjectMeta{Name: "db"},
+		Spec: v1alpha1.ChaosProtectionSpec{
+			Selectors: []v1alpha1.SelectorSpec{{LabelSelectors: map[string]string{"app": "db"}}},
+		},
+	})
+
+	provider := &fakeProvider{resp: &targetprovider.Response{}}
+	s := &PodSelector{Client: c, Provider: provider}
+	spec := &v1alpha1.PodChaosSpec{
+		Mode:     v1alpha1.AllPodMode,
+		Selector: v1alpha1.SelectorSpec{LabelSelectors: map[string]string{"app": "web"}},
+	}
+	ctx := targetprovider.WithExperiment(context.TODO(), targetprovider.Experiment{Kind: "PodChaos", Namespace: "default", Name: "kill"})
+
+	var selection v1alpha1.SelectionStatus
+	pods, err := s.SelectAndRecordPods(ctx, spec, &selection)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(pods).Should(HaveLen(1))
+	g.Expect(provider.req.Experiment.Name).Should(Equal("kill"))
+	g.Expect(provider.req.Candidates).Should(Equal([]targetprovider.Target{
+		{Namespace: "default", Name: "web", Labels: map[string]string{"app": "web"}},
+	}))
+
+	provider.resp = &targetprovider.Response{Vetoed: true, Reason: "frozen"}
+	_, err = s.SelectAndRecordPods(ctx, spec, &selection)
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(err.(*SelectionError).Reason()).Should(Equal(v1alpha1.SelectedReasonVetoed))
+
+	provider.resp = &targetprovider.Response{Targets: []targetprovider.Target{
+		{Namespace: "default", Name: "cache"},
+		{Namespace: "default", Name: "db"},
+		{Namespace: "default", Name: "not-found"},
+	}}
+	pods, err = s.SelectAndRecordPods(ctx, spec, &selection)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(pods).Should(HaveLen(1))
+	g.Expect(pods[0].Name).Should(Equal("cache"))
+	g.Expect(selection.Selected).Should(Equal(1))
+
+	provider.resp = &targetprovider.Response{Targets: []targetprovider.Target{}}
+	_, err = s.SelectAndRecordPods(ctx, spec, &selection)
+	g.Expect(errors.Is(err, ErrNoPodSelected)).Should(BeTrue())
+
+	provider.err = errors.New("cmdb is down")
+	_, err = s.SelectAndRecordPods(ctx, spec, &selection)
+	g.Expect(err.(*SelectionError).Reason()).Should(Equal(v1alpha1.SelectedReasonAPIError))
+}
@@ -0,0 +1,163 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// ChaosDaemonServerName is the name which the serving cert of chaos-daemon is issued for.
+// chaos-daemon is dialed by the address of node, so its cert is verified against this name.
+const ChaosDaemonServerName = "chaos-daemon"
+
+// TLSFiles is the paths of the CA cert, the cert and the key used in mutual TLS
+type TLSFiles struct {
+	CACert string
+	Cert   string
+	Key    string
+}
+
+// Enabled returns true if all the files are configured
+func (f TLSFiles) Enabled() bool {
+	return f.CACert != "" && f.Cert != "" && f.Key != ""
+}
+
+func (f TLSFiles) read() (caPEM, certPEM, keyPEM []byte, err error) {
+	if caPEM, err = ioutil.ReadFile(f.CACert); err != nil {
+		return
+	}
+	if certPEM, err = ioutil.ReadFile(f.Cert); err != nil {
+		return
+	}
+	keyPEM, err = ioutil.ReadFile(f.Key)
+	return
+}
+
+// ClientTLSConfig returns the tls config to dial the server with the client cert.
+// The files are read every time, so the rotated certs are used by the new connections.
+func (f TLSFiles) ClientTLSConfig(serverName string) (*tls.Config, error) {
+	caPEM, certPEM, keyPEM, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+	return NewClientTLSConfig(caPEM, certPEM, keyPEM, serverName)
+}
+
+// NewClientTLSConfig returns the tls config to dial the server with the client cert
+func NewClientTLSConfig(caPEM, certPEM, keyPEM []byte, serverName string) (*tls.Config, error) {
+	pool, cert, err := parseTLS(caPEM, certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{*cert},
+		RootCAs:      pool,
+		ServerName:   serverName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ServerTLSConfig returns the tls config which requires the clients to present a cert signed by the CA.
+// The files are reloaded once they are changed, so the rotated certs take effect without restart.
+func (f TLSFiles) ServerTLSConfig() (*tls.Config, error) {
+	r := &tlsReloader{files: f}
+	if _, err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.load()
+		},
+	}, nil
+}
+
+// tlsReloader caches the server tls config until any of the files is modified
+type tlsReloader struct {
+	sync.Mutex
+
+	files   TLSFiles
+	modTime time.Time
+	config  *tls.Config
+}
+
+// load returns the cached config if the files are not modified. If the files can't be
+// reloaded, e.g. they are being replaced, the previous config is returned.
+func (r *tlsReloader) load() (*tls.Config, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.reload(); err != nil {
+		if r.config == nil {
+			return nil, err
+		}
+		log.Error(err, "failed to reload certs, the previous certs are used")
+	}
+	return r.config, nil
+}
+
+func (r *tlsReloader) reload() error {
+	var modTime time.Time
+	for _, path := range []string{r.files.CACert, r.files.Cert, r.files.Key} {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+	}
+	if r.config != nil && modTime.Equal(r.modTime) {
+		return nil
+	}
+
+	caPEM, certPEM, keyPEM, err := r.files.read()
+	if err != nil {
+		return err
+	}
+	pool, cert, err := parseTLS(caPEM, certPEM, keyPEM)
+	if err != nil {
+		return err
+	}
+
+	r.modTime = modTime
+	r.config = &tls.Config{
+		Certificates: []tls.Certificate{*cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}
+	return nil
+}
+
+func parseTLS(caPEM, certPEM, keyPEM []byte) (*x509.CertPool, *tls.Certificate, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, nil, fmt.Errorf("no valid CA cert is found")
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pool, &cert, nil
+}
@@ -0,0 +1,176 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *rsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func newTestCert(g *GomegaWithT, name string, parent *testCert) *testCert {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	return &testCert{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+	}
+}
+
+func writeTestCert(g *GomegaWithT, dir string, ca, cert *testCert) TLSFiles {
+	files := TLSFiles{
+		CACert: filepath.Join(dir, "ca.crt"),
+		Cert:   filepath.Join(dir, "tls.crt"),
+		Key:    filepath.Join(dir, "tls.key"),
+	}
+	g.Expect(ioutil.WriteFile(files.CACert, ca.certPEM, 0600)).Should(Succeed())
+	g.Expect(ioutil.WriteFile(files.Cert, cert.certPEM, 0600)).Should(Succeed())
+	g.Expect(ioutil.WriteFile(files.Key, cert.keyPEM, 0600)).Should(Succeed())
+	return files
+}
+
+// handshake returns the common name of the server cert seen by the client,
+// an error is returned if either the client or the server fails the handshake
+func handshake(g *GomegaWithT, server, client *tls.Config) (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	defer listener.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- tls.Server(conn, server).Handshake()
+	}()
+
+	conn, err := tls.Dial("tcp", listener.Addr().String(), client)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	if err := <-serverErr; err != nil {
+		return "", err
+	}
+	return conn.ConnectionState().PeerCertificates[0].Subject.CommonName, nil
+}
+
+func TestMutualTLS(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := ioutil.TempDir("", "tls")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	defer os.RemoveAll(dir)
+	g.Expect(os.Mkdir(filepath.Join(dir, "server"), 0700)).Should(Succeed())
+	g.Expect(os.Mkdir(filepath.Join(dir, "client"), 0700)).Should(Succeed())
+
+	ca := newTestCert(g, "ca", nil)
+	serverFiles := writeTestCert(g, filepath.Join(dir, "server"), ca, newTestCert(g, ChaosDaemonServerName, ca))
+	clientFiles := writeTestCert(g, filepath.Join(dir, "client"), ca, newTestCert(g, "controller", ca))
+	g.Expect(serverFiles.Enabled()).Should(BeTrue())
+	g.Expect(TLSFiles{CACert: serverFiles.CACert}.Enabled()).Should(BeFalse())
+
+	server, err := serverFiles.ServerTLSConfig()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	client, err := clientFiles.ClientTLSConfig(ChaosDaemonServerName)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	name, err := handshake(g, server, client)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(name).Should(Equal(ChaosDaemonServerName))
+
+	// the server name must match the serving cert
+	wrongName, err := clientFiles.ClientTLSConfig("other")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	_, err = handshake(g, server, wrongName)
+	g.Expect(err).Should(HaveOccurred())
+
+	// the client cert must be signed by the CA
+	otherCA := newTestCert(g, "other-ca", nil)
+	otherCert := newTestCert(g, "controller", otherCA)
+	other, err := NewClientTLSConfig(ca.certPEM, otherCert.certPEM, otherCert.keyPEM, ChaosDaemonServerName)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	_, err = handshake(g, server, other)
+	g.Expect(err).Should(HaveOccurred())
+
+	// the rotated serving cert is used without recreating the config
+	rotated := newTestCert(g, "rotated", ca)
+	g.Expect(ioutil.WriteFile(serverFiles.Cert, rotated.certPEM, 0600)).Should(Succeed())
+	g.Expect(ioutil.WriteFile(serverFiles.Key, rotated.keyPEM, 0600)).Should(Succeed())
+	future := time.Now().Add(time.Minute)
+	g.Expect(os.Chtimes(serverFiles.Key, future, future)).Should(Succeed())
+
+	rotatedClient, err := clientFiles.ClientTLSConfig("rotated")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	name, err = handshake(g, server, rotatedClient)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(name).Should(Equal("rotated"))
+
+	// the previous certs are used if the files are broken
+	g.Expect(ioutil.WriteFile(serverFiles.Key, []byte("broken"), 0600)).Should(Succeed())
+	later := future.Add(time.Minute)
+	g.Expect(os.Chtimes(serverFiles.Key, later, later)).Should(Succeed())
+	name, err = handshake(g, server, rotatedClient)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(name).Should(Equal("rotated"))
+}
@@ -140,7 +140,12 @@ func injectRequired(metadata *metav1.ObjectMeta, cli client.Client, cfg *config.
 			return "", false
 		}
 	}
-	if !utils.IsAllowedNamespaces(metadata.Namespace) {
+	allowed, err := utils.IsAllowedNamespaces(context.Background(), cli, metadata.Namespace)
+	if err != nil {
+		log.Error(err, "Skip mutation for failed to check namespace", "name", metadata.Name, "namespace", metadata.Namespace)
+		return "", false
+	}
+	if !allowed {
 		log.Info("Skip mutation for it' in special namespace", "name", metadata.Name, "namespace", metadata.Namespace)
 		return "", false
 	}
@@ -181,6 +186,32 @@ func injectRequired(metadata *metav1.ObjectMeta, cli client.Client, cfg *config.
 	return "", false
 }
 
+// defaultConfig has the default annotation namespace, it's used to check the injection of the pods
+// out of the webhook, e.g. by the controllers of the chaos which relies on the sidecar
+var defaultConfig = config.NewConfigWatcherConf()
+
+// IsInjected returns true if the sidecar has been injected into the pod by the webhook
+func IsInjected(pod *corev1.Pod) bool {
+	return checkInjectStatus(&pod.ObjectMeta, defaultConfig)
+}
+
+// CheckInjected returns an error if the sidecar isn't injected into any of the pods, the chaos which
+// relies on the sidecar can't be injected into them
+func CheckInjected(pods []corev1.Pod) error {
+	var missing []string
+	for i := range pods {
+		if !IsInjected(&pods[i]) {
+			missing = append(missing, fmt.Sprintf("%s/%s", pods[i].Namespace, pods[i].Name))
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("the sidecar isn't injected into pods %v, annotate their namespaces with %s=<injection config> and recreate the pods",
+		missing, defaultConfig.RequestInitAnnotationKey())
+}
+
 func checkInjectStatus(metadata *metav1.ObjectMeta, cfg *config.Config) bool {
 	annotations := metadata.GetAnnotations()
 	if annotations == nil {
@@ -57,6 +57,27 @@ var _ = Describe("webhook inject", func() {
 		})
 	})
 
+	Context("CheckInjected", func() {
+		It("should return nil", func() {
+			pod := corev1.Pod{}
+			pod.Annotations = map[string]string{defaultConfig.StatusAnnotationKey(): StatusInjected}
+			Expect(IsInjected(&pod)).To(Equal(true))
+			Expect(CheckInjected([]corev1.Pod{pod})).To(BeNil())
+		})
+
+		It("should return the pods without the sidecar", func() {
+			injected := corev1.Pod{}
+			injected.Annotations = map[string]string{defaultConfig.StatusAnnotationKey(): StatusInjected}
+			pod := corev1.Pod{}
+			pod.Namespace = "default"
+			pod.Name = "web-0"
+			err := CheckInjected([]corev1.Pod{injected, pod})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("[default/web-0]"))
+			Expect(err.Error()).To(ContainSubstring(defaultConfig.RequestInitAnnotationKey()))
+		})
+	})
+
 	Context("injectByPodRequired", func() {
 		It("should return false", func() {
 			var metadata metav1.ObjectMeta
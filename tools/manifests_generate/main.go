@@ -0,0 +1,100 @@
+// Copyright 2020 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/shurcooL/httpfs/filter"
+	"github.com/shurcooL/httpfs/union"
+	"github.com/shurcooL/vfsgen"
+)
+
+// generates the manifests embedded into chaosctl, which are the CRDs and the templates of
+// the other resources, so that chaosctl can install Chaos Mesh without Helm or network access.
+// It also embeds the CRDs into the openapi package.
+func main() {
+	fs := union.New(map[string]http.FileSystem{
+		"/crd": filter.Keep(http.Dir("manifests"), func(path string, fi os.FileInfo) bool {
+			return path == "/" || path == "/crd.yaml"
+		}),
+		"/templates": http.Dir("pkg/chaosctl/install/templates"),
+	})
+
+	err := vfsgen.Generate(modTimeFS{fs}, vfsgen.Options{
+		Filename:     "pkg/chaosctl/install/zz_generated.manifests.go",
+		PackageName:  "install",
+		VariableName: "manifests",
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// the CRDs are embedded into the API server as well, whose OpenAPI schemas are served to the clients
+	crds := filter.Keep(http.Dir("manifests"), func(path string, fi os.FileInfo) bool {
+		return path == "/" || path == "/crd.yaml"
+	})
+	err = vfsgen.Generate(modTimeFS{crds}, vfsgen.Options{
+		Filename:     "pkg/openapi/zz_generated.crds.go",
+		PackageName:  "openapi",
+		VariableName: "crds",
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
+
+// modTimeFS hides the modification time of files to keep the generated code stable
+type modTimeFS struct {
+	http.FileSystem
+}
+
+func (fs modTimeFS) Open(name string) (http.File, error) {
+	f, err := fs.FileSystem.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return modTimeFile{f}, nil
+}
+
+type modTimeFile struct {
+	http.File
+}
+
+func (f modTimeFile) Stat() (os.FileInfo, error) {
+	fi, err := f.File.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return modTimeInfo{fi}, nil
+}
+
+func (f modTimeFile) Readdir(count int) ([]os.FileInfo, error) {
+	infos, err := f.File.Readdir(count)
+	for i := range infos {
+		infos[i] = modTimeInfo{infos[i]}
+	}
+	return infos, err
+}
+
+type modTimeInfo struct {
+	os.FileInfo
+}
+
+func (modTimeInfo) ModTime() time.Time {
+	return time.Time{}
+}
@@ -26,3 +26,6 @@ export const startExperiment = (namespace: string, name: string, kind: string) =
 
 export const detail = (namespace: string, name: string, kind: string) =>
   http.get(`/experiments/detail/${kind}/${namespace}/${name}`)
+
+export const artifacts = (namespace: string, name: string, kind: string) =>
+  http.get(`/experiments/artifacts/${kind}/${namespace}/${name}`)
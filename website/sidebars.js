@@ -19,6 +19,7 @@ module.exports = {
       items: [
         'user_guides/run_chaos_experiment',
         'user_guides/pause_experiment',
+        'user_guides/simulate_experiment',
         {
           type: 'category',
           label: 'Configure Chaos',
@@ -34,6 +35,12 @@ module.exports = {
         'user_guides/experiment_scope',
         'user_guides/sidecar_configmap',
         'user_guides/sidecar_template',
+        'user_guides/gitops',
+        'user_guides/notifications',
+        'user_guides/chaos_results',
+        'user_guides/windows_nodes',
+        'user_guides/workload_annotations',
+        'user_guides/import_experiments',
       ],
     },
     {